@@ -119,12 +119,18 @@ type Tap struct {
 	// ProofCourierAddr is the address of the proof courier that will be
 	// used to distribute related proofs for this address.
 	ProofCourierAddr url.URL
+
+	// FallbackCourierAddrs is an optional, ordered list of proof courier
+	// addresses that are used to distribute the proofs for this address if
+	// the courier at ProofCourierAddr isn't available.
+	FallbackCourierAddrs []url.URL
 }
 
 // newAddrOptions are a set of options that can modified how a new address is
 // created.
 type newAddrOptions struct {
-	assetVersion asset.Version
+	assetVersion         asset.Version
+	fallbackCourierAddrs []url.URL
 }
 
 // defaultNewAddrOptions returns a newAddrOptions struct with default values.`
@@ -146,6 +152,15 @@ func WithAssetVersion(v asset.Version) NewAddrOpt {
 	}
 }
 
+// WithFallbackCourierAddrs is a new address option that allows callers to
+// specify proof courier addresses that are used in the given order if the main
+// proof courier of the address isn't available.
+func WithFallbackCourierAddrs(addrs []url.URL) NewAddrOpt {
+	return func(o *newAddrOptions) {
+		o.fallbackCourierAddrs = addrs
+	}
+}
+
 // New creates an address for receiving a Taproot asset.
 //
 // TODO(ffranr): This function takes many arguments. Add a struct to better
@@ -215,6 +230,10 @@ func New(version Version, genesis asset.Genesis, groupKey *btcec.PublicKey,
 		assetGen:         genesis,
 		ProofCourierAddr: proofCourierAddr,
 	}
+	if len(options.fallbackCourierAddrs) > 0 {
+		payload.FallbackCourierAddrs = options.fallbackCourierAddrs
+	}
+
 	return &payload, nil
 }
 
@@ -227,6 +246,12 @@ func (a *Tap) Copy() *Tap {
 		addressCopy.GroupKey = &groupPubKey
 	}
 
+	if a.FallbackCourierAddrs != nil {
+		addressCopy.FallbackCourierAddrs = append(
+			[]url.URL(nil), a.FallbackCourierAddrs...,
+		)
+	}
+
 	return &addressCopy
 }
 
@@ -323,7 +348,7 @@ func (a *Tap) TaprootOutputKey() (*btcec.PublicKey, error) {
 // EncodeRecords determines the non-nil records to include when encoding an
 // address at runtime.
 func (a *Tap) EncodeRecords() []tlv.Record {
-	records := make([]tlv.Record, 0, 9)
+	records := make([]tlv.Record, 0, 10)
 	records = append(records, newAddressVersionRecord(&a.Version))
 	records = append(records, newAddressAssetVersionRecord(&a.AssetVersion))
 	records = append(records, newAddressAssetID(&a.AssetID))
@@ -344,6 +369,11 @@ func (a *Tap) EncodeRecords() []tlv.Record {
 	records = append(
 		records, newProofCourierAddrRecord(&a.ProofCourierAddr),
 	)
+	if len(a.FallbackCourierAddrs) > 0 {
+		records = append(records, newFallbackCourierAddrsRecord(
+			&a.FallbackCourierAddrs,
+		))
+	}
 
 	return records
 }
@@ -361,6 +391,7 @@ func (a *Tap) DecodeRecords() []tlv.Record {
 		newAddressTapscriptSiblingRecord(&a.TapscriptSibling),
 		newAddressAmountRecord(&a.Amount),
 		newProofCourierAddrRecord(&a.ProofCourierAddr),
+		newFallbackCourierAddrsRecord(&a.FallbackCourierAddrs),
 	}
 }
 
@@ -3,6 +3,7 @@ package address
 import (
 	"bytes"
 	"encoding/hex"
+	"net/url"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -112,6 +113,8 @@ func assertAddressEqual(t *testing.T, a, b *Tap) {
 	require.Equal(t, a.ScriptKey, b.ScriptKey)
 	require.Equal(t, a.InternalKey, b.InternalKey)
 	require.Equal(t, a.Amount, b.Amount)
+	require.Equal(t, a.ProofCourierAddr, b.ProofCourierAddr)
+	require.Equal(t, a.FallbackCourierAddrs, b.FallbackCourierAddrs)
 }
 
 // TestNewAddress tests edge cases around creating a new address.
@@ -317,6 +320,20 @@ func TestAddressEncoding(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "valid addr, fallback proof couriers",
+			f: func() (*Tap, string, error) {
+				return randEncodedAddress(
+					t, &MainNetTap, false, false,
+					asset.Normal,
+					WithFallbackCourierAddrs([]url.URL{
+						RandProofCourierAddr(t),
+						RandProofCourierAddr(t),
+					}),
+				)
+			},
+			err: nil,
+		},
 		{
 			name: "signet group collectible",
 			f: func() (*Tap, string, error) {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -21,6 +22,10 @@ var (
 	// This means an address can't be created until a Universe boostrap or
 	// manual issuance proof insertion.
 	ErrAssetGroupUnknown = fmt.Errorf("asset group is unknown")
+
+	// ErrEventNotFound is returned when there is no address event for a
+	// given on-chain output.
+	ErrEventNotFound = fmt.Errorf("address event not found")
 )
 
 // AddrWithKeyInfo wraps a normal Taproot Asset struct with key descriptor
@@ -205,6 +210,57 @@ func (b *Book) NewAddress(ctx context.Context, assetID asset.ID, amount uint64,
 	)
 }
 
+// NewAddressWithTapscript creates a new Taproot Asset address whose script key
+// commits to the tapscript tree assembled from the given leaves, on top of the
+// given internal key. Neither the internal key of the script key nor the keys
+// used in the scripts need to belong to the wallet, only the internal key of
+// the anchor output is derived from it. That's enough to detect and receive
+// inbound transfers, which are verified to be locked to the script key that
+// commits to the tree.
+func (b *Book) NewAddressWithTapscript(ctx context.Context, assetID asset.ID,
+	amount uint64, scriptInternalKey keychain.KeyDescriptor,
+	leaves []txscript.TapLeaf,
+	tapscriptSibling *commitment.TapscriptPreimage,
+	proofCourierAddr url.URL,
+	addrOpts ...NewAddrOpt) (*AddrWithKeyInfo, error) {
+
+	scriptKey, err := b.NewScriptKeyTapscript(
+		ctx, scriptInternalKey, leaves,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	internalKeyDesc, err := b.cfg.KeyRing.DeriveNextTaprootAssetKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gen key: %w", err)
+	}
+
+	return b.NewAddressWithKeys(
+		ctx, assetID, amount, scriptKey, internalKeyDesc,
+		tapscriptSibling, proofCourierAddr, addrOpts...,
+	)
+}
+
+// NewScriptKeyTapscript creates a script key that commits to the tapscript
+// tree assembled from the given leaves, on top of the given internal key. If
+// the wallet doesn't control the internal key, the script key is marked as
+// watch only, as assets locked to it can't be spent by the wallet on its own.
+func (b *Book) NewScriptKeyTapscript(ctx context.Context,
+	scriptInternalKey keychain.KeyDescriptor,
+	leaves []txscript.TapLeaf) (asset.ScriptKey, error) {
+
+	scriptKey, err := asset.NewScriptKeyTapscript(scriptInternalKey, leaves)
+	if err != nil {
+		return asset.ScriptKey{}, fmt.Errorf("unable to create script "+
+			"key: %w", err)
+	}
+
+	scriptKey.WatchOnly = !b.cfg.KeyRing.IsLocalKey(ctx, scriptInternalKey)
+
+	return scriptKey, nil
+}
+
 // NewAddressWithKeys creates a new Taproot Asset address based on the input
 // parameters that include pre-derived script and internal keys.
 func (b *Book) NewAddressWithKeys(ctx context.Context, assetID asset.ID,
@@ -288,6 +344,22 @@ func (b *Book) IsLocalKey(ctx context.Context,
 	return b.cfg.KeyRing.IsLocalKey(ctx, key)
 }
 
+// InsertInternalKey inserts an internal key into the database to make sure it
+// is identified as a local key later on when importing proofs.
+func (b *Book) InsertInternalKey(ctx context.Context,
+	keyDesc keychain.KeyDescriptor) error {
+
+	return b.cfg.Store.InsertInternalKey(ctx, keyDesc)
+}
+
+// InsertScriptKey inserts a script key into the database to make sure it is
+// identified as a local key later on when importing proofs.
+func (b *Book) InsertScriptKey(ctx context.Context,
+	scriptKey asset.ScriptKey) error {
+
+	return b.cfg.Store.InsertScriptKey(ctx, scriptKey)
+}
+
 // NextInternalKey derives then inserts an internal key into the database to
 // make sure it is identified as a local key later on when importing proofs. The
 // key can be an internal key for an asset script key or the internal key of an
@@ -389,6 +461,14 @@ func (b *Book) CompleteEvent(ctx context.Context, event *Event,
 	return b.cfg.Store.CompleteEvent(ctx, event, status, anchorPoint)
 }
 
+// SetEventLabel sets the label of the address events of the given on-chain
+// output. An empty label removes the current label.
+func (b *Book) SetEventLabel(ctx context.Context, outpoint wire.OutPoint,
+	label string) error {
+
+	return b.cfg.Store.SetEventLabel(ctx, outpoint, label)
+}
+
 // RegisterSubscriber adds a new subscriber for receiving events. The
 // deliverExisting boolean indicates whether already existing items should be
 // sent to the NewItemCreated channel when the subscription is started. An
@@ -73,6 +73,69 @@ func urlDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
 	)
 }
 
+// urlSliceEncoder encodes a slice of url.URL as a var int count followed by
+// each URL as a length prefixed byte slice.
+func urlSliceEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*[]url.URL); ok {
+		if err := tlv.WriteVarInt(w, uint64(len(*t)), buf); err != nil {
+			return err
+		}
+		for idx := range *t {
+			addrBytes := []byte((*t)[idx].String())
+			err := tlv.WriteVarInt(w, uint64(len(addrBytes)), buf)
+			if err != nil {
+				return err
+			}
+			err = tlv.EVarBytes(w, &addrBytes, buf)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*[]url.URL")
+}
+
+// urlSliceDecoder decodes a slice of url.URL that was encoded with
+// urlSliceEncoder.
+func urlSliceDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
+	if t, ok := val.(*[]url.URL); ok {
+		// Each URL is prefixed by its length, so there can't be more
+		// URLs than bytes in the record.
+		numAddrs, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+		if numAddrs > l {
+			return tlv.ErrRecordTooLarge
+		}
+
+		addrs := make([]url.URL, 0, numAddrs)
+		for i := uint64(0); i < numAddrs; i++ {
+			addrLen, err := tlv.ReadVarInt(r, buf)
+			if err != nil {
+				return err
+			}
+			if addrLen > l {
+				return tlv.ErrRecordTooLarge
+			}
+
+			var addr url.URL
+			err = urlDecoder(r, &addr, buf, addrLen)
+			if err != nil {
+				return err
+			}
+			addrs = append(addrs, addr)
+		}
+		*t = addrs
+
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(
+		val, "*[]url.URL", l, l,
+	)
+}
+
 func VersionEncoder(w io.Writer, val any, buf *[8]byte) error {
 	if t, ok := val.(*Version); ok {
 		return tlv.EUint8T(w, uint8(*t), buf)
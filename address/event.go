@@ -48,6 +48,10 @@ type EventQueryParams struct {
 	// StatusTo is the largest status to query for (inclusive). Can be
 	// set to nil to return events of all states.
 	StatusTo *Status
+
+	// Label is the optional label to filter by. Must be set to nil to
+	// return events with any or no label.
+	Label *string
 }
 
 // Event represents a single incoming asset transfer that was initiated by
@@ -93,6 +97,15 @@ type Event struct {
 	// don't keep a reference to it in memory as the proof itself can be
 	// large. The proof can be fetched by the script key of the address.
 	HasProof bool
+
+	// Label is the optional, user-defined label of the incoming asset
+	// transfer.
+	Label string
+
+	// Reused indicates that the address already received assets in an
+	// earlier transaction. Reusing an address links the transfers to it
+	// through its script key.
+	Reused bool
 }
 
 // EventStorage is the interface that a component storing address events should
@@ -115,4 +128,10 @@ type EventStorage interface {
 	// with the proof and asset that was imported/created for it.
 	CompleteEvent(ctx context.Context, event *Event, status Status,
 		anchorPoint wire.OutPoint) error
+
+	// SetEventLabel sets the label of the address events of the given
+	// on-chain output. An empty label removes the current label.
+	// ErrEventNotFound is returned if there is no event for the output.
+	SetEventLabel(ctx context.Context, outpoint wire.OutPoint,
+		label string) error
 }
@@ -139,6 +139,12 @@ func NewTestFromAddress(t testing.TB, a *Tap) *TestAddress {
 		)
 	}
 
+	for _, courierAddr := range a.FallbackCourierAddrs {
+		ta.FallbackCourierAddrs = append(
+			ta.FallbackCourierAddrs, courierAddr.String(),
+		)
+	}
+
 	return ta
 }
 
@@ -153,6 +159,8 @@ type TestAddress struct {
 	TapscriptSibling string `json:"tapscript_sibling"`
 	Amount           uint64 `json:"amount"`
 	ProofCourierAddr string `json:"proof_courier_addr"`
+
+	FallbackCourierAddrs []string `json:"fallback_courier_addrs,omitempty"`
 }
 
 func (ta *TestAddress) ToAddress(t testing.TB) *Tap {
@@ -222,5 +230,15 @@ func (ta *TestAddress) ToAddress(t testing.TB) *Tap {
 		)
 	}
 
+	for _, addrStr := range ta.FallbackCourierAddrs {
+		courierAddr, err := url.ParseRequestURI(addrStr)
+		if err != nil {
+			panic(err)
+		}
+		a.FallbackCourierAddrs = append(
+			a.FallbackCourierAddrs, *courierAddr,
+		)
+	}
+
 	return a
 }
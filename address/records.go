@@ -1,6 +1,7 @@
 package address
 
 import (
+	"bytes"
 	"net/url"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -40,6 +41,10 @@ const (
 
 	// addrProofCourierType is the TLV type of the proof courier address.
 	addrProofCourierAddrType addressTLVType = 12
+
+	// addrFallbackCourierAddrsType is the TLV type of the fallback proof
+	// courier addresses.
+	addrFallbackCourierAddrsType addressTLVType = 13
 )
 
 func newAddressVersionRecord(version *Version) tlv.Record {
@@ -118,3 +123,20 @@ func newProofCourierAddrRecord(addr *url.URL) tlv.Record {
 		urlEncoder, urlDecoder,
 	)
 }
+
+func newFallbackCourierAddrsRecord(addrs *[]url.URL) tlv.Record {
+	recordSize := func() uint64 {
+		var (
+			b   bytes.Buffer
+			buf [8]byte
+		)
+		if err := urlSliceEncoder(&b, addrs, &buf); err != nil {
+			panic(err)
+		}
+		return uint64(len(b.Bytes()))
+	}
+	return tlv.MakeDynamicRecord(
+		addrFallbackCourierAddrsType, addrs, recordSize,
+		urlSliceEncoder, urlSliceDecoder,
+	)
+}
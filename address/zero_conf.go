@@ -0,0 +1,64 @@
+package address
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// ZeroConfPolicy governs which inbound asset transfers are accepted as soon as
+// their anchor transaction is detected, before it confirms. The received
+// assets still can't be spent before the transfer is completed, which
+// requires the proof of the confirmed transaction. The zero value doesn't
+// accept any transfer at zero confirmations.
+type ZeroConfPolicy struct {
+	// AssetIDs are the hex encoded IDs of the assets that are accepted at
+	// zero confirmations.
+	AssetIDs []string `long:"assetid" description:"The hex encoded ID of an asset whose inbound transfers are accepted at zero confirmations. Can be specified multiple times."`
+
+	// MaxAmount is the maximum asset amount of a single inbound transfer
+	// that is accepted at zero confirmations. Zero means there is no
+	// limit.
+	MaxAmount uint64 `long:"maxamount" description:"The maximum asset amount of a single inbound transfer that is accepted at zero confirmations. 0 means there is no limit."`
+}
+
+// Validate makes sure the zero-conf policy is sane.
+func (p *ZeroConfPolicy) Validate() error {
+	for _, assetID := range p.AssetIDs {
+		idBytes, err := hex.DecodeString(assetID)
+		if err != nil || len(idBytes) != len(asset.ID{}) {
+			return fmt.Errorf("invalid asset ID %q", assetID)
+		}
+	}
+
+	return nil
+}
+
+// Accepts returns true if transfers to the given address are accepted at zero
+// confirmations.
+func (p *ZeroConfPolicy) Accepts(addr *Tap) bool {
+	if p.MaxAmount != 0 && addr.Amount > p.MaxAmount {
+		return false
+	}
+
+	assetID := hex.EncodeToString(addr.AssetID[:])
+	for _, acceptedID := range p.AssetIDs {
+		if strings.EqualFold(acceptedID, assetID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PendingAccepted returns the events of the given ones that are accepted at
+// zero confirmations but aren't completed yet.
+func (p *ZeroConfPolicy) PendingAccepted(events []*Event) []*Event {
+	return fn.Filter(events, func(event *Event) bool {
+		return event.Status != StatusCompleted &&
+			p.Accepts(event.Addr.Tap)
+	})
+}
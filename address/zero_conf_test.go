@@ -0,0 +1,66 @@
+package address
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestZeroConfPolicy tests that only transfers of the configured assets up to
+// the maximum amount are accepted at zero confirmations.
+func TestZeroConfPolicy(t *testing.T) {
+	t.Parallel()
+
+	acceptedID := asset.RandID(t)
+	policy := &ZeroConfPolicy{
+		AssetIDs: []string{
+			strings.ToUpper(hex.EncodeToString(acceptedID[:])),
+		},
+		MaxAmount: 100,
+	}
+	require.NoError(t, policy.Validate())
+
+	newEvent := func(assetID asset.ID, amount uint64,
+		status Status) *Event {
+
+		return &Event{
+			Addr: &AddrWithKeyInfo{
+				Tap: &Tap{
+					AssetID: assetID,
+					Amount:  amount,
+				},
+			},
+			Status: status,
+		}
+	}
+
+	accepted := newEvent(acceptedID, 100, StatusTransactionDetected)
+	tooLarge := newEvent(acceptedID, 101, StatusTransactionDetected)
+	otherAsset := newEvent(asset.RandID(t), 1, StatusTransactionDetected)
+	completed := newEvent(acceptedID, 1, StatusCompleted)
+	confirmed := newEvent(acceptedID, 1, StatusTransactionConfirmed)
+
+	require.True(t, policy.Accepts(accepted.Addr.Tap))
+	require.False(t, policy.Accepts(tooLarge.Addr.Tap))
+	require.False(t, policy.Accepts(otherAsset.Addr.Tap))
+
+	// Completed transfers are part of the regular balance already.
+	pending := policy.PendingAccepted([]*Event{
+		accepted, tooLarge, otherAsset, completed, confirmed,
+	})
+	require.Equal(t, []*Event{accepted, confirmed}, pending)
+
+	// Without a maximum amount, any amount is accepted.
+	policy.MaxAmount = 0
+	require.True(t, policy.Accepts(tooLarge.Addr.Tap))
+
+	// The zero value doesn't accept anything.
+	require.False(t, (&ZeroConfPolicy{}).Accepts(accepted.Addr.Tap))
+
+	// Asset IDs must be valid.
+	policy.AssetIDs = append(policy.AssetIDs, "abcd")
+	require.ErrorContains(t, policy.Validate(), "invalid asset ID")
+}
@@ -737,6 +737,12 @@ type TweakedScriptKey struct {
 	// Tweak is the tweak that is applied on the raw script key to get the
 	// public key. If this is nil, then a BIP-0086 tweak is assumed.
 	Tweak []byte
+
+	// WatchOnly indicates that the raw script key isn't controlled by the
+	// wallet, so assets locked to the script key can only be spent by
+	// satisfying one of the scripts it commits to, which may require the
+	// signatures of other parties.
+	WatchOnly bool
 }
 
 // ScriptKey represents a tweaked Taproot output key encumbering the different
@@ -773,6 +779,39 @@ func NewScriptKey(key *btcec.PublicKey) ScriptKey {
 	}
 }
 
+// NewScriptKeyTapscript constructs a ScriptKey that commits to the tapscript
+// tree assembled from the given leaves, so the asset can be spent by satisfying
+// any of their scripts, for example a multisig script. The tweak of the
+// resulting script key is the root hash of the tree.
+func NewScriptKeyTapscript(rawKey keychain.KeyDescriptor,
+	leaves []txscript.TapLeaf) (ScriptKey, error) {
+
+	if len(leaves) == 0 {
+		return ScriptKey{}, fmt.Errorf("tapscript tree must have at " +
+			"least one leaf")
+	}
+
+	tree := txscript.AssembleTaprootScriptTree(leaves...)
+	rootHash := tree.RootNode.TapHash()
+	tweakedPubKey := txscript.ComputeTaprootOutputKey(
+		rawKey.PubKey, rootHash[:],
+	)
+
+	// Just like for BIP-0086 keys, we only ever use the x-only
+	// representation of the key.
+	tweakedPubKey, _ = schnorr.ParsePubKey(
+		schnorr.SerializePubKey(tweakedPubKey),
+	)
+
+	return ScriptKey{
+		PubKey: tweakedPubKey,
+		TweakedScriptKey: &TweakedScriptKey{
+			RawKey: rawKey,
+			Tweak:  rootHash[:],
+		},
+	}, nil
+}
+
 // NewScriptKeyBip86 constructs a ScriptKey tweaked BIP-0086 style. The
 // resulting script key will include the specified BIP-0086 tweak (no real
 // tweak), and also apply that to the final external PubKey.
@@ -804,26 +843,160 @@ func DeriveGroupKey(genSigner GenesisSigner, genBuilder GenesisTxBuilder,
 	rawKey keychain.KeyDescriptor, initialGen Genesis,
 	newAsset *Asset) (*GroupKey, error) {
 
-	// First, perform the final checks on the asset being authorized for
-	// group membership.
+	return DeriveGroupKeyWithRoot(
+		genSigner, genBuilder, rawKey, nil, initialGen, newAsset,
+	)
+}
+
+// DeriveGroupKeyWithRoot derives an asset's group key like DeriveGroupKey, but
+// for a group key that commits to the given tapscript root, which may be nil.
+// The new asset is still authorized through a key path signature, script path
+// spends are handled by DeriveGroupKeyScriptSpend.
+func DeriveGroupKeyWithRoot(genSigner GenesisSigner,
+	genBuilder GenesisTxBuilder, rawKey keychain.KeyDescriptor,
+	tapscriptRoot []byte, initialGen Genesis,
+	newAsset *Asset) (*GroupKey, error) {
+
+	if len(tapscriptRoot) != 0 && len(tapscriptRoot) != sha256.Size {
+		return nil, fmt.Errorf("tapscript root must be %d bytes",
+			sha256.Size)
+	}
+
+	genesisTweak := initialGen.ID()
+	tweakedGroupKey, genesisTx, prevOut, err := groupGenesisTx(
+		genBuilder, rawKey, initialGen, newAsset, tapscriptRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the static signing descriptor needed to sign the virtual
+	// minting transaction. A group key without a tapscript root is a
+	// BIP-0086 key, otherwise the signer needs to apply the root as the
+	// taproot tweak.
+	signDesc := &lndclient.SignDescriptor{
+		KeyDesc:     rawKey,
+		SingleTweak: genesisTweak[:],
+		SignMethod:  input.TaprootKeySpendBIP0086SignMethod,
+		Output:      prevOut,
+		HashType:    txscript.SigHashDefault,
+		InputIndex:  0,
+	}
+	if len(tapscriptRoot) != 0 {
+		signDesc.SignMethod = input.TaprootKeySpendSignMethod
+		signDesc.TapTweak = tapscriptRoot
+	}
+
+	sig, err := genSigner.SignVirtualTx(signDesc, genesisTx, prevOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupKey{
+		RawKey:        rawKey,
+		GroupPubKey:   *tweakedGroupKey,
+		TapscriptRoot: tapscriptRoot,
+		Witness:       wire.TxWitness{sig.Serialize()},
+	}, nil
+}
+
+// DeriveGroupKeyScriptSpend derives an asset's group key like DeriveGroupKey,
+// but for a group key that commits to a tapscript tree with the given leaves.
+// The new asset is authorized through a script path spend of the leaf with the
+// given index instead of a key path signature.
+func DeriveGroupKeyScriptSpend(genSigner GenesisScriptSigner,
+	genBuilder GenesisTxBuilder, rawKey keychain.KeyDescriptor,
+	tapLeaves []txscript.TapLeaf, leafIdx int, initialGen Genesis,
+	newAsset *Asset) (*GroupKey, error) {
+
+	if leafIdx < 0 || leafIdx >= len(tapLeaves) {
+		return nil, fmt.Errorf("invalid tapscript leaf index %d",
+			leafIdx)
+	}
+	tapLeaf := tapLeaves[leafIdx]
+
+	tree := txscript.AssembleTaprootScriptTree(tapLeaves...)
+	rootHash := tree.RootNode.TapHash()
+
+	genesisTweak := initialGen.ID()
+	tweakedGroupKey, genesisTx, prevOut, err := groupGenesisTx(
+		genBuilder, rawKey, initialGen, newAsset, rootHash[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The control block proves the inclusion of the leaf in the tapscript
+	// tree of the group key, which is committed to by the internal key
+	// that is the raw key tweaked with the genesis tweak.
+	internalKey := input.TweakPubKeyWithTweak(
+		rawKey.PubKey, genesisTweak[:],
+	)
+	controlBlock := tree.LeafMerkleProofs[leafIdx].ToControlBlock(
+		internalKey,
+	)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("cannot serialize control block: %w",
+			err)
+	}
+
+	signDesc := &lndclient.SignDescriptor{
+		KeyDesc:       rawKey,
+		SingleTweak:   genesisTweak[:],
+		TapTweak:      rootHash[:],
+		WitnessScript: tapLeaf.Script,
+		SignMethod:    input.TaprootScriptSpendSignMethod,
+		Output:        prevOut,
+		HashType:      txscript.SigHashDefault,
+		InputIndex:    0,
+	}
+	witness, err := genSigner.SignVirtualTxScript(
+		signDesc, genesisTx, prevOut,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	witness = append(witness, tapLeaf.Script, controlBlockBytes)
+
+	return &GroupKey{
+		RawKey:        rawKey,
+		GroupPubKey:   *tweakedGroupKey,
+		TapscriptRoot: rootHash[:],
+		Witness:       witness,
+	}, nil
+}
+
+// groupGenesisTx performs the final checks on an asset that is authorized for
+// group membership, and builds the virtual minting transaction that must be
+// signed to generate its group witness. The tweaked group key is returned
+// along with the transaction and its previous output.
+func groupGenesisTx(genBuilder GenesisTxBuilder, rawKey keychain.KeyDescriptor,
+	initialGen Genesis, newAsset *Asset, tapTweak []byte) (*btcec.PublicKey,
+	*wire.MsgTx, *wire.TxOut, error) {
+
 	if newAsset == nil {
-		return nil, fmt.Errorf("grouped asset cannot be nil")
+		return nil, nil, nil, fmt.Errorf("grouped asset cannot be nil")
 	}
 
 	if !newAsset.HasGenesisWitness() {
-		return nil, fmt.Errorf("asset is not a genesis asset")
+		return nil, nil, nil, fmt.Errorf("asset is not a genesis asset")
 	}
 
 	if initialGen.Type != newAsset.Type {
-		return nil, fmt.Errorf("asset group type mismatch")
+		return nil, nil, nil, fmt.Errorf("asset group type mismatch")
 	}
 
 	// Compute the tweaked group key and set it in the asset before
 	// creating the virtual minting transaction.
 	genesisTweak := initialGen.ID()
-	tweakedGroupKey, err := GroupPubKey(rawKey.PubKey, genesisTweak[:], nil)
+	tweakedGroupKey, err := GroupPubKey(
+		rawKey.PubKey, genesisTweak[:], tapTweak,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot tweak group key: %w", err)
+		return nil, nil, nil, fmt.Errorf("cannot tweak group key: %w",
+			err)
 	}
 
 	assetWithGroup := newAsset.Copy()
@@ -835,30 +1008,11 @@ func DeriveGroupKey(genSigner GenesisSigner, genBuilder GenesisTxBuilder,
 	// asset, which will be signed to generate the group witness.
 	genesisTx, prevOut, err := genBuilder.BuildGenesisTx(assetWithGroup)
 	if err != nil {
-		return nil, fmt.Errorf("cannot build virtual tx: %w", err)
-	}
-
-	// Build the static signing descriptor needed to sign the virtual
-	// minting transaction. This is restricted to group keys with an empty
-	// tapscript root and key path spends.
-	signDesc := &lndclient.SignDescriptor{
-		KeyDesc:     rawKey,
-		SingleTweak: genesisTweak[:],
-		SignMethod:  input.TaprootKeySpendBIP0086SignMethod,
-		Output:      prevOut,
-		HashType:    txscript.SigHashDefault,
-		InputIndex:  0,
-	}
-	sig, err := genSigner.SignVirtualTx(signDesc, genesisTx, prevOut)
-	if err != nil {
-		return nil, err
+		return nil, nil, nil, fmt.Errorf("cannot build virtual tx: %w",
+			err)
 	}
 
-	return &GroupKey{
-		RawKey:      rawKey,
-		GroupPubKey: *tweakedGroupKey,
-		Witness:     wire.TxWitness{sig.Serialize()},
-	}, nil
+	return tweakedGroupKey, genesisTx, prevOut, nil
 }
 
 // Asset represents a Taproot asset.
@@ -790,3 +790,50 @@ func TestNewAssetWithCustomVersion(t *testing.T) {
 
 	require.Equal(t, int(assetCustomVersion.Version), newVersion)
 }
+
+// TestNewScriptKeyTapscript tests that a script key committing to a tapscript
+// tree can be spent through the scripts of the tree.
+func TestNewScriptKeyTapscript(t *testing.T) {
+	t.Parallel()
+
+	// A 2-of-3 multisig script is the most common use case.
+	builder := txscript.NewScriptBuilder()
+	for i := 0; i < 3; i++ {
+		builder.AddData(schnorr.SerializePubKey(test.RandPubKey(t)))
+		if i == 0 {
+			builder.AddOp(txscript.OP_CHECKSIG)
+		} else {
+			builder.AddOp(txscript.OP_CHECKSIGADD)
+		}
+	}
+	builder.AddInt64(2)
+	builder.AddOp(txscript.OP_NUMEQUAL)
+	multisigScript, err := builder.Script()
+	require.NoError(t, err)
+
+	leaves := []txscript.TapLeaf{
+		txscript.NewBaseTapLeaf(multisigScript),
+		txscript.NewBaseTapLeaf([]byte{txscript.OP_TRUE}),
+	}
+	rawKey := keychain.KeyDescriptor{
+		PubKey: NUMSPubKey,
+	}
+	scriptKey, err := NewScriptKeyTapscript(rawKey, leaves)
+	require.NoError(t, err)
+
+	tree := txscript.AssembleTaprootScriptTree(leaves...)
+	rootHash := tree.RootNode.TapHash()
+	require.Equal(t, rootHash[:], scriptKey.Tweak)
+	require.Equal(t, rawKey, scriptKey.RawKey)
+
+	// The multisig leaf must be provably committed to by the script key.
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(NUMSPubKey)
+	require.NoError(t, txscript.VerifyTaprootLeafCommitment(
+		&controlBlock, schnorr.SerializePubKey(scriptKey.PubKey),
+		multisigScript,
+	))
+
+	// A tree needs at least one leaf.
+	_, err = NewScriptKeyTapscript(rawKey, nil)
+	require.ErrorContains(t, err, "at least one leaf")
+}
@@ -0,0 +1,101 @@
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxDecimalDisplay is the maximum number of decimal places an asset
+	// can use for displaying its amounts. With 18 decimal places, the
+	// largest possible amount of an asset is still more than ten whole
+	// units.
+	MaxDecimalDisplay = 18
+)
+
+var (
+	// ErrInvalidDecimalDisplay signals that the decimal display of an asset
+	// is out of range.
+	ErrInvalidDecimalDisplay = fmt.Errorf("decimal display cannot "+
+		"exceed %d", MaxDecimalDisplay)
+
+	// ErrInvalidDisplayAmount signals that an amount in display units
+	// can't be converted to an amount in asset units without loss.
+	ErrInvalidDisplayAmount = errors.New("invalid display amount")
+)
+
+// DisplayAmount converts an amount in asset units into its human-readable
+// representation in display units by placing the decimal point according to
+// the decimal display of the asset. The conversion is exact, all decimal places
+// are always included.
+func DisplayAmount(amount uint64, decimalDisplay uint32) (string, error) {
+	if decimalDisplay > MaxDecimalDisplay {
+		return "", ErrInvalidDecimalDisplay
+	}
+
+	digits := strconv.FormatUint(amount, 10)
+	if decimalDisplay == 0 {
+		return digits, nil
+	}
+
+	// We pad the amount with leading zeros so there is at least one digit
+	// before the decimal point.
+	numDecimals := int(decimalDisplay)
+	if len(digits) <= numDecimals {
+		padding := strings.Repeat("0", numDecimals-len(digits)+1)
+		digits = padding + digits
+	}
+
+	pointIdx := len(digits) - numDecimals
+	return digits[:pointIdx] + "." + digits[pointIdx:], nil
+}
+
+// ParseDisplayAmount converts a human-readable amount in display units into
+// an amount in asset units, given the decimal display of the asset. An error
+// is returned if the amount has more decimal places than the asset supports,
+// as those would otherwise silently be lost, or if the amount overflows.
+func ParseDisplayAmount(displayAmount string,
+	decimalDisplay uint32) (uint64, error) {
+
+	if decimalDisplay > MaxDecimalDisplay {
+		return 0, ErrInvalidDecimalDisplay
+	}
+
+	whole, fraction, hasPoint := strings.Cut(displayAmount, ".")
+	switch {
+	case !isDigits(whole) || (hasPoint && !isDigits(fraction)):
+		return 0, fmt.Errorf("%w: %q is not a decimal number",
+			ErrInvalidDisplayAmount, displayAmount)
+
+	case len(fraction) > int(decimalDisplay):
+		return 0, fmt.Errorf("%w: %q has more than %d decimal places",
+			ErrInvalidDisplayAmount, displayAmount, decimalDisplay)
+	}
+
+	padding := strings.Repeat("0", int(decimalDisplay)-len(fraction))
+	amount, err := strconv.ParseUint(whole+fraction+padding, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is out of range",
+			ErrInvalidDisplayAmount, displayAmount)
+	}
+
+	return amount, nil
+}
+
+// isDigits returns true if the given string is non-empty and only consists of
+// decimal digits.
+func isDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, char := range s {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
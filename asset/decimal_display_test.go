@@ -0,0 +1,112 @@
+package asset
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDisplayAmount tests that amounts are converted between asset units and
+// display units without any loss.
+func TestDisplayAmount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		amount         uint64
+		decimalDisplay uint32
+		displayAmount  string
+	}{{
+		name:           "no decimals",
+		amount:         1234,
+		decimalDisplay: 0,
+		displayAmount:  "1234",
+	}, {
+		name:           "whole and fractional units",
+		amount:         1234567,
+		decimalDisplay: 3,
+		displayAmount:  "1234.567",
+	}, {
+		name:           "only fractional units",
+		amount:         5,
+		decimalDisplay: 3,
+		displayAmount:  "0.005",
+	}, {
+		name:           "zero amount",
+		amount:         0,
+		decimalDisplay: 2,
+		displayAmount:  "0.00",
+	}, {
+		name:           "max amount with max decimals",
+		amount:         math.MaxUint64,
+		decimalDisplay: MaxDecimalDisplay,
+		displayAmount:  "18.446744073709551615",
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			displayAmount, err := DisplayAmount(
+				testCase.amount, testCase.decimalDisplay,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.displayAmount, displayAmount)
+
+			amount, err := ParseDisplayAmount(
+				displayAmount, testCase.decimalDisplay,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.amount, amount)
+		})
+	}
+}
+
+// TestParseDisplayAmount tests that amounts in display units that can't be
+// represented exactly in asset units are rejected.
+func TestParseDisplayAmount(t *testing.T) {
+	t.Parallel()
+
+	// Fewer decimal places than the asset supports are fine.
+	amount, err := ParseDisplayAmount("12.5", 3)
+	require.NoError(t, err)
+	require.EqualValues(t, 12500, amount)
+
+	amount, err = ParseDisplayAmount("12", 3)
+	require.NoError(t, err)
+	require.EqualValues(t, 12000, amount)
+
+	invalidAmounts := []struct {
+		displayAmount  string
+		decimalDisplay uint32
+	}{
+		{"", 2},
+		{".5", 2},
+		{"1.", 2},
+		{"-1", 2},
+		{"+1", 2},
+		{"1,5", 2},
+		{"1.2.3", 2},
+		{"1.234", 2},
+		{"0.1", 0},
+		{"18.446744073709551616", MaxDecimalDisplay},
+		{"18446744073709551616", 0},
+	}
+	for _, invalid := range invalidAmounts {
+		_, err := ParseDisplayAmount(
+			invalid.displayAmount, invalid.decimalDisplay,
+		)
+		require.ErrorIs(t, err, ErrInvalidDisplayAmount,
+			invalid.displayAmount)
+	}
+
+	// The decimal display itself must be in range.
+	_, err = ParseDisplayAmount("1", MaxDecimalDisplay+1)
+	require.ErrorIs(t, err, ErrInvalidDecimalDisplay)
+
+	_, err = DisplayAmount(1, MaxDecimalDisplay+1)
+	require.ErrorIs(t, err, ErrInvalidDecimalDisplay)
+}
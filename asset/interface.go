@@ -15,6 +15,17 @@ type GenesisSigner interface {
 		prevOut *wire.TxOut) (*schnorr.Signature, error)
 }
 
+// GenesisScriptSigner is used to produce the witness of a group key script
+// spend that authorizes the minting of an asset into an asset group.
+type GenesisScriptSigner interface {
+	// SignVirtualTxScript generates the witness elements that satisfy the
+	// tapscript leaf set as the witness script of the passed signing
+	// descriptor. The elements don't include the script itself and the
+	// control block.
+	SignVirtualTxScript(signDesc *lndclient.SignDescriptor, tx *wire.MsgTx,
+		prevOut *wire.TxOut) (wire.TxWitness, error)
+}
+
 // GenesisTxBuilder is used to construct the virtual transaction that represents
 // asset minting for grouped assets. This transaction is used to generate a
 // group witness that authorizes the minting of an asset into the asset group.
@@ -0,0 +1,176 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+)
+
+const (
+	// maxBroadcastErrLen is the maximum number of bytes of an HTTP
+	// broadcast endpoint's error response we include in the error we
+	// return.
+	maxBroadcastErrLen = 512
+)
+
+// Broadcaster is used to publish fully signed transactions to the network.
+type Broadcaster interface {
+	// PublishTransaction attempts to publish a new transaction to the
+	// network. The label is attached to the transaction by backends that
+	// support labelling transactions and ignored by all others.
+	PublishTransaction(ctx context.Context, tx *wire.MsgTx,
+		label string) error
+}
+
+// LndBroadcaster is an implementation of the Broadcaster interface that
+// publishes transactions through the wallet of the connected lnd node.
+type LndBroadcaster struct {
+	lnd *lndclient.LndServices
+}
+
+// A compile-time assertion to ensure LndBroadcaster implements the
+// Broadcaster interface.
+var _ Broadcaster = (*LndBroadcaster)(nil)
+
+// NewLndBroadcaster creates a new broadcaster from an active lnd services
+// client.
+func NewLndBroadcaster(lnd *lndclient.LndServices) *LndBroadcaster {
+	return &LndBroadcaster{
+		lnd: lnd,
+	}
+}
+
+// PublishTransaction attempts to publish a new transaction to the network.
+func (l *LndBroadcaster) PublishTransaction(ctx context.Context,
+	tx *wire.MsgTx, label string) error {
+
+	return l.lnd.WalletKit.PublishTransaction(ctx, tx, label)
+}
+
+// BitcoindBroadcaster is an implementation of the Broadcaster interface that
+// publishes transactions through the RPC interface of a bitcoind node.
+type BitcoindBroadcaster struct {
+	client *rpcclient.Client
+}
+
+// A compile-time assertion to ensure BitcoindBroadcaster implements the
+// Broadcaster interface.
+var _ Broadcaster = (*BitcoindBroadcaster)(nil)
+
+// NewBitcoindBroadcaster creates a new broadcaster that connects to the
+// bitcoind RPC interface at the given host:port with the given credentials.
+func NewBitcoindBroadcaster(host, user,
+	pass string) (*BitcoindBroadcaster, error) {
+
+	client, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:         host,
+		User:         user,
+		Pass:         pass,
+		HTTPPostMode: true,
+		DisableTLS:   true,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create bitcoind RPC client: "+
+			"%w", err)
+	}
+
+	return &BitcoindBroadcaster{
+		client: client,
+	}, nil
+}
+
+// PublishTransaction attempts to publish a new transaction to the network.
+//
+// NOTE: The RPC client doesn't take a context, so if the context is done
+// before bitcoind answers, we return the context's error without waiting for
+// the request to finish.
+func (b *BitcoindBroadcaster) PublishTransaction(ctx context.Context,
+	tx *wire.MsgTx, _ string) error {
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := b.client.SendRawTransaction(tx, false)
+		errChan <- err
+	}()
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			return fmt.Errorf("unable to publish transaction via "+
+				"bitcoind: %w", err)
+		}
+
+		return nil
+
+	case <-ctx.Done():
+		return fmt.Errorf("unable to publish transaction via "+
+			"bitcoind: %w", ctx.Err())
+	}
+}
+
+// HTTPBroadcaster is an implementation of the Broadcaster interface that
+// publishes transactions by posting them, hex encoded, to an HTTP endpoint.
+// This is compatible with the transaction broadcast endpoint of Esplora based
+// block explorers.
+type HTTPBroadcaster struct {
+	url string
+
+	client *http.Client
+}
+
+// A compile-time assertion to ensure HTTPBroadcaster implements the
+// Broadcaster interface.
+var _ Broadcaster = (*HTTPBroadcaster)(nil)
+
+// NewHTTPBroadcaster creates a new broadcaster that posts transactions to the
+// given URL.
+func NewHTTPBroadcaster(url string) *HTTPBroadcaster {
+	return &HTTPBroadcaster{
+		url:    url,
+		client: &http.Client{},
+	}
+}
+
+// PublishTransaction attempts to publish a new transaction to the network.
+func (h *HTTPBroadcaster) PublishTransaction(ctx context.Context,
+	tx *wire.MsgTx, _ string) error {
+
+	var txBuf bytes.Buffer
+	if err := tx.Serialize(&txBuf); err != nil {
+		return fmt.Errorf("unable to serialize transaction: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, h.url,
+		bytes.NewBufferString(hex.EncodeToString(txBuf.Bytes())),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create broadcast request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to publish transaction via %s: %w",
+			h.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errMsg, _ := io.ReadAll(
+			io.LimitReader(resp.Body, maxBroadcastErrLen),
+		)
+		return fmt.Errorf("unable to publish transaction via %s: "+
+			"status %d: %s", h.url, resp.StatusCode,
+			bytes.TrimSpace(errMsg))
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package taprootassets
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// testBroadcastTx returns a minimal transaction and its hex encoding.
+func testBroadcastTx(t *testing.T) (*wire.MsgTx, string) {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{
+		Value:    1000,
+		PkScript: []byte{0x51},
+	})
+
+	var buf strings.Builder
+	require.NoError(t, tx.Serialize(hex.NewEncoder(&buf)))
+
+	return tx, buf.String()
+}
+
+// TestHTTPBroadcaster tests that the HTTP broadcaster posts the hex encoded
+// transaction and surfaces errors returned by the endpoint.
+func TestHTTPBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	tx, txHex := testBroadcastTx(t)
+
+	testCases := []struct {
+		name          string
+		status        int
+		respBody      string
+		delay         time.Duration
+		timeout       time.Duration
+		errContain    string
+		errNotContain string
+	}{{
+		name:     "success",
+		status:   http.StatusOK,
+		respBody: tx.TxHash().String(),
+	}, {
+		name:       "rejected",
+		status:     http.StatusBadRequest,
+		respBody:   "sendrawtransaction RPC error: bad-txns",
+		errContain: "status 400: sendrawtransaction RPC error",
+	}, {
+		name:          "long error truncated",
+		status:        http.StatusInternalServerError,
+		respBody:      strings.Repeat("x", 2*maxBroadcastErrLen),
+		errContain:    strings.Repeat("x", maxBroadcastErrLen),
+		errNotContain: strings.Repeat("x", maxBroadcastErrLen+1),
+	}, {
+		name:       "deadline exceeded",
+		status:     http.StatusOK,
+		delay:      time.Second,
+		timeout:    50 * time.Millisecond,
+		errContain: context.DeadlineExceeded.Error(),
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					require.Equal(t, http.MethodPost, r.Method)
+					require.Equal(t, txHex, string(body))
+
+					select {
+					case <-time.After(tc.delay):
+					case <-r.Context().Done():
+						return
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.respBody))
+				},
+			))
+			defer srv.Close()
+
+			ctx := context.Background()
+			if tc.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(
+					ctx, tc.timeout,
+				)
+				defer cancel()
+			}
+
+			broadcaster := NewHTTPBroadcaster(srv.URL + "/tx")
+			err := broadcaster.PublishTransaction(ctx, tx, "")
+			if tc.errContain == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.errContain)
+			if tc.errNotContain != "" {
+				require.NotContains(
+					t, err.Error(), tc.errNotContain,
+				)
+			}
+		})
+	}
+}
+
+// bitcoindRPCRequest is a JSON-RPC request sent by the bitcoind RPC client.
+type bitcoindRPCRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// newBitcoindServer creates an HTTP server that answers bitcoind JSON-RPC
+// requests the way bitcoind does. Raw transactions are answered with the given
+// error message, or the txid if the message is empty, after the given delay.
+func newBitcoindServer(t *testing.T, txHex, errMsg string,
+	delay time.Duration) *httptest.Server {
+
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req bitcoindRPCRequest
+			err := json.NewDecoder(r.Body).Decode(&req)
+			require.NoError(t, err)
+
+			var result, rpcErr any
+			switch req.Method {
+			// Like bitcoind, we don't know the btcd specific getinfo
+			// call, which makes the client detect the version
+			// through getnetworkinfo.
+			case "getinfo":
+				rpcErr = map[string]any{
+					"code":    -32601,
+					"message": "Method not found",
+				}
+
+			case "getnetworkinfo":
+				result = map[string]any{
+					"subversion": "/Satoshi:26.0.0/",
+				}
+
+			case "sendrawtransaction":
+				var rawTx string
+				require.NoError(
+					t, json.Unmarshal(req.Params[0], &rawTx),
+				)
+				require.Equal(t, txHex, rawTx)
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return
+				}
+
+				result = strings.Repeat("00", 32)
+				if errMsg != "" {
+					result = nil
+					rpcErr = map[string]any{
+						"code":    -26,
+						"message": errMsg,
+					}
+				}
+
+			default:
+				t.Errorf("unexpected method %v", req.Method)
+			}
+
+			err = json.NewEncoder(w).Encode(map[string]any{
+				"id":     req.ID,
+				"result": result,
+				"error":  rpcErr,
+			})
+			require.NoError(t, err)
+		},
+	))
+}
+
+// TestBitcoindBroadcaster tests that the bitcoind broadcaster publishes the
+// transaction through the RPC interface, surfaces errors returned by bitcoind
+// and honours the deadline of the context.
+func TestBitcoindBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	tx, txHex := testBroadcastTx(t)
+
+	testCases := []struct {
+		name       string
+		errMsg     string
+		delay      time.Duration
+		timeout    time.Duration
+		errContain string
+	}{{
+		name: "success",
+	}, {
+		name:       "rejected",
+		errMsg:     "bad-txns-inputs-missingorspent",
+		errContain: "bad-txns-inputs-missingorspent",
+	}, {
+		name:       "deadline exceeded",
+		delay:      time.Second,
+		timeout:    50 * time.Millisecond,
+		errContain: context.DeadlineExceeded.Error(),
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newBitcoindServer(t, txHex, tc.errMsg, tc.delay)
+			defer srv.Close()
+
+			host := strings.TrimPrefix(srv.URL, "http://")
+			broadcaster, err := NewBitcoindBroadcaster(
+				host, "user", "pass",
+			)
+			require.NoError(t, err)
+			defer broadcaster.client.Shutdown()
+
+			ctx := context.Background()
+			if tc.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(
+					ctx, tc.timeout,
+				)
+				defer cancel()
+			}
+
+			start := time.Now()
+			err = broadcaster.PublishTransaction(ctx, tx, "")
+			if tc.errContain == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.errContain)
+
+			// We must not wait for bitcoind once the deadline is
+			// exceeded.
+			if tc.timeout != 0 {
+				require.Less(t, time.Since(start), tc.delay)
+			}
+		})
+	}
+}
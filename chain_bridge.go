@@ -16,13 +16,18 @@ import (
 // interface backed by an active remote lnd node.
 type LndRpcChainBridge struct {
 	lnd *lndclient.LndServices
+
+	broadcaster Broadcaster
 }
 
 // NewLndRpcChainBridge creates a new chain bridge from an active lnd services
-// client.
-func NewLndRpcChainBridge(lnd *lndclient.LndServices) *LndRpcChainBridge {
+// client. Transactions are published with the given broadcaster.
+func NewLndRpcChainBridge(lnd *lndclient.LndServices,
+	broadcaster Broadcaster) *LndRpcChainBridge {
+
 	return &LndRpcChainBridge{
-		lnd: lnd,
+		lnd:         lnd,
+		broadcaster: broadcaster,
 	}
 }
 
@@ -59,6 +64,23 @@ func (l *LndRpcChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	}, errChan, nil
 }
 
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent on chain.
+func (l *LndRpcChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte,
+	heightHint uint32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	spendChan, errChan, err := l.lnd.ChainNotifier.RegisterSpendNtfn(
+		ctx, outpoint, pkScript, int32(heightHint),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to register for spend: %w",
+			err)
+	}
+
+	return spendChan, errChan, nil
+}
+
 // RegisterBlockEpochNtfn registers an intent to be notified of each new block
 // connected to the main chain.
 func (l *LndRpcChainBridge) RegisterBlockEpochNtfn(
@@ -139,10 +161,9 @@ func (l *LndRpcChainBridge) CurrentHeight(ctx context.Context) (uint32, error) {
 // PublishTransaction attempts to publish a new transaction to the
 // network.
 func (l *LndRpcChainBridge) PublishTransaction(ctx context.Context,
-	tx *wire.MsgTx) error {
+	tx *wire.MsgTx, label string) error {
 
-	label := "tapd-asset-minting"
-	return l.lnd.WalletKit.PublishTransaction(ctx, tx, label)
+	return l.broadcaster.PublishTransaction(ctx, tx, label)
 }
 
 // EstimateFee returns a fee estimate for the confirmation target.
@@ -22,6 +22,7 @@ var addrCommands = []cli.Command{
 			queryAddrsCommand,
 			decodeAddrCommand,
 			receivesAddrCommand,
+			labelReceiveCommand,
 		},
 	},
 }
@@ -32,6 +33,14 @@ const (
 	amtName = "amt"
 
 	assetVersionName = "asset_version"
+
+	labelName = "label"
+
+	fallbackProofCourierAddrName = "fallback_proof_courier_addr"
+
+	scriptKeyLeafName = "script_key_leaf"
+
+	scriptKeyInternalKeyName = "script_key_internal_key"
 )
 
 var newAddrCommand = cli.Command{
@@ -53,6 +62,32 @@ var newAddrCommand = cli.Command{
 			Name:  assetVersionName,
 			Usage: "the asset version of the asset to receive",
 		},
+		cli.StringFlag{
+			Name: proofCourierAddrName,
+			Usage: "the optional address of the proof courier " +
+				"to receive the proof through, if unset the " +
+				"daemon's default is used",
+		},
+		cli.StringSliceFlag{
+			Name: fallbackProofCourierAddrName,
+			Usage: "the address of a proof courier to receive " +
+				"the proof through if the main one isn't " +
+				"available; can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: scriptKeyLeafName,
+			Usage: "the hex encoded script of a tapscript leaf " +
+				"the script key of the received asset should " +
+				"commit to, for example a multisig script; " +
+				"can be specified multiple times",
+		},
+		cli.StringFlag{
+			Name: scriptKeyInternalKeyName,
+			Usage: "the optional hex encoded internal key of " +
+				"the script key that commits to the " +
+				"tapscript leaves, if unset the NUMS key is " +
+				"used",
+		},
 	},
 	Action: newAddr,
 }
@@ -79,11 +114,48 @@ func newAddr(ctx *cli.Context) error {
 		return err
 	}
 
-	addr, err := client.NewAddr(ctxc, &taprpc.NewAddrRequest{
-		AssetId:      assetID,
-		Amt:          ctx.Uint64(amtName),
-		AssetVersion: assetVersion,
-	})
+	req := &taprpc.NewAddrRequest{
+		AssetId:          assetID,
+		Amt:              ctx.Uint64(amtName),
+		AssetVersion:     assetVersion,
+		ProofCourierAddr: ctx.String(proofCourierAddrName),
+		FallbackProofCourierAddrs: ctx.StringSlice(
+			fallbackProofCourierAddrName,
+		),
+	}
+
+	if ctx.IsSet(scriptKeyLeafName) {
+		req.ScriptKeyTree = &taprpc.TapscriptFullTree{}
+		for _, leafHex := range ctx.StringSlice(scriptKeyLeafName) {
+			script, err := hex.DecodeString(leafHex)
+			if err != nil {
+				return fmt.Errorf("invalid script key leaf: %w",
+					err)
+			}
+
+			req.ScriptKeyTree.AllLeaves = append(
+				req.ScriptKeyTree.AllLeaves, &taprpc.TapLeaf{
+					Script: script,
+				},
+			)
+		}
+	}
+
+	if ctx.IsSet(scriptKeyInternalKeyName) {
+		internalKey, err := hex.DecodeString(
+			ctx.String(scriptKeyInternalKeyName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid script key internal key: %w",
+				err)
+		}
+
+		req.ScriptKeyInternalKey = &taprpc.KeyDescriptor{
+			RawKeyBytes: internalKey,
+		}
+	}
+
+	addr, err := client.NewAddr(ctxc, req)
 	if err != nil {
 		return fmt.Errorf("unable to make addr: %w", err)
 	}
@@ -222,6 +294,10 @@ var receivesAddrCommand = cli.Command{
 			Name:  addrName,
 			Usage: "show transfers of a single address only",
 		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "show transfers with the given label only",
+		},
 	},
 	Action: addrReceives,
 }
@@ -241,7 +317,8 @@ func addrReceives(ctx *cli.Context) error {
 	}
 
 	resp, err := client.AddrReceives(ctxc, &taprpc.AddrReceivesRequest{
-		FilterAddr: addr,
+		FilterAddr:  addr,
+		FilterLabel: ctx.String(labelName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to query addr receives: %w", err)
@@ -250,3 +327,47 @@ func addrReceives(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var labelReceiveCommand = cli.Command{
+	Name:      "label",
+	ShortName: "l",
+	Usage:     "Set the label of an inbound asset transfer",
+	Description: "Set the label of the inbound asset transfer that " +
+		"created the given on-chain outpoint. An empty label removes " +
+		"an existing one.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: outpointName,
+			Usage: "the outpoint of the inbound transfer in the " +
+				"form of txid:vout",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "the label to set",
+		},
+	},
+	Action: labelReceive,
+}
+
+func labelReceive(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(outpointName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	resp, err := client.SetReceiveLabel(
+		ctxc, &taprpc.SetReceiveLabelRequest{
+			Outpoint: ctx.String(outpointName),
+			Label:    ctx.String(labelName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to set receive label: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
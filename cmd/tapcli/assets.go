@@ -3,12 +3,23 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
 	taprootassets "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
 )
 
@@ -26,8 +37,19 @@ var assetsCommands = []cli.Command{
 			listAssetBalancesCommand,
 			sendAssetsCommand,
 			burnAssetsCommand,
+			listBurnsCommand,
+			exportBurnCommand,
 			listTransfersCommand,
+			exportTransfersCommand,
+			bumpTransferFeeCommand,
+			cpfpTransferCommand,
+			cancelTransferCommand,
+			resumeTransferDeliveryCommand,
+			sendQueueCommand,
+			consolidateAssetsCommand,
+			sweepAssetsCommand,
 			fetchMetaCommand,
+			importAssetCommand,
 		},
 	},
 }
@@ -50,59 +72,236 @@ var (
 	shortResponseName            = "short"
 	assetAmountName              = "amount"
 	burnOverrideConfirmationName = "override_confirmation_destroy_assets"
+	satPerVByteName              = "sat_per_vbyte"
+	scheduleIntervalName         = "interval"
+	scheduleMaxSeedlingsName     = "max_seedlings"
+	scheduleMaxMetaSizeName      = "max_meta_size"
+	externalGroupSignerName      = "external_group_signer"
+	groupInternalKeyName         = "group_internal_key"
+	sigHashName                  = "sig_hash"
+	signatureName                = "signature"
+	musig2SignerName             = "musig2_signer"
+	multiSigThresholdName        = "multisig_threshold"
+	multiSigKeyName              = "multisig_key"
+	delegateKeyName              = "delegate_key"
+	delegateKeyFamilyName        = "delegate_key_family"
+	delegateKeyIndexName         = "delegate_key_index"
+	signerKeyName                = "signer_key"
+	pubNonceName                 = "pub_nonce"
+	partialSigName               = "partial_sig"
+	metaByReferenceName          = "meta_by_reference"
+	metaBlobURLName              = "meta_blob_url"
+	decimalDisplayName           = "decimal_display"
+	maxSupplyName                = "max_supply"
+	vanityPrefixName             = "vanity_prefix"
+	emissionEpochName            = "emission_epoch"
+	tapscriptSiblingName         = "tapscript_sibling"
+	tapscriptLeafName            = "tapscript_leaf"
+	templateNameName             = "template"
+	templateFileName             = "template_file"
+	assetNameSuffixName          = "name_suffix"
+	externalFundingName          = "external_funding"
+	genesisPsbtName              = "psbt"
+	replayFromHeightName         = "replay_from_height"
+	batchLabelName               = "label"
+	scriptInternalKeyName        = "script_internal_key"
+	scriptKeyTweakName           = "script_key_tweak"
+	genesisOutpointName          = "genesis_outpoint"
+	anchorOutputIndexName        = "anchor_output_index"
+	scriptKeyFamilyName          = "script_key_family"
+	scriptKeyIndexName           = "script_key_index"
+	anchorInternalKeyName        = "anchor_internal_key"
+	anchorKeyFamilyName          = "anchor_key_family"
+	anchorKeyIndexName           = "anchor_key_index"
+	externalSignerName           = "external_signer"
+	inputAnchorPointName         = "input"
+	coinSelectStrategyName       = "coin_select_strategy"
+	anchorTxidName               = "anchor_txid"
+	sendDelayName                = "delay"
+	maxSatPerVByteName           = "max_sat_per_vbyte"
+	queuedSendIDName             = "id"
+	targetUtxoCountName          = "target_utxos"
+	maxChainFeeName              = "max_chain_fee"
+	proofCourierAddrName         = "proof_courier_addr"
+	anchorLockTimeName           = "anchor_lock_time"
+	anchorSequenceName           = "anchor_sequence"
+	burnNoteName                 = "note"
+	exportStartName              = "start"
+	exportEndName                = "end"
+	exportFormatName             = "format"
+	exportOutputFileName         = "output_file"
 )
 
+// mintAssetFlags are the flags that describe a new asset to mint.
+var mintAssetFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: assetTypeName,
+		Usage: "the type of asset, must either be: normal, " +
+			"or collectible",
+	},
+	cli.StringFlag{
+		Name:  assetTagName,
+		Usage: "the name/tag of the asset",
+	},
+	cli.Uint64Flag{
+		Name:  assetSupplyName,
+		Usage: "the target supply of the minted asset",
+	},
+	cli.Uint64Flag{
+		Name:  assetVersionName,
+		Usage: "the version of the asset to mint",
+	},
+	cli.StringFlag{
+		Name:  assetMetaBytesName,
+		Usage: "the raw metadata associated with the asset",
+	},
+	cli.StringFlag{
+		Name: assetMetaFilePathName,
+		Usage: "a path to a file on disk that should be read " +
+			"and used as the asset meta",
+	},
+	cli.IntFlag{
+		Name: assetMetaTypeName,
+		Usage: "the type of the meta data for the asset, " +
+			"0 for opaque or 1 for JSON",
+	},
+	cli.BoolFlag{
+		Name: metaByReferenceName,
+		Usage: "if true, then only a reference to the meta " +
+			"data is committed to, while the data itself " +
+			"is stored and served by the daemon",
+	},
+	cli.StringFlag{
+		Name: metaBlobURLName,
+		Usage: "the URL others can fetch the meta data from " +
+			"if it is committed to by reference",
+	},
+	cli.Uint64Flag{
+		Name: decimalDisplayName,
+		Usage: "the number of decimal places wallets should " +
+			"use when displaying amounts of the asset, " +
+			"committed to in the JSON meta data of the " +
+			"asset",
+	},
+	cli.Uint64Flag{
+		Name: maxSupplyName,
+		Usage: "the maximum total amount of the asset group " +
+			"created by the asset, summed over all " +
+			"issuances, committed to in the JSON meta " +
+			"data of the asset; requires --" +
+			assetEmissionName,
+	},
+	cli.StringSliceFlag{
+		Name: emissionEpochName,
+		Usage: "an epoch of the emission schedule of the " +
+			"asset group created by the asset, in the " +
+			"form start_height:amount, committed to in " +
+			"the JSON meta data of the asset; can be set " +
+			"multiple times and requires --" +
+			assetEmissionName,
+	},
+	cli.StringFlag{
+		Name: vanityPrefixName,
+		Usage: "an optional hex prefix of up to 6 characters " +
+			"the asset ID must start with, obtained by " +
+			"grinding a nonce in the JSON meta data of " +
+			"the asset",
+	},
+	cli.BoolFlag{
+		Name: assetEmissionName,
+		Usage: "if true, then the asset supports on going " +
+			"emission",
+	},
+	cli.StringFlag{
+		Name: assetGroupKeyName,
+		Usage: "the specific group key to use to mint the " +
+			"asset",
+	},
+	cli.StringFlag{
+		Name: assetGroupAnchorName,
+		Usage: "the other asset in this batch that the new " +
+			"asset be grouped with",
+	},
+	cli.BoolFlag{
+		Name: externalGroupSignerName,
+		Usage: "if true, then the group witness of the asset " +
+			"is produced by an external signer that " +
+			"holds the group key",
+	},
+	cli.StringFlag{
+		Name: groupInternalKeyName,
+		Usage: "the raw key of a new group held by an " +
+			"external signer",
+	},
+	cli.StringFlag{
+		Name: scriptInternalKeyName,
+		Usage: "the raw key of the script key the asset is " +
+			"minted to, which may be held by another " +
+			"party; if not set, a new script key is " +
+			"derived",
+	},
+	cli.StringFlag{
+		Name: scriptKeyTweakName,
+		Usage: "the optional hex encoded tapscript root the " +
+			"script key is tweaked with; if not set, a " +
+			"BIP-86 script key is used; requires --" +
+			scriptInternalKeyName,
+	},
+	cli.StringSliceFlag{
+		Name: musig2SignerName,
+		Usage: "the public key of a signer of a new group " +
+			"whose raw key is the MuSig2 aggregate of " +
+			"all signer keys; can be set multiple times",
+	},
+	cli.Uint64Flag{
+		Name: multiSigThresholdName,
+		Usage: "the number of signatures of the multisig keys " +
+			"required to issue into the group; requires " +
+			"--" + multiSigKeyName,
+	},
+	cli.StringSliceFlag{
+		Name: multiSigKeyName,
+		Usage: "a key of the multisig script the group key " +
+			"commits to, whose witness is signed for " +
+			"externally; can be set multiple times",
+	},
+	cli.StringFlag{
+		Name: delegateKeyName,
+		Usage: "the delegate key held by the backing lnd node " +
+			"to issue the asset with into the group of " +
+			"--" + assetGroupKeyName + " instead of the group key",
+	},
+	cli.Uint64Flag{
+		Name: delegateKeyFamilyName,
+		Usage: "the key family of the delegate key; requires " +
+			"--" + delegateKeyName,
+	},
+	cli.Uint64Flag{
+		Name: delegateKeyIndexName,
+		Usage: "the key index of the delegate key; requires " +
+			"--" + delegateKeyName,
+	},
+	cli.StringFlag{
+		Name: tapscriptSiblingName,
+		Usage: "the hex encoded preimage of a tapscript " +
+			"sibling to commit to in the minting output " +
+			"of the batch",
+	},
+	cli.StringSliceFlag{
+		Name: tapscriptLeafName,
+		Usage: "the hex encoded script of a tapscript leaf " +
+			"of a full tree to commit to in the minting " +
+			"output of the batch; can be set multiple " +
+			"times",
+	},
+}
+
 var mintAssetCommand = cli.Command{
 	Name:        "mint",
 	ShortName:   "m",
 	Usage:       "mint a new asset",
 	Description: "Attempt to mint a new asset with the specified parameters",
-	Flags: []cli.Flag{
-		cli.StringFlag{
-			Name: assetTypeName,
-			Usage: "the type of asset, must either be: normal, " +
-				"or collectible",
-		},
-		cli.StringFlag{
-			Name:  assetTagName,
-			Usage: "the name/tag of the asset",
-		},
-		cli.Uint64Flag{
-			Name:  assetSupplyName,
-			Usage: "the target supply of the minted asset",
-		},
-		cli.Uint64Flag{
-			Name:  assetVersionName,
-			Usage: "the version of the asset to mint",
-		},
-		cli.StringFlag{
-			Name:  assetMetaBytesName,
-			Usage: "the raw metadata associated with the asset",
-		},
-		cli.StringFlag{
-			Name: assetMetaFilePathName,
-			Usage: "a path to a file on disk that should be read " +
-				"and used as the asset meta",
-		},
-		cli.IntFlag{
-			Name:  assetMetaTypeName,
-			Usage: "the type of the meta data for the asset",
-		},
-		cli.BoolFlag{
-			Name: assetEmissionName,
-			Usage: "if true, then the asset supports on going " +
-				"emission",
-		},
-		cli.StringFlag{
-			Name: assetGroupKeyName,
-			Usage: "the specific group key to use to mint the " +
-				"asset",
-		},
-		cli.StringFlag{
-			Name: assetGroupAnchorName,
-			Usage: "the other asset in this batch that the new " +
-				"asset be grouped with",
-		},
+	Flags: append([]cli.Flag{
 		cli.BoolFlag{
 			Name: shortResponseName,
 			Usage: "if true, then the current assets within the " +
@@ -110,12 +309,22 @@ var mintAssetCommand = cli.Command{
 				"in order to avoid printing a large amount " +
 				"of data in case of large batches",
 		},
-	},
+	}, mintAssetFlags...),
 	Action: mintAsset,
 	Subcommands: []cli.Command{
+		validateMintCommand,
 		listBatchesCommand,
+		setBatchLabelCommand,
+		listBatchCostsCommand,
 		finalizeBatchCommand,
+		previewBatchCommand,
 		cancelBatchCommand,
+		cancelSeedlingCommand,
+		batchScheduleCommand,
+		groupWitnessesCommand,
+		batchTemplatesCommand,
+		genesisPsbtRequestsCommand,
+		mintEventsCommand,
 	},
 }
 
@@ -141,6 +350,87 @@ func mintAsset(ctx *cli.Context) error {
 		return cli.ShowSubcommandHelp(ctx)
 	}
 
+	req, err := parseMintAssetRequest(ctx)
+	if err != nil {
+		return err
+	}
+	req.ShortResponse = ctx.Bool(shortResponseName)
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.MintAsset(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to mint asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var validateMintCommand = cli.Command{
+	Name:      "validate",
+	ShortName: "v",
+	Usage:     "validate a new asset without minting it",
+	Description: `
+	Run all validation of a new asset as if it was added to the pending
+	batch, without adding it or persisting anything, and print the ID the
+	asset would be minted with. The ID commits to the first input and the
+	minting output index of the minting transaction, so it is only final
+	if the genesis outpoint of the batch is given.
+	`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: genesisOutpointName,
+			Usage: "the optional outpoint, in the form " +
+				"txid:index, that is spent by the minting " +
+				"transaction",
+		},
+		cli.Uint64Flag{
+			Name: anchorOutputIndexName,
+			Usage: "the index of the minting output in the " +
+				"minting transaction",
+		},
+	}, mintAssetFlags...),
+	Action: validateMint,
+}
+
+func validateMint(ctx *cli.Context) error {
+	switch {
+	case ctx.String(assetTagName) == "":
+		fallthrough
+	case ctx.Int64(assetSupplyName) == 0:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	req, err := parseMintAssetRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ValidateMint(ctxc, &mintrpc.ValidateMintRequest{
+		Assets:            []*mintrpc.MintAssetRequest{req},
+		GenesisOutpoint:   ctx.String(genesisOutpointName),
+		AnchorOutputIndex: uint32(ctx.Uint64(anchorOutputIndexName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to validate mint: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// parseMintAssetRequest parses the mint request of a new asset from the mint
+// asset flags.
+func parseMintAssetRequest(ctx *cli.Context) (*mintrpc.MintAssetRequest,
+	error) {
+
 	var (
 		groupKey    []byte
 		err         error
@@ -150,8 +440,83 @@ func mintAsset(ctx *cli.Context) error {
 	if len(groupKeyStr) != 0 {
 		groupKey, err = hex.DecodeString(groupKeyStr)
 		if err != nil {
-			return fmt.Errorf("invalid group key")
+			return nil, fmt.Errorf("invalid group key")
+		}
+	}
+
+	var (
+		groupInternalKey    *taprpc.KeyDescriptor
+		externalGroupSigner = ctx.Bool(externalGroupSignerName)
+	)
+	switch {
+	case ctx.IsSet(groupInternalKeyName) && ctx.IsSet(musig2SignerName):
+		return nil, fmt.Errorf("group internal key and musig2 " +
+			"signers cannot be both set")
+
+	// The raw key of a group signed by multiple signers is the MuSig2
+	// aggregate of their keys, which is held by an external signer.
+	case ctx.IsSet(musig2SignerName):
+		signers := make(
+			[]*btcec.PublicKey, 0,
+			len(ctx.StringSlice(musig2SignerName)),
+		)
+		for _, signerHex := range ctx.StringSlice(musig2SignerName) {
+			signerBytes, err := hex.DecodeString(signerHex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid musig2 " +
+					"signer key")
+			}
+
+			signer, err := btcec.ParsePubKey(signerBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid musig2 "+
+					"signer key: %w", err)
+			}
+
+			signers = append(signers, signer)
+		}
+
+		rawKey, err := tapgarden.MuSig2GroupInternalKey(signers)
+		if err != nil {
+			return nil, err
+		}
+
+		groupInternalKey = &taprpc.KeyDescriptor{
+			RawKeyBytes: rawKey.SerializeCompressed(),
 		}
+		externalGroupSigner = true
+
+	case ctx.IsSet(groupInternalKeyName):
+		rawKey, err := hex.DecodeString(
+			ctx.String(groupInternalKeyName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group internal key")
+		}
+
+		groupInternalKey = &taprpc.KeyDescriptor{
+			RawKeyBytes: rawKey,
+		}
+	}
+
+	// A group key with a multisig script is always signed for by the
+	// external holders of the multisig keys.
+	groupMultiSig, err := parseGroupMultiSig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if groupMultiSig != nil {
+		externalGroupSigner = true
+	}
+
+	delegateKey, err := parseDelegateKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptKey, err := parseScriptKey(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Both the meta bytes and the meta path can be set.
@@ -159,7 +524,7 @@ func mintAsset(ctx *cli.Context) error {
 	switch {
 	case ctx.String(assetMetaBytesName) != "" &&
 		ctx.String(assetMetaFilePathName) != "":
-		return fmt.Errorf("meta bytes or meta file path cannot " +
+		return nil, fmt.Errorf("meta bytes or meta file path cannot " +
 			"be both set")
 
 	case ctx.String(assetMetaBytesName) != "":
@@ -174,7 +539,8 @@ func mintAsset(ctx *cli.Context) error {
 		)
 		metaFileBytes, err := os.ReadFile(metaPath)
 		if err != nil {
-			return fmt.Errorf("unable to read meta file: %w", err)
+			return nil, fmt.Errorf("unable to read meta file: "+
+				"%w", err)
 		}
 
 		assetMeta = &taprpc.AssetMeta{
@@ -185,12 +551,13 @@ func mintAsset(ctx *cli.Context) error {
 
 	assetType, err := parseAssetType(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var (
-		amount        = ctx.Uint64(assetSupplyName)
-		isCollectible = assetType == taprpc.AssetType_COLLECTIBLE
+		amount         = ctx.Uint64(assetSupplyName)
+		decimalDisplay = ctx.Uint64(decimalDisplayName)
+		isCollectible  = assetType == taprpc.AssetType_COLLECTIBLE
 	)
 	switch {
 	// If the user did not specify the supply, we can silently assume they
@@ -202,19 +569,58 @@ func mintAsset(ctx *cli.Context) error {
 	// inform them instead of silently changing the value to 1, otherwise
 	// there will be surprises later.
 	case isCollectible && amount != 1:
-		return fmt.Errorf("supply must be 1 for collectibles")
+		return nil, fmt.Errorf("supply must be 1 for collectibles")
 
 	// Check that the amount is greater than 0 for normal assets. This is
 	// also checked in the RPC server, but we can avoid the round trip.
 	case !isCollectible && amount == 0:
-		return fmt.Errorf("supply must be set for normal assets")
+		return nil, fmt.Errorf("supply must be set for normal assets")
+
+	case decimalDisplay > asset.MaxDecimalDisplay:
+		return nil, asset.ErrInvalidDecimalDisplay
 	}
 
-	ctxc := getContext()
-	client, cleanUp := getMintClient(ctx)
-	defer cleanUp()
+	var (
+		tapscriptSibling []byte
+		fullTree         *taprpc.TapscriptFullTree
+	)
+	switch {
+	case ctx.IsSet(tapscriptSiblingName) && ctx.IsSet(tapscriptLeafName):
+		return nil, fmt.Errorf("tapscript sibling and tapscript " +
+			"leaves cannot be both set")
 
-	resp, err := client.MintAsset(ctxc, &mintrpc.MintAssetRequest{
+	case ctx.IsSet(tapscriptSiblingName):
+		tapscriptSibling, err = hex.DecodeString(
+			ctx.String(tapscriptSiblingName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tapscript sibling")
+		}
+
+	case ctx.IsSet(tapscriptLeafName):
+		fullTree = &taprpc.TapscriptFullTree{}
+		for _, leafHex := range ctx.StringSlice(tapscriptLeafName) {
+			script, err := hex.DecodeString(leafHex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid tapscript leaf")
+			}
+
+			fullTree.AllLeaves = append(
+				fullTree.AllLeaves, &taprpc.TapLeaf{
+					Script: script,
+				},
+			)
+		}
+	}
+
+	emissionSchedule, err := parseEmissionSchedule(
+		ctx.StringSlice(emissionEpochName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.MintAssetRequest{
 		Asset: &mintrpc.MintAsset{
 			AssetType:   assetType,
 			Name:        ctx.String(assetTagName),
@@ -225,16 +631,160 @@ func mintAsset(ctx *cli.Context) error {
 			AssetVersion: taprpc.AssetVersion(
 				ctx.Uint64(assetVersionName),
 			),
+			ExternalGroupSigner: externalGroupSigner,
+			GroupInternalKey:    groupInternalKey,
+			MetaByReference:     ctx.Bool(metaByReferenceName),
+			MetaBlobUrl:         ctx.String(metaBlobURLName),
+			DecimalDisplay:      uint32(decimalDisplay),
+			MaxSupply:           ctx.Uint64(maxSupplyName),
+			VanityPrefix:        ctx.String(vanityPrefixName),
+			EmissionSchedule:    emissionSchedule,
+			ScriptKey:           scriptKey,
+			GroupMultisig:       groupMultiSig,
+			DelegateKey:         delegateKey,
 		},
-		EnableEmission: ctx.Bool(assetEmissionName),
-		ShortResponse:  ctx.Bool(shortResponseName),
-	})
+		EnableEmission:   ctx.Bool(assetEmissionName),
+		TapscriptSibling: tapscriptSibling,
+		FullTree:         fullTree,
+	}, nil
+}
+
+// parseGroupMultiSig parses the optional multisig script of the group key of
+// an asset from its threshold and keys.
+func parseGroupMultiSig(ctx *cli.Context) (*mintrpc.GroupMultiSig, error) {
+	switch {
+	case ctx.IsSet(multiSigThresholdName) && !ctx.IsSet(multiSigKeyName):
+		return nil, fmt.Errorf("multisig threshold requires multisig " +
+			"keys")
+
+	case !ctx.IsSet(multiSigKeyName):
+		return nil, nil
+
+	case !ctx.IsSet(multiSigThresholdName):
+		return nil, fmt.Errorf("multisig keys require a multisig " +
+			"threshold")
+	}
+
+	multiSig := &mintrpc.GroupMultiSig{
+		Threshold: uint32(ctx.Uint64(multiSigThresholdName)),
+	}
+	for _, keyHex := range ctx.StringSlice(multiSigKeyName) {
+		key, err := hex.DecodeString(keyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multisig key")
+		}
+
+		multiSig.Keys = append(multiSig.Keys, key)
+	}
+
+	return multiSig, nil
+}
+
+// parseDelegateKey parses the optional delegate key an asset is issued with
+// from its raw key and key locator.
+func parseDelegateKey(ctx *cli.Context) (*taprpc.KeyDescriptor, error) {
+	switch {
+	case (ctx.IsSet(delegateKeyFamilyName) ||
+		ctx.IsSet(delegateKeyIndexName)) && !ctx.IsSet(delegateKeyName):
+
+		return nil, fmt.Errorf("delegate key locator requires a " +
+			"delegate key")
+
+	case !ctx.IsSet(delegateKeyName):
+		return nil, nil
+	}
+
+	rawKey, err := hex.DecodeString(ctx.String(delegateKeyName))
 	if err != nil {
-		return fmt.Errorf("unable to mint asset: %w", err)
+		return nil, fmt.Errorf("invalid delegate key")
 	}
 
-	printRespJSON(resp)
-	return nil
+	return &taprpc.KeyDescriptor{
+		RawKeyBytes: rawKey,
+		KeyLoc: &taprpc.KeyLocator{
+			KeyFamily: int32(ctx.Uint64(delegateKeyFamilyName)),
+			KeyIndex:  int32(ctx.Uint64(delegateKeyIndexName)),
+		},
+	}, nil
+}
+
+// parseScriptKey parses the optional script key of an asset from its raw key
+// and tapscript tweak.
+func parseScriptKey(ctx *cli.Context) (*taprpc.ScriptKey, error) {
+	switch {
+	case ctx.IsSet(scriptKeyTweakName) && !ctx.IsSet(scriptInternalKeyName):
+		return nil, fmt.Errorf("script key tweak requires a script " +
+			"internal key")
+
+	case !ctx.IsSet(scriptInternalKeyName):
+		return nil, nil
+	}
+
+	rawKeyBytes, err := hex.DecodeString(ctx.String(scriptInternalKeyName))
+	if err != nil {
+		return nil, fmt.Errorf("invalid script internal key")
+	}
+	rawKey, err := btcec.ParsePubKey(rawKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script internal key: %w", err)
+	}
+
+	var tweak []byte
+	if ctx.IsSet(scriptKeyTweakName) {
+		tweak, err = hex.DecodeString(ctx.String(scriptKeyTweakName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key tweak")
+		}
+	}
+
+	// The daemon verifies that the script key matches its raw key and
+	// tweak, so we only compute it here for convenience.
+	scriptKey := txscript.ComputeTaprootKeyNoScript(rawKey)
+	if len(tweak) != 0 {
+		scriptKey = txscript.ComputeTaprootOutputKey(rawKey, tweak)
+	}
+
+	return &taprpc.ScriptKey{
+		PubKey: schnorr.SerializePubKey(scriptKey),
+		KeyDesc: &taprpc.KeyDescriptor{
+			RawKeyBytes: rawKey.SerializeCompressed(),
+		},
+		TapTweak: tweak,
+	}, nil
+}
+
+// parseEmissionSchedule parses the epochs of an emission schedule, each in the
+// form start_height:amount.
+func parseEmissionSchedule(epochs []string) ([]*taprpc.EmissionEpoch,
+	error) {
+
+	schedule := make([]*taprpc.EmissionEpoch, 0, len(epochs))
+	for _, epoch := range epochs {
+		parts := strings.Split(epoch, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("emission epoch %q must be of "+
+				"the form start_height:amount", epoch)
+		}
+
+		startHeight, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emission epoch start "+
+				"height %q: %w", parts[0], err)
+		}
+
+		amount, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emission epoch amount "+
+				"%q: %w", parts[1], err)
+		}
+
+		schedule = append(schedule, &taprpc.EmissionEpoch{
+			StartHeight: uint32(startHeight),
+			Amount:      amount,
+		})
+	}
+
+	return schedule, nil
 }
 
 var finalizeBatchCommand = cli.Command{
@@ -250,6 +800,15 @@ var finalizeBatchCommand = cli.Command{
 				"in order to avoid printing a large amount " +
 				"of data in case of large batches",
 		},
+		cli.BoolFlag{
+			Name: externalFundingName,
+			Usage: "if true, the genesis transaction isn't " +
+				"funded by the lnd wallet; instead the " +
+				"unfunded genesis PSBT is returned, which " +
+				"must be funded and signed by an external " +
+				"wallet and submitted back with 'assets mint " +
+				"funding submit'",
+		},
 	},
 	Action: finalizeBatch,
 }
@@ -260,7 +819,8 @@ func finalizeBatch(ctx *cli.Context) error {
 	defer cleanUp()
 
 	resp, err := client.FinalizeBatch(ctxc, &mintrpc.FinalizeBatchRequest{
-		ShortResponse: ctx.Bool(shortResponseName),
+		ShortResponse:   ctx.Bool(shortResponseName),
+		ExternalFunding: ctx.Bool(externalFundingName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to finalize batch: %w", err)
@@ -270,6 +830,45 @@ func finalizeBatch(ctx *cli.Context) error {
 	return nil
 }
 
+var previewBatchCommand = cli.Command{
+	Name:      "preview",
+	ShortName: "p",
+	Usage:     "preview the cost of a batch",
+	Description: "Estimate the size and fee of the minting transaction " +
+		"of the pending batch, without finalizing it.",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: satPerVByteName,
+			Usage: "the fee rate in sat/vbyte to base the " +
+				"estimate on; if not set, the current fee " +
+				"estimate is used",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response",
+		},
+	},
+	Action: previewBatch,
+}
+
+func previewBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.PreviewBatch(ctxc, &mintrpc.PreviewBatchRequest{
+		SatPerVbyte:   ctx.Uint64(satPerVByteName),
+		ShortResponse: ctx.Bool(shortResponseName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to preview batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var cancelBatchCommand = cli.Command{
 	Name:        "cancel",
 	ShortName:   "c",
@@ -292,219 +891,2007 @@ func cancelBatch(ctx *cli.Context) error {
 	return nil
 }
 
-var listBatchesCommand = cli.Command{
-	Name:        "batches",
-	ShortName:   "b",
-	Usage:       "list all batches",
-	Description: "List all batches",
+var cancelSeedlingCommand = cli.Command{
+	Name:      "remove",
+	ShortName: "r",
+	Usage:     "remove an asset from the pending batch",
+	Description: "Attempt to remove a single asset from the pending " +
+		"batch without cancelling the rest of the batch.",
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  batchKeyName,
-			Usage: "if set, the batch key for a specific batch",
+			Name:  assetTagName,
+			Usage: "the name/tag of the asset to remove",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the remaining assets within " +
+				"the batch will not be returned in the " +
+				"response",
 		},
 	},
-	Action: listBatches,
+	Action: cancelSeedling,
 }
 
-func listBatches(ctx *cli.Context) error {
-	ctxc := getContext()
-	client, cleanUp := getMintClient(ctx)
+func cancelSeedling(ctx *cli.Context) error {
+	if ctx.String(assetTagName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CancelSeedling(ctxc, &mintrpc.CancelSeedlingRequest{
+		AssetName:     ctx.String(assetTagName),
+		ShortResponse: ctx.Bool(shortResponseName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel seedling: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var batchScheduleCommand = cli.Command{
+	Name:      "schedule",
+	ShortName: "s",
+	Usage:     "show the batch schedule",
+	Description: "Show the schedule that governs when the pending batch " +
+		"is finalized automatically.",
+	Action: getBatchSchedule,
+	Subcommands: []cli.Command{
+		updateBatchScheduleCommand,
+	},
+}
+
+func getBatchSchedule(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.GetBatchSchedule(
+		ctxc, &mintrpc.GetBatchScheduleRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to get batch schedule: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateBatchScheduleCommand = cli.Command{
+	Name:      "update",
+	ShortName: "u",
+	Usage:     "update the batch schedule",
+	Description: `
+	Replace the schedule that governs when the pending batch is finalized
+	automatically. The batch is finalized as soon as any of the given
+	conditions is met. Conditions that are not set are disabled. If the
+	pending batch already satisfies the new schedule, it is finalized
+	immediately.
+	`,
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name: scheduleIntervalName,
+			Usage: "the maximum duration (1m, 2h, etc) a batch " +
+				"is kept pending before it is finalized",
+		},
+		cli.Uint64Flag{
+			Name: scheduleMaxSeedlingsName,
+			Usage: "the number of seedlings in a pending batch " +
+				"that causes it to be finalized",
+		},
+		cli.Uint64Flag{
+			Name: scheduleMaxMetaSizeName,
+			Usage: "the total size in bytes of the asset meta " +
+				"data in a pending batch that causes it to " +
+				"be finalized",
+		},
+	},
+	Action: updateBatchSchedule,
+}
+
+func updateBatchSchedule(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UpdateBatchSchedule(
+		ctxc, &mintrpc.UpdateBatchScheduleRequest{
+			Schedule: &mintrpc.BatchSchedule{
+				IntervalSeconds: uint64(
+					ctx.Duration(scheduleIntervalName).
+						Seconds(),
+				),
+				MaxSeedlings: uint32(
+					ctx.Uint64(scheduleMaxSeedlingsName),
+				),
+				MaxMetaSize: ctx.Uint64(
+					scheduleMaxMetaSizeName,
+				),
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update batch schedule: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var groupWitnessesCommand = cli.Command{
+	Name:      "witnesses",
+	ShortName: "w",
+	Usage:     "list pending group witness requests",
+	Description: `
+	List the signing requests for the group witnesses of assets whose
+	group key is held by an external signer. A finalized batch is only
+	broadcast once a signature was submitted for each of its requests.
+	`,
+	Action: listGroupWitnessRequests,
+	Subcommands: []cli.Command{
+		submitGroupWitnessCommand,
+		submitGroupScriptSigCommand,
+		musig2GroupSessionsCommand,
+	},
+}
+
+func listGroupWitnessRequests(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListGroupWitnessRequests(
+		ctxc, &mintrpc.ListGroupWitnessRequestsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list group witness requests: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var submitGroupWitnessCommand = cli.Command{
+	Name:      "submit",
+	ShortName: "s",
+	Usage:     "submit the signature for a group witness request",
+	Description: `
+	Submit the schnorr signature of an external signer over the sig hash
+	of a pending group witness request.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sigHashName,
+			Usage: "the sig hash of the group witness request",
+		},
+		cli.StringFlag{
+			Name:  signatureName,
+			Usage: "the hex encoded 64-byte schnorr signature",
+		},
+	},
+	Action: submitGroupWitness,
+}
+
+func submitGroupWitness(ctx *cli.Context) error {
+	if !ctx.IsSet(sigHashName) || !ctx.IsSet(signatureName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	sigHash, err := hex.DecodeString(ctx.String(sigHashName))
+	if err != nil {
+		return fmt.Errorf("invalid sig hash: %w", err)
+	}
+
+	sig, err := hex.DecodeString(ctx.String(signatureName))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SubmitGroupWitness(
+		ctxc, &mintrpc.SubmitGroupWitnessRequest{
+			SigHash:   sigHash,
+			Signature: sig,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to submit group witness: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var submitGroupScriptSigCommand = cli.Command{
+	Name:      "scriptsig",
+	ShortName: "ss",
+	Usage:     "submit a multisig signature for a group witness request",
+	Description: `
+	Submit the schnorr signature of one of the multisig keys over the sig
+	hash of a pending group witness request whose group key commits to a
+	multisig script. Once enough signatures were submitted, they are
+	assembled into the group witness of the asset.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sigHashName,
+			Usage: "the sig hash of the group witness request",
+		},
+		cli.StringFlag{
+			Name:  signerKeyName,
+			Usage: "the multisig key the signature belongs to",
+		},
+		cli.StringFlag{
+			Name:  signatureName,
+			Usage: "the hex encoded 64-byte schnorr signature",
+		},
+	},
+	Action: submitGroupScriptSig,
+}
+
+func submitGroupScriptSig(ctx *cli.Context) error {
+	if !ctx.IsSet(sigHashName) || !ctx.IsSet(signerKeyName) ||
+		!ctx.IsSet(signatureName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	sigHash, err := hex.DecodeString(ctx.String(sigHashName))
+	if err != nil {
+		return fmt.Errorf("invalid sig hash: %w", err)
+	}
+
+	signerKey, err := hex.DecodeString(ctx.String(signerKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid signer key: %w", err)
+	}
+
+	sig, err := hex.DecodeString(ctx.String(signatureName))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SubmitGroupScriptSig(
+		ctxc, &mintrpc.SubmitGroupScriptSigRequest{
+			SigHash:   sigHash,
+			SignerKey: signerKey,
+			Signature: sig,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to submit group script sig: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var genesisPsbtRequestsCommand = cli.Command{
+	Name:      "funding",
+	ShortName: "fu",
+	Usage:     "list pending genesis PSBT requests",
+	Description: `
+	List the genesis PSBTs of finalized batches that are funded by an
+	external wallet. A PSBT in the fund stage must be funded with inputs
+	and an optional change output without signing it. A PSBT in the sign
+	stage contains the final minting output and must be signed without
+	any other changes.
+	`,
+	Action: listGenesisPsbtRequests,
+	Subcommands: []cli.Command{
+		submitGenesisPsbtCommand,
+	},
+}
+
+func listGenesisPsbtRequests(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListGenesisPsbtRequests(
+		ctxc, &mintrpc.ListGenesisPsbtRequestsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list genesis psbt requests: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var submitGenesisPsbtCommand = cli.Command{
+	Name:      "submit",
+	ShortName: "s",
+	Usage:     "submit the funded or signed genesis PSBT of a batch",
+	Description: `
+	Submit the genesis PSBT of an externally funded batch, funded but
+	unsigned in the fund stage, or signed in the sign stage.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the key of the batch the genesis PSBT " +
+				"belongs to",
+		},
+		cli.StringFlag{
+			Name:  genesisPsbtName,
+			Usage: "the hex encoded funded or signed genesis PSBT",
+		},
+	},
+	Action: submitGenesisPsbt,
+}
+
+func submitGenesisPsbt(ctx *cli.Context) error {
+	if !ctx.IsSet(batchKeyName) || !ctx.IsSet(genesisPsbtName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	genesisPsbt, err := hex.DecodeString(ctx.String(genesisPsbtName))
+	if err != nil {
+		return fmt.Errorf("invalid genesis psbt: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SubmitGenesisPsbt(
+		ctxc, &mintrpc.SubmitGenesisPsbtRequest{
+			BatchKey: batchKey,
+			Psbt:     genesisPsbt,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to submit genesis psbt: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var mintEventsCommand = cli.Command{
+	Name:      "events",
+	ShortName: "e",
+	Usage:     "subscribe to the state transitions of minting batches",
+	Description: `
+	Subscribe to the state transitions of all minting batches and print
+	them as they happen. Past transitions can be replayed first, either
+	starting with the first transition of a given batch, or starting at a
+	given block height.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "if set, replay all past events starting with " +
+				"the first event of this batch",
+		},
+		cli.Uint64Flag{
+			Name: replayFromHeightName,
+			Usage: "if set, replay all past events at or after " +
+				"this block height",
+		},
+	},
+	Action: subscribeMintEvents,
+}
+
+func subscribeMintEvents(ctx *cli.Context) error {
+	req := &mintrpc.SubscribeMintEventsRequest{
+		ReplayFromHeight: uint32(ctx.Uint64(replayFromHeightName)),
+	}
+	if ctx.IsSet(batchKeyName) {
+		batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid batch key: %w", err)
+		}
+
+		req.ReplayFromBatchKey = batchKey
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeMintEvents(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to mint events: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return fmt.Errorf("unable to receive mint event: %w",
+				err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var musig2GroupSessionsCommand = cli.Command{
+	Name:      "musig2",
+	ShortName: "m",
+	Usage:     "list MuSig2 group witness sessions",
+	Description: `
+	List the MuSig2 signing sessions that are in progress for group
+	witness requests of assets whose group key is the aggregate of
+	multiple signer keys.
+	`,
+	Action: listMuSig2GroupSessions,
+	Subcommands: []cli.Command{
+		startMuSig2GroupSessionCommand,
+		registerMuSig2GroupNonceCommand,
+		submitMuSig2GroupPartialSigCommand,
+	},
+}
+
+func listMuSig2GroupSessions(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListMuSig2GroupSessions(
+		ctxc, &mintrpc.ListMuSig2GroupSessionsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list musig2 sessions: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var startMuSig2GroupSessionCommand = cli.Command{
+	Name:      "start",
+	ShortName: "s",
+	Usage:     "start a MuSig2 group witness session",
+	Description: `
+	Start a MuSig2 signing session for a pending group witness request.
+	The signer keys must aggregate to the raw group key of the request.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sigHashName,
+			Usage: "the sig hash of the group witness request",
+		},
+		cli.StringSliceFlag{
+			Name: signerKeyName,
+			Usage: "the public key of a signer; must be set " +
+				"once for each signer",
+		},
+	},
+	Action: startMuSig2GroupSession,
+}
+
+func startMuSig2GroupSession(ctx *cli.Context) error {
+	if !ctx.IsSet(sigHashName) || !ctx.IsSet(signerKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	sigHash, err := hex.DecodeString(ctx.String(sigHashName))
+	if err != nil {
+		return fmt.Errorf("invalid sig hash: %w", err)
+	}
+
+	signerKeys := make([][]byte, 0, len(ctx.StringSlice(signerKeyName)))
+	for _, signerHex := range ctx.StringSlice(signerKeyName) {
+		signerKey, err := hex.DecodeString(signerHex)
+		if err != nil {
+			return fmt.Errorf("invalid signer key: %w", err)
+		}
+
+		signerKeys = append(signerKeys, signerKey)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.StartMuSig2GroupSession(
+		ctxc, &mintrpc.StartMuSig2GroupSessionRequest{
+			SigHash:    sigHash,
+			SignerKeys: signerKeys,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to start musig2 session: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var registerMuSig2GroupNonceCommand = cli.Command{
+	Name:      "nonce",
+	ShortName: "n",
+	Usage:     "register the public nonce of a signer",
+	Description: `
+	Register the public nonce of a signer of a MuSig2 group witness
+	session. Once all nonces are registered, the combined nonce of the
+	session is shown.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sigHashName,
+			Usage: "the sig hash of the group witness request",
+		},
+		cli.StringFlag{
+			Name:  signerKeyName,
+			Usage: "the public key of the signer",
+		},
+		cli.StringFlag{
+			Name:  pubNonceName,
+			Usage: "the hex encoded 66-byte public nonce",
+		},
+	},
+	Action: registerMuSig2GroupNonce,
+}
+
+func registerMuSig2GroupNonce(ctx *cli.Context) error {
+	if !ctx.IsSet(sigHashName) || !ctx.IsSet(signerKeyName) ||
+		!ctx.IsSet(pubNonceName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	sigHash, err := hex.DecodeString(ctx.String(sigHashName))
+	if err != nil {
+		return fmt.Errorf("invalid sig hash: %w", err)
+	}
+
+	signerKey, err := hex.DecodeString(ctx.String(signerKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid signer key: %w", err)
+	}
+
+	pubNonce, err := hex.DecodeString(ctx.String(pubNonceName))
+	if err != nil {
+		return fmt.Errorf("invalid pub nonce: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RegisterMuSig2GroupNonce(
+		ctxc, &mintrpc.RegisterMuSig2GroupNonceRequest{
+			SigHash:   sigHash,
+			SignerKey: signerKey,
+			PubNonce:  pubNonce,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to register nonce: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var submitMuSig2GroupPartialSigCommand = cli.Command{
+	Name:      "partialsig",
+	ShortName: "p",
+	Usage:     "submit the partial signature of a signer",
+	Description: `
+	Submit the partial signature of a signer of a MuSig2 group witness
+	session. Once all partial signatures are received, they are combined
+	into the group witness of the asset.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sigHashName,
+			Usage: "the sig hash of the group witness request",
+		},
+		cli.StringFlag{
+			Name:  signerKeyName,
+			Usage: "the public key of the signer",
+		},
+		cli.StringFlag{
+			Name:  partialSigName,
+			Usage: "the hex encoded 32-byte partial signature",
+		},
+	},
+	Action: submitMuSig2GroupPartialSig,
+}
+
+func submitMuSig2GroupPartialSig(ctx *cli.Context) error {
+	if !ctx.IsSet(sigHashName) || !ctx.IsSet(signerKeyName) ||
+		!ctx.IsSet(partialSigName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	sigHash, err := hex.DecodeString(ctx.String(sigHashName))
+	if err != nil {
+		return fmt.Errorf("invalid sig hash: %w", err)
+	}
+
+	signerKey, err := hex.DecodeString(ctx.String(signerKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid signer key: %w", err)
+	}
+
+	partialSig, err := hex.DecodeString(ctx.String(partialSigName))
+	if err != nil {
+		return fmt.Errorf("invalid partial sig: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SubmitMuSig2GroupPartialSig(
+		ctxc, &mintrpc.SubmitMuSig2GroupPartialSigRequest{
+			SigHash:    sigHash,
+			SignerKey:  signerKey,
+			PartialSig: partialSig,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to submit partial sig: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var batchTemplatesCommand = cli.Command{
+	Name:      "templates",
+	ShortName: "t",
+	Usage:     "list saved batch templates",
+	Description: `
+	List the saved batch templates. A batch template is a named set of
+	assets that can be added to the pending batch with a single command,
+	which makes recurring issuance of the same set of assets easy.
+	`,
+	Action: listBatchTemplates,
+	Subcommands: []cli.Command{
+		saveBatchTemplateCommand,
+		deleteBatchTemplateCommand,
+		mintBatchTemplateCommand,
+	},
+}
+
+func listBatchTemplates(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListBatchTemplates(
+		ctxc, &mintrpc.ListBatchTemplatesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list batch templates: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var saveBatchTemplateCommand = cli.Command{
+	Name:      "save",
+	ShortName: "s",
+	Usage:     "save a batch template",
+	Description: `
+	Save a batch template that is read from a JSON file. The file
+	contains the template in the same format it is returned by the list
+	command, for example:
+
+	{
+	    "name": "monthly",
+	    "assets": [{
+	        "asset": {
+	            "asset_type": "NORMAL",
+	            "name": "bond",
+	            "amount": "1000",
+	            "group_key": "<hex encoded group key>"
+	        }
+	    }]
+	}
+
+	An existing template with the same name is replaced.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  templateFileName,
+			Usage: "the path to the JSON file of the template",
+		},
+	},
+	Action: saveBatchTemplate,
+}
+
+func saveBatchTemplate(ctx *cli.Context) error {
+	if !ctx.IsSet(templateFileName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	templatePath := tapcfg.CleanAndExpandPath(ctx.String(templateFileName))
+	templateBytes, err := os.ReadFile(templatePath)
+	if err != nil {
+		return fmt.Errorf("unable to read template file: %w", err)
+	}
+
+	var template mintrpc.BatchTemplate
+	err = taprpc.ProtoJSONUnmarshalOpts.Unmarshal(templateBytes, &template)
+	if err != nil {
+		return fmt.Errorf("unable to parse template file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SaveBatchTemplate(
+		ctxc, &mintrpc.SaveBatchTemplateRequest{
+			Template: &template,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to save batch template: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deleteBatchTemplateCommand = cli.Command{
+	Name:      "delete",
+	ShortName: "d",
+	Usage:     "delete a batch template",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  templateNameName,
+			Usage: "the name of the template to delete",
+		},
+	},
+	Action: deleteBatchTemplate,
+}
+
+func deleteBatchTemplate(ctx *cli.Context) error {
+	if ctx.String(templateNameName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.DeleteBatchTemplate(
+		ctxc, &mintrpc.DeleteBatchTemplateRequest{
+			Name: ctx.String(templateNameName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to delete batch template: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var mintBatchTemplateCommand = cli.Command{
+	Name:      "mint",
+	ShortName: "m",
+	Usage:     "add the assets of a batch template to the pending batch",
+	Description: `
+	Add all assets of a saved batch template to the pending batch. Either
+	all assets of the template are added, or none of them.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  templateNameName,
+			Usage: "the name of the template to mint",
+		},
+		cli.StringFlag{
+			Name: assetNameSuffixName,
+			Usage: "an optional suffix to append to the names of " +
+				"all assets of the template",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: mintBatchTemplate,
+}
+
+func mintBatchTemplate(ctx *cli.Context) error {
+	if ctx.String(templateNameName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.MintBatchTemplate(
+		ctxc, &mintrpc.MintBatchTemplateRequest{
+			Name:            ctx.String(templateNameName),
+			AssetNameSuffix: ctx.String(assetNameSuffixName),
+			ShortResponse:   ctx.Bool(shortResponseName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to mint batch template: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listBatchesCommand = cli.Command{
+	Name:        "batches",
+	ShortName:   "b",
+	Usage:       "list all batches",
+	Description: "List all batches",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "if set, the batch key for a specific batch",
+		},
+	},
+	Action: listBatches,
+}
+
+func listBatches(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	var (
+		batchKeyStr = ctx.String(batchKeyName)
+		batchKey    []byte
+		err         error
+	)
+	if len(batchKeyStr) != 0 {
+		batchKey, err = hex.DecodeString(batchKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid batch key")
+		}
+	}
+
+	resp, err := client.ListBatches(ctxc, &mintrpc.ListBatchRequest{
+		Filter: &mintrpc.ListBatchRequest_BatchKey{
+			BatchKey: batchKey,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list batches: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setBatchLabelCommand = cli.Command{
+	Name:      "label",
+	Usage:     "set the label of a batch",
+	ArgsUsage: "--batch_key=<key> --label=<label>",
+	Description: "Set the user-defined label of a batch, replacing any " +
+		"previous label. An empty label removes the current label.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to label",
+		},
+		cli.StringFlag{
+			Name:  batchLabelName,
+			Usage: "the new label of the batch",
+		},
+	},
+	Action: setBatchLabel,
+}
+
+func setBatchLabel(ctx *cli.Context) error {
+	if !ctx.IsSet(batchKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetBatchLabel(ctxc, &mintrpc.SetBatchLabelRequest{
+		Batch: &mintrpc.SetBatchLabelRequest_BatchKey{
+			BatchKey: batchKey,
+		},
+		Label: ctx.String(batchLabelName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set batch label: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listBatchCostsCommand = cli.Command{
+	Name:  "costs",
+	Usage: "list the on-chain cost of all signed batches",
+	Description: "List the chain fees, the virtual size and the fees " +
+		"attributed to each asset of the minting transaction of " +
+		"every batch that was signed.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "if set, the batch key for a specific batch",
+		},
+	},
+	Action: listBatchCosts,
+}
+
+func listBatchCosts(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	var (
+		batchKeyStr = ctx.String(batchKeyName)
+		batchKey    []byte
+		err         error
+	)
+	if len(batchKeyStr) != 0 {
+		batchKey, err = hex.DecodeString(batchKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid batch key")
+		}
+	}
+
+	resp, err := client.ListBatchCosts(
+		ctxc, &mintrpc.ListBatchCostsRequest{
+			Filter: &mintrpc.ListBatchCostsRequest_BatchKey{
+				BatchKey: batchKey,
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list batch costs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAssetsCommand = cli.Command{
+	Name:        "list",
+	ShortName:   "l",
+	Usage:       "list all assets",
+	Description: "list all pending and mined assets",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  assetShowWitnessName,
+			Usage: "include the asset's witness data",
+		},
+		cli.BoolFlag{
+			Name:  assetShowSpentName,
+			Usage: "include fully spent assets in the list",
+		},
+	},
+	Action: listAssets,
+}
+
+func listAssets(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// TODO(roasbeef): need to reverse txid
+
+	resp, err := client.ListAssets(ctxc, &taprpc.ListAssetRequest{
+		WithWitness:  ctx.Bool(assetShowWitnessName),
+		IncludeSpent: ctx.Bool(assetShowSpentName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list assets: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+var listUtxosCommand = cli.Command{
+	Name:        "utxos",
+	ShortName:   "u",
+	Usage:       "list all utxos",
+	Description: "list all utxos managing assets",
+	Action:      listUtxos,
+}
+
+func listUtxos(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListUtxos(ctxc, &taprpc.ListUtxosRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list utxos: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+var listGroupsCommand = cli.Command{
+	Name:        "groups",
+	ShortName:   "g",
+	Usage:       "list all asset groups",
+	Description: "list all asset groups known to the daemon",
+	Action:      listGroups,
+}
+
+func listGroups(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListGroups(ctxc, &taprpc.ListGroupsRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list asset groups: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+var listAssetBalancesCommand = cli.Command{
+	Name:        "balance",
+	ShortName:   "b",
+	Usage:       "list asset balances",
+	Description: "list balances for all assets or a selected asset",
+	Action:      listAssetBalances,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  groupByGroupName,
+			Usage: "Group asset balances by group key",
+		},
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "A specific asset ID to run the balance query " +
+				"against",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "A specific asset group key to run the " +
+				"balance query against. Must be used " +
+				"together with --by_group",
+		},
+	},
+}
+
+func listAssetBalances(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var err error
+
+	req := &taprpc.ListBalancesRequest{}
+
+	if !ctx.Bool(groupByGroupName) {
+		req.GroupBy = &taprpc.ListBalancesRequest_AssetId{
+			AssetId: true,
+		}
+
+		assetIDHexStr := ctx.String(assetIDName)
+		if len(assetIDHexStr) != 0 {
+			req.AssetFilter, err = hex.DecodeString(assetIDHexStr)
+			if err != nil {
+				return fmt.Errorf("invalid asset ID")
+			}
+
+			if len(req.AssetFilter) != 32 {
+				return fmt.Errorf("invalid asset ID length")
+			}
+		}
+	} else {
+		req.GroupBy = &taprpc.ListBalancesRequest_GroupKey{
+			GroupKey: true,
+		}
+
+		assetGroupKeyHexStr := ctx.String(groupKeyName)
+		req.GroupKeyFilter, err = hex.DecodeString(assetGroupKeyHexStr)
+		if err != nil {
+			return fmt.Errorf("invalid group key")
+		}
+	}
+
+	resp, err := client.ListBalances(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list asset balances: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var sendAssetsCommand = cli.Command{
+	Name:      "send",
+	ShortName: "s",
+	Usage:     "send an asset",
+	Description: `
+	Send an asset to one or more taproot asset addrs. All addrs are paid
+	in a single anchor transaction, so batching multiple recipients only
+	pays for a single on-chain transaction. The addrs may be for different
+	asset IDs, in which case all assets are transferred in the same anchor
+	transaction. Explicit inputs can only be given if all addrs are for
+	the same asset ID.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "addr to send to; can be specified multiple " +
+				"times to send to multiple addresses at once",
+		},
+		cli.StringSliceFlag{
+			Name: inputAnchorPointName,
+			Usage: "the anchor outpoint, in the form txid:index, " +
+				"of an asset UTXO to spend instead of " +
+				"selecting the inputs automatically; can be " +
+				"specified multiple times",
+		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset and " +
+				"BTC inputs, must either be: largest-first, " +
+				"smallest-first, fewest-inputs or no-merge; " +
+				"if not set, the daemon's default is used",
+		},
+		cli.StringFlag{
+			Name: labelName,
+			Usage: "an optional label or memo to store along " +
+				"with the transfer",
+		},
+		cli.Uint64Flag{
+			Name: maxChainFeeName,
+			Usage: "the maximum amount of satoshis the anchor " +
+				"transaction may pay in chain fees; the " +
+				"send fails instead of being broadcast if " +
+				"the fees are higher",
+		},
+		cli.Uint64Flag{
+			Name: anchorLockTimeName,
+			Usage: "the absolute lock time of the anchor " +
+				"transaction, as a block height or UNIX " +
+				"timestamp",
+		},
+		cli.Uint64Flag{
+			Name: anchorSequenceName,
+			Usage: "the sequence number of all inputs of the " +
+				"anchor transaction, which can encode a " +
+				"relative lock time",
+		},
+		// TODO(roasbeef): add arg for file name to write sender proof
+		// blob
+	},
+	Action: sendAssets,
+}
+
+func sendAssets(ctx *cli.Context) error {
+	addrs := ctx.StringSlice(addrName)
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 || len(addrs) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	strategy, err := parseCoinSelectStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
+		TapAddrs:           addrs,
+		InputAnchorPoints:  ctx.StringSlice(inputAnchorPointName),
+		CoinSelectStrategy: strategy,
+		Label:              ctx.String(labelName),
+		MaxChainFeeSat:     ctx.Uint64(maxChainFeeName),
+		AnchorLockTime:     uint32(ctx.Uint64(anchorLockTimeName)),
+		AnchorSequence:     uint32(ctx.Uint64(anchorSequenceName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to send assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+func parseCoinSelectStrategy(ctx *cli.Context) (taprpc.CoinSelectStrategy,
+	error) {
+
+	switch ctx.String(coinSelectStrategyName) {
+	case "":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_DEFAULT, nil
+
+	case "largest-first":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST, nil
+
+	case "smallest-first":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_SMALLEST_FIRST, nil
+
+	case "fewest-inputs":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_FEWEST_INPUTS, nil
+
+	case "no-merge":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_NO_MERGE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy '%v'",
+			ctx.String(coinSelectStrategyName))
+	}
+}
+
+var burnAssetsCommand = cli.Command{
+	Name:  "burn",
+	Usage: "burn a number of asset units",
+	Description: `
+	Burn (destroy, remove from circulation) a number of asset units in a
+	provable way. The returned burn proof is cryptographic evidence that the
+	assets can no longer be spent and that the supply has been reduced by
+	the specified amount.
+
+	To avoid a dangling BTC output, not all assets within a commitment can
+	be burned completely, there always must be a change output (either with
+	a change amount or another asset that resides in the same commitment).
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID to burn units from",
+		},
+		cli.Uint64Flag{
+			Name:  assetAmountName,
+			Usage: "the amount of units to burn/destroy",
+		},
+		cli.BoolFlag{
+			Name: burnOverrideConfirmationName,
+			Usage: "if set, the confirmation prompt will be " +
+				"skipped and the assets are burned/destroyed " +
+				"immediately",
+		},
+		cli.StringFlag{
+			Name: burnNoteName,
+			Usage: "an optional reason or memo for the burn that " +
+				"is stored in the burn registry",
+		},
+	},
+	Action: burnAssets,
+}
+
+func burnAssets(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetIDHex := ctx.String(assetIDName)
+	assetIDBytes, err := hex.DecodeString(assetIDHex)
+	if err != nil {
+		return fmt.Errorf("invalid asset ID")
+	}
+
+	burnAmount := ctx.Uint64(assetAmountName)
+	if burnAmount == 0 {
+		return fmt.Errorf("invalid burn amount")
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Bool(burnOverrideConfirmationName) {
+		balance, err := client.ListBalances(
+			ctxc, &taprpc.ListBalancesRequest{
+				GroupBy: &taprpc.ListBalancesRequest_AssetId{
+					AssetId: true,
+				},
+				AssetFilter: assetIDBytes,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to list current asset "+
+				"balances: %w", err)
+		}
+
+		assetBalance, ok := balance.AssetBalances[assetIDHex]
+		if !ok {
+			return fmt.Errorf("couldn't fetch balance for asset %x",
+				assetIDBytes)
+		}
+
+		msg := fmt.Sprintf("Please confirm destructive action.\n"+
+			"Asset ID: %x\nCurrent available balance: %d\n"+
+			"Amount to burn: %d\n Are you sure you want to "+
+			"irreversibly burn (destroy, remove from circulation) "+
+			"the specified amount of assets?\nPlease answer 'yes' "+
+			"or 'no' and press enter: ", assetIDBytes,
+			assetBalance.Balance, burnAmount)
+
+		if !promptForConfirmation(msg) {
+			return nil
+		}
+	}
+
+	resp, err := client.BurnAsset(ctxc, &taprpc.BurnAssetRequest{
+		Asset: &taprpc.BurnAssetRequest_AssetId{
+			AssetId: assetIDBytes,
+		},
+		AmountToBurn:     burnAmount,
+		ConfirmationText: taprootassets.AssetBurnConfirmationText,
+		Note:             ctx.String(burnNoteName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to send assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listBurnsCommand = cli.Command{
+	Name:  "listburns",
+	Usage: "list asset burns",
+	Description: `
+	List the asset burns of the burn registry, along with their notes and
+	anchor transactions. The burns can be filtered by the burned asset, its
+	group or the anchor transaction of the burn.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "only list burns of the given asset ID",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "only list burns of the given asset group key",
+		},
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "only list burns anchored in the transaction " +
+				"with the given hash",
+		},
+	},
+	Action: listBurns,
+}
+
+func listBurns(ctx *cli.Context) error {
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to decode asset ID: %w", err)
+	}
+
+	groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode group key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListBurns(ctxc, &taprpc.ListBurnsRequest{
+		AssetId:         assetID,
+		TweakedGroupKey: groupKey,
+		AnchorTxid:      ctx.String(anchorTxidName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list burns: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var exportBurnCommand = cli.Command{
+	Name:  "exportburn",
+	Usage: "export an attestation of a confirmed asset burn",
+	Description: `
+	Export an attestation of a confirmed asset burn. The attestation
+	contains the full proof file of the burn output, which third parties
+	can verify to confirm that the burned units were provably destroyed
+	and the supply of the asset was reduced.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the burned asset",
+		},
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the burn script key of the burn output",
+		},
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "(optional) the file to write the raw proof " +
+				"file of the burn to instead of printing the " +
+				"attestation in the JSON format",
+		},
+	},
+	Action: exportBurn,
+}
+
+func exportBurn(ctx *cli.Context) error {
+	switch {
+	case ctx.String(scriptKeyName) == "",
+		ctx.String(assetIDName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKeyBytes, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode script key: %w", err)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to decode asset ID: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	var (
-		batchKeyStr = ctx.String(batchKeyName)
-		batchKey    []byte
-		err         error
+	resp, err := client.ExportBurnAttestation(
+		ctxc, &taprpc.ExportBurnAttestationRequest{
+			AssetId:   assetID,
+			ScriptKey: scriptKeyBytes,
+		},
 	)
-	if len(batchKeyStr) != 0 {
-		batchKey, err = hex.DecodeString(batchKeyStr)
-		if err != nil {
-			return fmt.Errorf("invalid batch key")
-		}
+	if err != nil {
+		return fmt.Errorf("unable to export burn attestation: %w", err)
 	}
 
-	resp, err := client.ListBatches(ctxc, &mintrpc.ListBatchRequest{
-		Filter: &mintrpc.ListBatchRequest_BatchKey{
-			BatchKey: batchKey,
+	if ctx.String(proofPathName) != "" {
+		filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+		return writeToFile(filePath, resp.RawProofFile)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listTransfersCommand = cli.Command{
+	Name:      "transfers",
+	ShortName: "t",
+	Usage:     "list asset transfers",
+	Description: "list outgoing transfers of all assets or a selected " +
+		"asset",
+	Action: listTransfers,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "A specific asset ID to list outgoing " +
+				"transfers for",
 		},
-	})
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "only list transfers with the given label",
+		},
+	},
+}
+
+func listTransfers(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ListTransfersRequest{
+		Label: ctx.String(labelName),
+	}
+	resp, err := client.ListTransfers(ctxc, req)
 	if err != nil {
-		return fmt.Errorf("unable to list batches: %w", err)
+		return fmt.Errorf("unable to list asset transfers: %w", err)
 	}
 
 	printRespJSON(resp)
 	return nil
 }
 
-var listAssetsCommand = cli.Command{
-	Name:        "list",
-	ShortName:   "l",
-	Usage:       "list all assets",
-	Description: "list all pending and mined assets",
+var exportTransfersCommand = cli.Command{
+	Name:  "exporttransfers",
+	Usage: "export asset transfers for accounting",
+	Description: `
+	Export the outgoing asset transfers and the completed incoming address
+	receives made within a time range as CSV or JSON. The export contains
+	the direction and the amounts of all assets moved, the chain fees paid
+	by the anchor transactions of outgoing transfers, the BTC sent along
+	with the assets to other parties and the addresses the assets were sent
+	to or received with.
+	`,
 	Flags: []cli.Flag{
-		cli.BoolFlag{
-			Name:  assetShowWitnessName,
-			Usage: "include the asset's witness data",
+		cli.StringFlag{
+			Name: exportStartName,
+			Usage: "(optional) only export transfers made at or " +
+				"after this date, given as YYYY-MM-DD or as " +
+				"RFC3339 timestamp",
 		},
-		cli.BoolFlag{
-			Name:  assetShowSpentName,
-			Usage: "include fully spent assets in the list",
+		cli.StringFlag{
+			Name: exportEndName,
+			Usage: "(optional) only export transfers made before " +
+				"this date, given as YYYY-MM-DD or as " +
+				"RFC3339 timestamp",
+		},
+		cli.StringFlag{
+			Name: exportFormatName,
+			Usage: "the format to export the transfers in, json " +
+				"or csv",
+			Value: "json",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "only export transfers with the given label",
+		},
+		cli.StringFlag{
+			Name: exportOutputFileName,
+			Usage: "(optional) the file to write the export to " +
+				"instead of printing it",
 		},
 	},
-	Action: listAssets,
+	Action: exportTransfers,
 }
 
-func listAssets(ctx *cli.Context) error {
+// parseExportTime parses the given date or RFC3339 timestamp into a unix
+// timestamp. An empty string is parsed as zero.
+func parseExportTime(timeStr string) (int64, error) {
+	if timeStr == "" {
+		return 0, nil
+	}
+
+	if date, err := time.Parse(time.DateOnly, timeStr); err == nil {
+		return date.Unix(), nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or "+
+			"RFC3339 timestamp", timeStr)
+	}
+
+	return timestamp.Unix(), nil
+}
+
+func exportTransfers(ctx *cli.Context) error {
+	var format taprpc.TransferExportFormat
+	switch ctx.String(exportFormatName) {
+	case "json":
+		format = taprpc.TransferExportFormat_TRANSFER_EXPORT_FORMAT_JSON
+
+	case "csv":
+		format = taprpc.TransferExportFormat_TRANSFER_EXPORT_FORMAT_CSV
+
+	default:
+		return fmt.Errorf("unknown export format %q, expected json "+
+			"or csv", ctx.String(exportFormatName))
+	}
+
+	start, err := parseExportTime(ctx.String(exportStartName))
+	if err != nil {
+		return err
+	}
+	end, err := parseExportTime(ctx.String(exportEndName))
+	if err != nil {
+		return err
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	// TODO(roasbeef): need to reverse txid
+	resp, err := client.ExportTransfers(
+		ctxc, &taprpc.ExportTransfersRequest{
+			StartTimestamp: start,
+			EndTimestamp:   end,
+			Format:         format,
+			Label:          ctx.String(labelName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export asset transfers: %w", err)
+	}
 
-	resp, err := client.ListAssets(ctxc, &taprpc.ListAssetRequest{
-		WithWitness:  ctx.Bool(assetShowWitnessName),
-		IncludeSpent: ctx.Bool(assetShowSpentName),
-	})
+	if ctx.String(exportOutputFileName) != "" {
+		filePath := lncfg.CleanAndExpandPath(
+			ctx.String(exportOutputFileName),
+		)
+		return writeToFile(filePath, resp.ExportData)
+	}
+
+	fmt.Print(string(resp.ExportData))
+	return nil
+}
+
+var bumpTransferFeeCommand = cli.Command{
+	Name:  "bumpfee",
+	Usage: "bump the fee of a pending asset transfer",
+	Description: `
+	Replace the anchor transaction of a pending, unconfirmed asset transfer
+	with one that pays the given, higher fee rate. The additional fee is
+	deducted from the BTC change output of the anchor transaction. The
+	proofs of the transfer are updated to reference the replacement.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "the txid of the anchor transaction of the " +
+				"pending transfer",
+		},
+		cli.Uint64Flag{
+			Name: satPerVByteName,
+			Usage: "the fee rate in sat/vB the replacement " +
+				"should pay",
+		},
+	},
+	Action: bumpTransferFee,
+}
+
+func bumpTransferFee(ctx *cli.Context) error {
+	if ctx.String(anchorTxidName) == "" ||
+		ctx.Uint64(satPerVByteName) == 0 {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.BumpTransferFeeRequest{
+		AnchorTxid:  ctx.String(anchorTxidName),
+		SatPerVbyte: ctx.Uint64(satPerVByteName),
+	}
+	resp, err := client.BumpTransferFee(ctxc, req)
 	if err != nil {
-		return fmt.Errorf("unable to list assets: %w", err)
+		return fmt.Errorf("unable to bump transfer fee: %w", err)
 	}
+
 	printRespJSON(resp)
 	return nil
 }
 
-var listUtxosCommand = cli.Command{
-	Name:        "utxos",
-	ShortName:   "u",
-	Usage:       "list all utxos",
-	Description: "list all utxos managing assets",
-	Action:      listUtxos,
+var cpfpTransferCommand = cli.Command{
+	Name:  "cpfp",
+	Usage: "accelerate a pending asset transfer with a child transaction",
+	Description: `
+	Accelerate the confirmation of the anchor transaction of a pending,
+	unconfirmed asset transfer by spending its BTC change output in a child
+	transaction that pays the given fee rate. The child transaction is
+	created and published by the lnd wallet.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "the txid of the anchor transaction of the " +
+				"pending transfer",
+		},
+		cli.Uint64Flag{
+			Name:  satPerVByteName,
+			Usage: "the fee rate in sat/vB the child should pay",
+		},
+	},
+	Action: cpfpTransfer,
 }
 
-func listUtxos(ctx *cli.Context) error {
+func cpfpTransfer(ctx *cli.Context) error {
+	if ctx.String(anchorTxidName) == "" ||
+		ctx.Uint64(satPerVByteName) == 0 {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.ListUtxos(ctxc, &taprpc.ListUtxosRequest{})
+	req := &taprpc.CpfpTransferRequest{
+		AnchorTxid:  ctx.String(anchorTxidName),
+		SatPerVbyte: ctx.Uint64(satPerVByteName),
+	}
+	resp, err := client.CpfpTransfer(ctxc, req)
 	if err != nil {
-		return fmt.Errorf("unable to list utxos: %w", err)
+		return fmt.Errorf("unable to bump transfer fee: %w", err)
 	}
+
 	printRespJSON(resp)
 	return nil
 }
 
-var listGroupsCommand = cli.Command{
-	Name:        "groups",
-	ShortName:   "g",
-	Usage:       "list all asset groups",
-	Description: "list all asset groups known to the daemon",
-	Action:      listGroups,
+var cancelTransferCommand = cli.Command{
+	Name:  "canceltransfer",
+	Usage: "cancel a pending asset transfer",
+	Description: `
+	Cancel a pending asset transfer whose anchor transaction hasn't
+	confirmed yet by double spending its BTC inputs back to the lnd wallet
+	at the given fee rate. Once the double spend confirms, the assets spent
+	by the transfer can be spent again.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "the txid of the anchor transaction of the " +
+				"pending transfer",
+		},
+		cli.Uint64Flag{
+			Name: satPerVByteName,
+			Usage: "the fee rate in sat/vB the double spend " +
+				"should pay",
+		},
+	},
+	Action: cancelTransfer,
 }
 
-func listGroups(ctx *cli.Context) error {
+func cancelTransfer(ctx *cli.Context) error {
+	if ctx.String(anchorTxidName) == "" ||
+		ctx.Uint64(satPerVByteName) == 0 {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.ListGroups(ctxc, &taprpc.ListGroupsRequest{})
+	req := &taprpc.CancelTransferRequest{
+		AnchorTxid:  ctx.String(anchorTxidName),
+		SatPerVbyte: ctx.Uint64(satPerVByteName),
+	}
+	resp, err := client.CancelTransfer(ctxc, req)
 	if err != nil {
-		return fmt.Errorf("unable to list asset groups: %w", err)
+		return fmt.Errorf("unable to cancel transfer: %w", err)
 	}
+
 	printRespJSON(resp)
 	return nil
 }
 
-var listAssetBalancesCommand = cli.Command{
-	Name:        "balance",
-	ShortName:   "b",
-	Usage:       "list asset balances",
-	Description: "list balances for all assets or a selected asset",
-	Action:      listAssetBalances,
+var resumeTransferDeliveryCommand = cli.Command{
+	Name:  "resumedelivery",
+	Usage: "resume the failed proof delivery of an asset transfer",
+	Description: `
+	Resume the delivery of the receiver proofs of a confirmed asset
+	transfer after it failed, without re-creating the transfer. If a proof
+	courier address is given, the proofs are delivered through it instead
+	of the couriers of the receivers' addresses.
+	`,
 	Flags: []cli.Flag{
-		cli.BoolFlag{
-			Name:  groupByGroupName,
-			Usage: "Group asset balances by group key",
-		},
 		cli.StringFlag{
-			Name: assetIDName,
-			Usage: "A specific asset ID to run the balance query " +
-				"against",
+			Name: anchorTxidName,
+			Usage: "the txid of the anchor transaction of the " +
+				"transfer",
 		},
 		cli.StringFlag{
-			Name: groupKeyName,
-			Usage: "A specific asset group key to run the " +
-				"balance query against. Must be used " +
-				"together with --by_group",
+			Name: proofCourierAddrName,
+			Usage: "the optional address of the proof courier " +
+				"to deliver the proofs through instead",
 		},
 	},
+	Action: resumeTransferDelivery,
+}
+
+func resumeTransferDelivery(ctx *cli.Context) error {
+	if ctx.String(anchorTxidName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ResumeTransferDeliveryRequest{
+		AnchorTxid:       ctx.String(anchorTxidName),
+		ProofCourierAddr: ctx.String(proofCourierAddrName),
+	}
+	resp, err := client.ResumeTransferDelivery(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to resume proof delivery: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// queueConditionFlags are the flags that describe when a queued send is
+// executed.
+var queueConditionFlags = []cli.Flag{
+	cli.DurationFlag{
+		Name: sendDelayName,
+		Usage: "the duration (1m, 2h, etc) from now before which the " +
+			"send isn't executed",
+	},
+	cli.Uint64Flag{
+		Name: maxSatPerVByteName,
+		Usage: "the maximum estimated on-chain fee rate in sat/vB at " +
+			"which the send is executed",
+	},
+}
+
+// parseQueueConditions parses the conditions of a queued send into the send
+// after timestamp and the maximum fee rate expected by the RPC server.
+func parseQueueConditions(ctx *cli.Context) (int64, uint64) {
+	var sendAfterUnix int64
+	if delay := ctx.Duration(sendDelayName); delay > 0 {
+		sendAfterUnix = time.Now().Add(delay).Unix()
+	}
+
+	return sendAfterUnix, ctx.Uint64(maxSatPerVByteName)
+}
+
+var sendQueueCommand = cli.Command{
+	Name:      "queue",
+	ShortName: "q",
+	Usage:     "manage sends queued for later execution",
+	Description: `
+	Queue sends that are executed by the daemon once a given time has
+	passed and the estimated on-chain fee rate is at or below a given
+	maximum. Queued sends are executed one at a time, so they don't compete
+	for the same inputs.
+	`,
+	Subcommands: []cli.Command{
+		queueSendCommand,
+		listQueuedSendsCommand,
+		updateQueuedSendCommand,
+		cancelQueuedSendCommand,
+	},
+}
+
+var queueSendCommand = cli.Command{
+	Name:  "add",
+	Usage: "queue a send to one or more addrs",
+	Description: `
+	Queue a send to one or more taproot asset addrs. The same rules as for
+	an immediate send apply. Conditions that are not set don't defer the
+	send.
+	`,
+	Flags: append([]cli.Flag{
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "addr to send to; can be specified multiple " +
+				"times to send to multiple addresses at once",
+		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset and " +
+				"BTC inputs, must either be: largest-first, " +
+				"smallest-first, fewest-inputs or no-merge; " +
+				"if not set, the daemon's default is used",
+		},
+	}, queueConditionFlags...),
+	Action: queueSend,
 }
 
-func listAssetBalances(ctx *cli.Context) error {
+func queueSend(ctx *cli.Context) error {
+	addrs := ctx.StringSlice(addrName)
+	if len(addrs) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	strategy, err := parseCoinSelectStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	var err error
+	sendAfterUnix, maxSatPerVByte := parseQueueConditions(ctx)
+	resp, err := client.QueueSend(ctxc, &taprpc.QueueSendRequest{
+		TapAddrs:           addrs,
+		CoinSelectStrategy: strategy,
+		SendAfterUnix:      sendAfterUnix,
+		MaxSatPerVbyte:     maxSatPerVByte,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to queue send: %w", err)
+	}
 
-	req := &taprpc.ListBalancesRequest{}
+	printRespJSON(resp)
+	return nil
+}
 
-	if !ctx.Bool(groupByGroupName) {
-		req.GroupBy = &taprpc.ListBalancesRequest_AssetId{
-			AssetId: true,
-		}
+var listQueuedSendsCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list all queued sends",
+	Action: listQueuedSends,
+}
 
-		assetIDHexStr := ctx.String(assetIDName)
-		if len(assetIDHexStr) != 0 {
-			req.AssetFilter, err = hex.DecodeString(assetIDHexStr)
-			if err != nil {
-				return fmt.Errorf("invalid asset ID")
-			}
+func listQueuedSends(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
 
-			if len(req.AssetFilter) != 32 {
-				return fmt.Errorf("invalid asset ID length")
-			}
-		}
-	} else {
-		req.GroupBy = &taprpc.ListBalancesRequest_GroupKey{
-			GroupKey: true,
-		}
+	resp, err := client.ListQueuedSends(
+		ctxc, &taprpc.ListQueuedSendsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list queued sends: %w", err)
+	}
 
-		assetGroupKeyHexStr := ctx.String(groupKeyName)
-		req.GroupKeyFilter, err = hex.DecodeString(assetGroupKeyHexStr)
-		if err != nil {
-			return fmt.Errorf("invalid group key")
-		}
+	printRespJSON(resp)
+	return nil
+}
+
+var updateQueuedSendCommand = cli.Command{
+	Name:  "update",
+	Usage: "replace the conditions of a queued send",
+	Description: `
+	Replace the conditions of a queued send. Conditions that are not set
+	no longer defer the send.
+	`,
+	Flags: append([]cli.Flag{
+		cli.Int64Flag{
+			Name:  queuedSendIDName,
+			Usage: "the ID of the queued send to update",
+		},
+	}, queueConditionFlags...),
+	Action: updateQueuedSend,
+}
+
+func updateQueuedSend(ctx *cli.Context) error {
+	if !ctx.IsSet(queuedSendIDName) {
+		return cli.ShowSubcommandHelp(ctx)
 	}
 
-	resp, err := client.ListBalances(ctxc, req)
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	sendAfterUnix, maxSatPerVByte := parseQueueConditions(ctx)
+	resp, err := client.UpdateQueuedSend(
+		ctxc, &taprpc.UpdateQueuedSendRequest{
+			Id:             ctx.Int64(queuedSendIDName),
+			SendAfterUnix:  sendAfterUnix,
+			MaxSatPerVbyte: maxSatPerVByte,
+		},
+	)
 	if err != nil {
-		return fmt.Errorf("unable to list asset balances: %w", err)
+		return fmt.Errorf("unable to update queued send: %w", err)
 	}
 
 	printRespJSON(resp)
 	return nil
 }
 
-var sendAssetsCommand = cli.Command{
-	Name:        "send",
-	ShortName:   "s",
-	Usage:       "send an asset",
-	Description: "send asset w/ a taproot asset addr",
+var cancelQueuedSendCommand = cli.Command{
+	Name:  "cancel",
+	Usage: "remove a send from the queue",
 	Flags: []cli.Flag{
-		cli.StringSliceFlag{
-			Name: addrName,
-			Usage: "addr to send to; can be specified multiple " +
-				"times to send to multiple addresses at once",
+		cli.Int64Flag{
+			Name:  queuedSendIDName,
+			Usage: "the ID of the queued send to cancel",
 		},
-		// TODO(roasbeef): add arg for file name to write sender proof
-		// blob
 	},
-	Action: sendAssets,
+	Action: cancelQueuedSend,
 }
 
-func sendAssets(ctx *cli.Context) error {
-	addrs := ctx.StringSlice(addrName)
-	if ctx.NArg() != 0 || ctx.NumFlags() == 0 || len(addrs) == 0 {
+func cancelQueuedSend(ctx *cli.Context) error {
+	if !ctx.IsSet(queuedSendIDName) {
 		return cli.ShowSubcommandHelp(ctx)
 	}
 
@@ -512,142 +2899,108 @@ func sendAssets(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		TapAddrs: addrs,
-	})
+	resp, err := client.CancelQueuedSend(
+		ctxc, &taprpc.CancelQueuedSendRequest{
+			Id: ctx.Int64(queuedSendIDName),
+		},
+	)
 	if err != nil {
-		return fmt.Errorf("unable to send assets: %w", err)
+		return fmt.Errorf("unable to cancel queued send: %w", err)
 	}
 
 	printRespJSON(resp)
 	return nil
 }
 
-var burnAssetsCommand = cli.Command{
-	Name:  "burn",
-	Usage: "burn a number of asset units",
+var consolidateAssetsCommand = cli.Command{
+	Name:  "consolidate",
+	Usage: "merge the smallest UTXOs of an asset",
 	Description: `
-	Burn (destroy, remove from circulation) a number of asset units in a
-	provable way. The returned burn proof is cryptographic evidence that the
-	assets can no longer be spent and that the supply has been reduced by
-	the specified amount.
-
-	To avoid a dangling BTC output, not all assets within a commitment can
-	be burned completely, there always must be a change output (either with
-	a change amount or another asset that resides in the same commitment).
+	Merge the smallest UTXOs of an asset, along with their BTC anchors,
+	into a single output of the wallet, so that at most the given number of
+	UTXOs of the asset remain.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  assetIDName,
-			Usage: "the asset ID to burn units from",
+			Usage: "the asset ID of the UTXOs to merge",
 		},
 		cli.Uint64Flag{
-			Name:  assetAmountName,
-			Usage: "the amount of units to burn/destroy",
-		},
-		cli.BoolFlag{
-			Name: burnOverrideConfirmationName,
-			Usage: "if set, the confirmation prompt will be " +
-				"skipped and the assets are burned/destroyed " +
-				"immediately",
+			Name: targetUtxoCountName,
+			Usage: "the number of UTXOs of the asset that should " +
+				"remain at most",
+			Value: 1,
 		},
 	},
-	Action: burnAssets,
+	Action: consolidateAssets,
 }
 
-func burnAssets(ctx *cli.Context) error {
-	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+func consolidateAssets(ctx *cli.Context) error {
+	if !ctx.IsSet(assetIDName) {
 		return cli.ShowSubcommandHelp(ctx)
 	}
 
-	assetIDHex := ctx.String(assetIDName)
-	assetIDBytes, err := hex.DecodeString(assetIDHex)
+	assetIDBytes, err := hex.DecodeString(ctx.String(assetIDName))
 	if err != nil {
 		return fmt.Errorf("invalid asset ID")
 	}
 
-	burnAmount := ctx.Uint64(assetAmountName)
-	if burnAmount == 0 {
-		return fmt.Errorf("invalid burn amount")
-	}
-
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	if !ctx.Bool(burnOverrideConfirmationName) {
-		balance, err := client.ListBalances(
-			ctxc, &taprpc.ListBalancesRequest{
-				GroupBy: &taprpc.ListBalancesRequest_AssetId{
-					AssetId: true,
-				},
-				AssetFilter: assetIDBytes,
-			},
-		)
-		if err != nil {
-			return fmt.Errorf("unable to list current asset "+
-				"balances: %w", err)
-		}
-
-		assetBalance, ok := balance.AssetBalances[assetIDHex]
-		if !ok {
-			return fmt.Errorf("couldn't fetch balance for asset %x",
-				assetIDBytes)
-		}
-
-		msg := fmt.Sprintf("Please confirm destructive action.\n"+
-			"Asset ID: %x\nCurrent available balance: %d\n"+
-			"Amount to burn: %d\n Are you sure you want to "+
-			"irreversibly burn (destroy, remove from circulation) "+
-			"the specified amount of assets?\nPlease answer 'yes' "+
-			"or 'no' and press enter: ", assetIDBytes,
-			assetBalance.Balance, burnAmount)
-
-		if !promptForConfirmation(msg) {
-			return nil
-		}
-	}
-
-	resp, err := client.BurnAsset(ctxc, &taprpc.BurnAssetRequest{
-		Asset: &taprpc.BurnAssetRequest_AssetId{
+	resp, err := client.ConsolidateAssets(
+		ctxc, &taprpc.ConsolidateAssetsRequest{
 			AssetId: assetIDBytes,
+			TargetUtxoCount: uint32(
+				ctx.Uint64(targetUtxoCountName),
+			),
 		},
-		AmountToBurn:     burnAmount,
-		ConfirmationText: taprootassets.AssetBurnConfirmationText,
-	})
+	)
 	if err != nil {
-		return fmt.Errorf("unable to send assets: %w", err)
+		return fmt.Errorf("unable to consolidate assets: %w", err)
 	}
 
 	printRespJSON(resp)
 	return nil
 }
 
-var listTransfersCommand = cli.Command{
-	Name:      "transfers",
-	ShortName: "t",
-	Usage:     "list asset transfers",
-	Description: "list outgoing transfers of all assets or a selected " +
-		"asset",
-	Action: listTransfers,
+var sweepAssetsCommand = cli.Command{
+	Name:  "sweep",
+	Usage: "move all UTXOs of an asset to new script keys",
+	Description: `
+	Move all UTXOs of an asset, or of all assets if no asset ID is given,
+	to new script keys of the wallet in a single anchor transaction,
+	merging the UTXOs of each asset into one output.
+
+	Assets that share an anchor output with another swept asset are listed
+	as deferred and must be swept again once the transfer confirmed.
+	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: assetIDName,
-			Usage: "A specific asset ID to list outgoing " +
-				"transfers for",
+			Usage: "the asset ID of the UTXOs to sweep, leave " +
+				"empty to sweep all assets",
 		},
 	},
+	Action: sweepAssets,
 }
 
-func listTransfers(ctx *cli.Context) error {
+func sweepAssets(ctx *cli.Context) error {
+	assetIDBytes, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID")
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &taprpc.ListTransfersRequest{}
-	resp, err := client.ListTransfers(ctxc, req)
+	resp, err := client.SweepAssets(ctxc, &taprpc.SweepAssetsRequest{
+		AssetId: assetIDBytes,
+	})
 	if err != nil {
-		return fmt.Errorf("unable to list asset transfers: %w", err)
+		return fmt.Errorf("unable to sweep assets: %w", err)
 	}
 
 	printRespJSON(resp)
@@ -655,7 +3008,9 @@ func listTransfers(ctx *cli.Context) error {
 }
 
 const (
-	metaName = "asset_meta"
+	metaName     = "asset_meta"
+	blobHashName = "blob_hash"
+	blobFileName = "blob_file"
 )
 
 var fetchMetaCommand = cli.Command{
@@ -674,6 +3029,9 @@ var fetchMetaCommand = cli.Command{
 			Usage: "meta_hash to fetch meta for",
 		},
 	},
+	Subcommands: []cli.Command{
+		fetchMetaBlobCommand,
+	},
 }
 
 func fetchMeta(ctx *cli.Context) error {
@@ -718,3 +3076,180 @@ func fetchMeta(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var fetchMetaBlobCommand = cli.Command{
+	Name:  "blob",
+	Usage: "fetch an asset meta blob",
+	Description: "fetch the meta data blob of an asset that commits to " +
+		"its meta data by reference, based on the asset_id or " +
+		"blob_hash",
+	Action: fetchMetaBlob,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "asset_id to fetch the meta blob for",
+		},
+		cli.StringFlag{
+			Name:  blobHashName,
+			Usage: "the hash of the meta blob to fetch",
+		},
+		cli.StringFlag{
+			Name: blobFileName,
+			Usage: "if set, the raw meta blob is written to this " +
+				"file instead of being printed; use - for " +
+				"stdout",
+		},
+	},
+}
+
+func fetchMetaBlob(ctx *cli.Context) error {
+	switch {
+	case ctx.IsSet(blobHashName) && ctx.IsSet(assetIDName):
+		return fmt.Errorf("only the asset_id or blob_hash can be set")
+
+	case !ctx.IsSet(assetIDName) && !ctx.IsSet(blobHashName):
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.FetchAssetMetaBlobRequest{}
+	if ctx.IsSet(assetIDName) {
+		req.Asset = &taprpc.FetchAssetMetaBlobRequest_AssetIdStr{
+			AssetIdStr: ctx.String(assetIDName),
+		}
+	} else {
+		req.Asset = &taprpc.FetchAssetMetaBlobRequest_BlobHashStr{
+			BlobHashStr: ctx.String(blobHashName),
+		}
+	}
+
+	resp, err := client.FetchAssetMetaBlob(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to fetch asset meta blob: %w", err)
+	}
+
+	if ctx.String(blobFileName) != "" {
+		filePath := lncfg.CleanAndExpandPath(ctx.String(blobFileName))
+		return writeToFile(filePath, resp.Blob)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importAssetCommand = cli.Command{
+	Name:      "import",
+	ShortName: "i",
+	Usage:     "import an asset into local custody from its proof file",
+	Description: `
+	Import an asset that was minted or received by another tapd instance
+	into local custody, without an on-chain send. The key descriptors of
+	the script key of the asset and of the internal key of its anchor
+	output must be given, so the asset can be spent later on. Unless the
+	keys are held by an external signer, they must be under the control
+	of the backing lnd node.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "the path to the proof file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+		cli.StringFlag{
+			Name:  scriptInternalKeyName,
+			Usage: "the raw key of the script key of the asset",
+		},
+		cli.Uint64Flag{
+			Name:  scriptKeyFamilyName,
+			Usage: "the key family of the raw script key",
+		},
+		cli.Uint64Flag{
+			Name:  scriptKeyIndexName,
+			Usage: "the key index of the raw script key",
+		},
+		cli.StringFlag{
+			Name: scriptKeyTweakName,
+			Usage: "the optional hex encoded tapscript root the " +
+				"script key is tweaked with; if not set, a " +
+				"BIP-86 script key is used",
+		},
+		cli.StringFlag{
+			Name: anchorInternalKeyName,
+			Usage: "the internal key of the anchor output of the " +
+				"asset",
+		},
+		cli.Uint64Flag{
+			Name:  anchorKeyFamilyName,
+			Usage: "the key family of the anchor internal key",
+		},
+		cli.Uint64Flag{
+			Name:  anchorKeyIndexName,
+			Usage: "the key index of the anchor internal key",
+		},
+		cli.BoolFlag{
+			Name: externalSignerName,
+			Usage: "if true, then the keys are held by an " +
+				"external signer instead of the lnd node; " +
+				"the asset is imported as watch only",
+		},
+	},
+	Action: importAsset,
+}
+
+func importAsset(ctx *cli.Context) error {
+	switch {
+	case ctx.String(proofPathName) == "",
+		ctx.String(scriptInternalKeyName) == "",
+		ctx.String(anchorInternalKeyName) == "":
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
+	}
+
+	scriptKey, err := parseScriptKey(ctx)
+	if err != nil {
+		return err
+	}
+	scriptKey.KeyDesc.KeyLoc = &taprpc.KeyLocator{
+		KeyFamily: int32(ctx.Uint64(scriptKeyFamilyName)),
+		KeyIndex:  int32(ctx.Uint64(scriptKeyIndexName)),
+	}
+
+	anchorKey, err := hex.DecodeString(ctx.String(anchorInternalKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid anchor internal key")
+	}
+
+	anchorKeyDesc := &taprpc.KeyDescriptor{
+		RawKeyBytes: anchorKey,
+		KeyLoc: &taprpc.KeyLocator{
+			KeyFamily: int32(ctx.Uint64(anchorKeyFamilyName)),
+			KeyIndex:  int32(ctx.Uint64(anchorKeyIndexName)),
+		},
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportAsset(ctxc, &wrpc.ImportAssetRequest{
+		ProofFile:         rawFile,
+		ScriptKey:         scriptKey,
+		AnchorInternalKey: anchorKeyDesc,
+		ExternalSigner:    ctx.Bool(externalSignerName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to import asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
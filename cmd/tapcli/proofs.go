@@ -11,6 +11,7 @@ import (
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
+	"google.golang.org/protobuf/proto"
 )
 
 var proofCommands = []cli.Command{
@@ -25,6 +26,8 @@ var proofCommands = []cli.Command{
 			exportProofCommand,
 			proveOwnershipCommand,
 			verifyOwnershipCommand,
+			attestReservesCommand,
+			verifyReservesCommand,
 		},
 	},
 }
@@ -392,3 +395,159 @@ func writeToFile(fileName string, content []byte) error {
 
 	return nil
 }
+
+const (
+	reserveMessageName = "message"
+
+	attestationPathName = "attestation_file"
+)
+
+var attestReservesCommand = cli.Command{
+	Name:      "attestreserves",
+	ShortName: "ar",
+	Usage:     "generate a proof-of-reserves attestation",
+	Description: `
+	Generates an attestation that proves the ownership of all unspent
+	holdings of an asset or an asset group. Each holding is proven with an
+	ownership proof that commits to the given message and the current
+	time, so the attestation can't be reused for a different message. An
+	auditor should choose the message, for example their name along with
+	a random nonce.
+
+	The attestation proves that the holdings could be spent at the time
+	of its creation. A verifier still needs to check that the anchor
+	outpoints of the holdings are unspent on chain.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "the asset ID of the asset to attest the " +
+				"reserves of",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "the group key of the asset group to attest " +
+				"the reserves of",
+		},
+		cli.StringFlag{
+			Name:  reserveMessageName,
+			Usage: "the message the attestation is bound to",
+		},
+		cli.StringFlag{
+			Name: attestationPathName,
+			Usage: "(optional) the file to write the raw binary " +
+				"attestation to instead of the default JSON " +
+				"format, which can then be verified with the " +
+				"verifyreserves command",
+		},
+	},
+	Action: attestReserves,
+}
+
+func attestReserves(ctx *cli.Context) error {
+	switch {
+	case ctx.String(reserveMessageName) == "",
+		ctx.String(assetIDName) == "" && ctx.String(groupKeyName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	var (
+		assetID, groupKey []byte
+		err               error
+	)
+	if ctx.String(assetIDName) != "" {
+		assetID, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("unable to decode asset ID: %w", err)
+		}
+	}
+	if ctx.String(groupKeyName) != "" {
+		groupKey, err = hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return fmt.Errorf("unable to decode group key: %w", err)
+		}
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AttestReserves(ctxc, &wrpc.AttestReservesRequest{
+		AssetId:  assetID,
+		GroupKey: groupKey,
+		Message:  []byte(ctx.String(reserveMessageName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to attest reserves: %w", err)
+	}
+
+	if ctx.String(attestationPathName) != "" {
+		rawAttestation, err := proto.Marshal(resp.Attestation)
+		if err != nil {
+			return fmt.Errorf("unable to encode attestation: %w",
+				err)
+		}
+
+		filePath := lncfg.CleanAndExpandPath(
+			ctx.String(attestationPathName),
+		)
+		return writeToFile(filePath, rawAttestation)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var verifyReservesCommand = cli.Command{
+	Name:      "verifyreserves",
+	ShortName: "vr",
+	Usage:     "verify a proof-of-reserves attestation",
+	Description: `
+	Verify the ownership proofs of all holdings of a proof-of-reserves
+	attestation and list the attested holdings. This doesn't check whether
+	the anchor outpoints of the holdings are still unspent on chain.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: attestationPathName,
+			Usage: "the path to the raw binary attestation file " +
+				"on disk; use the dash character (-) to read " +
+				"from stdin instead",
+		},
+	},
+	Action: verifyReserves,
+}
+
+func verifyReserves(ctx *cli.Context) error {
+	switch {
+	case ctx.String(attestationPathName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(attestationPathName))
+	rawAttestation, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read attestation file: %w", err)
+	}
+
+	attestation := &wrpc.ReserveAttestation{}
+	if err := proto.Unmarshal(rawAttestation, attestation); err != nil {
+		return fmt.Errorf("unable to decode attestation: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.VerifyReserveAttestation(
+		ctxc, &wrpc.VerifyReserveAttestationRequest{
+			Attestation: attestation,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to verify attestation: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
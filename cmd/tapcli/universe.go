@@ -40,10 +40,12 @@ var universeCommands = []cli.Command{
 			universeRootsCommand,
 			universeDeleteRootCommand,
 			universeLeavesCommand,
+			universeEmissionCommand,
 			universeKeysCommand,
 			universeProofCommand,
 			universeSyncCommand,
 			universeFederationCommand,
+			universePublicationsCommand,
 			universeInfoCommand,
 			universeStatsCommand,
 		},
@@ -318,6 +320,53 @@ func universeLeaves(ctx *cli.Context) error {
 	return nil
 }
 
+var universeEmissionCommand = cli.Command{
+	Name:      "emission",
+	ShortName: "e",
+	Usage:     "return the emission rules of an asset group",
+	Description: `
+	Query for the max supply and the emission schedule an asset group
+	committed to in the meta data of its group anchor, along with the
+	supply issued into the group so far. The commitment can be verified by
+	querying the issuance proof of the group anchor with the returned key.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the asset group to query for",
+		},
+	},
+	Action: universeEmission,
+}
+
+func universeEmission(ctx *cli.Context) error {
+	if !ctx.IsSet(groupKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	groupKeyBytes, err := hex.DecodeString(ctx.String(groupKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode group key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	emission, err := client.QueryGroupEmission(ctxc, &unirpc.ID{
+		Id: &unirpc.ID_GroupKey{
+			GroupKey: groupKeyBytes,
+		},
+		ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(emission)
+	return nil
+}
+
 const (
 	outpointName = "outpoint"
 )
@@ -1160,6 +1209,58 @@ func universeInfo(ctx *cli.Context) error {
 	return nil
 }
 
+var universePublicationsCommand = cli.Command{
+	Name:      "publications",
+	ShortName: "pub",
+	Usage: "list the publication status of new issuance proofs for " +
+		"each publication target",
+	Description: `
+	List the publication status of new issuance proofs for each of the
+	universe servers configured as publication targets. If an asset ID
+	or group key is given, only the publications of proofs of that
+	universe are shown.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to filter by",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to filter by",
+		},
+		cli.StringFlag{
+			Name:  proofTypeName,
+			Usage: "the type of proof to filter by",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+	},
+	Action: universePublications,
+}
+
+func universePublications(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListProofPublications(
+		ctxc, &unirpc.ListProofPublicationsRequest{
+			Id: universeID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeStatsCommand = cli.Command{
 	Name:      "stats",
 	ShortName: "s",
@@ -113,6 +113,37 @@ func NewPreimageFromBranch(branch txscript.TapBranch) *TapscriptPreimage {
 	}
 }
 
+// NewPreimageFromTree creates a new TapscriptPreimage from the root of the
+// tapscript tree that is assembled from the given leaves. None of the leaves
+// can be a Taproot Asset commitment.
+func NewPreimageFromTree(leaves []txscript.TapLeaf) (*TapscriptPreimage,
+	error) {
+
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("tapscript tree must have at least " +
+			"one leaf")
+	}
+
+	for _, leaf := range leaves {
+		if IsTaprootAssetCommitmentScript(leaf.Script) {
+			return nil, ErrPreimageIsTapCommitment
+		}
+	}
+
+	tree := txscript.AssembleTaprootScriptTree(leaves...)
+	switch rootNode := tree.RootNode.(type) {
+	case txscript.TapLeaf:
+		return NewPreimageFromLeaf(rootNode), nil
+
+	case txscript.TapBranch:
+		return NewPreimageFromBranch(rootNode), nil
+
+	default:
+		return nil, fmt.Errorf("unknown tapscript tree root node: %T",
+			rootNode)
+	}
+}
+
 // IsEmpty returns true if the sibling pre-image is empty.
 func (t *TapscriptPreimage) IsEmpty() bool {
 	if t == nil {
@@ -133,6 +133,42 @@ func TestTapscriptPreimage(t *testing.T) {
 	}
 }
 
+// TestPreimageFromTree tests that the preimage of a full tapscript tree commits
+// to the root of the tree.
+func TestPreimageFromTree(t *testing.T) {
+	leaf1 := test.ScriptHashLock(t, []byte("foo"))
+	leaf2 := test.ScriptHashLock(t, []byte("bar"))
+	leaf3 := test.ScriptHashLock(t, []byte("baz"))
+
+	// A tree with a single leaf is just that leaf.
+	preimage, err := NewPreimageFromTree([]txscript.TapLeaf{leaf1})
+	require.NoError(t, err)
+	require.Equal(t, NewPreimageFromLeaf(leaf1), preimage)
+
+	// A tree with multiple leaves is committed to by its root branch.
+	leaves := []txscript.TapLeaf{leaf1, leaf2, leaf3}
+	preimage, err = NewPreimageFromTree(leaves)
+	require.NoError(t, err)
+	require.Equal(t, BranchPreimage, preimage.SiblingType)
+
+	tree := txscript.AssembleTaprootScriptTree(leaves...)
+	expectedHash := tree.RootNode.TapHash()
+	hash, err := preimage.TapHash()
+	require.NoError(t, err)
+	require.Equal(t, expectedHash, *hash)
+
+	// Empty trees and trees with a Taproot Asset commitment are rejected.
+	_, err = NewPreimageFromTree(nil)
+	require.ErrorContains(t, err, "at least one leaf")
+
+	tapCommitment, err := NewTapCommitment()
+	require.NoError(t, err)
+	_, err = NewPreimageFromTree([]txscript.TapLeaf{
+		leaf1, tapCommitment.TapLeaf(),
+	})
+	require.ErrorIs(t, err, ErrPreimageIsTapCommitment)
+}
+
 // TestMaybeDecodeTapscriptPreimage tests the MaybeDecodeTapscriptPreimage
 // function.
 func TestMaybeDecodeTapscriptPreimage(t *testing.T) {
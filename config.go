@@ -49,6 +49,8 @@ type RPCConfig struct {
 
 	AllowPublicStats bool
 
+	AllowPublicMetaBlobs bool
+
 	LetsEncryptDir string
 
 	LetsEncryptListen string
@@ -102,6 +104,10 @@ type Config struct {
 
 	DefaultProofCourierAddr *url.URL
 
+	// ZeroConfPolicy governs which inbound asset transfers are accepted
+	// before their anchor transaction confirms.
+	ZeroConfPolicy address.ZeroConfPolicy
+
 	ProofArchive proof.Archiver
 
 	AssetWallet tapfreighter.Wallet
@@ -110,6 +116,10 @@ type Config struct {
 
 	ChainPorter tapfreighter.Porter
 
+	SendQueue *tapfreighter.SendQueue
+
+	Consolidator *tapfreighter.Consolidator
+
 	BaseUniverse *universe.MintingArchive
 
 	UniverseSyncer universe.Syncer
@@ -124,6 +134,10 @@ type Config struct {
 	// This applies to federation syncing as well as RPC insert and query.
 	UniversePublicAccess bool
 
+	// MetaBlobBaseURL is the base URL under which the meta data blobs of
+	// assets minted by reference are published.
+	MetaBlobBaseURL string
+
 	Prometheus monitoring.PrometheusConfig
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
@@ -4,11 +4,17 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/tapdevrpc"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/stretchr/testify/require"
 )
@@ -287,6 +293,115 @@ func runMultiSendTest(ctxt context.Context, t *harnessTest, alice,
 	require.NoError(t.t, err)
 }
 
+// testAddressTapscriptScriptKey tests that assets can be received with an
+// address whose script key commits to a multisig script that the receiver only
+// holds one of the keys of. The inbound transfer should be detected, verified
+// and stored, but the received asset must neither count towards the balance
+// of the receiver nor be selected as a coin, since the receiver can't spend it
+// on its own.
+func testAddressTapscriptScriptKey(t *harnessTest) {
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	mintedAsset := rpcAssets[0]
+	genInfo := mintedAsset.AssetGenesis
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	alice := t.tapd
+	bob := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.startupSyncNode = alice
+			params.startupSyncNumAssets = len(rpcAssets)
+		},
+	)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	// Bob only holds one of the keys of the 2-of-3 multisig script, the
+	// other two belong to other parties.
+	bobScriptKey, _ := deriveKeys(t.t, bob)
+	multiSigKeys := []*btcec.PublicKey{bobScriptKey.RawKey.PubKey}
+	for i := 0; i < 2; i++ {
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t.t, err)
+
+		multiSigKeys = append(multiSigKeys, privKey.PubKey())
+	}
+	multiSig, err := tapgarden.NewGroupMultiSig(2, multiSigKeys)
+	require.NoError(t.t, err)
+	multiSigLeaf, err := multiSig.TapLeaf()
+	require.NoError(t.t, err)
+
+	// Without an internal key for the script key, the NUMS key is used,
+	// so the asset can only be spent through the multisig script.
+	const recvAmt = 1000
+	addr, err := bob.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     recvAmt,
+		ScriptKeyTree: &taprpc.TapscriptFullTree{
+			AllLeaves: []*taprpc.TapLeaf{{
+				Script: multiSigLeaf.Script,
+			}},
+		},
+	})
+	require.NoError(t.t, err)
+	AssertAddrCreated(t.t, bob, mintedAsset, addr)
+
+	expectedScriptKey, err := asset.NewScriptKeyTapscript(
+		keychain.KeyDescriptor{
+			PubKey: asset.NUMSPubKey,
+		}, []txscript.TapLeaf{multiSigLeaf},
+	)
+	require.NoError(t.t, err)
+	require.Equal(
+		t.t, expectedScriptKey.PubKey.SerializeCompressed(),
+		addr.ScriptKey,
+	)
+
+	// Bob should detect the inbound transfer as soon as it's broadcast
+	// and again once it's confirmed.
+	sendAssetsToAddr(t, alice, addr)
+	AssertAddrEvent(t.t, bob, addr, 1, statusDetected)
+
+	MineBlocks(t.t, t.lndHarness.Miner.Client, 1, 1)
+	AssertAddrEvent(t.t, bob, addr, 1, statusConfirmed)
+
+	// Importing the proof verifies it against the script key of the
+	// address and stores the received asset.
+	sendProof(t, alice, bob, addr.ScriptKey, genInfo)
+	AssertNonInteractiveRecvComplete(t.t, bob, 1)
+
+	bobAssets, err := bob.ListAssets(ctxt, &taprpc.ListAssetRequest{})
+	require.NoError(t.t, err)
+	AssertAssetStateByScriptKey(
+		t.t, bobAssets.Assets, addr.ScriptKey,
+		AssetAmountCheck(recvAmt), AssetScriptKeyIsLocalCheck(false),
+	)
+
+	// Bob can't spend the asset on his own, so it isn't part of his
+	// balance and can't be used to fund a send.
+	AssertBalanceByID(t.t, bob, genInfo.AssetId, 0)
+
+	aliceAddr, err := alice.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     recvAmt / 2,
+	})
+	require.NoError(t.t, err)
+
+	_, err = bob.SendAsset(ctxt, &taprpc.SendAssetRequest{
+		TapAddrs: []string{aliceAddr.Encoded},
+	})
+	require.ErrorContains(
+		t.t, err, tapfreighter.ErrMatchingAssetsNotFound.Error(),
+	)
+}
+
 func sendProof(t *harnessTest, src, dst *tapdHarness, scriptKey []byte,
 	genInfo *taprpc.GenesisInfo) *tapdevrpc.ImportProofResponse {
 
@@ -1214,6 +1214,28 @@ func AssertUniverseKeysEqual(t *testing.T, uniIDs []*unirpc.ID,
 	}
 }
 
+// AssertFederationServers asserts that the federation of the given node
+// consists of exactly the given universe server hosts.
+func AssertFederationServers(t *testing.T, client unirpc.UniverseClient,
+	hosts ...string) {
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	resp, err := client.ListFederationServers(
+		ctxt, &unirpc.ListFederationServersRequest{},
+	)
+	require.NoError(t, err)
+
+	fedHosts := fn.Map(
+		resp.Servers, func(s *unirpc.UniverseFederationServer) string {
+			return s.Host
+		},
+	)
+	require.ElementsMatch(t, hosts, fedHosts)
+}
+
 func AssertUniverseStats(t *testing.T, client unirpc.UniverseClient,
 	numProofs, numSyncs, numAssets, numGroups int) {
 
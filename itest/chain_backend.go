@@ -0,0 +1,136 @@
+package itest
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/node"
+)
+
+// ChainBackendKind is the kind of chain backend that the lnd nodes of the test
+// network are connected to.
+type ChainBackendKind uint8
+
+const (
+	// ChainBackendBtcd is a btcd node that lnd connects to over RPC.
+	ChainBackendBtcd ChainBackendKind = iota
+
+	// ChainBackendBitcoindZMQ is a bitcoind node that notifies lnd of new
+	// blocks and transactions over ZMQ.
+	ChainBackendBitcoindZMQ
+
+	// ChainBackendBitcoindPolling is a bitcoind node that lnd polls for
+	// new blocks and transactions over RPC.
+	ChainBackendBitcoindPolling
+
+	// ChainBackendNeutrino is a neutrino light client embedded in lnd that
+	// connects to the miner over p2p.
+	ChainBackendNeutrino
+)
+
+// String returns a human-readable name of the chain backend kind.
+func (k ChainBackendKind) String() string {
+	switch k {
+	case ChainBackendBtcd:
+		return "btcd"
+
+	case ChainBackendBitcoindZMQ:
+		return "bitcoind"
+
+	case ChainBackendBitcoindPolling:
+		return "bitcoind-rpcpolling"
+
+	case ChainBackendNeutrino:
+		return lntest.NeutrinoBackendName
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(k))
+	}
+}
+
+// ChainBackend is the chain backend that the lnd nodes of the test network are
+// connected to. The backend itself is selected at compile time through the
+// build tags of lntest (see the backend variable of the Makefile), this
+// interface just lets the test cases find out which one they run against and
+// adapt to the differences between them, instead of assuming btcd.
+type ChainBackend interface {
+	node.BackendConfig
+
+	// Kind returns the kind of the chain backend.
+	Kind() ChainBackendKind
+
+	// HasMempool returns true if lnd learns about unconfirmed transactions
+	// that it didn't create itself through the chain backend.
+	HasMempool() bool
+
+	// CanDisconnectMiner returns true if the chain backend can be
+	// disconnected from the miner, which is required to generate re-orgs.
+	CanDisconnectMiner() bool
+}
+
+// chainBackend is the ChainBackend implementation that wraps the backend
+// config of lntest.
+type chainBackend struct {
+	node.BackendConfig
+
+	kind ChainBackendKind
+}
+
+// newChainBackend determines the kind of the given lntest backend config and
+// wraps it in a ChainBackend.
+func newChainBackend(cfg node.BackendConfig) (ChainBackend, error) {
+	var kind ChainBackendKind
+	switch cfg.Name() {
+	case "btcd":
+		kind = ChainBackendBtcd
+
+	// Both bitcoind flavors share the same name, they only differ in the
+	// arguments they pass to lnd.
+	case "bitcoind":
+		kind = ChainBackendBitcoindZMQ
+		for _, arg := range cfg.GenArgs() {
+			if arg == "--bitcoind.rpcpolling" {
+				kind = ChainBackendBitcoindPolling
+			}
+		}
+
+	case lntest.NeutrinoBackendName:
+		kind = ChainBackendNeutrino
+
+	default:
+		return nil, fmt.Errorf("unknown chain backend %v", cfg.Name())
+	}
+
+	return &chainBackend{
+		BackendConfig: cfg,
+		kind:          kind,
+	}, nil
+}
+
+// Kind returns the kind of the chain backend.
+//
+// NOTE: This is part of the ChainBackend interface.
+func (c *chainBackend) Kind() ChainBackendKind {
+	return c.kind
+}
+
+// HasMempool returns true if lnd learns about unconfirmed transactions that it
+// didn't create itself through the chain backend. A neutrino client only ever
+// sees confirmed transactions.
+//
+// NOTE: This is part of the ChainBackend interface.
+func (c *chainBackend) HasMempool() bool {
+	return c.kind != ChainBackendNeutrino
+}
+
+// CanDisconnectMiner returns true if the chain backend can be disconnected
+// from the miner. The neutrino backend of lntest connects to the miner through
+// a command line flag of lnd, so it can't be disconnected at runtime.
+//
+// NOTE: This is part of the ChainBackend interface.
+func (c *chainBackend) CanDisconnectMiner() bool {
+	return c.kind != ChainBackendNeutrino
+}
+
+// Ensure that chainBackend implements the ChainBackend interface.
+var _ ChainBackend = (*chainBackend)(nil)
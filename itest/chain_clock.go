@@ -0,0 +1,175 @@
+package itest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/integration/rpctest"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// maxBlockTimeOffset is how far a block timestamp may be ahead of the
+	// current time of the chain backend before the block is rejected.
+	maxBlockTimeOffset = 2 * time.Hour
+
+	// medianTimeBlocks is the number of previous blocks the median time
+	// past is calculated from.
+	medianTimeBlocks = 11
+
+	// timestampBlockVersion is the version of the blocks mined with a
+	// controlled timestamp. It must be high enough for the chain backend
+	// to accept the block after all soft forks activated.
+	timestampBlockVersion = 4
+)
+
+// MedianTimePast returns the median time past of the current chain tip, which
+// is the time lock-time and sequence based time locks are checked against.
+func MedianTimePast(t *testing.T, client *rpcclient.Client) time.Time {
+	info, err := client.GetBlockChainInfo()
+	require.NoError(t, err)
+
+	return time.Unix(info.MedianTime, 0)
+}
+
+// MineBlockAt mines a single block with the given timestamp on top of the
+// current chain tip. The block includes all transactions of the miner's
+// mempool, after waiting for the given number of transactions to show up in
+// it. The timestamp must be after the median time past of the chain and at
+// most two hours ahead of the current time, otherwise the block is rejected.
+func MineBlockAt(t *testing.T, client *rpcclient.Client, blockTime time.Time,
+	numTxs int) *wire.MsgBlock {
+
+	mtp := MedianTimePast(t, client)
+	require.Truef(t, blockTime.After(mtp), "block time %v not after "+
+		"median time past %v", blockTime, mtp)
+	require.Truef(
+		t, blockTime.Before(time.Now().Add(maxBlockTimeOffset)),
+		"block time %v too far in the future", blockTime,
+	)
+
+	if numTxs > 0 {
+		_, err := waitForNTxsInMempool(
+			client, numTxs, minerMempoolTimeout,
+		)
+		require.NoError(t, err)
+	}
+
+	bestHash, bestHeight, err := client.GetBestBlock()
+	require.NoError(t, err)
+	prevMsgBlock, err := client.GetBlock(bestHash)
+	require.NoError(t, err)
+
+	prevBlock := btcutil.NewBlock(prevMsgBlock)
+	prevBlock.SetHeight(bestHeight)
+
+	miningAddr, err := btcutil.DecodeAddress(
+		regtestMiningAddr, regtestParams,
+	)
+	require.NoError(t, err)
+
+	block, err := rpctest.CreateBlock(
+		prevBlock, mempoolTxs(t, client), timestampBlockVersion,
+		blockTime, miningAddr, nil, regtestParams,
+	)
+	require.NoError(t, err)
+
+	err = client.SubmitBlock(block, nil)
+	require.NoError(t, err)
+
+	// Make sure the block was accepted as the new chain tip, submitblock
+	// doesn't return an error for all rejected blocks.
+	newBestHash, _, err := client.GetBestBlock()
+	require.NoError(t, err)
+	require.Equal(t, *block.Hash(), *newBestHash)
+
+	return block.MsgBlock()
+}
+
+// AdvanceMedianTimePast mines blocks with increasing timestamps until the
+// median time past of the chain is at or after the given time, and returns the
+// mined blocks. As the timestamp of a block can be at most two hours ahead of
+// the current time, the median time past can't be advanced any further than
+// that.
+func AdvanceMedianTimePast(t *testing.T, client *rpcclient.Client,
+	target time.Time) []*wire.MsgBlock {
+
+	var blocks []*wire.MsgBlock
+	for i := 0; i < medianTimeBlocks; i++ {
+		if !MedianTimePast(t, client).Before(target) {
+			return blocks
+		}
+
+		// Every block needs a timestamp after the previous one, or the
+		// median wouldn't move.
+		bestHash, err := client.GetBestBlockHash()
+		require.NoError(t, err)
+		header, err := client.GetBlockHeader(bestHash)
+		require.NoError(t, err)
+
+		blockTime := target
+		if !blockTime.After(header.Timestamp) {
+			blockTime = header.Timestamp.Add(time.Second)
+		}
+
+		blocks = append(blocks, MineBlockAt(t, client, blockTime, 0))
+	}
+
+	mtp := MedianTimePast(t, client)
+	require.Falsef(t, mtp.Before(target), "median time past %v didn't "+
+		"reach %v", mtp, target)
+
+	return blocks
+}
+
+// mempoolTxs returns all transactions of the miner's mempool, ordered so that
+// every transaction comes after the transactions it spends from.
+func mempoolTxs(t *testing.T, client *rpcclient.Client) []*btcutil.Tx {
+	txids, err := client.GetRawMempool()
+	require.NoError(t, err)
+
+	pending := make(map[chainhash.Hash]*btcutil.Tx, len(txids))
+	for _, txid := range txids {
+		tx, err := client.GetRawTransaction(txid)
+		require.NoError(t, err)
+
+		pending[*txid] = tx
+	}
+
+	txs := make([]*btcutil.Tx, 0, len(pending))
+	for len(pending) > 0 {
+		numPending := len(pending)
+		for txid, tx := range pending {
+			if spendsPending(tx, pending) {
+				continue
+			}
+
+			txs = append(txs, tx)
+			delete(pending, txid)
+		}
+
+		// The mempool can't contain a dependency cycle, but we don't
+		// want to loop forever if it did.
+		require.Less(t, len(pending), numPending)
+	}
+
+	return txs
+}
+
+// spendsPending returns true if the given transaction spends an output of any
+// of the given pending transactions.
+func spendsPending(tx *btcutil.Tx,
+	pending map[chainhash.Hash]*btcutil.Tx) bool {
+
+	for _, txIn := range tx.MsgTx().TxIn {
+		if _, ok := pending[txIn.PreviousOutPoint.Hash]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,54 @@
+package itest
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testChainClock tests that the chain clock helpers advance the median time
+// past of the chain and mine blocks with the requested timestamps, and that
+// the node keeps up with the chain they produce.
+func testChainClock(t *harnessTest) {
+	ctxb := context.Background()
+	miner := t.lndHarness.Miner.Client
+
+	// Move the median time past an hour ahead of the current time.
+	target := time.Now().Add(time.Hour).Truncate(time.Second)
+	AdvanceMedianTimePast(t.t, miner, target)
+	require.False(t.t, MedianTimePast(t.t, miner).Before(target))
+
+	// Mint an asset and confirm the batch in a block with a controlled
+	// timestamp.
+	_, batchKey := MintAssetUnconfirmed(
+		t.t, miner, t.tapd,
+		[]*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+
+	blockTime := MedianTimePast(t.t, miner).Add(time.Minute)
+	block := MineBlockAt(t.t, miner, blockTime, 1)
+	require.Equal(t.t, blockTime, block.Header.Timestamp)
+	require.Len(t.t, block.Transactions, 2)
+
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	WaitForBatchState(
+		t.t, ctxt, t.tapd, defaultWaitTimeout, batchKey,
+		mintrpc.BatchState_BATCH_STATE_FINALIZED,
+	)
+
+	// The node should follow the chain to the block we mined.
+	blockHash := block.BlockHash()
+	require.Eventually(t.t, func() bool {
+		info, err := t.tapd.GetInfo(ctxt, &taprpc.GetInfoRequest{})
+		if err != nil {
+			return false
+		}
+
+		return info.BlockHash == blockHash.String()
+	}, defaultWaitTimeout, time.Second)
+}
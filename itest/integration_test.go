@@ -12,11 +12,37 @@ import (
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	"github.com/lightningnetwork/lnd/lntest"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
 )
 
 var optionalTests = flag.Bool("optional", false, "if true, the optional test"+
 	"list will be used")
 
+var (
+	// quarantineFile is a command line flag for specifying a file that
+	// lists the names of quarantined test cases, which are not run.
+	quarantineFile = flag.String("quarantinefile", "", "The path of a "+
+		"file that lists the names of the test cases to quarantine, "+
+		"one per line")
+
+	// resultsFile is a command line flag for specifying a file that the
+	// result of every test case attempt is appended to.
+	resultsFile = flag.String("resultsfile", "", "The path of a file "+
+		"that the result of every test case attempt is appended to")
+
+	// resumeFrom is a command line flag for specifying the name of the
+	// test case to resume a previous run from. All test cases before it
+	// are not run.
+	resumeFrom = flag.String("resumefrom", "", "The name of the test "+
+		"case to resume a previous run from, used to retry flaky "+
+		"test cases")
+
+	// attempt is a command line flag for specifying the attempt number of
+	// the first test case that is run, when resuming a previous run.
+	attempt = flag.Int("attempt", 1, "The attempt number of the first "+
+		"test case that is run, used to retry flaky test cases")
+)
+
 // TestTaprootAssetsDaemon performs a series of integration tests amongst a
 // programmatically driven set of participants, namely a Taproot Assets daemon
 // and a universe server.
@@ -50,12 +76,70 @@ func TestTaprootAssetsDaemon(t *testing.T) {
 
 	lndHarness.SetupStandbyNodes()
 
+	// All nodes of the test network share the same chain backend, so we
+	// can take it from any of them.
+	chainBackend, err := newChainBackend(lndHarness.Alice.Cfg.BackendCfg)
+	require.NoError(t, err)
+	ht.chainBackend = chainBackend
+	t.Logf("Running against the %v chain backend", chainBackend.Kind())
+
+	// Snapshots are shared between the test cases of a run, and only
+	// removed once all of them ran.
+	defer removeSnapshots()
+
+	quarantined, err := readQuarantine(*quarantineFile)
+	require.NoError(t, err)
+
+	results, err := newResultsWriter(*resultsFile)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, results.close())
+	}()
+
+	// If we resume a previous run, we skip all test cases that ran before
+	// the one we resume from.
+	if *resumeFrom != "" {
+		idx := slices.IndexFunc(testList, func(tc *testCase) bool {
+			return tc.name == *resumeFrom
+		})
+		if idx < 0 {
+			t.Fatalf("Unable to resume from unknown test case %v",
+				*resumeFrom)
+		}
+		testList = testList[idx:]
+	}
+
 	t.Logf("Running %v integration tests", len(testList))
-	for _, testCase := range testList {
+	for idx, testCase := range testList {
+		// Only the test case we resume from was attempted before.
+		caseAttempt := 1
+		if idx == 0 {
+			caseAttempt = *attempt
+		}
+
+		if _, ok := quarantined[testCase.name]; ok {
+			t.Logf("Skipping quarantined test case %v",
+				testCase.name)
+			require.NoError(t, results.record(
+				testCase, caseAttempt, resultQuarantined, 0,
+			))
+
+			continue
+		}
+
 		logLine := fmt.Sprintf("STARTING ============ %v ============\n",
 			testCase.name)
 
+		var (
+			ran, skipped bool
+			startTime    = time.Now()
+		)
 		success := t.Run(testCase.name, func(t1 *testing.T) {
+			ran = true
+			defer func() {
+				skipped = t1.Skipped()
+			}()
+
 			// The universe server and tapd client are both freshly
 			// created and later discarded for each test run to
 			// assure no state is taken over between runs.
@@ -84,6 +168,24 @@ func TestTaprootAssetsDaemon(t *testing.T) {
 			require.NoError(t1, err)
 		})
 
+		// Test cases that are filtered out by the test.run flag are not
+		// run at all, so there's nothing to record.
+		if ran {
+			result := resultPassed
+			switch {
+			case !success:
+				result = resultFailed
+
+			case skipped:
+				result = resultSkipped
+			}
+
+			require.NoError(t, results.record(
+				testCase, caseAttempt, result,
+				time.Since(startTime),
+			))
+		}
+
 		// Stop at the first failure. Mimic behavior of original test
 		// framework.
 		if !success {
@@ -0,0 +1,144 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/itest"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// addrGenTestCase is the test case label used for the address
+	// generation test.
+	addrGenTestCase = "addrgen"
+)
+
+// addrGenTest generates a large number of addresses on the receiving node and
+// records the latency of every address creation. Once the address book is
+// filled, it sends to one of the new addresses to measure how long it takes
+// the receiving node to detect and complete the inbound transfer when it has to
+// watch all those addresses.
+func addrGenTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+	pool := initNodePool(t, ctx, cfg, alice, bob)
+
+	send, receive, ok := pool.pickSendPair(
+		t, ctx, cfg.NumAssets, cfg.SendType,
+	)
+	if !ok {
+		t.Fatalf("No node has enough balance to send %d assets of "+
+			"type %v", cfg.NumAssets, cfg.SendType)
+	}
+	sendAsset := send.assetIDWithBalance(
+		t, ctx, cfg.NumAssets, cfg.SendType,
+	)
+	assetID := sendAsset.AssetGenesis.AssetId
+
+	t.Logf("Generating %d address(es) on %v using %d worker(s)",
+		cfg.AddrGenNumAddrs, receive.cfg.Name, cfg.AddrGenConcurrency)
+
+	var (
+		addrsMtx sync.Mutex
+		addrs    []*taprpc.Addr
+		genStart = time.Now()
+	)
+	t.Run("workers", func(t *testing.T) {
+		concurrency := cfg.AddrGenConcurrency
+		for worker := 0; worker < concurrency; worker++ {
+			// Distribute the addresses as evenly as possible over
+			// all workers.
+			numAddrs := cfg.AddrGenNumAddrs / concurrency
+			if worker < cfg.AddrGenNumAddrs%concurrency {
+				numAddrs++
+			}
+
+			name := fmt.Sprintf("worker-%d", worker)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				newAddrs := generateAddrs(
+					t, ctx, receive, assetID,
+					cfg.NumAssets, numAddrs,
+				)
+
+				addrsMtx.Lock()
+				addrs = append(addrs, newAddrs...)
+				addrsMtx.Unlock()
+			})
+		}
+	})
+	genDuration := time.Since(genStart)
+
+	t.Logf("Generated %d address(es) in %v (%.2f addresses per second)",
+		len(addrs), genDuration,
+		float64(len(addrs))/genDuration.Seconds())
+
+	// Listing the whole address book gives an indication of how the
+	// address book queries scale with its size.
+	listStart := time.Now()
+	_, err := receive.QueryAddrs(ctx, &taprpc.QueryAddrRequest{})
+	listDuration := time.Since(listStart)
+	if err != nil {
+		recordFailure(addrGenTestCase, opAddrList, listDuration, err)
+	}
+	require.NoError(t, err)
+	observeLatency(addrGenTestCase, opAddrList, listDuration)
+
+	// Finally, we send to one of the new addresses and measure how long it
+	// takes the receiver to process the inbound transfer.
+	addr := addrs[loadRand.Intn(len(addrs))]
+
+	sendStart := time.Now()
+	sendResp, err := send.SendAsset(ctx, &taprpc.SendAssetRequest{
+		TapAddrs: []string{addr.Encoded},
+	})
+	require.NoError(t, err)
+
+	itest.AssertAddrEvent(t, receive, addr, 1, statusDetected)
+	observeLatency(addrGenTestCase, opAddrDetect, time.Since(sendStart))
+
+	anchorTxHash, err := chainhash.NewHash(sendResp.Transfer.AnchorTxHash)
+	require.NoError(t, err)
+
+	miner := newBlockMiner(bitcoinClient, cfg.Chaos)
+	miner.confirmTx(t, anchorTxHash)
+
+	confirmTime := time.Now()
+	itest.AssertAddrEvent(t, receive, addr, 1, statusCompleted)
+	observeLatency(addrGenTestCase, opProofImport, time.Since(confirmTime))
+
+	t.Logf("Inbound transfer to one of %d address(es) completed after %v",
+		len(addrs), time.Since(sendStart))
+}
+
+// generateAddrs creates the given number of addresses for the given asset on
+// the receiving node and records the latency of each creation.
+func generateAddrs(t *testing.T, ctx context.Context, receive *rpcClient,
+	assetID []byte, amount uint64, numAddrs int) []*taprpc.Addr {
+
+	addrs := make([]*taprpc.Addr, 0, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		startTime := time.Now()
+		addr, err := receive.NewAddr(ctx, &taprpc.NewAddrRequest{
+			AssetId: assetID,
+			Amt:     amount,
+		})
+		latency := time.Since(startTime)
+		if err != nil {
+			recordFailure(addrGenTestCase, opAddrNew, latency, err)
+		}
+		require.NoError(t, err)
+		observeLatency(addrGenTestCase, opAddrNew, latency)
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs
+}
@@ -0,0 +1,112 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	taprootassets "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// burnTest checks that we are able to repeatedly burn units of a normal asset
+// and records how long each individual burn takes.
+func burnTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, _, bitcoinClient := initClients(t, ctx, cfg)
+
+	// We can't burn all units of an asset output in one go, so we'll make
+	// sure at least one more unit than we are going to burn in total is
+	// available.
+	totalBurnAmt := uint64(cfg.NumBurns) * cfg.BurnAmount
+	burnAsset := alice.assetIDWithBalance(
+		t, ctx, totalBurnAmt+1, taprpc.AssetType_NORMAL,
+	)
+	if burnAsset == nil {
+		burnAsset = mintNormalAsset(
+			t, cfg, alice, bitcoinClient, "burn", 2*totalBurnAmt,
+		)
+	}
+	assetID := burnAsset.AssetGenesis.AssetId
+
+	t.Logf("Running burn test, burning %d unit(s) of asset %x %d times",
+		cfg.BurnAmount, assetID, cfg.NumBurns)
+
+	var totalDuration time.Duration
+	miner := newBlockMiner(bitcoinClient, cfg.Chaos)
+	burnPacer := newPacer(cfg.LoadProfile)
+	for i := 1; i <= cfg.NumBurns; i++ {
+		require.NoError(t, burnPacer.wait(ctx))
+
+		burnDuration := burnAssets(
+			t, ctx, "burn", cfg.BurnAmount, assetID, alice, miner,
+		)
+		totalDuration += burnDuration
+		observeLatency("burn", opBurn, burnDuration)
+
+		t.Logf("Finished %d of %d burn operations, burn took %v", i,
+			cfg.NumBurns, burnDuration)
+	}
+
+	t.Logf("Burned %d unit(s) in %d burn operations, average burn "+
+		"duration %v", totalBurnAmt, cfg.NumBurns,
+		totalDuration/time.Duration(cfg.NumBurns))
+}
+
+// burnAssets burns the given amount of units of the asset with the given ID,
+// confirms the burn transaction and waits for the burn proof to be updated
+// with the confirmation. The total time it took for the burn to complete is
+// returned.
+func burnAssets(t *testing.T, ctx context.Context, testCase string,
+	amount uint64, assetID []byte, burner *rpcClient,
+	miner *blockMiner) time.Duration {
+
+	startTime := time.Now()
+
+	burnResp, err := burner.BurnAsset(ctx, &taprpc.BurnAssetRequest{
+		Asset: &taprpc.BurnAssetRequest_AssetId{
+			AssetId: assetID,
+		},
+		AmountToBurn:     amount,
+		ConfirmationText: taprootassets.AssetBurnConfirmationText,
+	})
+	if err != nil {
+		recordFailure(testCase, opBurn, time.Since(startTime), err)
+	}
+	require.NoError(t, err)
+
+	// Mine a block to confirm the burn. Other workers might be waiting for
+	// their transactions to confirm at the same time, so we go through the
+	// shared miner.
+	burnTxHash, err := chainhash.NewHash(
+		burnResp.BurnTransfer.AnchorTxHash,
+	)
+	require.NoError(t, err)
+	blockHash := miner.confirmTx(t, burnTxHash).String()
+
+	// The burn is only complete once the proof of the burned asset has been
+	// updated with the block that confirmed it.
+	burnedScriptKey := burnResp.BurnProof.Asset.ScriptKey
+	require.Eventually(t, func() bool {
+		resp, err := burner.ListAssets(ctx, &taprpc.ListAssetRequest{
+			IncludeSpent: true,
+		})
+		require.NoError(t, err)
+
+		for _, a := range resp.Assets {
+			if !bytes.Equal(a.ScriptKey, burnedScriptKey) {
+				continue
+			}
+
+			return a.ChainAnchor.AnchorBlockHash == blockHash
+		}
+
+		return false
+	}, defaultTimeout, wait.PollInterval)
+
+	return time.Since(startTime)
+}
@@ -0,0 +1,230 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// chaosTestCase is the test case label used for all injected failures.
+	chaosTestCase = "chaos"
+
+	// opChaosRestart is the operation label used for restarting a node
+	// under test.
+	opChaosRestart = "chaos_restart"
+
+	// opChaosCourierOutage is the operation label used for a simulated
+	// outage of the proof courier.
+	opChaosCourierOutage = "chaos_courier_outage"
+)
+
+// ChaosConfig defines the failures that are randomly injected while a test
+// case is running. Because the nodes under test are not managed by the load
+// test itself, node restarts and courier outages are triggered through shell
+// commands that are specific to the deployment (e.g. docker or systemd).
+type ChaosConfig struct {
+	Enabled bool `long:"enabled" description:"randomly inject failures while the test cases are running"`
+
+	Interval time.Duration `long:"interval" description:"the average time between two injected failures"`
+
+	RestartCmds []string `long:"restart-cmd" description:"a shell command that restarts one of the tapd or lnd nodes under test, can be specified multiple times"`
+
+	CourierDisconnectCmd string `long:"courier-disconnect-cmd" description:"a shell command that cuts the connection between the nodes and the proof courier"`
+
+	CourierReconnectCmd string `long:"courier-reconnect-cmd" description:"a shell command that restores the connection between the nodes and the proof courier"`
+
+	CourierOutage time.Duration `long:"courier-outage" description:"the time the proof courier stays disconnected"`
+
+	MaxBlockDelay time.Duration `long:"max-block-delay" description:"the maximum random delay added before a block is mined"`
+}
+
+// validate makes sure the chaos configuration is consistent.
+func (c *ChaosConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("chaos interval must be positive")
+	}
+
+	hasDisconnect := c.CourierDisconnectCmd != ""
+	hasReconnect := c.CourierReconnectCmd != ""
+	if hasDisconnect != hasReconnect {
+		return fmt.Errorf("courier disconnect and reconnect commands " +
+			"must be set together")
+	}
+
+	if len(c.RestartCmds) == 0 && !hasDisconnect && c.MaxBlockDelay <= 0 {
+		return fmt.Errorf("chaos mode enabled but no failure type " +
+			"configured")
+	}
+
+	return nil
+}
+
+// blockDelay returns the random delay that should be added before mining the
+// next block.
+func (c *ChaosConfig) blockDelay() time.Duration {
+	if !c.Enabled || c.MaxBlockDelay <= 0 {
+		return 0
+	}
+
+	return time.Duration(loadRand.Int63n(int64(c.MaxBlockDelay)))
+}
+
+// chaosMonkey randomly injects the configured failures until it is stopped.
+type chaosMonkey struct {
+	cfg *ChaosConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newChaosMonkey creates a new chaos monkey for the given configuration.
+func newChaosMonkey(cfg *ChaosConfig) *chaosMonkey {
+	return &chaosMonkey{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// start starts injecting failures in the background.
+func (m *chaosMonkey) start(t *testing.T, ctx context.Context) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		for {
+			// We use an exponentially distributed delay, so events
+			// don't happen in a predictable rhythm.
+			delay := time.Duration(
+				loadRand.ExpFloat64() * float64(m.cfg.Interval),
+			)
+
+			select {
+			case <-time.After(delay):
+				m.injectFailure(t, ctx)
+
+			case <-ctx.Done():
+				return
+
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops injecting failures and waits for a currently running failure to
+// be resolved.
+func (m *chaosMonkey) stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// injectFailure picks one of the configured failure types at random and
+// injects it. Block delays are not injected here, they are applied by the
+// block miner directly.
+func (m *chaosMonkey) injectFailure(t *testing.T, ctx context.Context) {
+	var failures []func(*testing.T, context.Context)
+	for _, cmd := range m.cfg.RestartCmds {
+		cmd := cmd
+		failures = append(failures, func(t *testing.T,
+			ctx context.Context) {
+
+			m.restartNode(t, ctx, cmd)
+		})
+	}
+	if m.cfg.CourierDisconnectCmd != "" {
+		failures = append(failures, m.courierOutage)
+	}
+
+	if len(failures) == 0 {
+		return
+	}
+
+	failures[loadRand.Intn(len(failures))](t, ctx)
+}
+
+// restartNode restarts a node by running the given restart command.
+func (m *chaosMonkey) restartNode(t *testing.T, ctx context.Context,
+	cmd string) {
+
+	t.Logf("Chaos: restarting node using '%s'", cmd)
+
+	startTime := time.Now()
+	err := runShellCmd(ctx, cmd)
+	if err != nil {
+		t.Logf("Chaos: unable to restart node: %v", err)
+		recordFailure(
+			chaosTestCase, opChaosRestart, time.Since(startTime),
+			err,
+		)
+
+		return
+	}
+
+	observeLatency(chaosTestCase, opChaosRestart, time.Since(startTime))
+}
+
+// courierOutage disconnects the proof courier for the configured outage
+// duration and then restores the connection.
+func (m *chaosMonkey) courierOutage(t *testing.T, ctx context.Context) {
+	t.Logf("Chaos: disconnecting proof courier for %v",
+		m.cfg.CourierOutage)
+
+	startTime := time.Now()
+	err := runShellCmd(ctx, m.cfg.CourierDisconnectCmd)
+	if err != nil {
+		t.Logf("Chaos: unable to disconnect courier: %v", err)
+		recordFailure(
+			chaosTestCase, opChaosCourierOutage,
+			time.Since(startTime), err,
+		)
+
+		return
+	}
+
+	select {
+	case <-time.After(m.cfg.CourierOutage):
+	case <-ctx.Done():
+	case <-m.quit:
+	}
+
+	// We always attempt to reconnect, even if we're shutting down, as we
+	// otherwise leave the environment in a broken state. So we can't use
+	// the potentially canceled context here.
+	err = runShellCmd(context.Background(), m.cfg.CourierReconnectCmd)
+	if err != nil {
+		t.Logf("Chaos: unable to reconnect courier: %v", err)
+		recordFailure(
+			chaosTestCase, opChaosCourierOutage,
+			time.Since(startTime), err,
+		)
+
+		return
+	}
+
+	t.Logf("Chaos: proof courier reconnected")
+	observeLatency(
+		chaosTestCase, opChaosCourierOutage, time.Since(startTime),
+	)
+}
+
+// runShellCmd runs the given command through the shell and returns an error
+// including the command's output if it fails.
+func runShellCmd(ctx context.Context, cmd string) error {
+	shellCmd := exec.CommandContext(ctx, "sh", "-c", cmd)
+	output, err := shellCmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("command '%s' failed: %w (output: %s)", cmd,
+			err, output)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/lightninglabs/taproot-assets/itest/loadtest"
+)
+
+// main runs the load test traffic generator as a long-lived process until it
+// is interrupted. It uses the same loadtest.conf as the load test binary.
+func main() {
+	cfg, err := loadtest.LoadConfig()
+	if err != nil {
+		log.Fatalf("Unable to load config: %v", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(
+		context.Background(), os.Interrupt, syscall.SIGTERM,
+	)
+	err = loadtest.RunDaemon(ctx, cfg, log.Printf)
+	cancel()
+
+	if err != nil {
+		log.Fatalf("Traffic generator failed: %v", err)
+	}
+}
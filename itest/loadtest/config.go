@@ -1,9 +1,11 @@
 package loadtest
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/jessevdk/go-flags"
+	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 )
 
@@ -16,6 +18,32 @@ const (
 
 	// defaultTestTimeout is the default timeout for each test.
 	defaultTestTimeout = 10 * time.Minute
+
+	// defaultSoakPushInterval is the default interval in which rolling
+	// metrics are pushed while running in soak mode.
+	defaultSoakPushInterval = time.Minute
+
+	// defaultResourceScrapeInterval is the default interval in which the
+	// resource usage of the nodes under test is scraped.
+	defaultResourceScrapeInterval = 15 * time.Second
+
+	// networkRegtest is the regtest network, on which the load test mines
+	// its own blocks.
+	networkRegtest = "regtest"
+
+	// networkSignet is the signet network, on which the load test waits
+	// for the blocks of the signet miner instead.
+	networkSignet = "signet"
+
+	// backendBitcoind is a bitcoind bitcoin backend.
+	backendBitcoind = "bitcoind"
+
+	// backendBtcd is a btcd bitcoin backend.
+	backendBtcd = "btcd"
+
+	// defaultBlockTimeout is the default maximum time to wait for a new
+	// block on signet.
+	defaultBlockTimeout = 30 * time.Minute
 )
 
 // User defines the config options for a user in the network.
@@ -32,6 +60,13 @@ type TapConfig struct {
 
 	TLSPath string `long:"tlspath" description:"Path to tapd's TLS certificate, leave empty if TLS is disabled"`
 	MacPath string `long:"macpath" description:"Path to tapd's macaroon file"`
+
+	MetricsURL string `long:"metricsurl" description:"URL of a Prometheus metrics endpoint that exposes the process metrics of tapd, either tapd's own (prometheus.active) or the one of a sidecar exporter"`
+	ProfileURL string `long:"profileurl" description:"base URL of tapd's pprof server (--profile), used to scrape goroutine count and memory usage if no metrics URL is set"`
+
+	DBPath      string `long:"dbpath" description:"path to tapd's sqlite database file, used to sample the database size; the file must be accessible from where the load test runs"`
+	PostgresDSN string `long:"postgresdsn" description:"DSN of tapd's Postgres database, used to sample the size of all tables; takes precedence over dbpath"`
+	DBBackend   string `long:"dbbackend" description:"the database backend of tapd, used to label the metrics; derived from postgresdsn or dbpath if not set" choice:"sqlite" choice:"postgres"`
 }
 
 // BitcoinConfig defines exported config options for the connection to the
@@ -42,6 +77,38 @@ type BitcoinConfig struct {
 	User     string `long:"user" description:"bitcoind/btcd user name"`
 	Password string `long:"password" description:"bitcoind/btcd password"`
 	TLSPath  string `long:"tlspath" description:"Path to btcd's TLS certificate, if TLS is enabled"`
+
+	Backend string `long:"backend" description:"the implementation of the bitcoin backend, checked on startup; the re-org test requires bitcoind" choice:"bitcoind" choice:"btcd"`
+
+	Network string `long:"network" description:"the network of the bitcoin backend; on signet, blocks can't be mined on demand, so the tests wait for the blocks of the signet miner, which should mine blocks quickly (e.g. a custom signet) to keep the tests fast" choice:"regtest" choice:"signet"`
+
+	BlockTimeout time.Duration `long:"block-timeout" description:"the maximum time to wait for a new block that confirms the transactions of a test on signet"`
+}
+
+// validate makes sure the bitcoin backend configuration is consistent.
+func (c *BitcoinConfig) validate() error {
+	if c.Network == networkSignet && c.BlockTimeout <= 0 {
+		return fmt.Errorf("block timeout must be positive on signet")
+	}
+
+	return nil
+}
+
+// PrometheusGatewayConfig defines the config options for pushing the load
+// test metrics to a Prometheus push gateway.
+type PrometheusGatewayConfig struct {
+	Enabled bool   `long:"enabled" description:"Enable pushing metrics to Prometheus PushGateway"`
+	Host    string `long:"host" description:"Prometheus PushGateway host address"`
+	Port    int    `long:"port" description:"Prometheus PushGateway port"`
+}
+
+// PrometheusServerConfig defines the config options for serving the load test
+// metrics on a local endpoint that Prometheus can scrape, as an alternative to
+// pushing them to a push gateway.
+type PrometheusServerConfig struct {
+	Enabled    bool          `long:"enabled" description:"Serve the metrics on a /metrics endpoint for the duration of the run"`
+	ListenAddr string        `long:"listenaddr" description:"the interface and port the metrics endpoint listens on"`
+	Linger     time.Duration `long:"linger" description:"the time the metrics endpoint stays up after the run completed, so Prometheus can scrape the final values"`
 }
 
 // Config holds the main configuration for the performance testing binary.
@@ -56,13 +123,49 @@ type Config struct {
 	// Bob is the configuration for the secondary user in the network.
 	Bob *User `group:"bob" namespace:"bob" description:"bob related configuration"`
 
+	// Nodes is the list of additional tapd nodes of the network topology.
+	// Alice and Bob are always part of the topology.
+	Nodes []*NodeSpec `long:"node" description:"an additional tapd node of the topology in the form name=<name>,host=<host>,port=<port>,tlspath=<path>,macpath=<path>,roles=<role>+<role>[,metricsurl=<url>][,profileurl=<url>]; roles are sender, receiver and universe; can be specified multiple times"`
+
 	// Bitcoin is the configuration for the bitcoin backend.
 	Bitcoin *BitcoinConfig `group:"bitcoin" namespace:"bitcoin" long:"bitcoin" description:"bitcoin client configuration"`
 
+	// PrometheusGateway is the configuration for the Prometheus
+	// PushGateway.
+	PrometheusGateway *PrometheusGatewayConfig `group:"prometheus-gateway" namespace:"prometheus-gateway" description:"prometheus gateway configuration"`
+
+	// PrometheusServer is the configuration for serving the metrics on a
+	// local scrape endpoint.
+	PrometheusServer *PrometheusServerConfig `group:"prometheus-server" namespace:"prometheus-server" description:"prometheus scrape endpoint configuration"`
+
+	// LoadProfile is the profile that controls the rate at which the
+	// send and burn operations are issued over the course of a test case.
+	LoadProfile *LoadProfileConfig `group:"load-profile" namespace:"load-profile" description:"load profile configuration"`
+
+	// Chaos is the configuration for randomly injecting failures while
+	// the test cases are running.
+	Chaos *ChaosConfig `group:"chaos" namespace:"chaos" description:"chaos injection configuration"`
+
+	// Client is the configuration of the client connections to the nodes
+	// under test.
+	Client *ClientConfig `group:"client" namespace:"client" description:"client connection configuration"`
+
+	// Hooks are the commands that are run before and after every test
+	// case, for example to start it against a fresh state.
+	Hooks *HookConfig `group:"hooks" namespace:"hooks" description:"per test case setup and teardown configuration"`
+
+	// Daemon is the configuration of the traffic the traffic generator
+	// daemon creates. It is not used by the load test cases.
+	Daemon *DaemonConfig `group:"daemon" namespace:"daemon" description:"traffic generator daemon configuration"`
+
 	// BatchSize is the number of assets to mint in a single batch. This is
 	// only relevant for the mint test.
 	BatchSize int `long:"mint-test-batch-size" description:"the number of assets to mint in a single batch; only relevant for the mint test"`
 
+	// MintSweepBatchSizes is the list of batch sizes to mint in sequence.
+	// This is only relevant for the mint sweep test.
+	MintSweepBatchSizes []int `long:"mint-sweep-test-batch-size" description:"a batch size to mint, can be specified multiple times; only relevant for the mint sweep test"`
+
 	// NumSends is the number of asset sends to perform. This is only
 	// relevant for the send test.
 	NumSends int `long:"send-test-num-sends" description:"the number of send operations to perform; only relevant for the send test"`
@@ -75,6 +178,208 @@ type Config struct {
 	// relevant for the send test.
 	SendType taprpc.AssetType `long:"send-test-send-type" description:"the type of asset to attempt to send; only relevant for the send test"`
 
+	// SendConcurrency is the number of workers that concurrently perform
+	// send operations. The total number of sends is distributed over all
+	// workers. This is only relevant for the send test.
+	SendConcurrency int `long:"send-test-concurrency" description:"the number of workers that concurrently perform send operations; only relevant for the send test"`
+
+	// MultiSendNumSends is the number of multi destination send
+	// operations to perform. This is only relevant for the multi send
+	// test.
+	MultiSendNumSends int `long:"multi-send-test-num-sends" description:"the number of multi destination send operations to perform; only relevant for the multi send test"`
+
+	// MultiSendFanOut is the number of addresses each multi destination
+	// send operation pays to within a single anchor transaction. This is
+	// only relevant for the multi send test.
+	MultiSendFanOut int `long:"multi-send-test-fan-out" description:"the number of addresses to send to in each multi destination send operation; only relevant for the multi send test"`
+
+	// MultiSendNumAssets is the number of assets to send to each address
+	// in a multi destination send operation. This is only relevant for the
+	// multi send test.
+	MultiSendNumAssets uint64 `long:"multi-send-test-num-assets" description:"the number of assets to send to each address in a multi destination send operation; only relevant for the multi send test"`
+
+	// SyncNumAssets is the number of assets to mint before running a full
+	// universe sync. This is only relevant for the sync test.
+	SyncNumAssets int `long:"sync-test-num-assets" description:"the number of assets to mint before running a full universe sync; only relevant for the sync test"`
+
+	// SyncIncrementalNumAssets is the number of assets to mint before
+	// running an incremental universe sync. This is only relevant for the
+	// sync test.
+	SyncIncrementalNumAssets int `long:"sync-test-incremental-num-assets" description:"the number of assets to mint before running an incremental universe sync; only relevant for the sync test"`
+
+	// NumBurns is the number of asset burns to perform. This is only
+	// relevant for the burn test.
+	NumBurns int `long:"burn-test-num-burns" description:"the number of burn operations to perform; only relevant for the burn test"`
+
+	// BurnAmount is the number of asset units to burn in each burn
+	// operation. This is only relevant for the burn test.
+	BurnAmount uint64 `long:"burn-test-burn-amount" description:"the number of asset units to burn in each burn operation; only relevant for the burn test"`
+
+	// GroupMintNumReissuances is the number of batches that are re-issued
+	// into the same asset group.
+	GroupMintNumReissuances int `long:"group-mint-test-num-reissuances" description:"the number of batches to re-issue into the same asset group; only relevant for the group mint test"`
+
+	// GroupMintBatchSize is the number of assets in every re-issued batch.
+	GroupMintBatchSize int `long:"group-mint-test-batch-size" description:"the number of assets in every re-issued batch; only relevant for the group mint test"`
+
+	// GroupMintAmount is the number of units of every grouped asset
+	// minted.
+	GroupMintAmount uint64 `long:"group-mint-test-amount" description:"the number of units of every grouped asset minted; only relevant for the group mint test"`
+
+	// CourierAddrs are the addresses of the proof couriers to stress. The
+	// courier test is skipped if this is empty.
+	CourierAddrs []string `long:"courier-test-addr" description:"the address of a proof courier to stress, e.g. hashmail://host:port or universerpc://host:port; can be specified multiple times; only relevant for the courier test"`
+
+	// CourierNumProofs is the number of proofs to deliver through each
+	// proof courier.
+	CourierNumProofs int `long:"courier-test-num-proofs" description:"the number of proofs to deliver through each courier; only relevant for the courier test"`
+
+	// CourierConcurrency is the number of workers that deliver proofs
+	// through a courier in parallel.
+	CourierConcurrency int `long:"courier-test-concurrency" description:"the number of workers delivering proofs in parallel; only relevant for the courier test"`
+
+	// CourierAckTimeout is the maximum time a hashmail courier waits for
+	// the receiver to acknowledge a proof.
+	CourierAckTimeout time.Duration `long:"courier-test-ack-timeout" description:"the maximum time to wait for the receiver to acknowledge a proof; only relevant for the courier test"`
+
+	// AddrGenNumAddrs is the number of addresses generated by the address
+	// generation test.
+	AddrGenNumAddrs int `long:"addr-gen-test-num-addrs" description:"the number of addresses to generate; only relevant for the address generation test"`
+
+	// AddrGenConcurrency is the number of workers that generate addresses
+	// in parallel.
+	AddrGenConcurrency int `long:"addr-gen-test-concurrency" description:"the number of workers generating addresses in parallel; only relevant for the address generation test"`
+
+	// MixedNumOps is the total number of operations the mixed workload
+	// test performs.
+	MixedNumOps int `long:"mixed-test-num-ops" description:"the total number of mint, send and burn operations to perform; only relevant for the mixed test"`
+
+	// MixedConcurrency is the number of workers that perform operations
+	// of the mixed workload test in parallel.
+	MixedConcurrency int `long:"mixed-test-concurrency" description:"the number of workers performing operations in parallel; only relevant for the mixed test"`
+
+	// MixedMintWeight is the relative weight of mint operations in the
+	// mixed workload.
+	MixedMintWeight int `long:"mixed-test-mint-weight" description:"the relative weight of mint operations, e.g. 10 for 10% if all weights add up to 100; only relevant for the mixed test"`
+
+	// MixedSendWeight is the relative weight of send operations in the
+	// mixed workload.
+	MixedSendWeight int `long:"mixed-test-send-weight" description:"the relative weight of send operations; only relevant for the mixed test"`
+
+	// MixedBurnWeight is the relative weight of burn operations in the
+	// mixed workload.
+	MixedBurnWeight int `long:"mixed-test-burn-weight" description:"the relative weight of burn operations; only relevant for the mixed test"`
+
+	// MixedMintAmount is the number of units of every asset minted by
+	// the mixed workload test.
+	MixedMintAmount uint64 `long:"mixed-test-mint-amount" description:"the number of units of every asset minted; only relevant for the mixed test"`
+
+	// MixedAmount is the number of units sent or burned in every send or
+	// burn operation of the mixed workload test.
+	MixedAmount uint64 `long:"mixed-test-amount" description:"the number of units sent or burned in every send or burn operation; only relevant for the mixed test"`
+
+	// ReorgNumReorgs is the number of re-orgs the re-org test performs.
+	ReorgNumReorgs int `long:"reorg-test-num-reorgs" description:"the number of re-orgs to perform; only relevant for the re-org test"`
+
+	// ReorgDepth is the number of blocks that are re-organized out in
+	// every re-org. This must be smaller than the re-org safe depth of the
+	// nodes under test, otherwise they stop watching the anchors before
+	// the re-org happens.
+	ReorgDepth int `long:"reorg-test-depth" description:"the number of blocks to re-organize out in every re-org; must be smaller than the reorgsafedepth of the nodes; only relevant for the re-org test"`
+
+	// ReorgSendAmount is the number of asset units sent from alice to bob
+	// before every re-org.
+	ReorgSendAmount uint64 `long:"reorg-test-send-amount" description:"the number of asset units to send before every re-org; only relevant for the re-org test"`
+
+	// FederationNumRounds is the number of rounds in which every issuer
+	// mints a batch of assets for the federation test.
+	FederationNumRounds int `long:"federation-test-num-rounds" description:"the number of rounds in which every issuer mints a batch of assets; only relevant for the federation test"`
+
+	// FederationBatchSize is the number of assets every issuer mints in
+	// each round of the federation test.
+	FederationBatchSize int `long:"federation-test-batch-size" description:"the number of assets every issuer mints in each round; only relevant for the federation test"`
+
+	// InterferenceNumOps is the number of mints and sends the interference
+	// test performs in isolation, as well as the number of sends it
+	// performs while minting concurrently.
+	InterferenceNumOps int `long:"interference-test-num-ops" description:"the number of mints and sends to perform in isolation and the number of sends to perform while minting concurrently; only relevant for the interference test"`
+
+	// InterferenceAmount is the number of asset units sent in every send
+	// of the interference test.
+	InterferenceAmount uint64 `long:"interference-test-amount" description:"the number of asset units to send in every send; only relevant for the interference test"`
+
+	// FeeBumpNumTransfers is the number of transfers whose fee the fee
+	// bump test bumps.
+	FeeBumpNumTransfers int `long:"fee-bump-test-num-transfers" description:"the number of transfers to bump the fee of; only relevant for the fee bump test"`
+
+	// FeeBumpNumBumps is the number of times the fee of every transfer is
+	// bumped before it is confirmed.
+	FeeBumpNumBumps int `long:"fee-bump-test-num-bumps" description:"the number of times to bump the fee of every transfer; only relevant for the fee bump test"`
+
+	// FeeBumpFeeRateStep is the fee rate in sat/vB every fee bump adds on
+	// top of the fee rate of the anchor transaction it replaces.
+	FeeBumpFeeRateStep uint64 `long:"fee-bump-test-fee-rate-step" description:"the fee rate in sat/vB to add with every fee bump; only relevant for the fee bump test"`
+
+	// FeeBumpAmount is the number of asset units sent in every transfer of
+	// the fee bump test.
+	FeeBumpAmount uint64 `long:"fee-bump-test-amount" description:"the number of asset units to send in every transfer; only relevant for the fee bump test"`
+
+	// ResultsFile is the path of the file the results of every single
+	// operation are written to at the end of the run. No file is written
+	// if this is empty.
+	ResultsFile string `long:"results-file" description:"if set, the results of every operation are written to this file at the end of the run"`
+
+	// ReportFile is the path of the standalone HTML report that is
+	// written at the end of the run. No report is written if this is
+	// empty.
+	ReportFile string `long:"report-file" description:"if set, a standalone HTML report with latency charts, errors and the config of the run is written to this file at the end of the run"`
+
+	// ResultsFormat is the format of the results file.
+	ResultsFormat string `long:"results-format" description:"the format of the results file" choice:"json" choice:"csv"`
+
+	// SLOs are the service level objectives the results of the run must
+	// meet. The run fails if any of them is violated.
+	SLOs []*SLO `long:"slo" description:"a service level objective in the form [<test_case>.[<operation>.]]<threshold>=<value> with the thresholds p50_max, p95_max, p99_max (durations) and max_error_rate (fraction), e.g. send.p95_max=5s; can be specified multiple times"`
+
+	// MatrixAlice are the alice nodes of the additional database backends
+	// of the backend matrix. If set, every configured test case is run
+	// against the main alice and bob nodes first and then against the
+	// alice and bob nodes of every additional backend.
+	MatrixAlice []*NodeSpec `long:"matrix-alice" description:"the alice node of an additional database backend to run all test cases against, in the same form as node; the backend is taken from dbbackend, postgresdsn or dbpath; can be specified multiple times"`
+
+	// MatrixBob are the bob nodes of the additional database backends of
+	// the backend matrix. There must be exactly one for every backend of
+	// the alice nodes.
+	MatrixBob []*NodeSpec `long:"matrix-bob" description:"the bob node of an additional database backend, in the same form as node; can be specified multiple times"`
+
+	// Overrides are the per test case overrides of the other options,
+	// which allow a single config file to define a whole benchmark matrix.
+	Overrides []*ConfigOverride `long:"override" description:"a per test case override of another option in the form <test_case>:<option>=<value>, e.g. send:send-test-num-sends=50 or mint:load-profile.type=constant; only top level, load-profile, chaos and hooks options can be overridden; can be specified multiple times"`
+
+	// Seed is the seed all random choices of the load tests are derived
+	// from. If it is zero, a seed is derived from the current time. The
+	// seed in use is logged at startup, so a run can be replayed.
+	Seed int64 `long:"seed" description:"the seed for all random choices of the load tests, a random seed is used if not set"`
+
+	// WarmupDuration is the duration at the start of every test case
+	// during which operations are executed but excluded from the recorded
+	// metrics and results.
+	WarmupDuration time.Duration `long:"warmup-duration" description:"the duration at the start of every test case during which operations are executed but not recorded"`
+
+	// SoakDuration is the wall clock duration for which the configured
+	// test cases are executed in a loop. If this is zero, every test case
+	// is only executed once.
+	SoakDuration time.Duration `long:"soak-duration" description:"if set, the configured test cases are run in a loop until this duration has elapsed"`
+
+	// SoakPushInterval is the interval in which the rolling metrics are
+	// pushed to the Prometheus push gateway while running in soak mode.
+	SoakPushInterval time.Duration `long:"soak-push-interval" description:"the interval in which rolling metrics are pushed while running in soak mode"`
+
+	// ResourceScrapeInterval is the interval in which the resource usage
+	// of all nodes with a metrics or profile URL is scraped while a test
+	// case is running.
+	ResourceScrapeInterval time.Duration `long:"resource-scrape-interval" description:"the interval in which the resource usage of the nodes under test is scraped"`
+
 	// TestSuiteTimeout is the timeout for the entire test suite.
 	TestSuiteTimeout time.Duration `long:"test-suite-timeout" description:"the timeout for the entire test suite"`
 
@@ -96,12 +401,83 @@ func DefaultConfig() Config {
 				Name: "bob",
 			},
 		},
-		BatchSize:        100,
-		NumSends:         50,
-		NumAssets:        1, // We only mint collectibles.
-		SendType:         taprpc.AssetType_COLLECTIBLE,
-		TestSuiteTimeout: defaultSuiteTimeout,
-		TestTimeout:      defaultTestTimeout,
+		Bitcoin: &BitcoinConfig{
+			Backend:      backendBitcoind,
+			Network:      networkRegtest,
+			BlockTimeout: defaultBlockTimeout,
+		},
+		PrometheusGateway: &PrometheusGatewayConfig{
+			Enabled: false,
+			Host:    "localhost",
+			Port:    9091,
+		},
+		PrometheusServer: &PrometheusServerConfig{
+			Enabled:    false,
+			ListenAddr: "localhost:9092",
+		},
+		LoadProfile: &LoadProfileConfig{
+			Type:    profileNone,
+			Arrival: arrivalConstant,
+		},
+		Chaos: &ChaosConfig{},
+		Client: &ClientConfig{
+			KeepaliveTime:    defaultKeepaliveTime,
+			KeepaliveTimeout: defaultKeepaliveTimeout,
+		},
+		Hooks: &HookConfig{
+			State:        stateReuse,
+			ReadyTimeout: defaultReadyTimeout,
+		},
+		Daemon: &DaemonConfig{
+			MintBatchSize:    1,
+			MintAmount:       100_000,
+			SendAmount:       10,
+			MineBlocks:       true,
+			OperationTimeout: defaultTestTimeout,
+		},
+		BatchSize:                100,
+		NumSends:                 50,
+		NumAssets:                1, // We only mint collectibles.
+		SendType:                 taprpc.AssetType_COLLECTIBLE,
+		SendConcurrency:          1,
+		MultiSendNumSends:        10,
+		MultiSendFanOut:          10,
+		MultiSendNumAssets:       10,
+		SyncNumAssets:            100,
+		SyncIncrementalNumAssets: 10,
+		NumBurns:                 50,
+		BurnAmount:               100,
+		GroupMintNumReissuances:  20,
+		GroupMintBatchSize:       1,
+		GroupMintAmount:          1000,
+		CourierNumProofs:         1000,
+		CourierConcurrency:       10,
+		CourierAckTimeout:        30 * time.Second,
+		AddrGenNumAddrs:          10000,
+		AddrGenConcurrency:       10,
+		MixedNumOps:              100,
+		MixedConcurrency:         4,
+		MixedMintWeight:          10,
+		MixedSendWeight:          80,
+		MixedBurnWeight:          10,
+		MixedMintAmount:          100000,
+		MixedAmount:              10,
+		ReorgNumReorgs:           10,
+		ReorgDepth:               2,
+		ReorgSendAmount:          10,
+		FederationNumRounds:      10,
+		FederationBatchSize:      10,
+		InterferenceNumOps:       10,
+		InterferenceAmount:       10,
+		FeeBumpNumTransfers:      10,
+		FeeBumpNumBumps:          5,
+		FeeBumpFeeRateStep:       5,
+		FeeBumpAmount:            10,
+		ResultsFormat:            resultsFormatJSON,
+		SoakPushInterval:         defaultSoakPushInterval,
+		ResourceScrapeInterval:   defaultResourceScrapeInterval,
+		TestSuiteTimeout:         defaultSuiteTimeout,
+		TestTimeout:              defaultTestTimeout,
 	}
 }
 
@@ -137,6 +513,198 @@ func LoadConfig() (*Config, error) {
 // ValidateConfig validates the given configuration and returns a clean version
 // of it with sane defaults.
 func ValidateConfig(cfg Config) (*Config, error) {
-	// TODO (positiveblue): add validation logic.
+	// TODO (positiveblue): add more validation logic.
+	if cfg.PrometheusGateway.Enabled {
+		if cfg.PrometheusGateway.Host == "" {
+			return nil, fmt.Errorf("prometheus-gateway.host must " +
+				"be set when the push gateway is enabled")
+		}
+
+		if cfg.PrometheusGateway.Port <= 0 {
+			return nil, fmt.Errorf("prometheus-gateway.port must " +
+				"be a positive number when the push gateway " +
+				"is enabled")
+		}
+	}
+
+	if cfg.PrometheusServer.Enabled {
+		if cfg.PrometheusServer.ListenAddr == "" {
+			return nil, fmt.Errorf("prometheus-server.listenaddr " +
+				"must be set when the metrics endpoint is " +
+				"enabled")
+		}
+
+		if cfg.PrometheusServer.Linger < 0 {
+			return nil, fmt.Errorf("prometheus-server.linger " +
+				"cannot be negative")
+		}
+	}
+
+	for _, batchSize := range cfg.MintSweepBatchSizes {
+		if batchSize <= 0 {
+			return nil, fmt.Errorf("mint-sweep-test-batch-size " +
+				"must be positive")
+		}
+	}
+
+	for _, addr := range cfg.CourierAddrs {
+		_, err := proof.ParseCourierAddrString(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid courier-test-addr: %w",
+				err)
+		}
+	}
+
+	if cfg.GroupMintBatchSize <= 0 || cfg.GroupMintAmount == 0 {
+		return nil, fmt.Errorf("group-mint-test-batch-size and " +
+			"group-mint-test-amount must be positive")
+	}
+
+	if cfg.CourierNumProofs <= 0 || cfg.CourierConcurrency <= 0 {
+		return nil, fmt.Errorf("courier-test-num-proofs and " +
+			"courier-test-concurrency must be positive")
+	}
+
+	if cfg.AddrGenNumAddrs <= 0 || cfg.AddrGenConcurrency <= 0 {
+		return nil, fmt.Errorf("addr-gen-test-num-addrs and " +
+			"addr-gen-test-concurrency must be positive")
+	}
+
+	if cfg.MixedNumOps <= 0 || cfg.MixedConcurrency <= 0 {
+		return nil, fmt.Errorf("mixed-test-num-ops and " +
+			"mixed-test-concurrency must be positive")
+	}
+
+	if cfg.MixedMintWeight < 0 || cfg.MixedSendWeight < 0 ||
+		cfg.MixedBurnWeight < 0 {
+
+		return nil, fmt.Errorf("mixed test weights must not be " +
+			"negative")
+	}
+
+	if cfg.MixedMintWeight+cfg.MixedSendWeight+cfg.MixedBurnWeight == 0 {
+		return nil, fmt.Errorf("at least one mixed test weight must " +
+			"be positive")
+	}
+
+	if cfg.MixedAmount == 0 || cfg.MixedMintAmount <= cfg.MixedAmount {
+		return nil, fmt.Errorf("mixed-test-amount must be positive " +
+			"and smaller than mixed-test-mint-amount")
+	}
+
+	if cfg.ReorgNumReorgs <= 0 || cfg.ReorgDepth <= 0 ||
+		cfg.ReorgSendAmount == 0 {
+
+		return nil, fmt.Errorf("reorg-test-num-reorgs, " +
+			"reorg-test-depth and reorg-test-send-amount must be " +
+			"positive")
+	}
+
+	if cfg.FederationNumRounds <= 0 || cfg.FederationBatchSize <= 0 {
+		return nil, fmt.Errorf("federation-test-num-rounds and " +
+			"federation-test-batch-size must be positive")
+	}
+
+	if cfg.InterferenceNumOps <= 0 || cfg.InterferenceAmount == 0 {
+		return nil, fmt.Errorf("interference-test-num-ops and " +
+			"interference-test-amount must be positive")
+	}
+
+	if cfg.FeeBumpNumTransfers <= 0 || cfg.FeeBumpNumBumps <= 0 ||
+		cfg.FeeBumpFeeRateStep == 0 || cfg.FeeBumpAmount == 0 {
+
+		return nil, fmt.Errorf("fee-bump-test-num-transfers, " +
+			"fee-bump-test-num-bumps, " +
+			"fee-bump-test-fee-rate-step and " +
+			"fee-bump-test-amount must be positive")
+	}
+
+	if err := cfg.LoadProfile.validate(); err != nil {
+		return nil, fmt.Errorf("invalid load profile: %w", err)
+	}
+
+	switch cfg.ResultsFormat {
+	case resultsFormatJSON, resultsFormatCSV:
+	default:
+		return nil, fmt.Errorf("unknown results-format: %v",
+			cfg.ResultsFormat)
+	}
+
+	if cfg.Bitcoin != nil {
+		if err := cfg.Bitcoin.validate(); err != nil {
+			return nil, fmt.Errorf("invalid bitcoin config: %w",
+				err)
+		}
+	}
+
+	if err := cfg.Chaos.validate(); err != nil {
+		return nil, fmt.Errorf("invalid chaos config: %w", err)
+	}
+
+	if err := cfg.Client.validate(); err != nil {
+		return nil, fmt.Errorf("invalid client config: %w", err)
+	}
+
+	if err := cfg.Hooks.validate(); err != nil {
+		return nil, fmt.Errorf("invalid hooks config: %w", err)
+	}
+
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+
+	if cfg.WarmupDuration < 0 {
+		return nil, fmt.Errorf("warmup-duration cannot be negative")
+	}
+
+	if cfg.WarmupDuration >= cfg.TestTimeout {
+		return nil, fmt.Errorf("warmup-duration (%v) must be shorter "+
+			"than test-timeout (%v)", cfg.WarmupDuration,
+			cfg.TestTimeout)
+	}
+
+	if cfg.ResourceScrapeInterval <= 0 {
+		return nil, fmt.Errorf("resource-scrape-interval must be " +
+			"positive")
+	}
+
+	if cfg.SoakDuration < 0 {
+		return nil, fmt.Errorf("soak-duration cannot be negative")
+	}
+
+	if cfg.SoakDuration > 0 {
+		if cfg.SoakPushInterval <= 0 {
+			return nil, fmt.Errorf("soak-push-interval must be " +
+				"positive when running in soak mode")
+		}
+
+		// The suite timeout is a hard limit for the whole run, so it
+		// must leave enough room for the soak duration and the last
+		// test iteration to finish.
+		if cfg.TestSuiteTimeout < cfg.SoakDuration+cfg.TestTimeout {
+			return nil, fmt.Errorf("test-suite-timeout (%v) must "+
+				"be at least soak-duration (%v) plus "+
+				"test-timeout (%v)", cfg.TestSuiteTimeout,
+				cfg.SoakDuration, cfg.TestTimeout)
+		}
+	}
+
+	if len(cfg.MatrixAlice) > 0 && cfg.SoakDuration > 0 {
+		return nil, fmt.Errorf("the backend matrix can't be combined " +
+			"with soak mode")
+	}
+
+	if _, err := cfg.backendConfigs(); err != nil {
+		return nil, err
+	}
+
+	// Make sure the overrides result in a valid configuration for every
+	// test case they apply to.
+	for _, testCase := range cfg.overriddenTestCases() {
+		if _, err := cfg.forTestCase(testCase); err != nil {
+			return nil, err
+		}
+	}
+
 	return &cfg, nil
 }
@@ -0,0 +1,190 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// defaultKeepaliveTime is the default time after which an idle client
+	// connection is pinged. tapd uses the default server keepalive
+	// enforcement of gRPC, which closes connections that ping more often
+	// than every five minutes.
+	defaultKeepaliveTime = 5 * time.Minute
+
+	// defaultKeepaliveTimeout is the default time to wait for the
+	// response to a keepalive ping before the connection is closed.
+	defaultKeepaliveTimeout = 20 * time.Second
+)
+
+var (
+	// clientConnDials counts the client connections dialed to every node,
+	// so a high connection churn is visible next to the RPC latencies.
+	clientConnDials = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "client_conn_dials_total",
+			Help: "Number of client connections dialed to the " +
+				"node under test",
+		}, []string{"node"},
+	)
+
+	// clientConnStates counts the state changes of the client connections
+	// to every node, partitioned by the new state. Transient failures
+	// followed by reconnects show up as changes to TRANSIENT_FAILURE and
+	// CONNECTING.
+	clientConnStates = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "client_conn_state_changes_total",
+			Help: "Number of state changes of the client " +
+				"connections to the node under test",
+		}, []string{"node", "state"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(clientConnDials)
+	prometheus.MustRegister(clientConnStates)
+}
+
+// ClientConfig defines the options of the client connections to the nodes
+// under test.
+type ClientConfig struct {
+	KeepaliveTime time.Duration `long:"keepalive-time" description:"the time after which an idle connection to a node is pinged, zero disables keepalive pings; must not be below the keepalive enforcement of the node, which is five minutes for tapd"`
+
+	KeepaliveTimeout time.Duration `long:"keepalive-timeout" description:"the time to wait for the response to a keepalive ping before the connection is considered broken"`
+}
+
+// validate makes sure the client configuration is consistent.
+func (c *ClientConfig) validate() error {
+	if c.KeepaliveTime < 0 {
+		return fmt.Errorf("keepalive time cannot be negative")
+	}
+
+	if c.KeepaliveTime > 0 && c.KeepaliveTimeout <= 0 {
+		return fmt.Errorf("keepalive timeout must be positive")
+	}
+
+	return nil
+}
+
+// connKey identifies a pooled connection. Connections that wait for a node to
+// become ready are kept apart from those that fail fast.
+type connKey struct {
+	name         string
+	addr         string
+	waitForReady bool
+}
+
+// connPool hands out a shared client connection for every tapd node, so test
+// cases don't dial new connections each time they run. gRPC reconnects a
+// pooled connection on its own if the node goes away, a connection that was
+// shut down is dialed again on the next request.
+type connPool struct {
+	sync.Mutex
+
+	conns map[connKey]*pooledConn
+}
+
+// pooledConn is a client connection of the pool.
+type pooledConn struct {
+	client *rpcClient
+	conn   *grpc.ClientConn
+}
+
+// connections is the connection pool shared by all test cases.
+var connections = newConnPool()
+
+// newConnPool creates a new, empty connection pool.
+func newConnPool() *connPool {
+	return &connPool{
+		conns: make(map[connKey]*pooledConn),
+	}
+}
+
+// client returns the pooled client of the given node, dialing a new connection
+// if there is none yet or the previous one was shut down.
+func (p *connPool) client(ctx context.Context, cfg *Config,
+	nodeCfg *TapConfig) (*rpcClient, error) {
+
+	key := connKey{
+		name:         nodeCfg.Name,
+		addr:         fmt.Sprintf("%s:%d", nodeCfg.Host, nodeCfg.Port),
+		waitForReady: cfg.Chaos.Enabled,
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	pooled, ok := p.conns[key]
+	if ok && pooled.conn.GetState() != connectivity.Shutdown {
+		return pooled.client, nil
+	}
+
+	dialOpts := clientDialOpts(cfg)
+	if cfg.Client.KeepaliveTime > 0 {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(
+			keepalive.ClientParameters{
+				Time:    cfg.Client.KeepaliveTime,
+				Timeout: cfg.Client.KeepaliveTimeout,
+			},
+		))
+	}
+
+	client, conn, err := dialTapClient(ctx, nodeCfg, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	clientConnDials.WithLabelValues(nodeCfg.Name).Inc()
+
+	go monitorConnState(nodeCfg.Name, conn)
+
+	p.conns[key] = &pooledConn{
+		client: client,
+		conn:   conn,
+	}
+
+	return client, nil
+}
+
+// closeAll closes all pooled connections. Later requests dial new ones, which
+// is needed if the nodes were restarted with new credentials.
+func (p *connPool) closeAll() error {
+	p.Lock()
+	defer p.Unlock()
+
+	var closeErr error
+	for key, pooled := range p.conns {
+		err := pooled.conn.Close()
+		if err != nil && closeErr == nil {
+			closeErr = fmt.Errorf("unable to close connection to "+
+				"%v: %w", key.name, err)
+		}
+	}
+
+	p.conns = make(map[connKey]*pooledConn)
+
+	return closeErr
+}
+
+// monitorConnState records every state change of the given connection until
+// it is shut down.
+//
+// NOTE: This function MUST be run as a goroutine.
+func monitorConnState(node string, conn *grpc.ClientConn) {
+	state := conn.GetState()
+	for state != connectivity.Shutdown {
+		// Closing the connection moves it to the shutdown state, so
+		// this doesn't block forever.
+		conn.WaitForStateChange(context.Background(), state)
+
+		state = conn.GetState()
+		clientConnStates.WithLabelValues(node, state.String()).Inc()
+	}
+}
@@ -0,0 +1,53 @@
+package loadtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConnPoolReuse tests that the pool hands out the same client for a node
+// until its connections are closed.
+func TestConnPoolReuse(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	nodeCfg := &TapConfig{
+		Name: "pool-test",
+		Host: "127.0.0.1",
+		Port: 1,
+	}
+
+	pool := newConnPool()
+	ctx := context.Background()
+
+	// Dialing doesn't block, so we get a client even though there's no
+	// node listening.
+	client, err := pool.client(ctx, &cfg, nodeCfg)
+	require.NoError(t, err)
+
+	sameClient, err := pool.client(ctx, &cfg, nodeCfg)
+	require.NoError(t, err)
+	require.Same(t, client, sameClient)
+	require.Equal(
+		t, 1.0, testutil.ToFloat64(
+			clientConnDials.WithLabelValues(nodeCfg.Name),
+		),
+	)
+
+	// Once closed, the next request dials a new connection.
+	require.NoError(t, pool.closeAll())
+
+	newClient, err := pool.client(ctx, &cfg, nodeCfg)
+	require.NoError(t, err)
+	require.NotSame(t, client, newClient)
+	require.Equal(
+		t, 2.0, testutil.ToFloat64(
+			clientConnDials.WithLabelValues(nodeCfg.Name),
+		),
+	)
+
+	require.NoError(t, pool.closeAll())
+}
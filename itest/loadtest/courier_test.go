@@ -0,0 +1,351 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// courierBackoffCfg is the backoff configuration the couriers of the
+	// courier test use. We only retry a few times with short delays, as
+	// we're interested in how often retries are needed at all.
+	courierBackoffCfg = &proof.BackoffCfg{
+		BackoffResetWait: time.Second,
+		NumTries:         3,
+		InitialBackoff:   100 * time.Millisecond,
+		MaxBackoff:       time.Second,
+	}
+)
+
+// courierDeliveryLog is an in-memory proof delivery log that counts the
+// delivery attempts a courier makes. As it never reports any previous
+// attempts, the courier doesn't delay the first attempt of a delivery.
+type courierDeliveryLog struct {
+	sync.Mutex
+
+	attempts int
+}
+
+// StoreProofDeliveryAttempt counts a proof delivery attempt.
+func (l *courierDeliveryLog) StoreProofDeliveryAttempt(context.Context,
+	proof.Locator) error {
+
+	l.Lock()
+	defer l.Unlock()
+
+	l.attempts++
+
+	return nil
+}
+
+// QueryProofDeliveryLog returns the timestamps of previous delivery attempts,
+// which is always empty for this log.
+func (l *courierDeliveryLog) QueryProofDeliveryLog(context.Context,
+	proof.Locator) ([]time.Time, error) {
+
+	return nil, nil
+}
+
+// reset returns the number of attempts counted so far and resets the counter.
+func (l *courierDeliveryLog) reset() int {
+	l.Lock()
+	defer l.Unlock()
+
+	attempts := l.attempts
+	l.attempts = 0
+
+	return attempts
+}
+
+// A compile-time assertion to ensure courierDeliveryLog meets the
+// proof.DeliveryLog interface.
+var _ proof.DeliveryLog = (*courierDeliveryLog)(nil)
+
+// courierTest pushes transfer proofs through each of the configured proof
+// couriers and retrieves them again, recording the delivery latency as well as
+// the number of failed deliveries and retries.
+func courierTest(t *testing.T, ctx context.Context, cfg *Config) {
+	if len(cfg.CourierAddrs) == 0 {
+		t.Skip("No proof courier configured, skipping courier test")
+	}
+
+	// Start by initializing all our client connections.
+	alice, _, bitcoinClient := initClients(t, ctx, cfg)
+
+	proofs := courierTestProofs(t, ctx, cfg, alice, bitcoinClient)
+
+	for _, addr := range cfg.CourierAddrs {
+		courierAddr, err := proof.ParseCourierAddrString(addr)
+		require.NoError(t, err)
+
+		testCase := fmt.Sprintf("courier-%s", courierAddr.Url().Scheme)
+
+		t.Logf("Running courier test, delivering %d proof(s) through "+
+			"%v using %d worker(s)", cfg.CourierNumProofs, addr,
+			cfg.CourierConcurrency)
+
+		// All workers share the same pacer, so the load profile
+		// applies to the courier as a whole.
+		courierPacer := newPacer(cfg.LoadProfile)
+
+		// The group sub test only returns once all parallel worker sub
+		// tests have completed.
+		t.Run(testCase, func(t *testing.T) {
+			concurrency := cfg.CourierConcurrency
+			for worker := 0; worker < concurrency; worker++ {
+				// Distribute the proofs as evenly as possible
+				// over all workers.
+				numProofs := cfg.CourierNumProofs / concurrency
+				if worker < cfg.CourierNumProofs%concurrency {
+					numProofs++
+				}
+
+				worker := worker
+				name := fmt.Sprintf("worker-%d", worker)
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+
+					courierWorker(
+						t, ctx, cfg, testCase,
+						courierAddr, worker, numProofs,
+						proofs, courierPacer,
+					)
+				})
+			}
+		})
+	}
+}
+
+// courierTestProofs exports the proofs of all assets owned by the given node,
+// so they can be pushed through the courier. If the node doesn't own any
+// assets yet, a new asset is minted first.
+func courierTestProofs(t *testing.T, ctx context.Context, cfg *Config,
+	node *rpcClient,
+	bitcoinClient *rpcclient.Client) []*proof.AnnotatedProof {
+
+	assets, err := node.ListAssets(ctx, &taprpc.ListAssetRequest{})
+	require.NoError(t, err)
+
+	if len(assets.Assets) == 0 {
+		minted := mintNormalAsset(
+			t, cfg, node, bitcoinClient, "courier", 1000,
+		)
+		assets.Assets = append(assets.Assets, minted)
+	}
+
+	var proofs []*proof.AnnotatedProof
+	for _, a := range assets.Assets {
+		// There's no need to export more distinct proofs than we are
+		// going to deliver.
+		if len(proofs) >= cfg.CourierNumProofs {
+			break
+		}
+
+		proofResp, err := node.ExportProof(
+			ctx, &taprpc.ExportProofRequest{
+				AssetId:   a.AssetGenesis.AssetId,
+				ScriptKey: a.ScriptKey,
+			},
+		)
+		require.NoError(t, err)
+
+		proofs = append(
+			proofs, annotateProof(t, proofResp.RawProofFile),
+		)
+	}
+
+	return proofs
+}
+
+// annotateProof creates an annotated proof for the given raw proof file, with
+// the locator pointing to the last proof in the file.
+func annotateProof(t *testing.T, rawFile []byte) *proof.AnnotatedProof {
+	var proofFile proof.File
+	require.NoError(t, proofFile.Decode(bytes.NewReader(rawFile)))
+
+	lastProof, err := proofFile.LastProof()
+	require.NoError(t, err)
+
+	var (
+		assetID  = lastProof.Asset.ID()
+		outPoint = lastProof.OutPoint()
+		groupKey *btcec.PublicKey
+	)
+	if lastProof.Asset.GroupKey != nil {
+		groupKey = &lastProof.Asset.GroupKey.GroupPubKey
+	}
+
+	return &proof.AnnotatedProof{
+		Locator: proof.Locator{
+			AssetID:   &assetID,
+			GroupKey:  groupKey,
+			ScriptKey: *lastProof.Asset.ScriptKey.PubKey,
+			OutPoint:  &outPoint,
+		},
+		Blob: rawFile,
+	}
+}
+
+// courierWorker delivers and retrieves the given number of proofs in sequence,
+// cycling through the given proofs. Each worker uses its own courier with a
+// random recipient, so the workers don't share any mailboxes.
+func courierWorker(t *testing.T, ctx context.Context, cfg *Config,
+	testCase string, courierAddr proof.CourierAddr, worker, numProofs int,
+	proofs []*proof.AnnotatedProof, courierPacer *pacer) {
+
+	recipientKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	deliveryLog := &courierDeliveryLog{}
+	courier, err := courierAddr.NewCourier(ctx, &proof.CourierCfg{
+		ReceiverAckTimeout: cfg.CourierAckTimeout,
+		BackoffCfg:         courierBackoffCfg,
+		DeliveryLog:        deliveryLog,
+	}, proof.Recipient{
+		ScriptKey: recipientKey.PubKey(),
+	})
+	require.NoError(t, err)
+
+	var numFailed, numRetries int
+	for i := 0; i < numProofs; i++ {
+		require.NoError(t, courierPacer.wait(ctx))
+
+		annotatedProof := proofs[(worker+i)%len(proofs)]
+		retries, err := deliverCourierProof(
+			ctx, testCase, courier, deliveryLog, annotatedProof,
+		)
+		numRetries += retries
+		courierRetries.WithLabelValues(testCase).Add(float64(retries))
+		if err != nil {
+			numFailed++
+			t.Logf("Worker %d failed to deliver proof %d of %d: %v",
+				worker, i+1, numProofs, err)
+		}
+	}
+
+	t.Logf("Worker %d delivered %d proof(s), %d failed, %d retries",
+		worker, numProofs, numFailed, numRetries)
+}
+
+// deliverCourierProof delivers a single proof through the courier and
+// retrieves it again. It returns the number of delivery retries the courier
+// needed.
+func deliverCourierProof(ctx context.Context, testCase string,
+	courier proof.Courier, deliveryLog *courierDeliveryLog,
+	annotatedProof *proof.AnnotatedProof) (int, error) {
+
+	// The hashmail courier only completes a delivery once the receiver
+	// acknowledged the proof, so we need to receive concurrently. The
+	// universe RPC courier on the other hand stores the proof, so it can
+	// only be received after the delivery completed.
+	_, isHashMail := courier.(*proof.HashMailCourier)
+
+	receiveCtx, cancelReceive := context.WithCancel(ctx)
+	defer cancelReceive()
+
+	var (
+		startTime  = time.Now()
+		wg         sync.WaitGroup
+		received   *proof.AnnotatedProof
+		receiveErr error
+	)
+	receive := func() {
+		received, receiveErr = courier.ReceiveProof(
+			receiveCtx, annotatedProof.Locator,
+		)
+	}
+	if isHashMail {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			receive()
+		}()
+	}
+
+	deliverErr := courier.DeliverProof(ctx, annotatedProof)
+	deliverDuration := time.Since(startTime)
+
+	// The universe RPC courier doesn't retry on its own, but logs one
+	// attempt for every proof in the file.
+	attempts := deliveryLog.reset()
+	retries := 0
+	if isHashMail && attempts > 1 {
+		retries = attempts - 1
+	}
+
+	if deliverErr != nil {
+		recordFailure(
+			testCase, opCourierDeliver, deliverDuration, deliverErr,
+		)
+
+		// The receiver would otherwise be waiting for the proof until
+		// the test case times out.
+		cancelReceive()
+		wg.Wait()
+
+		return retries, deliverErr
+	}
+	observeLatency(testCase, opCourierDeliver, deliverDuration)
+
+	if isHashMail {
+		wg.Wait()
+	} else {
+		receive()
+	}
+	receiveDuration := time.Since(startTime)
+
+	if receiveErr == nil {
+		receiveErr = matchCourierProof(annotatedProof, received)
+	}
+	if receiveErr != nil {
+		recordFailure(
+			testCase, opCourierReceive, receiveDuration, receiveErr,
+		)
+
+		return retries, receiveErr
+	}
+	observeLatency(testCase, opCourierReceive, receiveDuration)
+
+	return retries, nil
+}
+
+// matchCourierProof makes sure the received proof file ends with the same
+// proof as the delivered one.
+func matchCourierProof(delivered, received *proof.AnnotatedProof) error {
+	lastProof := func(blob proof.Blob) ([]byte, error) {
+		var proofFile proof.File
+		err := proofFile.Decode(bytes.NewReader(blob))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: "+
+				"%w", err)
+		}
+
+		return proofFile.RawLastProof()
+	}
+
+	deliveredProof, err := lastProof(delivered.Blob)
+	if err != nil {
+		return err
+	}
+	receivedProof, err := lastProof(received.Blob)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(deliveredProof, receivedProof) {
+		return fmt.Errorf("received proof doesn't match delivered " +
+			"proof")
+	}
+
+	return nil
+}
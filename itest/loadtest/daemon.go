@@ -0,0 +1,464 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	// daemonTestCase is the test case label used for all operations of
+	// the traffic generator daemon.
+	daemonTestCase = "daemon"
+
+	// daemonPollInterval is the interval in which the daemon polls the
+	// nodes for the completion of an operation.
+	daemonPollInterval = time.Second
+
+	// daemonRemineInterval is the interval in which the daemon mines
+	// another block while waiting for a transaction to confirm, in case
+	// the transaction wasn't in the mempool yet when the last block was
+	// mined.
+	daemonRemineInterval = 10 * time.Second
+
+	// daemonMiningAddr is the regtest address the daemon mines blocks to
+	// if the backend is bitcoind.
+	daemonMiningAddr = "n1VgRjYDzJT2TV72PnungWgWu18SWorXZS"
+)
+
+var (
+	// errNoBalance is returned if none of the nodes has enough balance for
+	// a send operation of the daemon.
+	errNoBalance = errors.New("no node has enough balance to send")
+)
+
+// DaemonConfig defines the traffic the traffic generator daemon creates.
+type DaemonConfig struct {
+	MintRate float64 `long:"mint-rate" description:"the number of mint batches per second the daemon creates, zero disables minting"`
+
+	MintBatchSize int `long:"mint-batch-size" description:"the number of assets in every batch the daemon mints"`
+
+	MintAmount uint64 `long:"mint-amount" description:"the number of units of every asset the daemon mints"`
+
+	SendRate float64 `long:"send-rate" description:"the number of sends per second the daemon creates, zero disables sending"`
+
+	SendAmount uint64 `long:"send-amount" description:"the number of asset units the daemon sends in every send operation"`
+
+	MineBlocks bool `long:"mine-blocks" description:"mine blocks to confirm the daemon's transactions, disable if the cluster has its own miner"`
+
+	OperationTimeout time.Duration `long:"operation-timeout" description:"the maximum time a single mint or send operation of the daemon may take"`
+}
+
+// validate makes sure the daemon configuration is consistent.
+func (c *DaemonConfig) validate() error {
+	switch {
+	case c.MintRate < 0 || c.SendRate < 0:
+		return fmt.Errorf("mint and send rate cannot be negative")
+
+	case c.MintRate == 0 && c.SendRate == 0:
+		return fmt.Errorf("either the mint or the send rate must be " +
+			"set")
+
+	case c.MintRate > 0 && (c.MintBatchSize <= 0 || c.MintAmount == 0):
+		return fmt.Errorf("mint batch size and amount must be " +
+			"positive")
+
+	case c.SendRate > 0 && c.SendAmount == 0:
+		return fmt.Errorf("send amount must be positive")
+
+	case c.OperationTimeout <= 0:
+		return fmt.Errorf("operation timeout must be positive")
+	}
+
+	return nil
+}
+
+// trafficDaemon continuously generates mint and send traffic against the
+// configured nodes.
+type trafficDaemon struct {
+	cfg  *Config
+	logf func(format string, args ...interface{})
+
+	nodes         []*rpcClient
+	bitcoinClient *rpcclient.Client
+
+	// mineMtx serializes mining blocks between the mint and send loops.
+	mineMtx sync.Mutex
+
+	// numMints and numSends count the operations of each type, so the
+	// daemon can alternate between the nodes.
+	numMints int
+	numSends int
+}
+
+// RunDaemon generates mint and send traffic against the configured nodes until
+// the given context is canceled. In contrast to TestPerformance it doesn't
+// require a testing environment, so it can run indefinitely as a long-lived
+// process. All operations are recorded in the usual load test metrics, which
+// are served and pushed as configured.
+func RunDaemon(ctx context.Context, cfg *Config,
+	logf func(format string, args ...interface{})) error {
+
+	if err := cfg.Daemon.validate(); err != nil {
+		return fmt.Errorf("invalid daemon config: %w", err)
+	}
+
+	// Only the signet miner can produce blocks on signet.
+	if cfg.Daemon.MineBlocks && cfg.Bitcoin.Network == networkSignet {
+		return fmt.Errorf("daemon.mine-blocks must be disabled on " +
+			"signet")
+	}
+
+	loadRand.seed(cfg.Seed)
+	logf("Using random seed %d", cfg.Seed)
+
+	// The daemon runs indefinitely, so we can't keep the results of every
+	// single operation in memory. The metrics still cover them.
+	results.disable()
+
+	if cfg.PrometheusServer.Enabled {
+		server, err := startMetricsServer(cfg.PrometheusServer)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err := server.stop(context.Background())
+			if err != nil {
+				logf("Unable to stop metrics server: %v", err)
+			}
+		}()
+
+		logf("Serving metrics on http://%v/metrics", server.addr())
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+	}
+
+	var nodes []*rpcClient
+	for _, nodeCfg := range []*TapConfig{cfg.Alice.Tapd, cfg.Bob.Tapd} {
+		node, conn, err := dialTapClient(ctx, nodeCfg, dialOpts...)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		nodes = append(nodes, node)
+	}
+
+	// Label all metrics with the setup of the nodes under test. The nodes
+	// might not be up yet, but the dial options make us wait for them.
+	if err := collectNodeInfo(ctx, cfg, dialOpts...); err != nil {
+		logf("Unable to collect node info: %v", err)
+	}
+
+	bitcoinClient, err := dialBitcoinConn(cfg.Bitcoin)
+	if err != nil {
+		return err
+	}
+	defer bitcoinClient.Shutdown()
+
+	d := &trafficDaemon{
+		cfg:           cfg,
+		logf:          logf,
+		nodes:         nodes,
+		bitcoinClient: bitcoinClient,
+	}
+
+	var wg sync.WaitGroup
+	if cfg.Daemon.MintRate > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.loop(ctx, opMintBatch, cfg.Daemon.MintRate, d.mint)
+		}()
+	}
+	if cfg.Daemon.SendRate > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.loop(ctx, opTransfer, cfg.Daemon.SendRate, d.send)
+		}()
+	}
+
+	if cfg.PrometheusGateway.Enabled {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.pushLoop(ctx)
+		}()
+	}
+
+	logf("Generating traffic: %v mint batch(es) and %v send(s) per second",
+		cfg.Daemon.MintRate, cfg.Daemon.SendRate)
+
+	<-ctx.Done()
+	wg.Wait()
+
+	logf("Traffic generator stopped")
+
+	return nil
+}
+
+// loop executes the given operation at the given rate until the context is
+// canceled.
+func (d *trafficDaemon) loop(ctx context.Context, operation string,
+	rate float64, op func(context.Context) error) {
+
+	opPacer := newPacer(&LoadProfileConfig{
+		Type:     profileConstant,
+		BaseRate: rate,
+	})
+
+	for {
+		if err := opPacer.wait(ctx); err != nil {
+			return
+		}
+
+		opCtx, cancel := context.WithTimeout(
+			ctx, d.cfg.Daemon.OperationTimeout,
+		)
+		startTime := time.Now()
+		err := op(opCtx)
+		latency := time.Since(startTime)
+		cancel()
+
+		// Operations that were interrupted by the shutdown of the
+		// daemon are not recorded.
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			d.logf("Operation %v failed after %v: %v", operation,
+				latency, err)
+			recordFailure(daemonTestCase, operation, latency, err)
+
+			continue
+		}
+
+		observeLatency(daemonTestCase, operation, latency)
+	}
+}
+
+// pushLoop pushes the rolling metrics to the push gateway until the context is
+// canceled.
+func (d *trafficDaemon) pushLoop(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.SoakPushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		err := pushMetrics(d.cfg.PrometheusGateway, daemonTestCase, "")
+		if err != nil {
+			d.logf("Unable to push metrics: %v", err)
+		}
+	}
+}
+
+// mint mints a new batch of normal assets, alternating between the nodes, and
+// waits for the batch to be finalized.
+func (d *trafficDaemon) mint(ctx context.Context) error {
+	minter := d.nodes[d.numMints%len(d.nodes)]
+	d.numMints++
+
+	var batchKey []byte
+	for i := 0; i < d.cfg.Daemon.MintBatchSize; i++ {
+		resp, err := minter.MintAsset(ctx, &mintrpc.MintAssetRequest{
+			Asset: &mintrpc.MintAsset{
+				AssetType: taprpc.AssetType_NORMAL,
+				Name: fmt.Sprintf(
+					"daemon-%d", loadRand.Int31(),
+				),
+				AssetMeta: &taprpc.AssetMeta{
+					Data: []byte("daemon load test"),
+				},
+				Amount: d.cfg.Daemon.MintAmount,
+			},
+			ShortResponse: true,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to mint asset: %w", err)
+		}
+
+		batchKey = resp.PendingBatch.BatchKey
+	}
+
+	_, err := minter.FinalizeBatch(ctx, &mintrpc.FinalizeBatchRequest{
+		ShortResponse: true,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to finalize batch: %w", err)
+	}
+
+	return d.waitConfirmed(ctx, func() (bool, error) {
+		resp, err := minter.ListBatches(ctx, &mintrpc.ListBatchRequest{
+			Filter: &mintrpc.ListBatchRequest_BatchKey{
+				BatchKey: batchKey,
+			},
+		})
+		if err != nil {
+			return false, fmt.Errorf("unable to list batches: %w",
+				err)
+		}
+		if len(resp.Batches) != 1 {
+			return false, fmt.Errorf("expected one batch, got %d",
+				len(resp.Batches))
+		}
+
+		state := resp.Batches[0].State
+		return state == mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+	})
+}
+
+// send sends assets between two nodes, alternating the direction, and waits
+// for the receiving node to complete the transfer.
+func (d *trafficDaemon) send(ctx context.Context) error {
+	amount := d.cfg.Daemon.SendAmount
+
+	// We prefer alternating the direction of the sends, but fall back to
+	// any node that has enough balance.
+	var (
+		sender, receiver *rpcClient
+		sendAsset        *taprpc.Asset
+	)
+	for i := 0; i < len(d.nodes); i++ {
+		idx := (d.numSends + i) % len(d.nodes)
+		candidate := d.nodes[idx]
+
+		a, err := candidate.findAssetWithBalance(
+			ctx, amount, taprpc.AssetType_NORMAL,
+		)
+		if err != nil {
+			return err
+		}
+		if a == nil {
+			continue
+		}
+
+		sender = candidate
+		receiver = d.nodes[(idx+1)%len(d.nodes)]
+		sendAsset = a
+
+		break
+	}
+	d.numSends++
+
+	if sendAsset == nil {
+		return errNoBalance
+	}
+
+	addr, err := receiver.NewAddr(ctx, &taprpc.NewAddrRequest{
+		AssetId: sendAsset.AssetGenesis.AssetId,
+		Amt:     amount,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create address: %w", err)
+	}
+
+	_, err = sender.SendAsset(ctx, &taprpc.SendAssetRequest{
+		TapAddrs: []string{addr.Encoded},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to send asset: %w", err)
+	}
+
+	completed := taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED
+	return d.waitConfirmed(ctx, func() (bool, error) {
+		resp, err := receiver.AddrReceives(
+			ctx, &taprpc.AddrReceivesRequest{
+				FilterAddr:   addr.Encoded,
+				FilterStatus: completed,
+			},
+		)
+		if err != nil {
+			return false, fmt.Errorf("unable to list address "+
+				"receives: %w", err)
+		}
+
+		return len(resp.Events) > 0, nil
+	})
+}
+
+// waitConfirmed polls the given completion check until it succeeds. If the
+// daemon is configured to mine blocks, a block is mined right away and then
+// again in regular intervals until the operation completed.
+func (d *trafficDaemon) waitConfirmed(ctx context.Context,
+	done func() (bool, error)) error {
+
+	var lastMined time.Time
+	for {
+		if d.cfg.Daemon.MineBlocks &&
+			time.Since(lastMined) >= daemonRemineInterval {
+
+			if err := d.mineBlock(); err != nil {
+				return err
+			}
+			lastMined = time.Now()
+		}
+
+		isDone, err := done()
+		if err != nil {
+			return err
+		}
+		if isDone {
+			return nil
+		}
+
+		select {
+		case <-time.After(daemonPollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// mineBlock mines a single block on the bitcoin backend.
+func (d *trafficDaemon) mineBlock() error {
+	d.mineMtx.Lock()
+	defer d.mineMtx.Unlock()
+
+	backend, err := d.bitcoinClient.BackendVersion()
+	if err != nil {
+		return fmt.Errorf("unable to query backend version: %w", err)
+	}
+
+	switch backend {
+	case rpcclient.BitcoindPost19:
+		addr, err := btcutil.DecodeAddress(
+			daemonMiningAddr, &chaincfg.RegressionNetParams,
+		)
+		if err != nil {
+			return fmt.Errorf("invalid mining address: %w", err)
+		}
+
+		_, err = d.bitcoinClient.GenerateToAddress(1, addr, nil)
+		if err != nil {
+			return fmt.Errorf("unable to mine block: %w", err)
+		}
+
+	case rpcclient.Btcd:
+		if _, err := d.bitcoinClient.Generate(1); err != nil {
+			return fmt.Errorf("unable to mine block: %w", err)
+		}
+
+	default:
+		return fmt.Errorf("unknown chain backend: %v", backend)
+	}
+
+	return nil
+}
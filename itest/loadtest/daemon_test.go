@@ -0,0 +1,47 @@
+package loadtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDaemonConfigValidate tests the validation of the traffic generator
+// daemon configuration.
+func TestDaemonConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	validCfg := func() *DaemonConfig {
+		return &DaemonConfig{
+			MintRate:         0.1,
+			MintBatchSize:    1,
+			MintAmount:       1000,
+			SendRate:         1,
+			SendAmount:       10,
+			OperationTimeout: time.Minute,
+		}
+	}
+
+	require.NoError(t, validCfg().validate())
+
+	cfg := validCfg()
+	cfg.MintRate, cfg.SendRate = 0, 0
+	require.ErrorContains(t, cfg.validate(), "either the mint or the send")
+
+	// A zero send amount is fine as long as the daemon doesn't send.
+	cfg = validCfg()
+	cfg.SendAmount = 0
+	require.ErrorContains(t, cfg.validate(), "send amount")
+
+	cfg.SendRate = 0
+	require.NoError(t, cfg.validate())
+
+	cfg = validCfg()
+	cfg.MintBatchSize = 0
+	require.ErrorContains(t, cfg.validate(), "mint batch size")
+
+	cfg = validCfg()
+	cfg.OperationTimeout = 0
+	require.ErrorContains(t, cfg.validate(), "operation timeout")
+}
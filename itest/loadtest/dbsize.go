@@ -0,0 +1,154 @@
+package loadtest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	// Register the Postgres driver for querying table sizes.
+	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// nodeDBSize is the total on-disk size of the database of a node under
+	// test, as last sampled.
+	nodeDBSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "target_db_size_bytes",
+			Help: "Total on-disk size of the database of the " +
+				"node under test, in bytes",
+		}, []string{"node"},
+	)
+
+	// nodeDBTableSize is the on-disk size of the individual tables of a
+	// node's Postgres database, including indexes, as last sampled.
+	nodeDBTableSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "target_db_table_size_bytes",
+			Help: "On-disk size of a table of the Postgres " +
+				"database of the node under test, including " +
+				"indexes, in bytes",
+		}, []string{"node", "table"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(nodeDBSize)
+	prometheus.MustRegister(nodeDBTableSize)
+}
+
+// postgresTableSizesQuery queries the total size of every user table of a
+// Postgres database, including its indexes and TOAST data.
+const postgresTableSizesQuery = `
+SELECT relname, pg_total_relation_size(relid)
+FROM pg_catalog.pg_statio_user_tables`
+
+// dbUsage is a single sample of the on-disk size of a node's database.
+type dbUsage struct {
+	// totalBytes is the total size of the database.
+	totalBytes float64
+
+	// tableBytes is the size of every table of the database. This is only
+	// available for Postgres databases.
+	tableBytes map[string]float64
+}
+
+// record sets the database size gauges of the given node to the sampled
+// values.
+func (u *dbUsage) record(node string) {
+	nodeDBSize.WithLabelValues(node).Set(u.totalBytes)
+
+	for table, size := range u.tableBytes {
+		nodeDBTableSize.WithLabelValues(node, table).Set(size)
+	}
+}
+
+// sampleDBSize samples the on-disk size of the database of the given node,
+// preferring the Postgres table sizes over the size of the sqlite file.
+func sampleDBSize(ctx context.Context, node *TapConfig) (*dbUsage, error) {
+	if node.PostgresDSN != "" {
+		return postgresDBSize(ctx, node.PostgresDSN)
+	}
+
+	return sqliteDBSize(node.DBPath)
+}
+
+// sqliteDBSize returns the size of the sqlite database file at the given path.
+// The write-ahead log is included, as recently written data only ends up in
+// the main file once the log is checkpointed.
+func sqliteDBSize(dbPath string) (*dbUsage, error) {
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to stat database file: %w", err)
+	}
+	totalBytes := float64(info.Size())
+
+	walInfo, err := os.Stat(dbPath + "-wal")
+	switch {
+	case err == nil:
+		totalBytes += float64(walInfo.Size())
+
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("unable to stat write-ahead log: %w",
+			err)
+	}
+
+	return &dbUsage{
+		totalBytes: totalBytes,
+	}, nil
+}
+
+// postgresDBSize queries the size of all user tables of the Postgres database
+// with the given DSN.
+func postgresDBSize(ctx context.Context, dsn string) (*dbUsage, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open database: %w", err)
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, postgresTableSizesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query table sizes: %w", err)
+	}
+	defer rows.Close()
+
+	usage := &dbUsage{
+		tableBytes: make(map[string]float64),
+	}
+	for rows.Next() {
+		var (
+			table string
+			size  int64
+		)
+		if err := rows.Scan(&table, &size); err != nil {
+			return nil, fmt.Errorf("unable to read table size: %w",
+				err)
+		}
+
+		usage.tableBytes[table] = float64(size)
+		usage.totalBytes += float64(size)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to query table sizes: %w", err)
+	}
+
+	return usage, nil
+}
+
+// countTransfers returns the number of successful transfers in the given
+// results.
+func countTransfers(results []operationResult) int {
+	var numTransfers int
+	for _, result := range results {
+		if result.Operation == opTransfer && result.Error == "" {
+			numTransfers++
+		}
+	}
+
+	return numTransfers
+}
@@ -0,0 +1,44 @@
+package loadtest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSqliteDBSize tests that the size of a sqlite database includes its
+// write-ahead log, if there is one.
+func TestSqliteDBSize(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "tapd.db")
+
+	_, err := sqliteDBSize(dbPath)
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(dbPath, make([]byte, 4096), 0600))
+
+	usage, err := sqliteDBSize(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, 4096.0, usage.totalBytes)
+	require.Empty(t, usage.tableBytes)
+
+	err = os.WriteFile(dbPath+"-wal", make([]byte, 1024), 0600)
+	require.NoError(t, err)
+
+	usage, err = sqliteDBSize(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, 5120.0, usage.totalBytes)
+}
+
+// TestCountTransfers tests that only successful transfers are counted.
+func TestCountTransfers(t *testing.T) {
+	require.Zero(t, countTransfers(nil))
+
+	require.Equal(t, 2, countTransfers([]operationResult{
+		{Operation: opTransfer},
+		{Operation: opTransfer, Error: "failed"},
+		{Operation: opProofImport},
+		{Operation: opTransfer},
+	}))
+}
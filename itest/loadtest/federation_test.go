@@ -0,0 +1,185 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// federationTestCase is the test case label used for the federation
+	// test.
+	federationTestCase = "federation"
+)
+
+// federationTest measures how long it takes a federation of universe servers
+// to converge as the number of leaves grows. Alice and all nodes with the
+// universe role form the federation, every node with the sender role issues
+// new assets. In every round, each issuer mints a batch of assets and the time
+// until every federation member knows all of them is recorded.
+//
+// NOTE: The federation converges through the proofs the issuers push to their
+// federation and the periodic sync of the members (universe.syncinterval), so
+// the test doesn't trigger any syncs itself.
+func federationTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+	pool := initNodePool(t, ctx, cfg, alice, bob)
+
+	members := []*rpcClient{alice}
+	for _, node := range pool.withRole(roleUniverse) {
+		if node != alice {
+			members = append(members, node)
+		}
+	}
+	issuers := pool.withRole(roleSender)
+
+	// Every issuer and member federates with all other members, so new
+	// issuance proofs are pushed to the whole federation.
+	federated := make(map[*rpcClient]struct{})
+	for _, node := range append(issuers, members...) {
+		if _, ok := federated[node]; ok {
+			continue
+		}
+		federated[node] = struct{}{}
+
+		for _, member := range members {
+			if member == node {
+				continue
+			}
+
+			addFederationServer(t, ctx, node, member.hostPort())
+		}
+	}
+
+	t.Logf("Running federation test with %d member(s) and %d "+
+		"issuer(s), minting %d asset(s) per issuer in %d round(s)",
+		len(members), len(issuers), cfg.FederationBatchSize,
+		cfg.FederationNumRounds)
+
+	var (
+		totalDuration time.Duration
+		numLeaves     int
+	)
+	for round := 1; round <= cfg.FederationNumRounds; round++ {
+		var newAssets []*taprpc.Asset
+		for _, issuer := range issuers {
+			newAssets = append(newAssets, mintUngroupedBatch(
+				t, cfg, issuer, bitcoinClient,
+				federationTestCase, cfg.FederationBatchSize,
+			)...)
+		}
+		numLeaves += len(newAssets)
+
+		convergeDuration := awaitFederationConsistency(
+			t, ctx, cfg, members, newAssets,
+		)
+		totalDuration += convergeDuration
+
+		t.Logf("Federation converged on %d new leaves (%d total) in "+
+			"round %d of %d in %v", len(newAssets), numLeaves,
+			round, cfg.FederationNumRounds, convergeDuration)
+	}
+
+	t.Logf("Federation converged %d times on a total of %d leaves, "+
+		"average convergence duration %v", cfg.FederationNumRounds,
+		numLeaves,
+		totalDuration/time.Duration(cfg.FederationNumRounds))
+}
+
+// addFederationServer adds the given host as a federation server of the given
+// node. The server might already be part of the node's federation from a
+// previous run, which is not an error.
+func addFederationServer(t *testing.T, ctx context.Context, node *rpcClient,
+	host string) {
+
+	_, err := node.AddFederationServer(
+		ctx, &unirpc.AddFederationServerRequest{
+			Servers: []*unirpc.UniverseFederationServer{
+				{
+					Host: host,
+				},
+			},
+		},
+	)
+	if err != nil {
+		require.ErrorContains(
+			t, err, universe.ErrDuplicateUniverse.Error(),
+		)
+	}
+}
+
+// awaitFederationConsistency waits until every member of the federation has
+// an issuance leaf for all of the given assets and returns how long that took.
+func awaitFederationConsistency(t *testing.T, ctx context.Context,
+	cfg *Config, members []*rpcClient,
+	newAssets []*taprpc.Asset) time.Duration {
+
+	startTime := time.Now()
+
+	// Members that already converged don't need to be queried again.
+	pending := make(map[*rpcClient]struct{}, len(members))
+	for _, member := range members {
+		pending[member] = struct{}{}
+	}
+
+	err := wait.Predicate(func() bool {
+		for member := range pending {
+			if !hasIssuanceLeaves(ctx, member, newAssets) {
+				continue
+			}
+
+			delete(pending, member)
+		}
+
+		return len(pending) == 0
+	}, cfg.TestTimeout)
+	if err != nil {
+		var missing []string
+		for member := range pending {
+			missing = append(missing, member.cfg.Name)
+		}
+
+		err = fmt.Errorf("federation members %v didn't converge: %w",
+			missing, err)
+		recordFailure(
+			federationTestCase, opFederationConverge,
+			time.Since(startTime), err,
+		)
+	}
+	require.NoError(t, err)
+
+	convergeDuration := time.Since(startTime)
+	observeLatency(
+		federationTestCase, opFederationConverge, convergeDuration,
+	)
+
+	return convergeDuration
+}
+
+// hasIssuanceLeaves returns true if the given universe server has an issuance
+// leaf for every one of the given assets.
+func hasIssuanceLeaves(ctx context.Context, server *rpcClient,
+	assets []*taprpc.Asset) bool {
+
+	for _, a := range assets {
+		resp, err := server.AssetLeafKeys(ctx, &unirpc.ID{
+			Id: &unirpc.ID_AssetId{
+				AssetId: a.AssetGenesis.AssetId,
+			},
+			ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+		})
+		if err != nil || len(resp.AssetKeys) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
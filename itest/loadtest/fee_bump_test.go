@@ -0,0 +1,275 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/itest"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// feeBumpTestCase is the test case label used for the fee bump test.
+	feeBumpTestCase = "feebump"
+)
+
+// feeBumpTest checks that the anchor transactions of pending transfers can be
+// replaced repeatedly while they are stuck in the mempool. It sends assets from
+// alice to bob at the fee rate alice's wallet estimates, then bumps the fee of
+// the anchor transaction the configured number of times without mining a
+// block, as if the mempool was congested. For every bump it records how long
+// alice takes to re-sign and re-broadcast the anchor transaction and how long
+// it takes until the replacement is in the mempool of the bitcoin backend.
+// Finally, the last replacement is confirmed and bob must receive the assets
+// through it.
+//
+// NOTE: This test is skipped on signet, where the signet miner might confirm
+// the anchor transactions before their fee is bumped.
+func feeBumpTest(t *testing.T, ctx context.Context, cfg *Config) {
+	if cfg.Bitcoin.Network == networkSignet {
+		t.Skipf("Fee bump test can't run on %v", networkSignet)
+	}
+
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+
+	totalAmt := uint64(cfg.FeeBumpNumTransfers) * cfg.FeeBumpAmount
+	sendAsset := alice.assetIDWithBalance(
+		t, ctx, totalAmt, taprpc.AssetType_NORMAL,
+	)
+	if sendAsset == nil {
+		sendAsset = mintNormalAsset(
+			t, cfg, alice, bitcoinClient, feeBumpTestCase,
+			2*totalAmt,
+		)
+	}
+
+	t.Logf("Running fee bump test, bumping the fee of %d transfer(s) %d "+
+		"time(s) each", cfg.FeeBumpNumTransfers, cfg.FeeBumpNumBumps)
+
+	var (
+		totalDuration time.Duration
+		numBumps      int
+	)
+	miner := newBlockMiner(bitcoinClient, cfg.Chaos)
+	feeBumpPacer := newPacer(cfg.LoadProfile)
+	for i := 1; i <= cfg.FeeBumpNumTransfers; i++ {
+		require.NoError(t, feeBumpPacer.wait(ctx))
+
+		bumpDurations := feeBumpTransfer(
+			t, ctx, cfg, sendAsset, alice, bob, bitcoinClient,
+			miner,
+		)
+		for _, bumpDuration := range bumpDurations {
+			totalDuration += bumpDuration
+		}
+		numBumps += len(bumpDurations)
+
+		t.Logf("Finished %d of %d fee bumped transfers", i,
+			cfg.FeeBumpNumTransfers)
+	}
+
+	t.Logf("Bumped the fee of %d transfer(s) %d time(s) in total, "+
+		"average fee bump duration %v", cfg.FeeBumpNumTransfers,
+		numBumps, totalDuration/time.Duration(numBumps))
+}
+
+// feeBumpTransfer sends the configured amount of the given asset from the
+// sender to the receiver and bumps the fee of the anchor transaction the
+// configured number of times before confirming it. The time every fee bump
+// took the sender is returned.
+func feeBumpTransfer(t *testing.T, ctx context.Context, cfg *Config,
+	sendAsset *taprpc.Asset, send, receive *rpcClient,
+	bitcoinClient *rpcclient.Client, miner *blockMiner) []time.Duration {
+
+	addr, err := receive.NewAddr(ctx, &taprpc.NewAddrRequest{
+		AssetId: sendAsset.AssetGenesis.AssetId,
+		Amt:     cfg.FeeBumpAmount,
+	})
+	require.NoError(t, err)
+	itest.AssertAddrCreated(t, receive, sendAsset, addr)
+
+	startTime := time.Now()
+	sendResp, err := send.SendAsset(ctx, &taprpc.SendAssetRequest{
+		TapAddrs: []string{addr.Encoded},
+	})
+	if err != nil {
+		recordFailure(
+			feeBumpTestCase, opTransfer, time.Since(startTime), err,
+		)
+	}
+	require.NoError(t, err)
+
+	transfer := sendResp.Transfer
+	bumpDurations := make([]time.Duration, 0, cfg.FeeBumpNumBumps)
+	for i := 0; i < cfg.FeeBumpNumBumps; i++ {
+		var bumpDuration time.Duration
+		transfer, bumpDuration = bumpAnchorFee(
+			t, ctx, cfg, send, bitcoinClient, transfer,
+		)
+		bumpDurations = append(bumpDurations, bumpDuration)
+	}
+
+	// Only the last replacement can confirm, and the receiver must take
+	// over the assets through it.
+	anchorTxHash, err := chainhash.NewHash(transfer.AnchorTxHash)
+	require.NoError(t, err)
+	miner.confirmTx(t, anchorTxHash)
+
+	confirmTime := time.Now()
+	awaitReceiveCompletedWithTx(t, ctx, receive, addr, anchorTxHash)
+	observeLatency(feeBumpTestCase, opProofImport, time.Since(confirmTime))
+
+	return bumpDurations
+}
+
+// bumpAnchorFee bumps the fee rate of the anchor transaction of the given
+// pending transfer by the configured step and waits for the replacement to
+// replace it in the mempool. The updated transfer and the time it took the
+// sender to re-sign and re-broadcast the anchor transaction are returned.
+func bumpAnchorFee(t *testing.T, ctx context.Context, cfg *Config,
+	send *rpcClient, bitcoinClient *rpcclient.Client,
+	transfer *taprpc.AssetTransfer) (*taprpc.AssetTransfer,
+	time.Duration) {
+
+	anchorTxHash, err := chainhash.NewHash(transfer.AnchorTxHash)
+	require.NoError(t, err)
+
+	// The fee rate of the anchor transaction is derived from its size in
+	// the mempool, which it must have reached before it can be replaced.
+	var vSize int64
+	err = wait.NoError(func() error {
+		entry, err := bitcoinClient.GetMempoolEntry(
+			anchorTxHash.String(),
+		)
+		if err != nil {
+			return err
+		}
+
+		vSize = int64(entry.VSize)
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t, err)
+
+	chainFees := transfer.AnchorTxChainFees
+	feeRate := uint64((chainFees+vSize-1)/vSize) + cfg.FeeBumpFeeRateStep
+
+	// The sender only accepts the fee bump once it is waiting for the
+	// confirmation of the anchor transaction, which it might not be yet
+	// right after broadcasting it. We only measure the attempt that
+	// succeeded.
+	var (
+		bumpResp     *taprpc.BumpTransferFeeResponse
+		bumpStart    time.Time
+		bumpDuration time.Duration
+	)
+	err = wait.NoError(func() error {
+		bumpStart = time.Now()
+		bumpResp, err = send.BumpTransferFee(
+			ctx, &taprpc.BumpTransferFeeRequest{
+				AnchorTxid:  anchorTxHash.String(),
+				SatPerVbyte: feeRate,
+			},
+		)
+		bumpDuration = time.Since(bumpStart)
+
+		return err
+	}, defaultTimeout)
+	if err != nil {
+		recordFailure(feeBumpTestCase, opFeeBump, bumpDuration, err)
+	}
+	require.NoError(t, err)
+	observeLatency(feeBumpTestCase, opFeeBump, bumpDuration)
+
+	newTransfer := bumpResp.Transfer
+	require.Greater(t, newTransfer.AnchorTxChainFees, chainFees)
+
+	newAnchorTxHash, err := chainhash.NewHash(newTransfer.AnchorTxHash)
+	require.NoError(t, err)
+	require.NotEqual(t, anchorTxHash, newAnchorTxHash)
+
+	// The replacement must have evicted the original anchor transaction
+	// from the mempool of the bitcoin backend.
+	err = wait.NoError(func() error {
+		mempool, err := bitcoinClient.GetRawMempool()
+		if err != nil {
+			return err
+		}
+
+		var foundNew bool
+		for _, txid := range mempool {
+			switch *txid {
+			case *anchorTxHash:
+				return fmt.Errorf("replaced tx %v still in "+
+					"mempool", anchorTxHash)
+
+			case *newAnchorTxHash:
+				foundNew = true
+			}
+		}
+		if !foundNew {
+			return fmt.Errorf("replacement tx %v not in mempool",
+				newAnchorTxHash)
+		}
+
+		return nil
+	}, defaultTimeout)
+	if err != nil {
+		recordFailure(
+			feeBumpTestCase, opFeeBumpMempool,
+			time.Since(bumpStart), err,
+		)
+	}
+	require.NoError(t, err)
+	observeLatency(
+		feeBumpTestCase, opFeeBumpMempool, time.Since(bumpStart),
+	)
+
+	return newTransfer, bumpDuration
+}
+
+// awaitReceiveCompletedWithTx waits for the inbound transfer to the given
+// address that is anchored in the given transaction to complete on the
+// receiving node. The receiver might also have detected anchor transactions
+// that were replaced since, so other receives of the address are ignored.
+func awaitReceiveCompletedWithTx(t *testing.T, ctx context.Context,
+	receive *rpcClient, addr *taprpc.Addr, anchorTxHash *chainhash.Hash) {
+
+	err := wait.NoError(func() error {
+		resp, err := receive.AddrReceives(
+			ctx, &taprpc.AddrReceivesRequest{
+				FilterAddr: addr.Encoded,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range resp.Events {
+			if !strings.HasPrefix(
+				event.Outpoint, anchorTxHash.String(),
+			) {
+
+				continue
+			}
+
+			if event.Status != statusCompleted {
+				return fmt.Errorf("got status %v, wanted %v",
+					event.Status, statusCompleted)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("no receive of tx %v", anchorTxHash)
+	}, defaultTimeout)
+	require.NoError(t, err)
+}
@@ -0,0 +1,145 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/itest"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// groupMintTestCase is the test case label used for the grouped asset
+	// mint test.
+	groupMintTestCase = "groupmint"
+)
+
+// groupMintTest mints a new asset group and then repeatedly re-issues assets
+// into that group. For every re-issuance it records the time it takes to
+// finalize the batch (which includes creating the group witness), to confirm
+// it, and to query the growing issuance universe of the group.
+func groupMintTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, _, bitcoinClient := initClients(t, ctx, cfg)
+
+	baseName := fmt.Sprintf("group-%d", loadRand.Int31())
+
+	itest.LogfTimestamped(t, "minting group anchor %s", baseName)
+
+	anchors := itest.MintAssetsConfirmBatch(
+		t, bitcoinClient, alice, []*mintrpc.MintAssetRequest{{
+			Asset: &mintrpc.MintAsset{
+				AssetType: taprpc.AssetType_NORMAL,
+				Name:      baseName,
+				AssetMeta: &taprpc.AssetMeta{
+					Data: []byte("group mint load test"),
+				},
+				Amount: cfg.GroupMintAmount,
+			},
+			EnableEmission: true,
+		}}, itest.WithMintingTimeout(cfg.TestTimeout),
+	)
+	require.Len(t, anchors, 1)
+	require.NotNil(t, anchors[0].AssetGroup)
+
+	groupKey := anchors[0].AssetGroup.TweakedGroupKey
+	numLeaves := 1
+
+	t.Logf("Re-issuing %d batch(es) of %d asset(s) into group %x",
+		cfg.GroupMintNumReissuances, cfg.GroupMintBatchSize, groupKey)
+
+	for i := 0; i < cfg.GroupMintNumReissuances; i++ {
+		reissueIntoGroup(
+			t, ctx, cfg, alice, bitcoinClient, baseName, i,
+			groupKey,
+		)
+		numLeaves += cfg.GroupMintBatchSize
+
+		// The issuance universe of the group grows with every batch,
+		// so we measure how long it takes to query all its leaves.
+		queryStart := time.Now()
+		leafKeys, err := alice.AssetLeafKeys(ctx, &unirpc.ID{
+			Id: &unirpc.ID_GroupKey{
+				GroupKey: groupKey[1:],
+			},
+			ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+		})
+		queryDuration := time.Since(queryStart)
+		if err != nil {
+			recordFailure(
+				groupMintTestCase, opGroupLeafQuery,
+				queryDuration, err,
+			)
+		}
+		require.NoError(t, err)
+		require.Len(t, leafKeys.AssetKeys, numLeaves)
+
+		observeLatency(
+			groupMintTestCase, opGroupLeafQuery, queryDuration,
+		)
+
+		t.Logf("Re-issuance %d of %d done, group universe has %d "+
+			"leaves, query took %v", i+1,
+			cfg.GroupMintNumReissuances, numLeaves, queryDuration)
+	}
+}
+
+// reissueIntoGroup mints a batch of new assets into the existing group with the
+// given key and records the finalization and confirmation latency.
+func reissueIntoGroup(t *testing.T, ctx context.Context, cfg *Config,
+	minter *rpcClient, bitcoinClient *rpcclient.Client, baseName string,
+	reissuance int, groupKey []byte) {
+
+	var batchKey []byte
+	for i := 0; i < cfg.GroupMintBatchSize; i++ {
+		resp, err := minter.MintAsset(ctx, &mintrpc.MintAssetRequest{
+			Asset: &mintrpc.MintAsset{
+				AssetType: taprpc.AssetType_NORMAL,
+				Name: fmt.Sprintf(
+					"%s-%d-%d", baseName, reissuance, i,
+				),
+				AssetMeta: &taprpc.AssetMeta{
+					Data: []byte("group mint load test"),
+				},
+				Amount:   cfg.GroupMintAmount,
+				GroupKey: groupKey,
+			},
+			ShortResponse: true,
+		})
+		require.NoError(t, err)
+
+		batchKey = resp.PendingBatch.BatchKey
+	}
+
+	// Finalizing the batch is where the group witnesses of all re-issued
+	// assets are created.
+	finalizeStart := time.Now()
+	_, err := minter.FinalizeBatch(ctx, &mintrpc.FinalizeBatchRequest{
+		ShortResponse: true,
+	})
+	finalizeDuration := time.Since(finalizeStart)
+	if err != nil {
+		recordFailure(
+			groupMintTestCase, opMintFinalize, finalizeDuration,
+			err,
+		)
+	}
+	require.NoError(t, err)
+	observeLatency(groupMintTestCase, opMintFinalize, finalizeDuration)
+
+	confirmStart := time.Now()
+	itest.MineBlocks(t, bitcoinClient, 1, 1)
+	waitForMinBatchState(
+		t, ctx, minter, cfg.TestTimeout, batchKey,
+		mintrpc.BatchState_BATCH_STATE_FINALIZED,
+	)
+	observeLatency(
+		groupMintTestCase, opMintConfirm, time.Since(confirmStart),
+	)
+}
@@ -0,0 +1,133 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+)
+
+const (
+	// stateReuse runs a test case against the state the nodes accumulated
+	// in previous test cases and runs.
+	stateReuse = "reuse"
+
+	// stateFresh wipes the state of the nodes before a test case runs.
+	stateFresh = "fresh"
+
+	// defaultReadyTimeout is the default time to wait for the nodes under
+	// test to become ready again after the setup hooks ran.
+	defaultReadyTimeout = 5 * time.Minute
+)
+
+// HookConfig defines the shell commands that are run before and after every
+// test case. Like the chaos commands, they are specific to the deployment of
+// the nodes under test (e.g. docker or systemd). All options can be
+// overridden per test case, so only some of the test cases start against a
+// fresh state.
+type HookConfig struct {
+	State string `long:"state" description:"whether a test case starts against the state the nodes accumulated in previous runs or against a fresh state, in which case the wipe commands are run before the test case" choice:"reuse" choice:"fresh"`
+
+	WipeCmds []string `long:"wipe-cmd" description:"a shell command that wipes the state (database and proofs) of one of the tapd nodes under test and restarts it, run before every test case in the fresh state; can be specified multiple times"`
+
+	SetupCmds []string `long:"setup-cmd" description:"a shell command that is run before every test case, after the state was wiped; can be specified multiple times"`
+
+	TeardownCmds []string `long:"teardown-cmd" description:"a shell command that is run after every test case, whatever its result; can be specified multiple times"`
+
+	ReadyTimeout time.Duration `long:"ready-timeout" description:"the maximum time to wait for all nodes under test to accept RPC calls again after the wipe and setup commands ran"`
+}
+
+// validate makes sure the hook configuration is consistent.
+func (h *HookConfig) validate() error {
+	if h.State == stateFresh && len(h.WipeCmds) == 0 {
+		return fmt.Errorf("wipe commands must be set to run test " +
+			"cases against a fresh state")
+	}
+
+	if h.ReadyTimeout <= 0 {
+		return fmt.Errorf("ready timeout must be positive")
+	}
+
+	return nil
+}
+
+// setup wipes the state of the nodes under test, if configured, and runs the
+// setup commands. If any command was run, it waits for all nodes of the
+// topology to be ready again, as the commands might have restarted them.
+func (h *HookConfig) setup(t *testing.T, ctx context.Context, cfg *Config) {
+	var cmds []string
+	if h.State == stateFresh {
+		cmds = append(cmds, h.WipeCmds...)
+	}
+	cmds = append(cmds, h.SetupCmds...)
+
+	if len(cmds) == 0 {
+		return
+	}
+
+	startTime := time.Now()
+	for _, cmd := range cmds {
+		t.Logf("Running setup command '%s'", cmd)
+
+		if err := runShellCmd(ctx, cmd); err != nil {
+			t.Fatalf("Unable to set up test case: %v", err)
+		}
+	}
+
+	// The commands might have restarted the nodes with new credentials,
+	// so the pooled connections are dialed again.
+	if err := connections.closeAll(); err != nil {
+		t.Logf("Unable to close pooled connections: %v", err)
+	}
+
+	if err := waitNodesReady(ctx, cfg, h.ReadyTimeout); err != nil {
+		t.Fatalf("Nodes not ready after setup: %v", err)
+	}
+
+	t.Logf("Set up test case with %v state in %v", h.State,
+		time.Since(startTime))
+}
+
+// teardown runs the teardown commands. A failing command fails the test case,
+// but all commands are run regardless, so the environment isn't left half torn
+// down.
+func (h *HookConfig) teardown(t *testing.T, ctx context.Context) {
+	for _, cmd := range h.TeardownCmds {
+		t.Logf("Running teardown command '%s'", cmd)
+
+		if err := runShellCmd(ctx, cmd); err != nil {
+			t.Errorf("Unable to tear down test case: %v", err)
+		}
+	}
+}
+
+// waitNodesReady waits until every tapd node of the configured topology
+// accepts RPC calls. The calls fail fast instead of waiting for a node to
+// come up, so the connection is retried until the timeout expires.
+func waitNodesReady(ctx context.Context, cfg *Config,
+	timeout time.Duration) error {
+
+	for _, nodeCfg := range cfg.topologyNodes() {
+		nodeCfg := nodeCfg
+
+		err := wait.NoError(func() error {
+			client, conn, err := dialTapClient(ctx, nodeCfg)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			_, err = client.GetInfo(ctx, &taprpc.GetInfoRequest{})
+			return err
+		}, timeout)
+		if err != nil {
+			return fmt.Errorf("node %v not ready: %w", nodeCfg.Name,
+				err)
+		}
+	}
+
+	return nil
+}
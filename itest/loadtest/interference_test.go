@@ -0,0 +1,118 @@
+package loadtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+)
+
+const (
+	// interferenceIsolated is the test case label used for the operations
+	// of the interference test that run on their own.
+	interferenceIsolated = "interference_isolated"
+
+	// interferenceConcurrent is the test case label used for the
+	// operations of the interference test that run while the other
+	// operation type is running at the same time.
+	interferenceConcurrent = "interference_concurrent"
+)
+
+// interferenceTest measures how much minting and sending on the same node slow
+// each other down. It first mints and then sends the configured number of
+// times on alice on their own, to get a baseline. It then keeps minting on
+// alice while sending the same number of times from alice to bob and compares
+// the latencies of both operations to their baseline.
+func interferenceTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+
+	var (
+		numOps     = cfg.InterferenceNumOps
+		amount     = cfg.InterferenceAmount
+		mintAmount = uint64(numOps) * amount
+		miner      = newBlockMiner(bitcoinClient, cfg.Chaos)
+	)
+
+	// Make sure alice has enough units for all sends of both phases, even
+	// before the assets minted during the test are finalized.
+	mintNormalAsset(
+		t, cfg, alice, bitcoinClient, "interference", 2*mintAmount,
+	)
+
+	t.Logf("Running interference test, minting and sending %d time(s) "+
+		"on %v in isolation and concurrently", numOps, alice.cfg.Name)
+
+	// We start with the baseline of both operations on their own.
+	var isolatedMint, isolatedSend time.Duration
+	for i := 0; i < numOps; i++ {
+		isolatedMint += mintWithMiner(
+			t, ctx, cfg, interferenceIsolated, alice, miner,
+			mintAmount,
+		)
+	}
+	for i := 0; i < numOps; i++ {
+		isolatedSend += sendAssets(
+			t, ctx, interferenceIsolated, amount,
+			taprpc.AssetType_NORMAL, alice, bob, miner,
+		)
+	}
+
+	// Now we keep minting for as long as the sends are running, so every
+	// send competes with a mint.
+	var (
+		concurrentMint, concurrentSend time.Duration
+		numMints                       int
+		sendsDone                      = make(chan struct{})
+	)
+	success := t.Run("concurrent", func(t *testing.T) {
+		t.Run("minter", func(t *testing.T) {
+			t.Parallel()
+
+			for {
+				concurrentMint += mintWithMiner(
+					t, ctx, cfg, interferenceConcurrent,
+					alice, miner, mintAmount,
+				)
+				numMints++
+
+				select {
+				case <-sendsDone:
+					return
+
+				default:
+				}
+			}
+		})
+
+		t.Run("sender", func(t *testing.T) {
+			t.Parallel()
+			defer close(sendsDone)
+
+			for i := 0; i < numOps; i++ {
+				concurrentSend += sendAssets(
+					t, ctx, interferenceConcurrent, amount,
+					taprpc.AssetType_NORMAL, alice, bob,
+					miner,
+				)
+			}
+		})
+	})
+	if !success {
+		return
+	}
+
+	var (
+		avgIsolatedMint   = isolatedMint / time.Duration(numOps)
+		avgIsolatedSend   = isolatedSend / time.Duration(numOps)
+		avgConcurrentMint = concurrentMint / time.Duration(numMints)
+		avgConcurrentSend = concurrentSend / time.Duration(numOps)
+	)
+	t.Logf("Average mint duration %v in isolation, %v while sending "+
+		"(%.2fx)", avgIsolatedMint, avgConcurrentMint,
+		avgConcurrentMint.Seconds()/avgIsolatedMint.Seconds())
+	t.Logf("Average send duration %v in isolation, %v while minting "+
+		"(%.2fx)", avgIsolatedSend, avgConcurrentSend,
+		avgConcurrentSend.Seconds()/avgIsolatedSend.Seconds())
+}
@@ -0,0 +1,187 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+)
+
+const (
+	// labelCommit is the label of the pushed metrics that identifies the
+	// tapd commit under test.
+	labelCommit = "tapd_commit"
+
+	// labelNetwork is the label of the pushed metrics that identifies the
+	// chain network the nodes under test run on.
+	labelNetwork = "network"
+
+	// labelDBBackend is the label of the pushed metrics that identifies
+	// the database backend of the nodes under test.
+	labelDBBackend = "db_backend"
+
+	// labelNodeAlias is the label of the pushed metrics that identifies
+	// the alias of the main node under test.
+	labelNodeAlias = "node_alias"
+
+	// dbBackendSqlite is the label value of the sqlite database backend.
+	dbBackendSqlite = "sqlite"
+
+	// dbBackendPostgres is the label value of the Postgres database
+	// backend.
+	dbBackendPostgres = "postgres"
+
+	// unknownLabel is the label value used if a label can't be determined.
+	unknownLabel = "unknown"
+)
+
+var (
+	// nodeInfo is an info metric that describes every node under test. Its
+	// value is always one, the description is in its labels, so it can be
+	// joined with the other per-node metrics.
+	nodeInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "target_info",
+			Help: "Description of the node under test, the value " +
+				"is always 1",
+		}, []string{
+			"node", labelNodeAlias, labelCommit, labelNetwork,
+			labelDBBackend,
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(nodeInfo)
+}
+
+// labelSet is a set of labels that is attached to all pushed metrics.
+type labelSet struct {
+	sync.Mutex
+
+	labels map[string]string
+}
+
+// runLabels are the labels that identify the setup of the current run. They
+// are attached to all pushed metrics, so runs across versions and backends can
+// be compared in the same dashboard.
+var runLabels = &labelSet{}
+
+// set replaces all labels of the set.
+func (s *labelSet) set(labels map[string]string) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.labels = labels
+}
+
+// get returns a copy of all labels of the set.
+func (s *labelSet) get() map[string]string {
+	s.Lock()
+	defer s.Unlock()
+
+	labels := make(map[string]string, len(s.labels))
+	for name, value := range s.labels {
+		labels[name] = value
+	}
+
+	return labels
+}
+
+// dbBackend returns the database backend of the node. If it isn't configured
+// explicitly, it is derived from the configured database location.
+func (c *TapConfig) dbBackend() string {
+	switch {
+	case c.DBBackend != "":
+		return c.DBBackend
+
+	case c.PostgresDSN != "":
+		return dbBackendPostgres
+
+	case c.DBPath != "":
+		return dbBackendSqlite
+
+	default:
+		return unknownLabel
+	}
+}
+
+// commitFromVersion extracts the commit from the version string a tapd node
+// reports, which has the form "<version> commit=<commit>". The whole version
+// is returned if it doesn't contain a commit.
+func commitFromVersion(version string) string {
+	_, commit, ok := strings.Cut(version, "commit=")
+	commit = strings.TrimSpace(commit)
+
+	switch {
+	case ok && commit != "":
+		return commit
+
+	case version != "":
+		return version
+
+	default:
+		return unknownLabel
+	}
+}
+
+// nodeLabels returns the labels that describe the node with the given config
+// and info.
+func nodeLabels(cfg *TapConfig,
+	info *taprpc.GetInfoResponse) map[string]string {
+
+	alias := info.NodeAlias
+	if alias == "" {
+		alias = cfg.Name
+	}
+
+	network := info.Network
+	if network == "" {
+		network = unknownLabel
+	}
+
+	return map[string]string{
+		labelNodeAlias: alias,
+		labelCommit:    commitFromVersion(info.Version),
+		labelNetwork:   network,
+		labelDBBackend: cfg.dbBackend(),
+	}
+}
+
+// collectNodeInfo queries the info of all nodes of the configured topology and
+// records it in the node info metric. The labels of alice, the main node under
+// test, become the labels of the run.
+func collectNodeInfo(ctx context.Context, cfg *Config,
+	dialOpts ...grpc.DialOption) error {
+
+	for idx, nodeCfg := range cfg.topologyNodes() {
+		client, conn, err := dialTapClient(ctx, nodeCfg, dialOpts...)
+		if err != nil {
+			return err
+		}
+
+		info, err := client.GetInfo(ctx, &taprpc.GetInfoRequest{})
+		conn.Close()
+		if err != nil {
+			return fmt.Errorf("unable to query info of %v: %w",
+				nodeCfg.Name, err)
+		}
+
+		labels := nodeLabels(nodeCfg, info)
+		nodeInfo.WithLabelValues(
+			nodeCfg.Name, labels[labelNodeAlias],
+			labels[labelCommit], labels[labelNetwork],
+			labels[labelDBBackend],
+		).Set(1)
+
+		if idx == 0 {
+			runLabels.set(labels)
+		}
+	}
+
+	return nil
+}
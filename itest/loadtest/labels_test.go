@@ -0,0 +1,50 @@
+package loadtest
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNodeLabels tests that the labels of a node are derived from its info and
+// config.
+func TestNodeLabels(t *testing.T) {
+	t.Parallel()
+
+	labels := nodeLabels(&TapConfig{
+		Name:   "alice",
+		DBPath: "/tapd.db",
+	}, &taprpc.GetInfoResponse{
+		Version:   "0.4.0-alpha commit=v0.4.0-12-gabcdef",
+		Network:   "regtest",
+		NodeAlias: "alice-lnd",
+	})
+	require.Equal(t, map[string]string{
+		labelNodeAlias: "alice-lnd",
+		labelCommit:    "v0.4.0-12-gabcdef",
+		labelNetwork:   "regtest",
+		labelDBBackend: dbBackendSqlite,
+	}, labels)
+
+	// Missing info falls back to the config or to unknown values.
+	labels = nodeLabels(&TapConfig{
+		Name:        "bob",
+		PostgresDSN: "postgres://localhost/tapd",
+		DBBackend:   dbBackendSqlite,
+	}, &taprpc.GetInfoResponse{
+		Version: "0.4.0-alpha",
+	})
+	require.Equal(t, map[string]string{
+		labelNodeAlias: "bob",
+		labelCommit:    "0.4.0-alpha",
+		labelNetwork:   unknownLabel,
+		labelDBBackend: dbBackendSqlite,
+	}, labels)
+
+	require.Equal(t, dbBackendPostgres, (&TapConfig{
+		PostgresDSN: "postgres://localhost/tapd",
+	}).dbBackend())
+	require.Equal(t, unknownLabel, (&TapConfig{}).dbBackend())
+	require.Equal(t, unknownLabel, commitFromVersion(""))
+}
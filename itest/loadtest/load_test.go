@@ -4,11 +4,20 @@ package loadtest
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/lightninglabs/taproot-assets/itest"
 	"github.com/stretchr/testify/require"
 )
 
+// soakTestCase is the grouping name under which the rolling metrics of a soak
+// test are pushed.
+const soakTestCase = "soak"
+
 type testCase struct {
 	name string
 	fn   func(t *testing.T, ctx context.Context, cfg *Config)
@@ -19,10 +28,58 @@ var loadTestCases = []testCase{
 		name: "mint",
 		fn:   mintTest,
 	},
+	{
+		name: "mintsweep",
+		fn:   mintSweepTest,
+	},
 	{
 		name: "send",
 		fn:   sendTest,
 	},
+	{
+		name: "groupmint",
+		fn:   groupMintTest,
+	},
+	{
+		name: "multisend",
+		fn:   multiSendTest,
+	},
+	{
+		name: "burn",
+		fn:   burnTest,
+	},
+	{
+		name: "sync",
+		fn:   syncTest,
+	},
+	{
+		name: "courier",
+		fn:   courierTest,
+	},
+	{
+		name: "addrgen",
+		fn:   addrGenTest,
+	},
+	{
+		name: "mixed",
+		fn:   mixedTest,
+	},
+	{
+		name: "reorg",
+		fn:   reorgTest,
+	},
+	{
+		name: "federation",
+		fn:   federationTest,
+	},
+	{
+		name: "interference",
+		fn:   interferenceTest,
+	},
+	{
+		name: "feebump",
+		fn:   feeBumpTest,
+	},
 }
 
 // TestPerformance executes the configured performance tests.
@@ -30,10 +87,114 @@ func TestPerformance(t *testing.T) {
 	cfg, err := LoadConfig()
 	require.NoError(t, err, "unable to load main config")
 
+	// Derive all random choices from the configured seed, so a failing run
+	// can be replayed by setting the same seed.
+	loadRand.seed(cfg.Seed)
+	t.Logf("Using random seed %d", cfg.Seed)
+
+	// On signet, the tests wait for the signet miner to confirm their
+	// transactions instead of mining blocks themselves.
+	itest.BlockWaitTimeout = cfg.Bitcoin.BlockTimeout
+
 	ctxb := context.Background()
 	ctxt, cancel := context.WithTimeout(ctxb, cfg.TestSuiteTimeout)
 	defer cancel()
 
+	// All test cases share the same connections to the nodes under test,
+	// which are only closed once the whole suite completed.
+	t.Cleanup(func() {
+		require.NoError(t, connections.closeAll())
+	})
+
+	// Label all metrics with the setup of the nodes under test, so runs
+	// can be compared across versions and backends.
+	err = collectNodeInfo(ctxt, cfg, clientDialOpts(cfg)...)
+	require.NoError(t, err)
+	t.Logf("Running against %v", runLabels.get())
+
+	// Once the suite timeout fires, the workers of the running test case
+	// are canceled through the context. Everything they record from then
+	// on is marked as aborted, so the partial results can be told apart.
+	stopAbort := context.AfterFunc(ctxt, func() {
+		if errors.Is(ctxt.Err(), context.DeadlineExceeded) {
+			results.abort()
+		}
+	})
+	defer stopAbort()
+
+	// Write the results of all operations once the run completes, even
+	// if one of the test cases failed.
+	if cfg.ResultsFile != "" {
+		t.Cleanup(func() {
+			if results.isAborted() {
+				t.Logf("Writing partial results of aborted "+
+					"run to %v", cfg.ResultsFile)
+			}
+
+			err := results.writeFile(
+				cfg.ResultsFile, cfg.ResultsFormat,
+			)
+			require.NoError(t, err)
+		})
+	}
+
+	// The report is rendered from the same results, so it also covers
+	// failed and aborted runs.
+	if cfg.ReportFile != "" {
+		t.Cleanup(func() {
+			err := writeReport(
+				cfg.ReportFile, cfg, results.snapshot(),
+			)
+			require.NoError(t, err)
+
+			t.Logf("Wrote load test report to %v", cfg.ReportFile)
+		})
+	}
+
+	// Serve the metrics for pull based Prometheus setups for the whole
+	// duration of the run, if configured.
+	if cfg.PrometheusServer.Enabled {
+		server, err := startMetricsServer(cfg.PrometheusServer)
+		require.NoError(t, err)
+
+		t.Logf("Serving metrics on http://%v/metrics", server.addr())
+
+		t.Cleanup(func() {
+			// Give Prometheus the chance to scrape the final values
+			// before we shut down.
+			if cfg.PrometheusServer.Linger > 0 {
+				t.Logf("Keeping metrics endpoint up for %v",
+					cfg.PrometheusServer.Linger)
+				time.Sleep(cfg.PrometheusServer.Linger)
+			}
+
+			require.NoError(t, server.stop(ctxb))
+		})
+	}
+
+	// In soak mode we keep running the configured test cases until the
+	// soak deadline is reached. With a backend matrix, every test case is
+	// run once against every backend.
+	switch {
+	case len(cfg.MatrixAlice) > 0:
+		runMatrix(t, ctxt, cfg)
+
+	case cfg.SoakDuration > 0:
+		runSoak(t, ctxt, cfg)
+
+	default:
+		runTestCases(t, ctxt, cfg)
+	}
+
+	// Finally, fail the run if the results don't meet the configured
+	// service level objectives.
+	for _, violation := range checkSLOs(cfg.SLOs, results.snapshot()) {
+		t.Error(violation)
+	}
+}
+
+// runTestCases runs every configured test case once.
+func runTestCases(t *testing.T, ctx context.Context, cfg *Config) {
 	for _, tc := range loadTestCases {
 		tc := tc
 
@@ -44,17 +205,206 @@ func TestPerformance(t *testing.T) {
 			continue
 		}
 
-		success := t.Run(tc.name, func(tt *testing.T) {
-			ctxt, cancel := context.WithTimeout(
-				ctxt, cfg.TestTimeout,
-			)
-			defer cancel()
+		runTestCase(t, ctx, cfg, tc, tc.name, true)
+	}
+}
+
+// runMatrix runs every configured test case once against every database
+// backend of the backend matrix, in a sub test named after the backend. The
+// metrics are labeled with the backend under test and reset in between, so
+// the runs of all backends can be compared side by side.
+func runMatrix(t *testing.T, ctx context.Context, cfg *Config) {
+	backendCfgs, err := cfg.backendConfigs()
+	require.NoError(t, err)
+
+	for _, backendCfg := range backendCfgs {
+		backendCfg := backendCfg
+
+		err := collectNodeInfo(
+			ctx, backendCfg, clientDialOpts(backendCfg)...,
+		)
+		require.NoError(t, err)
 
-			tc.fn(t, ctxt, cfg)
+		backend := runLabels.get()[labelDBBackend]
+		t.Logf("Running test cases against %v", runLabels.get())
+
+		resetRunMetrics()
+		t.Run(backend, func(t *testing.T) {
+			runTestCases(t, ctx, backendCfg)
 		})
-		if !success {
-			t.Fatalf("test case %v failed", tc.name)
+	}
+}
+
+// runTestCase runs a single test case as a sub test with the given name and
+// fails the whole suite if the test case fails. The test case runs with its
+// own configuration overrides applied. If push is true, the metrics of the
+// test case are pushed once it completed, whatever its result.
+func runTestCase(t *testing.T, ctx context.Context, mainCfg *Config,
+	tc testCase, name string, push bool) {
+
+	cfg, err := mainCfg.forTestCase(tc.name)
+	require.NoError(t, err)
+
+	var timedOut bool
+	success := t.Run(name, func(tt *testing.T) {
+		ctxt, cancel := context.WithTimeout(ctx, cfg.TestTimeout)
+		defer cancel()
+
+		// A test case that fails after its timeout expired is reported
+		// as timed out rather than failed.
+		defer func() {
+			timedOut = errors.Is(
+				ctxt.Err(), context.DeadlineExceeded,
+			)
+		}()
+
+		// Start the test case against a fresh or the accumulated state
+		// of the nodes, as configured. The teardown runs even if the
+		// test case timed out, so it can't use the test case's
+		// context.
+		cfg.Hooks.setup(tt, ctxt, cfg)
+		defer cfg.Hooks.teardown(tt, ctx)
+
+		// Operations are executed but not recorded until the warm-up
+		// phase of the test case is over.
+		if cfg.WarmupDuration > 0 {
+			tt.Logf("Warming up for %v before recording metrics",
+				cfg.WarmupDuration)
+		}
+		measurement.startAfter(cfg.WarmupDuration)
+
+		// Inject failures for as long as the test case is running, if
+		// configured.
+		if cfg.Chaos.Enabled {
+			monkey := newChaosMonkey(cfg.Chaos)
+			monkey.start(tt, ctxt)
+			defer monkey.stop()
+		}
+
+		// Scrape the resource usage of the nodes under test while the
+		// test case is running, so it can be correlated with the
+		// latency of the operations.
+		if nodes := resourceNodes(cfg); len(nodes) > 0 {
+			monitor := newResourceMonitor(
+				nodes, cfg.ResourceScrapeInterval,
+			)
+			monitor.start(tt, ctxt)
+			defer monitor.stop(tt, ctx)
+		}
+
+		tc.fn(tt, ctxt, cfg)
+	})
+
+	result := resultSuccess
+	switch {
+	case success:
+	case results.isAborted():
+		result = resultAborted
+
+	case timedOut:
+		result = resultTimeout
+
+	default:
+		result = resultFailure
+	}
+	testCaseRuns.WithLabelValues(tc.name, result).Inc()
+
+	// Push the metrics the test case collected, if configured. This also
+	// flushes the metrics a failed test case recorded before it failed.
+	// The results file is written by the cleanup of the suite.
+	if push && cfg.PrometheusGateway.Enabled {
+		err := pushMetrics(cfg.PrometheusGateway, name, result)
+		switch {
+		case err != nil && success:
+			require.NoError(t, err)
+
+		case err != nil:
+			t.Logf("Unable to push metrics of test case %v: %v",
+				name, err)
+		}
+	}
+
+	switch result {
+	case resultSuccess:
+	case resultAborted:
+		t.Fatalf("test case %v aborted after suite timeout of %v", name,
+			cfg.TestSuiteTimeout)
+
+	case resultTimeout:
+		t.Fatalf("test case %v timed out after %v", name,
+			cfg.TestTimeout)
+
+	default:
+		t.Fatalf("test case %v failed", name)
+	}
+}
+
+// runSoak continuously loops over the configured test cases until the soak
+// duration has elapsed. If the push gateway is enabled, the rolling metrics
+// are pushed in the configured interval for the whole duration of the soak.
+func runSoak(t *testing.T, ctx context.Context, cfg *Config) {
+	soakDeadline := time.Now().Add(cfg.SoakDuration)
+
+	t.Logf("Running soak test until %v", soakDeadline)
+
+	if cfg.PrometheusGateway.Enabled {
+		quit := make(chan struct{})
+		var wg sync.WaitGroup
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ticker := time.NewTicker(cfg.SoakPushInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					err := pushMetrics(
+						cfg.PrometheusGateway,
+						soakTestCase, "",
+					)
+					if err != nil {
+						t.Logf("Unable to push "+
+							"rolling metrics: %v",
+							err)
+					}
+
+				case <-quit:
+					return
+				}
+			}
+		}()
+
+		defer func() {
+			close(quit)
+			wg.Wait()
+
+			// Make sure the metrics of the last iteration are
+			// pushed as well.
+			err := pushMetrics(
+				cfg.PrometheusGateway, soakTestCase, "",
+			)
+			require.NoError(t, err)
+		}()
+	}
+
+	for iteration := 1; time.Now().Before(soakDeadline); iteration++ {
+		for _, tc := range loadTestCases {
+			if !shouldRunCase(tc.name, cfg.TestCases) {
+				continue
+			}
+
+			if !time.Now().Before(soakDeadline) {
+				break
+			}
+
+			name := fmt.Sprintf("%s-%d", tc.name, iteration)
+			runTestCase(t, ctx, cfg, tc, name, false)
 		}
+
+		t.Logf("Finished soak iteration %d", iteration)
 	}
 }
 
@@ -0,0 +1,79 @@
+package loadtest
+
+import (
+	"fmt"
+)
+
+// backendConfigs returns the configuration of every database backend of the
+// backend matrix, starting with the main configuration. The configuration of
+// an additional backend is the main configuration with alice and bob replaced
+// by the nodes of that backend. The additional nodes of the topology run on
+// the main backend, so they are left out of the other backends.
+func (c *Config) backendConfigs() ([]*Config, error) {
+	if len(c.MatrixAlice) == 0 && len(c.MatrixBob) == 0 {
+		return []*Config{c}, nil
+	}
+
+	// The backend is what tells the runs apart, so it must be known for
+	// every node of the matrix.
+	mainBackend := c.Alice.Tapd.dbBackend()
+	if mainBackend == unknownLabel {
+		return nil, fmt.Errorf("the database backend of alice must " +
+			"be configured to run a backend matrix")
+	}
+
+	bobs := make(map[string]*NodeSpec, len(c.MatrixBob))
+	for _, bob := range c.MatrixBob {
+		backend := bob.dbBackend()
+		switch {
+		case backend == unknownLabel:
+			return nil, fmt.Errorf("the database backend of "+
+				"matrix node %s must be configured", bob.Name)
+
+		case bobs[backend] != nil:
+			return nil, fmt.Errorf("more than one matrix-bob for "+
+				"backend %s", backend)
+		}
+
+		bobs[backend] = bob
+	}
+
+	cfgs := []*Config{c}
+	backends := map[string]struct{}{mainBackend: {}}
+	for _, alice := range c.MatrixAlice {
+		backend := alice.dbBackend()
+		if backend == unknownLabel {
+			return nil, fmt.Errorf("the database backend of "+
+				"matrix node %s must be configured", alice.Name)
+		}
+
+		if _, ok := backends[backend]; ok {
+			return nil, fmt.Errorf("backend %s is part of the "+
+				"matrix more than once", backend)
+		}
+		backends[backend] = struct{}{}
+
+		bob, ok := bobs[backend]
+		if !ok {
+			return nil, fmt.Errorf("no matrix-bob for backend %s",
+				backend)
+		}
+		delete(bobs, backend)
+
+		backendCfg := *c
+		backendCfg.Alice = &User{Tapd: &alice.TapConfig}
+		backendCfg.Bob = &User{Tapd: &bob.TapConfig}
+		backendCfg.Nodes = nil
+		backendCfg.MatrixAlice = nil
+		backendCfg.MatrixBob = nil
+
+		cfgs = append(cfgs, &backendCfg)
+	}
+
+	for backend := range bobs {
+		return nil, fmt.Errorf("no matrix-alice for backend %s",
+			backend)
+	}
+
+	return cfgs, nil
+}
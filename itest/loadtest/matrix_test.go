@@ -0,0 +1,85 @@
+package loadtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBackendConfigs tests that the backend matrix results in one
+// configuration per database backend.
+func TestBackendConfigs(t *testing.T) {
+	t.Parallel()
+
+	parse := func(value string) *NodeSpec {
+		var node NodeSpec
+		require.NoError(t, node.UnmarshalFlag(value))
+
+		return &node
+	}
+
+	cfg := DefaultConfig()
+	cfg.Alice.Tapd.DBPath = "/alice/tapd.db"
+	cfg.Nodes = []*NodeSpec{parse("name=carol,host=localhost,port=1")}
+
+	// Without a matrix, the main config is the only one.
+	cfgs, err := cfg.backendConfigs()
+	require.NoError(t, err)
+	require.Equal(t, []*Config{&cfg}, cfgs)
+
+	cfg.MatrixAlice = []*NodeSpec{
+		parse("name=alice-pg,host=localhost,port=2,dbbackend=postgres"),
+	}
+	cfg.MatrixBob = []*NodeSpec{
+		parse("name=bob-pg,host=localhost,port=3," +
+			"postgresdsn=postgres://localhost/tapd"),
+	}
+
+	mainCfg, err := ValidateConfig(cfg)
+	require.NoError(t, err)
+
+	cfgs, err = mainCfg.backendConfigs()
+	require.NoError(t, err)
+	require.Len(t, cfgs, 2)
+	require.Same(t, mainCfg, cfgs[0])
+
+	pgCfg := cfgs[1]
+	require.Equal(t, "alice-pg", pgCfg.Alice.Tapd.Name)
+	require.Equal(t, "bob-pg", pgCfg.Bob.Tapd.Name)
+	require.Empty(t, pgCfg.Nodes)
+	require.Empty(t, pgCfg.MatrixAlice)
+	require.Equal(t, mainCfg.NumSends, pgCfg.NumSends)
+
+	// The main config is unaffected.
+	require.Equal(t, "alice", mainCfg.Alice.Tapd.Name)
+	require.Len(t, mainCfg.Nodes, 1)
+
+	// Every backend needs both nodes and must only be part of the matrix
+	// once.
+	invalid := map[string]func(cfg *Config){
+		"no matrix-bob": func(cfg *Config) {
+			cfg.MatrixBob = nil
+		},
+		"no matrix-alice": func(cfg *Config) {
+			cfg.MatrixAlice = nil
+		},
+		"more than once": func(cfg *Config) {
+			cfg.Alice.Tapd.DBBackend = dbBackendPostgres
+		},
+		"must be configured": func(cfg *Config) {
+			cfg.Alice.Tapd.DBPath = ""
+		},
+		"soak mode": func(cfg *Config) {
+			cfg.SoakDuration = cfg.TestTimeout
+		},
+	}
+	for errStr, modify := range invalid {
+		invalidCfg := cfg
+		alice := *cfg.Alice.Tapd
+		invalidCfg.Alice = &User{Tapd: &alice}
+		modify(&invalidCfg)
+
+		_, err := ValidateConfig(invalidCfg)
+		require.ErrorContains(t, err, errStr)
+	}
+}
@@ -0,0 +1,487 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// pushGatewayJob is the name of the job under which all load test
+	// metrics are pushed to the Prometheus push gateway.
+	pushGatewayJob = "load_test"
+
+	// resultSuccess is the result label of a test case that passed.
+	resultSuccess = "success"
+
+	// resultFailure is the result label of a test case that failed before
+	// its timeout.
+	resultFailure = "failure"
+
+	// resultTimeout is the result label of a test case that failed because
+	// its test timeout expired.
+	resultTimeout = "timeout"
+
+	// resultAborted is the result label of a test case that was aborted by
+	// the suite timeout.
+	resultAborted = "aborted"
+
+	// opMintBatch is the operation label used for the time it takes to
+	// mint and confirm a full batch of assets.
+	opMintBatch = "mint_batch"
+
+	// opMintQueue is the operation label used for the time it takes to
+	// queue all seedlings of a batch.
+	opMintQueue = "mint_queue"
+
+	// opMintFinalize is the operation label used for the time it takes to
+	// finalize a batch, which includes funding, signing and broadcasting
+	// the minting transaction.
+	opMintFinalize = "mint_finalize"
+
+	// opMintConfirm is the operation label used for the time it takes the
+	// minter to detect the confirmation of a minting transaction.
+	opMintConfirm = "mint_confirm"
+
+	// opMintProofs is the operation label used for the time it takes the
+	// minter to generate and import the proofs of a confirmed batch.
+	opMintProofs = "mint_proofs"
+
+	// opTransfer is the operation label used for the time it takes for an
+	// asset transfer to complete, from submitting it on the sending node
+	// until the receiving node has imported the proof.
+	opTransfer = "transfer"
+
+	// opProofImport is the operation label used for the time it takes the
+	// receiving node to import the proof of a transfer after the anchor
+	// transaction confirmed.
+	opProofImport = "proof_import"
+
+	// opReceiveVerify is the operation label used for the time it takes
+	// the receiving node to fetch, verify and import the proof of a
+	// transfer after it noticed the confirmation of the anchor
+	// transaction. Unlike opProofImport, this excludes the time it takes
+	// the receiver to learn about the confirmation.
+	opReceiveVerify = "receive_verify"
+
+	// opBurn is the operation label used for the time it takes for a burn
+	// to be confirmed and its proof to be updated.
+	opBurn = "burn"
+
+	// opSyncFull is the operation label used for the time it takes to
+	// sync all universes from a universe server.
+	opSyncFull = "sync_full"
+
+	// opSyncIncremental is the operation label used for the time it takes
+	// to sync only the universes of newly minted assets from a universe
+	// server.
+	opSyncIncremental = "sync_incremental"
+
+	// opSyncLeafInsert is the operation label used for the average time it
+	// takes to insert a single new leaf during a universe sync.
+	opSyncLeafInsert = "sync_leaf_insert"
+
+	// opGroupLeafQuery is the operation label used for the time it takes
+	// to query all issuance leaves of an asset group's universe.
+	opGroupLeafQuery = "group_leaf_query"
+
+	// opCourierDeliver is the operation label used for the time it takes
+	// to deliver a proof through a proof courier.
+	opCourierDeliver = "courier_deliver"
+
+	// opCourierReceive is the operation label used for the time it takes
+	// from starting the delivery of a proof until it was received from
+	// the proof courier.
+	opCourierReceive = "courier_receive"
+
+	// opAddrNew is the operation label used for the time it takes to
+	// create a new address.
+	opAddrNew = "addr_new"
+
+	// opAddrList is the operation label used for the time it takes to
+	// query all addresses of a node.
+	opAddrList = "addr_list"
+
+	// opAddrDetect is the operation label used for the time it takes
+	// from sending to an address until the receiver detected the
+	// inbound transfer.
+	opAddrDetect = "addr_detect"
+
+	// opReorgRecovery is the operation label used for the time it takes
+	// from invalidating blocks until all proofs anchored in them are
+	// updated to the new chain.
+	opReorgRecovery = "reorg_recovery"
+
+	// opFederationConverge is the operation label used for the time it
+	// takes from minting new assets until every member of a universe
+	// federation knows about them.
+	opFederationConverge = "federation_converge"
+
+	// opFeeBump is the operation label used for the time it takes a node
+	// to re-sign and re-broadcast the anchor transaction of a pending
+	// transfer at a higher fee rate.
+	opFeeBump = "fee_bump"
+
+	// opFeeBumpMempool is the operation label used for the time it takes
+	// from requesting a fee bump until the replacement anchor transaction
+	// replaced the original one in the mempool of the bitcoin backend.
+	opFeeBumpMempool = "fee_bump_mempool"
+)
+
+var (
+	// labelNames are the labels all per-operation metrics are partitioned
+	// by.
+	labelNames = []string{"test_case", "operation"}
+
+	// operationLatency is a histogram of the latency of individual
+	// operations in seconds.
+	operationLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "operation_duration_seconds",
+			Help: "Latency of individual load test operations, " +
+				"in seconds",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 14),
+		}, labelNames,
+	)
+
+	// operationLatencyQuantiles tracks the p50, p95 and p99 latency of
+	// individual operations in seconds, as calculated by the load test
+	// client.
+	operationLatencyQuantiles = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name: "operation_duration_quantiles_seconds",
+			Help: "Latency quantiles of individual load test " +
+				"operations, in seconds",
+			Objectives: map[float64]float64{
+				0.5:  0.05,
+				0.95: 0.01,
+				0.99: 0.001,
+			},
+		}, labelNames,
+	)
+
+	// rpcLatency is a histogram of the latency of the individual RPC calls
+	// the load test client makes, partitioned by node and RPC method.
+	rpcLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "rpc_duration_seconds",
+			Help: "Latency of individual RPC calls made by the " +
+				"load test client, in seconds",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 16),
+		}, []string{"node", "method"},
+	)
+
+	// rpcErrors counts the RPC calls of the load test client that returned
+	// an error, partitioned by node, RPC method and gRPC status code.
+	rpcErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_errors_total",
+			Help: "Number of RPC calls made by the load test " +
+				"client that returned an error",
+		}, []string{"node", "method", "code"},
+	)
+
+	// courierRetries counts the delivery retries the proof couriers needed
+	// during the courier test, partitioned by test case.
+	courierRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "courier_delivery_retries_total",
+			Help: "Number of proof delivery retries made by the " +
+				"proof couriers",
+		}, []string{"test_case"},
+	)
+
+	// operationErrors counts the failed operations of every test case,
+	// so failures are visible even if no operation succeeded at all.
+	operationErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "operation_errors_total",
+			Help: "Number of failed load test operations",
+		}, labelNames,
+	)
+
+	// testCaseRuns counts the runs of every test case, partitioned by
+	// their result.
+	testCaseRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "test_case_runs_total",
+			Help: "Number of load test case runs by result",
+		}, []string{"test_case", "result"},
+	)
+
+	// queueDelay is a histogram of the time operations were issued later
+	// than scheduled by an open loop load profile, because all workers
+	// were still busy with previous operations. It has no labels, it is
+	// only a vector so it can be reset between the runs of a backend
+	// matrix.
+	queueDelay = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "operation_queue_delay_seconds",
+			Help: "Time operations were issued later than " +
+				"scheduled by the load profile, in seconds",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 16),
+		}, nil,
+	)
+)
+
+// measurementWindow keeps track of whether the current test case is still in
+// its warm-up phase, during which no latencies or errors are recorded.
+type measurementWindow struct {
+	sync.RWMutex
+
+	// start is the time from which on operations are recorded.
+	start time.Time
+}
+
+// startAfter starts a new warm-up phase of the given duration. Operations are
+// only recorded again once the warm-up phase is over.
+func (w *measurementWindow) startAfter(warmup time.Duration) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.start = time.Now().Add(warmup)
+}
+
+// active returns true if operations should currently be recorded.
+func (w *measurementWindow) active() bool {
+	w.RLock()
+	defer w.RUnlock()
+
+	return !time.Now().Before(w.start)
+}
+
+// measurement is the measurement window of the currently running test case.
+var measurement = &measurementWindow{}
+
+func init() {
+	// Register the metrics with Prometheus's default registry.
+	prometheus.MustRegister(operationLatency)
+	prometheus.MustRegister(operationLatencyQuantiles)
+	prometheus.MustRegister(rpcLatency)
+	prometheus.MustRegister(rpcErrors)
+	prometheus.MustRegister(courierRetries)
+	prometheus.MustRegister(queueDelay)
+	prometheus.MustRegister(operationErrors)
+	prometheus.MustRegister(testCaseRuns)
+}
+
+// observeQueueDelay records the time a single operation was issued later than
+// scheduled by the load profile.
+func observeQueueDelay(delay time.Duration) {
+	if !measurement.active() {
+		return
+	}
+
+	queueDelay.WithLabelValues().Observe(delay.Seconds())
+}
+
+// resetRunMetrics resets all metrics that are recorded by the operations of
+// the test cases. This is used between the runs of a backend matrix, so the
+// metrics pushed for one backend don't include the operations of another.
+func resetRunMetrics() {
+	operationLatency.Reset()
+	operationLatencyQuantiles.Reset()
+	rpcLatency.Reset()
+	rpcErrors.Reset()
+	courierRetries.Reset()
+	queueDelay.Reset()
+	operationErrors.Reset()
+	testCaseRuns.Reset()
+	clientConnDials.Reset()
+	clientConnStates.Reset()
+}
+
+// observeRPC records the latency and the outcome of a single RPC call to the
+// given node.
+func observeRPC(node, method string, latency time.Duration, rpcErr error) {
+	if !measurement.active() {
+		return
+	}
+
+	rpcLatency.WithLabelValues(node, method).Observe(latency.Seconds())
+
+	if rpcErr != nil {
+		code := status.Code(rpcErr).String()
+		rpcErrors.WithLabelValues(node, method, code).Inc()
+	}
+}
+
+// unaryMetricsInterceptor returns a client side unary interceptor that records
+// the latency and errors of every RPC call made to the given node.
+func unaryMetricsInterceptor(node string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+
+		startTime := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		observeRPC(node, method, time.Since(startTime), err)
+
+		return err
+	}
+}
+
+// streamMetricsInterceptor returns a client side stream interceptor that
+// records the time it takes to establish every stream to the given node, as
+// well as any errors while doing so.
+func streamMetricsInterceptor(node string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc,
+		cc *grpc.ClientConn, method string, streamer grpc.Streamer,
+		opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+		startTime := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		observeRPC(node, method, time.Since(startTime), err)
+
+		return stream, err
+	}
+}
+
+// observeLatency records the given latency of a single successful operation of
+// a test case. Operations that complete during the warm-up phase are ignored.
+func observeLatency(testCase, operation string, latency time.Duration) {
+	if !measurement.active() {
+		return
+	}
+
+	results.record(testCase, operation, latency, nil)
+
+	seconds := latency.Seconds()
+	operationLatency.WithLabelValues(testCase, operation).Observe(seconds)
+	operationLatencyQuantiles.WithLabelValues(testCase, operation).Observe(
+		seconds,
+	)
+}
+
+// recordFailure records a single operation of a test case that failed with the
+// given error after the given latency. Failures during the warm-up phase are
+// ignored.
+func recordFailure(testCase, operation string, latency time.Duration,
+	opErr error) {
+
+	if !measurement.active() {
+		return
+	}
+
+	results.record(testCase, operation, latency, opErr)
+	operationErrors.WithLabelValues(testCase, operation).Inc()
+}
+
+// pushMetrics pushes all collected metrics of the given test case to the
+// configured Prometheus push gateway, labeled with the setup of the run. If a
+// result is given, it is added as a label, so failed runs can be told apart
+// from successful ones. If the run was aborted, the metrics are pushed with an
+// additional aborted label.
+func pushMetrics(cfg *PrometheusGatewayConfig, testCase,
+	result string) error {
+
+	gatewayURL := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	pusher := push.New(gatewayURL, pushGatewayJob).
+		Collector(operationLatency).
+		Collector(operationLatencyQuantiles).
+		Collector(rpcLatency).
+		Collector(rpcErrors).
+		Collector(courierRetries).
+		Collector(queueDelay).
+		Collector(operationErrors).
+		Collector(testCaseRuns).
+		Collector(nodeCPUSeconds).
+		Collector(nodeMemory).
+		Collector(nodeOpenFDs).
+		Collector(nodeGoroutines).
+		Collector(nodeDBSize).
+		Collector(nodeDBTableSize).
+		Collector(nodeInfo).
+		Collector(clientConnDials).
+		Collector(clientConnStates).
+		Grouping("test_case", testCase)
+
+	// Identify the setup of the run, so runs across versions and backends
+	// can be compared.
+	for name, value := range runLabels.get() {
+		pusher = pusher.Grouping(name, value)
+	}
+
+	if result != "" {
+		pusher = pusher.Grouping("result", result)
+	}
+
+	// Metrics of a run that was cut short by the suite timeout are pushed
+	// into their own group, so they can be told apart from complete runs.
+	if results.isAborted() {
+		pusher = pusher.Grouping("aborted", "true")
+	}
+
+	if err := pusher.Push(); err != nil {
+		return fmt.Errorf("unable to push metrics to gateway %v: %w",
+			gatewayURL, err)
+	}
+
+	return nil
+}
+
+// metricsServer serves all collected metrics on a /metrics endpoint, so they
+// can be scraped by a pull based Prometheus setup.
+type metricsServer struct {
+	server   *http.Server
+	listener net.Listener
+	serveErr chan error
+}
+
+// startMetricsServer starts serving the collected metrics on the configured
+// listen address. An error is returned if the address can't be listened on.
+func startMetricsServer(cfg *PrometheusServerConfig) (*metricsServer, error) {
+	listener, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen on %v: %w",
+			cfg.ListenAddr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	s := &metricsServer{
+		server: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+		listener: listener,
+		serveErr: make(chan error, 1),
+	}
+
+	go func() {
+		err := s.server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.serveErr <- err
+		}
+		close(s.serveErr)
+	}()
+
+	return s, nil
+}
+
+// addr returns the address the metrics server is listening on.
+func (s *metricsServer) addr() string {
+	return s.listener.Addr().String()
+}
+
+// stop shuts down the metrics server and returns any error that occurred while
+// serving the metrics.
+func (s *metricsServer) stop(ctx context.Context) error {
+	if err := s.server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("unable to shut down metrics server: %w",
+			err)
+	}
+
+	return <-s.serveErr
+}
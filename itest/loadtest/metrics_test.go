@@ -0,0 +1,65 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMetricsServer tests that the collected metrics are served on the
+// /metrics endpoint and that the server shuts down cleanly.
+func TestMetricsServer(t *testing.T) {
+	server, err := startMetricsServer(&PrometheusServerConfig{
+		ListenAddr: "127.0.0.1:0",
+	})
+	require.NoError(t, err)
+
+	observeLatency("metrics-server", opTransfer, time.Second)
+	recordFailure(
+		"metrics-server", opBurn, time.Second, errors.New("failed"),
+	)
+
+	url := fmt.Sprintf("http://%s/metrics", server.addr())
+	resp, err := http.Get(url) //nolint:gosec
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(
+		t, string(body), `operation_duration_seconds_count{`+
+			`operation="transfer",test_case="metrics-server"} 1`,
+	)
+
+	// Failed operations are counted as well, so they show up even if no
+	// operation of the test case succeeded.
+	require.Contains(
+		t, string(body), `operation_errors_total{`+
+			`operation="burn",test_case="metrics-server"} 1`,
+	)
+
+	require.NoError(t, server.stop(context.Background()))
+}
+
+// TestMeasurementWindow tests that operations are only recorded once the
+// warm-up phase is over.
+func TestMeasurementWindow(t *testing.T) {
+	t.Parallel()
+
+	window := &measurementWindow{}
+	require.True(t, window.active())
+
+	window.startAfter(time.Hour)
+	require.False(t, window.active())
+
+	window.startAfter(0)
+	require.True(t, window.active())
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
-	"math/rand"
 	"strings"
 	"testing"
 	"time"
@@ -38,12 +37,10 @@ func mintTest(t *testing.T, ctx context.Context, cfg *Config) {
 		minterTimeout  = cfg.TestTimeout
 		batchSize      = cfg.BatchSize
 		batchReqs      = make([]*mintrpc.MintAssetRequest, batchSize)
-		baseName       = fmt.Sprintf("jpeg-%d", rand.Int31())
+		baseName       = fmt.Sprintf("jpeg-%d", loadRand.Int31())
 		metaPrefixSize = binary.MaxVarintLen16
 		metadataPrefix = make([]byte, metaPrefixSize)
-		aliceHost      = fmt.Sprintf(
-			"%s:%d", alice.cfg.Host, alice.cfg.Port,
-		)
+		aliceHost      = alice.hostPort()
 	)
 
 	// Before we mint a new group, let's first find out how many there
@@ -93,10 +90,12 @@ func mintTest(t *testing.T, ctx context.Context, cfg *Config) {
 	itest.LogfTimestamped(t, "beginning minting of batch of %d assets",
 		batchSize)
 
+	mintStart := time.Now()
 	mintBatch := itest.MintAssetsConfirmBatch(
 		t, bitcoinClient, alice, batchReqs,
 		itest.WithMintingTimeout(minterTimeout),
 	)
+	observeLatency("mint", opMintBatch, time.Since(mintStart))
 
 	itest.LogfTimestamped(t, "finished batch mint of %d assets", batchSize)
 
@@ -155,11 +154,37 @@ func mintTest(t *testing.T, ctx context.Context, cfg *Config) {
 	})
 	require.True(t, correctOp)
 
-	_, err = bob.AddFederationServer(
+	// Every universe server of the topology should now sync the new
+	// issuance proofs from alice.
+	pool := initNodePool(t, ctx, cfg, alice, bob)
+	for _, uniServer := range pool.withRole(roleUniverse) {
+		if uniServer == alice {
+			continue
+		}
+
+		syncFromFederationServer(
+			t, ctx, uniServer, aliceHost, minterTimeout,
+			func() bool {
+				return itest.AssertUniverseStateEqual(
+					t, alice, uniServer,
+				)
+			},
+		)
+	}
+}
+
+// syncFromFederationServer adds the given host as a federation server to the
+// syncing node (or kicks off a sync if it was added before) and waits until
+// the given condition is met.
+func syncFromFederationServer(t *testing.T, ctx context.Context,
+	syncer *rpcClient, host string, timeout time.Duration,
+	synced func() bool) {
+
+	_, err := syncer.AddFederationServer(
 		ctx, &unirpc.AddFederationServerRequest{
 			Servers: []*unirpc.UniverseFederationServer{
 				{
-					Host: aliceHost,
+					Host: host,
 				},
 			},
 		},
@@ -175,16 +200,13 @@ func mintTest(t *testing.T, ctx context.Context, cfg *Config) {
 		// If we've already added the server in a previous run, we'll
 		// just need to kick off a sync (as that would otherwise be done
 		// by adding the server request already).
-		_, err := bob.SyncUniverse(ctx, &unirpc.SyncRequest{
-			UniverseHost: aliceHost,
-			SyncMode:     unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY,
+		syncMode := unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY
+		_, err := syncer.SyncUniverse(ctx, &unirpc.SyncRequest{
+			UniverseHost: host,
+			SyncMode:     syncMode,
 		})
 		require.NoError(t, err)
 	}
 
-	require.Eventually(t, func() bool {
-		return itest.AssertUniverseStateEqual(
-			t, alice, bob,
-		)
-	}, minterTimeout, time.Second)
+	require.Eventually(t, synced, timeout, time.Second)
 }
@@ -0,0 +1,133 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/itest"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// mintSweepTest mints batches of increasing size and records the time it takes
+// to finalize, confirm and generate the proofs for each batch, so we can spot
+// super-linear behavior in the batch construction.
+func mintSweepTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, _, bitcoinClient := initClients(t, ctx, cfg)
+
+	for _, batchSize := range cfg.MintSweepBatchSizes {
+		var (
+			testCase  = fmt.Sprintf("mintsweep-%d", batchSize)
+			baseName  = fmt.Sprintf("sweep-%d", loadRand.Int31())
+			batchKey  []byte
+			mintStart = time.Now()
+		)
+
+		itest.LogfTimestamped(t, "queueing batch of %d assets",
+			batchSize)
+
+		for i := 0; i < batchSize; i++ {
+			mintReq := &mintrpc.MintAssetRequest{
+				Asset: &mintrpc.MintAsset{
+					AssetType: taprpc.AssetType_COLLECTIBLE,
+					Name: fmt.Sprintf(
+						"%s-%d", baseName, i,
+					),
+					AssetMeta: &taprpc.AssetMeta{
+						Data: []byte(baseName),
+					},
+					Amount: 1,
+				},
+				ShortResponse: true,
+			}
+			resp, err := alice.MintAsset(ctx, mintReq)
+			require.NoError(t, err)
+
+			batchKey = resp.PendingBatch.BatchKey
+		}
+		queueDuration := time.Since(mintStart)
+		observeLatency(testCase, opMintQueue, queueDuration)
+
+		// Finalizing the batch includes funding, signing and
+		// broadcasting the minting transaction.
+		finalizeStart := time.Now()
+		_, err := alice.FinalizeBatch(
+			ctx, &mintrpc.FinalizeBatchRequest{
+				ShortResponse: true,
+			},
+		)
+		finalizeDuration := time.Since(finalizeStart)
+		if err != nil {
+			recordFailure(
+				testCase, opMintFinalize, finalizeDuration, err,
+			)
+		}
+		require.NoError(t, err)
+		observeLatency(testCase, opMintFinalize, finalizeDuration)
+
+		// Mine a block and wait for the minter to notice the
+		// confirmation.
+		confirmStart := time.Now()
+		itest.MineBlocks(t, bitcoinClient, 1, 1)
+		waitForMinBatchState(
+			t, ctx, alice, cfg.TestTimeout, batchKey,
+			mintrpc.BatchState_BATCH_STATE_CONFIRMED,
+		)
+		confirmDuration := time.Since(confirmStart)
+		observeLatency(testCase, opMintConfirm, confirmDuration)
+
+		// Once confirmed, the minter creates and imports the proofs of
+		// all assets in the batch before marking it as finalized.
+		proofStart := time.Now()
+		waitForMinBatchState(
+			t, ctx, alice, cfg.TestTimeout, batchKey,
+			mintrpc.BatchState_BATCH_STATE_FINALIZED,
+		)
+		proofDuration := time.Since(proofStart)
+		observeLatency(testCase, opMintProofs, proofDuration)
+
+		t.Logf("Batch of %d assets: queueing took %v, finalization "+
+			"took %v, confirmation took %v, proof generation "+
+			"took %v", batchSize, queueDuration, finalizeDuration,
+			confirmDuration, proofDuration)
+	}
+}
+
+// waitForMinBatchState polls until the batch with the given key has reached at
+// least the given state. In contrast to itest.WaitForBatchState this also
+// succeeds if the batch already moved past the target state in between two
+// polls.
+func waitForMinBatchState(t *testing.T, ctx context.Context,
+	client *rpcClient, timeout time.Duration, batchKey []byte,
+	minState mintrpc.BatchState) {
+
+	err := wait.NoError(func() error {
+		batchResp, err := client.ListBatches(
+			ctx, &mintrpc.ListBatchRequest{
+				Filter: &mintrpc.ListBatchRequest_BatchKey{
+					BatchKey: batchKey,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		if len(batchResp.Batches) != 1 {
+			return fmt.Errorf("expected one batch, got %d",
+				len(batchResp.Batches))
+		}
+
+		state := batchResp.Batches[0].State
+		if state < minState {
+			return fmt.Errorf("expected batch state of at least "+
+				"%v, got %v", minState, state)
+		}
+
+		return nil
+	}, timeout)
+	require.NoError(t, err)
+}
@@ -0,0 +1,295 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// mixedTestCase is the test case label used for the mixed workload
+	// test.
+	mixedTestCase = "mixed"
+)
+
+// mixedOp is a single type of operation of the mixed workload.
+type mixedOp uint8
+
+const (
+	// mixedOpMint mints a new normal asset.
+	mixedOpMint mixedOp = iota
+
+	// mixedOpSend sends units of a normal asset between two nodes.
+	mixedOpSend
+
+	// mixedOpBurn burns units of a normal asset.
+	mixedOpBurn
+)
+
+// String returns a human readable name of the operation.
+func (o mixedOp) String() string {
+	switch o {
+	case mixedOpMint:
+		return "mint"
+
+	case mixedOpSend:
+		return "send"
+
+	case mixedOpBurn:
+		return "burn"
+
+	default:
+		return fmt.Sprintf("<unknown op %d>", uint8(o))
+	}
+}
+
+// mixedWorkload is the state shared between all workers of the mixed
+// workload test.
+type mixedWorkload struct {
+	cfg   *Config
+	pool  *nodePool
+	miner *blockMiner
+
+	// mintMtxs serializes minting on each node, as all seedlings a node
+	// receives concurrently would end up in the same batch otherwise.
+	mintMtxs map[*rpcClient]*sync.Mutex
+}
+
+// mixedTest interleaves mint, send and burn operations according to the
+// configured weights. All workers operate on the same nodes and assets, so
+// the operations contend with each other like they would in a real
+// deployment.
+func mixedTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+	pool := initNodePool(t, ctx, cfg, alice, bob)
+
+	workload := &mixedWorkload{
+		cfg:      cfg,
+		pool:     pool,
+		miner:    newBlockMiner(bitcoinClient, cfg.Chaos),
+		mintMtxs: make(map[*rpcClient]*sync.Mutex),
+	}
+	for _, node := range pool.withRole(roleSender) {
+		workload.mintMtxs[node] = &sync.Mutex{}
+	}
+
+	t.Logf("Running mixed test, performing %d operation(s) with weights "+
+		"mint=%d, send=%d, burn=%d using %d worker(s)", cfg.MixedNumOps,
+		cfg.MixedMintWeight, cfg.MixedSendWeight, cfg.MixedBurnWeight,
+		cfg.MixedConcurrency)
+
+	// All workers share the same pacer, so the load profile applies to the
+	// test case as a whole.
+	mixedPacer := newPacer(cfg.LoadProfile)
+
+	// The group sub test only returns once all parallel worker sub tests
+	// have completed.
+	t.Run("workers", func(t *testing.T) {
+		concurrency := cfg.MixedConcurrency
+		for worker := 0; worker < concurrency; worker++ {
+			// Distribute the operations as evenly as possible over
+			// all workers.
+			numOps := cfg.MixedNumOps / concurrency
+			if worker < cfg.MixedNumOps%concurrency {
+				numOps++
+			}
+
+			worker := worker
+			name := fmt.Sprintf("worker-%d", worker)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				workload.worker(
+					t, ctx, worker, numOps, mixedPacer,
+				)
+			})
+		}
+	})
+}
+
+// pickOp picks a random operation according to the configured weights.
+func (w *mixedWorkload) pickOp() mixedOp {
+	var (
+		mintWeight = w.cfg.MixedMintWeight
+		sendWeight = w.cfg.MixedSendWeight
+		burnWeight = w.cfg.MixedBurnWeight
+	)
+
+	n := loadRand.Intn(mintWeight + sendWeight + burnWeight)
+	switch {
+	case n < mintWeight:
+		return mixedOpMint
+
+	case n < mintWeight+sendWeight:
+		return mixedOpSend
+
+	default:
+		return mixedOpBurn
+	}
+}
+
+// worker performs the given number of randomly picked operations in
+// sequence, paced by the given pacer.
+func (w *mixedWorkload) worker(t *testing.T, ctx context.Context, worker,
+	numOps int, mixedPacer *pacer) {
+
+	opCounts := make(map[mixedOp]int)
+	for i := 1; i <= numOps; i++ {
+		require.NoError(t, mixedPacer.wait(ctx))
+
+		op := w.pickOp()
+
+		var duration time.Duration
+		switch op {
+		case mixedOpSend:
+			send, receive, ok := w.pool.pickSendPair(
+				t, ctx, w.cfg.MixedAmount,
+				taprpc.AssetType_NORMAL,
+			)
+			if !ok {
+				// There's nothing to send yet, so we'll have
+				// to mint first.
+				op = mixedOpMint
+				break
+			}
+
+			duration = sendAssets(
+				t, ctx, mixedTestCase, w.cfg.MixedAmount,
+				taprpc.AssetType_NORMAL, send, receive, w.miner,
+			)
+
+		case mixedOpBurn:
+			burner, assetID, ok := w.pickBurner(t, ctx)
+			if !ok {
+				// There's nothing to burn yet, so we'll have
+				// to mint first.
+				op = mixedOpMint
+				break
+			}
+
+			duration = burnAssets(
+				t, ctx, mixedTestCase, w.cfg.MixedAmount,
+				assetID, burner, w.miner,
+			)
+			observeLatency(mixedTestCase, opBurn, duration)
+		}
+
+		if op == mixedOpMint {
+			duration = w.mint(t, ctx)
+		}
+		opCounts[op]++
+
+		t.Logf("Worker %d finished %d of %d operations, %v took %v",
+			worker, i, numOps, op, duration)
+	}
+
+	t.Logf("Worker %d finished %d operations: %d mint(s), %d send(s), "+
+		"%d burn(s)", worker, numOps, opCounts[mixedOpMint],
+		opCounts[mixedOpSend], opCounts[mixedOpBurn])
+}
+
+// pickBurner picks a random node that has enough units of a normal asset to
+// burn. The boolean return value is false if no such node exists.
+func (w *mixedWorkload) pickBurner(t *testing.T,
+	ctx context.Context) (*rpcClient, []byte, bool) {
+
+	nodes := w.pool.withRole(roleSender)
+	loadRand.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+
+	// We can't burn all units of an asset output in one go, so we need at
+	// least one more unit than we are going to burn.
+	for _, node := range nodes {
+		burnAsset := node.assetIDWithBalance(
+			t, ctx, w.cfg.MixedAmount+1, taprpc.AssetType_NORMAL,
+		)
+		if burnAsset != nil {
+			return node, burnAsset.AssetGenesis.AssetId, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+// mint mints a new normal asset on a random node and waits for the minting
+// batch to be finalized. The total time it took to mint the asset is returned.
+func (w *mixedWorkload) mint(t *testing.T, ctx context.Context) time.Duration {
+	nodes := w.pool.withRole(roleSender)
+	minter := nodes[loadRand.Intn(len(nodes))]
+
+	mintMtx := w.mintMtxs[minter]
+	mintMtx.Lock()
+	defer mintMtx.Unlock()
+
+	return mintWithMiner(
+		t, ctx, w.cfg, mixedTestCase, minter, w.miner,
+		w.cfg.MixedMintAmount,
+	)
+}
+
+// mintWithMiner mints a new normal asset with the given amount on the given
+// node, waits for the minting batch to be finalized and records the latencies
+// under the given test case. Blocks are mined through the shared miner, so
+// the transactions of other workers are confirmed along the way. The total
+// time it took to mint the asset is returned.
+func mintWithMiner(t *testing.T, ctx context.Context, cfg *Config,
+	testCase string, minter *rpcClient, miner *blockMiner,
+	amount uint64) time.Duration {
+
+	startTime := time.Now()
+	resp, err := minter.MintAsset(ctx, &mintrpc.MintAssetRequest{
+		Asset: &mintrpc.MintAsset{
+			AssetType: taprpc.AssetType_NORMAL,
+			Name: fmt.Sprintf(
+				"%s-%d", testCase, loadRand.Int31(),
+			),
+			AssetMeta: &taprpc.AssetMeta{
+				Data: []byte(testCase + " load test"),
+			},
+			Amount: amount,
+		},
+		ShortResponse: true,
+	})
+	if err != nil {
+		recordFailure(testCase, opMintBatch, time.Since(startTime), err)
+	}
+	require.NoError(t, err)
+	batchKey := resp.PendingBatch.BatchKey
+
+	finalizeStart := time.Now()
+	_, err = minter.FinalizeBatch(ctx, &mintrpc.FinalizeBatchRequest{
+		ShortResponse: true,
+	})
+	finalizeDuration := time.Since(finalizeStart)
+	if err != nil {
+		recordFailure(testCase, opMintFinalize, finalizeDuration, err)
+	}
+	require.NoError(t, err)
+	observeLatency(testCase, opMintFinalize, finalizeDuration)
+
+	// We don't know the hash of the minting transaction, so we wait for it
+	// to be broadcast and then mine a block with whatever is in the
+	// mempool.
+	waitForMinBatchState(
+		t, ctx, minter, cfg.TestTimeout, batchKey,
+		mintrpc.BatchState_BATCH_STATE_BROADCAST,
+	)
+	miner.mineBlock(t)
+	waitForMinBatchState(
+		t, ctx, minter, cfg.TestTimeout, batchKey,
+		mintrpc.BatchState_BATCH_STATE_FINALIZED,
+	)
+
+	mintDuration := time.Since(startTime)
+	observeLatency(testCase, opMintBatch, mintDuration)
+
+	return mintDuration
+}
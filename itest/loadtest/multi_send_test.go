@@ -0,0 +1,143 @@
+package loadtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/itest"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// multiSendTest checks that we are able to send assets to multiple freshly
+// generated addresses within a single anchor transaction and records how the
+// individual phases of the transfer scale with the number of outputs.
+func multiSendTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+	pool := initNodePool(t, ctx, cfg, alice, bob)
+
+	var (
+		fanOut    = cfg.MultiSendFanOut
+		numAssets = cfg.MultiSendNumAssets
+		minAmount = uint64(fanOut) * numAssets
+	)
+
+	// Multiple outputs of the same collectible don't make sense, so we'll
+	// always send normal assets in this test. If none of the nodes has
+	// enough units, we mint a new asset first.
+	_, _, ok := pool.pickSendPair(
+		t, ctx, minAmount, taprpc.AssetType_NORMAL,
+	)
+	if !ok {
+		mintNormalAsset(
+			t, cfg, alice, bitcoinClient, "multi-send",
+			uint64(cfg.MultiSendNumSends)*minAmount,
+		)
+	}
+
+	t.Logf("Running multi send test, sending %d asset(s) to %d addresses "+
+		"%d times", numAssets, fanOut, cfg.MultiSendNumSends)
+	sendPacer := newPacer(cfg.LoadProfile)
+	for i := 1; i <= cfg.MultiSendNumSends; i++ {
+		require.NoError(t, sendPacer.wait(ctx))
+
+		send, receive, ok := pool.pickSendPair(
+			t, ctx, minAmount, taprpc.AssetType_NORMAL,
+		)
+		if !ok {
+			t.Fatalf("Aborting multi send test at attempt %d of "+
+				"%d as no node has enough balance to send %d "+
+				"assets to %d addresses", i,
+				cfg.MultiSendNumSends, numAssets, fanOut)
+			return
+		}
+
+		sendDuration, completeDuration := multiSendAssets(
+			t, ctx, numAssets, fanOut, send, receive, bitcoinClient,
+		)
+		observeLatency(
+			"multisend", opTransfer, sendDuration+completeDuration,
+		)
+		observeLatency("multisend", opProofImport, completeDuration)
+
+		t.Logf("Finished %d of %d multi send operations with %d "+
+			"outputs, send took %v, proof delivery to all "+
+			"addresses took %v", i, cfg.MultiSendNumSends, fanOut,
+			sendDuration, completeDuration)
+	}
+}
+
+// multiSendAssets sends the given number of assets to each of the given number
+// of new addresses created on the receiving node, all within a single anchor
+// transaction. The first returned duration is the time it took for the
+// transfer to be funded, signed and broadcast, the second one is the time it
+// took from the anchor transaction confirming until all addresses received
+// their proofs.
+func multiSendAssets(t *testing.T, ctx context.Context, numAssets uint64,
+	fanOut int, send, receive *rpcClient,
+	bitcoinClient *rpcclient.Client) (time.Duration, time.Duration) {
+
+	sendAsset := send.assetIDWithBalance(
+		t, ctx, uint64(fanOut)*numAssets, taprpc.AssetType_NORMAL,
+	)
+	t.Logf("Sending %d asset(s) with ID %x from %v to %d addresses on %v",
+		numAssets, sendAsset.AssetGenesis.AssetId, send.cfg.Name,
+		fanOut, receive.cfg.Name)
+
+	// Create all the addresses on the receiving node first.
+	addrs := make([]*taprpc.Addr, fanOut)
+	encodedAddrs := make([]string, fanOut)
+	for i := 0; i < fanOut; i++ {
+		addr, err := receive.NewAddr(ctx, &taprpc.NewAddrRequest{
+			AssetId: sendAsset.AssetGenesis.AssetId,
+			Amt:     numAssets,
+		})
+		require.NoError(t, err)
+		itest.AssertAddrCreated(t, receive, sendAsset, addr)
+
+		addrs[i] = addr
+		encodedAddrs[i] = addr.Encoded
+	}
+
+	transfersBefore := send.listTransfersSince(t, ctx, nil)
+
+	// Initiate the send to all addresses at once.
+	sendStart := time.Now()
+	_, err := send.SendAsset(ctx, &taprpc.SendAssetRequest{
+		TapAddrs: encodedAddrs,
+	})
+	if err != nil {
+		recordFailure(
+			"multisend", opTransfer, time.Since(sendStart), err,
+		)
+	}
+	require.NoError(t, err)
+
+	// Wait for the transfer to appear on the sending node.
+	require.Eventually(t, func() bool {
+		newTransfers := send.listTransfersSince(t, ctx, transfersBefore)
+		return len(newTransfers) == 1
+	}, defaultTimeout, wait.PollInterval)
+	sendDuration := time.Since(sendStart)
+
+	// All addresses should detect the transfer on the receiving node.
+	for _, addr := range addrs {
+		itest.AssertAddrEvent(t, receive, addr, 1, statusDetected)
+	}
+
+	// Mine a block to confirm the transfer.
+	itest.MineBlocks(t, bitcoinClient, 1, 1)
+
+	// Now the transfer should go to completed eventually for all
+	// addresses.
+	confirmTime := time.Now()
+	for _, addr := range addrs {
+		itest.AssertAddrEvent(t, receive, addr, 1, statusCompleted)
+	}
+
+	return sendDuration, time.Since(confirmTime)
+}
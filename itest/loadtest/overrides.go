@@ -0,0 +1,135 @@
+package loadtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+)
+
+// overridableGroups are the namespaces of the option groups that can be
+// overridden per test case. All other groups describe the environment the load
+// test runs in and are shared by all test cases.
+var overridableGroups = []string{
+	"load-profile.", "chaos.", "hooks.",
+}
+
+// ConfigOverride overrides the value of another option for a single test case.
+// It is specified as <test_case>:<option>=<value>, for example
+// send:send-test-num-sends=50 or mint:load-profile.type=constant.
+type ConfigOverride struct {
+	// TestCase is the test case the override applies to.
+	TestCase string
+
+	// Option is the long name of the overridden option.
+	Option string
+
+	// Value is the value the option is set to for the test case.
+	Value string
+}
+
+// UnmarshalFlag parses a configuration override from its string
+// representation.
+//
+// NOTE: This is part of the flags.Unmarshaler interface.
+func (o *ConfigOverride) UnmarshalFlag(value string) error {
+	testCase, setting, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if !ok || testCase == "" {
+		return fmt.Errorf("invalid override '%s', expected "+
+			"<test_case>:<option>=<value>", value)
+	}
+
+	option, val, ok := strings.Cut(setting, "=")
+	if !ok || option == "" {
+		return fmt.Errorf("invalid override '%s', expected "+
+			"<test_case>:<option>=<value>", value)
+	}
+
+	option = strings.TrimPrefix(option, "--")
+	if strings.Contains(option, ".") {
+		overridable := false
+		for _, group := range overridableGroups {
+			if strings.HasPrefix(option, group) {
+				overridable = true
+				break
+			}
+		}
+
+		if !overridable {
+			return fmt.Errorf("option '%s' can't be overridden "+
+				"per test case", option)
+		}
+	}
+
+	o.TestCase = testCase
+	o.Option = option
+	o.Value = val
+
+	return nil
+}
+
+// overriddenTestCases returns the names of all test cases that have at least
+// one override.
+func (c *Config) overriddenTestCases() []string {
+	unique := make(map[string]struct{})
+	for _, override := range c.Overrides {
+		unique[override.TestCase] = struct{}{}
+	}
+
+	testCases := make([]string, 0, len(unique))
+	for testCase := range unique {
+		testCases = append(testCases, testCase)
+	}
+	sort.Strings(testCases)
+
+	return testCases
+}
+
+// forTestCase returns the configuration of the given test case, which is the
+// main configuration with all overrides of the test case applied. If the test
+// case has no overrides, the main configuration is returned as is.
+func (c *Config) forTestCase(testCase string) (*Config, error) {
+	var args []string
+	for _, override := range c.Overrides {
+		if override.TestCase != testCase {
+			continue
+		}
+
+		args = append(
+			args, fmt.Sprintf("--%s=%s", override.Option,
+				override.Value),
+		)
+	}
+
+	if len(args) == 0 {
+		return c, nil
+	}
+
+	// The overridable groups are copied, so the overrides don't leak into
+	// the configuration of other test cases.
+	caseCfg := *c
+	loadProfile := *c.LoadProfile
+	caseCfg.LoadProfile = &loadProfile
+	chaos := *c.Chaos
+	caseCfg.Chaos = &chaos
+	hooks := *c.Hooks
+	caseCfg.Hooks = &hooks
+
+	// The overrides are applied now, so there's no need to carry them on.
+	caseCfg.Overrides = nil
+
+	parser := flags.NewParser(&caseCfg, flags.None)
+	if _, err := parser.ParseArgs(args); err != nil {
+		return nil, fmt.Errorf("invalid override for test case %s: %w",
+			testCase, err)
+	}
+
+	validCfg, err := ValidateConfig(caseCfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for test case %s: %w",
+			testCase, err)
+	}
+
+	return validCfg, nil
+}
@@ -0,0 +1,109 @@
+package loadtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestConfigOverrideUnmarshal tests the parsing of per test case overrides.
+func TestConfigOverrideUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var override ConfigOverride
+	err := override.UnmarshalFlag("send:send-test-num-sends=50")
+	require.NoError(t, err)
+	require.Equal(t, ConfigOverride{
+		TestCase: "send",
+		Option:   "send-test-num-sends",
+		Value:    "50",
+	}, override)
+
+	err = override.UnmarshalFlag("mint:--load-profile.type=constant")
+	require.NoError(t, err)
+	require.Equal(t, "load-profile.type", override.Option)
+
+	invalid := []string{
+		"send-test-num-sends=50",
+		":send-test-num-sends=50",
+		"send:send-test-num-sends",
+		"send:=50",
+		"send:alice.tapd.host=localhost",
+	}
+	for _, value := range invalid {
+		require.Error(t, override.UnmarshalFlag(value), value)
+	}
+}
+
+// TestConfigForTestCase tests that the overrides of a test case are applied to
+// its configuration only.
+func TestConfigForTestCase(t *testing.T) {
+	t.Parallel()
+
+	parse := func(value string) *ConfigOverride {
+		var override ConfigOverride
+		require.NoError(t, override.UnmarshalFlag(value))
+
+		return &override
+	}
+
+	cfg := DefaultConfig()
+	cfg.MintSweepBatchSizes = []int{1, 10}
+	cfg.Overrides = []*ConfigOverride{
+		parse("send:send-test-num-sends=50"),
+		parse("send:test-timeout=3h"),
+		parse("send:load-profile.type=constant"),
+		parse("send:load-profile.base-rate=2"),
+		parse("mintsweep:mint-sweep-test-batch-size=100"),
+		parse("mint:hooks.state=fresh"),
+		parse("mint:hooks.wipe-cmd=wipe-alice"),
+	}
+
+	mainCfg, err := ValidateConfig(cfg)
+	require.NoError(t, err)
+
+	sendCfg, err := mainCfg.forTestCase("send")
+	require.NoError(t, err)
+	require.Equal(t, 50, sendCfg.NumSends)
+	require.Equal(t, 3*time.Hour, sendCfg.TestTimeout)
+	require.Equal(t, profileConstant, sendCfg.LoadProfile.Type)
+	require.Equal(t, 2.0, sendCfg.LoadProfile.BaseRate)
+
+	// Overriding a list option replaces the whole list.
+	sweepCfg, err := mainCfg.forTestCase("mintsweep")
+	require.NoError(t, err)
+	require.Equal(t, []int{100}, sweepCfg.MintSweepBatchSizes)
+
+	mintCfg, err := mainCfg.forTestCase("mint")
+	require.NoError(t, err)
+	require.Equal(t, stateFresh, mintCfg.Hooks.State)
+	require.Equal(t, []string{"wipe-alice"}, mintCfg.Hooks.WipeCmds)
+
+	// The main config and test cases without overrides are unaffected.
+	burnCfg, err := mainCfg.forTestCase("burn")
+	require.NoError(t, err)
+	require.Same(t, mainCfg, burnCfg)
+	require.Equal(t, cfg.NumSends, mainCfg.NumSends)
+	require.Equal(t, cfg.TestTimeout, mainCfg.TestTimeout)
+	require.Equal(t, profileNone, mainCfg.LoadProfile.Type)
+	require.Equal(t, []int{1, 10}, mainCfg.MintSweepBatchSizes)
+	require.Equal(t, stateReuse, mainCfg.Hooks.State)
+	require.Empty(t, mainCfg.Hooks.WipeCmds)
+
+	// Overrides that result in an invalid configuration are rejected when
+	// validating the main config.
+	cfg.Overrides = []*ConfigOverride{
+		parse("send:load-profile.type=constant"),
+	}
+	_, err = ValidateConfig(cfg)
+	require.ErrorContains(t, err, "positive base rate")
+
+	cfg.Overrides = []*ConfigOverride{parse("send:hooks.state=fresh")}
+	_, err = ValidateConfig(cfg)
+	require.ErrorContains(t, err, "wipe commands must be set")
+
+	cfg.Overrides = []*ConfigOverride{parse("send:no-such-option=1")}
+	_, err = ValidateConfig(cfg)
+	require.ErrorContains(t, err, "invalid override")
+}
@@ -0,0 +1,264 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// profileNone issues operations back to back, as fast as the node
+	// processes them.
+	profileNone = "none"
+
+	// profileConstant issues operations at a constant base rate.
+	profileConstant = "constant"
+
+	// profileRamp linearly increases the rate from the base rate to the
+	// peak rate over the profile duration.
+	profileRamp = "ramp"
+
+	// profileStep increases the rate from the base rate to the peak rate
+	// in a number of equally sized steps over the profile duration.
+	profileStep = "step"
+
+	// profileSpike issues operations at the base rate, except for the
+	// spike window during which the peak rate is used.
+	profileSpike = "spike"
+
+	// arrivalConstant spaces out operations evenly, so they arrive at
+	// exactly the rate of the load profile.
+	arrivalConstant = "constant"
+
+	// arrivalPoisson draws the time between operations from an
+	// exponential distribution, so operations arrive as a Poisson process
+	// with the rate of the load profile.
+	arrivalPoisson = "poisson"
+
+	// arrivalBursty issues operations in bursts of back to back operations,
+	// spacing out the bursts so the average rate matches the load profile.
+	arrivalBursty = "bursty"
+)
+
+// LoadProfileConfig defines the rate at which operations are issued over the
+// course of a test case.
+type LoadProfileConfig struct {
+	Type string `long:"type" description:"the load profile to use" choice:"none" choice:"constant" choice:"ramp" choice:"step" choice:"spike"`
+
+	BaseRate float64 `long:"base-rate" description:"the number of operations per second issued at the start of the profile (or outside of the spike)"`
+
+	PeakRate float64 `long:"peak-rate" description:"the number of operations per second issued at the end of the ramp/step profile or during the spike"`
+
+	Duration time.Duration `long:"duration" description:"the duration over which the ramp/step profile goes from the base to the peak rate"`
+
+	Steps int `long:"steps" description:"the number of rate levels the step profile uses between the base and the peak rate"`
+
+	SpikeStart time.Duration `long:"spike-start" description:"the time after the start of the test case at which the spike begins"`
+
+	SpikeDuration time.Duration `long:"spike-duration" description:"the duration of the spike"`
+
+	Arrival string `long:"arrival" description:"the distribution of the times between two operations, at the average rate of the profile" choice:"constant" choice:"poisson" choice:"bursty"`
+
+	BurstSize int `long:"burst-size" description:"the number of operations issued back to back in every burst of the bursty arrival distribution"`
+
+	OpenLoop bool `long:"open-loop" description:"keep issuing operations on schedule even if previous operations haven't completed yet, instead of delaying the schedule; the lag behind the schedule is recorded as the queue delay"`
+}
+
+// validate makes sure the load profile configuration is consistent.
+func (c *LoadProfileConfig) validate() error {
+	switch c.Arrival {
+	case "", arrivalConstant, arrivalPoisson:
+	case arrivalBursty:
+		if c.BurstSize < 2 {
+			return fmt.Errorf("bursty arrival requires a burst " +
+				"size of at least two")
+		}
+
+	default:
+		return fmt.Errorf("unknown arrival distribution: %v",
+			c.Arrival)
+	}
+
+	switch c.Type {
+	case "", profileNone:
+		return nil
+
+	case profileConstant:
+		if c.BaseRate <= 0 {
+			return fmt.Errorf("constant load profile requires a " +
+				"positive base rate")
+		}
+
+		return nil
+
+	case profileRamp, profileStep, profileSpike:
+		if c.BaseRate <= 0 || c.PeakRate <= 0 {
+			return fmt.Errorf("%s load profile requires a "+
+				"positive base and peak rate", c.Type)
+		}
+
+	default:
+		return fmt.Errorf("unknown load profile type: %v", c.Type)
+	}
+
+	switch {
+	case c.Type == profileSpike && c.SpikeDuration <= 0:
+		return fmt.Errorf("spike load profile requires a positive " +
+			"spike duration")
+
+	case c.Type != profileSpike && c.Duration <= 0:
+		return fmt.Errorf("%s load profile requires a positive "+
+			"duration", c.Type)
+
+	case c.Type == profileStep && c.Steps < 2:
+		return fmt.Errorf("step load profile requires at least two " +
+			"steps")
+	}
+
+	return nil
+}
+
+// rate returns the number of operations per second that should be issued at
+// the given time after the start of the profile. A rate of zero means that
+// operations should be issued without any delay.
+func (c *LoadProfileConfig) rate(elapsed time.Duration) float64 {
+	// progress is the fraction of the profile duration that has elapsed,
+	// capped at one.
+	progress := func() float64 {
+		return math.Min(float64(elapsed)/float64(c.Duration), 1)
+	}
+
+	switch c.Type {
+	case profileConstant:
+		return c.BaseRate
+
+	case profileRamp:
+		return c.BaseRate + (c.PeakRate-c.BaseRate)*progress()
+
+	case profileStep:
+		steps := float64(c.Steps)
+		level := math.Min(math.Floor(progress()*steps), steps-1)
+
+		return c.BaseRate + (c.PeakRate-c.BaseRate)*level/(steps-1)
+
+	case profileSpike:
+		spikeEnd := c.SpikeStart + c.SpikeDuration
+		if elapsed >= c.SpikeStart && elapsed < spikeEnd {
+			return c.PeakRate
+		}
+
+		return c.BaseRate
+
+	default:
+		return 0
+	}
+}
+
+// interval returns the time between the operation with the given sequence
+// number and the next one, according to the arrival distribution and the given
+// rate.
+func (c *LoadProfileConfig) interval(rate float64, seq uint64) time.Duration {
+	meanInterval := float64(time.Second) / rate
+
+	switch c.Arrival {
+	case arrivalPoisson:
+		return time.Duration(loadRand.ExpFloat64() * meanInterval)
+
+	case arrivalBursty:
+		burstSize := uint64(c.BurstSize)
+		if (seq+1)%burstSize != 0 {
+			return 0
+		}
+
+		return time.Duration(float64(burstSize) * meanInterval)
+
+	default:
+		return time.Duration(meanInterval)
+	}
+}
+
+// pacer hands out the points in time at which the next operation should be
+// issued according to a load profile. A single pacer can be shared by multiple
+// concurrent workers.
+type pacer struct {
+	sync.Mutex
+
+	profile *LoadProfileConfig
+
+	// start is the time the first operation was issued.
+	start time.Time
+
+	// next is the earliest time the next operation should be issued.
+	next time.Time
+
+	// seq is the sequence number of the next operation.
+	seq uint64
+}
+
+// newPacer creates a new pacer for the given load profile.
+func newPacer(profile *LoadProfileConfig) *pacer {
+	return &pacer{
+		profile: profile,
+	}
+}
+
+// nextIssueTime reserves the next slot for an operation and returns the time
+// at which it should be issued.
+func (p *pacer) nextIssueTime(now time.Time) time.Time {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.start.IsZero() {
+		p.start = now
+		p.next = now
+	}
+
+	// If we're falling behind the profile (because operations take longer
+	// than the profile allows for), we don't try to catch up with a burst
+	// of operations but issue the next one right away. In open loop mode
+	// we stick to the schedule instead, so the operations queue up.
+	issueAt := p.next
+	if issueAt.Before(now) && !p.profile.OpenLoop {
+		issueAt = now
+	}
+
+	rate := p.profile.rate(issueAt.Sub(p.start))
+	if rate > 0 {
+		p.next = issueAt.Add(p.profile.interval(rate, p.seq))
+	} else {
+		p.next = issueAt
+	}
+	p.seq++
+
+	return issueAt
+}
+
+// wait blocks until the next operation should be issued according to the load
+// profile or the context is canceled. In open loop mode, the time the
+// operation was due already is recorded as its queue delay.
+func (p *pacer) wait(ctx context.Context) error {
+	issueAt := p.nextIssueTime(time.Now())
+
+	delay := time.Until(issueAt)
+	if delay <= 0 {
+		if p.profile.OpenLoop {
+			observeQueueDelay(-delay)
+		}
+
+		return nil
+	}
+
+	if p.profile.OpenLoop {
+		observeQueueDelay(0)
+	}
+
+	select {
+	case <-time.After(delay):
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
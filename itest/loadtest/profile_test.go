@@ -0,0 +1,231 @@
+package loadtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoadProfileRate tests that the different load profiles return the
+// expected rate over time.
+func TestLoadProfileRate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		profile  LoadProfileConfig
+		elapsed  time.Duration
+		expected float64
+	}{{
+		name: "none",
+		profile: LoadProfileConfig{
+			Type: profileNone,
+		},
+		elapsed:  time.Minute,
+		expected: 0,
+	}, {
+		name: "constant",
+		profile: LoadProfileConfig{
+			Type:     profileConstant,
+			BaseRate: 2,
+		},
+		elapsed:  time.Hour,
+		expected: 2,
+	}, {
+		name: "ramp half way",
+		profile: LoadProfileConfig{
+			Type:     profileRamp,
+			BaseRate: 1,
+			PeakRate: 3,
+			Duration: 10 * time.Minute,
+		},
+		elapsed:  5 * time.Minute,
+		expected: 2,
+	}, {
+		name: "ramp after duration",
+		profile: LoadProfileConfig{
+			Type:     profileRamp,
+			BaseRate: 1,
+			PeakRate: 3,
+			Duration: 10 * time.Minute,
+		},
+		elapsed:  time.Hour,
+		expected: 3,
+	}, {
+		name: "step first level",
+		profile: LoadProfileConfig{
+			Type:     profileStep,
+			BaseRate: 1,
+			PeakRate: 4,
+			Duration: 4 * time.Minute,
+			Steps:    4,
+		},
+		elapsed:  59 * time.Second,
+		expected: 1,
+	}, {
+		name: "step second level",
+		profile: LoadProfileConfig{
+			Type:     profileStep,
+			BaseRate: 1,
+			PeakRate: 4,
+			Duration: 4 * time.Minute,
+			Steps:    4,
+		},
+		elapsed:  time.Minute,
+		expected: 2,
+	}, {
+		name: "step after duration",
+		profile: LoadProfileConfig{
+			Type:     profileStep,
+			BaseRate: 1,
+			PeakRate: 4,
+			Duration: 4 * time.Minute,
+			Steps:    4,
+		},
+		elapsed:  time.Hour,
+		expected: 4,
+	}, {
+		name: "before spike",
+		profile: LoadProfileConfig{
+			Type:          profileSpike,
+			BaseRate:      1,
+			PeakRate:      10,
+			SpikeStart:    time.Minute,
+			SpikeDuration: time.Minute,
+		},
+		elapsed:  30 * time.Second,
+		expected: 1,
+	}, {
+		name: "during spike",
+		profile: LoadProfileConfig{
+			Type:          profileSpike,
+			BaseRate:      1,
+			PeakRate:      10,
+			SpikeStart:    time.Minute,
+			SpikeDuration: time.Minute,
+		},
+		elapsed:  90 * time.Second,
+		expected: 10,
+	}, {
+		name: "after spike",
+		profile: LoadProfileConfig{
+			Type:          profileSpike,
+			BaseRate:      1,
+			PeakRate:      10,
+			SpikeStart:    time.Minute,
+			SpikeDuration: time.Minute,
+		},
+		elapsed:  2 * time.Minute,
+		expected: 1,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.profile.validate())
+			require.InDelta(
+				t, tc.expected, tc.profile.rate(tc.elapsed),
+				0.0001,
+			)
+		})
+	}
+}
+
+// TestPacerNextIssueTime tests that the pacer spaces out operations according
+// to the load profile and doesn't try to catch up when falling behind.
+func TestPacerNextIssueTime(t *testing.T) {
+	t.Parallel()
+
+	p := newPacer(&LoadProfileConfig{
+		Type:     profileConstant,
+		BaseRate: 2,
+	})
+
+	start := time.Unix(1_000_000, 0)
+	require.Equal(t, start, p.nextIssueTime(start))
+	require.Equal(
+		t, start.Add(500*time.Millisecond), p.nextIssueTime(start),
+	)
+	require.Equal(t, start.Add(time.Second), p.nextIssueTime(start))
+
+	// If we fall behind, the next operation is issued right away.
+	late := start.Add(time.Minute)
+	require.Equal(t, late, p.nextIssueTime(late))
+	require.Equal(t, late.Add(500*time.Millisecond), p.nextIssueTime(late))
+}
+
+// TestPacerOpenLoop tests that the pacer sticks to the schedule in open loop
+// mode when falling behind.
+func TestPacerOpenLoop(t *testing.T) {
+	t.Parallel()
+
+	p := newPacer(&LoadProfileConfig{
+		Type:     profileConstant,
+		BaseRate: 2,
+		OpenLoop: true,
+	})
+
+	start := time.Unix(1_000_000, 0)
+	require.Equal(t, start, p.nextIssueTime(start))
+
+	// Even though we're late, the operations are scheduled as if we were
+	// on time.
+	late := start.Add(time.Minute)
+	require.Equal(
+		t, start.Add(500*time.Millisecond), p.nextIssueTime(late),
+	)
+	require.Equal(t, start.Add(time.Second), p.nextIssueTime(late))
+}
+
+// TestPacerArrivals tests that the arrival distributions space out the
+// operations at the average rate of the load profile.
+func TestPacerArrivals(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1_000_000, 0)
+
+	// With bursty arrivals, the operations of a burst are issued back to
+	// back and the gap between bursts makes up for it.
+	p := newPacer(&LoadProfileConfig{
+		Type:      profileConstant,
+		BaseRate:  2,
+		Arrival:   arrivalBursty,
+		BurstSize: 3,
+	})
+	for burst := 0; burst < 3; burst++ {
+		burstStart := start.Add(time.Duration(burst) * 1500 *
+			time.Millisecond)
+		for i := 0; i < 3; i++ {
+			require.Equal(t, burstStart, p.nextIssueTime(start))
+		}
+	}
+
+	// With Poisson arrivals, the intervals vary but average out to the
+	// rate of the profile.
+	p = newPacer(&LoadProfileConfig{
+		Type:     profileConstant,
+		BaseRate: 2,
+		Arrival:  arrivalPoisson,
+	})
+
+	const numOps = 10_000
+	var (
+		last          time.Time
+		distinctGaps  = make(map[time.Duration]struct{})
+		previousIssue = start
+	)
+	for i := 0; i < numOps; i++ {
+		last = p.nextIssueTime(start)
+		distinctGaps[last.Sub(previousIssue)] = struct{}{}
+		previousIssue = last
+	}
+
+	meanInterval := last.Sub(start) / (numOps - 1)
+	require.InDelta(
+		t, float64(500*time.Millisecond), float64(meanInterval),
+		float64(50*time.Millisecond),
+	)
+	require.Greater(t, len(distinctGaps), numOps/2)
+}
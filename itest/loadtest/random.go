@@ -0,0 +1,71 @@
+package loadtest
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// lockedRand is a source of pseudo random numbers that can safely be shared
+// by concurrent workers.
+type lockedRand struct {
+	sync.Mutex
+
+	rand *rand.Rand
+}
+
+// seed resets the random number generator to the given seed.
+func (r *lockedRand) seed(seed int64) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.rand = rand.New(rand.NewSource(seed))
+}
+
+// Int31 returns a non-negative pseudo random 31-bit integer.
+func (r *lockedRand) Int31() int32 {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.rand.Int31()
+}
+
+// Intn returns a non-negative pseudo random number in [0,n).
+func (r *lockedRand) Intn(n int) int {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.rand.Intn(n)
+}
+
+// Int63n returns a non-negative pseudo random number in [0,n).
+func (r *lockedRand) Int63n(n int64) int64 {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.rand.Int63n(n)
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with a rate
+// parameter of one.
+func (r *lockedRand) ExpFloat64() float64 {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.rand.ExpFloat64()
+}
+
+// Shuffle pseudo randomizes the order of n elements using the given swap
+// function.
+func (r *lockedRand) Shuffle(n int, swap func(i, j int)) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.rand.Shuffle(n, swap)
+}
+
+// loadRand is the source of all random choices the load tests make. It is
+// seeded from the configured seed at startup, so a run can be replayed by
+// using the same seed again.
+var loadRand = &lockedRand{
+	rand: rand.New(rand.NewSource(1)),
+}
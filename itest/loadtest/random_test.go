@@ -0,0 +1,28 @@
+package loadtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLockedRandSeed tests that seeding the random number generator with the
+// same seed results in the same sequence of random choices.
+func TestLockedRandSeed(t *testing.T) {
+	t.Parallel()
+
+	sequence := func(seed int64) []int {
+		r := &lockedRand{}
+		r.seed(seed)
+
+		values := make([]int, 0, 10)
+		for i := 0; i < 10; i++ {
+			values = append(values, r.Intn(1000))
+		}
+
+		return values
+	}
+
+	require.Equal(t, sequence(42), sequence(42))
+	require.NotEqual(t, sequence(42), sequence(43))
+}
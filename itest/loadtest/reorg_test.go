@@ -0,0 +1,210 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// reorgTestCase is the test case label used for the re-org test.
+	reorgTestCase = "reorg"
+)
+
+// reorgedAsset is an asset of a node whose proof is anchored in a block that
+// is re-organized out of the chain.
+type reorgedAsset struct {
+	node  *rpcClient
+	asset *taprpc.Asset
+}
+
+// reorgTest checks that the nodes under test update the proofs of mint and
+// transfer anchors that are re-organized out of the chain. It repeatedly mints
+// an asset, sends some of it from alice to bob, invalidates the blocks that
+// confirmed both and records how long it takes until all affected proofs are
+// anchored in the new chain again.
+//
+// NOTE: Blocks are invalidated through the invalidateblock RPC, so this test
+// requires a bitcoind backend that is shared by all nodes under test, and is
+// skipped for any other backend. It is also skipped on signet, where the
+// blocks of the new chain can't be mined.
+func reorgTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// We can't mine the blocks of the new chain on signet.
+	if cfg.Bitcoin.Network == networkSignet {
+		t.Skipf("Re-org test can't run on %v", networkSignet)
+	}
+
+	// btcd doesn't support invalidating blocks.
+	if cfg.Bitcoin.Backend != backendBitcoind {
+		t.Skipf("Re-org test requires a %v backend", backendBitcoind)
+	}
+
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+
+	t.Logf("Running re-org test, re-organizing %d block(s) %d times",
+		cfg.ReorgDepth, cfg.ReorgNumReorgs)
+
+	var totalDuration time.Duration
+	miner := newBlockMiner(bitcoinClient, cfg.Chaos)
+	reorgPacer := newPacer(cfg.LoadProfile)
+	for i := 1; i <= cfg.ReorgNumReorgs; i++ {
+		require.NoError(t, reorgPacer.wait(ctx))
+
+		// Create a fresh mint and transfer anchor at the tip of the
+		// chain, so they are part of the blocks we re-organize out.
+		mintNormalAsset(
+			t, cfg, alice, bitcoinClient, reorgTestCase,
+			2*cfg.ReorgSendAmount,
+		)
+		sendAssets(
+			t, ctx, reorgTestCase, cfg.ReorgSendAmount,
+			taprpc.AssetType_NORMAL, alice, bob, miner,
+		)
+
+		recoveryTime := reorgBlocks(
+			t, ctx, cfg, bitcoinClient, miner, alice, bob,
+		)
+		totalDuration += recoveryTime
+		observeLatency(reorgTestCase, opReorgRecovery, recoveryTime)
+
+		t.Logf("Finished %d of %d re-orgs, recovery took %v", i,
+			cfg.ReorgNumReorgs, recoveryTime)
+	}
+
+	t.Logf("Finished %d re-orgs, average recovery duration %v",
+		cfg.ReorgNumReorgs,
+		totalDuration/time.Duration(cfg.ReorgNumReorgs))
+}
+
+// reorgBlocks re-organizes the configured number of blocks at the tip of the
+// chain out by invalidating them and mining a longer chain that confirms the
+// same transactions again. It then waits for the proofs of all assets of the
+// given nodes that were anchored in the invalidated blocks to be updated and
+// returns the time that took.
+func reorgBlocks(t *testing.T, ctx context.Context, cfg *Config,
+	bitcoinClient *rpcclient.Client, miner *blockMiner,
+	nodes ...*rpcClient) time.Duration {
+
+	// Walk back from the tip to find all blocks that will be invalidated.
+	bestHash, _, err := bitcoinClient.GetBestBlock()
+	require.NoError(t, err)
+
+	staleBlocks := make(map[chainhash.Hash]struct{}, cfg.ReorgDepth)
+	forkHash := *bestHash
+	staleBlocks[forkHash] = struct{}{}
+	for len(staleBlocks) < cfg.ReorgDepth {
+		header, err := bitcoinClient.GetBlockHeader(&forkHash)
+		require.NoError(t, err)
+
+		forkHash = header.PrevBlock
+		staleBlocks[forkHash] = struct{}{}
+	}
+
+	// Collect the assets of all nodes that are anchored in those blocks
+	// before they become stale.
+	var assets []reorgedAsset
+	for _, node := range nodes {
+		resp, err := node.ListAssets(ctx, &taprpc.ListAssetRequest{})
+		require.NoError(t, err)
+
+		for _, a := range resp.Assets {
+			blockHash, err := chainhash.NewHashFromStr(
+				a.ChainAnchor.AnchorBlockHash,
+			)
+			require.NoError(t, err)
+
+			if _, ok := staleBlocks[*blockHash]; !ok {
+				continue
+			}
+
+			assets = append(assets, reorgedAsset{
+				node:  node,
+				asset: a,
+			})
+		}
+	}
+	require.NotEmpty(t, assets, "no assets anchored in the last %d "+
+		"block(s)", cfg.ReorgDepth)
+
+	t.Logf("Invalidating %d block(s) starting at %v, affecting %d "+
+		"asset(s)", cfg.ReorgDepth, forkHash, len(assets))
+
+	// Invalidating the oldest block also invalidates all its descendants
+	// and puts their transactions back into the mempool. One more block
+	// than we invalidated makes the new chain the longest one.
+	startTime := time.Now()
+	require.NoError(t, bitcoinClient.InvalidateBlock(&forkHash))
+	for i := 0; i <= cfg.ReorgDepth; i++ {
+		miner.mineBlock(t)
+	}
+
+	// The re-org is only complete once every affected proof is valid and
+	// anchored in a block of the new chain.
+	err = wait.Predicate(func() bool {
+		for _, a := range assets {
+			if !proofReorganized(ctx, a, staleBlocks) {
+				return false
+			}
+		}
+
+		return true
+	}, cfg.TestTimeout)
+	if err != nil {
+		err = fmt.Errorf("proofs not updated after re-org: %w", err)
+		recordFailure(
+			reorgTestCase, opReorgRecovery, time.Since(startTime),
+			err,
+		)
+	}
+	require.NoError(t, err)
+
+	return time.Since(startTime)
+}
+
+// proofReorganized returns true if the latest proof of the given asset is
+// valid and no longer anchored in any of the given stale blocks.
+func proofReorganized(ctx context.Context, a reorgedAsset,
+	staleBlocks map[chainhash.Hash]struct{}) bool {
+
+	exportResp, err := a.node.ExportProof(ctx, &taprpc.ExportProofRequest{
+		AssetId:   a.asset.AssetGenesis.AssetId,
+		ScriptKey: a.asset.ScriptKey,
+	})
+	if err != nil {
+		return false
+	}
+
+	f := &proof.File{}
+	err = f.Decode(bytes.NewReader(exportResp.RawProofFile))
+	if err != nil {
+		return false
+	}
+
+	lastProof, err := f.LastProof()
+	if err != nil {
+		return false
+	}
+
+	if _, ok := staleBlocks[lastProof.BlockHeader.BlockHash()]; ok {
+		return false
+	}
+
+	verifyResp, err := a.node.VerifyProof(ctx, &taprpc.ProofFile{
+		RawProofFile: exportResp.RawProofFile,
+	})
+	if err != nil {
+		return false
+	}
+
+	return verifyResp.Valid
+}
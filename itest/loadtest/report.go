@@ -0,0 +1,404 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// reportChartWidth is the width of the latency charts of the report
+	// in pixels.
+	reportChartWidth = 640
+
+	// reportChartHeight is the height of the latency charts of the report
+	// in pixels.
+	reportChartHeight = 160
+
+	// reportChartPadding is the padding around the plot area of the
+	// latency charts in pixels.
+	reportChartPadding = 30
+
+	// reportMaxChartPoints is the maximum number of operations drawn in a
+	// single latency chart. Larger series are thinned out evenly, so the
+	// report stays small enough to attach to release notes.
+	reportMaxChartPoints = 2000
+
+	// redacted replaces secrets in the config snapshot of the report.
+	redacted = "<redacted>"
+)
+
+// reportTemplate is the template of the standalone HTML report. It doesn't
+// reference any external resources, so it can be viewed offline.
+var reportTemplate = template.Must(template.New("report").Parse(reportHTML))
+
+// reportHTML is the HTML source of the report template.
+const reportHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Taproot Assets load test report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: right; }
+th { background: #eee; }
+td.name { text-align: left; }
+.aborted { color: #b00; font-weight: bold; }
+pre { background: #f6f6f6; padding: 1em; overflow-x: auto; }
+svg { background: #fafafa; border: 1px solid #ddd; }
+</style>
+</head>
+<body>
+<h1>Taproot Assets load test report</h1>
+<p>Generated at {{.Generated.Format "2006-01-02 15:04:05 MST"}} using random
+seed {{.Seed}}.</p>
+{{if .Aborted}}<p class="aborted">The run was aborted by the suite timeout, the
+results are incomplete.</p>{{end}}
+{{if .Violations}}<h2>SLO violations</h2>
+<ul>
+{{range .Violations}}<li>{{.}}</li>
+{{end}}</ul>{{end}}
+<h2>Operations</h2>
+<table>
+<tr><th>Test case</th><th>Operation</th><th>Count</th><th>Failed</th>
+<th>Error rate</th><th>p50</th><th>p95</th><th>p99</th><th>Max</th></tr>
+{{range .Operations}}<tr><td class="name">{{.TestCase}}</td>
+<td class="name">{{.Operation}}</td><td>{{.Count}}</td><td>{{.Failed}}</td>
+<td>{{printf "%.2f%%" .ErrorRate}}</td><td>{{.P50}}</td><td>{{.P95}}</td>
+<td>{{.P99}}</td><td>{{.Max}}</td></tr>
+{{end}}</table>
+<h2>Latency over time</h2>
+{{range .Operations}}{{if .Chart}}<h3>{{.TestCase}} / {{.Operation}}</h3>
+{{.Chart}}
+{{end}}{{end}}
+<h2>Errors</h2>
+{{if .Errors}}<table>
+<tr><th>Test case</th><th>Operation</th><th>Count</th><th>Error</th></tr>
+{{range .Errors}}<tr><td class="name">{{.TestCase}}</td>
+<td class="name">{{.Operation}}</td><td>{{.Count}}</td>
+<td class="name">{{.Error}}</td></tr>
+{{end}}</table>{{else}}<p>No operation failed.</p>{{end}}
+<h2>Configuration</h2>
+<pre>{{.Config}}</pre>
+</body>
+</html>
+`
+
+// reportData is the data the HTML report is rendered from.
+type reportData struct {
+	Generated  time.Time
+	Seed       int64
+	Aborted    bool
+	Violations []string
+	Operations []*reportOperation
+	Errors     []*reportError
+	Config     string
+}
+
+// reportOperation summarizes all results of a single operation of a test case.
+type reportOperation struct {
+	TestCase  string
+	Operation string
+	Count     int
+	Failed    int
+	ErrorRate float64
+	P50       time.Duration
+	P95       time.Duration
+	P99       time.Duration
+	Max       time.Duration
+	Chart     template.HTML
+}
+
+// reportError counts the occurrences of a single error of an operation.
+type reportError struct {
+	TestCase  string
+	Operation string
+	Error     string
+	Count     int
+}
+
+// writeReport renders the standalone HTML report of the given results to the
+// file at the given path.
+func writeReport(path string, cfg *Config,
+	allResults []operationResult) error {
+
+	data, err := newReportData(cfg, allResults)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create report file: %w", err)
+	}
+	defer f.Close()
+
+	if err := reportTemplate.Execute(f, data); err != nil {
+		return fmt.Errorf("unable to render report: %w", err)
+	}
+
+	return f.Sync()
+}
+
+// newReportData summarizes the given results for the report.
+func newReportData(cfg *Config,
+	allResults []operationResult) (*reportData, error) {
+
+	configSnapshot, err := redactedConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &reportData{
+		Generated: time.Now(),
+		Seed:      cfg.Seed,
+		Config:    configSnapshot,
+	}
+	for _, violation := range checkSLOs(cfg.SLOs, allResults) {
+		data.Violations = append(data.Violations, violation.Error())
+	}
+
+	type opKey struct {
+		testCase  string
+		operation string
+	}
+	byOperation := make(map[opKey][]operationResult)
+	errorCounts := make(map[opKey]map[string]int)
+	for _, result := range allResults {
+		if result.Aborted {
+			data.Aborted = true
+		}
+
+		key := opKey{result.TestCase, result.Operation}
+		byOperation[key] = append(byOperation[key], result)
+
+		if result.Error == "" {
+			continue
+		}
+		if errorCounts[key] == nil {
+			errorCounts[key] = make(map[string]int)
+		}
+		errorCounts[key][result.Error]++
+	}
+
+	for key, opResults := range byOperation {
+		data.Operations = append(
+			data.Operations, summarizeOperation(
+				key.testCase, key.operation, opResults,
+			),
+		)
+	}
+	sort.Slice(data.Operations, func(i, j int) bool {
+		a, b := data.Operations[i], data.Operations[j]
+		if a.TestCase != b.TestCase {
+			return a.TestCase < b.TestCase
+		}
+
+		return a.Operation < b.Operation
+	})
+
+	for key, counts := range errorCounts {
+		for errMsg, count := range counts {
+			data.Errors = append(data.Errors, &reportError{
+				TestCase:  key.testCase,
+				Operation: key.operation,
+				Error:     errMsg,
+				Count:     count,
+			})
+		}
+	}
+	sort.Slice(data.Errors, func(i, j int) bool {
+		a, b := data.Errors[i], data.Errors[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.TestCase != b.TestCase {
+			return a.TestCase < b.TestCase
+		}
+		if a.Operation != b.Operation {
+			return a.Operation < b.Operation
+		}
+
+		return a.Error < b.Error
+	})
+
+	return data, nil
+}
+
+// summarizeOperation calculates the statistics of the given results of a
+// single operation and draws their latency chart.
+func summarizeOperation(testCase, operation string,
+	opResults []operationResult) *reportOperation {
+
+	summary := &reportOperation{
+		TestCase:  testCase,
+		Operation: operation,
+		Count:     len(opResults),
+	}
+
+	var succeeded []operationResult
+	for _, result := range opResults {
+		if result.Error != "" {
+			summary.Failed++
+			continue
+		}
+
+		succeeded = append(succeeded, result)
+	}
+	summary.ErrorRate = 100 * float64(summary.Failed) /
+		float64(summary.Count)
+
+	if len(succeeded) == 0 {
+		return summary
+	}
+
+	latencies := make([]float64, len(succeeded))
+	for i, result := range succeeded {
+		latencies[i] = result.LatencySeconds
+	}
+
+	round := func(d time.Duration) time.Duration {
+		return d.Round(time.Millisecond)
+	}
+	summary.P50 = round(percentile(latencies, 0.5))
+	summary.P95 = round(percentile(latencies, 0.95))
+	summary.P99 = round(percentile(latencies, 0.99))
+	summary.Max = round(percentile(latencies, 1))
+	summary.Chart = latencyChart(succeeded)
+
+	return summary
+}
+
+// latencyChart draws an SVG scatter plot of the latency of the given
+// successful operations over the time they were started.
+func latencyChart(opResults []operationResult) template.HTML {
+	sorted := make([]operationResult, len(opResults))
+	copy(sorted, opResults)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	// Thin out large series evenly, so the chart stays readable.
+	step := int(math.Ceil(
+		float64(len(sorted)) / float64(reportMaxChartPoints),
+	))
+
+	var (
+		start      = sorted[0].Timestamp
+		end        = sorted[len(sorted)-1].Timestamp
+		span       = end.Sub(start).Seconds()
+		maxLatency float64
+	)
+	for _, result := range sorted {
+		maxLatency = math.Max(maxLatency, result.LatencySeconds)
+	}
+
+	// Avoid dividing by zero for a single operation or operations that
+	// all took no time at all.
+	if span == 0 {
+		span = 1
+	}
+	if maxLatency == 0 {
+		maxLatency = 1
+	}
+
+	const (
+		plotWidth  = reportChartWidth - 2*reportChartPadding
+		plotHeight = reportChartHeight - 2*reportChartPadding
+	)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" `+
+		`width="%d" height="%d">`, reportChartWidth, reportChartHeight)
+
+	// Draw the axes and label them with their maximum values.
+	fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" `+
+		`stroke="#888"/>`, reportChartPadding, reportChartPadding,
+		reportChartPadding, reportChartHeight-reportChartPadding)
+	fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" `+
+		`stroke="#888"/>`, reportChartPadding,
+		reportChartHeight-reportChartPadding,
+		reportChartWidth-reportChartPadding,
+		reportChartHeight-reportChartPadding)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="10">%.2fs</text>`,
+		2, reportChartPadding-8, maxLatency)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="10" `+
+		`text-anchor="end">%s</text>`,
+		reportChartWidth-reportChartPadding, reportChartHeight-8,
+		(time.Duration(span * float64(time.Second))).Round(time.Second))
+
+	for i := 0; i < len(sorted); i += step {
+		result := sorted[i]
+		offset := result.Timestamp.Sub(start).Seconds()
+
+		x := reportChartPadding + plotWidth*offset/span
+		y := reportChartPadding + plotHeight*
+			(1-result.LatencySeconds/maxLatency)
+		fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="2" `+
+			`fill="#36c"/>`, x, y)
+	}
+	b.WriteString(`</svg>`)
+
+	// The chart only consists of the markup above and numbers we
+	// formatted ourselves, so it is safe to embed as is.
+	return template.HTML(b.String()) //nolint:gosec
+}
+
+// redactedConfig returns the given config as indented JSON, with all secrets
+// removed.
+func redactedConfig(cfg *Config) (string, error) {
+	snapshot := *cfg
+
+	if cfg.Bitcoin != nil {
+		bitcoinCfg := *cfg.Bitcoin
+		if bitcoinCfg.Password != "" {
+			bitcoinCfg.Password = redacted
+		}
+		snapshot.Bitcoin = &bitcoinCfg
+	}
+
+	redactTap := func(tapCfg *TapConfig) *TapConfig {
+		if tapCfg == nil {
+			return nil
+		}
+
+		redactedCfg := *tapCfg
+		if redactedCfg.PostgresDSN != "" {
+			redactedCfg.PostgresDSN = redacted
+		}
+
+		return &redactedCfg
+	}
+	redactUser := func(user *User) *User {
+		if user == nil {
+			return nil
+		}
+
+		return &User{
+			Tapd: redactTap(user.Tapd),
+		}
+	}
+	snapshot.Alice = redactUser(cfg.Alice)
+	snapshot.Bob = redactUser(cfg.Bob)
+
+	snapshot.Nodes = make([]*NodeSpec, len(cfg.Nodes))
+	for i, spec := range cfg.Nodes {
+		snapshot.Nodes[i] = &NodeSpec{
+			TapConfig: *redactTap(&spec.TapConfig),
+			Roles:     spec.Roles,
+		}
+	}
+
+	configJSON, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("unable to encode config: %w", err)
+	}
+
+	return string(configJSON), nil
+}
@@ -0,0 +1,445 @@
+package loadtest
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// scrapeTimeout is the maximum time a single scrape of a node's
+	// metrics or profile endpoint may take.
+	scrapeTimeout = 10 * time.Second
+
+	// metricCPUSeconds is the name of the standard process collector
+	// metric for the total CPU time a process used.
+	metricCPUSeconds = "process_cpu_seconds_total"
+
+	// metricResidentMemory is the name of the standard process collector
+	// metric for the resident memory size of a process.
+	metricResidentMemory = "process_resident_memory_bytes"
+
+	// metricOpenFDs is the name of the standard process collector metric
+	// for the number of open file descriptors of a process.
+	metricOpenFDs = "process_open_fds"
+
+	// metricGoroutines is the name of the standard Go collector metric for
+	// the number of goroutines of a process.
+	metricGoroutines = "go_goroutines"
+)
+
+var (
+	// nodeCPUSeconds is the total CPU time a node under test used, as last
+	// scraped from the node.
+	nodeCPUSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "target_cpu_seconds",
+			Help: "Total CPU time used by the node under " +
+				"test, in seconds",
+		}, []string{"node"},
+	)
+
+	// nodeMemory is the memory used by a node under test, as last scraped
+	// from the node.
+	nodeMemory = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "target_memory_bytes",
+			Help: "Memory used by the node under test, in bytes",
+		}, []string{"node"},
+	)
+
+	// nodeOpenFDs is the number of open file descriptors of a node under
+	// test, as last scraped from the node.
+	nodeOpenFDs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "target_open_fds",
+			Help: "Number of open file descriptors of the node " +
+				"under test",
+		}, []string{"node"},
+	)
+
+	// nodeGoroutines is the number of goroutines of a node under test, as
+	// last scraped from the node.
+	nodeGoroutines = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "target_goroutines",
+			Help: "Number of goroutines of the node under test",
+		}, []string{"node"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(nodeCPUSeconds)
+	prometheus.MustRegister(nodeMemory)
+	prometheus.MustRegister(nodeOpenFDs)
+	prometheus.MustRegister(nodeGoroutines)
+}
+
+// resourceUsage is a single sample of the resource usage of a node. Values
+// that the scraped endpoint doesn't expose are nil.
+type resourceUsage struct {
+	cpuSeconds  *float64
+	memoryBytes *float64
+	openFDs     *float64
+	goroutines  *float64
+}
+
+// record sets the resource gauges of the given node to the sampled values.
+func (u *resourceUsage) record(node string) {
+	set := func(gauge *prometheus.GaugeVec, value *float64) {
+		if value != nil {
+			gauge.WithLabelValues(node).Set(*value)
+		}
+	}
+
+	set(nodeCPUSeconds, u.cpuSeconds)
+	set(nodeMemory, u.memoryBytes)
+	set(nodeOpenFDs, u.openFDs)
+	set(nodeGoroutines, u.goroutines)
+}
+
+// hasProcessEndpoint returns true if the node has an endpoint we can scrape
+// its process metrics or profiles from.
+func (c *TapConfig) hasProcessEndpoint() bool {
+	return c.MetricsURL != "" || c.ProfileURL != ""
+}
+
+// hasDB returns true if the node's database can be accessed to sample its
+// size.
+func (c *TapConfig) hasDB() bool {
+	return c.DBPath != "" || c.PostgresDSN != ""
+}
+
+// resourceNodes returns all nodes of the configured topology that have an
+// endpoint we can scrape their resource usage from or a database we can sample
+// the size of.
+func resourceNodes(cfg *Config) []*TapConfig {
+	var scrapable []*TapConfig
+	for _, node := range cfg.topologyNodes() {
+		if node.hasProcessEndpoint() || node.hasDB() {
+			scrapable = append(scrapable, node)
+		}
+	}
+
+	return scrapable
+}
+
+// resourceMonitor periodically scrapes the resource usage of the nodes under
+// test and records it in the resource gauges, so it is pushed alongside the
+// latency metrics.
+type resourceMonitor struct {
+	nodes    []*TapConfig
+	interval time.Duration
+	client   *http.Client
+
+	// firstDBSize and lastDBSize are the first and last sampled database
+	// sizes of each node, used to report the growth over the monitored
+	// period.
+	firstDBSize map[string]float64
+	lastDBSize  map[string]float64
+
+	// startTransfers is the number of successful transfers that were
+	// recorded when the monitor was started.
+	startTransfers int
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newResourceMonitor creates a new resource monitor for the given nodes.
+func newResourceMonitor(nodes []*TapConfig,
+	interval time.Duration) *resourceMonitor {
+
+	return &resourceMonitor{
+		nodes:    nodes,
+		interval: interval,
+		client: &http.Client{
+			Timeout: scrapeTimeout,
+		},
+		firstDBSize: make(map[string]float64),
+		lastDBSize:  make(map[string]float64),
+		quit:        make(chan struct{}),
+	}
+}
+
+// start starts scraping the nodes in the background. The first scrape happens
+// right away, so even short test cases get at least one sample.
+func (m *resourceMonitor) start(t *testing.T, ctx context.Context) {
+	m.startTransfers = countTransfers(results.snapshot())
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			m.scrapeAll(t, ctx)
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops scraping the nodes. A final scrape is done, so the pushed metrics
+// reflect the state at the end of the test case. The database growth of every
+// node over the monitored period is logged as well.
+func (m *resourceMonitor) stop(t *testing.T, ctx context.Context) {
+	close(m.quit)
+	m.wg.Wait()
+
+	m.scrapeAll(t, ctx)
+
+	numTransfers := countTransfers(results.snapshot()) - m.startTransfers
+	for _, node := range m.nodes {
+		first, ok := m.firstDBSize[node.Name]
+		if !ok {
+			continue
+		}
+
+		growth := m.lastDBSize[node.Name] - first
+		if numTransfers <= 0 {
+			t.Logf("Database of %v grew by %.0f bytes", node.Name,
+				growth)
+
+			continue
+		}
+
+		t.Logf("Database of %v grew by %.0f bytes over %d transfers "+
+			"(%.0f bytes per thousand transfers)", node.Name,
+			growth, numTransfers,
+			growth*1000/float64(numTransfers))
+	}
+}
+
+// scrapeAll scrapes the resource usage of all nodes. Scrape errors are only
+// logged, as a missing sample shouldn't fail the test case.
+func (m *resourceMonitor) scrapeAll(t *testing.T, ctx context.Context) {
+	for _, node := range m.nodes {
+		if node.hasProcessEndpoint() {
+			usage, err := m.scrape(ctx, node)
+			if err != nil {
+				t.Logf("Unable to scrape resource usage of "+
+					"%v: %v", node.Name, err)
+			} else {
+				usage.record(node.Name)
+			}
+		}
+
+		if node.hasDB() {
+			usage, err := sampleDBSize(ctx, node)
+			if err != nil {
+				t.Logf("Unable to sample database size of "+
+					"%v: %v", node.Name, err)
+
+				continue
+			}
+
+			usage.record(node.Name)
+
+			if _, ok := m.firstDBSize[node.Name]; !ok {
+				m.firstDBSize[node.Name] = usage.totalBytes
+			}
+			m.lastDBSize[node.Name] = usage.totalBytes
+		}
+	}
+}
+
+// scrape fetches the resource usage of a single node, preferring the metrics
+// endpoint over the profile server.
+func (m *resourceMonitor) scrape(ctx context.Context,
+	node *TapConfig) (*resourceUsage, error) {
+
+	if node.MetricsURL != "" {
+		body, err := m.get(ctx, node.MetricsURL)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+
+		return parseProcessMetrics(body)
+	}
+
+	baseURL := strings.TrimSuffix(node.ProfileURL, "/")
+
+	goroutineBody, err := m.get(
+		ctx, baseURL+"/debug/pprof/goroutine?debug=1",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer goroutineBody.Close()
+
+	goroutines, err := parseGoroutineProfile(goroutineBody)
+	if err != nil {
+		return nil, err
+	}
+
+	heapBody, err := m.get(ctx, baseURL+"/debug/pprof/heap?debug=1")
+	if err != nil {
+		return nil, err
+	}
+	defer heapBody.Close()
+
+	memory, err := parseHeapProfile(heapBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceUsage{
+		memoryBytes: &memory,
+		goroutines:  &goroutines,
+	}, nil
+}
+
+// get issues a GET request to the given URL and returns the response body if
+// the request was successful.
+func (m *resourceMonitor) get(ctx context.Context,
+	url string) (io.ReadCloser, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %v: %w", url, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unable to fetch %v: status %v", url,
+			resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// parseProcessMetrics extracts the resource usage from the Prometheus text
+// exposition format as exported by the standard process and Go collectors.
+func parseProcessMetrics(r io.Reader) (*resourceUsage, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse metrics: %w", err)
+	}
+
+	value := func(name string) *float64 {
+		family, ok := families[name]
+		if !ok || len(family.Metric) == 0 {
+			return nil
+		}
+
+		metric := family.Metric[0]
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			v := metric.GetCounter().GetValue()
+			return &v
+
+		case dto.MetricType_GAUGE:
+			v := metric.GetGauge().GetValue()
+			return &v
+
+		case dto.MetricType_UNTYPED:
+			v := metric.GetUntyped().GetValue()
+			return &v
+
+		default:
+			return nil
+		}
+	}
+
+	usage := &resourceUsage{
+		cpuSeconds:  value(metricCPUSeconds),
+		memoryBytes: value(metricResidentMemory),
+		openFDs:     value(metricOpenFDs),
+		goroutines:  value(metricGoroutines),
+	}
+	if usage.cpuSeconds == nil && usage.memoryBytes == nil &&
+		usage.openFDs == nil && usage.goroutines == nil {
+
+		return nil, fmt.Errorf("no process metrics found")
+	}
+
+	return usage, nil
+}
+
+// parseGoroutineProfile extracts the total number of goroutines from a
+// goroutine profile in the debug=1 text format.
+func parseGoroutineProfile(r io.Reader) (float64, error) {
+	const prefix = "goroutine profile: total "
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+
+		total, err := strconv.ParseFloat(
+			strings.TrimPrefix(line, prefix), 64,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("invalid goroutine total: %w", err)
+		}
+
+		return total, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("unable to read goroutine profile: %w",
+			err)
+	}
+
+	return 0, fmt.Errorf("goroutine total not found in profile")
+}
+
+// parseHeapProfile extracts the total memory obtained from the OS from a heap
+// profile in the debug=1 text format.
+func parseHeapProfile(r io.Reader) (float64, error) {
+	const prefix = "# Sys = "
+
+	// The memory statistics are at the very end of a potentially large
+	// profile, so we need to allow for long lines of the stack traces.
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+
+		sys, err := strconv.ParseFloat(
+			strings.TrimPrefix(line, prefix), 64,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("invalid heap sys value: %w", err)
+		}
+
+		return sys, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("unable to read heap profile: %w", err)
+	}
+
+	return 0, fmt.Errorf("memory statistics not found in profile")
+}
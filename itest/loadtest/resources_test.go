@@ -0,0 +1,72 @@
+package loadtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseProcessMetrics tests that the resource usage is extracted correctly
+// from the Prometheus text exposition format.
+func TestParseProcessMetrics(t *testing.T) {
+	t.Parallel()
+
+	const metrics = `# HELP go_goroutines Number of goroutines.
+# TYPE go_goroutines gauge
+go_goroutines 123
+# HELP process_cpu_seconds_total Total CPU time in seconds.
+# TYPE process_cpu_seconds_total counter
+process_cpu_seconds_total 4.5
+# HELP process_open_fds Number of open file descriptors.
+# TYPE process_open_fds gauge
+process_open_fds 42
+# HELP process_resident_memory_bytes Resident memory size in bytes.
+# TYPE process_resident_memory_bytes gauge
+process_resident_memory_bytes 1.048576e+06
+`
+
+	usage, err := parseProcessMetrics(strings.NewReader(metrics))
+	require.NoError(t, err)
+
+	require.Equal(t, 4.5, *usage.cpuSeconds)
+	require.Equal(t, 1048576.0, *usage.memoryBytes)
+	require.Equal(t, 42.0, *usage.openFDs)
+	require.Equal(t, 123.0, *usage.goroutines)
+
+	// Metrics without any of the process metrics should be rejected.
+	_, err = parseProcessMetrics(strings.NewReader("foo 1\n"))
+	require.ErrorContains(t, err, "no process metrics")
+}
+
+// TestParsePprofProfiles tests that the goroutine count and memory usage are
+// extracted correctly from the pprof debug text format.
+func TestParsePprofProfiles(t *testing.T) {
+	t.Parallel()
+
+	const goroutines = `goroutine profile: total 57
+20 @ 0x43b976 0x44b5de
+#	0x41c2f3	runtime.gopark+0x0	runtime/proc.go:363
+`
+	total, err := parseGoroutineProfile(strings.NewReader(goroutines))
+	require.NoError(t, err)
+	require.Equal(t, 57.0, total)
+
+	const heap = `heap profile: 1: 2 [3: 4] @ heap/1048576
+1: 2 [3: 4] @ 0x40f8e1 0x4cdc6b
+
+# runtime.MemStats
+# Alloc = 1234
+# TotalAlloc = 5678
+# Sys = 9999
+`
+	sys, err := parseHeapProfile(strings.NewReader(heap))
+	require.NoError(t, err)
+	require.Equal(t, 9999.0, sys)
+
+	_, err = parseGoroutineProfile(strings.NewReader("nothing"))
+	require.Error(t, err)
+
+	_, err = parseHeapProfile(strings.NewReader("nothing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,181 @@
+package loadtest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// resultsFormatJSON writes the results file as a JSON array of
+	// operation results.
+	resultsFormatJSON = "json"
+
+	// resultsFormatCSV writes the results file as CSV with a header row.
+	resultsFormatCSV = "csv"
+)
+
+// operationResult is the outcome of a single operation executed by one of the
+// load test cases.
+type operationResult struct {
+	// Timestamp is the time the operation was started.
+	Timestamp time.Time `json:"timestamp"`
+
+	// TestCase is the name of the test case that executed the operation.
+	TestCase string `json:"test_case"`
+
+	// Operation is the type of the operation.
+	Operation string `json:"operation"`
+
+	// LatencySeconds is the time it took for the operation to complete,
+	// in seconds.
+	LatencySeconds float64 `json:"latency_seconds"`
+
+	// Error is the error the operation failed with, if any.
+	Error string `json:"error,omitempty"`
+
+	// Aborted is true if the operation completed after the suite timeout
+	// fired, which usually means it was canceled while in flight.
+	Aborted bool `json:"aborted,omitempty"`
+}
+
+// resultRecorder collects the results of all operations executed during a load
+// test run, so they can be exported after the run.
+type resultRecorder struct {
+	sync.Mutex
+
+	results []operationResult
+
+	// disabled indicates that no results should be recorded.
+	disabled bool
+
+	// aborted indicates that the run was aborted by the suite timeout.
+	aborted bool
+}
+
+// results is the recorder all operation results of the current run are
+// collected in.
+var results = &resultRecorder{}
+
+// record adds the result of a single operation to the recorder.
+func (r *resultRecorder) record(testCase, operation string,
+	latency time.Duration, opErr error) {
+
+	result := operationResult{
+		Timestamp:      time.Now().Add(-latency),
+		TestCase:       testCase,
+		Operation:      operation,
+		LatencySeconds: latency.Seconds(),
+	}
+	if opErr != nil {
+		result.Error = opErr.Error()
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.disabled {
+		return
+	}
+
+	result.Aborted = r.aborted
+	r.results = append(r.results, result)
+}
+
+// abort marks the run as aborted. All results recorded from now on are marked
+// as aborted.
+func (r *resultRecorder) abort() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.aborted = true
+}
+
+// isAborted returns true if the run was aborted.
+func (r *resultRecorder) isAborted() bool {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.aborted
+}
+
+// disable stops recording any further results.
+func (r *resultRecorder) disable() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.disabled = true
+}
+
+// snapshot returns a copy of all results recorded so far.
+func (r *resultRecorder) snapshot() []operationResult {
+	r.Lock()
+	defer r.Unlock()
+
+	snapshot := make([]operationResult, len(r.results))
+	copy(snapshot, r.results)
+
+	return snapshot
+}
+
+// writeFile writes all results recorded so far to the file at the given path,
+// using the given format.
+func (r *resultRecorder) writeFile(path, format string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create results file: %w", err)
+	}
+	defer f.Close()
+
+	switch format {
+	case resultsFormatJSON:
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(r.results); err != nil {
+			return fmt.Errorf("unable to encode results: %w", err)
+		}
+
+	case resultsFormatCSV:
+		writer := csv.NewWriter(f)
+		err := writer.Write([]string{
+			"timestamp", "test_case", "operation",
+			"latency_seconds", "error", "aborted",
+		})
+		if err != nil {
+			return fmt.Errorf("unable to write header: %w", err)
+		}
+
+		for _, result := range r.results {
+			err := writer.Write([]string{
+				result.Timestamp.Format(time.RFC3339Nano),
+				result.TestCase, result.Operation,
+				strconv.FormatFloat(
+					result.LatencySeconds, 'f', -1, 64,
+				),
+				result.Error,
+				strconv.FormatBool(result.Aborted),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to write result: "+
+					"%w", err)
+			}
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("unable to write results: %w", err)
+		}
+
+	default:
+		return fmt.Errorf("unknown results format: %v", format)
+	}
+
+	return f.Sync()
+}
@@ -0,0 +1,84 @@
+package loadtest
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestResultRecorderWriteFile tests that recorded operation results are
+// written correctly in both supported formats.
+func TestResultRecorderWriteFile(t *testing.T) {
+	t.Parallel()
+
+	recorder := &resultRecorder{}
+	recorder.record("send", opTransfer, 2*time.Second, nil)
+	recorder.record(
+		"burn", opBurn, time.Second, errors.New("burn failed"),
+	)
+
+	tempDir := t.TempDir()
+
+	jsonPath := filepath.Join(tempDir, "results.json")
+	require.NoError(t, recorder.writeFile(jsonPath, resultsFormatJSON))
+
+	jsonBytes, err := os.ReadFile(jsonPath)
+	require.NoError(t, err)
+
+	var decoded []operationResult
+	require.NoError(t, json.Unmarshal(jsonBytes, &decoded))
+	require.Len(t, decoded, 2)
+	require.Equal(t, "send", decoded[0].TestCase)
+	require.Equal(t, opTransfer, decoded[0].Operation)
+	require.Equal(t, 2.0, decoded[0].LatencySeconds)
+	require.Empty(t, decoded[0].Error)
+	require.Equal(t, "burn failed", decoded[1].Error)
+
+	csvPath := filepath.Join(tempDir, "results.csv")
+	require.NoError(t, recorder.writeFile(csvPath, resultsFormatCSV))
+
+	csvFile, err := os.Open(csvPath)
+	require.NoError(t, err)
+	defer csvFile.Close()
+
+	records, err := csv.NewReader(csvFile).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "latency_seconds", records[0][3])
+	require.Equal(
+		t, []string{"burn", opBurn, "1", "burn failed", "false"},
+		records[2][1:],
+	)
+
+	err = recorder.writeFile(jsonPath, "xml")
+	require.ErrorContains(t, err, "unknown results format")
+}
+
+// TestResultRecorderAbort tests that only the results recorded after the run
+// was aborted are marked as aborted.
+func TestResultRecorderAbort(t *testing.T) {
+	t.Parallel()
+
+	recorder := &resultRecorder{}
+	recorder.record("send", opTransfer, time.Second, nil)
+	require.False(t, recorder.isAborted())
+
+	recorder.abort()
+	require.True(t, recorder.isAborted())
+
+	recorder.record(
+		"send", opTransfer, time.Second, context.DeadlineExceeded,
+	)
+
+	snapshot := recorder.snapshot()
+	require.Len(t, snapshot, 2)
+	require.False(t, snapshot[0].Aborted)
+	require.True(t, snapshot[1].Aborted)
+}
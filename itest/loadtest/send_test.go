@@ -2,10 +2,11 @@ package loadtest
 
 import (
 	"context"
-	prand "math/rand"
+	"fmt"
 	"testing"
+	"time"
 
-	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/taproot-assets/itest"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	"github.com/lightningnetwork/lnd/lntest/wait"
@@ -14,46 +15,107 @@ import (
 
 var (
 	statusDetected  = taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED
+	statusConfirmed = taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED
 	statusCompleted = taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED
 )
 
-// sendTest checks that we are able to send assets between the two nodes.
+// sendTest checks that we are able to send assets between the nodes of the
+// configured topology. The configured number of sends is distributed over the
+// configured number of concurrent workers.
 func sendTest(t *testing.T, ctx context.Context, cfg *Config) {
 	// Start by initializing all our client connections.
 	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+	pool := initNodePool(t, ctx, cfg, alice, bob)
 
 	ctxb := context.Background()
 	ctxt, cancel := context.WithTimeout(ctxb, cfg.TestTimeout)
 	defer cancel()
 
-	t.Logf("Running send test, sending %d asset(s) of type %v %d times",
-		cfg.NumAssets, cfg.SendType, cfg.NumSends)
-	for i := 1; i <= cfg.NumSends; i++ {
-		send, receive, ok := pickSendNode(
-			t, ctx, cfg.NumAssets, cfg.SendType, alice, bob,
+	concurrency := cfg.SendConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	t.Logf("Running send test, sending %d asset(s) of type %v %d times "+
+		"using %d worker(s)", cfg.NumAssets, cfg.SendType,
+		cfg.NumSends, concurrency)
+
+	miner := newBlockMiner(bitcoinClient, cfg.Chaos)
+
+	// All workers share the same pacer, so the load profile applies to the
+	// test case as a whole.
+	sendPacer := newPacer(cfg.LoadProfile)
+
+	// The group sub test only returns once all parallel worker sub tests
+	// have completed.
+	t.Run("workers", func(t *testing.T) {
+		for worker := 0; worker < concurrency; worker++ {
+			// Distribute the sends as evenly as possible over all
+			// workers.
+			numSends := cfg.NumSends / concurrency
+			if worker < cfg.NumSends%concurrency {
+				numSends++
+			}
+
+			worker := worker
+			name := fmt.Sprintf("worker-%d", worker)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				sendWorker(
+					t, ctxt, cfg, worker, numSends, pool,
+					miner, sendPacer,
+				)
+			})
+		}
+	})
+}
+
+// sendWorker performs the given number of send operations in sequence, paced
+// by the given pacer, and logs the worker's individual send durations.
+func sendWorker(t *testing.T, ctx context.Context, cfg *Config, worker,
+	numSends int, pool *nodePool, miner *blockMiner, sendPacer *pacer) {
+
+	var totalDuration time.Duration
+	for i := 1; i <= numSends; i++ {
+		require.NoError(t, sendPacer.wait(ctx))
+
+		send, receive, ok := pool.pickSendPair(
+			t, ctx, cfg.NumAssets, cfg.SendType,
 		)
 		if !ok {
-			t.Fatalf("Aborting send test at attempt %d of %d as "+
-				"no node has enough balance to send %d "+
-				"assets of type %v", i, cfg.NumSends,
-				cfg.NumAssets, cfg.SendType)
+			t.Fatalf("Aborting send test at attempt %d of %d of "+
+				"worker %d as no node has enough balance to "+
+				"send %d assets of type %v", i, numSends,
+				worker, cfg.NumAssets, cfg.SendType)
 			return
 		}
 
-		sendAssets(
-			t, ctxt, cfg.NumAssets, cfg.SendType, send, receive,
-			bitcoinClient,
+		sendDuration := sendAssets(
+			t, ctx, "send", cfg.NumAssets, cfg.SendType, send,
+			receive, miner,
 		)
+		totalDuration += sendDuration
+
+		t.Logf("Worker %d finished %d of %d send operations, send "+
+			"took %v", worker, i, numSends, sendDuration)
+	}
 
-		t.Logf("Finished %d of %d send operations", i, cfg.NumSends)
+	if numSends == 0 {
+		return
 	}
+
+	t.Logf("Worker %d finished %d send operations, average send duration "+
+		"%v", worker, numSends, totalDuration/time.Duration(numSends))
 }
 
 // sendAsset sends the given number of assets of the given type from the given
-// node to the other node.
-func sendAssets(t *testing.T, ctx context.Context, numAssets uint64,
-	assetType taprpc.AssetType, send, receive *rpcClient,
-	bitcoinClient *rpcclient.Client) {
+// node to the other node and records the latencies under the given test case.
+// The time it took for the transfer to complete on the receiving node is
+// returned.
+func sendAssets(t *testing.T, ctx context.Context, testCase string,
+	numAssets uint64, assetType taprpc.AssetType, send, receive *rpcClient,
+	miner *blockMiner) time.Duration {
 
 	// Query the asset we'll be sending, so we can assert some things about
 	// it later.
@@ -70,60 +132,92 @@ func sendAssets(t *testing.T, ctx context.Context, numAssets uint64,
 	require.NoError(t, err)
 	itest.AssertAddrCreated(t, receive, sendAsset, addr)
 
-	// Before we send the asset, we record the existing transfers on the
-	// sending node, so we can easily select the new transfer once it
-	// appears.
-	transfersBefore := send.listTransfersSince(t, ctx, nil)
-
 	// Initiate the send now.
-	_, err = send.SendAsset(ctx, &taprpc.SendAssetRequest{
+	startTime := time.Now()
+	sendResp, err := send.SendAsset(ctx, &taprpc.SendAssetRequest{
 		TapAddrs: []string{addr.Encoded},
 	})
+	if err != nil {
+		recordFailure(testCase, opTransfer, time.Since(startTime), err)
+	}
 	require.NoError(t, err)
 
-	// Wait for the transfer to appear on the sending node.
+	anchorTxHash, err := chainhash.NewHash(sendResp.Transfer.AnchorTxHash)
+	require.NoError(t, err)
+
+	// Wait for the transfer to appear on the sending node. Other workers
+	// might be sending at the same time, so we identify our transfer by
+	// its anchor transaction.
 	require.Eventually(t, func() bool {
-		newTransfers := send.listTransfersSince(t, ctx, transfersBefore)
-		return len(newTransfers) == 1
+		return send.hasTransfer(t, ctx, anchorTxHash)
 	}, defaultTimeout, wait.PollInterval)
 
 	// And for it to be detected on the receiving node.
 	itest.AssertAddrEvent(t, receive, addr, 1, statusDetected)
 
-	// Mine a block to confirm the transfer.
-	itest.MineBlocks(t, bitcoinClient, 1, 1)
+	// Make sure the transfer is confirmed in a block.
+	miner.confirmTx(t, anchorTxHash)
+
+	// Now the transfer should go to completed eventually, once the
+	// receiver fetched, verified and imported the proof.
+	confirmTime := time.Now()
+	receiveConfirmed, receiveCompleted := awaitReceiveCompleted(
+		t, ctx, receive, addr,
+	)
+
+	sendDuration := receiveCompleted.Sub(startTime)
+	observeLatency(
+		testCase, opProofImport, receiveCompleted.Sub(confirmTime),
+	)
+	if !receiveConfirmed.IsZero() {
+		observeLatency(
+			testCase, opReceiveVerify,
+			receiveCompleted.Sub(receiveConfirmed),
+		)
+	}
+	observeLatency(testCase, opTransfer, sendDuration)
 
-	// Now the transfer should go to completed eventually.
-	itest.AssertAddrEvent(t, receive, addr, 1, statusCompleted)
+	return sendDuration
 }
 
-// pickSendNode picks a node at random, checks whether it has enough assets of
-// the given type, and returns it. The second return value is the other node,
-// which will be the receiving node. The boolean argument returns true if there
-// is a node with sufficient balance. If that is false, the test should be
-// skipped.
-func pickSendNode(t *testing.T, ctx context.Context, minBalance uint64,
-	assetType taprpc.AssetType, a, b *rpcClient) (*rpcClient, *rpcClient,
-	bool) {
-
-	send, receive := a, b
-	if prand.Intn(1) == 0 {
-		send, receive = b, a
-	}
+// awaitReceiveCompleted waits for the inbound transfer to the given address to
+// complete on the receiving node. It returns the time the receiver first
+// reported the anchor transaction as confirmed and the time it completed the
+// transfer. The confirmation time is zero if the receiver completed the
+// transfer before its confirmed status could be observed.
+func awaitReceiveCompleted(t *testing.T, ctx context.Context,
+	receive *rpcClient, addr *taprpc.Addr) (time.Time, time.Time) {
+
+	var confirmedAt, completedAt time.Time
+	err := wait.NoError(func() error {
+		resp, err := receive.AddrReceives(
+			ctx, &taprpc.AddrReceivesRequest{
+				FilterAddr: addr.Encoded,
+			},
+		)
+		if err != nil {
+			return err
+		}
 
-	// Check if the randomly picked send node has enough balance.
-	if send.assetIDWithBalance(t, ctx, minBalance, assetType) != nil {
-		return send, receive, true
-	}
+		if len(resp.Events) != 1 {
+			return fmt.Errorf("got %d events, wanted 1",
+				len(resp.Events))
+		}
 
-	// If we get here, the send node doesn't have enough balance. We'll try
-	// the other one.
-	send, receive = receive, send
-	if send.assetIDWithBalance(t, ctx, minBalance, assetType) != nil {
-		return send, receive, true
-	}
+		status := resp.Events[0].Status
+		switch {
+		case status == statusCompleted:
+			completedAt = time.Now()
+			return nil
+
+		case status == statusConfirmed && confirmedAt.IsZero():
+			confirmedAt = time.Now()
+		}
+
+		return fmt.Errorf("got status %v, wanted %v", status,
+			statusCompleted)
+	}, defaultTimeout)
+	require.NoError(t, err)
 
-	// None of the nodes have enough balance. We can't run the send test
-	// currently.
-	return nil, nil, false
+	return confirmedAt, completedAt
 }
@@ -0,0 +1,234 @@
+package loadtest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// sloP50Max is the threshold for the maximum median latency.
+	sloP50Max = "p50_max"
+
+	// sloP95Max is the threshold for the maximum 95th percentile latency.
+	sloP95Max = "p95_max"
+
+	// sloP99Max is the threshold for the maximum 99th percentile latency.
+	sloP99Max = "p99_max"
+
+	// sloMaxErrorRate is the threshold for the maximum fraction of failed
+	// operations.
+	sloMaxErrorRate = "max_error_rate"
+)
+
+// sloPercentiles maps the latency thresholds to the percentile they limit.
+var sloPercentiles = map[string]float64{
+	sloP50Max: 0.5,
+	sloP95Max: 0.95,
+	sloP99Max: 0.99,
+}
+
+// SLO is a service level objective that the results of a load test run must
+// meet. It is specified as <test_case>[.<operation>].<threshold>=<value>, for
+// example send.p95_max=5s or send.transfer.max_error_rate=0.01. If the test
+// case is omitted (e.g. max_error_rate=0.01), the objective applies to every
+// test case on its own.
+type SLO struct {
+	// TestCase is the test case the objective applies to. All test cases
+	// are checked individually if this is empty.
+	TestCase string
+
+	// Operation is the operation of the test case the objective applies
+	// to. All operations of the test case are combined if this is empty.
+	Operation string
+
+	// Threshold is the type of threshold of the objective.
+	Threshold string
+
+	// MaxLatency is the maximum latency for the latency thresholds.
+	MaxLatency time.Duration
+
+	// MaxErrorRate is the maximum fraction of failed operations for the
+	// error rate threshold.
+	MaxErrorRate float64
+}
+
+// UnmarshalFlag parses a service level objective from its string
+// representation.
+//
+// NOTE: This is part of the flags.Unmarshaler interface.
+func (s *SLO) UnmarshalFlag(value string) error {
+	key, val, ok := strings.Cut(strings.TrimSpace(value), "=")
+	if !ok {
+		return fmt.Errorf("invalid SLO '%s', expected key=value", value)
+	}
+
+	parts := strings.Split(key, ".")
+	s.Threshold = parts[len(parts)-1]
+
+	switch len(parts) {
+	case 1:
+	case 2:
+		s.TestCase = parts[0]
+
+	case 3:
+		s.TestCase = parts[0]
+		s.Operation = parts[1]
+
+	default:
+		return fmt.Errorf("invalid SLO key '%s'", key)
+	}
+
+	switch s.Threshold {
+	case sloP50Max, sloP95Max, sloP99Max:
+		maxLatency, err := time.ParseDuration(val)
+		if err != nil {
+			return fmt.Errorf("invalid latency '%s': %w", val, err)
+		}
+		if maxLatency <= 0 {
+			return fmt.Errorf("latency threshold must be positive")
+		}
+		s.MaxLatency = maxLatency
+
+	case sloMaxErrorRate:
+		maxRate, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return fmt.Errorf("invalid error rate '%s': %w", val,
+				err)
+		}
+		if maxRate < 0 || maxRate > 1 {
+			return fmt.Errorf("error rate must be between 0 and 1")
+		}
+		s.MaxErrorRate = maxRate
+
+	default:
+		return fmt.Errorf("unknown SLO threshold '%s'", s.Threshold)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the objective.
+func (s *SLO) String() string {
+	key := s.Threshold
+	if s.Operation != "" {
+		key = s.Operation + "." + key
+	}
+	if s.TestCase != "" {
+		key = s.TestCase + "." + key
+	}
+
+	if s.Threshold == sloMaxErrorRate {
+		return fmt.Sprintf("%s=%v", key, s.MaxErrorRate)
+	}
+
+	return fmt.Sprintf("%s=%v", key, s.MaxLatency)
+}
+
+// check verifies the objective against the given results of a single test
+// case and returns an error if it is violated.
+func (s *SLO) check(testCase string, testResults []operationResult) error {
+	var (
+		latencies []float64
+		numFailed int
+		numTotal  int
+	)
+	for _, result := range testResults {
+		if s.Operation != "" && result.Operation != s.Operation {
+			continue
+		}
+
+		numTotal++
+		if result.Error != "" {
+			numFailed++
+			continue
+		}
+
+		latencies = append(latencies, result.LatencySeconds)
+	}
+
+	// An objective without any matching operations can't be violated.
+	if numTotal == 0 {
+		return nil
+	}
+
+	if s.Threshold == sloMaxErrorRate {
+		errorRate := float64(numFailed) / float64(numTotal)
+		if errorRate > s.MaxErrorRate {
+			return fmt.Errorf("SLO %v violated by test case %v: "+
+				"error rate was %.4f (%d of %d operations "+
+				"failed)", s, testCase, errorRate, numFailed,
+				numTotal)
+		}
+
+		return nil
+	}
+
+	if len(latencies) == 0 {
+		return nil
+	}
+
+	latency := percentile(latencies, sloPercentiles[s.Threshold])
+	if latency > s.MaxLatency {
+		return fmt.Errorf("SLO %v violated by test case %v: latency "+
+			"was %v", s, testCase, latency)
+	}
+
+	return nil
+}
+
+// checkSLOs verifies all objectives against the given results and returns an
+// error for every violated objective. Objectives without a test case are
+// checked against every test case except for the injected chaos failures.
+func checkSLOs(slos []*SLO, allResults []operationResult) []error {
+	byTestCase := make(map[string][]operationResult)
+	for _, result := range allResults {
+		byTestCase[result.TestCase] = append(
+			byTestCase[result.TestCase], result,
+		)
+	}
+
+	testCases := make([]string, 0, len(byTestCase))
+	for testCase := range byTestCase {
+		testCases = append(testCases, testCase)
+	}
+	sort.Strings(testCases)
+
+	var violations []error
+	for _, slo := range slos {
+		for _, testCase := range testCases {
+			switch {
+			case slo.TestCase != "" && slo.TestCase != testCase:
+				continue
+
+			case slo.TestCase == "" && testCase == chaosTestCase:
+				continue
+			}
+
+			err := slo.check(testCase, byTestCase[testCase])
+			if err != nil {
+				violations = append(violations, err)
+			}
+		}
+	}
+
+	return violations
+}
+
+// percentile returns the given percentile of the latencies in seconds, using
+// the nearest rank method.
+func percentile(latencies []float64, p float64) time.Duration {
+	sorted := make([]float64, len(latencies))
+	copy(sorted, latencies)
+	sort.Float64s(sorted)
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+
+	return time.Duration(sorted[rank] * float64(time.Second))
+}
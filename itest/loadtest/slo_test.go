@@ -0,0 +1,127 @@
+package loadtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSLOUnmarshalFlag tests the parsing of service level objectives.
+func TestSLOUnmarshalFlag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		value     string
+		expected  *SLO
+		expectErr string
+	}{{
+		name:  "test case latency",
+		value: "send.p95_max=5s",
+		expected: &SLO{
+			TestCase:   "send",
+			Threshold:  sloP95Max,
+			MaxLatency: 5 * time.Second,
+		},
+	}, {
+		name:  "operation latency",
+		value: "send.transfer.p99_max=1m",
+		expected: &SLO{
+			TestCase:   "send",
+			Operation:  opTransfer,
+			Threshold:  sloP99Max,
+			MaxLatency: time.Minute,
+		},
+	}, {
+		name:  "global error rate",
+		value: "max_error_rate=0.01",
+		expected: &SLO{
+			Threshold:    sloMaxErrorRate,
+			MaxErrorRate: 0.01,
+		},
+	}, {
+		name:      "unknown threshold",
+		value:     "send.p90_max=5s",
+		expectErr: "unknown SLO threshold",
+	}, {
+		name:      "invalid latency",
+		value:     "send.p50_max=5",
+		expectErr: "invalid latency",
+	}, {
+		name:      "invalid error rate",
+		value:     "max_error_rate=2",
+		expectErr: "between 0 and 1",
+	}, {
+		name:      "missing value",
+		value:     "send.p50_max",
+		expectErr: "expected key=value",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var slo SLO
+			err := slo.UnmarshalFlag(tc.value)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, &slo)
+		})
+	}
+}
+
+// TestCheckSLOs tests that violated service level objectives are detected.
+func TestCheckSLOs(t *testing.T) {
+	t.Parallel()
+
+	var testResults []operationResult
+	for i := 1; i <= 100; i++ {
+		testResults = append(testResults, operationResult{
+			TestCase:       "send",
+			Operation:      opTransfer,
+			LatencySeconds: float64(i) / 10,
+		})
+	}
+	testResults = append(testResults, operationResult{
+		TestCase:  "send",
+		Operation: opTransfer,
+		Error:     "send failed",
+	}, operationResult{
+		TestCase:  chaosTestCase,
+		Operation: opChaosRestart,
+		Error:     "restart failed",
+	})
+
+	parse := func(value string) *SLO {
+		var slo SLO
+		require.NoError(t, slo.UnmarshalFlag(value))
+
+		return &slo
+	}
+
+	// The p95 latency is 9.5 seconds and one out of 101 operations
+	// failed, the chaos failure isn't considered for global objectives.
+	require.Empty(t, checkSLOs([]*SLO{
+		parse("send.p95_max=9.5s"),
+		parse("send.transfer.p50_max=5s"),
+		parse("max_error_rate=0.01"),
+		parse("burn.p50_max=1ms"),
+	}, testResults))
+
+	violations := checkSLOs([]*SLO{
+		parse("send.p95_max=9s"),
+		parse("send.max_error_rate=0.005"),
+		parse("chaos.max_error_rate=0.5"),
+	}, testResults)
+	require.Len(t, violations, 3)
+	require.ErrorContains(t, violations[0], "latency was 9.5s")
+	require.ErrorContains(t, violations[1], "1 of 101 operations")
+	require.ErrorContains(t, violations[2], "test case chaos")
+}
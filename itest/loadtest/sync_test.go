@@ -0,0 +1,134 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/itest"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/stretchr/testify/require"
+)
+
+// syncTest mints a batch of assets on one node and measures how long it takes
+// the second node to sync the new issuance proofs from the first node's
+// universe. It first runs a full sync over all universes, then mints another
+// batch and runs an incremental sync that only targets the new assets.
+func syncTest(t *testing.T, ctx context.Context, cfg *Config) {
+	// Start by initializing all our client connections.
+	alice, bob, bitcoinClient := initClients(t, ctx, cfg)
+
+	aliceHost := alice.hostPort()
+
+	// We first mint the initial batch of assets on alice and ask bob to
+	// sync all universes alice knows about.
+	mintUngroupedBatch(
+		t, cfg, alice, bitcoinClient, "sync", cfg.SyncNumAssets,
+	)
+
+	t.Logf("Running full universe sync of %d new asset(s) from %v to %v",
+		cfg.SyncNumAssets, alice.cfg.Name, bob.cfg.Name)
+	syncUniverse(t, ctx, bob, aliceHost, opSyncFull, nil)
+
+	// Then we mint another batch and only sync the universes of the newly
+	// minted assets.
+	newAssets := mintUngroupedBatch(
+		t, cfg, alice, bitcoinClient, "sync",
+		cfg.SyncIncrementalNumAssets,
+	)
+
+	targets := make([]*unirpc.SyncTarget, 0, len(newAssets))
+	for _, a := range newAssets {
+		targets = append(targets, &unirpc.SyncTarget{
+			Id: &unirpc.ID{
+				Id: &unirpc.ID_AssetId{
+					AssetId: a.AssetGenesis.AssetId,
+				},
+				ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+			},
+		})
+	}
+
+	t.Logf("Running incremental universe sync of %d new asset(s) from %v "+
+		"to %v", len(newAssets), alice.cfg.Name, bob.cfg.Name)
+	syncUniverse(t, ctx, bob, aliceHost, opSyncIncremental, targets)
+
+	// After both syncs, the two universes should be in the same state.
+	require.True(t, itest.AssertUniverseStateEqual(t, alice, bob))
+}
+
+// mintUngroupedBatch mints a batch of the given number of ungrouped normal
+// assets, so each asset creates its own universe. The names of the assets are
+// derived from the given prefix.
+func mintUngroupedBatch(t *testing.T, cfg *Config, minter *rpcClient,
+	bitcoinClient *rpcclient.Client, namePrefix string,
+	numAssets int) []*taprpc.Asset {
+
+	baseName := fmt.Sprintf("%s-%d", namePrefix, loadRand.Int31())
+	batchReqs := make([]*mintrpc.MintAssetRequest, numAssets)
+	for i := 0; i < numAssets; i++ {
+		batchReqs[i] = &mintrpc.MintAssetRequest{
+			Asset: &mintrpc.MintAsset{
+				AssetType: taprpc.AssetType_NORMAL,
+				Name:      fmt.Sprintf("%s-%d", baseName, i),
+				AssetMeta: &taprpc.AssetMeta{
+					Data: []byte(namePrefix + " load test"),
+				},
+				Amount: 1000,
+			},
+		}
+	}
+
+	itest.LogfTimestamped(t, "minting batch of %d assets on %v for %s "+
+		"test", numAssets, minter.cfg.Name, namePrefix)
+
+	return itest.MintAssetsConfirmBatch(
+		t, bitcoinClient, minter, batchReqs,
+		itest.WithMintingTimeout(cfg.TestTimeout),
+	)
+}
+
+// syncUniverse instructs the syncing node to sync the given targets (or all
+// universes if no targets are given) from the given universe host and records
+// the total sync duration as well as the average duration per inserted leaf.
+func syncUniverse(t *testing.T, ctx context.Context, syncer *rpcClient,
+	universeHost, operation string, targets []*unirpc.SyncTarget) {
+
+	startTime := time.Now()
+	resp, err := syncer.SyncUniverse(ctx, &unirpc.SyncRequest{
+		UniverseHost: universeHost,
+		SyncMode:     unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY,
+		SyncTargets:  targets,
+	})
+	syncDuration := time.Since(startTime)
+	if err != nil {
+		recordFailure("sync", operation, syncDuration, err)
+	}
+	require.NoError(t, err)
+
+	observeLatency("sync", operation, syncDuration)
+
+	var numLeaves int
+	for _, syncedUniverse := range resp.SyncedUniverses {
+		numLeaves += len(syncedUniverse.NewAssetLeaves)
+	}
+
+	t.Logf("Synced %d universe(s) with %d new leaves in %v",
+		len(resp.SyncedUniverses), numLeaves, syncDuration)
+
+	// The node might have already synced some of the leaves in the
+	// background, in which case there's nothing to attribute the sync time
+	// to.
+	if numLeaves == 0 {
+		return
+	}
+
+	perLeafDuration := syncDuration / time.Duration(numLeaves)
+	observeLatency("sync", opSyncLeafInsert, perLeafDuration)
+
+	t.Logf("Average insert duration per leaf: %v", perLeafDuration)
+}
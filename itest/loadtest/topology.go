@@ -0,0 +1,237 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// roleSender marks a node that can be picked as the sending node of a
+	// transfer.
+	roleSender = "sender"
+
+	// roleReceiver marks a node that can be picked as the receiving node
+	// of a transfer.
+	roleReceiver = "receiver"
+
+	// roleUniverse marks a node that acts as a universe server and is
+	// expected to sync the issuance proofs of newly minted assets.
+	roleUniverse = "universe"
+)
+
+// defaultRoles are the roles a node has if none are configured explicitly.
+var defaultRoles = []string{roleSender, roleReceiver}
+
+// NodeSpec describes an additional tapd node of the network topology. It is
+// specified on the command line or in the config file as a comma separated
+// list of key=value pairs, for example:
+//
+//	node=name=carol,host=localhost,port=10035,tlspath=/tls.cert,
+//	     macpath=/admin.macaroon,roles=sender+receiver,
+//	     metricsurl=http://localhost:8991/metrics
+type NodeSpec struct {
+	TapConfig
+
+	// Roles is the list of roles the node has in the topology.
+	Roles []string
+}
+
+// UnmarshalFlag parses a node specification from its string representation.
+//
+// NOTE: This is part of the flags.Unmarshaler interface.
+func (n *NodeSpec) UnmarshalFlag(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return fmt.Errorf("invalid node option '%s', expected "+
+				"key=value", pair)
+		}
+
+		switch key {
+		case "name":
+			n.Name = val
+
+		case "host":
+			n.Host = val
+
+		case "port":
+			port, err := strconv.Atoi(val)
+			if err != nil {
+				return fmt.Errorf("invalid port '%s': %w", val,
+					err)
+			}
+			n.Port = port
+
+		case "tlspath":
+			n.TLSPath = val
+
+		case "macpath":
+			n.MacPath = val
+
+		case "metricsurl":
+			n.MetricsURL = val
+
+		case "profileurl":
+			n.ProfileURL = val
+
+		case "dbpath":
+			n.DBPath = val
+
+		case "postgresdsn":
+			n.PostgresDSN = val
+
+		case "dbbackend":
+			n.DBBackend = val
+
+		case "roles":
+			n.Roles = strings.Split(val, "+")
+
+		default:
+			return fmt.Errorf("unknown node option '%s'", key)
+		}
+	}
+
+	if n.Name == "" || n.Host == "" || n.Port == 0 {
+		return fmt.Errorf("node requires at least name, host and " +
+			"port")
+	}
+
+	if len(n.Roles) == 0 {
+		n.Roles = defaultRoles
+	}
+
+	for _, role := range n.Roles {
+		switch role {
+		case roleSender, roleReceiver, roleUniverse:
+		default:
+			return fmt.Errorf("unknown role '%s' for node %s", role,
+				n.Name)
+		}
+	}
+
+	return nil
+}
+
+// topologyNodes returns the configuration of all tapd nodes of the configured
+// topology, starting with alice and bob.
+func (c *Config) topologyNodes() []*TapConfig {
+	nodes := []*TapConfig{c.Alice.Tapd, c.Bob.Tapd}
+	for _, spec := range c.Nodes {
+		nodes = append(nodes, &spec.TapConfig)
+	}
+
+	return nodes
+}
+
+// poolNode is a node of the topology with an established client connection.
+type poolNode struct {
+	*rpcClient
+
+	roles map[string]struct{}
+}
+
+// hasRole returns true if the node has the given role.
+func (n *poolNode) hasRole(role string) bool {
+	_, ok := n.roles[role]
+	return ok
+}
+
+// nodePool is the set of all tapd nodes of the configured topology.
+type nodePool struct {
+	nodes []*poolNode
+}
+
+// initNodePool connects to all nodes of the configured topology. The main
+// alice and bob nodes are always part of the pool, with both the sender and
+// receiver roles. Bob additionally acts as a universe server that syncs from
+// alice, as the mint test has always expected.
+func initNodePool(t *testing.T, ctx context.Context, cfg *Config, alice,
+	bob *rpcClient) *nodePool {
+
+	pool := &nodePool{}
+	pool.add(alice, defaultRoles)
+	pool.add(bob, append([]string{roleUniverse}, defaultRoles...))
+
+	for _, spec := range cfg.Nodes {
+		spec := spec
+
+		node := getTapClient(t, ctx, cfg, &spec.TapConfig)
+		_, err := node.GetInfo(ctx, &taprpc.GetInfoRequest{})
+		require.NoError(t, err)
+
+		pool.add(node, spec.Roles)
+	}
+
+	return pool
+}
+
+// add adds a node with the given roles to the pool.
+func (p *nodePool) add(client *rpcClient, roles []string) {
+	node := &poolNode{
+		rpcClient: client,
+		roles:     make(map[string]struct{}, len(roles)),
+	}
+	for _, role := range roles {
+		node.roles[role] = struct{}{}
+	}
+
+	p.nodes = append(p.nodes, node)
+}
+
+// withRole returns all nodes of the pool that have the given role.
+func (p *nodePool) withRole(role string) []*rpcClient {
+	var nodes []*rpcClient
+	for _, node := range p.nodes {
+		if node.hasRole(role) {
+			nodes = append(nodes, node.rpcClient)
+		}
+	}
+
+	return nodes
+}
+
+// pickSendPair picks a random sending node that has enough assets of the given
+// type and a random receiving node that is different from the sender. The
+// boolean return value is false if no node with the sender role has enough
+// balance or there is no matching receiver.
+func (p *nodePool) pickSendPair(t *testing.T, ctx context.Context,
+	minBalance uint64, assetType taprpc.AssetType) (*rpcClient, *rpcClient,
+	bool) {
+
+	senders := p.withRole(roleSender)
+	loadRand.Shuffle(len(senders), func(i, j int) {
+		senders[i], senders[j] = senders[j], senders[i]
+	})
+
+	for _, send := range senders {
+		sendAsset := send.assetIDWithBalance(
+			t, ctx, minBalance, assetType,
+		)
+		if sendAsset == nil {
+			continue
+		}
+
+		var receivers []*rpcClient
+		for _, receive := range p.withRole(roleReceiver) {
+			if receive != send {
+				receivers = append(receivers, receive)
+			}
+		}
+
+		if len(receivers) == 0 {
+			continue
+		}
+
+		return send, receivers[loadRand.Intn(len(receivers))], true
+	}
+
+	// None of the nodes have enough balance. We can't run the send test
+	// currently.
+	return nil, nil, false
+}
@@ -0,0 +1,78 @@
+package loadtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestNodeSpecUnmarshalFlag tests the parsing of additional topology nodes.
+func TestNodeSpecUnmarshalFlag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		value     string
+		expected  *NodeSpec
+		expectErr string
+	}{{
+		name: "all options",
+		value: "name=carol,host=localhost,port=10035,tlspath=/tls," +
+			"macpath=/mac,roles=sender+universe",
+		expected: &NodeSpec{
+			TapConfig: TapConfig{
+				Name:    "carol",
+				Host:    "localhost",
+				Port:    10035,
+				TLSPath: "/tls",
+				MacPath: "/mac",
+			},
+			Roles: []string{roleSender, roleUniverse},
+		},
+	}, {
+		name:  "default roles",
+		value: "name=dave,host=localhost,port=10036",
+		expected: &NodeSpec{
+			TapConfig: TapConfig{
+				Name: "dave",
+				Host: "localhost",
+				Port: 10036,
+			},
+			Roles: defaultRoles,
+		},
+	}, {
+		name:      "missing port",
+		value:     "name=dave,host=localhost",
+		expectErr: "requires at least",
+	}, {
+		name:      "invalid port",
+		value:     "name=dave,host=localhost,port=abc",
+		expectErr: "invalid port",
+	}, {
+		name:      "unknown option",
+		value:     "name=dave,host=localhost,port=1,foo=bar",
+		expectErr: "unknown node option",
+	}, {
+		name:      "unknown role",
+		value:     "name=dave,host=localhost,port=1,roles=miner",
+		expectErr: "unknown role",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var spec NodeSpec
+			err := spec.UnmarshalFlag(tc.value)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, &spec)
+		})
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/lightninglabs/taproot-assets/itest"
 	"github.com/lightninglabs/taproot-assets/taprpc"
@@ -18,6 +20,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -43,29 +46,54 @@ type rpcClient struct {
 	assetwalletrpc.AssetWalletClient
 }
 
+// hostPort returns the host:port string of the node's RPC server, which can
+// also be used to sync from the node's universe.
+func (r *rpcClient) hostPort() string {
+	return fmt.Sprintf("%s:%d", r.cfg.Host, r.cfg.Port)
+}
+
 // assetIDWithBalance returns the asset ID of an asset that has at least the
 // given balance. If no such asset is found, nil is returned.
 func (r *rpcClient) assetIDWithBalance(t *testing.T, ctx context.Context,
 	minBalance uint64, assetType taprpc.AssetType) *taprpc.Asset {
 
+	asset, err := r.findAssetWithBalance(ctx, minBalance, assetType)
+	require.NoError(t, err)
+
+	return asset
+}
+
+// findAssetWithBalance returns an asset that has at least the given balance.
+// If no such asset is found, nil is returned.
+func (r *rpcClient) findAssetWithBalance(ctx context.Context,
+	minBalance uint64, assetType taprpc.AssetType) (*taprpc.Asset, error) {
+
 	balances, err := r.ListBalances(ctx, &taprpc.ListBalancesRequest{
 		GroupBy: &taprpc.ListBalancesRequest_AssetId{
 			AssetId: true,
 		},
 	})
-	require.NoError(t, err)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list balances: %w", err)
+	}
 
 	for assetIDHex, balance := range balances.AssetBalances {
 		if balance.Balance >= minBalance &&
 			balance.AssetType == assetType {
 
 			assetIDBytes, err := hex.DecodeString(assetIDHex)
-			require.NoError(t, err)
+			if err != nil {
+				return nil, fmt.Errorf("invalid asset ID: %w",
+					err)
+			}
 
 			assets, err := r.ListAssets(
 				ctx, &taprpc.ListAssetRequest{},
 			)
-			require.NoError(t, err)
+			if err != nil {
+				return nil, fmt.Errorf("unable to list "+
+					"assets: %w", err)
+			}
 
 			for _, asset := range assets.Assets {
 				if bytes.Equal(
@@ -73,13 +101,13 @@ func (r *rpcClient) assetIDWithBalance(t *testing.T, ctx context.Context,
 					assetIDBytes,
 				) {
 
-					return asset
+					return asset, nil
 				}
 			}
 		}
 	}
 
-	return nil
+	return nil, nil
 }
 
 // listTransfersSince returns all transfers that have been made since the last
@@ -98,16 +126,152 @@ func (r *rpcClient) listTransfersSince(t *testing.T, ctx context.Context,
 	return resp.Transfers[newIndex:]
 }
 
+// hasTransfer returns true if the node knows of an outbound transfer that is
+// anchored in the transaction with the given hash.
+func (r *rpcClient) hasTransfer(t *testing.T, ctx context.Context,
+	anchorTxHash *chainhash.Hash) bool {
+
+	resp, err := r.ListTransfers(ctx, &taprpc.ListTransfersRequest{})
+	require.NoError(t, err)
+
+	for _, transfer := range resp.Transfers {
+		if bytes.Equal(transfer.AnchorTxHash, anchorTxHash[:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// blockMiner serializes block generation between multiple concurrent workers
+// that each want their own transactions to be confirmed.
+type blockMiner struct {
+	sync.Mutex
+
+	client *rpcclient.Client
+
+	// chaos is the chaos configuration that determines whether random
+	// delays are added before mining a block.
+	chaos *ChaosConfig
+
+	// confirmedTxns maps the transactions that were included in a block
+	// mined by this miner to the hash of that block.
+	confirmedTxns map[chainhash.Hash]chainhash.Hash
+}
+
+// newBlockMiner creates a new block miner that uses the given bitcoin client
+// to generate blocks.
+func newBlockMiner(client *rpcclient.Client, chaos *ChaosConfig) *blockMiner {
+	return &blockMiner{
+		client:        client,
+		chaos:         chaos,
+		confirmedTxns: make(map[chainhash.Hash]chainhash.Hash),
+	}
+}
+
+// confirmTx makes sure the transaction with the given hash is confirmed in a
+// block and returns the hash of that block. If another worker already mined a
+// block that included the transaction, no new block is generated.
+func (m *blockMiner) confirmTx(t *testing.T,
+	txid *chainhash.Hash) chainhash.Hash {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if blockHash, ok := m.confirmedTxns[*txid]; ok {
+		return blockHash
+	}
+
+	// The transaction wasn't confirmed yet, so it must eventually show up
+	// in the mempool.
+	require.Eventually(t, func() bool {
+		mempool, err := m.client.GetRawMempool()
+		require.NoError(t, err)
+
+		for _, mempoolTxid := range mempool {
+			if *mempoolTxid == *txid {
+				return true
+			}
+		}
+
+		return false
+	}, defaultTimeout, wait.PollInterval)
+
+	m.mineBlockLocked(t)
+
+	require.Contains(t, m.confirmedTxns, *txid)
+
+	return m.confirmedTxns[*txid]
+}
+
+// mineBlock mines a single block that confirms all transactions currently in
+// the mempool. This is used for transactions that are broadcast by a node
+// without their hash being known upfront, like minting transactions.
+func (m *blockMiner) mineBlock(t *testing.T) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.mineBlockLocked(t)
+}
+
+// mineBlockLocked mines a single block and records all transactions it
+// confirmed.
+//
+// NOTE: The caller must hold the miner's lock.
+func (m *blockMiner) mineBlockLocked(t *testing.T) {
+	// Simulate slow block production, if configured.
+	if delay := m.chaos.blockDelay(); delay > 0 {
+		t.Logf("Chaos: delaying block by %v", delay)
+		time.Sleep(delay)
+	}
+
+	block := itest.MineBlocks(t, m.client, 1, 0)[0]
+	for _, tx := range block.Transactions {
+		m.confirmedTxns[tx.TxHash()] = block.BlockHash()
+	}
+}
+
+// mintNormalAsset mints a new normal asset with the given amount on the given
+// node and waits for the minting transaction to confirm. The name of the asset
+// is derived from the given prefix.
+func mintNormalAsset(t *testing.T, cfg *Config, minter *rpcClient,
+	bitcoinClient *rpcclient.Client, namePrefix string,
+	amount uint64) *taprpc.Asset {
+
+	itest.LogfTimestamped(t, "minting %d units of a new %s asset", amount,
+		namePrefix)
+
+	mintReq := &mintrpc.MintAssetRequest{
+		Asset: &mintrpc.MintAsset{
+			AssetType: taprpc.AssetType_NORMAL,
+			Name: fmt.Sprintf(
+				"%s-%d", namePrefix, loadRand.Int31(),
+			),
+			AssetMeta: &taprpc.AssetMeta{
+				Data: []byte(namePrefix + " load test"),
+			},
+			Amount: amount,
+		},
+	}
+	mintedAssets := itest.MintAssetsConfirmBatch(
+		t, bitcoinClient, minter, []*mintrpc.MintAssetRequest{mintReq},
+		itest.WithMintingTimeout(cfg.TestTimeout),
+	)
+	require.Len(t, mintedAssets, 1)
+
+	return mintedAssets[0]
+}
+
 func initClients(t *testing.T, ctx context.Context,
 	cfg *Config) (*rpcClient, *rpcClient, *rpcclient.Client) {
 
 	// Create tapd clients.
-	alice := getTapClient(t, ctx, cfg.Alice.Tapd)
+	alice := getTapClient(t, ctx, cfg, cfg.Alice.Tapd)
 
 	_, err := alice.GetInfo(ctx, &taprpc.GetInfoRequest{})
 	require.NoError(t, err)
 
-	bob := getTapClient(t, ctx, cfg.Bob.Tapd)
+	bob := getTapClient(t, ctx, cfg, cfg.Bob.Tapd)
 
 	_, err = bob.GetInfo(ctx, &taprpc.GetInfoRequest{})
 	require.NoError(t, err)
@@ -128,18 +292,53 @@ func initClients(t *testing.T, ctx context.Context,
 	return alice, bob, bitcoinClient
 }
 
-func getTapClient(t *testing.T, ctx context.Context,
-	cfg *TapConfig) *rpcClient {
+// clientDialOpts returns the additional dial options that should be used for
+// all tapd client connections.
+func clientDialOpts(cfg *Config) []grpc.DialOption {
+	// If we're injecting failures, nodes might be restarted at any time.
+	// Instead of failing RPC calls immediately while a node is down, we
+	// want to wait for it to come back up.
+	var dialOpts []grpc.DialOption
+	if cfg.Chaos.Enabled {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(
+			grpc.WaitForReady(true),
+		))
+	}
+
+	return dialOpts
+}
+
+// getTapClient returns the pooled client of the given node, dialing a new
+// connection if needed. The connection is shared with all other test cases and
+// is closed when the suite completes.
+func getTapClient(t *testing.T, ctx context.Context, cfg *Config,
+	nodeCfg *TapConfig) *rpcClient {
+
+	client, err := connections.client(ctx, cfg, nodeCfg)
+	require.NoError(t, err)
+
+	return client
+}
+
+// dialTapClient creates a new client connection to the given tapd node. The
+// caller is responsible for closing the returned connection.
+func dialTapClient(ctx context.Context, cfg *TapConfig,
+	extraOpts ...grpc.DialOption) (*rpcClient, *grpc.ClientConn, error) {
 
 	creds := credentials.NewTLS(&tls.Config{})
 	if cfg.TLSPath != "" {
 		// Load the certificate file now, if specified.
 		tlsCert, err := os.ReadFile(cfg.TLSPath)
-		require.NoError(t, err)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read TLS "+
+				"certificate: %w", err)
+		}
 
 		cp := x509.NewCertPool()
-		ok := cp.AppendCertsFromPEM(tlsCert)
-		require.True(t, ok)
+		if !cp.AppendCertsFromPEM(tlsCert) {
+			return nil, nil, fmt.Errorf("unable to parse TLS " +
+				"certificate")
+		}
 
 		creds = credentials.NewClientTLSFromCert(cp, "")
 	}
@@ -148,26 +347,46 @@ func getTapClient(t *testing.T, ctx context.Context,
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
 		grpc.WithDefaultCallOptions(maxMsgRecvSize),
+		grpc.WithChainUnaryInterceptor(
+			unaryMetricsInterceptor(cfg.Name),
+		),
+		grpc.WithChainStreamInterceptor(
+			streamMetricsInterceptor(cfg.Name),
+		),
 	}
 
 	if cfg.MacPath != "" {
 		var macBytes []byte
 		macBytes, err := os.ReadFile(cfg.MacPath)
-		require.NoError(t, err)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read macaroon: "+
+				"%w", err)
+		}
 
 		mac := &macaroon.Macaroon{}
 		err = mac.UnmarshalBinary(macBytes)
-		require.NoError(t, err)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode "+
+				"macaroon: %w", err)
+		}
 
 		macCred, err := macaroons.NewMacaroonCredential(mac)
-		require.NoError(t, err)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to create "+
+				"macaroon credential: %w", err)
+		}
 
 		opts = append(opts, grpc.WithPerRPCCredentials(macCred))
 	}
 
+	opts = append(opts, extraOpts...)
+
 	svrAddr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 	conn, err := grpc.DialContext(ctx, svrAddr, opts...)
-	require.NoError(t, err)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to connect to %v: %w",
+			svrAddr, err)
+	}
 
 	assetsClient := taprpc.NewTaprootAssetsClient(conn)
 	universeClient := universerpc.NewUniverseClient(conn)
@@ -182,15 +401,18 @@ func getTapClient(t *testing.T, ctx context.Context,
 		AssetWalletClient:   assetWalletClient,
 	}
 
-	t.Cleanup(func() {
-		err := conn.Close()
-		require.NoError(t, err)
-	})
+	return client, conn, nil
+}
+
+func getBitcoinConn(t *testing.T, cfg *BitcoinConfig) *rpcclient.Client {
+	client, err := dialBitcoinConn(cfg)
+	require.NoError(t, err)
 
 	return client
 }
 
-func getBitcoinConn(t *testing.T, cfg *BitcoinConfig) *rpcclient.Client {
+// dialBitcoinConn creates a new RPC client for the configured bitcoin backend.
+func dialBitcoinConn(cfg *BitcoinConfig) (*rpcclient.Client, error) {
 	var (
 		rpcCert []byte
 		err     error
@@ -202,7 +424,10 @@ func getBitcoinConn(t *testing.T, cfg *BitcoinConfig) *rpcclient.Client {
 	// read that file and provide it to the RPC connection as byte slice.
 	if !disableTLS {
 		rpcCert, err = os.ReadFile(cfg.TLSPath)
-		require.NoError(t, err)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read TLS "+
+				"certificate: %w", err)
+		}
 	}
 
 	// Connect to the backend with the certs we just loaded.
@@ -216,7 +441,61 @@ func getBitcoinConn(t *testing.T, cfg *BitcoinConfig) *rpcclient.Client {
 	}
 
 	client, err := rpcclient.New(connCfg, nil)
-	require.NoError(t, err)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create bitcoin client: %w",
+			err)
+	}
 
-	return client
+	// Make sure we don't try to mine blocks on a chain that doesn't allow
+	// it, or wait for blocks on one nobody else mines on.
+	if cfg.Network != "" {
+		info, err := client.GetBlockChainInfo()
+		if err != nil {
+			client.Shutdown()
+			return nil, fmt.Errorf("unable to query bitcoin "+
+				"backend: %w", err)
+		}
+
+		if info.Chain != cfg.Network {
+			client.Shutdown()
+			return nil, fmt.Errorf("bitcoin backend runs on %v, "+
+				"expected %v", info.Chain, cfg.Network)
+		}
+	}
+
+	// The tests pick the RPCs they use based on the backend, so we make
+	// sure it's the one we expect.
+	if cfg.Backend != "" {
+		if err := checkBitcoinBackend(client, cfg.Backend); err != nil {
+			client.Shutdown()
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// checkBitcoinBackend makes sure the given client is connected to the given
+// bitcoin backend implementation.
+func checkBitcoinBackend(client *rpcclient.Client, backend string) error {
+	version, err := client.BackendVersion()
+	if err != nil {
+		return fmt.Errorf("unable to query backend version: %w", err)
+	}
+
+	var actual string
+	switch version {
+	case rpcclient.Btcd:
+		actual = backendBtcd
+
+	default:
+		actual = backendBitcoind
+	}
+
+	if actual != backend {
+		return fmt.Errorf("bitcoin backend is %v, expected %v", actual,
+			backend)
+	}
+
+	return nil
 }
@@ -0,0 +1,268 @@
+package itest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/lntest/node"
+	"github.com/stretchr/testify/require"
+)
+
+// PartitionHarness is an integration testing harness that simulates a network
+// partition between a tapd node and a remote service, like a universe server
+// or a proof courier. It is a TCP proxy that forwards all connections to the
+// target service until it is severed. While severed, all open connections are
+// closed and new ones are dropped right after they are accepted, so the
+// service appears unreachable to the node. Restoring the partition makes the
+// proxy forward connections again.
+//
+// A node only goes through the proxy if it connects to ListenAddr instead of
+// the address of the service itself, either through its proof courier config
+// or by adding ListenAddr as a universe federation server.
+type PartitionHarness struct {
+	// ListenAddr is the address that the proxy is listening on.
+	ListenAddr string
+
+	// TargetAddr is the address of the service that connections are
+	// forwarded to.
+	TargetAddr string
+
+	// courier is the proof courier harness behind the proxy, if the
+	// partition was created for a proof courier.
+	courier proof.CourierHarness
+
+	listener net.Listener
+
+	mu      sync.Mutex
+	severed bool
+	conns   map[net.Conn]struct{}
+
+	wg sync.WaitGroup
+}
+
+// NewPartitionHarness creates a new partition harness that forwards
+// connections to the given target address. The harness must be started before
+// any node can connect through it.
+func NewPartitionHarness(targetAddr string) *PartitionHarness {
+	return &PartitionHarness{
+		ListenAddr: fmt.Sprintf(
+			node.ListenerFormat, node.NextAvailablePort(),
+		),
+		TargetAddr: targetAddr,
+		conns:      make(map[net.Conn]struct{}),
+	}
+}
+
+// NewCourierPartitionHarness creates a new partition harness in front of the
+// given proof courier. The returned harness can be used as the proof courier
+// of a tapd harness, to make the node reach its courier through the proxy.
+func NewCourierPartitionHarness(t *testing.T,
+	courier proof.CourierHarness) *PartitionHarness {
+
+	var targetAddr string
+	switch typedProofCourier := courier.(type) {
+	case *ApertureHarness:
+		targetAddr = typedProofCourier.ListenAddr
+
+	case *UniverseRPCHarness:
+		targetAddr = typedProofCourier.ListenAddr
+
+	default:
+		t.Fatalf("unsupported proof courier harness %T", courier)
+	}
+
+	h := NewPartitionHarness(targetAddr)
+	h.courier = courier
+
+	return h
+}
+
+// CourierAddr returns the proof courier address that makes a node reach the
+// proof courier behind the proxy.
+func (h *PartitionHarness) CourierAddr() string {
+	courierType := proof.HashmailCourierType
+	if _, ok := h.courier.(*UniverseRPCHarness); ok {
+		courierType = proof.UniverseRpcCourierType
+	}
+
+	return fmt.Sprintf("%s://%s", courierType, h.ListenAddr)
+}
+
+// Start starts the proxy. Only the proxy is started, the service behind it
+// must be started separately.
+func (h *PartitionHarness) Start(_ chan error) error {
+	listener, err := net.Listen("tcp", h.ListenAddr)
+	if err != nil {
+		return fmt.Errorf("unable to listen on %v: %w", h.ListenAddr,
+			err)
+	}
+	h.listener = listener
+
+	h.wg.Add(1)
+	go h.acceptConns()
+
+	return nil
+}
+
+// Stop stops the proxy and closes all open connections. The service behind the
+// proxy is not stopped.
+func (h *PartitionHarness) Stop() error {
+	err := h.listener.Close()
+
+	h.mu.Lock()
+	h.closeConns()
+	h.mu.Unlock()
+
+	h.wg.Wait()
+
+	return err
+}
+
+// Sever cuts the connectivity between the nodes and the service behind the
+// proxy. All open connections are closed and new connections are dropped until
+// the partition is restored.
+func (h *PartitionHarness) Sever() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.severed = true
+	h.closeConns()
+}
+
+// Restore restores the connectivity between the nodes and the service behind
+// the proxy. Connections that were closed while the partition was severed are
+// not restored, the nodes need to reconnect.
+func (h *PartitionHarness) Restore() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.severed = false
+}
+
+// IsSevered returns true if the connectivity is currently severed.
+func (h *PartitionHarness) IsSevered() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.severed
+}
+
+// closeConns closes all open connections of the proxy.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (h *PartitionHarness) closeConns() {
+	for conn := range h.conns {
+		_ = conn.Close()
+	}
+	h.conns = make(map[net.Conn]struct{})
+}
+
+// trackConns adds the given connections to the set of open connections, unless
+// the partition is severed. It returns false if the connections should be
+// dropped.
+func (h *PartitionHarness) trackConns(conns ...net.Conn) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.severed {
+		return false
+	}
+
+	for _, conn := range conns {
+		h.conns[conn] = struct{}{}
+	}
+
+	return true
+}
+
+// untrackConns removes the given connections from the set of open connections.
+func (h *PartitionHarness) untrackConns(conns ...net.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, conn := range conns {
+		delete(h.conns, conn)
+	}
+}
+
+// acceptConns accepts new connections until the listener is closed.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (h *PartitionHarness) acceptConns() {
+	defer h.wg.Done()
+
+	for {
+		clientConn, err := h.listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			continue
+		}
+
+		h.wg.Add(1)
+		go h.forward(clientConn)
+	}
+}
+
+// forward forwards the given client connection to the target service until
+// either side closes its connection or the partition is severed.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (h *PartitionHarness) forward(clientConn net.Conn) {
+	defer h.wg.Done()
+
+	// Drop the connection right away if the partition is severed, so the
+	// node sees the connection fail instead of hang.
+	if h.IsSevered() {
+		_ = clientConn.Close()
+		return
+	}
+
+	targetConn, err := net.Dial("tcp", h.TargetAddr)
+	if err != nil {
+		_ = clientConn.Close()
+		return
+	}
+
+	// The partition might have been severed while we were dialing.
+	if !h.trackConns(clientConn, targetConn) {
+		_ = clientConn.Close()
+		_ = targetConn.Close()
+		return
+	}
+	defer h.untrackConns(clientConn, targetConn)
+
+	// Closing both connections as soon as one direction is done unblocks
+	// the copy in the other direction.
+	var copyWg sync.WaitGroup
+	copyWg.Add(2)
+	pipe := func(dst, src net.Conn) {
+		defer copyWg.Done()
+
+		_, _ = io.Copy(dst, src)
+		_ = dst.Close()
+		_ = src.Close()
+	}
+	go pipe(targetConn, clientConn)
+	go pipe(clientConn, targetConn)
+
+	copyWg.Wait()
+}
+
+// Ensure that PartitionHarness implements the proof.CourierHarness interface.
+var _ proof.CourierHarness = (*PartitionHarness)(nil)
+
+// startPartitionHarness starts the given partition harness and makes sure it
+// is stopped at the end of the test.
+func startPartitionHarness(t *testing.T, h *PartitionHarness) {
+	require.NoError(t, h.Start(nil))
+	t.Cleanup(func() {
+		require.NoError(t, h.Stop())
+	})
+}
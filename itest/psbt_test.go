@@ -6,8 +6,10 @@ import (
 	"encoding/base64"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/address"
@@ -20,6 +22,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -965,6 +968,179 @@ func testPsbtMultiSend(t *harnessTest) {
 	)
 }
 
+// testPsbtExternalSignerSend tests that a node that doesn't hold the script
+// key of its assets can send them with the help of an external signer, which
+// creates the asset level signatures for the signing requests of the node.
+func testPsbtExternalSignerSend(t *harnessTest) {
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, t.tapd,
+		[]*mintrpc.MintAssetRequest{issuableAssets[0]},
+	)
+
+	mintedAsset := rpcAssets[0]
+	genInfo := rpcAssets[0].AssetGenesis
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// Now that we have the asset created, we'll make a new node that'll
+	// serve as the watch-only node which'll receive the assets.
+	secondTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.startupSyncNode = t.tapd
+			params.startupSyncNumAssets = len(rpcAssets)
+		},
+	)
+	defer func() {
+		require.NoError(t.t, secondTapd.stop(!*noDelete))
+	}()
+
+	var (
+		alice    = t.tapd
+		bob      = secondTapd
+		numUnits = uint64(2000)
+	)
+
+	// The script key of bob's assets is held by the external signer, so
+	// bob only knows its public key. The internal key of the anchor output
+	// is one of bob's, as his lnd signs the BTC level inputs.
+	signerKey, err := btcec.NewPrivateKey()
+	require.NoError(t.t, err)
+	bobScriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: signerKey.PubKey(),
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+		},
+	})
+	_, bobInternalKey := deriveKeys(t.t, bob)
+
+	bobAddr, err := bob.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId:      genInfo.AssetId,
+		Amt:          numUnits,
+		AssetVersion: mintedAsset.Version,
+		ScriptKey: &taprpc.ScriptKey{
+			PubKey:  schnorr.SerializePubKey(bobScriptKey.PubKey),
+			KeyDesc: lndKeyDescToTap(bobScriptKey.RawKey),
+		},
+		InternalKey: lndKeyDescToTap(bobInternalKey),
+	})
+	require.NoError(t.t, err)
+	AssertAddrCreated(t.t, bob, mintedAsset, bobAddr)
+
+	sendResp := sendAssetsToAddr(t, alice, bobAddr)
+	changeUnits := mintedAsset.Amount - numUnits
+	ConfirmAndAssertOutboundTransfer(
+		t.t, t.lndHarness.Miner.Client, alice, sendResp,
+		genInfo.AssetId, []uint64{changeUnits, numUnits}, 0, 1,
+	)
+	_ = sendProof(t, alice, bob, bobAddr.ScriptKey, genInfo)
+	AssertNonInteractiveRecvComplete(t.t, bob, 1)
+
+	// Bob now funds a send of half the assets back to alice.
+	aliceAddr, err := alice.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     numUnits / 2,
+	})
+	require.NoError(t.t, err)
+	AssertAddrCreated(t.t, alice, mintedAsset, aliceAddr)
+
+	fundResp := fundAddressSendPacket(t, bob, aliceAddr)
+	prepareResp, err := bob.PrepareExternalSigning(
+		ctxt, &wrpc.PrepareExternalSigningRequest{
+			VirtualPsbt: fundResp.FundedPsbt,
+		},
+	)
+	require.NoError(t.t, err)
+	require.Empty(t.t, prepareResp.PassiveAssetPsbts)
+	require.Len(t.t, prepareResp.SigningRequests, 1)
+
+	sigReq := prepareResp.SigningRequests[0]
+	require.Equal(
+		t.t, wrpc.SignMethod_SIGN_METHOD_TAPROOT_KEY_SPEND_BIP0086,
+		sigReq.SignMethod,
+	)
+	require.Equal(
+		t.t, signerKey.PubKey().SerializeCompressed(),
+		sigReq.RawKey.RawKeyBytes,
+	)
+
+	// A signature of any other key must be rejected.
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t.t, err)
+	_, err = bob.ApplyExternalSignatures(
+		ctxt, &wrpc.ApplyExternalSignaturesRequest{
+			VirtualPsbt: fundResp.FundedPsbt,
+			Signatures: []*wrpc.ExternalSignature{
+				externalSignature(t.t, otherKey, sigReq),
+			},
+		},
+	)
+	require.ErrorContains(t.t, err, "error signing packet")
+
+	applyResp, err := bob.ApplyExternalSignatures(
+		ctxt, &wrpc.ApplyExternalSignaturesRequest{
+			VirtualPsbt: fundResp.FundedPsbt,
+			Signatures: []*wrpc.ExternalSignature{
+				externalSignature(t.t, signerKey, sigReq),
+			},
+		},
+	)
+	require.NoError(t.t, err)
+
+	anchorResp, err := bob.FundAnchorPsbt(
+		ctxt, &wrpc.FundAnchorPsbtRequest{
+			VirtualPsbt:       applyResp.SignedVirtualPsbt,
+			PassiveAssetPsbts: applyResp.SignedPassiveAssetPsbts,
+		},
+	)
+	require.NoError(t.t, err)
+
+	// The anchor transaction carries the derivation information of all
+	// its inputs, so bob's lnd can sign the BTC level inputs.
+	anchorPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(anchorResp.AnchorPsbt), false,
+	)
+	require.NoError(t.t, err)
+
+	signResp := t.lndHarness.Bob.RPC.SignPsbt(&walletrpc.SignPsbtRequest{
+		FundedPsbt: anchorResp.AnchorPsbt,
+	})
+	require.Len(t.t, signResp.SignedInputs, len(anchorPkt.Inputs))
+
+	sendResp, err = bob.PublishAnchorPsbt(
+		ctxt, &wrpc.PublishAnchorPsbtRequest{
+			VirtualPsbt:       applyResp.SignedVirtualPsbt,
+			PassiveAssetPsbts: applyResp.SignedPassiveAssetPsbts,
+			SignedAnchorPsbt:  signResp.SignedPsbt,
+		},
+	)
+	require.NoError(t.t, err)
+
+	ConfirmAndAssertOutboundTransfer(
+		t.t, t.lndHarness.Miner.Client, bob, sendResp,
+		genInfo.AssetId, []uint64{numUnits / 2, numUnits / 2}, 0, 1,
+	)
+	_ = sendProof(t, bob, alice, aliceAddr.ScriptKey, genInfo)
+	AssertNonInteractiveRecvComplete(t.t, alice, 1)
+}
+
+// externalSignature creates the signature for the given BIP-0086 key spend
+// signing request with the given key, the way an external signer would.
+func externalSignature(t *testing.T, privKey *btcec.PrivateKey,
+	sigReq *wrpc.SigningRequest) *wrpc.ExternalSignature {
+
+	tweakedKey := txscript.TweakTaprootPrivKey(*privKey, nil)
+	sig, err := schnorr.Sign(tweakedKey, sigReq.SigHash)
+	require.NoError(t, err)
+
+	return &wrpc.ExternalSignature{
+		SigHash:   sigReq.SigHash,
+		Signature: sig.Serialize(),
+	}
+}
+
 func deriveKeys(t *testing.T, tapd *tapdHarness) (asset.ScriptKey,
 	keychain.KeyDescriptor) {
 
@@ -15,6 +15,8 @@ import (
 // testReOrgMint tests that when a re-org occurs, minted asset proofs are
 // updated accordingly.
 func testReOrgMint(t *harnessTest) {
+	skipWithoutReOrgs(t)
+
 	// First, we'll mint a few assets but don't confirm the batch TX.
 	mintRequests := []*mintrpc.MintAssetRequest{
 		issuableAssets[0], issuableAssets[1],
@@ -122,6 +124,8 @@ func testReOrgMint(t *harnessTest) {
 // testReOrgSend tests that when a re-org occurs, sent asset proofs are updated
 // accordingly.
 func testReOrgSend(t *harnessTest) {
+	skipWithoutReOrgs(t)
+
 	// First, we'll mint a few assets and confirm the batch TX.
 	mintRequests := []*mintrpc.MintAssetRequest{
 		issuableAssets[0], issuableAssets[1],
@@ -243,6 +247,8 @@ func testReOrgSend(t *harnessTest) {
 // testReOrgMintAndSend tests that when a re-org occurs, minted and directly
 // sent asset proofs are updated accordingly.
 func testReOrgMintAndSend(t *harnessTest) {
+	skipWithoutReOrgs(t)
+
 	ctxb := context.Background()
 	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
 	defer cancel()
@@ -371,6 +377,16 @@ func testReOrgMintAndSend(t *harnessTest) {
 	t.lndHarness.MineBlocks(8)
 }
 
+// skipWithoutReOrgs skips the current test case if re-orgs can't be generated
+// with the chain backend of the test network, as generateReOrg needs to
+// disconnect the backend from the miner.
+func skipWithoutReOrgs(t *harnessTest) {
+	if !t.chainBackend.CanDisconnectMiner() {
+		t.Skipf("Re-orgs can't be generated with the %v chain backend",
+			t.chainBackend.Kind())
+	}
+}
+
 // spawnTempMiner creates a temporary miner that uses the same chain backend
 // and client as the main miner.
 func spawnTempMiner(t *testing.T, ht *harnessTest,
@@ -607,6 +607,126 @@ func testOfflineReceiverEventuallyReceives(t *harnessTest) {
 	wg.Wait()
 }
 
+// testProofCourierPartition tests that a transfer completes once the
+// connectivity between the nodes and their proof courier is restored, after
+// it was severed while the proof was being delivered.
+func testProofCourierPartition(t *harnessTest) {
+	var (
+		ctxb = context.Background()
+		wg   sync.WaitGroup
+	)
+
+	// Both nodes reach the proof courier through a partition harness, so
+	// we can cut them off mid-transfer.
+	partition := NewCourierPartitionHarness(t.t, t.proofCourier)
+	startPartitionHarness(t.t, partition)
+
+	// Make a new node which will send the asset to the primary tapd node.
+	// The sender keeps on trying to deliver the proof for as long as the
+	// partition is severed.
+	sendTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.proofCourier = partition
+			params.proofSendBackoffCfg = &proof.BackoffCfg{
+				BackoffResetWait: 1 * time.Second,
+				NumTries:         200,
+				InitialBackoff:   1 * time.Second,
+				MaxBackoff:       1 * time.Second,
+			}
+		},
+	)
+	defer func() {
+		require.NoError(t.t, sendTapd.stop(!*noDelete))
+	}()
+
+	recvTapd := t.tapd
+
+	// Subscribe to receive asset send events from the sending node.
+	eventNtfns, err := sendTapd.SubscribeSendAssetEventNtfns(
+		ctxb, &taprpc.SubscribeSendAssetEventNtfnsRequest{},
+	)
+	require.NoError(t.t, err)
+
+	// Mint an asset for sending.
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, t.lndHarness.Miner.Client, sendTapd,
+		[]*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+
+	genInfo := rpcAssets[0].AssetGenesis
+
+	// Synchronize the Universe state of the second node, with the main
+	// node.
+	t.syncUniverseState(sendTapd, recvTapd, len(rpcAssets))
+
+	// Create a new address for the receiver node that points to the
+	// courier behind the partition.
+	recvAddr, err := recvTapd.NewAddr(ctxb, &taprpc.NewAddrRequest{
+		AssetId:          genInfo.AssetId,
+		Amt:              10,
+		ProofCourierAddr: partition.CourierAddr(),
+	})
+	require.NoError(t.t, err)
+	AssertAddrCreated(t.t, recvTapd, rpcAssets[0], recvAddr)
+
+	// Sever the connectivity to the proof courier before the transfer, so
+	// the proof can't be delivered.
+	t.Logf("Severing connectivity to the proof courier")
+	partition.Sever()
+
+	// The sender should back off from delivering the proof at least once
+	// while the partition is severed. This is executed in a goroutine to
+	// ensure that we can receive the event notification(s) from the tapd
+	// node as the rest of the test proceeds.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		targetEventSelector := func(event *taprpc.SendAssetEvent) bool {
+			switch eventTyped := event.Event.(type) {
+			case *taprpc.SendAssetEvent_ReceiverProofBackoffWaitEvent:
+				ev := eventTyped.ReceiverProofBackoffWaitEvent
+				t.Logf("Found event ntfs: %v", ev)
+				return true
+			}
+
+			return false
+		}
+
+		ctx, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+		defer cancel()
+
+		assertRecvNtfsEvent(t, ctx, eventNtfns, targetEventSelector, 2)
+	}()
+
+	// Send asset and then mine to confirm the associated on-chain tx.
+	sendAssetsToAddr(t, sendTapd, recvAddr)
+	_ = MineBlocks(t.t, t.lndHarness.Miner.Client, 1, 1)
+
+	// Wait for the failed delivery attempts before restoring the
+	// connectivity.
+	wg.Wait()
+
+	resp, err := recvTapd.AddrReceives(
+		ctxb, &taprpc.AddrReceivesRequest{},
+	)
+	require.NoError(t.t, err)
+	for _, event := range resp.Events {
+		require.NotEqual(
+			t.t, taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED,
+			event.Status,
+		)
+	}
+
+	t.Logf("Restoring connectivity to the proof courier")
+	partition.Restore()
+
+	// Confirm that the receiver eventually receives the asset now that the
+	// nodes can reach the proof courier again.
+	AssertNonInteractiveRecvComplete(t.t, recvTapd, 1)
+}
+
 // assertRecvNtfsEvent asserts that the given event notification was received.
 // This function will block until the event is received or the event stream is
 // closed.
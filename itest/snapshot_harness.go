@@ -0,0 +1,178 @@
+package itest
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightningnetwork/lnd/lntest/node"
+	"github.com/stretchr/testify/require"
+)
+
+// nodeSnapshot is a copy of the data directory of a tapd node, taken while
+// the node was stopped. A node restored from a snapshot starts with all the
+// assets, proofs and universe state the node had when the snapshot was taken.
+//
+// NOTE: The keys of the assets are derived from the lnd node that backed the
+// tapd node, and their anchor outputs are owned by it. A snapshot can
+// therefore only be restored on top of the same lnd node, and only as long as
+// that lnd node didn't spend any of the anchor outputs in the meantime.
+type nodeSnapshot struct {
+	// dir is the directory the data directory of the node was copied to.
+	dir string
+
+	// lndNode is the lnd node that backed the tapd node.
+	lndNode *node.HarnessNode
+}
+
+// nodeSnapshots holds the snapshots that were taken during the current test
+// run, by name. Test cases run one after another, so there's no need to guard
+// the map.
+var nodeSnapshots = make(map[string]*nodeSnapshot)
+
+// takeSnapshot stops the given node, copies its data directory to a new
+// snapshot with the given name and restarts the node. An existing snapshot
+// with the same name is replaced.
+//
+// NOTE: Only the SQLite backend stores all of the node's state in its data
+// directory, so snapshots can't be taken with any other backend.
+func takeSnapshot(t *harnessTest, name string, tapd *tapdHarness) {
+	require.Equal(
+		t.t, tapcfg.DatabaseBackendSqlite,
+		tapd.clientCfg.DatabaseBackend,
+		"snapshots are only supported with the SQLite backend",
+	)
+
+	snapshotDir, err := os.MkdirTemp("", "itest-snapshot")
+	require.NoError(t.t, err)
+
+	require.NoError(t.t, tapd.stop(false))
+	require.NoError(t.t, copyDir(tapd.cfg.BaseDir, snapshotDir))
+	require.NoError(t.t, tapd.start(false))
+
+	if old, ok := nodeSnapshots[name]; ok {
+		_ = os.RemoveAll(old.dir)
+	}
+	nodeSnapshots[name] = &nodeSnapshot{
+		dir:     snapshotDir,
+		lndNode: tapd.cfg.LndNode,
+	}
+
+	t.Logf("Took snapshot %v of node with data directory %v", name,
+		tapd.cfg.BaseDir)
+}
+
+// restoreSnapshot starts a new tapd node from a copy of the snapshot with the
+// given name, on top of the lnd node the snapshot was taken with. It returns
+// false if there is no such snapshot.
+func restoreSnapshot(t *harnessTest, name string,
+	opts ...Option) (*tapdHarness, bool) {
+
+	snapshot, ok := nodeSnapshots[name]
+	if !ok {
+		return nil, false
+	}
+
+	// Every restored node gets its own copy, so the snapshot can be
+	// restored again by later test cases.
+	baseDir, err := os.MkdirTemp("", "itest-tapd")
+	require.NoError(t.t, err)
+	require.NoError(t.t, copyDir(snapshot.dir, baseDir))
+
+	opts = append(opts, func(params *tapdHarnessParams) {
+		params.baseDir = baseDir
+	})
+	tapd := setupTapdHarness(t.t, t, snapshot.lndNode, nil, opts...)
+
+	t.Logf("Restored snapshot %v to data directory %v", name, baseDir)
+
+	return tapd, true
+}
+
+// setupTapdHarnessFromSnapshot restores the snapshot with the given name if it
+// exists. Otherwise, it creates a new tapd node backed by the given lnd node,
+// runs the given setup function on it and takes a snapshot with the given
+// name, so later test cases can skip the setup.
+func setupTapdHarnessFromSnapshot(t *harnessTest, name string,
+	lndNode *node.HarnessNode, setup func(tapd *tapdHarness),
+	opts ...Option) *tapdHarness {
+
+	if tapd, ok := restoreSnapshot(t, name, opts...); ok {
+		return tapd
+	}
+
+	tapd := setupTapdHarness(t.t, t, lndNode, nil, opts...)
+	setup(tapd)
+	takeSnapshot(t, name, tapd)
+
+	return tapd
+}
+
+// removeSnapshots deletes all snapshots taken during the test run.
+func removeSnapshots() {
+	for name, snapshot := range nodeSnapshots {
+		_ = os.RemoveAll(snapshot.dir)
+		delete(nodeSnapshots, name)
+	}
+}
+
+// copyDir recursively copies the contents of the source directory into the
+// destination directory, which must exist.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry,
+		err error) error {
+
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dst, relPath)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return os.MkdirAll(dstPath, info.Mode().Perm())
+		}
+
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("unable to copy %v: not a regular "+
+				"file", path)
+		}
+
+		return copyFile(path, dstPath, info.Mode().Perm())
+	})
+}
+
+// copyFile copies the source file to the destination path with the given
+// permissions.
+func copyFile(src, dst string, perm fs.FileMode) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	dstFile, err := os.OpenFile(
+		dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(dstFile, srcFile); err != nil {
+		_ = dstFile.Close()
+		return err
+	}
+
+	return dstFile.Close()
+}
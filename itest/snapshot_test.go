@@ -0,0 +1,59 @@
+package itest
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testNodeSnapshot tests that a node restored from a snapshot starts with the
+// assets the node had when the snapshot was taken.
+func testNodeSnapshot(t *harnessTest) {
+	if *dbbackend != tapcfg.DatabaseBackendSqlite {
+		t.Skipf("Snapshots are only supported with the SQLite backend")
+	}
+
+	const snapshotName = "node snapshot"
+
+	ctxb := context.Background()
+	miner := t.lndHarness.Miner.Client
+
+	// The snapshot doesn't exist yet, so the node is set up from scratch
+	// and the snapshot is taken once it has minted its assets.
+	var rpcAssets []*taprpc.Asset
+	bob := setupTapdHarnessFromSnapshot(
+		t, snapshotName, t.lndHarness.Bob, func(tapd *tapdHarness) {
+			rpcAssets = MintAssetsConfirmBatch(
+				t.t, miner, tapd, simpleAssets,
+			)
+		},
+	)
+	require.NotEmpty(t.t, rpcAssets)
+	require.NoError(t.t, bob.stop(!*noDelete))
+
+	// A node restored from the snapshot should have all the assets without
+	// minting them again.
+	restored, ok := restoreSnapshot(t, snapshotName)
+	require.True(t.t, ok)
+	defer func() {
+		require.NoError(t.t, restored.stop(!*noDelete))
+	}()
+
+	matchAssets := make([]MatchRpcAsset, 0, len(rpcAssets))
+	for _, rpcAsset := range rpcAssets {
+		assetID := rpcAsset.AssetGenesis.AssetId
+		matchAssets = append(matchAssets, func(a *taprpc.Asset) bool {
+			return bytes.Equal(a.AssetGenesis.AssetId, assetID)
+		})
+	}
+	AssertListAssets(t.t, ctxb, restored, matchAssets)
+
+	// The proofs of the assets must have been restored as well.
+	chainClient := restored.cfg.LndNode.RPC.ChainKit
+	for _, rpcAsset := range rpcAssets {
+		AssertAssetProofs(t.t, restored, chainClient, rpcAsset)
+	}
+}
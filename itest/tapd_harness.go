@@ -59,6 +59,10 @@ type tapdHarness struct {
 	server    *tap.Server
 	clientCfg *tapcfg.Config
 
+	// legacy is the process of the node if it runs a previously released
+	// tapd binary instead of the current build.
+	legacy *legacyProcess
+
 	ht *harnessTest
 	wg sync.WaitGroup
 
@@ -155,6 +159,14 @@ func newTapdHarness(t *testing.T, ht *harnessTest, cfg tapdConfig,
 		return nil, err
 	}
 
+	// If the proof courier is reached through a partition harness, the
+	// node connects to the proxy instead of the courier itself.
+	courierAddr := ""
+	if partition, ok := proofCourier.(*PartitionHarness); ok {
+		proofCourier = partition.courier
+		courierAddr = partition.CourierAddr()
+	}
+
 	// Populate proof courier specific config fields.
 	switch typedProofCourier := (proofCourier).(type) {
 	case *ApertureHarness:
@@ -195,6 +207,9 @@ func newTapdHarness(t *testing.T, ht *harnessTest, cfg tapdConfig,
 		finalCfg.DefaultProofCourierAddr = ""
 		finalCfg.HashMailCourier = nil
 	}
+	if courierAddr != "" {
+		finalCfg.DefaultProofCourierAddr = courierAddr
+	}
 
 	return &tapdHarness{
 		cfg:       &cfg,
@@ -233,7 +248,12 @@ func (hs *tapdHarness) start(expectErrExit bool) error {
 
 	time.Sleep(1 * time.Second)
 
-	// Create our client to interact with the tapd RPC server directly.
+	return hs.connectClients()
+}
+
+// connectClients creates the clients that interact with the tapd RPC server
+// directly.
+func (hs *tapdHarness) connectClients() error {
 	listenerAddr := hs.clientCfg.RpcConf.RawRPCListeners[0]
 	rpcConn, err := dialServer(
 		listenerAddr, hs.clientCfg.RpcConf.TLSCertPath,
@@ -254,6 +274,12 @@ func (hs *tapdHarness) start(expectErrExit bool) error {
 
 // stop shuts down the tapd server and deletes its temporary data directory.
 func (hs *tapdHarness) stop(deleteData bool) error {
+	// A node that runs a previously released binary is stopped through
+	// its process instead.
+	if hs.legacy != nil {
+		return hs.stopLegacy(deleteData)
+	}
+
 	// Don't return the error immediately if stopping goes wrong, always
 	// remove the temp directory.
 	err := hs.server.Stop()
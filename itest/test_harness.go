@@ -72,6 +72,11 @@ type testCase struct {
 	name             string
 	test             func(t *harnessTest)
 	proofCourierType proof.CourierType
+
+	// flaky marks a test case that is known to fail intermittently. A
+	// failed flaky test case is retried by the flake retry runner, failed
+	// test cases that aren't flaky fail the run right away.
+	flaky bool
 }
 
 // harnessTest wraps a regular testing.T providing enhanced error detection
@@ -95,6 +100,10 @@ type harnessTest struct {
 	// nil if not yet set up.
 	lndHarness *lntest.HarnessTest
 
+	// chainBackend is the chain backend the lnd nodes of the test network
+	// are connected to. Will be nil if not yet set up.
+	chainBackend ChainBackend
+
 	universeServer *serverHarness
 
 	tapd *tapdHarness
@@ -114,6 +123,7 @@ func (h *harnessTest) newHarnessTest(t *testing.T, net *lntest.HarnessTest,
 		t:              t,
 		proofCourier:   proofCourier,
 		lndHarness:     net,
+		chainBackend:   h.chainBackend,
 		universeServer: universeServer,
 		tapd:           tapd,
 		logWriter:      h.logWriter,
@@ -336,6 +346,14 @@ type tapdHarnessParams struct {
 	// startupSyncNumAssets is the number of assets that are expected to be
 	// synced from the above node.
 	startupSyncNumAssets int
+
+	// legacyBinary if present, then the node is started from this
+	// previously released tapd binary instead of the current build.
+	legacyBinary string
+
+	// baseDir if present, then the node uses this existing data directory
+	// instead of a new temporary one.
+	baseDir string
 }
 
 type Option func(*tapdHarnessParams)
@@ -367,13 +385,18 @@ func setupTapdHarness(t *testing.T, ht *harnessTest,
 		t, ht, tapdConfig{
 			NetParams: harnessNetParams,
 			LndNode:   node,
+			BaseDir:   params.baseDir,
 		}, selectedProofCourier,
 		params.proofSendBackoffCfg, params.proofReceiverAckTimeout,
 	)
 	require.NoError(t, err)
 
 	// Start the tapd harness now.
-	err = tapdHarness.start(params.expectErrExit)
+	if params.legacyBinary != "" {
+		err = tapdHarness.startLegacy(params.legacyBinary)
+	} else {
+		err = tapdHarness.start(params.expectErrExit)
+	}
 	require.NoError(t, err)
 
 	// Before we exit, we'll check to see if we need to sync the universe
@@ -23,20 +23,27 @@ var testCases = []*testCase{
 		name: "multi address",
 		test: testMultiAddress,
 	},
+	{
+		name: "address tapscript script key",
+		test: testAddressTapscriptScriptKey,
+	},
 	// For some (yet unknown) reason, the Postgres itest is much more flaky
 	// if the re-org tests run last. So we run them toward the beginning to
 	// reduce the flakiness of the Postgres itest.
 	{
-		name: "re-org mint",
-		test: testReOrgMint,
+		name:  "re-org mint",
+		test:  testReOrgMint,
+		flaky: true,
 	},
 	{
-		name: "re-org send",
-		test: testReOrgSend,
+		name:  "re-org send",
+		test:  testReOrgSend,
+		flaky: true,
 	},
 	{
-		name: "re-org mint and send",
-		test: testReOrgMintAndSend,
+		name:  "re-org mint and send",
+		test:  testReOrgMintAndSend,
+		flaky: true,
 	},
 	{
 		name:             "basic send unidirectional",
@@ -63,6 +70,16 @@ var testCases = []*testCase{
 		test:             testOfflineReceiverEventuallyReceives,
 		proofCourierType: proof.HashmailCourierType,
 	},
+	{
+		name:             "proof courier partition",
+		test:             testProofCourierPartition,
+		proofCourierType: proof.HashmailCourierType,
+	},
+	{
+		name:             "upgrade from legacy binary",
+		test:             testUpgradeFromLegacy,
+		proofCourierType: proof.HashmailCourierType,
+	},
 	{
 		name:             "basic send passive asset",
 		test:             testBasicSendPassiveAsset,
@@ -145,6 +162,10 @@ var testCases = []*testCase{
 		name: "psbt multi send",
 		test: testPsbtMultiSend,
 	},
+	{
+		name: "psbt external signer send",
+		test: testPsbtExternalSignerSend,
+	},
 	{
 		name: "universe REST API",
 		test: testUniverseREST,
@@ -157,10 +178,22 @@ var testCases = []*testCase{
 		name: "universe federation",
 		test: testUniverseFederation,
 	},
+	{
+		name: "universe multiple federations",
+		test: testUniverseMultipleFederations,
+	},
 	{
 		name: "get info",
 		test: testGetInfo,
 	},
+	{
+		name: "chain clock",
+		test: testChainClock,
+	},
+	{
+		name: "node snapshot",
+		test: testNodeSnapshot,
+	},
 	{
 		name: "burn test",
 		test: testBurnAssets,
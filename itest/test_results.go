@@ -0,0 +1,111 @@
+package itest
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// resultPassed is the result of a test case attempt that passed.
+	resultPassed = "passed"
+
+	// resultFailed is the result of a test case attempt that failed.
+	resultFailed = "failed"
+
+	// resultSkipped is the result of a test case that skipped itself.
+	resultSkipped = "skipped"
+
+	// resultQuarantined is the result of a test case that wasn't run
+	// because it is quarantined.
+	resultQuarantined = "quarantined"
+)
+
+// readQuarantine reads the names of the quarantined test cases from the file
+// at the given path. The file lists one test case name per line, empty lines
+// and lines starting with '#' are ignored. No test case is quarantined if the
+// path is empty.
+func readQuarantine(path string) (map[string]struct{}, error) {
+	quarantined := make(map[string]struct{})
+	if path == "" {
+		return quarantined, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open quarantine file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		quarantined[line] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read quarantine file: %w", err)
+	}
+
+	return quarantined, nil
+}
+
+// resultsWriter appends the result of every test case attempt to a results
+// file, so flakes can be tracked across runs. Every line of the file holds the
+// tab separated name, result, flaky flag, attempt number and duration in
+// seconds of a single attempt. A nil writer discards all results.
+type resultsWriter struct {
+	file *os.File
+}
+
+// newResultsWriter opens the results file at the given path for appending.
+// It returns a nil writer if the path is empty.
+func newResultsWriter(path string) (*resultsWriter, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := os.OpenFile(
+		path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open results file: %w", err)
+	}
+
+	return &resultsWriter{
+		file: file,
+	}, nil
+}
+
+// record writes the result of a single attempt of the given test case.
+func (w *resultsWriter) record(tc *testCase, attempt int, result string,
+	duration time.Duration) error {
+
+	if w == nil {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(
+		w.file, "%s\t%s\t%t\t%d\t%.3f\n", tc.name, result, tc.flaky,
+		attempt, duration.Seconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to write result: %w", err)
+	}
+
+	return nil
+}
+
+// close closes the results file.
+func (w *resultsWriter) close() error {
+	if w == nil {
+		return nil
+	}
+
+	return w.file.Close()
+}
@@ -0,0 +1,74 @@
+package itest
+
+import (
+	"context"
+	"fmt"
+
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightningnetwork/lnd/lntest/node"
+	"github.com/stretchr/testify/require"
+)
+
+// universeServerHarness is a universe server that was spun up for a single
+// test case, next to the primary tapd node of the test.
+type universeServerHarness struct {
+	// lnd is the lnd node that backs the universe server.
+	lnd *node.HarnessNode
+
+	// tapd is the tapd node that serves the universe.
+	*tapdHarness
+}
+
+// setupUniverseServers spins up the given number of universe servers. Every
+// server is a tapd node backed by its own lnd node and starts out with an
+// empty federation, so a test can build distinct federations out of them and
+// point different nodes at different subsets of the servers. All servers are
+// shut down at the end of the test case.
+func setupUniverseServers(t *harnessTest,
+	numServers int) []*universeServerHarness {
+
+	servers := make([]*universeServerHarness, 0, numServers)
+	for i := 0; i < numServers; i++ {
+		lndNode := t.lndHarness.NewNode(
+			fmt.Sprintf("universe-%d", i), lndDefaultArgs,
+		)
+		tapd := setupTapdHarness(t.t, t, lndNode, nil)
+
+		server := &universeServerHarness{
+			lnd:         lndNode,
+			tapdHarness: tapd,
+		}
+		t.t.Cleanup(func() {
+			shutdownAndAssert(t, server.lnd, server.tapdHarness)
+		})
+
+		servers = append(servers, server)
+	}
+
+	return servers
+}
+
+// federateWith adds the given universe servers to the federation of the given
+// node. The servers are only added to the federation of the node, they don't
+// federate with the node or each other in turn.
+func federateWith(t *harnessTest, tapd *tapdHarness,
+	servers ...*universeServerHarness) {
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	fedServers := make([]*unirpc.UniverseFederationServer, len(servers))
+	for i, server := range servers {
+		fedServers[i] = &unirpc.UniverseFederationServer{
+			Host: server.rpcHost(),
+		}
+	}
+
+	_, err := tapd.AddFederationServer(
+		ctxt, &unirpc.AddFederationServerRequest{
+			Servers: fedServers,
+		},
+	)
+	require.NoError(t.t, err)
+}
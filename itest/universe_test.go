@@ -533,6 +533,101 @@ func testUniverseFederation(t *harnessTest) {
 	require.Equal(t.t, 0, len(fedNodes.Servers))
 }
 
+// testUniverseMultipleFederations tests that nodes which are part of distinct
+// federations only learn about the assets issued within their federation,
+// until the federations are joined.
+func testUniverseMultipleFederations(t *harnessTest) {
+	miner := t.lndHarness.Miner.Client
+
+	// We start two universe servers that don't know about each other. The
+	// main node federates with the first one, Bob with the second one.
+	servers := setupUniverseServers(t, 2)
+	uniA, uniB := servers[0], servers[1]
+
+	bob := setupTapdHarness(t.t, t, t.lndHarness.Bob, nil)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	federateWith(t, t.tapd, uniA)
+	federateWith(t, bob, uniB)
+
+	AssertFederationServers(t.t, t.tapd, uniA.rpcHost())
+	AssertFederationServers(t.t, bob, uniB.rpcHost())
+	AssertFederationServers(t.t, uniA)
+	AssertFederationServers(t.t, uniB)
+
+	// Every node issues an asset, which is pushed to its own federation
+	// only.
+	mainAssets := MintAssetsConfirmBatch(
+		t.t, miner, t.tapd, simpleAssets[:1],
+	)
+	bobAssets := MintAssetsConfirmBatch(
+		t.t, miner, bob, []*mintrpc.MintAssetRequest{simpleAssets[1]},
+	)
+
+	assertNumUniverseAssets(t, uniA, 1)
+	assertNumUniverseAssets(t, uniB, 1)
+	assertHasIssuanceProof(t, uniA, mainAssets[0])
+	assertHasIssuanceProof(t, uniB, bobAssets[0])
+
+	// Once the second universe server federates with the first one, it
+	// syncs the asset of the main node, while the first server still only
+	// knows its own.
+	federateWith(t, uniB.tapdHarness, uniA)
+
+	assertNumUniverseAssets(t, uniB, 2)
+	assertHasIssuanceProof(t, uniB, mainAssets[0])
+	assertNumUniverseAssets(t, uniA, 1)
+}
+
+// assertNumUniverseAssets asserts that the given universe server eventually
+// knows about the given number of assets.
+func assertNumUniverseAssets(t *harnessTest, server *universeServerHarness,
+	numAssets int) {
+
+	ctxb := context.Background()
+
+	require.Eventually(t.t, func() bool {
+		info, err := server.Info(ctxb, &unirpc.InfoRequest{})
+		if err != nil {
+			return false
+		}
+
+		return info.NumAssets == uint64(numAssets)
+	}, defaultWaitTimeout, wait.PollInterval)
+}
+
+// assertHasIssuanceProof asserts that the issuance proof of the given asset
+// can be retrieved from the given universe server.
+func assertHasIssuanceProof(t *harnessTest, server *universeServerHarness,
+	a *taprpc.Asset) {
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	resp, err := server.AssetLeafKeys(ctxt, &unirpc.ID{
+		Id: &unirpc.ID_AssetId{
+			AssetId: a.AssetGenesis.AssetId,
+		},
+		ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+	})
+	require.NoError(t.t, err)
+	require.Len(t.t, resp.AssetKeys, 1)
+
+	_, err = server.QueryProof(ctxt, &unirpc.UniverseKey{
+		Id: &unirpc.ID{
+			Id: &unirpc.ID_AssetId{
+				AssetId: a.AssetGenesis.AssetId,
+			},
+			ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+		},
+		LeafKey: resp.AssetKeys[0],
+	})
+	require.NoError(t.t, err)
+}
+
 // testFederationSyncConfig tests that we can properly set and query the
 // federation sync config.
 func testFederationSyncConfig(t *harnessTest) {
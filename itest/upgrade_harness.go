@@ -0,0 +1,150 @@
+package itest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+)
+
+var (
+	// legacyTapdBinary is a command line flag for specifying the path of
+	// a previously released tapd binary. Upgrade tests start nodes from
+	// that binary and restart them under the current build.
+	legacyTapdBinary = flag.String("legacytapdbinary", "", "The path of "+
+		"a previously released tapd binary to run the upgrade tests "+
+		"with, the upgrade tests are skipped if not set")
+)
+
+const (
+	// legacyShutdownTimeout is the maximum time we wait for a node that
+	// runs a previously released binary to shut down after it was
+	// interrupted.
+	legacyShutdownTimeout = 30 * time.Second
+)
+
+// legacyProcess is the process of a tapd node that runs a previously released
+// binary.
+type legacyProcess struct {
+	cmd *exec.Cmd
+
+	// exited is closed once the process exited.
+	exited chan struct{}
+}
+
+// legacyArgs returns the command line arguments that make a previously
+// released tapd binary run with the configuration of the harness. Only options
+// that have been around for several releases are passed, as older binaries
+// refuse to start with unknown options.
+func (hs *tapdHarness) legacyArgs() []string {
+	cfg := hs.clientCfg
+
+	args := []string{
+		fmt.Sprintf("--network=%s", cfg.ChainConf.Network),
+		fmt.Sprintf("--tapddir=%s", cfg.TapdDir),
+		fmt.Sprintf("--debuglevel=%s", cfg.DebugLevel),
+		fmt.Sprintf("--tlscertpath=%s", cfg.RpcConf.TLSCertPath),
+		fmt.Sprintf("--tlskeypath=%s", cfg.RpcConf.TLSKeyPath),
+		fmt.Sprintf("--macaroonpath=%s", cfg.RpcConf.MacaroonPath),
+		fmt.Sprintf("--lnd.host=%s", cfg.Lnd.Host),
+		fmt.Sprintf("--lnd.macaroonpath=%s", cfg.Lnd.MacaroonPath),
+		fmt.Sprintf("--lnd.tlspath=%s", cfg.Lnd.TLSPath),
+		"--allow-public-uni-proof-courier",
+		"--universe.public-access",
+	}
+	for _, listener := range cfg.RpcConf.RawRPCListeners {
+		args = append(args, fmt.Sprintf("--rpclisten=%s", listener))
+	}
+	for _, listener := range cfg.RpcConf.RawRESTListeners {
+		args = append(args, fmt.Sprintf("--restlisten=%s", listener))
+	}
+	if cfg.DefaultProofCourierAddr != "" {
+		args = append(args, fmt.Sprintf(
+			"--proofcourieraddr=%s", cfg.DefaultProofCourierAddr,
+		))
+	}
+
+	return args
+}
+
+// startLegacy spins up the tapd server from the given previously released
+// binary instead of the current build. The node uses the same data directory
+// and listeners as it would under the current build, so it can be stopped
+// with stop(false) and restarted with start to upgrade it.
+func (hs *tapdHarness) startLegacy(binary string) error {
+	// The binary runs in its own process, so it can't use the database of
+	// a Postgres fixture of the harness.
+	if hs.clientCfg.DatabaseBackend != tapcfg.DatabaseBackendSqlite {
+		return fmt.Errorf("legacy tapd binaries can only be run with "+
+			"the %v backend", tapcfg.DatabaseBackendSqlite)
+	}
+
+	logFile, err := os.Create(filepath.Join(
+		hs.cfg.BaseDir, fmt.Sprintf("legacy-tapd-%d.log",
+			time.Now().UnixNano()),
+	))
+	if err != nil {
+		return fmt.Errorf("unable to create log file: %w", err)
+	}
+
+	cmd := exec.Command(binary, hs.legacyArgs()...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		_ = logFile.Close()
+		return fmt.Errorf("unable to start legacy tapd %v: %w", binary,
+			err)
+	}
+	legacy := &legacyProcess{
+		cmd:    cmd,
+		exited: make(chan struct{}),
+	}
+	hs.legacy = legacy
+
+	go func() {
+		defer close(legacy.exited)
+		defer logFile.Close()
+
+		_ = cmd.Wait()
+	}()
+
+	// The node creates its TLS certificate and macaroon on startup, which
+	// might take a while for a fresh data directory.
+	err = wait.NoError(hs.connectClients, defaultWaitTimeout)
+	if err != nil {
+		_ = hs.stopLegacy(false)
+		return fmt.Errorf("legacy tapd didn't start: %w", err)
+	}
+
+	return nil
+}
+
+// stopLegacy shuts down the process of a node that runs a previously released
+// binary and optionally deletes its data directory. Once stopped, the node can
+// be restarted under the current build.
+func (hs *tapdHarness) stopLegacy(deleteData bool) error {
+	legacy := hs.legacy
+	err := legacy.cmd.Process.Signal(os.Interrupt)
+
+	select {
+	case <-legacy.exited:
+	case <-time.After(legacyShutdownTimeout):
+		_ = legacy.cmd.Process.Kill()
+		<-legacy.exited
+
+		err = fmt.Errorf("legacy tapd didn't shut down within %v",
+			legacyShutdownTimeout)
+	}
+	hs.legacy = nil
+
+	if deleteData {
+		_ = os.RemoveAll(hs.cfg.BaseDir)
+	}
+
+	return err
+}
@@ -0,0 +1,73 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testUpgradeFromLegacy tests that a node which ran a previously released tapd
+// binary can be upgraded to the current build. The node creates assets and
+// starts a transfer under the old binary, which runs the database migrations
+// and has to resume the pending transfer once restarted under the current
+// build.
+func testUpgradeFromLegacy(t *harnessTest) {
+	if *legacyTapdBinary == "" {
+		t.Skipf("No legacy tapd binary set, skipping upgrade test")
+	}
+
+	ctxb := context.Background()
+	miner := t.lndHarness.Miner.Client
+
+	// We start the node that is going to be upgraded from the previously
+	// released binary.
+	legacyTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.legacyBinary = *legacyTapdBinary
+		},
+	)
+	defer func() {
+		require.NoError(t.t, legacyTapd.stop(!*noDelete))
+	}()
+
+	rpcAssets := MintAssetsConfirmBatch(
+		t.t, miner, legacyTapd,
+		[]*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	genInfo := rpcAssets[0].AssetGenesis
+
+	// Synchronize the Universe state of the legacy node with the main
+	// node, which is going to receive the transfer.
+	t.syncUniverseState(legacyTapd, t.tapd, len(rpcAssets))
+
+	recvAddr, err := t.tapd.NewAddr(ctxb, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     10,
+	})
+	require.NoError(t.t, err)
+	AssertAddrCreated(t.t, t.tapd, rpcAssets[0], recvAddr)
+
+	// Start the transfer, but stop the node before the anchoring
+	// transaction confirms, so the transfer is still pending when the node
+	// is upgraded.
+	sendResp := sendAssetsToAddr(t, legacyTapd, recvAddr)
+
+	t.Logf("Upgrading node from legacy binary %v", *legacyTapdBinary)
+	require.NoError(t.t, legacyTapd.stop(false))
+	require.NoError(t.t, legacyTapd.start(false))
+
+	// The upgraded node should pick up the pending transfer from the
+	// migrated database and complete it once the anchoring transaction
+	// confirms.
+	ConfirmAndAssertOutboundTransfer(
+		t.t, miner, legacyTapd, sendResp, genInfo.AssetId,
+		[]uint64{rpcAssets[0].Amount - 10, 10}, 0, 1,
+	)
+	AssertNonInteractiveRecvComplete(t.t, t.tapd, 1)
+	AssertBalanceByID(
+		t.t, legacyTapd, genInfo.AssetId, rpcAssets[0].Amount-10,
+	)
+}
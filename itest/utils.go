@@ -17,6 +17,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 )
@@ -25,6 +26,15 @@ var (
 	zeroHash          chainhash.Hash
 	regtestMiningAddr = "n1VgRjYDzJT2TV72PnungWgWu18SWorXZS"
 	regtestParams     = &chaincfg.RegressionNetParams
+
+	// BlockWaitTimeout is the maximum time MineBlocks waits for new blocks
+	// on a signet backend, where blocks can't be generated on demand.
+	BlockWaitTimeout = 30 * time.Minute
+)
+
+const (
+	// signetChain is the name of the chain a signet backend reports.
+	signetChain = "signet"
 )
 
 // CopyRequest is a helper function to copy a request so that we can modify it.
@@ -87,6 +97,10 @@ func ParseGenInfo(t *testing.T, genInfo *taprpc.GenesisInfo) *asset.Genesis {
 // MineBlocks mine 'num' of blocks and check that blocks are present in
 // node blockchain. numTxs should be set to the number of transactions
 // (excluding the coinbase) we expect to be included in the first mined block.
+//
+// NOTE: Blocks can only be generated on demand on regtest. On a signet
+// backend, MineBlocks waits for the signet miner to mine the blocks instead,
+// see waitForBlocks.
 func MineBlocks(t *testing.T, client *rpcclient.Client,
 	num uint32, numTxs int) []*wire.MsgBlock {
 
@@ -103,6 +117,12 @@ func MineBlocks(t *testing.T, client *rpcclient.Client,
 		}
 	}
 
+	chainInfo, err := client.GetBlockChainInfo()
+	require.NoError(t, err)
+	if chainInfo.Chain == signetChain {
+		return waitForBlocks(t, client, num, txids)
+	}
+
 	blocks := make([]*wire.MsgBlock, num)
 
 	backend, err := client.BackendVersion()
@@ -148,6 +168,60 @@ func MineBlocks(t *testing.T, client *rpcclient.Client,
 	return blocks
 }
 
+// waitForBlocks waits until at least 'num' new blocks were mined on top of the
+// current chain tip and all the given transactions were confirmed in them, and
+// returns all new blocks. This is used on backends like signet, where only the
+// signet miner can produce blocks, so the transactions might not make it into
+// the first new block.
+func waitForBlocks(t *testing.T, client *rpcclient.Client, num uint32,
+	txids []*chainhash.Hash) []*wire.MsgBlock {
+
+	startHeight, err := client.GetBlockCount()
+	require.NoError(t, err)
+
+	unconfirmed := make(map[chainhash.Hash]struct{}, len(txids))
+	for _, txid := range txids {
+		unconfirmed[*txid] = struct{}{}
+	}
+
+	var blocks []*wire.MsgBlock
+	err = wait.NoError(func() error {
+		height, err := client.GetBlockCount()
+		if err != nil {
+			return err
+		}
+
+		// Fetch all blocks we haven't seen yet.
+		nextHeight := startHeight + int64(len(blocks)) + 1
+		for h := nextHeight; h <= height; h++ {
+			blockHash, err := client.GetBlockHash(h)
+			if err != nil {
+				return err
+			}
+			block, err := client.GetBlock(blockHash)
+			if err != nil {
+				return err
+			}
+
+			for _, tx := range block.Transactions {
+				delete(unconfirmed, tx.TxHash())
+			}
+			blocks = append(blocks, block)
+		}
+
+		if len(blocks) < int(num) || len(unconfirmed) > 0 {
+			return fmt.Errorf("%d of %d block(s) mined, %d "+
+				"transaction(s) unconfirmed", len(blocks), num,
+				len(unconfirmed))
+		}
+
+		return nil
+	}, BlockWaitTimeout)
+	require.NoError(t, err)
+
+	return blocks
+}
+
 type MintOption func(*MintOptions)
 
 type MintOptions struct {
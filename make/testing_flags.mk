@@ -50,6 +50,28 @@ ifeq ($(dbbackend),postgres)
 DEV_TAGS += test_db_postgres
 endif
 
+# Quarantine the integration tests listed in the given file.
+ifneq ($(quarantine),)
+ITEST_FLAGS += -quarantinefile=$(abspath $(quarantine))
+endif
+
+# The number of times a failed flaky integration test is retried, and the file
+# the result of every attempt is appended to.
+FLAKE_RETRIES = 2
+ifneq ($(flakeretries),)
+FLAKE_RETRIES = $(flakeretries)
+endif
+
+ITEST_RESULTS_FILE = $(abspath itest/itest-results.tsv)
+ifneq ($(itestresults),)
+ITEST_RESULTS_FILE = $(abspath $(itestresults))
+endif
+
+# Run the upgrade itests with a previously released tapd binary.
+ifneq ($(legacytapd),)
+ITEST_FLAGS += -legacytapdbinary=$(legacytapd)
+endif
+
 ifneq ($(tags),)
 DEV_TAGS += ${tags}
 endif
@@ -104,7 +126,9 @@ UNIT_RACE := $(UNIT) -race
 endif
 
 
-# Default to btcd backend if not set.
+# Default to btcd backend if not set. The lnd nodes of the integration tests
+# can also run against bitcoind with ZMQ (backend=bitcoind), bitcoind with RPC
+# polling (backend="bitcoind rpcpolling") or neutrino (backend=neutrino).
 ifeq ($(backend),)
 backend = btcd
 endif
@@ -3,9 +3,10 @@ package monitoring
 import "github.com/prometheus/client_golang/prometheus"
 
 // metricGroupFactory is a factory method that given the primary prometheus
-// config, will create a new MetricGroup that will be managed by the main
-// PrometheusExporter.
-type metricGroupFactory func(*PrometheusConfig) (MetricGroup, error)
+// config and the registry to export metrics with, will create a new
+// MetricGroup that will be managed by the main PrometheusExporter.
+type metricGroupFactory func(*PrometheusConfig,
+	*prometheus.Registry) (MetricGroup, error)
 
 // MetricGroup is the primary interface of this package. The main exporter (in
 // this case the PrometheusExporter), will manage these directly, ensuring that
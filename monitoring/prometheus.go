@@ -39,6 +39,10 @@ var (
 // metrics.
 type PrometheusExporter struct {
 	config *PrometheusConfig
+
+	// registry is the registry all metric groups register their metrics
+	// with. It is created when the exporter is started.
+	registry *prometheus.Registry
 }
 
 // Start registers all relevant metrics with the Prometheus library, then
@@ -66,6 +70,7 @@ func (p *PrometheusExporter) Start() error {
 
 	// Next, we'll attempt to register all our metrics. If we fail to
 	// register ANY metric, then we'll fail all together.
+	p.registry = reg
 	if err := p.registerMetrics(); err != nil {
 		return err
 	}
@@ -107,7 +112,7 @@ func (p *PrometheusExporter) registerMetrics() error {
 	defer metricsMtx.Unlock()
 
 	for _, metricGroupFunc := range metricGroups {
-		metricGroup, err := metricGroupFunc(p.config)
+		metricGroup, err := metricGroupFunc(p.config, p.registry)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,128 @@
+package monitoring
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// transferCollectorName is the name of the metric group that exports
+	// metrics about asset transfers.
+	transferCollectorName = "transfer_collector"
+
+	passiveAssetsMetric      = "transfer_passive_assets_total"
+	anchorOutputsSavedMetric = "transfer_anchor_outputs_saved_total"
+	anchorVBytesSavedMetric  = "transfer_anchor_vbytes_saved_total"
+	anchorFeesSavedMetric    = "transfer_anchor_fees_saved_sats_total"
+)
+
+// transferCollector is a MetricGroup that exports metrics about the asset
+// transfers made by the chain porter.
+type transferCollector struct {
+	cfg *PrometheusConfig
+
+	registry *prometheus.Registry
+
+	passiveAssets *prometheus.Desc
+	outputsSaved  *prometheus.Desc
+	vBytesSaved   *prometheus.Desc
+	feesSaved     *prometheus.Desc
+}
+
+// A compile-time assertion to ensure transferCollector implements the
+// MetricGroup interface.
+var _ MetricGroup = (*transferCollector)(nil)
+
+// newTransferCollector creates a new transfer collector for the given config
+// that registers its metrics with the given registry.
+func newTransferCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (MetricGroup, error) {
+
+	return &transferCollector{
+		cfg:      cfg,
+		registry: registry,
+		passiveAssets: prometheus.NewDesc(
+			passiveAssetsMetric,
+			"number of passive assets re-anchored into shared "+
+				"anchor outputs", nil, nil,
+		),
+		outputsSaved: prometheus.NewDesc(
+			anchorOutputsSavedMetric,
+			"number of anchor outputs not created because "+
+				"passive assets shared an anchor output", nil,
+			nil,
+		),
+		vBytesSaved: prometheus.NewDesc(
+			anchorVBytesSavedMetric,
+			"size of the anchor outputs not created because "+
+				"passive assets shared an anchor output", nil,
+			nil,
+		),
+		feesSaved: prometheus.NewDesc(
+			anchorFeesSavedMetric,
+			"estimated chain fees not paid for the anchor "+
+				"outputs not created because passive assets "+
+				"shared an anchor output", nil, nil,
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (t *transferCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- t.passiveAssets
+	ch <- t.outputsSaved
+	ch <- t.vBytesSaved
+	ch <- t.feesSaved
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (t *transferCollector) Collect(ch chan<- prometheus.Metric) {
+	if t.cfg.ChainPorter == nil {
+		return
+	}
+
+	stats := t.cfg.ChainPorter.AnchorSharingStats()
+	ch <- prometheus.MustNewConstMetric(
+		t.passiveAssets, prometheus.CounterValue,
+		float64(stats.PassiveAssets),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		t.outputsSaved, prometheus.CounterValue,
+		float64(stats.OutputsSaved),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		t.vBytesSaved, prometheus.CounterValue,
+		float64(stats.VBytesSaved),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		t.feesSaved, prometheus.CounterValue, float64(stats.FeesSaved),
+	)
+}
+
+// Name is the name of the metric group. When exported to prometheus, it's
+// expected that all metric under this group have the same prefix.
+//
+// NOTE: Part of the MetricGroup interface.
+func (t *transferCollector) Name() string {
+	return transferCollectorName
+}
+
+// RegisterMetricFuncs signals to the underlying hybrid collector that it
+// should register all metrics that it aims to export with the global
+// Prometheus registry.
+//
+// NOTE: Part of the MetricGroup interface.
+func (t *transferCollector) RegisterMetricFuncs() error {
+	return t.registry.Register(t)
+}
+
+func init() {
+	metricsMtx.Lock()
+	defer metricsMtx.Unlock()
+
+	metricGroups[transferCollectorName] = newTransferCollector
+}
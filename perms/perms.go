@@ -40,6 +40,10 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ExportTransfers": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/QueryAddrs": {{
 			Entity: "addresses",
 			Action: "read",
@@ -56,6 +60,10 @@ var (
 			Entity: "addresses",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/SetReceiveLabel": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/VerifyProof": {{
 			Entity: "proofs",
 			Action: "read",
@@ -76,10 +84,62 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/ListBurns": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ExportBurnAttestation": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/BumpTransferFee": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/CpfpTransfer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/CancelTransfer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ResumeTransferDelivery": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/QueueSend": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListQueuedSends": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/UpdateQueuedSend": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/CancelQueuedSend": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ConsolidateAssets": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/SweepAssets": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/FetchAssetMeta": {{
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/FetchAssetMetaBlob": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/SubscribeSendAssetEventNtfns": {{
 			Entity: "assets",
 			Action: "write",
@@ -112,10 +172,50 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/assetwalletrpc.AssetWallet/AttestReserves": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/VerifyReserveAttestation": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/ImportAsset": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/assetwalletrpc.AssetWallet/RemoveUTXOLease": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/CreateSwapOffer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/AcceptSwapOffer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/CompleteSwap": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/PrepareExternalSigning": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/ApplyExternalSignatures": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/FundAnchorPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/PublishAnchorPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/MintAsset": {{
 			Entity: "mint",
 			Action: "write",
@@ -124,14 +224,98 @@ var (
 			Entity: "mint",
 			Action: "write",
 		}},
+		"/mintrpc.Mint/PreviewBatch": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/ValidateMint": {{
+			Entity: "mint",
+			Action: "read",
+		}},
 		"/mintrpc.Mint/CancelBatch": {{
 			Entity: "mint",
 			Action: "write",
 		}},
+		"/mintrpc.Mint/CancelSeedling": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/ListBatches": {{
 			Entity: "mint",
 			Action: "read",
 		}},
+		"/mintrpc.Mint/SetBatchLabel": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ListBatchCosts": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/GetBatchSchedule": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/UpdateBatchSchedule": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ListGroupWitnessRequests": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/SubmitGroupWitness": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/SubmitGroupScriptSig": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ListMuSig2GroupSessions": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/StartMuSig2GroupSession": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/RegisterMuSig2GroupNonce": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/SubmitMuSig2GroupPartialSig": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/SaveBatchTemplate": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ListBatchTemplates": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/DeleteBatchTemplate": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/MintBatchTemplate": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ListGenesisPsbtRequests": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/SubmitGenesisPsbt": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/SubscribeMintEvents": {{
+			Entity: "mint",
+			Action: "read",
+		}},
 		"/universerpc.Universe/AssetRoots": {{
 			Entity: "universe",
 			Action: "read",
@@ -152,6 +336,10 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryGroupEmission": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/QueryProof": {{
 			Entity: "universe",
 			Action: "read",
@@ -196,6 +384,10 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/ListProofPublications": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/tapdevrpc.TapDev/ImportProof": {{
 			Entity: "proofs",
 			Action: "write",
@@ -209,18 +401,19 @@ var (
 	// InsertProof as a valid proof requires an on-chain transaction, so we
 	// gain a layer of DoS defense.
 	defaultMacaroonWhitelist = map[string]struct{}{
-		"/universerpc.Universe/AssetRoots":      {},
-		"/universerpc.Universe/QueryAssetRoots": {},
-		"/universerpc.Universe/AssetLeafKeys":   {},
-		"/universerpc.Universe/AssetLeaves":     {},
-		"/universerpc.Universe/Info":            {},
+		"/universerpc.Universe/AssetRoots":         {},
+		"/universerpc.Universe/QueryAssetRoots":    {},
+		"/universerpc.Universe/AssetLeafKeys":      {},
+		"/universerpc.Universe/AssetLeaves":        {},
+		"/universerpc.Universe/QueryGroupEmission": {},
+		"/universerpc.Universe/Info":               {},
 	}
 )
 
 // MacaroonWhitelist returns the set of RPC endpoints that don't require
 // macaroon authentication.
 func MacaroonWhitelist(allowPublicUniProofCourier bool,
-	allowPublicStats bool) map[string]struct{} {
+	allowPublicStats bool, allowPublicMetaBlobs bool) map[string]struct{} {
 
 	// Make a copy of the default whitelist.
 	whitelist := make(map[string]struct{})
@@ -242,5 +435,12 @@ func MacaroonWhitelist(allowPublicUniProofCourier bool,
 		whitelist["/universerpc.Universe/QueryEvents"] = struct{}{}
 	}
 
+	// Conditionally add the public meta data blob RPC endpoint to the
+	// whitelist.
+	if allowPublicMetaBlobs {
+		whitelist["/taprpc.TaprootAssets/FetchAssetMetaBlob"] =
+			struct{}{}
+	}
+
 	return whitelist
 }
@@ -0,0 +1,266 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// delegationTermsLen is the length of the terms of an issuance
+	// delegation that are committed to in its tapscript leaf, which are
+	// the max amount and the expiry height.
+	delegationTermsLen = 8 + 4
+)
+
+var (
+	// ErrDelegationExceeded signals that an issuance by a delegate key
+	// would exceed the amount or expiry height the delegate key was
+	// authorized for when the asset group was created.
+	ErrDelegationExceeded = errors.New("issuance delegation of asset " +
+		"group exceeded")
+)
+
+// IssuanceDelegation authorizes a delegate key to issue assets into an asset
+// group without the group key, up to a maximum amount and optionally until an
+// expiry height. The group key commits to a tapscript leaf for every
+// delegation of the group, so the delegate key can produce a group witness by
+// spending its leaf. The terms of the delegation are committed to in the leaf
+// and in the meta data of the asset that creates the group, which allows
+// verifiers to enforce them.
+type IssuanceDelegation struct {
+	// Key is the hex encoded x-only delegate key.
+	Key string `json:"key"`
+
+	// MaxAmount is the maximum amount the delegate key can issue into the
+	// group, summed over all of its issuances.
+	MaxAmount uint64 `json:"max_amount"`
+
+	// ExpiryHeight is the last block height an issuance by the delegate
+	// key can be confirmed at. Zero means the delegation doesn't expire.
+	ExpiryHeight uint32 `json:"expiry_height,omitempty"`
+}
+
+// DelegateKey parses the delegate key of the delegation.
+func (d *IssuanceDelegation) DelegateKey() (*btcec.PublicKey, error) {
+	keyBytes, err := hex.DecodeString(d.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: delegation key must be hex "+
+			"encoded", ErrInvalidJSONMeta)
+	}
+
+	key, err := schnorr.ParsePubKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid delegation key: %v",
+			ErrInvalidJSONMeta, err)
+	}
+
+	return key, nil
+}
+
+// Script returns the tapscript of the delegation leaf, which commits to the
+// terms of the delegation and requires a signature of the delegate key:
+//
+//	<max_amount || expiry_height> OP_DROP <delegate_key> OP_CHECKSIG
+func (d *IssuanceDelegation) Script() ([]byte, error) {
+	key, err := d.DelegateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var terms [delegationTermsLen]byte
+	binary.BigEndian.PutUint64(terms[:8], d.MaxAmount)
+	binary.BigEndian.PutUint32(terms[8:], d.ExpiryHeight)
+
+	return txscript.NewScriptBuilder().
+		AddData(terms[:]).
+		AddOp(txscript.OP_DROP).
+		AddData(schnorr.SerializePubKey(key)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// CheckIssuance makes sure that issuing the given amount with the delegate key
+// at the given block height doesn't exceed the terms of the delegation, given
+// the amount the delegate key already issued.
+func (d *IssuanceDelegation) CheckIssuance(issued, amount uint64,
+	height uint32) error {
+
+	if d.ExpiryHeight != 0 && height > d.ExpiryHeight {
+		return fmt.Errorf("%w: delegation of key %s expired at height "+
+			"%d", ErrDelegationExceeded, d.Key, d.ExpiryHeight)
+	}
+
+	// We compare against the remaining amount to avoid an overflow of the
+	// summed amounts.
+	if issued > d.MaxAmount || amount > d.MaxAmount-issued {
+		return fmt.Errorf("%w: issuing %d on top of %d exceeds max "+
+			"amount %d of key %s", ErrDelegationExceeded, amount,
+			issued, d.MaxAmount, d.Key)
+	}
+
+	return nil
+}
+
+// IssuanceDelegations are the issuance delegations of an asset group. The
+// tapscript tree of the group key has a leaf for each of them, ordered by
+// their delegate key.
+type IssuanceDelegations []IssuanceDelegation
+
+// Validate makes sure every delegation has a valid delegate key and a non-zero
+// max amount, and that no delegate key is used twice.
+func (d IssuanceDelegations) Validate() error {
+	keys := make(map[[schnorr.PubKeyBytesLen]byte]struct{}, len(d))
+	for idx := range d {
+		key, err := d[idx].DelegateKey()
+		if err != nil {
+			return err
+		}
+
+		if d[idx].MaxAmount == 0 {
+			return fmt.Errorf("%w: delegation %d has zero max "+
+				"amount", ErrInvalidJSONMeta, idx)
+		}
+
+		var keyBytes [schnorr.PubKeyBytesLen]byte
+		copy(keyBytes[:], schnorr.SerializePubKey(key))
+		if _, ok := keys[keyBytes]; ok {
+			return fmt.Errorf("%w: duplicate delegation key %x",
+				ErrInvalidJSONMeta, keyBytes[:])
+		}
+		keys[keyBytes] = struct{}{}
+	}
+
+	return nil
+}
+
+// sorted returns a copy of the delegations ordered by their delegate key.
+func (d IssuanceDelegations) sorted() (IssuanceDelegations, error) {
+	type keyedDelegation struct {
+		key        []byte
+		delegation IssuanceDelegation
+	}
+
+	keyed := make([]keyedDelegation, 0, len(d))
+	for idx := range d {
+		key, err := d[idx].DelegateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		keyed = append(keyed, keyedDelegation{
+			key:        schnorr.SerializePubKey(key),
+			delegation: d[idx],
+		})
+	}
+
+	sort.Slice(keyed, func(i, j int) bool {
+		return bytes.Compare(keyed[i].key, keyed[j].key) < 0
+	})
+
+	sorted := make(IssuanceDelegations, 0, len(keyed))
+	for _, k := range keyed {
+		sorted = append(sorted, k.delegation)
+	}
+
+	return sorted, nil
+}
+
+// TapLeaves returns the tapscript leaves of the delegations, in the order
+// they're committed to in the tapscript tree of the group key.
+func (d IssuanceDelegations) TapLeaves() ([]txscript.TapLeaf, error) {
+	sorted, err := d.sorted()
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := make([]txscript.TapLeaf, 0, len(sorted))
+	for idx := range sorted {
+		script, err := sorted[idx].Script()
+		if err != nil {
+			return nil, err
+		}
+
+		leaves = append(leaves, txscript.NewBaseTapLeaf(script))
+	}
+
+	return leaves, nil
+}
+
+// TapscriptRoot returns the root of the tapscript tree of a group key that
+// commits to the delegations. Nil is returned if there are no delegations, as
+// the group key then doesn't commit to a tapscript tree.
+func (d IssuanceDelegations) TapscriptRoot() ([]byte, error) {
+	if len(d) == 0 {
+		return nil, nil
+	}
+
+	leaves, err := d.TapLeaves()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := txscript.AssembleTaprootScriptTree(leaves...)
+	rootHash := tree.RootNode.TapHash()
+
+	return rootHash[:], nil
+}
+
+// Find returns the delegation of the given delegate key, along with the index
+// of its leaf in the tapscript tree of the group key. False is returned if
+// the key isn't a delegate key of the group.
+func (d IssuanceDelegations) Find(
+	key *btcec.PublicKey) (*IssuanceDelegation, int, bool) {
+
+	sorted, err := d.sorted()
+	if err != nil {
+		return nil, 0, false
+	}
+
+	keyBytes := schnorr.SerializePubKey(key)
+	for idx := range sorted {
+		delegateKey, err := sorted[idx].DelegateKey()
+		if err != nil {
+			return nil, 0, false
+		}
+
+		if bytes.Equal(schnorr.SerializePubKey(delegateKey), keyBytes) {
+			return &sorted[idx], idx, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// FromWitness returns the delegation whose leaf is spent by the given group
+// witness, or nil if the witness isn't a script path spend of a delegation
+// leaf. The witness of such a spend consists of the signature of the delegate
+// key, the leaf script and the control block.
+func (d IssuanceDelegations) FromWitness(
+	witness wire.TxWitness) *IssuanceDelegation {
+
+	if len(witness) != 3 {
+		return nil
+	}
+
+	for idx := range d {
+		script, err := d[idx].Script()
+		if err != nil {
+			continue
+		}
+
+		if bytes.Equal(script, witness[1]) {
+			return &d[idx]
+		}
+	}
+
+	return nil
+}
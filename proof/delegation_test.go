@@ -0,0 +1,125 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIssuanceDelegations tests the validation of issuance delegations, the
+// tapscript tree they're committed to and the enforcement of their terms.
+func TestIssuanceDelegations(t *testing.T) {
+	t.Parallel()
+
+	keyA := test.RandPrivKey(t).PubKey()
+	keyB := test.RandPrivKey(t).PubKey()
+	keyC := test.RandPrivKey(t).PubKey()
+
+	delegations := IssuanceDelegations{{
+		Key:          hex.EncodeToString(schnorr.SerializePubKey(keyA)),
+		MaxAmount:    1000,
+		ExpiryHeight: 200,
+	}, {
+		Key:       hex.EncodeToString(schnorr.SerializePubKey(keyB)),
+		MaxAmount: 500,
+	}}
+	require.NoError(t, delegations.Validate())
+
+	// The leaves are ordered by delegate key, so the order of the
+	// delegations doesn't change the tapscript root.
+	reversed := IssuanceDelegations{delegations[1], delegations[0]}
+	root, err := delegations.TapscriptRoot()
+	require.NoError(t, err)
+	reversedRoot, err := reversed.TapscriptRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, reversedRoot)
+	require.Len(t, root, 32)
+
+	noRoot, err := IssuanceDelegations(nil).TapscriptRoot()
+	require.NoError(t, err)
+	require.Nil(t, noRoot)
+
+	// Each delegation is found at the index of its leaf, and the group
+	// witness that spends the leaf maps back to the delegation.
+	leaves, err := delegations.TapLeaves()
+	require.NoError(t, err)
+	for _, key := range []*btcec.PublicKey{keyA, keyB} {
+		delegation, idx, ok := delegations.Find(key)
+		require.True(t, ok)
+
+		script, err := delegation.Script()
+		require.NoError(t, err)
+		require.Equal(t, leaves[idx].Script, script)
+
+		witness := wire.TxWitness{
+			test.RandBytes(64), script, test.RandBytes(65),
+		}
+		require.Equal(t, delegation, delegations.FromWitness(witness))
+	}
+
+	_, _, ok := delegations.Find(keyC)
+	require.False(t, ok)
+	require.Nil(t, delegations.FromWitness(wire.TxWitness{
+		test.RandBytes(64),
+	}))
+
+	// The terms of the delegation are committed to in its leaf, so
+	// changing them changes the tapscript root.
+	changed := IssuanceDelegations{delegations[0], delegations[1]}
+	changed[1].MaxAmount++
+	changedRoot, err := changed.TapscriptRoot()
+	require.NoError(t, err)
+	require.False(t, bytes.Equal(root, changedRoot))
+
+	// A delegate can issue up to its max amount until it expires.
+	delegation := &delegations[0]
+	require.NoError(t, delegation.CheckIssuance(0, 1000, 200))
+	require.NoError(t, delegation.CheckIssuance(600, 400, 100))
+	require.ErrorIs(
+		t, delegation.CheckIssuance(600, 401, 100),
+		ErrDelegationExceeded,
+	)
+	require.ErrorIs(
+		t, delegation.CheckIssuance(0, 1, 201), ErrDelegationExceeded,
+	)
+	require.ErrorIs(
+		t, delegation.CheckIssuance(math.MaxUint64, 1, 0),
+		ErrDelegationExceeded,
+	)
+
+	// A delegation without expiry height never expires.
+	require.NoError(t, delegations[1].CheckIssuance(0, 500, math.MaxUint32))
+
+	invalidDelegations := []IssuanceDelegations{
+		{{Key: "not hex", MaxAmount: 1}},
+		{{Key: hex.EncodeToString(test.RandBytes(31)), MaxAmount: 1}},
+		{{Key: delegations[0].Key}},
+		{delegations[0], delegations[0]},
+	}
+	for _, invalid := range invalidDelegations {
+		require.ErrorIs(t, invalid.Validate(), ErrInvalidJSONMeta)
+	}
+
+	// The delegations survive a round trip through the JSON meta data of
+	// the group anchor.
+	reveal, err := NewJSONMetaReveal(&AssetMetadata{
+		Delegations: delegations,
+	})
+	require.NoError(t, err)
+
+	decoded, err := reveal.Delegations()
+	require.NoError(t, err)
+	require.Equal(t, delegations, decoded)
+
+	var noReveal *MetaReveal
+	noDelegations, err := noReveal.Delegations()
+	require.NoError(t, err)
+	require.Empty(t, noDelegations)
+}
@@ -3,8 +3,14 @@ package proof
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"math"
+	"net/url"
+	"regexp"
 
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -18,6 +24,14 @@ const (
 	// bytes without any specific interpretation.
 	MetaOpaque MetaType = 0
 
+	// MetaJson signals that the meta data is a JSON object that follows
+	// the well-known asset metadata schema described by AssetMetadata.
+	MetaJson MetaType = 1
+
+	// MetaReference signals that the meta data is a MetaBlobRef that
+	// commits to a meta data blob stored outside of the proofs.
+	MetaReference MetaType = 2
+
 	// MetaDataMaxSizeBytes is the maximum length of the meta data. We limit
 	// this to 1MiB for now. This should be of sufficient size to commit to
 	// any JSON data or even medium resolution images. If there is need to
@@ -27,6 +41,14 @@ const (
 	// the universe and needs to be validated by all senders and receivers
 	// of the asset.
 	MetaDataMaxSizeBytes = 1024 * 1024
+
+	// MetaJsonMaxNameLen is the maximum length of the name field of JSON
+	// asset metadata.
+	MetaJsonMaxNameLen = 64
+
+	// MetaJsonMaxDecimals is the maximum number of decimal places JSON
+	// asset metadata can specify for displaying asset amounts.
+	MetaJsonMaxDecimals = asset.MaxDecimalDisplay
 )
 
 var (
@@ -35,8 +57,239 @@ var (
 
 	// ErrMetaDataTooLarge signals that the meta data is too large.
 	ErrMetaDataTooLarge = errors.New("meta data too large")
+
+	// ErrInvalidJSONMeta signals that meta data of the JSON type doesn't
+	// follow the asset metadata schema.
+	ErrInvalidJSONMeta = errors.New("invalid JSON meta data")
+
+	// ErrMaxSupplyExceeded signals that an issuance would exceed the
+	// maximum supply committed to when the asset group was created.
+	ErrMaxSupplyExceeded = errors.New("max supply of asset group " +
+		"exceeded")
+
+	// ErrEmissionScheduleExceeded signals that an issuance would exceed
+	// the supply unlocked by the emission schedule committed to when the
+	// asset group was created.
+	ErrEmissionScheduleExceeded = errors.New("emission schedule of asset " +
+		"group exceeded")
+
+	// tickerRegex is the format of the ticker of JSON asset metadata.
+	tickerRegex = regexp.MustCompile(`^[A-Z0-9]{1,11}$`)
 )
 
+// AssetMetadata is the structured content of meta data of the MetaJson type.
+// All fields are optional, but if present they must be well-formed. Any
+// additional fields of the JSON object are ignored, which allows issuers to
+// extend the schema.
+type AssetMetadata struct {
+	// Name is the human-readable name of the asset.
+	Name string `json:"name,omitempty"`
+
+	// Ticker is the short, upper case ticker symbol of the asset.
+	Ticker string `json:"ticker,omitempty"`
+
+	// Decimals is the number of decimal places wallets should use when
+	// displaying amounts of the asset.
+	Decimals uint32 `json:"decimals,omitempty"`
+
+	// IssuerURL is the http or https URL of the issuer of the asset.
+	IssuerURL string `json:"issuer_url,omitempty"`
+
+	// ImageHash is the hex encoded SHA-256 hash of the image that
+	// represents the asset.
+	ImageHash string `json:"image_hash,omitempty"`
+
+	// MaxSupply is the maximum total amount of an asset group, summed over
+	// all issuances into the group. It is only meaningful in the meta
+	// data of the asset that creates the group. A value of zero means the
+	// supply of the group is not capped.
+	MaxSupply uint64 `json:"max_supply,omitempty"`
+
+	// EmissionSchedule is the schedule by which the supply of an asset
+	// group is unlocked, summed over all issuances into the group. Like
+	// the maximum supply, it is only meaningful in the meta data of the
+	// asset that creates the group. An empty schedule means issuances
+	// aren't restricted by block height.
+	EmissionSchedule EmissionSchedule `json:"emission_schedule,omitempty"`
+
+	// Delegations are the delegate keys that can issue assets into an
+	// asset group without the group key, each up to its own limits. Like
+	// the maximum supply, they are only meaningful in the meta data of
+	// the asset that creates the group, whose group key commits to a
+	// tapscript leaf for each of them.
+	Delegations IssuanceDelegations `json:"delegations,omitempty"`
+}
+
+// Validate makes sure the asset metadata follows the schema.
+func (a *AssetMetadata) Validate() error {
+	if len(a.Name) > MetaJsonMaxNameLen {
+		return fmt.Errorf("%w: name exceeds %d characters",
+			ErrInvalidJSONMeta, MetaJsonMaxNameLen)
+	}
+
+	if a.Ticker != "" && !tickerRegex.MatchString(a.Ticker) {
+		return fmt.Errorf("%w: ticker must be 1 to 11 upper case "+
+			"letters or digits", ErrInvalidJSONMeta)
+	}
+
+	if a.Decimals > MetaJsonMaxDecimals {
+		return fmt.Errorf("%w: decimals exceed %d", ErrInvalidJSONMeta,
+			MetaJsonMaxDecimals)
+	}
+
+	if a.IssuerURL != "" {
+		issuerURL, err := url.Parse(a.IssuerURL)
+		if err != nil || issuerURL.Host == "" ||
+			(issuerURL.Scheme != "http" &&
+				issuerURL.Scheme != "https") {
+
+			return fmt.Errorf("%w: issuer_url must be an absolute "+
+				"http or https URL", ErrInvalidJSONMeta)
+		}
+	}
+
+	if a.ImageHash != "" {
+		imageHash, err := hex.DecodeString(a.ImageHash)
+		if err != nil || len(imageHash) != sha256.Size {
+			return fmt.Errorf("%w: image_hash must be a hex "+
+				"encoded %d-byte hash", ErrInvalidJSONMeta,
+				sha256.Size)
+		}
+	}
+
+	if err := a.EmissionSchedule.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.Delegations.Validate(); err != nil {
+		return err
+	}
+
+	// A schedule that unlocks more than the maximum supply could never be
+	// fully issued, which is most likely a mistake of the issuer.
+	if a.MaxSupply != 0 && len(a.EmissionSchedule) != 0 &&
+		a.EmissionSchedule.Total() > a.MaxSupply {
+
+		return fmt.Errorf("%w: emission_schedule unlocks %d, which "+
+			"exceeds max_supply %d", ErrInvalidJSONMeta,
+			a.EmissionSchedule.Total(), a.MaxSupply)
+	}
+
+	return nil
+}
+
+// EmissionEpoch is a single epoch of an emission schedule.
+type EmissionEpoch struct {
+	// StartHeight is the block height the epoch starts at. Issuances
+	// confirmed at or above this height can issue the amount of the epoch.
+	StartHeight uint32 `json:"start_height"`
+
+	// Amount is the amount that is unlocked for issuance once the epoch
+	// starts.
+	Amount uint64 `json:"amount"`
+}
+
+// EmissionSchedule is a list of emission epochs, ordered by their start
+// height. The supply of an asset group unlocked at a given block height is the
+// sum of the amounts of all epochs that started at or before that height, and
+// the total amount of all issuances into the group confirmed up to that height
+// must not exceed it.
+type EmissionSchedule []EmissionEpoch
+
+// Validate makes sure the epochs of the emission schedule are strictly ordered
+// by their start height, unlock a non-zero amount each and don't overflow the
+// total amount.
+func (e EmissionSchedule) Validate() error {
+	var total uint64
+	for idx, epoch := range e {
+		if idx > 0 && epoch.StartHeight <= e[idx-1].StartHeight {
+			return fmt.Errorf("%w: emission_schedule epochs must "+
+				"have strictly increasing start heights",
+				ErrInvalidJSONMeta)
+		}
+
+		if epoch.Amount == 0 {
+			return fmt.Errorf("%w: emission_schedule epoch %d "+
+				"unlocks zero amount", ErrInvalidJSONMeta, idx)
+		}
+
+		if epoch.Amount > math.MaxUint64-total {
+			return fmt.Errorf("%w: emission_schedule total "+
+				"overflows", ErrInvalidJSONMeta)
+		}
+		total += epoch.Amount
+	}
+
+	return nil
+}
+
+// Unlocked returns the amount the emission schedule unlocks for issuance at
+// the given block height.
+func (e EmissionSchedule) Unlocked(height uint32) uint64 {
+	var unlocked uint64
+	for _, epoch := range e {
+		if epoch.StartHeight > height {
+			break
+		}
+
+		unlocked += epoch.Amount
+	}
+
+	return unlocked
+}
+
+// Total returns the amount the emission schedule unlocks once all of its
+// epochs have started.
+func (e EmissionSchedule) Total() uint64 {
+	var total uint64
+	for _, epoch := range e {
+		total += epoch.Amount
+	}
+
+	return total
+}
+
+// CheckIssuance makes sure that issuing the given amount at the given block
+// height doesn't exceed the supply unlocked by the emission schedule, given
+// the amount that was already issued at or before that height. An empty
+// schedule doesn't restrict issuances.
+func (e EmissionSchedule) CheckIssuance(issued, amount uint64,
+	height uint32) error {
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	// We compare against the remaining supply to avoid an overflow of the
+	// summed amounts.
+	unlocked := e.Unlocked(height)
+	if issued > unlocked || amount > unlocked-issued {
+		return fmt.Errorf("%w: issuing %d on top of %d exceeds %d "+
+			"unlocked at height %d", ErrEmissionScheduleExceeded,
+			amount, issued, unlocked, height)
+	}
+
+	return nil
+}
+
+// NewJSONMetaReveal creates a meta reveal of the JSON type that contains the
+// given asset metadata.
+func NewJSONMetaReveal(metadata *AssetMetadata) (*MetaReveal, error) {
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetaReveal{
+		Type: MetaJson,
+		Data: data,
+	}, nil
+}
+
 // MetaReveal is an optional TLV type that can be added to the proof of a
 // genesis asset to reveal pre-image to the metadata hash. If present, then the
 // following equality must hold for the genesis proof to be valid:
@@ -66,9 +319,141 @@ func (m *MetaReveal) Validate() error {
 		return ErrMetaDataTooLarge
 	}
 
+	switch m.Type {
+	// Meta data of the JSON type must follow the asset metadata schema.
+	case MetaJson:
+		if _, err := m.DecodeMetadata(); err != nil {
+			return err
+		}
+
+	// Meta data of the reference type must be a valid blob reference.
+	case MetaReference:
+		if _, err := m.BlobRef(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// BlobRef decodes the meta blob reference of a meta reveal of the reference
+// type.
+func (m *MetaReveal) BlobRef() (*MetaBlobRef, error) {
+	if m.Type != MetaReference {
+		return nil, fmt.Errorf("meta type %d is not a reference",
+			m.Type)
+	}
+
+	var ref MetaBlobRef
+	if err := ref.Decode(bytes.NewReader(m.Data)); err != nil {
+		return nil, fmt.Errorf("invalid meta blob reference: %w", err)
+	}
+
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ref, nil
+}
+
+// DecodeMetadata decodes the structured asset metadata of a meta reveal of the
+// JSON type and validates it against the schema.
+func (m *MetaReveal) DecodeMetadata() (*AssetMetadata, error) {
+	if m.Type != MetaJson {
+		return nil, fmt.Errorf("meta type %d is not JSON", m.Type)
+	}
+
+	// The data must be a JSON object, with the well-known fields having
+	// the expected types.
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(m.Data, &fields); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSONMeta, err)
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("%w: not a JSON object",
+			ErrInvalidJSONMeta)
+	}
+
+	var metadata AssetMetadata
+	if err := json.Unmarshal(m.Data, &metadata); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSONMeta, err)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+// DecimalDisplay returns the number of decimal places that should be used when
+// displaying amounts of the asset the meta reveal belongs to. Only meta data of
+// the JSON type can specify a decimal display, for all other meta data (or no
+// meta data at all) amounts are displayed in whole asset units.
+func (m *MetaReveal) DecimalDisplay() (uint32, error) {
+	if m == nil || m.Type != MetaJson {
+		return 0, nil
+	}
+
+	metadata, err := m.DecodeMetadata()
+	if err != nil {
+		return 0, err
+	}
+
+	return metadata.Decimals, nil
+}
+
+// MaxSupply returns the maximum supply of the asset group the meta reveal
+// commits to. Only meta data of the JSON type can specify a maximum supply,
+// for all other meta data (or no meta data at all) zero is returned, meaning
+// the supply isn't capped.
+func (m *MetaReveal) MaxSupply() (uint64, error) {
+	if m == nil || m.Type != MetaJson {
+		return 0, nil
+	}
+
+	metadata, err := m.DecodeMetadata()
+	if err != nil {
+		return 0, err
+	}
+
+	return metadata.MaxSupply, nil
+}
+
+// EmissionSchedule returns the emission schedule of the asset group the meta
+// reveal commits to. Only meta data of the JSON type can specify an emission
+// schedule, for all other meta data (or no meta data at all) an empty schedule
+// is returned, meaning issuances aren't restricted by block height.
+func (m *MetaReveal) EmissionSchedule() (EmissionSchedule, error) {
+	if m == nil || m.Type != MetaJson {
+		return nil, nil
+	}
+
+	metadata, err := m.DecodeMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.EmissionSchedule, nil
+}
+
+// Delegations returns the issuance delegations of the asset group the meta
+// reveal commits to. Only meta data of the JSON type can specify delegations,
+// for all other meta data (or no meta data at all) no delegations are
+// returned, meaning only the group key can issue assets into the group.
+func (m *MetaReveal) Delegations() (IssuanceDelegations, error) {
+	if m == nil || m.Type != MetaJson {
+		return nil, nil
+	}
+
+	metadata, err := m.DecodeMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.Delegations, nil
+}
+
 // MetaHash returns the computed meta hash based on the TLV serialization of
 // the meta data itself.
 func (m *MetaReveal) MetaHash() [asset.MetaHashLen]byte {
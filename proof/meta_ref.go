@@ -0,0 +1,201 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// MetaBlobMaxSizeBytes is the maximum size of a meta data blob that is
+	// committed to by reference. As the blob isn't part of the proofs,
+	// it can be much larger than meta data that is committed to directly.
+	MetaBlobMaxSizeBytes = 64 * 1024 * 1024
+
+	// MetaBlobRefHashType is the TLV type of the blob hash of a meta blob
+	// reference.
+	MetaBlobRefHashType tlv.Type = 0
+
+	// MetaBlobRefSizeType is the TLV type of the blob size of a meta blob
+	// reference.
+	MetaBlobRefSizeType tlv.Type = 2
+
+	// MetaBlobRefURLType is the TLV type of the optional URL of a meta
+	// blob reference.
+	MetaBlobRefURLType tlv.Type = 4
+)
+
+var (
+	// ErrMetaBlobMismatch signals that a meta data blob doesn't match the
+	// reference that commits to it.
+	ErrMetaBlobMismatch = errors.New("meta blob doesn't match reference")
+
+	// ErrMetaBlobTooLarge signals that a meta data blob is too large.
+	ErrMetaBlobTooLarge = errors.New("meta blob too large")
+)
+
+// MetaBlobRef is the content of meta data of the MetaReference type. Instead
+// of the meta data itself, only its hash and size are committed to, along
+// with an optional URL the blob can be fetched from. This keeps large meta
+// data such as media files out of the proofs and the universe.
+type MetaBlobRef struct {
+	// Hash is the SHA-256 hash of the meta data blob.
+	Hash [sha256.Size]byte
+
+	// Size is the size of the meta data blob in bytes.
+	Size uint64
+
+	// URL is the optional http or https URL the blob can be fetched from.
+	URL string
+}
+
+// NewMetaBlobRef creates a reference to the given meta data blob.
+func NewMetaBlobRef(blob []byte, blobURL string) (*MetaBlobRef, error) {
+	ref := &MetaBlobRef{
+		Hash: sha256.Sum256(blob),
+		Size: uint64(len(blob)),
+		URL:  blobURL,
+	}
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return ref, nil
+}
+
+// Validate makes sure the meta blob reference is sane.
+func (r *MetaBlobRef) Validate() error {
+	if r.Size == 0 {
+		return ErrMetaDataMissing
+	}
+
+	if r.Size > MetaBlobMaxSizeBytes {
+		return ErrMetaBlobTooLarge
+	}
+
+	if r.URL != "" {
+		blobURL, err := url.Parse(r.URL)
+		isHTTP := blobURL != nil &&
+			(blobURL.Scheme == "http" || blobURL.Scheme == "https")
+		if err != nil || blobURL.Host == "" || !isHTTP {
+			return fmt.Errorf("meta blob URL must be an absolute " +
+				"http or https URL")
+		}
+	}
+
+	return nil
+}
+
+// VerifyBlob makes sure the given blob is the one committed to by the
+// reference.
+func (r *MetaBlobRef) VerifyBlob(blob []byte) error {
+	if uint64(len(blob)) != r.Size || sha256.Sum256(blob) != r.Hash {
+		return ErrMetaBlobMismatch
+	}
+
+	return nil
+}
+
+// MetaReveal returns the meta reveal of the MetaReference type that commits to
+// the referenced blob.
+func (r *MetaBlobRef) MetaReveal() (*MetaReveal, error) {
+	var b bytes.Buffer
+	if err := r.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return &MetaReveal{
+		Type: MetaReference,
+		Data: b.Bytes(),
+	}, nil
+}
+
+// encodeRecords returns the TLV records of the meta blob reference.
+func (r *MetaBlobRef) encodeRecords(blobURL *[]byte) []tlv.Record {
+	return []tlv.Record{
+		tlv.MakePrimitiveRecord(MetaBlobRefHashType, &r.Hash),
+		tlv.MakePrimitiveRecord(MetaBlobRefSizeType, &r.Size),
+		tlv.MakePrimitiveRecord(MetaBlobRefURLType, blobURL),
+	}
+}
+
+// Encode encodes the meta blob reference to the given writer.
+func (r *MetaBlobRef) Encode(w io.Writer) error {
+	blobURL := []byte(r.URL)
+	records := r.encodeRecords(&blobURL)
+
+	// The URL is optional, so we only include it if it is set.
+	if len(blobURL) == 0 {
+		records = records[:2]
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+	return stream.Encode(w)
+}
+
+// Decode decodes the meta blob reference from the given reader.
+func (r *MetaBlobRef) Decode(reader io.Reader) error {
+	var blobURL []byte
+	stream, err := tlv.NewStream(r.encodeRecords(&blobURL)...)
+	if err != nil {
+		return err
+	}
+	if err := stream.Decode(reader); err != nil {
+		return err
+	}
+
+	r.URL = string(blobURL)
+
+	return nil
+}
+
+// FetchMetaBlob fetches the blob of the given meta blob reference from its
+// URL and verifies it against the reference.
+func FetchMetaBlob(ctx context.Context, client *http.Client,
+	ref *MetaBlobRef) ([]byte, error) {
+
+	if ref.URL == "" {
+		return nil, fmt.Errorf("meta blob reference has no URL")
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, ref.URL, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch meta blob: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch meta blob: status %v",
+			resp.Status)
+	}
+
+	// We never read more than the referenced size plus one byte, which is
+	// enough to detect a blob that is too large.
+	blob, err := io.ReadAll(io.LimitReader(resp.Body, int64(ref.Size)+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read meta blob: %w", err)
+	}
+
+	if err := ref.VerifyBlob(blob); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
@@ -0,0 +1,99 @@
+package proof
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMetaBlobRefReveal tests that a meta blob reference survives a round trip
+// through a meta reveal, and that the reveal is validated.
+func TestMetaBlobRefReveal(t *testing.T) {
+	t.Parallel()
+
+	// The blob of a reference can be larger than meta data that is
+	// committed to directly.
+	blob := test.RandBytes(MetaDataMaxSizeBytes + 1)
+
+	for _, blobURL := range []string{"", "https://example.com/blob"} {
+		ref, err := NewMetaBlobRef(blob, blobURL)
+		require.NoError(t, err)
+		require.NoError(t, ref.VerifyBlob(blob))
+
+		reveal, err := ref.MetaReveal()
+		require.NoError(t, err)
+		require.Equal(t, MetaReference, reveal.Type)
+		require.NoError(t, reveal.Validate())
+
+		decodedRef, err := reveal.BlobRef()
+		require.NoError(t, err)
+		require.Equal(t, ref, decodedRef)
+	}
+
+	// Any other blob doesn't match the reference.
+	ref, err := NewMetaBlobRef(blob, "")
+	require.NoError(t, err)
+	require.ErrorIs(t, ref.VerifyBlob(blob[1:]), ErrMetaBlobMismatch)
+
+	otherBlob := append([]byte{}, blob...)
+	otherBlob[0] ^= 1
+	require.ErrorIs(t, ref.VerifyBlob(otherBlob), ErrMetaBlobMismatch)
+
+	// Empty blobs and invalid URLs can't be referenced.
+	_, err = NewMetaBlobRef(nil, "")
+	require.ErrorIs(t, err, ErrMetaDataMissing)
+
+	_, err = NewMetaBlobRef(blob, "ftp://example.com/blob")
+	require.ErrorContains(t, err, "http or https")
+
+	// A reveal of the reference type must contain a valid reference.
+	invalidReveal := &MetaReveal{
+		Type: MetaReference,
+		Data: []byte("not a reference"),
+	}
+	require.Error(t, invalidReveal.Validate())
+}
+
+// TestFetchMetaBlob tests that a meta data blob is only accepted from the URL
+// of its reference if it matches the reference.
+func TestFetchMetaBlob(t *testing.T) {
+	t.Parallel()
+
+	blob := test.RandBytes(1024)
+	servedBlobs := map[string][]byte{
+		"/blob":   blob,
+		"/other":  test.RandBytes(1024),
+		"/larger": append(append([]byte{}, blob...), 0x00),
+	}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(servedBlobs[r.URL.Path])
+		},
+	))
+	t.Cleanup(server.Close)
+
+	ref, err := NewMetaBlobRef(blob, server.URL+"/blob")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	fetchedBlob, err := FetchMetaBlob(ctx, server.Client(), ref)
+	require.NoError(t, err)
+	require.Equal(t, blob, fetchedBlob)
+
+	// A server that serves a different or larger blob is caught.
+	for _, path := range []string{"/other", "/larger"} {
+		ref.URL = server.URL + path
+		_, err = FetchMetaBlob(ctx, server.Client(), ref)
+		require.ErrorIs(t, err, ErrMetaBlobMismatch)
+	}
+
+	// Without a URL, the blob can't be fetched.
+	refNoURL, err := NewMetaBlobRef(blob, "")
+	require.NoError(t, err)
+	_, err = FetchMetaBlob(ctx, server.Client(), refNoURL)
+	require.ErrorContains(t, err, "no URL")
+}
@@ -1,8 +1,11 @@
 package proof
 
 import (
+	"encoding/hex"
+	"math"
 	"testing"
 
+	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +41,72 @@ func TestValidateMetaReveal(t *testing.T) {
 			Data: make([]byte, MetaDataMaxSizeBytes+1),
 		},
 		expectedErr: ErrMetaDataTooLarge,
+	}, {
+		name: "valid json",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"name":"Test coin","ticker":"TEST",` +
+				`"decimals":8,` +
+				`"issuer_url":"https://test.com",` +
+				`"extra":[1,2]}`),
+		},
+		expectedErr: nil,
+	}, {
+		name: "json not an object",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`["TEST"]`),
+		},
+		expectedErr: ErrInvalidJSONMeta,
+	}, {
+		name: "json null",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`null`),
+		},
+		expectedErr: ErrInvalidJSONMeta,
+	}, {
+		name: "json wrong field type",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"decimals":"8"}`),
+		},
+		expectedErr: ErrInvalidJSONMeta,
+	}, {
+		name: "json invalid ticker",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"ticker":"test"}`),
+		},
+		expectedErr: ErrInvalidJSONMeta,
+	}, {
+		name: "json too many decimals",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"decimals":19}`),
+		},
+		expectedErr: ErrInvalidJSONMeta,
+	}, {
+		name: "json invalid issuer url",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"issuer_url":"ftp://test.com"}`),
+		},
+		expectedErr: ErrInvalidJSONMeta,
+	}, {
+		name: "json invalid image hash",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"image_hash":"abcd"}`),
+		},
+		expectedErr: ErrInvalidJSONMeta,
+	}, {
+		name: "opaque invalid json",
+		reveal: &MetaReveal{
+			Type: MetaOpaque,
+			Data: []byte(`{"ticker":"test"}`),
+		},
+		expectedErr: nil,
 	}}
 
 	for _, tc := range testCases {
@@ -57,3 +126,136 @@ func TestValidateMetaReveal(t *testing.T) {
 		})
 	}
 }
+
+// TestJSONMetaReveal tests that structured asset metadata survives a round
+// trip through a JSON meta reveal.
+func TestJSONMetaReveal(t *testing.T) {
+	t.Parallel()
+
+	metadata := &AssetMetadata{
+		Name:      "Test coin",
+		Ticker:    "TEST",
+		Decimals:  2,
+		IssuerURL: "https://example.com/issuer",
+		ImageHash: hex.EncodeToString(test.RandBytes(32)),
+		MaxSupply: 21_000_000,
+		EmissionSchedule: EmissionSchedule{{
+			StartHeight: 100,
+			Amount:      10_000_000,
+		}, {
+			StartHeight: 200,
+			Amount:      5_000_000,
+		}},
+	}
+	reveal, err := NewJSONMetaReveal(metadata)
+	require.NoError(t, err)
+	require.Equal(t, MetaJson, reveal.Type)
+	require.NoError(t, reveal.Validate())
+
+	decoded, err := reveal.DecodeMetadata()
+	require.NoError(t, err)
+	require.Equal(t, metadata, decoded)
+
+	decimalDisplay, err := reveal.DecimalDisplay()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, decimalDisplay)
+
+	maxSupply, err := reveal.MaxSupply()
+	require.NoError(t, err)
+	require.EqualValues(t, 21_000_000, maxSupply)
+
+	schedule, err := reveal.EmissionSchedule()
+	require.NoError(t, err)
+	require.Equal(t, metadata.EmissionSchedule, schedule)
+
+	// Invalid metadata can't be turned into a meta reveal, and opaque meta
+	// data can't be decoded.
+	_, err = NewJSONMetaReveal(&AssetMetadata{Ticker: "TOOLONGTICKER"})
+	require.ErrorIs(t, err, ErrInvalidJSONMeta)
+
+	opaqueReveal := &MetaReveal{
+		Type: MetaOpaque,
+		Data: reveal.Data,
+	}
+	_, err = opaqueReveal.DecodeMetadata()
+	require.ErrorContains(t, err, "not JSON")
+
+	// Opaque meta data, or no meta data at all, always uses whole asset
+	// units for display.
+	var noReveal *MetaReveal
+	for _, m := range []*MetaReveal{opaqueReveal, noReveal} {
+		decimalDisplay, err := m.DecimalDisplay()
+		require.NoError(t, err)
+		require.Zero(t, decimalDisplay)
+
+		maxSupply, err := m.MaxSupply()
+		require.NoError(t, err)
+		require.Zero(t, maxSupply)
+
+		schedule, err := m.EmissionSchedule()
+		require.NoError(t, err)
+		require.Empty(t, schedule)
+	}
+}
+
+// TestEmissionSchedule tests the validation of emission schedules and that
+// issuances are only allowed within the unlocked supply.
+func TestEmissionSchedule(t *testing.T) {
+	t.Parallel()
+
+	schedule := EmissionSchedule{{
+		StartHeight: 100,
+		Amount:      1000,
+	}, {
+		StartHeight: 200,
+		Amount:      500,
+	}}
+	require.NoError(t, schedule.Validate())
+	require.EqualValues(t, 1500, schedule.Total())
+	require.Zero(t, schedule.Unlocked(99))
+	require.EqualValues(t, 1000, schedule.Unlocked(100))
+	require.EqualValues(t, 1000, schedule.Unlocked(199))
+	require.EqualValues(t, 1500, schedule.Unlocked(200))
+
+	// Nothing can be issued before the first epoch starts, and each
+	// issuance only gets what's left of the unlocked supply.
+	require.ErrorIs(
+		t, schedule.CheckIssuance(0, 1, 99),
+		ErrEmissionScheduleExceeded,
+	)
+	require.NoError(t, schedule.CheckIssuance(0, 1000, 150))
+	require.ErrorIs(
+		t, schedule.CheckIssuance(1000, 1, 150),
+		ErrEmissionScheduleExceeded,
+	)
+	require.NoError(t, schedule.CheckIssuance(1000, 500, 250))
+	require.ErrorIs(
+		t, schedule.CheckIssuance(2000, 1, 250),
+		ErrEmissionScheduleExceeded,
+	)
+
+	// An empty schedule doesn't restrict issuances.
+	require.NoError(t, EmissionSchedule(nil).CheckIssuance(
+		math.MaxUint64, math.MaxUint64, 0,
+	))
+
+	invalidSchedules := []EmissionSchedule{
+		{{StartHeight: 100, Amount: 1}, {StartHeight: 100, Amount: 1}},
+		{{StartHeight: 200, Amount: 1}, {StartHeight: 100, Amount: 1}},
+		{{StartHeight: 100, Amount: 0}},
+		{
+			{StartHeight: 100, Amount: math.MaxUint64},
+			{StartHeight: 200, Amount: 1},
+		},
+	}
+	for _, invalid := range invalidSchedules {
+		require.ErrorIs(t, invalid.Validate(), ErrInvalidJSONMeta)
+	}
+
+	// A schedule can't unlock more than the max supply.
+	_, err := NewJSONMetaReveal(&AssetMetadata{
+		MaxSupply:        1000,
+		EmissionSchedule: schedule,
+	})
+	require.ErrorIs(t, err, ErrInvalidJSONMeta)
+}
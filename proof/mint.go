@@ -149,7 +149,8 @@ func NewMintingBlobs(params *MintParams, headerVerifier HeaderVerifier,
 	}
 
 	proofs, err := committedProofs(
-		base, params.TaprootAssetRoot, anchorVerifier, opts,
+		base, params.TaprootAssetRoot, params.TapscriptSibling,
+		anchorVerifier, opts,
 	)
 	if err != nil {
 		return nil, err
@@ -214,6 +215,7 @@ func coreProof(params *BaseProofParams) (*Proof, error) {
 // committedProofs creates a map of proofs, keyed by the script key of each of
 // the assets committed to in the Taproot Asset root of the given params.
 func committedProofs(baseProof *Proof, tapTreeRoot *commitment.TapCommitment,
+	tapSibling *commitment.TapscriptPreimage,
 	groupAnchorVerifier GroupAnchorVerifier,
 	opts *mintingBlobOpts) (AssetProofs, error) {
 
@@ -240,13 +242,11 @@ func committedProofs(baseProof *Proof, tapTreeRoot *commitment.TapCommitment,
 		}
 
 		// With the merkle proof obtained, we can now set that in the
-		// main inclusion proof.
-		//
-		// NOTE: We don't add a TapSiblingPreimage here since we assume
-		// that this minting output ONLY commits to the Taproot Asset
-		// commitment.
+		// main inclusion proof, along with the tapscript sibling of
+		// the Taproot Asset commitment, if the minting output has one.
 		assetProof.InclusionProof.CommitmentProof = &CommitmentProof{
-			Proof: *assetMerkleProof,
+			Proof:              *assetMerkleProof,
+			TapSiblingPreimage: tapSibling,
 		}
 
 		scriptKey := asset.ToSerialized(newAsset.ScriptKey.PubKey)
@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -20,6 +21,24 @@ import (
 func TestNewMintingBlobs(t *testing.T) {
 	t.Parallel()
 
+	t.Run("no tapscript sibling", func(t *testing.T) {
+		testNewMintingBlobs(t, nil)
+	})
+
+	// The minting output can also commit to a tapscript sibling next to
+	// the Taproot Asset commitment.
+	t.Run("tapscript sibling", func(t *testing.T) {
+		siblingLeaf := test.ScriptHashLock(t, []byte("sibling"))
+		tapSibling := commitment.NewPreimageFromLeaf(siblingLeaf)
+		testNewMintingBlobs(t, tapSibling)
+	})
+}
+
+// testNewMintingBlobs tests that NewMintingBlobs creates a valid proof for a
+// minting output with the given optional tapscript sibling.
+func testNewMintingBlobs(t *testing.T,
+	tapSibling *commitment.TapscriptPreimage) {
+
 	// First, we'll create a fake, but legit looking set of minting params
 	// to generate a proof with.
 	genesisPrivKey := test.RandPrivKey(t)
@@ -53,8 +72,14 @@ func TestNewMintingBlobs(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	var siblingHash *chainhash.Hash
+	if tapSibling != nil {
+		siblingHash, err = tapSibling.TapHash()
+		require.NoError(t, err)
+	}
+
 	internalKey := test.SchnorrPubKey(t, genesisPrivKey)
-	tapscriptRoot := tapCommitment.TapscriptRoot(nil)
+	tapscriptRoot := tapCommitment.TapscriptRoot(siblingHash)
 	taprootKey := txscript.ComputeTaprootOutputKey(
 		internalKey, tapscriptRoot[:],
 	)
@@ -109,6 +134,7 @@ func TestNewMintingBlobs(t *testing.T) {
 			OutputIndex:      0,
 			InternalKey:      internalKey,
 			TaprootAssetRoot: tapCommitment,
+			TapscriptSibling: tapSibling,
 			ExclusionProofs: []TaprootProof{{
 				OutputIndex: 1,
 				InternalKey: changeInternalKey,
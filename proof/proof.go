@@ -105,6 +105,10 @@ var (
 	// invalid.
 	ErrProofInvalid = errors.New("proof is invalid")
 
+	// ErrChallengeWitnessMissing is the error that's returned when an
+	// ownership proof is verified that doesn't carry a challenge witness.
+	ErrChallengeWitnessMissing = errors.New("challenge witness missing")
+
 	// RegtestTestVectorName is the name of the test vector file that is
 	// generated/updated by an actual integration test run on regtest. It is
 	// exported here, so we can use it in the integration tests.
@@ -204,6 +208,12 @@ func CheckMaxFileSize(blob Blob) error {
 // of a re-org.
 type UpdateCallback func([]*Proof) error
 
+// ReOrgCallback is a callback that is called when the anchor transaction of
+// watched proofs was re-organized out of the chain. The transaction is
+// re-broadcast before the callback is called, and the result of the broadcast
+// is passed along.
+type ReOrgCallback func(anchorTx *wire.MsgTx, broadcastErr error) error
+
 // Watcher is used to watch new proofs for their anchor transaction to be
 // confirmed safely with a minimum number of confirmations.
 type Watcher interface {
@@ -211,6 +221,13 @@ type Watcher interface {
 	// transaction to be watched until it reaches a safe confirmation depth.
 	WatchProofs(newProofs []*Proof, onProofUpdate UpdateCallback) error
 
+	// WatchProofsWithReOrg is the same as WatchProofs, but additionally
+	// calls the given re-org callback whenever the anchor transaction of
+	// the proofs was re-organized out of the chain, before it confirms
+	// again.
+	WatchProofsWithReOrg(newProofs []*Proof, onReOrg ReOrgCallback,
+		onProofUpdate UpdateCallback) error
+
 	// MaybeWatch inspects the given proof file for any proofs that are not
 	// yet buried sufficiently deep and adds them to the re-org watcher.
 	MaybeWatch(file *File, onProofUpdate UpdateCallback) error
@@ -0,0 +1,115 @@
+package proof
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+var (
+	// ErrDuplicateReserveHolding is returned if a reserve attestation
+	// contains the same holding more than once, which would count its
+	// amount multiple times.
+	ErrDuplicateReserveHolding = errors.New("duplicate reserve holding")
+
+	// reserveChallengeTag is the tag that is hashed into the challenge of
+	// a reserve attestation, so signatures for it can't be mistaken for
+	// ownership proofs of any other protocol.
+	reserveChallengeTag = []byte("taproot-assets:reserve-attestation")
+)
+
+// ReserveAttestation binds a set of unspent asset holdings to a message and a
+// timestamp. Each holding is proven with an ownership proof, which is the last
+// transition proof of the holding with a challenge witness that commits to
+// the message and the timestamp. A third party can verify the attestation with
+// the published proofs of the holdings, but still needs to check that the
+// anchor outpoints of the holdings are unspent on chain.
+type ReserveAttestation struct {
+	// Message is the message the attestation is bound to, for example the
+	// name of an auditor along with a nonce chosen by them.
+	Message []byte
+
+	// Timestamp is the time the attestation was created at. Only the
+	// seconds are committed to.
+	Timestamp time.Time
+
+	// Holdings is the set of ownership proofs, one for each holding.
+	Holdings []*Proof
+}
+
+// ReserveChallenge returns the challenge a reserve attestation with the given
+// message and timestamp commits to:
+//
+//	sha256(tag || timestamp_unix || message)
+func ReserveChallenge(message []byte, timestamp time.Time) [32]byte {
+	var unixTime [8]byte
+	binary.BigEndian.PutUint64(unixTime[:], uint64(timestamp.Unix()))
+
+	h := sha256.New()
+	_, _ = h.Write(reserveChallengeTag)
+	_, _ = h.Write(unixTime[:])
+	_, _ = h.Write(message)
+
+	return fn.ToArray[[32]byte](h.Sum(nil))
+}
+
+// Challenge returns the challenge the ownership proofs of all holdings of the
+// attestation commit to.
+func (r *ReserveAttestation) Challenge() [32]byte {
+	return ReserveChallenge(r.Message, r.Timestamp)
+}
+
+// Verify verifies the ownership proof of every holding against the challenge
+// of the attestation and returns the snapshot of each holding, in the order
+// of the holdings.
+func (r *ReserveAttestation) Verify(ctx context.Context,
+	headerVerifier HeaderVerifier,
+	groupVerifier GroupVerifier) ([]*AssetSnapshot, error) {
+
+	challenge := r.Challenge()
+
+	// The same asset can only be held once at the same outpoint with the
+	// same script key, any other occurrence would inflate the reserves.
+	type holdingKey struct {
+		assetID   asset.ID
+		scriptKey asset.SerializedKey
+		outPoint  wire.OutPoint
+	}
+	holdings := make(map[holdingKey]struct{}, len(r.Holdings))
+
+	snapshots := make([]*AssetSnapshot, 0, len(r.Holdings))
+	for idx, holding := range r.Holdings {
+		snapshot, err := holding.VerifyChallenge(
+			ctx, challenge, headerVerifier, groupVerifier,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ownership proof for "+
+				"holding %d: %w", idx, err)
+		}
+
+		key := holdingKey{
+			assetID: snapshot.Asset.ID(),
+			scriptKey: asset.ToSerialized(
+				snapshot.Asset.ScriptKey.PubKey,
+			),
+			outPoint: snapshot.OutPoint,
+		}
+		if _, ok := holdings[key]; ok {
+			return nil, fmt.Errorf("%w: asset_id=%v, outpoint=%v",
+				ErrDuplicateReserveHolding, key.assetID,
+				key.outPoint)
+		}
+		holdings[key] = struct{}{}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
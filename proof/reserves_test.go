@@ -0,0 +1,121 @@
+package proof
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTxValidator is a tapscript.TxValidator that runs the VM.
+type mockTxValidator struct{}
+
+// Execute creates and runs an instance of the Taproot Asset script V0 VM.
+func (m *mockTxValidator) Execute(newAsset *asset.Asset,
+	splitAssets []*commitment.SplitAsset,
+	prevAssets commitment.InputSet) error {
+
+	engine, err := vm.New(newAsset, splitAssets, prevAssets)
+	if err != nil {
+		return err
+	}
+
+	return engine.Execute()
+}
+
+// TestReserveAttestation tests that the ownership proofs of a reserve
+// attestation must commit to its message and timestamp, and that a holding
+// can't be counted twice.
+func TestReserveAttestation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	amt := uint64(1000)
+	genesisProof, privKey := genRandomGenesisWithProof(
+		t, asset.Normal, &amt, nil, true, nil, nil, asset.V0,
+	)
+
+	// signOwnership returns a copy of the genesis proof with a challenge
+	// witness for the given challenge.
+	signOwnership := func(challenge [32]byte) *Proof {
+		vPkt := tappsbt.OwnershipProofPacket(
+			genesisProof.Asset.Copy(), challenge,
+			&address.RegressionNetTap,
+		)
+		err := tapscript.SignVirtualTransaction(
+			vPkt, tapscript.NewMockSigner(privKey),
+			&mockTxValidator{},
+		)
+		require.NoError(t, err)
+
+		ownershipProof := genesisProof
+		ownershipProof.ChallengeWitness = vPkt.Outputs[0].Asset.
+			PrevWitnesses[0].TxWitness
+
+		return &ownershipProof
+	}
+
+	timestamp := time.Unix(1_700_000_000, 0)
+	attestation := &ReserveAttestation{
+		Message:   []byte("audit 2023-11-14"),
+		Timestamp: timestamp,
+	}
+	attestation.Holdings = []*Proof{
+		signOwnership(attestation.Challenge()),
+	}
+
+	snapshots, err := attestation.Verify(
+		ctx, MockHeaderVerifier, MockGroupVerifier,
+	)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, amt, snapshots[0].Asset.Amount)
+
+	// Only the seconds of the timestamp are committed to.
+	require.Equal(
+		t, attestation.Challenge(),
+		ReserveChallenge(attestation.Message, timestamp.Add(
+			time.Millisecond,
+		)),
+	)
+
+	// The ownership proof can't be reused for a different message or
+	// timestamp.
+	replayed := &ReserveAttestation{
+		Message:   []byte("audit 2023-11-15"),
+		Timestamp: timestamp,
+		Holdings:  attestation.Holdings,
+	}
+	_, err = replayed.Verify(ctx, MockHeaderVerifier, MockGroupVerifier)
+	require.Error(t, err)
+
+	replayed.Message = attestation.Message
+	replayed.Timestamp = timestamp.Add(time.Second)
+	_, err = replayed.Verify(ctx, MockHeaderVerifier, MockGroupVerifier)
+	require.Error(t, err)
+
+	// A plain ownership proof without a challenge isn't valid either.
+	replayed.Timestamp = timestamp
+	replayed.Holdings = []*Proof{signOwnership([32]byte{})}
+	_, err = replayed.Verify(ctx, MockHeaderVerifier, MockGroupVerifier)
+	require.Error(t, err)
+
+	// A proof without a challenge witness is rejected.
+	replayed.Holdings = []*Proof{&genesisProof}
+	_, err = replayed.Verify(ctx, MockHeaderVerifier, MockGroupVerifier)
+	require.ErrorIs(t, err, ErrChallengeWitnessMissing)
+
+	// The same holding can't be attested twice.
+	replayed.Holdings = append(
+		attestation.Holdings, attestation.Holdings[0],
+	)
+	_, err = replayed.Verify(ctx, MockHeaderVerifier, MockGroupVerifier)
+	require.ErrorIs(t, err, ErrDuplicateReserveHolding)
+}
@@ -116,6 +116,21 @@ func (p TxMerkleProof) Verify(tx *wire.MsgTx, merkleRoot chainhash.Hash) bool {
 	return current == merkleRoot
 }
 
+// TxIndex returns the index of the proved transaction within its block, which
+// is encoded in the directions of the proof.
+func (p TxMerkleProof) TxIndex() uint32 {
+	var txIndex uint32
+	for level, hasRightSibling := range p.Bits {
+		// Without a right sibling, the node on the path is the right
+		// child, so its index at this level is odd.
+		if !hasRightSibling {
+			txIndex |= 1 << level
+		}
+	}
+
+	return txIndex
+}
+
 // Encode encodes a TxMerkleProof into `w`.
 func (p TxMerkleProof) Encode(w io.Writer) error {
 	numNodes := uint64(len(p.Nodes))
@@ -97,6 +97,7 @@ func TestTxMerkleProofEncoding(t *testing.T) {
 			require.True(
 				t, proof.Verify(tx, block.Header.MerkleRoot),
 			)
+			require.EqualValues(t, i, proof.TxIndex())
 
 			var buf bytes.Buffer
 			require.NoError(t, proof.Encode(&buf))
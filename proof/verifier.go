@@ -249,14 +249,14 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 
 // verifyChallengeWitness verifies the challenge witness by constructing a
 // well-defined 1-in-1-out packet and verifying the witness is valid for that
-// virtual transaction.
-func (p *Proof) verifyChallengeWitness() (bool, error) {
+// virtual transaction and the given challenge.
+func (p *Proof) verifyChallengeWitness(challenge [32]byte) (bool, error) {
 	// The challenge witness packet always has one input and one output,
 	// independent of how the asset was created. The chain params are only
 	// needed when encoding/decoding a vPkt, so it doesn't matter what
 	// network we choose as we only need the packet to get the witness.
 	vPkt := tappsbt.OwnershipProofPacket(
-		p.Asset.Copy(), &address.MainNetTap,
+		p.Asset.Copy(), challenge, &address.MainNetTap,
 	)
 	vIn := vPkt.Inputs[0]
 	vOut := vPkt.Outputs[0]
@@ -393,6 +393,30 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 	headerVerifier HeaderVerifier,
 	groupVerifier GroupVerifier) (*AssetSnapshot, error) {
 
+	return p.verify(ctx, prev, [32]byte{}, headerVerifier, groupVerifier)
+}
+
+// VerifyChallenge verifies an ownership proof, which carries a challenge
+// witness instead of referencing a previous asset snapshot. The challenge
+// witness must be valid for the given challenge, all other checks are the
+// same as in Verify.
+func (p *Proof) VerifyChallenge(ctx context.Context, challenge [32]byte,
+	headerVerifier HeaderVerifier,
+	groupVerifier GroupVerifier) (*AssetSnapshot, error) {
+
+	if p.ChallengeWitness == nil {
+		return nil, ErrChallengeWitnessMissing
+	}
+
+	return p.verify(ctx, nil, challenge, headerVerifier, groupVerifier)
+}
+
+// verify verifies the proof as described in Verify. A challenge witness is
+// verified against the given challenge.
+func (p *Proof) verify(ctx context.Context, prev *AssetSnapshot,
+	challenge [32]byte, headerVerifier HeaderVerifier,
+	groupVerifier GroupVerifier) (*AssetSnapshot, error) {
+
 	// 0. Check only for the proof version.
 	if p.IsUnknownVersion() {
 		return nil, ErrUnknownVersion
@@ -511,7 +535,7 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 	var splitAsset bool
 	switch {
 	case prev == nil && p.ChallengeWitness != nil:
-		splitAsset, err = p.verifyChallengeWitness()
+		splitAsset, err = p.verifyChallengeWitness(challenge)
 
 	default:
 		splitAsset, err = p.verifyAssetStateTransition(
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -17,7 +18,11 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -41,7 +46,11 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/signal"
 	"google.golang.org/grpc"
 )
@@ -60,6 +69,21 @@ const (
 	// AssetBurnConfirmationText is the text that needs to be set on the
 	// RPC to confirm an asset burn.
 	AssetBurnConfirmationText = "assets will be destroyed"
+
+	// metaBlobFetchTimeout is the maximum amount of time we wait for a meta
+	// data blob to be fetched from the URL of its reference.
+	metaBlobFetchTimeout = time.Minute
+
+	// maxLabelLength is the maximum length of a user-defined label of a
+	// transfer or receive.
+	maxLabelLength = 500
+)
+
+var (
+	// anchorSpendCheckTimeout is the maximum amount of time we wait for
+	// the chain backend to report a spend of the anchor output of an
+	// imported asset.
+	anchorSpendCheckTimeout = 10 * time.Second
 )
 
 // cacheableTimestamp is a wrapper around a uint32 that can be used as a value
@@ -316,6 +340,32 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 func (r *rpcServer) MintAsset(ctx context.Context,
 	req *mintrpc.MintAssetRequest) (*mintrpc.MintAssetResponse, error) {
 
+	seedling, err := r.unmarshalSeedling(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingBatch, err := r.queueSeedling(ctx, seedling)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcBatch, err := marshalMintingBatch(pendingBatch, req.ShortResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.MintAssetResponse{
+		PendingBatch: rpcBatch,
+	}, nil
+}
+
+// unmarshalSeedling parses the mint request into a seedling. A meta data blob
+// that is committed to by reference is stored, unless this is a dry run.
+func (r *rpcServer) unmarshalSeedling(ctx context.Context,
+	req *mintrpc.MintAssetRequest, dryRun bool) (*tapgarden.Seedling,
+	error) {
+
 	if req.Asset == nil {
 		return nil, fmt.Errorf("asset cannot be nil")
 	}
@@ -344,16 +394,80 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 	}
 
 	seedling := &tapgarden.Seedling{
-		AssetVersion:   assetVersion,
-		AssetType:      asset.Type(req.Asset.AssetType),
-		AssetName:      req.Asset.Name,
-		Amount:         req.Asset.Amount,
-		EnableEmission: req.EnableEmission,
+		AssetVersion:        assetVersion,
+		AssetType:           asset.Type(req.Asset.AssetType),
+		AssetName:           req.Asset.Name,
+		Amount:              req.Asset.Amount,
+		DecimalDisplay:      req.Asset.DecimalDisplay,
+		MaxSupply:           req.Asset.MaxSupply,
+		EnableEmission:      req.EnableEmission,
+		ExternalGroupSigner: req.Asset.ExternalGroupSigner,
+		VanityPrefix:        req.Asset.VanityPrefix,
+		EmissionSchedule: unmarshalEmissionSchedule(
+			req.Asset.EmissionSchedule,
+		),
+	}
+
+	// An externally held key for a new group must be specified by the
+	// caller, as it can't be derived by the backing lnd node.
+	if req.Asset.GroupInternalKey != nil {
+		groupInternalKey, err := UnmarshalKeyDescriptor(
+			req.Asset.GroupInternalKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group internal key: %w",
+				err)
+		}
+
+		seedling.GroupInternalKey = &groupInternalKey
+	}
+
+	// The group key may commit to a multisig script that requires the
+	// signatures of multiple external signers.
+	if req.Asset.GroupMultisig != nil {
+		multiSig, err := unmarshalGroupMultiSig(req.Asset.GroupMultisig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group multisig: %w",
+				err)
+		}
+
+		seedling.GroupMultiSig = multiSig
+	}
+
+	// A delegate key of an existing group can issue into the group in
+	// place of the group key.
+	if req.Asset.DelegateKey != nil {
+		delegateKey, err := UnmarshalKeyDescriptor(
+			req.Asset.DelegateKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delegate key: %w", err)
+		}
+
+		seedling.DelegateKey = &delegateKey
+	}
+
+	// The asset may be minted to a script key of another party, which
+	// must be fully specified so the party can spend it.
+	if req.Asset.ScriptKey != nil {
+		scriptKey, err := UnmarshalScriptKey(req.Asset.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key: %w", err)
+		}
+
+		if scriptKey.TweakedScriptKey == nil {
+			return nil, fmt.Errorf("script key must include the " +
+				"raw key")
+		}
+
+		seedling.ScriptKey = scriptKey
 	}
 
 	rpcsLog.Infof("[MintAsset]: version=%v, type=%v, name=%v, amt=%v, "+
-		"issuance=%v", seedling.AssetVersion, seedling.AssetType,
-		seedling.AssetName, seedling.Amount, seedling.EnableEmission)
+		"decimal_display=%v, max_supply=%v, issuance=%v",
+		seedling.AssetVersion, seedling.AssetType, seedling.AssetName,
+		seedling.Amount, seedling.DecimalDisplay, seedling.MaxSupply,
+		seedling.EnableEmission)
 
 	// If a group key is provided, parse the provided group public key
 	// before creating the asset seedling.
@@ -389,7 +503,31 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		seedling.GroupAnchor = &req.Asset.GroupAnchor
 	}
 
-	if req.Asset.AssetMeta != nil {
+	switch {
+	case req.Asset.MetaBlobUrl != "" && !req.Asset.MetaByReference:
+		return nil, fmt.Errorf("meta blob URL requires meta by " +
+			"reference")
+
+	// If the meta data is committed to by reference, the data is stored
+	// as a blob and the asset only commits to a reference to it.
+	case req.Asset.MetaByReference:
+		if req.Asset.AssetMeta == nil {
+			return nil, fmt.Errorf("meta by reference requires " +
+				"asset meta")
+		}
+
+		metaReveal, err := r.storeMetaBlob(
+			ctx, req.Asset.AssetMeta.Data, req.Asset.MetaBlobUrl,
+			dryRun,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		seedling.Meta = metaReveal
+	}
+
+	if req.Asset.AssetMeta != nil && !req.Asset.MetaByReference {
 		// Ensure that the meta field is within bounds.
 		switch {
 		case req.Asset.AssetMeta.Type < 0:
@@ -413,6 +551,102 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		}
 	}
 
+	// A tapscript sibling to commit to in the genesis output can either be
+	// specified as a serialized preimage or as a full tree, but not both.
+	switch {
+	case len(req.TapscriptSibling) != 0 && req.FullTree != nil:
+		return nil, fmt.Errorf("cannot specify both a tapscript " +
+			"sibling and a full tree")
+
+	case len(req.TapscriptSibling) != 0:
+		sibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+			req.TapscriptSibling,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tapscript sibling: %w",
+				err)
+		}
+
+		seedling.TapscriptSibling = sibling
+
+	case req.FullTree != nil:
+		seedling.TapscriptSibling, err = UnmarshalTapscriptFullTree(
+			req.FullTree,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tapscript tree: %w",
+				err)
+		}
+	}
+
+	return seedling, nil
+}
+
+// ValidateMint runs all validation of a set of assets to mint as if they were
+// added to the pending batch, without persisting anything, and returns the IDs
+// the assets would be minted with.
+func (r *rpcServer) ValidateMint(ctx context.Context,
+	req *mintrpc.ValidateMintRequest) (*mintrpc.ValidateMintResponse,
+	error) {
+
+	if len(req.Assets) == 0 {
+		return nil, fmt.Errorf("at least one asset must be specified")
+	}
+
+	params := tapgarden.ValidateMintParams{
+		Seedlings:         make([]*tapgarden.Seedling, len(req.Assets)),
+		AnchorOutputIndex: req.AnchorOutputIndex,
+	}
+	for idx, assetReq := range req.Assets {
+		seedling, err := r.unmarshalSeedling(ctx, assetReq, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset %d: %w", idx, err)
+		}
+
+		params.Seedlings[idx] = seedling
+	}
+
+	if req.GenesisOutpoint != "" {
+		genesisPoint, err := UnmarshalOutpoint(req.GenesisOutpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis outpoint: %w",
+				err)
+		}
+
+		params.GenesisPoint = genesisPoint
+	}
+
+	validation, err := r.cfg.AssetMinter.ValidateMint(params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate mint: %w", err)
+	}
+
+	resp := &mintrpc.ValidateMintResponse{
+		Assets: make(
+			[]*mintrpc.ValidatedAsset, len(validation.Sprouts),
+		),
+		ProvisionalAssetIds: validation.ProvisionalIDs,
+	}
+	for idx, sprout := range validation.Sprouts {
+		assetID := sprout.ID()
+		resp.Assets[idx] = &mintrpc.ValidatedAsset{
+			Name:    sprout.Tag,
+			AssetId: assetID[:],
+		}
+
+		if meta := validation.Metas[idx]; meta != nil {
+			resp.Assets[idx].AssetMeta = marshalAssetMeta(meta)
+		}
+	}
+
+	return resp, nil
+}
+
+// queueSeedling queues a new seedling with the minter and returns the pending
+// batch the seedling was added to.
+func (r *rpcServer) queueSeedling(ctx context.Context,
+	seedling *tapgarden.Seedling) (*tapgarden.MintingBatch, error) {
+
 	updates, err := r.cfg.AssetMinter.QueueNewSeedling(seedling)
 	if err != nil {
 		return nil, fmt.Errorf("unable to mint new asset: %w", err)
@@ -430,16 +664,7 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 				update.Error)
 		}
 
-		rpcBatch, err := marshalMintingBatch(
-			update.PendingBatch, req.ShortResponse,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		return &mintrpc.MintAssetResponse{
-			PendingBatch: rpcBatch,
-		}, nil
+		return update.PendingBatch, nil
 	}
 }
 
@@ -448,7 +673,9 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 	req *mintrpc.FinalizeBatchRequest) (*mintrpc.FinalizeBatchResponse,
 	error) {
 
-	batch, err := r.cfg.AssetMinter.FinalizeBatch()
+	batch, err := r.cfg.AssetMinter.FinalizeBatch(tapgarden.FinalizeParams{
+		ExternalFunding: req.ExternalFunding,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to finalize batch: %w", err)
 	}
@@ -463,8 +690,75 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 		return nil, err
 	}
 
-	return &mintrpc.FinalizeBatchResponse{
+	resp := &mintrpc.FinalizeBatchResponse{
+		Batch: rpcBatch,
+	}
+
+	if !batch.ExternalFunding {
+		return resp, nil
+	}
+
+	// The batch is waiting for the external wallet to fund its genesis
+	// PSBT, so we hand out the unfunded template right away.
+	reqs, err := r.cfg.AssetMinter.GenesisPsbtRequests()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch genesis psbt: %w", err)
+	}
+
+	for _, psbtReq := range reqs {
+		if !psbtReq.BatchKey.IsEqual(batch.BatchKey.PubKey) {
+			continue
+		}
+
+		resp.GenesisPsbt, err = serializePsbt(psbtReq.Packet)
+		if err != nil {
+			return nil, err
+		}
+
+		break
+	}
+
+	return resp, nil
+}
+
+// PreviewBatch estimates the size and fee of the minting transaction of the
+// current pending batch without finalizing it.
+func (r *rpcServer) PreviewBatch(_ context.Context,
+	req *mintrpc.PreviewBatchRequest) (*mintrpc.PreviewBatchResponse,
+	error) {
+
+	var feeRate *chainfee.SatPerKWeight
+	if req.SatPerVbyte != 0 {
+		rate := chainfee.SatPerKVByte(
+			req.SatPerVbyte * 1000,
+		).FeePerKWeight()
+		feeRate = &rate
+	}
+
+	preview, err := r.cfg.AssetMinter.PreviewBatch(feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to preview batch: %w", err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(preview.Batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.PreviewBatchResponse{
 		Batch: rpcBatch,
+		SatPerVbyte: uint64(
+			preview.FeeRate.FeePerKVByte() / 1000,
+		),
+		EstimatedVsize:   preview.EstimatedVSize(),
+		EstimatedFeeSats: int64(preview.EstimatedFee),
+		AnchorOutput: &mintrpc.PreviewAnchorOutput{
+			AmtSats: int64(preview.AnchorOutputValue),
+			InternalKey: preview.InternalKey.
+				SerializeCompressed(),
+			NumAssets:    uint32(preview.NumAssets),
+			NumNewGroups: uint32(preview.NumNewGroups),
+		},
 	}, nil
 }
 
@@ -488,3575 +782,7325 @@ func (r *rpcServer) CancelBatch(_ context.Context,
 	}, nil
 }
 
-// ListBatches lists the set of batches submitted for minting, including pending
-// and cancelled batches.
-func (r *rpcServer) ListBatches(_ context.Context,
-	req *mintrpc.ListBatchRequest) (*mintrpc.ListBatchResponse, error) {
-
-	var (
-		batchKey *btcec.PublicKey
-		err      error
-	)
-
-	switch {
-	case len(req.GetBatchKey()) > 0 && len(req.GetBatchKeyStr()) > 0:
-		return nil, fmt.Errorf("cannot specify both batch_key and " +
-			"batch_key_string")
-
-	case len(req.GetBatchKey()) > 0:
-		batchKey, err = btcec.ParsePubKey(req.GetBatchKey())
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key: %w", err)
-		}
-
-	case len(req.GetBatchKeyStr()) > 0:
-		batchKeyBytes, err := hex.DecodeString(req.GetBatchKeyStr())
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key string: %w",
-				err)
-		}
+// CancelSeedling attempts to remove a single asset from the current pending
+// batch.
+func (r *rpcServer) CancelSeedling(_ context.Context,
+	req *mintrpc.CancelSeedlingRequest) (*mintrpc.CancelSeedlingResponse,
+	error) {
 
-		batchKey, err = btcec.ParsePubKey(batchKeyBytes)
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key: %w", err)
-		}
+	if req.AssetName == "" {
+		return nil, fmt.Errorf("asset name must be set")
 	}
 
-	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
+	batch, err := r.cfg.AssetMinter.CancelSeedling(req.AssetName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list batches: %w", err)
+		return nil, fmt.Errorf("unable to cancel seedling: %w", err)
 	}
 
-	rpcBatches, err := fn.MapErr(
-		batches,
-		func(b *tapgarden.MintingBatch) (*mintrpc.MintingBatch, error) {
-			return marshalMintingBatch(b, false)
-		},
-	)
+	// If the seedling was the last one, the batch was cancelled and there
+	// is no pending batch to return.
+	if batch == nil {
+		return &mintrpc.CancelSeedlingResponse{}, nil
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	return &mintrpc.ListBatchResponse{
-		Batches: rpcBatches,
+	return &mintrpc.CancelSeedlingResponse{
+		PendingBatch: rpcBatch,
 	}, nil
 }
 
-// checkBalanceOverflow ensures that the new asset amount will not overflow
-// the max allowed asset (or asset group) balance.
-func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
-	assetID *asset.ID, groupPubKey *btcec.PublicKey,
-	newAmount uint64) error {
-
-	if assetID != nil && groupPubKey != nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be set")
-	}
+// GetBatchSchedule returns the schedule that governs when the pending batch is
+// finalized automatically.
+func (r *rpcServer) GetBatchSchedule(_ context.Context,
+	_ *mintrpc.GetBatchScheduleRequest) (*mintrpc.GetBatchScheduleResponse,
+	error) {
 
-	if assetID == nil && groupPubKey == nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be nil")
+	schedule, err := r.cfg.AssetMinter.BatchSchedule()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get batch schedule: %w", err)
 	}
 
-	var balance uint64
-
-	switch {
-	case assetID != nil:
-		// Retrieve the current asset balance.
-		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
-			ctx, assetID,
-		)
-		if err != nil {
-			return fmt.Errorf("unable to query asset balance: %w",
-				err)
-		}
+	return &mintrpc.GetBatchScheduleResponse{
+		Schedule: marshalBatchSchedule(schedule),
+	}, nil
+}
 
-		// There should only be one balance entry per asset.
-		for _, balanceEntry := range balances {
-			balance = balanceEntry.Balance
-			break
-		}
+// UpdateBatchSchedule replaces the schedule that governs when the pending batch
+// is finalized automatically.
+func (r *rpcServer) UpdateBatchSchedule(_ context.Context,
+	req *mintrpc.UpdateBatchScheduleRequest) (
+	*mintrpc.UpdateBatchScheduleResponse, error) {
 
-	case groupPubKey != nil:
-		// Retrieve the current balance of the group.
-		balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
-			ctx, groupPubKey,
-		)
-		if err != nil {
-			return fmt.Errorf("unable to query group balance: %w",
-				err)
+	// An unset schedule disables automatic finalization altogether.
+	var schedule tapgarden.BatchSchedule
+	if req.Schedule != nil {
+		maxSeconds := uint64(math.MaxInt64 / int64(time.Second))
+		if req.Schedule.IntervalSeconds > maxSeconds {
+			return nil, fmt.Errorf("interval too large")
 		}
 
-		// There should only be one balance entry per group.
-		for _, balanceEntry := range balances {
-			balance = balanceEntry.Balance
-			break
+		schedule = tapgarden.BatchSchedule{
+			Interval: time.Duration(req.Schedule.IntervalSeconds) *
+				time.Second,
+			MaxSeedlings: req.Schedule.MaxSeedlings,
+			MaxMetaSize:  req.Schedule.MaxMetaSize,
 		}
 	}
 
-	// Check for overflow.
-	err := mssmt.CheckSumOverflowUint64(balance, newAmount)
+	newSchedule, err := r.cfg.AssetMinter.UpdateBatchSchedule(schedule)
 	if err != nil {
-		return fmt.Errorf("new asset amount would overflow "+
-			"asset balance: %w", err)
+		return nil, fmt.Errorf("unable to update batch schedule: %w",
+			err)
 	}
 
-	return nil
+	return &mintrpc.UpdateBatchScheduleResponse{
+		Schedule: marshalBatchSchedule(newSchedule),
+	}, nil
 }
 
-// ListAssets lists the set of assets owned by the target daemon.
-func (r *rpcServer) ListAssets(ctx context.Context,
-	req *taprpc.ListAssetRequest) (*taprpc.ListAssetResponse, error) {
+// marshalBatchSchedule converts a batch schedule into its RPC counterpart.
+func marshalBatchSchedule(
+	schedule *tapgarden.BatchSchedule) *mintrpc.BatchSchedule {
 
-	switch {
-	case req.IncludeSpent && req.IncludeLeased:
-		return nil, fmt.Errorf("cannot specify both include_spent " +
-			"and include_leased")
+	return &mintrpc.BatchSchedule{
+		IntervalSeconds: uint64(schedule.Interval / time.Second),
+		MaxSeedlings:    schedule.MaxSeedlings,
+		MaxMetaSize:     schedule.MaxMetaSize,
 	}
+}
 
-	rpcAssets, err := r.fetchRpcAssets(
-		ctx, req.WithWitness, req.IncludeSpent, req.IncludeLeased,
-	)
+// ListGroupWitnessRequests lists the signing requests for the group witnesses
+// of assets whose group key is held by an external signer.
+func (r *rpcServer) ListGroupWitnessRequests(_ context.Context,
+	_ *mintrpc.ListGroupWitnessRequestsRequest) (
+	*mintrpc.ListGroupWitnessRequestsResponse, error) {
+
+	reqs, err := r.cfg.AssetMinter.GroupWitnessRequests()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to list group witness "+
+			"requests: %w", err)
 	}
 
-	return &taprpc.ListAssetResponse{
-		Assets: rpcAssets,
+	rpcReqs := make([]*mintrpc.GroupWitnessRequest, 0, len(reqs))
+	for _, req := range reqs {
+		rpcReq, err := marshalGroupWitnessRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcReqs = append(rpcReqs, rpcReq)
+	}
+
+	return &mintrpc.ListGroupWitnessRequestsResponse{
+		Requests: rpcReqs,
 	}, nil
 }
 
-func (r *rpcServer) fetchRpcAssets(ctx context.Context, withWitness,
-	includeSpent, includeLeased bool) ([]*taprpc.Asset, error) {
+// SubmitGroupWitness submits the signature of an external signer for a
+// pending group witness request.
+func (r *rpcServer) SubmitGroupWitness(_ context.Context,
+	req *mintrpc.SubmitGroupWitnessRequest) (
+	*mintrpc.SubmitGroupWitnessResponse, error) {
 
-	assets, err := r.cfg.AssetStore.FetchAllAssets(
-		ctx, includeSpent, includeLeased, nil,
-	)
+	sigHash, err := chainhash.NewHash(req.SigHash)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+		return nil, fmt.Errorf("invalid sig hash: %w", err)
 	}
 
-	rpcAssets := make([]*taprpc.Asset, len(assets))
-	for i, a := range assets {
-		rpcAssets[i], err = r.marshalChainAsset(ctx, a, withWitness)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal asset: %w",
-				err)
-		}
+	sig, err := schnorr.ParseSignature(req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
 	}
 
-	return rpcAssets, nil
+	err = r.cfg.AssetMinter.SubmitGroupWitness(*sigHash, sig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to submit group witness: %w",
+			err)
+	}
+
+	return &mintrpc.SubmitGroupWitnessResponse{}, nil
 }
 
-func (r *rpcServer) marshalChainAsset(ctx context.Context, a *tapdb.ChainAsset,
-	withWitness bool) (*taprpc.Asset, error) {
+// marshalGroupWitnessRequest converts a group witness request into its RPC
+// counterpart.
+func marshalGroupWitnessRequest(
+	req *tapgarden.GroupWitnessRequest) (*mintrpc.GroupWitnessRequest,
+	error) {
 
-	rpcAsset, err := taprpc.MarshalAsset(
-		ctx, a.Asset, a.IsSpent, withWitness, r.cfg.AddrBook,
-	)
+	tweakedGroupKey, err := req.TweakedGroupKey()
 	if err != nil {
 		return nil, err
 	}
 
-	var anchorTxBytes []byte
-	if a.AnchorTx != nil {
-		var anchorTxBuf bytes.Buffer
-		err := a.AnchorTx.Serialize(&anchorTxBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to serialize anchor "+
-				"tx: %w", err)
-		}
-		anchorTxBytes = anchorTxBuf.Bytes()
+	pkt, err := req.Packet()
+	if err != nil {
+		return nil, err
 	}
 
-	rpcAsset.ChainAnchor = &taprpc.AnchorInfo{
-		AnchorTx:         anchorTxBytes,
-		AnchorTxid:       a.AnchorTxid.String(),
-		AnchorBlockHash:  a.AnchorBlockHash.String(),
-		AnchorOutpoint:   a.AnchorOutpoint.String(),
-		InternalKey:      a.AnchorInternalKey.SerializeCompressed(),
-		MerkleRoot:       a.AnchorMerkleRoot,
-		TapscriptSibling: a.AnchorTapscriptSibling,
-		BlockHeight:      a.AnchorBlockHeight,
+	var psbtBuf bytes.Buffer
+	if err := pkt.Serialize(&psbtBuf); err != nil {
+		return nil, fmt.Errorf("unable to serialize virtual psbt: %w",
+			err)
 	}
 
-	if a.AnchorLeaseOwner != [32]byte{} {
-		rpcAsset.LeaseOwner = a.AnchorLeaseOwner[:]
-		rpcAsset.LeaseExpiry = a.AnchorLeaseExpiry.UTC().Unix()
+	rpcReq := &mintrpc.GroupWitnessRequest{
+		BatchKey:        req.BatchKey.SerializeCompressed(),
+		AssetName:       req.AssetName,
+		RawGroupKey:     marshalKeyDescriptor(req.SignDesc.KeyDesc),
+		SingleTweak:     req.SignDesc.SingleTweak,
+		TweakedGroupKey: schnorr.SerializePubKey(tweakedGroupKey),
+		VirtualPsbt:     psbtBuf.Bytes(),
+		SigHash:         req.SigHash[:],
 	}
 
-	return rpcAsset, nil
+	if req.MultiSig != nil {
+		rpcReq.Multisig = marshalGroupMultiSig(req.MultiSig)
+		for _, signedKey := range req.SignedKeys {
+			rpcReq.SignedKeys = append(
+				rpcReq.SignedKeys,
+				schnorr.SerializePubKey(signedKey),
+			)
+		}
+	}
+
+	return rpcReq, nil
 }
 
-func (r *rpcServer) listBalancesByAsset(ctx context.Context,
-	assetID *asset.ID) (*taprpc.ListBalancesResponse, error) {
+// SubmitGroupScriptSig submits the signature of one of the multisig keys for
+// a pending group witness request.
+func (r *rpcServer) SubmitGroupScriptSig(_ context.Context,
+	req *mintrpc.SubmitGroupScriptSigRequest) (
+	*mintrpc.SubmitGroupScriptSigResponse, error) {
 
-	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
+	sigHash, err := chainhash.NewHash(req.SigHash)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
+		return nil, fmt.Errorf("invalid sig hash: %w", err)
 	}
 
-	resp := &taprpc.ListBalancesResponse{
-		AssetBalances: make(map[string]*taprpc.AssetBalance, len(balances)),
+	signer, err := parseUserKey(req.SignerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer key: %w", err)
 	}
 
-	for _, balance := range balances {
-		balance := balance
-
-		assetIDStr := hex.EncodeToString(balance.ID[:])
-
-		resp.AssetBalances[assetIDStr] = &taprpc.AssetBalance{
-			AssetGenesis: &taprpc.GenesisInfo{
-				Version:      int32(balance.Version),
-				GenesisPoint: balance.GenesisPoint.String(),
-				Name:         balance.Tag,
-				MetaHash:     balance.MetaHash[:],
-				AssetId:      balance.ID[:],
-			},
-			AssetType: taprpc.AssetType(balance.Type),
-			Balance:   balance.Balance,
-		}
+	sig, err := schnorr.ParseSignature(req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
 	}
 
-	return resp, nil
-}
-
-func (r *rpcServer) listBalancesByGroupKey(ctx context.Context,
-	groupKey *btcec.PublicKey) (*taprpc.ListBalancesResponse, error) {
-
-	balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
-		ctx, groupKey,
+	remaining, err := r.cfg.AssetMinter.SubmitGroupScriptSig(
+		*sigHash, signer, sig,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
+		return nil, fmt.Errorf("unable to submit group script sig: %w",
+			err)
 	}
 
-	resp := &taprpc.ListBalancesResponse{
-		AssetGroupBalances: make(
-			map[string]*taprpc.AssetGroupBalance, len(balances),
-		),
-	}
+	return &mintrpc.SubmitGroupScriptSigResponse{
+		SigsRemaining: remaining,
+	}, nil
+}
 
-	for _, balance := range balances {
-		balance := balance
+// unmarshalGroupMultiSig parses the RPC multisig script of a group key.
+func unmarshalGroupMultiSig(
+	rpcMultiSig *mintrpc.GroupMultiSig) (*tapgarden.GroupMultiSig, error) {
 
-		var groupKey []byte
-		if balance.GroupKey != nil {
-			groupKey = balance.GroupKey.SerializeCompressed()
+	keys := make([]*btcec.PublicKey, len(rpcMultiSig.Keys))
+	for idx, rpcKey := range rpcMultiSig.Keys {
+		key, err := parseUserKey(rpcKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multisig key: %w", err)
 		}
 
-		groupKeyString := hex.EncodeToString(groupKey)
-		resp.AssetGroupBalances[groupKeyString] = &taprpc.AssetGroupBalance{
-			GroupKey: groupKey,
-			Balance:  balance.Balance,
-		}
+		keys[idx] = key
 	}
 
-	return resp, nil
+	return tapgarden.NewGroupMultiSig(rpcMultiSig.Threshold, keys)
 }
 
-// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
-// hold.
-func (r *rpcServer) ListUtxos(ctx context.Context,
-	req *taprpc.ListUtxosRequest) (*taprpc.ListUtxosResponse, error) {
+// marshalGroupMultiSig converts the multisig script of a group key into its
+// RPC counterpart.
+func marshalGroupMultiSig(
+	multiSig *tapgarden.GroupMultiSig) *mintrpc.GroupMultiSig {
 
-	rpcAssets, err := r.fetchRpcAssets(ctx, false, false, req.IncludeLeased)
-	if err != nil {
-		return nil, err
+	rpcKeys := make([][]byte, len(multiSig.Keys))
+	for idx, key := range multiSig.Keys {
+		rpcKeys[idx] = schnorr.SerializePubKey(key)
 	}
 
-	managedUtxos, err := r.cfg.AssetStore.FetchManagedUTXOs(ctx)
-	if err != nil {
-		return nil, err
+	return &mintrpc.GroupMultiSig{
+		Threshold: multiSig.Threshold,
+		Keys:      rpcKeys,
 	}
+}
 
-	utxos := make(map[string]*taprpc.ManagedUtxo)
-	for _, u := range managedUtxos {
-		utxos[u.OutPoint.String()] = &taprpc.ManagedUtxo{
-			OutPoint:         u.OutPoint.String(),
-			AmtSat:           int64(u.OutputValue),
-			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
-			TaprootAssetRoot: u.TaprootAssetRoot,
-			MerkleRoot:       u.MerkleRoot,
-		}
+// ListGenesisPsbtRequests lists the genesis PSBTs of externally funded
+// batches that wait to be funded or signed.
+func (r *rpcServer) ListGenesisPsbtRequests(_ context.Context,
+	_ *mintrpc.ListGenesisPsbtRequestsRequest) (
+	*mintrpc.ListGenesisPsbtRequestsResponse, error) {
+
+	reqs, err := r.cfg.AssetMinter.GenesisPsbtRequests()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list genesis psbt "+
+			"requests: %w", err)
 	}
 
-	// Populate the assets managed by each UTXO.
-	for _, a := range rpcAssets {
-		op := a.ChainAnchor.AnchorOutpoint
-		utxo, ok := utxos[op]
-		if !ok {
-			return nil, fmt.Errorf("unable to find utxo %s for "+
-				"asset_id=%x", op, a.AssetGenesis.AssetId)
+	rpcReqs := make([]*mintrpc.GenesisPsbtRequest, 0, len(reqs))
+	for _, req := range reqs {
+		rpcStage, err := marshalGenesisPsbtStage(req.Stage)
+		if err != nil {
+			return nil, err
 		}
 
-		utxo.Assets = append(utxo.Assets, a)
-		utxos[op] = utxo
-	}
-
-	// As a final pass, we'll prune out any UTXOs that don't have any
-	// assets, as these may be in the DB just for record keeping.
-	for _, utxo := range utxos {
-		if len(utxo.Assets) == 0 {
-			delete(utxos, utxo.OutPoint)
+		rawPsbt, err := serializePsbt(req.Packet)
+		if err != nil {
+			return nil, err
 		}
+
+		rpcReqs = append(rpcReqs, &mintrpc.GenesisPsbtRequest{
+			BatchKey: req.BatchKey.SerializeCompressed(),
+			Stage:    rpcStage,
+			Psbt:     rawPsbt,
+		})
 	}
 
-	return &taprpc.ListUtxosResponse{
-		ManagedUtxos: utxos,
+	return &mintrpc.ListGenesisPsbtRequestsResponse{
+		Requests: rpcReqs,
 	}, nil
 }
 
-// ListGroups lists known groups and the assets held in each group.
-func (r *rpcServer) ListGroups(ctx context.Context,
-	_ *taprpc.ListGroupsRequest) (*taprpc.ListGroupsResponse, error) {
+// SubmitGenesisPsbt submits the funded or signed genesis PSBT of an externally
+// funded batch.
+func (r *rpcServer) SubmitGenesisPsbt(_ context.Context,
+	req *mintrpc.SubmitGenesisPsbtRequest) (
+	*mintrpc.SubmitGenesisPsbtResponse, error) {
 
-	readableAssets, err := r.cfg.AssetStore.FetchGroupedAssets(ctx)
+	batchKey, err := btcec.ParsePubKey(req.BatchKey)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid batch key: %w", err)
 	}
 
-	groupsWithAssets := make(map[string]*taprpc.GroupedAssets)
-
-	// Populate the map of group keys to assets in that group.
-	for _, a := range readableAssets {
-		groupKey := hex.EncodeToString(a.GroupKey.SerializeCompressed())
-
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			a.Version,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		asset := &taprpc.AssetHumanReadable{
-			Id:               a.ID[:],
-			Version:          assetVersion,
-			Amount:           a.Amount,
-			LockTime:         int32(a.LockTime),
-			RelativeLockTime: int32(a.RelativeLockTime),
-			Tag:              a.Tag,
-			MetaHash:         a.MetaHash[:],
-			Type:             taprpc.AssetType(a.Type),
-		}
+	pkt, err := psbt.NewFromRawBytes(bytes.NewReader(req.Psbt), false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid genesis psbt: %w", err)
+	}
 
-		_, ok := groupsWithAssets[groupKey]
-		if !ok {
-			groupsWithAssets[groupKey] = &taprpc.GroupedAssets{
-				Assets: []*taprpc.AssetHumanReadable{},
-			}
-		}
+	rpcsLog.Infof("[SubmitGenesisPsbt]: batch_key=%x, txid=%v",
+		req.BatchKey, pkt.UnsignedTx.TxHash())
 
-		groupsWithAssets[groupKey].Assets = append(
-			groupsWithAssets[groupKey].Assets, asset,
-		)
+	err = r.cfg.AssetMinter.SubmitGenesisPsbt(batchKey, pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to submit genesis psbt: %w",
+			err)
 	}
 
-	return &taprpc.ListGroupsResponse{Groups: groupsWithAssets}, nil
+	return &mintrpc.SubmitGenesisPsbtResponse{}, nil
 }
 
-// ListBalances lists the asset balances owned by the daemon.
-func (r *rpcServer) ListBalances(ctx context.Context,
-	req *taprpc.ListBalancesRequest) (*taprpc.ListBalancesResponse, error) {
+// marshalGenesisPsbtStage converts a genesis PSBT stage into its RPC
+// counterpart.
+func marshalGenesisPsbtStage(
+	stage tapgarden.GenesisPsbtStage) (mintrpc.GenesisPsbtStage, error) {
 
-	switch groupBy := req.GroupBy.(type) {
-	case *taprpc.ListBalancesRequest_AssetId:
-		if !groupBy.AssetId {
-			return nil, fmt.Errorf("invalid group_by")
-		}
+	switch stage {
+	case tapgarden.GenesisPsbtFund:
+		return mintrpc.GenesisPsbtStage_GENESIS_PSBT_STAGE_FUND, nil
 
-		var assetID *asset.ID
-		if len(req.AssetFilter) != 0 {
-			assetID = &asset.ID{}
-			if len(req.AssetFilter) != len(assetID) {
-				return nil, fmt.Errorf("invalid asset filter")
-			}
+	case tapgarden.GenesisPsbtSign:
+		return mintrpc.GenesisPsbtStage_GENESIS_PSBT_STAGE_SIGN, nil
 
-			copy(assetID[:], req.AssetFilter)
-		}
+	default:
+		return 0, fmt.Errorf("unknown genesis psbt stage: %v", stage)
+	}
+}
 
-		return r.listBalancesByAsset(ctx, assetID)
+// serializePsbt serializes a PSBT packet into its binary format.
+func serializePsbt(pkt *psbt.Packet) ([]byte, error) {
+	var b bytes.Buffer
+	if err := pkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("unable to serialize psbt: %w", err)
+	}
 
-	case *taprpc.ListBalancesRequest_GroupKey:
-		if !groupBy.GroupKey {
-			return nil, fmt.Errorf("invalid group_by")
-		}
+	return b.Bytes(), nil
+}
 
-		var groupKey *btcec.PublicKey
-		if len(req.GroupKeyFilter) != 0 {
-			var err error
-			groupKey, err = btcec.ParsePubKey(req.GroupKeyFilter)
-			if err != nil {
-				return nil, fmt.Errorf("invalid group key "+
-					"filter: %v", err)
-			}
-		}
+// ListMuSig2GroupSessions lists the MuSig2 signing sessions that are in
+// progress for group witness requests.
+func (r *rpcServer) ListMuSig2GroupSessions(_ context.Context,
+	_ *mintrpc.ListMuSig2GroupSessionsRequest) (
+	*mintrpc.ListMuSig2GroupSessionsResponse, error) {
 
-		return r.listBalancesByGroupKey(ctx, groupKey)
+	sessions, err := r.cfg.AssetMinter.MuSig2GroupSessions()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list musig2 sessions: %w",
+			err)
+	}
 
-	default:
-		return nil, fmt.Errorf("invalid group_by")
+	rpcSessions := make([]*mintrpc.MuSig2GroupSession, len(sessions))
+	for idx, session := range sessions {
+		rpcSessions[idx] = marshalMuSig2GroupSession(session)
 	}
+
+	return &mintrpc.ListMuSig2GroupSessionsResponse{
+		Sessions: rpcSessions,
+	}, nil
 }
 
-// ListTransfers lists all asset transfers managed by this deamon.
-func (r *rpcServer) ListTransfers(ctx context.Context,
-	_ *taprpc.ListTransfersRequest) (*taprpc.ListTransfersResponse,
-	error) {
+// StartMuSig2GroupSession starts a MuSig2 signing session for a pending group
+// witness request.
+func (r *rpcServer) StartMuSig2GroupSession(_ context.Context,
+	req *mintrpc.StartMuSig2GroupSessionRequest) (
+	*mintrpc.StartMuSig2GroupSessionResponse, error) {
 
-	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
+	sigHash, err := chainhash.NewHash(req.SigHash)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query parcels: %w", err)
-	}
-
-	resp := &taprpc.ListTransfersResponse{
-		Transfers: make([]*taprpc.AssetTransfer, len(parcels)),
+		return nil, fmt.Errorf("invalid sig hash: %w", err)
 	}
 
-	for idx := range parcels {
-		resp.Transfers[idx], err = marshalOutboundParcel(parcels[idx])
+	signers := make([]*btcec.PublicKey, len(req.SignerKeys))
+	for idx, signerKey := range req.SignerKeys {
+		signers[idx], err = btcec.ParsePubKey(signerKey)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal parcel: %w",
-				err)
+			return nil, fmt.Errorf("invalid signer key: %w", err)
 		}
 	}
 
-	return resp, nil
+	session, err := r.cfg.AssetMinter.StartMuSig2GroupSession(
+		*sigHash, signers,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start musig2 session: %w",
+			err)
+	}
+
+	return &mintrpc.StartMuSig2GroupSessionResponse{
+		Session: marshalMuSig2GroupSession(session),
+	}, nil
 }
 
-// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
-func (r *rpcServer) QueryAddrs(ctx context.Context,
-	req *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
+// RegisterMuSig2GroupNonce registers the public nonce of a signer of a MuSig2
+// group witness session.
+func (r *rpcServer) RegisterMuSig2GroupNonce(_ context.Context,
+	req *mintrpc.RegisterMuSig2GroupNonceRequest) (
+	*mintrpc.RegisterMuSig2GroupNonceResponse, error) {
 
-	query := address.QueryParams{
-		Limit:  req.Limit,
-		Offset: req.Offset,
+	sigHash, err := chainhash.NewHash(req.SigHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sig hash: %w", err)
 	}
 
-	// The unix time of 0 (1970-01-01) is not the same as an empty Time
-	// struct (0000-00-00). For our query to succeed, we need to set the
-	// time values the way the address book expects them.
-	if req.CreatedBefore > 0 {
-		query.CreatedBefore = time.Unix(req.CreatedBefore, 0)
+	signer, err := btcec.ParsePubKey(req.SignerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer key: %w", err)
 	}
-	if req.CreatedAfter > 0 {
-		query.CreatedAfter = time.Unix(req.CreatedAfter, 0)
+
+	if len(req.PubNonce) != musig2.PubNonceSize {
+		return nil, fmt.Errorf("pub nonce must be %d bytes",
+			musig2.PubNonceSize)
 	}
 
-	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
-		spew.Sdump(query))
+	var pubNonce [musig2.PubNonceSize]byte
+	copy(pubNonce[:], req.PubNonce)
 
-	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
+	session, err := r.cfg.AssetMinter.RegisterMuSig2GroupNonce(
+		*sigHash, signer, pubNonce,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query addrs: %w", err)
+		return nil, fmt.Errorf("unable to register nonce: %w", err)
 	}
 
-	// TODO(roasbeef): just stop storing the hrp in the addr?
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	return &mintrpc.RegisterMuSig2GroupNonceResponse{
+		Session: marshalMuSig2GroupSession(session),
+	}, nil
+}
 
-	addrs := make([]*taprpc.Addr, len(dbAddrs))
-	for i, dbAddr := range dbAddrs {
-		dbAddr.ChainParams = &tapParams
+// SubmitMuSig2GroupPartialSig submits the partial signature of a signer of a
+// MuSig2 group witness session.
+func (r *rpcServer) SubmitMuSig2GroupPartialSig(_ context.Context,
+	req *mintrpc.SubmitMuSig2GroupPartialSigRequest) (
+	*mintrpc.SubmitMuSig2GroupPartialSigResponse, error) {
 
-		addrs[i], err = marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal addr: %w",
-				err)
-		}
+	sigHash, err := chainhash.NewHash(req.SigHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sig hash: %w", err)
 	}
 
-	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
+	signer, err := btcec.ParsePubKey(req.SignerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer key: %w", err)
+	}
 
-	return &taprpc.QueryAddrResponse{
-		Addrs: addrs,
+	if len(req.PartialSig) != 32 {
+		return nil, fmt.Errorf("partial sig must be 32 bytes")
+	}
+
+	var partialSig musig2.PartialSignature
+	err = partialSig.Decode(bytes.NewReader(req.PartialSig))
+	if err != nil {
+		return nil, fmt.Errorf("invalid partial sig: %w", err)
+	}
+
+	session, err := r.cfg.AssetMinter.SubmitMuSig2GroupPartialSig(
+		*sigHash, signer, &partialSig,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to submit partial sig: %w", err)
+	}
+
+	return &mintrpc.SubmitMuSig2GroupPartialSigResponse{
+		Session: marshalMuSig2GroupSession(session),
 	}, nil
 }
 
-// NewAddr makes a new address from the set of request params.
-func (r *rpcServer) NewAddr(ctx context.Context,
-	req *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
+// marshalMuSig2GroupSession converts a MuSig2 group witness session into its
+// RPC counterpart.
+func marshalMuSig2GroupSession(
+	session *tapgarden.MuSig2GroupSession) *mintrpc.MuSig2GroupSession {
 
-	var err error
+	rpcSigners := make(
+		[]*mintrpc.MuSig2GroupSigner, len(session.Signers),
+	)
+	for idx, signer := range session.Signers {
+		signerKey := asset.ToSerialized(signer)
 
-	// Parse the proof courier address if one was provided, otherwise use
-	// the default specified in the config.
-	courierAddr := r.cfg.DefaultProofCourierAddr
-	if req.ProofCourierAddr != "" {
-		addr, err := proof.ParseCourierAddrString(
-			req.ProofCourierAddr,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("invalid proof courier "+
-				"address: %w", err)
+		rpcSigner := &mintrpc.MuSig2GroupSigner{
+			SignerKey: signerKey[:],
 		}
+		if nonce, ok := session.PubNonces[signerKey]; ok {
+			rpcSigner.PubNonce = nonce[:]
+		}
+		_, rpcSigner.PartialSigReceived = session.PartialSigs[signerKey]
 
-		// At this point, we do not intend on creating a proof courier
-		// service instance. We are only interested in parsing and
-		// validating the address. We therefore convert the address into
-		// an url.URL type for storage in the address book.
-		courierAddr = addr.Url()
+		rpcSigners[idx] = rpcSigner
 	}
 
-	// Check that the proof courier address is set. This should never
-	// happen, but we check anyway to avoid panics (possibly caused by
-	// future erroneous config changes).
-	if courierAddr == nil {
-		return nil, fmt.Errorf("no proof courier address provided")
+	rpcTweaks := make([]*mintrpc.MuSig2KeyTweak, len(session.Tweaks))
+	for idx := range session.Tweaks {
+		tweak := session.Tweaks[idx]
+		rpcTweaks[idx] = &mintrpc.MuSig2KeyTweak{
+			Tweak:   tweak.Tweak[:],
+			IsXOnly: tweak.IsXOnly,
+		}
 	}
-	proofCourierAddr := *courierAddr
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("invalid asset id length")
+	rpcSession := &mintrpc.MuSig2GroupSession{
+		SigHash:  session.SigHash[:],
+		Signers:  rpcSigners,
+		Tweaks:   rpcTweaks,
+		Complete: session.Complete,
+	}
+	if session.CombinedNonce != nil {
+		rpcSession.CombinedNonce = session.CombinedNonce[:]
 	}
 
-	var assetID asset.ID
-	copy(assetID[:], req.AssetId)
+	return rpcSession
+}
 
-	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
-		assetID[:], req.Amt)
+// SaveBatchTemplate saves a named template of a set of assets that can later
+// be minted with a single call. An existing template with the same name is
+// replaced.
+func (r *rpcServer) SaveBatchTemplate(ctx context.Context,
+	req *mintrpc.SaveBatchTemplateRequest) (
+	*mintrpc.SaveBatchTemplateResponse, error) {
 
-	err = r.checkBalanceOverflow(ctx, &assetID, nil, req.Amt)
+	if req.Template == nil {
+		return nil, fmt.Errorf("template cannot be nil")
+	}
+
+	template, err := unmarshalBatchTemplate(req.Template)
 	if err != nil {
 		return nil, err
 	}
+	template.CreationTime = time.Now()
 
-	// Was there a tapscript sibling preimage specified?
-	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
-		req.TapscriptSibling,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
+	if err := template.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid batch template: %w", err)
 	}
 
-	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
+	rpcsLog.Infof("[SaveBatchTemplate]: name=%v, num_assets=%v",
+		template.Name, len(template.Assets))
+
+	err = r.cfg.MintingStore.SaveBatchTemplate(ctx, template)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to save batch template: %w", err)
 	}
 
-	var addr *address.AddrWithKeyInfo
-	switch {
-	// No key was specified, we'll let the address book derive them.
-	case req.ScriptKey == nil && req.InternalKey == nil:
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddress(
-			ctx, assetID, req.Amt, tapscriptSibling,
-			proofCourierAddr,
-			address.WithAssetVersion(assetVersion),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
-		}
+	return &mintrpc.SaveBatchTemplateResponse{}, nil
+}
 
-	// Only the script key was specified.
-	case req.ScriptKey != nil && req.InternalKey == nil:
-		return nil, fmt.Errorf("internal key must also be specified " +
-			"if script key is specified")
+// ListBatchTemplates lists all saved batch templates.
+func (r *rpcServer) ListBatchTemplates(ctx context.Context,
+	_ *mintrpc.ListBatchTemplatesRequest) (
+	*mintrpc.ListBatchTemplatesResponse, error) {
 
-	// Only the internal key was specified.
-	case req.ScriptKey == nil && req.InternalKey != nil:
-		return nil, fmt.Errorf("script key must also be specified " +
-			"if internal key is specified")
+	templates, err := r.cfg.MintingStore.FetchBatchTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch batch templates: %w",
+			err)
+	}
 
-	// Both the script and internal keys were specified.
-	default:
-		scriptKey, err := UnmarshalScriptKey(req.ScriptKey)
+	rpcTemplates := make([]*mintrpc.BatchTemplate, 0, len(templates))
+	for _, template := range templates {
+		rpcTemplate, err := marshalBatchTemplate(template)
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode script key: "+
-				"%w", err)
+			return nil, err
 		}
 
-		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
-			scriptKey.PubKey.SerializeCompressed(),
-			scriptKey.RawKey.PubKey.SerializeCompressed(),
-			scriptKey.Tweak[:])
+		rpcTemplates = append(rpcTemplates, rpcTemplate)
+	}
 
-		internalKey, err := UnmarshalKeyDescriptor(req.InternalKey)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode internal "+
-				"key: %w", err)
-		}
+	return &mintrpc.ListBatchTemplatesResponse{
+		Templates: rpcTemplates,
+	}, nil
+}
 
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
-			ctx, assetID, req.Amt, *scriptKey, internalKey,
-			tapscriptSibling, proofCourierAddr,
-			address.WithAssetVersion(assetVersion),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
-		}
+// DeleteBatchTemplate deletes a saved batch template.
+func (r *rpcServer) DeleteBatchTemplate(ctx context.Context,
+	req *mintrpc.DeleteBatchTemplateRequest) (
+	*mintrpc.DeleteBatchTemplateResponse, error) {
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("template name must be set")
 	}
 
-	// With our addr obtained, we'll marshal it as an RPC message then send
-	// off the response.
-	rpcAddr, err := marshalAddr(addr.Tap, r.cfg.TapAddrBook)
+	err := r.cfg.MintingStore.DeleteBatchTemplate(ctx, req.Name)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+		return nil, fmt.Errorf("unable to delete batch template: %w",
+			err)
 	}
 
-	return rpcAddr, nil
+	return &mintrpc.DeleteBatchTemplateResponse{}, nil
 }
 
-// DecodeAddr decode a Taproot Asset address into a partial asset message that
-// represents the asset it wants to receive.
-func (r *rpcServer) DecodeAddr(_ context.Context,
-	req *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
+// MintBatchTemplate adds all assets of a saved batch template to the pending
+// batch. If any of the assets can't be added, the ones added before are
+// removed again, so either all or none of the assets end up in the batch.
+func (r *rpcServer) MintBatchTemplate(ctx context.Context,
+	req *mintrpc.MintBatchTemplateRequest) (
+	*mintrpc.MintBatchTemplateResponse, error) {
 
-	if len(req.Addr) == 0 {
-		return nil, fmt.Errorf("must specify an addr")
+	if req.Name == "" {
+		return nil, fmt.Errorf("template name must be set")
 	}
 
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
-
-	addr, err := address.DecodeAddress(req.Addr, &tapParams)
+	template, err := r.cfg.MintingStore.FetchBatchTemplate(ctx, req.Name)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode addr: %w", err)
+		return nil, fmt.Errorf("unable to fetch batch template: %w",
+			err)
 	}
 
-	rpcAddr, err := marshalAddr(addr, r.cfg.TapAddrBook)
+	seedlings, err := template.Seedlings(req.AssetNameSuffix)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+		return nil, fmt.Errorf("invalid asset name suffix: %w", err)
 	}
 
-	return rpcAddr, nil
-}
-
-// VerifyProof attempts to verify a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) VerifyProof(ctx context.Context,
-	req *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
+	// Issuing into an existing group must not overflow the balance of the
+	// group, which we can check before adding anything to the batch.
+	for _, seedling := range seedlings {
+		if !seedling.HasGroupKey() {
+			continue
+		}
 
-	if !proof.IsProofFile(req.RawProofFile) {
-		return nil, fmt.Errorf("invalid raw proof, expect file, not " +
-			"single encoded mint or transition proof")
+		err := r.checkBalanceOverflow(
+			ctx, nil, &seedling.GroupInfo.GroupPubKey,
+			seedling.Amount,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
-		return nil, fmt.Errorf("invalid proof file: %w", err)
-	}
+	rpcsLog.Infof("[MintBatchTemplate]: name=%v, suffix=%v, "+
+		"num_assets=%v", template.Name, req.AssetNameSuffix,
+		len(seedlings))
 
-	var proofFile proof.File
-	err := proofFile.Decode(bytes.NewReader(req.RawProofFile))
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode proof file: %w", err)
-	}
+	var pendingBatch *tapgarden.MintingBatch
+	for idx, seedling := range seedlings {
+		pendingBatch, err = r.queueSeedling(ctx, seedling)
+		if err == nil {
+			continue
+		}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	_, err = proofFile.Verify(ctx, headerVerifier, groupVerifier)
-	if err != nil {
-		// We don't want to fail the RPC request because of a proof
-		// verification error, but we do want to log it for easier
-		// debugging.
-		rpcsLog.Errorf("Proof verification failed with err: %v", err)
+		for _, queued := range seedlings[:idx] {
+			_, cancelErr := r.cfg.AssetMinter.CancelSeedling(
+				queued.AssetName,
+			)
+			if cancelErr != nil {
+				rpcsLog.Errorf("Unable to remove seedling %v "+
+					"of template %v: %v", queued.AssetName,
+					template.Name, cancelErr)
+			}
+		}
+
+		return nil, fmt.Errorf("unable to mint asset %v of template: "+
+			"%w", seedling.AssetName, err)
 	}
-	valid := err == nil
 
-	p, err := proofFile.LastProof()
+	rpcBatch, err := marshalMintingBatch(pendingBatch, req.ShortResponse)
 	if err != nil {
 		return nil, err
 	}
-	decodedProof, err := r.marshalProof(ctx, p, false, false)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof: %w", err)
-	}
-
-	decodedProof.ProofAtDepth = 0
-	decodedProof.NumberOfProofs = uint32(proofFile.NumProofs())
 
-	return &taprpc.VerifyProofResponse{
-		Valid:        valid,
-		DecodedProof: decodedProof,
+	return &mintrpc.MintBatchTemplateResponse{
+		PendingBatch: rpcBatch,
 	}, nil
 }
 
-// DecodeProof attempts to decode a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) DecodeProof(ctx context.Context,
-	req *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
+// unmarshalBatchTemplate parses the RPC batch template into its native
+// counterpart.
+func unmarshalBatchTemplate(
+	rpcTemplate *mintrpc.BatchTemplate) (*tapgarden.BatchTemplate, error) {
 
-	var (
-		proofReader = bytes.NewReader(req.RawProof)
-		rpcProof    *taprpc.DecodedProof
-	)
-	switch {
-	case proof.IsSingleProof(req.RawProof):
-		var p proof.Proof
-		err := p.Decode(proofReader)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode proof: %w",
-				err)
+	template := &tapgarden.BatchTemplate{
+		Name: rpcTemplate.Name,
+		Assets: make(
+			[]*tapgarden.AssetTemplate, 0, len(rpcTemplate.Assets),
+		),
+	}
+	for _, rpcTemplateAsset := range rpcTemplate.Assets {
+		rpcAsset := rpcTemplateAsset.Asset
+		switch {
+		case rpcAsset == nil:
+			return nil, fmt.Errorf("template asset cannot be nil")
+
+		case rpcAsset.ExternalGroupSigner ||
+			rpcAsset.GroupInternalKey != nil ||
+			rpcAsset.GroupMultisig != nil:
+
+			return nil, fmt.Errorf("external group signers are " +
+				"not supported in templates")
+
+		case rpcAsset.MetaByReference || rpcAsset.MetaBlobUrl != "":
+			return nil, fmt.Errorf("meta by reference is not " +
+				"supported in templates")
+
+		case rpcAsset.DelegateKey != nil:
+			return nil, fmt.Errorf("delegate keys are not " +
+				"supported in templates")
+
+		// Templates only store the meta data of an asset, which is
+		// where a max supply is committed to anyway.
+		case rpcAsset.MaxSupply != 0:
+			return nil, fmt.Errorf("max supply of templates must " +
+				"be set in the JSON meta data")
+
+		case len(rpcAsset.EmissionSchedule) != 0:
+			return nil, fmt.Errorf("emission schedule of " +
+				"templates must be set in the JSON meta data")
 		}
 
-		rpcProof, err = r.marshalProof(
-			ctx, &p, req.WithPrevWitnesses, req.WithMetaReveal,
+		assetVersion, err := taprpc.UnmarshalAssetVersion(
+			rpcAsset.AssetVersion,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal proof: %w",
-				err)
+			return nil, err
 		}
 
-		rpcProof.NumberOfProofs = 1
-
-	case proof.IsProofFile(req.RawProof):
-		if err := proof.CheckMaxFileSize(req.RawProof); err != nil {
-			return nil, fmt.Errorf("invalid proof file: %w", err)
+		templateAsset := &tapgarden.AssetTemplate{
+			AssetVersion:   assetVersion,
+			AssetType:      asset.Type(rpcAsset.AssetType),
+			AssetName:      rpcAsset.Name,
+			Amount:         rpcAsset.Amount,
+			DecimalDisplay: rpcAsset.DecimalDisplay,
+			EnableEmission: rpcTemplateAsset.EnableEmission,
 		}
 
-		var proofFile proof.File
-		if err := proofFile.Decode(proofReader); err != nil {
-			return nil, fmt.Errorf("unable to decode proof file: "+
-				"%w", err)
+		if rpcAsset.AssetMeta != nil {
+			metaType := rpcAsset.AssetMeta.Type
+			if metaType < 0 || metaType > math.MaxUint8 {
+				return nil, fmt.Errorf("invalid meta type: %v",
+					metaType)
+			}
+
+			templateAsset.Meta = &proof.MetaReveal{
+				Type: proof.MetaType(metaType),
+				Data: rpcAsset.AssetMeta.Data,
+			}
 		}
 
-		latestProofIndex := uint32(proofFile.NumProofs() - 1)
-		if req.ProofAtDepth > latestProofIndex {
-			return nil, fmt.Errorf("invalid depth %d is greater "+
-				"than latest proof index of %d",
-				req.ProofAtDepth, latestProofIndex)
+		if len(rpcAsset.GroupKey) != 0 {
+			groupKey, err := btcec.ParsePubKey(rpcAsset.GroupKey)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key: %w",
+					err)
+			}
+
+			templateAsset.GroupKey = groupKey
 		}
 
-		// Default to latest proof.
-		index := latestProofIndex - req.ProofAtDepth
-		p, err := proofFile.ProofAt(index)
-		if err != nil {
-			return nil, err
+		if rpcAsset.GroupAnchor != "" {
+			groupAnchor := rpcAsset.GroupAnchor
+			templateAsset.GroupAnchor = &groupAnchor
 		}
 
-		rpcProof, err = r.marshalProof(
-			ctx, p, req.WithPrevWitnesses,
-			req.WithMetaReveal,
+		template.Assets = append(template.Assets, templateAsset)
+	}
+
+	return template, nil
+}
+
+// marshalBatchTemplate converts a batch template into its RPC counterpart.
+func marshalBatchTemplate(
+	template *tapgarden.BatchTemplate) (*mintrpc.BatchTemplate, error) {
+
+	rpcAssets := make([]*mintrpc.TemplateAsset, 0, len(template.Assets))
+	for _, templateAsset := range template.Assets {
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			templateAsset.AssetVersion,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal proof: %w",
-				err)
+			return nil, err
 		}
 
-		rpcProof.ProofAtDepth = req.ProofAtDepth
-		rpcProof.NumberOfProofs = uint32(proofFile.NumProofs())
+		rpcAsset := &mintrpc.MintAsset{
+			AssetType: taprpc.AssetType(
+				templateAsset.AssetType,
+			),
+			Name:           templateAsset.AssetName,
+			Amount:         templateAsset.Amount,
+			AssetVersion:   assetVersion,
+			DecimalDisplay: templateAsset.DecimalDisplay,
+		}
 
-	default:
-		return nil, fmt.Errorf("invalid raw proof, could not " +
-			"identify decoding format")
+		if templateAsset.Meta != nil {
+			rpcAsset.AssetMeta = marshalAssetMeta(
+				templateAsset.Meta,
+			)
+		}
+
+		if templateAsset.GroupKey != nil {
+			rpcAsset.GroupKey = templateAsset.GroupKey.
+				SerializeCompressed()
+		}
+
+		if templateAsset.GroupAnchor != nil {
+			rpcAsset.GroupAnchor = *templateAsset.GroupAnchor
+		}
+
+		rpcAssets = append(rpcAssets, &mintrpc.TemplateAsset{
+			Asset:          rpcAsset,
+			EnableEmission: templateAsset.EnableEmission,
+		})
 	}
 
-	return &taprpc.DecodeProofResponse{
-		DecodedProof: rpcProof,
+	return &mintrpc.BatchTemplate{
+		Name:             template.Name,
+		Assets:           rpcAssets,
+		CreationTimeUnix: template.CreationTime.Unix(),
 	}, nil
 }
 
-// marshalProof turns a transition proof into an RPC DecodedProof.
-func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
-	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
+// ListBatches lists the set of batches submitted for minting, including pending
+// and cancelled batches.
+func (r *rpcServer) ListBatches(_ context.Context,
+	req *mintrpc.ListBatchRequest) (*mintrpc.ListBatchResponse, error) {
 
-	var (
-		rpcMeta        *taprpc.AssetMeta
-		rpcGenesis     = p.GenesisReveal
-		rpcGroupKey    = p.GroupKeyReveal
-		anchorOutpoint = wire.OutPoint{
-			Hash:  p.AnchorTx.TxHash(),
-			Index: p.InclusionProof.OutputIndex,
-		}
-		txMerkleProof  = p.TxMerkleProof
-		inclusionProof = p.InclusionProof
-		splitRootProof = p.SplitRootProof
+	batchKey, err := unmarshalBatchKey(
+		req.GetBatchKey(), req.GetBatchKeyStr(),
 	)
-
-	var txMerkleProofBuf bytes.Buffer
-	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
-			"merkle proof: %w", err)
+	if err != nil {
+		return nil, err
 	}
 
-	var inclusionProofBuf bytes.Buffer
-	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
-			err)
+	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list batches: %w", err)
 	}
 
-	if inclusionProof.CommitmentProof == nil {
-		return nil, fmt.Errorf("inclusion proof is missing " +
-			"commitment proof")
-	}
-	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
-		inclusionProof.CommitmentProof.TapSiblingPreimage,
+	rpcBatches, err := fn.MapErr(
+		batches,
+		func(b *tapgarden.MintingBatch) (*mintrpc.MintingBatch, error) {
+			return marshalMintingBatch(b, false)
+		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
-			err)
+		return nil, err
 	}
 
-	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
-		&p.Asset,
+	return &mintrpc.ListBatchResponse{
+		Batches: rpcBatches,
+	}, nil
+}
+
+// SetBatchLabel sets the user-defined label of a batch, replacing any previous
+// label.
+func (r *rpcServer) SetBatchLabel(_ context.Context,
+	req *mintrpc.SetBatchLabelRequest) (*mintrpc.SetBatchLabelResponse,
+	error) {
+
+	batchKey, err := unmarshalBatchKey(
+		req.GetBatchKey(), req.GetBatchKeyStr(),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error deriving inclusion proof: %w",
-			err)
+		return nil, err
 	}
-	merkleRoot := tapProof.TapscriptRoot(tsHash)
-
-	var exclusionProofs [][]byte
-	for _, exclusionProof := range p.ExclusionProofs {
-		var exclusionProofBuf bytes.Buffer
-		err := exclusionProof.Encode(&exclusionProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode exclusion "+
-				"proofs: %w", err)
-		}
-		exclusionProofs = append(
-			exclusionProofs, exclusionProofBuf.Bytes(),
-		)
+	if batchKey == nil {
+		return nil, fmt.Errorf("batch key must be set")
 	}
 
-	var splitRootProofBuf bytes.Buffer
-	if splitRootProof != nil {
-		err := splitRootProof.Encode(&splitRootProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode split root "+
-				"proof: %w", err)
-		}
+	err = r.cfg.AssetMinter.SetBatchLabel(batchKey, req.Label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set batch label: %w", err)
 	}
 
-	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
-		Asset:                  &p.Asset,
-		AnchorTx:               &p.AnchorTx,
-		AnchorTxid:             p.AnchorTx.TxHash(),
-		AnchorBlockHash:        p.BlockHeader.BlockHash(),
-		AnchorBlockHeight:      p.BlockHeight,
-		AnchorOutpoint:         anchorOutpoint,
-		AnchorInternalKey:      p.InclusionProof.InternalKey,
-		AnchorMerkleRoot:       merkleRoot[:],
-		AnchorTapscriptSibling: tsSibling,
-	}, withPrevWitnesses)
+	return &mintrpc.SetBatchLabelResponse{}, nil
+}
+
+// ListBatchCosts reports the on-chain cost of the minting transaction of each
+// batch that was signed.
+func (r *rpcServer) ListBatchCosts(_ context.Context,
+	req *mintrpc.ListBatchCostsRequest) (*mintrpc.ListBatchCostsResponse,
+	error) {
+
+	batchKey, err := unmarshalBatchKey(
+		req.GetBatchKey(), req.GetBatchKeyStr(),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if withMetaReveal {
-		metaHash := rpcAsset.AssetGenesis.MetaHash
-		if len(metaHash) == 0 {
-			return nil, fmt.Errorf("asset does not contain meta " +
-				"data")
-		}
+	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list batches: %w", err)
+	}
 
-		rpcMeta, err = r.FetchAssetMeta(
-			ctx, &taprpc.FetchAssetMetaRequest{
-				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
-					MetaHash: metaHash,
-				},
-			},
-		)
-		if err != nil {
-			return nil, err
+	var rpcCosts []*mintrpc.BatchCost
+	for _, batch := range batches {
+		// Only batches that got to the point of signing the minting
+		// transaction have any on-chain cost.
+		switch batch.State() {
+		case tapgarden.BatchStateCommitted,
+			tapgarden.BatchStateBroadcast,
+			tapgarden.BatchStateConfirmed,
+			tapgarden.BatchStateFinalized:
+
+		default:
+			continue
 		}
-	}
 
-	decodedAssetID := p.Asset.ID()
-	var genesisReveal *taprpc.GenesisReveal
-	if rpcGenesis != nil {
-		genesisReveal = &taprpc.GenesisReveal{
-			GenesisBaseReveal: &taprpc.GenesisInfo{
-				GenesisPoint: rpcGenesis.FirstPrevOut.String(),
-				Name:         rpcGenesis.Tag,
-				MetaHash:     rpcGenesis.MetaHash[:],
-				AssetId:      decodedAssetID[:],
-				OutputIndex:  rpcGenesis.OutputIndex,
-			},
-			AssetType: taprpc.AssetType(p.Asset.Type),
+		cost, err := tapgarden.NewBatchCost(batch)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute cost of "+
+				"batch %x: %w",
+				batch.BatchKey.PubKey.SerializeCompressed(),
+				err)
 		}
-	}
 
-	var GroupKeyReveal taprpc.GroupKeyReveal
-	if rpcGroupKey != nil {
-		GroupKeyReveal = taprpc.GroupKeyReveal{
-			RawGroupKey:   rpcGroupKey.RawKey[:],
-			TapscriptRoot: rpcGroupKey.TapscriptRoot,
+		rpcCost, err := marshalBatchCost(cost)
+		if err != nil {
+			return nil, err
 		}
+		rpcCosts = append(rpcCosts, rpcCost)
 	}
 
-	return &taprpc.DecodedProof{
-		Asset:               rpcAsset,
-		MetaReveal:          rpcMeta,
-		TxMerkleProof:       txMerkleProofBuf.Bytes(),
-		InclusionProof:      inclusionProofBuf.Bytes(),
-		ExclusionProofs:     exclusionProofs,
-		SplitRootProof:      splitRootProofBuf.Bytes(),
-		NumAdditionalInputs: uint32(len(p.AdditionalInputs)),
-		ChallengeWitness:    p.ChallengeWitness,
-		IsBurn:              p.Asset.IsBurn(),
-		GenesisReveal:       genesisReveal,
-		GroupKeyReveal:      &GroupKeyReveal,
+	return &mintrpc.ListBatchCostsResponse{
+		BatchCosts: rpcCosts,
 	}, nil
 }
 
-// ExportProof exports the latest raw proof file anchored at the specified
-// script_key.
-func (r *rpcServer) ExportProof(ctx context.Context,
-	req *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
+// unmarshalBatchKey parses a batch key that is given either as raw bytes or as
+// a hex encoded string. If neither is set, nil is returned.
+func unmarshalBatchKey(batchKeyBytes []byte,
+	batchKeyStr string) (*btcec.PublicKey, error) {
 
-	if len(req.ScriptKey) == 0 {
-		return nil, fmt.Errorf("a valid script key must be specified")
-	}
+	switch {
+	case len(batchKeyBytes) > 0 && len(batchKeyStr) > 0:
+		return nil, fmt.Errorf("cannot specify both batch_key and " +
+			"batch_key_string")
 
-	scriptKey, err := parseUserKey(req.ScriptKey)
-	if err != nil {
-		return nil, fmt.Errorf("invalid script key: %w", err)
-	}
+	case len(batchKeyStr) > 0:
+		var err error
+		batchKeyBytes, err = hex.DecodeString(batchKeyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key string: %w",
+				err)
+		}
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	case len(batchKeyBytes) == 0:
+		return nil, nil
 	}
 
-	var assetID asset.ID
-	copy(assetID[:], req.AssetId)
-
-	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
-		AssetID:   &assetID,
-		ScriptKey: *scriptKey,
-	})
+	batchKey, err := btcec.ParsePubKey(batchKeyBytes)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid batch key: %w", err)
 	}
 
-	return &taprpc.ProofFile{
-		RawProofFile: proofBlob,
-	}, nil
+	return batchKey, nil
 }
 
-// ImportProof attempts to import a proof file into the daemon. If successful, a
-// new asset will be inserted on disk, spendable using the specified target
-// script key, and internal key.
-func (r *rpcServer) ImportProof(ctx context.Context,
-	req *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse,
-	error) {
+// checkBalanceOverflow ensures that the new asset amount will not overflow
+// the max allowed asset (or asset group) balance.
+func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
+	assetID *asset.ID, groupPubKey *btcec.PublicKey,
+	newAmount uint64) error {
 
-	// We'll perform some basic input validation before we move forward.
-	if len(req.ProofFile) == 0 {
-		return nil, fmt.Errorf("proof file must be specified")
+	if assetID != nil && groupPubKey != nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be set")
 	}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	if assetID == nil && groupPubKey == nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be nil")
+	}
 
-	// Now that we know the proof file is at least present, we'll attempt
-	// to import it into the main archive.
-	err := r.cfg.ProofArchive.ImportProofs(
-		ctx, headerVerifier, groupVerifier, false,
-		&proof.AnnotatedProof{Blob: req.ProofFile},
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &tapdevrpc.ImportProofResponse{}, nil
-}
-
-// AddrReceives lists all receives for incoming asset transfers for addresses
-// that were created previously.
-func (r *rpcServer) AddrReceives(ctx context.Context,
-	req *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
-	error) {
-
-	var sqlQuery address.EventQueryParams
-
-	if len(req.FilterAddr) > 0 {
-		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
-
-		addr, err := address.DecodeAddress(req.FilterAddr, &tapParams)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode addr: %w", err)
-		}
+	var balance uint64
 
-		// Now that we've decoded the address, we'll check to make sure
-		// that we can fetch the genesis for this address. Otherwise,
-		// that means we don't know anything about what it should look
-		// like on chain (the genesis is required to derive the taproot
-		// output key).
-		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
-			ctx, addr.AssetID,
+	switch {
+	case assetID != nil:
+		// Retrieve the current asset balance.
+		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
+			ctx, assetID,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unknown asset=%x: %w",
-				addr.AssetID[:], err)
+			return fmt.Errorf("unable to query asset balance: %w",
+				err)
 		}
 
-		rpcsLog.Tracef("Listing receives for group: %v",
-			spew.Sdump(assetGroup))
-
-		addr.AttachGenesis(*assetGroup.Genesis)
+		// There should only be one balance entry per asset.
+		for _, balanceEntry := range balances {
+			balance = balanceEntry.Balance
+			break
+		}
 
-		taprootOutputKey, err := addr.TaprootOutputKey()
+	case groupPubKey != nil:
+		// Retrieve the current balance of the group.
+		balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
+			ctx, groupPubKey,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot key: %w",
+			return fmt.Errorf("unable to query group balance: %w",
 				err)
 		}
 
-		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
-			taprootOutputKey,
-		)
+		// There should only be one balance entry per group.
+		for _, balanceEntry := range balances {
+			balance = balanceEntry.Balance
+			break
+		}
 	}
 
-	if req.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
-		status, err := unmarshalAddrEventStatus(req.FilterStatus)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing status: %w", err)
-		}
+	// Check for overflow.
+	err := mssmt.CheckSumOverflowUint64(balance, newAmount)
+	if err != nil {
+		return fmt.Errorf("new asset amount would overflow "+
+			"asset balance: %w", err)
+	}
 
-		sqlQuery.StatusFrom = &status
-		sqlQuery.StatusTo = &status
+	return nil
+}
+
+// ListAssets lists the set of assets owned by the target daemon.
+func (r *rpcServer) ListAssets(ctx context.Context,
+	req *taprpc.ListAssetRequest) (*taprpc.ListAssetResponse, error) {
+
+	switch {
+	case req.IncludeSpent && req.IncludeLeased:
+		return nil, fmt.Errorf("cannot specify both include_spent " +
+			"and include_leased")
 	}
 
-	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	rpcAssets, err := r.fetchRpcAssets(
+		ctx, req.WithWitness, req.IncludeSpent, req.IncludeLeased,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error querying events: %w", err)
+		return nil, err
 	}
 
-	resp := &taprpc.AddrReceivesResponse{
-		Events: make([]*taprpc.AddrEvent, len(events)),
+	return &taprpc.ListAssetResponse{
+		Assets: rpcAssets,
+	}, nil
+}
+
+func (r *rpcServer) fetchRpcAssets(ctx context.Context, withWitness,
+	includeSpent, includeLeased bool) ([]*taprpc.Asset, error) {
+
+	assets, err := r.cfg.AssetStore.FetchAllAssets(
+		ctx, includeSpent, includeLeased, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
 	}
 
-	for idx, event := range events {
-		resp.Events[idx], err = marshalAddrEvent(
-			event, r.cfg.TapAddrBook,
-		)
+	rpcAssets := make([]*taprpc.Asset, len(assets))
+	for i, a := range assets {
+		rpcAssets[i], err = r.marshalChainAsset(ctx, a, withWitness)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling event: %w",
+			return nil, fmt.Errorf("unable to marshal asset: %w",
 				err)
 		}
 	}
 
-	return resp, nil
+	return rpcAssets, nil
 }
 
-// FundVirtualPsbt selects inputs from the available asset commitments to fund
-// a virtual transaction matching the template.
-func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
-	req *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
-	error) {
+func (r *rpcServer) marshalChainAsset(ctx context.Context, a *tapdb.ChainAsset,
+	withWitness bool) (*taprpc.Asset, error) {
 
-	var fundedVPkt *tapfreighter.FundedVPacket
-	switch {
-	case req.GetPsbt() != nil:
-		vPkt, err := tappsbt.NewFromRawBytes(
-			bytes.NewReader(req.GetPsbt()), false,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode psbt: %w", err)
-		}
+	rpcAsset, err := taprpc.MarshalAsset(
+		ctx, a.Asset, a.IsSpent, withWitness, r.cfg.AddrBook,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		// Extract the recipient information from the packet. This
-		// basically assembles the asset ID we want to send to and the
-		// sum of all output amounts.
-		desc, err := tapscript.DescribeRecipients(
-			ctx, vPkt, r.cfg.TapAddrBook,
-		)
+	var anchorTxBytes []byte
+	if a.AnchorTx != nil {
+		var anchorTxBuf bytes.Buffer
+		err := a.AnchorTx.Serialize(&anchorTxBuf)
 		if err != nil {
-			return nil, fmt.Errorf("unable to describe packet "+
-				"recipients: %w", err)
+			return nil, fmt.Errorf("unable to serialize anchor "+
+				"tx: %w", err)
 		}
+		anchorTxBytes = anchorTxBuf.Bytes()
+	}
 
-		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
-			ctx, desc, vPkt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error funding packet: %w", err)
-		}
+	rpcAsset.ChainAnchor = &taprpc.AnchorInfo{
+		AnchorTx:         anchorTxBytes,
+		AnchorTxid:       a.AnchorTxid.String(),
+		AnchorBlockHash:  a.AnchorBlockHash.String(),
+		AnchorOutpoint:   a.AnchorOutpoint.String(),
+		InternalKey:      a.AnchorInternalKey.SerializeCompressed(),
+		MerkleRoot:       a.AnchorMerkleRoot,
+		TapscriptSibling: a.AnchorTapscriptSibling,
+		BlockHeight:      a.AnchorBlockHeight,
+	}
 
-	case req.GetRaw() != nil:
-		raw := req.GetRaw()
-		if len(raw.Inputs) > 0 {
-			return nil, fmt.Errorf("template inputs not yet " +
-				"supported")
-		}
-		if len(raw.Recipients) > 1 {
-			return nil, fmt.Errorf("only one recipient supported")
-		}
+	if a.AnchorLeaseOwner != [32]byte{} {
+		rpcAsset.LeaseOwner = a.AnchorLeaseOwner[:]
+		rpcAsset.LeaseExpiry = a.AnchorLeaseExpiry.UTC().Unix()
+	}
 
-		var (
-			tapParams = address.ParamsForChain(
-				r.cfg.ChainParams.Name,
-			)
-			addr *address.Tap
-			err  error
-		)
-		for a := range raw.Recipients {
-			addr, err = address.DecodeAddress(a, &tapParams)
-			if err != nil {
-				return nil, fmt.Errorf("unable to decode "+
-					"addr: %w", err)
-			}
-		}
+	rpcAsset.DecimalDisplay, err = r.fetchDecimalDisplay(ctx, a.ID())
+	if err != nil {
+		return nil, err
+	}
 
-		if addr == nil {
-			return nil, fmt.Errorf("no recipients specified")
-		}
+	return rpcAsset, nil
+}
 
-		fundedVPkt, _, err = r.cfg.AssetWallet.FundAddressSend(
-			ctx, addr,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error funding address send: "+
-				"%w", err)
-		}
+// fetchDecimalDisplay returns the decimal display of the asset with the given
+// ID, as committed to in its meta data. Amounts of assets without known meta
+// data are displayed in whole asset units.
+func (r *rpcServer) fetchDecimalDisplay(ctx context.Context,
+	assetID asset.ID) (uint32, error) {
 
-	default:
-		return nil, fmt.Errorf("either PSBT or raw template must be " +
-			"specified")
-	}
+	assetMeta, err := r.cfg.AssetStore.FetchAssetMetaForAsset(ctx, assetID)
+	switch {
+	case errors.Is(err, tapdb.ErrAssetMetaNotFound):
+		return 0, nil
 
-	var b bytes.Buffer
-	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+	case err != nil:
+		return 0, fmt.Errorf("unable to fetch asset meta: %w", err)
 	}
 
-	return &wrpc.FundVirtualPsbtResponse{
-		FundedPsbt:        b.Bytes(),
-		ChangeOutputIndex: 0,
-	}, nil
+	return assetMeta.DecimalDisplay()
 }
 
-// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
-// commitments of the inputs and outputs.
-func (r *rpcServer) SignVirtualPsbt(_ context.Context,
-	req *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
-	error) {
+// zeroConfEvents returns the pending inbound transfers that are accepted at
+// zero confirmations according to the zero-conf policy.
+func (r *rpcServer) zeroConfEvents(
+	ctx context.Context) ([]*address.Event, error) {
 
-	if req.FundedPsbt == nil {
-		return nil, fmt.Errorf("request cannot be nil")
+	if len(r.cfg.ZeroConfPolicy.AssetIDs) == 0 {
+		return nil, nil
 	}
 
-	vPkt, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(req.FundedPsbt), false,
-	)
+	events, err := r.cfg.AddrBook.GetPendingEvents(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
+		return nil, fmt.Errorf("unable to fetch pending receives: %w",
+			err)
 	}
 
-	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	return r.cfg.ZeroConfPolicy.PendingAccepted(events), nil
+}
+
+func (r *rpcServer) listBalancesByAsset(ctx context.Context,
+	assetID *asset.ID) (*taprpc.ListBalancesResponse, error) {
+
+	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
 	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
+		return nil, fmt.Errorf("unable to list balances: %w", err)
 	}
 
-	var b bytes.Buffer
-	if err := vPkt.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+	resp := &taprpc.ListBalancesResponse{
+		AssetBalances: make(map[string]*taprpc.AssetBalance, len(balances)),
 	}
 
-	return &wrpc.SignVirtualPsbtResponse{
-		SignedPsbt:   b.Bytes(),
-		SignedInputs: signedInputs,
-	}, nil
-}
-
-// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
-// a single BTC level anchor transaction.
-//
-// TODO(guggero): Actually implement accepting and merging multiple
-// transactions.
-func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
-	req *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
-	error) {
-
-	if len(req.VirtualPsbts) == 0 {
-		return nil, fmt.Errorf("no virtual PSBTs specified")
-	}
+	for _, balance := range balances {
+		balance := balance
 
-	if len(req.VirtualPsbts) > 1 {
-		return nil, fmt.Errorf("only one virtual PSBT supported")
-	}
+		assetIDStr := hex.EncodeToString(balance.ID[:])
 
-	vPacket, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(req.VirtualPsbts[0]), false,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
-	}
+		decimalDisplay, err := r.fetchDecimalDisplay(ctx, balance.ID)
+		if err != nil {
+			return nil, err
+		}
 
-	if len(vPacket.Inputs) != 1 {
-		return nil, fmt.Errorf("only one input is currently supported")
+		resp.AssetBalances[assetIDStr] = &taprpc.AssetBalance{
+			AssetGenesis: &taprpc.GenesisInfo{
+				Version:      int32(balance.Version),
+				GenesisPoint: balance.GenesisPoint.String(),
+				Name:         balance.Tag,
+				MetaHash:     balance.MetaHash[:],
+				AssetId:      balance.ID[:],
+			},
+			AssetType:      taprpc.AssetType(balance.Type),
+			Balance:        balance.Balance,
+			DecimalDisplay: decimalDisplay,
+		}
 	}
 
-	inputAsset := vPacket.Inputs[0].Asset()
-	prevID := vPacket.Inputs[0].PrevID
-	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
-		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
-		&inputAsset.ScriptKey, true,
-	)
+	// Inbound transfers that are accepted at zero confirmations are
+	// reported separately, as they can't be spent yet.
+	zeroConfEvents, err := r.zeroConfEvents(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching input commitment: %w",
-			err)
+		return nil, err
 	}
+	for _, event := range zeroConfEvents {
+		eventAssetID := event.Addr.AssetID
+		if assetID != nil && eventAssetID != *assetID {
+			continue
+		}
 
-	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
-		"delivery", inputCommitment.AnchorPoint)
+		assetIDStr := hex.EncodeToString(eventAssetID[:])
+		rpcBalance, ok := resp.AssetBalances[assetIDStr]
+		if !ok {
+			assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+				ctx, eventAssetID,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to query "+
+					"asset: %w", err)
+			}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(
-			vPacket, tappsbt.InputCommitments{
-				0: inputCommitment.Commitment,
-			},
-		),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error requesting delivery: %w", err)
-	}
+			decimalDisplay, err := r.fetchDecimalDisplay(
+				ctx, eventAssetID,
+			)
+			if err != nil {
+				return nil, err
+			}
 
-	parcel, err := marshalOutboundParcel(resp)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+			gen := assetGroup.Genesis
+			rpcBalance = &taprpc.AssetBalance{
+				AssetGenesis: &taprpc.GenesisInfo{
+					GenesisPoint: gen.FirstPrevOut.String(),
+					Name:         gen.Tag,
+					MetaHash:     gen.MetaHash[:],
+					AssetId:      eventAssetID[:],
+				},
+				AssetType:      taprpc.AssetType(gen.Type),
+				DecimalDisplay: decimalDisplay,
+			}
+			resp.AssetBalances[assetIDStr] = rpcBalance
+		}
+
+		rpcBalance.UnconfirmedBalance += event.Addr.Amount
 	}
 
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
+	return resp, nil
 }
 
-// NextInternalKey derives the next internal key for the given key family and
-// stores it as an internal key in the database to make sure it is identified
-// as a local key later on when importing proofs. While an internal key can
-// also be used as the internal key of a script key, it is recommended to use
-// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
-// is also recognized as a local key.
-func (r *rpcServer) NextInternalKey(ctx context.Context,
-	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
-	error) {
+func (r *rpcServer) listBalancesByGroupKey(ctx context.Context,
+	groupKey *btcec.PublicKey) (*taprpc.ListBalancesResponse, error) {
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
+	balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
+		ctx, groupKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list balances: %w", err)
 	}
 
-	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
-	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	resp := &taprpc.ListBalancesResponse{
+		AssetGroupBalances: make(
+			map[string]*taprpc.AssetGroupBalance, len(balances),
+		),
 	}
 
-	return &wrpc.NextInternalKeyResponse{
-		InternalKey: marshalKeyDescriptor(keyDesc),
-	}, nil
-}
+	for _, balance := range balances {
+		balance := balance
 
-// NextScriptKey derives the next script key (and its corresponding internal
-// key) and stores them both in the database to make sure they are identified
-// as local keys later on when importing proofs.
-func (r *rpcServer) NextScriptKey(ctx context.Context,
-	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
-	error) {
+		var (
+			groupKey       []byte
+			decimalDisplay uint32
+		)
+		if balance.GroupKey != nil {
+			groupKey = balance.GroupKey.SerializeCompressed()
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
+			// All assets of a group share the decimal display
+			// of the asset that created the group.
+			group, err := r.cfg.MintingStore.FetchGroupByGroupKey(
+				ctx, balance.GroupKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch "+
+					"group: %w", err)
+			}
+
+			decimalDisplay, err = r.fetchDecimalDisplay(
+				ctx, group.Genesis.ID(),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		groupKeyString := hex.EncodeToString(groupKey)
+		resp.AssetGroupBalances[groupKeyString] = &taprpc.AssetGroupBalance{
+			GroupKey:       groupKey,
+			Balance:        balance.Balance,
+			DecimalDisplay: decimalDisplay,
+		}
 	}
 
-	scriptKey, err := r.cfg.AddrBook.NextScriptKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
+	// Inbound transfers that are accepted at zero confirmations are
+	// reported separately, as they can't be spent yet.
+	zeroConfEvents, err := r.zeroConfEvents(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
+		return nil, err
 	}
+	for _, event := range zeroConfEvents {
+		eventGroupKey := event.Addr.GroupKey
+		if groupKey != nil && (eventGroupKey == nil ||
+			!eventGroupKey.IsEqual(groupKey)) {
 
-	return &wrpc.NextScriptKeyResponse{
-		ScriptKey: marshalScriptKey(scriptKey),
-	}, nil
-}
-
-// marshalAddr turns an address into its RPC counterpart.
-func marshalAddr(addr *address.Tap,
-	db address.Storage) (*taprpc.Addr, error) {
+			continue
+		}
 
-	addrStr, err := addr.EncodeAddress()
-	if err != nil {
-		return nil, fmt.Errorf("unable to encode addr: %w", err)
-	}
+		var groupKeyBytes []byte
+		if eventGroupKey != nil {
+			groupKeyBytes = eventGroupKey.SerializeCompressed()
+		}
 
-	// We can only derive the taproot output if we already know the genesis
-	// for this asset, as that's required to make the template asset that
-	// will be committed to in the tapscript tree.
-	var taprootOutputKey []byte
-	assetGroup, err := db.QueryAssetGroup(
-		context.Background(), addr.AssetID,
-	)
-	if err == nil {
-		addr.AttachGenesis(*assetGroup.Genesis)
+		groupKeyString := hex.EncodeToString(groupKeyBytes)
+		rpcBalance, ok := resp.AssetGroupBalances[groupKeyString]
+		if !ok {
+			var decimalDisplay uint32
+			if eventGroupKey != nil {
+				decimalDisplay, err = r.fetchDecimalDisplay(
+					ctx, event.Addr.AssetID,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
 
-		outputKey, err := addr.TaprootOutputKey()
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot "+
-				"output key: %w", err)
+			rpcBalance = &taprpc.AssetGroupBalance{
+				GroupKey:       groupKeyBytes,
+				DecimalDisplay: decimalDisplay,
+			}
+			resp.AssetGroupBalances[groupKeyString] = rpcBalance
 		}
 
-		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+		rpcBalance.UnconfirmedBalance += event.Addr.Amount
 	}
 
-	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
-		addr.TapscriptSibling,
-	)
+	return resp, nil
+}
+
+// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
+// hold.
+func (r *rpcServer) ListUtxos(ctx context.Context,
+	req *taprpc.ListUtxosRequest) (*taprpc.ListUtxosResponse, error) {
+
+	rpcAssets, err := r.fetchRpcAssets(ctx, false, false, req.IncludeLeased)
 	if err != nil {
-		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
-			err)
+		return nil, err
 	}
 
-	assetVersion, err := taprpc.MarshalAssetVersion(addr.AssetVersion)
+	managedUtxos, err := r.cfg.AssetStore.FetchManagedUTXOs(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	id := addr.AssetID
-	rpcAddr := &taprpc.Addr{
-		AssetVersion:     assetVersion,
-		Encoded:          addrStr,
-		AssetId:          id[:],
-		Amount:           addr.Amount,
-		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
-		InternalKey:      addr.InternalKey.SerializeCompressed(),
-		TapscriptSibling: siblingBytes,
-		TaprootOutputKey: taprootOutputKey,
-		AssetType:        taprpc.AssetType(addr.AssetType()),
-		ProofCourierAddr: addr.ProofCourierAddr.String(),
+	utxos := make(map[string]*taprpc.ManagedUtxo)
+	for _, u := range managedUtxos {
+		utxos[u.OutPoint.String()] = &taprpc.ManagedUtxo{
+			OutPoint:         u.OutPoint.String(),
+			AmtSat:           int64(u.OutputValue),
+			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
+			TaprootAssetRoot: u.TaprootAssetRoot,
+			MerkleRoot:       u.MerkleRoot,
+		}
 	}
 
-	if addr.GroupKey != nil {
-		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	// Populate the assets managed by each UTXO.
+	for _, a := range rpcAssets {
+		op := a.ChainAnchor.AnchorOutpoint
+		utxo, ok := utxos[op]
+		if !ok {
+			return nil, fmt.Errorf("unable to find utxo %s for "+
+				"asset_id=%x", op, a.AssetGenesis.AssetId)
+		}
+
+		utxo.Assets = append(utxo.Assets, a)
+		utxos[op] = utxo
 	}
 
-	return rpcAddr, nil
+	// As a final pass, we'll prune out any UTXOs that don't have any
+	// assets, as these may be in the DB just for record keeping.
+	for _, utxo := range utxos {
+		if len(utxo.Assets) == 0 {
+			delete(utxos, utxo.OutPoint)
+		}
+	}
+
+	return &taprpc.ListUtxosResponse{
+		ManagedUtxos: utxos,
+	}, nil
 }
 
-// marshalAddrEvent turns an address event into its RPC counterpart.
-func marshalAddrEvent(event *address.Event,
-	db address.Storage) (*taprpc.AddrEvent, error) {
+// ListGroups lists known groups and the assets held in each group.
+func (r *rpcServer) ListGroups(ctx context.Context,
+	_ *taprpc.ListGroupsRequest) (*taprpc.ListGroupsResponse, error) {
 
-	rpcAddr, err := marshalAddr(event.Addr.Tap, db)
+	readableAssets, err := r.cfg.AssetStore.FetchGroupedAssets(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling addr: %w", err)
+		return nil, err
 	}
 
-	rpcStatus, err := marshalAddrEventStatus(event.Status)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling status: %w", err)
-	}
+	groupsWithAssets := make(map[string]*taprpc.GroupedAssets)
 
-	return &taprpc.AddrEvent{
-		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
-		Addr:                    rpcAddr,
-		Status:                  rpcStatus,
-		Outpoint:                event.Outpoint.String(),
-		UtxoAmtSat:              uint64(event.Amt),
-		ConfirmationHeight:      event.ConfirmationHeight,
-		HasProof:                event.HasProof,
-	}, nil
-}
-
-// unmarshalAddrEventStatus parses the RPC address event status into the native
-// counterpart.
-func unmarshalAddrEventStatus(
-	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
-
-	switch rpcStatus {
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
-		return address.StatusTransactionDetected, nil
+	// Populate the map of group keys to assets in that group.
+	for _, a := range readableAssets {
+		groupKey := hex.EncodeToString(a.GroupKey.SerializeCompressed())
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
-		return address.StatusTransactionConfirmed, nil
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			a.Version,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
-		return address.StatusProofReceived, nil
+		asset := &taprpc.AssetHumanReadable{
+			Id:               a.ID[:],
+			Version:          assetVersion,
+			Amount:           a.Amount,
+			LockTime:         int32(a.LockTime),
+			RelativeLockTime: int32(a.RelativeLockTime),
+			Tag:              a.Tag,
+			MetaHash:         a.MetaHash[:],
+			Type:             taprpc.AssetType(a.Type),
+		}
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
-		return address.StatusCompleted, nil
+		_, ok := groupsWithAssets[groupKey]
+		if !ok {
+			groupsWithAssets[groupKey] = &taprpc.GroupedAssets{
+				Assets: []*taprpc.AssetHumanReadable{},
+			}
+		}
 
-	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			rpcStatus)
+		groupsWithAssets[groupKey].Assets = append(
+			groupsWithAssets[groupKey].Assets, asset,
+		)
 	}
+
+	return &taprpc.ListGroupsResponse{Groups: groupsWithAssets}, nil
 }
 
-// marshalAddrEventStatus turns the address event status into the RPC
-// counterpart.
-func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
-	error) {
+// ListBalances lists the asset balances owned by the daemon.
+func (r *rpcServer) ListBalances(ctx context.Context,
+	req *taprpc.ListBalancesRequest) (*taprpc.ListBalancesResponse, error) {
 
-	switch status {
-	case address.StatusTransactionDetected:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
-			nil
+	switch groupBy := req.GroupBy.(type) {
+	case *taprpc.ListBalancesRequest_AssetId:
+		if !groupBy.AssetId {
+			return nil, fmt.Errorf("invalid group_by")
+		}
 
-	case address.StatusTransactionConfirmed:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
-			nil
+		var assetID *asset.ID
+		if len(req.AssetFilter) != 0 {
+			assetID = &asset.ID{}
+			if len(req.AssetFilter) != len(assetID) {
+				return nil, fmt.Errorf("invalid asset filter")
+			}
 
-	case address.StatusProofReceived:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
-			nil
+			copy(assetID[:], req.AssetFilter)
+		}
 
-	case address.StatusCompleted:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+		return r.listBalancesByAsset(ctx, assetID)
+
+	case *taprpc.ListBalancesRequest_GroupKey:
+		if !groupBy.GroupKey {
+			return nil, fmt.Errorf("invalid group_by")
+		}
+
+		var groupKey *btcec.PublicKey
+		if len(req.GroupKeyFilter) != 0 {
+			var err error
+			groupKey, err = btcec.ParsePubKey(req.GroupKeyFilter)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key "+
+					"filter: %v", err)
+			}
+		}
+
+		return r.listBalancesByGroupKey(ctx, groupKey)
 
 	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			status)
+		return nil, fmt.Errorf("invalid group_by")
 	}
 }
 
-// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
-// complete an asset send. The method returns information w.r.t the on chain
-// send, as well as the proof file information the receiver needs to fully
-// receive the asset.
-func (r *rpcServer) SendAsset(_ context.Context,
-	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+// ListTransfers lists all asset transfers managed by this deamon.
+func (r *rpcServer) ListTransfers(ctx context.Context,
+	req *taprpc.ListTransfersRequest) (*taprpc.ListTransfersResponse,
+	error) {
 
-	if len(req.TapAddrs) == 0 {
-		return nil, fmt.Errorf("at least one addr is required")
+	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, req.Label, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query parcels: %w", err)
 	}
 
-	var (
-		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
-		tapAddrs  = make([]*address.Tap, len(req.TapAddrs))
-		err       error
-	)
-	for idx := range req.TapAddrs {
-		if req.TapAddrs[idx] == "" {
-			return nil, fmt.Errorf("addr %d must be specified", idx)
-		}
+	resp := &taprpc.ListTransfersResponse{
+		Transfers: make([]*taprpc.AssetTransfer, len(parcels)),
+	}
 
-		tapAddrs[idx], err = address.DecodeAddress(
-			req.TapAddrs[idx], &tapParams,
+	for idx := range parcels {
+		resp.Transfers[idx], err = r.marshalOutboundParcel(
+			ctx, parcels[idx],
 		)
 		if err != nil {
-			return nil, err
-		}
-
-		// Ensure all addrs are of the same asset ID. Within a single
-		// transfer (=a single virtual packet), we expect only to have
-		// inputs and outputs of the same asset ID. Multiple assets can
-		// be moved in a single BTC level anchor output, but the
-		// expectation is that they would be in separate virtual
-		// packets, one for each asset ID. They would then be merged
-		// into the same anchor output in the wallet's
-		// AnchorVirtualTransactions call.
-		//
-		// TODO(guggero): Support creating multiple virtual packets, one
-		// for each asset ID when the user wants to send multiple asset
-		// IDs at the same time without going through the PSBT flow.
-		//
-		// TODO(guggero): Revisit after we have a way to send fungible
-		// assets with different IDs to an address (non-interactive).
-		if idx > 0 {
-			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
-				return nil, fmt.Errorf("all addrs must be of "+
-					"the same asset ID %v",
-					tapAddrs[0].AssetID)
-			}
+			return nil, fmt.Errorf("failed to marshal parcel: %w",
+				err)
 		}
 	}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewAddressParcel(tapAddrs...),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	parcel, err := marshalOutboundParcel(resp)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
-	}
-
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
+	return resp, nil
 }
 
-// BurnAsset burns the given number of units of a given asset by sending them
-// to a provably un-spendable script key. Burning means irrevocably destroying
-// a certain number of assets, reducing the total supply of the asset. Because
-// burning is such a destructive and non-reversible operation, some specific
-// values need to be set in the request to avoid accidental burns.
-func (r *rpcServer) BurnAsset(ctx context.Context,
-	in *taprpc.BurnAssetRequest) (*taprpc.BurnAssetResponse, error) {
-
-	var assetID asset.ID
-	switch {
-	case len(in.GetAssetId()) > 0:
-		copy(assetID[:], in.GetAssetId())
+// ExportTransfers exports the outbound asset transfers and the completed
+// inbound address receives made within the given time range for accounting
+// purposes.
+func (r *rpcServer) ExportTransfers(ctx context.Context,
+	req *taprpc.ExportTransfersRequest) (*taprpc.ExportTransfersResponse,
+	error) {
 
-	case len(in.GetAssetIdStr()) > 0:
-		assetIDBytes, err := hex.DecodeString(in.GetAssetIdStr())
-		if err != nil {
-			return nil, fmt.Errorf("error decoding asset ID: %w",
-				err)
-		}
+	var format tapfreighter.TransferExportFormat
+	switch req.Format {
+	case taprpc.TransferExportFormat_TRANSFER_EXPORT_FORMAT_JSON:
+		format = tapfreighter.TransferExportJSON
 
-		copy(assetID[:], assetIDBytes)
+	case taprpc.TransferExportFormat_TRANSFER_EXPORT_FORMAT_CSV:
+		format = tapfreighter.TransferExportCSV
 
 	default:
-		return nil, fmt.Errorf("asset ID must be specified")
+		return nil, fmt.Errorf("unknown export format: %v", req.Format)
 	}
 
-	if in.AmountToBurn == 0 {
-		return nil, fmt.Errorf("amount to burn must be specified")
+	if req.StartTimestamp < 0 || req.EndTimestamp < 0 {
+		return nil, fmt.Errorf("timestamps must not be negative")
 	}
-	if in.ConfirmationText != AssetBurnConfirmationText {
-		return nil, fmt.Errorf("invalid confirmation text, please " +
-			"read API doc and confirm safety measure to avoid " +
-			"accidental asset burns")
+	if req.EndTimestamp != 0 && req.EndTimestamp <= req.StartTimestamp {
+		return nil, fmt.Errorf("end timestamp must be after start " +
+			"timestamp")
 	}
 
-	fundResp, err := r.cfg.AssetWallet.FundBurn(
-		ctx, &tapscript.FundingDescriptor{
-			ID:     assetID,
-			Amount: in.AmountToBurn,
-		},
-	)
+	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, req.Label, false)
 	if err != nil {
-		return nil, fmt.Errorf("error funding burn: %w", err)
+		return nil, fmt.Errorf("failed to query parcels: %w", err)
 	}
 
-	// Now we can sign the packet and send it to the chain.
-	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundResp.VPacket)
-	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
-	}
+	// The parcels are ordered by their transfer time, so the ones within
+	// the requested range are exported in order.
+	exportParcels := make([]*tapfreighter.OutboundParcel, 0, len(parcels))
+	for _, parcel := range parcels {
+		transferTime := parcel.TransferTime.Unix()
+		if transferTime < req.StartTimestamp {
+			continue
+		}
+		if req.EndTimestamp != 0 && transferTime >= req.EndTimestamp {
+			continue
+		}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(
-			fundResp.VPacket, fundResp.InputCommitments,
-		),
-	)
-	if err != nil {
-		return nil, err
+		exportParcels = append(exportParcels, parcel)
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
+	// Only the receives that completed are exported, as the assets of the
+	// other ones aren't in our custody yet.
+	completed := address.StatusCompleted
+	eventQuery := address.EventQueryParams{
+		StatusFrom: &completed,
+		StatusTo:   &completed,
+	}
+	if len(req.Label) > 0 {
+		eventQuery.Label = &req.Label
+	}
+	events, err := r.cfg.AddrBook.QueryEvents(ctx, eventQuery)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+		return nil, fmt.Errorf("failed to query receives: %w", err)
 	}
 
-	var burnProof *taprpc.DecodedProof
-	for idx := range resp.Outputs {
-		vOut := fundResp.VPacket.Outputs[idx]
-		tOut := resp.Outputs[idx]
-		if vOut.Asset.IsBurn() {
-			var p proof.Proof
-			err = p.Decode(bytes.NewReader(tOut.ProofSuffix))
-			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
-					"burn proof: %w", err)
-			}
-
-			burnProof, err = r.marshalProof(ctx, &p, true, false)
-			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
-					"burn proof: %w", err)
-			}
+	exportReceives := make([]*address.Event, 0, len(events))
+	for _, event := range events {
+		receiveTime := event.CreationTime.Unix()
+		if receiveTime < req.StartTimestamp {
+			continue
+		}
+		if req.EndTimestamp != 0 && receiveTime >= req.EndTimestamp {
+			continue
 		}
+
+		exportReceives = append(exportReceives, event)
 	}
 
-	return &taprpc.BurnAssetResponse{
-		BurnTransfer: parcel,
-		BurnProof:    burnProof,
+	var exportData bytes.Buffer
+	err = tapfreighter.ExportTransfers(
+		ctx, exportParcels, exportReceives, format,
+		r.fetchDecimalDisplay, &exportData,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to export transfers: %w", err)
+	}
+
+	return &taprpc.ExportTransfersResponse{
+		ExportData: exportData.Bytes(),
+		NumTransfers: uint32(
+			len(exportParcels) + len(exportReceives),
+		),
 	}, nil
 }
 
-// marshalOutboundParcel turns a pending parcel into its RPC counterpart.
-func marshalOutboundParcel(
-	parcel *tapfreighter.OutboundParcel) (*taprpc.AssetTransfer,
-	error) {
+// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
+func (r *rpcServer) QueryAddrs(ctx context.Context,
+	req *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
 
-	rpcInputs := make([]*taprpc.TransferInput, len(parcel.Inputs))
-	for idx := range parcel.Inputs {
-		in := parcel.Inputs[idx]
-		rpcInputs[idx] = &taprpc.TransferInput{
-			AnchorPoint: in.OutPoint.String(),
-			AssetId:     in.ID[:],
-			ScriptKey:   in.ScriptKey[:],
-			Amount:      in.Amount,
-		}
+	query := address.QueryParams{
+		Limit:  req.Limit,
+		Offset: req.Offset,
 	}
 
-	rpcOutputs := make(
-		[]*taprpc.TransferOutput, len(parcel.Outputs),
-	)
-	for idx := range parcel.Outputs {
-		out := parcel.Outputs[idx]
+	// The unix time of 0 (1970-01-01) is not the same as an empty Time
+	// struct (0000-00-00). For our query to succeed, we need to set the
+	// time values the way the address book expects them.
+	if req.CreatedBefore > 0 {
+		query.CreatedBefore = time.Unix(req.CreatedBefore, 0)
+	}
+	if req.CreatedAfter > 0 {
+		query.CreatedAfter = time.Unix(req.CreatedAfter, 0)
+	}
+
+	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
+		spew.Sdump(query))
+
+	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addrs: %w", err)
+	}
+
+	// TODO(roasbeef): just stop storing the hrp in the addr?
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addrs := make([]*taprpc.Addr, len(dbAddrs))
+	for i, dbAddr := range dbAddrs {
+		dbAddr.ChainParams = &tapParams
+
+		addrs[i], err = r.marshalAddr(ctx, dbAddr.Tap)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal addr: %w",
+				err)
+		}
+	}
+
+	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
+
+	return &taprpc.QueryAddrResponse{
+		Addrs: addrs,
+	}, nil
+}
+
+// NewAddr makes a new address from the set of request params.
+func (r *rpcServer) NewAddr(ctx context.Context,
+	req *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
+
+	var err error
+
+	// Parse the proof courier address if one was provided, otherwise use
+	// the default specified in the config.
+	courierAddr := r.cfg.DefaultProofCourierAddr
+	if req.ProofCourierAddr != "" {
+		addr, err := proof.ParseCourierAddrString(
+			req.ProofCourierAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof courier "+
+				"address: %w", err)
+		}
+
+		// At this point, we do not intend on creating a proof courier
+		// service instance. We are only interested in parsing and
+		// validating the address. We therefore convert the address into
+		// an url.URL type for storage in the address book.
+		courierAddr = addr.Url()
+	}
+
+	// Check that the proof courier address is set. This should never
+	// happen, but we check anyway to avoid panics (possibly caused by
+	// future erroneous config changes).
+	if courierAddr == nil {
+		return nil, fmt.Errorf("no proof courier address provided")
+	}
+	proofCourierAddr := *courierAddr
+
+	// Any fallback proof courier addresses are validated the same way.
+	fallbackCourierAddrs := make(
+		[]url.URL, 0, len(req.FallbackProofCourierAddrs),
+	)
+	for _, addrStr := range req.FallbackProofCourierAddrs {
+		addr, err := proof.ParseCourierAddrString(addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fallback proof "+
+				"courier address %q: %w", addrStr, err)
+		}
+		fallbackCourierAddrs = append(
+			fallbackCourierAddrs, *addr.Url(),
+		)
+	}
+
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("invalid asset id length")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
+		assetID[:], req.Amt)
+
+	err = r.checkBalanceOverflow(ctx, &assetID, nil, req.Amt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Was there a tapscript sibling preimage specified?
+	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+		req.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
+	}
+
+	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ScriptKeyInternalKey != nil && req.ScriptKeyTree == nil {
+		return nil, fmt.Errorf("script key internal key can only be " +
+			"specified with a script key tree")
+	}
+
+	var addr *address.AddrWithKeyInfo
+	switch {
+	// The script key should commit to a tapscript tree, which requires us
+	// to derive it ourselves.
+	case req.ScriptKeyTree != nil:
+		if req.ScriptKey != nil {
+			return nil, fmt.Errorf("script key cannot be " +
+				"specified with a script key tree")
+		}
+
+		addr, err = r.newTapscriptAddr(
+			ctx, req, assetID, tapscriptSibling, proofCourierAddr,
+			address.WithAssetVersion(assetVersion),
+			address.WithFallbackCourierAddrs(fallbackCourierAddrs),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
+		}
+
+	// No key was specified, we'll let the address book derive them.
+	case req.ScriptKey == nil && req.InternalKey == nil:
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddress(
+			ctx, assetID, req.Amt, tapscriptSibling,
+			proofCourierAddr,
+			address.WithAssetVersion(assetVersion),
+			address.WithFallbackCourierAddrs(fallbackCourierAddrs),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
+		}
+
+	// Only the script key was specified.
+	case req.ScriptKey != nil && req.InternalKey == nil:
+		return nil, fmt.Errorf("internal key must also be specified " +
+			"if script key is specified")
+
+	// Only the internal key was specified.
+	case req.ScriptKey == nil && req.InternalKey != nil:
+		return nil, fmt.Errorf("script key must also be specified " +
+			"if internal key is specified")
+
+	// Both the script and internal keys were specified.
+	default:
+		scriptKey, err := UnmarshalScriptKey(req.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode script key: "+
+				"%w", err)
+		}
+
+		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
+			scriptKey.PubKey.SerializeCompressed(),
+			scriptKey.RawKey.PubKey.SerializeCompressed(),
+			scriptKey.Tweak[:])
+
+		internalKey, err := UnmarshalKeyDescriptor(req.InternalKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode internal "+
+				"key: %w", err)
+		}
+
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
+			ctx, assetID, req.Amt, *scriptKey, internalKey,
+			tapscriptSibling, proofCourierAddr,
+			address.WithAssetVersion(assetVersion),
+			address.WithFallbackCourierAddrs(fallbackCourierAddrs),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
+		}
+	}
+
+	// With our addr obtained, we'll marshal it as an RPC message then send
+	// off the response.
+	rpcAddr, err := r.marshalAddr(ctx, addr.Tap)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	}
+
+	return rpcAddr, nil
+}
+
+// newTapscriptAddr creates a new address whose script key commits to the
+// tapscript tree of the given request. Without an internal key for the script
+// key, the NUMS key is used, so the received asset can only be spent through
+// one of the scripts.
+func (r *rpcServer) newTapscriptAddr(ctx context.Context,
+	req *taprpc.NewAddrRequest, assetID asset.ID,
+	tapscriptSibling *commitment.TapscriptPreimage,
+	proofCourierAddr url.URL,
+	addrOpts ...address.NewAddrOpt) (*address.AddrWithKeyInfo, error) {
+
+	leaves, err := unmarshalTapLeaves(req.ScriptKeyTree)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key tree: %w", err)
+	}
+
+	scriptInternalKey := keychain.KeyDescriptor{
+		PubKey: asset.NUMSPubKey,
+	}
+	if req.ScriptKeyInternalKey != nil {
+		scriptInternalKey, err = UnmarshalKeyDescriptor(
+			req.ScriptKeyInternalKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode script key "+
+				"internal key: %w", err)
+		}
+	}
+
+	// Without an internal key for the anchor output, the address book
+	// derives one from the wallet.
+	if req.InternalKey == nil {
+		return r.cfg.AddrBook.NewAddressWithTapscript(
+			ctx, assetID, req.Amt, scriptInternalKey, leaves,
+			tapscriptSibling, proofCourierAddr, addrOpts...,
+		)
+	}
+
+	internalKey, err := UnmarshalKeyDescriptor(req.InternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode internal key: %w",
+			err)
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NewScriptKeyTapscript(
+		ctx, scriptInternalKey, leaves,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key tree: %w", err)
+	}
+
+	rpcsLog.Debugf("Derived script key %x from tapscript tree with %d "+
+		"leaves", scriptKey.PubKey.SerializeCompressed(), len(leaves))
+
+	return r.cfg.AddrBook.NewAddressWithKeys(
+		ctx, assetID, req.Amt, scriptKey, internalKey,
+		tapscriptSibling, proofCourierAddr, addrOpts...,
+	)
+}
+
+// DecodeAddr decode a Taproot Asset address into a partial asset message that
+// represents the asset it wants to receive.
+func (r *rpcServer) DecodeAddr(ctx context.Context,
+	req *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
+
+	if len(req.Addr) == 0 {
+		return nil, fmt.Errorf("must specify an addr")
+	}
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addr, err := address.DecodeAddress(req.Addr, &tapParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+
+	rpcAddr, err := r.marshalAddr(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	}
+
+	return rpcAddr, nil
+}
+
+// VerifyProof attempts to verify a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) VerifyProof(ctx context.Context,
+	req *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
+
+	if !proof.IsProofFile(req.RawProofFile) {
+		return nil, fmt.Errorf("invalid raw proof, expect file, not " +
+			"single encoded mint or transition proof")
+	}
+
+	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
+	}
+
+	var proofFile proof.File
+	err := proofFile.Decode(bytes.NewReader(req.RawProofFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	_, err = proofFile.Verify(ctx, headerVerifier, groupVerifier)
+	if err != nil {
+		// We don't want to fail the RPC request because of a proof
+		// verification error, but we do want to log it for easier
+		// debugging.
+		rpcsLog.Errorf("Proof verification failed with err: %v", err)
+	}
+	valid := err == nil
+
+	p, err := proofFile.LastProof()
+	if err != nil {
+		return nil, err
+	}
+	decodedProof, err := r.marshalProof(ctx, p, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal proof: %w", err)
+	}
+
+	decodedProof.ProofAtDepth = 0
+	decodedProof.NumberOfProofs = uint32(proofFile.NumProofs())
+
+	return &taprpc.VerifyProofResponse{
+		Valid:        valid,
+		DecodedProof: decodedProof,
+	}, nil
+}
+
+// DecodeProof attempts to decode a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) DecodeProof(ctx context.Context,
+	req *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
+
+	var (
+		proofReader = bytes.NewReader(req.RawProof)
+		rpcProof    *taprpc.DecodedProof
+	)
+	switch {
+	case proof.IsSingleProof(req.RawProof):
+		var p proof.Proof
+		err := p.Decode(proofReader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof: %w",
+				err)
+		}
+
+		rpcProof, err = r.marshalProof(
+			ctx, &p, req.WithPrevWitnesses, req.WithMetaReveal,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal proof: %w",
+				err)
+		}
+
+		rpcProof.NumberOfProofs = 1
+
+	case proof.IsProofFile(req.RawProof):
+		if err := proof.CheckMaxFileSize(req.RawProof); err != nil {
+			return nil, fmt.Errorf("invalid proof file: %w", err)
+		}
+
+		var proofFile proof.File
+		if err := proofFile.Decode(proofReader); err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: "+
+				"%w", err)
+		}
+
+		latestProofIndex := uint32(proofFile.NumProofs() - 1)
+		if req.ProofAtDepth > latestProofIndex {
+			return nil, fmt.Errorf("invalid depth %d is greater "+
+				"than latest proof index of %d",
+				req.ProofAtDepth, latestProofIndex)
+		}
+
+		// Default to latest proof.
+		index := latestProofIndex - req.ProofAtDepth
+		p, err := proofFile.ProofAt(index)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcProof, err = r.marshalProof(
+			ctx, p, req.WithPrevWitnesses,
+			req.WithMetaReveal,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal proof: %w",
+				err)
+		}
+
+		rpcProof.ProofAtDepth = req.ProofAtDepth
+		rpcProof.NumberOfProofs = uint32(proofFile.NumProofs())
+
+	default:
+		return nil, fmt.Errorf("invalid raw proof, could not " +
+			"identify decoding format")
+	}
+
+	return &taprpc.DecodeProofResponse{
+		DecodedProof: rpcProof,
+	}, nil
+}
+
+// marshalProof turns a transition proof into an RPC DecodedProof.
+func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
+	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
+
+	var (
+		rpcMeta        *taprpc.AssetMeta
+		rpcGenesis     = p.GenesisReveal
+		rpcGroupKey    = p.GroupKeyReveal
+		anchorOutpoint = wire.OutPoint{
+			Hash:  p.AnchorTx.TxHash(),
+			Index: p.InclusionProof.OutputIndex,
+		}
+		txMerkleProof  = p.TxMerkleProof
+		inclusionProof = p.InclusionProof
+		splitRootProof = p.SplitRootProof
+	)
+
+	var txMerkleProofBuf bytes.Buffer
+	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
+			"merkle proof: %w", err)
+	}
+
+	var inclusionProofBuf bytes.Buffer
+	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
+			err)
+	}
+
+	if inclusionProof.CommitmentProof == nil {
+		return nil, fmt.Errorf("inclusion proof is missing " +
+			"commitment proof")
+	}
+	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
+		inclusionProof.CommitmentProof.TapSiblingPreimage,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
+			err)
+	}
+
+	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
+		&p.Asset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving inclusion proof: %w",
+			err)
+	}
+	merkleRoot := tapProof.TapscriptRoot(tsHash)
+
+	var exclusionProofs [][]byte
+	for _, exclusionProof := range p.ExclusionProofs {
+		var exclusionProofBuf bytes.Buffer
+		err := exclusionProof.Encode(&exclusionProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode exclusion "+
+				"proofs: %w", err)
+		}
+		exclusionProofs = append(
+			exclusionProofs, exclusionProofBuf.Bytes(),
+		)
+	}
+
+	var splitRootProofBuf bytes.Buffer
+	if splitRootProof != nil {
+		err := splitRootProof.Encode(&splitRootProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode split root "+
+				"proof: %w", err)
+		}
+	}
+
+	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
+		Asset:                  &p.Asset,
+		AnchorTx:               &p.AnchorTx,
+		AnchorTxid:             p.AnchorTx.TxHash(),
+		AnchorBlockHash:        p.BlockHeader.BlockHash(),
+		AnchorBlockHeight:      p.BlockHeight,
+		AnchorOutpoint:         anchorOutpoint,
+		AnchorInternalKey:      p.InclusionProof.InternalKey,
+		AnchorMerkleRoot:       merkleRoot[:],
+		AnchorTapscriptSibling: tsSibling,
+	}, withPrevWitnesses)
+	if err != nil {
+		return nil, err
+	}
+
+	if withMetaReveal {
+		metaHash := rpcAsset.AssetGenesis.MetaHash
+		if len(metaHash) == 0 {
+			return nil, fmt.Errorf("asset does not contain meta " +
+				"data")
+		}
+
+		rpcMeta, err = r.FetchAssetMeta(
+			ctx, &taprpc.FetchAssetMetaRequest{
+				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
+					MetaHash: metaHash,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decodedAssetID := p.Asset.ID()
+	var genesisReveal *taprpc.GenesisReveal
+	if rpcGenesis != nil {
+		genesisReveal = &taprpc.GenesisReveal{
+			GenesisBaseReveal: &taprpc.GenesisInfo{
+				GenesisPoint: rpcGenesis.FirstPrevOut.String(),
+				Name:         rpcGenesis.Tag,
+				MetaHash:     rpcGenesis.MetaHash[:],
+				AssetId:      decodedAssetID[:],
+				OutputIndex:  rpcGenesis.OutputIndex,
+			},
+			AssetType: taprpc.AssetType(p.Asset.Type),
+		}
+	}
+
+	var GroupKeyReveal taprpc.GroupKeyReveal
+	if rpcGroupKey != nil {
+		GroupKeyReveal = taprpc.GroupKeyReveal{
+			RawGroupKey:   rpcGroupKey.RawKey[:],
+			TapscriptRoot: rpcGroupKey.TapscriptRoot,
+		}
+	}
+
+	return &taprpc.DecodedProof{
+		Asset:               rpcAsset,
+		MetaReveal:          rpcMeta,
+		TxMerkleProof:       txMerkleProofBuf.Bytes(),
+		InclusionProof:      inclusionProofBuf.Bytes(),
+		ExclusionProofs:     exclusionProofs,
+		SplitRootProof:      splitRootProofBuf.Bytes(),
+		NumAdditionalInputs: uint32(len(p.AdditionalInputs)),
+		ChallengeWitness:    p.ChallengeWitness,
+		IsBurn:              p.Asset.IsBurn(),
+		GenesisReveal:       genesisReveal,
+		GroupKeyReveal:      &GroupKeyReveal,
+	}, nil
+}
+
+// ExportProof exports the latest raw proof file anchored at the specified
+// script_key.
+func (r *rpcServer) ExportProof(ctx context.Context,
+	req *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
+
+	if len(req.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
+	}
+
+	scriptKey, err := parseUserKey(req.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ProofFile{
+		RawProofFile: proofBlob,
+	}, nil
+}
+
+// ImportProof attempts to import a proof file into the daemon. If successful, a
+// new asset will be inserted on disk, spendable using the specified target
+// script key, and internal key.
+func (r *rpcServer) ImportProof(ctx context.Context,
+	req *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse,
+	error) {
+
+	// We'll perform some basic input validation before we move forward.
+	if len(req.ProofFile) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+
+	// Now that we know the proof file is at least present, we'll attempt
+	// to import it into the main archive.
+	err := r.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, groupVerifier, false,
+		&proof.AnnotatedProof{Blob: req.ProofFile},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tapdevrpc.ImportProofResponse{}, nil
+}
+
+// AddrReceives lists all receives for incoming asset transfers for addresses
+// that were created previously.
+func (r *rpcServer) AddrReceives(ctx context.Context,
+	req *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
+	error) {
+
+	var sqlQuery address.EventQueryParams
+
+	if len(req.FilterAddr) > 0 {
+		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+		addr, err := address.DecodeAddress(req.FilterAddr, &tapParams)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode addr: %w", err)
+		}
+
+		// Now that we've decoded the address, we'll check to make sure
+		// that we can fetch the genesis for this address. Otherwise,
+		// that means we don't know anything about what it should look
+		// like on chain (the genesis is required to derive the taproot
+		// output key).
+		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+			ctx, addr.AssetID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unknown asset=%x: %w",
+				addr.AssetID[:], err)
+		}
+
+		rpcsLog.Tracef("Listing receives for group: %v",
+			spew.Sdump(assetGroup))
+
+		addr.AttachGenesis(*assetGroup.Genesis)
+
+		taprootOutputKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("error deriving Taproot key: %w",
+				err)
+		}
+
+		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
+			taprootOutputKey,
+		)
+	}
+
+	if req.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
+		status, err := unmarshalAddrEventStatus(req.FilterStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing status: %w", err)
+		}
+
+		sqlQuery.StatusFrom = &status
+		sqlQuery.StatusTo = &status
+	}
+
+	if len(req.FilterLabel) > 0 {
+		sqlQuery.Label = &req.FilterLabel
+	}
+
+	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error querying events: %w", err)
+	}
+
+	resp := &taprpc.AddrReceivesResponse{
+		Events: make([]*taprpc.AddrEvent, len(events)),
+	}
+
+	for idx, event := range events {
+		resp.Events[idx], err = r.marshalAddrEvent(ctx, event)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling event: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
+// SetReceiveLabel sets a user-defined label or memo on the receives of the
+// given on-chain output, so they can be reconciled with an external ledger.
+func (r *rpcServer) SetReceiveLabel(ctx context.Context,
+	req *taprpc.SetReceiveLabelRequest) (*taprpc.SetReceiveLabelResponse,
+	error) {
+
+	outpoint, err := UnmarshalOutpoint(req.Outpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outpoint: %w", err)
+	}
+
+	if err := validateLabel(req.Label); err != nil {
+		return nil, err
+	}
+
+	err = r.cfg.AddrBook.SetEventLabel(ctx, *outpoint, req.Label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set receive label: %w", err)
+	}
+
+	return &taprpc.SetReceiveLabelResponse{}, nil
+}
+
+// validateLabel makes sure the given user-defined label isn't too long.
+func validateLabel(label string) error {
+	if len(label) > maxLabelLength {
+		return fmt.Errorf("label must not be longer than %d bytes",
+			maxLabelLength)
+	}
+
+	return nil
+}
+
+// FundVirtualPsbt selects inputs from the available asset commitments to fund
+// a virtual transaction matching the template.
+func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
+	req *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
+	error) {
+
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	var fundedVPkt *tapfreighter.FundedVPacket
+	switch {
+	case req.GetPsbt() != nil:
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(req.GetPsbt()), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode psbt: %w", err)
+		}
+
+		// Extract the recipient information from the packet. This
+		// basically assembles the asset ID we want to send to and the
+		// sum of all output amounts.
+		desc, err := tapscript.DescribeRecipients(
+			ctx, vPkt, r.cfg.TapAddrBook,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to describe packet "+
+				"recipients: %w", err)
+		}
+
+		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
+			ctx, desc, strategy, vPkt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error funding packet: %w", err)
+		}
+
+	case req.GetRaw() != nil:
+		raw := req.GetRaw()
+		if len(raw.Recipients) > 1 {
+			return nil, fmt.Errorf("only one recipient supported")
+		}
+
+		var (
+			tapParams = address.ParamsForChain(
+				r.cfg.ChainParams.Name,
+			)
+			addr *address.Tap
+			err  error
+		)
+		for a := range raw.Recipients {
+			addr, err = address.DecodeAddress(a, &tapParams)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"addr: %w", err)
+			}
+		}
+
+		if addr == nil {
+			return nil, fmt.Errorf("no recipients specified")
+		}
+
+		prevIDs, err := unmarshalPrevIDs(raw.Inputs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inputs: %w", err)
+		}
+
+		fundedVPkt, _, err = r.cfg.AssetWallet.FundAddressSend(
+			ctx, prevIDs, strategy, 0, addr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error funding address send: "+
+				"%w", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("either PSBT or raw template must be " +
+			"specified")
+	}
+
+	var b bytes.Buffer
+	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.FundVirtualPsbtResponse{
+		FundedPsbt:        b.Bytes(),
+		ChangeOutputIndex: 0,
+	}, nil
+}
+
+// unmarshalPrevIDs parses the asset inputs of a virtual transaction template.
+// The asset ID and script key of an input are optional, to spend all matching
+// assets of its anchor outpoint.
+func unmarshalPrevIDs(rpcPrevIDs []*wrpc.PrevId) ([]asset.PrevID, error) {
+	prevIDs := make([]asset.PrevID, len(rpcPrevIDs))
+	for idx, rpcPrevID := range rpcPrevIDs {
+		if rpcPrevID.Outpoint == nil {
+			return nil, fmt.Errorf("input %d: outpoint must be "+
+				"specified", idx)
+		}
+
+		hash, err := chainhash.NewHash(rpcPrevID.Outpoint.Txid)
+		if err != nil {
+			return nil, fmt.Errorf("input %d: error parsing txid: "+
+				"%w", idx, err)
+		}
+		prevIDs[idx].OutPoint = wire.OutPoint{
+			Hash:  *hash,
+			Index: rpcPrevID.Outpoint.OutputIndex,
+		}
+
+		if len(rpcPrevID.Id) > 0 {
+			if len(rpcPrevID.Id) != sha256.Size {
+				return nil, fmt.Errorf("input %d: asset ID "+
+					"must be %d bytes", idx, sha256.Size)
+			}
+			copy(prevIDs[idx].ID[:], rpcPrevID.Id)
+		}
+
+		if len(rpcPrevID.ScriptKey) > 0 {
+			scriptKey, err := btcec.ParsePubKey(rpcPrevID.ScriptKey)
+			if err != nil {
+				return nil, fmt.Errorf("input %d: invalid "+
+					"script key: %w", idx, err)
+			}
+			prevIDs[idx].ScriptKey = asset.ToSerialized(scriptKey)
+		}
+	}
+
+	return prevIDs, nil
+}
+
+// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
+// commitments of the inputs and outputs.
+func (r *rpcServer) SignVirtualPsbt(_ context.Context,
+	req *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
+	error) {
+
+	if req.FundedPsbt == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
+	}
+
+	var b bytes.Buffer
+	if err := vPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.SignVirtualPsbtResponse{
+		SignedPsbt:   b.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
+}
+
+// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
+// a single BTC level anchor transaction.
+//
+// TODO(guggero): Actually implement accepting and merging multiple
+// transactions.
+func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
+	req *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
+	error) {
+
+	if len(req.VirtualPsbts) == 0 {
+		return nil, fmt.Errorf("no virtual PSBTs specified")
+	}
+
+	if len(req.VirtualPsbts) > 1 {
+		return nil, fmt.Errorf("only one virtual PSBT supported")
+	}
+
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	vPacket, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.VirtualPsbts[0]), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	if len(vPacket.Inputs) != 1 {
+		return nil, fmt.Errorf("only one input is currently supported")
+	}
+
+	inputAsset := vPacket.Inputs[0].Asset()
+	prevID := vPacket.Inputs[0].PrevID
+	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
+		&inputAsset.ScriptKey, true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching input commitment: %w",
+			err)
+	}
+
+	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
+		"delivery", inputCommitment.AnchorPoint)
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(
+			vPacket, tappsbt.InputCommitments{
+				0: inputCommitment.Commitment,
+			}, strategy,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	parcel, err := r.marshalOutboundParcel(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// NextInternalKey derives the next internal key for the given key family and
+// stores it as an internal key in the database to make sure it is identified
+// as a local key later on when importing proofs. While an internal key can
+// also be used as the internal key of a script key, it is recommended to use
+// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
+// is also recognized as a local key.
+func (r *rpcServer) NextInternalKey(ctx context.Context,
+	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
+		req.KeyFamily,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	return &wrpc.NextInternalKeyResponse{
+		InternalKey: marshalKeyDescriptor(keyDesc),
+	}, nil
+}
+
+// NextScriptKey derives the next script key (and its corresponding internal
+// key) and stores them both in the database to make sure they are identified
+// as local keys later on when importing proofs.
+func (r *rpcServer) NextScriptKey(ctx context.Context,
+	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NextScriptKey(ctx, keychain.KeyFamily(
+		req.KeyFamily,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	return &wrpc.NextScriptKeyResponse{
+		ScriptKey: marshalScriptKey(scriptKey),
+	}, nil
+}
+
+// marshalAddr turns an address into its RPC counterpart.
+func (r *rpcServer) marshalAddr(ctx context.Context,
+	addr *address.Tap) (*taprpc.Addr, error) {
+
+	addrStr, err := addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode addr: %w", err)
+	}
+
+	// We can only derive the taproot output if we already know the genesis
+	// for this asset, as that's required to make the template asset that
+	// will be committed to in the tapscript tree.
+	var taprootOutputKey []byte
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(ctx, addr.AssetID)
+	if err == nil {
+		addr.AttachGenesis(*assetGroup.Genesis)
+
+		outputKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("error deriving Taproot "+
+				"output key: %w", err)
+		}
+
+		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+	}
+
+	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		addr.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
+			err)
+	}
+
+	assetVersion, err := taprpc.MarshalAssetVersion(addr.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	id := addr.AssetID
+	decimalDisplay, err := r.fetchDecimalDisplay(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcAddr := &taprpc.Addr{
+		AssetVersion:     assetVersion,
+		Encoded:          addrStr,
+		AssetId:          id[:],
+		Amount:           addr.Amount,
+		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
+		InternalKey:      addr.InternalKey.SerializeCompressed(),
+		TapscriptSibling: siblingBytes,
+		TaprootOutputKey: taprootOutputKey,
+		AssetType:        taprpc.AssetType(addr.AssetType()),
+		ProofCourierAddr: addr.ProofCourierAddr.String(),
+		DecimalDisplay:   decimalDisplay,
+	}
+
+	if addr.GroupKey != nil {
+		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	}
+
+	for _, courierAddr := range addr.FallbackCourierAddrs {
+		rpcAddr.FallbackProofCourierAddrs = append(
+			rpcAddr.FallbackProofCourierAddrs, courierAddr.String(),
+		)
+	}
+
+	return rpcAddr, nil
+}
+
+// marshalAddrEvent turns an address event into its RPC counterpart.
+func (r *rpcServer) marshalAddrEvent(ctx context.Context,
+	event *address.Event) (*taprpc.AddrEvent, error) {
+
+	rpcAddr, err := r.marshalAddr(ctx, event.Addr.Tap)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling addr: %w", err)
+	}
+
+	rpcStatus, err := marshalAddrEventStatus(event.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling status: %w", err)
+	}
+
+	return &taprpc.AddrEvent{
+		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
+		Addr:                    rpcAddr,
+		Status:                  rpcStatus,
+		Outpoint:                event.Outpoint.String(),
+		UtxoAmtSat:              uint64(event.Amt),
+		ConfirmationHeight:      event.ConfirmationHeight,
+		HasProof:                event.HasProof,
+		Label:                   event.Label,
+		ZeroConfAccepted: r.cfg.ZeroConfPolicy.Accepts(
+			event.Addr.Tap,
+		),
+		Reused: event.Reused,
+	}, nil
+}
+
+// unmarshalAddrEventStatus parses the RPC address event status into the native
+// counterpart.
+func unmarshalAddrEventStatus(
+	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
+
+	switch rpcStatus {
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
+		return address.StatusTransactionDetected, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
+		return address.StatusTransactionConfirmed, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
+		return address.StatusProofReceived, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
+		return address.StatusCompleted, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			rpcStatus)
+	}
+}
+
+// unmarshalCoinSelectStrategy parses the RPC coin selection strategy into the
+// native counterpart.
+func unmarshalCoinSelectStrategy(rpcStrategy taprpc.CoinSelectStrategy) (
+	tapfreighter.MultiCommitmentSelectStrategy, error) {
+
+	switch rpcStrategy {
+	case taprpc.CoinSelectStrategy_COIN_SELECT_DEFAULT:
+		return tapfreighter.DefaultSelectStrategy, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST:
+		return tapfreighter.PreferMaxAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_SMALLEST_FIRST:
+		return tapfreighter.PreferMinAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_FEWEST_INPUTS:
+		return tapfreighter.PreferFewestInputs, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_NO_MERGE:
+		return tapfreighter.PreferNoMerge, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy <%d>",
+			rpcStrategy)
+	}
+}
+
+// marshalCoinSelectStrategy turns a coin selection strategy into its RPC
+// counterpart.
+func marshalCoinSelectStrategy(
+	strategy tapfreighter.MultiCommitmentSelectStrategy) (
+	taprpc.CoinSelectStrategy, error) {
+
+	switch strategy {
+	case tapfreighter.DefaultSelectStrategy:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_DEFAULT, nil
+
+	case tapfreighter.PreferMaxAmount:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST, nil
+
+	case tapfreighter.PreferMinAmount:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_SMALLEST_FIRST, nil
+
+	case tapfreighter.PreferFewestInputs:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_FEWEST_INPUTS, nil
+
+	case tapfreighter.PreferNoMerge:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_NO_MERGE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v",
+			strategy)
+	}
+}
+
+// marshalAddrEventStatus turns the address event status into the RPC
+// counterpart.
+func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
+	error) {
+
+	switch status {
+	case address.StatusTransactionDetected:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
+			nil
+
+	case address.StatusTransactionConfirmed:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
+			nil
+
+	case address.StatusProofReceived:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
+			nil
+
+	case address.StatusCompleted:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			status)
+	}
+}
+
+// decodeSendAddrs decodes the given Taproot Asset addresses to send to and
+// makes sure they can be delivered to in a single transfer.
+func (r *rpcServer) decodeSendAddrs(encodedAddrs []string) ([]*address.Tap,
+	error) {
+
+	if len(encodedAddrs) == 0 {
+		return nil, fmt.Errorf("at least one addr is required")
+	}
+
+	var (
+		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
+		tapAddrs  = make([]*address.Tap, len(encodedAddrs))
+		err       error
+	)
+	for idx := range encodedAddrs {
+		if encodedAddrs[idx] == "" {
+			return nil, fmt.Errorf("addr %d must be specified", idx)
+		}
+
+		tapAddrs[idx], err = address.DecodeAddress(
+			encodedAddrs[idx], &tapParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The addrs may be for different asset IDs. The porter funds
+		// a separate virtual packet for each asset ID and anchors all
+		// of them in the same BTC level transaction.
+		//
+		// TODO(guggero): Revisit after we have a way to send fungible
+		// assets with different IDs to an address (non-interactive).
+	}
+
+	return tapAddrs, nil
+}
+
+// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
+// complete an asset send. The method returns information w.r.t the on chain
+// send, as well as the proof file information the receiver needs to fully
+// receive the asset.
+func (r *rpcServer) SendAsset(ctx context.Context,
+	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+
+	tapAddrs, err := r.decodeSendAddrs(req.TapAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	prevIDs := make([]asset.PrevID, len(req.InputAnchorPoints))
+	for idx, anchorPoint := range req.InputAnchorPoints {
+		outPoint, err := UnmarshalOutpoint(anchorPoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input anchor point "+
+				"%d: %w", idx, err)
+		}
+
+		prevIDs[idx] = asset.PrevID{
+			OutPoint: *outPoint,
+		}
+	}
+
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateLabel(req.Label); err != nil {
+		return nil, err
+	}
+
+	anchorLockTime := tapfreighter.AnchorLockTime{
+		LockTime: req.AnchorLockTime,
+		Sequence: req.AnchorSequence,
+	}
+	if err := anchorLockTime.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewAddressParcelWithInputs(
+			prevIDs, strategy, req.Label,
+			btcutil.Amount(req.MaxChainFeeSat), anchorLockTime,
+			tapAddrs...,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := r.marshalOutboundParcel(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// BurnAsset burns the given number of units of a given asset by sending them
+// to a provably un-spendable script key. Burning means irrevocably destroying
+// a certain number of assets, reducing the total supply of the asset. Because
+// burning is such a destructive and non-reversible operation, some specific
+// values need to be set in the request to avoid accidental burns.
+func (r *rpcServer) BurnAsset(ctx context.Context,
+	in *taprpc.BurnAssetRequest) (*taprpc.BurnAssetResponse, error) {
+
+	var assetID asset.ID
+	switch {
+	case len(in.GetAssetId()) > 0:
+		copy(assetID[:], in.GetAssetId())
+
+	case len(in.GetAssetIdStr()) > 0:
+		assetIDBytes, err := hex.DecodeString(in.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error decoding asset ID: %w",
+				err)
+		}
+
+		copy(assetID[:], assetIDBytes)
+
+	default:
+		return nil, fmt.Errorf("asset ID must be specified")
+	}
+
+	if in.AmountToBurn == 0 {
+		return nil, fmt.Errorf("amount to burn must be specified")
+	}
+	if in.ConfirmationText != AssetBurnConfirmationText {
+		return nil, fmt.Errorf("invalid confirmation text, please " +
+			"read API doc and confirm safety measure to avoid " +
+			"accidental asset burns")
+	}
+
+	fundResp, err := r.cfg.AssetWallet.FundBurn(
+		ctx, &tapscript.FundingDescriptor{
+			ID:     assetID,
+			Amount: in.AmountToBurn,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error funding burn: %w", err)
+	}
+
+	// Now we can sign the packet and send it to the chain.
+	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundResp.VPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewBurnParcel(
+			fundResp.VPacket, fundResp.InputCommitments, in.Note,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := r.marshalOutboundParcel(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	var burnProof *taprpc.DecodedProof
+	for idx := range resp.Outputs {
+		vOut := fundResp.VPacket.Outputs[idx]
+		tOut := resp.Outputs[idx]
+		if vOut.Asset.IsBurn() {
+			var p proof.Proof
+			err = p.Decode(bytes.NewReader(tOut.ProofSuffix))
+			if err != nil {
+				return nil, fmt.Errorf("error decoding "+
+					"burn proof: %w", err)
+			}
+
+			burnProof, err = r.marshalProof(ctx, &p, true, false)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding "+
+					"burn proof: %w", err)
+			}
+		}
+	}
+
+	return &taprpc.BurnAssetResponse{
+		BurnTransfer: parcel,
+		BurnProof:    burnProof,
+	}, nil
+}
+
+// ListBurns lists the asset burns of the burn registry, optionally filtered
+// by the burned asset, its group or the anchor transaction of the burn.
+func (r *rpcServer) ListBurns(ctx context.Context,
+	in *taprpc.ListBurnsRequest) (*taprpc.ListBurnsResponse, error) {
+
+	var (
+		filters tapdb.BurnQueryFilters
+		err     error
+	)
+	if len(in.AssetId) > 0 {
+		if len(in.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], in.AssetId)
+		filters.AssetID = &assetID
+	}
+
+	if len(in.TweakedGroupKey) > 0 {
+		filters.GroupKey, err = btcec.ParsePubKey(in.TweakedGroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing group key: %w",
+				err)
+		}
+	}
+
+	if in.AnchorTxid != "" {
+		filters.AnchorTXID, err = chainhash.NewHashFromStr(
+			in.AnchorTxid,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing anchor txid: %w",
+				err)
+		}
+	}
+
+	burns, err := r.cfg.AssetStore.QueryBurns(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query burns: %w", err)
+	}
+
+	return &taprpc.ListBurnsResponse{
+		Burns: fn.Map(burns, marshalAssetBurn),
+	}, nil
+}
+
+// ExportBurnAttestation exports an attestation of a confirmed asset burn that
+// contains the full proof file of the burn output.
+func (r *rpcServer) ExportBurnAttestation(ctx context.Context,
+	in *taprpc.ExportBurnAttestationRequest) (*taprpc.BurnAttestation,
+	error) {
+
+	if len(in.AssetId) != sha256.Size {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], in.AssetId)
+
+	scriptKey, err := parseUserKey(in.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	burns, err := r.cfg.AssetStore.QueryBurns(ctx, tapdb.BurnQueryFilters{
+		AssetID:   &assetID,
+		ScriptKey: scriptKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query burns: %w", err)
+	}
+	if len(burns) == 0 {
+		return nil, fmt.Errorf("no burn found for asset ID %v and "+
+			"script key %x", assetID, in.ScriptKey)
+	}
+
+	// The proof file of the burn is only complete once its anchor
+	// transaction confirmed.
+	burn := burns[0]
+	if burn.BlockHash == nil {
+		return nil, fmt.Errorf("burn anchor transaction %v is not yet "+
+			"confirmed", burn.AnchorTXID)
+	}
+
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch burn proof: %w", err)
+	}
+
+	// We make sure the proof we export actually proves the burn, so the
+	// attestation holds up when it is verified by a third party.
+	var proofFile proof.File
+	if err := proofFile.Decode(bytes.NewReader(proofBlob)); err != nil {
+		return nil, fmt.Errorf("unable to decode burn proof file: %w",
+			err)
+	}
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch last burn proof: %w",
+			err)
+	}
+	if !lastProof.Asset.IsBurn() {
+		return nil, fmt.Errorf("proof for asset ID %v and script key "+
+			"%x is not a burn proof", assetID, in.ScriptKey)
+	}
+
+	return &taprpc.BurnAttestation{
+		Burn:         marshalAssetBurn(burn),
+		RawProofFile: proofBlob,
+		GenesisPoint: lastProof.Asset.Genesis.FirstPrevOut.String(),
+	}, nil
+}
+
+// marshalAssetBurn turns an asset burn of the burn registry into its RPC
+// counterpart.
+func marshalAssetBurn(burn *tapfreighter.AssetBurn) *taprpc.AssetBurn {
+	rpcBurn := &taprpc.AssetBurn{
+		Note:              burn.Note,
+		AssetId:           fn.ByteSlice(burn.AssetID),
+		Amount:            burn.Amount,
+		ScriptKey:         burn.ScriptKey.SerializeCompressed(),
+		AnchorTxid:        burn.AnchorTXID.String(),
+		TransferTimestamp: burn.TransferTime.Unix(),
+		BlockHeight:       burn.BlockHeight,
+	}
+	if burn.GroupKey != nil {
+		rpcBurn.TweakedGroupKey = burn.GroupKey.SerializeCompressed()
+	}
+	if burn.BlockHash != nil {
+		rpcBurn.BlockHash = burn.BlockHash.String()
+	}
+
+	return rpcBurn
+}
+
+// BumpTransferFee replaces the anchor transaction of a pending, unconfirmed
+// asset transfer with one that pays a higher fee rate.
+func (r *rpcServer) BumpTransferFee(ctx context.Context,
+	in *taprpc.BumpTransferFeeRequest) (*taprpc.BumpTransferFeeResponse,
+	error) {
+
+	anchorTXID, err := chainhash.NewHashFromStr(in.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing anchor txid: %w", err)
+	}
+
+	if in.SatPerVbyte == 0 {
+		return nil, fmt.Errorf("fee rate must be specified")
+	}
+	feeRate := chainfee.SatPerKVByte(in.SatPerVbyte * 1000).FeePerKWeight()
+
+	resp, err := r.cfg.ChainPorter.BumpFee(ctx, *anchorTXID, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("error bumping transfer fee: %w", err)
+	}
+
+	parcel, err := r.marshalOutboundParcel(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.BumpTransferFeeResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// CpfpTransfer accelerates the confirmation of the anchor transaction of a
+// pending asset transfer by spending its BTC change output in a child
+// transaction.
+func (r *rpcServer) CpfpTransfer(ctx context.Context,
+	in *taprpc.CpfpTransferRequest) (*taprpc.CpfpTransferResponse, error) {
+
+	anchorTXID, err := chainhash.NewHashFromStr(in.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing anchor txid: %w", err)
+	}
+
+	if in.SatPerVbyte == 0 {
+		return nil, fmt.Errorf("fee rate must be specified")
+	}
+	feeRate := chainfee.SatPerKVByte(in.SatPerVbyte * 1000).FeePerKWeight()
+
+	changeOutPoint, err := r.cfg.ChainPorter.BumpFeeCpfp(
+		ctx, *anchorTXID, feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error bumping transfer fee: %w", err)
+	}
+
+	return &taprpc.CpfpTransferResponse{
+		ChangeOutpoint: changeOutPoint.String(),
+	}, nil
+}
+
+// CancelTransfer cancels a pending asset transfer whose anchor transaction
+// hasn't confirmed yet by double spending its BTC inputs.
+func (r *rpcServer) CancelTransfer(ctx context.Context,
+	in *taprpc.CancelTransferRequest) (*taprpc.CancelTransferResponse,
+	error) {
+
+	anchorTXID, err := chainhash.NewHashFromStr(in.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing anchor txid: %w", err)
+	}
+
+	feeRate := chainfee.SatPerKVByte(in.SatPerVbyte * 1000).FeePerKWeight()
+	cancelTx, err := r.cfg.ChainPorter.CancelTransfer(
+		ctx, *anchorTXID, feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error cancelling transfer: %w", err)
+	}
+
+	return &taprpc.CancelTransferResponse{
+		CancelTxid: cancelTx.TxHash().String(),
+	}, nil
+}
+
+// ResumeTransferDelivery resumes the delivery of the receiver proofs of a
+// confirmed asset transfer after it failed, optionally through a different
+// proof courier.
+func (r *rpcServer) ResumeTransferDelivery(ctx context.Context,
+	in *taprpc.ResumeTransferDeliveryRequest) (
+	*taprpc.ResumeTransferDeliveryResponse, error) {
+
+	anchorTXID, err := chainhash.NewHashFromStr(in.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing anchor txid: %w", err)
+	}
+
+	var courierAddr *url.URL
+	if in.ProofCourierAddr != "" {
+		addr, err := proof.ParseCourierAddrString(in.ProofCourierAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof courier "+
+				"address: %w", err)
+		}
+		courierAddr = addr.Url()
+	}
+
+	err = r.cfg.ChainPorter.ResumeDelivery(ctx, *anchorTXID, courierAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error resuming proof delivery: %w",
+			err)
+	}
+
+	return &taprpc.ResumeTransferDeliveryResponse{}, nil
+}
+
+// unmarshalQueueConditions turns the RPC conditions of a queued send into
+// their native counterparts.
+func unmarshalQueueConditions(sendAfterUnix int64,
+	maxSatPerVByte uint64) (time.Time, chainfee.SatPerKWeight, error) {
+
+	if sendAfterUnix < 0 {
+		return time.Time{}, 0, fmt.Errorf("send after time must not " +
+			"be negative")
+	}
+
+	var sendAfter time.Time
+	if sendAfterUnix != 0 {
+		sendAfter = time.Unix(sendAfterUnix, 0).UTC()
+	}
+
+	maxFeeRate := chainfee.SatPerKVByte(maxSatPerVByte * 1000)
+
+	return sendAfter, maxFeeRate.FeePerKWeight(), nil
+}
+
+// marshalQueuedSend turns a queued send into its RPC counterpart.
+func marshalQueuedSend(send *tapfreighter.QueuedSend) (*taprpc.QueuedSend,
+	error) {
+
+	strategy, err := marshalCoinSelectStrategy(send.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	var sendAfterUnix int64
+	if !send.SendAfter.IsZero() {
+		sendAfterUnix = send.SendAfter.Unix()
+	}
+
+	return &taprpc.QueuedSend{
+		Id:                 send.ID,
+		TapAddrs:           send.TapAddrs,
+		CoinSelectStrategy: strategy,
+		SendAfterUnix:      sendAfterUnix,
+		MaxSatPerVbyte: uint64(
+			send.MaxFeeRate.FeePerKVByte() / 1000,
+		),
+		CreationTimeUnix: send.CreationTime.Unix(),
+		LastError:        send.LastError,
+		Failed:           send.Failed,
+	}, nil
+}
+
+// QueueSend queues a send to one or more Taproot Asset addresses that is
+// executed once its conditions are met.
+func (r *rpcServer) QueueSend(ctx context.Context,
+	req *taprpc.QueueSendRequest) (*taprpc.QueuedSend, error) {
+
+	// We decode the addresses here to apply the same rules as for an
+	// immediate send, the queue only keeps their encoded form.
+	if _, err := r.decodeSendAddrs(req.TapAddrs); err != nil {
+		return nil, err
+	}
+
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	sendAfter, maxFeeRate, err := unmarshalQueueConditions(
+		req.SendAfterUnix, req.MaxSatPerVbyte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	send, err := r.cfg.SendQueue.QueueSend(ctx, &tapfreighter.QueuedSend{
+		TapAddrs:           req.TapAddrs,
+		CoinSelectStrategy: strategy,
+		SendAfter:          sendAfter,
+		MaxFeeRate:         maxFeeRate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalQueuedSend(send)
+}
+
+// ListQueuedSends lists all sends that are queued and not yet executed.
+func (r *rpcServer) ListQueuedSends(ctx context.Context,
+	_ *taprpc.ListQueuedSendsRequest) (*taprpc.ListQueuedSendsResponse,
+	error) {
+
+	sends, err := r.cfg.SendQueue.QueuedSends(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list queued sends: %w", err)
+	}
+
+	rpcSends := make([]*taprpc.QueuedSend, len(sends))
+	for idx := range sends {
+		rpcSends[idx], err = marshalQueuedSend(sends[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &taprpc.ListQueuedSendsResponse{
+		QueuedSends: rpcSends,
+	}, nil
+}
+
+// UpdateQueuedSend replaces the conditions of a queued send.
+func (r *rpcServer) UpdateQueuedSend(ctx context.Context,
+	req *taprpc.UpdateQueuedSendRequest) (*taprpc.QueuedSend, error) {
+
+	sendAfter, maxFeeRate, err := unmarshalQueueConditions(
+		req.SendAfterUnix, req.MaxSatPerVbyte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	send, err := r.cfg.SendQueue.UpdateQueuedSend(
+		ctx, req.Id, sendAfter, maxFeeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalQueuedSend(send)
+}
+
+// CancelQueuedSend removes a send from the queue before it is executed.
+func (r *rpcServer) CancelQueuedSend(ctx context.Context,
+	req *taprpc.CancelQueuedSendRequest) (*taprpc.CancelQueuedSendResponse,
+	error) {
+
+	err := r.cfg.SendQueue.CancelQueuedSend(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.CancelQueuedSendResponse{}, nil
+}
+
+// ConsolidateAssets merges the smallest UTXOs of an asset into a single output
+// of the wallet, so that at most the given number of UTXOs of the asset
+// remain.
+func (r *rpcServer) ConsolidateAssets(ctx context.Context,
+	req *taprpc.ConsolidateAssetsRequest) (
+	*taprpc.ConsolidateAssetsResponse, error) {
+
+	if len(req.AssetId) != sha256.Size {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	if req.TargetUtxoCount == 0 {
+		return nil, fmt.Errorf("target UTXO count must be at least one")
+	}
+
+	resp, err := r.cfg.Consolidator.ConsolidateAsset(
+		ctx, assetID, req.TargetUtxoCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to consolidate assets: %w", err)
+	}
+
+	transfer, err := r.marshalOutboundParcel(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
+	}
+
+	return &taprpc.ConsolidateAssetsResponse{
+		Transfer: transfer,
+	}, nil
+}
+
+// SweepAssets moves all UTXOs of an asset, or of all assets, to new script keys
+// of the wallet in a single anchor transaction.
+func (r *rpcServer) SweepAssets(ctx context.Context,
+	req *taprpc.SweepAssetsRequest) (*taprpc.SweepAssetsResponse, error) {
+
+	var assetID *asset.ID
+	if len(req.AssetId) != 0 {
+		if len(req.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		assetID = &asset.ID{}
+		copy(assetID[:], req.AssetId)
+	}
+
+	resp, deferred, err := r.cfg.Consolidator.SweepAssets(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sweep assets: %w", err)
+	}
+
+	transfer, err := r.marshalOutboundParcel(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal transfer: %w", err)
+	}
+
+	deferredIDs := make([][]byte, len(deferred))
+	for idx := range deferred {
+		deferredIDs[idx] = fn.ByteSlice(deferred[idx])
+	}
+
+	return &taprpc.SweepAssetsResponse{
+		Transfer:         transfer,
+		DeferredAssetIds: deferredIDs,
+	}, nil
+}
+
+// marshalOutboundParcel turns a pending parcel into its RPC counterpart.
+func (r *rpcServer) marshalOutboundParcel(ctx context.Context,
+	parcel *tapfreighter.OutboundParcel) (*taprpc.AssetTransfer,
+	error) {
+
+	rpcInputs := make([]*taprpc.TransferInput, len(parcel.Inputs))
+	for idx := range parcel.Inputs {
+		in := parcel.Inputs[idx]
+
+		decimalDisplay, err := r.fetchDecimalDisplay(ctx, in.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcInputs[idx] = &taprpc.TransferInput{
+			AnchorPoint:    in.OutPoint.String(),
+			AssetId:        in.ID[:],
+			ScriptKey:      in.ScriptKey[:],
+			Amount:         in.Amount,
+			DecimalDisplay: decimalDisplay,
+		}
+	}
+
+	// The outputs carry the assets that are being transferred, which are
+	// the ones spent by the inputs. If the transfer moves more than one
+	// asset, we look up the asset of each output in its proof suffix.
+	var (
+		outputDecimalDisplay uint32
+		multipleAssets       bool
+	)
+	if len(rpcInputs) > 0 {
+		outputDecimalDisplay = rpcInputs[0].DecimalDisplay
+	}
+	for idx := range parcel.Inputs {
+		if parcel.Inputs[idx].ID != parcel.Inputs[0].ID {
+			multipleAssets = true
+		}
+	}
+
+	rpcOutputs := make(
+		[]*taprpc.TransferOutput, len(parcel.Outputs),
+	)
+	for idx := range parcel.Outputs {
+		out := parcel.Outputs[idx]
+
+		internalPubKey := out.Anchor.InternalKey.PubKey
+		internalKeyBytes := internalPubKey.SerializeCompressed()
+		rpcAnchor := &taprpc.TransferOutputAnchor{
+			Outpoint:         out.Anchor.OutPoint.String(),
+			Value:            int64(out.Anchor.Value),
+			InternalKey:      internalKeyBytes,
+			TaprootAssetRoot: out.Anchor.TaprootAssetRoot[:],
+			MerkleRoot:       out.Anchor.MerkleRoot[:],
+			TapscriptSibling: out.Anchor.TapscriptSibling,
+			NumPassiveAssets: out.Anchor.NumPassiveAssets,
+		}
+		scriptPubKey := out.ScriptKey.PubKey
+
+		var splitCommitRoot []byte
+		if out.SplitCommitmentRoot != nil {
+			hash := out.SplitCommitmentRoot.NodeHash()
+			if hash != mssmt.ZeroNodeHash {
+				splitCommitRoot = hash[:]
+			}
+		}
+
+		rpcOutType, err := marshalOutputType(out.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			out.AssetVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		decimalDisplay := outputDecimalDisplay
+		if multipleAssets && len(out.ProofSuffix) > 0 {
+			var proofSuffix proof.Proof
+			err := proofSuffix.Decode(
+				bytes.NewReader(out.ProofSuffix),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"proof suffix of output %d: %w", idx,
+					err)
+			}
+
+			decimalDisplay, err = r.fetchDecimalDisplay(
+				ctx, proofSuffix.Asset.ID(),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rpcOutputs[idx] = &taprpc.TransferOutput{
+			Anchor:              rpcAnchor,
+			ScriptKey:           scriptPubKey.SerializeCompressed(),
+			ScriptKeyIsLocal:    out.ScriptKeyLocal,
+			Amount:              out.Amount,
+			NewProofBlob:        out.ProofSuffix,
+			SplitCommitRootHash: splitCommitRoot,
+			OutputType:          rpcOutType,
+			AssetVersion:        assetVersion,
+			DecimalDisplay:      decimalDisplay,
+			RecipientAddr:       out.RecipientAddr,
+		}
+	}
+
+	anchorTxHash := parcel.AnchorTx.TxHash()
+	return &taprpc.AssetTransfer{
+		TransferTimestamp:  parcel.TransferTime.Unix(),
+		AnchorTxHash:       anchorTxHash[:],
+		AnchorTxHeightHint: parcel.AnchorTxHeightHint,
+		AnchorTxChainFees:  parcel.ChainFees,
+		Inputs:             rpcInputs,
+		Outputs:            rpcOutputs,
+		Label:              parcel.Label,
+	}, nil
+}
+
+// marshalOutputType turns the transfer output type into the RPC counterpart.
+func marshalOutputType(outputType tappsbt.VOutputType) (taprpc.OutputType,
+	error) {
+
+	switch outputType {
+	case tappsbt.TypeSimple:
+		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE, nil
+
+	case tappsbt.TypeSplitRoot:
+		return taprpc.OutputType_OUTPUT_TYPE_SPLIT_ROOT, nil
+
+	case tappsbt.TypePassiveAssetsOnly:
+		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_ASSETS_ONLY, nil
+
+	case tappsbt.TypePassiveSplitRoot:
+		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_SPLIT_ROOT, nil
+
+	case tappsbt.TypeSimplePassiveAssets:
+		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE_PASSIVE_ASSETS, nil
+
+	default:
+		return 0, fmt.Errorf("unknown output type: %d", outputType)
+	}
+}
+
+// SubscribeSendAssetEventNtfns registers a subscription to the event
+// notification stream which relates to the asset sending process.
+func (r *rpcServer) SubscribeSendAssetEventNtfns(
+	req *taprpc.SubscribeSendAssetEventNtfnsRequest,
+	ntfnStream taprpc.TaprootAssets_SubscribeSendAssetEventNtfnsServer) error {
+
+	var (
+		replay          tapfreighter.SendEventReplay
+		deliverExisting bool
+	)
+	if req.ReplayFromAnchorTxid != "" {
+		anchorTXID, err := chainhash.NewHashFromStr(
+			req.ReplayFromAnchorTxid,
+		)
+		if err != nil {
+			return fmt.Errorf("error parsing anchor txid: %w", err)
+		}
+
+		replay.AnchorTXID = anchorTXID
+		deliverExisting = true
+	}
+
+	// Create a new event subscriber and pass a copy to the chain porter.
+	// We will then read events from the subscriber.
+	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	defer eventSubscriber.Stop()
+
+	err := r.cfg.ChainPorter.RegisterSubscriber(
+		eventSubscriber, deliverExisting, replay,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register event notifications "+
+			"subscription: %w", err)
+	}
+	defer func() {
+		err := r.cfg.ChainPorter.RemoveSubscriber(eventSubscriber)
+		if err != nil {
+			rpcsLog.Warnf("Unable to remove send event "+
+				"subscriber: %v", err)
+		}
+	}()
+
+	// Loop and read from the ChainPorter event subscription and forward to
+	// the RPC stream.
+	for {
+		select {
+		// Handle receiving a new event from the ChainPorter.
+		// The event will be mapped to the RPC event type and
+		// sent over the stream.
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+
+			rpcEvent, err := marshallSendAssetEvent(event)
+			if err != nil {
+				return fmt.Errorf("failed to marshall "+
+					"ChainPorter event into RPC event: "+
+					"%w", err)
+			}
+
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
+
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// SubscribeMintEvents registers a subscription to the state transitions of all
+// minting batches. Past transitions can be replayed starting from a given
+// batch or block height before any new transitions are delivered.
+func (r *rpcServer) SubscribeMintEvents(
+	req *mintrpc.SubscribeMintEventsRequest,
+	ntfnStream mintrpc.Mint_SubscribeMintEventsServer) error {
+
+	var (
+		replay          tapgarden.MintEventReplay
+		deliverExisting bool
+	)
+	if len(req.ReplayFromBatchKey) != 0 {
+		batchKey, err := btcec.ParsePubKey(req.ReplayFromBatchKey)
+		if err != nil {
+			return fmt.Errorf("invalid batch key: %w", err)
+		}
+
+		replay.BatchKey = batchKey
+		deliverExisting = true
+	}
+	if req.ReplayFromHeight != 0 {
+		replay.StartHeight = req.ReplayFromHeight
+		deliverExisting = true
+	}
+
+	// Create a new event subscriber and pass a copy to the asset minter.
+	// We will then read events from the subscriber.
+	eventSubscriber := fn.NewEventReceiver[*tapgarden.MintEvent](
+		fn.DefaultQueueSize,
+	)
+	defer eventSubscriber.Stop()
+
+	err := r.cfg.AssetMinter.RegisterSubscriber(
+		eventSubscriber, deliverExisting, replay,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register mint event "+
+			"subscription: %w", err)
+	}
+	defer func() {
+		err := r.cfg.AssetMinter.RemoveSubscriber(eventSubscriber)
+		if err != nil {
+			rpcsLog.Warnf("Unable to remove mint event "+
+				"subscriber: %v", err)
+		}
+	}()
+
+	// An event that was committed while the subscription was registered
+	// may be delivered twice, so we skip any event we've already sent.
+	var lastEventID uint64
+	for {
+		select {
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+			if event.ID <= lastEventID {
+				continue
+			}
+
+			rpcEvent, err := marshalMintEvent(event)
+			if err != nil {
+				return fmt.Errorf("failed to marshal mint "+
+					"event: %w", err)
+			}
+
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
+
+			lastEventID = event.ID
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
+
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshalMintEvent maps a mint event to its RPC counterpart.
+func marshalMintEvent(event *tapgarden.MintEvent) (*mintrpc.MintEvent, error) {
+	rpcBatchState, err := marshalBatchState(event.BatchState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.MintEvent{
+		EventId:     event.ID,
+		Timestamp:   event.Timestamp().UnixMicro(),
+		BatchKey:    event.BatchKey.SerializeCompressed(),
+		BatchState:  rpcBatchState,
+		BlockHeight: event.BlockHeight,
+	}, nil
+}
+
+// marshallSendAssetEvent maps a ChainPorter event to its RPC counterpart.
+func marshallSendAssetEvent(
+	eventInterface fn.Event) (*taprpc.SendAssetEvent, error) {
+
+	switch event := eventInterface.(type) {
+	case *tapfreighter.ExecuteSendStateEvent:
+		rpcEvent := &taprpc.ExecuteSendStateEvent{
+			Timestamp: event.Timestamp().UnixMicro(),
+			SendState: event.SendState.String(),
+			EventId:   event.ID,
+			Attempt:   event.Attempt,
+		}
+		if event.AnchorTXID != nil {
+			rpcEvent.AnchorTxid = event.AnchorTXID.String()
+		}
+		if event.Err != nil {
+			rpcEvent.Error = &taprpc.SendStateError{
+				Message:   event.Err.Error(),
+				Resumable: event.Resumable,
+			}
+		}
+
+		eventRpc := &taprpc.SendAssetEvent_ExecuteSendStateEvent{
+			ExecuteSendStateEvent: rpcEvent,
+		}
+		return &taprpc.SendAssetEvent{
+			Event: eventRpc,
+		}, nil
+
+	case *proof.ReceiverProofBackoffWaitEvent:
+		eventRpc := taprpc.SendAssetEvent_ReceiverProofBackoffWaitEvent{
+			ReceiverProofBackoffWaitEvent: &taprpc.ReceiverProofBackoffWaitEvent{
+				Timestamp:    event.Timestamp().UnixMicro(),
+				Backoff:      event.Backoff.Microseconds(),
+				TriesCounter: event.TriesCounter,
+			},
+		}
+		return &taprpc.SendAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
+	}
+}
+
+// marshalBatchCost marshals the on-chain cost of a batch into the RPC
+// counterpart.
+func marshalBatchCost(cost *tapgarden.BatchCost) (*mintrpc.BatchCost, error) {
+	batch := cost.Batch
+	rpcBatchState, err := marshalBatchState(batch.State())
+	if err != nil {
+		return nil, err
+	}
+
+	assetCosts := fn.Map(
+		cost.AssetCosts,
+		func(c tapgarden.AssetCost) *mintrpc.AssetCost {
+			return &mintrpc.AssetCost{
+				AssetId:          fn.ByteSlice(c.AssetID),
+				Name:             c.Name,
+				Amount:           c.Amount,
+				AmortizedFeeSats: int64(c.AmortizedFee),
+			}
+		},
+	)
+
+	return &mintrpc.BatchCost{
+		BatchKey:         batch.BatchKey.PubKey.SerializeCompressed(),
+		Label:            batch.Label,
+		State:            rpcBatchState,
+		CreationTimeUnix: batch.CreationTime.Unix(),
+		Txid:             cost.Txid.String(),
+		ChainFeesSats:    int64(cost.ChainFees),
+		Vsize:            cost.VSize(),
+		AssetCosts:       assetCosts,
+	}, nil
+}
+
+// marshalMintingBatch marshals a minting batch into the RPC counterpart.
+func marshalMintingBatch(batch *tapgarden.MintingBatch,
+	skipSeedlings bool) (*mintrpc.MintingBatch, error) {
+
+	rpcBatchState, err := marshalBatchState(batch.State())
+	if err != nil {
+		return nil, err
+	}
+
+	rpcReOrgState, err := marshalBatchReOrgState(batch.ReOrgState)
+	if err != nil {
+		return nil, err
+	}
+
+	tapscriptSibling, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		batch.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcBatch := &mintrpc.MintingBatch{
+		BatchKey:         batch.BatchKey.PubKey.SerializeCompressed(),
+		State:            rpcBatchState,
+		TapscriptSibling: tapscriptSibling,
+		ExternalFunding:  batch.ExternalFunding,
+		Label:            batch.Label,
+		ReorgState:       rpcReOrgState,
+		NumReorgs:        batch.NumReOrgs,
+	}
+
+	// If we don't need to include the seedlings, we can return here.
+	if skipSeedlings {
+		return rpcBatch, nil
+	}
+
+	// When we have sprouts, then they represent the same assets as the
+	// seedlings but in a more "grown up" state. So in that case we only
+	// marshal the sprouts.
+	switch {
+	// We have sprouts, ignore seedlings.
+	case batch.RootAssetCommitment != nil &&
+		len(batch.RootAssetCommitment.CommittedAssets()) > 0:
+
+		rpcBatch.Assets = marshalSprouts(
+			batch.RootAssetCommitment.CommittedAssets(),
+			batch.AssetMetas,
+		)
+
+	// No sprouts, so we marshal the seedlings.
+	case len(batch.Seedlings) > 0:
+		rpcBatch.Assets, err = marshalSeedlings(batch.Seedlings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rpcBatch, nil
+}
+
+// marshalSeedlings marshals the seedlings into the RPC counterpart.
+func marshalSeedlings(
+	seedlings map[string]*tapgarden.Seedling) ([]*mintrpc.MintAsset, error) {
+
+	rpcAssets := make([]*mintrpc.MintAsset, 0, len(seedlings))
+	for _, seedling := range seedlings {
+		var groupKeyBytes []byte
+		if seedling.HasGroupKey() {
+			groupKey := seedling.GroupInfo.GroupKey
+			groupPubKey := groupKey.GroupPubKey
+			groupKeyBytes = groupPubKey.SerializeCompressed()
+		}
+
+		var groupAnchor string
+		if seedling.GroupAnchor != nil {
+			groupAnchor = *seedling.GroupAnchor
+		}
+
+		var seedlingMeta *taprpc.AssetMeta
+		if seedling.Meta != nil {
+			seedlingMeta = marshalAssetMeta(seedling.Meta)
+		}
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			seedling.AssetVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var groupInternalKey *taprpc.KeyDescriptor
+		if seedling.GroupInternalKey != nil {
+			groupInternalKey = marshalKeyDescriptor(
+				*seedling.GroupInternalKey,
+			)
+		}
+
+		var scriptKey *taprpc.ScriptKey
+		if seedling.ScriptKey != nil {
+			scriptKey = marshalScriptKey(*seedling.ScriptKey)
+		}
+
+		var groupMultiSig *mintrpc.GroupMultiSig
+		if seedling.GroupMultiSig != nil {
+			groupMultiSig = marshalGroupMultiSig(
+				seedling.GroupMultiSig,
+			)
+		}
+
+		var delegateKey *taprpc.KeyDescriptor
+		if seedling.DelegateKey != nil {
+			delegateKey = marshalKeyDescriptor(
+				*seedling.DelegateKey,
+			)
+		}
+
+		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
+			AssetType: taprpc.AssetType(
+				seedling.AssetType,
+			),
+			AssetVersion:        assetVersion,
+			Name:                seedling.AssetName,
+			AssetMeta:           seedlingMeta,
+			Amount:              seedling.Amount,
+			GroupKey:            groupKeyBytes,
+			GroupAnchor:         groupAnchor,
+			ExternalGroupSigner: seedling.ExternalGroupSigner,
+			GroupInternalKey:    groupInternalKey,
+			DecimalDisplay:      seedling.DecimalDisplay,
+			MaxSupply:           seedling.MaxSupply,
+			VanityPrefix:        seedling.VanityPrefix,
+			EmissionSchedule: marshalEmissionSchedule(
+				seedling.EmissionSchedule,
+			),
+			ScriptKey:     scriptKey,
+			GroupMultisig: groupMultiSig,
+			DelegateKey:   delegateKey,
+		})
+	}
+
+	return rpcAssets, nil
+}
+
+// marshalSprouts marshals the sprouts into the RPC counterpart.
+func marshalSprouts(sprouts []*asset.Asset,
+	metas tapgarden.AssetMetas) []*mintrpc.MintAsset {
+
+	rpcAssets := make([]*mintrpc.MintAsset, 0, len(sprouts))
+	for _, sprout := range sprouts {
+		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+
+		var (
+			assetMeta      *taprpc.AssetMeta
+			decimalDisplay uint32
+			maxSupply      uint64
+			schedule       proof.EmissionSchedule
+		)
+		if metas != nil {
+			if m, ok := metas[scriptKey]; ok && m != nil {
+				assetMeta = marshalAssetMeta(m)
+
+				// The meta data was already validated when
+				// the seedling was added to the batch.
+				decimalDisplay, _ = m.DecimalDisplay()
+				maxSupply, _ = m.MaxSupply()
+				schedule, _ = m.EmissionSchedule()
+			}
+		}
+
+		var groupKeyBytes []byte
+		if sprout.GroupKey != nil {
+			gpk := sprout.GroupKey.GroupPubKey
+			groupKeyBytes = gpk.SerializeCompressed()
+		}
+
+		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
+			AssetType:      taprpc.AssetType(sprout.Type),
+			Name:           sprout.Tag,
+			AssetMeta:      assetMeta,
+			Amount:         sprout.Amount,
+			GroupKey:       groupKeyBytes,
+			DecimalDisplay: decimalDisplay,
+			MaxSupply:      maxSupply,
+			EmissionSchedule: marshalEmissionSchedule(
+				schedule,
+			),
+		})
+	}
+
+	return rpcAssets
+}
+
+// marshalEmissionSchedule converts an emission schedule into its RPC
+// counterpart.
+func marshalEmissionSchedule(
+	schedule proof.EmissionSchedule) []*taprpc.EmissionEpoch {
+
+	if len(schedule) == 0 {
+		return nil
+	}
+
+	rpcSchedule := make([]*taprpc.EmissionEpoch, len(schedule))
+	for idx, epoch := range schedule {
+		rpcSchedule[idx] = &taprpc.EmissionEpoch{
+			StartHeight: epoch.StartHeight,
+			Amount:      epoch.Amount,
+		}
+	}
+
+	return rpcSchedule
+}
+
+// unmarshalEmissionSchedule converts an RPC emission schedule into its native
+// counterpart. The schedule is validated along with the seedling it belongs
+// to.
+func unmarshalEmissionSchedule(
+	rpcSchedule []*taprpc.EmissionEpoch) proof.EmissionSchedule {
+
+	if len(rpcSchedule) == 0 {
+		return nil
+	}
+
+	schedule := make(proof.EmissionSchedule, len(rpcSchedule))
+	for idx, rpcEpoch := range rpcSchedule {
+		schedule[idx] = proof.EmissionEpoch{
+			StartHeight: rpcEpoch.StartHeight,
+			Amount:      rpcEpoch.Amount,
+		}
+	}
+
+	return schedule
+}
+
+// marshalBatchState converts the batch state field into its RPC counterpart.
+func marshalBatchState(
+	currentBatchState tapgarden.BatchState) (mintrpc.BatchState, error) {
+
+	switch currentBatchState {
+	case tapgarden.BatchStatePending:
+		return mintrpc.BatchState_BATCH_STATE_PEDNING, nil
+
+	case tapgarden.BatchStateFrozen:
+		return mintrpc.BatchState_BATCH_STATE_FROZEN, nil
+
+	case tapgarden.BatchStateCommitted:
+		return mintrpc.BatchState_BATCH_STATE_COMMITTED, nil
+
+	case tapgarden.BatchStateBroadcast:
+		return mintrpc.BatchState_BATCH_STATE_BROADCAST, nil
+
+	case tapgarden.BatchStateConfirmed:
+		return mintrpc.BatchState_BATCH_STATE_CONFIRMED, nil
+
+	case tapgarden.BatchStateFinalized:
+		return mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+
+	case tapgarden.BatchStateSeedlingCancelled:
+		return mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED, nil
+
+	case tapgarden.BatchStateSproutCancelled:
+		return mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch state: %v",
+			currentBatchState.String())
+	}
+}
+
+// marshalBatchReOrgState converts the re-org state of a batch to its RPC
+// counterpart.
+func marshalBatchReOrgState(
+	reOrgState tapgarden.BatchReOrgState) (mintrpc.BatchReOrgState, error) {
+
+	switch reOrgState {
+	case tapgarden.ReOrgStateNone:
+		return mintrpc.BatchReOrgState_BATCH_REORG_STATE_NONE, nil
+
+	case tapgarden.ReOrgStatePending:
+		return mintrpc.BatchReOrgState_BATCH_REORG_STATE_PENDING, nil
+
+	case tapgarden.ReOrgStateRecovered:
+		return mintrpc.BatchReOrgState_BATCH_REORG_STATE_RECOVERED, nil
+
+	case tapgarden.ReOrgStateDoubleSpent:
+		return mintrpc.BatchReOrgState_BATCH_REORG_STATE_DOUBLE_SPENT,
+			nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch re-org state: %v",
+			reOrgState.String())
+	}
+}
+
+// UnmarshalScriptKey parses the RPC script key into the native counterpart.
+func UnmarshalScriptKey(rpcKey *taprpc.ScriptKey) (*asset.ScriptKey, error) {
+	var (
+		scriptKey asset.ScriptKey
+		err       error
+	)
+
+	// The script public key is a Taproot key, so 32-byte x-only.
+	scriptKey.PubKey, err = schnorr.ParsePubKey(rpcKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key descriptor is optional for script keys that are completely
+	// independent of the backing wallet.
+	if rpcKey.KeyDesc != nil {
+		keyDesc, err := UnmarshalKeyDescriptor(rpcKey.KeyDesc)
+		if err != nil {
+			return nil, err
+		}
+		scriptKey.TweakedScriptKey = &asset.TweakedScriptKey{
+			RawKey: keyDesc,
+
+			// The tweak is optional, if it's empty it means the key
+			// is derived using BIP-0086.
+			Tweak: rpcKey.TapTweak,
+		}
+	}
+
+	return &scriptKey, nil
+}
+
+// marshalScriptKey marshals the native script key into the RPC counterpart.
+func marshalScriptKey(scriptKey asset.ScriptKey) *taprpc.ScriptKey {
+	rpcScriptKey := &taprpc.ScriptKey{
+		PubKey: schnorr.SerializePubKey(scriptKey.PubKey),
+	}
+
+	if scriptKey.TweakedScriptKey != nil {
+		rpcScriptKey.KeyDesc = marshalKeyDescriptor(
+			scriptKey.TweakedScriptKey.RawKey,
+		)
+		rpcScriptKey.TapTweak = scriptKey.TweakedScriptKey.Tweak
+	}
+
+	return rpcScriptKey
+}
+
+// parseUserKey parses a user-provided script or group key, which can be in
+// either the Schnorr or Compressed format.
+func parseUserKey(scriptKey []byte) (*btcec.PublicKey, error) {
+	switch len(scriptKey) {
+	case schnorr.PubKeyBytesLen:
+		return schnorr.ParsePubKey(scriptKey)
+
+	// Truncate the key and then parse as a Schnorr key.
+	case btcec.PubKeyBytesLenCompressed:
+		return schnorr.ParsePubKey(scriptKey[1:])
+
+	default:
+		return nil, fmt.Errorf("unknown script key length: %v",
+			len(scriptKey))
+	}
+}
+
+// marshalKeyDescriptor marshals the native key descriptor into the RPC
+// counterpart.
+func marshalKeyDescriptor(desc keychain.KeyDescriptor) *taprpc.KeyDescriptor {
+	return &taprpc.KeyDescriptor{
+		RawKeyBytes: desc.PubKey.SerializeCompressed(),
+		KeyLoc: &taprpc.KeyLocator{
+			KeyFamily: int32(desc.KeyLocator.Family),
+			KeyIndex:  int32(desc.KeyLocator.Index),
+		},
+	}
+}
+
+// UnmarshalKeyDescriptor parses the RPC key descriptor into the native
+// counterpart.
+func UnmarshalKeyDescriptor(
+	rpcDesc *taprpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+
+	var (
+		desc keychain.KeyDescriptor
+		err  error
+	)
+
+	// The public key of a key descriptor is mandatory. It is enough to
+	// locate the corresponding private key in the backing wallet. But to
+	// speed things up (and for additional context), the locator should
+	// still be provided if available.
+	desc.PubKey, err = btcec.ParsePubKey(rpcDesc.RawKeyBytes)
+	if err != nil {
+		return desc, err
+	}
+
+	if rpcDesc.KeyLoc != nil {
+		desc.KeyLocator = keychain.KeyLocator{
+			Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
+			Index:  uint32(rpcDesc.KeyLoc.KeyIndex),
+		}
+	}
+
+	return desc, nil
+}
+
+// UnmarshalTapscriptFullTree parses the RPC tapscript tree into the preimage
+// of the tapscript sibling that commits to the root of the tree.
+func UnmarshalTapscriptFullTree(
+	tree *taprpc.TapscriptFullTree) (*commitment.TapscriptPreimage, error) {
+
+	leaves, err := unmarshalTapLeaves(tree)
+	if err != nil {
+		return nil, err
+	}
+
+	return commitment.NewPreimageFromTree(leaves)
+}
+
+// unmarshalTapLeaves parses the leaves of the RPC tapscript tree.
+func unmarshalTapLeaves(
+	tree *taprpc.TapscriptFullTree) ([]txscript.TapLeaf, error) {
+
+	leaves := make([]txscript.TapLeaf, 0, len(tree.AllLeaves))
+	for _, rpcLeaf := range tree.AllLeaves {
+		if len(rpcLeaf.Script) == 0 {
+			return nil, fmt.Errorf("tapscript leaf script cannot " +
+				"be empty")
+		}
+
+		leaves = append(leaves, txscript.NewBaseTapLeaf(rpcLeaf.Script))
+	}
+
+	return leaves, nil
+}
+
+// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
+// either by the asset ID for that asset, or a meta hash.
+func (r *rpcServer) FetchAssetMeta(ctx context.Context,
+	req *taprpc.FetchAssetMetaRequest) (*taprpc.AssetMeta, error) {
+
+	var (
+		assetMeta *proof.MetaReveal
+		err       error
+	)
+
+	switch {
+	case req.GetAssetId() != nil:
+		if len(req.GetAssetId()) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.GetAssetId())
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
+			ctx, assetID,
+		)
+
+	case req.GetAssetIdStr() != "":
+		if len(req.GetAssetIdStr()) != hex.EncodedLen(sha256.Size) {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetIDBytes []byte
+		assetIDBytes, err = hex.DecodeString(req.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding asset ID: "+
+				"%w", err)
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
+			ctx, assetID,
+		)
+
+	case req.GetMetaHash() != nil:
+		if len(req.GetMetaHash()) != sha256.Size {
+			return nil, fmt.Errorf("meta hash must be 32 bytes")
+		}
+
+		var metaHash [asset.MetaHashLen]byte
+		copy(metaHash[:], req.GetMetaHash())
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
+			ctx, metaHash,
+		)
+
+	case req.GetMetaHashStr() != "":
+		if len(req.GetMetaHashStr()) != hex.EncodedLen(sha256.Size) {
+			return nil, fmt.Errorf("meta hash must be 32 bytes")
+		}
+
+		var metaHashBytes []byte
+		metaHashBytes, err = hex.DecodeString(req.GetMetaHashStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding meta hash: "+
+				"%w", err)
+		}
+
+		var metaHash [asset.MetaHashLen]byte
+		copy(metaHash[:], metaHashBytes)
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
+			ctx, metaHash,
+		)
+
+	default:
+		return nil, fmt.Errorf("either asset ID or meta hash must " +
+			"be set")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch asset "+
+			"meta: %w", err)
+	}
+
+	return marshalAssetMeta(assetMeta), nil
+}
+
+// storeMetaBlob stores the given meta data blob, so it can be served to
+// others, and returns a meta reveal that commits to it by reference. If no URL
+// is given, the URL of the blob is derived from the configured base URL.
+func (r *rpcServer) storeMetaBlob(ctx context.Context, blob []byte,
+	blobURL string, dryRun bool) (*proof.MetaReveal, error) {
+
+	if blobURL == "" && r.cfg.MetaBlobBaseURL != "" {
+		blobHash := sha256.Sum256(blob)
+		blobURL = strings.TrimSuffix(r.cfg.MetaBlobBaseURL, "/") +
+			"/" + hex.EncodeToString(blobHash[:])
+	}
+
+	ref, err := proof.NewMetaBlobRef(blob, blobURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return ref.MetaReveal()
+	}
+
+	if err := r.cfg.AssetStore.StoreMetaBlob(ctx, ref, blob); err != nil {
+		return nil, fmt.Errorf("unable to store meta blob: %w", err)
+	}
+
+	return ref.MetaReveal()
+}
+
+// FetchAssetMetaBlob allows a caller to fetch the meta data blob of an asset
+// that commits to its meta data by reference, either by the asset ID of that
+// asset, or the hash of the blob.
+func (r *rpcServer) FetchAssetMetaBlob(ctx context.Context,
+	req *taprpc.FetchAssetMetaBlobRequest) (*taprpc.AssetMetaBlob, error) {
+
+	var (
+		assetIDBytes []byte
+		blobHash     [sha256.Size]byte
+		err          error
+	)
+	switch {
+	case req.GetAssetId() != nil:
+		assetIDBytes = req.GetAssetId()
+
+	case req.GetAssetIdStr() != "":
+		assetIDBytes, err = hex.DecodeString(req.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding asset ID: "+
+				"%w", err)
+		}
+
+	case req.GetBlobHash() != nil:
+		if len(req.GetBlobHash()) != sha256.Size {
+			return nil, fmt.Errorf("blob hash must be 32 bytes")
+		}
+
+		copy(blobHash[:], req.GetBlobHash())
+
+	case req.GetBlobHashStr() != "":
+		blobHashBytes, err := hex.DecodeString(req.GetBlobHashStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding blob hash: "+
+				"%w", err)
+		}
+		if len(blobHashBytes) != sha256.Size {
+			return nil, fmt.Errorf("blob hash must be 32 bytes")
+		}
+
+		copy(blobHash[:], blobHashBytes)
+
+	default:
+		return nil, fmt.Errorf("either asset ID or blob hash must " +
+			"be set")
+	}
+
+	// A blob that is requested by its hash can only be served from our
+	// local store, as we don't know where else to find it.
+	if assetIDBytes == nil {
+		blob, err := r.cfg.AssetStore.FetchMetaBlob(ctx, blobHash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch meta blob: %w",
+				err)
+		}
+
+		return &taprpc.AssetMetaBlob{
+			Blob:     blob,
+			BlobHash: blobHash[:],
+		}, nil
+	}
+
+	if len(assetIDBytes) != sha256.Size {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], assetIDBytes)
+
+	assetMeta, err := r.cfg.AssetStore.FetchAssetMetaForAsset(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch asset meta: %w", err)
+	}
+
+	ref, err := assetMeta.BlobRef()
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := r.cfg.AssetStore.FetchMetaBlob(ctx, ref.Hash)
+	switch {
+	// If we don't have the blob yet, we fetch it from the URL of the
+	// reference, and keep it for future requests once it was verified.
+	case errors.Is(err, tapdb.ErrMetaBlobNotFound):
+		client := &http.Client{
+			Timeout: metaBlobFetchTimeout,
+		}
+		blob, err = proof.FetchMetaBlob(ctx, client, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.cfg.AssetStore.StoreMetaBlob(ctx, ref, blob)
+		if err != nil {
+			return nil, fmt.Errorf("unable to store meta blob: %w",
+				err)
+		}
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch meta blob: %w", err)
+	}
+
+	return &taprpc.AssetMetaBlob{
+		Blob:     blob,
+		BlobHash: ref.Hash[:],
+	}, nil
+}
+
+// marshalAssetMeta converts a meta reveal into its RPC counterpart. Meta data
+// of the JSON and reference types is decoded as well.
+func marshalAssetMeta(meta *proof.MetaReveal) *taprpc.AssetMeta {
+	metaHash := meta.MetaHash()
+	rpcMeta := &taprpc.AssetMeta{
+		Data:     meta.Data,
+		Type:     taprpc.AssetMetaType(meta.Type),
+		MetaHash: metaHash[:],
+	}
+
+	// Meta data that was minted by another implementation might not
+	// follow the expected structure, in which case only the raw data is
+	// returned.
+	switch meta.Type {
+	case proof.MetaJson:
+		metadata, err := meta.DecodeMetadata()
+		if err != nil {
+			rpcsLog.Debugf("Unable to decode JSON meta data %x: %v",
+				metaHash[:], err)
+			break
+		}
+
+		rpcMeta.DecodedMetadata = &taprpc.AssetMetadata{
+			Name:      metadata.Name,
+			Ticker:    metadata.Ticker,
+			Decimals:  metadata.Decimals,
+			IssuerUrl: metadata.IssuerURL,
+			ImageHash: metadata.ImageHash,
+		}
+
+	case proof.MetaReference:
+		ref, err := meta.BlobRef()
+		if err != nil {
+			rpcsLog.Debugf("Unable to decode meta blob reference "+
+				"%x: %v", metaHash[:], err)
+			break
+		}
+
+		rpcMeta.BlobReference = &taprpc.MetaBlobReference{
+			BlobHash: ref.Hash[:],
+			BlobSize: ref.Size,
+			Url:      ref.URL,
+		}
+	}
+
+	return rpcMeta
+}
+
+// MarshalUniProofType marshals the universe proof type into the RPC
+// counterpart.
+func MarshalUniProofType(
+	proofType universe.ProofType) (unirpc.ProofType, error) {
+
+	switch proofType {
+	case universe.ProofTypeUnspecified:
+		return unirpc.ProofType_PROOF_TYPE_UNSPECIFIED, nil
+	case universe.ProofTypeIssuance:
+		return unirpc.ProofType_PROOF_TYPE_ISSUANCE, nil
+	case universe.ProofTypeTransfer:
+		return unirpc.ProofType_PROOF_TYPE_TRANSFER, nil
+
+	default:
+		return 0, fmt.Errorf("unknown universe proof type: %v",
+			proofType)
+	}
+}
+
+// MarshalUniID marshals the universe ID into the RPC counterpart.
+func MarshalUniID(id universe.Identifier) (*unirpc.ID, error) {
+	var uniID unirpc.ID
+
+	if id.GroupKey != nil {
+		uniID.Id = &unirpc.ID_GroupKey{
+			GroupKey: schnorr.SerializePubKey(id.GroupKey),
+		}
+	} else {
+		uniID.Id = &unirpc.ID_AssetId{
+			AssetId: id.AssetID[:],
+		}
+	}
+
+	proofTypeRpc, err := MarshalUniProofType(id.ProofType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal proof type: %w", err)
+	}
+	uniID.ProofType = proofTypeRpc
+
+	return &uniID, nil
+}
+
+// marshalMssmtNode marshals a MS-SMT node into the RPC counterpart.
+func marshalMssmtNode(node mssmt.Node) *unirpc.MerkleSumNode {
+	nodeHash := node.NodeHash()
+
+	return &unirpc.MerkleSumNode{
+		RootHash: nodeHash[:],
+		RootSum:  int64(node.NodeSum()),
+	}
+}
+
+// marshallUniverseRoot marshals the universe root into the RPC counterpart.
+func marshalUniverseRoot(node universe.BaseRoot) (*unirpc.UniverseRoot, error) {
+	// There was no old base root, so we'll just return a blank root.
+	if node.Node == nil {
+		return &unirpc.UniverseRoot{}, nil
+	}
+	mssmtRoot := marshalMssmtNode(node.Node)
+
+	rpcGroupedAssets := make(map[string]uint64, len(node.GroupedAssets))
+	for assetID, amount := range node.GroupedAssets {
+		rpcGroupedAssets[assetID.String()] = amount
+	}
+
+	uniID, err := MarshalUniID(node.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseRoot{
+		Id:               uniID,
+		MssmtRoot:        mssmtRoot,
+		AssetName:        node.AssetName,
+		AmountsByAssetId: rpcGroupedAssets,
+	}, nil
+}
+
+// AssetRoots queries for the known Universe roots associated with each known
+// asset. These roots represent the supply/audit state for each known asset.
+func (r *rpcServer) AssetRoots(ctx context.Context,
+	_ *unirpc.AssetRootRequest) (*unirpc.AssetRootResponse, error) {
+
+	// First, we'll retrieve the full set of known asset Universe roots.
+	assetRoots, err := r.cfg.BaseUniverse.RootNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.AssetRootResponse{
+		UniverseRoots: make(map[string]*unirpc.UniverseRoot),
+	}
+
+	// Retrieve config for use in filtering asset roots based on sync export
+	// settings.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// For each universe root, marshal it into the RPC form, taking care to
+	// specify the proper universe ID.
+	for _, assetRoot := range assetRoots {
+		idStr := assetRoot.ID.String()
+
+		// Skip this asset if it's not configured for sync export.
+		if !syncConfigs.IsSyncExportEnabled(assetRoot.ID) {
+			continue
+		}
+
+		resp.UniverseRoots[idStr], err = marshalUniverseRoot(assetRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// UnmarshalUniProofType parses the RPC universe proof type into the native
+// counterpart.
+func UnmarshalUniProofType(rpcType unirpc.ProofType) (universe.ProofType,
+	error) {
+
+	switch rpcType {
+	case unirpc.ProofType_PROOF_TYPE_UNSPECIFIED:
+		return universe.ProofTypeUnspecified, nil
+
+	case unirpc.ProofType_PROOF_TYPE_ISSUANCE:
+		return universe.ProofTypeIssuance, nil
+
+	case unirpc.ProofType_PROOF_TYPE_TRANSFER:
+		return universe.ProofTypeTransfer, nil
+
+	default:
+		return 0, fmt.Errorf("unknown universe proof type: %v", rpcType)
+	}
+}
+
+// unmarshalAssetSyncConfig parses the RPC asset sync config into the native
+// counterpart.
+func unmarshalAssetSyncConfig(
+	config *unirpc.AssetFederationSyncConfig) (*universe.FedUniSyncConfig,
+	error) {
+
+	if config == nil {
+		return nil, fmt.Errorf("empty universe sync config")
+	}
+
+	// Parse the universe ID from the RPC form.
+	uniID, err := UnmarshalUniID(config.Id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse universe id: %w",
+			err)
+	}
+
+	return &universe.FedUniSyncConfig{
+		UniverseID:      uniID,
+		AllowSyncInsert: config.AllowSyncInsert,
+		AllowSyncExport: config.AllowSyncExport,
+	}, nil
+}
+
+// UnmarshalUniID parses the RPC universe ID into the native counterpart.
+func UnmarshalUniID(rpcID *unirpc.ID) (universe.Identifier, error) {
+	// Unmarshal the proof type.
+	proofType, err := UnmarshalUniProofType(rpcID.ProofType)
+	if err != nil {
+		return universe.Identifier{}, fmt.Errorf("unable to unmarshal "+
+			"proof type: %w", err)
+	}
+	switch {
+	case rpcID.GetAssetId() != nil:
+		var assetID asset.ID
+		copy(assetID[:], rpcID.GetAssetId())
+
+		return universe.Identifier{
+			AssetID:   assetID,
+			ProofType: proofType,
+		}, nil
+
+	case rpcID.GetAssetIdStr() != "":
+		assetIDBytes, err := hex.DecodeString(rpcID.GetAssetIdStr())
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		// TODO(roasbeef): reuse with above
+
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
+
+		return universe.Identifier{
+			AssetID:   assetID,
+			ProofType: proofType,
+		}, nil
+
+	case rpcID.GetGroupKey() != nil:
+		groupKey, err := parseUserKey(rpcID.GetGroupKey())
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		return universe.Identifier{
+			GroupKey:  groupKey,
+			ProofType: proofType,
+		}, nil
+
+	case rpcID.GetGroupKeyStr() != "":
+		groupKeyBytes, err := hex.DecodeString(rpcID.GetGroupKeyStr())
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		// TODO(roasbeef): reuse with above
+
+		groupKey, err := parseUserKey(groupKeyBytes)
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		return universe.Identifier{
+			GroupKey:  groupKey,
+			ProofType: proofType,
+		}, nil
+
+	default:
+		return universe.Identifier{}, fmt.Errorf("no id set")
+	}
+}
+
+// QueryAssetRoots attempts to locate the current Universe root for a specific
+// asset. This asset can be identified by its asset ID or group key.
+func (r *rpcServer) QueryAssetRoots(ctx context.Context,
+	req *unirpc.AssetRootQuery) (*unirpc.QueryRootResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-		internalPubKey := out.Anchor.InternalKey.PubKey
-		internalKeyBytes := internalPubKey.SerializeCompressed()
-		rpcAnchor := &taprpc.TransferOutputAnchor{
-			Outpoint:         out.Anchor.OutPoint.String(),
-			Value:            int64(out.Anchor.Value),
-			InternalKey:      internalKeyBytes,
-			TaprootAssetRoot: out.Anchor.TaprootAssetRoot[:],
-			MerkleRoot:       out.Anchor.MerkleRoot[:],
-			TapscriptSibling: out.Anchor.TapscriptSibling,
-			NumPassiveAssets: out.Anchor.NumPassiveAssets,
+	// Attempt to retrieve the issuance universe root.
+	rpcsLog.Debugf("Querying for asset (group) issuance universe root "+
+		"for %v", spew.Sdump(universeID))
+
+	universeID.ProofType = universe.ProofTypeIssuance
+
+	// Ensure proof export is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
+	}
+
+	issuanceRoot, err := r.cfg.BaseUniverse.RootNode(ctx, universeID)
+	if err != nil {
+		// Do not return at this point if the error only indicates that
+		// the root wasn't found. We'll try to find the transfer root
+		// below.
+		if !errors.Is(err, universe.ErrNoUniverseRoot) {
+			return nil, err
 		}
-		scriptPubKey := out.ScriptKey.PubKey
+	}
 
-		var splitCommitRoot []byte
-		if out.SplitCommitmentRoot != nil {
-			hash := out.SplitCommitmentRoot.NodeHash()
-			if hash != mssmt.ZeroNodeHash {
-				splitCommitRoot = hash[:]
-			}
+	issuanceRootRPC, err := marshalUniverseRoot(issuanceRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attempt to retrieve the transfer universe root.
+	rpcsLog.Debugf("Querying for asset (group) transfer universe root "+
+		"for %v", spew.Sdump(universeID))
+
+	universeID.ProofType = universe.ProofTypeTransfer
+
+	transferRoot, err := r.cfg.BaseUniverse.RootNode(ctx, universeID)
+	if err != nil {
+		// Do not return at this point if the error only indicates that
+		// the root wasn't found. We may have found the issuance root
+		// above.
+		if !errors.Is(err, universe.ErrNoUniverseRoot) {
+			return nil, err
 		}
+	}
 
-		rpcOutType, err := marshalOutputType(out.Type)
+	transferRootRPC, err := marshalUniverseRoot(transferRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.QueryRootResponse{
+		IssuanceRoot: issuanceRootRPC,
+		TransferRoot: transferRootRPC,
+	}, nil
+}
+
+// DeleteAssetRoot attempts to locate the current Universe root for a specific
+// asset, and deletes the associated Universe tree if found.
+func (r *rpcServer) DeleteAssetRoot(ctx context.Context,
+	req *unirpc.DeleteRootQuery) (*unirpc.DeleteRootResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("Deleting asset root for %v", spew.Sdump(universeID))
+
+	// If the universe proof type is unspecified, we'll delete both the
+	// issuance and transfer roots.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		universeID.ProofType = universe.ProofTypeIssuance
+		_, err := r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
 		if err != nil {
 			return nil, err
 		}
 
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			out.AssetVersion,
-		)
+		universeID.ProofType = universe.ProofTypeTransfer
+		_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
 		if err != nil {
 			return nil, err
 		}
 
-		rpcOutputs[idx] = &taprpc.TransferOutput{
-			Anchor:              rpcAnchor,
-			ScriptKey:           scriptPubKey.SerializeCompressed(),
-			ScriptKeyIsLocal:    out.ScriptKeyLocal,
-			Amount:              out.Amount,
-			NewProofBlob:        out.ProofSuffix,
-			SplitCommitRootHash: splitCommitRoot,
-			OutputType:          rpcOutType,
-			AssetVersion:        assetVersion,
-		}
+		return &unirpc.DeleteRootResponse{}, nil
 	}
 
-	anchorTxHash := parcel.AnchorTx.TxHash()
-	return &taprpc.AssetTransfer{
-		TransferTimestamp:  parcel.TransferTime.Unix(),
-		AnchorTxHash:       anchorTxHash[:],
-		AnchorTxHeightHint: parcel.AnchorTxHeightHint,
-		AnchorTxChainFees:  parcel.ChainFees,
-		Inputs:             rpcInputs,
-		Outputs:            rpcOutputs,
-	}, nil
+	// At this point the universe proof type was specified, so we'll only
+	// delete the root for that proof type.
+	_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.DeleteRootResponse{}, nil
 }
 
-// marshalOutputType turns the transfer output type into the RPC counterpart.
-func marshalOutputType(outputType tappsbt.VOutputType) (taprpc.OutputType,
-	error) {
+func marshalLeafKey(leafKey universe.LeafKey) *unirpc.AssetKey {
+	return &unirpc.AssetKey{
+		Outpoint: &unirpc.AssetKey_OpStr{
+			OpStr: leafKey.OutPoint.String(),
+		},
+		ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
+			ScriptKeyBytes: schnorr.SerializePubKey(
+				leafKey.ScriptKey.PubKey,
+			),
+		},
+	}
+}
 
-	switch outputType {
-	case tappsbt.TypeSimple:
-		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE, nil
+// AssetLeafKeys queries for the set of Universe keys associated with a given
+// asset_id or group_key. Each key takes the form: (outpoint, script_key),
+// where outpoint is an outpoint in the Bitcoin blockchain that anchors a valid
+// Taproot Asset commitment, and script_key is the script_key of the asset
+// within the Taproot Asset commitment for the given asset_id or group_key.
+func (r *rpcServer) AssetLeafKeys(ctx context.Context,
+	req *unirpc.ID) (*unirpc.AssetLeafKeyResponse, error) {
 
-	case tappsbt.TypeSplitRoot:
-		return taprpc.OutputType_OUTPUT_TYPE_SPLIT_ROOT, nil
+	universeID, err := UnmarshalUniID(req)
+	if err != nil {
+		return nil, err
+	}
 
-	case tappsbt.TypePassiveAssetsOnly:
-		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_ASSETS_ONLY, nil
+	// TODO(roasbeef): tell above if was tring or not, then would set
+	// below diff
 
-	case tappsbt.TypePassiveSplitRoot:
-		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_SPLIT_ROOT, nil
+	leafKeys, err := r.cfg.BaseUniverse.UniverseLeafKeys(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
 
-	case tappsbt.TypeSimplePassiveAssets:
-		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE_PASSIVE_ASSETS, nil
+	resp := &unirpc.AssetLeafKeyResponse{
+		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
+	}
 
-	default:
-		return 0, fmt.Errorf("unknown output type: %d", outputType)
+	for i, leafKey := range leafKeys {
+		resp.AssetKeys[i] = marshalLeafKey(leafKey)
 	}
+
+	return resp, nil
 }
 
-// SubscribeSendAssetEventNtfns registers a subscription to the event
-// notification stream which relates to the asset sending process.
-func (r *rpcServer) SubscribeSendAssetEventNtfns(
-	_ *taprpc.SubscribeSendAssetEventNtfnsRequest,
-	ntfnStream taprpc.TaprootAssets_SubscribeSendAssetEventNtfnsServer) error {
+func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
+	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
 
-	// Create a new event subscriber and pass a copy to the chain porter.
-	// We will then read events from the subscriber.
-	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
-	defer eventSubscriber.Stop()
+	// In order to display the full asset, we'll also encode the genesis
+	// proof.
+	var buf bytes.Buffer
+	if err := assetLeaf.Proof.Encode(&buf); err != nil {
+		return nil, err
+	}
 
-	err := r.cfg.ChainPorter.RegisterSubscriber(eventSubscriber, false, false)
+	rpcAsset, err := taprpc.MarshalAsset(
+		ctx, &assetLeaf.Proof.Asset, false, true, keys,
+	)
 	if err != nil {
-		return fmt.Errorf("failed to register event notifications "+
-			"subscription: %w", err)
+		return nil, err
 	}
 
-	// Loop and read from the ChainPorter event subscription and forward to
-	// the RPC stream.
-	for {
-		select {
-		// Handle receiving a new event from the ChainPorter.
-		// The event will be mapped to the RPC event type and
-		// sent over the stream.
-		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+	return &unirpc.AssetLeaf{
+		Asset:         rpcAsset,
+		IssuanceProof: buf.Bytes(),
+	}, nil
+}
 
-			rpcEvent, err := marshallSendAssetEvent(event)
-			if err != nil {
-				return fmt.Errorf("failed to marshall "+
-					"ChainPorter event into RPC event: "+
-					"%w", err)
-			}
+// marshalAssetLeaf marshals an asset leaf into the RPC form.
+func (r *rpcServer) marshalAssetLeaf(ctx context.Context,
+	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
 
-			err = ntfnStream.Send(rpcEvent)
-			if err != nil {
-				return fmt.Errorf("failed to RPC stream send "+
-					"event: %w", err)
-			}
+	return marshalAssetLeaf(ctx, r.cfg.AddrBook, assetLeaf)
+}
 
-		// Handle the case where the RPC stream is closed by the
-		// client.
-		case <-ntfnStream.Context().Done():
-			// Don't return an error if a normal context
-			// cancellation has occurred.
-			isCanceledContext := errors.Is(
-				ntfnStream.Context().Err(), context.Canceled,
-			)
-			if isCanceledContext {
-				return nil
-			}
+// AssetLeaves queries for the set of asset leaves (the values in the Universe
+// MS-SMT tree) for a given asset_id or group_key. These represents either
+// asset issuance events (they have a genesis witness) or asset transfers that
+// took place on chain. The leaves contain a normal Taproot asset proof, as well
+// as details for the asset.
+func (r *rpcServer) AssetLeaves(ctx context.Context,
+	req *unirpc.ID) (*unirpc.AssetLeafResponse, error) {
 
-			return ntfnStream.Context().Err()
+	universeID, err := UnmarshalUniID(req)
+	if err != nil {
+		return nil, err
+	}
 
-		// Handle the case where the RPC server is shutting down.
-		case <-r.quit:
-			return nil
-		}
+	assetLeaves, err := r.cfg.BaseUniverse.MintingLeaves(ctx, universeID)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// marshallSendAssetEvent maps a ChainPorter event to its RPC counterpart.
-func marshallSendAssetEvent(
-	eventInterface fn.Event) (*taprpc.SendAssetEvent, error) {
 
-	switch event := eventInterface.(type) {
-	case *tapfreighter.ExecuteSendStateEvent:
-		eventRpc := &taprpc.SendAssetEvent_ExecuteSendStateEvent{
-			ExecuteSendStateEvent: &taprpc.ExecuteSendStateEvent{
-				Timestamp: event.Timestamp().UnixMicro(),
-				SendState: event.SendState.String(),
-			},
-		}
-		return &taprpc.SendAssetEvent{
-			Event: eventRpc,
-		}, nil
+	resp := &unirpc.AssetLeafResponse{
+		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
+	}
+	for i, assetLeaf := range assetLeaves {
+		assetLeaf := assetLeaf
 
-	case *proof.ReceiverProofBackoffWaitEvent:
-		eventRpc := taprpc.SendAssetEvent_ReceiverProofBackoffWaitEvent{
-			ReceiverProofBackoffWaitEvent: &taprpc.ReceiverProofBackoffWaitEvent{
-				Timestamp:    event.Timestamp().UnixMicro(),
-				Backoff:      event.Backoff.Microseconds(),
-				TriesCounter: event.TriesCounter,
-			},
+		resp.Leaves[i], err = r.marshalAssetLeaf(ctx, &assetLeaf)
+		if err != nil {
+			return nil, err
 		}
-		return &taprpc.SendAssetEvent{
-			Event: &eventRpc,
-		}, nil
-
-	default:
-		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
 	}
+
+	return resp, nil
 }
 
-// marshalMintingBatch marshals a minting batch into the RPC counterpart.
-func marshalMintingBatch(batch *tapgarden.MintingBatch,
-	skipSeedlings bool) (*mintrpc.MintingBatch, error) {
+// QueryGroupEmission queries for the max supply and the emission schedule an
+// asset group committed to in the meta data of its group anchor, along with
+// the supply issued into the group so far.
+func (r *rpcServer) QueryGroupEmission(ctx context.Context,
+	req *unirpc.ID) (*unirpc.GroupEmissionResponse, error) {
 
-	rpcBatchState, err := marshalBatchState(batch)
+	universeID, err := UnmarshalUniID(req)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcBatch := &mintrpc.MintingBatch{
-		BatchKey: batch.BatchKey.PubKey.SerializeCompressed(),
-		State:    rpcBatchState,
+	// The emission rules only apply to issuances, so we always query the
+	// issuance universe of the group.
+	if universeID.GroupKey == nil {
+		return nil, fmt.Errorf("group key must be specified")
 	}
+	universeID.ProofType = universe.ProofTypeIssuance
 
-	// If we don't need to include the seedlings, we can return here.
-	if skipSeedlings {
-		return rpcBatch, nil
+	emission, err := r.cfg.BaseUniverse.GroupEmission(ctx, universeID)
+	if err != nil {
+		return nil, err
 	}
 
-	// When we have sprouts, then they represent the same assets as the
-	// seedlings but in a more "grown up" state. So in that case we only
-	// marshal the sprouts.
-	switch {
-	// We have sprouts, ignore seedlings.
-	case batch.RootAssetCommitment != nil &&
-		len(batch.RootAssetCommitment.CommittedAssets()) > 0:
+	return &unirpc.GroupEmissionResponse{
+		GroupAnchor:      marshalLeafKey(emission.AnchorKey),
+		MaxSupply:        emission.MaxSupply,
+		EmissionSchedule: marshalEmissionSchedule(emission.Schedule),
+		IssuedSupply:     emission.Issued,
+	}, nil
+}
 
-		rpcBatch.Assets = marshalSprouts(
-			batch.RootAssetCommitment.CommittedAssets(),
-			batch.AssetMetas,
-		)
+// UnmarshalOutpoint un-marshals an outpoint from a string received via RPC.
+func UnmarshalOutpoint(outpoint string) (*wire.OutPoint, error) {
+	parts := strings.Split(outpoint, ":")
+	if len(parts) != 2 {
+		return nil, errors.New("outpoint should be of form txid:index")
+	}
 
-	// No sprouts, so we marshal the seedlings.
-	case len(batch.Seedlings) > 0:
-		rpcBatch.Assets, err = marshalSeedlings(batch.Seedlings)
-		if err != nil {
-			return nil, err
-		}
+	txidStr := parts[0]
+	if hex.DecodedLen(len(txidStr)) != chainhash.HashSize {
+		return nil, fmt.Errorf("invalid hex-encoded txid %v", txidStr)
 	}
 
-	return rpcBatch, nil
+	txid, err := chainhash.NewHashFromStr(txidStr)
+	if err != nil {
+		return nil, err
+	}
+
+	outputIndex, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index: %v", err)
+	}
+
+	return &wire.OutPoint{
+		Hash:  *txid,
+		Index: uint32(outputIndex),
+	}, nil
 }
 
-// marshalSeedlings marshals the seedlings into the RPC counterpart.
-func marshalSeedlings(
-	seedlings map[string]*tapgarden.Seedling) ([]*mintrpc.MintAsset, error) {
+// unmarshalLeafKey un-marshals a leaf key from the RPC form.
+func unmarshalLeafKey(key *unirpc.AssetKey) (universe.LeafKey, error) {
+	var (
+		leafKey universe.LeafKey
+		err     error
+	)
 
-	rpcAssets := make([]*mintrpc.MintAsset, 0, len(seedlings))
-	for _, seedling := range seedlings {
-		var groupKeyBytes []byte
-		if seedling.HasGroupKey() {
-			groupKey := seedling.GroupInfo.GroupKey
-			groupPubKey := groupKey.GroupPubKey
-			groupKeyBytes = groupPubKey.SerializeCompressed()
+	switch {
+	case key.GetScriptKeyBytes() != nil:
+		pubKey, err := parseUserKey(key.GetScriptKeyBytes())
+		if err != nil {
+			return leafKey, err
 		}
 
-		var groupAnchor string
-		if seedling.GroupAnchor != nil {
-			groupAnchor = *seedling.GroupAnchor
+		leafKey.ScriptKey = &asset.ScriptKey{
+			PubKey: pubKey,
 		}
 
-		var seedlingMeta *taprpc.AssetMeta
-		if seedling.Meta != nil {
-			seedlingMeta = &taprpc.AssetMeta{
-				MetaHash: fn.ByteSlice(
-					seedling.Meta.MetaHash(),
-				),
-				Data: seedling.Meta.Data,
-				Type: taprpc.AssetMetaType(seedling.Meta.Type),
-			}
+	case key.GetScriptKeyStr() != "":
+		scriptKeyBytes, sErr := hex.DecodeString(key.GetScriptKeyStr())
+		if sErr != nil {
+			return leafKey, err
 		}
 
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			seedling.AssetVersion,
-		)
+		pubKey, err := parseUserKey(scriptKeyBytes)
 		if err != nil {
-			return nil, err
+			return leafKey, err
 		}
 
-		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
-			AssetType:    taprpc.AssetType(seedling.AssetType),
-			AssetVersion: assetVersion,
-			Name:         seedling.AssetName,
-			AssetMeta:    seedlingMeta,
-			Amount:       seedling.Amount,
-			GroupKey:     groupKeyBytes,
-			GroupAnchor:  groupAnchor,
-		})
+		leafKey.ScriptKey = &asset.ScriptKey{
+			PubKey: pubKey,
+		}
+	default:
+		// TODO(roasbeef): can actually allow not to be, then would
+		// fetch all for the given outpoint
+		return leafKey, fmt.Errorf("script key must be set")
 	}
 
-	return rpcAssets, nil
-}
+	switch {
+	case key.GetOpStr() != "":
+		// Parse a bitcoin outpoint in the form txid:index into a
+		// wire.OutPoint struct.
+		outpointStr := key.GetOpStr()
+		outpoint, err := UnmarshalOutpoint(outpointStr)
+		if err != nil {
+			return leafKey, err
+		}
 
-// marshalSprouts marshals the sprouts into the RPC counterpart.
-func marshalSprouts(sprouts []*asset.Asset,
-	metas tapgarden.AssetMetas) []*mintrpc.MintAsset {
+		leafKey.OutPoint = *outpoint
 
-	rpcAssets := make([]*mintrpc.MintAsset, 0, len(sprouts))
-	for _, sprout := range sprouts {
-		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+	case key.GetOutpoint() != nil:
+		op := key.GetOp()
 
-		var assetMeta *taprpc.AssetMeta
-		if metas != nil {
-			if m, ok := metas[scriptKey]; ok && m != nil {
-				assetMeta = &taprpc.AssetMeta{
-					MetaHash: fn.ByteSlice(m.MetaHash()),
-					Data:     m.Data,
-					Type:     taprpc.AssetMetaType(m.Type),
-				}
-			}
+		hash, err := chainhash.NewHashFromStr(op.HashStr)
+		if err != nil {
+			return leafKey, err
 		}
 
-		var groupKeyBytes []byte
-		if sprout.GroupKey != nil {
-			gpk := sprout.GroupKey.GroupPubKey
-			groupKeyBytes = gpk.SerializeCompressed()
+		leafKey.OutPoint = wire.OutPoint{
+			Hash:  *hash,
+			Index: uint32(op.Index),
 		}
 
-		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
-			AssetType: taprpc.AssetType(sprout.Type),
-			Name:      sprout.Tag,
-			AssetMeta: assetMeta,
-			Amount:    sprout.Amount,
-			GroupKey:  groupKeyBytes,
-		})
+	default:
+		return leafKey, fmt.Errorf("outpoint not set: %v", err)
 	}
 
-	return rpcAssets
+	return leafKey, nil
 }
 
-// marshalBatchState converts the batch state field into its RPC counterpart.
-func marshalBatchState(batch *tapgarden.MintingBatch) (mintrpc.BatchState,
-	error) {
+// marshalMssmtProof marshals a MS-SMT proof into the RPC form.
+func marshalMssmtProof(proof *mssmt.Proof) ([]byte, error) {
+	compressedProof := proof.Compress()
 
-	currentBatchState := batch.State()
+	var b bytes.Buffer
+	if err := compressedProof.Encode(&b); err != nil {
+		return nil, err
+	}
 
-	switch currentBatchState {
-	case tapgarden.BatchStatePending:
-		return mintrpc.BatchState_BATCH_STATE_PEDNING, nil
+	return b.Bytes(), nil
+}
 
-	case tapgarden.BatchStateFrozen:
-		return mintrpc.BatchState_BATCH_STATE_FROZEN, nil
+// marshalIssuanceProof marshals an issuance proof into the RPC form.
+func (r *rpcServer) marshalIssuanceProof(ctx context.Context,
+	req *unirpc.UniverseKey,
+	proof *universe.Proof) (*unirpc.AssetProofResponse, error) {
 
-	case tapgarden.BatchStateCommitted:
-		return mintrpc.BatchState_BATCH_STATE_COMMITTED, nil
+	uniProof, err := marshalMssmtProof(proof.UniverseInclusionProof)
+	if err != nil {
+		return nil, err
+	}
 
-	case tapgarden.BatchStateBroadcast:
-		return mintrpc.BatchState_BATCH_STATE_BROADCAST, nil
+	assetLeaf, err := r.marshalAssetLeaf(ctx, proof.Leaf)
+	if err != nil {
+		return nil, err
+	}
 
-	case tapgarden.BatchStateConfirmed:
-		return mintrpc.BatchState_BATCH_STATE_CONFIRMED, nil
+	uniRoot, err := marshalUniverseRoot(universe.BaseRoot{
+		Node: proof.UniverseRoot,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	case tapgarden.BatchStateFinalized:
-		return mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+	uniRoot.AssetName = assetLeaf.Asset.AssetGenesis.Name
+	uniRoot.Id = req.Id
 
-	case tapgarden.BatchStateSeedlingCancelled:
-		return mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED, nil
+	// Marshal multiverse specific fields.
+	multiverseRoot := marshalMssmtNode(proof.MultiverseRoot)
 
-	case tapgarden.BatchStateSproutCancelled:
-		return mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED, nil
+	multiverseProof, err := marshalMssmtProof(
+		proof.MultiverseInclusionProof,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	default:
-		return 0, fmt.Errorf("unknown batch state: %v",
-			currentBatchState.String())
+	return &unirpc.AssetProofResponse{
+		Req:                      req,
+		UniverseRoot:             uniRoot,
+		UniverseInclusionProof:   uniProof,
+		AssetLeaf:                assetLeaf,
+		MultiverseRoot:           multiverseRoot,
+		MultiverseInclusionProof: multiverseProof,
+	}, nil
+}
+
+// QueryProof attempts to query for an issuance proof for a given asset based
+// on its UniverseKey. A UniverseKey is composed of the Universe ID
+// (asset_id/group_key) and also a leaf key (outpoint || script_key). If found,
+// then the issuance proof is returned that includes an inclusion proof to the
+// known Universe root, as well as a Taproot Asset state transition or issuance
+// proof for the said asset.
+func (r *rpcServer) QueryProof(ctx context.Context,
+	req *unirpc.UniverseKey) (*unirpc.AssetProofResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	leafKey, err := unmarshalLeafKey(req.LeafKey)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// UnmarshalScriptKey parses the RPC script key into the native counterpart.
-func UnmarshalScriptKey(rpcKey *taprpc.ScriptKey) (*asset.ScriptKey, error) {
-	var (
-		scriptKey asset.ScriptKey
-		err       error
-	)
+	rpcsLog.Debugf("[QueryProof]: fetching proof at (universeID=%v, "+
+		"leafKey=%x)", universeID, leafKey.UniverseKey())
 
-	// The script public key is a Taproot key, so 32-byte x-only.
-	scriptKey.PubKey, err = schnorr.ParsePubKey(rpcKey.PubKey)
+	// Retrieve proof export config for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// The key descriptor is optional for script keys that are completely
-	// independent of the backing wallet.
-	if rpcKey.KeyDesc != nil {
-		keyDesc, err := UnmarshalKeyDescriptor(rpcKey.KeyDesc)
-		if err != nil {
-			return nil, err
-		}
-		scriptKey.TweakedScriptKey = &asset.TweakedScriptKey{
-			RawKey: keyDesc,
+	var candidateIDs []universe.Identifier
 
-			// The tweak is optional, if it's empty it means the key
-			// is derived using BIP-0086.
-			Tweak: rpcKey.TapTweak,
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		// If the proof type is unspecified, then we'll attempt to
+		// retrieve both the issuance and transfer proofs. We gather the
+		// corresponding universe IDs into a candidate set.
+		universeID.ProofType = universe.ProofTypeIssuance
+		if syncConfigs.IsSyncExportEnabled(universeID) {
+			candidateIDs = append(candidateIDs, universeID)
 		}
-	}
 
-	return &scriptKey, nil
-}
+		universeID.ProofType = universe.ProofTypeTransfer
+		if syncConfigs.IsSyncExportEnabled(universeID) {
+			candidateIDs = append(candidateIDs, universeID)
+		}
+	} else {
+		// Otherwise, we'll only attempt to retrieve the proof for the
+		// specified proof type. But first we'll check that proof export
+		// is enabled for the given universe.
+		if !syncConfigs.IsSyncExportEnabled(universeID) {
+			return nil, fmt.Errorf("proof export is disabled for " +
+				"the given universe")
+		}
 
-// marshalScriptKey marshals the native script key into the RPC counterpart.
-func marshalScriptKey(scriptKey asset.ScriptKey) *taprpc.ScriptKey {
-	rpcScriptKey := &taprpc.ScriptKey{
-		PubKey: schnorr.SerializePubKey(scriptKey.PubKey),
+		candidateIDs = append(candidateIDs, universeID)
 	}
 
-	if scriptKey.TweakedScriptKey != nil {
-		rpcScriptKey.KeyDesc = marshalKeyDescriptor(
-			scriptKey.TweakedScriptKey.RawKey,
-		)
-		rpcScriptKey.TapTweak = scriptKey.TweakedScriptKey.Tweak
+	// If no candidate IDs were applicable then our config must have
+	// disabled proof export for the given universe.
+	if len(candidateIDs) == 0 {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
 	}
 
-	return rpcScriptKey
-}
+	// Attempt to retrieve the proof given the candidate set of universe
+	// IDs.
+	var proofs []*universe.Proof
+	for i := range candidateIDs {
+		candidateID := candidateIDs[i]
 
-// parseUserKey parses a user-provided script or group key, which can be in
-// either the Schnorr or Compressed format.
-func parseUserKey(scriptKey []byte) (*btcec.PublicKey, error) {
-	switch len(scriptKey) {
-	case schnorr.PubKeyBytesLen:
-		return schnorr.ParsePubKey(scriptKey)
+		proofs, err = r.cfg.BaseUniverse.FetchIssuanceProof(
+			ctx, candidateID, leafKey,
+		)
+		if err != nil {
+			if errors.Is(err, universe.ErrNoUniverseProofFound) {
+				continue
+			}
 
-	// Truncate the key and then parse as a Schnorr key.
-	case btcec.PubKeyBytesLenCompressed:
-		return schnorr.ParsePubKey(scriptKey[1:])
+			rpcsLog.Debugf("[QueryProof]: error querying for "+
+				"proof at (universeID=%v, leafKey=%x)",
+				universeID, leafKey.UniverseKey())
+			return nil, err
+		}
 
-	default:
-		return nil, fmt.Errorf("unknown script key length: %v",
-			len(scriptKey))
+		// At this point we've found a proof, so we'll break out of the
+		// loop. We don't need to attempt to retrieve a proof for any
+		// other candidate IDs.
+		break
 	}
-}
 
-// marshalKeyDescriptor marshals the native key descriptor into the RPC
-// counterpart.
-func marshalKeyDescriptor(desc keychain.KeyDescriptor) *taprpc.KeyDescriptor {
-	return &taprpc.KeyDescriptor{
-		RawKeyBytes: desc.PubKey.SerializeCompressed(),
-		KeyLoc: &taprpc.KeyLocator{
-			KeyFamily: int32(desc.KeyLocator.Family),
-			KeyIndex:  int32(desc.KeyLocator.Index),
-		},
+	if len(proofs) == 0 {
+		return nil, universe.ErrNoUniverseProofFound
 	}
-}
 
-// UnmarshalKeyDescriptor parses the RPC key descriptor into the native
-// counterpart.
-func UnmarshalKeyDescriptor(
-	rpcDesc *taprpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+	// TODO(roasbeef): query may return multiple proofs, if allow key to
+	// not be fully specified
+	proof := proofs[0]
 
-	var (
-		desc keychain.KeyDescriptor
-		err  error
-	)
+	rpcsLog.Debugf("[QueryProof]: found proof at (universeID=%v, "+
+		"leafKey=%x)", universeID, leafKey.UniverseKey())
 
-	// The public key of a key descriptor is mandatory. It is enough to
-	// locate the corresponding private key in the backing wallet. But to
-	// speed things up (and for additional context), the locator should
-	// still be provided if available.
-	desc.PubKey, err = btcec.ParsePubKey(rpcDesc.RawKeyBytes)
-	if err != nil {
-		return desc, err
-	}
+	return r.marshalIssuanceProof(ctx, req, proof)
+}
 
-	if rpcDesc.KeyLoc != nil {
-		desc.KeyLocator = keychain.KeyLocator{
-			Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
-			Index:  uint32(rpcDesc.KeyLoc.KeyIndex),
-		}
+// unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
+func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
+	// We'll just pull the asset details from the serialized issuance proof
+	// itself.
+	var assetProof proof.Proof
+	if err := assetProof.Decode(
+		bytes.NewReader(leaf.IssuanceProof),
+	); err != nil {
+		return nil, err
 	}
 
-	return desc, nil
+	// TODO(roasbeef): double check posted file format everywhere
+	//  * raw proof, or within file?
+
+	return &universe.Leaf{
+		GenesisWithGroup: universe.GenesisWithGroup{
+			Genesis:  assetProof.Asset.Genesis,
+			GroupKey: assetProof.Asset.GroupKey,
+		},
+		Proof: &assetProof,
+		Amt:   assetProof.Asset.Amount,
+	}, nil
 }
 
-// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
-// either by the asset ID for that asset, or a meta hash.
-func (r *rpcServer) FetchAssetMeta(ctx context.Context,
-	req *taprpc.FetchAssetMetaRequest) (*taprpc.AssetMeta, error) {
+// InsertProof attempts to insert a new issuance proof into the Universe tree
+// specified by the UniverseKey. If valid, then the proof is inserted into the
+// database, with a new Universe root returned for the updated
+// asset_id/group_key.
+func (r *rpcServer) InsertProof(ctx context.Context,
+	req *unirpc.AssetProof) (*unirpc.AssetProofResponse, error) {
 
-	var (
-		assetMeta *proof.MetaReveal
-		err       error
-	)
+	if req.Key == nil {
+		return nil, fmt.Errorf("key cannot be nil")
+	}
 
-	switch {
-	case req.GetAssetId() != nil:
-		if len(req.GetAssetId()) != sha256.Size {
-			return nil, fmt.Errorf("asset ID must be 32 bytes")
-		}
+	universeID, err := UnmarshalUniID(req.Key.Id)
+	if err != nil {
+		return nil, err
+	}
+	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
+	if err != nil {
+		return nil, err
+	}
 
-		var assetID asset.ID
-		copy(assetID[:], req.GetAssetId())
+	assetLeaf, err := unmarshalAssetLeaf(req.AssetLeaf)
+	if err != nil {
+		return nil, err
+	}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
-			ctx, assetID,
+	// If universe proof type unspecified, set based on the provided asset
+	// proof.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		universeID.ProofType, err = universe.NewProofTypeFromAssetProof(
+			assetLeaf.Proof,
 		)
-
-	case req.GetAssetIdStr() != "":
-		if len(req.GetAssetIdStr()) != hex.EncodedLen(sha256.Size) {
-			return nil, fmt.Errorf("asset ID must be 32 bytes")
-		}
-
-		var assetIDBytes []byte
-		assetIDBytes, err = hex.DecodeString(req.GetAssetIdStr())
 		if err != nil {
-			return nil, fmt.Errorf("error hex decoding asset ID: "+
-				"%w", err)
+			return nil, err
 		}
+	}
 
-		var assetID asset.ID
-		copy(assetID[:], assetIDBytes)
-
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
-			ctx, assetID,
-		)
+	// Ensure that the new proof is of the correct type for the target
+	// universe.
+	err = universe.ValidateProofUniverseType(assetLeaf.Proof, universeID)
+	if err != nil {
+		return nil, err
+	}
 
-	case req.GetMetaHash() != nil:
-		if len(req.GetMetaHash()) != sha256.Size {
-			return nil, fmt.Errorf("meta hash must be 32 bytes")
-		}
+	// Ensure proof insert is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		var metaHash [asset.MetaHashLen]byte
-		copy(metaHash[:], req.GetMetaHash())
+	if !syncConfigs.IsSyncInsertEnabled(universeID) {
+		return nil, fmt.Errorf("proof insert is disabled for the " +
+			"given universe")
+	}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
-			ctx, metaHash,
-		)
+	rpcsLog.Debugf("[InsertProof]: inserting proof at "+
+		"(universeID=%v, leafKey=%x)", universeID,
+		leafKey.UniverseKey())
 
-	case req.GetMetaHashStr() != "":
-		if len(req.GetMetaHashStr()) != hex.EncodedLen(sha256.Size) {
-			return nil, fmt.Errorf("meta hash must be 32 bytes")
-		}
+	newUniverseState, err := r.cfg.BaseUniverse.RegisterIssuance(
+		ctx, universeID, leafKey, assetLeaf,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		var metaHashBytes []byte
-		metaHashBytes, err = hex.DecodeString(req.GetMetaHashStr())
-		if err != nil {
-			return nil, fmt.Errorf("error hex decoding meta hash: "+
-				"%w", err)
-		}
+	universeRootHash := newUniverseState.UniverseRoot.NodeHash()
+	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
+		universeRootHash[:])
 
-		var metaHash [asset.MetaHashLen]byte
-		copy(metaHash[:], metaHashBytes)
+	return r.marshalIssuanceProof(ctx, req.Key, newUniverseState)
+}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
-			ctx, metaHash,
-		)
+// Info returns a set of information about the current state of the Universe.
+func (r *rpcServer) Info(ctx context.Context,
+	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
 
-	default:
-		return nil, fmt.Errorf("either asset ID or meta hash must " +
-			"be set")
-	}
+	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to fetch asset "+
-			"meta: %w", err)
+		return nil, err
 	}
 
-	metaHash := assetMeta.MetaHash()
-	return &taprpc.AssetMeta{
-		Data:     assetMeta.Data,
-		Type:     taprpc.AssetMetaType(assetMeta.Type),
-		MetaHash: metaHash[:],
+	return &unirpc.InfoResponse{
+		RuntimeId: r.cfg.RuntimeID,
+		NumAssets: universeStats.NumTotalAssets,
 	}, nil
 }
 
-// MarshalUniProofType marshals the universe proof type into the RPC
-// counterpart.
-func MarshalUniProofType(
-	proofType universe.ProofType) (unirpc.ProofType, error) {
+// unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
+// type.
+func unmarshalUniverseSyncType(req unirpc.UniverseSyncMode) (
+	universe.SyncType, error) {
 
-	switch proofType {
-	case universe.ProofTypeUnspecified:
-		return unirpc.ProofType_PROOF_TYPE_UNSPECIFIED, nil
-	case universe.ProofTypeIssuance:
-		return unirpc.ProofType_PROOF_TYPE_ISSUANCE, nil
-	case universe.ProofTypeTransfer:
-		return unirpc.ProofType_PROOF_TYPE_TRANSFER, nil
+	switch req {
+	case unirpc.UniverseSyncMode_SYNC_FULL:
+		return universe.SyncFull, nil
+
+	case unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY:
+		return universe.SyncIssuance, nil
 
 	default:
-		return 0, fmt.Errorf("unknown universe proof type: %v",
-			proofType)
+		return 0, fmt.Errorf("unknown sync type: %v", req)
 	}
 }
 
-// MarshalUniID marshals the universe ID into the RPC counterpart.
-func MarshalUniID(id universe.Identifier) (*unirpc.ID, error) {
-	var uniID unirpc.ID
-
-	if id.GroupKey != nil {
-		uniID.Id = &unirpc.ID_GroupKey{
-			GroupKey: schnorr.SerializePubKey(id.GroupKey),
-		}
-	} else {
-		uniID.Id = &unirpc.ID_AssetId{
-			AssetId: id.AssetID[:],
+// unmarshalSyncTargets maps an RPC sync target into a concrete type.
+func unmarshalSyncTargets(targets []*unirpc.SyncTarget) ([]universe.Identifier, error) {
+	uniIDs := make([]universe.Identifier, 0, len(targets))
+	for _, target := range targets {
+		uniID, err := UnmarshalUniID(target.Id)
+		if err != nil {
+			return nil, err
 		}
+		uniIDs = append(uniIDs, uniID)
 	}
 
-	proofTypeRpc, err := MarshalUniProofType(id.ProofType)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof type: %w", err)
-	}
-	uniID.ProofType = proofTypeRpc
-
-	return &uniID, nil
+	return uniIDs, nil
 }
 
-// marshalMssmtNode marshals a MS-SMT node into the RPC counterpart.
-func marshalMssmtNode(node mssmt.Node) *unirpc.MerkleSumNode {
-	nodeHash := node.NodeHash()
+// marshalUniverseDiff marshals a universe diff into the RPC form.
+func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
+	uniDiff []universe.AssetSyncDiff) (*unirpc.SyncResponse, error) {
 
-	return &unirpc.MerkleSumNode{
-		RootHash: nodeHash[:],
-		RootSum:  int64(node.NodeSum()),
+	resp := &unirpc.SyncResponse{
+		SyncedUniverses: make([]*unirpc.SyncedUniverse, 0, len(uniDiff)),
 	}
-}
 
-// marshallUniverseRoot marshals the universe root into the RPC counterpart.
-func marshalUniverseRoot(node universe.BaseRoot) (*unirpc.UniverseRoot, error) {
-	// There was no old base root, so we'll just return a blank root.
-	if node.Node == nil {
-		return &unirpc.UniverseRoot{}, nil
-	}
-	mssmtRoot := marshalMssmtNode(node.Node)
+	err := fn.ForEachErr(uniDiff, func(diff universe.AssetSyncDiff) error {
+		oldUniRoot, err := marshalUniverseRoot(diff.OldUniverseRoot)
+		if err != nil {
+			return fmt.Errorf("unable to marshal old uni "+
+				"root: %w", err)
+		}
+		newUniRoot, err := marshalUniverseRoot(diff.NewUniverseRoot)
+		if err != nil {
+			return fmt.Errorf("unable to marshal new unit "+
+				"root: %w", err)
+		}
 
-	rpcGroupedAssets := make(map[string]uint64, len(node.GroupedAssets))
-	for assetID, amount := range node.GroupedAssets {
-		rpcGroupedAssets[assetID.String()] = amount
-	}
+		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
+		for i, leaf := range diff.NewLeafProofs {
+			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
+			if err != nil {
+				return err
+			}
+		}
 
-	uniID, err := MarshalUniID(node.ID)
+		resp.SyncedUniverses = append(
+			resp.SyncedUniverses, &unirpc.SyncedUniverse{
+				OldAssetRoot:   oldUniRoot,
+				NewAssetRoot:   newUniRoot,
+				NewAssetLeaves: leaves,
+			},
+		)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.UniverseRoot{
-		Id:               uniID,
-		MssmtRoot:        mssmtRoot,
-		AssetName:        node.AssetName,
-		AmountsByAssetId: rpcGroupedAssets,
-	}, nil
+	return resp, nil
 }
 
-// AssetRoots queries for the known Universe roots associated with each known
-// asset. These roots represent the supply/audit state for each known asset.
-func (r *rpcServer) AssetRoots(ctx context.Context,
-	_ *unirpc.AssetRootRequest) (*unirpc.AssetRootResponse, error) {
+// SyncUniverse takes host information for a remote Universe server, then
+// attempts to synchronize either only the set of specified asset_ids, or all
+// assets if none are specified. The sync process will attempt to query for the
+// latest known root for each asset, performing tree based reconciliation to
+// arrive at a new shared root.
+func (r *rpcServer) SyncUniverse(ctx context.Context,
+	req *unirpc.SyncRequest) (*unirpc.SyncResponse, error) {
 
-	// First, we'll retrieve the full set of known asset Universe roots.
-	assetRoots, err := r.cfg.BaseUniverse.RootNodes(ctx)
+	// TODO(roasbeef): have another layer, only allow single outstanding
+	// sync request per host?
+
+	syncMode, err := unmarshalUniverseSyncType(req.SyncMode)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to parse sync type: %w", err)
+	}
+	syncTargets, err := unmarshalSyncTargets(req.SyncTargets)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sync targets: %w", err)
 	}
 
-	resp := &unirpc.AssetRootResponse{
-		UniverseRoots: make(map[string]*unirpc.UniverseRoot),
+	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
+
+	// Obtain the general and universe specific federation sync configs.
+	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
+	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"config(s): %w", err)
 	}
 
-	// Retrieve config for use in filtering asset roots based on sync export
-	// settings.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	syncConfigs := universe.SyncConfigs{
+		GlobalSyncConfigs: globalConfigs,
+		UniSyncConfigs:    uniSyncConfigs,
+	}
+
+	// TODO(roasbeef): add layer of indirection in front of?
+	//  * just interface interaction
+	// TODO(ffranr): Sync via the FederationEnvoy rather than syncer.
+	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverse(
+		ctx, uniAddr, syncMode, syncConfigs, syncTargets...,
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to sync universe: %w", err)
 	}
 
-	// For each universe root, marshal it into the RPC form, taking care to
-	// specify the proper universe ID.
-	for _, assetRoot := range assetRoots {
-		idStr := assetRoot.ID.String()
+	return r.marshalUniverseDiff(ctx, universeDiff)
+}
 
-		// Skip this asset if it's not configured for sync export.
-		if !syncConfigs.IsSyncExportEnabled(assetRoot.ID) {
-			continue
-		}
+func marshalUniverseServer(server universe.ServerAddr,
+) *unirpc.UniverseFederationServer {
 
-		resp.UniverseRoots[idStr], err = marshalUniverseRoot(assetRoot)
-		if err != nil {
-			return nil, err
-		}
+	return &unirpc.UniverseFederationServer{
+		Host: server.HostStr(),
+		Id:   int32(server.ID),
 	}
-
-	return resp, nil
 }
 
-// UnmarshalUniProofType parses the RPC universe proof type into the native
-// counterpart.
-func UnmarshalUniProofType(rpcType unirpc.ProofType) (universe.ProofType,
-	error) {
+// ListFederationServers lists the set of servers that make up the federation
+// of the local Universe server. This servers are used to push out new proofs,
+// and also periodically call sync new proofs from the remote server.
+func (r *rpcServer) ListFederationServers(ctx context.Context,
+	_ *unirpc.ListFederationServersRequest,
+) (*unirpc.ListFederationServersResponse, error) {
 
-	switch rpcType {
-	case unirpc.ProofType_PROOF_TYPE_UNSPECIFIED:
-		return universe.ProofTypeUnspecified, nil
+	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	case unirpc.ProofType_PROOF_TYPE_ISSUANCE:
-		return universe.ProofTypeIssuance, nil
+	return &unirpc.ListFederationServersResponse{
+		Servers: fn.Map(uniServers, marshalUniverseServer),
+	}, nil
+}
 
-	case unirpc.ProofType_PROOF_TYPE_TRANSFER:
-		return universe.ProofTypeTransfer, nil
+func unmarshalUniverseServer(
+	server *unirpc.UniverseFederationServer) universe.ServerAddr {
 
-	default:
-		return 0, fmt.Errorf("unknown universe proof type: %v", rpcType)
-	}
+	return universe.NewServerAddr(int64(server.Id), server.Host)
 }
 
-// unmarshalAssetSyncConfig parses the RPC asset sync config into the native
-// counterpart.
-func unmarshalAssetSyncConfig(
-	config *unirpc.AssetFederationSyncConfig) (*universe.FedUniSyncConfig,
-	error) {
+// AddFederationServer adds a new server to the federation of the local
+// Universe server. Once a server is added, this call can also optionally be
+// used to trigger a sync of the remote server.
+func (r *rpcServer) AddFederationServer(ctx context.Context,
+	req *unirpc.AddFederationServerRequest,
+) (*unirpc.AddFederationServerResponse, error) {
+
+	serversToAdd := fn.Map(req.Servers, unmarshalUniverseServer)
 
-	if config == nil {
-		return nil, fmt.Errorf("empty universe sync config")
+	for idx := range serversToAdd {
+		server := serversToAdd[idx]
+
+		// Before we add the server as a federation member, we check
+		// that we can actually connect to it and that it isn't
+		// ourselves.
+		err := CheckFederationServer(
+			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Parse the universe ID from the RPC form.
-	uniID, err := UnmarshalUniID(config.Id)
+	err := r.cfg.UniverseFederation.AddServer(serversToAdd...)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse universe id: %w",
-			err)
+		return nil, err
 	}
 
-	return &universe.FedUniSyncConfig{
-		UniverseID:      uniID,
-		AllowSyncInsert: config.AllowSyncInsert,
-		AllowSyncExport: config.AllowSyncExport,
-	}, nil
+	return &unirpc.AddFederationServerResponse{}, nil
 }
 
-// UnmarshalUniID parses the RPC universe ID into the native counterpart.
-func UnmarshalUniID(rpcID *unirpc.ID) (universe.Identifier, error) {
-	// Unmarshal the proof type.
-	proofType, err := UnmarshalUniProofType(rpcID.ProofType)
-	if err != nil {
-		return universe.Identifier{}, fmt.Errorf("unable to unmarshal "+
-			"proof type: %w", err)
-	}
-	switch {
-	case rpcID.GetAssetId() != nil:
-		var assetID asset.ID
-		copy(assetID[:], rpcID.GetAssetId())
+// DeleteFederationServer removes a server from the federation of the local
+// Universe server.
+func (r *rpcServer) DeleteFederationServer(ctx context.Context,
+	req *unirpc.DeleteFederationServerRequest,
+) (*unirpc.DeleteFederationServerResponse, error) {
 
-		return universe.Identifier{
-			AssetID:   assetID,
-			ProofType: proofType,
-		}, nil
+	serversToDel := fn.Map(req.Servers, unmarshalUniverseServer)
 
-	case rpcID.GetAssetIdStr() != "":
-		assetIDBytes, err := hex.DecodeString(rpcID.GetAssetIdStr())
-		if err != nil {
-			return universe.Identifier{}, err
-		}
+	err := r.cfg.FederationDB.RemoveServers(ctx, serversToDel...)
+	if err != nil {
+		return nil, err
+	}
 
-		// TODO(roasbeef): reuse with above
+	return &unirpc.DeleteFederationServerResponse{}, nil
+}
 
-		var assetID asset.ID
-		copy(assetID[:], assetIDBytes)
+// SetFederationSyncConfig sets the configuration of the universe federation
+// sync.
+func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
+	req *unirpc.SetFederationSyncConfigRequest) (
+	*unirpc.SetFederationSyncConfigResponse, error) {
 
-		return universe.Identifier{
-			AssetID:   assetID,
-			ProofType: proofType,
-		}, nil
+	// Unmarshal global sync configs.
+	globalSyncConfig := make(
+		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
+	)
+	for i := range req.GlobalSyncConfigs {
+		config := req.GlobalSyncConfigs[i]
 
-	case rpcID.GetGroupKey() != nil:
-		groupKey, err := parseUserKey(rpcID.GetGroupKey())
+		proofType, err := UnmarshalUniProofType(config.ProofType)
 		if err != nil {
-			return universe.Identifier{}, err
+			return nil, fmt.Errorf("unable to unmarshal "+
+				"proof type: %w", err)
 		}
 
-		return universe.Identifier{
-			GroupKey:  groupKey,
-			ProofType: proofType,
-		}, nil
-
-	case rpcID.GetGroupKeyStr() != "":
-		groupKeyBytes, err := hex.DecodeString(rpcID.GetGroupKeyStr())
-		if err != nil {
-			return universe.Identifier{}, err
+		globalSyncConfig[i] = &universe.FedGlobalSyncConfig{
+			ProofType:       proofType,
+			AllowSyncInsert: config.AllowSyncInsert,
+			AllowSyncExport: config.AllowSyncExport,
 		}
+	}
 
-		// TODO(roasbeef): reuse with above
-
-		groupKey, err := parseUserKey(groupKeyBytes)
+	// Unmarshal asset (asset/asset group) specific sync configs.
+	assetSyncConfigs := make(
+		[]*universe.FedUniSyncConfig, len(req.AssetSyncConfigs),
+	)
+	for i := range req.AssetSyncConfigs {
+		assetSyncConfig := req.AssetSyncConfigs[i]
+		config, err := unmarshalAssetSyncConfig(assetSyncConfig)
 		if err != nil {
-			return universe.Identifier{}, err
+			return nil, fmt.Errorf("unable to parse asset sync "+
+				"config: %w", err)
 		}
 
-		return universe.Identifier{
-			GroupKey:  groupKey,
-			ProofType: proofType,
-		}, nil
-
-	default:
-		return universe.Identifier{}, fmt.Errorf("no id set")
+		assetSyncConfigs[i] = config
 	}
-}
-
-// QueryAssetRoots attempts to locate the current Universe root for a specific
-// asset. This asset can be identified by its asset ID or group key.
-func (r *rpcServer) QueryAssetRoots(ctx context.Context,
-	req *unirpc.AssetRootQuery) (*unirpc.QueryRootResponse, error) {
 
-	universeID, err := UnmarshalUniID(req.Id)
+	// Update asset (asset/asset group) specific sync configs.
+	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
+		ctx, globalSyncConfig, assetSyncConfigs,
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to set federation sync "+
+			"config: %w", err)
 	}
 
-	// Attempt to retrieve the issuance universe root.
-	rpcsLog.Debugf("Querying for asset (group) issuance universe root "+
-		"for %v", spew.Sdump(universeID))
+	return &unirpc.SetFederationSyncConfigResponse{}, nil
+}
 
-	universeID.ProofType = universe.ProofTypeIssuance
+// QueryFederationSyncConfig queries the universe federation sync configuration
+// settings.
+func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
+	_ *unirpc.QueryFederationSyncConfigRequest,
+) (*unirpc.QueryFederationSyncConfigResponse, error) {
 
-	// Ensure proof export is enabled for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	// Obtain the general and universe specific federation sync configs.
+	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
+	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"config(s): %w", err)
 	}
 
-	if !syncConfigs.IsSyncExportEnabled(universeID) {
-		return nil, fmt.Errorf("proof export is disabled for the " +
-			"given universe")
-	}
+	// Marshal the general sync config into the RPC form.
+	globalConfigRPC := make(
+		[]*unirpc.GlobalFederationSyncConfig, len(globalConfigs),
+	)
+	for i := range globalConfigs {
+		globalConfig := globalConfigs[i]
 
-	issuanceRoot, err := r.cfg.BaseUniverse.RootNode(ctx, universeID)
-	if err != nil {
-		// Do not return at this point if the error only indicates that
-		// the root wasn't found. We'll try to find the transfer root
-		// below.
-		if !errors.Is(err, universe.ErrNoUniverseRoot) {
-			return nil, err
+		proofTypeRpc, err := MarshalUniProofType(globalConfig.ProofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal "+
+				"proof type: %w", err)
 		}
-	}
-
-	issuanceRootRPC, err := marshalUniverseRoot(issuanceRoot)
-	if err != nil {
-		return nil, err
-	}
-
-	// Attempt to retrieve the transfer universe root.
-	rpcsLog.Debugf("Querying for asset (group) transfer universe root "+
-		"for %v", spew.Sdump(universeID))
-
-	universeID.ProofType = universe.ProofTypeTransfer
 
-	transferRoot, err := r.cfg.BaseUniverse.RootNode(ctx, universeID)
-	if err != nil {
-		// Do not return at this point if the error only indicates that
-		// the root wasn't found. We may have found the issuance root
-		// above.
-		if !errors.Is(err, universe.ErrNoUniverseRoot) {
-			return nil, err
+		globalConfigRPC[i] = &unirpc.GlobalFederationSyncConfig{
+			ProofType:       proofTypeRpc,
+			AllowSyncInsert: globalConfig.AllowSyncInsert,
+			AllowSyncExport: globalConfig.AllowSyncExport,
 		}
 	}
 
-	transferRootRPC, err := marshalUniverseRoot(transferRoot)
-	if err != nil {
-		return nil, err
+	// Marshal universe specific sync configs into the RPC form.
+	uniConfigRPCs := make(
+		[]*unirpc.AssetFederationSyncConfig, len(uniSyncConfigs),
+	)
+	for i := range uniSyncConfigs {
+		uniSyncConfig := uniSyncConfigs[i]
+		uniConfigRPC, err := MarshalAssetFedSyncCfg(*uniSyncConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal universe "+
+				"specific federation sync config: %w", err)
+		}
+		uniConfigRPCs[i] = uniConfigRPC
 	}
 
-	return &unirpc.QueryRootResponse{
-		IssuanceRoot: issuanceRootRPC,
-		TransferRoot: transferRootRPC,
+	return &unirpc.QueryFederationSyncConfigResponse{
+		GlobalSyncConfigs: globalConfigRPC,
+		AssetSyncConfigs:  uniConfigRPCs,
 	}, nil
 }
 
-// DeleteAssetRoot attempts to locate the current Universe root for a specific
-// asset, and deletes the associated Universe tree if found.
-func (r *rpcServer) DeleteAssetRoot(ctx context.Context,
-	req *unirpc.DeleteRootQuery) (*unirpc.DeleteRootResponse, error) {
+// marshalPublicationState marshals a proof publication state into the RPC
+// form.
+func marshalPublicationState(
+	state universe.PublicationState) (unirpc.ProofPublicationState, error) {
 
-	universeID, err := UnmarshalUniID(req.Id)
-	if err != nil {
-		return nil, err
-	}
+	switch state {
+	case universe.PublicationPending:
+		return unirpc.ProofPublicationState_PUBLICATION_STATE_PENDING,
+			nil
 
-	rpcsLog.Debugf("Deleting asset root for %v", spew.Sdump(universeID))
+	case universe.PublicationPublished:
+		return unirpc.ProofPublicationState_PUBLICATION_STATE_PUBLISHED,
+			nil
 
-	// If the universe proof type is unspecified, we'll delete both the
-	// issuance and transfer roots.
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		universeID.ProofType = universe.ProofTypeIssuance
-		_, err := r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
-		if err != nil {
-			return nil, err
-		}
+	case universe.PublicationFailed:
+		return unirpc.ProofPublicationState_PUBLICATION_STATE_FAILED,
+			nil
 
-		universeID.ProofType = universe.ProofTypeTransfer
-		_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
-		if err != nil {
-			return nil, err
-		}
+	default:
+		return 0, fmt.Errorf("unknown publication state: %v", state)
+	}
+}
 
-		return &unirpc.DeleteRootResponse{}, nil
+// marshalPublication marshals the publication status of a single proof into
+// the RPC form.
+func marshalPublication(
+	pub universe.Publication) (*unirpc.ProofPublication, error) {
+
+	uniID, err := MarshalUniID(pub.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	// At this point the universe proof type was specified, so we'll only
-	// delete the root for that proof type.
-	_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
+	state, err := marshalPublicationState(pub.State)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.DeleteRootResponse{}, nil
-}
-
-func marshalLeafKey(leafKey universe.LeafKey) *unirpc.AssetKey {
-	return &unirpc.AssetKey{
-		Outpoint: &unirpc.AssetKey_OpStr{
-			OpStr: leafKey.OutPoint.String(),
-		},
-		ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
-			ScriptKeyBytes: schnorr.SerializePubKey(
-				leafKey.ScriptKey.PubKey,
-			),
+	rpcPub := &unirpc.ProofPublication{
+		Key: &unirpc.UniverseKey{
+			Id:      uniID,
+			LeafKey: marshalLeafKey(pub.Key),
 		},
+		State:       state,
+		NumAttempts: pub.NumAttempts,
+	}
+	if !pub.LastAttempt.IsZero() {
+		rpcPub.LastAttemptTimestamp = pub.LastAttempt.Unix()
 	}
+	if pub.LastErr != nil {
+		rpcPub.LastError = pub.LastErr.Error()
+	}
+
+	return rpcPub, nil
 }
 
-// AssetLeafKeys queries for the set of Universe keys associated with a given
-// asset_id or group_key. Each key takes the form: (outpoint, script_key),
-// where outpoint is an outpoint in the Bitcoin blockchain that anchors a valid
-// Taproot Asset commitment, and script_key is the script_key of the asset
-// within the Taproot Asset commitment for the given asset_id or group_key.
-func (r *rpcServer) AssetLeafKeys(ctx context.Context,
-	req *unirpc.ID) (*unirpc.AssetLeafKeyResponse, error) {
+// ListProofPublications lists the publication status of new issuance proofs
+// for each of the static publication targets the daemon is configured with.
+func (r *rpcServer) ListProofPublications(_ context.Context,
+	req *unirpc.ListProofPublicationsRequest,
+) (*unirpc.ListProofPublicationsResponse, error) {
 
-	universeID, err := UnmarshalUniID(req)
-	if err != nil {
-		return nil, err
+	var filter func(universe.Identifier) bool
+	if req.Id != nil {
+		filterID, err := UnmarshalUniID(req.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		filter = func(id universe.Identifier) bool {
+			return id.ProofType == filterID.ProofType &&
+				id.Bytes() == filterID.Bytes()
+		}
 	}
 
-	// TODO(roasbeef): tell above if was tring or not, then would set
-	// below diff
+	status := r.cfg.UniverseFederation.PublicationStatus(filter)
 
-	leafKeys, err := r.cfg.BaseUniverse.UniverseLeafKeys(ctx, universeID)
-	if err != nil {
-		return nil, err
-	}
+	targets := make([]*unirpc.ProofPublicationTarget, 0, len(status))
+	for idx := range status {
+		targetStatus := status[idx]
 
-	resp := &unirpc.AssetLeafKeyResponse{
-		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
-	}
+		rpcTarget := &unirpc.ProofPublicationTarget{
+			Host:                targetStatus.Target.HostStr(),
+			ConsecutiveFailures: targetStatus.ConsecutiveFailures,
+		}
+		if !targetStatus.NextAttempt.IsZero() {
+			rpcTarget.NextAttemptTimestamp =
+				targetStatus.NextAttempt.Unix()
+		}
 
-	for i, leafKey := range leafKeys {
-		resp.AssetKeys[i] = marshalLeafKey(leafKey)
+		for _, pub := range targetStatus.Publications {
+			rpcPub, err := marshalPublication(pub)
+			if err != nil {
+				return nil, fmt.Errorf("unable to marshal "+
+					"publication: %w", err)
+			}
+
+			rpcTarget.Publications = append(
+				rpcTarget.Publications, rpcPub,
+			)
+		}
+
+		targets = append(targets, rpcTarget)
 	}
 
-	return resp, nil
+	return &unirpc.ListProofPublicationsResponse{
+		Targets: targets,
+	}, nil
 }
 
-func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
-	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
+// ProveAssetOwnership creates an ownership proof embedded in an asset
+// transition proof. That ownership proof is a signed virtual transaction
+// spending the asset with a valid witness to prove the prover owns the keys
+// that can spend the asset.
+func (r *rpcServer) ProveAssetOwnership(ctx context.Context,
+	req *wrpc.ProveAssetOwnershipRequest) (*wrpc.ProveAssetOwnershipResponse,
+	error) {
 
-	// In order to display the full asset, we'll also encode the genesis
-	// proof.
-	var buf bytes.Buffer
-	if err := assetLeaf.Proof.Encode(&buf); err != nil {
-		return nil, err
+	if len(req.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
 	}
 
-	rpcAsset, err := taprpc.MarshalAsset(
-		ctx, &assetLeaf.Proof.Asset, false, true, keys,
+	scriptKey, err := parseUserKey(req.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	assetID := fn.ToArray[asset.ID](req.AssetId)
+	ownershipProof, _, err := r.proveOwnership(
+		ctx, assetID, scriptKey, [32]byte{},
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.AssetLeaf{
-		Asset:         rpcAsset,
-		IssuanceProof: buf.Bytes(),
+	var buf bytes.Buffer
+	if err := ownershipProof.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("error encoding proof file: %w", err)
+	}
+
+	return &wrpc.ProveAssetOwnershipResponse{
+		ProofWithWitness: buf.Bytes(),
 	}, nil
 }
 
-// marshalAssetLeaf marshals an asset leaf into the RPC form.
-func (r *rpcServer) marshalAssetLeaf(ctx context.Context,
-	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
+// proveOwnership creates an ownership proof for the asset with the given ID
+// and script key, with a challenge witness that commits to the given
+// challenge. The verified snapshot of the last transition proof of the asset
+// is returned as well.
+func (r *rpcServer) proveOwnership(ctx context.Context, assetID asset.ID,
+	scriptKey *btcec.PublicKey,
+	challenge [32]byte) (*proof.Proof, *proof.AssetSnapshot, error) {
 
-	return marshalAssetLeaf(ctx, r.cfg.AddrBook, assetLeaf)
-}
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot fetch proof: %w", err)
+	}
 
-// AssetLeaves queries for the set of asset leaves (the values in the Universe
-// MS-SMT tree) for a given asset_id or group_key. These represents either
-// asset issuance events (they have a genesis witness) or asset transfers that
-// took place on chain. The leaves contain a normal Taproot asset proof, as well
-// as details for the asset.
-func (r *rpcServer) AssetLeaves(ctx context.Context,
-	req *unirpc.ID) (*unirpc.AssetLeafResponse, error) {
+	proofFile := &proof.File{}
+	err = proofFile.Decode(bytes.NewReader(proofBlob))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot decode proof: %w", err)
+	}
 
-	universeID, err := UnmarshalUniID(req)
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	lastSnapshot, err := proofFile.Verify(
+		ctx, headerVerifier, groupVerifier,
+	)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("cannot verify proof: %w", err)
 	}
 
-	assetLeaves, err := r.cfg.BaseUniverse.MintingLeaves(ctx, universeID)
+	inputAsset := lastSnapshot.Asset
+	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+		ctx, inputAsset.ID(), lastSnapshot.OutPoint,
+		inputAsset.GroupKey, &inputAsset.ScriptKey, false,
+	)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("error fetching commitment: %w",
+			err)
 	}
 
-	resp := &unirpc.AssetLeafResponse{
-		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
+	challengeWitness, err := r.cfg.AssetWallet.SignOwnershipProof(
+		inputCommitment.Asset.Copy(), challenge,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error signing ownership proof: "+
+			"%w", err)
 	}
-	for i, assetLeaf := range assetLeaves {
-		assetLeaf := assetLeaf
 
-		resp.Leaves[i], err = r.marshalAssetLeaf(ctx, &assetLeaf)
-		if err != nil {
-			return nil, err
-		}
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching last proof: %w",
+			err)
 	}
 
-	return resp, nil
+	lastProof.ChallengeWitness = challengeWitness
+
+	return lastProof, lastSnapshot, nil
 }
 
-// UnmarshalOutpoint un-marshals an outpoint from a string received via RPC.
-func UnmarshalOutpoint(outpoint string) (*wire.OutPoint, error) {
-	parts := strings.Split(outpoint, ":")
-	if len(parts) != 2 {
-		return nil, errors.New("outpoint should be of form txid:index")
-	}
+// VerifyAssetOwnership verifies the asset ownership proof embedded in the
+// given transition proof of an asset and returns true if the proof is valid.
+func (r *rpcServer) VerifyAssetOwnership(ctx context.Context,
+	req *wrpc.VerifyAssetOwnershipRequest) (*wrpc.VerifyAssetOwnershipResponse,
+	error) {
 
-	txidStr := parts[0]
-	if hex.DecodedLen(len(txidStr)) != chainhash.HashSize {
-		return nil, fmt.Errorf("invalid hex-encoded txid %v", txidStr)
+	if len(req.ProofWithWitness) == 0 {
+		return nil, fmt.Errorf("a valid proof must be specified")
 	}
 
-	txid, err := chainhash.NewHashFromStr(txidStr)
+	p := &proof.Proof{}
+	err := p.Decode(bytes.NewReader(req.ProofWithWitness))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("cannot decode proof file: %w", err)
 	}
 
-	outputIndex, err := strconv.Atoi(parts[1])
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	_, err = p.Verify(ctx, nil, headerVerifier, groupVerifier)
 	if err != nil {
-		return nil, fmt.Errorf("invalid output index: %v", err)
+		return nil, fmt.Errorf("error verifying proof: %w", err)
 	}
 
-	return &wire.OutPoint{
-		Hash:  *txid,
-		Index: uint32(outputIndex),
+	return &wrpc.VerifyAssetOwnershipResponse{
+		ValidProof: true,
 	}, nil
 }
 
-// unmarshalLeafKey un-marshals a leaf key from the RPC form.
-func unmarshalLeafKey(key *unirpc.AssetKey) (universe.LeafKey, error) {
-	var (
-		leafKey universe.LeafKey
-		err     error
-	)
+// AttestReserves creates a reserve attestation that binds all unspent holdings
+// of an asset or asset group to a message and the current time.
+func (r *rpcServer) AttestReserves(ctx context.Context,
+	req *wrpc.AttestReservesRequest) (*wrpc.AttestReservesResponse, error) {
 
+	var constraints tapfreighter.CommitmentConstraints
 	switch {
-	case key.GetScriptKeyBytes() != nil:
-		pubKey, err := parseUserKey(key.GetScriptKeyBytes())
-		if err != nil {
-			return leafKey, err
+	case len(req.AssetId) > 0 && len(req.GroupKey) > 0:
+		return nil, fmt.Errorf("cannot set both asset ID and group key")
+
+	case len(req.AssetId) > 0:
+		if len(req.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
 		}
 
-		leafKey.ScriptKey = &asset.ScriptKey{
-			PubKey: pubKey,
+		assetID := fn.ToArray[asset.ID](req.AssetId)
+		constraints.AssetID = &assetID
+
+	case len(req.GroupKey) > 0:
+		groupKey, err := btcec.ParsePubKey(req.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
 		}
+		constraints.GroupKey = groupKey
 
-	case key.GetScriptKeyStr() != "":
-		scriptKeyBytes, sErr := hex.DecodeString(key.GetScriptKeyStr())
-		if sErr != nil {
-			return leafKey, err
+	default:
+		return nil, fmt.Errorf("either asset ID or group key must be " +
+			"set")
+	}
+
+	// We include leased assets, as they are still held by us until the
+	// transfer that leased them is confirmed.
+	chainAssets, err := r.cfg.AssetStore.FetchAllAssets(
+		ctx, false, true, &tapdb.AssetQueryFilters{
+			CommitmentConstraints: constraints,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch assets: %w", err)
+	}
+
+	attestation := &proof.ReserveAttestation{
+		Message:   req.Message,
+		Timestamp: time.Now(),
+	}
+	challenge := attestation.Challenge()
+
+	for _, chainAsset := range chainAssets {
+		// Burnt assets can't be spent anymore, so they don't count
+		// towards the reserves.
+		if chainAsset.IsBurn() {
+			continue
 		}
 
-		pubKey, err := parseUserKey(scriptKeyBytes)
+		ownershipProof, snapshot, err := r.proveOwnership(
+			ctx, chainAsset.ID(), chainAsset.ScriptKey.PubKey,
+			challenge,
+		)
 		if err != nil {
-			return leafKey, err
+			return nil, fmt.Errorf("unable to prove ownership of "+
+				"asset at %v: %w", chainAsset.AnchorOutpoint,
+				err)
 		}
 
-		leafKey.ScriptKey = &asset.ScriptKey{
-			PubKey: pubKey,
+		// The proof archive only keeps the latest proof per asset ID
+		// and script key, so we make sure it's about the same output.
+		if snapshot.OutPoint != chainAsset.AnchorOutpoint {
+			return nil, fmt.Errorf("proof for asset at %v is "+
+				"anchored at %v", chainAsset.AnchorOutpoint,
+				snapshot.OutPoint)
 		}
-	default:
-		// TODO(roasbeef): can actually allow not to be, then would
-		// fetch all for the given outpoint
-		return leafKey, fmt.Errorf("script key must be set")
+
+		attestation.Holdings = append(
+			attestation.Holdings, ownershipProof,
+		)
 	}
 
-	switch {
-	case key.GetOpStr() != "":
-		// Parse a bitcoin outpoint in the form txid:index into a
-		// wire.OutPoint struct.
-		outpointStr := key.GetOpStr()
-		outpoint, err := UnmarshalOutpoint(outpointStr)
+	// We verify the attestation ourselves, which also makes sure we don't
+	// attest the same holding twice.
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	snapshots, err := attestation.Verify(
+		ctx, headerVerifier, groupVerifier,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying attestation: %w", err)
+	}
+
+	rpcAttestation, err := marshalReserveAttestation(attestation)
+	if err != nil {
+		return nil, err
+	}
+
+	holdings, total := marshalReserveHoldings(snapshots)
+	return &wrpc.AttestReservesResponse{
+		Attestation: rpcAttestation,
+		Holdings:    holdings,
+		TotalAmount: total,
+	}, nil
+}
+
+// VerifyReserveAttestation verifies the ownership proofs of all holdings of a
+// reserve attestation and returns the attested holdings.
+func (r *rpcServer) VerifyReserveAttestation(ctx context.Context,
+	req *wrpc.VerifyReserveAttestationRequest) (
+	*wrpc.VerifyReserveAttestationResponse, error) {
+
+	if req.Attestation == nil {
+		return nil, fmt.Errorf("an attestation must be specified")
+	}
+
+	attestation := &proof.ReserveAttestation{
+		Message:   req.Attestation.Message,
+		Timestamp: time.Unix(req.Attestation.Timestamp, 0),
+		Holdings: make(
+			[]*proof.Proof, len(req.Attestation.HoldingProofs),
+		),
+	}
+	for idx, proofBytes := range req.Attestation.HoldingProofs {
+		p := &proof.Proof{}
+		err := p.Decode(bytes.NewReader(proofBytes))
 		if err != nil {
-			return leafKey, err
+			return nil, fmt.Errorf("cannot decode proof of "+
+				"holding %d: %w", idx, err)
 		}
 
-		leafKey.OutPoint = *outpoint
+		attestation.Holdings[idx] = p
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	snapshots, err := attestation.Verify(
+		ctx, headerVerifier, groupVerifier,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying attestation: %w", err)
+	}
 
-	case key.GetOutpoint() != nil:
-		op := key.GetOp()
+	holdings, total := marshalReserveHoldings(snapshots)
+	return &wrpc.VerifyReserveAttestationResponse{
+		Holdings:    holdings,
+		TotalAmount: total,
+	}, nil
+}
 
-		hash, err := chainhash.NewHashFromStr(op.HashStr)
-		if err != nil {
-			return leafKey, err
-		}
+// marshalReserveAttestation converts a reserve attestation to its RPC
+// counterpart.
+func marshalReserveAttestation(
+	attestation *proof.ReserveAttestation) (*wrpc.ReserveAttestation,
+	error) {
 
-		leafKey.OutPoint = wire.OutPoint{
-			Hash:  *hash,
-			Index: uint32(op.Index),
+	holdingProofs := make([][]byte, len(attestation.Holdings))
+	for idx, holding := range attestation.Holdings {
+		var buf bytes.Buffer
+		if err := holding.Encode(&buf); err != nil {
+			return nil, fmt.Errorf("error encoding proof: %w", err)
 		}
 
-	default:
-		return leafKey, fmt.Errorf("outpoint not set: %v", err)
+		holdingProofs[idx] = buf.Bytes()
 	}
 
-	return leafKey, nil
+	return &wrpc.ReserveAttestation{
+		Message:       attestation.Message,
+		Timestamp:     attestation.Timestamp.Unix(),
+		HoldingProofs: holdingProofs,
+	}, nil
 }
 
-// marshalMssmtProof marshals a MS-SMT proof into the RPC form.
-func marshalMssmtProof(proof *mssmt.Proof) ([]byte, error) {
-	compressedProof := proof.Compress()
+// marshalReserveHoldings converts the verified snapshots of the holdings of a
+// reserve attestation to their RPC counterpart and returns their total amount.
+func marshalReserveHoldings(
+	snapshots []*proof.AssetSnapshot) ([]*wrpc.ReserveHolding, uint64) {
 
-	var b bytes.Buffer
-	if err := compressedProof.Encode(&b); err != nil {
-		return nil, err
+	var total uint64
+	holdings := make([]*wrpc.ReserveHolding, len(snapshots))
+	for idx, snapshot := range snapshots {
+		a := snapshot.Asset
+		assetID := a.ID()
+		scriptKey := a.ScriptKey.PubKey.SerializeCompressed()
+
+		var groupKey []byte
+		if a.GroupKey != nil {
+			groupKey = a.GroupKey.GroupPubKey.SerializeCompressed()
+		}
+
+		holdings[idx] = &wrpc.ReserveHolding{
+			AssetId:           assetID[:],
+			GroupKey:          groupKey,
+			ScriptKey:         scriptKey,
+			Amount:            a.Amount,
+			AnchorOutpoint:    snapshot.OutPoint.String(),
+			AnchorBlockHeight: snapshot.AnchorBlockHeight,
+		}
+		total += a.Amount
 	}
 
-	return b.Bytes(), nil
+	return holdings, total
 }
 
-// marshalIssuanceProof marshals an issuance proof into the RPC form.
-func (r *rpcServer) marshalIssuanceProof(ctx context.Context,
-	req *unirpc.UniverseKey,
-	proof *universe.Proof) (*unirpc.AssetProofResponse, error) {
+// ImportAsset imports an asset into local custody from its proof file. The key
+// descriptors of the script key and the anchor internal key are stored before
+// the proof is imported, so the asset is recognized as spendable.
+func (r *rpcServer) ImportAsset(ctx context.Context,
+	req *wrpc.ImportAssetRequest) (*wrpc.ImportAssetResponse, error) {
 
-	uniProof, err := marshalMssmtProof(proof.UniverseInclusionProof)
+	switch {
+	case len(req.ProofFile) == 0:
+		return nil, fmt.Errorf("proof file must be specified")
+
+	case req.ScriptKey == nil || req.ScriptKey.KeyDesc == nil:
+		return nil, fmt.Errorf("script key descriptor must be " +
+			"specified")
+
+	case req.AnchorInternalKey == nil:
+		return nil, fmt.Errorf("anchor internal key must be specified")
+	}
+
+	var proofFile proof.File
+	err := proofFile.Decode(bytes.NewReader(req.ProofFile))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("cannot decode proof file: %w", err)
+	}
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch last proof: %w", err)
 	}
 
-	assetLeaf, err := r.marshalAssetLeaf(ctx, proof.Leaf)
+	// The given keys must be the ones the asset and its anchor output
+	// commit to.
+	scriptKey, err := UnmarshalScriptKey(req.ScriptKey)
 	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+	if err := tapgarden.ValidateScriptKey(*scriptKey); err != nil {
 		return nil, err
 	}
 
-	uniRoot, err := marshalUniverseRoot(universe.BaseRoot{
-		Node: proof.UniverseRoot,
-	})
+	// Only the x coordinate of the script key is given, so we use the
+	// full key of the asset to make sure the stored key matches it.
+	assetScriptKey := lastProof.Asset.ScriptKey.PubKey
+	if !bytes.Equal(
+		schnorr.SerializePubKey(scriptKey.PubKey),
+		schnorr.SerializePubKey(assetScriptKey),
+	) {
+
+		return nil, fmt.Errorf("script key doesn't match asset script "+
+			"key %x", assetScriptKey.SerializeCompressed())
+	}
+	scriptKey.PubKey = assetScriptKey
+
+	internalKey, err := UnmarshalKeyDescriptor(req.AnchorInternalKey)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid anchor internal key: %w", err)
+	}
+	if !internalKey.PubKey.IsEqual(lastProof.InclusionProof.InternalKey) {
+		return nil, fmt.Errorf("anchor internal key doesn't match "+
+			"proof internal key %x", lastProof.InclusionProof.
+			InternalKey.SerializeCompressed())
 	}
 
-	uniRoot.AssetName = assetLeaf.Asset.AssetGenesis.Name
-	uniRoot.Id = req.Id
+	// Unless the keys are held by an external signer, the backing lnd node
+	// must be able to sign with them. Assets of an external signer are
+	// stored as watch only, so coin selection won't pick them for
+	// transfers that are signed by the backing lnd node.
+	if !req.ExternalSigner {
+		if !r.cfg.AddrBook.IsLocalKey(ctx, scriptKey.RawKey) {
+			return nil, fmt.Errorf("script key is not a local key")
+		}
+		if !r.cfg.AddrBook.IsLocalKey(ctx, internalKey) {
+			return nil, fmt.Errorf("anchor internal key is not a " +
+				"local key")
+		}
+	}
+	scriptKey.WatchOnly = req.ExternalSigner
 
-	// Marshal multiverse specific fields.
-	multiverseRoot := marshalMssmtNode(proof.MultiverseRoot)
+	// The asset must not be in our custody already.
+	assetID := lastProof.Asset.ID()
+	anchorPoint := lastProof.OutPoint()
+	_, err = r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *assetScriptKey,
+		OutPoint:  &anchorPoint,
+	})
+	switch {
+	case err == nil:
+		return nil, fmt.Errorf("asset at anchor outpoint %v already "+
+			"imported", anchorPoint)
 
-	multiverseProof, err := marshalMssmtProof(
-		proof.MultiverseInclusionProof,
+	case !errors.Is(err, proof.ErrProofNotFound):
+		return nil, fmt.Errorf("unable to look up proof: %w", err)
+	}
+
+	// The asset must still be held in its anchor output, otherwise it was
+	// already spent from the proof's last state.
+	err = checkAnchorUnspent(
+		ctx, r.cfg.ChainBridge, lastProof, anchorSpendCheckTimeout,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.AssetProofResponse{
-		Req:                      req,
-		UniverseRoot:             uniRoot,
-		UniverseInclusionProof:   uniProof,
-		AssetLeaf:                assetLeaf,
-		MultiverseRoot:           multiverseRoot,
-		MultiverseInclusionProof: multiverseProof,
+	// The keys need to be known before the proof is imported, otherwise
+	// the asset would be stored with the bare keys of the proof.
+	err = r.cfg.AddrBook.InsertInternalKey(ctx, internalKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to insert internal key: %w", err)
+	}
+	if err := r.cfg.AddrBook.InsertScriptKey(ctx, *scriptKey); err != nil {
+		return nil, fmt.Errorf("unable to insert script key: %w", err)
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	err = r.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, groupVerifier, false,
+		&proof.AnnotatedProof{Blob: req.ProofFile},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import proof: %w", err)
+	}
+
+	return &wrpc.ImportAssetResponse{
+		AssetId:   assetID[:],
+		Amount:    lastProof.Asset.Amount,
+		ScriptKey: scriptKey.PubKey.SerializeCompressed(),
+		AnchorOutpoint: &wrpc.OutPoint{
+			Txid:        anchorPoint.Hash[:],
+			OutputIndex: anchorPoint.Index,
+		},
 	}, nil
 }
 
-// QueryProof attempts to query for an issuance proof for a given asset based
-// on its UniverseKey. A UniverseKey is composed of the Universe ID
-// (asset_id/group_key) and also a leaf key (outpoint || script_key). If found,
-// then the issuance proof is returned that includes an inclusion proof to the
-// known Universe root, as well as a Taproot Asset state transition or issuance
-// proof for the said asset.
-func (r *rpcServer) QueryProof(ctx context.Context,
-	req *unirpc.UniverseKey) (*unirpc.AssetProofResponse, error) {
+// checkAnchorUnspent makes sure the anchor output of the given proof is still
+// unspent on chain. The chain backend only notifies us about spends, so we
+// consider the output unspent if it didn't report a spend within the given
+// timeout.
+func checkAnchorUnspent(ctx context.Context, chainBridge tapgarden.ChainBridge,
+	p *proof.Proof, timeout time.Duration) error {
 
-	universeID, err := UnmarshalUniID(req.Id)
-	if err != nil {
-		return nil, err
+	outputIndex := p.InclusionProof.OutputIndex
+	if int(outputIndex) >= len(p.AnchorTx.TxOut) {
+		return fmt.Errorf("invalid anchor output index %d", outputIndex)
 	}
-	leafKey, err := unmarshalLeafKey(req.LeafKey)
+
+	ctxt, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The anchor output can't have been spent before the block it was
+	// confirmed in.
+	anchorPoint := p.OutPoint()
+	spendChan, errChan, err := chainBridge.RegisterSpendNtfn(
+		ctxt, &anchorPoint, p.AnchorTx.TxOut[outputIndex].PkScript,
+		p.BlockHeight,
+	)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("unable to register for anchor output "+
+			"spend: %w", err)
 	}
 
-	rpcsLog.Debugf("[QueryProof]: fetching proof at (universeID=%v, "+
-		"leafKey=%x)", universeID, leafKey.UniverseKey())
+	select {
+	case spend := <-spendChan:
+		return fmt.Errorf("anchor outpoint %v already spent by tx %v",
+			anchorPoint, spend.SpenderTxHash)
 
-	// Retrieve proof export config for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	case err := <-errChan:
+		return fmt.Errorf("error whilst checking anchor output "+
+			"spend: %w", err)
+
+	case <-ctxt.Done():
+		// If the parent context is done, we can't tell whether the
+		// output is unspent.
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return nil
+	}
+}
+
+// UniverseStats returns a set of aggregate statistics for the current state
+// of the Universe.
+func (r *rpcServer) UniverseStats(ctx context.Context,
+	_ *unirpc.StatsRequest) (*unirpc.StatsResponse, error) {
+
+	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var candidateIDs []universe.Identifier
+	return &unirpc.StatsResponse{
+		NumTotalAssets: int64(universeStats.NumTotalAssets),
+		NumTotalGroups: int64(universeStats.NumTotalGroups),
+		NumTotalSyncs:  int64(universeStats.NumTotalSyncs),
+		NumTotalProofs: int64(universeStats.NumTotalProofs),
+	}, nil
+}
 
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		// If the proof type is unspecified, then we'll attempt to
-		// retrieve both the issuance and transfer proofs. We gather the
-		// corresponding universe IDs into a candidate set.
-		universeID.ProofType = universe.ProofTypeIssuance
-		if syncConfigs.IsSyncExportEnabled(universeID) {
-			candidateIDs = append(candidateIDs, universeID)
-		}
+// marshalAssetSyncSnapshot maps a universe asset sync stat snapshot to the RPC
+// counterpart.
+func (r *rpcServer) marshalAssetSyncSnapshot(ctx context.Context,
+	a universe.AssetSyncSnapshot) *unirpc.AssetStatsSnapshot {
 
-		universeID.ProofType = universe.ProofTypeTransfer
-		if syncConfigs.IsSyncExportEnabled(universeID) {
-			candidateIDs = append(candidateIDs, universeID)
-		}
-	} else {
-		// Otherwise, we'll only attempt to retrieve the proof for the
-		// specified proof type. But first we'll check that proof export
-		// is enabled for the given universe.
-		if !syncConfigs.IsSyncExportEnabled(universeID) {
-			return nil, fmt.Errorf("proof export is disabled for " +
-				"the given universe")
-		}
+	resp := &unirpc.AssetStatsSnapshot{
+		TotalSyncs:  int64(a.TotalSyncs),
+		TotalProofs: int64(a.TotalProofs),
+		GroupSupply: int64(a.GroupSupply),
+	}
+	rpcAsset := &unirpc.AssetStatsAsset{
+		AssetId:          a.AssetID[:],
+		GenesisPoint:     a.GenesisPoint.String(),
+		AssetName:        a.AssetName,
+		AssetType:        taprpc.AssetType(a.AssetType),
+		TotalSupply:      int64(a.TotalSupply),
+		GenesisHeight:    int32(a.GenesisHeight),
+		GenesisTimestamp: r.getBlockTimestamp(ctx, a.GenesisHeight),
+	}
 
-		candidateIDs = append(candidateIDs, universeID)
+	if a.GroupKey != nil {
+		resp.GroupKey = a.GroupKey.SerializeCompressed()
+		resp.GroupAnchor = rpcAsset
+	} else {
+		resp.Asset = rpcAsset
 	}
 
-	// If no candidate IDs were applicable then our config must have
-	// disabled proof export for the given universe.
-	if len(candidateIDs) == 0 {
-		return nil, fmt.Errorf("proof export is disabled for the " +
-			"given universe")
+	return resp
+}
+
+// QueryAssetStats returns a set of statistics for a given set of assets.
+// Stats can be queried for all assets, or based on the: asset ID, name, or
+// asset type. Pagination is supported via the offset and limit params.
+// Results can also be sorted based on any of the main query params.
+func (r *rpcServer) QueryAssetStats(ctx context.Context,
+	req *unirpc.AssetStatsQuery) (*unirpc.UniverseAssetStats, error) {
+
+	assetStats, err := r.cfg.UniverseStats.QuerySyncStats(
+		ctx, universe.SyncStatsQuery{
+			AssetNameFilter: req.AssetNameFilter,
+			AssetTypeFilter: func() *asset.Type {
+				switch req.AssetTypeFilter {
+				case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
+					return fn.Ptr(asset.Normal)
+
+				case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
+					return fn.Ptr(asset.Collectible)
+
+				default:
+					return nil
+				}
+			}(),
+			AssetIDFilter: fn.ToArray[asset.ID](
+				req.AssetIdFilter,
+			),
+			SortBy:        universe.SyncStatsSort(req.SortBy),
+			SortDirection: universe.SortDirection(req.Direction),
+			Offset:        int(req.Offset),
+			Limit:         int(req.Limit),
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	// Attempt to retrieve the proof given the candidate set of universe
-	// IDs.
-	var proofs []*universe.Proof
-	for i := range candidateIDs {
-		candidateID := candidateIDs[i]
+	resp := &unirpc.UniverseAssetStats{
+		AssetStats: make(
+			[]*unirpc.AssetStatsSnapshot, len(assetStats.SyncStats),
+		),
+	}
+	for idx, snapshot := range assetStats.SyncStats {
+		resp.AssetStats[idx] = r.marshalAssetSyncSnapshot(ctx, snapshot)
+	}
 
-		proofs, err = r.cfg.BaseUniverse.FetchIssuanceProof(
-			ctx, candidateID, leafKey,
-		)
-		if err != nil {
-			if errors.Is(err, universe.ErrNoUniverseProofFound) {
-				continue
-			}
+	return resp, nil
+}
 
-			rpcsLog.Debugf("[QueryProof]: error querying for "+
-				"proof at (universeID=%v, leafKey=%x)",
-				universeID, leafKey.UniverseKey())
-			return nil, err
-		}
+// getBlockTimestamp returns the timestamp of the block at the given height.
+func (r *rpcServer) getBlockTimestamp(ctx context.Context,
+	height uint32) int64 {
 
-		// At this point we've found a proof, so we'll break out of the
-		// loop. We don't need to attempt to retrieve a proof for any
-		// other candidate IDs.
-		break
+	// Shortcut any lookup in case we don't have a valid height in the first
+	// place.
+	if height == 0 {
+		return 0
 	}
 
-	if len(proofs) == 0 {
-		return nil, universe.ErrNoUniverseProofFound
+	cacheTS, err := r.blockTimestampCache.Get(height)
+	if err == nil {
+		return int64(cacheTS)
 	}
 
-	// TODO(roasbeef): query may return multiple proofs, if allow key to
-	// not be fully specified
-	proof := proofs[0]
-
-	rpcsLog.Debugf("[QueryProof]: found proof at (universeID=%v, "+
-		"leafKey=%x)", universeID, leafKey.UniverseKey())
-
-	return r.marshalIssuanceProof(ctx, req, proof)
-}
+	hash, err := r.cfg.Lnd.ChainKit.GetBlockHash(ctx, int64(height))
+	if err != nil {
+		return 0
+	}
 
-// unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
-func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
-	// We'll just pull the asset details from the serialized issuance proof
-	// itself.
-	var assetProof proof.Proof
-	if err := assetProof.Decode(
-		bytes.NewReader(leaf.IssuanceProof),
-	); err != nil {
-		return nil, err
+	block, err := r.cfg.Lnd.ChainKit.GetBlock(ctx, hash)
+	if err != nil {
+		return 0
 	}
 
-	// TODO(roasbeef): double check posted file format everywhere
-	//  * raw proof, or within file?
+	ts := uint32(block.Header.Timestamp.Unix())
+	_, _ = r.blockTimestampCache.Put(height, cacheableTimestamp(ts))
 
-	return &universe.Leaf{
-		GenesisWithGroup: universe.GenesisWithGroup{
-			Genesis:  assetProof.Asset.Genesis,
-			GroupKey: assetProof.Asset.GroupKey,
-		},
-		Proof: &assetProof,
-		Amt:   assetProof.Asset.Amount,
-	}, nil
+	return int64(ts)
 }
 
-// InsertProof attempts to insert a new issuance proof into the Universe tree
-// specified by the UniverseKey. If valid, then the proof is inserted into the
-// database, with a new Universe root returned for the updated
-// asset_id/group_key.
-func (r *rpcServer) InsertProof(ctx context.Context,
-	req *unirpc.AssetProof) (*unirpc.AssetProofResponse, error) {
+// QueryEvents returns the number of sync and proof events for a given time
+// period, grouped by day.
+func (r *rpcServer) QueryEvents(ctx context.Context,
+	req *unirpc.QueryEventsRequest) (*unirpc.QueryEventsResponse, error) {
 
-	if req.Key == nil {
-		return nil, fmt.Errorf("key cannot be nil")
+	// If no start or end time is specified, default to the last 30 days.
+	var (
+		startTime = time.Now().AddDate(0, 0, -30)
+		endTime   = time.Now()
+	)
+	if req.StartTimestamp > 0 {
+		startTime = time.Unix(req.StartTimestamp, 0)
 	}
-
-	universeID, err := UnmarshalUniID(req.Key.Id)
-	if err != nil {
-		return nil, err
+	if req.EndTimestamp > 0 {
+		endTime = time.Unix(req.EndTimestamp, 0)
 	}
-	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
-	if err != nil {
-		return nil, err
+
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("end time cannot be before start time")
 	}
 
-	assetLeaf, err := unmarshalAssetLeaf(req.AssetLeaf)
+	stats, err := r.cfg.UniverseStats.QueryAssetStatsPerDay(
+		ctx, universe.GroupedStatsQuery{
+			StartTime: startTime,
+			EndTime:   endTime,
+		},
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error querying stats: %w", err)
 	}
 
-	// If universe proof type unspecified, set based on the provided asset
-	// proof.
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		universeID.ProofType, err = universe.NewProofTypeFromAssetProof(
-			assetLeaf.Proof,
-		)
-		if err != nil {
-			return nil, err
+	rpcStats := &unirpc.QueryEventsResponse{
+		Events: make([]*unirpc.GroupedUniverseEvents, len(stats)),
+	}
+	for day, s := range stats {
+		rpcStats.Events[day] = &unirpc.GroupedUniverseEvents{
+			Date:           s.Date,
+			SyncEvents:     s.NumTotalSyncs,
+			NewProofEvents: s.NumTotalProofs,
 		}
 	}
 
-	// Ensure that the new proof is of the correct type for the target
-	// universe.
-	err = universe.ValidateProofUniverseType(assetLeaf.Proof, universeID)
-	if err != nil {
-		return nil, err
+	return rpcStats, nil
+}
+
+// RemoveUTXOLease removes the lease/lock/reservation of the given managed
+// UTXO.
+func (r *rpcServer) RemoveUTXOLease(ctx context.Context,
+	req *wrpc.RemoveUTXOLeaseRequest) (*wrpc.RemoveUTXOLeaseResponse,
+	error) {
+
+	if req.Outpoint == nil {
+		return nil, fmt.Errorf("outpoint must be specified")
 	}
 
-	// Ensure proof insert is enabled for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	hash, err := chainhash.NewHash(req.Outpoint.Txid)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error parsing txid: %w", err)
 	}
 
-	if !syncConfigs.IsSyncInsertEnabled(universeID) {
-		return nil, fmt.Errorf("proof insert is disabled for the " +
-			"given universe")
+	outPoint := wire.OutPoint{
+		Hash:  *hash,
+		Index: req.Outpoint.OutputIndex,
 	}
 
-	rpcsLog.Debugf("[InsertProof]: inserting proof at "+
-		"(universeID=%v, leafKey=%x)", universeID,
-		leafKey.UniverseKey())
-
-	newUniverseState, err := r.cfg.BaseUniverse.RegisterIssuance(
-		ctx, universeID, leafKey, assetLeaf,
-	)
+	err = r.cfg.CoinSelect.ReleaseCoins(ctx, outPoint)
 	if err != nil {
 		return nil, err
 	}
 
-	universeRootHash := newUniverseState.UniverseRoot.NodeHash()
-	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
-		universeRootHash[:])
-
-	return r.marshalIssuanceProof(ctx, req.Key, newUniverseState)
+	return &wrpc.RemoveUTXOLeaseResponse{}, nil
 }
 
-// Info returns a set of information about the current state of the Universe.
-func (r *rpcServer) Info(ctx context.Context,
-	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
+// marshalSwapOffer turns a swap offer into its RPC counterpart.
+func marshalSwapOffer(offer *tapfreighter.SwapOffer, tapAddr string,
+	inputProofs [][]byte) (*wrpc.SwapOffer, error) {
 
-	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
-	if err != nil {
-		return nil, err
+	var vPktBuf bytes.Buffer
+	if err := offer.VPacket.Serialize(&vPktBuf); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
 	}
 
-	return &unirpc.InfoResponse{
-		RuntimeId: r.cfg.RuntimeID,
-		NumAssets: universeStats.NumTotalAssets,
+	var anchorBuf bytes.Buffer
+	if err := offer.AnchorPsbt.Serialize(&anchorBuf); err != nil {
+		return nil, fmt.Errorf("error serializing anchor psbt: %w", err)
+	}
+
+	return &wrpc.SwapOffer{
+		VirtualPsbt: vPktBuf.Bytes(),
+		AnchorPsbt:  anchorBuf.Bytes(),
+		TapAddr:     tapAddr,
+		InputProofs: inputProofs,
 	}, nil
 }
 
-// unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
-// type.
-func unmarshalUniverseSyncType(req unirpc.UniverseSyncMode) (
-	universe.SyncType, error) {
+// unmarshalSwapOffer parses an RPC swap offer and the address of the taker it
+// transfers the asset to.
+func (r *rpcServer) unmarshalSwapOffer(
+	rpcOffer *wrpc.SwapOffer) (*tapfreighter.SwapOffer, *address.Tap,
+	error) {
 
-	switch req {
-	case unirpc.UniverseSyncMode_SYNC_FULL:
-		return universe.SyncFull, nil
+	if rpcOffer == nil {
+		return nil, nil, fmt.Errorf("swap offer must be specified")
+	}
 
-	case unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY:
-		return universe.SyncIssuance, nil
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(rpcOffer.VirtualPsbt), false,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding packet: %w", err)
+	}
 
-	default:
-		return 0, fmt.Errorf("unknown sync type: %v", req)
+	anchorPsbt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(rpcOffer.AnchorPsbt), false,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding anchor psbt: %w",
+			err)
+	}
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	addr, err := address.DecodeAddress(rpcOffer.TapAddr, &tapParams)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode addr: %w", err)
 	}
+
+	return &tapfreighter.SwapOffer{
+		VPacket:    vPkt,
+		AnchorPsbt: anchorPsbt,
+	}, addr, nil
 }
 
-// unmarshalSyncTargets maps an RPC sync target into a concrete type.
-func unmarshalSyncTargets(targets []*unirpc.SyncTarget) ([]universe.Identifier, error) {
-	uniIDs := make([]universe.Identifier, 0, len(targets))
-	for _, target := range targets {
-		uniID, err := UnmarshalUniID(target.Id)
+// unmarshalPassivePkts parses the serialized passive asset packets of a swap
+// offer.
+func unmarshalPassivePkts(rawPkts [][]byte) ([]*tappsbt.VPacket, error) {
+	passivePkts := make([]*tappsbt.VPacket, len(rawPkts))
+	for idx, rawPkt := range rawPkts {
+		var err error
+		passivePkts[idx], err = tappsbt.NewFromRawBytes(
+			bytes.NewReader(rawPkt), false,
+		)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("error decoding passive "+
+				"packet %d: %w", idx, err)
 		}
-		uniIDs = append(uniIDs, uniID)
 	}
 
-	return uniIDs, nil
+	return passivePkts, nil
 }
 
-// marshalUniverseDiff marshals a universe diff into the RPC form.
-func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
-	uniDiff []universe.AssetSyncDiff) (*unirpc.SyncResponse, error) {
-
-	resp := &unirpc.SyncResponse{
-		SyncedUniverses: make([]*unirpc.SyncedUniverse, 0, len(uniDiff)),
-	}
+// fetchInputCommitments fetches the Taproot Asset commitments of the anchor
+// outputs spent by the inputs of the given virtual packet. The inputs must be
+// leased by us.
+func (r *rpcServer) fetchInputCommitments(ctx context.Context,
+	vPkt *tappsbt.VPacket) (tappsbt.InputCommitments, error) {
 
-	err := fn.ForEachErr(uniDiff, func(diff universe.AssetSyncDiff) error {
-		oldUniRoot, err := marshalUniverseRoot(diff.OldUniverseRoot)
-		if err != nil {
-			return fmt.Errorf("unable to marshal old uni "+
-				"root: %w", err)
-		}
-		newUniRoot, err := marshalUniverseRoot(diff.NewUniverseRoot)
-		if err != nil {
-			return fmt.Errorf("unable to marshal new unit "+
-				"root: %w", err)
+	inputCommitments := make(tappsbt.InputCommitments, len(vPkt.Inputs))
+	for idx, vIn := range vPkt.Inputs {
+		inputAsset := vIn.Asset()
+		if inputAsset == nil {
+			return nil, fmt.Errorf("input %d is missing its asset",
+				idx)
 		}
 
-		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
-		for i, leaf := range diff.NewLeafProofs {
-			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
-			if err != nil {
-				return err
-			}
+		inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+			ctx, inputAsset.ID(), vIn.PrevID.OutPoint,
+			inputAsset.GroupKey, &inputAsset.ScriptKey, true,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching commitment of "+
+				"input %d: %w", idx, err)
 		}
 
-		resp.SyncedUniverses = append(
-			resp.SyncedUniverses, &unirpc.SyncedUniverse{
-				OldAssetRoot:   oldUniRoot,
-				NewAssetRoot:   newUniRoot,
-				NewAssetLeaves: leaves,
-			},
-		)
-		return nil
-	})
-	if err != nil {
-		return nil, err
+		inputCommitments[idx] = inputCommitment.Commitment
 	}
 
-	return resp, nil
+	return inputCommitments, nil
 }
 
-// SyncUniverse takes host information for a remote Universe server, then
-// attempts to synchronize either only the set of specified asset_ids, or all
-// assets if none are specified. The sync process will attempt to query for the
-// latest known root for each asset, performing tree based reconciliation to
-// arrive at a new shared root.
-func (r *rpcServer) SyncUniverse(ctx context.Context,
-	req *unirpc.SyncRequest) (*unirpc.SyncResponse, error) {
+// serializeVPkts serializes the given virtual packets.
+func serializeVPkts(vPkts []*tappsbt.VPacket) ([][]byte, error) {
+	rawPkts := make([][]byte, len(vPkts))
+	for idx, vPkt := range vPkts {
+		var b bytes.Buffer
+		if err := vPkt.Serialize(&b); err != nil {
+			return nil, fmt.Errorf("error serializing packet "+
+				"%d: %w", idx, err)
+		}
+		rawPkts[idx] = b.Bytes()
+	}
 
-	// TODO(roasbeef): have another layer, only allow single outstanding
-	// sync request per host?
+	return rawPkts, nil
+}
 
-	syncMode, err := unmarshalUniverseSyncType(req.SyncMode)
+// CreateSwapOffer creates an offer to atomically swap an asset for BTC.
+func (r *rpcServer) CreateSwapOffer(ctx context.Context,
+	req *wrpc.CreateSwapOfferRequest) (*wrpc.CreateSwapOfferResponse,
+	error) {
+
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse sync type: %w", err)
+		return nil, err
 	}
-	syncTargets, err := unmarshalSyncTargets(req.SyncTargets)
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	addr, err := address.DecodeAddress(req.TapAddr, &tapParams)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse sync targets: %w", err)
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
 	}
 
-	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
+	prevIDs, err := unmarshalPrevIDs(req.Inputs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inputs: %w", err)
+	}
 
-	// Obtain the general and universe specific federation sync configs.
-	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
-	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
+	// The payment goes to a new P2WKH output of the backing lnd node. We
+	// deliberately don't use a P2TR output, as we'd need to create an
+	// exclusion proof for it.
+	payAddr, err := r.cfg.Lnd.WalletKit.NextAddr(
+		ctx, "", walletrpc.AddressType_WITNESS_PUBKEY_HASH, false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive payment address: %w",
+			err)
+	}
+	payScript, err := txscript.PayToAddrScript(payAddr)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query federation sync "+
-			"config(s): %w", err)
+		return nil, fmt.Errorf("unable to create payment script: %w",
+			err)
 	}
 
-	syncConfigs := universe.SyncConfigs{
-		GlobalSyncConfigs: globalConfigs,
-		UniSyncConfigs:    uniSyncConfigs,
+	payment := &wire.TxOut{
+		Value:    int64(req.PaymentSat),
+		PkScript: payScript,
+	}
+	dustLimit := lnwallet.DustLimitForSize(len(payScript))
+	if btcutil.Amount(payment.Value) < dustLimit {
+		return nil, fmt.Errorf("payment must be at least the dust "+
+			"limit of %d satoshis", dustLimit)
 	}
 
-	// TODO(roasbeef): add layer of indirection in front of?
-	//  * just interface interaction
-	// TODO(ffranr): Sync via the FederationEnvoy rather than syncer.
-	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverse(
-		ctx, uniAddr, syncMode, syncConfigs, syncTargets...,
+	fundedVPkt, _, err := r.cfg.AssetWallet.FundAddressSend(
+		ctx, prevIDs, strategy, 0, addr,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to sync universe: %w", err)
+		return nil, fmt.Errorf("error funding address send: %w", err)
 	}
 
-	return r.marshalUniverseDiff(ctx, universeDiff)
-}
-
-func marshalUniverseServer(server universe.ServerAddr,
-) *unirpc.UniverseFederationServer {
-
-	return &unirpc.UniverseFederationServer{
-		Host: server.HostStr(),
-		Id:   int32(server.ID),
+	vPkt := fundedVPkt.VPacket
+	_, err = r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
 	}
-}
-
-// ListFederationServers lists the set of servers that make up the federation
-// of the local Universe server. This servers are used to push out new proofs,
-// and also periodically call sync new proofs from the remote server.
-func (r *rpcServer) ListFederationServers(ctx context.Context,
-	_ *unirpc.ListFederationServersRequest,
-) (*unirpc.ListFederationServersResponse, error) {
 
-	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	passiveAssets, err := r.cfg.AssetWallet.SignPassiveAssets(
+		vPkt, fundedVPkt.InputCommitments,
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error signing passive assets: %w", err)
 	}
 
-	return &unirpc.ListFederationServersResponse{
-		Servers: fn.Map(uniServers, marshalUniverseServer),
-	}, nil
-}
-
-func unmarshalUniverseServer(
-	server *unirpc.UniverseFederationServer) universe.ServerAddr {
+	passivePkts := make([]*tappsbt.VPacket, len(passiveAssets))
+	rawPassivePkts := make([][]byte, len(passiveAssets))
+	for idx, passiveAsset := range passiveAssets {
+		passivePkts[idx] = passiveAsset.VPacket
 
-	return universe.NewServerAddr(int64(server.Id), server.Host)
-}
+		var b bytes.Buffer
+		if err := passiveAsset.VPacket.Serialize(&b); err != nil {
+			return nil, fmt.Errorf("error serializing passive "+
+				"packet: %w", err)
+		}
+		rawPassivePkts[idx] = b.Bytes()
+	}
 
-// AddFederationServer adds a new server to the federation of the local
-// Universe server. Once a server is added, this call can also optionally be
-// used to trigger a sync of the remote server.
-func (r *rpcServer) AddFederationServer(ctx context.Context,
-	req *unirpc.AddFederationServerRequest,
-) (*unirpc.AddFederationServerResponse, error) {
+	offer, err := tapfreighter.NewSwapOffer(
+		vPkt, fundedVPkt.InputCommitments, passivePkts, payment,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating swap offer: %w", err)
+	}
 
-	serversToAdd := fn.Map(req.Servers, unmarshalUniverseServer)
+	// We remember the payment we asked for, so we can verify the taker
+	// pays it without trusting the offer they hand back to us.
+	err = r.cfg.AssetStore.StoreSwapOffer(ctx, offer.ID(), payment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store swap offer: %w", err)
+	}
 
-	for idx := range serversToAdd {
-		server := serversToAdd[idx]
+	// The taker needs the full provenance of the assets we're spending.
+	inputProofs := make([][]byte, len(vPkt.Inputs))
+	for idx, vIn := range vPkt.Inputs {
+		assetID := vIn.PrevID.ID
+		scriptKey, err := vIn.PrevID.ScriptKey.ToPubKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key of input "+
+				"%d: %w", idx, err)
+		}
 
-		// Before we add the server as a federation member, we check
-		// that we can actually connect to it and that it isn't
-		// ourselves.
-		err := CheckFederationServer(
-			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+		inputProofs[idx], err = r.cfg.ProofArchive.FetchProof(
+			ctx, proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *scriptKey,
+				OutPoint:  &vIn.PrevID.OutPoint,
+			},
 		)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("unable to fetch proof of "+
+				"input %d: %w", idx, err)
 		}
 	}
 
-	err := r.cfg.UniverseFederation.AddServer(serversToAdd...)
+	rpcOffer, err := marshalSwapOffer(offer, req.TapAddr, inputProofs)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.AddFederationServerResponse{}, nil
+	return &wrpc.CreateSwapOfferResponse{
+		Offer:             rpcOffer,
+		PassiveAssetPsbts: rawPassivePkts,
+	}, nil
 }
 
-// DeleteFederationServer removes a server from the federation of the local
-// Universe server.
-func (r *rpcServer) DeleteFederationServer(ctx context.Context,
-	req *unirpc.DeleteFederationServerRequest,
-) (*unirpc.DeleteFederationServerResponse, error) {
-
-	serversToDel := fn.Map(req.Servers, unmarshalUniverseServer)
+// AcceptSwapOffer verifies a swap offer, then funds and countersigns its
+// anchor transaction.
+func (r *rpcServer) AcceptSwapOffer(ctx context.Context,
+	req *wrpc.AcceptSwapOfferRequest) (*wrpc.AcceptSwapOfferResponse,
+	error) {
 
-	err := r.cfg.FederationDB.RemoveServers(ctx, serversToDel...)
+	offer, addr, err := r.unmarshalSwapOffer(req.Offer)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.DeleteFederationServerResponse{}, nil
-}
-
-// SetFederationSyncConfig sets the configuration of the universe federation
-// sync.
-func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
-	req *unirpc.SetFederationSyncConfigRequest) (
-	*unirpc.SetFederationSyncConfigResponse, error) {
+	// We only pay for assets that are sent to one of our own addresses.
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(ctx, addr.AssetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query asset of addr: %w", err)
+	}
+	addr.AttachGenesis(*assetGroup.Genesis)
 
-	// Unmarshal global sync configs.
-	globalSyncConfig := make(
-		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
-	)
-	for i := range req.GlobalSyncConfigs {
-		config := req.GlobalSyncConfigs[i]
+	outputKey, err := addr.TaprootOutputKey()
+	if err != nil {
+		return nil, fmt.Errorf("error deriving Taproot output key: %w",
+			err)
+	}
+	_, err = r.cfg.AddrBook.AddrByTaprootOutput(ctx, outputKey)
+	if err != nil {
+		return nil, fmt.Errorf("addr of swap offer is not ours: %w",
+			err)
+	}
 
-		proofType, err := UnmarshalUniProofType(config.ProofType)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal "+
-				"proof type: %w", err)
+	// Each input of the virtual transaction must be the last state of a
+	// valid proof file.
+	vPkt := offer.VPacket
+	if len(req.Offer.InputProofs) != len(vPkt.Inputs) {
+		return nil, fmt.Errorf("expected %d input proofs, got %d",
+			len(vPkt.Inputs), len(req.Offer.InputProofs))
+	}
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	for idx, vIn := range vPkt.Inputs {
+		rawProof := req.Offer.InputProofs[idx]
+		if err := proof.CheckMaxFileSize(rawProof); err != nil {
+			return nil, fmt.Errorf("invalid proof of input %d: %w",
+				idx, err)
 		}
 
-		globalSyncConfig[i] = &universe.FedGlobalSyncConfig{
-			ProofType:       proofType,
-			AllowSyncInsert: config.AllowSyncInsert,
-			AllowSyncExport: config.AllowSyncExport,
+		var proofFile proof.File
+		err := proofFile.Decode(bytes.NewReader(rawProof))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof of "+
+				"input %d: %w", idx, err)
 		}
-	}
 
-	// Unmarshal asset (asset/asset group) specific sync configs.
-	assetSyncConfigs := make(
-		[]*universe.FedUniSyncConfig, len(req.AssetSyncConfigs),
-	)
-	for i := range req.AssetSyncConfigs {
-		assetSyncConfig := req.AssetSyncConfigs[i]
-		config, err := unmarshalAssetSyncConfig(assetSyncConfig)
+		snapshot, err := proofFile.Verify(
+			ctx, headerVerifier, groupVerifier,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse asset sync "+
-				"config: %w", err)
+			return nil, fmt.Errorf("invalid proof of input %d: %w",
+				idx, err)
 		}
 
-		assetSyncConfigs[i] = config
+		proven, input := snapshot.Asset, vIn.Asset()
+		provenKey := proven.ScriptKey.PubKey
+		if snapshot.OutPoint != vIn.PrevID.OutPoint ||
+			proven.ID() != input.ID() ||
+			proven.Amount != input.Amount ||
+			!provenKey.IsEqual(input.ScriptKey.PubKey) {
+
+			return nil, fmt.Errorf("%w: proof doesn't match input "+
+				"%d", tapfreighter.ErrSwapOfferMismatch, idx)
+		}
 	}
 
-	// Update asset (asset/asset group) specific sync configs.
-	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
-		ctx, globalSyncConfig, assetSyncConfigs,
-	)
+	err = tapfreighter.VerifySwapOffer(offer, addr, &ValidatorV0{})
 	if err != nil {
-		return nil, fmt.Errorf("unable to set federation sync "+
-			"config: %w", err)
+		return nil, fmt.Errorf("invalid swap offer: %w", err)
 	}
 
-	return &unirpc.SetFederationSyncConfigResponse{}, nil
-}
-
-// QueryFederationSyncConfig queries the universe federation sync configuration
-// settings.
-func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
-	_ *unirpc.QueryFederationSyncConfigRequest,
-) (*unirpc.QueryFederationSyncConfigResponse, error) {
-
-	// Obtain the general and universe specific federation sync configs.
-	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
-	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
+	payment, err := offer.Payment()
 	if err != nil {
-		return nil, fmt.Errorf("unable to query federation sync "+
-			"config(s): %w", err)
+		return nil, err
+	}
+	if uint64(payment) > req.MaxPaymentSat {
+		return nil, fmt.Errorf("offer asks for %d satoshis, more than "+
+			"the maximum of %d", payment, req.MaxPaymentSat)
 	}
 
-	// Marshal the general sync config into the RPC form.
-	globalConfigRPC := make(
-		[]*unirpc.GlobalFederationSyncConfig, len(globalConfigs),
-	)
-	for i := range globalConfigs {
-		globalConfig := globalConfigs[i]
-
-		proofTypeRpc, err := MarshalUniProofType(globalConfig.ProofType)
+	feeRate := chainfee.SatPerKVByte(req.SatPerVbyte * 1000).FeePerKWeight()
+	if req.SatPerVbyte == 0 {
+		feeRate, err = r.cfg.ChainBridge.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal "+
-				"proof type: %w", err)
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
 		}
+	}
 
-		globalConfigRPC[i] = &unirpc.GlobalFederationSyncConfig{
-			ProofType:       proofTypeRpc,
-			AllowSyncInsert: globalConfig.AllowSyncInsert,
-			AllowSyncExport: globalConfig.AllowSyncExport,
-		}
+	signedPkt, err := r.cfg.AssetWallet.FundSwapOffer(ctx, offer, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("error funding swap offer: %w", err)
 	}
 
-	// Marshal universe specific sync configs into the RPC form.
-	uniConfigRPCs := make(
-		[]*unirpc.AssetFederationSyncConfig, len(uniSyncConfigs),
-	)
-	for i := range uniSyncConfigs {
-		uniSyncConfig := uniSyncConfigs[i]
-		uniConfigRPC, err := MarshalAssetFedSyncCfg(*uniSyncConfig)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal universe "+
-				"specific federation sync config: %w", err)
-		}
-		uniConfigRPCs[i] = uniConfigRPC
+	var b bytes.Buffer
+	if err := signedPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing psbt: %w", err)
 	}
 
-	return &unirpc.QueryFederationSyncConfigResponse{
-		GlobalSyncConfigs: globalConfigRPC,
-		AssetSyncConfigs:  uniConfigRPCs,
+	return &wrpc.AcceptSwapOfferResponse{
+		CountersignedPsbt: b.Bytes(),
+		PaymentSat:        uint64(payment),
 	}, nil
 }
 
-// ProveAssetOwnership creates an ownership proof embedded in an asset
-// transition proof. That ownership proof is a signed virtual transaction
-// spending the asset with a valid witness to prove the prover owns the keys
-// that can spend the asset.
-func (r *rpcServer) ProveAssetOwnership(ctx context.Context,
-	req *wrpc.ProveAssetOwnershipRequest) (*wrpc.ProveAssetOwnershipResponse,
-	error) {
+// CompleteSwap signs the asset inputs of a swap offer that was countersigned
+// by the taker, then broadcasts the anchor transaction and logs the transfer.
+func (r *rpcServer) CompleteSwap(ctx context.Context,
+	req *wrpc.CompleteSwapRequest) (*taprpc.SendAssetResponse, error) {
 
-	if len(req.ScriptKey) == 0 {
-		return nil, fmt.Errorf("a valid script key must be specified")
+	if err := validateLabel(req.Label); err != nil {
+		return nil, err
 	}
 
-	scriptKey, err := parseUserKey(req.ScriptKey)
+	offer, addr, err := r.unmarshalSwapOffer(req.Offer)
 	if err != nil {
-		return nil, fmt.Errorf("invalid script key: %w", err)
+		return nil, err
 	}
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	// We only complete offers we made ourselves, and only for the payment
+	// we asked for.
+	payment, err := r.cfg.AssetStore.SwapOfferPayment(ctx, offer.ID())
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up swap offer: %w", err)
 	}
 
-	assetID := fn.ToArray[asset.ID](req.AssetId)
-	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
-		AssetID:   &assetID,
-		ScriptKey: *scriptKey,
-	})
+	passivePkts, err := unmarshalPassivePkts(req.PassiveAssetPsbts)
 	if err != nil {
-		return nil, fmt.Errorf("cannot fetch proof: %w", err)
+		return nil, err
 	}
 
-	proofFile := &proof.File{}
-	err = proofFile.Decode(bytes.NewReader(proofBlob))
+	counterSignedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.CountersignedPsbt), false,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot decode proof: %w", err)
+		return nil, fmt.Errorf("error decoding countersigned psbt: %w",
+			err)
 	}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	lastSnapshot, err := proofFile.Verify(
-		ctx, headerVerifier, groupVerifier,
+	// The inputs must still be leased by us, otherwise the offer expired.
+	vPkt := offer.VPacket
+	inputCommitments, err := r.fetchInputCommitments(ctx, vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorTx, err := r.cfg.AssetWallet.CompleteSwap(
+		ctx, offer, payment, inputCommitments, passivePkts,
+		counterSignedPkt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error completing swap: %w", err)
+	}
+
+	// The proof of the swapped asset is delivered to the taker through
+	// the proof courier of their address.
+	outputIdxToAddr := make(tappsbt.OutputIdxToAddr)
+	for idx, vOut := range vPkt.Outputs {
+		if vOut.ScriptKey.PubKey != nil &&
+			vOut.ScriptKey.PubKey.IsEqual(&addr.ScriptKey) {
+
+			outputIdxToAddr[idx] = *addr
+		}
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreAnchoredParcel(
+			vPkt, passivePkts, inputCommitments, anchorTx,
+			outputIdxToAddr, req.Label,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	// The offer can't be completed a second time, as its inputs are now
+	// spent.
+	err = r.cfg.AssetStore.DeleteSwapOffer(ctx, offer.ID())
+	if err != nil {
+		rpcsLog.Warnf("Unable to delete completed swap offer %v: %v",
+			offer.ID(), err)
+	}
+
+	parcel, err := r.marshalOutboundParcel(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// marshalSignMethod converts the sign method of a signing request to its RPC
+// counterpart.
+func marshalSignMethod(method input.SignMethod) (wrpc.SignMethod, error) {
+	switch method {
+	case input.TaprootKeySpendBIP0086SignMethod:
+		return wrpc.SignMethod_SIGN_METHOD_TAPROOT_KEY_SPEND_BIP0086,
+			nil
+
+	case input.TaprootKeySpendSignMethod:
+		return wrpc.SignMethod_SIGN_METHOD_TAPROOT_KEY_SPEND, nil
+
+	case input.TaprootScriptSpendSignMethod:
+		return wrpc.SignMethod_SIGN_METHOD_TAPROOT_SCRIPT_SPEND, nil
+
+	default:
+		return 0, fmt.Errorf("unknown sign method: %v", method)
+	}
+}
+
+// PrepareExternalSigning creates the unsigned passive asset virtual
+// transactions for a funded virtual transaction and returns the signing
+// requests for all of them.
+func (r *rpcServer) PrepareExternalSigning(ctx context.Context,
+	req *wrpc.PrepareExternalSigningRequest) (
+	*wrpc.PrepareExternalSigningResponse, error) {
+
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.VirtualPsbt), false,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot verify proof: %w", err)
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	inputAsset := lastSnapshot.Asset
-	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
-		ctx, inputAsset.ID(), lastSnapshot.OutPoint,
-		inputAsset.GroupKey, &inputAsset.ScriptKey, false,
-	)
+	inputCommitments, err := r.fetchInputCommitments(ctx, vPkt)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching commitment: %w", err)
+		return nil, err
 	}
 
-	challengeWitness, err := r.cfg.AssetWallet.SignOwnershipProof(
-		inputCommitment.Asset.Copy(),
+	passiveAssets, err := r.cfg.AssetWallet.CreatePassiveAssets(
+		vPkt, inputCommitments,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error signing ownership proof: %w", err)
+		return nil, fmt.Errorf("error creating passive assets: %w", err)
 	}
 
-	lastProof, err := proofFile.LastProof()
+	passivePkts := make([]*tappsbt.VPacket, len(passiveAssets))
+	for idx, passiveAsset := range passiveAssets {
+		passivePkts[idx] = passiveAsset.VPacket
+	}
+	rawPassivePkts, err := serializeVPkts(passivePkts)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching last proof: %w", err)
+		return nil, err
 	}
 
-	lastProof.ChallengeWitness = challengeWitness
+	allPkts := append([]*tappsbt.VPacket{vPkt}, passivePkts...)
+	var signingRequests []*wrpc.SigningRequest
+	for pktIdx, pkt := range allPkts {
+		requests, err := tapscript.SigningRequests(pkt)
+		if err != nil {
+			return nil, fmt.Errorf("error creating signing "+
+				"requests for packet %d: %w", pktIdx, err)
+		}
 
-	var buf bytes.Buffer
-	if err := lastProof.Encode(&buf); err != nil {
-		return nil, fmt.Errorf("error encoding proof file: %w", err)
+		for _, sigReq := range requests {
+			desc := sigReq.SignDesc
+			signMethod, err := marshalSignMethod(desc.SignMethod)
+			if err != nil {
+				return nil, err
+			}
+
+			rawKey := marshalKeyDescriptor(desc.KeyDesc)
+			rpcReq := &wrpc.SigningRequest{
+				PacketIndex:   uint32(pktIdx),
+				InputIndex:    uint32(sigReq.InputIndex),
+				SigHash:       sigReq.SigHash[:],
+				RawKey:        rawKey,
+				SignMethod:    signMethod,
+				TapTweak:      desc.TapTweak,
+				WitnessScript: desc.WitnessScript,
+			}
+			signingRequests = append(signingRequests, rpcReq)
+		}
 	}
 
-	return &wrpc.ProveAssetOwnershipResponse{
-		ProofWithWitness: buf.Bytes(),
+	return &wrpc.PrepareExternalSigningResponse{
+		PassiveAssetPsbts: rawPassivePkts,
+		SigningRequests:   signingRequests,
 	}, nil
 }
 
-// VerifyAssetOwnership verifies the asset ownership proof embedded in the
-// given transition proof of an asset and returns true if the proof is valid.
-func (r *rpcServer) VerifyAssetOwnership(ctx context.Context,
-	req *wrpc.VerifyAssetOwnershipRequest) (*wrpc.VerifyAssetOwnershipResponse,
-	error) {
-
-	if len(req.ProofWithWitness) == 0 {
-		return nil, fmt.Errorf("a valid proof must be specified")
-	}
+// ApplyExternalSignatures adds the signatures created by an external signer to
+// the virtual transactions and validates them.
+func (r *rpcServer) ApplyExternalSignatures(_ context.Context,
+	req *wrpc.ApplyExternalSignaturesRequest) (
+	*wrpc.ApplyExternalSignaturesResponse, error) {
 
-	p := &proof.Proof{}
-	err := p.Decode(bytes.NewReader(req.ProofWithWitness))
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.VirtualPsbt), false,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot decode proof file: %w", err)
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	_, err = p.Verify(ctx, nil, headerVerifier, groupVerifier)
+	passivePkts, err := unmarshalPassivePkts(req.PassiveAssetPsbts)
 	if err != nil {
-		return nil, fmt.Errorf("error verifying proof: %w", err)
+		return nil, err
 	}
 
-	return &wrpc.VerifyAssetOwnershipResponse{
-		ValidProof: true,
-	}, nil
-}
+	sigs := make(map[chainhash.Hash]*schnorr.Signature)
+	for idx, rpcSig := range req.Signatures {
+		sigHash, err := chainhash.NewHash(rpcSig.SigHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sighash of signature "+
+				"%d: %w", idx, err)
+		}
 
-// UniverseStats returns a set of aggregate statistics for the current state
-// of the Universe.
-func (r *rpcServer) UniverseStats(ctx context.Context,
-	_ *unirpc.StatsRequest) (*unirpc.StatsResponse, error) {
+		sig, err := schnorr.ParseSignature(rpcSig.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", idx,
+				err)
+		}
 
-	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
-	if err != nil {
-		return nil, err
+		sigs[*sigHash] = sig
 	}
 
-	return &unirpc.StatsResponse{
-		NumTotalAssets: int64(universeStats.NumTotalAssets),
-		NumTotalGroups: int64(universeStats.NumTotalGroups),
-		NumTotalSyncs:  int64(universeStats.NumTotalSyncs),
-		NumTotalProofs: int64(universeStats.NumTotalProofs),
-	}, nil
-}
-
-// marshalAssetSyncSnapshot maps a universe asset sync stat snapshot to the RPC
-// counterpart.
-func (r *rpcServer) marshalAssetSyncSnapshot(ctx context.Context,
-	a universe.AssetSyncSnapshot) *unirpc.AssetStatsSnapshot {
-
-	resp := &unirpc.AssetStatsSnapshot{
-		TotalSyncs:  int64(a.TotalSyncs),
-		TotalProofs: int64(a.TotalProofs),
-		GroupSupply: int64(a.GroupSupply),
+	signer := tapscript.NewExternalSigner(sigs)
+	_, err = r.cfg.AssetWallet.SignVirtualPacket(
+		vPkt, tapfreighter.WithSigner(signer),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
 	}
-	rpcAsset := &unirpc.AssetStatsAsset{
-		AssetId:          a.AssetID[:],
-		GenesisPoint:     a.GenesisPoint.String(),
-		AssetName:        a.AssetName,
-		AssetType:        taprpc.AssetType(a.AssetType),
-		TotalSupply:      int64(a.TotalSupply),
-		GenesisHeight:    int32(a.GenesisHeight),
-		GenesisTimestamp: r.getBlockTimestamp(ctx, a.GenesisHeight),
+
+	for idx, passivePkt := range passivePkts {
+		_, err := r.cfg.AssetWallet.SignVirtualPacket(
+			passivePkt, tapfreighter.SkipInputProofVerify(),
+			tapfreighter.WithSigner(signer),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error signing passive packet "+
+				"%d: %w", idx, err)
+		}
 	}
 
-	if a.GroupKey != nil {
-		resp.GroupKey = a.GroupKey.SerializeCompressed()
-		resp.GroupAnchor = rpcAsset
-	} else {
-		resp.Asset = rpcAsset
+	rawPkts, err := serializeVPkts(
+		append([]*tappsbt.VPacket{vPkt}, passivePkts...),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return resp
+	return &wrpc.ApplyExternalSignaturesResponse{
+		SignedVirtualPsbt:       rawPkts[0],
+		SignedPassiveAssetPsbts: rawPkts[1:],
+	}, nil
 }
 
-// QueryAssetStats returns a set of statistics for a given set of assets.
-// Stats can be queried for all assets, or based on the: asset ID, name, or
-// asset type. Pagination is supported via the offset and limit params.
-// Results can also be sorted based on any of the main query params.
-func (r *rpcServer) QueryAssetStats(ctx context.Context,
-	req *unirpc.AssetStatsQuery) (*unirpc.UniverseAssetStats, error) {
-
-	assetStats, err := r.cfg.UniverseStats.QuerySyncStats(
-		ctx, universe.SyncStatsQuery{
-			AssetNameFilter: req.AssetNameFilter,
-			AssetTypeFilter: func() *asset.Type {
-				switch req.AssetTypeFilter {
-				case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
-					return fn.Ptr(asset.Normal)
-
-				case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
-					return fn.Ptr(asset.Collectible)
+// FundAnchorPsbt creates and funds the BTC level anchor transaction for the
+// externally signed virtual transactions, without signing it.
+func (r *rpcServer) FundAnchorPsbt(ctx context.Context,
+	req *wrpc.FundAnchorPsbtRequest) (*wrpc.FundAnchorPsbtResponse, error) {
 
-				default:
-					return nil
-				}
-			}(),
-			AssetIDFilter: fn.ToArray[asset.ID](
-				req.AssetIdFilter,
-			),
-			SortBy:        universe.SyncStatsSort(req.SortBy),
-			SortDirection: universe.SortDirection(req.Direction),
-			Offset:        int(req.Offset),
-			Limit:         int(req.Limit),
-		},
-	)
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &unirpc.UniverseAssetStats{
-		AssetStats: make(
-			[]*unirpc.AssetStatsSnapshot, len(assetStats.SyncStats),
-		),
-	}
-	for idx, snapshot := range assetStats.SyncStats {
-		resp.AssetStats[idx] = r.marshalAssetSyncSnapshot(ctx, snapshot)
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.VirtualPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	return resp, nil
-}
-
-// getBlockTimestamp returns the timestamp of the block at the given height.
-func (r *rpcServer) getBlockTimestamp(ctx context.Context,
-	height uint32) int64 {
+	passivePkts, err := unmarshalPassivePkts(req.PassiveAssetPsbts)
+	if err != nil {
+		return nil, err
+	}
 
-	// Shortcut any lookup in case we don't have a valid height in the first
-	// place.
-	if height == 0 {
-		return 0
+	inputCommitments, err := r.fetchInputCommitments(ctx, vPkt)
+	if err != nil {
+		return nil, err
 	}
 
-	cacheTS, err := r.blockTimestampCache.Get(height)
-	if err == nil {
-		return int64(cacheTS)
+	feeRate := chainfee.SatPerKVByte(req.SatPerVbyte * 1000).FeePerKWeight()
+	if req.SatPerVbyte == 0 {
+		feeRate, err = r.cfg.ChainBridge.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
 	}
 
-	hash, err := r.cfg.Lnd.ChainKit.GetBlockHash(ctx, int64(height))
+	anchorTx, err := r.cfg.AssetWallet.FundAnchorTransaction(
+		ctx, &tapfreighter.AnchorVTxnsParams{
+			FeeRate: feeRate,
+			VPkts:   []*tappsbt.VPacket{vPkt},
+			InputCommitments: []tappsbt.InputCommitments{
+				inputCommitments,
+			},
+			PassiveAssetsVPkts: passivePkts,
+			CoinSelectStrategy: strategy,
+		},
+	)
 	if err != nil {
-		return 0
+		return nil, fmt.Errorf("error funding anchor transaction: %w",
+			err)
 	}
 
-	block, err := r.cfg.Lnd.ChainKit.GetBlock(ctx, hash)
+	anchorPkt := anchorTx.FundedPsbt.Pkt
+	chainFees, err := tapgarden.GetTxFee(anchorPkt)
 	if err != nil {
-		return 0
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
 	}
 
-	ts := uint32(block.Header.Timestamp.Unix())
-	_, _ = r.blockTimestampCache.Put(height, cacheableTimestamp(ts))
+	var b bytes.Buffer
+	if err := anchorPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing anchor psbt: %w", err)
+	}
 
-	return int64(ts)
+	return &wrpc.FundAnchorPsbtResponse{
+		AnchorPsbt:   b.Bytes(),
+		ChainFeesSat: uint64(chainFees),
+	}, nil
 }
 
-// QueryEvents returns the number of sync and proof events for a given time
-// period, grouped by day.
-func (r *rpcServer) QueryEvents(ctx context.Context,
-	req *unirpc.QueryEventsRequest) (*unirpc.QueryEventsResponse, error) {
-
-	// If no start or end time is specified, default to the last 30 days.
-	var (
-		startTime = time.Now().AddDate(0, 0, -30)
-		endTime   = time.Now()
-	)
-	if req.StartTimestamp > 0 {
-		startTime = time.Unix(req.StartTimestamp, 0)
-	}
-	if req.EndTimestamp > 0 {
-		endTime = time.Unix(req.EndTimestamp, 0)
-	}
+// PublishAnchorPsbt verifies that the externally signed anchor transaction
+// commits to the virtual transactions, then broadcasts it, logs the transfer
+// and delivers the proofs.
+func (r *rpcServer) PublishAnchorPsbt(ctx context.Context,
+	req *wrpc.PublishAnchorPsbtRequest) (*taprpc.SendAssetResponse, error) {
 
-	if endTime.Before(startTime) {
-		return nil, fmt.Errorf("end time cannot be before start time")
+	if err := validateLabel(req.Label); err != nil {
+		return nil, err
 	}
 
-	stats, err := r.cfg.UniverseStats.QueryAssetStatsPerDay(
-		ctx, universe.GroupedStatsQuery{
-			StartTime: startTime,
-			EndTime:   endTime,
-		},
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.VirtualPsbt), false,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error querying stats: %w", err)
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	rpcStats := &unirpc.QueryEventsResponse{
-		Events: make([]*unirpc.GroupedUniverseEvents, len(stats)),
-	}
-	for day, s := range stats {
-		rpcStats.Events[day] = &unirpc.GroupedUniverseEvents{
-			Date:           s.Date,
-			SyncEvents:     s.NumTotalSyncs,
-			NewProofEvents: s.NumTotalProofs,
-		}
+	passivePkts, err := unmarshalPassivePkts(req.PassiveAssetPsbts)
+	if err != nil {
+		return nil, err
 	}
 
-	return rpcStats, nil
-}
-
-// RemoveUTXOLease removes the lease/lock/reservation of the given managed
-// UTXO.
-func (r *rpcServer) RemoveUTXOLease(ctx context.Context,
-	req *wrpc.RemoveUTXOLeaseRequest) (*wrpc.RemoveUTXOLeaseResponse,
-	error) {
+	signedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedAnchorPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding signed anchor psbt: %w",
+			err)
+	}
 
-	if req.Outpoint == nil {
-		return nil, fmt.Errorf("outpoint must be specified")
+	inputCommitments, err := r.fetchInputCommitments(ctx, vPkt)
+	if err != nil {
+		return nil, err
 	}
 
-	hash, err := chainhash.NewHash(req.Outpoint.Txid)
+	anchorTx, err := r.cfg.AssetWallet.FinalizeAnchorTransaction(
+		&tapfreighter.AnchorVTxnsParams{
+			VPkts: []*tappsbt.VPacket{vPkt},
+			InputCommitments: []tappsbt.InputCommitments{
+				inputCommitments,
+			},
+			PassiveAssetsVPkts: passivePkts,
+		}, signedPkt,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing txid: %w", err)
+		return nil, fmt.Errorf("error finalizing anchor transaction: "+
+			"%w", err)
 	}
 
-	outPoint := wire.OutPoint{
-		Hash:  *hash,
-		Index: req.Outpoint.OutputIndex,
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreAnchoredParcel(
+			vPkt, passivePkts, inputCommitments, anchorTx, nil,
+			req.Label,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
 	}
 
-	err = r.cfg.CoinSelect.ReleaseCoins(ctx, outPoint)
+	parcel, err := r.marshalOutboundParcel(ctx, resp)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
 	}
 
-	return &wrpc.RemoveUTXOLeaseResponse{}, nil
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
 }
 
 // MarshalAssetFedSyncCfg returns an RPC ready asset specific federation sync
@@ -0,0 +1,357 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockImportKeyStore is an address book store that records the keys inserted
+// by an asset import.
+type mockImportKeyStore struct {
+	address.Storage
+
+	mu           sync.Mutex
+	internalKeys []keychain.KeyDescriptor
+	scriptKeys   []asset.ScriptKey
+}
+
+func (m *mockImportKeyStore) InsertInternalKey(_ context.Context,
+	keyDesc keychain.KeyDescriptor) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.internalKeys = append(m.internalKeys, keyDesc)
+
+	return nil
+}
+
+func (m *mockImportKeyStore) InsertScriptKey(_ context.Context,
+	scriptKey asset.ScriptKey) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.scriptKeys = append(m.scriptKeys, scriptKey)
+
+	return nil
+}
+
+// mockImportKeyRing is a key ring that only controls the given keys.
+type mockImportKeyRing struct {
+	address.KeyRing
+
+	localKeys []*btcec.PublicKey
+}
+
+func (m *mockImportKeyRing) IsLocalKey(_ context.Context,
+	keyDesc keychain.KeyDescriptor) bool {
+
+	for _, key := range m.localKeys {
+		if key.IsEqual(keyDesc.PubKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mockImportArchive is a proof archive that holds the imported proofs by the
+// anchor outpoint of their last state.
+type mockImportArchive struct {
+	proof.Archiver
+
+	mu     sync.Mutex
+	proofs map[wire.OutPoint]proof.Blob
+}
+
+func (m *mockImportArchive) FetchProof(_ context.Context,
+	id proof.Locator) (proof.Blob, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	blob, ok := m.proofs[*id.OutPoint]
+	if !ok {
+		return nil, proof.ErrProofNotFound
+	}
+
+	return blob, nil
+}
+
+func (m *mockImportArchive) ImportProofs(_ context.Context,
+	_ proof.HeaderVerifier, _ proof.GroupVerifier, _ bool,
+	proofs ...*proof.AnnotatedProof) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, p := range proofs {
+		var proofFile proof.File
+		err := proofFile.Decode(bytes.NewReader(p.Blob))
+		if err != nil {
+			return err
+		}
+		lastProof, err := proofFile.LastProof()
+		if err != nil {
+			return err
+		}
+
+		m.proofs[lastProof.OutPoint()] = p.Blob
+	}
+
+	return nil
+}
+
+// mockSpendChainBridge is a chain bridge that reports the spend of the given
+// outpoints.
+type mockSpendChainBridge struct {
+	tapgarden.ChainBridge
+
+	spent map[wire.OutPoint]chainhash.Hash
+}
+
+func (m *mockSpendChainBridge) RegisterSpendNtfn(_ context.Context,
+	outpoint *wire.OutPoint, _ []byte,
+	_ uint32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	if spenderHash, ok := m.spent[*outpoint]; ok {
+		spendChan <- &chainntnfs.SpendDetail{
+			SpentOutPoint: outpoint,
+			SpenderTxHash: &spenderHash,
+		}
+	}
+
+	return spendChan, make(chan error), nil
+}
+
+// TestImportAsset tests that an asset is only imported if the given keys are
+// the ones of the proof and can be used for signing, and if its anchor output
+// is unspent and wasn't imported before.
+func TestImportAsset(t *testing.T) {
+	t.Parallel()
+
+	scriptKeyDesc := keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  1,
+		},
+	}
+	internalKeyDesc := keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  2,
+		},
+	}
+
+	lastProof := &proof.Proof{
+		BlockHeader: wire.BlockHeader{
+			Timestamp: time.Unix(1_700_000_000, 0),
+		},
+		BlockHeight: 100,
+		AnchorTx: wire.MsgTx{
+			Version: 2,
+			TxIn: []*wire.TxIn{{
+				Witness: [][]byte{[]byte("foo")},
+			}},
+			TxOut: []*wire.TxOut{{
+				Value:    1000,
+				PkScript: test.RandBytes(34),
+			}},
+		},
+		Asset: *asset.RandAsset(t, asset.Normal),
+		InclusionProof: proof.TaprootProof{
+			InternalKey: internalKeyDesc.PubKey,
+		},
+	}
+	lastProof.Asset.ScriptKey = asset.NewScriptKeyBip86(scriptKeyDesc)
+	anchorPoint := lastProof.OutPoint()
+
+	proofFile, err := proof.NewFile(proof.V0, *lastProof)
+	require.NoError(t, err)
+
+	var proofBuf bytes.Buffer
+	require.NoError(t, proofFile.Encode(&proofBuf))
+
+	assetScriptKey := lastProof.Asset.ScriptKey.PubKey
+	rpcScriptKey := &taprpc.ScriptKey{
+		PubKey:  schnorr.SerializePubKey(assetScriptKey),
+		KeyDesc: marshalKeyDescriptor(scriptKeyDesc),
+	}
+
+	otherKeyDesc := keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+	}
+	otherScriptKey := asset.NewScriptKeyBip86(otherKeyDesc)
+
+	allLocal := []*btcec.PublicKey{
+		scriptKeyDesc.PubKey, internalKeyDesc.PubKey,
+	}
+
+	testCases := []struct {
+		name           string
+		scriptKey      *taprpc.ScriptKey
+		internalKey    keychain.KeyDescriptor
+		externalSigner bool
+		localKeys      []*btcec.PublicKey
+		spent          bool
+		errStr         string
+	}{{
+		name:        "local keys",
+		scriptKey:   rpcScriptKey,
+		internalKey: internalKeyDesc,
+		localKeys:   allLocal,
+	}, {
+		name:           "external signer",
+		scriptKey:      rpcScriptKey,
+		internalKey:    internalKeyDesc,
+		externalSigner: true,
+	}, {
+		name: "script key mismatch",
+		scriptKey: &taprpc.ScriptKey{
+			PubKey: schnorr.SerializePubKey(
+				otherScriptKey.PubKey,
+			),
+			KeyDesc: marshalKeyDescriptor(otherKeyDesc),
+		},
+		internalKey: internalKeyDesc,
+		localKeys:   allLocal,
+		errStr:      "script key doesn't match asset script key",
+	}, {
+		name:        "internal key mismatch",
+		scriptKey:   rpcScriptKey,
+		internalKey: otherKeyDesc,
+		localKeys:   allLocal,
+		errStr:      "anchor internal key doesn't match",
+	}, {
+		name:        "non-local script key",
+		scriptKey:   rpcScriptKey,
+		internalKey: internalKeyDesc,
+		localKeys:   []*btcec.PublicKey{internalKeyDesc.PubKey},
+		errStr:      "script key is not a local key",
+	}, {
+		name:        "non-local internal key",
+		scriptKey:   rpcScriptKey,
+		internalKey: internalKeyDesc,
+		localKeys:   []*btcec.PublicKey{scriptKeyDesc.PubKey},
+		errStr:      "anchor internal key is not a local key",
+	}, {
+		name:        "anchor already spent",
+		scriptKey:   rpcScriptKey,
+		internalKey: internalKeyDesc,
+		localKeys:   allLocal,
+		spent:       true,
+		errStr:      "already spent",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keyStore := &mockImportKeyStore{}
+			archive := &mockImportArchive{
+				proofs: make(map[wire.OutPoint]proof.Blob),
+			}
+			chainBridge := &mockSpendChainBridge{
+				spent: make(map[wire.OutPoint]chainhash.Hash),
+			}
+			if tc.spent {
+				chainBridge.spent[anchorPoint] = test.RandHash()
+			}
+
+			r := &rpcServer{
+				cfg: &Config{
+					AddrBook: address.NewBook(
+						address.BookConfig{
+							Store: keyStore,
+							KeyRing: &mockImportKeyRing{
+								localKeys: tc.localKeys,
+							},
+						},
+					),
+					ChainBridge:    chainBridge,
+					ProofArchive:   archive,
+					DatabaseConfig: &DatabaseConfig{},
+				},
+			}
+
+			ctx := context.Background()
+			req := &wrpc.ImportAssetRequest{
+				ProofFile: proofBuf.Bytes(),
+				ScriptKey: tc.scriptKey,
+				AnchorInternalKey: marshalKeyDescriptor(
+					tc.internalKey,
+				),
+				ExternalSigner: tc.externalSigner,
+			}
+			resp, err := r.ImportAsset(ctx, req)
+			if tc.errStr != "" {
+				require.ErrorContains(t, err, tc.errStr)
+
+				// Nothing must be stored for a rejected
+				// import.
+				require.Empty(t, keyStore.internalKeys)
+				require.Empty(t, keyStore.scriptKeys)
+				require.Empty(t, archive.proofs)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assetID := lastProof.Asset.ID()
+			require.Equal(t, assetID[:], resp.AssetId)
+			require.Equal(
+				t, anchorPoint.Hash[:], resp.AnchorOutpoint.Txid,
+			)
+			require.Equal(
+				t, assetScriptKey.SerializeCompressed(),
+				resp.ScriptKey,
+			)
+
+			// Assets of an external signer must be stored as watch
+			// only, so coin selection doesn't pick them.
+			require.Len(t, keyStore.internalKeys, 1)
+			require.Len(t, keyStore.scriptKeys, 1)
+			scriptKey := keyStore.scriptKeys[0]
+			require.Equal(t, assetScriptKey, scriptKey.PubKey)
+			require.Equal(t, tc.externalSigner, scriptKey.WatchOnly)
+			require.Contains(t, archive.proofs, anchorPoint)
+
+			// The asset is now in our custody, so it can't be
+			// imported again.
+			_, err = r.ImportAsset(ctx, req)
+			require.ErrorContains(t, err, "already imported")
+			require.Len(t, keyStore.scriptKeys, 1)
+		})
+	}
+}
+
+func init() {
+	// The mock chain bridge reports spends right away, so we don't need
+	// to wait long for an anchor output to be considered unspent.
+	anchorSpendCheckTimeout = 50 * time.Millisecond
+}
@@ -0,0 +1,57 @@
+#!/bin/bash
+
+# Runs the integration tests with the given command and retries failed test
+# cases that are marked as flaky. Go stops at the first failed test case, so a
+# retry resumes the run from the failed test case. The result of every attempt
+# is appended to the results file.
+#
+# Usage: itest_flake_retry.sh <max_retries> <results_file> <test_command...>
+
+MAX_RETRIES=$1
+RESULTS_FILE=$2
+shift 2
+
+touch "$RESULTS_FILE"
+
+resume_from=""
+attempt=1
+while true; do
+  # Only the results of the current run are of interest, earlier runs stay
+  # in the file for the flake statistics.
+  num_lines=$(wc -l < "$RESULTS_FILE")
+
+  args=("$@" -resultsfile="$RESULTS_FILE" -attempt="$attempt")
+  if [[ -n "$resume_from" ]]; then
+    args+=(-resumefrom="$resume_from")
+  fi
+
+  if "${args[@]}"; then
+    exit 0
+  fi
+
+  failed=$(tail -n +$((num_lines + 1)) "$RESULTS_FILE" | \
+    awk -F'\t' '$2 == "failed"' | tail -n 1)
+  if [[ -z "$failed" ]]; then
+    echo "Test run failed outside of a test case, not retrying"
+    exit 1
+  fi
+
+  IFS=$'\t' read -r name _ flaky _ _ <<< "$failed"
+  if [[ "$flaky" != "true" ]]; then
+    echo "Test case '$name' failed and is not marked as flaky"
+    exit 1
+  fi
+
+  # The attempt counter is reset whenever a different test case fails.
+  if [[ "$name" != "$resume_from" ]]; then
+    attempt=1
+  fi
+  if [[ $attempt -gt $MAX_RETRIES ]]; then
+    echo "Flaky test case '$name' failed $attempt times, giving up"
+    exit 1
+  fi
+
+  attempt=$((attempt + 1))
+  resume_from="$name"
+  echo "Flaky test case '$name' failed, retrying (attempt $attempt)"
+done
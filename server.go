@@ -151,6 +151,14 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start chain porter: %v", err)
 	}
 
+	if err := s.cfg.SendQueue.Start(); err != nil {
+		return fmt.Errorf("unable to start send queue: %v", err)
+	}
+
+	if err := s.cfg.Consolidator.Start(); err != nil {
+		return fmt.Errorf("unable to start consolidator: %v", err)
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return fmt.Errorf("unable to start universe "+
 			"federation: %v", err)
@@ -238,6 +246,7 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 	macaroonWhitelist := perms.MacaroonWhitelist(
 		s.cfg.RPCConfig.AllowPublicUniProofCourier,
 		s.cfg.RPCConfig.AllowPublicStats,
+		s.cfg.RPCConfig.AllowPublicMetaBlobs,
 	)
 
 	// Create a new RPC interceptor that we'll add to the GRPC server. This
@@ -314,6 +323,7 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		// Set the gRPC server instance in the Prometheus exporter
 		// configuration.
 		s.cfg.Prometheus.RPCServer = grpcServer
+		s.cfg.Prometheus.ChainPorter = s.cfg.ChainPorter
 
 		promExporter, err := monitoring.NewPrometheusExporter(
 			&s.cfg.Prometheus,
@@ -562,6 +572,14 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.Consolidator.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.SendQueue.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.ChainPorter.Stop(); err != nil {
 		return err
 	}
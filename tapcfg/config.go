@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -20,9 +21,12 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -82,6 +86,18 @@ const (
 	// DatabaseBackendPostgres is the name of the Postgres database backend.
 	DatabaseBackendPostgres = "postgres"
 
+	// BroadcastBackendLnd is the name of the broadcast backend that
+	// publishes transactions through the wallet of the connected lnd node.
+	BroadcastBackendLnd = "lnd"
+
+	// BroadcastBackendBitcoind is the name of the broadcast backend that
+	// publishes transactions through the RPC interface of a bitcoind node.
+	BroadcastBackendBitcoind = "bitcoind"
+
+	// BroadcastBackendHTTP is the name of the broadcast backend that
+	// publishes transactions by posting them to an HTTP endpoint.
+	BroadcastBackendHTTP = "http"
+
 	// defaultProofTransferBackoffResetWait is the default amount of time
 	// we'll wait before resetting the backoff of a proof transfer.
 	defaultProofTransferBackoffResetWait = 10 * time.Minute
@@ -113,6 +129,10 @@ const (
 	// defaultReOrgSafeDepth is the default number of confirmations we'll
 	// wait for before considering a transaction safely buried in the chain.
 	defaultReOrgSafeDepth = 6
+
+	// defaultCoinSelectStrategy is the default strategy used to select the
+	// inputs of asset transfers.
+	defaultCoinSelectStrategy = "largest-first"
 )
 
 var (
@@ -215,6 +235,7 @@ type RpcConfig struct {
 
 	AllowPublicUniProofCourier bool `long:"allow-public-uni-proof-courier" description:"Disable macaroon authentication for universe proof courier RPC endpoints."`
 	AllowPublicStats           bool `long:"allow-public-stats" description:"Disable macaroon authentication for stats RPC endpoints."`
+	AllowPublicMetaBlobs       bool `long:"allow-public-meta-blobs" description:"Disable macaroon authentication for the RPC endpoint that serves meta data blobs committed to by reference."`
 
 	RestCORS []string `long:"restcors" description:"Add an ip:port/hostname to allow cross origin access from. To allow all origins, set as \"*\"."`
 
@@ -251,6 +272,50 @@ type UniverseConfig struct {
 	FederationServers []string `long:"federationserver" description:"The host:port of a Universe server peer with. These servers will be added as the default set of federation servers. Can be specified multiple times."`
 
 	PublicAccess bool `long:"public-access" description:"If true, and the Universe server is on a public interface, valid proof from remote parties will be accepted, and proofs will be queryable by remote parties. This applies to federation syncing as well as RPC insert and query."`
+
+	PublicationTargets []string `long:"publicationtarget" description:"The host:port of a Universe server that all new issuance proofs are published to, in addition to the federation servers. Failed publications are retried with an exponential backoff per server. Can be specified multiple times."`
+}
+
+// BroadcastConfig is the config that governs how tapd publishes the
+// transactions it creates.
+type BroadcastConfig struct {
+	Backend string `long:"backend" description:"The backend used to publish transactions." choice:"lnd" choice:"bitcoind" choice:"http"`
+
+	BitcoindHost string `long:"bitcoindhost" description:"The host:port of the bitcoind RPC interface to publish transactions through, if the bitcoind backend is used."`
+	BitcoindUser string `long:"bitcoinduser" description:"The username for the bitcoind RPC interface."`
+	BitcoindPass string `long:"bitcoindpass" description:"The password for the bitcoind RPC interface."`
+
+	HTTPURL string `long:"httpurl" description:"The http or https URL that transactions are posted to, hex encoded, if the http backend is used. For example the transaction endpoint of an Esplora instance."`
+}
+
+// Validate makes sure the broadcast config is sane.
+func (b *BroadcastConfig) Validate() error {
+	switch b.Backend {
+	case BroadcastBackendLnd:
+		return nil
+
+	case BroadcastBackendBitcoind:
+		if b.BitcoindHost == "" {
+			return fmt.Errorf("bitcoindhost must be set for the " +
+				"bitcoind backend")
+		}
+
+		return nil
+
+	case BroadcastBackendHTTP:
+		httpURL, err := url.Parse(b.HTTPURL)
+		isHTTP := httpURL != nil &&
+			(httpURL.Scheme == "http" || httpURL.Scheme == "https")
+		if err != nil || httpURL.Host == "" || !isHTTP {
+			return fmt.Errorf("httpurl must be an absolute http " +
+				"or https URL for the http backend")
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown broadcast backend %q", b.Backend)
+	}
 }
 
 // Config is the main config for the tapd cli command.
@@ -272,11 +337,36 @@ type Config struct {
 
 	BatchMintingInterval time.Duration `long:"batch-minting-interval" description:"A duration (1m, 2h, etc) that governs how frequently pending assets are gather into a batch to be minted."`
 
+	BatchSchedule *tapgarden.BatchSchedule `group:"batchschedule" namespace:"batchschedule"`
+
 	ReOrgSafeDepth int32 `long:"reorgsafedepth" description:"The number of confirmations we'll wait for before considering a transaction safely buried in the chain."`
 
+	MetaBlobBaseURL string `long:"metablobbaseurl" description:"The base http or https URL under which the meta data blobs of assets minted by reference are published. The hex encoded hash of a blob is appended to form the URL that is committed to in its reference."`
+
+	Consolidation *tapfreighter.ConsolidationPolicy `group:"consolidation" namespace:"consolidation"`
+
+	ZeroConf *address.ZeroConfPolicy `group:"zeroconf" namespace:"zeroconf"`
+
+	Change *tapfreighter.ChangePolicy `group:"change" namespace:"change"`
+
+	SendBatch *tapfreighter.SendBatchPolicy `group:"sendbatch" namespace:"sendbatch"`
+
+	Split *tapfreighter.SplitPolicy `group:"split" namespace:"split"`
+
+	AddrReuse *tapfreighter.AddrReusePolicy `group:"addrreuse" namespace:"addrreuse"`
+
+	AnchorDust *tapfreighter.AnchorDustPolicy `group:"anchordust" namespace:"anchordust"`
+
+	SigningService *tapfreighter.SigningServiceCfg `group:"signingservice" namespace:"signingservice"`
+
+	CoinSelectStrategy string `long:"coinselectstrategy" description:"The strategy used to select both the asset and the BTC level inputs of asset transfers that don't specify one." choice:"largest-first" choice:"smallest-first" choice:"fewest-inputs" choice:"no-merge"`
+
 	// The following options are used to configure the proof courier.
-	DefaultProofCourierAddr string                    `long:"proofcourieraddr" description:"Default proof courier service address."`
-	HashMailCourier         *proof.HashMailCourierCfg `group:"proofcourier" namespace:"hashmailcourier"`
+	DefaultProofCourierAddr   string                    `long:"proofcourieraddr" description:"Default proof courier service address."`
+	FallbackProofCourierAddrs []string                  `long:"fallbackproofcourieraddr" description:"The address of a proof courier service a proof is delivered through if delivery through the courier of the receiver's address fails. Fallbacks are tried in the order they are given. Can be specified multiple times."`
+	HashMailCourier           *proof.HashMailCourierCfg `group:"proofcourier" namespace:"hashmailcourier"`
+
+	ProofRetrieval *tapgarden.ProofRetrievalCfg `group:"proofretrieval" namespace:"proofretrieval"`
 
 	ChainConf *ChainConfig
 	RpcConf   *RpcConfig
@@ -289,6 +379,8 @@ type Config struct {
 
 	Universe *UniverseConfig `group:"universe" namespace:"universe"`
 
+	Broadcast *BroadcastConfig `group:"broadcast" namespace:"broadcast"`
+
 	Prometheus monitoring.PrometheusConfig `group:"prometheus" namespace:"prometheus"`
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
@@ -348,7 +440,17 @@ func DefaultConfig() Config {
 		LogWriter:               build.NewRotatingLogWriter(),
 		Prometheus:              monitoring.DefaultPrometheusConfig(),
 		BatchMintingInterval:    defaultBatchMintingInterval,
+		BatchSchedule:           &tapgarden.BatchSchedule{},
 		ReOrgSafeDepth:          defaultReOrgSafeDepth,
+		CoinSelectStrategy:      defaultCoinSelectStrategy,
+		Consolidation:           &tapfreighter.ConsolidationPolicy{},
+		ZeroConf:                &address.ZeroConfPolicy{},
+		Change:                  &tapfreighter.ChangePolicy{},
+		SendBatch:               &tapfreighter.SendBatchPolicy{},
+		Split:                   &tapfreighter.SplitPolicy{},
+		AddrReuse:               &tapfreighter.AddrReusePolicy{},
+		AnchorDust:              &tapfreighter.AnchorDustPolicy{},
+		SigningService:          &tapfreighter.SigningServiceCfg{},
 		DefaultProofCourierAddr: defaultProofCourierAddr,
 		HashMailCourier: &proof.HashMailCourierCfg{
 			ReceiverAckTimeout: defaultProofTransferReceiverAckTimeout,
@@ -359,9 +461,13 @@ func DefaultConfig() Config {
 				MaxBackoff:       defaultProofTransferMaxBackoff,
 			},
 		},
+		ProofRetrieval: tapgarden.DefaultProofRetrievalCfg(),
 		Universe: &UniverseConfig{
 			SyncInterval: defaultUniverseSyncInterval,
 		},
+		Broadcast: &BroadcastConfig{
+			Backend: BroadcastBackendLnd,
+		},
 	}
 }
 
@@ -612,6 +718,74 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 		}
 	}
 
+	// Make sure the schedule for automatic batch finalization is sane.
+	if err := cfg.BatchSchedule.Validate(); err != nil {
+		return nil, mkErr("invalid batch schedule: %v", err)
+	}
+
+	// Make sure the policy for automatic UTXO consolidation is sane.
+	if err := cfg.Consolidation.Validate(); err != nil {
+		return nil, mkErr("invalid consolidation policy: %v", err)
+	}
+
+	// Make sure the policy for accepting inbound transfers at zero
+	// confirmations is sane.
+	if err := cfg.ZeroConf.Validate(); err != nil {
+		return nil, mkErr("invalid zero-conf policy: %v", err)
+	}
+
+	// Make sure the policy for handling the change of transfers is sane.
+	if err := cfg.Change.Validate(); err != nil {
+		return nil, mkErr("invalid change policy: %v", err)
+	}
+
+	// Make sure the policy for batching sends is sane.
+	if err := cfg.SendBatch.Validate(); err != nil {
+		return nil, mkErr("invalid send batch policy: %v", err)
+	}
+
+	// Make sure the policy for splitting assets is sane.
+	if err := cfg.Split.Validate(); err != nil {
+		return nil, mkErr("invalid split policy: %v", err)
+	}
+
+	// Make sure the policy for sending to used addresses is sane.
+	if err := cfg.AddrReuse.Validate(); err != nil {
+		return nil, mkErr("invalid address reuse policy: %v", err)
+	}
+
+	// Make sure the policy for uneconomical anchor outputs is sane.
+	if err := cfg.AnchorDust.Validate(); err != nil {
+		return nil, mkErr("invalid anchor dust policy: %v", err)
+	}
+
+	// Make sure the signing service, if any, can be reached.
+	if err := cfg.SigningService.Validate(); err != nil {
+		return nil, mkErr("invalid signing service config: %v", err)
+	}
+
+	// Make sure the retries of inbound proof retrievals are sane.
+	if err := cfg.ProofRetrieval.Validate(); err != nil {
+		return nil, mkErr("invalid proof retrieval config: %v", err)
+	}
+
+	// Make sure we know how to publish the transactions we create.
+	if err := cfg.Broadcast.Validate(); err != nil {
+		return nil, mkErr("invalid broadcast config: %v", err)
+	}
+
+	// The meta blob base URL is used as the prefix of the URLs committed
+	// to in meta blob references, so it must be a valid URL itself.
+	if cfg.MetaBlobBaseURL != "" {
+		baseURL, err := url.Parse(cfg.MetaBlobBaseURL)
+		isHTTP := baseURL != nil &&
+			(baseURL.Scheme == "http" || baseURL.Scheme == "https")
+		if err != nil || baseURL.Host == "" || !isHTTP {
+			return nil, mkErr("metablobbaseurl must be an " +
+				"absolute http or https URL")
+		}
+	}
+
 	// We'll now construct the network directory which will be where we
 	// store all the data specific to this chain/network.
 	cfg.networkDir = filepath.Join(
@@ -0,0 +1,91 @@
+package tapcfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBroadcastConfigValidate tests that only complete broadcast configs of
+// known backends are accepted.
+func TestBroadcastConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		cfg    BroadcastConfig
+		errStr string
+	}{{
+		name: "lnd",
+		cfg: BroadcastConfig{
+			Backend: BroadcastBackendLnd,
+		},
+	}, {
+		name: "bitcoind",
+		cfg: BroadcastConfig{
+			Backend:      BroadcastBackendBitcoind,
+			BitcoindHost: "localhost:8332",
+		},
+	}, {
+		name: "bitcoind without host",
+		cfg: BroadcastConfig{
+			Backend: BroadcastBackendBitcoind,
+		},
+		errStr: "bitcoindhost must be set",
+	}, {
+		name: "http",
+		cfg: BroadcastConfig{
+			Backend: BroadcastBackendHTTP,
+			HTTPURL: "https://mempool.space/api/tx",
+		},
+	}, {
+		name: "http without url",
+		cfg: BroadcastConfig{
+			Backend: BroadcastBackendHTTP,
+		},
+		errStr: "httpurl must be an absolute http",
+	}, {
+		name: "http relative url",
+		cfg: BroadcastConfig{
+			Backend: BroadcastBackendHTTP,
+			HTTPURL: "/api/tx",
+		},
+		errStr: "httpurl must be an absolute http",
+	}, {
+		name: "http invalid scheme",
+		cfg: BroadcastConfig{
+			Backend: BroadcastBackendHTTP,
+			HTTPURL: "ftp://example.com/tx",
+		},
+		errStr: "httpurl must be an absolute http",
+	}, {
+		name: "http invalid url",
+		cfg: BroadcastConfig{
+			Backend: BroadcastBackendHTTP,
+			HTTPURL: "http://[::1",
+		},
+		errStr: "httpurl must be an absolute http",
+	}, {
+		name: "unknown backend",
+		cfg: BroadcastConfig{
+			Backend: "electrum",
+		},
+		errStr: "unknown broadcast backend",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.cfg.Validate()
+			if tc.errStr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.errStr)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"encoding/binary"
 	"fmt"
+	"net/url"
 
 	"github.com/btcsuite/btclog"
 	"github.com/lightninglabs/lndclient"
@@ -33,6 +34,29 @@ type databaseBackend interface {
 	WithTx(tx *sql.Tx) *sqlc.Queries
 }
 
+// newBroadcaster creates the broadcaster that publishes all transactions tapd
+// creates, as configured.
+func newBroadcaster(cfg *BroadcastConfig,
+	lndServices *lndclient.LndServices) (tap.Broadcaster, error) {
+
+	switch cfg.Backend {
+	case BroadcastBackendLnd:
+		return tap.NewLndBroadcaster(lndServices), nil
+
+	case BroadcastBackendBitcoind:
+		return tap.NewBitcoindBroadcaster(
+			cfg.BitcoindHost, cfg.BitcoindUser, cfg.BitcoindPass,
+		)
+
+	case BroadcastBackendHTTP:
+		return tap.NewHTTPBroadcaster(cfg.HTTPURL), nil
+
+	default:
+		return nil, fmt.Errorf("unknown broadcast backend: %s",
+			cfg.Backend)
+	}
+}
+
 // genServerConfig generates a server config from the given tapd config.
 //
 // NOTE: The RPCConfig and SignalInterceptor fields must be set by the caller
@@ -96,7 +120,11 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	keyRing := tap.NewLndRpcKeyRing(lndServices)
 	walletAnchor := tap.NewLndRpcWalletAnchor(lndServices)
-	chainBridge := tap.NewLndRpcChainBridge(lndServices)
+	broadcaster, err := newBroadcaster(cfg.Broadcast, lndServices)
+	if err != nil {
+		return nil, err
+	}
+	chainBridge := tap.NewLndRpcChainBridge(lndServices, broadcaster)
 
 	addrBook := address.NewBook(address.BookConfig{
 		Store:        tapdbAddrBook,
@@ -227,6 +255,18 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		}
 	}
 
+	fallbackCourierAddrs := make(
+		[]url.URL, len(cfg.FallbackProofCourierAddrs),
+	)
+	for idx, addr := range cfg.FallbackProofCourierAddrs {
+		courierAddr, err := proof.ParseCourierAddrString(addr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse fallback "+
+				"proof courier address %q: %v", addr, err)
+		}
+		fallbackCourierAddrs[idx] = *courierAddr.Url()
+	}
+
 	// TODO(ffranr): This logic is leftover for integration tests which
 	//  do not yet enable a proof courier. Remove once all integration tests
 	//  support a proof courier.
@@ -283,6 +323,16 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	}
 
 	runtimeID := int64(binary.BigEndian.Uint64(runtimeIDBytes[:]))
+	publicationTargets := fn.Map(
+		cfg.Universe.PublicationTargets, universe.NewServerAddrFromStr,
+	)
+	proofPublisher := universe.NewProofPublisher(universe.PublisherConfig{
+		Targets:            publicationTargets,
+		NewRemoteRegistrar: tap.NewRpcUniverseRegistrar,
+		PublicationLog:     federationDB,
+		LocalDiffEngine:    baseUni,
+	})
+
 	universeFederation := universe.NewFederationEnvoy(
 		universe.FederationConfig{
 			FederationDB:            federationDB,
@@ -297,23 +347,83 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 					addr,
 				)
 			},
-			ErrChan: mainErrChan,
+			ErrChan:   mainErrChan,
+			Publisher: proofPublisher,
 		},
 	)
 
 	virtualTxSigner := tap.NewLndRpcVirtualTxSigner(lndServices)
+	externalGroupSigner := tapgarden.NewExternalGroupSigner()
+	externalGenesisFunder := tapgarden.NewExternalGenesisFunder()
 	coinSelect := tapfreighter.NewCoinSelect(assetStore)
+	coinSelectStrategy, err := tapfreighter.ParseCoinSelectStrategy(
+		cfg.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If a signing service is configured, all virtual packets the wallet
+	// would sign itself are handed to it instead.
+	var signingService tapfreighter.SigningService
+	if cfg.SigningService.URL != "" {
+		signingService = tapfreighter.NewHTTPSigningService(
+			cfg.SigningService,
+		)
+	}
+
 	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
-		CoinSelector: coinSelect,
-		AssetProofs:  proofArchive,
-		AddrBook:     tapdbAddrBook,
-		KeyRing:      keyRing,
-		Signer:       virtualTxSigner,
-		TxValidator:  &tap.ValidatorV0{},
-		Wallet:       walletAnchor,
-		ChainParams:  &tapChainParams,
+		CoinSelector:       coinSelect,
+		AssetProofs:        proofArchive,
+		AddrBook:           tapdbAddrBook,
+		KeyRing:            keyRing,
+		Signer:             virtualTxSigner,
+		TxValidator:        &tap.ValidatorV0{},
+		Wallet:             walletAnchor,
+		ChainParams:        &tapChainParams,
+		ChangePolicy:       *cfg.Change,
+		SplitPolicy:        *cfg.Split,
+		DustPolicy:         *cfg.AnchorDust,
+		CoinSelectStrategy: coinSelectStrategy,
+		SigningService:     signingService,
 	})
 
+	chainPorter := tapfreighter.NewChainPorter(
+		&tapfreighter.ChainPorterConfig{
+			Signer:      virtualTxSigner,
+			TxValidator: &tap.ValidatorV0{},
+			ExportLog:   assetStore,
+			ChainBridge: chainBridge,
+			GroupVerifier: tapgarden.GenGroupVerifier(
+				context.Background(), assetMintingStore,
+			),
+			Wallet:               walletAnchor,
+			KeyRing:              keyRing,
+			AssetWallet:          assetWallet,
+			AssetProofs:          proofFileStore,
+			ProofCourierCfg:      proofCourierCfg,
+			FallbackCourierAddrs: fallbackCourierAddrs,
+			ProofWatcher:         reOrgWatcher,
+			AddrUsage:            tapdbAddrBook,
+			AddrReusePolicy:      *cfg.AddrReuse,
+			ErrChan:              mainErrChan,
+		},
+	)
+
+	// If send batching is enabled, address sends submitted over RPC are
+	// collected by the send batcher before they reach the chain porter.
+	var porter tapfreighter.Porter = chainPorter
+	if cfg.SendBatch.IsActive() {
+		porter = tapfreighter.NewSendBatcher(
+			&tapfreighter.SendBatcherConfig{
+				ChainPorter: chainPorter,
+				Policy:      *cfg.SendBatch,
+			},
+		)
+	}
+
+	sendQueueInterval := tapfreighter.DefaultSendQueuePollInterval
+
 	return &tap.Config{
 		DebugLevel:   cfg.DebugLevel,
 		RuntimeID:    runtimeID,
@@ -329,14 +439,17 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				GenSigner:             virtualTxSigner,
 				GenTxBuilder:          &tapscript.GroupTxBuilder{},
 				TxValidator:           &tap.ValidatorV0{},
+				ExternalSigner:        externalGroupSigner,
+				ExternalFunder:        externalGenesisFunder,
 				ProofFiles:            proofFileStore,
 				Universe:              universeFederation,
 				ProofWatcher:          reOrgWatcher,
 				UniversePushBatchSize: defaultUniverseSyncBatchSize,
 			},
-			BatchTicker:  ticker.NewForce(cfg.BatchMintingInterval),
-			ProofUpdates: proofArchive,
-			ErrChan:      mainErrChan,
+			BatchTicker:   ticker.NewForce(cfg.BatchMintingInterval),
+			BatchSchedule: *cfg.BatchSchedule,
+			ProofUpdates:  proofArchive,
+			ErrChan:       mainErrChan,
 		}),
 		AssetCustodian: tapgarden.NewCustodian(
 			&tapgarden.CustodianConfig{
@@ -352,30 +465,36 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				ErrChan:         mainErrChan,
 				ProofCourierCfg: proofCourierCfg,
 				ProofWatcher:    reOrgWatcher,
+
+				ProofRetrievalCfg: cfg.ProofRetrieval,
 			},
 		),
 		ChainBridge:             chainBridge,
 		AddrBook:                addrBook,
 		DefaultProofCourierAddr: proofCourierAddr.Url(),
+		ZeroConfPolicy:          *cfg.ZeroConf,
 		ProofArchive:            proofArchive,
 		AssetWallet:             assetWallet,
 		CoinSelect:              coinSelect,
-		ChainPorter: tapfreighter.NewChainPorter(
-			&tapfreighter.ChainPorterConfig{
-				Signer:      virtualTxSigner,
-				TxValidator: &tap.ValidatorV0{},
-				ExportLog:   assetStore,
+		ChainPorter:             porter,
+		Consolidator: tapfreighter.NewConsolidator(
+			&tapfreighter.ConsolidatorConfig{
+				CoinLister:  assetStore,
+				AssetWallet: assetWallet,
+				ChainPorter: chainPorter,
 				ChainBridge: chainBridge,
-				GroupVerifier: tapgarden.GenGroupVerifier(
-					context.Background(), assetMintingStore,
-				),
-				Wallet:          walletAnchor,
-				KeyRing:         keyRing,
-				AssetWallet:     assetWallet,
-				AssetProofs:     proofFileStore,
-				ProofCourierCfg: proofCourierCfg,
-				ProofWatcher:    reOrgWatcher,
-				ErrChan:         mainErrChan,
+				Policy:      *cfg.Consolidation,
+			},
+		),
+		SendQueue: tapfreighter.NewSendQueue(
+			&tapfreighter.SendQueueConfig{
+				Store:       assetStore,
+				ChainPorter: chainPorter,
+				ChainBridge: chainBridge,
+				ChainParams: &tapChainParams,
+				Ticker:      ticker.NewForce(sendQueueInterval),
+				Clock:       defaultClock,
+				ErrChan:     mainErrChan,
 			},
 		),
 		BaseUniverse:         baseUni,
@@ -383,6 +502,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		UniverseFederation:   universeFederation,
 		UniverseStats:        universeStats,
 		UniversePublicAccess: cfg.Universe.PublicAccess,
+		MetaBlobBaseURL:      cfg.MetaBlobBaseURL,
 		LogWriter:            cfg.LogWriter,
 		DatabaseConfig: &tap.DatabaseConfig{
 			RootKeyStore: tapdb.NewRootKeyStore(rksDB),
@@ -448,6 +568,7 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 		MacaroonPath:               cfg.RpcConf.MacaroonPath,
 		AllowPublicUniProofCourier: cfg.RpcConf.AllowPublicUniProofCourier,
 		AllowPublicStats:           cfg.RpcConf.AllowPublicStats,
+		AllowPublicMetaBlobs:       cfg.RpcConf.AllowPublicMetaBlobs,
 		LetsEncryptDir:             cfg.RpcConf.LetsEncryptDir,
 		LetsEncryptListen:          cfg.RpcConf.LetsEncryptListen,
 		LetsEncryptEmail:           cfg.RpcConf.LetsEncryptEmail,
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -59,6 +60,10 @@ type (
 	// and its corresponding address.
 	AddrEventID = sqlc.QueryEventIDsRow
 
+	// AddrEventLabel is a type alias for setting the label of an address
+	// event.
+	AddrEventLabel = sqlc.SetAddrEventLabelParams
+
 	// Genesis is a type alias for fetching the genesis asset information.
 	Genesis = sqlc.FetchGenesisByIDRow
 
@@ -125,6 +130,16 @@ type AddrBook interface {
 	QueryEventIDs(ctx context.Context, query AddrEventQuery) ([]AddrEventID,
 		error)
 
+	// SetAddrEventLabel sets the label of the address event of the given
+	// on-chain output and returns the number of updated events.
+	SetAddrEventLabel(ctx context.Context, arg AddrEventLabel) (int64,
+		error)
+
+	// CountScriptKeyReceipts returns the number of transfer outputs and
+	// address events that sent assets to the given script key.
+	CountScriptKeyReceipts(ctx context.Context,
+		tweakedScriptKey []byte) (int32, error)
+
 	// FetchAssetProof fetches the asset proof for a given asset identified
 	// by its script key.
 	FetchAssetProof(ctx context.Context, scriptKey []byte) (AssetProofI,
@@ -236,6 +251,7 @@ func (t *TapAddressBook) InsertAddrs(ctx context.Context,
 				InternalKeyID:    rawScriptKeyID,
 				TweakedScriptKey: addr.ScriptKey.SerializeCompressed(),
 				Tweak:            addr.ScriptKeyTweak.Tweak,
+				WatchOnly:        addr.ScriptKeyTweak.WatchOnly,
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert script "+
@@ -282,6 +298,9 @@ func (t *TapAddressBook) InsertAddrs(ctx context.Context,
 				AssetType:        int16(assetGen.AssetType),
 				CreationTime:     addr.CreationTime.UTC(),
 				ProofCourierAddr: proofCourierAddrBytes,
+				FallbackProofCourierAddrs: encodeCourierAddrs(
+					addr.FallbackCourierAddrs,
+				),
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert addr: %w",
@@ -433,6 +452,13 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 					"courier address: %w", err)
 			}
 
+			fallbackCourierAddrs, err := parseCourierAddrs(
+				addr.FallbackProofCourierAddrs,
+			)
+			if err != nil {
+				return err
+			}
+
 			tapAddr, err := address.New(
 				address.Version(addr.Version), assetGenesis,
 				groupKey, groupWitness,
@@ -441,6 +467,9 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 				address.WithAssetVersion(
 					asset.Version(addr.AssetVersion),
 				),
+				address.WithFallbackCourierAddrs(
+					fallbackCourierAddrs,
+				),
 			)
 			if err != nil {
 				return fmt.Errorf("unable to make addr: %w", err)
@@ -449,8 +478,9 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 			addrs = append(addrs, address.AddrWithKeyInfo{
 				Tap: tapAddr,
 				ScriptKeyTweak: asset.TweakedScriptKey{
-					RawKey: rawScriptKeyDesc,
-					Tweak:  addr.ScriptKeyTweak,
+					RawKey:    rawScriptKeyDesc,
+					Tweak:     addr.ScriptKeyTweak,
+					WatchOnly: addr.ScriptKeyWatchOnly,
 				},
 				InternalKeyDesc:  internalKeyDesc,
 				TaprootOutputKey: *taprootOutputKey,
@@ -584,11 +614,19 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 			"address: %w", err)
 	}
 
+	fallbackCourierAddrs, err := parseCourierAddrs(
+		dbAddr.FallbackProofCourierAddrs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	tapAddr, err := address.New(
 		address.Version(dbAddr.Version), genesis, groupKey,
 		groupWitness, *scriptKey, *internalKey, uint64(dbAddr.Amount),
 		tapscriptSibling, params, *proofCourierAddr,
 		address.WithAssetVersion(asset.Version(dbAddr.AssetVersion)),
+		address.WithFallbackCourierAddrs(fallbackCourierAddrs),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make addr: %w", err)
@@ -597,8 +635,9 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 	return &address.AddrWithKeyInfo{
 		Tap: tapAddr,
 		ScriptKeyTweak: asset.TweakedScriptKey{
-			RawKey: scriptKeyDesc,
-			Tweak:  dbAddr.ScriptKeyTweak,
+			RawKey:    scriptKeyDesc,
+			Tweak:     dbAddr.ScriptKeyTweak,
+			WatchOnly: dbAddr.ScriptKeyWatchOnly,
 		},
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
@@ -665,6 +704,7 @@ func (t *TapAddressBook) InsertScriptKey(ctx context.Context,
 			InternalKeyID:    internalKeyID,
 			TweakedScriptKey: scriptKey.PubKey.SerializeCompressed(),
 			Tweak:            scriptKey.Tweak,
+			WatchOnly:        scriptKey.WatchOnly,
 		})
 		return err
 	})
@@ -797,6 +837,9 @@ func (t *TapAddressBook) QueryAddrEvents(
 	if params.StatusTo != nil {
 		sqlQuery.StatusTo = int16(*params.StatusTo)
 	}
+	if params.Label != nil {
+		sqlQuery.Label = sqlStr(*params.Label)
+	}
 
 	var (
 		readTxOpts = NewAssetStoreReadTx()
@@ -878,9 +921,59 @@ func fetchEvent(ctx context.Context, db AddrBook, eventID int64,
 		InternalKey:        internalKey,
 		ConfirmationHeight: uint32(dbEvent.ConfirmationHeight.Int32),
 		HasProof:           dbEvent.AssetProofID.Valid,
+		Label:              dbEvent.Label.String,
+		Reused:             dbEvent.NumPrevEvents > 0,
 	}, nil
 }
 
+// AddrReceived returns true if assets were already sent to the script key of
+// the given address, either by one of our transfers or by anyone to one of our
+// own addresses.
+func (t *TapAddressBook) AddrReceived(ctx context.Context,
+	addr *address.Tap) (bool, error) {
+
+	var numReceipts int32
+
+	readOpts := NewAddrBookReadTx()
+	err := t.db.ExecTx(ctx, &readOpts, func(db AddrBook) error {
+		var err error
+		numReceipts, err = db.CountScriptKeyReceipts(
+			ctx, addr.ScriptKey.SerializeCompressed(),
+		)
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to count script key "+
+			"receipts: %w", err)
+	}
+
+	return numReceipts > 0, nil
+}
+
+// SetEventLabel sets the label of the address events of the given on-chain
+// output. An empty label removes the current label. address.ErrEventNotFound
+// is returned if there is no event for the output.
+func (t *TapAddressBook) SetEventLabel(ctx context.Context,
+	outpoint wire.OutPoint, label string) error {
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		numRows, err := db.SetAddrEventLabel(ctx, AddrEventLabel{
+			Label:               sqlStr(label),
+			ChainTxnOutputIndex: int32(outpoint.Index),
+			Txid:                outpoint.Hash[:],
+		})
+		if err != nil {
+			return fmt.Errorf("error setting event label: %w", err)
+		}
+		if numRows == 0 {
+			return address.ErrEventNotFound
+		}
+
+		return nil
+	})
+}
+
 // CompleteEvent updates an address event as being complete and links it with
 // the proof and asset that was imported/created for it.
 func (t *TapAddressBook) CompleteEvent(ctx context.Context,
@@ -1048,7 +1141,8 @@ func (t *TapAddressBook) FetchScriptKey(ctx context.Context,
 		}
 
 		scriptKey = &asset.TweakedScriptKey{
-			Tweak: dbKey.Tweak,
+			Tweak:     dbKey.Tweak,
+			WatchOnly: dbKey.WatchOnly,
 			RawKey: keychain.KeyDescriptor{
 				PubKey: rawKey,
 				KeyLocator: keychain.KeyLocator{
@@ -1077,3 +1171,38 @@ func (t *TapAddressBook) FetchScriptKey(ctx context.Context,
 // address.Storage and address.EventStorage interface.
 var _ address.Storage = (*TapAddressBook)(nil)
 var _ address.EventStorage = (*TapAddressBook)(nil)
+
+// encodeCourierAddrs encodes the given proof courier addresses as a newline
+// separated list for storage in the database.
+func encodeCourierAddrs(addrs []url.URL) []byte {
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	addrStrs := fn.Map(addrs, func(addr url.URL) string {
+		return addr.String()
+	})
+
+	return []byte(strings.Join(addrStrs, "\n"))
+}
+
+// parseCourierAddrs parses a list of proof courier addresses that was encoded
+// with encodeCourierAddrs.
+func parseCourierAddrs(addrBytes []byte) ([]url.URL, error) {
+	if len(addrBytes) == 0 {
+		return nil, nil
+	}
+
+	addrStrs := strings.Split(string(addrBytes), "\n")
+	addrs := make([]url.URL, 0, len(addrStrs))
+	for _, addrStr := range addrStrs {
+		addr, err := url.ParseRequestURI(addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse fallback "+
+				"proof courier address: %w", err)
+		}
+		addrs = append(addrs, *addr)
+	}
+
+	return addrs, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightningnetwork/lnd/clock"
@@ -151,7 +152,8 @@ func TestAddressInsertion(t *testing.T) {
 
 	var writeTxOpts AddrBookTxOptions
 
-	// Make a series of new addrs, then insert them into the DB.
+	// Make a series of new addrs, then insert them into the DB. Some of
+	// them also list fallback proof couriers.
 	const numAddrs = 5
 	proofCourierAddr := address.RandProofCourierAddr(t)
 	addrs := make([]address.AddrWithKeyInfo, numAddrs)
@@ -159,6 +161,12 @@ func TestAddressInsertion(t *testing.T) {
 		addr, assetGen, assetGroup := address.RandAddr(
 			t, chainParams, proofCourierAddr,
 		)
+		for j := 0; j < i%3; j++ {
+			addr.FallbackCourierAddrs = append(
+				addr.FallbackCourierAddrs,
+				address.RandProofCourierAddr(t),
+			)
+		}
 
 		addrs[i] = *addr
 
@@ -467,6 +475,55 @@ func TestAddrEventCreation(t *testing.T) {
 
 		assertEqualAddrEvent(t, *events[idx], *actual)
 	}
+
+	// Finally, we label one of the events and make sure we can filter the
+	// events by that label.
+	const label = "salary"
+	err = addrBook.SetEventLabel(ctx, events[0].Outpoint, label)
+	require.NoError(t, err)
+	events[0].Label = label
+
+	labeledEvents, err := addrBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{
+			Label: fn.Ptr(label),
+		},
+	)
+	require.NoError(t, err)
+	assertEqualAddrEvents(t, events[:1], labeledEvents)
+
+	// Labeling an unknown outpoint should fail.
+	err = addrBook.SetEventLabel(ctx, wire.OutPoint{Index: 7}, label)
+	require.ErrorIs(t, err, address.ErrEventNotFound)
+
+	// All addresses received assets now, but none of them was reused yet.
+	for idx := range events {
+		require.False(t, events[idx].Reused)
+
+		used, err := addrBook.AddrReceived(ctx, events[idx].Addr.Tap)
+		require.NoError(t, err)
+		require.True(t, used)
+	}
+
+	unusedAddr, _, _ := address.RandAddr(t, chainParams, proofCourierAddr)
+	used, err := addrBook.AddrReceived(ctx, unusedAddr.Tap)
+	require.NoError(t, err)
+	require.False(t, used)
+
+	// A receive to the first address in another transaction marks the new
+	// event as reused, but not the first one.
+	reuseEvent, err := addrBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionDetected, events[0].Addr,
+		randWalletTx(), 0,
+	)
+	require.NoError(t, err)
+	require.True(t, reuseEvent.Reused)
+
+	firstEvent, err := addrBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionConfirmed, events[0].Addr,
+		txns[0], events[0].Outpoint.Index,
+	)
+	require.NoError(t, err)
+	require.False(t, firstEvent.Reused)
 }
 
 // TestAddressEventQuery tests that we're able to properly retrieve rows based
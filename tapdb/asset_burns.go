@@ -0,0 +1,137 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+// BurnQueryFilters is a set of optional filters for querying the burn
+// registry. Burns need to match all filters that are set.
+type BurnQueryFilters struct {
+	// AssetID only matches burns of the asset with this ID.
+	AssetID *asset.ID
+
+	// GroupKey only matches burns of assets with this tweaked group key.
+	GroupKey *btcec.PublicKey
+
+	// ScriptKey only matches the burn with this burn script key.
+	ScriptKey *btcec.PublicKey
+
+	// AnchorTXID only matches burns anchored in this transaction.
+	AnchorTXID *chainhash.Hash
+}
+
+// insertAssetBurn adds a burn of the transfer with the given ID to the burn
+// registry.
+func insertAssetBurn(ctx context.Context, q ActiveAssetsStore,
+	transferID int64, burn *tapfreighter.AssetBurn) error {
+
+	var groupKeyBytes []byte
+	if burn.GroupKey != nil {
+		groupKeyBytes = burn.GroupKey.SerializeCompressed()
+	}
+
+	return q.InsertBurn(ctx, NewAssetBurn{
+		TransferID: transferID,
+		Note:       sqlStr(burn.Note),
+		AssetID:    burn.AssetID[:],
+		GroupKey:   groupKeyBytes,
+		Amount:     int64(burn.Amount),
+		ScriptKey:  burn.ScriptKey.SerializeCompressed(),
+	})
+}
+
+// QueryBurns returns all asset burns of the registry that match the given
+// filters, in the order they were logged.
+func (a *AssetStore) QueryBurns(ctx context.Context,
+	filters BurnQueryFilters) ([]*tapfreighter.AssetBurn, error) {
+
+	var query BurnQuery
+	if filters.AssetID != nil {
+		query.AssetID = filters.AssetID[:]
+	}
+	if filters.GroupKey != nil {
+		query.GroupKey = filters.GroupKey.SerializeCompressed()
+	}
+	if filters.ScriptKey != nil {
+		query.ScriptKey = filters.ScriptKey.SerializeCompressed()
+	}
+	if filters.AnchorTXID != nil {
+		query.AnchorTxid = filters.AnchorTXID[:]
+	}
+
+	var burns []*tapfreighter.AssetBurn
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbBurns, err := q.QueryBurns(ctx, query)
+		if err != nil {
+			return fmt.Errorf("unable to query burns: %w", err)
+		}
+
+		burns = make([]*tapfreighter.AssetBurn, 0, len(dbBurns))
+		for _, dbBurn := range dbBurns {
+			burn, err := parseAssetBurn(dbBurn)
+			if err != nil {
+				return err
+			}
+
+			burns = append(burns, burn)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return burns, nil
+}
+
+// parseAssetBurn parses an asset burn of the burn registry.
+func parseAssetBurn(dbBurn AssetBurnRow) (*tapfreighter.AssetBurn, error) {
+	burn := &tapfreighter.AssetBurn{
+		Note:         dbBurn.Note.String,
+		Amount:       uint64(dbBurn.Amount),
+		TransferTime: dbBurn.TransferTimeUnix.UTC(),
+		BlockHeight:  uint32(dbBurn.BlockHeight.Int32),
+	}
+	copy(burn.AssetID[:], dbBurn.AssetID)
+
+	var err error
+	burn.ScriptKey, err = btcec.ParsePubKey(dbBurn.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse burn script key: %w",
+			err)
+	}
+
+	if len(dbBurn.GroupKey) != 0 {
+		burn.GroupKey, err = btcec.ParsePubKey(dbBurn.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse burn group "+
+				"key: %w", err)
+		}
+	}
+
+	anchorTXID, err := chainhash.NewHash(dbBurn.AnchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse burn anchor txid: %w",
+			err)
+	}
+	burn.AnchorTXID = *anchorTXID
+
+	if len(dbBurn.BlockHash) != 0 {
+		burn.BlockHash, err = chainhash.NewHash(dbBurn.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse burn block "+
+				"hash: %w", err)
+		}
+	}
+
+	return burn, nil
+}
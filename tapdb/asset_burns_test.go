@@ -0,0 +1,140 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAssetBurnRegistry tests that the burns of a transfer are added to the
+// burn registry and can be queried with the different filters.
+func TestAssetBurnRegistry(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	inputAsset := allAssets[0]
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: assetGen.anchorPoints[0],
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	burnKey := asset.RandScriptKey(t)
+	groupKey := test.RandPubKey(t)
+	burns := []*tapfreighter.AssetBurn{{
+		Note:      "supply reduction",
+		AssetID:   inputAsset.ID(),
+		GroupKey:  groupKey,
+		Amount:    6,
+		ScriptKey: burnKey.PubKey,
+	}, {
+		AssetID:   asset.RandID(t),
+		Amount:    10,
+		ScriptKey: test.RandPubKey(t),
+	}}
+
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		TransferTime:       time.Now(),
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:   burnKey,
+			Amount:      6,
+			ProofSuffix: bytes.Repeat([]byte{0x01}, 100),
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}},
+			}},
+		}},
+		Burns: burns,
+	}
+	leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, leaseOwner, time.Now().Add(time.Hour),
+	))
+
+	// Without any filters, all burns are returned in order. They're all
+	// unconfirmed, since the anchor transaction wasn't confirmed yet.
+	dbBurns, err := assetsStore.QueryBurns(ctx, BurnQueryFilters{})
+	require.NoError(t, err)
+	require.Len(t, dbBurns, 2)
+	for idx, dbBurn := range dbBurns {
+		require.Equal(t, burns[idx].Note, dbBurn.Note)
+		require.Equal(t, burns[idx].AssetID, dbBurn.AssetID)
+		require.Equal(t, burns[idx].GroupKey, dbBurn.GroupKey)
+		require.Equal(t, burns[idx].Amount, dbBurn.Amount)
+		require.Equal(t, burns[idx].ScriptKey, dbBurn.ScriptKey)
+		require.Equal(t, anchorTxHash, dbBurn.AnchorTXID)
+		require.Nil(t, dbBurn.BlockHash)
+	}
+
+	// Each of the filters only matches the first burn.
+	assetID := inputAsset.ID()
+	for _, filters := range []BurnQueryFilters{
+		{AssetID: &assetID},
+		{GroupKey: groupKey},
+		{ScriptKey: burnKey.PubKey},
+		{AssetID: &assetID, AnchorTXID: &anchorTxHash},
+	} {
+		dbBurns, err := assetsStore.QueryBurns(ctx, filters)
+		require.NoError(t, err)
+		require.Len(t, dbBurns, 1)
+		require.Equal(t, burns[0].ScriptKey, dbBurns[0].ScriptKey)
+	}
+
+	// Burns of other transactions don't match.
+	dbBurns, err = assetsStore.QueryBurns(ctx, BurnQueryFilters{
+		AnchorTXID: &chainhash.Hash{1},
+	})
+	require.NoError(t, err)
+	require.Empty(t, dbBurns)
+}
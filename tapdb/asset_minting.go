@@ -6,6 +6,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil/psbt"
@@ -25,6 +27,33 @@ type (
 	// BatchStateUpdate holds the arguments to update the state of a batch.
 	BatchStateUpdate = sqlc.UpdateMintingBatchStateParams
 
+	// BatchFundingUpdate holds the arguments to mark a minting
+	// batch as externally funded.
+	BatchFundingUpdate = sqlc.UpdateMintingBatchExternalFundingParams
+
+	// BatchLabelUpdate holds the arguments to update the label of a
+	// minting batch.
+	BatchLabelUpdate = sqlc.UpdateMintingBatchLabelParams
+
+	// BatchReOrgUpdate holds the arguments to mark a minting batch as
+	// re-organized out of the chain.
+	BatchReOrgUpdate = sqlc.MarkMintingBatchReOrgedParams
+
+	// BatchReOrgStateUpdate holds the arguments to update the re-org state
+	// of a minting batch.
+	BatchReOrgStateUpdate = sqlc.UpdateMintingBatchReOrgStateParams
+
+	// MintEventInsert holds the arguments to record a new state
+	// transition of a minting batch.
+	MintEventInsert = sqlc.InsertMintingBatchEventParams
+
+	// MintEventQuery holds the arguments to query the recorded state
+	// transitions of minting batches.
+	MintEventQuery = sqlc.QueryMintingBatchEventsParams
+
+	// MintEventRow is a recorded state transition of a minting batch.
+	MintEventRow = sqlc.QueryMintingBatchEventsRow
+
 	// InternalKey holds the arguments to update an internal key.
 	InternalKey = sqlc.UpsertInternalKeyParams
 
@@ -116,6 +145,27 @@ type (
 	// NewAssetMeta wraps the params needed to insert a new asset meta on
 	// disk.
 	NewAssetMeta = sqlc.UpsertAssetMetaParams
+
+	// BatchTemplateInit is used to insert a new batch template on disk.
+	BatchTemplateInit = sqlc.InsertBatchTemplateParams
+
+	// BatchTemplateAssetInit is used to insert a new asset of a batch
+	// template on disk.
+	BatchTemplateAssetInit = sqlc.InsertBatchTemplateAssetParams
+
+	// BatchTemplate is a batch template stored on disk.
+	BatchTemplate = sqlc.BatchTemplate
+
+	// BatchTemplateAsset is an asset of a batch template stored on disk.
+	BatchTemplateAsset = sqlc.BatchTemplateAsset
+
+	// GroupSupplyQuery is used to query the issued supply of an asset
+	// group.
+	GroupSupplyQuery = sqlc.QueryGroupSupplyParams
+
+	// DelegatedSupplyQuery is used to query the supply issued into an
+	// asset group by a delegate key.
+	DelegatedSupplyQuery = sqlc.QueryDelegatedSupplyParams
 )
 
 // PendingAssetStore is a sub-set of the main sqlc.Querier interface that
@@ -137,6 +187,26 @@ type PendingAssetStore interface {
 	UpdateMintingBatchState(ctx context.Context,
 		arg BatchStateUpdate) error
 
+	// UpdateMintingBatchExternalFunding updates whether the genesis
+	// transaction of an existing minting batch is funded externally.
+	UpdateMintingBatchExternalFunding(ctx context.Context,
+		arg BatchFundingUpdate) error
+
+	// UpdateMintingBatchLabel updates the user-defined label of an
+	// existing minting batch.
+	UpdateMintingBatchLabel(ctx context.Context,
+		arg BatchLabelUpdate) error
+
+	// MarkMintingBatchReOrged sets the re-org state of an existing minting
+	// batch and increments its number of re-orgs.
+	MarkMintingBatchReOrged(ctx context.Context,
+		arg BatchReOrgUpdate) error
+
+	// UpdateMintingBatchReOrgState updates the re-org state of an existing
+	// minting batch.
+	UpdateMintingBatchReOrgState(ctx context.Context,
+		arg BatchReOrgStateUpdate) error
+
 	// InsertAssetSeedling inserts a new asset seedling (base description)
 	// into the database.
 	InsertAssetSeedling(ctx context.Context, arg AssetSeedlingShell) error
@@ -173,6 +243,10 @@ type PendingAssetStore interface {
 	FetchSeedlingByID(ctx context.Context,
 		seedlingID int64) (AssetSeedling, error)
 
+	// DeleteAssetSeedling removes a seedling from the batch it was
+	// included in.
+	DeleteAssetSeedling(ctx context.Context, seedlingID int64) error
+
 	// BindMintingBatchWithTx adds the minting transaction to an existing
 	// batch.
 	BindMintingBatchWithTx(ctx context.Context, arg BatchChainUpdate) error
@@ -212,6 +286,57 @@ type PendingAssetStore interface {
 	// FetchAssetMetaForAsset fetches the asset meta for a given asset.
 	FetchAssetMetaForAsset(ctx context.Context,
 		assetID []byte) (sqlc.FetchAssetMetaForAssetRow, error)
+
+	// QueryGroupSupply returns the total amount of all seedlings issued
+	// into an asset group, not including cancelled batches.
+	QueryGroupSupply(ctx context.Context, arg GroupSupplyQuery) (int64,
+		error)
+
+	// QueryDelegatedSupply returns the total amount of all seedlings
+	// issued into an asset group by a delegate key, not including
+	// cancelled batches.
+	QueryDelegatedSupply(ctx context.Context,
+		arg DelegatedSupplyQuery) (int64, error)
+
+	// InsertBatchTemplate inserts a new batch template and returns its
+	// primary key.
+	InsertBatchTemplate(ctx context.Context, arg BatchTemplateInit) (int64,
+		error)
+
+	// InsertBatchTemplateAsset inserts a new asset of a batch template.
+	InsertBatchTemplateAsset(ctx context.Context,
+		arg BatchTemplateAssetInit) error
+
+	// FetchBatchTemplate fetches a batch template by its name.
+	FetchBatchTemplate(ctx context.Context,
+		templateName string) (BatchTemplate, error)
+
+	// FetchBatchTemplates fetches all batch templates.
+	FetchBatchTemplates(ctx context.Context) ([]BatchTemplate, error)
+
+	// FetchBatchTemplateAssets fetches the assets of a batch template.
+	FetchBatchTemplateAssets(ctx context.Context,
+		templateID int64) ([]BatchTemplateAsset, error)
+
+	// DeleteBatchTemplate deletes a batch template, along with its assets,
+	// by its name and returns the number of deleted templates.
+	DeleteBatchTemplate(ctx context.Context, templateName string) (int64,
+		error)
+
+	// InsertMintingBatchEvent records a new state transition of a minting
+	// batch, and returns the ID and block height of the new event.
+	InsertMintingBatchEvent(ctx context.Context,
+		arg MintEventInsert) (sqlc.InsertMintingBatchEventRow, error)
+
+	// FetchFirstMintingBatchEventID fetches the ID of the first recorded
+	// state transition of the minting batch with the given key.
+	FetchFirstMintingBatchEventID(ctx context.Context,
+		rawKey []byte) (int64, error)
+
+	// QueryMintingBatchEvents queries the recorded state transitions of
+	// all minting batches, starting at the given event ID and height.
+	QueryMintingBatchEvents(ctx context.Context,
+		arg MintEventQuery) ([]MintEventRow, error)
 }
 
 // AssetStoreTxOptions defines the set of db txn options the PendingAssetStore
@@ -250,14 +375,85 @@ type BatchedPendingAssetStore interface {
 // logic for any backend that can implement the specified interface.
 type AssetMintingStore struct {
 	db BatchedPendingAssetStore
+
+	// eventDistributor is an event distributor that will be used to notify
+	// subscribers about state transitions of minting batches.
+	eventDistributor *fn.EventDistributor[*tapgarden.MintEvent]
+
+	// eventMtx serializes the delivery of new mint events with the
+	// registration of new subscribers, so a subscriber never receives a
+	// new event before the replayed ones.
+	eventMtx sync.Mutex
 }
 
 // NewAssetMintingStore creates a new AssetMintingStore from the specified
 // BatchedPendingAssetStore interface.
 func NewAssetMintingStore(db BatchedPendingAssetStore) *AssetMintingStore {
 	return &AssetMintingStore{
-		db: db,
+		db:               db,
+		eventDistributor: fn.NewEventDistributor[*tapgarden.MintEvent](),
+	}
+}
+
+// insertMintEvent records the transition of the batch with the given key to
+// the given state. If no block height is given, the height hint of the batch
+// is used instead.
+func insertMintEvent(ctx context.Context, q PendingAssetStore,
+	batchKey *btcec.PublicKey, newState tapgarden.BatchState,
+	blockHeight sql.NullInt32) (*tapgarden.MintEvent, error) {
+
+	batchKeyBytes := batchKey.SerializeCompressed()
+	if !blockHeight.Valid {
+		dbBatch, err := q.FetchMintingBatch(ctx, batchKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch minting "+
+				"batch: %w", err)
+		}
+
+		blockHeight = sqlInt32(dbBatch.HeightHint)
+	}
+
+	eventTime := time.Now().UTC()
+	event, err := q.InsertMintingBatchEvent(ctx, MintEventInsert{
+		RawKey:        batchKeyBytes,
+		BatchState:    int16(newState),
+		BlockHeight:   blockHeight.Int32,
+		EventTimeUnix: eventTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to insert mint event: %w", err)
 	}
+
+	return tapgarden.NewMintEvent(
+		uint64(event.EventID), batchKey, newState,
+		uint32(event.BlockHeight), eventTime,
+	), nil
+}
+
+// updateBatchState updates the state of the batch with the given key, and
+// records the state transition as a new mint event.
+func updateBatchState(ctx context.Context, q PendingAssetStore,
+	batchKey *btcec.PublicKey, newState tapgarden.BatchState,
+	blockHeight sql.NullInt32) (*tapgarden.MintEvent, error) {
+
+	err := q.UpdateMintingBatchState(ctx, BatchStateUpdate{
+		RawKey:     batchKey.SerializeCompressed(),
+		BatchState: int16(newState),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return insertMintEvent(ctx, q, batchKey, newState, blockHeight)
+}
+
+// notifyMintEvent delivers a new mint event to all subscribers. This must
+// only be called once the event has been committed to disk.
+func (a *AssetMintingStore) notifyMintEvent(event *tapgarden.MintEvent) {
+	a.eventMtx.Lock()
+	defer a.eventMtx.Unlock()
+
+	a.eventDistributor.NotifySubscribers(event)
 }
 
 // CommitMintingBatch commits a new minting batch to disk along with any
@@ -270,8 +466,18 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 
 	rawBatchKey := newBatch.BatchKey.PubKey.SerializeCompressed()
 
-	var writeTxOpts AssetStoreTxOptions
-	err := a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+	tapscriptSibling, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		newBatch.TapscriptSibling,
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		writeTxOpts AssetStoreTxOptions
+		mintEvent   *tapgarden.MintEvent
+	)
+	err = a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
 		// First, we'll need to insert a new internal key which'll act
 		// as the foreign key our batch references.
 		batchID, err := q.UpsertInternalKey(ctx, InternalKey{
@@ -290,11 +496,22 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 			BatchID:          batchID,
 			HeightHint:       int32(newBatch.HeightHint),
 			CreationTimeUnix: newBatch.CreationTime.UTC(),
+			TapscriptSibling: tapscriptSibling,
 		}); err != nil {
 			return fmt.Errorf("unable to insert minting "+
 				"batch: %w", err)
 		}
 
+		// Every new batch starts out in the pending state, which
+		// is the first event of the batch.
+		mintEvent, err = insertMintEvent(
+			ctx, q, newBatch.BatchKey.PubKey,
+			tapgarden.BatchStatePending, sql.NullInt32{},
+		)
+		if err != nil {
+			return err
+		}
+
 		// Now that our minting batch is in place, which references the
 		// internal key inserted above, we can create the set of new
 		// seedlings. We insert group anchors before other assets.
@@ -322,6 +539,60 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMetaID:     assetMetaID,
 				EmissionEnabled: seedling.EnableEmission,
+				VanityPrefix:    seedling.VanityPrefix,
+			}
+
+			// If the group witness of this seedling is produced by
+			// an external signer, we'll also need to store the raw
+			// key of a new group and its multisig script, as the
+			// backing lnd node can't derive them again.
+			if seedling.ExternalGroupSigner {
+				dbSeedling.ExternalGroupSigner = true
+			}
+			if seedling.GroupMultiSig != nil {
+				script, err := seedling.GroupMultiSig.Script()
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.GroupMultisigScript = script
+			}
+			if seedling.GroupInternalKey != nil {
+				keyID, err := upsertSeedlingKey(
+					ctx, q, *seedling.GroupInternalKey,
+				)
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.GroupInternalKeyID = sqlInt64(keyID)
+			}
+
+			// The delegate key is stored so the issued amount can
+			// be counted against the delegation of the key.
+			if seedling.DelegateKey != nil {
+				keyID, err := upsertSeedlingKey(
+					ctx, q, *seedling.DelegateKey,
+				)
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.DelegateKeyID = sqlInt64(keyID)
+			}
+
+			// A script key given by the caller may not be known to
+			// the backing lnd node, so we store it along with its
+			// raw key.
+			if seedling.ScriptKey != nil {
+				scriptKeyID, err := upsertScriptKey(
+					ctx, *seedling.ScriptKey, q,
+				)
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.ScriptKeyID = sqlInt64(scriptKeyID)
 			}
 
 			// If this seedling is being issued to an existing
@@ -361,8 +632,13 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	a.notifyMintEvent(mintEvent)
+
+	return nil
 }
 
 // AddSeedlingsToBatch adds a new set of seedlings to an existing batch.
@@ -396,6 +672,60 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMetaID:     assetMetaID,
 				EmissionEnabled: seedling.EnableEmission,
+				VanityPrefix:    seedling.VanityPrefix,
+			}
+
+			// If the group witness of this seedling is produced by
+			// an external signer, we'll also need to store the raw
+			// key of a new group and its multisig script, as the
+			// backing lnd node can't derive them again.
+			if seedling.ExternalGroupSigner {
+				dbSeedling.ExternalGroupSigner = true
+			}
+			if seedling.GroupMultiSig != nil {
+				script, err := seedling.GroupMultiSig.Script()
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.GroupMultisigScript = script
+			}
+			if seedling.GroupInternalKey != nil {
+				keyID, err := upsertSeedlingKey(
+					ctx, q, *seedling.GroupInternalKey,
+				)
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.GroupInternalKeyID = sqlInt64(keyID)
+			}
+
+			// The delegate key is stored so the issued amount can
+			// be counted against the delegation of the key.
+			if seedling.DelegateKey != nil {
+				keyID, err := upsertSeedlingKey(
+					ctx, q, *seedling.DelegateKey,
+				)
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.DelegateKeyID = sqlInt64(keyID)
+			}
+
+			// A script key given by the caller may not be known to
+			// the backing lnd node, so we store it along with its
+			// raw key.
+			if seedling.ScriptKey != nil {
+				scriptKeyID, err := upsertScriptKey(
+					ctx, *seedling.ScriptKey, q,
+				)
+				if err != nil {
+					return err
+				}
+
+				dbSeedling.ScriptKeyID = sqlInt64(scriptKeyID)
 			}
 
 			// If this seedling is being issued to an existing
@@ -438,6 +768,38 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 	})
 }
 
+// RemoveSeedlingFromBatch removes the seedling with the given asset name from
+// the batch identified by the batch key.
+func (a *AssetMintingStore) RemoveSeedlingFromBatch(ctx context.Context,
+	batchKey *btcec.PublicKey, seedlingName string) error {
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		seedlingID, err := fetchSeedlingID(
+			ctx, q, rawBatchKey, seedlingName,
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("seedling %v not found in batch",
+				seedlingName)
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch seedling: %w", err)
+		}
+
+		// The meta data of the seedling is left in place, as it may
+		// be shared with other assets.
+		err = q.DeleteAssetSeedling(ctx, seedlingID)
+		if err != nil {
+			return fmt.Errorf("unable to delete seedling: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // fetchSeedlingID attempts to fetch the ID for a seedling from a specific
 // batch. This is performed within the context of a greater DB transaction.
 func fetchSeedlingID(ctx context.Context, q PendingAssetStore,
@@ -451,6 +813,25 @@ func fetchSeedlingID(ctx context.Context, q PendingAssetStore,
 	return q.FetchSeedlingID(ctx, seedlingParams)
 }
 
+// upsertGroupInternalKey inserts the raw key of an asset group into the set of
+// internal keys, returning the primary key of the key. This is performed within
+// the context of a greater DB transaction.
+func upsertSeedlingKey(ctx context.Context, q PendingAssetStore,
+	rawKey keychain.KeyDescriptor) (int64, error) {
+
+	keyID, err := q.UpsertInternalKey(ctx, InternalKey{
+		RawKey:    rawKey.PubKey.SerializeCompressed(),
+		KeyFamily: int32(rawKey.Family),
+		KeyIndex:  int32(rawKey.Index),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to insert seedling key: %w",
+			err)
+	}
+
+	return keyID, nil
+}
+
 // fetchAssetSeedlings attempts to fetch a set of asset seedlings for a given
 // batch. This is performed within the context of a greater DB transaction.
 func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
@@ -476,7 +857,9 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 			Amount: uint64(
 				dbSeedling.AssetSupply,
 			),
-			EnableEmission: dbSeedling.EmissionEnabled,
+			EnableEmission:      dbSeedling.EmissionEnabled,
+			ExternalGroupSigner: dbSeedling.ExternalGroupSigner,
+			VanityPrefix:        dbSeedling.VanityPrefix,
 		}
 
 		// Fetch the group info for seedlings with a specific group.
@@ -510,6 +893,94 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 			seedling.GroupAnchor = &seedlingAnchor.AssetName
 		}
 
+		// Restore the externally held raw group key, if one was set.
+		if len(dbSeedling.GroupInternalKeyRaw) != 0 {
+			rawKey, err := btcec.ParsePubKey(
+				dbSeedling.GroupInternalKeyRaw,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			keyFam := dbSeedling.GroupInternalKeyFam.Int32
+			keyIndex := dbSeedling.GroupInternalKeyIndex.Int32
+			seedling.GroupInternalKey = &keychain.KeyDescriptor{
+				PubKey: rawKey,
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(keyFam),
+					Index:  uint32(keyIndex),
+				},
+			}
+		}
+
+		// Restore the delegate key the asset is issued with, if one
+		// was set.
+		if len(dbSeedling.DelegateKeyRaw) != 0 {
+			delegateKey, err := btcec.ParsePubKey(
+				dbSeedling.DelegateKeyRaw,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			keyFam := dbSeedling.DelegateKeyFam.Int32
+			keyIndex := dbSeedling.DelegateKeyIndex.Int32
+			seedling.DelegateKey = &keychain.KeyDescriptor{
+				PubKey: delegateKey,
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(keyFam),
+					Index:  uint32(keyIndex),
+				},
+			}
+		}
+
+		// Restore the multisig script of the group key, if one was
+		// set.
+		if len(dbSeedling.GroupMultisigScript) != 0 {
+			multiSig, err := tapgarden.ParseGroupMultiSig(
+				dbSeedling.GroupMultisigScript,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			seedling.GroupMultiSig = multiSig
+		}
+
+		// Restore the script key the asset is minted to, if one was
+		// set.
+		if len(dbSeedling.ScriptKeyTweaked) != 0 {
+			scriptKey, err := btcec.ParsePubKey(
+				dbSeedling.ScriptKeyTweaked,
+			)
+			if err != nil {
+				return nil, err
+			}
+			rawKey, err := btcec.ParsePubKey(
+				dbSeedling.ScriptKeyRaw,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			keyFam := dbSeedling.ScriptKeyFam.Int32
+			keyIndex := dbSeedling.ScriptKeyIndex.Int32
+			rawKeyDesc := keychain.KeyDescriptor{
+				PubKey: rawKey,
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(keyFam),
+					Index:  uint32(keyIndex),
+				},
+			}
+			seedling.ScriptKey = &asset.ScriptKey{
+				PubKey: scriptKey,
+				TweakedScriptKey: &asset.TweakedScriptKey{
+					RawKey: rawKeyDesc,
+					Tweak:  dbSeedling.ScriptKeyTweak,
+				},
+			}
+		}
+
 		if len(dbSeedling.MetaDataBlob) != 0 {
 			seedling.Meta = &proof.MetaReveal{
 				Data: dbSeedling.MetaDataBlob,
@@ -519,6 +990,23 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 			}
 		}
 
+		// The decimal display, max supply and emission schedule aren't
+		// stored separately, as they are committed to in the meta
+		// data.
+		seedling.DecimalDisplay, err = seedling.Meta.DecimalDisplay()
+		if err != nil {
+			return nil, err
+		}
+		seedling.MaxSupply, err = seedling.Meta.MaxSupply()
+		if err != nil {
+			return nil, err
+		}
+		seedling.EmissionSchedule, err =
+			seedling.Meta.EmissionSchedule()
+		if err != nil {
+			return nil, err
+		}
+
 		seedlings[seedling.AssetName] = seedling
 	}
 
@@ -547,18 +1035,33 @@ func fetchAssetSprouts(ctx context.Context, q PendingAssetStore,
 	for i, sprout := range dbSprout {
 		// First, we'll decode the script key which very asset must
 		// specify, and populate the key locator information
-		scriptKeyPub, err := btcec.ParsePubKey(sprout.ScriptKeyRaw)
+		rawScriptKeyPub, err := btcec.ParsePubKey(sprout.ScriptKeyRaw)
 		if err != nil {
 			return nil, err
 		}
-		scriptKey := keychain.KeyDescriptor{
-			PubKey: scriptKeyPub,
+		rawScriptKey := keychain.KeyDescriptor{
+			PubKey: rawScriptKeyPub,
 			KeyLocator: keychain.KeyLocator{
 				Index:  uint32(sprout.ScriptKeyIndex),
 				Family: keychain.KeyFamily(sprout.ScriptKeyFam),
 			},
 		}
 
+		// The script key isn't necessarily a BIP-86 key, as seedlings
+		// may specify their own script key, so we use the stored
+		// tweaked key and tweak.
+		scriptKeyPub, err := btcec.ParsePubKey(sprout.TweakedScriptKey)
+		if err != nil {
+			return nil, err
+		}
+		scriptKey := asset.ScriptKey{
+			PubKey: scriptKeyPub,
+			TweakedScriptKey: &asset.TweakedScriptKey{
+				RawKey: rawScriptKey,
+				Tweak:  sprout.Tweak,
+			},
+		}
+
 		// Not all assets have a key group, so we only need to
 		// populate this information for those that signalled the
 		// requirement of on going emission.
@@ -595,8 +1098,9 @@ func fetchAssetSprouts(ctx context.Context, q PendingAssetStore,
 						),
 					},
 				},
-				GroupPubKey: *tweakedGroupKey,
-				Witness:     groupWitness,
+				GroupPubKey:   *tweakedGroupKey,
+				TapscriptRoot: sprout.TapscriptRoot,
+				Witness:       groupWitness,
 			}
 		}
 
@@ -638,7 +1142,7 @@ func fetchAssetSprouts(ctx context.Context, q PendingAssetStore,
 
 		assetSprout, err := asset.New(
 			assetGenesis, amount, lockTime, relativeLocktime,
-			asset.NewScriptKeyBip86(scriptKey), groupKey,
+			scriptKey, groupKey,
 			asset.WithAssetVersion(asset.Version(sprout.Version)),
 		)
 		if err != nil {
@@ -845,6 +1349,18 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 
 	batch.UpdateState(batchState)
 
+	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+		dbBatch.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, err
+	}
+	batch.TapscriptSibling = tapscriptSibling
+	batch.ExternalFunding = dbBatch.ExternalFunding
+	batch.Label = dbBatch.Label
+	batch.ReOrgState = tapgarden.BatchReOrgState(dbBatch.ReorgState)
+	batch.NumReOrgs = uint32(dbBatch.NumReorgs)
+
 	if dbBatch.MintingTxPsbt != nil {
 		genesisPkt, err := psbt.NewFromRawBytes(
 			bytes.NewReader(dbBatch.MintingTxPsbt), false,
@@ -903,11 +1419,62 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 func (a *AssetMintingStore) UpdateBatchState(ctx context.Context,
 	batchKey *btcec.PublicKey, newState tapgarden.BatchState) error {
 
+	var (
+		writeTxOpts AssetStoreTxOptions
+		mintEvent   *tapgarden.MintEvent
+	)
+	err := a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		var err error
+		mintEvent, err = updateBatchState(
+			ctx, q, batchKey, newState, sql.NullInt32{},
+		)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	a.notifyMintEvent(mintEvent)
+
+	return nil
+}
+
+// MarkBatchExternallyFunded marks the batch identified by the batch key as
+// funded and signed by an external wallet.
+func (a *AssetMintingStore) MarkBatchExternallyFunded(ctx context.Context,
+	batchKey *btcec.PublicKey) error {
+
 	var writeTxOpts AssetStoreTxOptions
 	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
-		return q.UpdateMintingBatchState(ctx, BatchStateUpdate{
-			RawKey:     batchKey.SerializeCompressed(),
-			BatchState: int16(newState),
+		return q.UpdateMintingBatchExternalFunding(
+			ctx, BatchFundingUpdate{
+				RawKey:          batchKey.SerializeCompressed(),
+				ExternalFunding: true,
+			},
+		)
+	})
+}
+
+// UpdateBatchLabel sets the user-defined label of the batch identified by the
+// batch key, replacing any previous label.
+func (a *AssetMintingStore) UpdateBatchLabel(ctx context.Context,
+	batchKey *btcec.PublicKey, label string) error {
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// The update itself doesn't fail for an unknown batch, so we
+		// make sure the batch exists first.
+		_, err := q.FetchMintingBatch(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch minting batch: %w",
+				err)
+		}
+
+		return q.UpdateMintingBatchLabel(ctx, BatchLabelUpdate{
+			RawKey: rawBatchKey,
+			Label:  label,
 		})
 	})
 }
@@ -926,10 +1493,12 @@ func encodeOutpoint(outPoint wire.OutPoint) ([]byte, error) {
 
 // AddSproutsToBatch updates a batch with the passed batch transaction and also
 // binds the genesis transaction (which will create the set of assets in the
-// batch) to the batch itself.
+// batch) to the batch itself. The passed asset metas are stored along with the
+// assets.
 func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 	batchKey *btcec.PublicKey, genesisPacket *tapgarden.FundedPsbt,
-	assetRoot *commitment.TapCommitment) error {
+	assetRoot *commitment.TapCommitment,
+	assetMetas tapgarden.AssetMetas) error {
 
 	// Before we open the DB transaction below, we'll fetch the set of
 	// assets committed to within the root commitment specified.
@@ -955,13 +1524,32 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 
 	rawBatchKey := batchKey.SerializeCompressed()
 
-	var writeTxOpts AssetStoreTxOptions
-	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
-		genesisPointID, _, err := upsertAssetsWithGenesis(
-			ctx, q, genesisOutpoint, sortedAssets, nil,
-		)
-		if err != nil {
-			return fmt.Errorf("error inserting assets with "+
+	var (
+		writeTxOpts AssetStoreTxOptions
+		mintEvent   *tapgarden.MintEvent
+	)
+	err = a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// The meta data of an asset may differ from the one stored
+		// with its seedling, for example if a nonce was added to grind
+		// a vanity asset ID. So we'll insert all meta reveals first,
+		// as the genesis of each asset references its meta data.
+		for _, metaReveal := range assetMetas {
+			if metaReveal == nil {
+				continue
+			}
+
+			_, err := maybeUpsertAssetMeta(ctx, q, nil, metaReveal)
+			if err != nil {
+				return fmt.Errorf("unable to insert asset "+
+					"meta: %w", err)
+			}
+		}
+
+		genesisPointID, _, err := upsertAssetsWithGenesis(
+			ctx, q, genesisOutpoint, sortedAssets, nil,
+		)
+		if err != nil {
+			return fmt.Errorf("error inserting assets with "+
 				"genesis: %w", err)
 		}
 
@@ -985,11 +1573,19 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 		}
 
 		// Finally, update the batch state to BatchStateCommitted.
-		return q.UpdateMintingBatchState(ctx, BatchStateUpdate{
-			RawKey:     rawBatchKey,
-			BatchState: int16(tapgarden.BatchStateCommitted),
-		})
+		mintEvent, err = updateBatchState(
+			ctx, q, batchKey, tapgarden.BatchStateCommitted,
+			sql.NullInt32{},
+		)
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	a.notifyMintEvent(mintEvent)
+
+	return nil
 }
 
 // CommitSignedGenesisTx binds a fully signed genesis transaction to a pending
@@ -1002,7 +1598,7 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 // root manually?
 func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 	batchKey *btcec.PublicKey, genesisPkt *tapgarden.FundedPsbt,
-	anchorOutputIndex uint32, merkleRoot []byte) error {
+	anchorOutputIndex uint32, merkleRoot, tapRoot []byte) error {
 
 	// The managed UTXO we'll insert only contains the raw tx of the
 	// genesis packet, so we'll extract that now.
@@ -1038,8 +1634,11 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 		return err
 	}
 
-	var writeTxOpts AssetStoreTxOptions
-	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+	var (
+		writeTxOpts AssetStoreTxOptions
+		mintEvent   *tapgarden.MintEvent
+	)
+	err = a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
 		// First, we'll update the genesis packet stored as part of the
 		// batch, as this packet is now fully signed.
 		var psbtBuf bytes.Buffer
@@ -1066,6 +1665,15 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 			return fmt.Errorf("unable to insert chain tx: %w", err)
 		}
 
+		// The tapscript sibling of the minting output, if any, is
+		// stored with the batch. We need to store it with the managed
+		// UTXO as well, so the assets can later be spent.
+		dbBatch, err := q.FetchMintingBatch(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch minting batch: %w",
+				err)
+		}
+
 		// Now that the genesis tx has been updated within the main
 		// batch, we'll create a new managed UTXO for this batch as
 		// this is where all the assets will be anchored within.
@@ -1073,11 +1681,11 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 			RawKey:   rawBatchKey,
 			Outpoint: anchorOutpoint,
 			AmtSats:  anchorOutput.Value,
-			// When minting, we never have a tapscript sibling, so
-			// the TaprootAssetRoot root is always equal to the
-			// merkle root.
-			TaprootAssetRoot: merkleRoot,
+			// Without a tapscript sibling, the TaprootAssetRoot
+			// root is equal to the merkle root.
+			TaprootAssetRoot: tapRoot,
 			MerkleRoot:       merkleRoot,
+			TapscriptSibling: dbBatch.TapscriptSibling,
 			TxnID:            chainTXID,
 		})
 		if err != nil {
@@ -1105,11 +1713,19 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 		}
 
 		// Finally, update the batch state to BatchStateBroadcast.
-		return q.UpdateMintingBatchState(ctx, BatchStateUpdate{
-			RawKey:     rawBatchKey,
-			BatchState: int16(tapgarden.BatchStateBroadcast),
-		})
+		mintEvent, err = updateBatchState(
+			ctx, q, batchKey, tapgarden.BatchStateBroadcast,
+			sql.NullInt32{},
+		)
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	a.notifyMintEvent(mintEvent)
+
+	return nil
 }
 
 // MarkBatchConfirmed stores final confirmation information for a batch on
@@ -1121,14 +1737,18 @@ func (a *AssetMintingStore) MarkBatchConfirmed(ctx context.Context,
 
 	rawBatchKey := batchKey.SerializeCompressed()
 
-	var writeTxOpts AssetStoreTxOptions
-	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+	var (
+		writeTxOpts AssetStoreTxOptions
+		mintEvent   *tapgarden.MintEvent
+	)
+	err := a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
 		// First, we'll update the state of the target batch to reflect
 		// that the batch is fully finalized.
-		err := q.UpdateMintingBatchState(ctx, BatchStateUpdate{
-			RawKey:     rawBatchKey,
-			BatchState: int16(tapgarden.BatchStateConfirmed),
-		})
+		var err error
+		mintEvent, err = updateBatchState(
+			ctx, q, batchKey, tapgarden.BatchStateConfirmed,
+			sqlInt32(blockHeight),
+		)
 		if err != nil {
 			return err
 		}
@@ -1158,6 +1778,58 @@ func (a *AssetMintingStore) MarkBatchConfirmed(ctx context.Context,
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	a.notifyMintEvent(mintEvent)
+
+	return nil
+}
+
+// MarkBatchReOrged records that the minting transaction of the batch was
+// re-organized out of the chain, and sets the given re-org state.
+func (a *AssetMintingStore) MarkBatchReOrged(ctx context.Context,
+	batchKey *btcec.PublicKey, reOrgState tapgarden.BatchReOrgState) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		return q.MarkMintingBatchReOrged(ctx, BatchReOrgUpdate{
+			RawKey:     batchKey.SerializeCompressed(),
+			ReorgState: int16(reOrgState),
+		})
+	})
+}
+
+// MarkBatchReConfirmed updates the block location of the minting transaction
+// of the batch after it confirmed again following a re-org, and marks the
+// batch as recovered.
+func (a *AssetMintingStore) MarkBatchReConfirmed(ctx context.Context,
+	batchKey *btcec.PublicKey, blockHash *chainhash.Hash,
+	blockHeight uint32, txIndex uint32) error {
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		err := q.ConfirmChainTx(ctx, ChainTxConf{
+			RawKey:      rawBatchKey,
+			BlockHeight: sqlInt32(blockHeight),
+			BlockHash:   blockHash[:],
+			TxIndex:     sqlInt32(txIndex),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to confirm chain tx: %w", err)
+		}
+
+		recovered := int16(tapgarden.ReOrgStateRecovered)
+		return q.UpdateMintingBatchReOrgState(
+			ctx, BatchReOrgStateUpdate{
+				RawKey:     rawBatchKey,
+				ReorgState: recovered,
+			},
+		)
+	})
 }
 
 // FetchGroupByGenesis fetches the asset group created by the genesis referenced
@@ -1206,6 +1878,409 @@ func (a *AssetMintingStore) FetchGroupByGroupKey(ctx context.Context,
 	return dbGroup, nil
 }
 
+// FetchAssetMeta fetches the meta data reveal of the asset with the given ID.
+// If the asset was created without meta data, nil is returned.
+func (a *AssetMintingStore) FetchAssetMeta(ctx context.Context,
+	assetID asset.ID) (*proof.MetaReveal, error) {
+
+	var assetMeta *proof.MetaReveal
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		dbMeta, err := q.FetchAssetMetaForAsset(ctx, assetID[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		// An empty meta entry means there is no meta data to
+		// reveal.
+		if len(dbMeta.MetaDataBlob) == 0 {
+			return nil
+		}
+
+		assetMeta = &proof.MetaReveal{
+			Data: dbMeta.MetaDataBlob,
+			Type: proof.MetaType(dbMeta.MetaDataType.Int16),
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return assetMeta, nil
+}
+
+// FetchGroupSupply returns the total amount of all assets issued into the
+// asset group with the given tweaked key, including the seedlings of batches
+// that haven't been cancelled yet.
+func (a *AssetMintingStore) FetchGroupSupply(ctx context.Context,
+	groupKey *btcec.PublicKey) (uint64, error) {
+
+	var supply int64
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		var err error
+		supply, err = q.QueryGroupSupply(ctx, GroupSupplyQuery{
+			GroupKey: groupKey.SerializeCompressed(),
+			SeedlingCancelledState: int16(
+				tapgarden.BatchStateSeedlingCancelled,
+			),
+			SproutCancelledState: int16(
+				tapgarden.BatchStateSproutCancelled,
+			),
+		})
+		return err
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return uint64(supply), nil
+}
+
+// FetchDelegatedSupply returns the total amount of all assets issued into the
+// asset group with the given tweaked key by the given delegate key, including
+// the seedlings of batches that haven't been cancelled yet.
+func (a *AssetMintingStore) FetchDelegatedSupply(ctx context.Context,
+	groupKey, delegateKey *btcec.PublicKey) (uint64, error) {
+
+	var supply int64
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		var err error
+		supply, err = q.QueryDelegatedSupply(ctx, DelegatedSupplyQuery{
+			GroupKey:    groupKey.SerializeCompressed(),
+			DelegateKey: delegateKey.SerializeCompressed(),
+			SeedlingCancelledState: int16(
+				tapgarden.BatchStateSeedlingCancelled,
+			),
+			SproutCancelledState: int16(
+				tapgarden.BatchStateSproutCancelled,
+			),
+		})
+		return err
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return uint64(supply), nil
+}
+
+// SaveBatchTemplate stores the given batch template on disk. An existing
+// template with the same name is replaced.
+func (a *AssetMintingStore) SaveBatchTemplate(ctx context.Context,
+	template *tapgarden.BatchTemplate) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// Any existing template of the same name is replaced as a
+		// whole, its assets are deleted along with it.
+		_, err := q.DeleteBatchTemplate(ctx, template.Name)
+		if err != nil {
+			return fmt.Errorf("unable to delete template: %w", err)
+		}
+
+		templateID, err := q.InsertBatchTemplate(ctx, BatchTemplateInit{
+			TemplateName:     template.Name,
+			CreationTimeUnix: template.CreationTime.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert template: %w", err)
+		}
+
+		for _, templateAsset := range template.Assets {
+			dbAsset := BatchTemplateAssetInit{
+				TemplateID:   templateID,
+				AssetName:    templateAsset.AssetName,
+				AssetVersion: int16(templateAsset.AssetVersion),
+				AssetType:    int16(templateAsset.AssetType),
+				AssetSupply:  int64(templateAsset.Amount),
+				DecimalDisplay: int32(
+					templateAsset.DecimalDisplay,
+				),
+				EmissionEnabled: templateAsset.EnableEmission,
+			}
+
+			if templateAsset.Meta != nil {
+				dbAsset.MetaDataType = sqlInt16(
+					templateAsset.Meta.Type,
+				)
+				dbAsset.MetaDataBlob = templateAsset.Meta.Data
+			}
+
+			if templateAsset.GroupKey != nil {
+				dbAsset.GroupKey = templateAsset.GroupKey.
+					SerializeCompressed()
+			}
+
+			if templateAsset.GroupAnchor != nil {
+				dbAsset.GroupAnchorName = sqlStr(
+					*templateAsset.GroupAnchor,
+				)
+			}
+
+			err := q.InsertBatchTemplateAsset(ctx, dbAsset)
+			if err != nil {
+				return fmt.Errorf("unable to insert template "+
+					"asset: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// fetchBatchTemplateAssets fetches the assets of the given batch template and
+// turns it into its native counterpart.
+func fetchBatchTemplateAssets(ctx context.Context, q PendingAssetStore,
+	dbTemplate BatchTemplate) (*tapgarden.BatchTemplate, error) {
+
+	dbAssets, err := q.FetchBatchTemplateAssets(ctx, dbTemplate.TemplateID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch template assets: %w",
+			err)
+	}
+
+	template := &tapgarden.BatchTemplate{
+		Name: dbTemplate.TemplateName,
+		Assets: make(
+			[]*tapgarden.AssetTemplate, 0, len(dbAssets),
+		),
+		CreationTime: dbTemplate.CreationTimeUnix.UTC(),
+	}
+	for _, dbAsset := range dbAssets {
+		templateAsset := &tapgarden.AssetTemplate{
+			AssetVersion:   asset.Version(dbAsset.AssetVersion),
+			AssetType:      asset.Type(dbAsset.AssetType),
+			AssetName:      dbAsset.AssetName,
+			Amount:         uint64(dbAsset.AssetSupply),
+			DecimalDisplay: uint32(dbAsset.DecimalDisplay),
+			EnableEmission: dbAsset.EmissionEnabled,
+		}
+
+		if len(dbAsset.MetaDataBlob) != 0 {
+			metaType := proof.MetaType(dbAsset.MetaDataType.Int16)
+			templateAsset.Meta = &proof.MetaReveal{
+				Type: metaType,
+				Data: dbAsset.MetaDataBlob,
+			}
+		}
+
+		if len(dbAsset.GroupKey) != 0 {
+			templateAsset.GroupKey, err = btcec.ParsePubKey(
+				dbAsset.GroupKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if dbAsset.GroupAnchorName.Valid {
+			groupAnchor := dbAsset.GroupAnchorName.String
+			templateAsset.GroupAnchor = &groupAnchor
+		}
+
+		template.Assets = append(template.Assets, templateAsset)
+	}
+
+	return template, nil
+}
+
+// FetchBatchTemplate fetches the batch template with the given name. If no
+// such template exists, tapgarden.ErrBatchTemplateNotFound is returned.
+func (a *AssetMintingStore) FetchBatchTemplate(ctx context.Context,
+	name string) (*tapgarden.BatchTemplate, error) {
+
+	var template *tapgarden.BatchTemplate
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		dbTemplate, err := q.FetchBatchTemplate(ctx, name)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return tapgarden.ErrBatchTemplateNotFound
+
+		case err != nil:
+			return err
+		}
+
+		template, err = fetchBatchTemplateAssets(ctx, q, dbTemplate)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return template, nil
+}
+
+// FetchBatchTemplates fetches all batch templates, ordered by name.
+func (a *AssetMintingStore) FetchBatchTemplates(
+	ctx context.Context) ([]*tapgarden.BatchTemplate, error) {
+
+	var templates []*tapgarden.BatchTemplate
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		dbTemplates, err := q.FetchBatchTemplates(ctx)
+		if err != nil {
+			return err
+		}
+
+		templates = make(
+			[]*tapgarden.BatchTemplate, 0, len(dbTemplates),
+		)
+		for _, dbTemplate := range dbTemplates {
+			template, err := fetchBatchTemplateAssets(
+				ctx, q, dbTemplate,
+			)
+			if err != nil {
+				return err
+			}
+
+			templates = append(templates, template)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return templates, nil
+}
+
+// DeleteBatchTemplate deletes the batch template with the given name. If no
+// such template exists, tapgarden.ErrBatchTemplateNotFound is returned.
+func (a *AssetMintingStore) DeleteBatchTemplate(ctx context.Context,
+	name string) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		numDeleted, err := q.DeleteBatchTemplate(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		if numDeleted == 0 {
+			return tapgarden.ErrBatchTemplateNotFound
+		}
+
+		return nil
+	})
+}
+
+// fetchMintEvents fetches the recorded mint events that match the given
+// replay parameters, in the order they were emitted.
+func (a *AssetMintingStore) fetchMintEvents(ctx context.Context,
+	replay tapgarden.MintEventReplay) ([]*tapgarden.MintEvent, error) {
+
+	var (
+		events []*tapgarden.MintEvent
+		readTx = NewAssetStoreReadTx()
+	)
+	dbErr := a.db.ExecTx(ctx, &readTx, func(q PendingAssetStore) error {
+		query := MintEventQuery{
+			StartHeight: int32(replay.StartHeight),
+		}
+
+		// If a batch key is given, we'll start with the first event
+		// of that batch.
+		if replay.BatchKey != nil {
+			eventID, err := q.FetchFirstMintingBatchEventID(
+				ctx, replay.BatchKey.SerializeCompressed(),
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return fmt.Errorf("no mint events found for "+
+					"batch %x",
+					replay.BatchKey.SerializeCompressed())
+
+			case err != nil:
+				return err
+			}
+
+			query.StartEventID = eventID
+		}
+
+		dbEvents, err := q.QueryMintingBatchEvents(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		events = make([]*tapgarden.MintEvent, 0, len(dbEvents))
+		for _, dbEvent := range dbEvents {
+			batchKey, err := btcec.ParsePubKey(dbEvent.BatchKey)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, tapgarden.NewMintEvent(
+				uint64(dbEvent.EventID), batchKey,
+				tapgarden.BatchState(dbEvent.BatchState),
+				uint32(dbEvent.BlockHeight),
+				dbEvent.EventTimeUnix.UTC(),
+			))
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to fetch mint events: %w", dbErr)
+	}
+
+	return events, nil
+}
+
+// RegisterSubscriber adds a new subscriber for receiving mint events. The
+// deliverExisting boolean indicates whether already emitted events should be
+// delivered to the subscriber first, starting from the event specified by
+// deliverFrom. Replayed events are always delivered before any new event.
+func (a *AssetMintingStore) RegisterSubscriber(
+	receiver *fn.EventReceiver[*tapgarden.MintEvent],
+	deliverExisting bool, deliverFrom tapgarden.MintEventReplay) error {
+
+	// We hold the event mutex while replaying, so no new event can be
+	// delivered in between. An event that is committed but not yet
+	// delivered may be received twice, which subscribers can detect by
+	// its ID.
+	a.eventMtx.Lock()
+	defer a.eventMtx.Unlock()
+
+	if deliverExisting {
+		ctx := context.Background()
+		events, err := a.fetchMintEvents(ctx, deliverFrom)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			receiver.NewItemCreated.ChanIn() <- event
+		}
+	}
+
+	a.eventDistributor.RegisterSubscriber(receiver)
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+func (a *AssetMintingStore) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*tapgarden.MintEvent]) error {
+
+	return a.eventDistributor.RemoveSubscriber(subscriber)
+}
+
 // A compile-time assertion to ensure that AssetMintingStore meets the
 // tapgarden.MintingStore interface.
 var _ tapgarden.MintingStore = (*AssetMintingStore)(nil)
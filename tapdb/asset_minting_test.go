@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
@@ -68,6 +70,7 @@ func assertBatchEqual(t *testing.T, a, b *tapgarden.MintingBatch) {
 	require.Equal(t, a.Seedlings, b.Seedlings)
 	require.Equal(t, a.GenesisPacket, b.GenesisPacket)
 	require.Equal(t, a.RootAssetCommitment, b.RootAssetCommitment)
+	require.Equal(t, a.TapscriptSibling, b.TapscriptSibling)
 }
 
 func assertSeedlingBatchLen(t *testing.T, batches []*tapgarden.MintingBatch,
@@ -550,7 +553,7 @@ func TestAddSproutsToBatch(t *testing.T) {
 		mintingBatch.Seedlings, seedlingGroups,
 	)
 	require.NoError(t, assetStore.AddSproutsToBatch(
-		ctx, batchKey, genesisPacket, assetRoot,
+		ctx, batchKey, genesisPacket, assetRoot, nil,
 	))
 
 	// Now we'll query for that same batch, and assert that the set of
@@ -614,7 +617,7 @@ func addRandAssets(t *testing.T, ctx context.Context,
 		mintingBatch.Seedlings, seedlingGroups,
 	)
 	require.NoError(t, assetStore.AddSproutsToBatch(
-		ctx, batchKey, genesisPacket, assetRoot,
+		ctx, batchKey, genesisPacket, assetRoot, nil,
 	))
 
 	scriptRoot := assetRoot.TapscriptRoot(nil)
@@ -630,6 +633,74 @@ func addRandAssets(t *testing.T, ctx context.Context,
 	}
 }
 
+// TestCommitBatchTapscriptSibling tests that the tapscript sibling of a batch
+// is persisted along with the batch, and that it's carried over to the managed
+// UTXO of the minting output once the genesis transaction is signed.
+func TestCommitBatchTapscriptSibling(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 3
+	assetStore, _, db := newAssetStore(t)
+
+	// We'll create a new batch that commits to a tapscript sibling next
+	// to the Taproot Asset commitment.
+	sibling := commitment.NewPreimageFromLeaf(test.ScriptHashLock(
+		t, test.RandBytes(32),
+	))
+	siblingHash, err := sibling.TapHash()
+	require.NoError(t, err)
+
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	mintingBatch.TapscriptSibling = sibling
+	batchKey := mintingBatch.BatchKey.PubKey
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+
+	// The sibling should be returned when reading the batch back.
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	require.Len(t, mintingBatches, 1)
+	assertBatchEqual(t, mintingBatch, mintingBatches[0])
+
+	// Next, we'll turn the seedlings into sprouts and commit the signed
+	// genesis transaction.
+	genesisPacket := randGenesisPacket(t)
+	assetRoot := seedlingsToAssetRoot(
+		t, genesisPacket.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint,
+		mintingBatch.Seedlings, nil,
+	)
+	require.NoError(t, assetStore.AddSproutsToBatch(
+		ctx, batchKey, genesisPacket, assetRoot, nil,
+	))
+
+	genesisPacket.Pkt.Inputs[0].FinalScriptSig = []byte{}
+	tapRoot := assetRoot.TapscriptRoot(nil)
+	merkleRoot := assetRoot.TapscriptRoot(siblingHash)
+	require.NoError(t, assetStore.CommitSignedGenesisTx(
+		ctx, batchKey, genesisPacket, 2, merkleRoot[:], tapRoot[:],
+	))
+
+	// The managed UTXO of the minting output should now commit to both
+	// the Taproot Asset root and the sibling.
+	rawGenTx, err := psbt.Extract(genesisPacket.Pkt)
+	require.NoError(t, err)
+	genTXID := rawGenTx.TxHash()
+	dbGenTx, err := db.FetchChainTx(ctx, genTXID[:])
+	require.NoError(t, err)
+
+	managedUTXO, err := db.FetchManagedUTXO(ctx, sqlc.FetchManagedUTXOParams{
+		TxnID: sqlInt64(dbGenTx.TxnID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, tapRoot[:], managedUTXO.TaprootAssetRoot)
+	require.Equal(t, merkleRoot[:], managedUTXO.MerkleRoot)
+
+	dbSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+		managedUTXO.TapscriptSibling,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sibling, dbSibling)
+}
+
 // TestCommitBatchChainActions tests that we're able to properly write a signed
 // genesis transaction to disk, update all dependent tables along the way, and
 // also transition the batch to the BatchStateBroadcast state. Finally we test
@@ -660,7 +731,7 @@ func TestCommitBatchChainActions(t *testing.T) {
 	// alongside any managed UTXOs.
 	require.NoError(t, assetStore.CommitSignedGenesisTx(
 		ctx, randAssetCtx.batchKey, randAssetCtx.genesisPkt, 2,
-		randAssetCtx.scriptRoot,
+		randAssetCtx.scriptRoot, randAssetCtx.scriptRoot,
 	))
 
 	// The batch updated above should be found, with the batch state
@@ -1121,7 +1192,7 @@ func TestGroupAnchors(t *testing.T) {
 		mintingBatch.Seedlings, seedlingGroups,
 	)
 	require.NoError(t, assetStore.AddSproutsToBatch(
-		ctx, batchKey, genesisPacket, assetRoot,
+		ctx, batchKey, genesisPacket, assetRoot, nil,
 	))
 
 	// Now we'll query for that same batch, and assert that the set of
@@ -1137,6 +1208,429 @@ func TestGroupAnchors(t *testing.T) {
 	assertAssetsEqual(t, assetRoot, mintingBatches[0].RootAssetCommitment)
 }
 
+// TestRemoveSeedlingFromBatch tests that a single seedling can be removed from
+// a pending batch on disk while the rest of the batch is left untouched.
+func TestRemoveSeedlingFromBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 5
+	assetStore, _, _ := newAssetStore(t)
+
+	// First, we'll write a new minting batch with a set of seedlings to
+	// disk.
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	err := assetStore.CommitMintingBatch(ctx, mintingBatch)
+	require.NoError(t, err)
+
+	batchKey := mintingBatch.BatchKey.PubKey
+
+	// We'll now remove one of the seedlings, which should leave the rest
+	// of the batch as is.
+	seedlingName := maps.Keys(mintingBatch.Seedlings)[0]
+	require.NoError(t, assetStore.RemoveSeedlingFromBatch(
+		ctx, batchKey, seedlingName,
+	))
+	delete(mintingBatch.Seedlings, seedlingName)
+
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings-1)
+	assertBatchEqual(t, mintingBatch, mintingBatches[0])
+	assertBatchState(t, mintingBatches[0], tapgarden.BatchStatePending)
+
+	// Removing the same seedling again should fail, as it's no longer part
+	// of the batch.
+	err = assetStore.RemoveSeedlingFromBatch(ctx, batchKey, seedlingName)
+	require.ErrorContains(t, err, "not found in batch")
+
+	// New seedlings can still be added to the batch afterwards.
+	seedlings := tapgarden.RandSeedlings(t, 1)
+	require.NoError(t, assetStore.AddSeedlingsToBatch(
+		ctx, batchKey, maps.Values(seedlings)...,
+	))
+	mintingBatch.Seedlings = mergeMap(mintingBatch.Seedlings, seedlings)
+
+	mintingBatches = noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings)
+	assertBatchEqual(t, mintingBatch, mintingBatches[0])
+}
+
+// TestExternalGroupSignerSeedlings tests that seedlings with a group key held
+// by an external signer are stored along with the raw group key.
+func TestExternalGroupSignerSeedlings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 3
+	assetStore, _, _ := newAssetStore(t)
+
+	// We'll create a batch with an anchor seedling for a new group whose
+	// key is held by an external signer and commits to a multisig script.
+	// The multisig keys are read back in their x-only form.
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	groupInternalKey, _ := randKeyDesc(t)
+	multiSigKeys := make([]*btcec.PublicKey, 3)
+	for idx := range multiSigKeys {
+		multiSigKeys[idx], _ = schnorr.ParsePubKey(
+			schnorr.SerializePubKey(test.RandPubKey(t)),
+		)
+	}
+	multiSig, err := tapgarden.NewGroupMultiSig(2, multiSigKeys)
+	require.NoError(t, err)
+
+	anchorName := "external-anchor"
+	mintingBatch.Seedlings[anchorName] = &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           anchorName,
+		Amount:              1000,
+		EnableEmission:      true,
+		ExternalGroupSigner: true,
+		GroupInternalKey:    &groupInternalKey,
+		GroupMultiSig:       multiSig,
+	}
+
+	err = assetStore.CommitMintingBatch(ctx, mintingBatch)
+	require.NoError(t, err)
+
+	// A seedling that is added to the batch later on and joins the group
+	// must also be signed for externally.
+	memberName := "external-member"
+	member := &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           memberName,
+		Amount:              50,
+		GroupAnchor:         &anchorName,
+		ExternalGroupSigner: true,
+		GroupMultiSig:       multiSig,
+	}
+	require.NoError(t, assetStore.AddSeedlingsToBatch(
+		ctx, mintingBatch.BatchKey.PubKey, member,
+	))
+	mintingBatch.Seedlings[memberName] = member
+
+	// Both seedlings should be read back with the external signer flag
+	// set, and the anchor with its raw group key.
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings+2)
+	assertBatchEqual(t, mintingBatch, mintingBatches[0])
+
+	dbAnchor := mintingBatches[0].Seedlings[anchorName]
+	require.True(t, dbAnchor.ExternalGroupSigner)
+	require.Equal(t, groupInternalKey, *dbAnchor.GroupInternalKey)
+	require.Equal(t, multiSig, dbAnchor.GroupMultiSig)
+	require.True(t, mintingBatches[0].Seedlings[memberName].
+		ExternalGroupSigner)
+	require.Equal(t, multiSig, mintingBatches[0].Seedlings[memberName].
+		GroupMultiSig)
+}
+
+// TestSeedlingScriptKeys tests that the script keys of seedlings, that may not
+// be known to the backing lnd node, are stored along with their raw key and
+// tweak.
+func TestSeedlingScriptKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 3
+	assetStore, _, _ := newAssetStore(t)
+
+	// We'll create a batch with one seedling that is minted to a BIP-86
+	// key of another party.
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	bip86RawKey, _ := randKeyDesc(t)
+	bip86ScriptKey := asset.NewScriptKeyBip86(bip86RawKey)
+	bip86Name := "bip86-script-key"
+	mintingBatch.Seedlings[bip86Name] = &tapgarden.Seedling{
+		AssetType: asset.Normal,
+		AssetName: bip86Name,
+		Amount:    1000,
+		ScriptKey: &bip86ScriptKey,
+	}
+
+	err := assetStore.CommitMintingBatch(ctx, mintingBatch)
+	require.NoError(t, err)
+
+	// A seedling minted to a script key with a tapscript tweak is added
+	// to the batch later on.
+	tweakedRawKey, _ := randKeyDesc(t)
+	tweak := test.RandBytes(32)
+	tweakedScriptKey := asset.ScriptKey{
+		PubKey: txscript.ComputeTaprootOutputKey(
+			tweakedRawKey.PubKey, tweak,
+		),
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: tweakedRawKey,
+			Tweak:  tweak,
+		},
+	}
+	tweakedName := "tweaked-script-key"
+	tweakedSeedling := &tapgarden.Seedling{
+		AssetType: asset.Collectible,
+		AssetName: tweakedName,
+		Amount:    1,
+		ScriptKey: &tweakedScriptKey,
+	}
+	require.NoError(t, assetStore.AddSeedlingsToBatch(
+		ctx, mintingBatch.BatchKey.PubKey, tweakedSeedling,
+	))
+	mintingBatch.Seedlings[tweakedName] = tweakedSeedling
+
+	// Both script keys should be read back, while the other seedlings
+	// still don't have one.
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings+2)
+	assertBatchEqual(t, mintingBatch, mintingBatches[0])
+
+	dbSeedlings := mintingBatches[0].Seedlings
+	require.Equal(t, bip86ScriptKey, *dbSeedlings[bip86Name].ScriptKey)
+	require.Equal(
+		t, tweakedScriptKey, *dbSeedlings[tweakedName].ScriptKey,
+	)
+	for name, seedling := range dbSeedlings {
+		if name == bip86Name || name == tweakedName {
+			continue
+		}
+
+		require.Nil(t, seedling.ScriptKey)
+	}
+}
+
+// TestMarkBatchExternallyFunded tests that the external funding flag of a
+// batch is stored and read back with the batch.
+func TestMarkBatchExternallyFunded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 3
+	assetStore, _, _ := newAssetStore(t)
+
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	err := assetStore.CommitMintingBatch(ctx, mintingBatch)
+	require.NoError(t, err)
+
+	batchKey := mintingBatch.BatchKey.PubKey
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.False(t, dbBatch.ExternalFunding)
+
+	// Once marked, the flag is set no matter how the batch is fetched.
+	require.NoError(t, assetStore.MarkBatchExternallyFunded(ctx, batchKey))
+
+	dbBatch, err = assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.True(t, dbBatch.ExternalFunding)
+
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	require.Len(t, mintingBatches, 1)
+	require.True(t, mintingBatches[0].ExternalFunding)
+
+	mintingBatches = noError1(t, assetStore.FetchAllBatches, ctx)
+	require.Len(t, mintingBatches, 1)
+	require.True(t, mintingBatches[0].ExternalFunding)
+}
+
+// TestUpdateBatchLabel tests that the label of a batch can be updated in any
+// state and is read back with the batch.
+func TestUpdateBatchLabel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 2
+	assetStore, _, _ := newAssetStore(t)
+
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	err := assetStore.CommitMintingBatch(ctx, mintingBatch)
+	require.NoError(t, err)
+
+	batchKey := mintingBatch.BatchKey.PubKey
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.Empty(t, dbBatch.Label)
+
+	const label = "invoice 2023-0042"
+	require.NoError(t, assetStore.UpdateBatchLabel(ctx, batchKey, label))
+
+	dbBatch, err = assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.Equal(t, label, dbBatch.Label)
+
+	// The label survives state transitions of the batch and can still be
+	// changed afterwards.
+	err = assetStore.UpdateBatchState(
+		ctx, batchKey, tapgarden.BatchStateSeedlingCancelled,
+	)
+	require.NoError(t, err)
+
+	mintingBatches := noError1(t, assetStore.FetchAllBatches, ctx)
+	require.Len(t, mintingBatches, 1)
+	require.Equal(t, label, mintingBatches[0].Label)
+
+	require.NoError(t, assetStore.UpdateBatchLabel(ctx, batchKey, ""))
+
+	dbBatch, err = assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.Empty(t, dbBatch.Label)
+
+	// Labelling an unknown batch fails.
+	unknownKey := test.RandPubKey(t)
+	err = assetStore.UpdateBatchLabel(ctx, unknownKey, label)
+	require.Error(t, err)
+}
+
+// TestMarkBatchReOrged tests that the re-org state of a confirmed batch is
+// stored and that re-confirming the batch updates the location of its minting
+// transaction.
+func TestMarkBatchReOrged(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 2
+	assetStore, _, db := newAssetStore(t)
+
+	randAssetCtx := addRandAssets(t, ctx, assetStore, numSeedlings)
+	randAssetCtx.genesisPkt.Pkt.Inputs[0].FinalScriptSig = []byte{}
+	require.NoError(t, assetStore.CommitSignedGenesisTx(
+		ctx, randAssetCtx.batchKey, randAssetCtx.genesisPkt, 2,
+		randAssetCtx.scriptRoot, randAssetCtx.scriptRoot,
+	))
+
+	blockHash := chainhash.Hash(sha256.Sum256([]byte("first")))
+	require.NoError(t, assetStore.MarkBatchConfirmed(
+		ctx, randAssetCtx.batchKey, &blockHash, 20, 5, nil,
+	))
+
+	batchKey := randAssetCtx.batchKey
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.Equal(t, tapgarden.ReOrgStateNone, dbBatch.ReOrgState)
+	require.Zero(t, dbBatch.NumReOrgs)
+
+	// Every re-org is counted, the state is replaced.
+	err = assetStore.MarkBatchReOrged(
+		ctx, batchKey, tapgarden.ReOrgStatePending,
+	)
+	require.NoError(t, err)
+	err = assetStore.MarkBatchReOrged(
+		ctx, batchKey, tapgarden.ReOrgStatePending,
+	)
+	require.NoError(t, err)
+
+	mintingBatches := noError1(t, assetStore.FetchAllBatches, ctx)
+	require.Len(t, mintingBatches, 1)
+	require.Equal(
+		t, tapgarden.ReOrgStatePending, mintingBatches[0].ReOrgState,
+	)
+	require.EqualValues(t, 2, mintingBatches[0].NumReOrgs)
+
+	// Once the minting transaction confirms again, the batch is recovered
+	// and the chain transaction points to the new block.
+	newBlockHash := chainhash.Hash(sha256.Sum256([]byte("second")))
+	require.NoError(t, assetStore.MarkBatchReConfirmed(
+		ctx, batchKey, &newBlockHash, 21, 3,
+	))
+
+	dbBatch, err = assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.Equal(t, tapgarden.ReOrgStateRecovered, dbBatch.ReOrgState)
+	require.EqualValues(t, 2, dbBatch.NumReOrgs)
+
+	rawGenTx, err := psbt.Extract(randAssetCtx.genesisPkt.Pkt)
+	require.NoError(t, err)
+	genTXID := rawGenTx.TxHash()
+	dbGenTx, err := db.FetchChainTx(ctx, genTXID[:])
+	require.NoError(t, err)
+	require.Equal(t, newBlockHash[:], dbGenTx.BlockHash)
+	require.EqualValues(t, 21, extractSqlInt32[uint32](dbGenTx.BlockHeight))
+	require.EqualValues(t, 3, extractSqlInt32[uint32](dbGenTx.TxIndex))
+}
+
+// TestFetchGroupSupply tests that the issued supply of an asset group covers
+// all seedlings of the group, both in committed and pending batches, but not
+// in cancelled ones.
+func TestFetchGroupSupply(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assetStore, _, _ := newAssetStore(t)
+
+	// We'll create a batch with a new asset group that consists of an
+	// anchor and a second seedling that references it.
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 2)
+	anchorName, memberName := "supply-anchor", "supply-member"
+	mintingBatch.Seedlings[anchorName] = &tapgarden.Seedling{
+		AssetType:      asset.Normal,
+		AssetName:      anchorName,
+		Amount:         600,
+		EnableEmission: true,
+	}
+	mintingBatch.Seedlings[memberName] = &tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   memberName,
+		Amount:      300,
+		GroupAnchor: &anchorName,
+	}
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+
+	// The group key is only known once the batch is committed to.
+	genesisPacket := randGenesisPacket(t)
+	assetRoot := seedlingsToAssetRoot(
+		t, genesisPacket.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint,
+		mintingBatch.Seedlings, nil,
+	)
+	require.NoError(t, assetStore.AddSproutsToBatch(
+		ctx, mintingBatch.BatchKey.PubKey, genesisPacket, assetRoot,
+		nil,
+	))
+
+	var groupKey *btcec.PublicKey
+	for _, a := range assetRoot.CommittedAssets() {
+		if a.Genesis.Tag == anchorName {
+			groupKey = &a.GroupKey.GroupPubKey
+		}
+	}
+	require.NotNil(t, groupKey)
+
+	supply, err := assetStore.FetchGroupSupply(ctx, groupKey)
+	require.NoError(t, err)
+	require.EqualValues(t, 900, supply)
+
+	// A reissuance into the group in a new pending batch should be
+	// counted as well.
+	group, err := assetStore.FetchGroupByGroupKey(ctx, groupKey)
+	require.NoError(t, err)
+	group.GroupKey.Witness = nil
+
+	reissuanceBatch := tapgarden.RandSeedlingMintingBatch(t, 0)
+	reissuanceName := "supply-reissuance"
+	reissuanceBatch.Seedlings[reissuanceName] = &tapgarden.Seedling{
+		AssetType: asset.Normal,
+		AssetName: reissuanceName,
+		Amount:    50,
+		GroupInfo: group,
+	}
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, reissuanceBatch))
+
+	supply, err = assetStore.FetchGroupSupply(ctx, groupKey)
+	require.NoError(t, err)
+	require.EqualValues(t, 950, supply)
+
+	// Once the reissuance batch is cancelled, it no longer counts towards
+	// the supply of the group.
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, reissuanceBatch.BatchKey.PubKey,
+		tapgarden.BatchStateSeedlingCancelled,
+	))
+
+	supply, err = assetStore.FetchGroupSupply(ctx, groupKey)
+	require.NoError(t, err)
+	require.EqualValues(t, 900, supply)
+
+	// An unknown group has no supply at all.
+	supply, err = assetStore.FetchGroupSupply(ctx, test.RandPubKey(t))
+	require.NoError(t, err)
+	require.Zero(t, supply)
+}
+
 func init() {
 	rand.Seed(time.Now().Unix())
 
@@ -1145,3 +1639,243 @@ func init() {
 	logWriter.RegisterSubLogger(Subsystem, logger)
 	UseLogger(logger)
 }
+
+// TestBatchTemplates tests that batch templates can be saved, fetched,
+// replaced and deleted.
+func TestBatchTemplates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assetStore, _, _ := newAssetStore(t)
+
+	// Without any templates, we should get an empty list and a not found
+	// error for a specific template.
+	templates, err := assetStore.FetchBatchTemplates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, templates)
+
+	_, err = assetStore.FetchBatchTemplate(ctx, "monthly")
+	require.ErrorIs(t, err, tapgarden.ErrBatchTemplateNotFound)
+
+	// We'll now save a template with a group anchor, a member of the new
+	// group and an asset that is issued into an existing group.
+	anchorName := "bond"
+	monthly := &tapgarden.BatchTemplate{
+		Name: "monthly",
+		Assets: []*tapgarden.AssetTemplate{{
+			AssetVersion: asset.V1,
+			AssetType:    asset.Normal,
+			AssetName:    anchorName,
+			Meta: &proof.MetaReveal{
+				Data: []byte("bond series"),
+			},
+			Amount:         1000,
+			EnableEmission: true,
+		}, {
+			AssetType:   asset.Normal,
+			AssetName:   "coupon",
+			Amount:      50,
+			GroupAnchor: &anchorName,
+		}, {
+			AssetType: asset.Collectible,
+			AssetName: "certificate",
+			Amount:    1,
+			GroupKey:  test.RandPubKey(t),
+		}},
+		CreationTime: time.Unix(time.Now().Unix(), 0).UTC(),
+	}
+	weekly := &tapgarden.BatchTemplate{
+		Name: "weekly",
+		Assets: []*tapgarden.AssetTemplate{{
+			AssetType:      asset.Normal,
+			AssetName:      "voucher",
+			Amount:         10,
+			DecimalDisplay: 2,
+		}},
+		CreationTime: time.Unix(time.Now().Unix(), 0).UTC(),
+	}
+	require.NoError(t, assetStore.SaveBatchTemplate(ctx, weekly))
+	require.NoError(t, assetStore.SaveBatchTemplate(ctx, monthly))
+
+	dbMonthly, err := assetStore.FetchBatchTemplate(ctx, monthly.Name)
+	require.NoError(t, err)
+	require.Equal(t, monthly, dbMonthly)
+
+	// The templates should be listed in order of their names.
+	templates, err = assetStore.FetchBatchTemplates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.BatchTemplate{monthly, weekly}, templates)
+
+	// Saving a template with an existing name replaces the old template.
+	monthly.Assets = monthly.Assets[2:]
+	require.NoError(t, assetStore.SaveBatchTemplate(ctx, monthly))
+
+	dbMonthly, err = assetStore.FetchBatchTemplate(ctx, monthly.Name)
+	require.NoError(t, err)
+	require.Equal(t, monthly, dbMonthly)
+
+	// Finally, a deleted template can't be fetched or deleted again.
+	require.NoError(t, assetStore.DeleteBatchTemplate(ctx, monthly.Name))
+
+	_, err = assetStore.FetchBatchTemplate(ctx, monthly.Name)
+	require.ErrorIs(t, err, tapgarden.ErrBatchTemplateNotFound)
+
+	err = assetStore.DeleteBatchTemplate(ctx, monthly.Name)
+	require.ErrorIs(t, err, tapgarden.ErrBatchTemplateNotFound)
+
+	templates, err = assetStore.FetchBatchTemplates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.BatchTemplate{weekly}, templates)
+}
+
+// TestMintEventReplay tests that every state transition of a minting batch is
+// recorded as a mint event, and that past events can be replayed to new
+// subscribers starting from a batch or a block height.
+func TestMintEventReplay(t *testing.T) {
+	t.Parallel()
+
+	const eventTimeout = 5 * time.Second
+
+	ctx := context.Background()
+	assetStore, _, _ := newAssetStore(t)
+
+	// We'll create two batches at different heights. The first one is
+	// frozen and then cancelled after the second one was created.
+	batchA := tapgarden.RandSeedlingMintingBatch(t, 1)
+	batchA.HeightHint = 100
+	batchB := tapgarden.RandSeedlingMintingBatch(t, 1)
+	batchB.HeightHint = 200
+
+	keyA, keyB := batchA.BatchKey.PubKey, batchB.BatchKey.PubKey
+
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, batchA))
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, keyA, tapgarden.BatchStateFrozen,
+	))
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, batchB))
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, keyA, tapgarden.BatchStateSproutCancelled,
+	))
+
+	type expectedEvent struct {
+		batchKey    *btcec.PublicKey
+		state       tapgarden.BatchState
+		blockHeight uint32
+	}
+	allEvents := []expectedEvent{
+		{keyA, tapgarden.BatchStatePending, 100},
+		{keyA, tapgarden.BatchStateFrozen, 100},
+		{keyB, tapgarden.BatchStatePending, 200},
+		{keyA, tapgarden.BatchStateSproutCancelled, 100},
+	}
+
+	// subscribe registers a new subscriber and reads the given number of
+	// events from it.
+	subscribe := func(deliverExisting bool,
+		replay tapgarden.MintEventReplay,
+		numEvents int) []*tapgarden.MintEvent {
+
+		receiver := fn.NewEventReceiver[*tapgarden.MintEvent](
+			fn.DefaultQueueSize,
+		)
+		t.Cleanup(func() {
+			_ = assetStore.RemoveSubscriber(receiver)
+		})
+
+		err := assetStore.RegisterSubscriber(
+			receiver, deliverExisting, replay,
+		)
+		require.NoError(t, err)
+
+		events := make([]*tapgarden.MintEvent, 0, numEvents)
+		for len(events) < numEvents {
+			select {
+			case event := <-receiver.NewItemCreated.ChanOut():
+				events = append(events, event)
+
+			case <-time.After(eventTimeout):
+				t.Fatalf("expected %d events, got %d",
+					numEvents, len(events))
+			}
+		}
+
+		return events
+	}
+	assertEvents := func(expected []expectedEvent,
+		events []*tapgarden.MintEvent) {
+
+		require.Len(t, events, len(expected))
+		for idx, event := range events {
+			require.True(t, expected[idx].batchKey.IsEqual(
+				event.BatchKey,
+			))
+			require.Equal(t, expected[idx].state, event.BatchState)
+			require.Equal(
+				t, expected[idx].blockHeight, event.BlockHeight,
+			)
+
+			if idx > 0 {
+				require.Greater(t, event.ID, events[idx-1].ID)
+			}
+		}
+	}
+
+	// Without any replay parameters, all past events are delivered.
+	assertEvents(allEvents, subscribe(
+		true, tapgarden.MintEventReplay{}, len(allEvents),
+	))
+
+	// Replaying from the second batch starts with its first event.
+	assertEvents(allEvents[2:], subscribe(
+		true, tapgarden.MintEventReplay{
+			BatchKey: keyB,
+		}, 2,
+	))
+
+	// Replaying from a block height only includes the events at or after
+	// that height.
+	assertEvents(allEvents[2:3], subscribe(
+		true, tapgarden.MintEventReplay{
+			StartHeight: 150,
+		}, 1,
+	))
+
+	// Replaying from an unknown batch fails.
+	receiver := fn.NewEventReceiver[*tapgarden.MintEvent](
+		fn.DefaultQueueSize,
+	)
+	err := assetStore.RegisterSubscriber(
+		receiver, true, tapgarden.MintEventReplay{
+			BatchKey: test.RandPubKey(t),
+		},
+	)
+	require.ErrorContains(t, err, "no mint events found")
+	receiver.Stop()
+
+	// Finally, a subscriber that doesn't want any replay only receives new
+	// events, after all the replayed ones.
+	liveReceiver := fn.NewEventReceiver[*tapgarden.MintEvent](
+		fn.DefaultQueueSize,
+	)
+	t.Cleanup(func() {
+		_ = assetStore.RemoveSubscriber(liveReceiver)
+	})
+	err = assetStore.RegisterSubscriber(
+		liveReceiver, false, tapgarden.MintEventReplay{},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, keyB, tapgarden.BatchStateSeedlingCancelled,
+	))
+
+	select {
+	case event := <-liveReceiver.NewItemCreated.ChanOut():
+		assertEvents([]expectedEvent{
+			{keyB, tapgarden.BatchStateSeedlingCancelled, 200},
+		}, []*tapgarden.MintEvent{event})
+
+	case <-time.After(eventTimeout):
+		t.Fatalf("no live mint event received")
+	}
+}
@@ -324,6 +324,7 @@ func upsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
 			InternalKeyID:    rawScriptKeyID,
 			TweakedScriptKey: scriptKey.PubKey.SerializeCompressed(),
 			Tweak:            scriptKey.Tweak,
+			WatchOnly:        scriptKey.WatchOnly,
 		})
 		if err != nil {
 			return 0, fmt.Errorf("unable to insert script key: "+
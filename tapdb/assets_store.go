@@ -3,6 +3,7 @@ package tapdb
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -130,6 +132,58 @@ type (
 
 	// ReAnchorParams wraps the params needed to re-anchor a passive asset.
 	ReAnchorParams = sqlc.ReAnchorPassiveAssetsParams
+
+	// ReplaceAnchorTxParams wraps the params needed to replace the anchor
+	// transaction of an asset transfer.
+	ReplaceAnchorTxParams = sqlc.ReplaceTransferAnchorTxParams
+
+	// TransferCancelTx wraps the params needed to store the cancel
+	// transaction of an asset transfer.
+	TransferCancelTx = sqlc.SetTransferCancelTxParams
+
+	// ReAnchorUTXOParams wraps the params needed to move a managed UTXO to
+	// a new anchor transaction.
+	ReAnchorUTXOParams = sqlc.ReAnchorManagedUTXOParams
+
+	// OutputProofSuffix wraps the params needed to update the proof suffix
+	// of a transfer output.
+	OutputProofSuffix = sqlc.UpdateTransferOutputProofSuffixParams
+
+	// OutputsCourierAddr wraps the params needed to update the proof
+	// courier address of the outputs of a transfer.
+	OutputsCourierAddr = sqlc.UpdateTransferOutputsProofCourierAddrParams
+
+	// PassiveAssetProof wraps the params needed to update the new proof of
+	// a passive asset.
+	PassiveAssetProof = sqlc.UpdatePassiveAssetProofParams
+
+	// QueuedSend is a send that is queued for later execution.
+	QueuedSend = sqlc.QueuedSend
+
+	// NewQueuedSend wraps the params needed to insert a new queued send.
+	NewQueuedSend = sqlc.InsertQueuedSendParams
+
+	// NewQueuedSendAddr wraps the params needed to insert an address of a
+	// queued send.
+	NewQueuedSendAddr = sqlc.InsertQueuedSendAddrParams
+
+	// QueuedSendUpdate wraps the params needed to update a queued send.
+	QueuedSendUpdate = sqlc.UpdateQueuedSendParams
+
+	// SwapOffer is the payment a swap offer we made asked for.
+	SwapOffer = sqlc.SwapOffer
+
+	// NewSwapOffer wraps the params needed to insert a new swap offer.
+	NewSwapOffer = sqlc.InsertSwapOfferParams
+
+	// NewAssetBurn wraps the params needed to insert a new asset burn.
+	NewAssetBurn = sqlc.InsertBurnParams
+
+	// BurnQuery wraps the params needed to query asset burns.
+	BurnQuery = sqlc.QueryBurnsParams
+
+	// AssetBurnRow is an asset burn, along with its anchor transaction.
+	AssetBurnRow = sqlc.QueryBurnsRow
 )
 
 // ActiveAssetsStore is a sub-set of the main sqlc.Querier interface that
@@ -283,6 +337,89 @@ type ActiveAssetsStore interface {
 	// the passed params.
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorParams) error
 
+	// ReplaceTransferAnchorTx points an asset transfer to a new anchor
+	// transaction and stores the PSBT it was created from.
+	ReplaceTransferAnchorTx(ctx context.Context,
+		arg ReplaceAnchorTxParams) error
+
+	// SetTransferCancelTx stores the transaction that double spends the
+	// anchor transaction of an asset transfer.
+	SetTransferCancelTx(ctx context.Context, arg TransferCancelTx) error
+
+	// ReAnchorManagedUTXO moves a managed UTXO to a new outpoint of a new
+	// anchor transaction.
+	ReAnchorManagedUTXO(ctx context.Context, arg ReAnchorUTXOParams) error
+
+	// UpdateTransferOutputProofSuffix updates the proof suffix of a
+	// transfer output.
+	UpdateTransferOutputProofSuffix(ctx context.Context,
+		arg OutputProofSuffix) error
+
+	// UpdateTransferOutputsProofCourierAddr updates the proof courier
+	// address of all outputs of a transfer that don't go to our own node.
+	UpdateTransferOutputsProofCourierAddr(ctx context.Context,
+		arg OutputsCourierAddr) error
+
+	// UpdatePassiveAssetProof updates the new proof of a passive asset.
+	UpdatePassiveAssetProof(ctx context.Context,
+		arg PassiveAssetProof) error
+
+	// DeleteTransferInputs deletes all inputs of a transfer.
+	DeleteTransferInputs(ctx context.Context, transferID int64) error
+
+	// DeleteTransferOutputs deletes all outputs of a transfer.
+	DeleteTransferOutputs(ctx context.Context, transferID int64) error
+
+	// DeleteTransferPassiveAssets deletes all passive assets re-anchored
+	// by a transfer.
+	DeleteTransferPassiveAssets(ctx context.Context,
+		transferID int64) error
+
+	// DeleteAssetTransfer deletes a transfer along with its burns. Its
+	// inputs, outputs and passive assets must be deleted first.
+	DeleteAssetTransfer(ctx context.Context, transferID int64) error
+
+	// DeleteUnreferencedManagedUTXO deletes the managed UTXO with the given
+	// ID, unless an asset, transfer or address event still references it.
+	DeleteUnreferencedManagedUTXO(ctx context.Context, utxoID int64) error
+
+	// InsertQueuedSend inserts a new queued send and returns its ID.
+	InsertQueuedSend(ctx context.Context, arg NewQueuedSend) (int64, error)
+
+	// InsertQueuedSendAddr inserts an address of a queued send.
+	InsertQueuedSendAddr(ctx context.Context, arg NewQueuedSendAddr) error
+
+	// FetchQueuedSends fetches all queued sends.
+	FetchQueuedSends(ctx context.Context) ([]QueuedSend, error)
+
+	// FetchQueuedSendAddrs fetches the addresses of a queued send.
+	FetchQueuedSendAddrs(ctx context.Context,
+		queuedSendID int64) ([]string, error)
+
+	// UpdateQueuedSend updates the conditions, the last error and the
+	// failed flag of a queued send.
+	UpdateQueuedSend(ctx context.Context, arg QueuedSendUpdate) (int64,
+		error)
+
+	// DeleteQueuedSend deletes a queued send, along with its addresses.
+	DeleteQueuedSend(ctx context.Context, queuedSendID int64) (int64,
+		error)
+
+	// InsertSwapOffer inserts a new swap offer.
+	InsertSwapOffer(ctx context.Context, arg NewSwapOffer) error
+
+	// FetchSwapOffer fetches the swap offer with the given ID.
+	FetchSwapOffer(ctx context.Context, offerID []byte) (SwapOffer, error)
+
+	// DeleteSwapOffer deletes the swap offer with the given ID.
+	DeleteSwapOffer(ctx context.Context, offerID []byte) (int64, error)
+
+	// InsertBurn inserts a new asset burn of a transfer.
+	InsertBurn(ctx context.Context, arg NewAssetBurn) error
+
+	// QueryBurns queries the asset burns matching the given query.
+	QueryBurns(ctx context.Context, arg BurnQuery) ([]AssetBurnRow, error)
+
 	// FetchAssetMetaByHash fetches the asset meta for a given meta hash.
 	//
 	// TODO(roasbeef): split into MetaStore?
@@ -292,6 +429,13 @@ type ActiveAssetsStore interface {
 	// FetchAssetMetaForAsset fetches the asset meta for a given asset.
 	FetchAssetMetaForAsset(ctx context.Context,
 		assetID []byte) (sqlc.FetchAssetMetaForAssetRow, error)
+
+	// UpsertMetaBlob stores a meta data blob that is committed to by
+	// reference.
+	UpsertMetaBlob(ctx context.Context, arg sqlc.UpsertMetaBlobParams) error
+
+	// FetchMetaBlob fetches the meta data blob with the given hash.
+	FetchMetaBlob(ctx context.Context, blobHash []byte) ([]byte, error)
 }
 
 type InsertRecvProofTxAttemptParams = sqlc.InsertReceiverProofTransferAttemptParams
@@ -613,8 +757,9 @@ func (a *AssetStore) dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 						),
 					},
 				},
-				GroupPubKey: *tweakedGroupKey,
-				Witness:     groupWitness,
+				GroupPubKey:   *tweakedGroupKey,
+				TapscriptRoot: sprout.TapscriptRoot,
+				Witness:       groupWitness,
 			}
 		}
 
@@ -661,8 +806,9 @@ func (a *AssetStore) dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 		scriptKey := asset.ScriptKey{
 			PubKey: scriptKeyPub,
 			TweakedScriptKey: &asset.TweakedScriptKey{
-				RawKey: rawScriptKeyDesc,
-				Tweak:  sprout.ScriptKeyTweak,
+				RawKey:    rawScriptKeyDesc,
+				Tweak:     sprout.ScriptKeyTweak,
+				WatchOnly: sprout.ScriptKeyWatchOnly,
 			},
 		}
 
@@ -1664,9 +1810,11 @@ func (a *AssetStore) ListEligibleCoins(ctx context.Context,
 		CommitmentConstraints: constraints,
 	})
 
-	// We only want to select unspent and non-leased commitments.
+	// We only want to select unspent and non-leased commitments that
+	// aren't locked to watch only script keys, as we can't sign for those.
 	assetFilter.Spent = sqlBool(false)
 	assetFilter.Leased = sqlBool(false)
+	assetFilter.WatchOnly = sqlBool(false)
 
 	return a.queryCommitments(ctx, assetFilter)
 }
@@ -1916,6 +2064,11 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 	}
 	anchorTxBytes := txBuf.Bytes()
 
+	anchorPsbtBytes, err := encodeAnchorPsbt(spend.AnchorPsbt)
+	if err != nil {
+		return err
+	}
+
 	var writeTxOpts AssetStoreTxOptions
 	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
 		// First, we'll insert the new transaction that anchors the new
@@ -1937,6 +2090,8 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 			HeightHint:       int32(spend.AnchorTxHeightHint),
 			AnchorTxid:       newAnchorTXID[:],
 			TransferTimeUnix: spend.TransferTime,
+			AnchorPsbt:       anchorPsbtBytes,
+			Label:            sqlStr(spend.Label),
 		})
 		if err != nil {
 			return fmt.Errorf("unable to insert asset transfer: "+
@@ -1967,10 +2122,53 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 			}
 		}
 
+		// Any assets burned by the transfer are added to the burn
+		// registry.
+		for _, burn := range spend.Burns {
+			err = insertAssetBurn(ctx, q, transferID, burn)
+			if err != nil {
+				return fmt.Errorf("unable to insert asset "+
+					"burn: %w", err)
+			}
+		}
+
 		return nil
 	})
 }
 
+// encodeAnchorPsbt serializes the given anchor PSBT. A nil PSBT is encoded
+// as a nil byte slice, so it is stored as NULL.
+func encodeAnchorPsbt(anchorPsbt *psbt.Packet) ([]byte, error) {
+	if anchorPsbt == nil {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if err := anchorPsbt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("unable to serialize anchor psbt: %w",
+			err)
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeAnchorPsbt parses the given anchor PSBT bytes, returning nil if no
+// PSBT was stored.
+func decodeAnchorPsbt(anchorPsbtBytes []byte) (*psbt.Packet, error) {
+	if len(anchorPsbtBytes) == 0 {
+		return nil, nil
+	}
+
+	anchorPsbt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(anchorPsbtBytes), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse anchor psbt: %w", err)
+	}
+
+	return anchorPsbt, nil
+}
+
 // insertAssetTransferInput inserts a new asset transfer input into the DB.
 func insertAssetTransferInput(ctx context.Context, q ActiveAssetsStore,
 	transferID int64, input tapfreighter.TransferInput,
@@ -2113,10 +2311,14 @@ func insertAssetTransferOutput(ctx context.Context, q ActiveAssetsStore,
 	scriptInternalKey := keychain.KeyDescriptor{
 		PubKey: output.ScriptKey.PubKey,
 	}
-	var tweak []byte
+	var (
+		tweak     []byte
+		watchOnly bool
+	)
 	if output.ScriptKey.TweakedScriptKey != nil {
 		scriptInternalKey = output.ScriptKey.RawKey
 		tweak = output.ScriptKey.Tweak
+		watchOnly = output.ScriptKey.WatchOnly
 	}
 	scriptInternalKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
 		RawKey:    scriptInternalKey.PubKey.SerializeCompressed(),
@@ -2131,6 +2333,7 @@ func insertAssetTransferOutput(ctx context.Context, q ActiveAssetsStore,
 		InternalKeyID:    scriptInternalKeyID,
 		TweakedScriptKey: output.ScriptKey.PubKey.SerializeCompressed(),
 		Tweak:            tweak,
+		WatchOnly:        watchOnly,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to insert script key: %w", err)
@@ -2148,6 +2351,10 @@ func insertAssetTransferOutput(ctx context.Context, q ActiveAssetsStore,
 		NumPassiveAssets:    int32(output.Anchor.NumPassiveAssets),
 		OutputType:          int16(output.Type),
 		ProofCourierAddr:    output.ProofCourierAddr,
+		FallbackProofCourierAddrs: encodeCourierAddrs(
+			output.FallbackProofCourierAddrs,
+		),
+		RecipientAddr: sqlStr(output.RecipientAddr),
 	}
 
 	// There might not have been a split, so we can't rely on the split root
@@ -2229,6 +2436,13 @@ func fetchAssetTransferOutputs(ctx context.Context, q ActiveAssetsStore,
 				err)
 		}
 
+		fallbackCourierAddrs, err := parseCourierAddrs(
+			dbOut.FallbackProofCourierAddrs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
 		outputs[idx] = tapfreighter.TransferOutput{
 			Anchor: tapfreighter.Anchor{
 				Value: btcutil.Amount(
@@ -2273,6 +2487,9 @@ func fetchAssetTransferOutputs(ctx context.Context, q ActiveAssetsStore,
 			ProofSuffix:      dbOut.ProofSuffix,
 			Type:             tappsbt.VOutputType(dbOut.OutputType),
 			ProofCourierAddr: dbOut.ProofCourierAddr,
+
+			FallbackProofCourierAddrs: fallbackCourierAddrs,
+			RecipientAddr:             dbOut.RecipientAddr.String,
 		}
 
 		err = readOutPoint(
@@ -2709,17 +2926,330 @@ func (a *AssetStore) reAnchorPassiveAssets(ctx context.Context,
 	return nil
 }
 
+// ReplacePendingParcelAnchorTx replaces the anchor transaction of the pending
+// parcel anchored by the transaction with the given hash. The anchor outpoints
+// and proof suffixes of the transfer outputs are updated to the ones of the
+// given parcel, which must list its outputs in the same order as they were
+// originally logged. The re-anchor proofs of any passive assets are updated to
+// reference the new anchor transaction.
+func (a *AssetStore) ReplacePendingParcelAnchorTx(ctx context.Context,
+	prevAnchorTXID chainhash.Hash,
+	parcel *tapfreighter.OutboundParcel) error {
+
+	newAnchorTXID := parcel.AnchorTx.TxHash()
+	var txBuf bytes.Buffer
+	if err := parcel.AnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+	anchorTxBytes := txBuf.Bytes()
+
+	anchorPsbtBytes, err := encodeAnchorPsbt(parcel.AnchorPsbt)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: prevAnchorTXID[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(assetTransfers) != 1 {
+			return fmt.Errorf("no pending transfer with anchor "+
+				"tx %v found", prevAnchorTXID)
+		}
+		transferID := assetTransfers[0].ID
+
+		// We'll insert the replacement transaction first, so the
+		// transfer and its outputs can reference it.
+		txnID, err := q.UpsertChainTx(ctx, ChainTxParams{
+			Txid:      newAnchorTXID[:],
+			RawTx:     anchorTxBytes,
+			ChainFees: parcel.ChainFees,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert new chain "+
+				"tx: %w", err)
+		}
+
+		err = q.ReplaceTransferAnchorTx(ctx, ReplaceAnchorTxParams{
+			AnchorTxid: newAnchorTXID[:],
+			AnchorPsbt: anchorPsbtBytes,
+			TransferID: transferID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to replace anchor tx: %w",
+				err)
+		}
+
+		outputs, err := q.FetchTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer outputs: "+
+				"%w", err)
+		}
+		if len(outputs) != len(parcel.Outputs) {
+			return fmt.Errorf("replacement has %d outputs, "+
+				"transfer has %d", len(parcel.Outputs),
+				len(outputs))
+		}
+
+		for idx := range outputs {
+			var (
+				dbOut  = outputs[idx]
+				newOut = parcel.Outputs[idx]
+			)
+
+			anchorPointBytes, err := encodeOutpoint(
+				newOut.Anchor.OutPoint,
+			)
+			if err != nil {
+				return err
+			}
+
+			// Multiple outputs can share the same anchor UTXO, in
+			// which case we'll just update it multiple times with
+			// the same values.
+			err = q.ReAnchorManagedUTXO(ctx, ReAnchorUTXOParams{
+				Outpoint: anchorPointBytes,
+				AmtSats:  int64(newOut.Anchor.Value),
+				TxnID:    txnID,
+				UtxoID:   dbOut.AnchorUtxoID,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to re-anchor "+
+					"managed utxo: %w", err)
+			}
+
+			err = q.UpdateTransferOutputProofSuffix(
+				ctx, OutputProofSuffix{
+					ProofSuffix: newOut.ProofSuffix,
+					OutputID:    dbOut.OutputID,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update proof "+
+					"suffix: %w", err)
+			}
+		}
+
+		// Finally, the proofs of the passive assets also commit to the
+		// anchor transaction, so we'll need to update them as well.
+		passiveAssets, err := q.QueryPassiveAssets(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to query passive assets: %w",
+				err)
+		}
+		for idx := range passiveAssets {
+			var newProof proof.Proof
+			err := newProof.Decode(
+				bytes.NewReader(passiveAssets[idx].NewProof),
+			)
+			if err != nil {
+				return fmt.Errorf("unable to decode passive "+
+					"asset proof: %w", err)
+			}
+
+			err = tapfreighter.UpdateProofAnchorTx(
+				&newProof, parcel.AnchorTx,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update passive "+
+					"asset proof: %w", err)
+			}
+
+			var newProofBuf bytes.Buffer
+			if err := newProof.Encode(&newProofBuf); err != nil {
+				return fmt.Errorf("unable to encode passive "+
+					"asset proof: %w", err)
+			}
+
+			err = q.UpdatePassiveAssetProof(ctx, PassiveAssetProof{
+				NewProof:  newProofBuf.Bytes(),
+				PassiveID: passiveAssets[idx].PassiveID,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to store passive "+
+					"asset proof: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// UpdatePendingParcelCourier replaces the proof courier address of all
+// outputs of the pending parcel with the given anchor transaction hash that
+// don't go to our own node.
+func (a *AssetStore) UpdatePendingParcelCourier(ctx context.Context,
+	anchorTXID chainhash.Hash, courierAddr []byte) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: anchorTXID[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(assetTransfers) != 1 {
+			return fmt.Errorf("no pending transfer with anchor "+
+				"tx %v found", anchorTXID)
+		}
+
+		err = q.UpdateTransferOutputsProofCourierAddr(
+			ctx, OutputsCourierAddr{
+				ProofCourierAddr: courierAddr,
+				TransferID:       assetTransfers[0].ID,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to update proof courier "+
+				"address: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// MarkPendingParcelCancelling stores the transaction that double spends the
+// anchor transaction of the pending parcel anchored by the transaction with the
+// given hash. The parcel itself is kept until the cancel transaction confirms.
+func (a *AssetStore) MarkPendingParcelCancelling(ctx context.Context,
+	anchorTXID chainhash.Hash, cancelTx *wire.MsgTx) error {
+
+	var txBuf bytes.Buffer
+	if err := cancelTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: anchorTXID[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(assetTransfers) != 1 {
+			return fmt.Errorf("no pending transfer with anchor "+
+				"tx %v found", anchorTXID)
+		}
+
+		err = q.SetTransferCancelTx(ctx, TransferCancelTx{
+			CancelTx:   txBuf.Bytes(),
+			TransferID: assetTransfers[0].ID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to store cancel tx: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// CancelPendingParcel removes the pending parcel anchored by the transaction
+// with the given hash from disk, after its anchor transaction was double
+// spent. The new anchor UTXOs of the parcel are deleted and the leases
+// on its input UTXOs are released, so the assets they anchor can be spent
+// again.
+func (a *AssetStore) CancelPendingParcel(ctx context.Context,
+	anchorTXID chainhash.Hash) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: anchorTXID[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(assetTransfers) != 1 {
+			return fmt.Errorf("no pending transfer with anchor "+
+				"tx %v found", anchorTXID)
+		}
+		transferID := assetTransfers[0].ID
+
+		inputs, err := q.FetchTransferInputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer inputs: %w",
+				err)
+		}
+		outputs, err := q.FetchTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer outputs: "+
+				"%w", err)
+		}
+
+		err = q.DeleteTransferPassiveAssets(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete passive assets: %w",
+				err)
+		}
+		err = q.DeleteTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer outputs: "+
+				"%w", err)
+		}
+		err = q.DeleteTransferInputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer inputs: "+
+				"%w", err)
+		}
+		err = q.DeleteAssetTransfer(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete asset transfer: %w",
+				err)
+		}
+
+		// The anchor UTXOs of the outputs were only created for the
+		// transfer. Multiple outputs can share an anchor UTXO, and our
+		// own addresses might already reference it, in which case it
+		// is kept.
+		for idx := range outputs {
+			err = q.DeleteUnreferencedManagedUTXO(
+				ctx, outputs[idx].AnchorUtxoID,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to delete anchor "+
+					"utxo: %w", err)
+			}
+		}
+
+		// Finally, the inputs can be selected again.
+		for idx := range inputs {
+			err = q.DeleteUTXOLease(ctx, inputs[idx].AnchorPoint)
+			if err != nil {
+				return fmt.Errorf("unable to release input "+
+					"utxo: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
 // PendingParcels returns the set of parcels that haven't yet been finalized.
 // This can be used to query the set of unconfirmed
 // transactions for re-broadcast.
 func (a *AssetStore) PendingParcels(
 	ctx context.Context) ([]*tapfreighter.OutboundParcel, error) {
 
-	return a.QueryParcels(ctx, true)
+	return a.QueryParcels(ctx, "", true)
 }
 
-// QueryParcels returns the set of confirmed or unconfirmed parcels.
-func (a *AssetStore) QueryParcels(ctx context.Context,
+// QueryParcels returns the set of confirmed or unconfirmed parcels. If a label
+// is given, only the parcels with that label are returned.
+func (a *AssetStore) QueryParcels(ctx context.Context, label string,
 	pending bool) ([]*tapfreighter.OutboundParcel, error) {
 
 	var transfers []*tapfreighter.OutboundParcel
@@ -2730,6 +3260,7 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 		// the UnconfOnly field.
 		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
 			UnconfOnly: pending,
+			Label:      sqlStr(label),
 		})
 		if err != nil {
 			return err
@@ -2774,13 +3305,34 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 					"anchor tx: %w", err)
 			}
 
+			anchorPsbt, err := decodeAnchorPsbt(dbT.AnchorPsbt)
+			if err != nil {
+				return err
+			}
+
+			var cancelTx *wire.MsgTx
+			if len(dbT.CancelTx) > 0 {
+				cancelTx = wire.NewMsgTx(2)
+				err = cancelTx.Deserialize(bytes.NewReader(
+					dbT.CancelTx,
+				))
+				if err != nil {
+					return fmt.Errorf("unable to "+
+						"deserialize cancel tx: %w",
+						err)
+				}
+			}
+
 			transfer := &tapfreighter.OutboundParcel{
 				AnchorTx:           anchorTx,
 				AnchorTxHeightHint: uint32(dbT.HeightHint),
 				TransferTime:       dbT.TransferTimeUnix.UTC(),
 				ChainFees:          dbAnchorTx.ChainFees,
+				AnchorPsbt:         anchorPsbt,
+				CancelTx:           cancelTx,
 				Inputs:             inputs,
 				Outputs:            outputs,
+				Label:              dbT.Label.String,
 			}
 			transfers = append(transfers, transfer)
 		}
@@ -2858,6 +3410,49 @@ func (a *AssetStore) FetchAssetMetaByHash(ctx context.Context,
 	return assetMeta, nil
 }
 
+// ErrMetaBlobNotFound is returned when a meta data blob isn't found.
+var ErrMetaBlobNotFound = fmt.Errorf("meta blob not found")
+
+// StoreMetaBlob stores the meta data blob of a meta blob reference, so it can
+// be served to others. The blob must match the reference.
+func (a *AssetStore) StoreMetaBlob(ctx context.Context, ref *proof.MetaBlobRef,
+	blob []byte) error {
+
+	if err := ref.VerifyBlob(blob); err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.UpsertMetaBlob(ctx, sqlc.UpsertMetaBlobParams{
+			BlobHash: ref.Hash[:],
+			Blob:     blob,
+		})
+	})
+}
+
+// FetchMetaBlob fetches the meta data blob with the given hash.
+func (a *AssetStore) FetchMetaBlob(ctx context.Context,
+	blobHash [sha256.Size]byte) ([]byte, error) {
+
+	var blob []byte
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		blob, err = q.FetchMetaBlob(ctx, blobHash[:])
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, ErrMetaBlobNotFound
+	case dbErr != nil:
+		return nil, dbErr
+	}
+
+	return blob, nil
+}
+
 // A compile-time constraint to ensure that AssetStore meets the
 // proof.NotifyArchiver interface.
 var _ proof.NotifyArchiver = (*AssetStore)(nil)
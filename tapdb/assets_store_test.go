@@ -4,16 +4,20 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"database/sql"
 	"math/rand"
+	"net/url"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -1115,6 +1119,95 @@ func TestSelectCommitment(t *testing.T) {
 	}
 }
 
+// watchOnlyScriptKey returns a script key that commits to a tapscript tree on
+// top of the NUMS key, which the wallet can't sign for.
+func watchOnlyScriptKey(t *testing.T) *asset.ScriptKey {
+	scriptKey, err := asset.NewScriptKeyTapscript(
+		keychain.KeyDescriptor{
+			PubKey: asset.NUMSPubKey,
+		}, []txscript.TapLeaf{
+			txscript.NewBaseTapLeaf(test.RandBytes(32)),
+		},
+	)
+	require.NoError(t, err)
+
+	scriptKey.WatchOnly = true
+
+	return &scriptKey
+}
+
+// TestWatchOnlyAssets tests that assets locked to watch only script keys are
+// listed along with all other assets, but are neither selected as coins nor
+// counted towards the balances.
+func TestWatchOnlyAssets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assetGen := newAssetGenerator(t, 1, 1)
+	watchOnlyKey := watchOnlyScriptKey(t)
+
+	_, assetsStore, _ := newAssetStore(t)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		keyGroup:    assetGen.groupKeys[0],
+		amt:         6,
+	}, {
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		keyGroup:    assetGen.groupKeys[0],
+		scriptKey:   watchOnlyKey,
+		amt:         10,
+	}})
+
+	// Both assets are listed, and the watch only one is marked as such.
+	assets, err := assetsStore.FetchAllAssets(ctx, false, false, nil)
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+
+	var numWatchOnly int
+	for _, a := range assets {
+		require.NotNil(t, a.ScriptKey.TweakedScriptKey)
+		if !a.ScriptKey.WatchOnly {
+			continue
+		}
+
+		numWatchOnly++
+		require.True(t, a.ScriptKey.PubKey.IsEqual(watchOnlyKey.PubKey))
+		require.Equal(t, watchOnlyKey.Tweak, a.ScriptKey.Tweak)
+	}
+	require.Equal(t, 1, numWatchOnly)
+
+	// But only the asset we can sign for is selected as a coin.
+	assetID := assetGen.bindAssetID(0, assetGen.anchorPoints[0])
+	coins, err := assetsStore.ListEligibleCoins(
+		ctx, tapfreighter.CommitmentConstraints{
+			AssetID: assetID,
+			MinAmt:  1,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, coins, 1)
+	require.False(t, coins[0].Asset.ScriptKey.WatchOnly)
+	require.EqualValues(t, 6, coins[0].Asset.Amount)
+
+	// And only that asset counts towards the balances.
+	balances, err := assetsStore.QueryBalancesByAsset(ctx, assetID)
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	require.EqualValues(t, 6, balances[*assetID].Balance)
+
+	groupKey := assetGen.bindKeyGroup(0, assetGen.anchorPoints[0])
+	groupBalances, err := assetsStore.QueryAssetBalancesByGroup(
+		ctx, groupKey,
+	)
+	require.NoError(t, err)
+	require.Len(t, groupBalances, 1)
+	require.EqualValues(
+		t, 6, groupBalances[asset.ToSerialized(groupKey)].Balance,
+	)
+}
+
 // TestAssetExportLog tests that were able to properly spend/transfer assets on
 // disk. This ensures we can properly commit the end result of an asset
 // transfer initiated at a higher level.
@@ -1502,6 +1595,440 @@ func TestAssetExportLog(t *testing.T) {
 	require.Equal(t, 0, len(parcels))
 }
 
+// TestReplacePendingParcelAnchorTx tests that the anchor transaction of a
+// pending parcel can be replaced, moving its outputs to the new transaction.
+func TestReplacePendingParcelAnchorTx(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	inputAsset := allAssets[0]
+
+	newAnchorTx := func(changeValue int64) *wire.MsgTx {
+		anchorTx := wire.NewMsgTx(2)
+		anchorTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: assetGen.anchorPoints[0],
+		})
+		anchorTx.AddTxOut(&wire.TxOut{
+			PkScript: bytes.Repeat([]byte{0x01}, 34),
+			Value:    1000,
+		})
+		anchorTx.AddTxOut(&wire.TxOut{
+			PkScript: bytes.Repeat([]byte{0x02}, 34),
+			Value:    changeValue,
+		})
+
+		return anchorTx
+	}
+	newAnchorPsbt := func(anchorTx *wire.MsgTx) *psbt.Packet {
+		anchorPsbt, err := psbt.NewFromUnsignedTx(anchorTx.Copy())
+		require.NoError(t, err)
+
+		return anchorPsbt
+	}
+	requirePsbtEqual := func(expected, actual *psbt.Packet) {
+		expectedB64, err := expected.B64Encode()
+		require.NoError(t, err)
+		actualB64, err := actual.B64Encode()
+		require.NoError(t, err)
+
+		require.Equal(t, expectedB64, actualB64)
+	}
+
+	anchorTx := newAnchorTx(5000)
+	anchorTxHash := anchorTx.TxHash()
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		ChainFees:          100,
+		AnchorPsbt:         newAnchorPsbt(anchorTx),
+		Label:              "rent",
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:      asset.RandScriptKey(t),
+			ScriptKeyLocal: true,
+			Amount:         inputAsset.Amount,
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}},
+			}},
+			ProofSuffix: bytes.Repeat([]byte{0x01}, 100),
+		}},
+	}
+	leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, leaseOwner, time.Now().Add(time.Hour),
+	))
+
+	// The anchor PSBT should be stored along with the transfer.
+	parcels, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	requirePsbtEqual(parcel.AnchorPsbt, parcels[0].AnchorPsbt)
+
+	// Now we'll replace the anchor transaction with one that pays a higher
+	// fee by reducing the change output.
+	replacementTx := newAnchorTx(4000)
+	replacementTxHash := replacementTx.TxHash()
+	replacement := *parcel
+	replacement.AnchorTx = replacementTx
+	replacement.AnchorPsbt = newAnchorPsbt(replacementTx)
+	replacement.ChainFees = 1100
+	replacement.Outputs = []tapfreighter.TransferOutput{parcel.Outputs[0]}
+	replacement.Outputs[0].Anchor.OutPoint.Hash = replacementTxHash
+	replacement.Outputs[0].ProofSuffix = bytes.Repeat([]byte{0x02}, 100)
+
+	require.NoError(t, assetsStore.ReplacePendingParcelAnchorTx(
+		ctx, anchorTxHash, &replacement,
+	))
+
+	// The pending parcel should now reference the replacement.
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+
+	dbParcel := parcels[0]
+	require.Equal(t, replacementTxHash, dbParcel.AnchorTx.TxHash())
+	require.Equal(t, replacement.ChainFees, dbParcel.ChainFees)
+	requirePsbtEqual(replacement.AnchorPsbt, dbParcel.AnchorPsbt)
+	require.Len(t, dbParcel.Outputs, 1)
+	require.Equal(
+		t, replacement.Outputs[0].Anchor.OutPoint,
+		dbParcel.Outputs[0].Anchor.OutPoint,
+	)
+	require.Equal(
+		t, replacement.Outputs[0].ProofSuffix,
+		dbParcel.Outputs[0].ProofSuffix,
+	)
+
+	// The label of the transfer is kept, so it can still be found by it.
+	require.Equal(t, parcel.Label, dbParcel.Label)
+	parcels, err = assetsStore.QueryParcels(ctx, parcel.Label, false)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	parcels, err = assetsStore.QueryParcels(ctx, "groceries", false)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	// The transfer can no longer be found by the replaced transaction.
+	assetTransfers, err := db.QueryAssetTransfers(ctx, TransferQuery{
+		AnchorTxHash: anchorTxHash[:],
+	})
+	require.NoError(t, err)
+	require.Empty(t, assetTransfers)
+
+	// Replacing it again is therefore not possible.
+	err = assetsStore.ReplacePendingParcelAnchorTx(
+		ctx, anchorTxHash, &replacement,
+	)
+	require.ErrorContains(t, err, "no pending transfer")
+}
+
+// TestUpdatePendingParcelCourier tests that the proof courier address of only
+// the outputs of a pending parcel that go to other nodes can be replaced.
+func TestUpdatePendingParcelCourier(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	inputAsset := allAssets[0]
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: assetGen.anchorPoints[0],
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	newOutput := func(amount uint64, local bool,
+		courierAddr string) tapfreighter.TransferOutput {
+
+		return tapfreighter.TransferOutput{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:        asset.RandScriptKey(t),
+			ScriptKeyLocal:   local,
+			Amount:           amount,
+			ProofSuffix:      bytes.Repeat([]byte{0x01}, 100),
+			ProofCourierAddr: []byte(courierAddr),
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}},
+			}},
+		}
+	}
+
+	const (
+		deadCourier = "hashmail://dead.example.com:443"
+		newCourier  = "universerpc://alive.example.com:10029"
+	)
+	fallbackCourier := address.RandProofCourierAddr(t)
+	remoteOutput := newOutput(10, false, deadCourier)
+	remoteOutput.FallbackProofCourierAddrs = []url.URL{fallbackCourier}
+	remoteOutput.RecipientAddr = "taprt1recipient"
+
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{
+			newOutput(6, true, ""),
+			remoteOutput,
+		},
+	}
+	leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, leaseOwner, time.Now().Add(time.Hour),
+	))
+
+	require.NoError(t, assetsStore.UpdatePendingParcelCourier(
+		ctx, anchorTxHash, []byte(newCourier),
+	))
+
+	parcels, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.Len(t, parcels[0].Outputs, 2)
+	require.Empty(t, parcels[0].Outputs[0].ProofCourierAddr)
+	require.Equal(
+		t, []byte(newCourier), parcels[0].Outputs[1].ProofCourierAddr,
+	)
+
+	// The fallback couriers of the receiver's address are kept.
+	require.Empty(t, parcels[0].Outputs[0].FallbackProofCourierAddrs)
+	require.Equal(
+		t, []url.URL{fallbackCourier},
+		parcels[0].Outputs[1].FallbackProofCourierAddrs,
+	)
+
+	// So is the address the remote output pays to.
+	require.Empty(t, parcels[0].Outputs[0].RecipientAddr)
+	require.Equal(
+		t, remoteOutput.RecipientAddr,
+		parcels[0].Outputs[1].RecipientAddr,
+	)
+
+	// Unknown transfers can't be updated.
+	err = assetsStore.UpdatePendingParcelCourier(
+		ctx, chainhash.Hash{1}, []byte(newCourier),
+	)
+	require.ErrorContains(t, err, "no pending transfer")
+}
+
+// TestCancelPendingParcel tests that a cancelled pending parcel is removed from
+// disk, along with its anchor UTXOs, and that its inputs can be spent again.
+func TestCancelPendingParcel(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	inputAsset := allAssets[0]
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: assetGen.anchorPoints[0],
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	// Both outputs share the same anchor UTXO.
+	newOutput := func(amount uint64) tapfreighter.TransferOutput {
+		return tapfreighter.TransferOutput{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:      asset.RandScriptKey(t),
+			ScriptKeyLocal: true,
+			Amount:         amount,
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}},
+			}},
+			ProofSuffix: bytes.Repeat([]byte{0x01}, 100),
+		}
+	}
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		ChainFees:          100,
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{
+			newOutput(6), newOutput(10),
+		},
+	}
+	leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, leaseOwner, time.Now().Add(time.Hour),
+	))
+
+	inputPoint, err := encodeOutpoint(assetGen.anchorPoints[0])
+	require.NoError(t, err)
+	outputPoint, err := encodeOutpoint(parcel.Outputs[0].Anchor.OutPoint)
+	require.NoError(t, err)
+
+	inputUtxo, err := db.FetchManagedUTXO(ctx, UtxoQuery{
+		Outpoint: inputPoint,
+	})
+	require.NoError(t, err)
+	require.Equal(t, leaseOwner[:], inputUtxo.LeaseOwner)
+
+	_, err = db.FetchManagedUTXO(ctx, UtxoQuery{
+		Outpoint: outputPoint,
+	})
+	require.NoError(t, err)
+
+	// Marking the transfer as cancelling stores the cancel transaction,
+	// but keeps the transfer and the lease on its input.
+	cancelTx := wire.NewMsgTx(2)
+	cancelTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	cancelTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x02}, 34),
+		Value:    900,
+	})
+	require.NoError(t, assetsStore.MarkPendingParcelCancelling(
+		ctx, anchorTxHash, cancelTx,
+	))
+
+	parcels, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.Equal(t, cancelTx.TxHash(), parcels[0].CancelTx.TxHash())
+
+	inputUtxo, err = db.FetchManagedUTXO(ctx, UtxoQuery{
+		Outpoint: inputPoint,
+	})
+	require.NoError(t, err)
+	require.Equal(t, leaseOwner[:], inputUtxo.LeaseOwner)
+
+	require.NoError(t, assetsStore.CancelPendingParcel(ctx, anchorTxHash))
+
+	// The transfer is gone, along with the anchor UTXO of its outputs.
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	_, err = db.FetchManagedUTXO(ctx, UtxoQuery{
+		Outpoint: outputPoint,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// The input is no longer leased, while its asset is left untouched.
+	inputUtxo, err = db.FetchManagedUTXO(ctx, UtxoQuery{
+		Outpoint: inputPoint,
+	})
+	require.NoError(t, err)
+	require.Nil(t, inputUtxo.LeaseOwner)
+	require.False(t, inputUtxo.LeaseExpiry.Valid)
+
+	allAssets, err = assetsStore.FetchAllAssets(ctx, true, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 1)
+	require.Equal(t, inputAsset.Amount, allAssets[0].Amount)
+
+	// Cancelling the transfer again is not possible.
+	err = assetsStore.CancelPendingParcel(ctx, anchorTxHash)
+	require.ErrorContains(t, err, "no pending transfer")
+}
+
 // TestAssetGroupSigUpsert tests that if you try to insert another asset
 // group sig with the same asset_gen_id, then only one is actually created.
 func TestAssetGroupSigUpsert(t *testing.T) {
@@ -1729,3 +2256,33 @@ func TestFetchGroupedAssets(t *testing.T) {
 	equalityCheck(allAssets[2].Asset, groupedAssets[1])
 	equalityCheck(allAssets[3].Asset, groupedAssets[2])
 }
+
+// TestMetaBlobs tests that meta data blobs committed to by reference can be
+// stored and fetched, and that only blobs matching their reference are
+// accepted.
+func TestMetaBlobs(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	blob := test.RandBytes(1024)
+	ref, err := proof.NewMetaBlobRef(blob, "")
+	require.NoError(t, err)
+
+	// A blob that isn't stored yet can't be found.
+	_, err = assetsStore.FetchMetaBlob(ctx, ref.Hash)
+	require.ErrorIs(t, err, ErrMetaBlobNotFound)
+
+	// A blob that doesn't match the reference is rejected.
+	err = assetsStore.StoreMetaBlob(ctx, ref, test.RandBytes(1024))
+	require.ErrorIs(t, err, proof.ErrMetaBlobMismatch)
+
+	// Storing the blob is idempotent.
+	require.NoError(t, assetsStore.StoreMetaBlob(ctx, ref, blob))
+	require.NoError(t, assetsStore.StoreMetaBlob(ctx, ref, blob))
+
+	dbBlob, err := assetsStore.FetchMetaBlob(ctx, ref.Hash)
+	require.NoError(t, err)
+	require.Equal(t, blob, dbBlob)
+}
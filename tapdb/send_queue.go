@@ -0,0 +1,171 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// A compile-time assertion to ensure AssetStore implements the send queue
+// store interface.
+var _ tapfreighter.SendQueueStore = (*AssetStore)(nil)
+
+// sqlSendAfter turns the optional send time of a queued send into its nullable
+// database representation.
+func sqlSendAfter(sendAfter time.Time) sql.NullTime {
+	if sendAfter.IsZero() {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{
+		Time:  sendAfter.UTC(),
+		Valid: true,
+	}
+}
+
+// QueueSend stores a new queued send and returns its ID.
+func (a *AssetStore) QueueSend(ctx context.Context,
+	send *tapfreighter.QueuedSend) (int64, error) {
+
+	var sendID int64
+
+	var writeTxOpts AssetStoreTxOptions
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		var err error
+		sendID, err = q.InsertQueuedSend(ctx, NewQueuedSend{
+			CoinSelectStrategy: send.CoinSelectStrategy.String(),
+			SendAfterUnix:      sqlSendAfter(send.SendAfter),
+			MaxFeeRate:         int64(send.MaxFeeRate),
+			CreationTimeUnix:   send.CreationTime.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert queued send: %w",
+				err)
+		}
+
+		for _, tapAddr := range send.TapAddrs {
+			err := q.InsertQueuedSendAddr(ctx, NewQueuedSendAddr{
+				QueuedSendID: sendID,
+				TapAddr:      tapAddr,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert queued "+
+					"send addr: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return sendID, nil
+}
+
+// QueuedSends returns all queued sends, in the order they were queued.
+func (a *AssetStore) QueuedSends(
+	ctx context.Context) ([]*tapfreighter.QueuedSend, error) {
+
+	var sends []*tapfreighter.QueuedSend
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbSends, err := q.FetchQueuedSends(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch queued sends: %w",
+				err)
+		}
+
+		sends = make([]*tapfreighter.QueuedSend, 0, len(dbSends))
+		for _, dbSend := range dbSends {
+			tapAddrs, err := q.FetchQueuedSendAddrs(
+				ctx, dbSend.QueuedSendID,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch queued "+
+					"send addrs: %w", err)
+			}
+
+			strategy, err := tapfreighter.ParseCoinSelectStrategy(
+				dbSend.CoinSelectStrategy,
+			)
+			if err != nil {
+				return err
+			}
+
+			send := &tapfreighter.QueuedSend{
+				ID:                 dbSend.QueuedSendID,
+				TapAddrs:           tapAddrs,
+				CoinSelectStrategy: strategy,
+				MaxFeeRate: chainfee.SatPerKWeight(
+					dbSend.MaxFeeRate,
+				),
+				CreationTime: dbSend.CreationTimeUnix.UTC(),
+				LastError:    dbSend.LastError.String,
+				Failed:       dbSend.Failed,
+			}
+			if dbSend.SendAfterUnix.Valid {
+				send.SendAfter = dbSend.SendAfterUnix.Time.UTC()
+			}
+
+			sends = append(sends, send)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return sends, nil
+}
+
+// UpdateQueuedSend updates the conditions, the last error and the failed flag
+// of the given queued send. tapfreighter.ErrQueuedSendNotFound is returned if
+// the send does not exist.
+func (a *AssetStore) UpdateQueuedSend(ctx context.Context,
+	send *tapfreighter.QueuedSend) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		numRows, err := q.UpdateQueuedSend(ctx, QueuedSendUpdate{
+			SendAfterUnix: sqlSendAfter(send.SendAfter),
+			MaxFeeRate:    int64(send.MaxFeeRate),
+			LastError:     sqlStr(send.LastError),
+			Failed:        send.Failed,
+			QueuedSendID:  send.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update queued send: %w",
+				err)
+		}
+		if numRows == 0 {
+			return tapfreighter.ErrQueuedSendNotFound
+		}
+
+		return nil
+	})
+}
+
+// DeleteQueuedSend deletes the queued send with the given ID.
+// tapfreighter.ErrQueuedSendNotFound is returned if the send does not exist.
+func (a *AssetStore) DeleteQueuedSend(ctx context.Context, id int64) error {
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		numRows, err := q.DeleteQueuedSend(ctx, id)
+		if err != nil {
+			return fmt.Errorf("unable to delete queued send: %w",
+				err)
+		}
+		if numRows == 0 {
+			return tapfreighter.ErrQueuedSendNotFound
+		}
+
+		return nil
+	})
+}
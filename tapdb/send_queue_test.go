@@ -0,0 +1,69 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueuedSends tests that queued sends can be stored, updated and deleted.
+func TestQueuedSends(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	sends, err := assetsStore.QueuedSends(ctx)
+	require.NoError(t, err)
+	require.Empty(t, sends)
+
+	creationTime := time.Unix(1_700_000_000, 0).UTC()
+	timedSend := &tapfreighter.QueuedSend{
+		TapAddrs:           []string{"addr1", "addr2"},
+		CoinSelectStrategy: tapfreighter.PreferNoMerge,
+		SendAfter:          creationTime.Add(time.Hour),
+		CreationTime:       creationTime,
+	}
+	feeSend := &tapfreighter.QueuedSend{
+		TapAddrs:     []string{"addr3"},
+		MaxFeeRate:   2500,
+		CreationTime: creationTime,
+	}
+
+	timedSend.ID, err = assetsStore.QueueSend(ctx, timedSend)
+	require.NoError(t, err)
+	feeSend.ID, err = assetsStore.QueueSend(ctx, feeSend)
+	require.NoError(t, err)
+
+	sends, err = assetsStore.QueuedSends(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapfreighter.QueuedSend{timedSend, feeSend}, sends)
+
+	// Updating a send replaces its conditions, error and failed flag.
+	feeSend.SendAfter = creationTime.Add(time.Minute)
+	feeSend.MaxFeeRate = 0
+	feeSend.LastError = "unable to publish transaction"
+	feeSend.Failed = true
+	require.NoError(t, assetsStore.UpdateQueuedSend(ctx, feeSend))
+
+	sends, err = assetsStore.QueuedSends(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapfreighter.QueuedSend{timedSend, feeSend}, sends)
+
+	// Deleting a send also removes its addresses, and the send can't be
+	// modified or deleted anymore.
+	require.NoError(t, assetsStore.DeleteQueuedSend(ctx, timedSend.ID))
+
+	sends, err = assetsStore.QueuedSends(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapfreighter.QueuedSend{feeSend}, sends)
+
+	err = assetsStore.UpdateQueuedSend(ctx, timedSend)
+	require.ErrorIs(t, err, tapfreighter.ErrQueuedSendNotFound)
+
+	err = assetsStore.DeleteQueuedSend(ctx, timedSend.ID)
+	require.ErrorIs(t, err, tapfreighter.ErrQueuedSendNotFound)
+}
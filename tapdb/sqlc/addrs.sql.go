@@ -11,13 +11,40 @@ import (
 	"time"
 )
 
+const countScriptKeyReceipts = `-- name: CountScriptKeyReceipts :one
+SELECT
+    (
+        SELECT COUNT(*)
+        FROM asset_transfer_outputs outputs
+        JOIN script_keys keys
+            ON outputs.script_key = keys.script_key_id
+        WHERE keys.tweaked_script_key = $1
+    ) + (
+        SELECT COUNT(*)
+        FROM addr_events events
+        JOIN addrs
+            ON events.addr_id = addrs.id
+        JOIN script_keys keys
+            ON addrs.script_key_id = keys.script_key_id
+        WHERE keys.tweaked_script_key = $1
+    ) AS num_receipts
+`
+
+func (q *Queries) CountScriptKeyReceipts(ctx context.Context, tweakedScriptKey []byte) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countScriptKeyReceipts, tweakedScriptKey)
+	var num_receipts int32
+	err := row.Scan(&num_receipts)
+	return num_receipts, err
+}
+
 const fetchAddrByTaprootOutputKey = `-- name: FetchAddrByTaprootOutputKey :one
 SELECT
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
     taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    proof_courier_addr, fallback_proof_courier_addrs,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.watch_only AS script_key_watch_only,
     raw_script_keys.raw_key as raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -35,25 +62,27 @@ WHERE taproot_output_key = $1
 `
 
 type FetchAddrByTaprootOutputKeyRow struct {
-	Version          int16
-	AssetVersion     int16
-	GenesisAssetID   int64
-	GroupKey         []byte
-	TapscriptSibling []byte
-	TaprootOutputKey []byte
-	Amount           int64
-	AssetType        int16
-	CreationTime     time.Time
-	ManagedFrom      sql.NullTime
-	ProofCourierAddr []byte
-	TweakedScriptKey []byte
-	ScriptKeyTweak   []byte
-	RawScriptKey     []byte
-	ScriptKeyFamily  int32
-	ScriptKeyIndex   int32
-	RawTaprootKey    []byte
-	TaprootKeyFamily int32
-	TaprootKeyIndex  int32
+	Version                   int16
+	AssetVersion              int16
+	GenesisAssetID            int64
+	GroupKey                  []byte
+	TapscriptSibling          []byte
+	TaprootOutputKey          []byte
+	Amount                    int64
+	AssetType                 int16
+	CreationTime              time.Time
+	ManagedFrom               sql.NullTime
+	ProofCourierAddr          []byte
+	FallbackProofCourierAddrs []byte
+	TweakedScriptKey          []byte
+	ScriptKeyTweak            []byte
+	ScriptKeyWatchOnly        bool
+	RawScriptKey              []byte
+	ScriptKeyFamily           int32
+	ScriptKeyIndex            int32
+	RawTaprootKey             []byte
+	TaprootKeyFamily          int32
+	TaprootKeyIndex           int32
 }
 
 func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error) {
@@ -71,8 +100,10 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 		&i.CreationTime,
 		&i.ManagedFrom,
 		&i.ProofCourierAddr,
+		&i.FallbackProofCourierAddrs,
 		&i.TweakedScriptKey,
 		&i.ScriptKeyTweak,
+		&i.ScriptKeyWatchOnly,
 		&i.RawScriptKey,
 		&i.ScriptKeyFamily,
 		&i.ScriptKeyIndex,
@@ -85,13 +116,21 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 
 const fetchAddrEvent = `-- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    creation_time, status, asset_proof_id, asset_id, label,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
     managed_utxos.amt_sats as amt_sats,
     managed_utxos.tapscript_sibling as tapscript_sibling,
-    internal_keys.raw_key as internal_key
+    internal_keys.raw_key as internal_key,
+    -- An address is reused if it already received assets in an earlier
+    -- transaction.
+    (
+        SELECT COUNT(*)
+        FROM addr_events prev_events
+        WHERE prev_events.addr_id = addr_events.addr_id
+          AND prev_events.id < addr_events.id
+    ) AS num_prev_events
 FROM addr_events
 LEFT JOIN chain_txns
        ON addr_events.chain_txn_id = chain_txns.txn_id
@@ -99,7 +138,7 @@ LEFT JOIN managed_utxos
        ON addr_events.managed_utxo_id = managed_utxos.utxo_id
 LEFT JOIN internal_keys
        ON managed_utxos.internal_key_id = internal_keys.key_id
-WHERE id = $1
+WHERE addr_events.id = $1
 `
 
 type FetchAddrEventRow struct {
@@ -107,12 +146,14 @@ type FetchAddrEventRow struct {
 	Status             int16
 	AssetProofID       sql.NullInt64
 	AssetID            sql.NullInt64
+	Label              sql.NullString
 	Txid               []byte
 	ConfirmationHeight sql.NullInt32
 	OutputIndex        int32
 	AmtSats            sql.NullInt64
 	TapscriptSibling   []byte
 	InternalKey        []byte
+	NumPrevEvents      int64
 }
 
 func (q *Queries) FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventRow, error) {
@@ -123,12 +164,14 @@ func (q *Queries) FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventR
 		&i.Status,
 		&i.AssetProofID,
 		&i.AssetID,
+		&i.Label,
 		&i.Txid,
 		&i.ConfirmationHeight,
 		&i.OutputIndex,
 		&i.AmtSats,
 		&i.TapscriptSibling,
 		&i.InternalKey,
+		&i.NumPrevEvents,
 	)
 	return i, err
 }
@@ -137,9 +180,10 @@ const fetchAddrs = `-- name: FetchAddrs :many
 SELECT 
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
     taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    proof_courier_addr, fallback_proof_courier_addrs,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.watch_only AS script_key_watch_only,
     raw_script_keys.raw_key AS raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -170,25 +214,27 @@ type FetchAddrsParams struct {
 }
 
 type FetchAddrsRow struct {
-	Version          int16
-	AssetVersion     int16
-	GenesisAssetID   int64
-	GroupKey         []byte
-	TapscriptSibling []byte
-	TaprootOutputKey []byte
-	Amount           int64
-	AssetType        int16
-	CreationTime     time.Time
-	ManagedFrom      sql.NullTime
-	ProofCourierAddr []byte
-	TweakedScriptKey []byte
-	ScriptKeyTweak   []byte
-	RawScriptKey     []byte
-	ScriptKeyFamily  int32
-	ScriptKeyIndex   int32
-	RawTaprootKey    []byte
-	TaprootKeyFamily int32
-	TaprootKeyIndex  int32
+	Version                   int16
+	AssetVersion              int16
+	GenesisAssetID            int64
+	GroupKey                  []byte
+	TapscriptSibling          []byte
+	TaprootOutputKey          []byte
+	Amount                    int64
+	AssetType                 int16
+	CreationTime              time.Time
+	ManagedFrom               sql.NullTime
+	ProofCourierAddr          []byte
+	FallbackProofCourierAddrs []byte
+	TweakedScriptKey          []byte
+	ScriptKeyTweak            []byte
+	ScriptKeyWatchOnly        bool
+	RawScriptKey              []byte
+	ScriptKeyFamily           int32
+	ScriptKeyIndex            int32
+	RawTaprootKey             []byte
+	TaprootKeyFamily          int32
+	TaprootKeyIndex           int32
 }
 
 func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error) {
@@ -218,8 +264,10 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 			&i.CreationTime,
 			&i.ManagedFrom,
 			&i.ProofCourierAddr,
+			&i.FallbackProofCourierAddrs,
 			&i.TweakedScriptKey,
 			&i.ScriptKeyTweak,
+			&i.ScriptKeyWatchOnly,
 			&i.RawScriptKey,
 			&i.ScriptKeyFamily,
 			&i.ScriptKeyIndex,
@@ -244,23 +292,26 @@ const insertAddr = `-- name: InsertAddr :one
 INSERT INTO addrs (
     version, asset_version, genesis_asset_id, group_key, script_key_id,
     taproot_key_id, tapscript_sibling, taproot_output_key, amount, asset_type,
-    creation_time, proof_courier_addr
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id
+    creation_time, proof_courier_addr, fallback_proof_courier_addrs
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING id
 `
 
 type InsertAddrParams struct {
-	Version          int16
-	AssetVersion     int16
-	GenesisAssetID   int64
-	GroupKey         []byte
-	ScriptKeyID      int64
-	TaprootKeyID     int64
-	TapscriptSibling []byte
-	TaprootOutputKey []byte
-	Amount           int64
-	AssetType        int16
-	CreationTime     time.Time
-	ProofCourierAddr []byte
+	Version                   int16
+	AssetVersion              int16
+	GenesisAssetID            int64
+	GroupKey                  []byte
+	ScriptKeyID               int64
+	TaprootKeyID              int64
+	TapscriptSibling          []byte
+	TaprootOutputKey          []byte
+	Amount                    int64
+	AssetType                 int16
+	CreationTime              time.Time
+	ProofCourierAddr          []byte
+	FallbackProofCourierAddrs []byte
 }
 
 func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int64, error) {
@@ -277,6 +328,7 @@ func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int64,
 		arg.AssetType,
 		arg.CreationTime,
 		arg.ProofCourierAddr,
+		arg.FallbackProofCourierAddrs,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -292,6 +344,8 @@ JOIN addrs
 WHERE addr_events.status >= $1 
   AND addr_events.status <= $2
   AND COALESCE($3, addrs.taproot_output_key) = addrs.taproot_output_key
+  AND (addr_events.label = $4 OR
+       $4 IS NULL)
 ORDER by addr_events.creation_time
 `
 
@@ -299,6 +353,7 @@ type QueryEventIDsParams struct {
 	StatusFrom     int16
 	StatusTo       int16
 	AddrTaprootKey []byte
+	Label          sql.NullString
 }
 
 type QueryEventIDsRow struct {
@@ -307,7 +362,12 @@ type QueryEventIDsRow struct {
 }
 
 func (q *Queries) QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error) {
-	rows, err := q.db.QueryContext(ctx, queryEventIDs, arg.StatusFrom, arg.StatusTo, arg.AddrTaprootKey)
+	rows, err := q.db.QueryContext(ctx, queryEventIDs,
+		arg.StatusFrom,
+		arg.StatusTo,
+		arg.AddrTaprootKey,
+		arg.Label,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +389,32 @@ func (q *Queries) QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([
 	return items, nil
 }
 
+const setAddrEventLabel = `-- name: SetAddrEventLabel :execrows
+WITH target_txn(txn_id) AS (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = $3
+)
+UPDATE addr_events
+SET label = $1
+WHERE chain_txn_id = (SELECT txn_id FROM target_txn)
+  AND chain_txn_output_index = $2
+`
+
+type SetAddrEventLabelParams struct {
+	Label               sql.NullString
+	ChainTxnOutputIndex int32
+	Txid                []byte
+}
+
+func (q *Queries) SetAddrEventLabel(ctx context.Context, arg SetAddrEventLabelParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setAddrEventLabel, arg.Label, arg.ChainTxnOutputIndex, arg.Txid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setAddrManaged = `-- name: SetAddrManaged :exec
 WITH target_addr(addr_id) AS (
     SELECT id
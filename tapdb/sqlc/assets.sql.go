@@ -87,7 +87,7 @@ func (q *Queries) AllInternalKeys(ctx context.Context) ([]InternalKey, error) {
 }
 
 const allMintingBatches = `-- name: AllMintingBatches :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index 
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, external_funding, label, reorg_state, num_reorgs, key_id, raw_key, key_family, key_index 
 FROM asset_minting_batches
 JOIN internal_keys 
 ON asset_minting_batches.batch_id = internal_keys.key_id
@@ -101,6 +101,11 @@ type AllMintingBatchesRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	TapscriptSibling  []byte
+	ExternalFunding   bool
+	Label             string
+	ReorgState        int16
+	NumReorgs         int32
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -124,6 +129,11 @@ func (q *Queries) AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow
 			&i.GenesisID,
 			&i.HeightHint,
 			&i.CreationTimeUnix,
+			&i.TapscriptSibling,
+			&i.ExternalFunding,
+			&i.Label,
+			&i.ReorgState,
+			&i.NumReorgs,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -413,6 +423,29 @@ func (q *Queries) ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams)
 	return err
 }
 
+const deleteAssetSeedling = `-- name: DeleteAssetSeedling :exec
+DELETE FROM asset_seedlings
+WHERE seedling_id = $1
+`
+
+func (q *Queries) DeleteAssetSeedling(ctx context.Context, seedlingID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetSeedling, seedlingID)
+	return err
+}
+
+const deleteBatchTemplate = `-- name: DeleteBatchTemplate :execrows
+DELETE FROM batch_templates
+WHERE template_name = $1
+`
+
+func (q *Queries) DeleteBatchTemplate(ctx context.Context, templateName string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteBatchTemplate, templateName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteExpiredUTXOLeases = `-- name: DeleteExpiredUTXOLeases :exec
 UPDATE managed_utxos
 SET lease_owner = NULL, lease_expiry = NULL
@@ -866,6 +899,93 @@ func (q *Queries) FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]Fet
 	return items, nil
 }
 
+const fetchBatchTemplate = `-- name: FetchBatchTemplate :one
+SELECT template_id, template_name, creation_time_unix
+FROM batch_templates
+WHERE template_name = $1
+`
+
+func (q *Queries) FetchBatchTemplate(ctx context.Context, templateName string) (BatchTemplate, error) {
+	row := q.db.QueryRowContext(ctx, fetchBatchTemplate, templateName)
+	var i BatchTemplate
+	err := row.Scan(&i.TemplateID, &i.TemplateName, &i.CreationTimeUnix)
+	return i, err
+}
+
+const fetchBatchTemplateAssets = `-- name: FetchBatchTemplateAssets :many
+SELECT template_asset_id, template_id, asset_name, asset_version, asset_type,
+    asset_supply, decimal_display, meta_data_type, meta_data_blob,
+    emission_enabled, group_key, group_anchor_name
+FROM batch_template_assets
+WHERE template_id = $1
+ORDER BY template_asset_id
+`
+
+func (q *Queries) FetchBatchTemplateAssets(ctx context.Context, templateID int64) ([]BatchTemplateAsset, error) {
+	rows, err := q.db.QueryContext(ctx, fetchBatchTemplateAssets, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BatchTemplateAsset
+	for rows.Next() {
+		var i BatchTemplateAsset
+		if err := rows.Scan(
+			&i.TemplateAssetID,
+			&i.TemplateID,
+			&i.AssetName,
+			&i.AssetVersion,
+			&i.AssetType,
+			&i.AssetSupply,
+			&i.DecimalDisplay,
+			&i.MetaDataType,
+			&i.MetaDataBlob,
+			&i.EmissionEnabled,
+			&i.GroupKey,
+			&i.GroupAnchorName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchBatchTemplates = `-- name: FetchBatchTemplates :many
+SELECT template_id, template_name, creation_time_unix
+FROM batch_templates
+ORDER BY template_name
+`
+
+func (q *Queries) FetchBatchTemplates(ctx context.Context) ([]BatchTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, fetchBatchTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BatchTemplate
+	for rows.Next() {
+		var i BatchTemplate
+		if err := rows.Scan(&i.TemplateID, &i.TemplateName, &i.CreationTimeUnix); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchChainTx = `-- name: FetchChainTx :one
 SELECT txn_id, txid, chain_fees, raw_tx, block_height, block_hash, tx_index
 FROM chain_txns
@@ -887,6 +1007,23 @@ func (q *Queries) FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, erro
 	return i, err
 }
 
+const fetchFirstMintingBatchEventID = `-- name: FetchFirstMintingBatchEventID :one
+SELECT events.event_id
+FROM mint_batch_events events
+JOIN internal_keys keys
+    ON events.batch_id = keys.key_id
+WHERE keys.raw_key = $1
+ORDER BY events.event_id
+LIMIT 1
+`
+
+func (q *Queries) FetchFirstMintingBatchEventID(ctx context.Context, rawKey []byte) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchFirstMintingBatchEventID, rawKey)
+	var event_id int64
+	err := row.Scan(&event_id)
+	return event_id, err
+}
+
 const fetchGenesisByAssetID = `-- name: FetchGenesisByAssetID :one
 SELECT gen_asset_id, asset_id, asset_tag, meta_hash, output_index, asset_type, prev_out, block_height 
 FROM genesis_info_view
@@ -1261,6 +1398,19 @@ func (q *Queries) FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow
 	return items, nil
 }
 
+const fetchMetaBlob = `-- name: FetchMetaBlob :one
+SELECT blob
+FROM asset_meta_blobs
+WHERE blob_hash = $1
+`
+
+func (q *Queries) FetchMetaBlob(ctx context.Context, blobHash []byte) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, fetchMetaBlob, blobHash)
+	var blob []byte
+	err := row.Scan(&blob)
+	return blob, err
+}
+
 const fetchMintingBatch = `-- name: FetchMintingBatch :one
 WITH target_batch AS (
     -- This CTE is used to fetch the ID of a batch, based on the serialized
@@ -1273,7 +1423,7 @@ WITH target_batch AS (
         ON batches.batch_id = keys.key_id
     WHERE keys.raw_key = $1
 )
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, external_funding, label, reorg_state, num_reorgs, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1288,6 +1438,11 @@ type FetchMintingBatchRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	TapscriptSibling  []byte
+	ExternalFunding   bool
+	Label             string
+	ReorgState        int16
+	NumReorgs         int32
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1305,6 +1460,11 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 		&i.GenesisID,
 		&i.HeightHint,
 		&i.CreationTimeUnix,
+		&i.TapscriptSibling,
+		&i.ExternalFunding,
+		&i.Label,
+		&i.ReorgState,
+		&i.NumReorgs,
 		&i.KeyID,
 		&i.RawKey,
 		&i.KeyFamily,
@@ -1314,7 +1474,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 }
 
 const fetchMintingBatchesByInverseState = `-- name: FetchMintingBatchesByInverseState :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, external_funding, label, reorg_state, num_reorgs, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1329,6 +1489,11 @@ type FetchMintingBatchesByInverseStateRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	TapscriptSibling  []byte
+	ExternalFunding   bool
+	Label             string
+	ReorgState        int16
+	NumReorgs         int32
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1352,6 +1517,11 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 			&i.GenesisID,
 			&i.HeightHint,
 			&i.CreationTimeUnix,
+			&i.TapscriptSibling,
+			&i.ExternalFunding,
+			&i.Label,
+			&i.ReorgState,
+			&i.NumReorgs,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -1371,7 +1541,7 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 }
 
 const fetchScriptKeyByTweakedKey = `-- name: FetchScriptKeyByTweakedKey :one
-SELECT tweak, raw_key, key_family, key_index
+SELECT tweak, watch_only, raw_key, key_family, key_index
 FROM script_keys
 JOIN internal_keys
   ON script_keys.internal_key_id = internal_keys.key_id
@@ -1380,6 +1550,7 @@ WHERE script_keys.tweaked_script_key = $1
 
 type FetchScriptKeyByTweakedKeyRow struct {
 	Tweak     []byte
+	WatchOnly bool
 	RawKey    []byte
 	KeyFamily int32
 	KeyIndex  int32
@@ -1390,6 +1561,7 @@ func (q *Queries) FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptK
 	var i FetchScriptKeyByTweakedKeyRow
 	err := row.Scan(
 		&i.Tweak,
+		&i.WatchOnly,
 		&i.RawKey,
 		&i.KeyFamily,
 		&i.KeyIndex,
@@ -1411,7 +1583,7 @@ func (q *Queries) FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScrip
 }
 
 const fetchSeedlingByID = `-- name: FetchSeedlingByID :one
-SELECT seedling_id, asset_name, asset_version, asset_type, asset_supply, asset_meta_id, emission_enabled, batch_id, group_genesis_id, group_anchor_id
+SELECT seedling_id, asset_name, asset_version, asset_type, asset_supply, asset_meta_id, emission_enabled, batch_id, group_genesis_id, group_anchor_id, external_group_signer, group_internal_key_id, vanity_prefix, script_key_id, group_multisig_script, delegate_key_id
 FROM asset_seedlings
 WHERE seedling_id = $1
 `
@@ -1430,6 +1602,12 @@ func (q *Queries) FetchSeedlingByID(ctx context.Context, seedlingID int64) (Asse
 		&i.BatchID,
 		&i.GroupGenesisID,
 		&i.GroupAnchorID,
+		&i.ExternalGroupSigner,
+		&i.GroupInternalKeyID,
+		&i.VanityPrefix,
+		&i.ScriptKeyID,
+		&i.GroupMultisigScript,
+		&i.DelegateKeyID,
 	)
 	return i, err
 }
@@ -1475,26 +1653,60 @@ WITH target_batch(batch_id) AS (
 SELECT seedling_id, asset_name, asset_type, asset_version, asset_supply, 
     assets_meta.meta_data_hash, assets_meta.meta_data_type, 
     assets_meta.meta_data_blob, emission_enabled, batch_id, 
-    group_genesis_id, group_anchor_id
+    group_genesis_id, group_anchor_id, external_group_signer, vanity_prefix,
+    group_multisig_script,
+    group_keys.raw_key AS group_internal_key_raw,
+    group_keys.key_family AS group_internal_key_fam,
+    group_keys.key_index AS group_internal_key_index,
+    script_keys.tweaked_script_key AS script_key_tweaked,
+    script_keys.tweak AS script_key_tweak,
+    script_internal_keys.raw_key AS script_key_raw,
+    script_internal_keys.key_family AS script_key_fam,
+    script_internal_keys.key_index AS script_key_index,
+    delegate_keys.raw_key AS delegate_key_raw,
+    delegate_keys.key_family AS delegate_key_fam,
+    delegate_keys.key_index AS delegate_key_index
 FROM asset_seedlings 
 LEFT JOIN assets_meta
     ON asset_seedlings.asset_meta_id = assets_meta.meta_id
+LEFT JOIN internal_keys group_keys
+    ON asset_seedlings.group_internal_key_id = group_keys.key_id
+LEFT JOIN script_keys
+    ON asset_seedlings.script_key_id = script_keys.script_key_id
+LEFT JOIN internal_keys script_internal_keys
+    ON script_keys.internal_key_id = script_internal_keys.key_id
+LEFT JOIN internal_keys delegate_keys
+    ON asset_seedlings.delegate_key_id = delegate_keys.key_id
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch)
 `
 
 type FetchSeedlingsForBatchRow struct {
-	SeedlingID      int64
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	MetaDataHash    []byte
-	MetaDataType    sql.NullInt16
-	MetaDataBlob    []byte
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	SeedlingID            int64
+	AssetName             string
+	AssetType             int16
+	AssetVersion          int16
+	AssetSupply           int64
+	MetaDataHash          []byte
+	MetaDataType          sql.NullInt16
+	MetaDataBlob          []byte
+	EmissionEnabled       bool
+	BatchID               int64
+	GroupGenesisID        sql.NullInt64
+	GroupAnchorID         sql.NullInt64
+	ExternalGroupSigner   bool
+	VanityPrefix          string
+	GroupMultisigScript   []byte
+	GroupInternalKeyRaw   []byte
+	GroupInternalKeyFam   sql.NullInt32
+	GroupInternalKeyIndex sql.NullInt32
+	ScriptKeyTweaked      []byte
+	ScriptKeyTweak        []byte
+	ScriptKeyRaw          []byte
+	ScriptKeyFam          sql.NullInt32
+	ScriptKeyIndex        sql.NullInt32
+	DelegateKeyRaw        []byte
+	DelegateKeyFam        sql.NullInt32
+	DelegateKeyIndex      sql.NullInt32
 }
 
 func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error) {
@@ -1519,6 +1731,20 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 			&i.BatchID,
 			&i.GroupGenesisID,
 			&i.GroupAnchorID,
+			&i.ExternalGroupSigner,
+			&i.VanityPrefix,
+			&i.GroupMultisigScript,
+			&i.GroupInternalKeyRaw,
+			&i.GroupInternalKeyFam,
+			&i.GroupInternalKeyIndex,
+			&i.ScriptKeyTweaked,
+			&i.ScriptKeyTweak,
+			&i.ScriptKeyRaw,
+			&i.ScriptKeyFam,
+			&i.ScriptKeyIndex,
+			&i.DelegateKeyRaw,
+			&i.DelegateKeyFam,
+			&i.DelegateKeyIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -1600,23 +1826,34 @@ func (q *Queries) GenesisPoints(ctx context.Context) ([]GenesisPoint, error) {
 const insertAssetSeedling = `-- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_signer, group_internal_key_id, vanity_prefix,
+    script_key_id, group_multisig_script, delegate_key_id
 ) VALUES (
    $1, $2, $3, $4, $5, $6, $7,
-   $8, $9
+   $8, $9,
+   $10, $11,
+   $12, $13, $14,
+   $15
 )
 `
 
 type InsertAssetSeedlingParams struct {
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	AssetName           string
+	AssetType           int16
+	AssetVersion        int16
+	AssetSupply         int64
+	AssetMetaID         int64
+	EmissionEnabled     bool
+	BatchID             int64
+	GroupGenesisID      sql.NullInt64
+	GroupAnchorID       sql.NullInt64
+	ExternalGroupSigner bool
+	GroupInternalKeyID  sql.NullInt64
+	VanityPrefix        string
+	ScriptKeyID         sql.NullInt64
+	GroupMultisigScript []byte
+	DelegateKeyID       sql.NullInt64
 }
 
 func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error {
@@ -1630,6 +1867,12 @@ func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedli
 		arg.BatchID,
 		arg.GroupGenesisID,
 		arg.GroupAnchorID,
+		arg.ExternalGroupSigner,
+		arg.GroupInternalKeyID,
+		arg.VanityPrefix,
+		arg.ScriptKeyID,
+		arg.GroupMultisigScript,
+		arg.DelegateKeyID,
 	)
 	return err
 }
@@ -1647,24 +1890,35 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_signer, group_internal_key_id, vanity_prefix,
+    script_key_id, group_multisig_script, delegate_key_id
 ) VALUES (
     $2, $3, $4, $5, $6, $7,
     (SELECT key_id FROM target_key_id),
-    $8, $9
+    $8, $9,
+    $10, $11,
+    $12, $13, $14,
+    $15
 )
 `
 
 type InsertAssetSeedlingIntoBatchParams struct {
-	RawKey          []byte
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	RawKey              []byte
+	AssetName           string
+	AssetType           int16
+	AssetVersion        int16
+	AssetSupply         int64
+	AssetMetaID         int64
+	EmissionEnabled     bool
+	GroupGenesisID      sql.NullInt64
+	GroupAnchorID       sql.NullInt64
+	ExternalGroupSigner bool
+	GroupInternalKeyID  sql.NullInt64
+	VanityPrefix        string
+	ScriptKeyID         sql.NullInt64
+	GroupMultisigScript []byte
+	DelegateKeyID       sql.NullInt64
 }
 
 func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error {
@@ -1678,6 +1932,12 @@ func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAs
 		arg.EmissionEnabled,
 		arg.GroupGenesisID,
 		arg.GroupAnchorID,
+		arg.ExternalGroupSigner,
+		arg.GroupInternalKeyID,
+		arg.VanityPrefix,
+		arg.ScriptKeyID,
+		arg.GroupMultisigScript,
+		arg.DelegateKeyID,
 	)
 	return err
 }
@@ -1712,6 +1972,109 @@ func (q *Queries) InsertAssetWitness(ctx context.Context, arg InsertAssetWitness
 	return err
 }
 
+const insertBatchTemplate = `-- name: InsertBatchTemplate :one
+INSERT INTO batch_templates (
+    template_name, creation_time_unix
+) VALUES (
+    $1, $2
+)
+RETURNING template_id
+`
+
+type InsertBatchTemplateParams struct {
+	TemplateName     string
+	CreationTimeUnix time.Time
+}
+
+func (q *Queries) InsertBatchTemplate(ctx context.Context, arg InsertBatchTemplateParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertBatchTemplate, arg.TemplateName, arg.CreationTimeUnix)
+	var template_id int64
+	err := row.Scan(&template_id)
+	return template_id, err
+}
+
+const insertBatchTemplateAsset = `-- name: InsertBatchTemplateAsset :exec
+INSERT INTO batch_template_assets (
+    template_id, asset_name, asset_version, asset_type, asset_supply,
+    decimal_display, meta_data_type, meta_data_blob, emission_enabled,
+    group_key, group_anchor_name
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+`
+
+type InsertBatchTemplateAssetParams struct {
+	TemplateID      int64
+	AssetName       string
+	AssetVersion    int16
+	AssetType       int16
+	AssetSupply     int64
+	DecimalDisplay  int32
+	MetaDataType    sql.NullInt16
+	MetaDataBlob    []byte
+	EmissionEnabled bool
+	GroupKey        []byte
+	GroupAnchorName sql.NullString
+}
+
+func (q *Queries) InsertBatchTemplateAsset(ctx context.Context, arg InsertBatchTemplateAssetParams) error {
+	_, err := q.db.ExecContext(ctx, insertBatchTemplateAsset,
+		arg.TemplateID,
+		arg.AssetName,
+		arg.AssetVersion,
+		arg.AssetType,
+		arg.AssetSupply,
+		arg.DecimalDisplay,
+		arg.MetaDataType,
+		arg.MetaDataBlob,
+		arg.EmissionEnabled,
+		arg.GroupKey,
+		arg.GroupAnchorName,
+	)
+	return err
+}
+
+const insertMintingBatchEvent = `-- name: InsertMintingBatchEvent :one
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $4
+)
+INSERT INTO mint_batch_events (
+    batch_id, batch_state, block_height, event_time_unix
+) VALUES (
+    (SELECT batch_id FROM target_batch), $1, $2,
+    $3
+)
+RETURNING event_id, block_height
+`
+
+type InsertMintingBatchEventParams struct {
+	BatchState    int16
+	BlockHeight   int32
+	EventTimeUnix time.Time
+	RawKey        []byte
+}
+
+type InsertMintingBatchEventRow struct {
+	EventID     int64
+	BlockHeight int32
+}
+
+func (q *Queries) InsertMintingBatchEvent(ctx context.Context, arg InsertMintingBatchEventParams) (InsertMintingBatchEventRow, error) {
+	row := q.db.QueryRowContext(ctx, insertMintingBatchEvent,
+		arg.BatchState,
+		arg.BlockHeight,
+		arg.EventTimeUnix,
+		arg.RawKey,
+	)
+	var i InsertMintingBatchEventRow
+	err := row.Scan(&i.EventID, &i.BlockHeight)
+	return i, err
+}
+
 const insertNewAsset = `-- name: InsertNewAsset :one
 INSERT INTO assets (
     genesis_id, version, script_key_id, asset_group_witness_id, script_version, 
@@ -1752,20 +2115,49 @@ func (q *Queries) InsertNewAsset(ctx context.Context, arg InsertNewAssetParams)
 	return asset_id, err
 }
 
+const markMintingBatchReOrged = `-- name: MarkMintingBatchReOrged :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET reorg_state = $2, num_reorgs = num_reorgs + 1
+WHERE batch_id in (SELECT batch_id FROM target_batch)
+`
+
+type MarkMintingBatchReOrgedParams struct {
+	RawKey     []byte
+	ReorgState int16
+}
+
+func (q *Queries) MarkMintingBatchReOrged(ctx context.Context, arg MarkMintingBatchReOrgedParams) error {
+	_, err := q.db.ExecContext(ctx, markMintingBatchReOrged, arg.RawKey, arg.ReorgState)
+	return err
+}
+
 const newMintingBatch = `-- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3)
+    batch_state, batch_id, height_hint, creation_time_unix, tapscript_sibling
+) VALUES (0, $1, $2, $3, $4)
 `
 
 type NewMintingBatchParams struct {
 	BatchID          int64
 	HeightHint       int32
 	CreationTimeUnix time.Time
+	TapscriptSibling []byte
 }
 
 func (q *Queries) NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error {
-	_, err := q.db.ExecContext(ctx, newMintingBatch, arg.BatchID, arg.HeightHint, arg.CreationTimeUnix)
+	_, err := q.db.ExecContext(ctx, newMintingBatch,
+		arg.BatchID,
+		arg.HeightHint,
+		arg.CreationTimeUnix,
+		arg.TapscriptSibling,
+	)
 	return err
 }
 
@@ -1782,6 +2174,9 @@ JOIN genesis_info_view
         $1 IS NULL)
 LEFT JOIN key_group_info_view
     ON assets.genesis_id = key_group_info_view.gen_asset_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id AND
+       script_keys.watch_only = FALSE
 WHERE spent = FALSE
 GROUP BY assets.genesis_id, genesis_info_view.asset_id,
          version, genesis_info_view.asset_tag, genesis_info_view.meta_hash,
@@ -1804,6 +2199,8 @@ type QueryAssetBalancesByAssetRow struct {
 // generate rows that have NULL values for the group key fields if an asset
 // doesn't have a group key. See the comment in fetchAssetSprouts for a work
 // around that needs to be used with this query until a sqlc bug is fixed.
+// Assets locked to watch only script keys can't be spent by the wallet on its
+// own, so they don't count towards the balance.
 func (q *Queries) QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter []byte) ([]QueryAssetBalancesByAssetRow, error) {
 	rows, err := q.db.QueryContext(ctx, queryAssetBalancesByAsset, assetIDFilter)
 	if err != nil {
@@ -1844,6 +2241,9 @@ JOIN key_group_info_view
     ON assets.genesis_id = key_group_info_view.gen_asset_id AND
       (key_group_info_view.tweaked_group_key = $1 OR
         $1 IS NULL)
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id AND
+       script_keys.watch_only = FALSE
 WHERE spent = FALSE
 GROUP BY key_group_info_view.tweaked_group_key
 `
@@ -1881,6 +2281,7 @@ SELECT
     assets.asset_id AS asset_primary_key, assets.genesis_id, version, spent,
     script_keys.tweak AS script_key_tweak, 
     script_keys.tweaked_script_key, 
+    script_keys.watch_only AS script_key_watch_only,
     internal_keys.raw_key AS script_key_raw,
     internal_keys.key_family AS script_key_fam,
     internal_keys.key_index AS script_key_index,
@@ -1919,38 +2320,40 @@ LEFT JOIN key_group_info_view
 JOIN script_keys
     ON assets.script_key_id = script_keys.script_key_id AND
       (script_keys.tweaked_script_key = $2 OR
-       $2 IS NULL)
+       $2 IS NULL) AND
+      script_keys.watch_only = COALESCE($3, script_keys.watch_only)
 JOIN internal_keys
     ON script_keys.internal_key_id = internal_keys.key_id
 JOIN managed_utxos utxos
     ON assets.anchor_utxo_id = utxos.utxo_id AND
-      (utxos.outpoint = $3 OR
-       $3 IS NULL) AND
+      (utxos.outpoint = $4 OR
+       $4 IS NULL) AND
        CASE
-           WHEN $4 = true THEN
-               (utxos.lease_owner IS NOT NULL AND utxos.lease_expiry > $5)
-           WHEN $4 = false THEN
+           WHEN $5 = true THEN
+               (utxos.lease_owner IS NOT NULL AND utxos.lease_expiry > $6)
+           WHEN $5 = false THEN
                (utxos.lease_owner IS NULL OR 
                 utxos.lease_expiry IS NULL OR
-                utxos.lease_expiry <= $5)
+                utxos.lease_expiry <= $6)
            ELSE TRUE
        END
 JOIN internal_keys utxo_internal_keys
     ON utxos.internal_key_id = utxo_internal_keys.key_id
 JOIN chain_txns txns
     ON utxos.txn_id = txns.txn_id AND
-      COALESCE(txns.block_height, 0) >= COALESCE($6, txns.block_height, 0)
+      COALESCE(txns.block_height, 0) >= COALESCE($7, txns.block_height, 0)
 WHERE (
-    assets.amount >= COALESCE($7, assets.amount) AND
-    assets.spent = COALESCE($8, assets.spent) AND
-    (key_group_info_view.tweaked_group_key = $9 OR
-      $9 IS NULL)
+    assets.amount >= COALESCE($8, assets.amount) AND
+    assets.spent = COALESCE($9, assets.spent) AND
+    (key_group_info_view.tweaked_group_key = $10 OR
+      $10 IS NULL)
 )
 `
 
 type QueryAssetsParams struct {
 	AssetIDFilter    []byte
 	TweakedScriptKey []byte
+	WatchOnly        sql.NullBool
 	AnchorPoint      []byte
 	Leased           interface{}
 	Now              sql.NullTime
@@ -1967,6 +2370,7 @@ type QueryAssetsRow struct {
 	Spent                    bool
 	ScriptKeyTweak           []byte
 	TweakedScriptKey         []byte
+	ScriptKeyWatchOnly       bool
 	ScriptKeyRaw             []byte
 	ScriptKeyFam             int32
 	ScriptKeyIndex           int32
@@ -2013,6 +2417,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 	rows, err := q.db.QueryContext(ctx, queryAssets,
 		arg.AssetIDFilter,
 		arg.TweakedScriptKey,
+		arg.WatchOnly,
 		arg.AnchorPoint,
 		arg.Leased,
 		arg.Now,
@@ -2035,6 +2440,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 			&i.Spent,
 			&i.ScriptKeyTweak,
 			&i.TweakedScriptKey,
+			&i.ScriptKeyWatchOnly,
 			&i.ScriptKeyRaw,
 			&i.ScriptKeyFam,
 			&i.ScriptKeyIndex,
@@ -2081,6 +2487,137 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 	return items, nil
 }
 
+const queryDelegatedSupply = `-- name: QueryDelegatedSupply :one
+SELECT CAST(COALESCE(SUM(seedlings.asset_supply), 0) AS BIGINT) AS supply
+FROM asset_seedlings seedlings
+JOIN asset_minting_batches batches
+    ON seedlings.batch_id = batches.batch_id
+JOIN internal_keys delegate_keys
+    ON seedlings.delegate_key_id = delegate_keys.key_id
+WHERE batches.batch_state NOT IN (
+    $1, $2
+) AND delegate_keys.raw_key = $3 AND
+    seedlings.group_genesis_id IN (
+        SELECT gen_asset_id
+        FROM key_group_info_view
+        WHERE key_group_info_view.tweaked_group_key = $4
+    )
+`
+
+type QueryDelegatedSupplyParams struct {
+	SeedlingCancelledState int16
+	SproutCancelledState   int16
+	DelegateKey            []byte
+	GroupKey               []byte
+}
+
+// A delegate key can only issue into an existing group, so the group of its
+// seedlings is always known from the start.
+func (q *Queries) QueryDelegatedSupply(ctx context.Context, arg QueryDelegatedSupplyParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, queryDelegatedSupply,
+		arg.SeedlingCancelledState,
+		arg.SproutCancelledState,
+		arg.DelegateKey,
+		arg.GroupKey,
+	)
+	var supply int64
+	err := row.Scan(&supply)
+	return supply, err
+}
+
+const queryGroupSupply = `-- name: QueryGroupSupply :one
+SELECT CAST(COALESCE(SUM(seedlings.asset_supply), 0) AS BIGINT) AS supply
+FROM asset_seedlings seedlings
+JOIN asset_minting_batches batches
+    ON seedlings.batch_id = batches.batch_id
+LEFT JOIN genesis_assets gen
+    ON gen.genesis_point_id = batches.genesis_id AND
+       gen.asset_tag = seedlings.asset_name
+WHERE batches.batch_state NOT IN (
+    $1, $2
+) AND (
+    seedlings.group_genesis_id IN (
+        SELECT gen_asset_id
+        FROM key_group_info_view
+        WHERE key_group_info_view.tweaked_group_key = $3
+    ) OR gen.gen_asset_id IN (
+        SELECT gen_asset_id
+        FROM key_group_info_view
+        WHERE key_group_info_view.tweaked_group_key = $3
+    )
+)
+`
+
+type QueryGroupSupplyParams struct {
+	SeedlingCancelledState int16
+	SproutCancelledState   int16
+	GroupKey               []byte
+}
+
+// The group of a seedling is either known from the start, if it is issued
+// into an existing group, or once the genesis asset of the seedling has been
+// created when the batch was committed.
+func (q *Queries) QueryGroupSupply(ctx context.Context, arg QueryGroupSupplyParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, queryGroupSupply, arg.SeedlingCancelledState, arg.SproutCancelledState, arg.GroupKey)
+	var supply int64
+	err := row.Scan(&supply)
+	return supply, err
+}
+
+const queryMintingBatchEvents = `-- name: QueryMintingBatchEvents :many
+SELECT
+    events.event_id, keys.raw_key AS batch_key, events.batch_state,
+    events.block_height, events.event_time_unix
+FROM mint_batch_events events
+JOIN internal_keys keys
+    ON events.batch_id = keys.key_id
+WHERE events.event_id >= $1
+    AND events.block_height >= $2
+ORDER BY events.event_id
+`
+
+type QueryMintingBatchEventsParams struct {
+	StartEventID int64
+	StartHeight  int32
+}
+
+type QueryMintingBatchEventsRow struct {
+	EventID       int64
+	BatchKey      []byte
+	BatchState    int16
+	BlockHeight   int32
+	EventTimeUnix time.Time
+}
+
+func (q *Queries) QueryMintingBatchEvents(ctx context.Context, arg QueryMintingBatchEventsParams) ([]QueryMintingBatchEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryMintingBatchEvents, arg.StartEventID, arg.StartHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryMintingBatchEventsRow
+	for rows.Next() {
+		var i QueryMintingBatchEventsRow
+		if err := rows.Scan(
+			&i.EventID,
+			&i.BatchKey,
+			&i.BatchState,
+			&i.BlockHeight,
+			&i.EventTimeUnix,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setAssetSpent = `-- name: SetAssetSpent :one
 WITH target_asset(asset_id) AS (
     SELECT assets.asset_id
@@ -2136,6 +2673,75 @@ func (q *Queries) UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenes
 	return err
 }
 
+const updateMintingBatchExternalFunding = `-- name: UpdateMintingBatchExternalFunding :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET external_funding = $2
+WHERE batch_id in (SELECT batch_id FROM target_batch)
+`
+
+type UpdateMintingBatchExternalFundingParams struct {
+	RawKey          []byte
+	ExternalFunding bool
+}
+
+func (q *Queries) UpdateMintingBatchExternalFunding(ctx context.Context, arg UpdateMintingBatchExternalFundingParams) error {
+	_, err := q.db.ExecContext(ctx, updateMintingBatchExternalFunding, arg.RawKey, arg.ExternalFunding)
+	return err
+}
+
+const updateMintingBatchLabel = `-- name: UpdateMintingBatchLabel :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET label = $2
+WHERE batch_id in (SELECT batch_id FROM target_batch)
+`
+
+type UpdateMintingBatchLabelParams struct {
+	RawKey []byte
+	Label  string
+}
+
+func (q *Queries) UpdateMintingBatchLabel(ctx context.Context, arg UpdateMintingBatchLabelParams) error {
+	_, err := q.db.ExecContext(ctx, updateMintingBatchLabel, arg.RawKey, arg.Label)
+	return err
+}
+
+const updateMintingBatchReOrgState = `-- name: UpdateMintingBatchReOrgState :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET reorg_state = $2
+WHERE batch_id in (SELECT batch_id FROM target_batch)
+`
+
+type UpdateMintingBatchReOrgStateParams struct {
+	RawKey     []byte
+	ReorgState int16
+}
+
+func (q *Queries) UpdateMintingBatchReOrgState(ctx context.Context, arg UpdateMintingBatchReOrgStateParams) error {
+	_, err := q.db.ExecContext(ctx, updateMintingBatchReOrgState, arg.RawKey, arg.ReorgState)
+	return err
+}
+
 const updateMintingBatchState = `-- name: UpdateMintingBatchState :exec
 WITH target_batch AS (
     -- This CTE is used to fetch the ID of a batch, based on the serialized
@@ -2460,11 +3066,31 @@ func (q *Queries) UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOPa
 	return utxo_id, err
 }
 
+const upsertMetaBlob = `-- name: UpsertMetaBlob :exec
+INSERT INTO asset_meta_blobs (
+    blob_hash, blob
+) VALUES (
+    $1, $2
+) ON CONFLICT (blob_hash)
+    -- The blob hash commits to the blob, so there's nothing to update.
+    DO NOTHING
+`
+
+type UpsertMetaBlobParams struct {
+	BlobHash []byte
+	Blob     []byte
+}
+
+func (q *Queries) UpsertMetaBlob(ctx context.Context, arg UpsertMetaBlobParams) error {
+	_, err := q.db.ExecContext(ctx, upsertMetaBlob, arg.BlobHash, arg.Blob)
+	return err
+}
+
 const upsertScriptKey = `-- name: UpsertScriptKey :one
 INSERT INTO script_keys (
-    internal_key_id, tweaked_script_key, tweak
+    internal_key_id, tweaked_script_key, tweak, watch_only
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4
 )  ON CONFLICT (tweaked_script_key)
     -- As a NOP, we just set the script key to the one that triggered the
     -- conflict.
@@ -2476,10 +3102,16 @@ type UpsertScriptKeyParams struct {
 	InternalKeyID    int64
 	TweakedScriptKey []byte
 	Tweak            []byte
+	WatchOnly        bool
 }
 
 func (q *Queries) UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, upsertScriptKey, arg.InternalKeyID, arg.TweakedScriptKey, arg.Tweak)
+	row := q.db.QueryRowContext(ctx, upsertScriptKey,
+		arg.InternalKeyID,
+		arg.TweakedScriptKey,
+		arg.Tweak,
+		arg.WatchOnly,
+	)
 	var script_key_id int64
 	err := row.Scan(&script_key_id)
 	return script_key_id, err
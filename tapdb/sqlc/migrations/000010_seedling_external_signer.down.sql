@@ -0,0 +1,2 @@
+ALTER TABLE asset_seedlings DROP COLUMN group_internal_key_id;
+ALTER TABLE asset_seedlings DROP COLUMN external_group_signer;
@@ -0,0 +1,9 @@
+-- external_group_signer indicates that the group witness of the seedling is
+-- produced by an external signer instead of the backing lnd node. This is
+-- added as a separate field with a default value to make this change
+-- non-breaking.
+ALTER TABLE asset_seedlings ADD COLUMN external_group_signer BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- group_internal_key_id references the raw group key of a seedling that
+-- creates a new asset group with a key held by an external signer.
+ALTER TABLE asset_seedlings ADD COLUMN group_internal_key_id BIGINT REFERENCES internal_keys(key_id);
@@ -0,0 +1,8 @@
+-- asset_meta_blobs stores meta data blobs that are committed to by reference.
+-- Only a reference with the hash of the blob is part of the asset meta data,
+-- while the blob itself is kept here so it can be served to others.
+CREATE TABLE IF NOT EXISTS asset_meta_blobs (
+    blob_hash BLOB PRIMARY KEY CHECK(length(blob_hash) = 32),
+
+    blob BLOB NOT NULL
+);
@@ -0,0 +1,4 @@
+-- tapscript_sibling is the optional encoded tapscript preimage that is
+-- committed to next to the Taproot Asset commitment in the minting output of
+-- the batch.
+ALTER TABLE asset_minting_batches ADD COLUMN tapscript_sibling BLOB;
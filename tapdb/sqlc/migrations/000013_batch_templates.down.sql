@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS batch_template_assets;
+DROP TABLE IF EXISTS batch_templates;
@@ -0,0 +1,44 @@
+-- batch_templates stores named templates of minting batches, which allow a
+-- recurring set of assets to be minted with a single call.
+CREATE TABLE IF NOT EXISTS batch_templates (
+    template_id BIGINT PRIMARY KEY,
+
+    template_name TEXT UNIQUE NOT NULL,
+
+    creation_time_unix TIMESTAMP NOT NULL
+);
+
+-- batch_template_assets stores the assets of a batch template. The assets are
+-- minted in the order of their primary key, so group anchors come before the
+-- assets that reference them.
+CREATE TABLE IF NOT EXISTS batch_template_assets (
+    template_asset_id BIGINT PRIMARY KEY,
+
+    template_id BIGINT NOT NULL REFERENCES batch_templates(template_id) ON DELETE CASCADE,
+
+    asset_name TEXT NOT NULL,
+
+    asset_version SMALLINT NOT NULL,
+
+    asset_type SMALLINT NOT NULL,
+
+    asset_supply BIGINT NOT NULL,
+
+    decimal_display INTEGER NOT NULL,
+
+    meta_data_type SMALLINT,
+
+    meta_data_blob BLOB,
+
+    emission_enabled BOOLEAN NOT NULL,
+
+    -- group_key is the tweaked key of an existing group the asset is issued
+    -- into.
+    group_key BLOB,
+
+    -- group_anchor_name is the name of another asset of the same template
+    -- that creates the group the asset is part of.
+    group_anchor_name TEXT,
+
+    UNIQUE(template_id, asset_name)
+);
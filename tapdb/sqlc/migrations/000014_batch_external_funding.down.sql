@@ -0,0 +1 @@
+ALTER TABLE asset_minting_batches DROP COLUMN external_funding;
@@ -0,0 +1,3 @@
+-- external_funding denotes whether the genesis transaction of the batch is
+-- funded and signed by an external wallet instead of the backing lnd wallet.
+ALTER TABLE asset_minting_batches ADD COLUMN external_funding BOOLEAN NOT NULL DEFAULT FALSE;
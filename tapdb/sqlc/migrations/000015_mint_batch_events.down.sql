@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS mint_batch_events_block_height_idx;
+DROP INDEX IF EXISTS mint_batch_events_batch_id_idx;
+DROP TABLE IF EXISTS mint_batch_events;
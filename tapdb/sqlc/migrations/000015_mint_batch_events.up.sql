@@ -0,0 +1,36 @@
+-- mint_batch_events stores every state transition of a minting batch, so
+-- mint event subscribers that missed some of them can have them replayed.
+CREATE TABLE IF NOT EXISTS mint_batch_events (
+    event_id BIGINT PRIMARY KEY,
+
+    batch_id BIGINT NOT NULL REFERENCES asset_minting_batches(batch_id),
+
+    batch_state SMALLINT NOT NULL,
+
+    -- block_height is the height of the block that confirmed the batch for
+    -- confirmation events, and the height hint of the batch for all others.
+    block_height INTEGER NOT NULL,
+
+    event_time_unix TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS mint_batch_events_batch_id_idx
+    ON mint_batch_events(batch_id);
+
+CREATE INDEX IF NOT EXISTS mint_batch_events_block_height_idx
+    ON mint_batch_events(block_height);
+
+-- Existing batches get a single event for the state they're currently in.
+INSERT INTO mint_batch_events (
+    batch_id, batch_state, block_height, event_time_unix
+)
+SELECT
+    batches.batch_id, batches.batch_state,
+    COALESCE(txns.block_height, batches.height_hint),
+    batches.creation_time_unix
+FROM asset_minting_batches batches
+LEFT JOIN genesis_points genesis
+    ON batches.genesis_id = genesis.genesis_id
+LEFT JOIN chain_txns txns
+    ON genesis.anchor_tx_id = txns.txn_id
+ORDER BY batches.batch_id;
@@ -0,0 +1 @@
+ALTER TABLE asset_seedlings DROP COLUMN vanity_prefix;
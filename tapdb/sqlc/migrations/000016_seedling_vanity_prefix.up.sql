@@ -0,0 +1,5 @@
+-- vanity_prefix is the optional hex encoded prefix the asset ID of a seedling
+-- must start with. An empty string means no vanity asset ID is ground for the
+-- seedling. This is added as a separate field with a default value to make
+-- this change non-breaking.
+ALTER TABLE asset_seedlings ADD COLUMN vanity_prefix TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+-- label is an optional user-defined label of the batch, for example to tie it
+-- to an internal accounting reference.
+ALTER TABLE asset_minting_batches ADD COLUMN label TEXT NOT NULL DEFAULT '';
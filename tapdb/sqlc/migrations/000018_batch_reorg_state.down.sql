@@ -0,0 +1,2 @@
+ALTER TABLE asset_minting_batches DROP COLUMN num_reorgs;
+ALTER TABLE asset_minting_batches DROP COLUMN reorg_state;
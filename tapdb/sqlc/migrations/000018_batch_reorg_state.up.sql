@@ -0,0 +1,7 @@
+-- reorg_state tracks whether the minting transaction of a finalized batch was
+-- re-organized out of the chain, and whether the batch recovered from it.
+ALTER TABLE asset_minting_batches ADD COLUMN reorg_state SMALLINT NOT NULL DEFAULT 0;
+
+-- num_reorgs is the number of times the minting transaction of the batch was
+-- re-organized out of the chain.
+ALTER TABLE asset_minting_batches ADD COLUMN num_reorgs INTEGER NOT NULL DEFAULT 0;
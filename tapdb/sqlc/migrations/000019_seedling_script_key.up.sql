@@ -0,0 +1,4 @@
+-- script_key_id references the optional script key a seedling is minted to,
+-- which may be held by a party other than the backing lnd node. If it isn't
+-- set, a new script key is derived when the batch is finalized.
+ALTER TABLE asset_seedlings ADD COLUMN script_key_id BIGINT REFERENCES script_keys(script_key_id);
@@ -0,0 +1,4 @@
+-- anchor_psbt is the funded but unsigned PSBT of the anchor transaction of a
+-- transfer. It contains all the information required to sign the anchor
+-- transaction again, for example to replace it with one paying a higher fee.
+ALTER TABLE asset_transfers ADD COLUMN anchor_psbt BLOB;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS queued_send_addrs;
+DROP TABLE IF EXISTS queued_sends;
@@ -0,0 +1,32 @@
+-- queued_sends stores asset sends that are deferred until a scheduled time
+-- has passed and the on-chain fee rate is below a threshold. Queued sends are
+-- executed one after the other, so they don't compete for the same coins.
+CREATE TABLE IF NOT EXISTS queued_sends (
+    queued_send_id BIGINT PRIMARY KEY,
+
+    coin_select_strategy TEXT NOT NULL,
+
+    -- send_after_unix is the time after which the send is executed. If it
+    -- isn't set, the send is executed as soon as the fee rate allows.
+    send_after_unix TIMESTAMP,
+
+    -- max_fee_rate is the highest fee rate in sat/kw the send is executed
+    -- at. A value of zero means the send is executed at any fee rate.
+    max_fee_rate BIGINT NOT NULL,
+
+    creation_time_unix TIMESTAMP NOT NULL,
+
+    -- last_error is the error of the last failed attempt to execute the
+    -- send, if any.
+    last_error TEXT
+);
+
+-- queued_send_addrs stores the encoded Taproot Asset addresses a queued send
+-- pays to, in the order of their primary key.
+CREATE TABLE IF NOT EXISTS queued_send_addrs (
+    queued_send_addr_id BIGINT PRIMARY KEY,
+
+    queued_send_id BIGINT NOT NULL REFERENCES queued_sends(queued_send_id) ON DELETE CASCADE,
+
+    tap_addr TEXT NOT NULL
+);
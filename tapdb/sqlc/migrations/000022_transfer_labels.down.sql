@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS asset_transfers_label_idx;
+ALTER TABLE addr_events DROP COLUMN label;
+ALTER TABLE asset_transfers DROP COLUMN label;
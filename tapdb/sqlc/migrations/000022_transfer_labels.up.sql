@@ -0,0 +1,10 @@
+-- label is an optional, user-defined label or memo of an outbound transfer,
+-- which can be used to reconcile the transfer with an external ledger.
+ALTER TABLE asset_transfers ADD COLUMN label TEXT;
+
+-- label is an optional, user-defined label or memo of an inbound transfer
+-- to an address.
+ALTER TABLE addr_events ADD COLUMN label TEXT;
+
+CREATE INDEX IF NOT EXISTS asset_transfers_label_idx
+    ON asset_transfers(label);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS asset_burns_transfer_id_idx;
+DROP INDEX IF EXISTS asset_burns_asset_id_idx;
+DROP TABLE IF EXISTS asset_burns;
@@ -0,0 +1,27 @@
+-- asset_burns is a registry of all assets burned by this node. Each entry
+-- references the transfer that burned the asset, which also determines the
+-- anchor transaction of the burn.
+CREATE TABLE IF NOT EXISTS asset_burns (
+    burn_id BIGINT PRIMARY KEY,
+
+    transfer_id BIGINT NOT NULL REFERENCES asset_transfers(id) ON DELETE CASCADE,
+
+    -- note is an optional, user-defined reason or memo for the burn.
+    note TEXT,
+
+    asset_id BLOB NOT NULL,
+
+    -- group_key is the tweaked group key of the burned asset, if it is
+    -- part of an asset group.
+    group_key BLOB,
+
+    amount BIGINT NOT NULL,
+
+    -- script_key is the burn script key of the burn output, which together
+    -- with the asset ID identifies the proof of the burn.
+    script_key BLOB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS asset_burns_asset_id_idx ON asset_burns(asset_id);
+CREATE INDEX IF NOT EXISTS asset_burns_transfer_id_idx
+    ON asset_burns(transfer_id);
@@ -0,0 +1,2 @@
+ALTER TABLE asset_transfer_outputs DROP COLUMN fallback_proof_courier_addrs;
+ALTER TABLE addrs DROP COLUMN fallback_proof_courier_addrs;
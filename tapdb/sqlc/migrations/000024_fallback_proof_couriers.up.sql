@@ -0,0 +1,8 @@
+-- fallback_proof_courier_addrs is the newline separated, ordered list of
+-- proof courier addresses that are used to transfer the proof of an address
+-- if its main proof courier isn't available.
+ALTER TABLE addrs ADD COLUMN fallback_proof_courier_addrs BLOB;
+
+-- fallback_proof_courier_addrs is the newline separated, ordered list of
+-- fallback proof courier addresses of the address a transfer output pays to.
+ALTER TABLE asset_transfer_outputs ADD COLUMN fallback_proof_courier_addrs BLOB;
@@ -0,0 +1 @@
+ALTER TABLE asset_transfer_outputs DROP COLUMN recipient_addr;
@@ -0,0 +1,3 @@
+-- recipient_addr is the bech32 encoded Taproot Asset address a transfer output
+-- pays to, if the output was created to fulfill a send to an address.
+ALTER TABLE asset_transfer_outputs ADD COLUMN recipient_addr TEXT;
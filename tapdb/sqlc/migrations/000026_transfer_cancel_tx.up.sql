@@ -0,0 +1,4 @@
+-- cancel_tx is the raw transaction that double spends the anchor transaction
+-- of a pending transfer to cancel it. The transfer is only removed once the
+-- cancel transaction confirms.
+ALTER TABLE asset_transfers ADD COLUMN cancel_tx BLOB;
@@ -0,0 +1 @@
+ALTER TABLE asset_seedlings DROP COLUMN group_multisig_script;
@@ -0,0 +1,4 @@
+-- group_multisig_script is the optional k-of-n multisig tapscript leaf that
+-- the group key of a seedling commits to. If it is set, the group witness of
+-- the seedling is a script path spend that is signed for externally.
+ALTER TABLE asset_seedlings ADD COLUMN group_multisig_script BLOB;
@@ -0,0 +1 @@
+ALTER TABLE asset_seedlings DROP COLUMN delegate_key_id;
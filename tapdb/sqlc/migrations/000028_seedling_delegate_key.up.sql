@@ -0,0 +1,5 @@
+-- delegate_key_id references the delegate key a seedling is issued with into
+-- an existing asset group. If it is set, the group witness of the seedling
+-- spends the issuance delegation leaf of the key instead of being signed by
+-- the group key.
+ALTER TABLE asset_seedlings ADD COLUMN delegate_key_id BIGINT REFERENCES internal_keys(key_id);
@@ -0,0 +1 @@
+ALTER TABLE script_keys DROP COLUMN watch_only;
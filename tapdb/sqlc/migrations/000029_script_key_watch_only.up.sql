@@ -0,0 +1,6 @@
+-- watch_only is set for script keys whose internal key isn't controlled by
+-- the wallet, for example the NUMS key or the key of another party that a
+-- tapscript-encumbered script key of an address is built on. Assets locked to
+-- such keys can be received, but not spent by the wallet on its own, so they
+-- are excluded from balances and coin selection.
+ALTER TABLE script_keys ADD COLUMN watch_only BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,38 @@
+-- universe_publications tracks the publication of issuance proofs to the
+-- static publication targets, so the publications that are still pending can
+-- be resumed after a restart.
+CREATE TABLE IF NOT EXISTS universe_publications (
+    id BIGINT PRIMARY KEY,
+
+    -- target_host is the host of the universe server the proof is published
+    -- to.
+    target_host TEXT NOT NULL,
+
+    -- namespace_root identifies the universe of the proof, while the asset ID,
+    -- group key and proof type allow the universe identifier to be restored.
+    namespace_root VARCHAR NOT NULL,
+
+    asset_id BLOB NOT NULL CHECK(LENGTH(asset_id) = 32),
+
+    group_key BLOB CHECK(LENGTH(group_key) = 33),
+
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    -- The minting point and the script key form the leaf key of the proof.
+    minting_point BLOB NOT NULL,
+
+    script_key_bytes BLOB NOT NULL CHECK(LENGTH(script_key_bytes) = 33),
+
+    publication_state SMALLINT NOT NULL,
+
+    num_attempts INTEGER NOT NULL,
+
+    last_attempt_time TIMESTAMP,
+
+    last_error TEXT,
+
+    UNIQUE(target_host, namespace_root, minting_point, script_key_bytes)
+);
+
+CREATE INDEX IF NOT EXISTS universe_publications_target_host_idx
+    ON universe_publications(target_host);
@@ -0,0 +1 @@
+ALTER TABLE queued_sends DROP COLUMN failed;
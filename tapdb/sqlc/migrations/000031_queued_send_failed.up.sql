@@ -0,0 +1,6 @@
+-- failed is set for queued sends that failed after their transfer was handed
+-- to the chain porter. The transfer might have been committed to disk and be
+-- resumed on restart, so such sends are never executed again, as that could
+-- pay the recipients twice. They are kept so the error can be inspected
+-- before the send is canceled.
+ALTER TABLE queued_sends ADD COLUMN failed BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,17 @@
+-- swap_offers stores the BTC payment the maker of a swap offer asked for, so
+-- the anchor transaction countersigned by the taker can be checked against it
+-- instead of against the offer the taker hands back. An offer is identified by
+-- the txid of its unsigned anchor transaction template, which commits to the
+-- anchor inputs, the asset anchor outputs and the payment output.
+CREATE TABLE IF NOT EXISTS swap_offers (
+    offer_id BLOB PRIMARY KEY CHECK(LENGTH(offer_id) = 32),
+
+    -- payment_pk_script is the script of the wallet address the taker pays
+    -- to.
+    payment_pk_script BLOB NOT NULL,
+
+    -- payment_value is the amount in satoshis the taker pays.
+    payment_value BIGINT NOT NULL,
+
+    creation_time_unix TIMESTAMP NOT NULL
+);
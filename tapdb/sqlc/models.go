@@ -10,20 +10,21 @@ import (
 )
 
 type Addr struct {
-	ID               int64
-	Version          int16
-	AssetVersion     int16
-	GenesisAssetID   int64
-	GroupKey         []byte
-	ScriptKeyID      int64
-	TaprootKeyID     int64
-	TapscriptSibling []byte
-	TaprootOutputKey []byte
-	Amount           int64
-	AssetType        int16
-	CreationTime     time.Time
-	ManagedFrom      sql.NullTime
-	ProofCourierAddr []byte
+	ID                        int64
+	Version                   int16
+	AssetVersion              int16
+	GenesisAssetID            int64
+	GroupKey                  []byte
+	ScriptKeyID               int64
+	TaprootKeyID              int64
+	TapscriptSibling          []byte
+	TaprootOutputKey          []byte
+	Amount                    int64
+	AssetType                 int16
+	CreationTime              time.Time
+	ManagedFrom               sql.NullTime
+	ProofCourierAddr          []byte
+	FallbackProofCourierAddrs []byte
 }
 
 type AddrEvent struct {
@@ -36,6 +37,7 @@ type AddrEvent struct {
 	ManagedUtxoID       int64
 	AssetProofID        sql.NullInt64
 	AssetID             sql.NullInt64
+	Label               sql.NullString
 }
 
 type Asset struct {
@@ -54,6 +56,16 @@ type Asset struct {
 	Spent                    bool
 }
 
+type AssetBurn struct {
+	BurnID     int64
+	TransferID int64
+	Note       sql.NullString
+	AssetID    []byte
+	GroupKey   []byte
+	Amount     int64
+	ScriptKey  []byte
+}
+
 type AssetGroup struct {
 	GroupID         int64
 	TweakedGroupKey []byte
@@ -69,6 +81,11 @@ type AssetGroupWitness struct {
 	GroupKeyID   int64
 }
 
+type AssetMetaBlob struct {
+	BlobHash []byte
+	Blob     []byte
+}
+
 type AssetMintingBatch struct {
 	BatchID           int64
 	BatchState        int16
@@ -77,6 +94,11 @@ type AssetMintingBatch struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	TapscriptSibling  []byte
+	ExternalFunding   bool
+	Label             string
+	ReorgState        int16
+	NumReorgs         int32
 }
 
 type AssetProof struct {
@@ -86,16 +108,22 @@ type AssetProof struct {
 }
 
 type AssetSeedling struct {
-	SeedlingID      int64
-	AssetName       string
-	AssetVersion    int16
-	AssetType       int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	SeedlingID          int64
+	AssetName           string
+	AssetVersion        int16
+	AssetType           int16
+	AssetSupply         int64
+	AssetMetaID         int64
+	EmissionEnabled     bool
+	BatchID             int64
+	GroupGenesisID      sql.NullInt64
+	GroupAnchorID       sql.NullInt64
+	ExternalGroupSigner bool
+	GroupInternalKeyID  sql.NullInt64
+	VanityPrefix        string
+	ScriptKeyID         sql.NullInt64
+	GroupMultisigScript []byte
+	DelegateKeyID       sql.NullInt64
 }
 
 type AssetTransfer struct {
@@ -103,6 +131,9 @@ type AssetTransfer struct {
 	HeightHint       int32
 	AnchorTxnID      int64
 	TransferTimeUnix time.Time
+	AnchorPsbt       []byte
+	Label            sql.NullString
+	CancelTx         []byte
 }
 
 type AssetTransferInput struct {
@@ -115,20 +146,22 @@ type AssetTransferInput struct {
 }
 
 type AssetTransferOutput struct {
-	OutputID                 int64
-	TransferID               int64
-	AnchorUtxo               int64
-	ScriptKey                int64
-	ScriptKeyLocal           bool
-	Amount                   int64
-	AssetVersion             int32
-	SerializedWitnesses      []byte
-	SplitCommitmentRootHash  []byte
-	SplitCommitmentRootValue sql.NullInt64
-	ProofSuffix              []byte
-	NumPassiveAssets         int32
-	OutputType               int16
-	ProofCourierAddr         []byte
+	OutputID                  int64
+	TransferID                int64
+	AnchorUtxo                int64
+	ScriptKey                 int64
+	ScriptKeyLocal            bool
+	Amount                    int64
+	AssetVersion              int32
+	SerializedWitnesses       []byte
+	SplitCommitmentRootHash   []byte
+	SplitCommitmentRootValue  sql.NullInt64
+	ProofSuffix               []byte
+	NumPassiveAssets          int32
+	OutputType                int16
+	ProofCourierAddr          []byte
+	FallbackProofCourierAddrs []byte
+	RecipientAddr             sql.NullString
 }
 
 type AssetWitness struct {
@@ -148,6 +181,27 @@ type AssetsMetum struct {
 	MetaDataType sql.NullInt16
 }
 
+type BatchTemplate struct {
+	TemplateID       int64
+	TemplateName     string
+	CreationTimeUnix time.Time
+}
+
+type BatchTemplateAsset struct {
+	TemplateAssetID int64
+	TemplateID      int64
+	AssetName       string
+	AssetVersion    int16
+	AssetType       int16
+	AssetSupply     int64
+	DecimalDisplay  int32
+	MetaDataType    sql.NullInt16
+	MetaDataBlob    []byte
+	EmissionEnabled bool
+	GroupKey        []byte
+	GroupAnchorName sql.NullString
+}
+
 type ChainTxn struct {
 	TxnID       int64
 	Txid        []byte
@@ -237,6 +291,14 @@ type ManagedUtxo struct {
 	LeaseExpiry      sql.NullTime
 }
 
+type MintBatchEvent struct {
+	EventID       int64
+	BatchID       int64
+	BatchState    int16
+	BlockHeight   int32
+	EventTimeUnix time.Time
+}
+
 type MssmtNode struct {
 	HashKey   []byte
 	LHashKey  []byte
@@ -263,6 +325,22 @@ type PassiveAsset struct {
 	NewProof        []byte
 }
 
+type QueuedSend struct {
+	QueuedSendID       int64
+	CoinSelectStrategy string
+	SendAfterUnix      sql.NullTime
+	MaxFeeRate         int64
+	CreationTimeUnix   time.Time
+	LastError          sql.NullString
+	Failed             bool
+}
+
+type QueuedSendAddr struct {
+	QueuedSendAddrID int64
+	QueuedSendID     int64
+	TapAddr          string
+}
+
 type ReceiverProofTransferAttempt struct {
 	ProofLocatorHash []byte
 	TimeUnix         time.Time
@@ -273,6 +351,14 @@ type ScriptKey struct {
 	InternalKeyID    int64
 	TweakedScriptKey []byte
 	Tweak            []byte
+	WatchOnly        bool
+}
+
+type SwapOffer struct {
+	OfferID          []byte
+	PaymentPkScript  []byte
+	PaymentValue     int64
+	CreationTimeUnix time.Time
 }
 
 type UniverseEvent struct {
@@ -293,6 +379,21 @@ type UniverseLeafe struct {
 	LeafNodeNamespace string
 }
 
+type UniversePublication struct {
+	ID               int64
+	TargetHost       string
+	NamespaceRoot    string
+	AssetID          []byte
+	GroupKey         []byte
+	ProofType        string
+	MintingPoint     []byte
+	ScriptKeyBytes   []byte
+	PublicationState int16
+	NumAttempts      int32
+	LastAttemptTime  sql.NullTime
+	LastError        sql.NullString
+}
+
 type UniverseRoot struct {
 	ID            int64
 	NamespaceRoot string
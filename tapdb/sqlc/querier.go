@@ -22,17 +22,28 @@ type Querier interface {
 	BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) error
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
+	CountScriptKeyReceipts(ctx context.Context, tweakedScriptKey []byte) (int32, error)
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
+	DeleteAssetSeedling(ctx context.Context, seedlingID int64) error
+	// The burns of the transfer are deleted along with it.
+	DeleteAssetTransfer(ctx context.Context, transferID int64) error
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
+	DeleteBatchTemplate(ctx context.Context, templateName string) (int64, error)
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeleteQueuedSend(ctx context.Context, queuedSendID int64) (int64, error)
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
+	DeleteSwapOffer(ctx context.Context, offerID []byte) (int64, error)
+	DeleteTransferInputs(ctx context.Context, transferID int64) error
+	DeleteTransferOutputs(ctx context.Context, transferID int64) error
+	DeleteTransferPassiveAssets(ctx context.Context, transferID int64) error
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
 	DeleteUniverseEvents(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
 	DeleteUniverseRoot(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseServer(ctx context.Context, arg DeleteUniverseServerParams) error
+	DeleteUnreferencedManagedUTXO(ctx context.Context, utxoID int64) error
 	FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error)
 	FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventRow, error)
 	FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error)
@@ -50,9 +61,13 @@ type Querier interface {
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]FetchAssetsForBatchRow, error)
+	FetchBatchTemplate(ctx context.Context, templateName string) (BatchTemplate, error)
+	FetchBatchTemplateAssets(ctx context.Context, templateID int64) ([]BatchTemplateAsset, error)
+	FetchBatchTemplates(ctx context.Context) ([]BatchTemplate, error)
 	FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, error)
 	FetchChildren(ctx context.Context, arg FetchChildrenParams) ([]FetchChildrenRow, error)
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
+	FetchFirstMintingBatchEventID(ctx context.Context, rawKey []byte) (int64, error)
 	FetchGenesisByAssetID(ctx context.Context, assetID []byte) (GenesisInfoView, error)
 	FetchGenesisByID(ctx context.Context, genAssetID int64) (FetchGenesisByIDRow, error)
 	FetchGenesisID(ctx context.Context, arg FetchGenesisIDParams) (int64, error)
@@ -63,14 +78,18 @@ type Querier interface {
 	FetchGroupedAssets(ctx context.Context) ([]FetchGroupedAssetsRow, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
+	FetchMetaBlob(ctx context.Context, blobHash []byte) ([]byte, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
+	FetchQueuedSendAddrs(ctx context.Context, queuedSendID int64) ([]string, error)
+	FetchQueuedSends(ctx context.Context) ([]QueuedSend, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
 	FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyByTweakedKeyRow, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
 	FetchSeedlingByID(ctx context.Context, seedlingID int64) (AssetSeedling, error)
 	FetchSeedlingID(ctx context.Context, arg FetchSeedlingIDParams) (int64, error)
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error)
+	FetchSwapOffer(ctx context.Context, offerID []byte) (SwapOffer, error)
 	FetchTransferInputs(ctx context.Context, transferID int64) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error)
 	FetchUniverseKeys(ctx context.Context, namespace string) ([]FetchUniverseKeysRow, error)
@@ -85,23 +104,33 @@ type Querier interface {
 	InsertAssetTransferInput(ctx context.Context, arg InsertAssetTransferInputParams) error
 	InsertAssetTransferOutput(ctx context.Context, arg InsertAssetTransferOutputParams) error
 	InsertAssetWitness(ctx context.Context, arg InsertAssetWitnessParams) error
+	InsertBatchTemplate(ctx context.Context, arg InsertBatchTemplateParams) (int64, error)
+	InsertBatchTemplateAsset(ctx context.Context, arg InsertBatchTemplateAssetParams) error
 	InsertBranch(ctx context.Context, arg InsertBranchParams) error
+	InsertBurn(ctx context.Context, arg InsertBurnParams) error
 	InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error
 	InsertLeaf(ctx context.Context, arg InsertLeafParams) error
+	InsertMintingBatchEvent(ctx context.Context, arg InsertMintingBatchEventParams) (InsertMintingBatchEventRow, error)
 	InsertNewAsset(ctx context.Context, arg InsertNewAssetParams) (int64, error)
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertQueuedSend(ctx context.Context, arg InsertQueuedSendParams) (int64, error)
+	InsertQueuedSendAddr(ctx context.Context, arg InsertQueuedSendAddrParams) error
 	InsertReceiverProofTransferAttempt(ctx context.Context, arg InsertReceiverProofTransferAttemptParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertSwapOffer(ctx context.Context, arg InsertSwapOfferParams) error
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
 	ListUniverseServers(ctx context.Context) ([]UniverseServer, error)
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
+	MarkMintingBatchReOrged(ctx context.Context, arg MarkMintingBatchReOrgedParams) error
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
+	// Assets locked to watch only script keys can't be spent by the wallet on its
+	// own, so they don't count towards the balance.
 	QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter []byte) ([]QueryAssetBalancesByAssetRow, error)
 	QueryAssetBalancesByGroup(ctx context.Context, keyGroupFilter []byte) ([]QueryAssetBalancesByGroupRow, error)
 	QueryAssetStatsPerDayPostgres(ctx context.Context, arg QueryAssetStatsPerDayPostgresParams) ([]QueryAssetStatsPerDayPostgresRow, error)
@@ -110,6 +139,7 @@ type Querier interface {
 	// unconfirmed. But only if the unconf_only field is set.
 	// Here we have another optional query clause to select a given transfer
 	// based on the anchor_tx_hash, but only if it's specified.
+	// Transfers can also be filtered by their label, if one is specified.
 	QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfersParams) ([]QueryAssetTransfersRow, error)
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
@@ -120,23 +150,46 @@ type Querier interface {
 	// make the entire statement evaluate to true, if none of these extra args are
 	// specified.
 	QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]QueryAssetsRow, error)
+	// Burns can optionally be filtered by the burned asset ID, its group key,
+	// the burn script key and the anchor transaction of the burn.
+	QueryBurns(ctx context.Context, arg QueryBurnsParams) ([]QueryBurnsRow, error)
+	// A delegate key can only issue into an existing group, so the group of its
+	// seedlings is always known from the start.
+	QueryDelegatedSupply(ctx context.Context, arg QueryDelegatedSupplyParams) (int64, error)
 	QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error)
 	QueryFederationGlobalSyncConfigs(ctx context.Context) ([]FederationGlobalSyncConfig, error)
 	QueryFederationUniSyncConfigs(ctx context.Context) ([]FederationUniSyncConfig, error)
+	// The group of a seedling is either known from the start, if it is issued
+	// into an existing group, or once the genesis asset of the seedling has been
+	// created when the batch was committed.
+	QueryGroupSupply(ctx context.Context, arg QueryGroupSupplyParams) (int64, error)
+	QueryMintingBatchEvents(ctx context.Context, arg QueryMintingBatchEventsParams) ([]QueryMintingBatchEventsRow, error)
 	QueryPassiveAssets(ctx context.Context, transferID int64) ([]QueryPassiveAssetsRow, error)
 	QueryReceiverProofTransferAttempt(ctx context.Context, proofLocatorHash []byte) ([]time.Time, error)
 	// TODO(roasbeef): use the universe id instead for the grouping? so namespace
 	// root, simplifies queries
 	QueryUniverseAssetStats(ctx context.Context, arg QueryUniverseAssetStatsParams) ([]QueryUniverseAssetStatsRow, error)
 	QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error)
+	QueryUniversePublications(ctx context.Context, targetHost string) ([]UniversePublication, error)
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
+	ReAnchorManagedUTXO(ctx context.Context, arg ReAnchorManagedUTXOParams) error
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	ReplaceTransferAnchorTx(ctx context.Context, arg ReplaceTransferAnchorTxParams) error
+	SetAddrEventLabel(ctx context.Context, arg SetAddrEventLabelParams) (int64, error)
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64, error)
+	SetTransferCancelTx(ctx context.Context, arg SetTransferCancelTxParams) error
 	UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error)
 	UniverseRoots(ctx context.Context) ([]UniverseRootsRow, error)
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
+	UpdateMintingBatchExternalFunding(ctx context.Context, arg UpdateMintingBatchExternalFundingParams) error
+	UpdateMintingBatchLabel(ctx context.Context, arg UpdateMintingBatchLabelParams) error
+	UpdateMintingBatchReOrgState(ctx context.Context, arg UpdateMintingBatchReOrgStateParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdatePassiveAssetProof(ctx context.Context, arg UpdatePassiveAssetProofParams) error
+	UpdateQueuedSend(ctx context.Context, arg UpdateQueuedSendParams) (int64, error)
+	UpdateTransferOutputProofSuffix(ctx context.Context, arg UpdateTransferOutputProofSuffixParams) error
+	UpdateTransferOutputsProofCourierAddr(ctx context.Context, arg UpdateTransferOutputsProofCourierAddrParams) error
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int64, error)
 	UpsertAssetGroupKey(ctx context.Context, arg UpsertAssetGroupKeyParams) (int64, error)
@@ -150,9 +203,11 @@ type Querier interface {
 	UpsertGenesisPoint(ctx context.Context, prevOut []byte) (int64, error)
 	UpsertInternalKey(ctx context.Context, arg UpsertInternalKeyParams) (int64, error)
 	UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOParams) (int64, error)
+	UpsertMetaBlob(ctx context.Context, arg UpsertMetaBlobParams) error
 	UpsertRootNode(ctx context.Context, arg UpsertRootNodeParams) error
 	UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int64, error)
 	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error
+	UpsertUniversePublication(ctx context.Context, arg UpsertUniversePublicationParams) error
 	UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRootParams) (int64, error)
 }
 
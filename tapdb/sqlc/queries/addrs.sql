@@ -2,16 +2,19 @@
 INSERT INTO addrs (
     version, asset_version, genesis_asset_id, group_key, script_key_id,
     taproot_key_id, tapscript_sibling, taproot_output_key, amount, asset_type,
-    creation_time, proof_courier_addr
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id;
+    creation_time, proof_courier_addr, fallback_proof_courier_addrs
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING id;
 
 -- name: FetchAddrs :many
 SELECT 
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
     taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    proof_courier_addr, fallback_proof_courier_addrs,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.watch_only AS script_key_watch_only,
     raw_script_keys.raw_key AS raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -36,9 +39,10 @@ LIMIT @num_limit OFFSET @num_offset;
 SELECT
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
     taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    proof_courier_addr, fallback_proof_courier_addrs,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.watch_only AS script_key_watch_only,
     raw_script_keys.raw_key as raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -89,13 +93,21 @@ RETURNING id;
 
 -- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    creation_time, status, asset_proof_id, asset_id, label,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
     managed_utxos.amt_sats as amt_sats,
     managed_utxos.tapscript_sibling as tapscript_sibling,
-    internal_keys.raw_key as internal_key
+    internal_keys.raw_key as internal_key,
+    -- An address is reused if it already received assets in an earlier
+    -- transaction.
+    (
+        SELECT COUNT(*)
+        FROM addr_events prev_events
+        WHERE prev_events.addr_id = addr_events.addr_id
+          AND prev_events.id < addr_events.id
+    ) AS num_prev_events
 FROM addr_events
 LEFT JOIN chain_txns
        ON addr_events.chain_txn_id = chain_txns.txn_id
@@ -103,7 +115,7 @@ LEFT JOIN managed_utxos
        ON addr_events.managed_utxo_id = managed_utxos.utxo_id
 LEFT JOIN internal_keys
        ON managed_utxos.internal_key_id = internal_keys.key_id
-WHERE id = $1;
+WHERE addr_events.id = $1;
 
 -- name: QueryEventIDs :many
 SELECT
@@ -114,4 +126,35 @@ JOIN addrs
 WHERE addr_events.status >= @status_from 
   AND addr_events.status <= @status_to
   AND COALESCE(@addr_taproot_key, addrs.taproot_output_key) = addrs.taproot_output_key
+  AND (addr_events.label = sqlc.narg('label') OR
+       sqlc.narg('label') IS NULL)
 ORDER by addr_events.creation_time;
+
+-- name: SetAddrEventLabel :execrows
+WITH target_txn(txn_id) AS (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = @txid
+)
+UPDATE addr_events
+SET label = @label
+WHERE chain_txn_id = (SELECT txn_id FROM target_txn)
+  AND chain_txn_output_index = @chain_txn_output_index;
+
+-- name: CountScriptKeyReceipts :one
+SELECT
+    (
+        SELECT COUNT(*)
+        FROM asset_transfer_outputs outputs
+        JOIN script_keys keys
+            ON outputs.script_key = keys.script_key_id
+        WHERE keys.tweaked_script_key = @tweaked_script_key
+    ) + (
+        SELECT COUNT(*)
+        FROM addr_events events
+        JOIN addrs
+            ON events.addr_id = addrs.id
+        JOIN script_keys keys
+            ON addrs.script_key_id = keys.script_key_id
+        WHERE keys.tweaked_script_key = @tweaked_script_key
+    ) AS num_receipts;
@@ -10,8 +10,8 @@ RETURNING key_id;
 
 -- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3);
+    batch_state, batch_id, height_hint, creation_time_unix, tapscript_sibling
+) VALUES (0, $1, $2, $3, $4);
 
 -- name: FetchMintingBatchesByInverseState :many
 SELECT *
@@ -54,13 +54,102 @@ UPDATE asset_minting_batches
 SET batch_state = $2
 WHERE batch_id in (SELECT batch_id FROM target_batch);
 
+-- name: UpdateMintingBatchExternalFunding :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET external_funding = $2
+WHERE batch_id in (SELECT batch_id FROM target_batch);
+
+-- name: UpdateMintingBatchLabel :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET label = $2
+WHERE batch_id in (SELECT batch_id FROM target_batch);
+
+-- name: MarkMintingBatchReOrged :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET reorg_state = $2, num_reorgs = num_reorgs + 1
+WHERE batch_id in (SELECT batch_id FROM target_batch);
+
+-- name: UpdateMintingBatchReOrgState :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET reorg_state = $2
+WHERE batch_id in (SELECT batch_id FROM target_batch);
+
+-- name: InsertMintingBatchEvent :one
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = @raw_key
+)
+INSERT INTO mint_batch_events (
+    batch_id, batch_state, block_height, event_time_unix
+) VALUES (
+    (SELECT batch_id FROM target_batch), @batch_state, @block_height,
+    @event_time_unix
+)
+RETURNING event_id, block_height;
+
+-- name: FetchFirstMintingBatchEventID :one
+SELECT events.event_id
+FROM mint_batch_events events
+JOIN internal_keys keys
+    ON events.batch_id = keys.key_id
+WHERE keys.raw_key = $1
+ORDER BY events.event_id
+LIMIT 1;
+
+-- name: QueryMintingBatchEvents :many
+SELECT
+    events.event_id, keys.raw_key AS batch_key, events.batch_state,
+    events.block_height, events.event_time_unix
+FROM mint_batch_events events
+JOIN internal_keys keys
+    ON events.batch_id = keys.key_id
+WHERE events.event_id >= @start_event_id
+    AND events.block_height >= @start_height
+ORDER BY events.event_id;
+
 -- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_signer, group_internal_key_id, vanity_prefix,
+    script_key_id, group_multisig_script, delegate_key_id
 ) VALUES (
    $1, $2, $3, $4, $5, $6, $7,
-   sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id')
+   sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id'),
+   @external_group_signer, sqlc.narg('group_internal_key_id'),
+   @vanity_prefix, sqlc.narg('script_key_id'), @group_multisig_script,
+   sqlc.narg('delegate_key_id')
 );
 
 -- name: FetchSeedlingID :one
@@ -85,6 +174,10 @@ SELECT *
 FROM asset_seedlings
 WHERE seedling_id = @seedling_id;
 
+-- name: DeleteAssetSeedling :exec
+DELETE FROM asset_seedlings
+WHERE seedling_id = @seedling_id;
+
 -- name: AllInternalKeys :many
 SELECT * 
 FROM internal_keys;
@@ -108,11 +201,16 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_signer, group_internal_key_id, vanity_prefix,
+    script_key_id, group_multisig_script, delegate_key_id
 ) VALUES (
     $2, $3, $4, $5, $6, $7,
     (SELECT key_id FROM target_key_id),
-    sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id')
+    sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id'),
+    @external_group_signer, sqlc.narg('group_internal_key_id'),
+    @vanity_prefix, sqlc.narg('script_key_id'), @group_multisig_script,
+    sqlc.narg('delegate_key_id')
 );
 
 -- name: FetchSeedlingsForBatch :many
@@ -126,10 +224,30 @@ WITH target_batch(batch_id) AS (
 SELECT seedling_id, asset_name, asset_type, asset_version, asset_supply, 
     assets_meta.meta_data_hash, assets_meta.meta_data_type, 
     assets_meta.meta_data_blob, emission_enabled, batch_id, 
-    group_genesis_id, group_anchor_id
+    group_genesis_id, group_anchor_id, external_group_signer, vanity_prefix,
+    group_multisig_script,
+    group_keys.raw_key AS group_internal_key_raw,
+    group_keys.key_family AS group_internal_key_fam,
+    group_keys.key_index AS group_internal_key_index,
+    script_keys.tweaked_script_key AS script_key_tweaked,
+    script_keys.tweak AS script_key_tweak,
+    script_internal_keys.raw_key AS script_key_raw,
+    script_internal_keys.key_family AS script_key_fam,
+    script_internal_keys.key_index AS script_key_index,
+    delegate_keys.raw_key AS delegate_key_raw,
+    delegate_keys.key_family AS delegate_key_fam,
+    delegate_keys.key_index AS delegate_key_index
 FROM asset_seedlings 
 LEFT JOIN assets_meta
     ON asset_seedlings.asset_meta_id = assets_meta.meta_id
+LEFT JOIN internal_keys group_keys
+    ON asset_seedlings.group_internal_key_id = group_keys.key_id
+LEFT JOIN script_keys
+    ON asset_seedlings.script_key_id = script_keys.script_key_id
+LEFT JOIN internal_keys script_internal_keys
+    ON script_keys.internal_key_id = script_internal_keys.key_id
+LEFT JOIN internal_keys delegate_keys
+    ON asset_seedlings.delegate_key_id = delegate_keys.key_id
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch);
 
 -- name: UpsertGenesisPoint :one
@@ -291,6 +409,11 @@ JOIN genesis_info_view
 -- around that needs to be used with this query until a sqlc bug is fixed.
 LEFT JOIN key_group_info_view
     ON assets.genesis_id = key_group_info_view.gen_asset_id
+-- Assets locked to watch only script keys can't be spent by the wallet on its
+-- own, so they don't count towards the balance.
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id AND
+       script_keys.watch_only = FALSE
 WHERE spent = FALSE
 GROUP BY assets.genesis_id, genesis_info_view.asset_id,
          version, genesis_info_view.asset_tag, genesis_info_view.meta_hash,
@@ -305,9 +428,55 @@ JOIN key_group_info_view
     ON assets.genesis_id = key_group_info_view.gen_asset_id AND
       (key_group_info_view.tweaked_group_key = sqlc.narg('key_group_filter') OR
         sqlc.narg('key_group_filter') IS NULL)
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id AND
+       script_keys.watch_only = FALSE
 WHERE spent = FALSE
 GROUP BY key_group_info_view.tweaked_group_key;
 
+-- name: QueryGroupSupply :one
+-- The group of a seedling is either known from the start, if it is issued
+-- into an existing group, or once the genesis asset of the seedling has been
+-- created when the batch was committed.
+SELECT CAST(COALESCE(SUM(seedlings.asset_supply), 0) AS BIGINT) AS supply
+FROM asset_seedlings seedlings
+JOIN asset_minting_batches batches
+    ON seedlings.batch_id = batches.batch_id
+LEFT JOIN genesis_assets gen
+    ON gen.genesis_point_id = batches.genesis_id AND
+       gen.asset_tag = seedlings.asset_name
+WHERE batches.batch_state NOT IN (
+    @seedling_cancelled_state, @sprout_cancelled_state
+) AND (
+    seedlings.group_genesis_id IN (
+        SELECT gen_asset_id
+        FROM key_group_info_view
+        WHERE key_group_info_view.tweaked_group_key = @group_key
+    ) OR gen.gen_asset_id IN (
+        SELECT gen_asset_id
+        FROM key_group_info_view
+        WHERE key_group_info_view.tweaked_group_key = @group_key
+    )
+);
+
+-- name: QueryDelegatedSupply :one
+-- A delegate key can only issue into an existing group, so the group of its
+-- seedlings is always known from the start.
+SELECT CAST(COALESCE(SUM(seedlings.asset_supply), 0) AS BIGINT) AS supply
+FROM asset_seedlings seedlings
+JOIN asset_minting_batches batches
+    ON seedlings.batch_id = batches.batch_id
+JOIN internal_keys delegate_keys
+    ON seedlings.delegate_key_id = delegate_keys.key_id
+WHERE batches.batch_state NOT IN (
+    @seedling_cancelled_state, @sprout_cancelled_state
+) AND delegate_keys.raw_key = @delegate_key AND
+    seedlings.group_genesis_id IN (
+        SELECT gen_asset_id
+        FROM key_group_info_view
+        WHERE key_group_info_view.tweaked_group_key = @group_key
+    );
+
 -- name: FetchGroupedAssets :many
 SELECT
     assets.asset_id AS asset_primary_key,
@@ -359,6 +528,7 @@ SELECT
     assets.asset_id AS asset_primary_key, assets.genesis_id, version, spent,
     script_keys.tweak AS script_key_tweak, 
     script_keys.tweaked_script_key, 
+    script_keys.watch_only AS script_key_watch_only,
     internal_keys.raw_key AS script_key_raw,
     internal_keys.key_family AS script_key_fam,
     internal_keys.key_index AS script_key_index,
@@ -401,7 +571,8 @@ LEFT JOIN key_group_info_view
 JOIN script_keys
     ON assets.script_key_id = script_keys.script_key_id AND
       (script_keys.tweaked_script_key = sqlc.narg('tweaked_script_key') OR
-       sqlc.narg('tweaked_script_key') IS NULL)
+       sqlc.narg('tweaked_script_key') IS NULL) AND
+      script_keys.watch_only = COALESCE(sqlc.narg('watch_only'), script_keys.watch_only)
 JOIN internal_keys
     ON script_keys.internal_key_id = internal_keys.key_id
 JOIN managed_utxos utxos
@@ -734,9 +905,9 @@ WHERE txid = $1;
 
 -- name: UpsertScriptKey :one
 INSERT INTO script_keys (
-    internal_key_id, tweaked_script_key, tweak
+    internal_key_id, tweaked_script_key, tweak, watch_only
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4
 )  ON CONFLICT (tweaked_script_key)
     -- As a NOP, we just set the script key to the one that triggered the
     -- conflict.
@@ -749,7 +920,7 @@ FROM script_keys
 WHERE tweaked_script_key = $1;
 
 -- name: FetchScriptKeyByTweakedKey :one
-SELECT tweak, raw_key, key_family, key_index
+SELECT tweak, watch_only, raw_key, key_family, key_index
 FROM script_keys
 JOIN internal_keys
   ON script_keys.internal_key_id = internal_keys.key_id
@@ -790,3 +961,56 @@ FROM genesis_assets assets
 JOIN assets_meta
     ON assets.meta_data_id = assets_meta.meta_id
 WHERE assets.asset_id = $1;
+
+-- name: UpsertMetaBlob :exec
+INSERT INTO asset_meta_blobs (
+    blob_hash, blob
+) VALUES (
+    $1, $2
+) ON CONFLICT (blob_hash)
+    -- The blob hash commits to the blob, so there's nothing to update.
+    DO NOTHING;
+
+-- name: FetchMetaBlob :one
+SELECT blob
+FROM asset_meta_blobs
+WHERE blob_hash = $1;
+
+-- name: InsertBatchTemplate :one
+INSERT INTO batch_templates (
+    template_name, creation_time_unix
+) VALUES (
+    $1, $2
+)
+RETURNING template_id;
+
+-- name: InsertBatchTemplateAsset :exec
+INSERT INTO batch_template_assets (
+    template_id, asset_name, asset_version, asset_type, asset_supply,
+    decimal_display, meta_data_type, meta_data_blob, emission_enabled,
+    group_key, group_anchor_name
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+);
+
+-- name: FetchBatchTemplate :one
+SELECT template_id, template_name, creation_time_unix
+FROM batch_templates
+WHERE template_name = $1;
+
+-- name: FetchBatchTemplates :many
+SELECT template_id, template_name, creation_time_unix
+FROM batch_templates
+ORDER BY template_name;
+
+-- name: FetchBatchTemplateAssets :many
+SELECT template_asset_id, template_id, asset_name, asset_version, asset_type,
+    asset_supply, decimal_display, meta_data_type, meta_data_blob,
+    emission_enabled, group_key, group_anchor_name
+FROM batch_template_assets
+WHERE template_id = $1
+ORDER BY template_asset_id;
+
+-- name: DeleteBatchTemplate :execrows
+DELETE FROM batch_templates
+WHERE template_name = $1;
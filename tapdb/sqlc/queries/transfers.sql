@@ -5,11 +5,76 @@ WITH target_txn(txn_id) AS (
     WHERE txid = @anchor_txid
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, anchor_psbt, label
 ) VALUES (
-    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix
+    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix,
+    @anchor_psbt, @label
 ) RETURNING id;
 
+-- name: ReplaceTransferAnchorTx :exec
+WITH target_txn(txn_id) AS (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = @anchor_txid
+)
+UPDATE asset_transfers
+SET anchor_txn_id = (SELECT txn_id FROM target_txn),
+    anchor_psbt = @anchor_psbt
+WHERE id = @transfer_id;
+
+-- name: SetTransferCancelTx :exec
+UPDATE asset_transfers
+SET cancel_tx = @cancel_tx
+WHERE id = @transfer_id;
+
+-- name: ReAnchorManagedUTXO :exec
+UPDATE managed_utxos
+SET outpoint = @outpoint, amt_sats = @amt_sats, txn_id = @txn_id
+WHERE utxo_id = @utxo_id;
+
+-- name: UpdateTransferOutputProofSuffix :exec
+UPDATE asset_transfer_outputs
+SET proof_suffix = @proof_suffix
+WHERE output_id = @output_id;
+
+-- name: UpdateTransferOutputsProofCourierAddr :exec
+UPDATE asset_transfer_outputs
+SET proof_courier_addr = @proof_courier_addr
+WHERE transfer_id = @transfer_id AND script_key_local = false;
+
+-- name: DeleteTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = @transfer_id;
+
+-- name: DeleteTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = @transfer_id;
+
+-- name: DeleteTransferPassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = @transfer_id;
+
+-- name: DeleteAssetTransfer :exec
+-- The burns of the transfer are deleted along with it.
+DELETE FROM asset_transfers
+WHERE id = @transfer_id;
+
+-- name: DeleteUnreferencedManagedUTXO :exec
+DELETE FROM managed_utxos
+WHERE utxo_id = @utxo_id AND NOT EXISTS (
+    SELECT 1 FROM assets
+    WHERE assets.anchor_utxo_id = managed_utxos.utxo_id
+) AND NOT EXISTS (
+    SELECT 1 FROM asset_transfer_outputs outputs
+    WHERE outputs.anchor_utxo = managed_utxos.utxo_id
+) AND NOT EXISTS (
+    SELECT 1 FROM passive_assets
+    WHERE passive_assets.new_anchor_utxo = managed_utxos.utxo_id
+) AND NOT EXISTS (
+    SELECT 1 FROM addr_events
+    WHERE addr_events.managed_utxo_id = managed_utxos.utxo_id
+);
+
 -- name: InsertAssetTransferInput :exec
 INSERT INTO asset_transfer_inputs (
     transfer_id, anchor_point, asset_id, script_key, amount
@@ -22,14 +87,16 @@ INSERT INTO asset_transfer_outputs (
     transfer_id, anchor_utxo, script_key, script_key_local,
     amount, serialized_witnesses, split_commitment_root_hash,
     split_commitment_root_value, proof_suffix, num_passive_assets,
-    output_type, proof_courier_addr, asset_version
+    output_type, proof_courier_addr, asset_version,
+    fallback_proof_courier_addrs, recipient_addr
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 );
 
 -- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt, label,
+    cancel_tx
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -42,6 +109,10 @@ WHERE (@unconf_only = false OR @unconf_only IS NULL OR
 -- based on the anchor_tx_hash, but only if it's specified.
 AND (txns.txid = sqlc.narg('anchor_tx_hash') OR
     sqlc.narg('anchor_tx_hash') IS NULL)
+
+-- Transfers can also be filtered by their label, if one is specified.
+AND (transfers.label = sqlc.narg('label') OR
+    sqlc.narg('label') IS NULL)
 ORDER BY transfer_time_unix;
 
 -- name: FetchTransferInputs :many
@@ -55,6 +126,7 @@ SELECT
     output_id, proof_suffix, amount, serialized_witnesses, script_key_local,
     split_commitment_root_hash, split_commitment_root_value, num_passive_assets,
     output_type, proof_courier_addr, asset_version,
+    fallback_proof_courier_addrs, recipient_addr,
     utxos.utxo_id AS anchor_utxo_id,
     utxos.outpoint AS anchor_outpoint,
     utxos.amt_sats AS anchor_value,
@@ -149,9 +221,14 @@ INSERT INTO passive_assets (
     @script_key, @new_witness_stack, @new_proof, @asset_version
 );
 
+-- name: UpdatePassiveAssetProof :exec
+UPDATE passive_assets
+SET new_proof = @new_proof
+WHERE passive_id = @passive_id;
+
 -- name: QueryPassiveAssets :many
-SELECT passive.asset_id, passive.new_anchor_utxo, passive.script_key,
-       passive.new_witness_stack, passive.new_proof,
+SELECT passive.passive_id, passive.asset_id, passive.new_anchor_utxo,
+       passive.script_key, passive.new_witness_stack, passive.new_proof,
        genesis_assets.asset_id AS genesis_id, passive.asset_version
 FROM passive_assets as passive
     JOIN assets
@@ -159,3 +236,84 @@ FROM passive_assets as passive
     JOIN genesis_assets
         ON assets.genesis_id = genesis_assets.gen_asset_id
 WHERE passive.transfer_id = @transfer_id;
+
+-- name: InsertQueuedSend :one
+INSERT INTO queued_sends (
+    coin_select_strategy, send_after_unix, max_fee_rate, creation_time_unix
+) VALUES (
+    @coin_select_strategy, @send_after_unix, @max_fee_rate,
+    @creation_time_unix
+) RETURNING queued_send_id;
+
+-- name: InsertQueuedSendAddr :exec
+INSERT INTO queued_send_addrs (
+    queued_send_id, tap_addr
+) VALUES (
+    @queued_send_id, @tap_addr
+);
+
+-- name: FetchQueuedSends :many
+SELECT *
+FROM queued_sends
+ORDER BY queued_send_id;
+
+-- name: FetchQueuedSendAddrs :many
+SELECT tap_addr
+FROM queued_send_addrs
+WHERE queued_send_id = @queued_send_id
+ORDER BY queued_send_addr_id;
+
+-- name: UpdateQueuedSend :execrows
+UPDATE queued_sends
+SET send_after_unix = @send_after_unix, max_fee_rate = @max_fee_rate,
+    last_error = @last_error, failed = @failed
+WHERE queued_send_id = @queued_send_id;
+
+-- name: DeleteQueuedSend :execrows
+DELETE FROM queued_sends
+WHERE queued_send_id = @queued_send_id;
+
+-- name: InsertBurn :exec
+INSERT INTO asset_burns (
+    transfer_id, note, asset_id, group_key, amount, script_key
+) VALUES (
+    @transfer_id, @note, @asset_id, @group_key, @amount, @script_key
+);
+
+-- name: QueryBurns :many
+SELECT
+    burns.note, burns.asset_id, burns.group_key, burns.amount,
+    burns.script_key, transfers.transfer_time_unix, txns.txid AS anchor_txid,
+    txns.block_height, txns.block_hash
+FROM asset_burns burns
+JOIN asset_transfers transfers
+    ON burns.transfer_id = transfers.id
+JOIN chain_txns txns
+    ON transfers.anchor_txn_id = txns.txn_id
+-- Burns can optionally be filtered by the burned asset ID, its group key,
+-- the burn script key and the anchor transaction of the burn.
+WHERE (burns.asset_id = sqlc.narg('asset_id') OR
+    sqlc.narg('asset_id') IS NULL)
+AND (burns.group_key = sqlc.narg('group_key') OR
+    sqlc.narg('group_key') IS NULL)
+AND (burns.script_key = sqlc.narg('script_key') OR
+    sqlc.narg('script_key') IS NULL)
+AND (txns.txid = sqlc.narg('anchor_txid') OR
+    sqlc.narg('anchor_txid') IS NULL)
+ORDER BY burns.burn_id;
+
+-- name: InsertSwapOffer :exec
+INSERT INTO swap_offers (
+    offer_id, payment_pk_script, payment_value, creation_time_unix
+) VALUES (
+    @offer_id, @payment_pk_script, @payment_value, @creation_time_unix
+);
+
+-- name: FetchSwapOffer :one
+SELECT *
+FROM swap_offers
+WHERE offer_id = @offer_id;
+
+-- name: DeleteSwapOffer :execrows
+DELETE FROM swap_offers
+WHERE offer_id = @offer_id;
@@ -345,4 +345,25 @@ ON CONFLICT(namespace)
 -- name: QueryFederationUniSyncConfigs :many
 SELECT namespace, asset_id, group_key, proof_type, allow_sync_insert, allow_sync_export
 FROM federation_uni_sync_config
-ORDER BY group_key NULLS LAST, asset_id NULLS LAST, proof_type;
\ No newline at end of file
+ORDER BY group_key NULLS LAST, asset_id NULLS LAST, proof_type;
+-- name: UpsertUniversePublication :exec
+INSERT INTO universe_publications (
+    target_host, namespace_root, asset_id, group_key, proof_type,
+    minting_point, script_key_bytes, publication_state, num_attempts,
+    last_attempt_time, last_error
+) VALUES (
+    @target_host, @namespace_root, @asset_id, sqlc.narg('group_key'),
+    @proof_type, @minting_point, @script_key_bytes, @publication_state,
+    @num_attempts, sqlc.narg('last_attempt_time'), sqlc.narg('last_error')
+)
+ON CONFLICT (target_host, namespace_root, minting_point, script_key_bytes)
+    DO UPDATE SET publication_state = EXCLUDED.publication_state,
+        num_attempts = EXCLUDED.num_attempts,
+        last_attempt_time = EXCLUDED.last_attempt_time,
+        last_error = EXCLUDED.last_error;
+
+-- name: QueryUniversePublications :many
+SELECT *
+FROM universe_publications
+WHERE target_host = @target_host
+ORDER BY id;
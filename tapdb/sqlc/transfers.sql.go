@@ -62,6 +62,17 @@ func (q *Queries) ApplyPendingOutput(ctx context.Context, arg ApplyPendingOutput
 	return asset_id, err
 }
 
+const deleteAssetTransfer = `-- name: DeleteAssetTransfer :exec
+DELETE FROM asset_transfers
+WHERE id = $1
+`
+
+// The burns of the transfer are deleted along with it.
+func (q *Queries) DeleteAssetTransfer(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransfer, transferID)
+	return err
+}
+
 const deleteAssetWitnesses = `-- name: DeleteAssetWitnesses :exec
 DELETE FROM asset_witnesses
 WHERE asset_id = $1
@@ -72,6 +83,169 @@ func (q *Queries) DeleteAssetWitnesses(ctx context.Context, assetID int64) error
 	return err
 }
 
+const deleteQueuedSend = `-- name: DeleteQueuedSend :execrows
+DELETE FROM queued_sends
+WHERE queued_send_id = $1
+`
+
+func (q *Queries) DeleteQueuedSend(ctx context.Context, queuedSendID int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteQueuedSend, queuedSendID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteSwapOffer = `-- name: DeleteSwapOffer :execrows
+DELETE FROM swap_offers
+WHERE offer_id = $1
+`
+
+func (q *Queries) DeleteSwapOffer(ctx context.Context, offerID []byte) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteSwapOffer, offerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTransferInputs = `-- name: DeleteTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteTransferInputs(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteTransferInputs, transferID)
+	return err
+}
+
+const deleteTransferOutputs = `-- name: DeleteTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteTransferOutputs(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteTransferOutputs, transferID)
+	return err
+}
+
+const deleteTransferPassiveAssets = `-- name: DeleteTransferPassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteTransferPassiveAssets(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteTransferPassiveAssets, transferID)
+	return err
+}
+
+const deleteUnreferencedManagedUTXO = `-- name: DeleteUnreferencedManagedUTXO :exec
+DELETE FROM managed_utxos
+WHERE utxo_id = $1 AND NOT EXISTS (
+    SELECT 1 FROM assets
+    WHERE assets.anchor_utxo_id = managed_utxos.utxo_id
+) AND NOT EXISTS (
+    SELECT 1 FROM asset_transfer_outputs outputs
+    WHERE outputs.anchor_utxo = managed_utxos.utxo_id
+) AND NOT EXISTS (
+    SELECT 1 FROM passive_assets
+    WHERE passive_assets.new_anchor_utxo = managed_utxos.utxo_id
+) AND NOT EXISTS (
+    SELECT 1 FROM addr_events
+    WHERE addr_events.managed_utxo_id = managed_utxos.utxo_id
+)
+`
+
+func (q *Queries) DeleteUnreferencedManagedUTXO(ctx context.Context, utxoID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUnreferencedManagedUTXO, utxoID)
+	return err
+}
+
+const fetchQueuedSendAddrs = `-- name: FetchQueuedSendAddrs :many
+SELECT tap_addr
+FROM queued_send_addrs
+WHERE queued_send_id = $1
+ORDER BY queued_send_addr_id
+`
+
+func (q *Queries) FetchQueuedSendAddrs(ctx context.Context, queuedSendID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, fetchQueuedSendAddrs, queuedSendID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tap_addr string
+		if err := rows.Scan(&tap_addr); err != nil {
+			return nil, err
+		}
+		items = append(items, tap_addr)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchQueuedSends = `-- name: FetchQueuedSends :many
+SELECT queued_send_id, coin_select_strategy, send_after_unix, max_fee_rate, creation_time_unix, last_error, failed
+FROM queued_sends
+ORDER BY queued_send_id
+`
+
+func (q *Queries) FetchQueuedSends(ctx context.Context) ([]QueuedSend, error) {
+	rows, err := q.db.QueryContext(ctx, fetchQueuedSends)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueuedSend
+	for rows.Next() {
+		var i QueuedSend
+		if err := rows.Scan(
+			&i.QueuedSendID,
+			&i.CoinSelectStrategy,
+			&i.SendAfterUnix,
+			&i.MaxFeeRate,
+			&i.CreationTimeUnix,
+			&i.LastError,
+			&i.Failed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchSwapOffer = `-- name: FetchSwapOffer :one
+SELECT offer_id, payment_pk_script, payment_value, creation_time_unix
+FROM swap_offers
+WHERE offer_id = $1
+`
+
+func (q *Queries) FetchSwapOffer(ctx context.Context, offerID []byte) (SwapOffer, error) {
+	row := q.db.QueryRowContext(ctx, fetchSwapOffer, offerID)
+	var i SwapOffer
+	err := row.Scan(
+		&i.OfferID,
+		&i.PaymentPkScript,
+		&i.PaymentValue,
+		&i.CreationTimeUnix,
+	)
+	return i, err
+}
+
 const fetchTransferInputs = `-- name: FetchTransferInputs :many
 SELECT input_id, anchor_point, asset_id, script_key, amount
 FROM asset_transfer_inputs inputs
@@ -121,6 +295,7 @@ SELECT
     output_id, proof_suffix, amount, serialized_witnesses, script_key_local,
     split_commitment_root_hash, split_commitment_root_value, num_passive_assets,
     output_type, proof_courier_addr, asset_version,
+    fallback_proof_courier_addrs, recipient_addr,
     utxos.utxo_id AS anchor_utxo_id,
     utxos.outpoint AS anchor_outpoint,
     utxos.amt_sats AS anchor_value,
@@ -150,32 +325,34 @@ ORDER BY output_id
 `
 
 type FetchTransferOutputsRow struct {
-	OutputID                 int64
-	ProofSuffix              []byte
-	Amount                   int64
-	SerializedWitnesses      []byte
-	ScriptKeyLocal           bool
-	SplitCommitmentRootHash  []byte
-	SplitCommitmentRootValue sql.NullInt64
-	NumPassiveAssets         int32
-	OutputType               int16
-	ProofCourierAddr         []byte
-	AssetVersion             int32
-	AnchorUtxoID             int64
-	AnchorOutpoint           []byte
-	AnchorValue              int64
-	AnchorMerkleRoot         []byte
-	AnchorTaprootAssetRoot   []byte
-	AnchorTapscriptSibling   []byte
-	InternalKeyRawKeyBytes   []byte
-	InternalKeyFamily        int32
-	InternalKeyIndex         int32
-	ScriptKeyBytes           []byte
-	ScriptKeyTweak           []byte
-	ScriptKeyID              int64
-	ScriptKeyRawKeyBytes     []byte
-	ScriptKeyFamily          int32
-	ScriptKeyIndex           int32
+	OutputID                  int64
+	ProofSuffix               []byte
+	Amount                    int64
+	SerializedWitnesses       []byte
+	ScriptKeyLocal            bool
+	SplitCommitmentRootHash   []byte
+	SplitCommitmentRootValue  sql.NullInt64
+	NumPassiveAssets          int32
+	OutputType                int16
+	ProofCourierAddr          []byte
+	AssetVersion              int32
+	FallbackProofCourierAddrs []byte
+	RecipientAddr             sql.NullString
+	AnchorUtxoID              int64
+	AnchorOutpoint            []byte
+	AnchorValue               int64
+	AnchorMerkleRoot          []byte
+	AnchorTaprootAssetRoot    []byte
+	AnchorTapscriptSibling    []byte
+	InternalKeyRawKeyBytes    []byte
+	InternalKeyFamily         int32
+	InternalKeyIndex          int32
+	ScriptKeyBytes            []byte
+	ScriptKeyTweak            []byte
+	ScriptKeyID               int64
+	ScriptKeyRawKeyBytes      []byte
+	ScriptKeyFamily           int32
+	ScriptKeyIndex            int32
 }
 
 func (q *Queries) FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error) {
@@ -199,6 +376,8 @@ func (q *Queries) FetchTransferOutputs(ctx context.Context, transferID int64) ([
 			&i.OutputType,
 			&i.ProofCourierAddr,
 			&i.AssetVersion,
+			&i.FallbackProofCourierAddrs,
+			&i.RecipientAddr,
 			&i.AnchorUtxoID,
 			&i.AnchorOutpoint,
 			&i.AnchorValue,
@@ -232,23 +411,32 @@ const insertAssetTransfer = `-- name: InsertAssetTransfer :one
 WITH target_txn(txn_id) AS (
     SELECT txn_id
     FROM chain_txns
-    WHERE txid = $3
+    WHERE txid = $5
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, anchor_psbt, label
 ) VALUES (
-    $1, (SELECT txn_id FROM target_txn), $2
+    $1, (SELECT txn_id FROM target_txn), $2,
+    $3, $4
 ) RETURNING id
 `
 
 type InsertAssetTransferParams struct {
 	HeightHint       int32
 	TransferTimeUnix time.Time
+	AnchorPsbt       []byte
+	Label            sql.NullString
 	AnchorTxid       []byte
 }
 
 func (q *Queries) InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, insertAssetTransfer, arg.HeightHint, arg.TransferTimeUnix, arg.AnchorTxid)
+	row := q.db.QueryRowContext(ctx, insertAssetTransfer,
+		arg.HeightHint,
+		arg.TransferTimeUnix,
+		arg.AnchorPsbt,
+		arg.Label,
+		arg.AnchorTxid,
+	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
@@ -286,26 +474,29 @@ INSERT INTO asset_transfer_outputs (
     transfer_id, anchor_utxo, script_key, script_key_local,
     amount, serialized_witnesses, split_commitment_root_hash,
     split_commitment_root_value, proof_suffix, num_passive_assets,
-    output_type, proof_courier_addr, asset_version
+    output_type, proof_courier_addr, asset_version,
+    fallback_proof_courier_addrs, recipient_addr
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 )
 `
 
 type InsertAssetTransferOutputParams struct {
-	TransferID               int64
-	AnchorUtxo               int64
-	ScriptKey                int64
-	ScriptKeyLocal           bool
-	Amount                   int64
-	SerializedWitnesses      []byte
-	SplitCommitmentRootHash  []byte
-	SplitCommitmentRootValue sql.NullInt64
-	ProofSuffix              []byte
-	NumPassiveAssets         int32
-	OutputType               int16
-	ProofCourierAddr         []byte
-	AssetVersion             int32
+	TransferID                int64
+	AnchorUtxo                int64
+	ScriptKey                 int64
+	ScriptKeyLocal            bool
+	Amount                    int64
+	SerializedWitnesses       []byte
+	SplitCommitmentRootHash   []byte
+	SplitCommitmentRootValue  sql.NullInt64
+	ProofSuffix               []byte
+	NumPassiveAssets          int32
+	OutputType                int16
+	ProofCourierAddr          []byte
+	AssetVersion              int32
+	FallbackProofCourierAddrs []byte
+	RecipientAddr             sql.NullString
 }
 
 func (q *Queries) InsertAssetTransferOutput(ctx context.Context, arg InsertAssetTransferOutputParams) error {
@@ -323,6 +514,37 @@ func (q *Queries) InsertAssetTransferOutput(ctx context.Context, arg InsertAsset
 		arg.OutputType,
 		arg.ProofCourierAddr,
 		arg.AssetVersion,
+		arg.FallbackProofCourierAddrs,
+		arg.RecipientAddr,
+	)
+	return err
+}
+
+const insertBurn = `-- name: InsertBurn :exec
+INSERT INTO asset_burns (
+    transfer_id, note, asset_id, group_key, amount, script_key
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type InsertBurnParams struct {
+	TransferID int64
+	Note       sql.NullString
+	AssetID    []byte
+	GroupKey   []byte
+	Amount     int64
+	ScriptKey  []byte
+}
+
+func (q *Queries) InsertBurn(ctx context.Context, arg InsertBurnParams) error {
+	_, err := q.db.ExecContext(ctx, insertBurn,
+		arg.TransferID,
+		arg.Note,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.Amount,
+		arg.ScriptKey,
 	)
 	return err
 }
@@ -375,6 +597,52 @@ func (q *Queries) InsertPassiveAsset(ctx context.Context, arg InsertPassiveAsset
 	return err
 }
 
+const insertQueuedSend = `-- name: InsertQueuedSend :one
+INSERT INTO queued_sends (
+    coin_select_strategy, send_after_unix, max_fee_rate, creation_time_unix
+) VALUES (
+    $1, $2, $3,
+    $4
+) RETURNING queued_send_id
+`
+
+type InsertQueuedSendParams struct {
+	CoinSelectStrategy string
+	SendAfterUnix      sql.NullTime
+	MaxFeeRate         int64
+	CreationTimeUnix   time.Time
+}
+
+func (q *Queries) InsertQueuedSend(ctx context.Context, arg InsertQueuedSendParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertQueuedSend,
+		arg.CoinSelectStrategy,
+		arg.SendAfterUnix,
+		arg.MaxFeeRate,
+		arg.CreationTimeUnix,
+	)
+	var queued_send_id int64
+	err := row.Scan(&queued_send_id)
+	return queued_send_id, err
+}
+
+const insertQueuedSendAddr = `-- name: InsertQueuedSendAddr :exec
+INSERT INTO queued_send_addrs (
+    queued_send_id, tap_addr
+) VALUES (
+    $1, $2
+)
+`
+
+type InsertQueuedSendAddrParams struct {
+	QueuedSendID int64
+	TapAddr      string
+}
+
+func (q *Queries) InsertQueuedSendAddr(ctx context.Context, arg InsertQueuedSendAddrParams) error {
+	_, err := q.db.ExecContext(ctx, insertQueuedSendAddr, arg.QueuedSendID, arg.TapAddr)
+	return err
+}
+
 const insertReceiverProofTransferAttempt = `-- name: InsertReceiverProofTransferAttempt :exec
 INSERT INTO receiver_proof_transfer_attempts (
     proof_locator_hash, time_unix
@@ -393,9 +661,35 @@ func (q *Queries) InsertReceiverProofTransferAttempt(ctx context.Context, arg In
 	return err
 }
 
+const insertSwapOffer = `-- name: InsertSwapOffer :exec
+INSERT INTO swap_offers (
+    offer_id, payment_pk_script, payment_value, creation_time_unix
+) VALUES (
+    $1, $2, $3, $4
+)
+`
+
+type InsertSwapOfferParams struct {
+	OfferID          []byte
+	PaymentPkScript  []byte
+	PaymentValue     int64
+	CreationTimeUnix time.Time
+}
+
+func (q *Queries) InsertSwapOffer(ctx context.Context, arg InsertSwapOfferParams) error {
+	_, err := q.db.ExecContext(ctx, insertSwapOffer,
+		arg.OfferID,
+		arg.PaymentPkScript,
+		arg.PaymentValue,
+		arg.CreationTimeUnix,
+	)
+	return err
+}
+
 const queryAssetTransfers = `-- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, anchor_psbt, label,
+    cancel_tx
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -404,12 +698,16 @@ WHERE ($1 = false OR $1 IS NULL OR
 
 AND (txns.txid = $2 OR
     $2 IS NULL)
+
+AND (transfers.label = $3 OR
+    $3 IS NULL)
 ORDER BY transfer_time_unix
 `
 
 type QueryAssetTransfersParams struct {
 	UnconfOnly   interface{}
 	AnchorTxHash []byte
+	Label        sql.NullString
 }
 
 type QueryAssetTransfersRow struct {
@@ -417,14 +715,18 @@ type QueryAssetTransfersRow struct {
 	HeightHint       int32
 	Txid             []byte
 	TransferTimeUnix time.Time
+	AnchorPsbt       []byte
+	Label            sql.NullString
+	CancelTx         []byte
 }
 
 // We'll use this clause to filter out for only transfers that are
 // unconfirmed. But only if the unconf_only field is set.
 // Here we have another optional query clause to select a given transfer
 // based on the anchor_tx_hash, but only if it's specified.
+// Transfers can also be filtered by their label, if one is specified.
 func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfersParams) ([]QueryAssetTransfersRow, error) {
-	rows, err := q.db.QueryContext(ctx, queryAssetTransfers, arg.UnconfOnly, arg.AnchorTxHash)
+	rows, err := q.db.QueryContext(ctx, queryAssetTransfers, arg.UnconfOnly, arg.AnchorTxHash, arg.Label)
 	if err != nil {
 		return nil, err
 	}
@@ -437,6 +739,89 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 			&i.HeightHint,
 			&i.Txid,
 			&i.TransferTimeUnix,
+			&i.AnchorPsbt,
+			&i.Label,
+			&i.CancelTx,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryBurns = `-- name: QueryBurns :many
+SELECT
+    burns.note, burns.asset_id, burns.group_key, burns.amount,
+    burns.script_key, transfers.transfer_time_unix, txns.txid AS anchor_txid,
+    txns.block_height, txns.block_hash
+FROM asset_burns burns
+JOIN asset_transfers transfers
+    ON burns.transfer_id = transfers.id
+JOIN chain_txns txns
+    ON transfers.anchor_txn_id = txns.txn_id
+WHERE (burns.asset_id = $1 OR
+    $1 IS NULL)
+AND (burns.group_key = $2 OR
+    $2 IS NULL)
+AND (burns.script_key = $3 OR
+    $3 IS NULL)
+AND (txns.txid = $4 OR
+    $4 IS NULL)
+ORDER BY burns.burn_id
+`
+
+type QueryBurnsParams struct {
+	AssetID    []byte
+	GroupKey   []byte
+	ScriptKey  []byte
+	AnchorTxid []byte
+}
+
+type QueryBurnsRow struct {
+	Note             sql.NullString
+	AssetID          []byte
+	GroupKey         []byte
+	Amount           int64
+	ScriptKey        []byte
+	TransferTimeUnix time.Time
+	AnchorTxid       []byte
+	BlockHeight      sql.NullInt32
+	BlockHash        []byte
+}
+
+// Burns can optionally be filtered by the burned asset ID, its group key,
+// the burn script key and the anchor transaction of the burn.
+func (q *Queries) QueryBurns(ctx context.Context, arg QueryBurnsParams) ([]QueryBurnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryBurns,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ScriptKey,
+		arg.AnchorTxid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryBurnsRow
+	for rows.Next() {
+		var i QueryBurnsRow
+		if err := rows.Scan(
+			&i.Note,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.Amount,
+			&i.ScriptKey,
+			&i.TransferTimeUnix,
+			&i.AnchorTxid,
+			&i.BlockHeight,
+			&i.BlockHash,
 		); err != nil {
 			return nil, err
 		}
@@ -452,8 +837,8 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 }
 
 const queryPassiveAssets = `-- name: QueryPassiveAssets :many
-SELECT passive.asset_id, passive.new_anchor_utxo, passive.script_key,
-       passive.new_witness_stack, passive.new_proof,
+SELECT passive.passive_id, passive.asset_id, passive.new_anchor_utxo,
+       passive.script_key, passive.new_witness_stack, passive.new_proof,
        genesis_assets.asset_id AS genesis_id, passive.asset_version
 FROM passive_assets as passive
     JOIN assets
@@ -464,6 +849,7 @@ WHERE passive.transfer_id = $1
 `
 
 type QueryPassiveAssetsRow struct {
+	PassiveID       int64
 	AssetID         int64
 	NewAnchorUtxo   int64
 	ScriptKey       []byte
@@ -483,6 +869,7 @@ func (q *Queries) QueryPassiveAssets(ctx context.Context, transferID int64) ([]Q
 	for rows.Next() {
 		var i QueryPassiveAssetsRow
 		if err := rows.Scan(
+			&i.PassiveID,
 			&i.AssetID,
 			&i.NewAnchorUtxo,
 			&i.ScriptKey,
@@ -534,6 +921,29 @@ func (q *Queries) QueryReceiverProofTransferAttempt(ctx context.Context, proofLo
 	return items, nil
 }
 
+const reAnchorManagedUTXO = `-- name: ReAnchorManagedUTXO :exec
+UPDATE managed_utxos
+SET outpoint = $1, amt_sats = $2, txn_id = $3
+WHERE utxo_id = $4
+`
+
+type ReAnchorManagedUTXOParams struct {
+	Outpoint []byte
+	AmtSats  int64
+	TxnID    int64
+	UtxoID   int64
+}
+
+func (q *Queries) ReAnchorManagedUTXO(ctx context.Context, arg ReAnchorManagedUTXOParams) error {
+	_, err := q.db.ExecContext(ctx, reAnchorManagedUTXO,
+		arg.Outpoint,
+		arg.AmtSats,
+		arg.TxnID,
+		arg.UtxoID,
+	)
+	return err
+}
+
 const reAnchorPassiveAssets = `-- name: ReAnchorPassiveAssets :exec
 UPDATE assets
 SET anchor_utxo_id = $1
@@ -549,3 +959,119 @@ func (q *Queries) ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassive
 	_, err := q.db.ExecContext(ctx, reAnchorPassiveAssets, arg.NewAnchorUtxoID, arg.AssetID)
 	return err
 }
+
+const replaceTransferAnchorTx = `-- name: ReplaceTransferAnchorTx :exec
+WITH target_txn(txn_id) AS (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = $3
+)
+UPDATE asset_transfers
+SET anchor_txn_id = (SELECT txn_id FROM target_txn),
+    anchor_psbt = $1
+WHERE id = $2
+`
+
+type ReplaceTransferAnchorTxParams struct {
+	AnchorPsbt []byte
+	TransferID int64
+	AnchorTxid []byte
+}
+
+func (q *Queries) ReplaceTransferAnchorTx(ctx context.Context, arg ReplaceTransferAnchorTxParams) error {
+	_, err := q.db.ExecContext(ctx, replaceTransferAnchorTx, arg.AnchorPsbt, arg.TransferID, arg.AnchorTxid)
+	return err
+}
+
+const setTransferCancelTx = `-- name: SetTransferCancelTx :exec
+UPDATE asset_transfers
+SET cancel_tx = $1
+WHERE id = $2
+`
+
+type SetTransferCancelTxParams struct {
+	CancelTx   []byte
+	TransferID int64
+}
+
+func (q *Queries) SetTransferCancelTx(ctx context.Context, arg SetTransferCancelTxParams) error {
+	_, err := q.db.ExecContext(ctx, setTransferCancelTx, arg.CancelTx, arg.TransferID)
+	return err
+}
+
+const updatePassiveAssetProof = `-- name: UpdatePassiveAssetProof :exec
+UPDATE passive_assets
+SET new_proof = $1
+WHERE passive_id = $2
+`
+
+type UpdatePassiveAssetProofParams struct {
+	NewProof  []byte
+	PassiveID int64
+}
+
+func (q *Queries) UpdatePassiveAssetProof(ctx context.Context, arg UpdatePassiveAssetProofParams) error {
+	_, err := q.db.ExecContext(ctx, updatePassiveAssetProof, arg.NewProof, arg.PassiveID)
+	return err
+}
+
+const updateQueuedSend = `-- name: UpdateQueuedSend :execrows
+UPDATE queued_sends
+SET send_after_unix = $1, max_fee_rate = $2,
+    last_error = $3, failed = $4
+WHERE queued_send_id = $5
+`
+
+type UpdateQueuedSendParams struct {
+	SendAfterUnix sql.NullTime
+	MaxFeeRate    int64
+	LastError     sql.NullString
+	Failed        bool
+	QueuedSendID  int64
+}
+
+func (q *Queries) UpdateQueuedSend(ctx context.Context, arg UpdateQueuedSendParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateQueuedSend,
+		arg.SendAfterUnix,
+		arg.MaxFeeRate,
+		arg.LastError,
+		arg.Failed,
+		arg.QueuedSendID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateTransferOutputProofSuffix = `-- name: UpdateTransferOutputProofSuffix :exec
+UPDATE asset_transfer_outputs
+SET proof_suffix = $1
+WHERE output_id = $2
+`
+
+type UpdateTransferOutputProofSuffixParams struct {
+	ProofSuffix []byte
+	OutputID    int64
+}
+
+func (q *Queries) UpdateTransferOutputProofSuffix(ctx context.Context, arg UpdateTransferOutputProofSuffixParams) error {
+	_, err := q.db.ExecContext(ctx, updateTransferOutputProofSuffix, arg.ProofSuffix, arg.OutputID)
+	return err
+}
+
+const updateTransferOutputsProofCourierAddr = `-- name: UpdateTransferOutputsProofCourierAddr :exec
+UPDATE asset_transfer_outputs
+SET proof_courier_addr = $1
+WHERE transfer_id = $2 AND script_key_local = false
+`
+
+type UpdateTransferOutputsProofCourierAddrParams struct {
+	ProofCourierAddr []byte
+	TransferID       int64
+}
+
+func (q *Queries) UpdateTransferOutputsProofCourierAddr(ctx context.Context, arg UpdateTransferOutputsProofCourierAddrParams) error {
+	_, err := q.db.ExecContext(ctx, updateTransferOutputsProofCourierAddr, arg.ProofCourierAddr, arg.TransferID)
+	return err
+}
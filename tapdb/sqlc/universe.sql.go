@@ -660,6 +660,49 @@ func (q *Queries) QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeav
 	return items, nil
 }
 
+const queryUniversePublications = `-- name: QueryUniversePublications :many
+SELECT id, target_host, namespace_root, asset_id, group_key, proof_type, minting_point, script_key_bytes, publication_state, num_attempts, last_attempt_time, last_error
+FROM universe_publications
+WHERE target_host = $1
+ORDER BY id
+`
+
+func (q *Queries) QueryUniversePublications(ctx context.Context, targetHost string) ([]UniversePublication, error) {
+	rows, err := q.db.QueryContext(ctx, queryUniversePublications, targetHost)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniversePublication
+	for rows.Next() {
+		var i UniversePublication
+		if err := rows.Scan(
+			&i.ID,
+			&i.TargetHost,
+			&i.NamespaceRoot,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+			&i.MintingPoint,
+			&i.ScriptKeyBytes,
+			&i.PublicationState,
+			&i.NumAttempts,
+			&i.LastAttemptTime,
+			&i.LastError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryUniverseStats = `-- name: QueryUniverseStats :one
 WITH stats AS (
     SELECT total_asset_syncs, total_asset_proofs
@@ -900,6 +943,54 @@ func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeaf
 	return err
 }
 
+const upsertUniversePublication = `-- name: UpsertUniversePublication :exec
+INSERT INTO universe_publications (
+    target_host, namespace_root, asset_id, group_key, proof_type,
+    minting_point, script_key_bytes, publication_state, num_attempts,
+    last_attempt_time, last_error
+) VALUES (
+    $1, $2, $3, $4,
+    $5, $6, $7, $8,
+    $9, $10, $11
+)
+ON CONFLICT (target_host, namespace_root, minting_point, script_key_bytes)
+    DO UPDATE SET publication_state = EXCLUDED.publication_state,
+        num_attempts = EXCLUDED.num_attempts,
+        last_attempt_time = EXCLUDED.last_attempt_time,
+        last_error = EXCLUDED.last_error
+`
+
+type UpsertUniversePublicationParams struct {
+	TargetHost       string
+	NamespaceRoot    string
+	AssetID          []byte
+	GroupKey         []byte
+	ProofType        string
+	MintingPoint     []byte
+	ScriptKeyBytes   []byte
+	PublicationState int16
+	NumAttempts      int32
+	LastAttemptTime  sql.NullTime
+	LastError        sql.NullString
+}
+
+func (q *Queries) UpsertUniversePublication(ctx context.Context, arg UpsertUniversePublicationParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUniversePublication,
+		arg.TargetHost,
+		arg.NamespaceRoot,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ProofType,
+		arg.MintingPoint,
+		arg.ScriptKeyBytes,
+		arg.PublicationState,
+		arg.NumAttempts,
+		arg.LastAttemptTime,
+		arg.LastError,
+	)
+	return err
+}
+
 const upsertUniverseRoot = `-- name: UpsertUniverseRoot :one
 INSERT INTO universe_roots (
     namespace_root, asset_id, group_key, proof_type
@@ -0,0 +1,92 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+// A compile-time assertion to ensure AssetStore implements the swap offer
+// store interface.
+var _ tapfreighter.SwapOfferStore = (*AssetStore)(nil)
+
+// StoreSwapOffer stores the payment the swap offer with the given ID asks
+// for.
+func (a *AssetStore) StoreSwapOffer(ctx context.Context, id chainhash.Hash,
+	payment *wire.TxOut) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		err := q.InsertSwapOffer(ctx, NewSwapOffer{
+			OfferID:          id[:],
+			PaymentPkScript:  payment.PkScript,
+			PaymentValue:     payment.Value,
+			CreationTimeUnix: a.clock.Now().UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert swap offer: %w",
+				err)
+		}
+
+		return nil
+	})
+}
+
+// SwapOfferPayment returns the payment the swap offer with the given ID asks
+// for. tapfreighter.ErrSwapOfferNotFound is returned if the offer does not
+// exist.
+func (a *AssetStore) SwapOfferPayment(ctx context.Context,
+	id chainhash.Hash) (*wire.TxOut, error) {
+
+	var payment *wire.TxOut
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		offer, err := q.FetchSwapOffer(ctx, id[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return tapfreighter.ErrSwapOfferNotFound
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch swap offer: %w",
+				err)
+		}
+
+		payment = &wire.TxOut{
+			Value:    offer.PaymentValue,
+			PkScript: offer.PaymentPkScript,
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return payment, nil
+}
+
+// DeleteSwapOffer deletes the swap offer with the given ID.
+// tapfreighter.ErrSwapOfferNotFound is returned if the offer does not exist.
+func (a *AssetStore) DeleteSwapOffer(ctx context.Context,
+	id chainhash.Hash) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		numRows, err := q.DeleteSwapOffer(ctx, id[:])
+		if err != nil {
+			return fmt.Errorf("unable to delete swap offer: %w",
+				err)
+		}
+		if numRows == 0 {
+			return tapfreighter.ErrSwapOfferNotFound
+		}
+
+		return nil
+	})
+}
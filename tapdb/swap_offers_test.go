@@ -0,0 +1,54 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapOffers tests that the payments of swap offers can be stored, looked
+// up by the offer ID and deleted.
+func TestSwapOffers(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	offerID := chainhash.Hash(test.RandBytes(32))
+	payment := &wire.TxOut{
+		Value:    50_000,
+		PkScript: test.RandBytes(22),
+	}
+
+	_, err := assetsStore.SwapOfferPayment(ctx, offerID)
+	require.ErrorIs(t, err, tapfreighter.ErrSwapOfferNotFound)
+
+	require.NoError(t, assetsStore.StoreSwapOffer(ctx, offerID, payment))
+
+	// An offer can only be stored once.
+	require.Error(t, assetsStore.StoreSwapOffer(ctx, offerID, payment))
+
+	dbPayment, err := assetsStore.SwapOfferPayment(ctx, offerID)
+	require.NoError(t, err)
+	require.Equal(t, payment, dbPayment)
+
+	// Other offers are unknown.
+	_, err = assetsStore.SwapOfferPayment(
+		ctx, chainhash.Hash(test.RandBytes(32)),
+	)
+	require.ErrorIs(t, err, tapfreighter.ErrSwapOfferNotFound)
+
+	// Once deleted, the offer can't be looked up or deleted anymore.
+	require.NoError(t, assetsStore.DeleteSwapOffer(ctx, offerID))
+
+	_, err = assetsStore.SwapOfferPayment(ctx, offerID)
+	require.ErrorIs(t, err, tapfreighter.ErrSwapOfferNotFound)
+
+	err = assetsStore.DeleteSwapOffer(ctx, offerID)
+	require.ErrorIs(t, err, tapfreighter.ErrSwapOfferNotFound)
+}
@@ -1,7 +1,9 @@
 package tapdb
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"sort"
@@ -38,6 +40,14 @@ type (
 	// FedUniSyncConfigs is the universe specific federation sync config
 	// returned from a query.
 	FedUniSyncConfigs = sqlc.FederationUniSyncConfig
+
+	// UpsertUniPublicationParams is used to insert or update the
+	// publication of a proof to a static publication target.
+	UpsertUniPublicationParams = sqlc.UpsertUniversePublicationParams
+
+	// UniPublication is the publication of a proof to a static publication
+	// target returned from a query.
+	UniPublication = sqlc.UniversePublication
 )
 
 var (
@@ -97,6 +107,16 @@ type UniverseServerStore interface {
 
 	// ListUniverseServers returns the total set of all universe servers.
 	ListUniverseServers(ctx context.Context) ([]sqlc.UniverseServer, error)
+
+	// UpsertUniversePublication inserts or updates the publication of a
+	// proof to a static publication target.
+	UpsertUniversePublication(ctx context.Context,
+		arg UpsertUniPublicationParams) error
+
+	// QueryUniversePublications returns the publications of all proofs to
+	// the given static publication target.
+	QueryUniversePublications(ctx context.Context,
+		targetHost string) ([]UniPublication, error)
 }
 
 // UniverseFederationOptions is the database tx object for the universe server store.
@@ -238,6 +258,128 @@ func (u *UniverseFederationDB) LogNewSyncs(ctx context.Context,
 	})
 }
 
+// UpsertPublication inserts the publication of a proof to the given target, or
+// updates its state if it already exists.
+func (u *UniverseFederationDB) UpsertPublication(ctx context.Context,
+	target universe.ServerAddr, pub universe.Publication) error {
+
+	mintingPoint, err := encodeOutpoint(pub.Key.OutPoint)
+	if err != nil {
+		return err
+	}
+
+	params := UpsertUniPublicationParams{
+		TargetHost:    target.HostStr(),
+		NamespaceRoot: pub.ID.String(),
+		AssetID:       fn.ByteSlice(pub.ID.AssetID),
+		ProofType:     pub.ID.ProofType.String(),
+		MintingPoint:  mintingPoint,
+		ScriptKeyBytes: pub.Key.ScriptKey.PubKey.
+			SerializeCompressed(),
+		PublicationState: int16(pub.State),
+		NumAttempts:      int32(pub.NumAttempts),
+	}
+	if pub.ID.GroupKey != nil {
+		params.GroupKey = pub.ID.GroupKey.SerializeCompressed()
+	}
+	if !pub.LastAttempt.IsZero() {
+		params.LastAttemptTime = sql.NullTime{
+			Time:  pub.LastAttempt.UTC(),
+			Valid: true,
+		}
+	}
+	if pub.LastErr != nil {
+		params.LastError = sqlStr(pub.LastErr.Error())
+	}
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.UpsertUniversePublication(ctx, params)
+	})
+}
+
+// QueryPublications returns the publications of all proofs to the given
+// target, in the order they were queued.
+func (u *UniverseFederationDB) QueryPublications(ctx context.Context,
+	target universe.ServerAddr) ([]universe.Publication, error) {
+
+	var pubs []universe.Publication
+
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		dbPubs, err := db.QueryUniversePublications(
+			ctx, target.HostStr(),
+		)
+		if err != nil {
+			return err
+		}
+
+		pubs = make([]universe.Publication, 0, len(dbPubs))
+		for _, dbPub := range dbPubs {
+			pub, err := parseUniPublication(dbPub)
+			if err != nil {
+				return err
+			}
+
+			pubs = append(pubs, pub)
+		}
+
+		return nil
+	})
+
+	return pubs, dbErr
+}
+
+// parseUniPublication parses the publication of a proof from its database
+// representation.
+func parseUniPublication(dbPub UniPublication) (universe.Publication,
+	error) {
+
+	var pub universe.Publication
+
+	proofType, err := universe.ParseStrProofType(dbPub.ProofType)
+	if err != nil {
+		return pub, err
+	}
+	pub.ID.ProofType = proofType
+	copy(pub.ID.AssetID[:], dbPub.AssetID)
+
+	if len(dbPub.GroupKey) > 0 {
+		pub.ID.GroupKey, err = btcec.ParsePubKey(dbPub.GroupKey)
+		if err != nil {
+			return pub, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+	}
+
+	err = readOutPoint(
+		bytes.NewReader(dbPub.MintingPoint), 0, 0, &pub.Key.OutPoint,
+	)
+	if err != nil {
+		return pub, fmt.Errorf("unable to parse minting point: %w",
+			err)
+	}
+
+	scriptKey, err := btcec.ParsePubKey(dbPub.ScriptKeyBytes)
+	if err != nil {
+		return pub, fmt.Errorf("unable to parse script key: %w", err)
+	}
+	pub.Key.ScriptKey = &asset.ScriptKey{
+		PubKey: scriptKey,
+	}
+
+	pub.State = universe.PublicationState(dbPub.PublicationState)
+	pub.NumAttempts = uint32(dbPub.NumAttempts)
+	if dbPub.LastAttemptTime.Valid {
+		pub.LastAttempt = dbPub.LastAttemptTime.Time.UTC()
+	}
+	if dbPub.LastError.Valid {
+		pub.LastErr = errors.New(dbPub.LastError.String)
+	}
+
+	return pub, nil
+}
+
 // UpsertFederationSyncConfig upserts both the global and universe specific
 // federation sync configs.
 func (u *UniverseFederationDB) UpsertFederationSyncConfig(
@@ -425,4 +567,5 @@ func (u *UniverseFederationDB) QueryFederationSyncConfigs(
 var (
 	_ universe.FederationLog          = (*UniverseFederationDB)(nil)
 	_ universe.FederationSyncConfigDB = (*UniverseFederationDB)(nil)
+	_ universe.PublicationLog         = (*UniverseFederationDB)(nil)
 )
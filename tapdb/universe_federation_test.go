@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
@@ -98,6 +99,80 @@ func TestUniverseFederationCRUD(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestUniversePublications tests that the publications of proofs to the static
+// publication targets are persisted and updated per target.
+func TestUniversePublications(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	targetA := universe.NewServerAddrFromStr("a:10029")
+	targetB := universe.NewServerAddrFromStr("b:10029")
+
+	groupKey := test.RandPubKey(t)
+	newPub := func(id universe.Identifier) universe.Publication {
+		return universe.Publication{
+			ID: id,
+			Key: universe.LeafKey{
+				OutPoint: test.RandOp(t),
+				ScriptKey: &asset.ScriptKey{
+					PubKey: test.RandPubKey(t),
+				},
+			},
+			State: universe.PublicationPending,
+		}
+	}
+	assetPub := newPub(universe.Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: universe.ProofTypeIssuance,
+	})
+	groupPub := newPub(universe.Identifier{
+		AssetID:   asset.RandID(t),
+		GroupKey:  groupKey,
+		ProofType: universe.ProofTypeIssuance,
+	})
+
+	// Nothing was published yet.
+	pubs, err := fedDB.QueryPublications(ctx, targetA)
+	require.NoError(t, err)
+	require.Empty(t, pubs)
+
+	for _, pub := range []universe.Publication{assetPub, groupPub} {
+		require.NoError(t, fedDB.UpsertPublication(ctx, targetA, pub))
+	}
+	require.NoError(t, fedDB.UpsertPublication(ctx, targetB, groupPub))
+
+	pubs, err = fedDB.QueryPublications(ctx, targetA)
+	require.NoError(t, err)
+	require.Equal(t, []universe.Publication{assetPub, groupPub}, pubs)
+
+	// Updating the publication to one target doesn't affect the same
+	// publication to another target.
+	groupPub.State = universe.PublicationFailed
+	groupPub.NumAttempts = 3
+	groupPub.LastAttempt = testClock.Now().UTC().Truncate(time.Second)
+	groupPub.LastErr = fmt.Errorf("unable to connect")
+	require.NoError(t, fedDB.UpsertPublication(ctx, targetA, groupPub))
+
+	pubs, err = fedDB.QueryPublications(ctx, targetA)
+	require.NoError(t, err)
+	require.Len(t, pubs, 2)
+	require.Equal(t, assetPub, pubs[0])
+	require.Equal(t, universe.PublicationFailed, pubs[1].State)
+	require.EqualValues(t, 3, pubs[1].NumAttempts)
+	require.True(t, groupPub.LastAttempt.Equal(pubs[1].LastAttempt))
+	require.EqualError(t, pubs[1].LastErr, "unable to connect")
+
+	pubs, err = fedDB.QueryPublications(ctx, targetB)
+	require.NoError(t, err)
+	require.Len(t, pubs, 1)
+	require.Equal(t, universe.PublicationPending, pubs[0].State)
+	require.True(t, groupKey.IsEqual(pubs[0].ID.GroupKey))
+}
+
 // TestFederationConfigDefault tests that we're able to fetch the default
 // federation config.
 func TestFederationConfigDefault(t *testing.T) {
@@ -0,0 +1,89 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/address"
+)
+
+const (
+	// AddrReuseAllow is the address reuse action that sends to previously
+	// used addresses without any checks.
+	AddrReuseAllow = "allow"
+
+	// AddrReuseWarn is the address reuse action that logs a warning when
+	// sending to a previously used address.
+	AddrReuseWarn = "warn"
+
+	// AddrReuseRefuse is the address reuse action that refuses to send to
+	// a previously used address.
+	AddrReuseRefuse = "refuse"
+)
+
+// ErrAddrReused is returned if a send to a previously used address is refused
+// by the address reuse policy.
+var ErrAddrReused = errors.New("address was already used")
+
+// AddrUsageLog is used to find out if Taproot Asset addresses were already
+// used to receive assets.
+type AddrUsageLog interface {
+	// AddrReceived returns true if assets were already sent to the script
+	// key of the given address, either by one of our transfers or by
+	// anyone to one of our own addresses.
+	AddrReceived(ctx context.Context, addr *address.Tap) (bool, error)
+}
+
+// AddrReusePolicy governs sends to Taproot Asset addresses that already
+// received assets. Wallets create a new script key for every address, so
+// reusing one links all transfers to it. The zero value logs a warning for
+// every send to a previously used address.
+type AddrReusePolicy struct {
+	// Action is what happens when sending to a previously used address.
+	Action string `long:"action" description:"What to do when sending to a Taproot Asset address that already received assets, which links the transfers to the same script key. 'allow' sends without checking, 'warn' logs a warning and 'refuse' fails the send." choice:"allow" choice:"warn" choice:"refuse"`
+}
+
+// Validate makes sure the address reuse policy is sane.
+func (p *AddrReusePolicy) Validate() error {
+	switch p.Action {
+	case "", AddrReuseAllow, AddrReuseWarn, AddrReuseRefuse:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown address reuse action: %v", p.Action)
+	}
+}
+
+// check applies the policy to a send to the given addresses, using the given
+// log to find out which of them were used before.
+func (p *AddrReusePolicy) check(ctx context.Context, usage AddrUsageLog,
+	addrs []*address.Tap) error {
+
+	if p.Action == AddrReuseAllow || usage == nil {
+		return nil
+	}
+
+	for _, addr := range addrs {
+		used, err := usage.AddrReceived(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("unable to check address usage: %w",
+				err)
+		}
+		if !used {
+			continue
+		}
+
+		scriptKey := addr.ScriptKey.SerializeCompressed()
+		if p.Action == AddrReuseRefuse {
+			return fmt.Errorf("%w: script key %x already received "+
+				"assets", ErrAddrReused, scriptKey)
+		}
+
+		log.Warnf("Sending to address with script key %x that "+
+			"already received assets, the transfers will be "+
+			"linked to each other", scriptKey)
+	}
+
+	return nil
+}
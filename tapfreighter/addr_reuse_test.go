@@ -0,0 +1,64 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAddrUsageLog is a mock AddrUsageLog that reports the addresses with the
+// given script keys as used.
+type mockAddrUsageLog struct {
+	used map[asset.SerializedKey]bool
+}
+
+func (m *mockAddrUsageLog) AddrReceived(_ context.Context,
+	addr *address.Tap) (bool, error) {
+
+	return m.used[asset.ToSerialized(&addr.ScriptKey)], nil
+}
+
+// TestAddrReusePolicy tests that the address reuse policy allows, warns about
+// or refuses sends to previously used addresses.
+func TestAddrReusePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	courierAddr := address.RandProofCourierAddr(t)
+	newAddr := func() *address.Tap {
+		addr, _, _ := address.RandAddr(
+			t, &address.RegressionNetTap, courierAddr,
+		)
+		return addr.Tap
+	}
+
+	freshAddr, usedAddr := newAddr(), newAddr()
+	usage := &mockAddrUsageLog{
+		used: map[asset.SerializedKey]bool{
+			asset.ToSerialized(&usedAddr.ScriptKey): true,
+		},
+	}
+	addrs := []*address.Tap{freshAddr, usedAddr}
+
+	// The zero value only warns about reuse.
+	policy := &AddrReusePolicy{}
+	require.NoError(t, policy.Validate())
+	require.NoError(t, policy.check(ctx, usage, addrs))
+
+	policy.Action = "ignore"
+	require.Error(t, policy.Validate())
+
+	policy.Action = AddrReuseRefuse
+	require.NoError(t, policy.Validate())
+	require.ErrorIs(t, policy.check(ctx, usage, addrs), ErrAddrReused)
+	require.NoError(t, policy.check(ctx, usage, addrs[:1]))
+
+	// Without a usage log, nothing can be checked.
+	require.NoError(t, policy.check(ctx, nil, addrs))
+
+	policy.Action = AddrReuseAllow
+	require.NoError(t, policy.check(ctx, usage, addrs))
+}
@@ -0,0 +1,70 @@
+package tapfreighter
+
+import (
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// AnchorSharingStats describes the savings of re-anchoring the passive assets
+// of spent anchor outputs into shared anchor outputs, instead of creating an
+// anchor output for each of them.
+type AnchorSharingStats struct {
+	// PassiveAssets is the number of passive assets that were re-anchored.
+	PassiveAssets uint64
+
+	// OutputsSaved is the number of anchor outputs that weren't created
+	// because passive assets shared an anchor output.
+	OutputsSaved uint64
+
+	// VBytesSaved is the size of the anchor outputs that weren't created.
+	VBytesSaved uint64
+
+	// FeesSaved is the estimated amount of chain fees that weren't paid
+	// for the anchor outputs that weren't created, at the fee rate of the
+	// transfers that re-anchored the passive assets.
+	FeesSaved btcutil.Amount
+}
+
+// add adds the given stats to the stats.
+func (s *AnchorSharingStats) add(other AnchorSharingStats) {
+	s.PassiveAssets += other.PassiveAssets
+	s.OutputsSaved += other.OutputsSaved
+	s.VBytesSaved += other.VBytesSaved
+	s.FeesSaved += other.FeesSaved
+}
+
+// parcelAnchorSharing returns the anchor sharing savings of the given parcel.
+// Every passive asset would need an anchor output of its own, so all of them
+// are saved, apart from the one of outputs that carry nothing but passive
+// assets.
+func parcelAnchorSharing(parcel *OutboundParcel) AnchorSharingStats {
+	var stats AnchorSharingStats
+	for _, out := range parcel.Outputs {
+		numPassive := uint64(out.Anchor.NumPassiveAssets)
+		if numPassive == 0 {
+			continue
+		}
+
+		stats.PassiveAssets += numPassive
+		stats.OutputsSaved += numPassive
+		if out.Type == tappsbt.TypePassiveAssetsOnly {
+			stats.OutputsSaved--
+		}
+	}
+	stats.VBytesSaved = stats.OutputsSaved * input.P2TROutputSize
+
+	txWeight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(parcel.AnchorTx),
+	)
+	vSize := (txWeight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+	if vSize > 0 {
+		stats.FeesSaved = btcutil.Amount(
+			int64(stats.VBytesSaved) * parcel.ChainFees / vSize,
+		)
+	}
+
+	return stats
+}
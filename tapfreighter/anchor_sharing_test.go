@@ -0,0 +1,69 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParcelAnchorSharing tests that the savings of re-anchoring passive
+// assets into shared anchor outputs are calculated correctly.
+func TestParcelAnchorSharing(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{})
+	for i := 0; i < 3; i++ {
+		anchorTx.AddTxOut(&wire.TxOut{
+			Value: 1000, PkScript: make([]byte, 34),
+		})
+	}
+
+	newOutput := func(outType tappsbt.VOutputType,
+		numPassive uint32) TransferOutput {
+
+		return TransferOutput{
+			Anchor: Anchor{NumPassiveAssets: numPassive},
+			Type:   outType,
+		}
+	}
+
+	// Without passive assets, nothing is saved.
+	parcel := &OutboundParcel{
+		AnchorTx:  anchorTx,
+		ChainFees: 1000,
+		Outputs: []TransferOutput{
+			newOutput(tappsbt.TypeSimple, 0),
+			newOutput(tappsbt.TypeSplitRoot, 0),
+		},
+	}
+	require.Equal(t, AnchorSharingStats{}, parcelAnchorSharing(parcel))
+
+	// Passive assets in the change output don't need any output of their
+	// own, while passive assets that need an output only for themselves
+	// still share one.
+	parcel.Outputs = []TransferOutput{
+		newOutput(tappsbt.TypeSimple, 0),
+		newOutput(tappsbt.TypePassiveSplitRoot, 3),
+		newOutput(tappsbt.TypePassiveAssetsOnly, 2),
+	}
+	stats := parcelAnchorSharing(parcel)
+	require.EqualValues(t, 5, stats.PassiveAssets)
+	require.EqualValues(t, 4, stats.OutputsSaved)
+	require.EqualValues(t, 4*input.P2TROutputSize, stats.VBytesSaved)
+
+	vSize := int64(anchorTx.SerializeSize())
+	require.EqualValues(
+		t, int64(stats.VBytesSaved)*parcel.ChainFees/vSize,
+		stats.FeesSaved,
+	)
+
+	var total AnchorSharingStats
+	total.add(stats)
+	total.add(stats)
+	require.EqualValues(t, 10, total.PassiveAssets)
+	require.Equal(t, 2*stats.FeesSaved, total.FeesSaved)
+}
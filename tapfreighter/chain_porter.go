@@ -5,12 +5,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -20,8 +22,50 @@ import (
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
+const (
+	// maxSendEventHistory is the number of most recent send events that
+	// are kept in memory, so they can be replayed to new subscribers.
+	maxSendEventHistory = 1000
+)
+
+var (
+	// ErrNoFailedDelivery is returned when we attempt to resume the proof
+	// delivery of a transfer whose delivery didn't fail.
+	ErrNoFailedDelivery = errors.New("no failed proof delivery for " +
+		"transfer")
+
+	// ErrTransferCancelled is returned when the cancel transaction double
+	// spending the anchor transaction of a transfer confirms while we wait
+	// for the anchor transaction to confirm.
+	ErrTransferCancelled = errors.New("transfer was cancelled")
+
+	// ErrTransferCancelling is returned when we attempt to replace or
+	// cancel the anchor transaction of a transfer that is already being
+	// cancelled.
+	ErrTransferCancelling = errors.New("transfer is being cancelled")
+)
+
+// UncommittedSendError is returned when a send fails before its transfer was
+// committed to disk. Nothing of such a send was broadcast or will be resumed
+// on restart, so it's safe to retry it.
+type UncommittedSendError struct {
+	// Err is the error the send failed with.
+	Err error
+}
+
+// Error returns the error message of the underlying error.
+func (e *UncommittedSendError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *UncommittedSendError) Unwrap() error {
+	return e.Err
+}
+
 // ChainPorterConfig is the main config for the chain porter.
 type ChainPorterConfig struct {
 	// Signer implements the Taproot Asset level signing we need to sign a
@@ -63,10 +107,23 @@ type ChainPorterConfig struct {
 	// service handles.
 	ProofCourierCfg *proof.CourierCfg
 
+	// FallbackCourierAddrs is the ordered list of proof courier addresses
+	// a proof is delivered through if delivery through the courier of the
+	// receiver's address fails.
+	FallbackCourierAddrs []url.URL
+
 	// ProofWatcher is used to watch new proofs for their anchor transaction
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// AddrUsage is used to find out if the addresses of a send already
+	// received assets.
+	AddrUsage AddrUsageLog
+
+	// AddrReusePolicy governs sends to addresses that already received
+	// assets.
+	AddrReusePolicy AddrReusePolicy
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -90,9 +147,46 @@ type ChainPorter struct {
 	subscribers map[uint64]*fn.EventReceiver[fn.Event]
 
 	// subscriberMtx guards the subscribers map and access to the
-	// subscriptionID.
+	// subscriptionID, as well as the event history.
 	subscriberMtx sync.Mutex
 
+	// eventHistory holds the most recent send events in the order they
+	// were emitted, so they can be replayed to new subscribers.
+	eventHistory []*ExecuteSendStateEvent
+
+	// nextEventID is the ID of the next send event that is emitted.
+	nextEventID uint64
+
+	// nextTransferSeq is the sequence number of the next transfer that is
+	// delivered. It is only accessed by the main porter goroutine.
+	nextTransferSeq uint64
+
+	// replacements is a map of channels that are used to notify the
+	// goroutines waiting for a transfer to confirm about a replacement of
+	// the anchor transaction, keyed by the anchor TXID being waited for. A
+	// nil parcel is sent if the transfer was cancelled.
+	replacements map[chainhash.Hash]chan *OutboundParcel
+
+	// replacementsMtx guards the replacements map. It is held for the
+	// whole duration of a fee bump, so only a single replacement of an
+	// anchor transaction can happen at a time.
+	replacementsMtx sync.Mutex
+
+	// failedDeliveries holds the packages of the transfers for which the
+	// delivery of the receiver proofs failed, keyed by their anchor TXID.
+	// Their delivery can be resumed without restarting the daemon.
+	failedDeliveries map[chainhash.Hash]*sendPackage
+
+	// deliveriesMtx guards the failedDeliveries map.
+	deliveriesMtx sync.Mutex
+
+	// anchorSharing sums up the savings of re-anchoring passive assets
+	// into shared anchor outputs of all broadcast transfers.
+	anchorSharing AnchorSharingStats
+
+	// anchorSharingMtx guards anchorSharing.
+	anchorSharingMtx sync.Mutex
+
 	*fn.ContextGuard
 }
 
@@ -103,9 +197,14 @@ func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
 		map[uint64]*fn.EventReceiver[fn.Event],
 	)
 	return &ChainPorter{
-		cfg:         cfg,
-		exportReqs:  make(chan Parcel),
-		subscribers: subscribers,
+		cfg:          cfg,
+		exportReqs:   make(chan Parcel),
+		subscribers:  subscribers,
+		nextEventID:  1,
+		replacements: make(map[chainhash.Hash]chan *OutboundParcel),
+		failedDeliveries: make(
+			map[chainhash.Hash]*sendPackage,
+		),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: tapgarden.DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -182,7 +281,9 @@ func (p *ChainPorter) RequestShipment(req Parcel) (*OutboundParcel, error) {
 	// coins or broadcasting.
 	err := req.Validate()
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate parcel: %w", err)
+		return nil, &UncommittedSendError{
+			Err: fmt.Errorf("failed to validate parcel: %w", err),
+		}
 	}
 
 	if !fn.SendOrQuit(p.exportReqs, req, p.Quit) {
@@ -201,6 +302,274 @@ func (p *ChainPorter) RequestShipment(req Parcel) (*OutboundParcel, error) {
 	}
 }
 
+// BumpFee replaces the anchor transaction of the pending transfer with the
+// given anchor transaction hash with one that pays the given, higher fee rate.
+// The replacement is broadcast and delivery of the transfer continues with it.
+func (p *ChainPorter) BumpFee(ctx context.Context, anchorTXID chainhash.Hash,
+	feeRate chainfee.SatPerKWeight) (*OutboundParcel, error) {
+
+	p.replacementsMtx.Lock()
+	defer p.replacementsMtx.Unlock()
+
+	// We can only replace the anchor transaction of a transfer that is
+	// currently waiting for its confirmation.
+	replaced, ok := p.replacements[anchorTXID]
+	if !ok {
+		return nil, fmt.Errorf("no transfer with anchor TX %v is "+
+			"waiting for confirmation", anchorTXID)
+	}
+
+	parcel, err := p.fetchPendingParcel(ctx, anchorTXID)
+	if err != nil {
+		return nil, err
+	}
+	if parcel.CancelTx != nil {
+		return nil, ErrTransferCancelling
+	}
+
+	anchorTx, err := p.cfg.AssetWallet.BumpAnchorFee(ctx, parcel, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump anchor TX fee: %w", err)
+	}
+
+	newParcel, err := replaceParcelAnchorTx(parcel, anchorTx)
+	if err != nil {
+		return nil, err
+	}
+	newAnchorTXID := newParcel.AnchorTx.TxHash()
+
+	// We broadcast the replacement before updating the transfer on disk,
+	// so we don't end up with a transfer that references a transaction
+	// that is rejected by the network.
+	log.Infof("Broadcasting replacement tx %v for transfer tx %v",
+		newAnchorTXID, anchorTXID)
+	err = p.cfg.ChainBridge.PublishTransaction(
+		ctx, newParcel.AnchorTx, "tapd-asset-transfer-fee-bump",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish replacement tx: %w",
+			err)
+	}
+
+	err = p.cfg.ExportLog.ReplacePendingParcelAnchorTx(
+		ctx, anchorTXID, newParcel,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to replace anchor tx of "+
+			"pending parcel: %w", err)
+	}
+
+	// Finally, we let the goroutine waiting for the confirmation of the
+	// transfer know that it should wait for the replacement instead. The
+	// channel is buffered, so this won't block.
+	replaced <- newParcel
+
+	return newParcel, nil
+}
+
+// BumpFeeCpfp accelerates the confirmation of the anchor transaction of the
+// pending transfer with the given anchor transaction hash by spending its BTC
+// change output in a child transaction that pays the given fee rate. The
+// outpoint of the spent change output is returned.
+func (p *ChainPorter) BumpFeeCpfp(ctx context.Context,
+	anchorTXID chainhash.Hash,
+	feeRate chainfee.SatPerKWeight) (*wire.OutPoint, error) {
+
+	parcel, err := p.fetchPendingParcel(ctx, anchorTXID)
+	if err != nil {
+		return nil, err
+	}
+	if parcel.CancelTx != nil {
+		return nil, ErrTransferCancelling
+	}
+
+	// Only the BTC change output belongs to the lnd wallet. Spending any
+	// of the other outputs would destroy the assets they anchor.
+	changeIdx, err := BtcChangeOutputIndex(parcel.AnchorTx, parcel.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	changeOutPoint := wire.OutPoint{
+		Hash:  anchorTXID,
+		Index: changeIdx,
+	}
+
+	log.Infof("Bumping fee of transfer tx %v by spending change output "+
+		"%v at fee rate %v", anchorTXID, changeOutPoint,
+		feeRate.FeePerKVByte())
+
+	err = p.cfg.Wallet.BumpOutputFee(ctx, changeOutPoint, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump fee of change output: "+
+			"%w", err)
+	}
+
+	return &changeOutPoint, nil
+}
+
+// CancelTransfer cancels the pending transfer with the given anchor
+// transaction hash by double spending the BTC inputs of its anchor transaction
+// back to the wallet at the given fee rate. The cancel transaction is returned.
+// Since the anchor transaction might still confirm, the transfer is only
+// removed and its asset inputs can only be spent again once the cancel
+// transaction confirms.
+func (p *ChainPorter) CancelTransfer(ctx context.Context,
+	anchorTXID chainhash.Hash,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+
+	p.replacementsMtx.Lock()
+	defer p.replacementsMtx.Unlock()
+
+	parcel, err := p.fetchPendingParcel(ctx, anchorTXID)
+	if err != nil {
+		return nil, err
+	}
+	if parcel.CancelTx != nil {
+		return nil, ErrTransferCancelling
+	}
+
+	// Even if the transfer isn't waiting for its confirmation, for example
+	// because its broadcast failed, the anchor transaction might have
+	// reached the network. So we always double spend it.
+	if feeRate == 0 {
+		return nil, fmt.Errorf("fee rate required to double spend " +
+			"transfer")
+	}
+
+	cancelTx, err := p.cfg.AssetWallet.CancelAnchorTx(ctx, parcel, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create cancel tx: %w", err)
+	}
+
+	// Just like for a fee bump, we only touch the transfer on disk once
+	// the network accepted the double spend.
+	log.Infof("Broadcasting cancel tx %v for transfer tx %v",
+		cancelTx.TxHash(), anchorTXID)
+	err = p.cfg.ChainBridge.PublishTransaction(
+		ctx, cancelTx, "tapd-asset-transfer-cancel",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish cancel tx: %w", err)
+	}
+
+	err = p.cfg.ExportLog.MarkPendingParcelCancelling(
+		ctx, anchorTXID, cancelTx,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to mark pending parcel as "+
+			"cancelling: %w", err)
+	}
+
+	// If the transfer is waiting for its confirmation, we let the waiting
+	// goroutine know that it should wait for the cancel transaction as
+	// well. The channel is buffered, so this won't block. Otherwise the
+	// cancellation completes once the delivery of the transfer is resumed
+	// on restart.
+	newParcel := *parcel
+	newParcel.CancelTx = cancelTx
+	if replaced, ok := p.replacements[anchorTXID]; ok {
+		replaced <- &newParcel
+	}
+
+	return cancelTx, nil
+}
+
+// fetchPendingParcel returns the pending parcel with the given anchor
+// transaction hash.
+func (p *ChainPorter) fetchPendingParcel(ctx context.Context,
+	anchorTXID chainhash.Hash) (*OutboundParcel, error) {
+
+	pendingParcels, err := p.cfg.ExportLog.PendingParcels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending parcels: %w",
+			err)
+	}
+
+	for idx := range pendingParcels {
+		if pendingParcels[idx].AnchorTx.TxHash() == anchorTXID {
+			return pendingParcels[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no pending transfer with anchor TX %v found",
+		anchorTXID)
+}
+
+// replaceParcelAnchorTx returns a copy of the given parcel that is anchored in
+// the given replacement anchor transaction. The anchor outpoints and proof
+// suffixes of all outputs are updated to reference the new transaction.
+func replaceParcelAnchorTx(parcel *OutboundParcel,
+	anchorTx *AnchorTransaction) (*OutboundParcel, error) {
+
+	newParcel := *parcel
+	newParcel.AnchorTx = anchorTx.FinalTx
+	newParcel.AnchorPsbt = anchorTx.FundedPsbt.Pkt
+	newParcel.ChainFees = anchorTx.ChainFees
+	newParcel.Outputs = make([]TransferOutput, len(parcel.Outputs))
+
+	newAnchorTXID := anchorTx.FinalTx.TxHash()
+	for idx := range parcel.Outputs {
+		out := parcel.Outputs[idx]
+		out.Anchor.OutPoint.Hash = newAnchorTXID
+
+		// Outputs that only anchor passive assets don't carry a proof
+		// suffix, the passive asset proofs are updated by the export
+		// log.
+		if len(out.ProofSuffix) > 0 {
+			var proofSuffix proof.Proof
+			err := proofSuffix.Decode(
+				bytes.NewReader(out.ProofSuffix),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding proof "+
+					"suffix %d: %w", idx, err)
+			}
+
+			err = UpdateProofAnchorTx(
+				&proofSuffix, anchorTx.FinalTx,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error updating proof "+
+					"suffix %d: %w", idx, err)
+			}
+
+			var b bytes.Buffer
+			if err := proofSuffix.Encode(&b); err != nil {
+				return nil, fmt.Errorf("error encoding proof "+
+					"suffix %d: %w", idx, err)
+			}
+			out.ProofSuffix = b.Bytes()
+		}
+
+		newParcel.Outputs[idx] = out
+	}
+
+	return &newParcel, nil
+}
+
+// watchReplacement registers a channel over which a replacement of the given
+// anchor transaction is delivered.
+func (p *ChainPorter) watchReplacement(
+	anchorTXID chainhash.Hash) <-chan *OutboundParcel {
+
+	p.replacementsMtx.Lock()
+	defer p.replacementsMtx.Unlock()
+
+	replaced := make(chan *OutboundParcel, 1)
+	p.replacements[anchorTXID] = replaced
+
+	return replaced
+}
+
+// unwatchReplacement removes the replacement channel of the given anchor
+// transaction.
+func (p *ChainPorter) unwatchReplacement(anchorTXID chainhash.Hash) {
+	p.replacementsMtx.Lock()
+	defer p.replacementsMtx.Unlock()
+
+	delete(p.replacements, anchorTXID)
+}
+
 // assetsPorter is the main goroutine of the ChainPorter. This takes in incoming
 // requests, and attempt to complete a transfer. A response is sent back to the
 // caller if a transfer can be completed. Otherwise, an error is returned.
@@ -214,6 +583,12 @@ func (p *ChainPorter) assetsPorter() {
 			// to send to, or a send package is already initialized.
 			sendPkg := req.pkg()
 
+			// The sequence number lets us tell apart the events of
+			// the different transfers, also before their anchor
+			// transaction is known.
+			p.nextTransferSeq++
+			sendPkg.transferSeq = p.nextTransferSeq
+
 			// Advance the state machine for this package as far as
 			// possible in its own goroutine. The status will be
 			// reported through the different channels of the send
@@ -230,6 +605,14 @@ func (p *ChainPorter) assetsPorter() {
 //
 // NOTE: This method MUST be called as a goroutine.
 func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
+	// A state can be executed multiple times in a row, for example when
+	// we wait for the confirmation of a replaced anchor transaction. We
+	// count the attempts of the current state for the send events.
+	var (
+		prevState = pkg.SendState
+		attempt   uint32
+	)
+
 	// Continue state transitions whilst state complete has not yet
 	// been reached.
 	for pkg.SendState < SendStateComplete {
@@ -245,8 +628,27 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 		default:
 		}
 
+		if pkg.SendState != prevState {
+			prevState = pkg.SendState
+			attempt = 0
+		}
+		attempt++
+
+		// Notify subscribers that the state machine is about to
+		// execute a state.
+		p.publishSendEvent(pkg, pkg.SendState, attempt, nil)
+
 		updatedPkg, err := p.stateStep(*pkg)
 		if err != nil {
+			p.publishSendEvent(pkg, pkg.SendState, attempt, err)
+
+			// The transfer is only written to disk once the log
+			// commit state succeeds, so a failure up to and
+			// including that state leaves nothing behind.
+			if pkg.SendState <= SendStateLogCommit {
+				err = &UncommittedSendError{Err: err}
+			}
+
 			kit.errChan <- err
 			log.Errorf("Error evaluating state (%v): %v",
 				pkg.SendState, err)
@@ -279,33 +681,272 @@ func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
 	// Launch a goroutine that'll notify us when the transaction confirms.
 	defer confCancel()
 
-	var confEvent *chainntnfs.TxConfirmation
-	select {
-	case confEvent = <-confNtfn.Confirmed:
-		log.Debugf("Got chain confirmation: %v", confEvent.Tx.TxHash())
-		pkg.TransferTxConfEvent = confEvent
-		pkg.SendState = SendStateStoreProofs
+	// If the transfer is being cancelled, either the anchor transaction or
+	// the cancel transaction that double spends it confirms. We only know
+	// which of the two it is once one of them does.
+	var (
+		cancelConf    <-chan *chainntnfs.TxConfirmation
+		cancelErrChan <-chan error
+	)
+	if cancelTx := outboundPkg.CancelTx; cancelTx != nil {
+		cancelHash := cancelTx.TxHash()
+		log.Infof("Waiting for confirmation of cancel_txid=%v",
+			cancelHash)
+
+		ntfn, errs, err := p.cfg.ChainBridge.RegisterConfirmationsNtfn(
+			confCtx, &cancelHash, cancelTx.TxOut[0].PkScript, 1,
+			outboundPkg.AnchorTxHeightHint, true, nil,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to register for cancel tx "+
+				"conf: %w", err)
+		}
+		cancelConf = ntfn.Confirmed
+		cancelErrChan = errs
+	}
 
-	case err := <-errChan:
-		return fmt.Errorf("error whilst waiting for package tx "+
-			"confirmation: %w", err)
+	// The anchor transaction might be replaced while we wait, for example
+	// to bump its fee.
+	replaced := p.watchReplacement(txHash)
+	defer p.unwatchReplacement(txHash)
+
+	// A transaction we replaced can still confirm instead of its
+	// replacement, even if we were restarted in between and no longer
+	// know it. All versions of the anchor transaction spend the same
+	// inputs, so we watch the first one to find out which version
+	// confirmed.
+	spendNtfn, spendErrChan, err := p.watchAnchorInputSpend(
+		confCtx, outboundPkg,
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case confEvent := <-confNtfn.Confirmed:
+			if confEvent == nil {
+				return fmt.Errorf("got empty package tx " +
+					"confirmation event in batch")
+			}
 
-	case <-confCtx.Done():
-		log.Debugf("Skipping TX confirmation, context done")
+			log.Debugf("Got chain confirmation: %v",
+				confEvent.Tx.TxHash())
+			pkg.TransferTxConfEvent = confEvent
+			pkg.SendState = SendStateStoreProofs
 
-	case <-p.Quit:
-		log.Debugf("Skipping TX confirmation, exiting")
-		return nil
+			return nil
+
+		case err := <-errChan:
+			return fmt.Errorf("error whilst waiting for package "+
+				"tx confirmation: %w", err)
+
+		case spend := <-spendNtfn:
+			// If the input was spent by the current anchor
+			// transaction or the cancel transaction, their
+			// confirmation notification takes care of the rest.
+			spenderHash := *spend.SpenderTxHash
+			cancelTx := outboundPkg.CancelTx
+			if spenderHash == txHash || (cancelTx != nil &&
+				cancelTx.TxHash() == spenderHash) {
+
+				spendNtfn = nil
+				continue
+			}
+
+			return p.restoreConfirmedAnchorTx(
+				pkg, spend.SpendingTx,
+			)
+
+		case err := <-spendErrChan:
+			return fmt.Errorf("error whilst waiting for anchor "+
+				"input spend: %w", err)
+
+		case <-cancelConf:
+			// The anchor transaction can no longer confirm, so we
+			// remove the transfer from disk, which makes its inputs
+			// spendable again.
+			log.Infof("Cancel tx of transfer tx %v confirmed",
+				txHash)
+
+			ctx, cancel := p.CtxBlocking()
+			defer cancel()
+
+			err := p.cfg.ExportLog.CancelPendingParcel(ctx, txHash)
+			if err != nil {
+				return fmt.Errorf("unable to cancel pending "+
+					"parcel: %w", err)
+			}
+
+			return ErrTransferCancelled
+
+		case err := <-cancelErrChan:
+			return fmt.Errorf("error whilst waiting for cancel tx "+
+				"confirmation: %w", err)
+
+		case newParcel := <-replaced:
+			// The replacement or cancel transaction was already
+			// broadcast, so we stay in the current state and wait
+			// for the new transactions to confirm instead.
+			log.Infof("Transfer tx %v replaced by tx %v", txHash,
+				newParcel.AnchorTx.TxHash())
+			pkg.OutboundPkg = newParcel
+			return nil
+
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation, context done")
+			return fmt.Errorf("got empty package tx confirmation " +
+				"event in batch")
+
+		case <-p.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return nil
+		}
+	}
+}
+
+// watchAnchorInputSpend registers for the spend of the first input of the
+// anchor transaction of the given parcel. If the UTXO information of the input
+// is unknown, the returned channels are nil, so they never deliver anything.
+func (p *ChainPorter) watchAnchorInputSpend(ctx context.Context,
+	parcel *OutboundParcel) (chan *chainntnfs.SpendDetail, chan error,
+	error) {
+
+	// Transfers without an anchor PSBT can't be replaced, so there's
+	// nothing to watch for.
+	anchorPsbt := parcel.AnchorPsbt
+	if anchorPsbt == nil || len(anchorPsbt.Inputs) == 0 ||
+		anchorPsbt.Inputs[0].WitnessUtxo == nil {
+
+		return nil, nil, nil
 	}
 
-	if confEvent == nil {
-		return fmt.Errorf("got empty package tx confirmation event " +
-			"in batch")
+	prevOut := anchorPsbt.UnsignedTx.TxIn[0].PreviousOutPoint
+	spendNtfn, errChan, err := p.cfg.ChainBridge.RegisterSpendNtfn(
+		ctx, &prevOut, anchorPsbt.Inputs[0].WitnessUtxo.PkScript,
+		parcel.AnchorTxHeightHint,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to register for anchor "+
+			"input spend: %w", err)
+	}
+
+	return spendNtfn, errChan, nil
+}
+
+// restoreConfirmedAnchorTx makes the given transaction, which spent the anchor
+// inputs of the transfer of the given package in place of its current anchor
+// transaction, the anchor transaction of the transfer again. This is the case
+// if a version of the anchor transaction we replaced, for example to bump its
+// fee, confirmed. The package stays in its state, so we then wait for the
+// confirmation of the restored anchor transaction.
+func (p *ChainPorter) restoreConfirmedAnchorTx(pkg *sendPackage,
+	confirmedTx *wire.MsgTx) error {
+
+	parcel := pkg.OutboundPkg
+	anchorTXID := parcel.AnchorTx.TxHash()
+	confirmedTXID := confirmedTx.TxHash()
+
+	anchorTx, err := replacedAnchorTx(parcel, confirmedTx)
+	if err != nil {
+		return fmt.Errorf("anchor inputs of transfer tx %v spent by "+
+			"tx %v: %w", anchorTXID, confirmedTXID, err)
 	}
 
+	newParcel, err := replaceParcelAnchorTx(parcel, anchorTx)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Replaced tx %v of transfer tx %v confirmed", confirmedTXID,
+		anchorTXID)
+
+	ctx, cancel := p.CtxBlocking()
+	defer cancel()
+
+	err = p.cfg.ExportLog.ReplacePendingParcelAnchorTx(
+		ctx, anchorTXID, newParcel,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to restore anchor tx of pending "+
+			"parcel: %w", err)
+	}
+
+	pkg.OutboundPkg = newParcel
+
 	return nil
 }
 
+// replacedAnchorTx returns the given transaction as the anchor transaction of
+// the given parcel, if it is a version of the current anchor transaction that
+// was replaced to bump its fee. Such a version spends the same inputs and
+// creates the same outputs, only the value of the BTC change output differs.
+func replacedAnchorTx(parcel *OutboundParcel,
+	tx *wire.MsgTx) (*AnchorTransaction, error) {
+
+	current := parcel.AnchorTx
+	if parcel.AnchorPsbt == nil {
+		return nil, ErrNoAnchorPsbt
+	}
+
+	if len(tx.TxIn) != len(current.TxIn) {
+		return nil, fmt.Errorf("number of inputs differs")
+	}
+	for idx := range tx.TxIn {
+		prevOut := current.TxIn[idx].PreviousOutPoint
+		if tx.TxIn[idx].PreviousOutPoint != prevOut {
+			return nil, fmt.Errorf("input %d differs", idx)
+		}
+	}
+
+	// Only the value of the BTC change output may differ, if there is
+	// one.
+	changeIdx, err := BtcChangeOutputIndex(current, parcel.Outputs)
+	switch {
+	case errors.Is(err, ErrNoBtcChangeOutput):
+		changeIdx = uint32(len(current.TxOut))
+
+	case err != nil:
+		return nil, err
+	}
+
+	if len(tx.TxOut) != len(current.TxOut) {
+		return nil, fmt.Errorf("number of outputs differs")
+	}
+	for idx := range tx.TxOut {
+		txOut, currentOut := tx.TxOut[idx], current.TxOut[idx]
+		if !bytes.Equal(txOut.PkScript, currentOut.PkScript) ||
+			(uint32(idx) != changeIdx &&
+				txOut.Value != currentOut.Value) {
+
+			return nil, fmt.Errorf("output %d differs", idx)
+		}
+	}
+
+	anchorPkt, err := copyPsbt(parcel.AnchorPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	// Whatever the change output lacks compared to the current version
+	// was paid in fees.
+	chainFees := parcel.ChainFees
+	if changeIdx < uint32(len(tx.TxOut)) {
+		changeValue := tx.TxOut[changeIdx].Value
+		chainFees += current.TxOut[changeIdx].Value - changeValue
+		anchorPkt.UnsignedTx.TxOut[changeIdx].Value = changeValue
+	}
+
+	return &AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               anchorPkt,
+			ChangeOutputIndex: int32(changeIdx),
+		},
+		FinalTx:   tx,
+		ChainFees: chainFees,
+	}, nil
+}
+
 // storeProofs writes the updated sender and receiver proof files to the proof
 // archive.
 func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
@@ -385,7 +1026,6 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		map[asset.SerializedKey]*proof.AnnotatedProof,
 		len(parcel.Outputs),
 	)
-	firstInput := parcel.Inputs[0]
 	for idx := range parcel.Outputs {
 		out := parcel.Outputs[idx]
 
@@ -417,6 +1057,18 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 				"%d: %w", idx, err)
 		}
 
+		// A transfer can contain multiple assets, so only the inputs
+		// of the same asset as the output are relevant for its proof.
+		assetInputs := fn.Filter(
+			parcel.Inputs, func(in TransferInput) bool {
+				return in.ID == proofSuffix.Asset.ID()
+			},
+		)
+		if len(assetInputs) == 0 {
+			return fmt.Errorf("no input found for output %d", idx)
+		}
+		firstInput := assetInputs[0]
+
 		// The suffix is complete, so we need to fetch the input proof
 		// in order to append the suffix to it.
 		inputProofFile, err := p.fetchInputProof(ctx, firstInput)
@@ -426,9 +1078,9 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 
 		// Are there more inputs? Then this is a merge, and we need to
 		// add those additional files to the suffix as well.
-		for idx := 1; idx < len(parcel.Inputs); idx++ {
+		for idx := 1; idx < len(assetInputs); idx++ {
 			additionalInputProofFile, err := p.fetchInputProof(
-				ctx, parcel.Inputs[idx],
+				ctx, assetInputs[idx],
 			)
 			if err != nil {
 				return fmt.Errorf("error fetching input "+
@@ -632,57 +1284,48 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 				"script key %x", key.SerializeCompressed())
 		}
 
-		log.Debugf("Attempting to deliver proof for script key %x",
-			key.SerializeCompressed())
-
-		proofCourierAddr, err := proof.ParseCourierAddrString(
-			string(out.ProofCourierAddr),
-		)
-		if err != nil {
-			return fmt.Errorf("failed to parse proof courier "+
-				"address: %w", err)
+		// We first try the courier of the receiver's address, then
+		// the fallback couriers of the address and finally our own
+		// fallback couriers, each in order.
+		courierAddrs := []string{string(out.ProofCourierAddr)}
+		for idx := range out.FallbackProofCourierAddrs {
+			fallbackAddr := out.FallbackProofCourierAddrs[idx]
+			courierAddrs = append(
+				courierAddrs, fallbackAddr.String(),
+			)
+		}
+		for idx := range p.cfg.FallbackCourierAddrs {
+			fallbackAddr := p.cfg.FallbackCourierAddrs[idx]
+			courierAddrs = append(
+				courierAddrs, fallbackAddr.String(),
+			)
 		}
 
-		// Initiate proof courier service handle from the proof
-		// courier address found in the Tap address.
 		recipient := proof.Recipient{
 			ScriptKey: key,
 			AssetID:   *receiverProof.AssetID,
 			Amount:    out.Amount,
 		}
-		courier, err := proofCourierAddr.NewCourier(
-			ctx, p.cfg.ProofCourierCfg, recipient,
-		)
-		if err != nil {
-			return fmt.Errorf("unable to initiate proof courier "+
-				"service handle: %w", err)
-		}
 
-		// Update courier events subscribers before attempting to
-		// deliver proof.
-		p.subscriberMtx.Lock()
-		courier.SetSubscribers(p.subscribers)
-		p.subscriberMtx.Unlock()
+		var deliveryErr error
+		for _, courierAddr := range courierAddrs {
+			log.Debugf("Attempting to deliver proof for script "+
+				"key %x via %s", key.SerializeCompressed(),
+				courierAddr)
 
-		// Deliver proof to proof courier service.
-		err = courier.DeliverProof(ctx, receiverProof)
-		if err != nil {
-			return fmt.Errorf("failed to deliver proof via "+
-				"courier service: %w", err)
-		}
+			deliveryErr = p.deliverProof(
+				ctx, courierAddr, recipient, receiverProof,
+			)
+			if deliveryErr == nil {
+				return nil
+			}
 
-		// If the proof courier returned a backoff error, then
-		// we'll just return nil here so that we can retry
-		// later.
-		var backoffExecErr *proof.BackoffExecError
-		if errors.As(err, &backoffExecErr) {
-			return nil
-		}
-		if err != nil {
-			return fmt.Errorf("error delivering proof: %w", err)
+			log.Warnf("Unable to deliver proof for script key "+
+				"%x via %s: %v", key.SerializeCompressed(),
+				courierAddr, deliveryErr)
 		}
 
-		return nil
+		return deliveryErr
 	}
 
 	// If we have a proof courier instance active, then we'll launch several
@@ -745,6 +1388,156 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 	return nil
 }
 
+// deliverProof delivers the given receiver proof through the proof courier
+// with the given address.
+func (p *ChainPorter) deliverProof(ctx context.Context, courierAddr string,
+	recipient proof.Recipient, receiverProof *proof.AnnotatedProof) error {
+
+	proofCourierAddr, err := proof.ParseCourierAddrString(courierAddr)
+	if err != nil {
+		return fmt.Errorf("failed to parse proof courier address: %w",
+			err)
+	}
+
+	// Initiate proof courier service handle from the proof courier
+	// address.
+	courier, err := proofCourierAddr.NewCourier(
+		ctx, p.cfg.ProofCourierCfg, recipient,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to initiate proof courier service "+
+			"handle: %w", err)
+	}
+
+	// Update courier events subscribers before attempting to deliver
+	// proof.
+	p.subscriberMtx.Lock()
+	courier.SetSubscribers(p.subscribers)
+	p.subscriberMtx.Unlock()
+
+	// Deliver proof to proof courier service.
+	err = courier.DeliverProof(ctx, receiverProof)
+	if err != nil {
+		return fmt.Errorf("failed to deliver proof via courier "+
+			"service: %w", err)
+	}
+
+	return nil
+}
+
+// recordAnchorSharing adds the anchor sharing savings of the given broadcast
+// parcel to the porter's stats.
+func (p *ChainPorter) recordAnchorSharing(parcel *OutboundParcel) {
+	stats := parcelAnchorSharing(parcel)
+	if stats.PassiveAssets == 0 {
+		return
+	}
+
+	log.Debugf("Re-anchored %d passive assets into shared anchor "+
+		"outputs, saving %d outputs (%d vbytes, %v)",
+		stats.PassiveAssets, stats.OutputsSaved, stats.VBytesSaved,
+		stats.FeesSaved)
+
+	p.anchorSharingMtx.Lock()
+	defer p.anchorSharingMtx.Unlock()
+
+	p.anchorSharing.add(stats)
+}
+
+// AnchorSharingStats returns the savings of re-anchoring passive assets into
+// shared anchor outputs, summed up over all transfers that were broadcast
+// since the porter was started.
+func (p *ChainPorter) AnchorSharingStats() AnchorSharingStats {
+	p.anchorSharingMtx.Lock()
+	defer p.anchorSharingMtx.Unlock()
+
+	return p.anchorSharing
+}
+
+// ResumeDelivery resumes the delivery of the receiver proofs of the transfer
+// with the given anchor transaction hash, after it failed. If a courier
+// address is given, it replaces the proof courier of all outputs of the
+// transfer that don't go to our own node, also for future restarts of the
+// delivery.
+func (p *ChainPorter) ResumeDelivery(ctx context.Context,
+	anchorTXID chainhash.Hash, courierAddr *url.URL) error {
+
+	p.deliveriesMtx.Lock()
+	defer p.deliveriesMtx.Unlock()
+
+	pkg, ok := p.failedDeliveries[anchorTXID]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrNoFailedDelivery, anchorTXID)
+	}
+
+	if courierAddr != nil {
+		_, err := proof.ParseCourierAddrUrl(*courierAddr)
+		if err != nil {
+			return fmt.Errorf("invalid proof courier address: %w",
+				err)
+		}
+
+		addrBytes := []byte(courierAddr.String())
+		err = p.cfg.ExportLog.UpdatePendingParcelCourier(
+			ctx, anchorTXID, addrBytes,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to update proof courier: %w",
+				err)
+		}
+
+		for idx := range pkg.OutboundPkg.Outputs {
+			out := &pkg.OutboundPkg.Outputs[idx]
+			if !out.ScriptKeyLocal {
+				out.ProofCourierAddr = addrBytes
+			}
+		}
+	}
+
+	delete(p.failedDeliveries, anchorTXID)
+
+	log.Infof("Resuming proof delivery for transfer tx %v", anchorTXID)
+
+	pkg.proofDeliveryAttempt++
+	p.publishSendEvent(
+		pkg, SendStateReceiverProofTransfer, pkg.proofDeliveryAttempt,
+		nil,
+	)
+
+	p.startProofDelivery(pkg)
+
+	return nil
+}
+
+// startProofDelivery delivers the receiver proofs of the given package in the
+// background. If the delivery fails, the package is kept so its delivery can
+// be resumed.
+func (p *ChainPorter) startProofDelivery(pkg *sendPackage) {
+	p.Wg.Add(1)
+	go func() {
+		defer p.Wg.Done()
+
+		err := p.transferReceiverProof(pkg)
+		if err == nil {
+			p.publishSendEvent(pkg, SendStateComplete, 1, nil)
+			return
+		}
+
+		log.Errorf("unable to transfer receiver proof: %v", err)
+
+		p.publishSendEvent(
+			pkg, SendStateReceiverProofTransfer,
+			pkg.proofDeliveryAttempt, err,
+		)
+
+		p.deliveriesMtx.Lock()
+		defer p.deliveriesMtx.Unlock()
+
+		anchorTXID := pkg.OutboundPkg.AnchorTx.TxHash()
+		p.failedDeliveries[anchorTXID] = pkg
+	}()
+}
+
 // importLocalAddresses imports the addresses for outputs that go to ourselves,
 // from the given outbound parcel.
 func (p *ChainPorter) importLocalAddresses(ctx context.Context,
@@ -811,11 +1604,6 @@ func createDummyOutput() *wire.TxOut {
 // stateStep attempts to step through the state machine to complete a Taproot
 // Asset transfer.
 func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
-	// Notify subscribers that the state machine is about to execute a
-	// state.
-	stateEvent := NewExecuteSendStateEvent(currentPkg.SendState)
-	p.publishSubscriberEvent(stateEvent)
-
 	switch currentPkg.SendState {
 	// At this point we have the initial package information populated, so
 	// we'll perform coin selection to see if the send request is even
@@ -832,18 +1620,20 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			return nil, fmt.Errorf("unable to cast parcel to " +
 				"address parcel")
 		}
-		fundSendRes, outputIdxToAddr, err :=
-			p.cfg.AssetWallet.FundAddressSend(
-				ctx, addrParcel.destAddrs...,
-			)
+
+		// Before we select any coins, we make sure our policy allows
+		// sending to addresses that already received assets.
+		err := p.cfg.AddrReusePolicy.check(
+			ctx, p.cfg.AddrUsage, addrParcel.destAddrs,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to fund address send: "+
-				"%w", err)
+			return nil, err
 		}
 
-		currentPkg.VirtualPacket = fundSendRes.VPacket
-		currentPkg.InputCommitments = fundSendRes.InputCommitments
-		currentPkg.OutputIdxToAddr = outputIdxToAddr
+		err = p.fundAddressParcel(ctx, &currentPkg, addrParcel)
+		if err != nil {
+			return nil, err
+		}
 
 		currentPkg.SendState = SendStateVirtualSign
 
@@ -852,18 +1642,20 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	// At this point, we have everything we need to sign our _virtual_
 	// transaction on the Taproot Asset layer.
 	case SendStateVirtualSign:
-		vPacket := currentPkg.VirtualPacket
-		receiverScriptKey := vPacket.Outputs[1].ScriptKey.PubKey
-		log.Infof("Generating Taproot Asset witnesses for send to: %x",
-			receiverScriptKey.SerializeCompressed())
-
-		// Now we'll use the signer to sign all the inputs for the new
-		// Taproot Asset leaves. The witness data for each input will be
-		// assigned for us.
-		_, err := p.cfg.AssetWallet.SignVirtualPacket(vPacket)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign and commit "+
-				"virtual packet: %w", err)
+		for _, vPacket := range currentPkg.VirtualPackets {
+			receiverScriptKey := vPacket.Outputs[1].ScriptKey.PubKey
+			log.Infof("Generating Taproot Asset witnesses for "+
+				"send to: %x",
+				receiverScriptKey.SerializeCompressed())
+
+			// Now we'll use the signer to sign all the inputs for
+			// the new Taproot Asset leaves. The witness data for
+			// each input will be assigned for us.
+			_, err := p.cfg.AssetWallet.SignVirtualPacket(vPacket)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign and "+
+					"commit virtual packet: %w", err)
+			}
 		}
 
 		currentPkg.SendState = SendStateAnchorSign
@@ -888,25 +1680,34 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				err)
 		}
 
-		vPacket := currentPkg.VirtualPacket
-		firstRecipient, err := vPacket.FirstNonSplitRootOutput()
-		if err != nil {
-			return nil, fmt.Errorf("unable to get first "+
-				"interactive output: %w", err)
-		}
-		receiverScriptKey := firstRecipient.ScriptKey.PubKey
-		log.Infof("Constructing new Taproot Asset commitments for "+
-			"send to: %x", receiverScriptKey.SerializeCompressed())
-
-		// Gather passive assets virtual packets and sign them.
+		// Gather passive assets virtual packets and sign them. Each
+		// virtual packet re-anchors the passive assets of its own
+		// anchor inputs.
 		wallet := p.cfg.AssetWallet
 
-		currentPkg.PassiveAssets, err = wallet.SignPassiveAssets(
-			vPacket, currentPkg.InputCommitments,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign passive "+
-				"assets: %w", err)
+		currentPkg.PassiveAssets = nil
+		for idx, vPacket := range currentPkg.VirtualPackets {
+			firstRecipient, err := vPacket.FirstNonSplitRootOutput()
+			if err != nil {
+				return nil, fmt.Errorf("unable to get first "+
+					"interactive output: %w", err)
+			}
+			receiverScriptKey := firstRecipient.ScriptKey.PubKey
+			log.Infof("Constructing new Taproot Asset "+
+				"commitments for send to: %x",
+				receiverScriptKey.SerializeCompressed())
+
+			passiveAssets, err := wallet.SignPassiveAssets(
+				vPacket, currentPkg.InputCommitments[idx],
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign "+
+					"passive assets: %w", err)
+			}
+
+			currentPkg.PassiveAssets = append(
+				currentPkg.PassiveAssets, passiveAssets...,
+			)
 		}
 
 		var passiveVPackets []*tappsbt.VPacket
@@ -916,12 +1717,16 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			)
 		}
 
+		strategy := currentPkg.CoinSelectStrategy
 		anchorTx, err := wallet.AnchorVirtualTransactions(
 			ctx, &AnchorVTxnsParams{
 				FeeRate:            feeRate,
-				VPkts:              []*tappsbt.VPacket{vPacket},
+				VPkts:              currentPkg.VirtualPackets,
 				InputCommitments:   currentPkg.InputCommitments,
 				PassiveAssetsVPkts: passiveVPackets,
+				CoinSelectStrategy: strategy,
+				MaxChainFees:       currentPkg.MaxChainFees,
+				AnchorLockTime:     currentPkg.AnchorLockTime,
 			},
 		)
 		if err != nil {
@@ -1008,18 +1813,28 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"addresses: %w", err)
 		}
 
+		// A transfer that is being cancelled is resumed by
+		// broadcasting the cancel transaction again instead of the
+		// anchor transaction it double spends.
+		broadcastTx := currentPkg.OutboundPkg.AnchorTx
+		if currentPkg.OutboundPkg.CancelTx != nil {
+			broadcastTx = currentPkg.OutboundPkg.CancelTx
+		}
+
 		log.Infof("Broadcasting new transfer tx, txid=%v",
-			currentPkg.OutboundPkg.AnchorTx.TxHash())
+			broadcastTx.TxHash())
 
 		// With the public key imported, we can now broadcast to the
 		// network.
 		err = p.cfg.ChainBridge.PublishTransaction(
-			ctx, currentPkg.OutboundPkg.AnchorTx,
+			ctx, broadcastTx, "tapd-asset-transfer",
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		p.recordAnchorSharing(currentPkg.OutboundPkg)
+
 		// With the transaction broadcast, we'll deliver a
 		// notification via the transaction broadcast response channel.
 		currentPkg.deliverTxBroadcastResp()
@@ -1048,17 +1863,9 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		// main loop breaks out. We'll continue to attempt proof
 		// deliver in the background.
 		currentPkg.SendState = SendStateComplete
+		currentPkg.proofDeliveryAttempt = 1
 
-		p.Wg.Add(1)
-		go func() {
-			defer p.Wg.Done()
-
-			err := p.transferReceiverProof(&currentPkg)
-			if err != nil {
-				log.Errorf("unable to transfer receiver "+
-					"proof: %v", err)
-			}
-		}()
+		p.startProofDelivery(&currentPkg)
 
 		return &currentPkg, nil
 
@@ -1068,17 +1875,99 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	}
 }
 
+// fundAddressParcel funds the virtual packets of the given address parcel. The
+// addresses are grouped by asset ID, and one virtual packet is funded for each
+// asset ID. The anchor outputs of the packets don't overlap, so all of them
+// can be anchored in the same BTC level transaction.
+func (p *ChainPorter) fundAddressParcel(ctx context.Context,
+	pkg *sendPackage, addrParcel *AddressParcel) error {
+
+	var (
+		anchorOutputOffset uint32
+		numOutputs         int
+	)
+	addrGroups := groupAddrsByAssetID(addrParcel.destAddrs)
+
+	// An input that is only identified by its anchor outpoint can't be
+	// assigned to one of multiple assets.
+	for _, prevID := range addrParcel.prevIDs {
+		if len(addrGroups) > 1 && prevID.ID == (asset.ID{}) {
+			return fmt.Errorf("input %v must specify its asset ID "+
+				"when sending multiple assets", prevID.OutPoint)
+		}
+	}
+
+	pkg.VirtualPackets = nil
+	pkg.InputCommitments = nil
+	pkg.OutputIdxToAddr = make(tappsbt.OutputIdxToAddr)
+	for _, addrs := range addrGroups {
+		assetID := addrs[0].AssetID
+		prevIDs := fn.Filter(
+			addrParcel.prevIDs, func(prevID asset.PrevID) bool {
+				return len(addrGroups) == 1 ||
+					prevID.ID == assetID
+			},
+		)
+
+		// If the caller specified the inputs to spend, there must be
+		// at least one for each asset that is sent.
+		if len(addrParcel.prevIDs) > 0 && len(prevIDs) == 0 {
+			return fmt.Errorf("no inputs specified for asset %v",
+				assetID)
+		}
+
+		fundSendRes, outputIdxToAddr, err :=
+			p.cfg.AssetWallet.FundAddressSend(
+				ctx, prevIDs, pkg.CoinSelectStrategy,
+				anchorOutputOffset, addrs...,
+			)
+		if err != nil {
+			return fmt.Errorf("unable to fund address send for "+
+				"asset %v: %w", assetID, err)
+		}
+
+		vPacket := fundSendRes.VPacket
+		for outIdx, addr := range outputIdxToAddr {
+			pkg.OutputIdxToAddr[numOutputs+outIdx] = addr
+		}
+		for _, vOut := range vPacket.Outputs {
+			if vOut.AnchorOutputIndex >= anchorOutputOffset {
+				anchorOutputOffset = vOut.AnchorOutputIndex + 1
+			}
+		}
+		numOutputs += len(vPacket.Outputs)
+
+		pkg.VirtualPackets = append(pkg.VirtualPackets, vPacket)
+		pkg.InputCommitments = append(
+			pkg.InputCommitments, fundSendRes.InputCommitments,
+		)
+	}
+
+	return nil
+}
+
 // RegisterSubscriber adds a new subscriber to the set of subscribers that will
-// be notified of any new events that are broadcast.
-//
-// TODO(ffranr): Add support for delivering existing events to new subscribers.
+// be notified of any new events that are broadcast. The deliverExisting
+// boolean indicates whether the send events that are still kept in memory
+// should be delivered to the subscriber first, starting from the event
+// specified by deliverFrom. Replayed events are always delivered before any
+// new event.
 func (p *ChainPorter) RegisterSubscriber(
 	receiver *fn.EventReceiver[fn.Event],
-	deliverExisting bool, deliverFrom bool) error {
+	deliverExisting bool, deliverFrom SendEventReplay) error {
 
 	p.subscriberMtx.Lock()
 	defer p.subscriberMtx.Unlock()
 
+	if deliverExisting {
+		for _, event := range replaySendEvents(
+			p.eventHistory, deliverFrom,
+		) {
+
+			receiver.NewItemCreated.ChanIn() <- event
+		}
+	}
+
 	p.subscribers[receiver.ID()] = receiver
 
 	return nil
@@ -1104,13 +1993,26 @@ func (p *ChainPorter) RemoveSubscriber(
 	return nil
 }
 
-// publishSubscriberEvent publishes an event to all subscribers.
-func (p *ChainPorter) publishSubscriberEvent(event fn.Event) {
-	// Lock the subscriber mutex to ensure that we don't modify the
-	// subscriber map while we're iterating over it.
+// publishSendEvent records a send event for the given state of the package
+// in the event history and publishes it to all subscribers. A nil error means
+// the state is about to be executed.
+func (p *ChainPorter) publishSendEvent(pkg *sendPackage, state SendState,
+	attempt uint32, err error) {
+
 	p.subscriberMtx.Lock()
 	defer p.subscriberMtx.Unlock()
 
+	event := NewExecuteSendStateEvent(
+		p.nextEventID, state, pkg.anchorTXID(), attempt, err,
+	)
+	event.transferSeq = pkg.transferSeq
+	p.nextEventID++
+
+	p.eventHistory = append(p.eventHistory, event)
+	if len(p.eventHistory) > maxSendEventHistory {
+		p.eventHistory = p.eventHistory[1:]
+	}
+
 	for _, sub := range p.subscribers {
 		sub.NewItemCreated.ChanIn() <- event
 	}
@@ -1118,16 +2020,45 @@ func (p *ChainPorter) publishSubscriberEvent(event fn.Event) {
 
 // A compile-time assertion to make sure ChainPorter satisfies the
 // fn.EventPublisher interface.
-var _ fn.EventPublisher[fn.Event, bool] = (*ChainPorter)(nil)
+var _ fn.EventPublisher[fn.Event, SendEventReplay] = (*ChainPorter)(nil)
 
 // ExecuteSendStateEvent is an event which is sent to the ChainPorter's event
-// subscribers before a state is executed.
+// subscribers before a state is executed, and again with an error if the
+// execution of the state failed.
 type ExecuteSendStateEvent struct {
 	// timestamp is the time the event was created.
 	timestamp time.Time
 
-	// SendState is the state that is about to be executed.
+	// ID is the unique ID of the event. Event IDs are increasing in the
+	// order the events were emitted, but aren't persisted across
+	// restarts.
+	ID uint64
+
+	// SendState is the state that is about to be executed, or that failed
+	// to execute.
 	SendState SendState
+
+	// AnchorTXID is the hash of the anchor transaction of the transfer.
+	// It is nil for events that are emitted before the anchor transaction
+	// is created.
+	AnchorTXID *chainhash.Hash
+
+	// Attempt is the number of times the state was attempted for this
+	// transfer, including the current attempt.
+	Attempt uint32
+
+	// Err is the error the execution of the state failed with. It is nil
+	// if the state is about to be executed.
+	Err error
+
+	// Resumable indicates that the transfer was already committed to disk
+	// when the state failed, so its delivery is resumed on restart (or
+	// through ResumeDelivery for a failed proof delivery).
+	Resumable bool
+
+	// transferSeq is the sequence number of the transfer the event belongs
+	// to.
+	transferSeq uint64
 }
 
 // Timestamp returns the timestamp of the event.
@@ -1136,9 +2067,58 @@ func (e *ExecuteSendStateEvent) Timestamp() time.Time {
 }
 
 // NewExecuteSendStateEvent creates a new ExecuteSendStateEvent.
-func NewExecuteSendStateEvent(state SendState) *ExecuteSendStateEvent {
+func NewExecuteSendStateEvent(id uint64, state SendState,
+	anchorTXID *chainhash.Hash, attempt uint32,
+	err error) *ExecuteSendStateEvent {
+
 	return &ExecuteSendStateEvent{
-		timestamp: time.Now().UTC(),
-		SendState: state,
+		timestamp:  time.Now().UTC(),
+		ID:         id,
+		SendState:  state,
+		AnchorTXID: anchorTXID,
+		Attempt:    attempt,
+		Err:        err,
+		Resumable:  err != nil && state > SendStateLogCommit,
 	}
 }
+
+// SendEventReplay specifies which of the send events that are still kept in
+// memory are delivered to a new subscriber. If no field is set, all of them
+// are delivered.
+type SendEventReplay struct {
+	// AnchorTXID, if set, denotes that all events starting with the first
+	// event of the transfer with this anchor transaction hash should be
+	// delivered. Events that were emitted before the anchor transaction of
+	// the transfer was known, or before its fee was bumped, are included.
+	AnchorTXID *chainhash.Hash
+}
+
+// replaySendEvents returns the events of the given history that should be
+// replayed to a new subscriber.
+func replaySendEvents(history []*ExecuteSendStateEvent,
+	replay SendEventReplay) []*ExecuteSendStateEvent {
+
+	if replay.AnchorTXID == nil {
+		return history
+	}
+
+	// We first find out which transfer the anchor transaction belongs to,
+	// then deliver everything starting with its first event.
+	transferEvent, err := fn.First(
+		history, func(event *ExecuteSendStateEvent) bool {
+			return event.AnchorTXID != nil &&
+				*event.AnchorTXID == *replay.AnchorTXID
+		},
+	)
+	if err != nil {
+		return nil
+	}
+
+	for idx, event := range history {
+		if event.transferSeq == transferEvent.transferSeq {
+			return history[idx:]
+		}
+	}
+
+	return nil
+}
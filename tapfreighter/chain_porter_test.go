@@ -1,17 +1,503 @@
 package tapfreighter
 
 import (
+	"context"
+	"errors"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRunChainPorter(t *testing.T) {
 	t.Parallel()
 }
 
+// TestSendEventReplay tests that the send events kept in memory are replayed
+// to new subscribers, starting with the first event of a transfer.
+func TestSendEventReplay(t *testing.T) {
+	t.Parallel()
+
+	porter := NewChainPorter(&ChainPorterConfig{})
+
+	newPkg := func(seq uint64) *sendPackage {
+		anchorTx := wire.NewMsgTx(2)
+		anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+
+		return &sendPackage{
+			transferSeq: seq,
+			AnchorTx: &AnchorTransaction{
+				FinalTx: anchorTx,
+			},
+		}
+	}
+	pkg1, pkg2 := newPkg(1), newPkg(2)
+
+	// The first event of the second transfer is emitted before its anchor
+	// transaction is known.
+	anchorTx2 := pkg2.AnchorTx
+	pkg2.AnchorTx = nil
+
+	stateErr := errors.New("broadcast failed")
+	porter.publishSendEvent(pkg1, SendStateAnchorSign, 1, nil)
+	porter.publishSendEvent(pkg2, SendStateVirtualSign, 1, nil)
+	pkg2.AnchorTx = anchorTx2
+	porter.publishSendEvent(pkg1, SendStateBroadcast, 1, nil)
+	porter.publishSendEvent(pkg2, SendStateBroadcast, 1, stateErr)
+
+	receive := func(replay SendEventReplay) []uint64 {
+		receiver := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+		defer receiver.Stop()
+
+		err := porter.RegisterSubscriber(receiver, true, replay)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, porter.RemoveSubscriber(receiver))
+		}()
+
+		var ids []uint64
+		for {
+			select {
+			case event := <-receiver.NewItemCreated.ChanOut():
+				sendEvent := event.(*ExecuteSendStateEvent)
+				ids = append(ids, sendEvent.ID)
+
+			case <-time.After(50 * time.Millisecond):
+				return ids
+			}
+		}
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4}, receive(SendEventReplay{}))
+	require.Equal(t, []uint64{2, 3, 4}, receive(SendEventReplay{
+		AnchorTXID: pkg2.anchorTXID(),
+	}))
+	require.Empty(t, receive(SendEventReplay{
+		AnchorTXID: newPkg(3).anchorTXID(),
+	}))
+
+	failedEvent := porter.eventHistory[3]
+	require.ErrorIs(t, failedEvent.Err, stateErr)
+	require.True(t, failedEvent.Resumable)
+	require.Nil(t, porter.eventHistory[1].AnchorTXID)
+}
+
+// defaultWaitTimeout is the time we wait for the chain porter to react to a
+// notification.
+const defaultWaitTimeout = 5 * time.Second
+
+// mockConfChainBridge is a chain bridge that lets the test deliver
+// confirmation and spend notifications.
+type mockConfChainBridge struct {
+	ChainBridge
+
+	mu        sync.Mutex
+	confs     map[chainhash.Hash]chan *chainntnfs.TxConfirmation
+	spends    []chan *chainntnfs.SpendDetail
+	confReqs  chan chainhash.Hash
+	published []*wire.MsgTx
+}
+
+func newMockConfChainBridge() *mockConfChainBridge {
+	return &mockConfChainBridge{
+		confs: make(
+			map[chainhash.Hash]chan *chainntnfs.TxConfirmation,
+		),
+		confReqs: make(chan chainhash.Hash, 10),
+	}
+}
+
+func (m *mockConfChainBridge) RegisterConfirmationsNtfn(_ context.Context,
+	txid *chainhash.Hash, _ []byte, _, _ uint32, _ bool,
+	_ chan struct{}) (*chainntnfs.ConfirmationEvent, chan error, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	confChan := make(chan *chainntnfs.TxConfirmation, 1)
+	m.confs[*txid] = confChan
+	m.confReqs <- *txid
+
+	return &chainntnfs.ConfirmationEvent{
+		Confirmed: confChan,
+		Cancel:    func() {},
+	}, make(chan error), nil
+}
+
+func (m *mockConfChainBridge) RegisterSpendNtfn(_ context.Context,
+	_ *wire.OutPoint, _ []byte,
+	_ uint32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	m.spends = append(m.spends, spendChan)
+
+	return spendChan, make(chan error), nil
+}
+
+func (m *mockConfChainBridge) PublishTransaction(_ context.Context,
+	tx *wire.MsgTx, _ string) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.published = append(m.published, tx)
+
+	return nil
+}
+
+// confirm delivers the confirmation of the given transaction to the latest
+// confirmation request for it and its spend of the anchor input to all spend
+// requests.
+func (m *mockConfChainBridge) confirm(tx *wire.MsgTx) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txid := tx.TxHash()
+	for _, spendChan := range m.spends {
+		spendChan <- &chainntnfs.SpendDetail{
+			SpentOutPoint: &tx.TxIn[0].PreviousOutPoint,
+			SpenderTxHash: &txid,
+			SpendingTx:    tx,
+		}
+	}
+	m.spends = nil
+
+	if confChan, ok := m.confs[txid]; ok {
+		confChan <- &chainntnfs.TxConfirmation{
+			Tx: tx,
+		}
+	}
+}
+
+// mockPendingExportLog is an export log that keeps a single pending parcel in
+// memory.
+type mockPendingExportLog struct {
+	ExportLog
+
+	mu     sync.Mutex
+	parcel *OutboundParcel
+}
+
+func (m *mockPendingExportLog) PendingParcels(
+	context.Context) ([]*OutboundParcel, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return []*OutboundParcel{m.parcel}, nil
+}
+
+func (m *mockPendingExportLog) ReplacePendingParcelAnchorTx(_ context.Context,
+	anchorTXID chainhash.Hash, newParcel *OutboundParcel) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.parcel.AnchorTx.TxHash() != anchorTXID {
+		return errors.New("unknown anchor tx")
+	}
+	m.parcel = newParcel
+
+	return nil
+}
+
+// mockBumpWallet is an asset wallet that bumps the fee of an anchor
+// transaction by deducting the given amount from its change output.
+type mockBumpWallet struct {
+	Wallet
+
+	bumpAmt int64
+}
+
+func (m *mockBumpWallet) BumpAnchorFee(_ context.Context,
+	parcel *OutboundParcel, _ chainfee.SatPerKWeight) (*AnchorTransaction,
+	error) {
+
+	pkt, err := copyPsbt(parcel.AnchorPsbt)
+	if err != nil {
+		return nil, err
+	}
+
+	changeIdx := len(pkt.UnsignedTx.TxOut) - 1
+	pkt.UnsignedTx.TxOut[changeIdx].Value -= m.bumpAmt
+
+	return &AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               pkt,
+			ChangeOutputIndex: int32(changeIdx),
+		},
+		FinalTx:   pkt.UnsignedTx.Copy(),
+		ChainFees: parcel.ChainFees + m.bumpAmt,
+	}, nil
+}
+
+// TestReplacedAnchorTxConfirms tests that a transfer completes with the
+// original anchor transaction if it confirms after its fee was bumped.
+func TestReplacedAnchorTxConfirms(t *testing.T) {
+	t.Parallel()
+
+	// The anchor transaction has an asset anchor output and a BTC change
+	// output.
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    1000,
+		PkScript: test.RandBytes(34),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    50_000,
+		PkScript: test.RandBytes(34),
+	})
+
+	anchorPkt, err := psbt.NewFromUnsignedTx(anchorTx.Copy())
+	require.NoError(t, err)
+	anchorPkt.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    52_000,
+		PkScript: test.RandBytes(34),
+	}
+
+	parcel := &OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorPsbt:         anchorPkt,
+		AnchorTxHeightHint: 100,
+		ChainFees:          1000,
+		Outputs: []TransferOutput{{
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTx.TxHash(),
+					Index: 0,
+				},
+			},
+		}},
+	}
+
+	chainBridge := newMockConfChainBridge()
+	exportLog := &mockPendingExportLog{
+		parcel: parcel,
+	}
+	porter := NewChainPorter(&ChainPorterConfig{
+		ChainBridge: chainBridge,
+		ExportLog:   exportLog,
+		AssetWallet: &mockBumpWallet{
+			bumpAmt: 2000,
+		},
+	})
+
+	pkg := &sendPackage{
+		OutboundPkg: parcel,
+		SendState:   SendStateWaitTxConf,
+	}
+	waitForConf := func() chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- porter.waitForTransferTxConf(pkg)
+		}()
+
+		return errChan
+	}
+	requireConfReq := func(txid chainhash.Hash) {
+		select {
+		case reqTXID := <-chainBridge.confReqs:
+			require.Equal(t, txid, reqTXID)
+
+		case <-time.After(defaultWaitTimeout):
+			t.Fatalf("no confirmation request for tx %v", txid)
+		}
+	}
+	requireResult := func(errChan chan error) {
+		select {
+		case err := <-errChan:
+			require.NoError(t, err)
+
+		case <-time.After(defaultWaitTimeout):
+			t.Fatalf("no result")
+		}
+	}
+
+	// We wait for the original anchor transaction to confirm and bump its
+	// fee in the meantime.
+	errChan := waitForConf()
+	requireConfReq(anchorTx.TxHash())
+
+	ctx := context.Background()
+	newParcel, err := porter.BumpFee(
+		ctx, anchorTx.TxHash(), chainfee.FeePerKwFloor,
+	)
+	require.NoError(t, err)
+	requireResult(errChan)
+
+	bumpTx := newParcel.AnchorTx
+	require.NotEqual(t, anchorTx.TxHash(), bumpTx.TxHash())
+	require.Equal(t, newParcel, pkg.OutboundPkg)
+	require.Equal(t, bumpTx, exportLog.parcel.AnchorTx)
+	require.Equal(t, []*wire.MsgTx{bumpTx}, chainBridge.published)
+
+	// We now wait for the replacement to confirm, but the original anchor
+	// transaction confirms instead. The transfer must be anchored in the
+	// original transaction again.
+	errChan = waitForConf()
+	requireConfReq(bumpTx.TxHash())
+
+	chainBridge.confirm(anchorTx)
+	requireResult(errChan)
+
+	restored := pkg.OutboundPkg
+	require.Equal(t, SendStateWaitTxConf, pkg.SendState)
+	require.Equal(t, anchorTx.TxHash(), restored.AnchorTx.TxHash())
+	require.Equal(
+		t, anchorTx.TxHash(), restored.AnchorPsbt.UnsignedTx.TxHash(),
+	)
+	require.Equal(t, parcel.ChainFees, restored.ChainFees)
+	require.Equal(
+		t, anchorTx.TxHash(), restored.Outputs[0].Anchor.OutPoint.Hash,
+	)
+	require.Equal(t, restored, exportLog.parcel)
+
+	// Finally, the confirmation of the original anchor transaction is
+	// used to store the proofs.
+	errChan = waitForConf()
+	requireConfReq(anchorTx.TxHash())
+
+	chainBridge.confirm(anchorTx)
+	requireResult(errChan)
+
+	require.Equal(t, SendStateStoreProofs, pkg.SendState)
+	require.Equal(t, anchorTx, pkg.TransferTxConfEvent.Tx)
+}
+
+// TestReplacedAnchorTx tests that only versions of the anchor transaction that
+// differ in the value of the BTC change output are accepted as replaced
+// versions.
+func TestReplacedAnchorTx(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    1000,
+		PkScript: test.RandBytes(34),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    40_000,
+		PkScript: test.RandBytes(34),
+	})
+
+	anchorPkt, err := psbt.NewFromUnsignedTx(anchorTx.Copy())
+	require.NoError(t, err)
+
+	parcel := &OutboundParcel{
+		AnchorTx:   anchorTx,
+		AnchorPsbt: anchorPkt,
+		ChainFees:  3000,
+		Outputs: []TransferOutput{{
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTx.TxHash(),
+					Index: 0,
+				},
+			},
+		}},
+	}
+
+	testCases := []struct {
+		name      string
+		modify    func(tx *wire.MsgTx)
+		chainFees int64
+		errStr    string
+	}{{
+		name: "higher change value",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[1].Value += 2000
+		},
+		chainFees: 1000,
+	}, {
+		name: "lower change value",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[1].Value -= 2000
+		},
+		chainFees: 5000,
+	}, {
+		name: "different input",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxIn[1].PreviousOutPoint = test.RandOp(t)
+		},
+		errStr: "input 1 differs",
+	}, {
+		name: "missing input",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxIn = tx.TxIn[:1]
+		},
+		errStr: "number of inputs differs",
+	}, {
+		name: "different anchor value",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[0].Value++
+		},
+		errStr: "output 0 differs",
+	}, {
+		name: "different change script",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[1].PkScript = test.RandBytes(34)
+		},
+		errStr: "output 1 differs",
+	}, {
+		name: "extra output",
+		modify: func(tx *wire.MsgTx) {
+			tx.AddTxOut(&wire.TxOut{
+				Value:    1000,
+				PkScript: test.RandBytes(34),
+			})
+		},
+		errStr: "number of outputs differs",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tx := anchorTx.Copy()
+			tc.modify(tx)
+
+			replacedTx, err := replacedAnchorTx(parcel, tx)
+			if tc.errStr != "" {
+				require.ErrorContains(t, err, tc.errStr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tx, replacedTx.FinalTx)
+			require.Equal(t, tc.chainFees, replacedTx.ChainFees)
+			require.Equal(
+				t, tx.TxHash(),
+				replacedTx.FundedPsbt.Pkt.UnsignedTx.TxHash(),
+			)
+
+			// The PSBT of the parcel must not be modified.
+			require.Equal(
+				t, anchorTx.TxHash(),
+				parcel.AnchorPsbt.UnsignedTx.TxHash(),
+			)
+		})
+	}
+}
+
 func init() {
 	rand.Seed(time.Now().Unix())
 
@@ -0,0 +1,96 @@
+package tapfreighter
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// ChangePolicy governs how the change of an asset transfer is handled. The
+// zero value results in the default behavior of deriving change script keys
+// from the Taproot Assets key family and anchoring the change in its own
+// output.
+type ChangePolicy struct {
+	// KeyFamily is the key family the script keys of change outputs are
+	// derived from. Zero means the Taproot Assets key family is used.
+	KeyFamily uint32 `long:"keyfamily" description:"The key family (BIP-0043 account) the script keys of asset change outputs are derived from. 0 uses the default Taproot Assets key family (212)."`
+
+	// ShareAnchorOutput indicates that change may be committed to the same
+	// anchor output as an interactive recipient output that is anchored
+	// locally, instead of creating a separate anchor output for it.
+	ShareAnchorOutput bool `long:"shareanchor" description:"Commit asset change to the same anchor output as the recipient output if that output is an interactive output anchored in the local wallet, instead of creating a separate BTC output for the change."`
+
+	// MinChangeAmount is the smallest amount of change that is kept. If
+	// the change of a transfer to a single interactive recipient is below
+	// this amount, it is added to the amount sent instead.
+	MinChangeAmount uint64 `long:"minchangeamount" description:"The minimum amount of asset change that is kept. Smaller change of interactive sends to a single recipient is added to the amount sent. 0 always keeps the change."`
+}
+
+// Validate makes sure the change policy is sane.
+func (p *ChangePolicy) Validate() error {
+	// The key families up to the watchtower family are reserved by lnd,
+	// we don't want to derive change keys from any of those.
+	if p.KeyFamily != 0 &&
+		p.KeyFamily <= uint32(keychain.KeyFamilyTowerID) {
+
+		return fmt.Errorf("key family %d is reserved by lnd",
+			p.KeyFamily)
+	}
+
+	return nil
+}
+
+// ScriptKeyFamily returns the key family the script keys of change outputs
+// should be derived from.
+func (p *ChangePolicy) ScriptKeyFamily() keychain.KeyFamily {
+	if p.KeyFamily == 0 {
+		return asset.TaprootAssetsKeyFamily
+	}
+
+	return keychain.KeyFamily(p.KeyFamily)
+}
+
+// changeAnchorIndex returns the anchor output index the change output of the
+// given packet should be committed to. The change shares the anchor output of
+// the last output if the policy allows it and that output is an interactive
+// output that will be anchored locally. Otherwise, a new anchor output is
+// used.
+func (p *ChangePolicy) changeAnchorIndex(vPkt *tappsbt.VPacket) uint32 {
+	lastOut := vPkt.Outputs[len(vPkt.Outputs)-1]
+	if p.ShareAnchorOutput && lastOut.Interactive &&
+		lastOut.AnchorOutputInternalKey == nil {
+
+		return lastOut.AnchorOutputIndex
+	}
+
+	return lastOut.AnchorOutputIndex + 1
+}
+
+// absorbChange adds the given change amount to the recipient output of the
+// packet if the change is below the minimum change amount of the policy. This
+// is only done for interactive sends to a single recipient that don't already
+// declare a change output, since the amount of non-interactive outputs is
+// fixed by the address they're sent to. True is returned if the change was
+// absorbed.
+func (p *ChangePolicy) absorbChange(vPkt *tappsbt.VPacket,
+	change uint64) bool {
+
+	if change == 0 || change >= p.MinChangeAmount {
+		return false
+	}
+
+	if len(vPkt.Outputs) != 1 {
+		return false
+	}
+
+	recipientOut := vPkt.Outputs[0]
+	if !recipientOut.Interactive || recipientOut.Type.IsSplitRoot() {
+		return false
+	}
+
+	recipientOut.Amount += change
+
+	return true
+}
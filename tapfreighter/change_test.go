@@ -0,0 +1,73 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChangePolicy tests that the change policy is validated and applied to
+// the outputs of a virtual packet correctly.
+func TestChangePolicy(t *testing.T) {
+	t.Parallel()
+
+	// The zero value keeps the default behavior.
+	policy := &ChangePolicy{}
+	require.NoError(t, policy.Validate())
+	require.EqualValues(
+		t, asset.TaprootAssetsKeyFamily, policy.ScriptKeyFamily(),
+	)
+
+	policy.KeyFamily = uint32(keychain.KeyFamilyNodeKey)
+	require.Error(t, policy.Validate())
+
+	policy.KeyFamily = 1017
+	require.NoError(t, policy.Validate())
+	require.EqualValues(t, 1017, policy.ScriptKeyFamily())
+
+	newPacket := func(interactive bool) *tappsbt.VPacket {
+		return &tappsbt.VPacket{
+			Outputs: []*tappsbt.VOutput{{
+				Amount:            50,
+				Interactive:       interactive,
+				AnchorOutputIndex: 0,
+			}},
+		}
+	}
+
+	// Change gets its own anchor output unless the policy allows sharing
+	// a locally anchored interactive output.
+	vPkt := newPacket(true)
+	require.EqualValues(t, 1, policy.changeAnchorIndex(vPkt))
+
+	policy.ShareAnchorOutput = true
+	require.EqualValues(t, 0, policy.changeAnchorIndex(vPkt))
+	require.EqualValues(t, 1, policy.changeAnchorIndex(newPacket(false)))
+
+	vPkt.Outputs[0].SetAnchorInternalKey(
+		keychain.KeyDescriptor{PubKey: test.RandPubKey(t)}, 0,
+	)
+	require.EqualValues(t, 1, policy.changeAnchorIndex(vPkt))
+
+	// Change is only ever absorbed if it is below the minimum and goes to
+	// a single interactive recipient.
+	vPkt = newPacket(true)
+	require.False(t, policy.absorbChange(vPkt, 5))
+
+	policy.MinChangeAmount = 10
+	require.False(t, policy.absorbChange(vPkt, 10))
+	require.False(t, policy.absorbChange(newPacket(false), 5))
+	require.True(t, policy.absorbChange(vPkt, 5))
+	require.EqualValues(t, 55, vPkt.Outputs[0].Amount)
+
+	vPkt = newPacket(true)
+	vPkt.Outputs = append(vPkt.Outputs, &tappsbt.VOutput{
+		Interactive:       true,
+		AnchorOutputIndex: 1,
+	})
+	require.False(t, policy.absorbChange(vPkt, 5))
+}
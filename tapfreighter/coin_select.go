@@ -0,0 +1,267 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrInsufficientCoins is returned if the candidates given to a coin
+	// selection strategy don't sum up to the target amount, or if the
+	// strategy doesn't allow selecting enough of them.
+	ErrInsufficientCoins = errors.New("insufficient coins for strategy")
+
+	// ErrNothingToConsolidate is returned if an asset doesn't have more
+	// UTXOs than the consolidation target.
+	ErrNothingToConsolidate = errors.New("number of asset UTXOs doesn't " +
+		"exceed the consolidation target")
+
+	// ErrNothingToSweep is returned if there are no asset UTXOs to sweep.
+	ErrNothingToSweep = errors.New("no asset UTXOs to sweep")
+)
+
+// SelectByStrategy selects a subset of the given candidates according to the
+// given strategy. The target function returns the amount the selected
+// candidates must at least sum up to, which can depend on the selected
+// candidates themselves, for example to account for the fees of spending
+// them. The candidates aren't modified.
+func SelectByStrategy[T any](candidates []T, amount func(T) uint64,
+	target func([]T) uint64,
+	strategy MultiCommitmentSelectStrategy) ([]T, error) {
+
+	ascending := make([]T, len(candidates))
+	copy(ascending, candidates)
+	sort.SliceStable(ascending, func(i, j int) bool {
+		return amount(ascending[i]) < amount(ascending[j])
+	})
+
+	descending := make([]T, len(ascending))
+	for idx := range ascending {
+		descending[len(ascending)-1-idx] = ascending[idx]
+	}
+
+	// accumulate selects the candidates in the given order until their
+	// sum reaches the target.
+	accumulate := func(ordered []T) ([]T, error) {
+		var (
+			selected []T
+			sum      uint64
+		)
+		for _, candidate := range ordered {
+			selected = append(selected, candidate)
+
+			sum += amount(candidate)
+			if sum >= target(selected) {
+				return selected, nil
+			}
+		}
+
+		return nil, ErrInsufficientCoins
+	}
+
+	// singleCoin selects the smallest candidate that reaches the target
+	// on its own.
+	singleCoin := func() ([]T, error) {
+		for _, candidate := range ascending {
+			selected := []T{candidate}
+			if amount(candidate) >= target(selected) {
+				return selected, nil
+			}
+		}
+
+		return nil, ErrInsufficientCoins
+	}
+
+	switch strategy {
+	case PreferMaxAmount:
+		return accumulate(descending)
+
+	case PreferMinAmount:
+		return accumulate(ascending)
+
+	case PreferFewestInputs:
+		selected, err := singleCoin()
+		if err == nil {
+			return selected, nil
+		}
+
+		return accumulate(descending)
+
+	case PreferNoMerge:
+		return singleCoin()
+
+	default:
+		return nil, fmt.Errorf("unknown multi coin selection "+
+			"strategy: %v", strategy)
+	}
+}
+
+// SelectAnchorUtxos selects the BTC level inputs from the given UTXOs that
+// fund the outputs of the given anchor transaction template at the given fee
+// rate, according to the given strategy. The selected inputs need to cover the
+// fee of a P2TR change output too, since the change is only added once the
+// template is funded.
+func SelectAnchorUtxos(utxos []*lnwallet.Utxo, packet *psbt.Packet,
+	feeRate chainfee.SatPerKWeight,
+	strategy MultiCommitmentSelectStrategy) ([]*lnwallet.Utxo, error) {
+
+	var (
+		baseWeight input.TxWeightEstimator
+		outputSum  uint64
+	)
+	for _, txOut := range packet.UnsignedTx.TxOut {
+		baseWeight.AddTxOutput(txOut)
+		outputSum += uint64(txOut.Value)
+	}
+	baseWeight.AddP2TROutput()
+
+	// We can only estimate the weight of the inputs we know how to spend.
+	spendable := make([]*lnwallet.Utxo, 0, len(utxos))
+	for _, utxo := range utxos {
+		switch utxo.AddressType {
+		case lnwallet.WitnessPubKey, lnwallet.NestedWitnessPubKey,
+			lnwallet.TaprootPubkey:
+
+			spendable = append(spendable, utxo)
+		}
+	}
+
+	target := func(selected []*lnwallet.Utxo) uint64 {
+		weight := baseWeight
+		for _, utxo := range selected {
+			switch utxo.AddressType {
+			case lnwallet.WitnessPubKey:
+				weight.AddP2WKHInput()
+
+			case lnwallet.NestedWitnessPubKey:
+				weight.AddNestedP2WKHInput()
+
+			case lnwallet.TaprootPubkey:
+				weight.AddTaprootKeySpendInput(
+					txscript.SigHashDefault,
+				)
+			}
+		}
+
+		fee := feeRate.FeeForWeight(int64(weight.Weight()))
+		return outputSum + uint64(fee)
+	}
+
+	selected, err := SelectByStrategy(
+		spendable, func(utxo *lnwallet.Utxo) uint64 {
+			return uint64(utxo.Value)
+		}, target, strategy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to select anchor inputs: %w",
+			err)
+	}
+
+	return selected, nil
+}
+
+// SelectForConsolidation selects the smallest of the given coins that need to
+// be merged into a single one so that at most targetCount coins remain. The
+// coins aren't modified.
+func SelectForConsolidation(coins []*AnchoredCommitment,
+	targetCount uint32) ([]*AnchoredCommitment, error) {
+
+	if targetCount == 0 {
+		return nil, fmt.Errorf("consolidation target must be at " +
+			"least one UTXO")
+	}
+
+	if uint64(len(coins)) <= uint64(targetCount) {
+		return nil, ErrNothingToConsolidate
+	}
+
+	ascending := make([]*AnchoredCommitment, len(coins))
+	copy(ascending, coins)
+	sort.SliceStable(ascending, func(i, j int) bool {
+		return ascending[i].Asset.Amount < ascending[j].Asset.Amount
+	})
+
+	// Merging n coins into one reduces the number of coins by n-1, so we
+	// need to merge one more than the number of coins above the target.
+	numMerged := len(coins) - int(targetCount) + 1
+
+	return ascending[:numMerged], nil
+}
+
+// SweepPlan describes the assets that are swept in a single anchor
+// transaction.
+type SweepPlan struct {
+	// AssetIDs are the IDs of the assets that are swept, in ascending
+	// order.
+	AssetIDs []asset.ID
+
+	// Coins are the coins of each swept asset.
+	Coins map[asset.ID][]*AnchoredCommitment
+
+	// Deferred are the IDs of the assets that can't be swept in the same
+	// transaction, in ascending order.
+	Deferred []asset.ID
+}
+
+// PlanSweep decides which assets of the given coins are swept in a single
+// anchor transaction. Every anchor output can only be spent by the virtual
+// transaction of a single asset, which re-anchors all other assets of the
+// output as passive assets under their current script keys. So an asset that
+// shares an anchor output with an asset that is swept before it is deferred
+// until that transaction confirmed. The assets are considered in ascending
+// order of their IDs.
+func PlanSweep(coins []*AnchoredCommitment) (*SweepPlan, error) {
+	if len(coins) == 0 {
+		return nil, ErrNothingToSweep
+	}
+
+	coinsByID := make(map[asset.ID][]*AnchoredCommitment)
+	for _, coin := range coins {
+		assetID := coin.Asset.ID()
+		coinsByID[assetID] = append(coinsByID[assetID], coin)
+	}
+
+	assetIDs := make([]asset.ID, 0, len(coinsByID))
+	for assetID := range coinsByID {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sort.Slice(assetIDs, func(i, j int) bool {
+		return bytes.Compare(assetIDs[i][:], assetIDs[j][:]) < 0
+	})
+
+	plan := &SweepPlan{
+		Coins: make(map[asset.ID][]*AnchoredCommitment),
+	}
+	claimed := make(map[wire.OutPoint]struct{})
+	for _, assetID := range assetIDs {
+		assetCoins := coinsByID[assetID]
+
+		shared := fn.Any(assetCoins, func(c *AnchoredCommitment) bool {
+			_, ok := claimed[c.AnchorPoint]
+			return ok
+		})
+		if shared {
+			plan.Deferred = append(plan.Deferred, assetID)
+			continue
+		}
+
+		for _, coin := range assetCoins {
+			claimed[coin.AnchorPoint] = struct{}{}
+		}
+		plan.AssetIDs = append(plan.AssetIDs, assetID)
+		plan.Coins[assetID] = assetCoins
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,186 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCoinSelectStrategy tests that all strategies can be parsed from
+// their names.
+func TestParseCoinSelectStrategy(t *testing.T) {
+	t.Parallel()
+
+	for s := DefaultSelectStrategy; s <= PreferNoMerge; s++ {
+		parsed, err := ParseCoinSelectStrategy(s.String())
+		require.NoError(t, err)
+		require.Equal(t, s, parsed)
+	}
+
+	_, err := ParseCoinSelectStrategy("random")
+	require.Error(t, err)
+}
+
+// TestSelectAnchorUtxos tests that the BTC level inputs of an anchor
+// transaction are selected with the given strategy and cover the fees of
+// spending them.
+func TestSelectAnchorUtxos(t *testing.T) {
+	t.Parallel()
+
+	pkScript := test.RandBytes(34)
+	packet, err := psbt.New(
+		nil, []*wire.TxOut{{Value: 1000, PkScript: pkScript}}, 2, 0,
+		nil,
+	)
+	require.NoError(t, err)
+
+	newUtxo := func(value btcutil.Amount) *lnwallet.Utxo {
+		return &lnwallet.Utxo{
+			AddressType: lnwallet.TaprootPubkey,
+			Value:       value,
+			OutPoint: wire.OutPoint{
+				Index: uint32(value),
+			},
+		}
+	}
+	small, medium, large := newUtxo(600), newUtxo(5_000), newUtxo(50_000)
+	unknown := newUtxo(100_000)
+	unknown.AddressType = lnwallet.UnknownAddressType
+	utxos := []*lnwallet.Utxo{medium, unknown, large, small}
+
+	feeRate := chainfee.SatPerKWeight(1000)
+	selectUtxos := func(
+		strategy MultiCommitmentSelectStrategy) []*lnwallet.Utxo {
+
+		selected, err := SelectAnchorUtxos(
+			utxos, packet, feeRate, strategy,
+		)
+		require.NoError(t, err)
+
+		return selected
+	}
+
+	require.Equal(t, []*lnwallet.Utxo{large}, selectUtxos(PreferMaxAmount))
+	require.Equal(
+		t, []*lnwallet.Utxo{medium}, selectUtxos(PreferFewestInputs),
+	)
+	require.Equal(t, []*lnwallet.Utxo{medium}, selectUtxos(PreferNoMerge))
+
+	// The smallest UTXO covers the output amount but not the fees, so a
+	// second one needs to be selected.
+	require.Equal(
+		t, []*lnwallet.Utxo{small, medium},
+		selectUtxos(PreferMinAmount),
+	)
+
+	// UTXOs of unknown types are never selected, even if they would be
+	// the only ones large enough.
+	packet.UnsignedTx.TxOut[0].Value = 75_000
+	_, err = SelectAnchorUtxos(utxos, packet, feeRate, PreferNoMerge)
+	require.ErrorIs(t, err, ErrInsufficientCoins)
+}
+
+// TestSelectForConsolidation tests that the smallest coins are selected for
+// consolidation, so that the target number of coins remains.
+func TestSelectForConsolidation(t *testing.T) {
+	t.Parallel()
+
+	newCoin := func(amount uint64) *AnchoredCommitment {
+		return &AnchoredCommitment{
+			Asset: &asset.Asset{
+				Amount: amount,
+			},
+		}
+	}
+	coins := []*AnchoredCommitment{
+		newCoin(50), newCoin(10), newCoin(40), newCoin(20),
+		newCoin(30),
+	}
+
+	// A target of zero coins can never be reached.
+	_, err := SelectForConsolidation(coins, 0)
+	require.Error(t, err)
+
+	// If there aren't more coins than the target, there's nothing to do.
+	_, err = SelectForConsolidation(coins, 5)
+	require.ErrorIs(t, err, ErrNothingToConsolidate)
+
+	// Merging the two smallest coins leaves four coins.
+	selected, err := SelectForConsolidation(coins, 4)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{coins[1], coins[3]}, selected)
+
+	// Merging all coins leaves a single one.
+	selected, err = SelectForConsolidation(coins, 1)
+	require.NoError(t, err)
+	require.Len(t, selected, len(coins))
+
+	// The given coins aren't re-ordered.
+	require.EqualValues(t, 50, coins[0].Asset.Amount)
+}
+
+// TestPlanSweep tests that assets sharing an anchor output with an asset that
+// is swept before them are deferred.
+func TestPlanSweep(t *testing.T) {
+	t.Parallel()
+
+	_, err := PlanSweep(nil)
+	require.ErrorIs(t, err, ErrNothingToSweep)
+
+	// We need the asset IDs in ascending order to know which asset is
+	// swept first.
+	genesisA := asset.RandGenesis(t, asset.Normal)
+	genesisB := asset.RandGenesis(t, asset.Normal)
+	genesisC := asset.RandGenesis(t, asset.Normal)
+	geneses := []asset.Genesis{genesisA, genesisB, genesisC}
+	sort.Slice(geneses, func(i, j int) bool {
+		idI, idJ := geneses[i].ID(), geneses[j].ID()
+		return bytes.Compare(idI[:], idJ[:]) < 0
+	})
+	idA, idB, idC := geneses[0].ID(), geneses[1].ID(), geneses[2].ID()
+
+	newCoin := func(genesis asset.Genesis,
+		anchor wire.OutPoint) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint: anchor,
+			Asset: &asset.Asset{
+				Genesis: genesis,
+				Amount:  1,
+			},
+		}
+	}
+
+	// The first asset shares an anchor with the second one, which is
+	// therefore deferred. The third asset has its own anchors.
+	sharedAnchor := test.RandOp(t)
+	coins := []*AnchoredCommitment{
+		newCoin(geneses[2], test.RandOp(t)),
+		newCoin(geneses[1], sharedAnchor),
+		newCoin(geneses[0], sharedAnchor),
+		newCoin(geneses[0], test.RandOp(t)),
+		newCoin(geneses[1], test.RandOp(t)),
+		newCoin(geneses[2], test.RandOp(t)),
+	}
+
+	plan, err := PlanSweep(coins)
+	require.NoError(t, err)
+	require.Equal(t, []asset.ID{idA, idC}, plan.AssetIDs)
+	require.Equal(t, []asset.ID{idB}, plan.Deferred)
+	require.Len(t, plan.Coins, 2)
+	require.Equal(
+		t, []*AnchoredCommitment{coins[2], coins[3]}, plan.Coins[idA],
+	)
+	require.Equal(
+		t, []*AnchoredCommitment{coins[0], coins[5]}, plan.Coins[idC],
+	)
+}
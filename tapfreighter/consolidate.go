@@ -0,0 +1,300 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ConsolidationPolicy governs the automatic consolidation of asset UTXOs. The
+// zero value disables automatic consolidation.
+type ConsolidationPolicy struct {
+	// Interval is the interval in which the asset UTXOs are checked for
+	// consolidation.
+	Interval time.Duration `long:"interval" description:"The interval (1h, 24h, etc) in which the asset UTXOs are checked for automatic consolidation. 0 disables automatic consolidation."`
+
+	// TargetUtxos is the number of UTXOs per asset ID that is aimed for.
+	// The smallest UTXOs of an asset that has more are merged.
+	TargetUtxos uint32 `long:"targetutxos" description:"The number of UTXOs per asset ID that automatic consolidation aims for. The smallest UTXOs of an asset that has more are merged into one."`
+
+	// MaxSatPerVByte is the highest estimated on-chain fee rate in sat/vB
+	// at which the asset UTXOs are consolidated automatically.
+	MaxSatPerVByte uint64 `long:"maxsatpervbyte" description:"The maximum estimated on-chain fee rate in sat/vB at which asset UTXOs are consolidated automatically. 0 consolidates regardless of the fee rate."`
+}
+
+// Validate makes sure the consolidation policy is sane.
+func (p *ConsolidationPolicy) Validate() error {
+	if p.Interval < 0 {
+		return fmt.Errorf("interval must not be negative")
+	}
+
+	if p.Interval > 0 && p.TargetUtxos == 0 {
+		return fmt.Errorf("target number of UTXOs must be at least one")
+	}
+
+	return nil
+}
+
+// IsActive returns true if automatic consolidation is enabled.
+func (p *ConsolidationPolicy) IsActive() bool {
+	return p.Interval > 0
+}
+
+// MaxFeeRate returns the maximum fee rate of the policy, or zero if the
+// consolidation isn't limited by the fee rate.
+func (p *ConsolidationPolicy) MaxFeeRate() chainfee.SatPerKWeight {
+	return chainfee.SatPerKVByte(p.MaxSatPerVByte * 1000).FeePerKWeight()
+}
+
+// ConsolidatorConfig is the main config for the consolidator.
+type ConsolidatorConfig struct {
+	// CoinLister is used to find the assets that have more UTXOs than the
+	// target of the policy.
+	CoinLister CoinLister
+
+	// AssetWallet is used to fund and sign the consolidation transfers.
+	AssetWallet Wallet
+
+	// ChainPorter is used to ship the consolidation transfers.
+	ChainPorter Porter
+
+	// ChainBridge is used to estimate the current on-chain fee rate.
+	ChainBridge ChainBridge
+
+	// Policy is the policy for automatic consolidation.
+	Policy ConsolidationPolicy
+}
+
+// Consolidator merges many small asset UTXOs, along with their BTC anchors,
+// into fewer outputs. It does so on request or automatically, according to
+// its policy.
+type Consolidator struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *ConsolidatorConfig
+
+	// consolidationMtx makes sure only a single consolidation happens at
+	// a time.
+	consolidationMtx sync.Mutex
+
+	*fn.ContextGuard
+}
+
+// NewConsolidator creates a new consolidator given a valid config.
+func NewConsolidator(cfg *ConsolidatorConfig) *Consolidator {
+	return &Consolidator{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: tapgarden.DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the automatic consolidation, if the policy enables it.
+func (c *Consolidator) Start() error {
+	c.startOnce.Do(func() {
+		log.Infof("Starting Consolidator")
+
+		if !c.cfg.Policy.IsActive() {
+			return
+		}
+
+		c.Wg.Add(1)
+		go c.consolidationTicker()
+	})
+
+	return nil
+}
+
+// Stop signals the consolidator to halt all operations.
+func (c *Consolidator) Stop() error {
+	c.stopOnce.Do(func() {
+		log.Infof("Stopping Consolidator")
+
+		close(c.Quit)
+		c.Wg.Wait()
+	})
+
+	return nil
+}
+
+// ConsolidateAsset merges the smallest UTXOs of the given asset into a single
+// output of the wallet, so that at most the given number of UTXOs of the asset
+// remain. ErrNothingToConsolidate is returned if the asset doesn't have more
+// UTXOs than that.
+func (c *Consolidator) ConsolidateAsset(ctx context.Context, assetID asset.ID,
+	targetCount uint32) (*OutboundParcel, error) {
+
+	c.consolidationMtx.Lock()
+	defer c.consolidationMtx.Unlock()
+
+	fundedPkt, err := c.cfg.AssetWallet.FundConsolidation(
+		ctx, assetID, targetCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund consolidation: %w", err)
+	}
+
+	_, err = c.cfg.AssetWallet.SignVirtualPacket(fundedPkt.VPacket)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign consolidation: %w", err)
+	}
+
+	return c.cfg.ChainPorter.RequestShipment(NewPreSignedParcel(
+		fundedPkt.VPacket, fundedPkt.InputCommitments,
+		DefaultSelectStrategy,
+	))
+}
+
+// SweepAssets moves all UTXOs of the given asset, or of all assets if no asset
+// ID is given, to new script keys of the wallet, merging the UTXOs of each
+// asset into a single output. All of them are swept in a single anchor
+// transaction. Assets that share an anchor output with another swept asset
+// are re-anchored under their current script keys, their IDs are returned so
+// they can be swept again once the transfer confirmed. ErrNothingToSweep is
+// returned if there are no UTXOs to sweep.
+func (c *Consolidator) SweepAssets(ctx context.Context,
+	assetID *asset.ID) (*OutboundParcel, []asset.ID, error) {
+
+	c.consolidationMtx.Lock()
+	defer c.consolidationMtx.Unlock()
+
+	fundedPkts, deferred, err := c.cfg.AssetWallet.FundSweep(ctx, assetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fund sweep: %w", err)
+	}
+
+	vPkts := make([]*tappsbt.VPacket, len(fundedPkts))
+	inputCommitments := make([]tappsbt.InputCommitments, len(fundedPkts))
+	for idx, fundedPkt := range fundedPkts {
+		_, err := c.cfg.AssetWallet.SignVirtualPacket(fundedPkt.VPacket)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to sign sweep: %w",
+				err)
+		}
+
+		vPkts[idx] = fundedPkt.VPacket
+		inputCommitments[idx] = fundedPkt.InputCommitments
+	}
+
+	parcel, err := c.cfg.ChainPorter.RequestShipment(
+		NewMultiPreSignedParcel(
+			vPkts, inputCommitments, DefaultSelectStrategy,
+		),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parcel, deferred, nil
+}
+
+// consolidationTicker periodically consolidates the asset UTXOs according to
+// the policy.
+func (c *Consolidator) consolidationTicker() {
+	defer c.Wg.Done()
+
+	ticker := time.NewTicker(c.cfg.Policy.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.consolidateAll(); err != nil {
+				log.Errorf("Unable to consolidate asset "+
+					"UTXOs: %v", err)
+			}
+
+		case <-c.Quit:
+			return
+		}
+	}
+}
+
+// consolidateAll consolidates all assets that have more UTXOs than the target
+// of the policy, as long as the fee rate is low enough.
+func (c *Consolidator) consolidateAll() error {
+	ctx, cancel := c.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	policy := c.cfg.Policy
+	if policy.MaxSatPerVByte != 0 {
+		feeRate, err := c.cfg.ChainBridge.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to estimate fee: %w", err)
+		}
+
+		if feeRate > policy.MaxFeeRate() {
+			log.Debugf("Skipping consolidation, fee rate %v "+
+				"exceeds maximum of %v", feeRate,
+				policy.MaxFeeRate())
+
+			return nil
+		}
+	}
+
+	coins, err := c.cfg.CoinLister.ListEligibleCoins(
+		ctx, CommitmentConstraints{
+			MinAmt: 1,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list eligible coins: %w", err)
+	}
+
+	assetIDs := FragmentedAssets(coins, policy.TargetUtxos)
+	for _, assetID := range assetIDs {
+		log.Infof("Consolidating UTXOs of asset %x", assetID[:])
+
+		_, err := c.ConsolidateAsset(ctx, assetID, policy.TargetUtxos)
+		switch {
+		// Some of the coins might have been leased by another send in
+		// the meantime, which is fine.
+		case errors.Is(err, ErrNothingToConsolidate):
+
+		case err != nil:
+			log.Errorf("Unable to consolidate UTXOs of asset %x: "+
+				"%v", assetID[:], err)
+		}
+	}
+
+	return nil
+}
+
+// FragmentedAssets returns the IDs of the assets that have more of the given
+// coins than the target count, in ascending order.
+func FragmentedAssets(coins []*AnchoredCommitment,
+	targetCount uint32) []asset.ID {
+
+	numCoins := make(map[asset.ID]uint64)
+	for _, coin := range coins {
+		numCoins[coin.Asset.ID()]++
+	}
+
+	var assetIDs []asset.ID
+	for assetID, num := range numCoins {
+		if num > uint64(targetCount) {
+			assetIDs = append(assetIDs, assetID)
+		}
+	}
+	sort.Slice(assetIDs, func(i, j int) bool {
+		return bytes.Compare(assetIDs[i][:], assetIDs[j][:]) < 0
+	})
+
+	return assetIDs
+}
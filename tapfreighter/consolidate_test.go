@@ -0,0 +1,65 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConsolidationPolicyValidate tests that only sane consolidation policies
+// are accepted.
+func TestConsolidationPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	// The zero value disables automatic consolidation.
+	policy := &ConsolidationPolicy{}
+	require.NoError(t, policy.Validate())
+	require.False(t, policy.IsActive())
+
+	policy.Interval = -time.Hour
+	require.Error(t, policy.Validate())
+
+	policy.Interval = time.Hour
+	require.Error(t, policy.Validate())
+
+	policy.TargetUtxos = 3
+	require.NoError(t, policy.Validate())
+	require.True(t, policy.IsActive())
+}
+
+// TestFragmentedAssets tests that only the assets with more coins than the
+// target are returned for consolidation.
+func TestFragmentedAssets(t *testing.T) {
+	t.Parallel()
+
+	genesisA := asset.RandGenesis(t, asset.Normal)
+	genesisB := asset.RandGenesis(t, asset.Normal)
+	newCoins := func(genesis asset.Genesis, num int) []*AnchoredCommitment {
+		coins := make([]*AnchoredCommitment, num)
+		for idx := range coins {
+			coins[idx] = &AnchoredCommitment{
+				Asset: &asset.Asset{
+					Genesis: genesis,
+					Amount:  uint64(idx + 1),
+				},
+			}
+		}
+
+		return coins
+	}
+
+	coins := append(newCoins(genesisA, 3), newCoins(genesisB, 5)...)
+
+	require.Empty(t, FragmentedAssets(coins, 5))
+	require.Equal(
+		t, []asset.ID{genesisB.ID()}, FragmentedAssets(coins, 3),
+	)
+
+	// Multiple assets are returned in a stable order.
+	assetIDs := FragmentedAssets(coins, 2)
+	require.Len(t, assetIDs, 2)
+	require.Negative(t, bytes.Compare(assetIDs[0][:], assetIDs[1][:]))
+}
@@ -0,0 +1,104 @@
+package tapfreighter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// AnchorDustAllow is the anchor dust action that creates anchor outputs
+	// without checking whether they are economical to spend.
+	AnchorDustAllow = "allow"
+
+	// AnchorDustWarn is the anchor dust action that logs a warning when a
+	// transfer creates an anchor output that is uneconomical to spend.
+	AnchorDustWarn = "warn"
+
+	// AnchorDustRefuse is the anchor dust action that refuses transfers
+	// that create anchor outputs that are uneconomical to spend.
+	AnchorDustRefuse = "refuse"
+
+	// anchorSpendWeight is the weight a key spend of a taproot anchor
+	// output adds to the transaction that spends it.
+	anchorSpendWeight = input.InputSize*4 + input.TaprootKeyPathWitnessSize
+)
+
+// ErrUneconomicalAnchor is returned if a transfer is refused by the anchor dust
+// policy because one of its anchor outputs costs more to spend than it holds.
+var ErrUneconomicalAnchor = errors.New("anchor output is uneconomical to " +
+	"spend")
+
+// AnchorDustPolicy governs transfers that create anchor outputs whose BTC value
+// doesn't cover the fees for spending them at the fee rate of the transfer.
+// Such outputs can still be spent to move the assets they anchor, but only by
+// adding more BTC than they hold. The zero value logs a warning for every such
+// output.
+type AnchorDustPolicy struct {
+	// Action is what happens when a transfer creates an anchor output that
+	// is uneconomical to spend.
+	Action string `long:"action" description:"What to do when a transfer creates an anchor output whose BTC value is smaller than the fee for spending it at the fee rate of the transfer. 'allow' creates the output without checking, 'warn' logs a warning and 'refuse' fails the transfer." choice:"allow" choice:"warn" choice:"refuse"`
+}
+
+// Validate makes sure the anchor dust policy is sane.
+func (p *AnchorDustPolicy) Validate() error {
+	switch p.Action {
+	case "", AnchorDustAllow, AnchorDustWarn, AnchorDustRefuse:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown anchor dust action: %v", p.Action)
+	}
+}
+
+// check applies the policy to the anchor outputs the given virtual packets
+// create in the given funded anchor PSBT, using the fee rate the transaction
+// was funded with as the estimate for the fee rate they'll be spent at.
+func (p *AnchorDustPolicy) check(pkt *psbt.Packet, vPkts []*tappsbt.VPacket,
+	feeRate chainfee.SatPerKWeight) error {
+
+	if p.Action == AnchorDustAllow || feeRate == 0 {
+		return nil
+	}
+
+	spendFee := feeRate.FeeForWeight(anchorSpendWeight)
+	checked := make(map[uint32]struct{})
+	for _, vPkt := range vPkts {
+		for _, vOut := range vPkt.Outputs {
+			idx := vOut.AnchorOutputIndex
+			if _, ok := checked[idx]; ok {
+				continue
+			}
+			checked[idx] = struct{}{}
+
+			if int(idx) >= len(pkt.UnsignedTx.TxOut) {
+				return fmt.Errorf("anchor output index %d out "+
+					"of range", idx)
+			}
+
+			value := btcutil.Amount(pkt.UnsignedTx.TxOut[idx].Value)
+			if value >= spendFee {
+				continue
+			}
+
+			if p.Action == AnchorDustRefuse {
+				return fmt.Errorf("%w: output %d holds %v but "+
+					"spending it costs %v at %v",
+					ErrUneconomicalAnchor, idx, value,
+					spendFee, feeRate.FeePerKVByte())
+			}
+
+			log.Warnf("Anchor output %d holds %v but spending it "+
+				"costs %v at %v, moving its assets will "+
+				"require additional BTC", idx, value, spendFee,
+				feeRate.FeePerKVByte())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAnchorDustPolicy tests that the anchor dust policy allows, warns about or
+// refuses anchor outputs that are uneconomical to spend.
+func TestAnchorDustPolicy(t *testing.T) {
+	t.Parallel()
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(&wire.TxOut{Value: 1000})
+	tx.AddTxOut(&wire.TxOut{Value: 330})
+	pkt := &psbt.Packet{UnsignedTx: tx}
+
+	vPkts := []*tappsbt.VPacket{{
+		Outputs: []*tappsbt.VOutput{{
+			AnchorOutputIndex: 0,
+		}, {
+			AnchorOutputIndex: 1,
+		}},
+	}}
+
+	// Spending a key spend input at 10 sat/vB costs 575 sats, so only the
+	// second output is uneconomical.
+	lowFeeRate := chainfee.SatPerKVByte(10_000).FeePerKWeight()
+	highFeeRate := chainfee.SatPerKVByte(100_000).FeePerKWeight()
+
+	// The zero value only warns about uneconomical outputs.
+	policy := &AnchorDustPolicy{}
+	require.NoError(t, policy.Validate())
+	require.NoError(t, policy.check(pkt, vPkts, highFeeRate))
+
+	policy.Action = "ignore"
+	require.Error(t, policy.Validate())
+
+	policy.Action = AnchorDustRefuse
+	require.NoError(t, policy.Validate())
+	require.ErrorIs(
+		t, policy.check(pkt, vPkts, lowFeeRate), ErrUneconomicalAnchor,
+	)
+
+	vPkts[0].Outputs = vPkts[0].Outputs[:1]
+	require.NoError(t, policy.check(pkt, vPkts, lowFeeRate))
+	require.ErrorIs(
+		t, policy.check(pkt, vPkts, highFeeRate), ErrUneconomicalAnchor,
+	)
+
+	// Without a fee rate, nothing can be checked.
+	require.NoError(t, policy.check(pkt, vPkts, 0))
+
+	policy.Action = AnchorDustAllow
+	require.NoError(t, policy.check(pkt, vPkts, highFeeRate))
+}
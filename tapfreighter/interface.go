@@ -3,6 +3,7 @@ package tapfreighter
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -19,6 +20,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // CommitmentConstraints conveys the constraints on the type of Taproot asset
@@ -39,6 +41,12 @@ type CommitmentConstraints struct {
 	// MinAmt is the minimum amount that an asset commitment needs to hold
 	// to satisfy the constraints.
 	MinAmt uint64
+
+	// PrevIDs is the optional list of asset inputs that must be selected.
+	// An input without an asset ID and script key selects all matching
+	// assets of its anchor outpoint. If set, only these inputs are
+	// selected and they must cumulatively hold at least MinAmt.
+	PrevIDs []asset.PrevID
 }
 
 // AnchoredCommitment is the response to satisfying the set of
@@ -77,6 +85,11 @@ var (
 	ErrMatchingAssetsNotFound = fmt.Errorf("failed to find coin(s) that " +
 		"satisfy given constraints; if previous transfers are un-" +
 		"confirmed, wait for them to confirm before trying again")
+
+	// ErrInputNotEligible is returned when an explicitly selected input
+	// doesn't match any asset coin that is eligible for spending, for
+	// example because it is unknown, already spent or leased.
+	ErrInputNotEligible = fmt.Errorf("input is not an eligible asset coin")
 )
 
 // CoinLister attracts over the coin selection process needed to be
@@ -85,6 +98,8 @@ type CoinLister interface {
 	// ListEligibleCoins takes the set of commitment constraints and returns
 	// an AnchoredCommitment that returns all the information needed to use
 	// the commitment as an input to an on chain Taproot Asset transaction.
+	// Assets locked to watch only script keys are never eligible, as the
+	// wallet can't sign for them.
 	//
 	// If coin selection cannot be completed, then ErrMatchingAssetsNotFound
 	// should be returned.
@@ -106,16 +121,77 @@ type CoinLister interface {
 }
 
 // MultiCommitmentSelectStrategy is an enum that describes the strategy that
-// should be used when preferentially selecting multiple commitments.
+// should be used when preferentially selecting multiple commitments. The same
+// strategy is used to select the BTC level inputs that fund the anchor
+// transaction of a transfer.
 type MultiCommitmentSelectStrategy uint8
 
 const (
+	// DefaultSelectStrategy is a placeholder for the strategy the wallet
+	// is configured with.
+	DefaultSelectStrategy MultiCommitmentSelectStrategy = iota
+
 	// PreferMaxAmount is a strategy which considers commitments in order of
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
-	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+	PreferMaxAmount
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount. This consolidates small
+	// commitments at the cost of more inputs.
+	PreferMinAmount
+
+	// PreferFewestInputs is a strategy which selects the smallest single
+	// commitment that covers the minimum target amount, which avoids
+	// creating a large change output. If no single commitment is large
+	// enough, it falls back to PreferMaxAmount.
+	PreferFewestInputs
+
+	// PreferNoMerge is a privacy preferring strategy which never merges
+	// multiple commitments, as spending them together links them to the
+	// same owner. It selects the smallest single commitment that covers the
+	// minimum target amount and fails if there is none.
+	PreferNoMerge
 )
 
+// String returns the name of the strategy as used in the configuration.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case DefaultSelectStrategy:
+		return "default"
+
+	case PreferMaxAmount:
+		return "largest-first"
+
+	case PreferMinAmount:
+		return "smallest-first"
+
+	case PreferFewestInputs:
+		return "fewest-inputs"
+
+	case PreferNoMerge:
+		return "no-merge"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", uint8(s))
+	}
+}
+
+// ParseCoinSelectStrategy parses the name of a coin selection strategy as
+// returned by MultiCommitmentSelectStrategy.String.
+func ParseCoinSelectStrategy(name string) (MultiCommitmentSelectStrategy,
+	error) {
+
+	for s := DefaultSelectStrategy; s <= PreferNoMerge; s++ {
+		if s.String() == name {
+			return s, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown coin selection strategy: %v", name)
+}
+
 // CoinSelector is an interface that describes the functionality used in
 // selecting coins during the asset send process.
 type CoinSelector interface {
@@ -126,6 +202,20 @@ type CoinSelector interface {
 		strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 		error)
 
+	// SelectConsolidationCoins returns the smallest not yet leased coins of
+	// the given asset ID that need to be merged so that at most the given
+	// number of coins of the asset remain. The coins returned are leased
+	// for the default lease duration.
+	SelectConsolidationCoins(ctx context.Context, assetID asset.ID,
+		targetCount uint32) ([]*AnchoredCommitment, error)
+
+	// SelectSweepCoins returns the not yet leased coins of the given asset
+	// ID, or of all assets if no asset ID is given, that can be swept in a
+	// single anchor transaction. The coins returned are leased for the
+	// default lease duration.
+	SelectSweepCoins(ctx context.Context, assetID *asset.ID) (*SweepPlan,
+		error)
+
 	// ReleaseCoins releases/unlocks coins that were previously leased and
 	// makes them available for coin selection again.
 	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
@@ -212,6 +302,16 @@ type TransferOutput struct {
 	// ProofCourierAddr is the bytes encoded proof courier service address
 	// associated with this output.
 	ProofCourierAddr []byte
+
+	// FallbackProofCourierAddrs is the ordered list of proof courier
+	// addresses that are tried if the proof can't be delivered through the
+	// courier at ProofCourierAddr.
+	FallbackProofCourierAddrs []url.URL
+
+	// RecipientAddr is the bech32 encoded Taproot Asset address this output
+	// pays to. It is empty if the output wasn't created for a send to an
+	// address, for example for change or interactive transfers.
+	RecipientAddr string
 }
 
 // OutboundParcel represents the database level delta of an outbound Taproot
@@ -236,6 +336,19 @@ type OutboundParcel struct {
 	// anchor transaction.
 	ChainFees int64
 
+	// AnchorPsbt is the funded but unsigned PSBT of the anchor transaction,
+	// with all the input information required to sign it again. This is
+	// used to replace the anchor transaction with one that pays a higher
+	// fee. It may be nil for transfers that were logged before the PSBT
+	// was stored.
+	AnchorPsbt *psbt.Packet
+
+	// CancelTx is the transaction that double spends the anchor
+	// transaction to cancel the transfer. It is nil unless the transfer is
+	// being cancelled. Once either of the two transactions confirms, the
+	// transfer is either completed or removed.
+	CancelTx *wire.MsgTx
+
 	// PassiveAssets is the set of passive assets that are re-anchored
 	// during the parcel confirmation process.
 	PassiveAssets []*PassiveAssetReAnchor
@@ -247,6 +360,48 @@ type OutboundParcel struct {
 	// Outputs represents the list of new assets that were created with this
 	// transfer.
 	Outputs []TransferOutput
+
+	// Label is the optional, user-defined label of the transfer.
+	Label string
+
+	// Burns is the list of assets that are burned with this transfer.
+	Burns []*AssetBurn
+}
+
+// AssetBurn describes an amount of an asset that was provably burned by
+// sending it to a burn script key.
+type AssetBurn struct {
+	// Note is the optional, user-defined reason or memo of the burn.
+	Note string
+
+	// AssetID is the ID of the burned asset.
+	AssetID asset.ID
+
+	// GroupKey is the tweaked group key of the burned asset, if it is part
+	// of an asset group.
+	GroupKey *btcec.PublicKey
+
+	// Amount is the amount of the asset that was burned.
+	Amount uint64
+
+	// ScriptKey is the burn script key of the burn output. Together with
+	// the asset ID it identifies the proof of the burn.
+	ScriptKey *btcec.PublicKey
+
+	// AnchorTXID is the hash of the transaction that anchors the burn.
+	AnchorTXID chainhash.Hash
+
+	// TransferTime is the time the burn was logged.
+	TransferTime time.Time
+
+	// BlockHash is the hash of the block that confirmed the anchor
+	// transaction of the burn. It is nil as long as the burn is
+	// unconfirmed.
+	BlockHash *chainhash.Hash
+
+	// BlockHeight is the height of the block that confirmed the anchor
+	// transaction of the burn.
+	BlockHeight uint32
 }
 
 // AssetConfirmEvent is used to mark a batched spend as confirmed on disk.
@@ -325,6 +480,34 @@ type ExportLog interface {
 	// updates the on-chain reference information on disk to point to this
 	// new spend.
 	ConfirmParcelDelivery(context.Context, *AssetConfirmEvent) error
+
+	// ReplacePendingParcelAnchorTx replaces the anchor transaction of the
+	// pending parcel anchored by the transaction with the given hash. The
+	// given parcel must spend the same inputs and create the same outputs,
+	// with the anchor outpoints and proof suffixes updated to the new
+	// anchor transaction. The re-anchor proofs of any passive assets are
+	// updated by the log itself.
+	ReplacePendingParcelAnchorTx(ctx context.Context,
+		prevAnchorTXID chainhash.Hash, parcel *OutboundParcel) error
+
+	// UpdatePendingParcelCourier replaces the proof courier address of all
+	// outputs of the pending parcel with the given anchor transaction hash
+	// that don't go to our own node.
+	UpdatePendingParcelCourier(ctx context.Context,
+		anchorTXID chainhash.Hash, courierAddr []byte) error
+
+	// MarkPendingParcelCancelling stores the transaction that double
+	// spends the anchor transaction of the pending parcel with the given
+	// anchor transaction hash. The parcel is kept until either of the two
+	// transactions confirms.
+	MarkPendingParcelCancelling(ctx context.Context,
+		anchorTXID chainhash.Hash, cancelTx *wire.MsgTx) error
+
+	// CancelPendingParcel removes the pending parcel with the given anchor
+	// transaction hash, after its cancel transaction confirmed. The leases
+	// on the input UTXOs of the parcel are released.
+	CancelPendingParcel(ctx context.Context,
+		anchorTXID chainhash.Hash) error
 }
 
 // ChainBridge aliases into the ChainBridge of the tapgarden package.
@@ -334,9 +517,22 @@ type ChainBridge = tapgarden.ChainBridge
 type WalletAnchor interface {
 	tapgarden.WalletAnchor
 
+	// FundPsbtWithStrategy attaches enough inputs to the target PSBT
+	// packet for it to be valid, selecting them with the given strategy.
+	FundPsbtWithStrategy(ctx context.Context, packet *psbt.Packet,
+		minConfs uint32, feeRate chainfee.SatPerKWeight,
+		strategy MultiCommitmentSelectStrategy) (tapgarden.FundedPsbt,
+		error)
+
 	// SignPsbt signs all the inputs it can in the passed-in PSBT packet,
 	// returning a new one with updated signature/witness data.
 	SignPsbt(ctx context.Context, packet *psbt.Packet) (*psbt.Packet, error)
+
+	// BumpOutputFee spends the given wallet output of an unconfirmed
+	// transaction in a child transaction that pays the given fee rate, to
+	// accelerate the confirmation of the parent (CPFP).
+	BumpOutputFee(ctx context.Context, op wire.OutPoint,
+		feeRate chainfee.SatPerKWeight) error
 }
 
 // KeyRing aliases into the KeyRing of the tapgarden package.
@@ -353,6 +549,40 @@ type Porter interface {
 	// returned with the pending transfer information.
 	RequestShipment(req Parcel) (*OutboundParcel, error)
 
+	// BumpFee replaces the anchor transaction of the pending transfer with
+	// the given anchor transaction hash with one that pays the given,
+	// higher fee rate. The replacement is broadcast and delivery of the
+	// transfer continues with it.
+	BumpFee(ctx context.Context, anchorTXID chainhash.Hash,
+		feeRate chainfee.SatPerKWeight) (*OutboundParcel, error)
+
+	// BumpFeeCpfp accelerates the confirmation of the anchor transaction
+	// of the pending transfer with the given anchor transaction hash by
+	// spending its BTC change output in a child transaction that pays the
+	// given fee rate. The outpoint of the spent change output is returned.
+	BumpFeeCpfp(ctx context.Context, anchorTXID chainhash.Hash,
+		feeRate chainfee.SatPerKWeight) (*wire.OutPoint, error)
+
+	// CancelTransfer cancels the pending transfer with the given anchor
+	// transaction hash by double spending the BTC inputs of its anchor
+	// transaction back to the wallet at the given fee rate. The cancel
+	// transaction is returned. The asset inputs of the transfer can only
+	// be spent again once the cancel transaction confirms.
+	CancelTransfer(ctx context.Context, anchorTXID chainhash.Hash,
+		feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error)
+
+	// ResumeDelivery resumes the delivery of the receiver proofs of the
+	// transfer with the given anchor transaction hash, after it failed. If
+	// a courier address is given, the proofs are delivered through it
+	// instead of the couriers of the receivers' addresses.
+	ResumeDelivery(ctx context.Context, anchorTXID chainhash.Hash,
+		courierAddr *url.URL) error
+
+	// AnchorSharingStats returns the savings of re-anchoring passive
+	// assets into shared anchor outputs, summed up over all transfers
+	// that were broadcast since the porter was started.
+	AnchorSharingStats() AnchorSharingStats
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -362,5 +592,5 @@ type Porter interface {
 
 	// EventPublisher is a subscription interface that allows callers to
 	// subscribe to events that are relevant to the Porter.
-	fn.EventPublisher[fn.Event, bool]
+	fn.EventPublisher[fn.Event, SendEventReplay]
 }
@@ -3,10 +3,12 @@ package tapfreighter
 import (
 	"bytes"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -130,6 +132,25 @@ type AddressParcel struct {
 	// destAddrs is the list of address that should be used to satisfy the
 	// transfer.
 	destAddrs []*address.Tap
+
+	// prevIDs is the optional list of asset inputs that must be spent by
+	// the transfer. If empty, the inputs are selected automatically.
+	prevIDs []asset.PrevID
+
+	// strategy is the coin selection strategy used to select the asset
+	// inputs, if they aren't given, and the BTC level inputs.
+	strategy MultiCommitmentSelectStrategy
+
+	// label is the optional, user-defined label of the transfer.
+	label string
+
+	// maxChainFees is the maximum amount of sats the anchor transaction
+	// may pay in chain fees. Zero means there is no limit.
+	maxChainFees btcutil.Amount
+
+	// anchorLockTime is the optional lock time and sequence of the anchor
+	// transaction.
+	anchorLockTime AnchorLockTime
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -138,12 +159,35 @@ var _ Parcel = (*AddressParcel)(nil)
 
 // NewAddressParcel creates a new AddressParcel.
 func NewAddressParcel(destAddrs ...*address.Tap) *AddressParcel {
+	return NewAddressParcelWithInputs(
+		nil, DefaultSelectStrategy, "", 0, AnchorLockTime{},
+		destAddrs...,
+	)
+}
+
+// NewAddressParcelWithInputs creates a new AddressParcel that spends exactly
+// the given asset inputs instead of selecting them automatically. If no inputs
+// are given, they are selected with the given strategy, which is also used to
+// select the BTC level inputs of the transfer. The optional label is stored
+// with the transfer. If maxChainFees is non-zero, the transfer fails instead
+// of being broadcast if its anchor transaction would pay more in chain fees.
+// The anchor transaction is created with the given lock time and sequence.
+func NewAddressParcelWithInputs(prevIDs []asset.PrevID,
+	strategy MultiCommitmentSelectStrategy, label string,
+	maxChainFees btcutil.Amount, anchorLockTime AnchorLockTime,
+	destAddrs ...*address.Tap) *AddressParcel {
+
 	return &AddressParcel{
 		parcelKit: &parcelKit{
 			respChan: make(chan *OutboundParcel, 1),
 			errChan:  make(chan error, 1),
 		},
-		destAddrs: destAddrs,
+		destAddrs:      destAddrs,
+		prevIDs:        prevIDs,
+		strategy:       strategy,
+		label:          label,
+		maxChainFees:   maxChainFees,
+		anchorLockTime: anchorLockTime,
 	}
 }
 
@@ -154,7 +198,11 @@ func (p *AddressParcel) pkg() *sendPackage {
 
 	// Initialize a package with the destination address.
 	return &sendPackage{
-		Parcel: p,
+		Parcel:             p,
+		CoinSelectStrategy: p.strategy,
+		Label:              p.label,
+		MaxChainFees:       p.maxChainFees,
+		AnchorLockTime:     p.anchorLockTime,
 	}
 }
 
@@ -171,6 +219,11 @@ func (p *AddressParcel) Validate() error {
 			"specified in address parcel")
 	}
 
+	// The proofs of the outputs are stored by their script key, so every
+	// address must be for a distinct script key. Sending to the same
+	// address twice in one transfer would otherwise overwrite one of the
+	// proofs.
+	scriptKeys := make(map[asset.SerializedKey]int, len(p.destAddrs))
 	for idx := range p.destAddrs {
 		tapAddr := p.destAddrs[idx]
 
@@ -180,11 +233,39 @@ func (p *AddressParcel) Validate() error {
 			return fmt.Errorf("invalid proof courier address: %w",
 				err)
 		}
+
+		scriptKey := asset.ToSerialized(&tapAddr.ScriptKey)
+		if prevIdx, ok := scriptKeys[scriptKey]; ok {
+			return fmt.Errorf("address %d has the same script key "+
+				"as address %d", idx, prevIdx)
+		}
+		scriptKeys[scriptKey] = idx
 	}
 
 	return nil
 }
 
+// groupAddrsByAssetID groups the given addresses by their asset ID. The groups
+// are returned in the order the asset IDs first appear in the list.
+func groupAddrsByAssetID(addrs []*address.Tap) [][]*address.Tap {
+	var (
+		groups   [][]*address.Tap
+		groupIdx = make(map[asset.ID]int)
+	)
+	for _, addr := range addrs {
+		idx, ok := groupIdx[addr.AssetID]
+		if !ok {
+			idx = len(groups)
+			groupIdx[addr.AssetID] = idx
+			groups = append(groups, nil)
+		}
+
+		groups[idx] = append(groups[idx], addr)
+	}
+
+	return groups
+}
+
 // PendingParcel is a parcel that has not yet completed delivery.
 type PendingParcel struct {
 	*parcelKit
@@ -226,17 +307,26 @@ func (p *PendingParcel) Validate() error {
 }
 
 // PreSignedParcel is a request to issue an asset transfer of a pre-signed
-// parcel. This packages a virtual transaction, the input commitment, and also
-// the response context.
+// parcel. This packages one or more virtual transactions, the input
+// commitments, and also the response context.
 type PreSignedParcel struct {
 	*parcelKit
 
-	// vPkt is the virtual transaction that should be delivered.
-	vPkt *tappsbt.VPacket
+	// vPkts are the virtual transactions that should be delivered in a
+	// single anchor transaction.
+	vPkts []*tappsbt.VPacket
 
-	// inputCommitments are the commitments for the input that are being
-	// spent in the virtual transaction.
-	inputCommitments tappsbt.InputCommitments
+	// inputCommitments are the commitments for the inputs that are being
+	// spent in each of the virtual transactions.
+	inputCommitments []tappsbt.InputCommitments
+
+	// strategy is the coin selection strategy used to select the BTC level
+	// inputs of the transfer.
+	strategy MultiCommitmentSelectStrategy
+
+	// burnNote is the optional, user-defined reason or memo of the assets
+	// burned by the transfer.
+	burnNote string
 }
 
 // A compile-time assertion to ensure PreSignedParcel implements the parcel
@@ -245,30 +335,61 @@ var _ Parcel = (*PreSignedParcel)(nil)
 
 // NewPreSignedParcel creates a new PreSignedParcel.
 func NewPreSignedParcel(vPkt *tappsbt.VPacket,
-	inputCommitments tappsbt.InputCommitments) *PreSignedParcel {
+	inputCommitments tappsbt.InputCommitments,
+	strategy MultiCommitmentSelectStrategy) *PreSignedParcel {
+
+	return NewMultiPreSignedParcel(
+		[]*tappsbt.VPacket{vPkt},
+		[]tappsbt.InputCommitments{inputCommitments}, strategy,
+	)
+}
+
+// NewBurnParcel creates a new PreSignedParcel for a virtual transaction that
+// burns assets. The given note is recorded along with each burn.
+func NewBurnParcel(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments,
+	note string) *PreSignedParcel {
+
+	parcel := NewPreSignedParcel(
+		vPkt, inputCommitments, DefaultSelectStrategy,
+	)
+	parcel.burnNote = note
+
+	return parcel
+}
+
+// NewMultiPreSignedParcel creates a new PreSignedParcel that anchors all given
+// virtual transactions in a single anchor transaction. The input commitments
+// must be given in the same order as the virtual transactions.
+func NewMultiPreSignedParcel(vPkts []*tappsbt.VPacket,
+	inputCommitments []tappsbt.InputCommitments,
+	strategy MultiCommitmentSelectStrategy) *PreSignedParcel {
 
 	return &PreSignedParcel{
 		parcelKit: &parcelKit{
 			respChan: make(chan *OutboundParcel, 1),
 			errChan:  make(chan error, 1),
 		},
-		vPkt:             vPkt,
+		vPkts:            vPkts,
 		inputCommitments: inputCommitments,
+		strategy:         strategy,
 	}
 }
 
 // pkg returns the send package that should be delivered.
 func (p *PreSignedParcel) pkg() *sendPackage {
-	log.Infof("New signed delivery request with %d outputs",
-		len(p.vPkt.Outputs))
+	log.Infof("New signed delivery request with %d virtual transactions",
+		len(p.vPkts))
 
-	// Initialize a package the signed virtual transaction and input
-	// commitment.
+	// Initialize a package the signed virtual transactions and input
+	// commitments.
 	return &sendPackage{
-		Parcel:           p,
-		SendState:        SendStateAnchorSign,
-		VirtualPacket:    p.vPkt,
-		InputCommitments: p.inputCommitments,
+		Parcel:             p,
+		SendState:          SendStateAnchorSign,
+		VirtualPackets:     p.vPkts,
+		CoinSelectStrategy: p.strategy,
+		InputCommitments:   p.inputCommitments,
+		BurnNote:           p.burnNote,
 	}
 }
 
@@ -279,30 +400,171 @@ func (p *PreSignedParcel) kit() *parcelKit {
 
 // Validate validates the parcel.
 func (p *PreSignedParcel) Validate() error {
+	if len(p.vPkts) == 0 {
+		return fmt.Errorf("no virtual transactions to deliver")
+	}
+
+	if len(p.inputCommitments) != len(p.vPkts) {
+		return fmt.Errorf("expected input commitments for %d virtual "+
+			"transactions, got %d", len(p.vPkts),
+			len(p.inputCommitments))
+	}
+
 	// TODO(ffranr): Add validation where appropriate.
 	return nil
 }
 
+// PreAnchoredParcel is a request to log and broadcast an asset transfer whose
+// virtual transaction and BTC level anchor transaction are both already
+// signed, for example the transfer of an asset swap.
+type PreAnchoredParcel struct {
+	*parcelKit
+
+	// vPkt is the signed virtual transaction that should be delivered.
+	vPkt *tappsbt.VPacket
+
+	// passivePkts are the signed virtual transactions that re-anchor the
+	// passive assets of the inputs.
+	passivePkts []*tappsbt.VPacket
+
+	// inputCommitments are the commitments for the input that are being
+	// spent in the virtual transaction.
+	inputCommitments tappsbt.InputCommitments
+
+	// anchorTx is the signed anchor transaction.
+	anchorTx *AnchorTransaction
+
+	// outputIdxToAddr maps the outputs of the virtual transaction to the
+	// Tap addresses they pay to, if any.
+	outputIdxToAddr tappsbt.OutputIdxToAddr
+
+	// label is the optional, user-defined label of the transfer.
+	label string
+}
+
+// A compile-time assertion to ensure PreAnchoredParcel implements the parcel
+// interface.
+var _ Parcel = (*PreAnchoredParcel)(nil)
+
+// NewPreAnchoredParcel creates a new PreAnchoredParcel.
+func NewPreAnchoredParcel(vPkt *tappsbt.VPacket,
+	passivePkts []*tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments,
+	anchorTx *AnchorTransaction, outputIdxToAddr tappsbt.OutputIdxToAddr,
+	label string) *PreAnchoredParcel {
+
+	return &PreAnchoredParcel{
+		parcelKit: &parcelKit{
+			respChan: make(chan *OutboundParcel, 1),
+			errChan:  make(chan error, 1),
+		},
+		vPkt:             vPkt,
+		passivePkts:      passivePkts,
+		inputCommitments: inputCommitments,
+		anchorTx:         anchorTx,
+		outputIdxToAddr:  outputIdxToAddr,
+		label:            label,
+	}
+}
+
+// pkg returns the send package that should be delivered.
+func (p *PreAnchoredParcel) pkg() *sendPackage {
+	log.Infof("New pre-anchored delivery request with %d outputs",
+		len(p.vPkt.Outputs))
+
+	passiveAssets := make([]*PassiveAssetReAnchor, len(p.passivePkts))
+	for idx, passivePkt := range p.passivePkts {
+		passiveIn := passivePkt.Inputs[0]
+		passiveAssets[idx] = &PassiveAssetReAnchor{
+			VPacket:         passivePkt,
+			GenesisID:       passiveIn.PrevID.ID,
+			PrevAnchorPoint: passiveIn.PrevID.OutPoint,
+			ScriptKey:       passiveIn.Asset().ScriptKey,
+			AssetVersion:    passiveIn.Asset().Version,
+		}
+	}
+
+	// Both transactions are signed already, so the package goes straight
+	// to being logged and broadcast.
+	return &sendPackage{
+		Parcel:         p,
+		SendState:      SendStateLogCommit,
+		VirtualPackets: []*tappsbt.VPacket{p.vPkt},
+		InputCommitments: []tappsbt.InputCommitments{
+			p.inputCommitments,
+		},
+		OutputIdxToAddr: p.outputIdxToAddr,
+		PassiveAssets:   passiveAssets,
+		AnchorTx:        p.anchorTx,
+		Label:           p.label,
+	}
+}
+
+// kit returns the parcel kit used for delivery.
+func (p *PreAnchoredParcel) kit() *parcelKit {
+	return p.parcelKit
+}
+
+// Validate validates the parcel.
+func (p *PreAnchoredParcel) Validate() error {
+	if p.anchorTx == nil || p.anchorTx.FinalTx == nil {
+		return fmt.Errorf("signed anchor transaction must be " +
+			"specified in pre-anchored parcel")
+	}
+
+	for idx, passivePkt := range p.passivePkts {
+		if len(passivePkt.Inputs) != 1 || len(passivePkt.Outputs) != 1 {
+			return fmt.Errorf("passive asset packet %d must have "+
+				"exactly one input and output", idx)
+		}
+	}
+
+	return nil
+}
+
 // sendPackage houses the information we need to complete a package transfer.
 type sendPackage struct {
 	// SendState is the current send state of this parcel.
 	SendState SendState
 
-	// VirtualPacket is the virtual packet that we'll use to construct the
-	// virtual asset transition transaction.
-	VirtualPacket *tappsbt.VPacket
+	// VirtualPackets are the virtual packets that we'll use to construct
+	// the virtual asset transition transactions, one for each asset ID
+	// that is transferred. All of them are anchored in the same BTC level
+	// transaction.
+	VirtualPackets []*tappsbt.VPacket
 
-	// OutputIdxToAddr is a map from a VPacket's VOutput index to its
-	// associated Tap address.
+	// OutputIdxToAddr is a map from a VOutput index to its associated Tap
+	// address. The index counts the outputs of all virtual packets in
+	// order.
 	OutputIdxToAddr tappsbt.OutputIdxToAddr
 
-	// InputCommitments is a map from virtual package input index to its
-	// associated Taproot Asset commitment.
-	InputCommitments tappsbt.InputCommitments
+	// InputCommitments holds the input commitments of each virtual packet,
+	// in the same order as VirtualPackets. Each entry is a map from virtual
+	// package input index to its associated Taproot Asset commitment.
+	InputCommitments []tappsbt.InputCommitments
+
+	// CoinSelectStrategy is the strategy used to select the asset and BTC
+	// level inputs of the transfer.
+	CoinSelectStrategy MultiCommitmentSelectStrategy
 
 	// PassiveAssets is the data used in re-anchoring passive assets.
 	PassiveAssets []*PassiveAssetReAnchor
 
+	// Label is the optional, user-defined label of the transfer.
+	Label string
+
+	// BurnNote is the optional, user-defined reason or memo of the assets
+	// burned by the transfer.
+	BurnNote string
+
+	// MaxChainFees is the maximum amount of sats the anchor transaction may
+	// pay in chain fees. Zero means there is no limit.
+	MaxChainFees btcutil.Amount
+
+	// AnchorLockTime is the optional lock time and sequence of the anchor
+	// transaction.
+	AnchorLockTime AnchorLockTime
+
 	// Parcel is the asset transfer request that kicked off this transfer.
 	Parcel Parcel
 
@@ -322,6 +584,32 @@ type sendPackage struct {
 	// TransferTxConfEvent contains transfer transaction on-chain
 	// confirmation data.
 	TransferTxConfEvent *chainntnfs.TxConfirmation
+
+	// transferSeq is the sequence number the chain porter assigned to the
+	// transfer, to tell apart the send events of different transfers.
+	transferSeq uint64
+
+	// proofDeliveryAttempt is the number of times the delivery of the
+	// receiver proofs was attempted.
+	proofDeliveryAttempt uint32
+}
+
+// anchorTXID returns the hash of the anchor transaction of the package, or nil
+// if the anchor transaction wasn't created yet.
+func (s *sendPackage) anchorTXID() *chainhash.Hash {
+	var txid chainhash.Hash
+	switch {
+	case s.OutboundPkg != nil:
+		txid = s.OutboundPkg.AnchorTx.TxHash()
+
+	case s.AnchorTx != nil && s.AnchorTx.FinalTx != nil:
+		txid = s.AnchorTx.FinalTx.TxHash()
+
+	default:
+		return nil
+	}
+
+	return &txid
 }
 
 // prepareForStorage prepares the send package for storing to the database.
@@ -344,7 +632,23 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		passiveAsset.NewWitnessData = signedAsset.PrevWitnesses
 	}
 
-	vPkt := s.VirtualPacket
+	// The inputs and outputs of all virtual packets are stored as a single
+	// flat list.
+	var (
+		vInputs  []*tappsbt.VInput
+		vOutputs []*tappsbt.VOutput
+		vOutPkts []*tappsbt.VPacket
+		vOutIdxs []int
+	)
+	for _, vPkt := range s.VirtualPackets {
+		vInputs = append(vInputs, vPkt.Inputs...)
+		vOutputs = append(vOutputs, vPkt.Outputs...)
+		for outIdx := range vPkt.Outputs {
+			vOutPkts = append(vOutPkts, vPkt)
+			vOutIdxs = append(vOutIdxs, outIdx)
+		}
+	}
+
 	anchorTXID := s.AnchorTx.FinalTx.TxHash()
 	parcel := &OutboundParcel{
 		AnchorTx:           s.AnchorTx.FinalTx,
@@ -352,13 +656,15 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		// TODO(bhandras): use clock.Clock instead.
 		TransferTime:  time.Now(),
 		ChainFees:     s.AnchorTx.ChainFees,
-		Inputs:        make([]TransferInput, len(vPkt.Inputs)),
-		Outputs:       make([]TransferOutput, len(vPkt.Outputs)),
+		AnchorPsbt:    s.AnchorTx.FundedPsbt.Pkt,
+		Inputs:        make([]TransferInput, len(vInputs)),
+		Outputs:       make([]TransferOutput, len(vOutputs)),
 		PassiveAssets: s.PassiveAssets,
+		Label:         s.Label,
 	}
 
-	for idx := range vPkt.Inputs {
-		vIn := vPkt.Inputs[idx]
+	for idx := range vInputs {
+		vIn := vInputs[idx]
 
 		// We don't know the actual outpoint the input is spending, so
 		// we need to look it up by the pkScript in the anchor TX.
@@ -394,17 +700,29 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 	}
 
 	outputCommitments := s.AnchorTx.OutputCommitments
-	for idx := range vPkt.Outputs {
-		vOut := vPkt.Outputs[idx]
+	for idx := range vOutputs {
+		vOut := vOutputs[idx]
 
 		// Convert any proof courier address associated with this output
 		// to bytes for db storage.
-		var proofCourierAddrBytes []byte
+		var (
+			proofCourierAddrBytes []byte
+			fallbackCourierAddrs  []url.URL
+			recipientAddr         string
+		)
 		if s.OutputIdxToAddr != nil {
 			if addr, ok := s.OutputIdxToAddr[idx]; ok {
 				proofCourierAddrBytes = []byte(
 					addr.ProofCourierAddr.String(),
 				)
+				fallbackCourierAddrs = addr.FallbackCourierAddrs
+
+				var err error
+				recipientAddr, err = addr.EncodeAddress()
+				if err != nil {
+					return nil, fmt.Errorf("unable to "+
+						"encode address: %w", err)
+				}
 			}
 		}
 
@@ -440,9 +758,16 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		)
 
 		// If there are passive assets, they are always committed to the
-		// output that is marked as the split root.
+		// output that is marked as the split root of the virtual packet
+		// that spends their anchor input.
 		if vOut.Type.CanCarryPassive() {
-			numPassiveAssets = uint32(len(s.PassiveAssets))
+			for _, passiveAsset := range s.PassiveAssets {
+				passiveOut := passiveAsset.VPacket.Outputs[0]
+				anchorIdx := passiveOut.AnchorOutputIndex
+				if anchorIdx == vOut.AnchorOutputIndex {
+					numPassiveAssets++
+				}
+			}
 		}
 
 		// Either we have an asset that we commit to or we have an
@@ -458,7 +783,9 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		// In any other case we expect an active asset transfer to be
 		// committed to.
 		case vOut.Asset != nil:
-			proofSuffix, err := s.createProofSuffix(idx)
+			proofSuffix, err := s.createProofSuffix(
+				vOutPkts[idx], vOutIdxs[idx],
+			)
 			if err != nil {
 				return nil, fmt.Errorf("unable to create "+
 					"proof %d: %w", idx, err)
@@ -499,6 +826,27 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 			SplitCommitmentRoot: splitCommitmentRoot,
 			ProofSuffix:         proofSuffixBuf.Bytes(),
 			ProofCourierAddr:    proofCourierAddrBytes,
+
+			FallbackProofCourierAddrs: fallbackCourierAddrs,
+			RecipientAddr:             recipientAddr,
+		}
+
+		// Outputs that send to a burn script key are additionally
+		// recorded as burns.
+		if vOut.Asset != nil && vOut.Asset.IsBurn() {
+			burn := &AssetBurn{
+				Note:         s.BurnNote,
+				AssetID:      vOut.Asset.ID(),
+				Amount:       vOut.Asset.Amount,
+				ScriptKey:    vOut.Asset.ScriptKey.PubKey,
+				AnchorTXID:   anchorTXID,
+				TransferTime: parcel.TransferTime,
+			}
+			if vOut.Asset.GroupKey != nil {
+				burn.GroupKey = &vOut.Asset.GroupKey.GroupPubKey
+			}
+
+			parcel.Burns = append(parcel.Burns, burn)
 		}
 	}
 
@@ -509,30 +857,23 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 // final state transition that will be added to the proofs of the receiver. The
 // proof returned will have all the Taproot Asset level proof information, but
 // contains dummy data for the on-chain part.
-func (s *sendPackage) createProofSuffix(outIndex int) (*proof.Proof, error) {
-	inputPrevID := s.VirtualPacket.Inputs[0].PrevID
+func (s *sendPackage) createProofSuffix(vPkt *tappsbt.VPacket,
+	outIndex int) (*proof.Proof, error) {
+
+	inputPrevID := vPkt.Inputs[0].PrevID
 
-	params, err := proofParams(s.AnchorTx, s.VirtualPacket, outIndex)
+	params, err := proofParams(
+		s.AnchorTx, vPkt, outIndex, s.allOutputs(),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	// We also need to account for any P2TR change outputs.
 	if len(s.AnchorTx.FundedPsbt.Pkt.UnsignedTx.TxOut) > 1 {
-		isAnchor := func(idx uint32) bool {
-			for outIdx := range s.VirtualPacket.Outputs {
-				vOut := s.VirtualPacket.Outputs[outIdx]
-				if vOut.AnchorOutputIndex == idx {
-					return true
-				}
-			}
-
-			return false
-		}
-
 		err := proof.AddExclusionProofs(
 			&params.BaseProofParams, s.AnchorTx.FundedPsbt.Pkt,
-			isAnchor,
+			s.isAnchor,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error adding exclusion "+
@@ -574,10 +915,49 @@ func newParams(anchorTx *AnchorTransaction, a *asset.Asset, outputIndex int,
 	}
 }
 
+// UpdateProofAnchorTx updates the given transition proof to be anchored in the
+// given transaction. Like the proof suffixes created when a transfer is first
+// anchored, the updated proof references a dummy block that only contains the
+// anchor transaction, which is replaced once the transaction confirms.
+func UpdateProofAnchorTx(p *proof.Proof, anchorTx *wire.MsgTx) error {
+	return p.UpdateTransitionProof(&proof.BaseProofParams{
+		Block: &wire.MsgBlock{
+			Transactions: []*wire.MsgTx{anchorTx},
+		},
+		Tx:      anchorTx,
+		TxIndex: 0,
+	})
+}
+
+// allOutputs returns the outputs of all virtual packets of the send package.
+func (s *sendPackage) allOutputs() []*tappsbt.VOutput {
+	var outputs []*tappsbt.VOutput
+	for _, vPkt := range s.VirtualPackets {
+		outputs = append(outputs, vPkt.Outputs...)
+	}
+
+	return outputs
+}
+
+// isAnchor returns true if the given anchor output index carries the outputs
+// of any of the virtual packets of the send package.
+func (s *sendPackage) isAnchor(idx uint32) bool {
+	for _, vOut := range s.allOutputs() {
+		if vOut.AnchorOutputIndex == idx {
+			return true
+		}
+	}
+
+	return false
+}
+
 // proofParams creates the set of parameters that will be used to create the
-// proofs for the sender and receiver.
+// proofs for the sender and receiver. The exclusion proofs are created for
+// all the given anchored outputs, which can include the outputs of other
+// virtual packets anchored in the same transaction.
 func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
-	outIndex int) (*proof.TransitionParams, error) {
+	outIndex int, allOutputs []*tappsbt.VOutput) (*proof.TransitionParams,
+	error) {
 
 	outputCommitments := anchorTx.OutputCommitments
 
@@ -604,10 +984,10 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 
 		// Add exclusion proofs for all the other outputs.
 		err = addOtherOutputExclusionProofs(
-			vPkt.Outputs, rootOut.Asset, rootParams,
+			allOutputs, rootOut.Asset, rootParams,
 			outputCommitments,
-			func(i int, _ *tappsbt.VOutput) bool {
-				return i == outIndex
+			func(_ int, vOut *tappsbt.VOutput) bool {
+				return vOut == rootOut
 			},
 		)
 		if err != nil {
@@ -660,14 +1040,14 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 
 	// Add exclusion proofs for all the other outputs.
 	err = addOtherOutputExclusionProofs(
-		vPkt.Outputs, splitOut.Asset, splitParams, outputCommitments,
-		func(i int, vOut *tappsbt.VOutput) bool {
+		allOutputs, splitOut.Asset, splitParams, outputCommitments,
+		func(_ int, vOut *tappsbt.VOutput) bool {
 			// We don't need exclusion proofs for:
 			//	- The split output itself.
 			//	- The split root output.
 			//	- Any output that is committed to the same
 			//	  anchor output as our split output.
-			return i == outIndex || vOut == splitRootOut ||
+			return vOut == splitOut || vOut == splitRootOut ||
 				vOut.AnchorOutputIndex == splitIndex
 		},
 	)
@@ -737,11 +1117,23 @@ func (s *sendPackage) createReAnchorProof(
 	passiveIn := passivePkt.Inputs[0]
 	passiveOut := passivePkt.Outputs[0]
 
+	// The passive asset is re-anchored by the virtual packet that spends
+	// its anchor input.
+	carrierPkt, err := fn.First(
+		s.VirtualPackets, func(vPkt *tappsbt.VPacket) bool {
+			return spendsAnchor(vPkt, passiveIn.PrevID.OutPoint)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("virtual packet for passive asset not "+
+			"found: %w", err)
+	}
+
 	// Passive assets are always anchored at a specific marked output, which
 	// normally contains asset change. But it can also be that the split
 	// root output was just created for the passive assets, if there is no
 	// active transfer or no change.
-	passiveCarrierOut, err := s.VirtualPacket.PassiveAssetsOutput()
+	passiveCarrierOut, err := carrierPkt.PassiveAssetsOutput()
 	if err != nil {
 		return nil, fmt.Errorf("anchor output for passive assets not "+
 			"found: %w", err)
@@ -763,7 +1155,7 @@ func (s *sendPackage) createReAnchorProof(
 	// provide an exclusion proof of the passive asset for each of the other
 	// BTC level outputs.
 	err = addOtherOutputExclusionProofs(
-		s.VirtualPacket.Outputs, passiveOut.Asset, passiveParams,
+		s.allOutputs(), passiveOut.Asset, passiveParams,
 		outputCommitments, func(i int, vOut *tappsbt.VOutput) bool {
 			return vOut.AnchorOutputIndex == passiveOutputIndex
 		},
@@ -775,20 +1167,9 @@ func (s *sendPackage) createReAnchorProof(
 	// Add exclusion proof(s) for any P2TR (=BIP-0086, not carrying any
 	// assets) change outputs.
 	if len(s.AnchorTx.FundedPsbt.Pkt.UnsignedTx.TxOut) > 1 {
-		isAnchor := func(idx uint32) bool {
-			for outIdx := range s.VirtualPacket.Outputs {
-				vOut := s.VirtualPacket.Outputs[outIdx]
-				if vOut.AnchorOutputIndex == idx {
-					return true
-				}
-			}
-
-			return false
-		}
-
 		err := proof.AddExclusionProofs(
 			&passiveParams.BaseProofParams,
-			s.AnchorTx.FundedPsbt.Pkt, isAnchor,
+			s.AnchorTx.FundedPsbt.Pkt, s.isAnchor,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error adding exclusion "+
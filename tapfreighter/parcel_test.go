@@ -0,0 +1,82 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddressParcelValidate tests that an address parcel can carry multiple
+// addresses, as long as none of them share a script key.
+func TestAddressParcelValidate(t *testing.T) {
+	t.Parallel()
+
+	courierAddr := address.RandProofCourierAddr(t)
+	firstAddr, _, _ := address.RandAddr(
+		t, &address.RegressionNetTap, courierAddr,
+	)
+	secondAddr, _, _ := address.RandAddr(
+		t, &address.RegressionNetTap, courierAddr,
+	)
+
+	parcel := NewAddressParcel(firstAddr.Tap, secondAddr.Tap)
+	require.NoError(t, parcel.Validate())
+
+	parcel = NewAddressParcel()
+	require.ErrorContains(t, parcel.Validate(), "at least one")
+
+	// Sending to the same address twice would store both outputs under
+	// the same script key.
+	parcel = NewAddressParcel(firstAddr.Tap, secondAddr.Tap, firstAddr.Tap)
+	require.ErrorContains(t, parcel.Validate(), "same script key")
+}
+
+// TestPreSignedParcelValidate tests that a pre-signed parcel needs the input
+// commitments of each of its virtual transactions.
+func TestPreSignedParcelValidate(t *testing.T) {
+	t.Parallel()
+
+	vPkts := []*tappsbt.VPacket{{}, {}}
+	parcel := NewMultiPreSignedParcel(
+		vPkts, []tappsbt.InputCommitments{{}, {}},
+		DefaultSelectStrategy,
+	)
+	require.NoError(t, parcel.Validate())
+
+	parcel = NewMultiPreSignedParcel(
+		vPkts, []tappsbt.InputCommitments{{}}, DefaultSelectStrategy,
+	)
+	require.ErrorContains(t, parcel.Validate(), "input commitments")
+
+	parcel = NewMultiPreSignedParcel(nil, nil, DefaultSelectStrategy)
+	require.ErrorContains(t, parcel.Validate(), "no virtual transactions")
+}
+
+// TestGroupAddrsByAssetID tests that addresses are grouped by their asset ID
+// in the order the asset IDs first appear.
+func TestGroupAddrsByAssetID(t *testing.T) {
+	t.Parallel()
+
+	courierAddr := address.RandProofCourierAddr(t)
+	newAddr := func() *address.Tap {
+		addr, _, _ := address.RandAddr(
+			t, &address.RegressionNetTap, courierAddr,
+		)
+		return addr.Tap
+	}
+
+	firstAddr, secondAddr, thirdAddr := newAddr(), newAddr(), newAddr()
+	thirdAddr.AssetID = firstAddr.AssetID
+
+	groups := groupAddrsByAssetID([]*address.Tap{
+		firstAddr, secondAddr, thirdAddr,
+	})
+	require.Equal(t, [][]*address.Tap{
+		{firstAddr, thirdAddr},
+		{secondAddr},
+	}, groups)
+
+	require.Empty(t, groupAddrsByAssetID(nil))
+}
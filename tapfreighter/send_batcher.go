@@ -0,0 +1,349 @@
+package tapfreighter
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+)
+
+// SendBatchPolicy governs the aggregation of independently submitted sends
+// into shared anchor transactions. The zero value disables the aggregation.
+type SendBatchPolicy struct {
+	// Window is the duration sends are collected for, starting with the
+	// first send of a batch, before they're executed together.
+	Window time.Duration `long:"window" description:"The duration (5s, 1m, etc) independently submitted address sends are collected for, starting with the first one, before they're merged into a single anchor transaction. 0 disables send batching."`
+
+	// MaxSends is the number of sends after which a batch is executed
+	// before its window ends.
+	MaxSends uint32 `long:"maxsends" description:"The number of collected sends after which a batch is executed before its window ends. 0 means there is no limit."`
+}
+
+// Validate makes sure the send batch policy is sane.
+func (p *SendBatchPolicy) Validate() error {
+	if p.Window < 0 {
+		return fmt.Errorf("window must not be negative")
+	}
+
+	return nil
+}
+
+// IsActive returns true if send batching is enabled.
+func (p *SendBatchPolicy) IsActive() bool {
+	return p.Window > 0
+}
+
+// batchedSend is an address parcel that waits to be executed as part of a
+// batch, along with the channels its result is delivered on.
+type batchedSend struct {
+	parcel *AddressParcel
+
+	respChan chan *OutboundParcel
+	errChan  chan error
+}
+
+// isBatchable returns true if the given parcel can be merged with other
+// parcels. Only address parcels that select their inputs automatically, don't
+// limit their chain fees and don't lock their anchor transaction qualify,
+// since those restrictions can't be divided between multiple sends.
+func isBatchable(req Parcel) (*AddressParcel, bool) {
+	addrParcel, ok := req.(*AddressParcel)
+	if !ok {
+		return nil, false
+	}
+
+	return addrParcel, len(addrParcel.prevIDs) == 0 &&
+		addrParcel.maxChainFees == 0 &&
+		addrParcel.anchorLockTime == (AnchorLockTime{})
+}
+
+// groupSends splits the given sends into groups that can be merged into a
+// single address parcel each. Sends are only merged if they use the same
+// coin selection strategy and label, and don't send to the same script key.
+func groupSends(sends []*batchedSend) [][]*batchedSend {
+	type group struct {
+		sends      []*batchedSend
+		scriptKeys map[asset.SerializedKey]struct{}
+	}
+
+	var groups []*group
+	for _, send := range sends {
+		parcel := send.parcel
+		toKey := func(a *address.Tap) asset.SerializedKey {
+			return asset.ToSerialized(&a.ScriptKey)
+		}
+		keys := fn.Map(parcel.destAddrs, toKey)
+
+		fits := func(g *group) bool {
+			first := g.sends[0].parcel
+			if first.strategy != parcel.strategy ||
+				first.label != parcel.label {
+
+				return false
+			}
+
+			return fn.None(keys, func(k asset.SerializedKey) bool {
+				_, ok := g.scriptKeys[k]
+				return ok
+			})
+		}
+
+		target, err := fn.First(groups, fits)
+		if err != nil {
+			target = &group{
+				scriptKeys: make(
+					map[asset.SerializedKey]struct{},
+				),
+			}
+			groups = append(groups, target)
+		}
+
+		target.sends = append(target.sends, send)
+		for _, k := range keys {
+			target.scriptKeys[k] = struct{}{}
+		}
+	}
+
+	return fn.Map(groups, func(g *group) []*batchedSend {
+		return g.sends
+	})
+}
+
+// SendBatcherConfig is the main config for the send batcher.
+type SendBatcherConfig struct {
+	// ChainPorter is used to execute the batched sends. All other requests
+	// are passed through to it.
+	ChainPorter Porter
+
+	// Policy is the policy for batching sends.
+	Policy SendBatchPolicy
+}
+
+// SendBatcher is a Porter that collects independently submitted address sends
+// for a batching window and merges them into a single anchor transaction
+// where possible. All callers of a merged send receive the same transfer. If
+// the merged send fails before its transfer is committed to disk, each of its
+// sends is executed on its own instead. Otherwise all callers receive the
+// error of the merged send.
+type SendBatcher struct {
+	// Porter is the chain porter all requests that aren't batched are
+	// passed through to.
+	Porter
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *SendBatcherConfig
+
+	newSends chan *batchedSend
+
+	*fn.ContextGuard
+}
+
+// A compile-time assertion to ensure SendBatcher implements the Porter
+// interface.
+var _ Porter = (*SendBatcher)(nil)
+
+// NewSendBatcher creates a new send batcher given a valid config.
+func NewSendBatcher(cfg *SendBatcherConfig) *SendBatcher {
+	return &SendBatcher{
+		Porter:   cfg.ChainPorter,
+		cfg:      cfg,
+		newSends: make(chan *batchedSend),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: tapgarden.DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the chain porter and the send batcher.
+func (b *SendBatcher) Start() error {
+	var startErr error
+	b.startOnce.Do(func() {
+		if err := b.Porter.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		log.Infof("Starting SendBatcher")
+
+		b.Wg.Add(1)
+		go b.batchCollector()
+	})
+
+	return startErr
+}
+
+// Stop stops the send batcher and the chain porter.
+func (b *SendBatcher) Stop() error {
+	var stopErr error
+	b.stopOnce.Do(func() {
+		log.Infof("Stopping SendBatcher")
+
+		// We stop the chain porter first, so batches that are still
+		// being executed return.
+		close(b.Quit)
+		stopErr = b.Porter.Stop()
+
+		b.Wg.Wait()
+	})
+
+	return stopErr
+}
+
+// RequestShipment requests a new transfer. Address sends that can be merged
+// with others are held back until their batch is executed, all other
+// requests are passed through to the chain porter right away.
+func (b *SendBatcher) RequestShipment(req Parcel) (*OutboundParcel, error) {
+	addrParcel, ok := isBatchable(req)
+	if !ok {
+		return b.Porter.RequestShipment(req)
+	}
+
+	// We validate the parcel now, so an invalid send doesn't end up
+	// failing the batch it's merged into.
+	if err := addrParcel.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate parcel: %w", err)
+	}
+
+	send := &batchedSend{
+		parcel:   addrParcel,
+		respChan: make(chan *OutboundParcel, 1),
+		errChan:  make(chan error, 1),
+	}
+	if !fn.SendOrQuit(b.newSends, send, b.Quit) {
+		return nil, fmt.Errorf("SendBatcher shutting down")
+	}
+
+	select {
+	case err := <-send.errChan:
+		return nil, err
+
+	case resp := <-send.respChan:
+		return resp, nil
+
+	case <-b.Quit:
+		return nil, fmt.Errorf("SendBatcher shutting down")
+	}
+}
+
+// batchCollector is the main goroutine of the send batcher. It collects new
+// sends and executes them once the batching window ends or the batch is full.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (b *SendBatcher) batchCollector() {
+	defer b.Wg.Done()
+
+	var (
+		pending     []*batchedSend
+		windowTimer <-chan time.Time
+	)
+	execute := func() {
+		batch := pending
+		pending = nil
+		windowTimer = nil
+
+		b.Wg.Add(1)
+		go func() {
+			defer b.Wg.Done()
+
+			b.executeBatch(batch)
+		}()
+	}
+
+	for {
+		select {
+		case send := <-b.newSends:
+			if len(pending) == 0 {
+				windowTimer = time.After(b.cfg.Policy.Window)
+			}
+			pending = append(pending, send)
+
+			maxSends := int(b.cfg.Policy.MaxSends)
+			if maxSends > 0 && len(pending) >= maxSends {
+				execute()
+			}
+
+		case <-windowTimer:
+			execute()
+
+		case <-b.Quit:
+			return
+		}
+	}
+}
+
+// executeBatch merges the given sends into as few transfers as possible and
+// requests their shipment.
+func (b *SendBatcher) executeBatch(batch []*batchedSend) {
+	for _, sends := range groupSends(batch) {
+		if len(sends) == 1 {
+			b.executeSend(sends[0])
+			continue
+		}
+
+		var destAddrs []*address.Tap
+		for _, send := range sends {
+			destAddrs = append(destAddrs, send.parcel.destAddrs...)
+		}
+
+		first := sends[0].parcel
+		merged := NewAddressParcelWithInputs(
+			nil, first.strategy, first.label, 0, AnchorLockTime{},
+			destAddrs...,
+		)
+
+		log.Infof("Merging %d sends to %d addrs into one transfer",
+			len(sends), len(destAddrs))
+
+		resp, err := b.Porter.RequestShipment(merged)
+
+		// If the merged send failed before its transfer was committed
+		// to disk, we can safely execute the sends one by one instead.
+		// Any later failure means the merged transfer is resumed on
+		// restart, so executing the sends again would pay each
+		// recipient twice.
+		var uncommittedErr *UncommittedSendError
+		if errors.As(err, &uncommittedErr) {
+			log.Warnf("Unable to execute merged send, executing "+
+				"sends one by one: %v", err)
+
+			for _, send := range sends {
+				b.executeSend(send)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			log.Errorf("Unable to execute merged send: %v", err)
+
+			for _, send := range sends {
+				send.errChan <- err
+			}
+
+			continue
+		}
+
+		for _, send := range sends {
+			send.respChan <- resp
+		}
+	}
+}
+
+// executeSend requests the shipment of a single send and delivers its result.
+func (b *SendBatcher) executeSend(send *batchedSend) {
+	resp, err := b.Porter.RequestShipment(send.parcel)
+	if err != nil {
+		send.errChan <- err
+		return
+	}
+
+	send.respChan <- resp
+}
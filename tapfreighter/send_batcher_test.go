@@ -0,0 +1,194 @@
+package tapfreighter
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGroupSends tests that only compatible sends are grouped to be merged
+// into a single transfer.
+func TestGroupSends(t *testing.T) {
+	t.Parallel()
+
+	courierAddr := address.RandProofCourierAddr(t)
+	newAddr := func() *address.Tap {
+		addr, _, _ := address.RandAddr(
+			t, &address.RegressionNetTap, courierAddr,
+		)
+		return addr.Tap
+	}
+	newSend := func(strategy MultiCommitmentSelectStrategy, label string,
+		addrs ...*address.Tap) *batchedSend {
+
+		return &batchedSend{
+			parcel: NewAddressParcelWithInputs(
+				nil, strategy, label, 0, AnchorLockTime{},
+				addrs...,
+			),
+		}
+	}
+
+	sharedAddr := newAddr()
+	first := newSend(DefaultSelectStrategy, "", newAddr(), sharedAddr)
+	second := newSend(DefaultSelectStrategy, "", newAddr())
+	otherLabel := newSend(DefaultSelectStrategy, "payroll", newAddr())
+	sameKey := newSend(DefaultSelectStrategy, "", sharedAddr)
+	otherStrategy := newSend(PreferMinAmount, "", newAddr())
+	third := newSend(DefaultSelectStrategy, "", newAddr())
+
+	groups := groupSends([]*batchedSend{
+		first, second, otherLabel, sameKey, otherStrategy, third,
+	})
+	require.Equal(t, [][]*batchedSend{
+		{first, second, third},
+		{otherLabel},
+		{sameKey},
+		{otherStrategy},
+	}, groups)
+
+	// Only address parcels without restrictions that can't be divided
+	// between sends are batched.
+	_, ok := isBatchable(first.parcel)
+	require.True(t, ok)
+
+	_, ok = isBatchable(NewAddressParcelWithInputs(
+		nil, DefaultSelectStrategy, "", 1000, AnchorLockTime{},
+		newAddr(),
+	))
+	require.False(t, ok)
+
+	_, ok = isBatchable(NewAddressParcelWithInputs(
+		nil, DefaultSelectStrategy, "", 0, AnchorLockTime{
+			LockTime: 800_000,
+		}, newAddr(),
+	))
+	require.False(t, ok)
+}
+
+// mockShipmentPorter is a porter that records the requested shipments and
+// fails merged sends with a configured error.
+type mockShipmentPorter struct {
+	Porter
+
+	mergedErr error
+
+	mu       sync.Mutex
+	requests []*AddressParcel
+}
+
+// RequestShipment records the request and fails it if it's a merged send.
+func (m *mockShipmentPorter) RequestShipment(req Parcel) (*OutboundParcel,
+	error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	addrParcel := req.(*AddressParcel)
+	m.requests = append(m.requests, addrParcel)
+
+	if len(addrParcel.destAddrs) > 1 && m.mergedErr != nil {
+		return nil, m.mergedErr
+	}
+
+	return &OutboundParcel{
+		ChainFees: int64(len(m.requests)),
+	}, nil
+}
+
+// TestExecuteBatch tests that the sends of a failed merged send are only
+// executed one by one if the merged transfer wasn't committed to disk.
+func TestExecuteBatch(t *testing.T) {
+	t.Parallel()
+
+	courierAddr := address.RandProofCourierAddr(t)
+	newSend := func() *batchedSend {
+		addr, _, _ := address.RandAddr(
+			t, &address.RegressionNetTap, courierAddr,
+		)
+		return &batchedSend{
+			parcel: NewAddressParcelWithInputs(
+				nil, DefaultSelectStrategy, "", 0,
+				AnchorLockTime{}, addr.Tap,
+			),
+			respChan: make(chan *OutboundParcel, 1),
+			errChan:  make(chan error, 1),
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		mergedErr error
+
+		// numRequests is the expected number of shipments requested
+		// from the porter.
+		numRequests int
+
+		// fallback indicates that the sends are expected to be
+		// executed one by one.
+		fallback bool
+	}{{
+		name:        "merged send succeeds",
+		numRequests: 1,
+	}, {
+		name: "merged send fails before commit",
+		mergedErr: &UncommittedSendError{
+			Err: errors.New("not enough funds"),
+		},
+		numRequests: 3,
+		fallback:    true,
+	}, {
+		name:        "merged send fails after commit",
+		mergedErr:   errors.New("unable to publish transaction"),
+		numRequests: 1,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			porter := &mockShipmentPorter{
+				mergedErr: tc.mergedErr,
+			}
+			batcher := NewSendBatcher(&SendBatcherConfig{
+				ChainPorter: porter,
+			})
+
+			sends := []*batchedSend{newSend(), newSend()}
+			batcher.executeBatch(sends)
+
+			require.Len(t, porter.requests, tc.numRequests)
+			require.Len(t, porter.requests[0].destAddrs, 2)
+
+			for idx, send := range sends {
+				switch {
+				// Each send receives its own transfer.
+				case tc.fallback:
+					resp := <-send.respChan
+					require.EqualValues(
+						t, idx+2, resp.ChainFees,
+					)
+
+				// All sends receive the error of the merged
+				// send.
+				case tc.mergedErr != nil:
+					err := <-send.errChan
+					require.ErrorIs(t, err, tc.mergedErr)
+
+				// All sends receive the merged transfer.
+				default:
+					resp := <-send.respChan
+					require.EqualValues(
+						t, 1, resp.ChainFees,
+					)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,429 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultSendQueuePollInterval is the default interval in which the
+	// send queue checks whether any of the queued sends are due.
+	DefaultSendQueuePollInterval = time.Minute
+)
+
+var (
+	// ErrQueuedSendNotFound is returned if a queued send with the given ID
+	// does not exist.
+	ErrQueuedSendNotFound = errors.New("queued send not found")
+
+	// ErrQueuedSendFailed is returned if a queued send that failed is
+	// updated. A failed send can only be canceled.
+	ErrQueuedSendFailed = errors.New("queued send failed")
+)
+
+// QueuedSend is a send to one or more Taproot Asset addresses that is not
+// executed right away but once all of its conditions are met.
+type QueuedSend struct {
+	// ID is the unique ID of the queued send, assigned when it is stored.
+	ID int64
+
+	// TapAddrs is the list of encoded addresses to send to.
+	TapAddrs []string
+
+	// CoinSelectStrategy is the coin selection strategy used to fund the
+	// send once it is executed.
+	CoinSelectStrategy MultiCommitmentSelectStrategy
+
+	// SendAfter is the earliest point in time the send is executed at. A
+	// zero value means the send is not deferred by time.
+	SendAfter time.Time
+
+	// MaxFeeRate is the highest estimated on-chain fee rate the send is
+	// executed at. A zero value means the send is executed regardless of
+	// the fee rate.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// CreationTime is the time the send was queued.
+	CreationTime time.Time
+
+	// LastError is the error of the last failed attempt to execute the
+	// send, if any.
+	LastError string
+
+	// Failed is set if the send failed after its transfer was handed to
+	// the chain porter. Such a send is never executed again, as its
+	// transfer might have been committed to disk and be resumed on
+	// restart.
+	Failed bool
+}
+
+// IsDue returns true if the send should be executed at the given time and
+// fee rate.
+func (s *QueuedSend) IsDue(now time.Time,
+	feeRate chainfee.SatPerKWeight) bool {
+
+	if s.Failed {
+		return false
+	}
+
+	if !s.SendAfter.IsZero() && now.Before(s.SendAfter) {
+		return false
+	}
+
+	return s.MaxFeeRate == 0 || feeRate <= s.MaxFeeRate
+}
+
+// SendQueueStore is the interface used to persist queued sends.
+type SendQueueStore interface {
+	// QueueSend stores a new queued send and returns its ID.
+	QueueSend(ctx context.Context, send *QueuedSend) (int64, error)
+
+	// QueuedSends returns all queued sends, in the order they were queued.
+	QueuedSends(ctx context.Context) ([]*QueuedSend, error)
+
+	// UpdateQueuedSend updates the conditions, the last error and the
+	// failed flag of the given queued send. ErrQueuedSendNotFound is
+	// returned if the send does not exist.
+	UpdateQueuedSend(ctx context.Context, send *QueuedSend) error
+
+	// DeleteQueuedSend deletes the queued send with the given ID.
+	// ErrQueuedSendNotFound is returned if the send does not exist.
+	DeleteQueuedSend(ctx context.Context, id int64) error
+}
+
+// SendQueueConfig is the main config for the send queue.
+type SendQueueConfig struct {
+	// Store is used to persist the queued sends.
+	Store SendQueueStore
+
+	// ChainPorter is used to execute the sends once they are due.
+	ChainPorter Porter
+
+	// ChainBridge is used to estimate the current on-chain fee rate.
+	ChainBridge ChainBridge
+
+	// ChainParams are the chain parameters the addresses are decoded
+	// with.
+	ChainParams *address.ChainParams
+
+	// Ticker is used to periodically check whether any of the queued
+	// sends are due.
+	Ticker *ticker.Force
+
+	// Clock is used to determine whether a send is due.
+	Clock clock.Clock
+
+	// ErrChan is the main error channel the send queue will report back
+	// critical errors to the main server.
+	ErrChan chan<- error
+}
+
+// SendQueue keeps sends that are scheduled for a future time or deferred
+// until the on-chain fee rate falls below a threshold. Due sends are handed to
+// the chain porter one at a time, so they don't race each other for coins.
+type SendQueue struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *SendQueueConfig
+
+	// poke is used to trigger an immediate check for due sends.
+	poke chan struct{}
+
+	// mtx serializes the modification of queued sends with their
+	// execution, so a send can't be changed or canceled while it is
+	// executed.
+	mtx sync.Mutex
+
+	*fn.ContextGuard
+}
+
+// NewSendQueue creates a new send queue given a valid config.
+func NewSendQueue(cfg *SendQueueConfig) *SendQueue {
+	return &SendQueue{
+		cfg:  cfg,
+		poke: make(chan struct{}, 1),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: tapgarden.DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the send queue.
+func (q *SendQueue) Start() error {
+	q.startOnce.Do(func() {
+		log.Infof("Starting SendQueue")
+
+		q.cfg.Ticker.Resume()
+
+		q.Wg.Add(1)
+		go q.queueProcessor()
+
+		// Sends may have become due while we were offline, so we check
+		// right away instead of waiting for the first tick.
+		q.pokeQueue()
+	})
+
+	return nil
+}
+
+// Stop signals the send queue to halt all operations.
+func (q *SendQueue) Stop() error {
+	q.stopOnce.Do(func() {
+		log.Infof("Stopping SendQueue")
+
+		close(q.Quit)
+		q.Wg.Wait()
+
+		q.cfg.Ticker.Stop()
+	})
+
+	return nil
+}
+
+// pokeQueue triggers a check for due sends, unless one is already pending.
+func (q *SendQueue) pokeQueue() {
+	select {
+	case q.poke <- struct{}{}:
+	default:
+	}
+}
+
+// decodeAddrs decodes and validates the addresses of the given send.
+func (q *SendQueue) decodeAddrs(send *QueuedSend) ([]*address.Tap, error) {
+	tapAddrs := make([]*address.Tap, len(send.TapAddrs))
+	for idx := range send.TapAddrs {
+		var err error
+		tapAddrs[idx], err = address.DecodeAddress(
+			send.TapAddrs[idx], q.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode addr %d: %w",
+				idx, err)
+		}
+	}
+
+	parcel := NewAddressParcelWithInputs(
+		nil, send.CoinSelectStrategy, "", 0, AnchorLockTime{},
+		tapAddrs...,
+	)
+	if err := parcel.Validate(); err != nil {
+		return nil, err
+	}
+
+	return tapAddrs, nil
+}
+
+// QueueSend validates and stores the given send. The send is executed once
+// all of its conditions are met.
+func (q *SendQueue) QueueSend(ctx context.Context,
+	send *QueuedSend) (*QueuedSend, error) {
+
+	if _, err := q.decodeAddrs(send); err != nil {
+		return nil, err
+	}
+
+	newSend := *send
+	newSend.CreationTime = q.cfg.Clock.Now().UTC()
+	newSend.LastError = ""
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	id, err := q.cfg.Store.QueueSend(ctx, &newSend)
+	if err != nil {
+		return nil, fmt.Errorf("unable to queue send: %w", err)
+	}
+	newSend.ID = id
+
+	q.pokeQueue()
+
+	return &newSend, nil
+}
+
+// QueuedSends returns all sends that are still queued.
+func (q *SendQueue) QueuedSends(ctx context.Context) ([]*QueuedSend, error) {
+	return q.cfg.Store.QueuedSends(ctx)
+}
+
+// UpdateQueuedSend replaces the conditions of the queued send with the given
+// ID.
+func (q *SendQueue) UpdateQueuedSend(ctx context.Context, id int64,
+	sendAfter time.Time,
+	maxFeeRate chainfee.SatPerKWeight) (*QueuedSend, error) {
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	sends, err := q.cfg.Store.QueuedSends(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch queued sends: %w", err)
+	}
+
+	send, err := fn.First(sends, func(s *QueuedSend) bool {
+		return s.ID == id
+	})
+	if err != nil {
+		return nil, ErrQueuedSendNotFound
+	}
+
+	if send.Failed {
+		return nil, fmt.Errorf("%w: %s", ErrQueuedSendFailed,
+			send.LastError)
+	}
+
+	send.SendAfter = sendAfter
+	send.MaxFeeRate = maxFeeRate
+	if err := q.cfg.Store.UpdateQueuedSend(ctx, send); err != nil {
+		return nil, fmt.Errorf("unable to update queued send: %w", err)
+	}
+
+	q.pokeQueue()
+
+	return send, nil
+}
+
+// CancelQueuedSend removes the queued send with the given ID from the queue.
+// A send that is currently being executed can no longer be canceled.
+func (q *SendQueue) CancelQueuedSend(ctx context.Context, id int64) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	return q.cfg.Store.DeleteQueuedSend(ctx, id)
+}
+
+// queueProcessor is the main goroutine of the send queue. It executes the
+// queued sends once they are due.
+func (q *SendQueue) queueProcessor() {
+	defer q.Wg.Done()
+
+	for {
+		select {
+		case <-q.cfg.Ticker.Ticks():
+		case <-q.poke:
+		case <-q.Quit:
+			return
+		}
+
+		if err := q.executeDueSends(); err != nil {
+			log.Errorf("Unable to process send queue: %v", err)
+		}
+	}
+}
+
+// executeDueSends executes all queued sends that are due, one at a time. A
+// send that fails before its transfer was committed to disk is kept in the
+// queue along with its error and is retried on the next check. Any other
+// failure marks the send as failed, so it isn't executed again.
+func (q *SendQueue) executeDueSends() error {
+	ctx, cancel := q.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	sends, err := q.cfg.Store.QueuedSends(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch queued sends: %w", err)
+	}
+
+	// We only need to know the current fee rate if any of the sends is
+	// deferred by it, and we'll only query it once per check.
+	var (
+		now     = q.cfg.Clock.Now()
+		feeRate chainfee.SatPerKWeight
+	)
+	for _, send := range sends {
+		if send.MaxFeeRate == 0 || feeRate != 0 {
+			continue
+		}
+
+		feeRate, err = q.cfg.ChainBridge.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to estimate fee: %w", err)
+		}
+	}
+
+	for _, send := range sends {
+		if !send.IsDue(now, feeRate) {
+			continue
+		}
+
+		log.Infof("Executing queued send %d to %d addrs", send.ID,
+			len(send.TapAddrs))
+
+		err := q.executeSend(send)
+		if err != nil {
+			// Only a send that failed before its transfer was
+			// committed to disk can safely be retried. Otherwise,
+			// the transfer is resumed on restart, and executing
+			// the send again would pay the recipients twice.
+			var uncommittedErr *UncommittedSendError
+			if errors.As(err, &uncommittedErr) {
+				log.Warnf("Unable to execute queued send %d, "+
+					"retrying later: %v", send.ID, err)
+			} else {
+				log.Errorf("Unable to execute queued send "+
+					"%d, marking it as failed: %v", send.ID,
+					err)
+
+				send.Failed = true
+			}
+
+			send.LastError = err.Error()
+			err := q.cfg.Store.UpdateQueuedSend(ctx, send)
+			if err != nil {
+				return fmt.Errorf("unable to update queued "+
+					"send: %w", err)
+			}
+
+			continue
+		}
+
+		// The send was broadcast, so it must not be executed again.
+		// If we can't remove it from the queue, we stop so it isn't
+		// sent twice.
+		err = q.cfg.Store.DeleteQueuedSend(ctx, send.ID)
+		if err != nil {
+			err = fmt.Errorf("unable to delete executed queued "+
+				"send %d: %w", send.ID, err)
+			q.cfg.ErrChan <- err
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeSend hands the given send to the chain porter and waits until its
+// transfer is broadcast.
+func (q *SendQueue) executeSend(send *QueuedSend) error {
+	tapAddrs, err := q.decodeAddrs(send)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.cfg.ChainPorter.RequestShipment(
+		NewAddressParcelWithInputs(
+			nil, send.CoinSelectStrategy, "", 0, AnchorLockTime{},
+			tapAddrs...,
+		),
+	)
+
+	return err
+}
@@ -0,0 +1,289 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueuedSendIsDue tests that a queued send is only due once all of its
+// conditions are met.
+func TestQueuedSendIsDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+
+	testCases := []struct {
+		name       string
+		sendAfter  time.Time
+		maxFeeRate chainfee.SatPerKWeight
+		feeRate    chainfee.SatPerKWeight
+		failed     bool
+		due        bool
+	}{{
+		name: "no conditions",
+		due:  true,
+	}, {
+		name:      "send time in the future",
+		sendAfter: now.Add(time.Second),
+		due:       false,
+	}, {
+		name:      "send time reached",
+		sendAfter: now,
+		due:       true,
+	}, {
+		name:       "fee rate too high",
+		maxFeeRate: 1000,
+		feeRate:    1001,
+		due:        false,
+	}, {
+		name:       "fee rate at maximum",
+		maxFeeRate: 1000,
+		feeRate:    1000,
+		due:        true,
+	}, {
+		name:       "fee rate low but send time in the future",
+		sendAfter:  now.Add(time.Hour),
+		maxFeeRate: 1000,
+		feeRate:    253,
+		due:        false,
+	}, {
+		name:       "all conditions met",
+		sendAfter:  now.Add(-time.Hour),
+		maxFeeRate: 1000,
+		feeRate:    253,
+		due:        true,
+	}, {
+		name:   "failed",
+		failed: true,
+		due:    false,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			send := &QueuedSend{
+				SendAfter:  tc.sendAfter,
+				MaxFeeRate: tc.maxFeeRate,
+				Failed:     tc.failed,
+			}
+
+			require.Equal(t, tc.due, send.IsDue(now, tc.feeRate))
+		})
+	}
+}
+
+// mockSendQueueStore is an in-memory send queue store.
+type mockSendQueueStore struct {
+	mu     sync.Mutex
+	nextID int64
+	sends  []*QueuedSend
+}
+
+// QueueSend stores a new queued send and returns its ID.
+func (m *mockSendQueueStore) QueueSend(_ context.Context,
+	send *QueuedSend) (int64, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	newSend := *send
+	newSend.ID = m.nextID
+	m.sends = append(m.sends, &newSend)
+
+	return newSend.ID, nil
+}
+
+// QueuedSends returns copies of all queued sends.
+func (m *mockSendQueueStore) QueuedSends(
+	_ context.Context) ([]*QueuedSend, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return fn.Map(m.sends, func(s *QueuedSend) *QueuedSend {
+		sendCopy := *s
+		return &sendCopy
+	}), nil
+}
+
+// UpdateQueuedSend replaces the stored send with the same ID.
+func (m *mockSendQueueStore) UpdateQueuedSend(_ context.Context,
+	send *QueuedSend) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for idx := range m.sends {
+		if m.sends[idx].ID == send.ID {
+			sendCopy := *send
+			m.sends[idx] = &sendCopy
+
+			return nil
+		}
+	}
+
+	return ErrQueuedSendNotFound
+}
+
+// DeleteQueuedSend deletes the stored send with the given ID.
+func (m *mockSendQueueStore) DeleteQueuedSend(_ context.Context,
+	id int64) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for idx := range m.sends {
+		if m.sends[idx].ID == id {
+			m.sends = append(m.sends[:idx], m.sends[idx+1:]...)
+			return nil
+		}
+	}
+
+	return ErrQueuedSendNotFound
+}
+
+// mockFailingPorter is a porter that fails every shipment with the configured
+// error and counts the requested shipments.
+type mockFailingPorter struct {
+	Porter
+
+	err error
+
+	mu          sync.Mutex
+	numRequests int
+}
+
+// RequestShipment counts the request and fails it with the configured error.
+func (m *mockFailingPorter) RequestShipment(Parcel) (*OutboundParcel,
+	error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.numRequests++
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return &OutboundParcel{}, nil
+}
+
+// TestExecuteDueSends tests that a queued send is only retried if it failed
+// before its transfer was committed to disk, and is marked as failed and never
+// executed again otherwise.
+func TestExecuteDueSends(t *testing.T) {
+	t.Parallel()
+
+	courierAddr := address.RandProofCourierAddr(t)
+
+	testCases := []struct {
+		name string
+
+		porterErr error
+
+		// numRequests is the expected number of shipments requested
+		// from the porter after executing the due sends twice.
+		numRequests int
+
+		// queued indicates that the send is expected to still be
+		// queued.
+		queued bool
+
+		// failed indicates that the send is expected to be marked as
+		// failed.
+		failed bool
+	}{{
+		name:        "send succeeds",
+		numRequests: 1,
+	}, {
+		name: "send fails before commit",
+		porterErr: &UncommittedSendError{
+			Err: errors.New("not enough funds"),
+		},
+		numRequests: 2,
+		queued:      true,
+	}, {
+		name:        "send fails after commit",
+		porterErr:   errors.New("unable to publish transaction"),
+		numRequests: 1,
+		queued:      true,
+		failed:      true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			addr, _, _ := address.RandAddr(
+				t, &address.RegressionNetTap, courierAddr,
+			)
+			encodedAddr, err := addr.EncodeAddress()
+			require.NoError(t, err)
+
+			store := &mockSendQueueStore{}
+			porter := &mockFailingPorter{
+				err: tc.porterErr,
+			}
+			queue := NewSendQueue(&SendQueueConfig{
+				Store:       store,
+				ChainPorter: porter,
+				ChainParams: &address.RegressionNetTap,
+				Ticker:      ticker.NewForce(time.Hour),
+				Clock:       clock.NewDefaultClock(),
+				ErrChan:     make(chan error, 1),
+			})
+
+			ctx := context.Background()
+			send, err := queue.QueueSend(ctx, &QueuedSend{
+				TapAddrs: []string{encodedAddr},
+			})
+			require.NoError(t, err)
+
+			// We execute the due sends twice, to make sure a send
+			// is only retried if that is safe.
+			require.NoError(t, queue.executeDueSends())
+			require.NoError(t, queue.executeDueSends())
+
+			require.Equal(t, tc.numRequests, porter.numRequests)
+
+			sends, err := queue.QueuedSends(ctx)
+			require.NoError(t, err)
+
+			if !tc.queued {
+				require.Empty(t, sends)
+				return
+			}
+
+			require.Len(t, sends, 1)
+			require.Equal(t, send.ID, sends[0].ID)
+			require.Equal(t, tc.failed, sends[0].Failed)
+			require.Equal(
+				t, tc.porterErr.Error(), sends[0].LastError,
+			)
+
+			// A failed send can't be updated to be executed again.
+			_, err = queue.UpdateQueuedSend(
+				ctx, send.ID, time.Time{}, 0,
+			)
+			if tc.failed {
+				require.ErrorIs(t, err, ErrQueuedSendFailed)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+)
+
+const (
+	// maxSigningServiceRespLen is the maximum number of bytes of a signing
+	// service response we read.
+	maxSigningServiceRespLen = 10 * 1024 * 1024
+
+	// maxSigningServiceErrLen is the maximum number of bytes of a signing
+	// service's error response we include in the error we return.
+	maxSigningServiceErrLen = 512
+)
+
+// ErrSigningRefused is returned if the signing service refuses to sign a
+// virtual packet, for example because it violates the service's policy.
+var ErrSigningRefused = errors.New("signing service refused to sign")
+
+// SigningService is an external service that approves and signs the asset
+// inputs of virtual packets, for example a co-signer or a policy engine that
+// enforces spend limits or allowlists.
+type SigningService interface {
+	// SignVirtualPacket sends the given unsigned virtual packet to the
+	// service and returns the packet signed by it. ErrSigningRefused is
+	// returned if the service refuses to sign the packet.
+	SignVirtualPacket(ctx context.Context,
+		vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error)
+}
+
+// SigningServiceCfg configures the signing service the wallet hands virtual
+// packets to instead of signing them itself. The zero value doesn't use a
+// signing service.
+type SigningServiceCfg struct {
+	// URL is the http or https URL the unsigned virtual packets are posted
+	// to.
+	URL string `long:"url" description:"The http or https URL that unsigned virtual PSBTs are posted to, base64 encoded, before their asset inputs are signed. The service must respond with the signed virtual PSBT, base64 encoded, and a 2xx status, or refuse to sign with any other status. If empty, the asset inputs are signed by the daemon."`
+
+	// Timeout is the maximum time a request to the signing service may
+	// take.
+	Timeout time.Duration `long:"timeout" description:"The maximum time a request to the signing service may take. 0 means there is no timeout."`
+}
+
+// Validate makes sure the signing service config is sane.
+func (c *SigningServiceCfg) Validate() error {
+	if c.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative")
+	}
+
+	if c.URL == "" {
+		return nil
+	}
+
+	serviceURL, err := url.Parse(c.URL)
+	isHTTP := serviceURL != nil &&
+		(serviceURL.Scheme == "http" || serviceURL.Scheme == "https")
+	if err != nil || serviceURL.Host == "" || !isHTTP {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+
+	return nil
+}
+
+// HTTPSigningService is an implementation of the SigningService interface
+// that posts virtual packets, base64 encoded, to an HTTP endpoint.
+type HTTPSigningService struct {
+	url string
+
+	client *http.Client
+}
+
+// A compile-time assertion to ensure HTTPSigningService implements the
+// SigningService interface.
+var _ SigningService = (*HTTPSigningService)(nil)
+
+// NewHTTPSigningService creates a new signing service that posts virtual
+// packets to the URL of the given config.
+func NewHTTPSigningService(cfg *SigningServiceCfg) *HTTPSigningService {
+	return &HTTPSigningService{
+		url: cfg.URL,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+	}
+}
+
+// SignVirtualPacket sends the given unsigned virtual packet to the service and
+// returns the packet signed by it.
+func (h *HTTPSigningService) SignVirtualPacket(ctx context.Context,
+	vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error) {
+
+	b64Pkt, err := vPkt.B64Encode()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode virtual packet: %w",
+			err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, h.url, bytes.NewBufferString(b64Pkt),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create signing request: %w",
+			err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach signing service at "+
+			"%s: %w", h.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errMsg, _ := io.ReadAll(
+			io.LimitReader(resp.Body, maxSigningServiceErrLen),
+		)
+		return nil, fmt.Errorf("%w: status %d: %s", ErrSigningRefused,
+			resp.StatusCode, bytes.TrimSpace(errMsg))
+	}
+
+	respBody, err := io.ReadAll(
+		io.LimitReader(resp.Body, maxSigningServiceRespLen),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read signing service "+
+			"response: %w", err)
+	}
+
+	signedPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(bytes.TrimSpace(respBody)), true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode signed virtual "+
+			"packet: %w", err)
+	}
+
+	return signedPkt, nil
+}
+
+// signWithService hands the given virtual packet to the signing service and
+// attaches the witnesses of the packet it returns. The witnesses are verified
+// against our own copy of the virtual transaction, so the service can't
+// change what is being spent or where it goes.
+func signWithService(ctx context.Context, service SigningService,
+	vPkt *tappsbt.VPacket, validator tapscript.TxValidator) error {
+
+	signedPkt, err := service.SignVirtualPacket(ctx, vPkt)
+	if err != nil {
+		return err
+	}
+
+	if len(signedPkt.Inputs) != len(vPkt.Inputs) ||
+		len(signedPkt.Outputs) != len(vPkt.Outputs) {
+
+		return fmt.Errorf("signing service returned a packet with "+
+			"%d inputs and %d outputs, expected %d and %d",
+			len(signedPkt.Inputs), len(signedPkt.Outputs),
+			len(vPkt.Inputs), len(vPkt.Outputs))
+	}
+
+	witnesses, err := tapscript.VirtualTxWitnesses(signedPkt)
+	if err != nil {
+		return fmt.Errorf("unable to extract witnesses of signed "+
+			"packet: %w", err)
+	}
+
+	err = tapscript.AttachVirtualTxWitnesses(vPkt, witnesses, validator)
+	if err != nil {
+		return fmt.Errorf("invalid witnesses from signing service: %w",
+			err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package tapfreighter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHTTPSigningService tests that virtual packets are posted to the signing
+// service and that refusals to sign are reported.
+func TestHTTPSigningService(t *testing.T) {
+	t.Parallel()
+
+	// The zero value doesn't use a signing service.
+	cfg := &SigningServiceCfg{}
+	require.NoError(t, cfg.Validate())
+
+	cfg.URL = "signer.example.com"
+	require.Error(t, cfg.Validate())
+
+	signedPkt := tappsbt.RandPacket(t)
+	b64SignedPkt, err := signedPkt.B64Encode()
+	require.NoError(t, err)
+
+	unsignedPkt := tappsbt.RandPacket(t)
+	b64UnsignedPkt, err := unsignedPkt.B64Encode()
+	require.NoError(t, err)
+
+	// The service only signs the packet we expect and refuses everything
+	// else.
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			if string(body) != b64UnsignedPkt {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("spend limit exceeded\n"))
+				return
+			}
+
+			_, _ = w.Write([]byte(b64SignedPkt))
+		},
+	))
+	defer server.Close()
+
+	cfg.URL = server.URL
+	require.NoError(t, cfg.Validate())
+	service := NewHTTPSigningService(cfg)
+
+	ctx := context.Background()
+	resp, err := service.SignVirtualPacket(ctx, unsignedPkt)
+	require.NoError(t, err)
+
+	b64Resp, err := resp.B64Encode()
+	require.NoError(t, err)
+	require.Equal(t, b64SignedPkt, b64Resp)
+
+	_, err = service.SignVirtualPacket(ctx, tappsbt.RandPacket(t))
+	require.ErrorIs(t, err, ErrSigningRefused)
+	require.ErrorContains(t, err, "spend limit exceeded")
+}
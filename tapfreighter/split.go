@@ -0,0 +1,103 @@
+package tapfreighter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+const (
+	// SplitStrategyExact is the split strategy that sends the change of a
+	// split to a single output with the exact remaining amount.
+	SplitStrategyExact = "exact"
+
+	// SplitStrategyRound is the split strategy that divides the change of
+	// a split into an output with a round amount and one with the rest.
+	SplitStrategyRound = "round"
+)
+
+// ErrTooManySplitOutputs is returned if funding a virtual transaction would
+// split its inputs into more outputs than the split policy allows.
+var ErrTooManySplitOutputs = errors.New("too many split outputs")
+
+// SplitPolicy governs how the wallet splits assets when funding a transfer.
+// Each output of a split adds a split commitment proof that every downstream
+// recipient of the asset inherits, so uncontrolled splitting increases the
+// size of their proofs. The zero value doesn't limit the number of outputs and
+// uses the exact split strategy.
+type SplitPolicy struct {
+	// MaxOutputs is the maximum number of asset outputs a single virtual
+	// transaction that splits its inputs may have, including the change.
+	// Zero means there is no limit.
+	MaxOutputs uint32 `long:"maxoutputs" description:"The maximum number of asset outputs, including change, a transfer may split the inputs of a single asset into. 0 means there is no limit."`
+
+	// Strategy is the strategy used to choose the amounts of the change
+	// outputs of a split.
+	Strategy string `long:"strategy" description:"The strategy used to choose the change amounts of asset splits. 'exact' creates a single change output with the remaining amount. 'round' splits the change into an output with a multiple of the round unit and one with the rest, so later sends of round amounts can spend a matching output without splitting it again." choice:"exact" choice:"round"`
+
+	// RoundUnit is the unit the round part of the change is a multiple of
+	// when using the round split strategy.
+	RoundUnit uint64 `long:"roundunit" description:"The unit the round change output of the 'round' split strategy is a multiple of."`
+}
+
+// Validate makes sure the split policy is sane.
+func (p *SplitPolicy) Validate() error {
+	switch p.Strategy {
+	case "", SplitStrategyExact:
+		return nil
+
+	case SplitStrategyRound:
+		if p.RoundUnit < 2 {
+			return fmt.Errorf("round unit must be at least 2 for " +
+				"the round split strategy")
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown split strategy: %v", p.Strategy)
+	}
+}
+
+// checkOutputs makes sure the given virtual packet doesn't split its inputs
+// into more outputs than allowed. Packets with a single output don't split
+// their inputs and are always allowed.
+func (p *SplitPolicy) checkOutputs(vPkt *tappsbt.VPacket) error {
+	numOutputs := len(vPkt.Outputs)
+	if p.MaxOutputs == 0 || numOutputs <= 1 {
+		return nil
+	}
+
+	if numOutputs > int(p.MaxOutputs) {
+		return fmt.Errorf("%w: transfer has %d asset outputs, "+
+			"policy allows at most %d", ErrTooManySplitOutputs,
+			numOutputs, p.MaxOutputs)
+	}
+
+	return nil
+}
+
+// roundChange returns the amounts the given change should be divided into by
+// the round split strategy, with the round amount first. False is returned if
+// the change shouldn't be divided, because the policy doesn't use the round
+// strategy, the change is already round or smaller than the round unit, or an
+// additional output would exceed the maximum number of outputs of the packet.
+func (p *SplitPolicy) roundChange(vPkt *tappsbt.VPacket,
+	change uint64) (uint64, uint64, bool) {
+
+	if p.Strategy != SplitStrategyRound || change < p.RoundUnit {
+		return 0, 0, false
+	}
+
+	remainder := change % p.RoundUnit
+	if remainder == 0 {
+		return 0, 0, false
+	}
+
+	if p.MaxOutputs != 0 && len(vPkt.Outputs)+1 > int(p.MaxOutputs) {
+		return 0, 0, false
+	}
+
+	return change - remainder, remainder, true
+}
@@ -0,0 +1,66 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplitPolicy tests that the split policy is validated and limits the
+// outputs and change amounts of a virtual packet correctly.
+func TestSplitPolicy(t *testing.T) {
+	t.Parallel()
+
+	// The zero value doesn't limit or divide anything.
+	policy := &SplitPolicy{}
+	require.NoError(t, policy.Validate())
+
+	newPacket := func(numOutputs int) *tappsbt.VPacket {
+		vPkt := &tappsbt.VPacket{}
+		for i := 0; i < numOutputs; i++ {
+			vPkt.Outputs = append(vPkt.Outputs, &tappsbt.VOutput{})
+		}
+
+		return vPkt
+	}
+	require.NoError(t, policy.checkOutputs(newPacket(10)))
+	_, _, ok := policy.roundChange(newPacket(2), 1234)
+	require.False(t, ok)
+
+	policy.Strategy = "random"
+	require.Error(t, policy.Validate())
+
+	policy.Strategy = SplitStrategyRound
+	require.Error(t, policy.Validate())
+
+	policy.RoundUnit = 100
+	require.NoError(t, policy.Validate())
+
+	// Change is divided into a round amount and the rest, unless it is
+	// already round or smaller than the unit.
+	roundAmt, restAmt, ok := policy.roundChange(newPacket(2), 1234)
+	require.True(t, ok)
+	require.EqualValues(t, 1200, roundAmt)
+	require.EqualValues(t, 34, restAmt)
+
+	_, _, ok = policy.roundChange(newPacket(2), 1200)
+	require.False(t, ok)
+	_, _, ok = policy.roundChange(newPacket(2), 99)
+	require.False(t, ok)
+
+	// Packets that don't split their inputs are always allowed, all others
+	// are limited to the maximum number of outputs. Change isn't divided
+	// if that would exceed the limit.
+	policy.MaxOutputs = 2
+	require.NoError(t, policy.checkOutputs(newPacket(1)))
+	require.NoError(t, policy.checkOutputs(newPacket(2)))
+	require.ErrorIs(
+		t, policy.checkOutputs(newPacket(3)), ErrTooManySplitOutputs,
+	)
+
+	_, _, ok = policy.roundChange(newPacket(2), 1234)
+	require.False(t, ok)
+	_, _, ok = policy.roundChange(newPacket(1), 1234)
+	require.True(t, ok)
+}
@@ -0,0 +1,593 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrSwapOfferMismatch is returned if a swap offer or the anchor
+	// transaction countersigned by the taker of the offer doesn't match
+	// what was agreed on.
+	ErrSwapOfferMismatch = errors.New("swap doesn't match the offer")
+
+	// ErrSwapOfferNotFound is returned if a swap offer with the given ID
+	// was not made by us.
+	ErrSwapOfferNotFound = errors.New("swap offer not found")
+)
+
+// SwapOfferStore is the interface used to persist the payments our swap offers
+// ask for, so we don't have to trust the offer the taker hands back.
+type SwapOfferStore interface {
+	// StoreSwapOffer stores the payment the swap offer with the given ID
+	// asks for.
+	StoreSwapOffer(ctx context.Context, id chainhash.Hash,
+		payment *wire.TxOut) error
+
+	// SwapOfferPayment returns the payment the swap offer with the given
+	// ID asks for. ErrSwapOfferNotFound is returned if the offer does not
+	// exist.
+	SwapOfferPayment(ctx context.Context, id chainhash.Hash) (*wire.TxOut,
+		error)
+
+	// DeleteSwapOffer deletes the swap offer with the given ID.
+	// ErrSwapOfferNotFound is returned if the offer does not exist.
+	DeleteSwapOffer(ctx context.Context, id chainhash.Hash) error
+}
+
+// SwapOffer is an offer to atomically swap an asset for BTC. The maker of the
+// offer transfers the asset to an address of the taker, and the taker pays the
+// maker in the same anchor transaction.
+type SwapOffer struct {
+	// VPacket is the signed virtual transaction that transfers the asset
+	// from the maker to the taker.
+	VPacket *tappsbt.VPacket
+
+	// AnchorPsbt is the template of the anchor transaction. It spends the
+	// anchor inputs of the maker and contains all anchor outputs of the
+	// virtual transaction, followed by the BTC payment output of the
+	// maker. It is neither funded nor signed.
+	AnchorPsbt *psbt.Packet
+}
+
+// ID returns the ID of the offer, which is the txid of its unsigned anchor
+// transaction template. It commits to the anchor inputs and the asset anchor
+// outputs of the offer, as well as the payment output of the maker.
+func (o *SwapOffer) ID() chainhash.Hash {
+	return o.AnchorPsbt.UnsignedTx.TxHash()
+}
+
+// isAnchor returns true if the given output index of the anchor transaction
+// carries any of the asset outputs of the offer.
+func (o *SwapOffer) isAnchor(idx uint32) bool {
+	for _, vOut := range o.VPacket.Outputs {
+		if vOut.AnchorOutputIndex == idx {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isMakerInput returns true if the given outpoint is spent by the maker of the
+// offer.
+func (o *SwapOffer) isMakerInput(outPoint wire.OutPoint) bool {
+	for _, txIn := range o.AnchorPsbt.UnsignedTx.TxIn {
+		if txIn.PreviousOutPoint == outPoint {
+			return true
+		}
+	}
+
+	return false
+}
+
+// makerInputValue returns the total value of the anchor inputs of the maker.
+func (o *SwapOffer) makerInputValue() (int64, error) {
+	var total int64
+	for idx, pIn := range o.AnchorPsbt.Inputs {
+		if pIn.WitnessUtxo == nil {
+			return 0, fmt.Errorf("offer input %d is missing its "+
+				"UTXO information", idx)
+		}
+
+		total += pIn.WitnessUtxo.Value
+	}
+
+	return total, nil
+}
+
+// Payment returns the amount the taker pays to accept the offer, excluding
+// on-chain fees. That is the value of all outputs of the offer minus the value
+// of the anchor inputs of the maker.
+func (o *SwapOffer) Payment() (btcutil.Amount, error) {
+	inputValue, err := o.makerInputValue()
+	if err != nil {
+		return 0, err
+	}
+
+	var outputValue int64
+	for _, txOut := range o.AnchorPsbt.UnsignedTx.TxOut {
+		outputValue += txOut.Value
+	}
+
+	if outputValue < inputValue {
+		return 0, fmt.Errorf("%w: outputs are worth less than the "+
+			"inputs", ErrSwapOfferMismatch)
+	}
+
+	return btcutil.Amount(outputValue - inputValue), nil
+}
+
+// NewSwapOffer creates a swap offer for the given signed virtual packet, which
+// transfers the asset to the taker. The anchor transaction template of the
+// offer contains the anchor outputs of the packet, with the given passive
+// assets re-anchored in its change output, followed by the given BTC payment
+// output of the maker.
+func NewSwapOffer(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments,
+	passivePkts []*tappsbt.VPacket, payment *wire.TxOut) (*SwapOffer,
+	error) {
+
+	anchorPkt, _, err := swapAnchorTemplate(
+		vPkt, inputCommitments, passivePkts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorPkt.UnsignedTx.AddTxOut(payment)
+	anchorPkt.Outputs = append(anchorPkt.Outputs, psbt.POutput{})
+
+	// The taker doesn't need to know how the keys of the maker's inputs
+	// are derived. They are added back when completing the swap.
+	for idx := range anchorPkt.Inputs {
+		anchorPkt.Inputs[idx].Bip32Derivation = nil
+		anchorPkt.Inputs[idx].TaprootBip32Derivation = nil
+	}
+
+	offer := &SwapOffer{
+		VPacket:    vPkt,
+		AnchorPsbt: anchorPkt,
+	}
+	if _, err := offer.Payment(); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// swapAnchorTemplate creates the anchor transaction template that spends the
+// anchor inputs of the given virtual packet and commits to its outputs and the
+// given passive assets. The commitments of the anchor outputs are returned as
+// well.
+func swapAnchorTemplate(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments,
+	passivePkts []*tappsbt.VPacket) (*psbt.Packet,
+	map[uint32]*commitment.TapCommitment, error) {
+
+	outputCommitments, err := tapscript.CreateOutputCommitments(
+		inputCommitments, vPkt, passivePkts,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create output "+
+			"commitments: %w", err)
+	}
+
+	anchorPkt, err := tapscript.CreateAnchorTx(vPkt.Outputs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating anchor TX: %w", err)
+	}
+
+	anchorCommitments, err := tapscript.UpdateTaprootOutputKeys(
+		anchorPkt, vPkt, outputCommitments,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error updating taproot output "+
+			"keys: %w", err)
+	}
+
+	appendAnchorInputs(anchorPkt, vPkt.Inputs)
+
+	return anchorPkt, anchorCommitments, nil
+}
+
+// VerifySwapOffer makes sure the given swap offer transfers the asset of the
+// given address of the taker with a valid virtual transaction, and that it
+// only spends the anchor inputs of that transaction. The provenance of the
+// input assets is not verified.
+func VerifySwapOffer(offer *SwapOffer, recipient *address.Tap,
+	validator tapscript.TxValidator) error {
+
+	vPkt := offer.VPacket
+	anchorTx := offer.AnchorPsbt.UnsignedTx
+
+	// Every anchor input of the offer must be the anchor of one of the
+	// inputs of the virtual transaction, and the other way around.
+	if len(anchorTx.TxIn) != len(offer.AnchorPsbt.Inputs) {
+		return fmt.Errorf("%w: inconsistent number of inputs",
+			ErrSwapOfferMismatch)
+	}
+	for idx, txIn := range anchorTx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		vIn, err := fn.First(vPkt.Inputs, func(i *tappsbt.VInput) bool {
+			return i.PrevID.OutPoint == prevOut
+		})
+		if err != nil {
+			return fmt.Errorf("%w: input %v is not spent by the "+
+				"virtual transaction", ErrSwapOfferMismatch,
+				txIn.PreviousOutPoint)
+		}
+
+		utxo := offer.AnchorPsbt.Inputs[idx].WitnessUtxo
+		if utxo == nil || utxo.Value != int64(vIn.Anchor.Value) ||
+			!bytes.Equal(utxo.PkScript, vIn.Anchor.PkScript) {
+
+			return fmt.Errorf("%w: UTXO information of input %v "+
+				"doesn't match its anchor",
+				ErrSwapOfferMismatch, txIn.PreviousOutPoint)
+		}
+	}
+	for _, vIn := range vPkt.Inputs {
+		if !offer.isMakerInput(vIn.PrevID.OutPoint) {
+			return fmt.Errorf("%w: anchor input %v is missing",
+				ErrSwapOfferMismatch, vIn.PrevID.OutPoint)
+		}
+	}
+
+	err := tapscript.ValidateVirtualTransaction(vPkt, validator)
+	if err != nil {
+		return fmt.Errorf("invalid virtual transaction: %w", err)
+	}
+
+	// The asset must be sent to the taker's address, which means it must
+	// be the only asset committed to in its anchor output.
+	vOut, err := fn.First(vPkt.Outputs, func(vOut *tappsbt.VOutput) bool {
+		return vOut.ScriptKey.PubKey != nil &&
+			vOut.ScriptKey.PubKey.IsEqual(&recipient.ScriptKey)
+	})
+	if err != nil {
+		return fmt.Errorf("%w: no output pays to the address",
+			ErrSwapOfferMismatch)
+	}
+	if vOut.Asset == nil || vOut.Asset.ID() != recipient.AssetID ||
+		vOut.Amount != recipient.Amount {
+
+		return fmt.Errorf("%w: output doesn't transfer the asset "+
+			"amount of the address", ErrSwapOfferMismatch)
+	}
+	if vOut.AnchorOutputIndex >= uint32(len(anchorTx.TxOut)) {
+		return fmt.Errorf("%w: anchor output %d of the address is "+
+			"missing", ErrSwapOfferMismatch, vOut.AnchorOutputIndex)
+	}
+
+	outputKey, err := recipient.TaprootOutputKey()
+	if err != nil {
+		return err
+	}
+	pkScript, err := txscript.PayToTaprootScript(outputKey)
+	if err != nil {
+		return err
+	}
+	anchorOut := anchorTx.TxOut[vOut.AnchorOutputIndex]
+	if !bytes.Equal(anchorOut.PkScript, pkScript) {
+		return fmt.Errorf("%w: anchor output %d doesn't commit to "+
+			"the address", ErrSwapOfferMismatch,
+			vOut.AnchorOutputIndex)
+	}
+
+	if _, err := offer.Payment(); err != nil {
+		return err
+	}
+
+	return verifyBip86Outputs(offer.AnchorPsbt, offer.isAnchor)
+}
+
+// verifySwapCompletion makes sure that the given anchor transaction, funded
+// and signed by the taker of the given offer, still spends all inputs and
+// contains all outputs of the offer, and that all inputs of the taker are
+// signed.
+func verifySwapCompletion(offer *SwapOffer, pkt *psbt.Packet) error {
+	offerTx := offer.AnchorPsbt.UnsignedTx
+	tx := pkt.UnsignedTx
+
+	// The taker may only add outputs after the ones of the offer.
+	if len(tx.TxOut) < len(offerTx.TxOut) {
+		return fmt.Errorf("%w: outputs are missing",
+			ErrSwapOfferMismatch)
+	}
+	for idx, offerOut := range offerTx.TxOut {
+		txOut := tx.TxOut[idx]
+		if txOut.Value != offerOut.Value ||
+			!bytes.Equal(txOut.PkScript, offerOut.PkScript) {
+
+			return fmt.Errorf("%w: output %d was modified",
+				ErrSwapOfferMismatch, idx)
+		}
+	}
+
+	numMakerInputs := 0
+	for idx, txIn := range tx.TxIn {
+		if offer.isMakerInput(txIn.PreviousOutPoint) {
+			numMakerInputs++
+			continue
+		}
+
+		pIn := pkt.Inputs[idx]
+		if len(pIn.FinalScriptWitness) == 0 &&
+			len(pIn.FinalScriptSig) == 0 {
+
+			return fmt.Errorf("input %d of the taker is not signed",
+				idx)
+		}
+	}
+	if numMakerInputs != len(offerTx.TxIn) {
+		return fmt.Errorf("%w: inputs are missing",
+			ErrSwapOfferMismatch)
+	}
+
+	return verifyBip86Outputs(pkt, offer.isAnchor)
+}
+
+// verifyOwnSwap makes sure that the given offer handed back by the taker is the
+// offer we created from the given anchor transaction template and payment
+// output, and that the given anchor transaction countersigned by the taker
+// completes our offer and pays exactly the payment output.
+func verifyOwnSwap(offer *SwapOffer, templatePkt *psbt.Packet,
+	payment *wire.TxOut, counterSignedPkt *psbt.Packet) error {
+
+	expectedPkt, err := copyPsbt(templatePkt)
+	if err != nil {
+		return fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+	expectedPkt.UnsignedTx.AddTxOut(payment)
+	expectedPkt.Outputs = append(expectedPkt.Outputs, psbt.POutput{})
+
+	// The virtual transaction and the payment we stored must result in
+	// the offer we made, otherwise the taker combined the virtual
+	// transaction of one offer with the payment of another.
+	expectedOffer := &SwapOffer{
+		VPacket:    offer.VPacket,
+		AnchorPsbt: expectedPkt,
+	}
+	if expectedOffer.ID() != offer.ID() {
+		return fmt.Errorf("%w: virtual transaction doesn't match the "+
+			"offer", ErrSwapOfferMismatch)
+	}
+
+	// From here on, we only rely on our own version of the offer.
+	err = verifySwapCompletion(expectedOffer, counterSignedPkt)
+	if err != nil {
+		return err
+	}
+
+	// The payment must go to our address, with exactly the amount we
+	// asked for.
+	payIdx := len(expectedPkt.UnsignedTx.TxOut) - 1
+	payOut := counterSignedPkt.UnsignedTx.TxOut[payIdx]
+	if payOut.Value != payment.Value ||
+		!bytes.Equal(payOut.PkScript, payment.PkScript) {
+
+		return fmt.Errorf("%w: payment output was modified",
+			ErrSwapOfferMismatch)
+	}
+
+	return nil
+}
+
+// verifyBip86Outputs makes sure that every P2TR output of the given PSBT that
+// doesn't anchor any assets declares the internal key of a BIP-0086 output.
+// Otherwise, the exclusion proofs for the output can't be created.
+func verifyBip86Outputs(pkt *psbt.Packet, isAnchor func(uint32) bool) error {
+	for idx, txOut := range pkt.UnsignedTx.TxOut {
+		if isAnchor(uint32(idx)) ||
+			!txscript.IsPayToTaproot(txOut.PkScript) {
+
+			continue
+		}
+
+		internalKey, err := schnorr.ParsePubKey(
+			pkt.Outputs[idx].TaprootInternalKey,
+		)
+		if err != nil {
+			return fmt.Errorf("P2TR output %d has no valid "+
+				"internal key: %w", idx, err)
+		}
+
+		pkScript, err := txscript.PayToTaprootScript(
+			txscript.ComputeTaprootKeyNoScript(internalKey),
+		)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(txOut.PkScript, pkScript) {
+			return fmt.Errorf("P2TR output %d is not a BIP-0086 "+
+				"output of its internal key", idx)
+		}
+	}
+
+	return nil
+}
+
+// FundSwapOffer funds the anchor transaction of the given swap offer with BTC
+// inputs of the wallet, paying the given fee rate, and signs them. The value
+// of the maker's anchor inputs is credited to the change output of the wallet,
+// which is added as the last output. The returned PSBT is ready to be
+// completed by the maker.
+func (f *AssetWallet) FundSwapOffer(ctx context.Context, offer *SwapOffer,
+	feeRate chainfee.SatPerKWeight) (*psbt.Packet, error) {
+
+	offerTx := offer.AnchorPsbt.UnsignedTx
+	template, err := psbt.New(
+		nil, offerTx.Copy().TxOut, offerTx.Version, offerTx.LockTime,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create template: %w", err)
+	}
+
+	fundedPkt, err := f.cfg.Wallet.FundPsbtWithStrategy(
+		ctx, template, 1, feeRate,
+		f.coinSelectStrategy(DefaultSelectStrategy),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	// The maker's input value is credited to the change output, so we
+	// need one. And we rely on the outputs of the offer keeping their
+	// index.
+	fundedTx := fundedPkt.Pkt.UnsignedTx
+	if fundedPkt.ChangeOutputIndex == -1 {
+		return nil, ErrNoBtcChangeOutput
+	}
+	if len(fundedTx.TxOut) != len(offerTx.TxOut)+1 ||
+		int(fundedPkt.ChangeOutputIndex) != len(offerTx.TxOut) {
+
+		return nil, fmt.Errorf("wallet unexpectedly reordered the " +
+			"outputs of the offer")
+	}
+	for idx, offerOut := range offerTx.TxOut {
+		txOut := fundedTx.TxOut[idx]
+		if txOut.Value != offerOut.Value ||
+			!bytes.Equal(txOut.PkScript, offerOut.PkScript) {
+
+			return nil, fmt.Errorf("wallet unexpectedly modified "+
+				"output %d of the offer", idx)
+		}
+	}
+
+	makerInputValue, err := offer.makerInputValue()
+	if err != nil {
+		return nil, err
+	}
+	adjustFundedPsbt(&fundedPkt, makerInputValue)
+
+	anchorPkt := fundedPkt.Pkt
+	offerPkt, err := copyPsbt(offer.AnchorPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+	anchorPkt.Inputs = append(anchorPkt.Inputs, offerPkt.Inputs...)
+	anchorPkt.UnsignedTx.TxIn = append(
+		anchorPkt.UnsignedTx.TxIn, offerPkt.UnsignedTx.TxIn...,
+	)
+
+	err = adjustChangeForFee(anchorPkt, feeRate, f.cfg.ChainParams.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	signedPkt, err := f.cfg.Wallet.SignPsbt(ctx, anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	// We only finalize our own inputs, the maker's are signed when the
+	// swap is completed.
+	for idx, txIn := range signedPkt.UnsignedTx.TxIn {
+		if offer.isMakerInput(txIn.PreviousOutPoint) {
+			continue
+		}
+
+		finalized, err := psbt.MaybeFinalize(signedPkt, idx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to finalize input %d: "+
+				"%w", idx, err)
+		}
+		if !finalized {
+			return nil, fmt.Errorf("wallet didn't sign input %d",
+				idx)
+		}
+	}
+
+	return signedPkt, nil
+}
+
+// CompleteSwap verifies that the given anchor transaction, funded and signed
+// by the taker of the given offer, matches the offer. It then signs the anchor
+// inputs of the maker and returns the final anchor transaction. The payment,
+// input commitments and passive assets must be the ones the offer was created
+// with.
+func (f *AssetWallet) CompleteSwap(ctx context.Context, offer *SwapOffer,
+	payment *wire.TxOut, inputCommitments tappsbt.InputCommitments,
+	passivePkts []*tappsbt.VPacket,
+	counterSignedPkt *psbt.Packet) (*AnchorTransaction, error) {
+
+	// We re-create the template of the offer from our own data, so we
+	// know the commitments of its anchor outputs and don't need to trust
+	// the offer handed back by the taker.
+	templatePkt, anchorCommitments, err := swapAnchorTemplate(
+		offer.VPacket, inputCommitments, passivePkts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyOwnSwap(offer, templatePkt, payment, counterSignedPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorPkt, err := copyPsbt(counterSignedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	// Our inputs need their full information for lnd to sign them, and
+	// our anchor outputs their internal keys.
+	templateTx := templatePkt.UnsignedTx
+	for idx, txIn := range anchorPkt.UnsignedTx.TxIn {
+		for tplIdx, tplIn := range templateTx.TxIn {
+			if tplIn.PreviousOutPoint != txIn.PreviousOutPoint {
+				continue
+			}
+
+			anchorPkt.Inputs[idx] = templatePkt.Inputs[tplIdx]
+		}
+	}
+	for idx := range templatePkt.Outputs {
+		anchorPkt.Outputs[idx] = templatePkt.Outputs[idx]
+	}
+
+	// We need the PSBT output information later to create the exclusion
+	// proofs, so we sign a copy.
+	signPkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+	finalTx, chainFees, err := f.signAnchorPsbt(ctx, signPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	// The taker chose the fee rate, so we just report the actual one.
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(finalTx))
+	feeRate := chainfee.SatPerKWeight(chainFees * 1000 / weight)
+	return &AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               anchorPkt,
+			ChangeOutputIndex: -1,
+		},
+		FinalTx:           finalTx,
+		TargetFeeRate:     feeRate,
+		ChainFees:         chainFees,
+		OutputCommitments: anchorCommitments,
+	}, nil
+}
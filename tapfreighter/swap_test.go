@@ -0,0 +1,228 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSwapOffer creates a swap offer that spends a single maker input
+// worth 1000 satoshis into an asset anchor output and a payment output.
+func newTestSwapOffer(t *testing.T, payment int64) *SwapOffer {
+	anchorKey := test.RandPubKey(t)
+	pkt, err := psbt.New(
+		[]*wire.OutPoint{fn.Ptr(test.RandOp(t))},
+		[]*wire.TxOut{{
+			Value:    1_000,
+			PkScript: test.ComputeTaprootScript(t, anchorKey),
+		}, {
+			Value:    payment,
+			PkScript: test.RandBytes(22),
+		}}, 2, 0, []uint32{0},
+	)
+	require.NoError(t, err)
+
+	pkt.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    1_000,
+		PkScript: test.ComputeTaprootScript(t, test.RandPubKey(t)),
+	}
+
+	return &SwapOffer{
+		VPacket: &tappsbt.VPacket{
+			Outputs: []*tappsbt.VOutput{{
+				AnchorOutputIndex: 0,
+			}},
+		},
+		AnchorPsbt: pkt,
+	}
+}
+
+// TestSwapOfferPayment tests that the payment of a swap offer is the value of
+// its outputs minus the value of the maker's inputs.
+func TestSwapOfferPayment(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestSwapOffer(t, 5_000)
+	payment, err := offer.Payment()
+	require.NoError(t, err)
+	require.EqualValues(t, 5_000, payment)
+
+	// The maker can't take BTC out of its own inputs.
+	offer.AnchorPsbt.UnsignedTx.TxOut[0].Value = 100
+	offer.AnchorPsbt.UnsignedTx.TxOut[1].Value = 100
+	_, err = offer.Payment()
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+
+	// Without the UTXO information, the payment can't be determined.
+	offer.AnchorPsbt.Inputs[0].WitnessUtxo = nil
+	_, err = offer.Payment()
+	require.ErrorContains(t, err, "missing its UTXO information")
+}
+
+// TestVerifyBip86Outputs tests that P2TR outputs that don't anchor assets must
+// be BIP-0086 outputs of their declared internal key.
+func TestVerifyBip86Outputs(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestSwapOffer(t, 5_000)
+	pkt := offer.AnchorPsbt
+
+	// Neither the asset anchor nor the non-P2TR payment output needs an
+	// internal key.
+	require.NoError(t, verifyBip86Outputs(pkt, offer.isAnchor))
+
+	internalKey := test.RandPubKey(t)
+	bip86Key := txscript.ComputeTaprootKeyNoScript(internalKey)
+	pkt.UnsignedTx.AddTxOut(&wire.TxOut{
+		Value:    2_000,
+		PkScript: test.ComputeTaprootScript(t, bip86Key),
+	})
+	pkt.Outputs = append(pkt.Outputs, psbt.POutput{})
+
+	// A P2TR output without an internal key is rejected.
+	err := verifyBip86Outputs(pkt, offer.isAnchor)
+	require.ErrorContains(t, err, "has no valid internal key")
+
+	// So is one with an internal key that commits to a script.
+	pkt.Outputs[2].TaprootInternalKey = schnorr.SerializePubKey(
+		test.RandPubKey(t),
+	)
+	err = verifyBip86Outputs(pkt, offer.isAnchor)
+	require.ErrorContains(t, err, "is not a BIP-0086 output")
+
+	pkt.Outputs[2].TaprootInternalKey = schnorr.SerializePubKey(
+		internalKey,
+	)
+	require.NoError(t, verifyBip86Outputs(pkt, offer.isAnchor))
+}
+
+// completeTestSwapOffer returns a copy of the anchor transaction of the given
+// offer with a signed input and a change output of the taker.
+func completeTestSwapOffer(t *testing.T, offer *SwapOffer) *psbt.Packet {
+	pkt, err := copyPsbt(offer.AnchorPsbt)
+	require.NoError(t, err)
+
+	pkt.UnsignedTx.TxIn = append(
+		[]*wire.TxIn{{PreviousOutPoint: test.RandOp(t)}},
+		pkt.UnsignedTx.TxIn...,
+	)
+	pkt.Inputs = append([]psbt.PInput{{
+		FinalScriptWitness: test.RandBytes(64),
+	}}, pkt.Inputs...)
+
+	pkt.UnsignedTx.AddTxOut(&wire.TxOut{
+		Value:    10_000,
+		PkScript: test.RandBytes(22),
+	})
+	pkt.Outputs = append(pkt.Outputs, psbt.POutput{})
+
+	return pkt
+}
+
+// TestVerifySwapCompletion tests that the taker of a swap offer may only add
+// signed inputs and outputs to its anchor transaction.
+func TestVerifySwapCompletion(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestSwapOffer(t, 5_000)
+	complete := func() *psbt.Packet {
+		return completeTestSwapOffer(t, offer)
+	}
+
+	require.NoError(t, verifySwapCompletion(offer, complete()))
+
+	// The payment output must not be changed.
+	pkt := complete()
+	pkt.UnsignedTx.TxOut[1].Value--
+	err := verifySwapCompletion(offer, pkt)
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+
+	// Nor may the outputs of the offer be reordered.
+	pkt = complete()
+	txOuts := pkt.UnsignedTx.TxOut
+	txOuts[1], txOuts[2] = txOuts[2], txOuts[1]
+	err = verifySwapCompletion(offer, pkt)
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+
+	// The maker's input must still be spent.
+	pkt = complete()
+	pkt.UnsignedTx.TxIn = pkt.UnsignedTx.TxIn[:1]
+	pkt.Inputs = pkt.Inputs[:1]
+	err = verifySwapCompletion(offer, pkt)
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+
+	// And the taker's inputs must be signed.
+	pkt = complete()
+	pkt.Inputs[0].FinalScriptWitness = nil
+	err = verifySwapCompletion(offer, pkt)
+	require.ErrorContains(t, err, "is not signed")
+}
+
+// TestVerifyOwnSwap tests that a swap is only completed if the offer handed
+// back by the taker is the one we created and the taker pays exactly the
+// payment we stored for it.
+func TestVerifyOwnSwap(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestSwapOffer(t, 5_000)
+
+	// Our template of the offer is its anchor transaction without the
+	// payment output, which we stored separately.
+	templatePkt, err := copyPsbt(offer.AnchorPsbt)
+	require.NoError(t, err)
+	payment := templatePkt.UnsignedTx.TxOut[1]
+	templatePkt.UnsignedTx.TxOut = templatePkt.UnsignedTx.TxOut[:1]
+	templatePkt.Outputs = templatePkt.Outputs[:1]
+
+	require.NoError(t, verifyOwnSwap(
+		offer, templatePkt, payment, completeTestSwapOffer(t, offer),
+	))
+
+	// If the taker lowers the payment in both the offer and the anchor
+	// transaction, the offer no longer has the ID we stored the payment
+	// under.
+	cheapOffer := &SwapOffer{
+		VPacket: offer.VPacket,
+	}
+	cheapOffer.AnchorPsbt, err = copyPsbt(offer.AnchorPsbt)
+	require.NoError(t, err)
+	cheapOffer.AnchorPsbt.UnsignedTx.TxOut[1].Value = 1_000
+	err = verifyOwnSwap(
+		cheapOffer, templatePkt, payment,
+		completeTestSwapOffer(t, cheapOffer),
+	)
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+
+	// The same goes for combining the payment with the virtual
+	// transaction of another offer, which results in a different
+	// template.
+	otherTemplate, err := copyPsbt(templatePkt)
+	require.NoError(t, err)
+	otherTemplate.UnsignedTx.TxOut[0].PkScript = test.ComputeTaprootScript(
+		t, test.RandPubKey(t),
+	)
+	err = verifyOwnSwap(
+		offer, otherTemplate, payment, completeTestSwapOffer(t, offer),
+	)
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+
+	// Paying less than we asked for is rejected, even if the offer is
+	// ours.
+	pkt := completeTestSwapOffer(t, offer)
+	pkt.UnsignedTx.TxOut[1].Value--
+	err = verifyOwnSwap(offer, templatePkt, payment, pkt)
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+
+	// So is paying to a different address.
+	pkt = completeTestSwapOffer(t, offer)
+	pkt.UnsignedTx.TxOut[1].PkScript = test.RandBytes(22)
+	err = verifyOwnSwap(offer, templatePkt, payment, pkt)
+	require.ErrorIs(t, err, ErrSwapOfferMismatch)
+}
@@ -0,0 +1,330 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// TransferExportFormat is the format transfers are exported in.
+type TransferExportFormat uint8
+
+const (
+	// TransferExportJSON exports every transfer as a JSON object with its
+	// inputs and outputs nested in it.
+	TransferExportJSON TransferExportFormat = iota
+
+	// TransferExportCSV exports one CSV row for every output of every
+	// transfer. The transfer level fee columns are only set on the first
+	// row of each transfer, so they can be summed up.
+	TransferExportCSV
+)
+
+const (
+	// transferDirectionOutbound marks the record of a transfer that was
+	// sent by the local node.
+	transferDirectionOutbound = "outbound"
+
+	// transferDirectionInbound marks the record of a transfer that was
+	// received by the local node through one of its addresses.
+	transferDirectionInbound = "inbound"
+)
+
+// transferCSVHeader is the header row of transfers exported as CSV.
+var transferCSVHeader = []string{
+	"timestamp", "direction", "anchor_txid", "label", "chain_fees_sats",
+	"anchor_tx_vsize", "fee_rate_sat_per_vbyte", "anchor_value_sent_sats",
+	"anchor_outpoint", "anchor_value_sats", "asset_id", "amount",
+	"decimal_display", "script_key", "script_key_is_local",
+	"recipient_addr",
+}
+
+// DecimalDisplayLookup returns the decimal display of the asset with the given
+// ID.
+type DecimalDisplayLookup func(ctx context.Context, id asset.ID) (uint32,
+	error)
+
+// transferRecord is the accounting record of a single outbound or inbound
+// transfer. The chain fees of inbound transfers are paid by the sender, so
+// they are always zero.
+type transferRecord struct {
+	Timestamp           string  `json:"timestamp"`
+	Direction           string  `json:"direction"`
+	AnchorTxid          string  `json:"anchor_txid"`
+	Label               string  `json:"label,omitempty"`
+	ChainFeesSats       int64   `json:"chain_fees_sats"`
+	AnchorTxVSize       int64   `json:"anchor_tx_vsize"`
+	FeeRateSatPerVByte  float64 `json:"fee_rate_sat_per_vbyte"`
+	AnchorValueSentSats int64   `json:"anchor_value_sent_sats"`
+
+	Inputs  []transferRecordInput  `json:"inputs"`
+	Outputs []transferRecordOutput `json:"outputs"`
+
+	// transferTime is the time of the transfer, which the records are
+	// ordered by.
+	transferTime time.Time
+}
+
+// transferRecordInput is the accounting record of an asset spent by an
+// outbound transfer.
+type transferRecordInput struct {
+	AnchorPoint    string `json:"anchor_point"`
+	AssetID        string `json:"asset_id"`
+	Amount         uint64 `json:"amount"`
+	DecimalDisplay uint32 `json:"decimal_display"`
+}
+
+// transferRecordOutput is the accounting record of an asset output created by
+// an outbound transfer, or received by an inbound transfer.
+type transferRecordOutput struct {
+	AnchorOutpoint   string `json:"anchor_outpoint"`
+	AnchorValueSats  int64  `json:"anchor_value_sats"`
+	AssetID          string `json:"asset_id,omitempty"`
+	Amount           uint64 `json:"amount"`
+	DecimalDisplay   uint32 `json:"decimal_display"`
+	ScriptKey        string `json:"script_key"`
+	ScriptKeyIsLocal bool   `json:"script_key_is_local"`
+	RecipientAddr    string `json:"recipient_addr,omitempty"`
+}
+
+// ExportTransfers writes the accounting records of the given outbound parcels
+// and inbound address receives to the given writer in the given format,
+// ordered by their time. The records contain the amounts of all assets moved,
+// the chain fees paid, the BTC sent along with the assets to other parties and
+// the addresses the assets were sent to or received with.
+func ExportTransfers(ctx context.Context, parcels []*OutboundParcel,
+	receives []*address.Event, format TransferExportFormat,
+	decDisplay DecimalDisplayLookup, w io.Writer) error {
+
+	records := make([]*transferRecord, 0, len(parcels)+len(receives))
+	for _, parcel := range parcels {
+		record, err := newTransferRecord(ctx, parcel, decDisplay)
+		if err != nil {
+			return fmt.Errorf("unable to create record of "+
+				"transfer %v: %w", parcel.AnchorTx.TxHash(),
+				err)
+		}
+
+		records = append(records, record)
+	}
+	for _, receive := range receives {
+		record, err := newReceiveRecord(ctx, receive, decDisplay)
+		if err != nil {
+			return fmt.Errorf("unable to create record of "+
+				"receive %v: %w", receive.Outpoint, err)
+		}
+
+		records = append(records, record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].transferTime.Before(records[j].transferTime)
+	})
+
+	switch format {
+	case TransferExportJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "    ")
+
+		return encoder.Encode(records)
+
+	case TransferExportCSV:
+		rows := [][]string{transferCSVHeader}
+		for _, record := range records {
+			rows = append(rows, record.csvRows()...)
+		}
+
+		return csv.NewWriter(w).WriteAll(rows)
+
+	default:
+		return fmt.Errorf("unknown transfer export format: %v", format)
+	}
+}
+
+// newTransferRecord creates the accounting record of the given outbound
+// parcel.
+func newTransferRecord(ctx context.Context, parcel *OutboundParcel,
+	decDisplay DecimalDisplayLookup) (*transferRecord, error) {
+
+	txWeight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(parcel.AnchorTx),
+	)
+	vSize := (txWeight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+
+	record := &transferRecord{
+		Timestamp:     parcel.TransferTime.UTC().Format(time.RFC3339),
+		Direction:     transferDirectionOutbound,
+		AnchorTxid:    parcel.AnchorTx.TxHash().String(),
+		Label:         parcel.Label,
+		ChainFeesSats: parcel.ChainFees,
+		AnchorTxVSize: vSize,
+		Inputs: make(
+			[]transferRecordInput, len(parcel.Inputs),
+		),
+		Outputs: make(
+			[]transferRecordOutput, len(parcel.Outputs),
+		),
+		transferTime: parcel.TransferTime,
+	}
+	if vSize > 0 {
+		record.FeeRateSatPerVByte = float64(parcel.ChainFees) /
+			float64(vSize)
+	}
+
+	for idx, in := range parcel.Inputs {
+		decimalDisplay, err := decDisplay(ctx, in.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		record.Inputs[idx] = transferRecordInput{
+			AnchorPoint:    in.OutPoint.String(),
+			AssetID:        in.ID.String(),
+			Amount:         in.Amount,
+			DecimalDisplay: decimalDisplay,
+		}
+	}
+
+	// The BTC value of anchor outputs that only carry assets of other
+	// parties leaves our wallet along with the assets, so it is accounted
+	// for separately from the chain fees.
+	remoteAnchors := make(map[wire.OutPoint]int64)
+	localAnchors := make(map[wire.OutPoint]struct{})
+	for idx, out := range parcel.Outputs {
+		outRecord := transferRecordOutput{
+			AnchorOutpoint:  out.Anchor.OutPoint.String(),
+			AnchorValueSats: int64(out.Anchor.Value),
+			Amount:          out.Amount,
+			ScriptKey: hex.EncodeToString(
+				out.ScriptKey.PubKey.SerializeCompressed(),
+			),
+			ScriptKeyIsLocal: out.ScriptKeyLocal,
+			RecipientAddr:    out.RecipientAddr,
+		}
+
+		// Outputs that only carry passive assets don't have a proof
+		// suffix of their own.
+		if len(out.ProofSuffix) > 0 {
+			var proofSuffix proof.Proof
+			err := proofSuffix.Decode(bytes.NewReader(
+				out.ProofSuffix,
+			))
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"proof suffix of output %d: %w", idx,
+					err)
+			}
+
+			assetID := proofSuffix.Asset.ID()
+			outRecord.AssetID = assetID.String()
+			outRecord.DecimalDisplay, err = decDisplay(ctx, assetID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if out.ScriptKeyLocal {
+			localAnchors[out.Anchor.OutPoint] = struct{}{}
+		} else {
+			remoteAnchors[out.Anchor.OutPoint] = int64(
+				out.Anchor.Value,
+			)
+		}
+
+		record.Outputs[idx] = outRecord
+	}
+
+	for outpoint, value := range remoteAnchors {
+		if _, ok := localAnchors[outpoint]; ok {
+			continue
+		}
+
+		record.AnchorValueSentSats += value
+	}
+
+	return record, nil
+}
+
+// newReceiveRecord creates the accounting record of the given inbound address
+// receive. The transfer only has a single output of interest, which is the one
+// that carries the assets of the address.
+func newReceiveRecord(ctx context.Context, receive *address.Event,
+	decDisplay DecimalDisplayLookup) (*transferRecord, error) {
+
+	addr := receive.Addr
+	decimalDisplay, err := decDisplay(ctx, addr.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedAddr, err := addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode address: %w", err)
+	}
+
+	return &transferRecord{
+		Timestamp:  receive.CreationTime.UTC().Format(time.RFC3339),
+		Direction:  transferDirectionInbound,
+		AnchorTxid: receive.Outpoint.Hash.String(),
+		Label:      receive.Label,
+		Inputs:     []transferRecordInput{},
+		Outputs: []transferRecordOutput{{
+			AnchorOutpoint:  receive.Outpoint.String(),
+			AnchorValueSats: int64(receive.Amt),
+			AssetID:         addr.AssetID.String(),
+			Amount:          addr.Amount,
+			DecimalDisplay:  decimalDisplay,
+			ScriptKey: hex.EncodeToString(
+				addr.ScriptKey.SerializeCompressed(),
+			),
+			ScriptKeyIsLocal: !addr.ScriptKeyTweak.WatchOnly,
+			RecipientAddr:    encodedAddr,
+		}},
+		transferTime: receive.CreationTime,
+	}, nil
+}
+
+// csvRows returns the CSV rows of the record, one for every output.
+func (r *transferRecord) csvRows() [][]string {
+	rows := make([][]string, len(r.Outputs))
+	for idx, out := range r.Outputs {
+		var fees, vSize, feeRate, valueSent string
+		if idx == 0 {
+			fees = strconv.FormatInt(r.ChainFeesSats, 10)
+			vSize = strconv.FormatInt(r.AnchorTxVSize, 10)
+			feeRate = strconv.FormatFloat(
+				r.FeeRateSatPerVByte, 'f', 2, 64,
+			)
+			valueSent = strconv.FormatInt(
+				r.AnchorValueSentSats, 10,
+			)
+		}
+
+		rows[idx] = []string{
+			r.Timestamp, r.Direction, r.AnchorTxid, r.Label, fees,
+			vSize, feeRate, valueSent, out.AnchorOutpoint,
+			strconv.FormatInt(out.AnchorValueSats, 10),
+			out.AssetID, strconv.FormatUint(out.Amount, 10),
+			strconv.FormatUint(uint64(out.DecimalDisplay), 10),
+			out.ScriptKey, strconv.FormatBool(out.ScriptKeyIsLocal),
+			out.RecipientAddr,
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,198 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExportTransfers tests that outbound transfers are exported with their
+// fees, amounts and recipients, and inbound receives with the address they
+// were received with, in both the JSON and the CSV format.
+func TestExportTransfers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sentAsset := asset.RandAsset(t, asset.Normal)
+	assetID := sentAsset.ID()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+		Witness:          [][]byte{bytes.Repeat([]byte{1}, 64)},
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value: 1000, PkScript: bytes.Repeat([]byte{2}, 34),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value: 2000, PkScript: bytes.Repeat([]byte{3}, 34),
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	var proofSuffix bytes.Buffer
+	suffix := &proof.Proof{
+		AnchorTx: *anchorTx,
+		Asset:    *sentAsset,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: test.RandPubKey(t),
+		},
+	}
+	require.NoError(t, suffix.Encode(&proofSuffix))
+
+	newOutput := func(idx uint32, local bool) TransferOutput {
+		return TransferOutput{
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: idx,
+				},
+				Value: btcutil.Amount(
+					anchorTx.TxOut[idx].Value,
+				),
+			},
+			ScriptKey:      asset.RandScriptKey(t),
+			ScriptKeyLocal: local,
+			Amount:         uint64(idx + 1),
+			ProofSuffix:    proofSuffix.Bytes(),
+		}
+	}
+	remoteOutput := newOutput(1, false)
+	remoteOutput.RecipientAddr = "taprt1recipient"
+
+	parcel := &OutboundParcel{
+		AnchorTx:     anchorTx,
+		TransferTime: time.Unix(1700000000, 0),
+		ChainFees:    500,
+		Label:        "rent",
+		Inputs: []TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: test.RandOp(t),
+				ID:       assetID,
+			},
+			Amount: 3,
+		}},
+		Outputs: []TransferOutput{newOutput(0, true), remoteOutput},
+	}
+
+	// The receive happened after the transfer, so it's exported after it.
+	recvAddr, _, _ := address.RandAddr(
+		t, &address.RegressionNetTap, address.RandProofCourierAddr(t),
+	)
+	encodedRecvAddr, err := recvAddr.EncodeAddress()
+	require.NoError(t, err)
+	receive := &address.Event{
+		CreationTime: time.Unix(1700001000, 0),
+		Addr:         recvAddr,
+		Status:       address.StatusCompleted,
+		Outpoint:     test.RandOp(t),
+		Amt:          1000,
+		Label:        "salary",
+	}
+	receives := []*address.Event{receive}
+
+	decDisplay := func(_ context.Context, id asset.ID) (uint32, error) {
+		if id == recvAddr.AssetID {
+			return 6, nil
+		}
+
+		require.Equal(t, assetID, id)
+		return 2, nil
+	}
+
+	// The JSON export nests the inputs and outputs in the transfer.
+	var jsonExport bytes.Buffer
+	err = ExportTransfers(
+		ctx, []*OutboundParcel{parcel}, receives, TransferExportJSON,
+		decDisplay, &jsonExport,
+	)
+	require.NoError(t, err)
+
+	var records []*transferRecord
+	require.NoError(t, json.Unmarshal(jsonExport.Bytes(), &records))
+	require.Len(t, records, 2)
+
+	record := records[0]
+	require.Equal(t, "2023-11-14T22:13:20Z", record.Timestamp)
+	require.Equal(t, transferDirectionOutbound, record.Direction)
+	require.Equal(t, anchorTxHash.String(), record.AnchorTxid)
+	require.Equal(t, "rent", record.Label)
+	require.EqualValues(t, 500, record.ChainFeesSats)
+	require.Positive(t, record.AnchorTxVSize)
+	require.InDelta(
+		t, 500/float64(record.AnchorTxVSize),
+		record.FeeRateSatPerVByte, 0.0001,
+	)
+	require.EqualValues(t, 2000, record.AnchorValueSentSats)
+
+	require.Len(t, record.Inputs, 1)
+	require.Equal(t, assetID.String(), record.Inputs[0].AssetID)
+	require.EqualValues(t, 3, record.Inputs[0].Amount)
+
+	require.Len(t, record.Outputs, 2)
+	require.Equal(t, assetID.String(), record.Outputs[1].AssetID)
+	require.EqualValues(t, 2, record.Outputs[1].Amount)
+	require.EqualValues(t, 2, record.Outputs[1].DecimalDisplay)
+	require.False(t, record.Outputs[1].ScriptKeyIsLocal)
+	require.Equal(t, "taprt1recipient", record.Outputs[1].RecipientAddr)
+
+	// The fees of the receive were paid by the sender, so only the output
+	// that carries the received assets is exported.
+	recvRecord := records[1]
+	require.Equal(t, transferDirectionInbound, recvRecord.Direction)
+	require.Equal(t, receive.Outpoint.Hash.String(), recvRecord.AnchorTxid)
+	require.Equal(t, "salary", recvRecord.Label)
+	require.Zero(t, recvRecord.ChainFeesSats)
+	require.Empty(t, recvRecord.Inputs)
+	require.Equal(t, []transferRecordOutput{{
+		AnchorOutpoint:  receive.Outpoint.String(),
+		AnchorValueSats: 1000,
+		AssetID:         recvAddr.AssetID.String(),
+		Amount:          recvAddr.Amount,
+		DecimalDisplay:  6,
+		ScriptKey: hex.EncodeToString(
+			recvAddr.ScriptKey.SerializeCompressed(),
+		),
+		ScriptKeyIsLocal: true,
+		RecipientAddr:    encodedRecvAddr,
+	}}, recvRecord.Outputs)
+
+	// The CSV export has one row per output, with the fees only set on the
+	// first row of the transfer.
+	var csvExport bytes.Buffer
+	err = ExportTransfers(
+		ctx, []*OutboundParcel{parcel}, receives, TransferExportCSV,
+		decDisplay, &csvExport,
+	)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(&csvExport).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	require.Equal(t, transferCSVHeader, rows[0])
+	require.Equal(t, transferDirectionOutbound, rows[1][1])
+	require.Equal(t, "500", rows[1][4])
+	require.Equal(t, "2000", rows[1][7])
+	require.Empty(t, rows[2][4])
+	require.Equal(t, "taprt1recipient", rows[2][15])
+	require.Equal(t, transferDirectionInbound, rows[3][1])
+	require.Equal(t, "0", rows[3][4])
+	require.Equal(t, encodedRecvAddr, rows[3][15])
+
+	// Unknown formats are rejected.
+	err = ExportTransfers(
+		ctx, []*OutboundParcel{parcel}, nil, 7, decDisplay, &csvExport,
+	)
+	require.ErrorContains(t, err, "unknown transfer export format")
+}
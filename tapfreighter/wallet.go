@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"sync"
 	"time"
 
@@ -30,6 +29,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
@@ -64,6 +64,34 @@ var (
 	// assets of an anchor output, which is not supported.
 	ErrFullBurnNotSupported = errors.New("burning all assets of an " +
 		"anchor output is not supported")
+
+	// ErrNoAnchorPsbt is returned when we attempt to bump the fee of a
+	// transfer for which the funded anchor PSBT wasn't stored.
+	ErrNoAnchorPsbt = errors.New("anchor PSBT of transfer not available")
+
+	// ErrNoBtcChangeOutput is returned when we attempt to bump the fee of
+	// an anchor transaction that doesn't have a BTC change output the
+	// additional fee could be paid from.
+	ErrNoBtcChangeOutput = errors.New("anchor TX has no BTC change " +
+		"output to pay the additional fee from")
+
+	// ErrCounterpartyInputs is returned when we attempt to bump the fee of
+	// an anchor transaction that also spends inputs of a counterparty, for
+	// example the one of an asset swap. We can't sign a replacement for it
+	// on our own.
+	ErrCounterpartyInputs = errors.New("anchor TX spends inputs of a " +
+		"counterparty")
+
+	// ErrNoWalletInputs is returned when we attempt to cancel a transfer
+	// whose anchor transaction doesn't spend any BTC inputs of the wallet
+	// that could be double spent without also spending the asset inputs.
+	ErrNoWalletInputs = errors.New("anchor TX has no BTC wallet inputs " +
+		"to double spend")
+
+	// ErrMaxChainFeesExceeded is returned when the chain fees of a funded
+	// anchor transaction exceed the maximum the caller is willing to pay.
+	ErrMaxChainFeesExceeded = errors.New("anchor TX chain fees exceed " +
+		"the maximum")
 )
 
 // AnchorTransaction is a type that holds all information about a BTC level
@@ -95,18 +123,25 @@ type AnchorTransaction struct {
 // Wallet is an interface for funding and signing asset transfers.
 type Wallet interface {
 	// FundAddressSend funds a virtual transaction, selecting assets to
-	// spend in order to pay the given address. It also returns supporting
-	// data which assists in processing the virtual transaction: passive
-	// asset re-anchors and the Taproot Asset level commitment of the
-	// selected assets.
-	FundAddressSend(ctx context.Context,
+	// spend in order to pay the given address. If prevIDs is set, only the
+	// given asset inputs are spent instead, otherwise they are selected
+	// with the given strategy. It also returns supporting data which
+	// assists in processing the virtual transaction: passive asset
+	// re-anchors and the Taproot Asset level commitment of the selected
+	// assets. The anchor output indexes of the virtual outputs start at
+	// the given offset, which allows multiple packets to be anchored in
+	// the same transaction.
+	FundAddressSend(ctx context.Context, prevIDs []asset.PrevID,
+		strategy MultiCommitmentSelectStrategy,
+		anchorOutputOffset uint32,
 		receiverAddrs ...*address.Tap) (*FundedVPacket,
 		tappsbt.OutputIdxToAddr, error)
 
 	// FundPacket funds a virtual transaction, selecting assets to spend
-	// in order to pay the given recipient. The selected input is then added
-	// to the given virtual transaction.
+	// in order to pay the given recipient with the given strategy. The
+	// selected input is then added to the given virtual transaction.
 	FundPacket(ctx context.Context, fundDesc *tapscript.FundingDescriptor,
+		strategy MultiCommitmentSelectStrategy,
 		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
 
 	// FundBurn funds a virtual transaction for burning the given amount of
@@ -114,6 +149,21 @@ type Wallet interface {
 	FundBurn(ctx context.Context,
 		fundDesc *tapscript.FundingDescriptor) (*FundedVPacket, error)
 
+	// FundConsolidation funds a virtual transaction that merges the
+	// smallest UTXOs of the given asset into a single output to a new
+	// script key of the wallet, so that at most the given number of UTXOs
+	// of the asset remain.
+	FundConsolidation(ctx context.Context, assetID asset.ID,
+		targetCount uint32) (*FundedVPacket, error)
+
+	// FundSweep funds the virtual transactions that move all UTXOs of the
+	// given asset, or of all assets if no asset ID is given, to new script
+	// keys of the wallet. The packets can be anchored in a single
+	// transaction. The IDs of the assets that can only be swept once that
+	// transaction confirmed are returned as well.
+	FundSweep(ctx context.Context, assetID *asset.ID) ([]*FundedVPacket,
+		[]asset.ID, error)
+
 	// SignVirtualPacket signs the virtual transaction of the given packet
 	// and returns the input indexes that were signed.
 	SignVirtualPacket(vPkt *tappsbt.VPacket,
@@ -126,6 +176,13 @@ type Wallet interface {
 		inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
 		error)
 
+	// CreatePassiveAssets creates the unsigned passive asset packets for
+	// the given input commitment and virtual packet that contains the
+	// active asset transfer.
+	CreatePassiveAssets(vPkt *tappsbt.VPacket,
+		inputCommitments tappsbt.InputCommitments) (
+		[]*PassiveAssetReAnchor, error)
+
 	// AnchorVirtualTransactions creates a BTC level anchor transaction that
 	// anchors all the virtual transactions of the given packets (for both
 	// sending and passive asset re-anchoring).
@@ -137,10 +194,55 @@ type Wallet interface {
 	AnchorVirtualTransactions(ctx context.Context,
 		params *AnchorVTxnsParams) (*AnchorTransaction, error)
 
+	// FundAnchorTransaction creates and funds the BTC level anchor
+	// transaction that anchors all the virtual transactions of the given
+	// packets, without signing it.
+	FundAnchorTransaction(ctx context.Context,
+		params *AnchorVTxnsParams) (*AnchorTransaction, error)
+
+	// FinalizeAnchorTransaction verifies and finalizes an anchor
+	// transaction that was created with FundAnchorTransaction for the
+	// given packets and then signed externally.
+	FinalizeAnchorTransaction(params *AnchorVTxnsParams,
+		signedPkt *psbt.Packet) (*AnchorTransaction, error)
+
+	// BumpAnchorFee creates and signs a replacement for the anchor
+	// transaction of the given pending parcel that pays the given, higher
+	// fee rate. The additional fee is deducted from the BTC change output
+	// of the anchor transaction, all other outputs remain unchanged.
+	BumpAnchorFee(ctx context.Context, parcel *OutboundParcel,
+		feeRate chainfee.SatPerKWeight) (*AnchorTransaction, error)
+
+	// CancelAnchorTx creates and signs a transaction that double spends
+	// the BTC inputs of the anchor transaction of the given pending parcel
+	// back to its BTC change output, paying the given fee rate. The asset
+	// inputs of the anchor transaction aren't spent, so the assets they
+	// anchor remain where they are.
+	CancelAnchorTx(ctx context.Context, parcel *OutboundParcel,
+		feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error)
+
+	// FundSwapOffer funds the anchor transaction of the given swap offer
+	// with BTC inputs of the wallet, paying the given fee rate, and signs
+	// them. The returned PSBT is ready to be completed by the maker of the
+	// offer.
+	FundSwapOffer(ctx context.Context, offer *SwapOffer,
+		feeRate chainfee.SatPerKWeight) (*psbt.Packet, error)
+
+	// CompleteSwap verifies that the given anchor transaction, funded and
+	// signed by the taker of the given offer, matches the offer and pays
+	// the given payment output. It then signs the anchor inputs of the
+	// maker and returns the final anchor transaction.
+	CompleteSwap(ctx context.Context, offer *SwapOffer,
+		payment *wire.TxOut, inputCommitments tappsbt.InputCommitments,
+		passivePkts []*tappsbt.VPacket,
+		counterSignedPkt *psbt.Packet) (*AnchorTransaction, error)
+
 	// SignOwnershipProof creates and signs an ownership proof for the given
 	// owned asset. The ownership proof consists of a valid witness of a
 	// signed virtual packet that spends the asset fully to the NUMS key.
-	SignOwnershipProof(ownedAsset *asset.Asset) (wire.TxWitness, error)
+	// The witness commits to the given challenge, which can be zero.
+	SignOwnershipProof(ownedAsset *asset.Asset,
+		challenge [32]byte) (wire.TxWitness, error)
 }
 
 // AddrBook is an interface that provides access to the address book.
@@ -164,13 +266,73 @@ type AnchorVTxnsParams struct {
 	// anchored by the anchor transaction.
 	VPkts []*tappsbt.VPacket
 
-	// InputCommitments is a map from virtual package input index to its
-	// associated Taproot Assets commitment.
-	InputCommitments tappsbt.InputCommitments
+	// InputCommitments holds the input commitments of each virtual
+	// transaction, in the same order as VPkts. Each entry is a map from
+	// virtual package input index to its associated Taproot Assets
+	// commitment.
+	InputCommitments []tappsbt.InputCommitments
 
 	// PassiveAssetsVPkts is a list of all the virtual transactions which
-	// re-anchor passive assets.
+	// re-anchor passive assets. Each passive asset is anchored in the
+	// change output of the virtual transaction that spends the same anchor
+	// input.
 	PassiveAssetsVPkts []*tappsbt.VPacket
+
+	// CoinSelectStrategy is the strategy that is used to select the BTC
+	// level inputs that fund the anchor transaction.
+	CoinSelectStrategy MultiCommitmentSelectStrategy
+
+	// MaxChainFees is the maximum amount of sats the anchor transaction
+	// may pay in chain fees. If the fees of the funded transaction exceed
+	// it, the transaction isn't signed and the asset inputs are released.
+	// Zero means there is no limit.
+	MaxChainFees btcutil.Amount
+
+	// AnchorLockTime is the optional lock time and sequence of the anchor
+	// transaction.
+	AnchorLockTime AnchorLockTime
+}
+
+// AnchorLockTime is the lock time and input sequence an anchor transaction is
+// created with, to restrict when it can be confirmed.
+type AnchorLockTime struct {
+	// LockTime is the absolute lock time of the anchor transaction, as a
+	// block height or UNIX timestamp. Zero means there is no lock time.
+	LockTime uint32
+
+	// Sequence is the sequence number of all inputs of the anchor
+	// transaction, which can encode a BIP-0068 relative lock time. Zero
+	// means the sequence numbers chosen by the wallet are used.
+	Sequence uint32
+}
+
+// Validate makes sure the lock time of the anchor transaction is enforced.
+func (a AnchorLockTime) Validate() error {
+	if a.LockTime != 0 && a.Sequence == wire.MaxTxInSequenceNum {
+		return fmt.Errorf("lock time %d is disabled by sequence %d",
+			a.LockTime, a.Sequence)
+	}
+
+	return nil
+}
+
+// apply sets the lock time and input sequence numbers of the given anchor
+// PSBT. A lock time is only enforced if at least one input isn't final, so
+// final inputs are made non-final if there is a lock time.
+func (a AnchorLockTime) apply(pkt *psbt.Packet) {
+	pkt.UnsignedTx.LockTime = a.LockTime
+
+	for _, txIn := range pkt.UnsignedTx.TxIn {
+		switch {
+		case a.Sequence != 0:
+			txIn.Sequence = a.Sequence
+
+		case a.LockTime != 0 &&
+			txIn.Sequence == wire.MaxTxInSequenceNum:
+
+			txIn.Sequence = wire.MaxTxInSequenceNum - 1
+		}
+	}
 }
 
 // NewCoinSelect creates a new CoinSelect.
@@ -226,9 +388,19 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 		len(eligibleCommitments), constraints.MinAmt,
 		constraints.AssetID[:])
 
-	selectedCoins, err := s.selectForAmount(
-		constraints.MinAmt, eligibleCommitments, strategy,
-	)
+	// If the caller specified the inputs to spend, we won't select any
+	// other coins.
+	var selectedCoins []*AnchoredCommitment
+	if len(constraints.PrevIDs) > 0 {
+		selectedCoins, err = selectPrevIDs(
+			constraints.PrevIDs, eligibleCommitments,
+			constraints.MinAmt,
+		)
+	} else {
+		selectedCoins, err = s.selectForAmount(
+			constraints.MinAmt, eligibleCommitments, strategy,
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to select coins: %w", err)
 	}
@@ -251,6 +423,107 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 	return selectedCoins, nil
 }
 
+// SelectConsolidationCoins returns the smallest not yet leased coins of the
+// given asset ID that need to be merged so that at most the given number of
+// coins of the asset remain. The coins returned are leased for the default
+// lease duration.
+func (s *CoinSelect) SelectConsolidationCoins(ctx context.Context,
+	assetID asset.ID, targetCount uint32) ([]*AnchoredCommitment, error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
+		return nil, fmt.Errorf("unable to delete expired leases: %w",
+			err)
+	}
+
+	eligibleCommitments, err := s.coinLister.ListEligibleCoins(
+		ctx, CommitmentConstraints{
+			AssetID: &assetID,
+			MinAmt:  1,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list eligible coins: %w", err)
+	}
+
+	selectedCoins, err := SelectForConsolidation(
+		eligibleCommitments, targetCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Consolidating %v of %v asset inputs of %x",
+		len(selectedCoins), len(eligibleCommitments), assetID[:])
+
+	expiry := time.Now().Add(defaultCoinLeaseDuration)
+	coinOutPoints := fn.Map(
+		selectedCoins, func(c *AnchoredCommitment) wire.OutPoint {
+			return c.AnchorPoint
+		},
+	)
+	err = s.coinLister.LeaseCoins(
+		ctx, defaultWalletLeaseIdentifier, expiry, coinOutPoints...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lease coin: %w", err)
+	}
+
+	return selectedCoins, nil
+}
+
+// SelectSweepCoins returns the not yet leased coins of the given asset ID, or
+// of all assets if no asset ID is given, that can be swept in a single anchor
+// transaction. The coins returned are leased for the default lease duration.
+func (s *CoinSelect) SelectSweepCoins(ctx context.Context,
+	assetID *asset.ID) (*SweepPlan, error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
+		return nil, fmt.Errorf("unable to delete expired leases: %w",
+			err)
+	}
+
+	eligibleCommitments, err := s.coinLister.ListEligibleCoins(
+		ctx, CommitmentConstraints{
+			AssetID: assetID,
+			MinAmt:  1,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list eligible coins: %w", err)
+	}
+
+	plan, err := PlanSweep(eligibleCommitments)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Sweeping %d assets, deferring %d", len(plan.AssetIDs),
+		len(plan.Deferred))
+
+	var coinOutPoints []wire.OutPoint
+	for _, coins := range plan.Coins {
+		for _, coin := range coins {
+			coinOutPoints = append(coinOutPoints, coin.AnchorPoint)
+		}
+	}
+
+	expiry := time.Now().Add(defaultCoinLeaseDuration)
+	err = s.coinLister.LeaseCoins(
+		ctx, defaultWalletLeaseIdentifier, expiry, coinOutPoints...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lease coin: %w", err)
+	}
+
+	return plan, nil
+}
+
 // LeaseCoins leases/locks/reserves coins for the given lease owner until the
 // given expiry. This is used to prevent multiple concurrent coin selection
 // attempts from selecting the same coin(s).
@@ -284,53 +557,87 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 	error) {
 
-	// Select the first subset of eligible commitments which cumulatively
-	// sum to at least the minimum required amount.
-	var selectedCommitments []*AnchoredCommitment
-	amountSum := uint64(0)
-
-	switch strategy {
-	case PreferMaxAmount:
-		// Sort eligible commitments from the largest amount to
-		// smallest.
-		sort.Slice(
-			eligibleCommitments, func(i, j int) bool {
-				isLess := eligibleCommitments[i].Asset.Amount <
-					eligibleCommitments[j].Asset.Amount
-
-				// Negate the result to sort in descending
-				// order.
-				return !isLess
-			},
+	selectedCommitments, err := SelectByStrategy(
+		eligibleCommitments, func(c *AnchoredCommitment) uint64 {
+			return c.Asset.Amount
+		}, func([]*AnchoredCommitment) uint64 {
+			return minTotalAmount
+		}, strategy,
+	)
+	if errors.Is(err, ErrInsufficientCoins) {
+		return nil, ErrMatchingAssetsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return selectedCommitments, nil
+}
+
+// selectPrevIDs selects the eligible commitments that match the given asset
+// inputs. An input without an asset ID and script key matches all eligible
+// commitments of its anchor outpoint. Every input must match at least one
+// eligible commitment, and the selected commitments must cumulatively sum to
+// at least the minimum required amount.
+func selectPrevIDs(prevIDs []asset.PrevID,
+	eligibleCommitments []*AnchoredCommitment,
+	minTotalAmount uint64) ([]*AnchoredCommitment, error) {
+
+	var (
+		selectedCommitments []*AnchoredCommitment
+		selected            = make(map[asset.PrevID]struct{})
+		amountSum           uint64
+	)
+	for _, prevID := range prevIDs {
+		var (
+			anyAssetID   = prevID.ID == asset.ID{}
+			anyScriptKey = prevID.ScriptKey == asset.SerializedKey{}
+			matched      bool
 		)
+		for _, coin := range eligibleCommitments {
+			coinID := asset.PrevID{
+				OutPoint: coin.AnchorPoint,
+				ID:       coin.Asset.ID(),
+				ScriptKey: asset.ToSerialized(
+					coin.Asset.ScriptKey.PubKey,
+				),
+			}
 
-		// Select the first subset of eligible commitments which
-		// cumulatively sum to at least the minimum required amount.
-		for _, anchoredCommitment := range eligibleCommitments {
-			selectedCommitments = append(
-				selectedCommitments, anchoredCommitment,
-			)
+			switch {
+			case coinID.OutPoint != prevID.OutPoint:
+				continue
+
+			case !anyAssetID && coinID.ID != prevID.ID:
+				continue
+
+			case !anyScriptKey &&
+				coinID.ScriptKey != prevID.ScriptKey:
 
-			// Keep track of the total amount of assets we've seen
-			// so far.
-			amountSum += uint64(anchoredCommitment.Asset.Amount)
-			if amountSum >= minTotalAmount {
-				// At this point a target min amount was
-				// specified and has been reached.
-				break
+				continue
 			}
+
+			matched = true
+			if _, ok := selected[coinID]; ok {
+				continue
+			}
+
+			selected[coinID] = struct{}{}
+			selectedCommitments = append(selectedCommitments, coin)
+			amountSum += coin.Asset.Amount
 		}
 
-	default:
-		return nil, fmt.Errorf("unknown multi coin selection "+
-			"strategy: %v", strategy)
+		if !matched {
+			return nil, fmt.Errorf("%w: outpoint=%v, asset_id=%v",
+				ErrInputNotEligible, prevID.OutPoint, prevID.ID)
+		}
 	}
 
-	// Having examined all the eligible commitments, return an error if the
-	// minimal funding amount was not reached.
 	if amountSum < minTotalAmount {
-		return nil, ErrMatchingAssetsNotFound
+		return nil, fmt.Errorf("selected inputs hold %d units, but %d "+
+			"are required: %w", amountSum, minTotalAmount,
+			ErrMatchingAssetsNotFound)
 	}
+
 	return selectedCommitments, nil
 }
 
@@ -364,11 +671,31 @@ type WalletConfig struct {
 	// transaction we create.
 	TxValidator tapscript.TxValidator
 
+	// SigningService is an optional external service that virtual packets
+	// are handed to for signing instead of the Signer. If it is nil, the
+	// wallet signs all packets itself.
+	SigningService SigningService
+
 	// Wallet is used to fund+sign PSBTs for the transfer transaction.
 	Wallet WalletAnchor
 
 	// ChainParams is the chain params of the chain we operate on.
 	ChainParams *address.ChainParams
+
+	// ChangePolicy governs how the change of a transfer is handled.
+	ChangePolicy ChangePolicy
+
+	// SplitPolicy governs how assets are split when funding a transfer.
+	SplitPolicy SplitPolicy
+
+	// DustPolicy governs transfers that create anchor outputs that are
+	// uneconomical to spend.
+	DustPolicy AnchorDustPolicy
+
+	// CoinSelectStrategy is the coin selection strategy that is used if a
+	// request doesn't specify one. If it is the default placeholder too,
+	// PreferMaxAmount is used.
+	CoinSelectStrategy MultiCommitmentSelectStrategy
 }
 
 // AssetWallet is an implementation of the Wallet interface that can create
@@ -385,6 +712,23 @@ func NewAssetWallet(cfg *WalletConfig) *AssetWallet {
 	}
 }
 
+// coinSelectStrategy returns the given coin selection strategy, or the one the
+// wallet is configured with if the given one is the default placeholder.
+func (f *AssetWallet) coinSelectStrategy(
+	strategy MultiCommitmentSelectStrategy) MultiCommitmentSelectStrategy {
+
+	switch {
+	case strategy != DefaultSelectStrategy:
+		return strategy
+
+	case f.cfg.CoinSelectStrategy != DefaultSelectStrategy:
+		return f.cfg.CoinSelectStrategy
+
+	default:
+		return PreferMaxAmount
+	}
+}
+
 // FundedVPacket is the result from an attempt to fund a given Taproot Asset
 // address send request via a call to FundAddressSend.
 type FundedVPacket struct {
@@ -398,32 +742,42 @@ type FundedVPacket struct {
 }
 
 // FundAddressSend funds a virtual transaction, selecting assets to spend in
-// order to pay the given address. It also returns supporting data which assists
-// in processing the virtual transaction: passive asset re-anchors and the
-// Taproot Asset level commitment of the selected assets.
+// order to pay the given address. If prevIDs is set, only the given asset
+// inputs are spent instead, otherwise they are selected with the given
+// strategy. It also returns supporting data which assists in processing the
+// virtual transaction: passive asset re-anchors and the Taproot Asset level
+// commitment of the selected assets. The anchor output indexes of the virtual
+// outputs start at the given offset, which allows multiple packets to be
+// anchored in the same transaction.
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
-	receiverAddrs ...*address.Tap) (*FundedVPacket,
-	tappsbt.OutputIdxToAddr, error) {
+	prevIDs []asset.PrevID, strategy MultiCommitmentSelectStrategy,
+	anchorOutputOffset uint32, receiverAddrs ...*address.Tap) (
+	*FundedVPacket, tappsbt.OutputIdxToAddr, error) {
 
 	// We start by creating a new virtual transaction that will be used to
 	// hold the asset transfer. Because sending to an address is always a
 	// non-interactive process, we can use this function that always creates
-	// a change output.
-	vPkt, outputIdxToAddr, err := tappsbt.FromAddresses(receiverAddrs, 1)
+	// a change output. The change output is anchored at the offset, the
+	// recipients right after it.
+	vPkt, outputIdxToAddr, err := tappsbt.FromAddresses(
+		receiverAddrs, anchorOutputOffset+1,
+	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to create virtual "+
 			"transaction from addresses: %w", err)
 	}
+	vPkt.Outputs[0].AnchorOutputIndex = anchorOutputOffset
 
 	fundDesc, err := tapscript.DescribeAddrs(receiverAddrs)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to describe recipients: "+
 			"%w", err)
 	}
+	fundDesc.PrevIDs = prevIDs
 
-	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
+	fundedVPkt, err := f.FundPacket(ctx, fundDesc, strategy, vPkt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -495,10 +849,11 @@ func (f *AssetWallet) passiveAssetVPacket(passiveAsset *asset.Asset,
 }
 
 // FundPacket funds a virtual transaction, selecting assets to spend in order to
-// pay the given recipient. The selected input is then added to the given
-// virtual transaction.
+// pay the given recipient with the given strategy. The selected input is then
+// added to the given virtual transaction.
 func (f *AssetWallet) FundPacket(ctx context.Context,
 	fundDesc *tapscript.FundingDescriptor,
+	strategy MultiCommitmentSelectStrategy,
 	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
 
 	// The input and address networks must match.
@@ -513,9 +868,10 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 		GroupKey: fundDesc.GroupKey,
 		AssetID:  &fundDesc.ID,
 		MinAmt:   fundDesc.Amount,
+		PrevIDs:  fundDesc.PrevIDs,
 	}
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount,
+		ctx, constraints, f.coinSelectStrategy(strategy),
 	)
 	if err != nil {
 		return nil, err
@@ -538,7 +894,7 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 		MinAmt:   fundDesc.Amount,
 	}
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount,
+		ctx, constraints, f.coinSelectStrategy(DefaultSelectStrategy),
 	)
 	if err != nil {
 		return nil, err
@@ -667,6 +1023,163 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 	return fundedPkt, nil
 }
 
+// FundConsolidation funds a virtual transaction that merges the smallest UTXOs
+// of the given asset into a single output to a new script key of the wallet,
+// so that at most the given number of UTXOs of the asset remain.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) FundConsolidation(ctx context.Context, assetID asset.ID,
+	targetCount uint32) (*FundedVPacket, error) {
+
+	selectedCommitments, err := f.cfg.CoinSelector.SelectConsolidationCoins(
+		ctx, assetID, targetCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we return with an error, we want to release the coins we've
+	// selected.
+	success := false
+	defer func() {
+		if !success {
+			outpoints := fn.Map(
+				selectedCommitments,
+				func(c *AnchoredCommitment) wire.OutPoint {
+					return c.AnchorPoint
+				},
+			)
+			err := f.cfg.CoinSelector.ReleaseCoins(
+				ctx, outpoints...,
+			)
+			if err != nil {
+				log.Errorf("Unable to release coins: %v", err)
+			}
+		}
+	}()
+
+	fundedPkt, err := f.fundMergedPacket(
+		ctx, assetID, selectedCommitments, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't release the coins we've selected, as so far we've been
+	// successful.
+	success = true
+	return fundedPkt, nil
+}
+
+// FundSweep funds the virtual transactions that move all UTXOs of the given
+// asset, or of all assets if no asset ID is given, to new script keys of the
+// wallet, one merged output per asset. The packets can be anchored in a single
+// transaction. The IDs of the assets that can only be swept once that
+// transaction confirmed are returned as well.
+func (f *AssetWallet) FundSweep(ctx context.Context,
+	assetID *asset.ID) ([]*FundedVPacket, []asset.ID, error) {
+
+	plan, err := f.cfg.CoinSelector.SelectSweepCoins(ctx, assetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// If we return with an error, we want to release the coins we've
+	// selected.
+	success := false
+	defer func() {
+		if !success {
+			var outpoints []wire.OutPoint
+			for _, coins := range plan.Coins {
+				for _, coin := range coins {
+					outpoints = append(
+						outpoints, coin.AnchorPoint,
+					)
+				}
+			}
+			err := f.cfg.CoinSelector.ReleaseCoins(
+				ctx, outpoints...,
+			)
+			if err != nil {
+				log.Errorf("Unable to release coins: %v", err)
+			}
+		}
+	}()
+
+	// Each packet gets its own anchor outputs, starting after the ones of
+	// the previous packet.
+	var (
+		fundedPkts         = make([]*FundedVPacket, len(plan.AssetIDs))
+		anchorOutputOffset uint32
+	)
+	for idx, sweptID := range plan.AssetIDs {
+		fundedPkts[idx], err = f.fundMergedPacket(
+			ctx, sweptID, plan.Coins[sweptID], anchorOutputOffset,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, vOut := range fundedPkts[idx].VPacket.Outputs {
+			if vOut.AnchorOutputIndex >= anchorOutputOffset {
+				anchorOutputOffset = vOut.AnchorOutputIndex + 1
+			}
+		}
+	}
+
+	// Don't release the coins we've selected, as so far we've been
+	// successful.
+	success = true
+	return fundedPkts, plan.Deferred, nil
+}
+
+// fundMergedPacket funds a virtual transaction that merges the given coins of
+// an asset into a single output to a new script key of the wallet, anchored at
+// the given output index.
+func (f *AssetWallet) fundMergedPacket(ctx context.Context, assetID asset.ID,
+	coins []*AnchoredCommitment,
+	anchorOutputIndex uint32) (*FundedVPacket, error) {
+
+	// The merged output receives the full amount of all coins, in the
+	// highest asset version of any of them.
+	fundDesc := &tapscript.FundingDescriptor{
+		ID: assetID,
+	}
+	var assetVersion asset.Version
+	for _, coin := range coins {
+		fundDesc.Amount += coin.Asset.Amount
+		if coin.Asset.Version > assetVersion {
+			assetVersion = coin.Asset.Version
+		}
+	}
+	if groupKey := coins[0].Asset.GroupKey; groupKey != nil {
+		fundDesc.GroupKey = &groupKey.GroupPubKey
+	}
+
+	scriptKeyDesc, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+	internalKey, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We're sending to ourselves, so this is an interactive full value
+	// send to a BIP-0086 script key, the same we'd use for change.
+	scriptKey := asset.NewScriptKeyBip86(scriptKeyDesc)
+	vPkt := tappsbt.ForInteractiveSend(
+		assetID, fundDesc.Amount, scriptKey, anchorOutputIndex,
+		internalKey, assetVersion, f.cfg.ChainParams,
+	)
+
+	return f.fundPacketWithInputs(ctx, fundDesc, vPkt, coins)
+}
+
 // fundPacketWithInputs funds a virtual transaction with the given inputs.
 func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 	fundDesc *tapscript.FundingDescriptor, vPkt *tappsbt.VPacket,
@@ -720,6 +1233,18 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 		return nil, err
 	}
 
+	// If the change would be below the minimum change amount of our
+	// policy, we add it to the amount sent instead, which turns this into
+	// a full value send.
+	changeAmt := totalInputAmt - fundDesc.Amount
+	if !fullValue && f.cfg.ChangePolicy.absorbChange(vPkt, changeAmt) {
+		log.Debugf("Adding change of %d below minimum change amount "+
+			"to recipient output", changeAmt)
+
+		fundDesc.Amount = totalInputAmt
+		fullValue = true
+	}
+
 	// We want to know if we are sending to ourselves. We detect that by
 	// looking at the key descriptor of the script key. Because that is not
 	// part of addresses and might not be specified by the user through the
@@ -786,8 +1311,9 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 		// Do we need to add a change output?
 		changeOut, err = vPkt.SplitRootOutput()
 		if err != nil {
+			policy := f.cfg.ChangePolicy
 			lastOut := vPkt.Outputs[len(vPkt.Outputs)-1]
-			splitOutIndex := lastOut.AnchorOutputIndex + 1
+			splitOutIndex := policy.changeAnchorIndex(vPkt)
 			changeOut = &tappsbt.VOutput{
 				Type:              tappsbt.TypeSplitRoot,
 				Interactive:       lastOut.Interactive,
@@ -820,9 +1346,10 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 			return nil, fmt.Errorf("cannot determine if script "+
 				"key is spendable: %w", err)
 		}
-		if unSpendable && !fullValue {
+		derivedChangeKey := unSpendable && !fullValue
+		if derivedChangeKey {
 			changeScriptKey, err := f.cfg.KeyRing.DeriveNextKey(
-				ctx, asset.TaprootAssetsKeyFamily,
+				ctx, f.cfg.ChangePolicy.ScriptKeyFamily(),
 			)
 			if err != nil {
 				return nil, err
@@ -858,23 +1385,65 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 			return maxVersion
 		}
 		changeOut.AssetVersion = fn.Reduce(vPkt.Inputs, maxVersion)
+
+		// If our split policy asks for round change amounts, we move
+		// the rest of the change we created ourselves into an
+		// additional output that shares the anchor output of the
+		// change.
+		roundAmt, restAmt, ok := f.cfg.SplitPolicy.roundChange(
+			vPkt, changeOut.Amount,
+		)
+		if derivedChangeKey && ok {
+			restScriptKey, err := f.cfg.KeyRing.DeriveNextKey(
+				ctx, f.cfg.ChangePolicy.ScriptKeyFamily(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			log.Debugf("Splitting change of %d into round amount "+
+				"%d and rest %d", changeOut.Amount, roundAmt,
+				restAmt)
+
+			changeOut.Amount = roundAmt
+			vPkt.Outputs = append(vPkt.Outputs, &tappsbt.VOutput{
+				Type:              tappsbt.TypeSimple,
+				Interactive:       changeOut.Interactive,
+				AnchorOutputIndex: changeOut.AnchorOutputIndex,
+				ScriptKey: asset.NewScriptKeyBip86(
+					restScriptKey,
+				),
+				Amount:       restAmt,
+				AssetVersion: changeOut.AssetVersion,
+			})
+		}
+	}
+
+	if err := f.cfg.SplitPolicy.checkOutputs(vPkt); err != nil {
+		return nil, err
 	}
 
 	// Before we can prepare output assets for our send, we need to generate
 	// a new internal key for the anchor outputs. We assume any output that
 	// hasn't got an internal key set is going to a local anchor, and we
-	// provide the internal key for that.
+	// provide the internal key for that. Outputs that share an anchor
+	// output also need to share its internal key.
+	internalKeys := make(map[uint32]keychain.KeyDescriptor)
 	for idx := range vPkt.Outputs {
 		vOut := vPkt.Outputs[idx]
 		if vOut.AnchorOutputInternalKey != nil {
 			continue
 		}
 
-		newInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
-			ctx, asset.TaprootAssetsKeyFamily,
-		)
-		if err != nil {
-			return nil, err
+		newInternalKey, ok := internalKeys[vOut.AnchorOutputIndex]
+		if !ok {
+			newInternalKey, err = f.cfg.KeyRing.DeriveNextKey(
+				ctx, asset.TaprootAssetsKeyFamily,
+			)
+			if err != nil {
+				return nil, err
+			}
+			internalKeys[vOut.AnchorOutputIndex] = newInternalKey
 		}
 		vOut.SetAnchorInternalKey(
 			newInternalKey, f.cfg.ChainParams.HDCoinType,
@@ -1016,6 +1585,10 @@ func (f *AssetWallet) setVPacketInputs(ctx context.Context,
 type SignVirtualPacketOptions struct {
 	// SkipInputProofVerify skips virtual input proof verification when true.
 	SkipInputProofVerify bool
+
+	// Signer is the signer used to create the asset level witnesses. If
+	// nil, the signer of the wallet is used.
+	Signer tapscript.Signer
 }
 
 // defaultSignVirtualPacketOptions returns the set of default options for the
@@ -1036,9 +1609,18 @@ func SkipInputProofVerify() SignVirtualPacketOption {
 	}
 }
 
+// WithSigner sets the signer SignVirtualPacket uses to create the asset level
+// witnesses, for example to apply signatures created by an external signer.
+func WithSigner(signer tapscript.Signer) SignVirtualPacketOption {
+	return func(o *SignVirtualPacketOptions) {
+		o.Signer = signer
+	}
+}
+
 // SignVirtualPacket signs the virtual transaction of the given packet and
 // returns the input indexes that were signed (referring to the virtual
-// transaction's inputs).
+// transaction's inputs). If the wallet has a signing service and no signer is
+// given as an option, the packet is signed by the service.
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) SignVirtualPacket(vPkt *tappsbt.VPacket,
@@ -1066,15 +1648,35 @@ func (f *AssetWallet) SignVirtualPacket(vPkt *tappsbt.VPacket,
 		}
 	}
 
-	// Now we'll use the signer to sign all the inputs for the new Taproot
-	// Asset leaves. The witness data for each input will be assigned for
-	// us.
-	err := tapscript.SignVirtualTransaction(
-		vPkt, f.cfg.Signer, f.cfg.TxValidator,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to generate Taproot Asset "+
-			"witness data: %w", err)
+	signer := f.cfg.Signer
+	if opts.Signer != nil {
+		signer = opts.Signer
+	}
+
+	// If a signing service is configured, it has the final say over the
+	// packets we'd otherwise sign ourselves. We only proceed with the
+	// witnesses it returns.
+	var err error
+	if opts.Signer == nil && f.cfg.SigningService != nil {
+		err = signWithService(
+			context.Background(), f.cfg.SigningService, vPkt,
+			f.cfg.TxValidator,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign with signing "+
+				"service: %w", err)
+		}
+	} else {
+		// Now we'll use the signer to sign all the inputs for the new
+		// Taproot Asset leaves. The witness data for each input will
+		// be assigned for us.
+		err = tapscript.SignVirtualTransaction(
+			vPkt, signer, f.cfg.TxValidator,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate Taproot "+
+				"Asset witness data: %w", err)
+		}
 	}
 
 	// Mark all inputs as signed.
@@ -1256,6 +1858,32 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 	inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
 	error) {
 
+	passiveAssets, err := f.CreatePassiveAssets(vPkt, inputCommitments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign all the passive assets virtual packets.
+	for idx := range passiveAssets {
+		passiveAsset := passiveAssets[idx]
+		_, err := f.SignVirtualPacket(
+			passiveAsset.VPacket, SkipInputProofVerify(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign passive asset "+
+				"virtual packet: %w", err)
+		}
+	}
+
+	return passiveAssets, nil
+}
+
+// CreatePassiveAssets creates the unsigned passive asset packets for the given
+// virtual packet and input Taproot Asset commitments.
+func (f *AssetWallet) CreatePassiveAssets(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
+	error) {
+
 	// Gather passive assets found in each input Taproot Asset commitment.
 	var passiveAssets []*PassiveAssetReAnchor
 	for inputIdx := range inputCommitments {
@@ -1309,18 +1937,6 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 		}
 	}
 
-	// Sign all the passive assets virtual packets.
-	for idx := range passiveAssets {
-		passiveAsset := passiveAssets[idx]
-		_, err := f.SignVirtualPacket(
-			passiveAsset.VPacket, SkipInputProofVerify(),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign passive asset "+
-				"virtual packet: %w", err)
-		}
-	}
-
 	return passiveAssets, nil
 }
 
@@ -1335,33 +1951,98 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	params *AnchorVTxnsParams) (*AnchorTransaction, error) {
 
-	// We currently only support anchoring a single virtual transaction.
-	//
-	// TODO(guggero): Support merging and anchoring multiple virtual
-	// transactions.
-	if len(params.VPkts) != 1 {
-		return nil, fmt.Errorf("only a single virtual transaction is " +
-			"supported for now")
+	anchorTx, err := f.FundAnchorTransaction(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	signAnchorPkt := anchorTx.FundedPsbt.Pkt
+
+	// The fees are fixed once the transaction is funded, so we can check
+	// them against the budget before anything is signed. The BTC inputs
+	// locked by the wallet are released once their lease expires.
+	err = verifyChainFees(signAnchorPkt, params.MaxChainFees)
+	if err != nil {
+		f.releaseVPktInputs(ctx, params.VPkts)
+		return nil, err
 	}
-	vPacket := params.VPkts[0]
 
-	outputCommitments, err := tapscript.CreateOutputCommitments(
-		params.InputCommitments, vPacket, params.PassiveAssetsVPkts,
+	err = f.cfg.DustPolicy.check(
+		signAnchorPkt, params.VPkts, params.FeeRate,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create new output "+
-			"commitments: %w", err)
+		f.releaseVPktInputs(ctx, params.VPkts)
+		return nil, err
+	}
+
+	finalTx, chainFees, err := f.signAnchorPsbt(ctx, signAnchorPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorTx.FinalTx = finalTx
+	anchorTx.ChainFees = chainFees
+
+	return anchorTx, nil
+}
+
+// FundAnchorTransaction creates and funds the BTC level anchor transaction
+// that anchors all the virtual transactions of the given packets, without
+// signing it. The returned anchor transaction contains the funded PSBT with
+// all the output information intact, but no final transaction.
+//
+// NOTE: The BTC inputs that fund the transaction are locked by the wallet
+// until their lease expires.
+func (f *AssetWallet) FundAnchorTransaction(ctx context.Context,
+	params *AnchorVTxnsParams) (*AnchorTransaction, error) {
+
+	if len(params.VPkts) == 0 {
+		return nil, fmt.Errorf("no virtual transactions to anchor")
+	}
+	if len(params.InputCommitments) != len(params.VPkts) {
+		return nil, fmt.Errorf("expected input commitments for %d "+
+			"virtual transactions, got %d", len(params.VPkts),
+			len(params.InputCommitments))
+	}
+
+	// Every virtual transaction spends its own anchor inputs and creates
+	// its own anchor outputs. Otherwise, the commitments of the virtual
+	// transactions couldn't be created independently of each other.
+	if err := assertAnchorsDisjoint(params.VPkts); err != nil {
+		return nil, err
+	}
+
+	var (
+		allOutputs        []*tappsbt.VOutput
+		outputCommitments = make(
+			[][]*commitment.TapCommitment, len(params.VPkts),
+		)
+		anchorInputValue int64
+	)
+	for idx, vPacket := range params.VPkts {
+		var err error
+		outputCommitments[idx], err = tapscript.CreateOutputCommitments(
+			params.InputCommitments[idx], vPacket,
+			packetPassiveAssets(vPacket, params.PassiveAssetsVPkts),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new output "+
+				"commitments: %w", err)
+		}
+
+		allOutputs = append(allOutputs, vPacket.Outputs...)
+		anchorInputValue += int64(vPacket.Inputs[0].Anchor.Value)
 	}
 
 	// Construct our template PSBT to commits to the set of dummy locators
 	// we use to make fee estimation work.
-	sendPacket, err := tapscript.CreateAnchorTx(vPacket.Outputs)
+	sendPacket, err := tapscript.CreateAnchorTx(allOutputs)
 	if err != nil {
 		return nil, fmt.Errorf("error creating anchor TX: %w", err)
 	}
 
-	anchorPkt, err := f.cfg.Wallet.FundPsbt(
+	anchorPkt, err := f.cfg.Wallet.FundPsbtWithStrategy(
 		ctx, sendPacket, 1, params.FeeRate,
+		f.coinSelectStrategy(params.CoinSelectStrategy),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fund psbt: %w", err)
@@ -1376,7 +2057,7 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	// TODO(jhb): Do we need richer handling for the change output?
 	// We could reassign the change value to our Taproot Asset change output
 	// and remove the change output entirely.
-	adjustFundedPsbt(&anchorPkt, int64(vPacket.Inputs[0].Anchor.Value))
+	adjustFundedPsbt(&anchorPkt, anchorInputValue)
 
 	log.Infof("Received funded PSBT packet")
 	log.Tracef("Packet: %v", spew.Sdump(anchorPkt.Pkt))
@@ -1390,85 +2071,555 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	}
 
 	// First, we'll update the PSBT packets to insert the _real_ outputs we
-	// need to commit to the asset transfer.
-	mergedCommitments, err := tapscript.UpdateTaprootOutputKeys(
-		signAnchorPkt, vPacket, outputCommitments,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error updating taproot output keys: %w",
-			err)
+	// need to commit to the asset transfer. The anchor outputs of the
+	// virtual transactions are disjoint, so we can just collect the merged
+	// commitments of all of them.
+	mergedCommitments := make(map[uint32]*commitment.TapCommitment)
+	for idx, vPacket := range params.VPkts {
+		pktCommitments, err := tapscript.UpdateTaprootOutputKeys(
+			signAnchorPkt, vPacket, outputCommitments[idx],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error updating taproot output "+
+				"keys: %w", err)
+		}
+
+		for anchorIdx, anchorCommitment := range pktCommitments {
+			mergedCommitments[anchorIdx] = anchorCommitment
+		}
 	}
 
 	// Now that all the real outputs are in the PSBT, we'll also
 	// add our anchor inputs as well, since the wallet can sign for
 	// it itself.
 	err = addAnchorPsbtInputs(
-		signAnchorPkt, vPacket, params.FeeRate,
+		signAnchorPkt, params.VPkts, params.FeeRate,
 		f.cfg.ChainParams.Params,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error adding anchor input: %w", err)
 	}
+	params.AnchorLockTime.apply(signAnchorPkt)
 	anchorPkt.Pkt = signAnchorPkt
 
+	return &AnchorTransaction{
+		FundedPsbt:        &anchorPkt,
+		TargetFeeRate:     params.FeeRate,
+		OutputCommitments: mergedCommitments,
+	}, nil
+}
+
+// FinalizeAnchorTransaction verifies and finalizes an anchor transaction that
+// was created with FundAnchorTransaction for the given packets and then signed
+// externally. The virtual transactions of the packets must be signed as well.
+// The anchor transaction may only differ from the funded one in the BTC level
+// inputs and its BTC change output.
+func (f *AssetWallet) FinalizeAnchorTransaction(params *AnchorVTxnsParams,
+	signedPkt *psbt.Packet) (*AnchorTransaction, error) {
+
+	if len(params.VPkts) == 0 {
+		return nil, fmt.Errorf("no virtual transactions to anchor")
+	}
+	if len(params.InputCommitments) != len(params.VPkts) {
+		return nil, fmt.Errorf("expected input commitments for %d "+
+			"virtual transactions, got %d", len(params.VPkts),
+			len(params.InputCommitments))
+	}
+
+	// We only anchor virtual transactions that carry valid witnesses, the
+	// transfer would be invalid otherwise.
+	allPkts := append(
+		append([]*tappsbt.VPacket{}, params.VPkts...),
+		params.PassiveAssetsVPkts...,
+	)
+	for idx, vPkt := range allPkts {
+		err := tapscript.ValidateVirtualTransaction(
+			vPkt, f.cfg.TxValidator,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid virtual transaction "+
+				"%d: %w", idx, err)
+		}
+	}
+
+	// We re-create the anchor outputs from the virtual transactions, so
+	// we know their commitments and can make sure the externally signed
+	// transaction commits to them.
+	var allOutputs []*tappsbt.VOutput
+	for _, vPkt := range params.VPkts {
+		allOutputs = append(allOutputs, vPkt.Outputs...)
+	}
+	templatePkt, err := tapscript.CreateAnchorTx(allOutputs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating anchor TX: %w", err)
+	}
+
+	mergedCommitments := make(map[uint32]*commitment.TapCommitment)
+	for idx, vPkt := range params.VPkts {
+		outputCommitments, err := tapscript.CreateOutputCommitments(
+			params.InputCommitments[idx], vPkt,
+			packetPassiveAssets(vPkt, params.PassiveAssetsVPkts),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new output "+
+				"commitments: %w", err)
+		}
+
+		pktCommitments, err := tapscript.UpdateTaprootOutputKeys(
+			templatePkt, vPkt, outputCommitments,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error updating taproot output "+
+				"keys: %w", err)
+		}
+
+		for anchorIdx, anchorCommitment := range pktCommitments {
+			mergedCommitments[anchorIdx] = anchorCommitment
+		}
+	}
+
+	err = verifyExternalAnchorPsbt(
+		templatePkt, signedPkt, mergedCommitments, params.VPkts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We need the PSBT output information later to create the exclusion
+	// proofs, so we finalize a copy.
+	anchorPkt, err := copyPsbt(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+	for anchorIdx := range mergedCommitments {
+		anchorPkt.Outputs[anchorIdx] = templatePkt.Outputs[anchorIdx]
+	}
+
+	signedTx := anchorPkt.UnsignedTx
+	finalizePkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+	finalTx, chainFees, err := finalizeAnchorPsbt(finalizePkt)
+	if err != nil {
+		return nil, err
+	}
+
+	// The BTC change output is always the last one, if there is one that
+	// doesn't carry any assets.
+	changeIdx := int32(len(signedTx.TxOut) - 1)
+	if _, ok := mergedCommitments[uint32(changeIdx)]; ok {
+		changeIdx = -1
+	}
+
+	return &AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               anchorPkt,
+			ChangeOutputIndex: changeIdx,
+		},
+		FinalTx:           finalTx,
+		TargetFeeRate:     params.FeeRate,
+		ChainFees:         chainFees,
+		OutputCommitments: mergedCommitments,
+	}, nil
+}
+
+// verifyExternalAnchorPsbt makes sure the given externally signed anchor PSBT
+// creates the anchor outputs of the given template PSBT that carry the given
+// commitments and spends all anchor inputs of the given packets. Apart from the
+// anchor outputs, it may only create a single BTC change output after them.
+func verifyExternalAnchorPsbt(templatePkt, signedPkt *psbt.Packet,
+	anchorCommitments map[uint32]*commitment.TapCommitment,
+	vPkts []*tappsbt.VPacket) error {
+
+	signedTx := signedPkt.UnsignedTx
+	templateTx := templatePkt.UnsignedTx
+	for anchorIdx := range anchorCommitments {
+		if int(anchorIdx) >= len(signedTx.TxOut) {
+			return fmt.Errorf("anchor output %d is missing",
+				anchorIdx)
+		}
+
+		txOut, tplOut := signedTx.TxOut[anchorIdx],
+			templateTx.TxOut[anchorIdx]
+		if !bytes.Equal(txOut.PkScript, tplOut.PkScript) ||
+			txOut.Value != tplOut.Value {
+
+			return fmt.Errorf("anchor output %d doesn't match the "+
+				"virtual transactions", anchorIdx)
+		}
+	}
+
+	// Any additional output could be mistaken for the BTC change output,
+	// for example when bumping the fee of the transaction.
+	if len(signedTx.TxOut) > len(templateTx.TxOut)+1 {
+		return fmt.Errorf("expected at most %d outputs, got %d",
+			len(templateTx.TxOut)+1, len(signedTx.TxOut))
+	}
+
+	// The assets are only moved if all their anchor inputs are spent.
+	for _, vPkt := range vPkts {
+		for _, vIn := range vPkt.Inputs {
+			prevOut := vIn.PrevID.OutPoint
+			isPrevOut := func(in *wire.TxIn) bool {
+				return in.PreviousOutPoint == prevOut
+			}
+			if !fn.Any(signedTx.TxIn, isPrevOut) {
+				return fmt.Errorf("anchor input %v isn't spent",
+					prevOut)
+			}
+		}
+	}
+
+	return nil
+}
+
+// verifyChainFees makes sure the chain fees paid by the given funded anchor
+// PSBT don't exceed the given maximum. A maximum of zero means there is no
+// limit.
+func verifyChainFees(pkt *psbt.Packet, maxChainFees btcutil.Amount) error {
+	if maxChainFees == 0 {
+		return nil
+	}
+
+	chainFees, err := tapgarden.GetTxFee(pkt)
+	if err != nil {
+		return fmt.Errorf("unable to get on-chain fees for psbt: %w",
+			err)
+	}
+
+	if btcutil.Amount(chainFees) > maxChainFees {
+		return fmt.Errorf("%w: fees of %v exceed maximum of %v",
+			ErrMaxChainFeesExceeded, btcutil.Amount(chainFees),
+			maxChainFees)
+	}
+
+	return nil
+}
+
+// releaseVPktInputs releases the leases of the asset inputs of the given
+// virtual packets, so they can be selected again.
+func (f *AssetWallet) releaseVPktInputs(ctx context.Context,
+	vPkts []*tappsbt.VPacket) {
+
+	var outpoints []wire.OutPoint
+	for _, vPkt := range vPkts {
+		for _, vIn := range vPkt.Inputs {
+			outpoints = append(outpoints, vIn.PrevID.OutPoint)
+		}
+	}
+
+	err := f.cfg.CoinSelector.ReleaseCoins(ctx, outpoints...)
+	if err != nil {
+		log.Errorf("Unable to release coins: %v", err)
+	}
+}
+
+// signAnchorPsbt asks lnd to sign all inputs of the given anchor PSBT, then
+// finalizes and extracts the final anchor TX. The total amount of sats paid in
+// chain fees is returned as well.
+func (f *AssetWallet) signAnchorPsbt(ctx context.Context,
+	anchorPkt *psbt.Packet) (*wire.MsgTx, int64, error) {
+
 	// With all the input and output information in the packet, we
 	// can now ask lnd to sign it, and then extract the final
 	// version ourselves.
 	log.Debugf("Signing PSBT")
-	log.Tracef("PSBT: %s", spew.Sdump(signAnchorPkt))
-	signedPsbt, err := f.cfg.Wallet.SignPsbt(ctx, signAnchorPkt)
+	log.Tracef("PSBT: %s", spew.Sdump(anchorPkt))
+	signedPsbt, err := f.cfg.Wallet.SignPsbt(ctx, anchorPkt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+		return nil, 0, fmt.Errorf("unable to sign psbt: %w", err)
 	}
 	log.Debugf("Got signed PSBT")
 	log.Tracef("PSBT: %s", spew.Sdump(signedPsbt))
 
+	return finalizeAnchorPsbt(signedPsbt)
+}
+
+// finalizeAnchorPsbt finalizes and extracts the final anchor TX from the given
+// signed anchor PSBT. The total amount of sats paid in chain fees is returned
+// as well.
+func finalizeAnchorPsbt(signedPsbt *psbt.Packet) (*wire.MsgTx, int64, error) {
 	// Before we finalize, we need to calculate the actual, final fees that
 	// we pay.
 	chainFees, err := tapgarden.GetTxFee(signedPsbt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
-			"%w", err)
+		return nil, 0, fmt.Errorf("unable to get on-chain fees for "+
+			"psbt: %w", err)
 	}
 
 	err = psbt.MaybeFinalizeAll(signedPsbt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+		return nil, 0, fmt.Errorf("unable to finalize psbt: %w", err)
 	}
 
 	// Extract the final packet from the PSBT transaction (has all sigs
 	// included).
 	finalTx, err := psbt.Extract(signedPsbt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+		return nil, 0, fmt.Errorf("unable to extract psbt: %w", err)
 	}
 
 	// Final TX sanity check.
 	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
 	if err != nil {
-		return nil, fmt.Errorf("anchor TX failed final checks: %w", err)
+		return nil, 0, fmt.Errorf("anchor TX failed final checks: %w",
+			err)
+	}
+
+	return finalTx, chainFees, nil
+}
+
+// BumpAnchorFee creates and signs a replacement for the anchor transaction of
+// the given pending parcel that pays the given, higher fee rate. The
+// additional fee is deducted from the BTC change output of the anchor
+// transaction, all other outputs remain unchanged.
+func (f *AssetWallet) BumpAnchorFee(ctx context.Context, parcel *OutboundParcel,
+	feeRate chainfee.SatPerKWeight) (*AnchorTransaction, error) {
+
+	if parcel.AnchorPsbt == nil {
+		return nil, ErrNoAnchorPsbt
+	}
+
+	// We don't want to modify the packet of the parcel, so we'll work on a
+	// copy.
+	bumpPkt, err := copyPsbt(parcel.AnchorPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	// Inputs of a counterparty are already finalized when the anchor TX is
+	// completed, their signatures would be invalidated by the bump.
+	for _, pIn := range bumpPkt.Inputs {
+		isFinal := len(pIn.FinalScriptWitness) > 0 ||
+			len(pIn.FinalScriptSig) > 0
+		if isFinal {
+			return nil, ErrCounterpartyInputs
+		}
+	}
+
+	// The weight of the replacement will be the same as the weight of the
+	// already signed anchor TX, apart from small differences in signature
+	// sizes.
+	weight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(parcel.AnchorTx),
+	)
+	err = bumpAnchorPsbtFee(
+		bumpPkt, parcel.Outputs, parcel.ChainFees, weight, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	finalTx, chainFees, err := f.signAnchorPsbt(ctx, bumpPkt)
+	if err != nil {
+		return nil, err
 	}
 
+	changeIdx := int32(len(bumpPkt.UnsignedTx.TxOut) - 1)
 	return &AnchorTransaction{
-		FundedPsbt:        &anchorPkt,
-		FinalTx:           finalTx,
-		TargetFeeRate:     params.FeeRate,
-		ChainFees:         chainFees,
-		OutputCommitments: mergedCommitments,
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               bumpPkt,
+			ChangeOutputIndex: changeIdx,
+		},
+		FinalTx:       finalTx,
+		TargetFeeRate: feeRate,
+		ChainFees:     chainFees,
 	}, nil
 }
 
+// BtcChangeOutputIndex returns the index of the BTC change output of the given
+// anchor TX. The change output is always the last output of an anchor TX, if it
+// isn't used as the anchor of any of the given transfer outputs.
+func BtcChangeOutputIndex(anchorTx *wire.MsgTx,
+	outputs []TransferOutput) (uint32, error) {
+
+	if len(anchorTx.TxOut) == 0 {
+		return 0, ErrNoBtcChangeOutput
+	}
+
+	changeIdx := uint32(len(anchorTx.TxOut) - 1)
+	for idx := range outputs {
+		if outputs[idx].Anchor.OutPoint.Index == changeIdx {
+			return 0, ErrNoBtcChangeOutput
+		}
+	}
+
+	return changeIdx, nil
+}
+
+// bumpAnchorPsbtFee deducts the additional fee required to pay the given fee
+// rate for a transaction of the given weight from the BTC change output of the
+// given anchor PSBT.
+func bumpAnchorPsbtFee(anchorPkt *psbt.Packet, outputs []TransferOutput,
+	currentFee int64, weight int64, feeRate chainfee.SatPerKWeight) error {
+
+	changeIdx, err := BtcChangeOutputIndex(anchorPkt.UnsignedTx, outputs)
+	if err != nil {
+		return err
+	}
+
+	// A replacement transaction must pay for its own relay bandwidth on
+	// top of the absolute fee of the transaction it replaces.
+	newFee := int64(feeRate.FeeForWeight(weight))
+	minFee := currentFee + int64(
+		chainfee.FeePerKwFloor.FeeForWeight(weight),
+	)
+	if newFee < minFee {
+		return fmt.Errorf("fee of %d sats at fee rate %v is below "+
+			"minimum replacement fee of %d sats", newFee,
+			feeRate.FeePerKVByte(), minFee)
+	}
+
+	change := anchorPkt.UnsignedTx.TxOut[changeIdx]
+	feeDelta := newFee - currentFee
+	dustLimit := int64(lnwallet.DustLimitForSize(len(change.PkScript)))
+	if change.Value-feeDelta < dustLimit {
+		return fmt.Errorf("fee increase of %d sats exceeds change "+
+			"amount of %d sats", feeDelta, change.Value)
+	}
+
+	change.Value -= feeDelta
+
+	log.Infof("Bumping anchor TX fee by delta of %v from %d sats to %d "+
+		"sats", feeDelta, currentFee, newFee)
+
+	return nil
+}
+
+// CancelAnchorTx creates and signs a transaction that double spends the BTC
+// inputs of the anchor transaction of the given pending parcel back to its BTC
+// change output, paying the given fee rate. The asset inputs of the anchor
+// transaction aren't spent, so the assets they anchor remain where they are.
+func (f *AssetWallet) CancelAnchorTx(ctx context.Context,
+	parcel *OutboundParcel,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+
+	if parcel.AnchorPsbt == nil {
+		return nil, ErrNoAnchorPsbt
+	}
+
+	cancelPkt, err := cancelAnchorPsbt(parcel, feeRate, f.cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelTx, _, err := f.signAnchorPsbt(ctx, cancelPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelTx, nil
+}
+
+// cancelAnchorPsbt creates a PSBT that spends the BTC wallet inputs of the
+// anchor transaction of the given parcel to its BTC change output. The
+// transaction pays the given fee rate, which must be high enough for it to
+// replace the anchor transaction.
+func cancelAnchorPsbt(parcel *OutboundParcel, feeRate chainfee.SatPerKWeight,
+	params *address.ChainParams) (*psbt.Packet, error) {
+
+	changeIdx, err := BtcChangeOutputIndex(parcel.AnchorTx, parcel.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	assetInputs := make(map[wire.OutPoint]struct{}, len(parcel.Inputs))
+	for _, in := range parcel.Inputs {
+		assetInputs[in.OutPoint] = struct{}{}
+	}
+
+	anchorPkt, err := copyPsbt(parcel.AnchorPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	// Asset anchors always commit to a Taproot Asset root, so an input
+	// without a merkle root is a BTC input funded by the wallet. Inputs
+	// that are already finalized belong to a counterparty, we can't sign
+	// for them.
+	cancelTx := wire.NewMsgTx(anchorPkt.UnsignedTx.Version)
+	var cancelInputs []psbt.PInput
+	for idx, txIn := range anchorPkt.UnsignedTx.TxIn {
+		pIn := anchorPkt.Inputs[idx]
+		_, isAsset := assetInputs[txIn.PreviousOutPoint]
+		isFinal := len(pIn.FinalScriptWitness) > 0 ||
+			len(pIn.FinalScriptSig) > 0
+		if isAsset || isFinal || len(pIn.TaprootMerkleRoot) > 0 {
+			continue
+		}
+
+		cancelTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: txIn.PreviousOutPoint,
+			Sequence:         txIn.Sequence,
+		})
+		cancelInputs = append(cancelInputs, pIn)
+	}
+	if len(cancelInputs) == 0 {
+		return nil, ErrNoWalletInputs
+	}
+
+	var inputAmt int64
+	for _, pIn := range cancelInputs {
+		inputAmt += pIn.WitnessUtxo.Value
+	}
+
+	change := anchorPkt.UnsignedTx.TxOut[changeIdx]
+	cancelTx.AddTxOut(&wire.TxOut{
+		Value:    inputAmt,
+		PkScript: change.PkScript,
+	})
+
+	cancelPkt, err := psbt.NewFromUnsignedTx(cancelTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create PSBT: %w", err)
+	}
+	cancelPkt.Inputs = cancelInputs
+	cancelPkt.Outputs[0] = anchorPkt.Outputs[changeIdx]
+
+	weight, err := estimateAnchorPsbtWeight(cancelPkt, params.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Just like any other replacement, the cancel transaction must pay
+	// for its own relay bandwidth on top of the absolute fee of the
+	// transaction it replaces.
+	newFee := int64(feeRate.FeeForWeight(weight))
+	minFee := parcel.ChainFees + int64(
+		chainfee.FeePerKwFloor.FeeForWeight(weight),
+	)
+	if newFee < minFee {
+		return nil, fmt.Errorf("fee of %d sats at fee rate %v is "+
+			"below minimum replacement fee of %d sats", newFee,
+			feeRate.FeePerKVByte(), minFee)
+	}
+
+	dustLimit := int64(lnwallet.DustLimitForSize(len(change.PkScript)))
+	if inputAmt-newFee < dustLimit {
+		return nil, fmt.Errorf("fee of %d sats exceeds wallet input "+
+			"amount of %d sats", newFee, inputAmt)
+	}
+	cancelTx.TxOut[0].Value = inputAmt - newFee
+
+	log.Infof("Cancelling anchor TX %v by spending %d wallet inputs at "+
+		"fee of %d sats", parcel.AnchorTx.TxHash(), len(cancelInputs),
+		newFee)
+
+	return cancelPkt, nil
+}
+
 // SignOwnershipProof creates and signs an ownership proof for the given owned
 // asset. The ownership proof consists of a signed virtual packet that spends
-// the asset fully to the NUMS key.
-func (f *AssetWallet) SignOwnershipProof(
-	ownedAsset *asset.Asset) (wire.TxWitness, error) {
+// the asset fully to the NUMS key. The witness commits to the given challenge.
+func (f *AssetWallet) SignOwnershipProof(ownedAsset *asset.Asset,
+	challenge [32]byte) (wire.TxWitness, error) {
 
 	outputAsset := ownedAsset.Copy()
 	log.Infof("Generating ownership proof for asset %v", outputAsset.ID())
 
 	vPkt := tappsbt.OwnershipProofPacket(
-		ownedAsset.Copy(), f.cfg.ChainParams,
+		ownedAsset.Copy(), challenge, f.cfg.ChainParams,
 	)
 	err := tapscript.SignVirtualTransaction(
 		vPkt, f.cfg.Signer, f.cfg.TxValidator,
@@ -1610,16 +2761,32 @@ func adjustFundedPsbt(fPkt *tapgarden.FundedPsbt, anchorInputValue int64) {
 	fPkt.ChangeOutputIndex = int32(maxOutputIndex)
 }
 
-// addAnchorPsbtInputs adds anchor information from all inputs to the PSBT
-// packet. This is called after the PSBT has been funded, but before signing.
-func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
+// addAnchorPsbtInputs adds anchor information from all inputs of the given
+// packets to the PSBT packet. This is called after the PSBT has been funded,
+// but before signing.
+func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkts []*tappsbt.VPacket,
 	feeRate chainfee.SatPerKWeight, params *chaincfg.Params) error {
 
-	for idx := range vPkt.Inputs {
+	var vInputs []*tappsbt.VInput
+	for _, vPkt := range vPkts {
+		vInputs = append(vInputs, vPkt.Inputs...)
+	}
+	appendAnchorInputs(btcPkt, vInputs)
+
+	// Now that we've added an extra input, we'll want to re-calculate the
+	// total weight of the transaction, so we can ensure we're paying
+	// enough in fees.
+	return adjustChangeForFee(btcPkt, feeRate, params)
+}
+
+// appendAnchorInputs adds the anchor information of the given virtual inputs
+// as inputs to the PSBT packet.
+func appendAnchorInputs(btcPkt *psbt.Packet, vInputs []*tappsbt.VInput) {
+	for idx := range vInputs {
 		// With the BIP-0032 information completed, we'll now add the
 		// information as a partial input and also add the input to the
 		// unsigned transaction.
-		vIn := vPkt.Inputs[idx]
+		vIn := vInputs[idx]
 		btcPkt.Inputs = append(btcPkt.Inputs, psbt.PInput{
 			WitnessUtxo: &wire.TxOut{
 				Value:    int64(vIn.Anchor.Value),
@@ -1639,17 +2806,63 @@ func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 			},
 		)
 	}
+}
 
-	// Now that we've added an extra input, we'll want to re-calculate the
-	// total weight of the transaction, so we can ensure we're paying
-	// enough in fees.
-	var (
-		weightEstimator     input.TxWeightEstimator
-		inputAmt, outputAmt int64
-	)
+// adjustChangeForFee re-calculates the total weight of the given PSBT and
+// adjusts its change output, which must be the last output, so the
+// transaction pays the given fee rate.
+func adjustChangeForFee(btcPkt *psbt.Packet, feeRate chainfee.SatPerKWeight,
+	params *chaincfg.Params) error {
+
+	var inputAmt, outputAmt int64
 	for _, pIn := range btcPkt.Inputs {
 		inputAmt += pIn.WitnessUtxo.Value
+	}
+	for _, txOut := range btcPkt.UnsignedTx.TxOut {
+		outputAmt += txOut.Value
+	}
+
+	// With this, we can now calculate the total fee we need to pay. We'll
+	// also make sure to round up the required fee to the floor.
+	totalWeight, err := estimateAnchorPsbtWeight(btcPkt, params)
+	if err != nil {
+		return err
+	}
+	requiredFee := feeRate.FeeForWeight(totalWeight)
+
+	// Given the current fee (which doesn't account for our input) and the
+	// total fee we want to pay, we'll adjust the wallet's change output
+	// accordingly.
+	//
+	// Earlier in adjustFundedPsbt we set wallet's change output to be the
+	// very last output in the transaction.
+	lastIdx := len(btcPkt.UnsignedTx.TxOut) - 1
+	currentFee := inputAmt - outputAmt
+	feeDelta := int64(requiredFee) - currentFee
+	changeValue := btcPkt.UnsignedTx.TxOut[lastIdx].Value
+
+	// The fee may exceed the total value of the change output, which means
+	// this spend is impossible with the given inputs and fee rate.
+	if changeValue-feeDelta < 0 {
+		return fmt.Errorf("fee of %d sats exceeds change amount of %d"+
+			"sats", requiredFee, changeValue)
+	}
 
+	btcPkt.UnsignedTx.TxOut[lastIdx].Value -= feeDelta
+
+	log.Infof("Adjusting send pkt by delta of %v from %d sats to %d sats",
+		feeDelta, currentFee, requiredFee)
+
+	return nil
+}
+
+// estimateAnchorPsbtWeight estimates the weight of the given PSBT once all of
+// its inputs are signed.
+func estimateAnchorPsbtWeight(btcPkt *psbt.Packet,
+	params *chaincfg.Params) (int64, error) {
+
+	var weightEstimator input.TxWeightEstimator
+	for _, pIn := range btcPkt.Inputs {
 		inputPkScript := pIn.WitnessUtxo.PkScript
 		switch {
 		case txscript.IsPayToWitnessPubKeyHash(inputPkScript):
@@ -1663,18 +2876,16 @@ func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 				txscript.SigHashDefault,
 			)
 		default:
-			return fmt.Errorf("unknown pkScript: %x",
+			return 0, fmt.Errorf("unknown pkScript: %x",
 				inputPkScript)
 		}
 	}
 	for _, txOut := range btcPkt.UnsignedTx.TxOut {
-		outputAmt += txOut.Value
-
 		addrType, _, _, err := txscript.ExtractPkScriptAddrs(
 			txOut.PkScript, params,
 		)
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		switch addrType {
@@ -1687,40 +2898,68 @@ func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 		case txscript.WitnessV1TaprootTy:
 			weightEstimator.AddP2TROutput()
 		default:
-			return fmt.Errorf("unknwon pkscript: %x",
+			return 0, fmt.Errorf("unknwon pkscript: %x",
 				txOut.PkScript)
 		}
 	}
 
-	// With this, we can now calculate the total fee we need to pay. We'll
-	// also make sure to round up the required fee to the floor.
-	totalWeight := int64(weightEstimator.Weight())
-	requiredFee := feeRate.FeeForWeight(totalWeight)
+	return int64(weightEstimator.Weight()), nil
+}
 
-	// Given the current fee (which doesn't account for our input) and the
-	// total fee we want to pay, we'll adjust the wallet's change output
-	// accordingly.
-	//
-	// Earlier in adjustFundedPsbt we set wallet's change output to be the
-	// very last output in the transaction.
-	lastIdx := len(btcPkt.UnsignedTx.TxOut) - 1
-	currentFee := inputAmt - outputAmt
-	feeDelta := int64(requiredFee) - currentFee
-	changeValue := btcPkt.UnsignedTx.TxOut[lastIdx].Value
+// assertAnchorsDisjoint makes sure that no two of the given virtual packets
+// spend the same anchor input or commit to the same anchor output.
+func assertAnchorsDisjoint(vPkts []*tappsbt.VPacket) error {
+	var (
+		inputOwner  = make(map[wire.OutPoint]int)
+		outputOwner = make(map[uint32]int)
+	)
+	for pktIdx, vPkt := range vPkts {
+		for _, vIn := range vPkt.Inputs {
+			outPoint := vIn.PrevID.OutPoint
+			owner, ok := inputOwner[outPoint]
+			if ok && owner != pktIdx {
+				return fmt.Errorf("anchor input %v is spent "+
+					"by virtual packets %d and %d",
+					outPoint, owner, pktIdx)
+			}
+			inputOwner[outPoint] = pktIdx
+		}
 
-	// The fee may exceed the total value of the change output, which means
-	// this spend is impossible with the given inputs and fee rate.
-	if changeValue-feeDelta < 0 {
-		return fmt.Errorf("fee of %d sats exceeds change amount of %d"+
-			"sats", requiredFee, changeValue)
+		for _, vOut := range vPkt.Outputs {
+			anchorIdx := vOut.AnchorOutputIndex
+			owner, ok := outputOwner[anchorIdx]
+			if ok && owner != pktIdx {
+				return fmt.Errorf("anchor output %d is used "+
+					"by virtual packets %d and %d",
+					anchorIdx, owner, pktIdx)
+			}
+			outputOwner[anchorIdx] = pktIdx
+		}
 	}
 
-	btcPkt.UnsignedTx.TxOut[lastIdx].Value -= feeDelta
+	return nil
+}
 
-	log.Infof("Adjusting send pkt by delta of %v from %d sats to %d sats",
-		feeDelta, currentFee, requiredFee)
+// packetPassiveAssets returns the passive asset packets that re-anchor assets
+// from one of the anchor inputs of the given virtual packet.
+func packetPassiveAssets(vPkt *tappsbt.VPacket,
+	passivePkts []*tappsbt.VPacket) []*tappsbt.VPacket {
 
-	return nil
+	return fn.Filter(passivePkts, func(passivePkt *tappsbt.VPacket) bool {
+		return spendsAnchor(vPkt, passivePkt.Inputs[0].PrevID.OutPoint)
+	})
+}
+
+// spendsAnchor returns true if any of the inputs of the given virtual packet
+// spends the given anchor outpoint.
+func spendsAnchor(vPkt *tappsbt.VPacket, anchorPoint wire.OutPoint) bool {
+	for _, vIn := range vPkt.Inputs {
+		if vIn.PrevID.OutPoint == anchorPoint {
+			return true
+		}
+	}
+
+	return false
 }
 
 // copyPsbt creates a deep copy of a PSBT packet by serializing and
@@ -5,8 +5,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
 )
 
@@ -130,6 +138,182 @@ func TestCoinSelection(t *testing.T) {
 				},
 			},
 		},
+
+		// Test that when the PreferMinAmount strategy is employed
+		// the smallest commitments are selected first.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 510,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 490,
+					},
+				},
+			},
+			strategy:                 PreferMinAmount,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 490,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 510,
+					},
+				},
+			},
+		},
+
+		// Test that when the PreferFewestInputs strategy is employed
+		// the smallest single commitment that covers the amount is
+		// selected.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 510,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 1200,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 490,
+					},
+				},
+			},
+			strategy:                 PreferFewestInputs,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 1200,
+					},
+				},
+			},
+		},
+
+		// Test that the PreferFewestInputs strategy falls back to
+		// selecting the largest commitments first if no single
+		// commitment covers the amount.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 980,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 999,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 10,
+					},
+				},
+			},
+			strategy:                 PreferFewestInputs,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 999,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 980,
+					},
+				},
+			},
+		},
+
+		// Test that when the PreferNoMerge strategy is employed
+		// the smallest single commitment that covers the amount is
+		// selected.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 510,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 2000,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 1200,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 490,
+					},
+				},
+			},
+			strategy:                 PreferNoMerge,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 1200,
+					},
+				},
+			},
+		},
+
+		// Test that the PreferNoMerge strategy fails if no single
+		// commitment covers the amount.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				{
+					Asset: &asset.Asset{
+						Amount: 980,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 999,
+					},
+				},
+				{
+					Asset: &asset.Asset{
+						Amount: 10,
+					},
+				},
+			},
+			strategy:        PreferNoMerge,
+			expectedSomeErr: true,
+		},
 	}
 
 	// Execute test cases.
@@ -163,3 +347,545 @@ func TestCoinSelection(t *testing.T) {
 		_ = idx
 	}
 }
+
+// TestCoinSelectionPrevIDs tests that coin selection only selects the given
+// inputs if the constraints specify them.
+func TestCoinSelectionPrevIDs(t *testing.T) {
+	t.Parallel()
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	newCoin := func(anchorPoint wire.OutPoint,
+		amount uint64) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint: anchorPoint,
+			Asset: asset.NewAssetNoErr(
+				t, genesis, amount, 0, 0,
+				asset.RandScriptKey(t), nil,
+			),
+		}
+	}
+
+	var (
+		firstOutPoint  = test.RandOp(t)
+		secondOutPoint = test.RandOp(t)
+		firstCoin      = newCoin(firstOutPoint, 100)
+		secondCoin     = newCoin(secondOutPoint, 200)
+		thirdCoin      = newCoin(secondOutPoint, 300)
+		assetID        = genesis.ID()
+	)
+	coinSelect := NewCoinSelect(&mockCoinLister{
+		eligibleCommitments: []*AnchoredCommitment{
+			firstCoin, secondCoin, thirdCoin,
+		},
+	})
+
+	selectCoins := func(minAmt uint64,
+		prevIDs ...asset.PrevID) ([]*AnchoredCommitment, error) {
+
+		return coinSelect.SelectCoins(
+			context.Background(), CommitmentConstraints{
+				AssetID: &assetID,
+				MinAmt:  minAmt,
+				PrevIDs: prevIDs,
+			}, PreferMaxAmount,
+		)
+	}
+
+	// An input with an asset ID and script key selects a single coin, even
+	// if another coin would cover the amount on its own.
+	firstPrevID := asset.PrevID{
+		OutPoint:  firstOutPoint,
+		ID:        assetID,
+		ScriptKey: asset.ToSerialized(firstCoin.Asset.ScriptKey.PubKey),
+	}
+	coins, err := selectCoins(50, firstPrevID)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{firstCoin}, coins)
+
+	// An input with only an outpoint selects all coins of that outpoint,
+	// and selecting the same coin twice doesn't count it twice.
+	coins, err = selectCoins(
+		600, firstPrevID, asset.PrevID{OutPoint: secondOutPoint},
+		asset.PrevID{OutPoint: firstOutPoint},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, []*AnchoredCommitment{firstCoin, secondCoin, thirdCoin},
+		coins,
+	)
+
+	// The selected inputs must cover the amount.
+	_, err = selectCoins(200, firstPrevID)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+
+	// An input that isn't an eligible coin is rejected.
+	_, err = selectCoins(50, asset.PrevID{OutPoint: test.RandOp(t)})
+	require.ErrorIs(t, err, ErrInputNotEligible)
+
+	firstPrevID.ScriptKey = asset.ToSerialized(test.RandPubKey(t))
+	_, err = selectCoins(50, firstPrevID)
+	require.ErrorIs(t, err, ErrInputNotEligible)
+}
+
+// TestBumpAnchorPsbtFee tests that the additional fee of a replacement anchor
+// TX is deducted from the BTC change output correctly.
+func TestBumpAnchorPsbtFee(t *testing.T) {
+	t.Parallel()
+
+	const (
+		changeValue = 10_000
+		currentFee  = 500
+		weight      = 800
+	)
+
+	p2trScript := func() []byte {
+		return append(
+			[]byte{txscript.OP_1, txscript.OP_DATA_32},
+			test.RandBytes(32)...,
+		)
+	}
+	newPacket := func() *psbt.Packet {
+		pkt, err := psbt.New(
+			[]*wire.OutPoint{fn.Ptr(test.RandOp(t))},
+			[]*wire.TxOut{{
+				Value:    1_000,
+				PkScript: p2trScript(),
+			}, {
+				Value:    changeValue,
+				PkScript: p2trScript(),
+			}}, 2, 0, []uint32{0},
+		)
+		require.NoError(t, err)
+
+		return pkt
+	}
+	anchorOutputs := []TransferOutput{{
+		Anchor: Anchor{
+			OutPoint: wire.OutPoint{Index: 0},
+		},
+	}}
+
+	// A higher fee rate that also pays for the relay of the replacement is
+	// deducted from the change output.
+	pkt := newPacket()
+	err := bumpAnchorPsbtFee(pkt, anchorOutputs, currentFee, weight, 1_000)
+	require.NoError(t, err)
+	require.EqualValues(
+		t, changeValue-(800-currentFee), pkt.UnsignedTx.TxOut[1].Value,
+	)
+	require.EqualValues(t, 1_000, pkt.UnsignedTx.TxOut[0].Value)
+
+	// A fee rate that doesn't pay for the relay of the replacement is
+	// rejected.
+	pkt = newPacket()
+	err = bumpAnchorPsbtFee(pkt, anchorOutputs, currentFee, weight, 700)
+	require.ErrorContains(t, err, "below minimum replacement fee")
+
+	// The change output must not become dust.
+	err = bumpAnchorPsbtFee(pkt, anchorOutputs, currentFee, weight, 13_000)
+	require.ErrorContains(t, err, "exceeds change amount")
+	require.EqualValues(t, changeValue, pkt.UnsignedTx.TxOut[1].Value)
+
+	// If the last output anchors assets, there is no change output to
+	// deduct the fee from.
+	anchorOutputs = append(anchorOutputs, TransferOutput{
+		Anchor: Anchor{
+			OutPoint: wire.OutPoint{Index: 1},
+		},
+	})
+	err = bumpAnchorPsbtFee(pkt, anchorOutputs, currentFee, weight, 1_000)
+	require.ErrorIs(t, err, ErrNoBtcChangeOutput)
+}
+
+// TestCancelAnchorPsbt tests that the transaction cancelling an anchor TX only
+// spends the BTC wallet inputs of the anchor TX back to its change output.
+func TestCancelAnchorPsbt(t *testing.T) {
+	t.Parallel()
+
+	p2trScript := func() []byte {
+		return append(
+			[]byte{txscript.OP_1, txscript.OP_DATA_32},
+			test.RandBytes(32)...,
+		)
+	}
+
+	assetInput := test.RandOp(t)
+	walletInput := test.RandOp(t)
+	changeScript := p2trScript()
+	anchorPkt, err := psbt.New(
+		[]*wire.OutPoint{&assetInput, &walletInput},
+		[]*wire.TxOut{{
+			Value:    1_000,
+			PkScript: p2trScript(),
+		}, {
+			Value:    10_500,
+			PkScript: changeScript,
+		}}, 2, 0, []uint32{0, 0},
+	)
+	require.NoError(t, err)
+	anchorPkt.Inputs[0] = psbt.PInput{
+		WitnessUtxo: &wire.TxOut{
+			Value:    1_000,
+			PkScript: p2trScript(),
+		},
+		TaprootMerkleRoot: test.RandBytes(32),
+	}
+	anchorPkt.Inputs[1] = psbt.PInput{
+		WitnessUtxo: &wire.TxOut{
+			Value:    11_000,
+			PkScript: p2trScript(),
+		},
+	}
+
+	parcel := &OutboundParcel{
+		AnchorTx:   anchorPkt.UnsignedTx,
+		AnchorPsbt: anchorPkt,
+		ChainFees:  500,
+		Inputs: []TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetInput,
+			},
+		}},
+		Outputs: []TransferOutput{{
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{Index: 0},
+			},
+		}},
+	}
+	params := &address.RegressionNetTap
+
+	// Only the wallet input is spent, to the change output.
+	cancelPkt, err := cancelAnchorPsbt(parcel, 5_000, params)
+	require.NoError(t, err)
+
+	cancelTx := cancelPkt.UnsignedTx
+	require.Len(t, cancelTx.TxIn, 1)
+	require.Equal(t, walletInput, cancelTx.TxIn[0].PreviousOutPoint)
+	require.Len(t, cancelPkt.Inputs, 1)
+	require.Equal(t, anchorPkt.Inputs[1], cancelPkt.Inputs[0])
+	require.Len(t, cancelTx.TxOut, 1)
+	require.Equal(t, changeScript, cancelTx.TxOut[0].PkScript)
+
+	weight, err := estimateAnchorPsbtWeight(cancelPkt, params.Params)
+	require.NoError(t, err)
+	fee := int64(chainfee.SatPerKWeight(5_000).FeeForWeight(weight))
+	require.EqualValues(t, 11_000-fee, cancelTx.TxOut[0].Value)
+
+	// The anchor PSBT of the parcel isn't modified.
+	require.Len(t, anchorPkt.UnsignedTx.TxIn, 2)
+	require.EqualValues(t, 10_500, anchorPkt.UnsignedTx.TxOut[1].Value)
+
+	// A fee rate that doesn't pay for the relay of the double spend is
+	// rejected.
+	_, err = cancelAnchorPsbt(parcel, 1_000, params)
+	require.ErrorContains(t, err, "below minimum replacement fee")
+
+	// The fee can't exceed the value of the wallet inputs.
+	_, err = cancelAnchorPsbt(parcel, 100_000, params)
+	require.ErrorContains(t, err, "exceeds wallet input amount")
+
+	// Without any wallet inputs, the anchor TX can't be cancelled without
+	// spending the asset inputs.
+	parcel.Inputs = append(parcel.Inputs, TransferInput{
+		PrevID: asset.PrevID{
+			OutPoint: walletInput,
+		},
+	})
+	_, err = cancelAnchorPsbt(parcel, 5_000, params)
+	require.ErrorIs(t, err, ErrNoWalletInputs)
+}
+
+// TestVerifyChainFees tests that the chain fees of a funded anchor PSBT are
+// only accepted if they don't exceed the maximum.
+func TestVerifyChainFees(t *testing.T) {
+	t.Parallel()
+
+	pkt, err := psbt.New(
+		[]*wire.OutPoint{fn.Ptr(test.RandOp(t))},
+		[]*wire.TxOut{{
+			Value:    9_000,
+			PkScript: test.RandBytes(34),
+		}}, 2, 0, []uint32{0},
+	)
+	require.NoError(t, err)
+	pkt.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    10_000,
+		PkScript: test.RandBytes(34),
+	}
+
+	// Without a maximum, any fee is accepted.
+	require.NoError(t, verifyChainFees(pkt, 0))
+
+	require.NoError(t, verifyChainFees(pkt, 1_000))
+
+	err = verifyChainFees(pkt, 999)
+	require.ErrorIs(t, err, ErrMaxChainFeesExceeded)
+
+	// The fees can't be determined without the UTXO information.
+	pkt.Inputs[0].WitnessUtxo = nil
+	require.ErrorContains(
+		t, verifyChainFees(pkt, 1_000), "unable to get on-chain fees",
+	)
+}
+
+// TestAnchorLockTime tests that the lock time and sequence of an anchor TX are
+// applied such that the lock time is enforced.
+func TestAnchorLockTime(t *testing.T) {
+	t.Parallel()
+
+	newPkt := func() *psbt.Packet {
+		pkt, err := psbt.New(
+			[]*wire.OutPoint{
+				fn.Ptr(test.RandOp(t)), fn.Ptr(test.RandOp(t)),
+			}, []*wire.TxOut{{
+				Value:    1_000,
+				PkScript: test.RandBytes(34),
+			}}, 2, 0, []uint32{wire.MaxTxInSequenceNum, 10},
+		)
+		require.NoError(t, err)
+
+		return pkt
+	}
+
+	// Without a lock time or sequence, the wallet's choice is kept.
+	pkt := newPkt()
+	AnchorLockTime{}.apply(pkt)
+	require.Zero(t, pkt.UnsignedTx.LockTime)
+	require.EqualValues(
+		t, wire.MaxTxInSequenceNum, pkt.UnsignedTx.TxIn[0].Sequence,
+	)
+	require.EqualValues(t, 10, pkt.UnsignedTx.TxIn[1].Sequence)
+
+	// A lock time makes final inputs non-final.
+	pkt = newPkt()
+	AnchorLockTime{LockTime: 800_000}.apply(pkt)
+	require.EqualValues(t, 800_000, pkt.UnsignedTx.LockTime)
+	require.EqualValues(
+		t, wire.MaxTxInSequenceNum-1, pkt.UnsignedTx.TxIn[0].Sequence,
+	)
+	require.EqualValues(t, 10, pkt.UnsignedTx.TxIn[1].Sequence)
+
+	// An explicit sequence is set on all inputs.
+	pkt = newPkt()
+	AnchorLockTime{LockTime: 800_000, Sequence: 144}.apply(pkt)
+	for _, txIn := range pkt.UnsignedTx.TxIn {
+		require.EqualValues(t, 144, txIn.Sequence)
+	}
+
+	// A final sequence would disable the lock time.
+	require.ErrorContains(t, AnchorLockTime{
+		LockTime: 800_000,
+		Sequence: wire.MaxTxInSequenceNum,
+	}.Validate(), "is disabled")
+	require.NoError(t, AnchorLockTime{Sequence: 144}.Validate())
+}
+
+// TestBtcChangeOutputIndex tests that the BTC change output of an anchor TX is
+// only found if the last output doesn't anchor any assets.
+func TestBtcChangeOutputIndex(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorOutputs := []TransferOutput{{
+		Anchor: Anchor{
+			OutPoint: wire.OutPoint{Index: 0},
+		},
+	}}
+
+	// An anchor TX without outputs has no change output.
+	_, err := BtcChangeOutputIndex(anchorTx, nil)
+	require.ErrorIs(t, err, ErrNoBtcChangeOutput)
+
+	// If the only output anchors assets, there is no change output.
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1_000})
+	_, err = BtcChangeOutputIndex(anchorTx, anchorOutputs)
+	require.ErrorIs(t, err, ErrNoBtcChangeOutput)
+
+	// Otherwise, the last output is the change output.
+	anchorTx.AddTxOut(&wire.TxOut{Value: 10_000})
+	changeIdx, err := BtcChangeOutputIndex(anchorTx, anchorOutputs)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, changeIdx)
+}
+
+// TestAssertAnchorsDisjoint tests that virtual packets anchored in the same
+// transaction must not share anchor inputs or outputs.
+func TestAssertAnchorsDisjoint(t *testing.T) {
+	t.Parallel()
+
+	newPkt := func(outPoint wire.OutPoint,
+		anchorIdxs ...uint32) *tappsbt.VPacket {
+
+		vPkt := &tappsbt.VPacket{
+			Inputs: []*tappsbt.VInput{{
+				PrevID: asset.PrevID{OutPoint: outPoint},
+			}},
+		}
+		for _, anchorIdx := range anchorIdxs {
+			vPkt.Outputs = append(vPkt.Outputs, &tappsbt.VOutput{
+				AnchorOutputIndex: anchorIdx,
+			})
+		}
+
+		return vPkt
+	}
+
+	firstPoint := test.RandOp(t)
+	secondPoint := test.RandOp(t)
+
+	// Outputs of the same packet may share an anchor output.
+	err := assertAnchorsDisjoint([]*tappsbt.VPacket{
+		newPkt(firstPoint, 0, 1, 1), newPkt(secondPoint, 2, 3),
+	})
+	require.NoError(t, err)
+
+	err = assertAnchorsDisjoint([]*tappsbt.VPacket{
+		newPkt(firstPoint, 0, 1), newPkt(firstPoint, 2, 3),
+	})
+	require.ErrorContains(t, err, "anchor input")
+
+	err = assertAnchorsDisjoint([]*tappsbt.VPacket{
+		newPkt(firstPoint, 0, 1), newPkt(secondPoint, 1, 2),
+	})
+	require.ErrorContains(t, err, "anchor output 1")
+
+	// Passive assets belong to the packet that spends their anchor.
+	firstPkt := newPkt(firstPoint, 0, 1)
+	passivePkts := []*tappsbt.VPacket{
+		newPkt(secondPoint, 2), newPkt(firstPoint, 0),
+	}
+	require.Equal(
+		t, passivePkts[1:], packetPassiveAssets(firstPkt, passivePkts),
+	)
+}
+
+// TestVerifyExternalAnchorPsbt tests that externally signed anchor PSBTs are
+// only accepted if they create the expected anchor outputs and spend all anchor
+// inputs.
+func TestVerifyExternalAnchorPsbt(t *testing.T) {
+	t.Parallel()
+
+	// The template creates two anchor outputs for a packet that spends a
+	// single anchor input.
+	anchorInput := test.RandOp(t)
+	vPkt := &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{{
+			PrevID: asset.PrevID{OutPoint: anchorInput},
+		}},
+	}
+	templateTx := wire.NewMsgTx(2)
+	for i := 0; i < 2; i++ {
+		templateTx.AddTxOut(&wire.TxOut{
+			Value:    1000,
+			PkScript: test.RandBytes(34),
+		})
+	}
+	templatePkt, err := psbt.NewFromUnsignedTx(templateTx)
+	require.NoError(t, err)
+
+	anchorCommitments := map[uint32]*commitment.TapCommitment{
+		0: nil,
+		1: nil,
+	}
+
+	// The signed transaction additionally spends a BTC input and creates
+	// a BTC change output.
+	newSignedPkt := func() *psbt.Packet {
+		signedTx := templateTx.Copy()
+		signedTx.AddTxIn(&wire.TxIn{PreviousOutPoint: anchorInput})
+		signedTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+		signedTx.AddTxOut(&wire.TxOut{
+			Value:    50_000,
+			PkScript: test.RandBytes(34),
+		})
+
+		signedPkt, err := psbt.NewFromUnsignedTx(signedTx)
+		require.NoError(t, err)
+
+		return signedPkt
+	}
+
+	testCases := []struct {
+		name   string
+		tamper func(tx *wire.MsgTx)
+		errStr string
+	}{{
+		name:   "valid",
+		tamper: func(*wire.MsgTx) {},
+	}, {
+		name: "no change output",
+		tamper: func(tx *wire.MsgTx) {
+			tx.TxOut = tx.TxOut[:2]
+		},
+	}, {
+		name: "additional BTC input",
+		tamper: func(tx *wire.MsgTx) {
+			tx.AddTxIn(&wire.TxIn{
+				PreviousOutPoint: test.RandOp(t),
+			})
+		},
+	}, {
+		name: "altered anchor output script",
+		tamper: func(tx *wire.MsgTx) {
+			tx.TxOut[1].PkScript = test.RandBytes(34)
+		},
+		errStr: "anchor output 1 doesn't match",
+	}, {
+		name: "altered anchor output value",
+		tamper: func(tx *wire.MsgTx) {
+			tx.TxOut[0].Value -= 500
+			tx.TxOut[2].Value += 500
+		},
+		errStr: "anchor output 0 doesn't match",
+	}, {
+		name: "swapped anchor outputs",
+		tamper: func(tx *wire.MsgTx) {
+			tx.TxOut[0], tx.TxOut[1] = tx.TxOut[1], tx.TxOut[0]
+		},
+		errStr: "doesn't match",
+	}, {
+		name: "missing anchor output",
+		tamper: func(tx *wire.MsgTx) {
+			tx.TxOut = tx.TxOut[:1]
+		},
+		errStr: "anchor output 1 is missing",
+	}, {
+		name: "missing asset input",
+		tamper: func(tx *wire.MsgTx) {
+			tx.TxIn = tx.TxIn[1:]
+		},
+		errStr: "anchor input " + anchorInput.String() + " isn't",
+	}, {
+		name: "extra output",
+		tamper: func(tx *wire.MsgTx) {
+			tx.AddTxOut(&wire.TxOut{
+				Value:    10_000,
+				PkScript: test.RandBytes(34),
+			})
+		},
+		errStr: "expected at most 3 outputs, got 4",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			signedPkt := newSignedPkt()
+			tc.tamper(signedPkt.UnsignedTx)
+
+			err := verifyExternalAnchorPsbt(
+				templatePkt, signedPkt, anchorCommitments,
+				[]*tappsbt.VPacket{vPkt},
+			)
+			if tc.errStr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.errStr)
+		})
+	}
+}
@@ -1,11 +1,13 @@
 package tapgarden
 
 import (
+	"errors"
 	"fmt"
 	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
@@ -14,6 +16,13 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
+// MaxBatchLabelLen is the maximum length of the label of a batch in bytes.
+const MaxBatchLabelLen = 500
+
+// ErrBatchLabelTooLong is returned if the label of a batch exceeds
+// MaxBatchLabelLen.
+var ErrBatchLabelTooLong = errors.New("batch label too long")
+
 // AssetMetas maps the serialized script key of an asset to the meta reveal for
 // that asset, if it has one.
 type AssetMetas map[asset.SerializedKey]*proof.MetaReveal
@@ -63,12 +72,38 @@ type MintingBatch struct {
 	// reveal for that asset, if it has one.
 	AssetMetas AssetMetas
 
+	// TapscriptSibling is an optional tapscript sibling that is committed
+	// to next to the Taproot Asset commitment in the minting output. This
+	// allows the issuer to attach custom spending conditions to the
+	// minting output.
+	TapscriptSibling *commitment.TapscriptPreimage
+
+	// ExternalFunding denotes that the genesis transaction of the batch is
+	// funded and signed by an external wallet. The caretaker then hands
+	// out the genesis PSBT instead of using the backing wallet.
+	ExternalFunding bool
+
+	// Label is an optional user-defined label of the batch, for example to
+	// tie it to an internal accounting reference. It can be changed at any
+	// time, also after the batch was confirmed.
+	Label string
+
+	// ReOrgState tracks whether the minting transaction of the batch was
+	// re-organized out of the chain after the batch was finalized.
+	ReOrgState BatchReOrgState
+
+	// NumReOrgs is the number of times the minting transaction of the
+	// batch was re-organized out of the chain.
+	NumReOrgs uint32
+
 	// mintingPubKey is the top-level Taproot output key that will be used
 	// to commit to the Taproot Asset commitment above.
 	mintingPubKey *btcec.PublicKey
 
-	// taprootAssetScriptRoot is the root hash of the Taproot Asset
-	// commitment. If this is nil, then the mintingPubKey will be as well.
+	// taprootAssetScriptRoot is the tapscript root of the minting output,
+	// which commits to the Taproot Asset commitment and the optional
+	// tapscript sibling. If this is nil, then the mintingPubKey will be as
+	// well.
 	taprootAssetScriptRoot []byte
 }
 
@@ -81,13 +116,32 @@ func (m *MintingBatch) addSeedling(s *Seedling) error {
 			s.AssetName)
 	}
 
+	// The assets of a batch are identified by their script key until
+	// their proofs are created, so each script key can only be used once.
+	if s.ScriptKey != nil {
+		scriptKey := s.ScriptKey.PubKey
+		for _, other := range m.Seedlings {
+			if other.ScriptKey != nil &&
+				other.ScriptKey.PubKey.IsEqual(scriptKey) {
+
+				return fmt.Errorf("script key of asset %v "+
+					"already used by asset %v in batch",
+					s.AssetName, other.AssetName)
+			}
+		}
+	}
+
 	m.Seedlings[s.AssetName] = s
 	return nil
 }
 
 // validateGroupAnchor checks if the group anchor for a seedling is valid.
 // A valid anchor must already be part of the batch and have emission enabled.
-func (m *MintingBatch) validateGroupAnchor(s *Seedling) error {
+// The mint height is the earliest block height the batch can be confirmed at,
+// which determines the supply unlocked by the emission schedule of the group.
+func (m *MintingBatch) validateGroupAnchor(s *Seedling,
+	mintHeight uint32) error {
+
 	anchor, ok := m.Seedlings[*s.GroupAnchor]
 
 	if !ok {
@@ -98,6 +152,66 @@ func (m *MintingBatch) validateGroupAnchor(s *Seedling) error {
 		return fmt.Errorf("group anchor %v has emission disabled",
 			*s.GroupAnchor)
 	}
+	if anchor.ExternalGroupSigner != s.ExternalGroupSigner {
+		return fmt.Errorf("group anchor %v and seedling must use the "+
+			"same group signer", *s.GroupAnchor)
+	}
+	if !anchor.GroupMultiSig.IsEqual(s.GroupMultiSig) {
+		return fmt.Errorf("group anchor %v and seedling must use the "+
+			"same group multisig script", *s.GroupAnchor)
+	}
+	if anchor.DecimalDisplay != s.DecimalDisplay {
+		return fmt.Errorf("group anchor %v and seedling must use the "+
+			"same decimal display", *s.GroupAnchor)
+	}
+
+	// The supply of the new group is the amount of the anchor and all the
+	// other seedlings of the batch that use the same anchor.
+	issued := anchor.Amount
+	for _, seedling := range m.Seedlings {
+		if seedling.GroupAnchor != nil &&
+			*seedling.GroupAnchor == *s.GroupAnchor {
+
+			issued += seedling.Amount
+		}
+	}
+
+	err := validateGroupSupply(issued, s.Amount, anchor.MaxSupply)
+	if err != nil {
+		return err
+	}
+
+	return anchor.EmissionSchedule.CheckIssuance(
+		issued, s.Amount, mintHeight,
+	)
+}
+
+// validateTapscriptSibling checks that the tapscript sibling of a seedling, if
+// it specifies one, matches the tapscript sibling of the batch. As the sibling
+// is committed to in the minting output of the batch, it can only be set when
+// the batch is created.
+func (m *MintingBatch) validateTapscriptSibling(s *Seedling) error {
+	if s.TapscriptSibling == nil {
+		return nil
+	}
+
+	if m.TapscriptSibling == nil {
+		return fmt.Errorf("pending batch has no tapscript sibling")
+	}
+
+	batchSiblingHash, err := m.TapscriptSibling.TapHash()
+	if err != nil {
+		return err
+	}
+	seedlingSiblingHash, err := s.TapscriptSibling.TapHash()
+	if err != nil {
+		return err
+	}
+
+	if *batchSiblingHash != *seedlingSiblingHash {
+		return fmt.Errorf("tapscript sibling does not match tapscript "+
+			"sibling %v of pending batch", batchSiblingHash)
+	}
 
 	return nil
 }
@@ -113,7 +227,18 @@ func (m *MintingBatch) MintingOutputKey() (*btcec.PublicKey, []byte, error) {
 		return nil, nil, fmt.Errorf("no asset commitment present")
 	}
 
-	taprootAssetScriptRoot := m.RootAssetCommitment.TapscriptRoot(nil)
+	var siblingHash *chainhash.Hash
+	if m.TapscriptSibling != nil {
+		var err error
+		siblingHash, err = m.TapscriptSibling.TapHash()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	taprootAssetScriptRoot := m.RootAssetCommitment.TapscriptRoot(
+		siblingHash,
+	)
 
 	m.taprootAssetScriptRoot = taprootAssetScriptRoot[:]
 	m.mintingPubKey = txscript.ComputeTaprootOutputKey(
@@ -0,0 +1,123 @@
+package tapgarden
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// AssetCost is the share of the on-chain cost of a minting transaction that is
+// attributed to a single asset of the batch.
+type AssetCost struct {
+	// AssetID is the ID of the asset.
+	AssetID asset.ID
+
+	// Name is the name of the asset.
+	Name string
+
+	// Amount is the amount of units of the asset that were minted.
+	Amount uint64
+
+	// AmortizedFee is the share of the chain fees of the minting
+	// transaction that is attributed to the asset.
+	AmortizedFee btcutil.Amount
+}
+
+// BatchCost is the on-chain cost of the minting transaction of a batch that
+// was already signed.
+type BatchCost struct {
+	// Batch is the batch the cost was computed for.
+	Batch *MintingBatch
+
+	// Txid is the ID of the minting transaction.
+	Txid chainhash.Hash
+
+	// ChainFees is the total amount of chain fees paid by the minting
+	// transaction.
+	ChainFees btcutil.Amount
+
+	// Weight is the weight of the minting transaction.
+	Weight int64
+
+	// AssetCosts is the cost attributed to each asset of the batch, sorted
+	// by asset name and then asset ID.
+	AssetCosts []AssetCost
+}
+
+// VSize returns the virtual size of the minting transaction in vbytes.
+func (b *BatchCost) VSize() int64 {
+	return (b.Weight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+}
+
+// NewBatchCost computes the on-chain cost of the minting transaction of the
+// given batch. The batch must at least be committed, which is when the minting
+// transaction is signed. The chain fees are split equally among the assets of
+// the batch, with any remainder going to the first assets, so the amortized
+// fees always add up to the total chain fees.
+func NewBatchCost(batch *MintingBatch) (*BatchCost, error) {
+	switch batch.State() {
+	case BatchStateCommitted, BatchStateBroadcast, BatchStateConfirmed,
+		BatchStateFinalized:
+
+	default:
+		return nil, fmt.Errorf("batch in state %v has no signed "+
+			"minting transaction", batch.State())
+	}
+
+	if batch.GenesisPacket == nil || batch.RootAssetCommitment == nil {
+		return nil, fmt.Errorf("batch is missing minting transaction")
+	}
+
+	genesisPkt := batch.GenesisPacket.Pkt
+	chainFees, err := GetTxFee(genesisPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute chain fees: %w", err)
+	}
+
+	genesisTx, err := psbt.Extract(genesisPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract minting "+
+			"transaction: %w", err)
+	}
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(genesisTx))
+
+	assets := batch.RootAssetCommitment.CommittedAssets()
+	sort.Slice(assets, func(i, j int) bool {
+		if assets[i].Genesis.Tag != assets[j].Genesis.Tag {
+			return assets[i].Genesis.Tag < assets[j].Genesis.Tag
+		}
+
+		idI, idJ := assets[i].ID(), assets[j].ID()
+		return bytes.Compare(idI[:], idJ[:]) < 0
+	})
+
+	assetCosts := make([]AssetCost, len(assets))
+	for idx, a := range assets {
+		fee := chainFees / int64(len(assets))
+		if int64(idx) < chainFees%int64(len(assets)) {
+			fee++
+		}
+
+		assetCosts[idx] = AssetCost{
+			AssetID:      a.ID(),
+			Name:         a.Genesis.Tag,
+			Amount:       a.Amount,
+			AmortizedFee: btcutil.Amount(fee),
+		}
+	}
+
+	return &BatchCost{
+		Batch:      batch,
+		Txid:       genesisTx.TxHash(),
+		ChainFees:  btcutil.Amount(chainFees),
+		Weight:     weight,
+		AssetCosts: assetCosts,
+	}, nil
+}
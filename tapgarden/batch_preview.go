@@ -0,0 +1,100 @@
+package tapgarden
+
+import (
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// BatchPreview is an estimate of the cost and structure of the minting
+// transaction of a pending batch, computed without finalizing the batch.
+//
+// The exact minting transaction is only known once the wallet funded it, which
+// isn't done for a preview as that would lock wallet UTXOs. The estimate
+// therefore assumes that the wallet funds the transaction with a single P2TR
+// input and adds a P2TR change output, which is the common case for a tapd
+// backed by an lnd wallet. Every additional or larger input the wallet selects
+// increases the final fee.
+type BatchPreview struct {
+	// Batch is the pending batch the preview was created for.
+	Batch *MintingBatch
+
+	// FeeRate is the fee rate the fee estimate is based on.
+	FeeRate chainfee.SatPerKWeight
+
+	// EstimatedWeight is the estimated weight of the minting transaction.
+	EstimatedWeight int64
+
+	// EstimatedFee is the estimated fee of the minting transaction at the
+	// fee rate of the preview.
+	EstimatedFee btcutil.Amount
+
+	// AnchorOutputValue is the amount of satoshis that are locked in the
+	// anchor output that commits to the assets of the batch. Its position
+	// in the transaction depends on where the wallet places the change
+	// output.
+	AnchorOutputValue btcutil.Amount
+
+	// InternalKey is the internal key of the anchor output, which is the
+	// batch key.
+	InternalKey *btcec.PublicKey
+
+	// NumAssets is the number of assets the anchor output will commit to.
+	NumAssets int
+
+	// NumNewGroups is the number of new asset groups that will be created
+	// by the batch.
+	NumNewGroups int
+}
+
+// EstimatedVSize returns the estimated virtual size of the minting transaction
+// in vbytes.
+func (b *BatchPreview) EstimatedVSize() int64 {
+	return (b.EstimatedWeight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+}
+
+// estimateGenesisTxWeight estimates the weight of a minting transaction that
+// spends a single P2TR input into the anchor output and a change output.
+func estimateGenesisTxWeight() int64 {
+	var weightEstimator input.TxWeightEstimator
+	weightEstimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+
+	// The anchor output that commits to the assets is always a P2TR
+	// output. The dummy output the wallet funds has the same size.
+	weightEstimator.AddP2TROutput()
+
+	// The change output of the lnd wallet.
+	weightEstimator.AddP2TROutput()
+
+	return int64(weightEstimator.Weight())
+}
+
+// newBatchPreview creates a preview of the minting transaction of the given
+// pending batch at the given fee rate.
+func newBatchPreview(batch *MintingBatch,
+	feeRate chainfee.SatPerKWeight) *BatchPreview {
+
+	weight := estimateGenesisTxWeight()
+
+	var numNewGroups int
+	for _, seedling := range batch.Seedlings {
+		if seedling.EnableEmission && !seedling.HasGroupKey() {
+			numNewGroups++
+		}
+	}
+
+	return &BatchPreview{
+		Batch:             batch,
+		FeeRate:           feeRate,
+		EstimatedWeight:   weight,
+		EstimatedFee:      feeRate.FeeForWeight(weight),
+		AnchorOutputValue: GenesisAmtSats,
+		InternalKey:       batch.BatchKey.PubKey,
+		NumAssets:         len(batch.Seedlings),
+		NumNewGroups:      numNewGroups,
+	}
+}
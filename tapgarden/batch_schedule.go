@@ -0,0 +1,76 @@
+package tapgarden
+
+import (
+	"fmt"
+	"time"
+)
+
+// BatchSchedule governs when the planter automatically finalizes its pending
+// batch. A batch is finalized as soon as any of the configured conditions is
+// met. A zero value for a condition disables it, so the zero value of the
+// schedule as a whole leaves finalization to the caller.
+type BatchSchedule struct {
+	// Interval is the maximum amount of time a batch is kept pending,
+	// measured from the point the first seedling was added to it.
+	Interval time.Duration `long:"interval" description:"The maximum duration (1m, 2h, etc) a pending batch is kept open before it is finalized automatically, counted from the creation of the batch. 0 disables timed finalization."`
+
+	// MaxSeedlings is the number of seedlings in the pending batch that
+	// triggers finalization.
+	MaxSeedlings uint32 `long:"maxseedlings" description:"The number of seedlings in a pending batch that causes the batch to be finalized automatically. 0 disables this threshold."`
+
+	// MaxMetaSize is the total size in bytes of the metadata of all
+	// seedlings in the pending batch that triggers finalization. The meta
+	// data is by far the largest variable part of the proofs of a batch,
+	// so this bounds the amount of data that is committed to in a batch.
+	MaxMetaSize uint64 `long:"maxmetasize" description:"The total size in bytes of the asset meta data of all seedlings in a pending batch that causes the batch to be finalized automatically. 0 disables this threshold."`
+}
+
+// Validate makes sure the batch schedule is sane.
+func (s *BatchSchedule) Validate() error {
+	if s.Interval < 0 {
+		return fmt.Errorf("interval must not be negative")
+	}
+
+	return nil
+}
+
+// IsActive returns true if at least one of the conditions of the schedule is
+// enabled.
+func (s *BatchSchedule) IsActive() bool {
+	return s.Interval > 0 || s.MaxSeedlings > 0 || s.MaxMetaSize > 0
+}
+
+// Deadline returns the time at which the given pending batch should be
+// finalized. False is returned if timed finalization is disabled.
+func (s *BatchSchedule) Deadline(batch *MintingBatch) (time.Time, bool) {
+	if s.Interval == 0 {
+		return time.Time{}, false
+	}
+
+	return batch.CreationTime.Add(s.Interval), true
+}
+
+// ThresholdReached returns true if the given pending batch reached either the
+// seedling count or the meta data size threshold of the schedule.
+func (s *BatchSchedule) ThresholdReached(batch *MintingBatch) bool {
+	if s.MaxSeedlings > 0 &&
+		uint64(len(batch.Seedlings)) >= uint64(s.MaxSeedlings) {
+
+		return true
+	}
+
+	return s.MaxMetaSize > 0 && batchMetaSize(batch) >= s.MaxMetaSize
+}
+
+// batchMetaSize returns the total size of the meta data of all seedlings in
+// the batch.
+func batchMetaSize(batch *MintingBatch) uint64 {
+	var size uint64
+	for _, seedling := range batch.Seedlings {
+		if seedling.Meta != nil {
+			size += uint64(len(seedling.Meta.Data))
+		}
+	}
+
+	return size
+}
@@ -10,9 +10,11 @@ import (
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/neutrino/cache/lru"
@@ -23,6 +25,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 )
@@ -85,6 +88,12 @@ type BatchCaretakerConfig struct {
 	// BroadcastCompleteChan is sent on, never both.
 	BroadcastErrChan chan error
 
+	// ExternalFundingChan is used to signal back to the caller that the
+	// genesis PSBT of an externally funded batch is waiting to be funded
+	// by the external wallet. The broadcast channels are then no longer
+	// sent on for this batch, as broadcast depends on the external wallet.
+	ExternalFundingChan chan struct{}
+
 	// SignalCompletion is used to signal back to the BatchPlanter that
 	// their batch has been finalized.
 	SignalCompletion func()
@@ -103,6 +112,11 @@ type BatchCaretakerConfig struct {
 	// can happen (the batch is finalized after a single confirmation).
 	UpdateMintingProofs func([]*proof.Proof) error
 
+	// ReOrgMintingTx is called in case the minting transaction of the
+	// batch was re-organized out of the chain after the batch was
+	// finalized, and was re-broadcast.
+	ReOrgMintingTx proof.ReOrgCallback
+
 	// ErrChan is the main error channel the caretaker will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -139,10 +153,22 @@ type BatchCaretaker struct {
 //
 // TODO(roasbeef): rename to Cultivator?
 func NewBatchCaretaker(cfg *BatchCaretakerConfig) *BatchCaretaker {
+	batchKey := asset.ToSerialized(cfg.Batch.BatchKey.PubKey)
+
+	// A batch that is resumed after its sprouts were committed already
+	// has a genesis packet, from which we know the anchor output.
+	var anchorOutputIndex uint32
+	if cfg.Batch.GenesisPacket != nil &&
+		cfg.Batch.GenesisPacket.ChangeOutputIndex == 0 {
+
+		anchorOutputIndex = 1
+	}
+
 	return &BatchCaretaker{
-		batchKey:  asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
-		cfg:       cfg,
-		confEvent: make(chan *chainntnfs.TxConfirmation, 1),
+		batchKey:          batchKey,
+		cfg:               cfg,
+		confEvent:         make(chan *chainntnfs.TxConfirmation, 1),
+		anchorOutputIndex: anchorOutputIndex,
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -312,7 +338,14 @@ func (b *BatchCaretaker) assetCultivator() {
 	)
 	if err != nil {
 		log.Errorf("unable to advance state machine: %v", err)
-		b.cfg.BroadcastErrChan <- err
+
+		// The error channel may already hold the error of a batch
+		// cancellation that nobody waits for.
+		select {
+		case b.cfg.BroadcastErrChan <- err:
+		default:
+		}
+
 		return
 	}
 
@@ -376,9 +409,7 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 	log.Infof("BatchCaretaker(%x): attempting to fund GenesisPacket",
 		b.batchKey[:])
 
-	txTemplate := wire.NewMsgTx(2)
-	txTemplate.AddTxOut(&DummyGenesisTxOut)
-	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	genesisPkt, err := newGenesisPsbtTemplate()
 	if err != nil {
 		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
 	}
@@ -386,6 +417,22 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 	log.Infof("BatchCaretaker(%x): creating skeleton PSBT", b.batchKey[:])
 	log.Tracef("PSBT: %v", spew.Sdump(genesisPkt))
 
+	// If the batch is funded by an external wallet, we hand out the
+	// template and wait for the wallet to fund it.
+	if b.cfg.Batch.ExternalFunding {
+		fundedPkt, err := b.awaitGenesisPsbt(
+			ctx, GenesisPsbtFund, genesisPkt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("BatchCaretaker(%x): GenesisPacket funded "+
+			"externally", b.batchKey[:])
+
+		return validateFundedGenesisPsbt(fundedPkt)
+	}
+
 	feeRate, err := b.cfg.ChainBridge.EstimateFee(
 		ctx, GenesisConfTarget,
 	)
@@ -406,6 +453,75 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 	return &fundedGenesisPkt, nil
 }
 
+// awaitGenesisPsbt hands out the genesis PSBT of an externally funded batch
+// and blocks until the external wallet submits a valid funded or signed PSBT,
+// depending on the stage. The batch can still be cancelled while waiting.
+func (b *BatchCaretaker) awaitGenesisPsbt(ctx context.Context,
+	stage GenesisPsbtStage, pkt *psbt.Packet) (*psbt.Packet, error) {
+
+	funder := b.cfg.ExternalFunder
+	if funder == nil {
+		return nil, fmt.Errorf("external funding not supported")
+	}
+
+	req := funder.newRequest(b.cfg.Batch.BatchKey.PubKey, stage, pkt)
+	defer funder.removeRequest(req)
+
+	log.Infof("BatchCaretaker(%x): waiting for external wallet, "+
+		"stage=%v", b.batchKey[:], stage)
+
+	// Let the planter know that the genesis PSBT can now be handed out,
+	// unless it already knows.
+	select {
+	case b.cfg.ExternalFundingChan <- struct{}{}:
+	default:
+	}
+
+	for {
+		select {
+		case submittedPkt := <-req.pktChan:
+			return submittedPkt, nil
+
+		case <-b.cfg.CancelReqChan:
+			cancelResp := b.Cancel()
+			b.cfg.CancelRespChan <- cancelResp
+
+			if cancelResp.finalState != nil {
+				return nil, fmt.Errorf("BatchCaretaker(%x), "+
+					"attempted batch cancellation, "+
+					"shutting down", b.batchKey[:])
+			}
+
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for genesis psbt "+
+				"aborted: %w", ctx.Err())
+		}
+	}
+}
+
+// signGenesisPsbt signs and finalizes the committed genesis PSBT with the
+// backing wallet. The genesis PSBT of an externally funded batch is signed by
+// the external wallet instead, which may take a while.
+func (b *BatchCaretaker) signGenesisPsbt() (*psbt.Packet, error) {
+	genesisPkt := b.cfg.Batch.GenesisPacket.Pkt
+	if b.cfg.Batch.ExternalFunding {
+		ctx, cancel := b.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		return b.awaitGenesisPsbt(ctx, GenesisPsbtSign, genesisPkt)
+	}
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(ctx, genesisPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	return signedPkt, nil
+}
+
 // extractGenesisOutpoint extracts the genesis point (the first output from the
 // genesis transaction).
 func extractGenesisOutpoint(tx *wire.MsgTx) wire.OutPoint {
@@ -441,6 +557,24 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 			Type:         seedling.AssetType,
 		}
 
+		// If a vanity asset ID was requested, we'll grind a nonce in
+		// the meta data of the seedling until the asset ID matches the
+		// prefix. The asset ID is only known now, as it commits to the
+		// genesis outpoint.
+		if seedling.VanityPrefix != "" {
+			vanityMeta, err := grindVanityMeta(
+				ctx, assetGen, seedling.Meta,
+				seedling.VanityPrefix,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to grind "+
+					"vanity asset ID for seedling %v: %w",
+					seedlingName, err)
+			}
+
+			seedling.Meta = vanityMeta
+		}
+
 		// If the seedling has a meta data reveal set, then we'll bind
 		// that by including the hash of the meta data in the asset
 		// genesis.
@@ -448,14 +582,25 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 			assetGen.MetaHash = seedling.Meta.MetaHash()
 		}
 
-		scriptKey, err := b.cfg.KeyRing.DeriveNextKey(
-			ctx, asset.TaprootAssetsKeyFamily,
+		// The asset is minted to the script key of the seedling if one
+		// was given, otherwise we derive a new one.
+		var (
+			tweakedScriptKey asset.ScriptKey
+			err              error
 		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to obtain script "+
-				"key: %w", err)
+		if seedling.ScriptKey != nil {
+			tweakedScriptKey = *seedling.ScriptKey
+		} else {
+			var scriptKey keychain.KeyDescriptor
+			scriptKey, err = b.cfg.KeyRing.DeriveNextKey(
+				ctx, asset.TaprootAssetsKeyFamily,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to obtain "+
+					"script key: %w", err)
+			}
+			tweakedScriptKey = asset.NewScriptKeyBip86(scriptKey)
 		}
-		tweakedScriptKey := asset.NewScriptKeyBip86(scriptKey)
 
 		var (
 			amount         uint64
@@ -501,9 +646,9 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		}
 
 		if groupInfo != nil {
-			sproutGroupKey, err = asset.DeriveGroupKey(
-				b.cfg.GenSigner, b.cfg.GenTxBuilder,
-				groupInfo.GroupKey.RawKey,
+			sproutGroupKey, err = b.deriveGroupKey(
+				ctx, seedling, groupInfo.GroupKey.RawKey,
+				groupInfo.GroupKey.TapscriptRoot,
 				*groupInfo.Genesis, protoAsset,
 			)
 			if err != nil {
@@ -515,19 +660,38 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		// If emission is enabled without a group key specified,
 		// then we'll need to generate another public key,
 		// then use that to derive the key group signature
-		// along with the tweaked key group.
+		// along with the tweaked key group. An externally held
+		// group key was already specified by the caller.
 		if seedling.EnableEmission {
-			rawGroupKey, err := b.cfg.KeyRing.DeriveNextKey(
-				ctx, asset.TaprootAssetsKeyFamily,
-			)
+			var rawGroupKey keychain.KeyDescriptor
+			switch {
+			case seedling.GroupInternalKey != nil:
+				rawGroupKey = *seedling.GroupInternalKey
+
+			default:
+				rawGroupKey, err = b.cfg.KeyRing.DeriveNextKey(
+					ctx, asset.TaprootAssetsKeyFamily,
+				)
+				if err != nil {
+					return nil, fmt.Errorf("unable to "+
+						"derive group key: %w", err)
+				}
+			}
+
+			// The group key of a new group commits to the
+			// leaves of its issuance delegations, if it has any.
+			delegations, err := seedling.Meta.Delegations()
+			if err != nil {
+				return nil, err
+			}
+			tapscriptRoot, err := delegations.TapscriptRoot()
 			if err != nil {
-				return nil, fmt.Errorf("unable to derive "+
-					"group key: %w", err)
+				return nil, err
 			}
 
-			sproutGroupKey, err = asset.DeriveGroupKey(
-				b.cfg.GenSigner, b.cfg.GenTxBuilder,
-				rawGroupKey, assetGen, protoAsset,
+			sproutGroupKey, err = b.deriveGroupKey(
+				ctx, seedling, rawGroupKey, tapscriptRoot,
+				assetGen, protoAsset,
 			)
 			if err != nil {
 				return nil, fmt.Errorf("unable to tweak group "+
@@ -570,6 +734,94 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 	return commitment.FromAssets(newAssets...)
 }
 
+// deriveGroupKey derives the group key of the asset minted from the given
+// seedling and produces its group witness. The group key commits to the given
+// tapscript root, which may be nil. If the group key is held by an external
+// signer, the group witness is obtained through a signing request that blocks
+// until enough signatures were submitted. A group key with a multisig script
+// is always authorized through a script path spend, and so is an issuance by a
+// delegate key of the group.
+func (b *BatchCaretaker) deriveGroupKey(ctx context.Context, seedling *Seedling,
+	rawKey keychain.KeyDescriptor, tapscriptRoot []byte,
+	initialGen asset.Genesis, protoAsset *asset.Asset) (*asset.GroupKey,
+	error) {
+
+	if seedling.DelegateKey != nil {
+		return b.deriveDelegatedGroupKey(
+			ctx, seedling, rawKey, initialGen, protoAsset,
+		)
+	}
+
+	if !seedling.ExternalGroupSigner {
+		return asset.DeriveGroupKeyWithRoot(
+			b.cfg.GenSigner, b.cfg.GenTxBuilder, rawKey,
+			tapscriptRoot, initialGen, protoAsset,
+		)
+	}
+
+	genSigner := b.cfg.ExternalSigner.genesisSigner(
+		ctx, b.cfg.Batch.BatchKey.PubKey, seedling.AssetName,
+	)
+	if seedling.GroupMultiSig == nil {
+		return asset.DeriveGroupKeyWithRoot(
+			genSigner, b.cfg.GenTxBuilder, rawKey, tapscriptRoot,
+			initialGen, protoAsset,
+		)
+	}
+
+	tapLeaf, err := seedling.GroupMultiSig.TapLeaf()
+	if err != nil {
+		return nil, err
+	}
+
+	return asset.DeriveGroupKeyScriptSpend(
+		genSigner, b.cfg.GenTxBuilder, rawKey,
+		[]txscript.TapLeaf{tapLeaf}, 0, initialGen, protoAsset,
+	)
+}
+
+// deriveDelegatedGroupKey produces the group witness of an asset that is
+// issued into an existing group by a delegate key of the group. The witness
+// spends the leaf of the issuance delegation of the key, which is found in the
+// meta data of the asset that created the group.
+func (b *BatchCaretaker) deriveDelegatedGroupKey(ctx context.Context,
+	seedling *Seedling, rawKey keychain.KeyDescriptor,
+	initialGen asset.Genesis, protoAsset *asset.Asset) (*asset.GroupKey,
+	error) {
+
+	groupMeta, err := b.cfg.Log.FetchAssetMeta(ctx, initialGen.ID())
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch group meta data: %w",
+			err)
+	}
+	delegations, err := groupMeta.Delegations()
+	if err != nil {
+		return nil, err
+	}
+
+	_, leafIdx, ok := delegations.Find(seedling.DelegateKey.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("key %x is not a delegate key of the "+
+			"group", schnorr.SerializePubKey(
+			seedling.DelegateKey.PubKey,
+		))
+	}
+	tapLeaves, err := delegations.TapLeaves()
+	if err != nil {
+		return nil, err
+	}
+
+	genSigner := &delegateGenesisSigner{
+		signer:      b.cfg.GenSigner,
+		delegateKey: *seedling.DelegateKey,
+	}
+
+	return asset.DeriveGroupKeyScriptSpend(
+		genSigner, b.cfg.GenTxBuilder, rawKey, tapLeaves, leafIdx,
+		initialGen, protoAsset,
+	)
+}
+
 // stateStep attempts to transition the state machine from one state to
 // another. Two states are terminal: the broadcast state, and the finalized
 // state.
@@ -643,22 +895,6 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 
 		genesisTxPkt.Pkt.UnsignedTx.TxOut[b.anchorOutputIndex].PkScript = genesisScript
 
-		log.Infof("BatchCaretaker(%x): committing sprouts to disk",
-			b.batchKey[:])
-
-		// With all our commitments created, we'll commit them to disk,
-		// replacing the existing seedlings we had created for each of
-		// these assets.
-		err = b.cfg.Log.AddSproutsToBatch(
-			ctx, b.cfg.Batch.BatchKey.PubKey,
-			genesisTxPkt, b.cfg.Batch.RootAssetCommitment,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to commit batch: %w", err)
-		}
-
-		b.cfg.Batch.GenesisPacket = genesisTxPkt
-
 		// Now that we know the script key for all the assets, we'll
 		// populate the asset metas map as we need that to create the
 		// asset proofs. On restart, we'll get these in the batch
@@ -675,6 +911,23 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 			b.cfg.Batch.AssetMetas[scriptKey] = seedling.Meta
 		}
 
+		log.Infof("BatchCaretaker(%x): committing sprouts to disk",
+			b.batchKey[:])
+
+		// With all our commitments created, we'll commit them to disk,
+		// replacing the existing seedlings we had created for each of
+		// these assets.
+		err = b.cfg.Log.AddSproutsToBatch(
+			ctx, b.cfg.Batch.BatchKey.PubKey,
+			genesisTxPkt, b.cfg.Batch.RootAssetCommitment,
+			b.cfg.Batch.AssetMetas,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("unable to commit batch: %w", err)
+		}
+
+		b.cfg.Batch.GenesisPacket = genesisTxPkt
+
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateFrozen, BatchStateCommitted)
 
@@ -692,13 +945,9 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// was then modified.
 		//
 		// TODO(roasbeef): only execute if finalized? or missing sig
-		ctx, cancel := b.WithCtxQuit()
-		defer cancel()
-		signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(
-			ctx, b.cfg.Batch.GenesisPacket.Pkt,
-		)
+		signedPkt, err := b.signGenesisPsbt()
 		if err != nil {
-			return 0, fmt.Errorf("unable to sign psbt: %w", err)
+			return 0, err
 		}
 
 		// Final TX sanity check.
@@ -733,14 +982,17 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		//
 		// TODO(roasbeef): re-run during the broadcast phase to ensure
 		// it's fully imported?
-		mintingOutputKey, tapRoot, err := b.cfg.Batch.MintingOutputKey()
+		ctx, cancel := b.WithCtxQuit()
+		defer cancel()
+		batch := b.cfg.Batch
+		mintingOutputKey, merkleRoot, err := batch.MintingOutputKey()
 		if err != nil {
 			return 0, err
 		}
+		tapRoot := batch.RootAssetCommitment.TapscriptRoot(nil)
 		err = b.cfg.Log.CommitSignedGenesisTx(
-			ctx, b.cfg.Batch.BatchKey.PubKey,
-			b.cfg.Batch.GenesisPacket, b.anchorOutputIndex,
-			tapRoot,
+			ctx, batch.BatchKey.PubKey, batch.GenesisPacket,
+			b.anchorOutputIndex, merkleRoot, tapRoot[:],
 		)
 		if err != nil {
 			return 0, fmt.Errorf("unable to commit genesis "+
@@ -796,7 +1048,9 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// transaction, then request a confirmation notification.
 		ctx, cancel := b.WithCtxQuit()
 		defer cancel()
-		err = b.cfg.ChainBridge.PublishTransaction(ctx, signedTx)
+		err = b.cfg.ChainBridge.PublishTransaction(
+			ctx, signedTx, "tapd-asset-minting",
+		)
 		if err != nil {
 			return 0, fmt.Errorf("unable to publish "+
 				"transaction: %w", err)
@@ -907,6 +1161,7 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 				OutputIndex:      int(b.anchorOutputIndex),
 				InternalKey:      b.cfg.Batch.BatchKey.PubKey,
 				TaprootAssetRoot: batchCommitment,
+				TapscriptSibling: b.cfg.Batch.TapscriptSibling,
 			},
 			GenesisPoint: extractGenesisOutpoint(
 				b.cfg.Batch.GenesisPacket.Pkt.UnsignedTx,
@@ -1040,8 +1295,9 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 
 		// Now that we've confirmed the batch, we'll hand over the
 		// proofs to the re-org watcher.
-		if err := b.cfg.ProofWatcher.WatchProofs(
-			maps.Values(mintingProofs), b.cfg.UpdateMintingProofs,
+		if err := b.cfg.ProofWatcher.WatchProofsWithReOrg(
+			maps.Values(mintingProofs), b.cfg.ReOrgMintingTx,
+			b.cfg.UpdateMintingProofs,
 		); err != nil {
 			return 0, fmt.Errorf("error watching proof: %w", err)
 		}
@@ -2,8 +2,10 @@ package tapgarden
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -18,10 +20,6 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 )
 
-const (
-	defaultProofRetrievalDelay = 5 * time.Second
-)
-
 // CustodianConfig houses all the items that the Custodian needs to carry out
 // its duties.
 type CustodianConfig struct {
@@ -58,6 +56,11 @@ type CustodianConfig struct {
 	// service handles.
 	ProofCourierCfg *proof.CourierCfg
 
+	// ProofRetrievalCfg configures the retries of proof retrievals from
+	// the proof couriers of our addresses. If this is nil, the default
+	// config is used.
+	ProofRetrievalCfg *ProofRetrievalCfg
+
 	// ProofWatcher is used to watch new proofs for their anchor transaction
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
@@ -102,6 +105,11 @@ func NewCustodian(cfg *CustodianConfig) *Custodian {
 		fn.DefaultQueueSize,
 	)
 	proofSub := fn.NewEventReceiver[proof.Blob](fn.DefaultQueueSize)
+
+	if cfg.ProofRetrievalCfg == nil {
+		cfg.ProofRetrievalCfg = DefaultProofRetrievalCfg()
+	}
+
 	return &Custodian{
 		cfg:               cfg,
 		addrSubscription:  addrSub,
@@ -373,41 +381,28 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 			log.Debugf("Waiting to receive proof for script key %x",
 				addr.ScriptKey.SerializeCompressed())
 
-			// Initiate proof courier service handle from the proof
-			// courier address found in the Tap address.
 			recipient := proof.Recipient{
 				ScriptKey: &addr.ScriptKey,
 				AssetID:   assetID,
 				Amount:    addr.Amount,
 			}
-			courier, err := proof.NewCourier(
-				ctx, addr.ProofCourierAddr,
-				c.cfg.ProofCourierCfg, recipient,
-			)
-			if err != nil {
-				log.Errorf("unable to initiate proof courier "+
-					"service handle: %v", err)
-				return
-			}
-
-			// Sleep to give the sender an opportunity to transfer
-			// the proof to the proof courier service.
-			// Without this delay our first attempt at retrieving
-			// the proof will very likely fail. We should expect
-			// retrieval success before this delay.
-			select {
-			case <-time.After(defaultProofRetrievalDelay):
-			case <-ctx.Done():
-				return
-			}
-
-			// Attempt to receive proof via proof courier service.
 			loc := proof.Locator{
 				AssetID:   &assetID,
 				ScriptKey: addr.ScriptKey,
 				OutPoint:  &op,
 			}
-			addrProof, err := courier.ReceiveProof(ctx, loc)
+
+			// Attempt to receive the proof via the proof courier
+			// service of the Tap address, falling back to the
+			// other couriers of the address in order.
+			fetch := c.newProofFetcher(recipient, loc)
+			courierAddrs := append(
+				[]url.URL{addr.ProofCourierAddr},
+				addr.FallbackCourierAddrs...,
+			)
+			addrProof, err := c.cfg.ProofRetrievalCfg.retrieve(
+				ctx, courierAddrs, fetch,
+			)
 			if err != nil {
 				log.Errorf("unable to recv proof: %v", err)
 				return
@@ -438,6 +433,34 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 	return nil
 }
 
+// newProofFetcher returns a proof fetcher that receives the proof identified
+// by the given locator from a proof courier. Each courier service handle is
+// only initiated once and then re-used for further attempts.
+func (c *Custodian) newProofFetcher(recipient proof.Recipient,
+	loc proof.Locator) proofFetcher {
+
+	couriers := make(map[string]proof.Courier)
+	return func(ctx context.Context,
+		courierAddr url.URL) (*proof.AnnotatedProof, error) {
+
+		key := courierAddr.String()
+		if _, ok := couriers[key]; !ok {
+			courier, err := proof.NewCourier(
+				ctx, courierAddr, c.cfg.ProofCourierCfg,
+				recipient,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to initiate "+
+					"proof courier service handle: %w",
+					err)
+			}
+			couriers[key] = courier
+		}
+
+		return couriers[key].ReceiveProof(ctx, loc)
+	}
+}
+
 // mapToTapAddr attempts to match a transaction output to a Taproot Asset
 // address. If a matching address is found, an event is created for it. If an
 // event already exists, it is updated with the current transaction information.
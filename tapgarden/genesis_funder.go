@@ -0,0 +1,281 @@
+package tapgarden
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+var (
+	// ErrGenesisPsbtRequestNotFound is returned if a PSBT is submitted for
+	// a batch that doesn't wait for its genesis PSBT.
+	ErrGenesisPsbtRequestNotFound = fmt.Errorf("genesis psbt request " +
+		"not found")
+)
+
+// GenesisPsbtStage denotes what an external wallet must do with the genesis
+// PSBT of an externally funded batch.
+type GenesisPsbtStage uint8
+
+const (
+	// GenesisPsbtFund denotes that the genesis PSBT must be funded. The
+	// external wallet adds inputs and an optional change output, but must
+	// not sign the PSBT yet, as the script of the minting output can only
+	// be created once the genesis outpoint is known.
+	GenesisPsbtFund GenesisPsbtStage = 0
+
+	// GenesisPsbtSign denotes that the genesis PSBT contains the final
+	// minting output and must be signed and finalized without any other
+	// changes.
+	GenesisPsbtSign GenesisPsbtStage = 1
+)
+
+// String returns a human-readable string for the genesis PSBT stage.
+func (s GenesisPsbtStage) String() string {
+	switch s {
+	case GenesisPsbtFund:
+		return "GenesisPsbtFund"
+
+	case GenesisPsbtSign:
+		return "GenesisPsbtSign"
+
+	default:
+		return fmt.Sprintf("UnknownStage(%d)", s)
+	}
+}
+
+// GenesisPsbtRequest is a request for an external wallet to fund or sign the
+// genesis PSBT of a batch.
+type GenesisPsbtRequest struct {
+	// BatchKey is the key of the batch the genesis PSBT belongs to. It
+	// also identifies the request.
+	BatchKey *btcec.PublicKey
+
+	// Stage denotes whether the PSBT must be funded or signed.
+	Stage GenesisPsbtStage
+
+	// Packet is the genesis PSBT that must be funded or signed.
+	Packet *psbt.Packet
+
+	// pktChan is used to deliver a valid PSBT to the caretaker that waits
+	// for it.
+	pktChan chan *psbt.Packet
+}
+
+// ExternalGenesisFunder hands out the genesis PSBTs of batches that are funded
+// by an external wallet, and collects the funded and signed PSBTs for them. A
+// caretaker of such a batch blocks until a valid PSBT is submitted, the batch
+// is cancelled or the caretaker is shut down.
+type ExternalGenesisFunder struct {
+	mu       sync.Mutex
+	requests map[asset.SerializedKey]*GenesisPsbtRequest
+}
+
+// NewExternalGenesisFunder creates a new external genesis funder without any
+// pending requests.
+func NewExternalGenesisFunder() *ExternalGenesisFunder {
+	return &ExternalGenesisFunder{
+		requests: make(map[asset.SerializedKey]*GenesisPsbtRequest),
+	}
+}
+
+// PendingRequests returns all genesis PSBT requests that are waiting for a
+// PSBT, ordered by batch key.
+func (e *ExternalGenesisFunder) PendingRequests() []*GenesisPsbtRequest {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	reqs := make([]*GenesisPsbtRequest, 0, len(e.requests))
+	for _, req := range e.requests {
+		reqs = append(reqs, req)
+	}
+
+	sort.Slice(reqs, func(i, j int) bool {
+		iKey := asset.ToSerialized(reqs[i].BatchKey)
+		jKey := asset.ToSerialized(reqs[j].BatchKey)
+
+		return bytes.Compare(iKey[:], jKey[:]) < 0
+	})
+
+	return reqs
+}
+
+// PendingRequest returns the genesis PSBT request of the batch with the given
+// key, if there is one.
+func (e *ExternalGenesisFunder) PendingRequest(
+	batchKey *btcec.PublicKey) (*GenesisPsbtRequest, bool) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	req, ok := e.requests[asset.ToSerialized(batchKey)]
+	return req, ok
+}
+
+// SubmitPsbt submits the funded or signed genesis PSBT for the batch with the
+// given key. The PSBT is only accepted if it is valid for the stage of the
+// pending request.
+func (e *ExternalGenesisFunder) SubmitPsbt(batchKey *btcec.PublicKey,
+	pkt *psbt.Packet) error {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	serializedKey := asset.ToSerialized(batchKey)
+	req, ok := e.requests[serializedKey]
+	if !ok {
+		return fmt.Errorf("%w: %x", ErrGenesisPsbtRequestNotFound,
+			serializedKey[:])
+	}
+
+	var err error
+	switch req.Stage {
+	case GenesisPsbtFund:
+		_, err = validateFundedGenesisPsbt(pkt)
+
+	case GenesisPsbtSign:
+		err = validateSignedGenesisPsbt(req.Packet, pkt)
+
+	default:
+		err = fmt.Errorf("unknown genesis psbt stage: %v", req.Stage)
+	}
+	if err != nil {
+		return err
+	}
+
+	delete(e.requests, serializedKey)
+	req.pktChan <- pkt
+
+	return nil
+}
+
+// newRequest registers a new pending genesis PSBT request for the given
+// batch.
+func (e *ExternalGenesisFunder) newRequest(batchKey *btcec.PublicKey,
+	stage GenesisPsbtStage, pkt *psbt.Packet) *GenesisPsbtRequest {
+
+	req := &GenesisPsbtRequest{
+		BatchKey: batchKey,
+		Stage:    stage,
+		Packet:   pkt,
+		pktChan:  make(chan *psbt.Packet, 1),
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.requests[asset.ToSerialized(batchKey)] = req
+
+	return req
+}
+
+// removeRequest removes a genesis PSBT request, if it is still pending.
+func (e *ExternalGenesisFunder) removeRequest(req *GenesisPsbtRequest) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	serializedKey := asset.ToSerialized(req.BatchKey)
+	if e.requests[serializedKey] == req {
+		delete(e.requests, serializedKey)
+	}
+}
+
+// newGenesisPsbtTemplate creates the unfunded template of a genesis PSBT,
+// which only contains the dummy minting output.
+func newGenesisPsbtTemplate() (*psbt.Packet, error) {
+	txTemplate := wire.NewMsgTx(2)
+	txTemplate.AddTxOut(&DummyGenesisTxOut)
+
+	return psbt.NewFromUnsignedTx(txTemplate)
+}
+
+// validateFundedGenesisPsbt checks that an externally funded genesis PSBT
+// still contains the dummy minting output of the template, next to at most
+// one change output, and that the value of all inputs is known. The funded
+// PSBT is returned along with the index of its change output.
+func validateFundedGenesisPsbt(pkt *psbt.Packet) (*FundedPsbt, error) {
+	tx := pkt.UnsignedTx
+	if len(tx.TxIn) == 0 {
+		return nil, fmt.Errorf("funded genesis psbt has no inputs")
+	}
+
+	numOutputs := len(tx.TxOut)
+	if numOutputs == 0 || numOutputs > 2 {
+		return nil, fmt.Errorf("funded genesis psbt must have one or "+
+			"two outputs, got %d", numOutputs)
+	}
+
+	anchorIndex := -1
+	for idx, txOut := range tx.TxOut {
+		isDummyScript := bytes.Equal(
+			txOut.PkScript, DummyGenesisTxOut.PkScript,
+		)
+		if txOut.Value == DummyGenesisTxOut.Value && isDummyScript {
+			anchorIndex = idx
+			break
+		}
+	}
+	if anchorIndex == -1 {
+		return nil, fmt.Errorf("funded genesis psbt is missing the " +
+			"minting output")
+	}
+
+	chainFees, err := GetTxFee(pkt)
+	if err != nil {
+		return nil, err
+	}
+	if chainFees < 0 {
+		return nil, fmt.Errorf("funded genesis psbt spends more than "+
+			"its inputs: fee=%d", chainFees)
+	}
+
+	changeIndex := int32(-1)
+	if numOutputs == 2 {
+		changeIndex = int32(1 - anchorIndex)
+	}
+
+	return &FundedPsbt{
+		Pkt:               pkt,
+		ChangeOutputIndex: changeIndex,
+		ChainFees:         chainFees,
+	}, nil
+}
+
+// validateSignedGenesisPsbt checks that an externally signed genesis PSBT
+// spends and creates exactly what the committed genesis PSBT does, and that
+// it can be finalized into a valid transaction.
+func validateSignedGenesisPsbt(committedPkt, signedPkt *psbt.Packet) error {
+	committedTxid := committedPkt.UnsignedTx.TxHash()
+	signedTxid := signedPkt.UnsignedTx.TxHash()
+	if committedTxid != signedTxid {
+		return fmt.Errorf("signed genesis psbt has txid %v, "+
+			"expected %v", signedTxid, committedTxid)
+	}
+
+	// The external wallet may have only signed the inputs without
+	// finalizing them, so we try to do this here.
+	err := psbt.MaybeFinalizeAll(signedPkt)
+	if err != nil {
+		return fmt.Errorf("unable to finalize genesis psbt: %w", err)
+	}
+
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return fmt.Errorf("unable to extract genesis psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
+	if err != nil {
+		return fmt.Errorf("genesis TX failed final checks: %w", err)
+	}
+
+	return nil
+}
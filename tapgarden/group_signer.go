@@ -0,0 +1,478 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	// ErrGroupWitnessRequestNotFound is returned if a signature is
+	// submitted for a group witness request that isn't pending.
+	ErrGroupWitnessRequestNotFound = fmt.Errorf("group witness request " +
+		"not found")
+)
+
+// GroupWitnessRequest is a request for the signature over the virtual minting
+// transaction of an asset whose group key is held by an external signer. The
+// signature is used as the group witness of the asset.
+type GroupWitnessRequest struct {
+	// BatchKey is the key of the batch the asset is minted in.
+	BatchKey *btcec.PublicKey
+
+	// AssetName is the name of the seedling the asset is minted from.
+	AssetName string
+
+	// SignDesc describes how the virtual transaction must be signed. The
+	// raw group key must be tweaked with the single tweak and then BIP-0086
+	// style before producing a key spend signature. For a multisig group
+	// key, the witness script is the multisig leaf that must be signed
+	// with a script spend signature instead.
+	SignDesc *lndclient.SignDescriptor
+
+	// MultiSig is the multisig script of the group key, if the group
+	// witness is a script path spend that needs the signatures of multiple
+	// signers.
+	MultiSig *GroupMultiSig
+
+	// SignedKeys are the multisig keys that already submitted a valid
+	// signature for the request.
+	SignedKeys []*btcec.PublicKey
+
+	// VirtualTx is the virtual minting transaction that must be signed.
+	VirtualTx *wire.MsgTx
+
+	// SigHash is the BIP-0341 signature hash of the virtual transaction,
+	// which is the message that must be signed. For a multisig group key,
+	// it commits to the multisig leaf. It also identifies the request.
+	SigHash chainhash.Hash
+
+	// sigChan is used to deliver a valid signature to the caretaker that
+	// waits for it.
+	sigChan chan *schnorr.Signature
+
+	// scriptSigs are the valid signatures of the multisig keys received so
+	// far, keyed by the signer key.
+	scriptSigs map[asset.SerializedKey]*schnorr.Signature
+
+	// witnessChan is used to deliver the multisig witness to the caretaker
+	// that waits for it, once enough signatures were received.
+	witnessChan chan wire.TxWitness
+}
+
+// TweakedGroupKey returns the key a signature for the request must be valid
+// for.
+func (r *GroupWitnessRequest) TweakedGroupKey() (*btcec.PublicKey, error) {
+	pkScript := r.SignDesc.Output.PkScript
+	if !txscript.IsPayToTaproot(pkScript) {
+		return nil, fmt.Errorf("virtual prev out is not a taproot " +
+			"output")
+	}
+
+	return schnorr.ParsePubKey(pkScript[2:])
+}
+
+// Packet returns the virtual minting transaction as a PSBT that contains the
+// previous output, the internal key and the tapscript root needed to sign it.
+func (r *GroupWitnessRequest) Packet() (*psbt.Packet, error) {
+	pkt, err := psbt.NewFromUnsignedTx(r.VirtualTx.Copy())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create virtual psbt: %w", err)
+	}
+
+	internalKey := input.TweakPubKeyWithTweak(
+		r.SignDesc.KeyDesc.PubKey, r.SignDesc.SingleTweak,
+	)
+
+	pkt.Inputs[0].WitnessUtxo = r.SignDesc.Output
+	pkt.Inputs[0].SighashType = r.SignDesc.HashType
+	pkt.Inputs[0].TaprootInternalKey = schnorr.SerializePubKey(internalKey)
+
+	// A group key that commits to issuance delegations is signed for with
+	// its tapscript root as the taproot tweak.
+	if r.MultiSig == nil {
+		if len(r.SignDesc.TapTweak) != 0 {
+			pkt.Inputs[0].TaprootMerkleRoot = r.SignDesc.TapTweak
+		}
+
+		return pkt, nil
+	}
+
+	// A multisig group witness is a script path spend, so the signers
+	// also need the multisig leaf and its control block.
+	leaf := txscript.NewBaseTapLeaf(r.SignDesc.WitnessScript)
+	tree := txscript.AssembleTaprootScriptTree(leaf)
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(internalKey)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize control block: %w",
+			err)
+	}
+
+	rootHash := tree.RootNode.TapHash()
+	pkt.Inputs[0].TaprootMerkleRoot = rootHash[:]
+	pkt.Inputs[0].TaprootLeafScript = []*psbt.TaprootTapLeafScript{{
+		ControlBlock: controlBlockBytes,
+		Script:       leaf.Script,
+		LeafVersion:  leaf.LeafVersion,
+	}}
+
+	return pkt, nil
+}
+
+// ExternalGroupSigner hands out signing requests for the group witnesses of
+// assets whose group key is held by an external signer, and collects the
+// signatures for them. A caretaker that needs such a group witness blocks until
+// a valid signature is submitted or it is shut down.
+type ExternalGroupSigner struct {
+	mu       sync.Mutex
+	requests map[chainhash.Hash]*GroupWitnessRequest
+
+	// sessions are the MuSig2 signing sessions for group witness requests
+	// of group keys that are an aggregate of multiple signer keys.
+	sessions map[chainhash.Hash]*MuSig2GroupSession
+}
+
+// NewExternalGroupSigner creates a new external group signer without any
+// pending requests.
+func NewExternalGroupSigner() *ExternalGroupSigner {
+	return &ExternalGroupSigner{
+		requests: make(map[chainhash.Hash]*GroupWitnessRequest),
+		sessions: make(map[chainhash.Hash]*MuSig2GroupSession),
+	}
+}
+
+// PendingRequests returns all group witness requests that are waiting for a
+// signature, ordered by batch key and asset name.
+func (e *ExternalGroupSigner) PendingRequests() []*GroupWitnessRequest {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	reqs := make([]*GroupWitnessRequest, 0, len(e.requests))
+	for _, req := range e.requests {
+		// The signed keys of a request change while signatures are
+		// submitted, so we return a snapshot of them.
+		reqCopy := *req
+		reqCopy.SignedKeys = slices.Clone(req.SignedKeys)
+		reqs = append(reqs, &reqCopy)
+	}
+
+	sort.Slice(reqs, func(i, j int) bool {
+		iKey := asset.ToSerialized(reqs[i].BatchKey)
+		jKey := asset.ToSerialized(reqs[j].BatchKey)
+		if iKey != jKey {
+			return bytes.Compare(iKey[:], jKey[:]) < 0
+		}
+
+		return reqs[i].AssetName < reqs[j].AssetName
+	})
+
+	return reqs
+}
+
+// SubmitSignature submits the signature for the group witness request with
+// the given signature hash. The signature is only accepted if it is valid for
+// the tweaked group key of the request.
+func (e *ExternalGroupSigner) SubmitSignature(sigHash chainhash.Hash,
+	sig *schnorr.Signature) error {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.submitSignature(sigHash, sig)
+}
+
+// submitSignature verifies and delivers the signature for the group witness
+// request with the given signature hash.
+//
+// NOTE: The mutex must be held when calling this method.
+func (e *ExternalGroupSigner) submitSignature(sigHash chainhash.Hash,
+	sig *schnorr.Signature) error {
+
+	req, ok := e.requests[sigHash]
+	if !ok {
+		return fmt.Errorf("%w: %x", ErrGroupWitnessRequestNotFound,
+			sigHash[:])
+	}
+
+	if req.MultiSig != nil {
+		return fmt.Errorf("group witness request %x requires multisig "+
+			"signatures", sigHash[:])
+	}
+
+	groupKey, err := req.TweakedGroupKey()
+	if err != nil {
+		return err
+	}
+
+	if !sig.Verify(req.SigHash[:], groupKey) {
+		return fmt.Errorf("invalid signature for group witness "+
+			"request %x", sigHash[:])
+	}
+
+	delete(e.requests, sigHash)
+	delete(e.sessions, sigHash)
+	req.sigChan <- sig
+
+	return nil
+}
+
+// SubmitScriptSignature submits the signature of one of the multisig keys for
+// the group witness request with the given signature hash. The signature is
+// only accepted if it is valid for the signer key. Once the threshold of the
+// multisig script is reached, the signatures are assembled into the group
+// witness of the asset. The number of signatures that are still missing is
+// returned.
+func (e *ExternalGroupSigner) SubmitScriptSignature(sigHash chainhash.Hash,
+	signer *btcec.PublicKey, sig *schnorr.Signature) (uint32, error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	req, ok := e.requests[sigHash]
+	if !ok {
+		return 0, fmt.Errorf("%w: %x", ErrGroupWitnessRequestNotFound,
+			sigHash[:])
+	}
+
+	if req.MultiSig == nil {
+		return 0, fmt.Errorf("group witness request %x is not a "+
+			"multisig request", sigHash[:])
+	}
+
+	keyIdx := req.MultiSig.keyIndex(signer)
+	if keyIdx < 0 {
+		return 0, fmt.Errorf("key %x is not a multisig key of group "+
+			"witness request %x", schnorr.SerializePubKey(signer),
+			sigHash[:])
+	}
+
+	signerKey := req.MultiSig.Keys[keyIdx]
+	if !sig.Verify(req.SigHash[:], signerKey) {
+		return 0, fmt.Errorf("invalid signature of key %x for group "+
+			"witness request %x", schnorr.SerializePubKey(signer),
+			sigHash[:])
+	}
+
+	serializedKey := asset.ToSerialized(signerKey)
+	if _, ok := req.scriptSigs[serializedKey]; ok {
+		return 0, fmt.Errorf("key %x already signed group witness "+
+			"request %x", schnorr.SerializePubKey(signer),
+			sigHash[:])
+	}
+
+	req.scriptSigs[serializedKey] = sig
+	req.SignedKeys = append(req.SignedKeys, signerKey)
+
+	numSigs := uint32(len(req.scriptSigs))
+	if numSigs < req.MultiSig.Threshold {
+		return req.MultiSig.Threshold - numSigs, nil
+	}
+
+	witness, err := req.MultiSig.witness(req.scriptSigs)
+	if err != nil {
+		return 0, err
+	}
+
+	delete(e.requests, sigHash)
+	req.witnessChan <- witness
+
+	return 0, nil
+}
+
+// genesisSigner returns a genesis signer that publishes a group witness
+// request for the given seedling of a batch. It can produce both key path
+// signatures and multisig script path witnesses.
+func (e *ExternalGroupSigner) genesisSigner(ctx context.Context,
+	batchKey *btcec.PublicKey, assetName string) *externalGenesisSigner {
+
+	return &externalGenesisSigner{
+		ctx:       ctx,
+		signer:    e,
+		batchKey:  batchKey,
+		assetName: assetName,
+	}
+}
+
+// addRequest registers a new pending group witness request.
+func (e *ExternalGroupSigner) addRequest(req *GroupWitnessRequest) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.requests[req.SigHash] = req
+}
+
+// removeRequest removes a group witness request, if it is still pending, along
+// with its MuSig2 session.
+func (e *ExternalGroupSigner) removeRequest(req *GroupWitnessRequest) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	delete(e.requests, req.SigHash)
+	delete(e.sessions, req.SigHash)
+}
+
+// externalGenesisSigner is a genesis signer that obtains the signature for the
+// virtual minting transaction of a single seedling from an external signer.
+type externalGenesisSigner struct {
+	ctx       context.Context
+	signer    *ExternalGroupSigner
+	batchKey  *btcec.PublicKey
+	assetName string
+}
+
+// SignVirtualTx publishes a group witness request for the passed virtual
+// transaction and blocks until a signature is submitted for it.
+//
+// NOTE: This is part of the asset.GenesisSigner interface.
+func (s *externalGenesisSigner) SignVirtualTx(
+	signDesc *lndclient.SignDescriptor, virtualTx *wire.MsgTx,
+	prevOut *wire.TxOut) (*schnorr.Signature, error) {
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(
+		prevOut.PkScript, prevOut.Value,
+	)
+	sigHashes := txscript.NewTxSigHashes(virtualTx, prevOutFetcher)
+	sigHash, err := txscript.CalcTaprootSignatureHash(
+		sigHashes, signDesc.HashType, virtualTx, signDesc.InputIndex,
+		prevOutFetcher,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute sighash: %w", err)
+	}
+
+	req := &GroupWitnessRequest{
+		BatchKey:  s.batchKey,
+		AssetName: s.assetName,
+		SignDesc:  signDesc,
+		VirtualTx: virtualTx,
+		sigChan:   make(chan *schnorr.Signature, 1),
+	}
+	copy(req.SigHash[:], sigHash)
+
+	s.signer.addRequest(req)
+	defer s.signer.removeRequest(req)
+
+	log.Infof("Waiting for external signature of group witness request "+
+		"%x for asset %v", req.SigHash[:], s.assetName)
+
+	select {
+	case sig := <-req.sigChan:
+		return sig, nil
+
+	case <-s.ctx.Done():
+		return nil, fmt.Errorf("waiting for group witness of asset "+
+			"%v aborted: %w", s.assetName, s.ctx.Err())
+	}
+}
+
+// SignVirtualTxScript publishes a multisig group witness request for the
+// passed virtual transaction and blocks until enough signatures are submitted
+// to satisfy the multisig script of the signing descriptor.
+//
+// NOTE: This is part of the asset.GenesisScriptSigner interface.
+func (s *externalGenesisSigner) SignVirtualTxScript(
+	signDesc *lndclient.SignDescriptor, virtualTx *wire.MsgTx,
+	prevOut *wire.TxOut) (wire.TxWitness, error) {
+
+	multiSig, err := ParseGroupMultiSig(signDesc.WitnessScript)
+	if err != nil {
+		return nil, err
+	}
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(
+		prevOut.PkScript, prevOut.Value,
+	)
+	sigHashes := txscript.NewTxSigHashes(virtualTx, prevOutFetcher)
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		sigHashes, signDesc.HashType, virtualTx,
+		int(signDesc.InputIndex), prevOutFetcher,
+		txscript.NewBaseTapLeaf(signDesc.WitnessScript),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute sighash: %w", err)
+	}
+
+	req := &GroupWitnessRequest{
+		BatchKey:    s.batchKey,
+		AssetName:   s.assetName,
+		SignDesc:    signDesc,
+		MultiSig:    multiSig,
+		VirtualTx:   virtualTx,
+		scriptSigs:  make(map[asset.SerializedKey]*schnorr.Signature),
+		witnessChan: make(chan wire.TxWitness, 1),
+	}
+	copy(req.SigHash[:], sigHash)
+
+	s.signer.addRequest(req)
+	defer s.signer.removeRequest(req)
+
+	log.Infof("Waiting for %d-of-%d multisig signatures of group witness "+
+		"request %x for asset %v", multiSig.Threshold,
+		len(multiSig.Keys), req.SigHash[:], s.assetName)
+
+	select {
+	case witness := <-req.witnessChan:
+		return witness, nil
+
+	case <-s.ctx.Done():
+		return nil, fmt.Errorf("waiting for group witness of asset "+
+			"%v aborted: %w", s.assetName, s.ctx.Err())
+	}
+}
+
+// A compile-time assertion to ensure externalGenesisSigner meets the
+// GenesisSigner and GenesisScriptSigner interfaces.
+var _ asset.GenesisSigner = (*externalGenesisSigner)(nil)
+var _ asset.GenesisScriptSigner = (*externalGenesisSigner)(nil)
+
+// delegateGenesisSigner is a genesis script signer that signs the script path
+// spend of an issuance delegation leaf with the delegate key, which is held by
+// the backing lnd node.
+type delegateGenesisSigner struct {
+	signer      asset.GenesisSigner
+	delegateKey keychain.KeyDescriptor
+}
+
+// SignVirtualTxScript signs the passed virtual transaction with the delegate
+// key for the delegation leaf of the signing descriptor. The delegation leaf
+// checks a signature of the untweaked delegate key, so the tweaks of the group
+// key are dropped from the signing descriptor.
+//
+// NOTE: This is part of the asset.GenesisScriptSigner interface.
+func (s *delegateGenesisSigner) SignVirtualTxScript(
+	signDesc *lndclient.SignDescriptor, virtualTx *wire.MsgTx,
+	prevOut *wire.TxOut) (wire.TxWitness, error) {
+
+	delegateSignDesc := *signDesc
+	delegateSignDesc.KeyDesc = s.delegateKey
+	delegateSignDesc.SingleTweak = nil
+	delegateSignDesc.TapTweak = nil
+
+	sig, err := s.signer.SignVirtualTx(
+		&delegateSignDesc, virtualTx, prevOut,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.TxWitness{sig.Serialize()}, nil
+}
+
+// A compile-time assertion to ensure delegateGenesisSigner meets the
+// GenesisScriptSigner interface.
+var _ asset.GenesisScriptSigner = (*delegateGenesisSigner)(nil)
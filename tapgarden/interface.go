@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -12,6 +14,7 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -36,20 +39,93 @@ type Planter interface {
 	// details of a specific batch.
 	ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch, error)
 
-	// CancelSeedling attempts to cancel the creation of a new asset
-	// identified by its name. If the seedling has already progressed to a
-	// point where the genesis PSBT has been broadcasted, an error is
-	// returned.
-	CancelSeedling() error
+	// CancelSeedling removes the seedling for the new asset identified by
+	// its name from the pending batch, and returns the updated pending
+	// batch. If the seedling was the last one in the batch, the whole
+	// batch is cancelled and nil is returned instead. Seedlings of batches
+	// that have already been finalized can't be cancelled.
+	CancelSeedling(assetName string) (*MintingBatch, error)
 
 	// FinalizeBatch signals that the asset minter should finalize
 	// the current batch, if one exists.
-	FinalizeBatch() (*MintingBatch, error)
+	FinalizeBatch(params FinalizeParams) (*MintingBatch, error)
 
 	// CancelBatch signals that the asset minter should cancel the
 	// current batch, if one exists.
 	CancelBatch() (*btcec.PublicKey, error)
 
+	// PreviewBatch estimates the size and fee of the minting
+	// transaction of the pending batch without finalizing it. If no fee
+	// rate is given, the current fee rate estimate is used.
+	PreviewBatch(feeRate *chainfee.SatPerKWeight) (*BatchPreview, error)
+
+	// ValidateMint runs all validation of the given seedlings as if they
+	// were queued, and creates the assets they would mint, without
+	// persisting anything.
+	ValidateMint(params ValidateMintParams) (*MintValidation, error)
+
+	// SetBatchLabel sets the user-defined label of the batch with the
+	// given key, which can be in any state.
+	SetBatchLabel(batchKey *btcec.PublicKey, label string) error
+
+	// BatchSchedule returns the active schedule for automatic
+	// finalization of the pending batch.
+	BatchSchedule() (*BatchSchedule, error)
+
+	// UpdateBatchSchedule replaces the schedule for automatic
+	// finalization of the pending batch.
+	UpdateBatchSchedule(schedule BatchSchedule) (*BatchSchedule, error)
+
+	// GroupWitnessRequests returns the group witness requests of assets
+	// with an externally held group key that wait for a signature.
+	GroupWitnessRequests() ([]*GroupWitnessRequest, error)
+
+	// SubmitGroupWitness submits the signature for the group witness
+	// request with the given signature hash.
+	SubmitGroupWitness(sigHash chainhash.Hash,
+		sig *schnorr.Signature) error
+
+	// SubmitGroupScriptSig submits the signature of one of the multisig
+	// keys for the group witness request with the given signature hash,
+	// and returns the number of signatures that are still missing.
+	SubmitGroupScriptSig(sigHash chainhash.Hash, signer *btcec.PublicKey,
+		sig *schnorr.Signature) (uint32, error)
+
+	// GenesisPsbtRequests returns the genesis PSBT requests of
+	// externally funded batches that wait for the external wallet.
+	GenesisPsbtRequests() ([]*GenesisPsbtRequest, error)
+
+	// SubmitGenesisPsbt submits the funded or signed genesis PSBT of
+	// the externally funded batch with the given key.
+	SubmitGenesisPsbt(batchKey *btcec.PublicKey, pkt *psbt.Packet) error
+
+	// MuSig2GroupSessions returns the MuSig2 signing sessions for group
+	// witness requests that are in progress.
+	MuSig2GroupSessions() ([]*MuSig2GroupSession, error)
+
+	// StartMuSig2GroupSession starts a MuSig2 signing session for the
+	// group witness request with the given signature hash.
+	StartMuSig2GroupSession(sigHash chainhash.Hash,
+		signers []*btcec.PublicKey) (*MuSig2GroupSession, error)
+
+	// RegisterMuSig2GroupNonce registers the public nonce of a signer of
+	// a MuSig2 group witness session.
+	RegisterMuSig2GroupNonce(sigHash chainhash.Hash,
+		signer *btcec.PublicKey,
+		pubNonce [musig2.PubNonceSize]byte) (*MuSig2GroupSession, error)
+
+	// SubmitMuSig2GroupPartialSig submits the partial signature of a
+	// signer of a MuSig2 group witness session.
+	SubmitMuSig2GroupPartialSig(sigHash chainhash.Hash,
+		signer *btcec.PublicKey,
+		partialSig *musig2.PartialSignature) (*MuSig2GroupSession,
+		error)
+
+	// EventPublisher allows callers to subscribe to the state transitions
+	// of all minting batches. Past transitions can optionally be replayed
+	// to new subscribers.
+	fn.EventPublisher[*MintEvent, MintEventReplay]
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -130,6 +206,52 @@ func (b BatchState) String() string {
 	}
 }
 
+// BatchReOrgState tracks whether the minting transaction of a finalized batch
+// was re-organized out of the chain, and whether the batch recovered from it.
+type BatchReOrgState uint8
+
+const (
+	// ReOrgStateNone denotes that the minting transaction of the batch was
+	// never re-organized out of the chain.
+	ReOrgStateNone BatchReOrgState = 0
+
+	// ReOrgStatePending denotes that the minting transaction was
+	// re-organized out of the chain and re-broadcast, but didn't confirm
+	// again yet.
+	ReOrgStatePending BatchReOrgState = 1
+
+	// ReOrgStateRecovered denotes that the minting transaction confirmed
+	// again after a re-org, and the proofs of the batch were updated to
+	// the new block.
+	ReOrgStateRecovered BatchReOrgState = 2
+
+	// ReOrgStateDoubleSpent denotes that the minting transaction was
+	// re-organized out of the chain and an input of it was spent by a
+	// different transaction. The assets can't be re-anchored, as their IDs
+	// commit to the now spent genesis outpoint.
+	ReOrgStateDoubleSpent BatchReOrgState = 3
+)
+
+// String returns a human-readable string for the target re-org state.
+func (b BatchReOrgState) String() string {
+	switch b {
+	case ReOrgStateNone:
+		return "ReOrgStateNone"
+
+	case ReOrgStatePending:
+		return "ReOrgStatePending"
+
+	case ReOrgStateRecovered:
+		return "ReOrgStateRecovered"
+
+	case ReOrgStateDoubleSpent:
+		return "ReOrgStateDoubleSpent"
+
+	default:
+		return fmt.Sprintf("UnknownReOrgState(%d)", b)
+	}
+}
+
 // NewBatchState creates a BatchState from a uint8, returning an error if the
 // input value does not map to a valid BatchState.
 func NewBatchState(state uint8) (BatchState, error) {
@@ -185,6 +307,22 @@ type MintingStore interface {
 	AddSeedlingsToBatch(ctx context.Context, batchKey *btcec.PublicKey,
 		seedlings ...*Seedling) error
 
+	// RemoveSeedlingFromBatch removes the seedling with the given asset
+	// name from an existing batch that is still in the
+	// BatchStatePending state.
+	RemoveSeedlingFromBatch(ctx context.Context, batchKey *btcec.PublicKey,
+		seedlingName string) error
+
+	// MarkBatchExternallyFunded marks the batch identified by the batch
+	// key as funded and signed by an external wallet.
+	MarkBatchExternallyFunded(ctx context.Context,
+		batchKey *btcec.PublicKey) error
+
+	// UpdateBatchLabel sets the user-defined label of the batch
+	// identified by the batch key, replacing any previous label.
+	UpdateBatchLabel(ctx context.Context, batchKey *btcec.PublicKey,
+		label string) error
+
 	// FetchAllBatches fetches all the batches on disk.
 	FetchAllBatches(ctx context.Context) ([]*MintingBatch, error)
 
@@ -199,22 +337,25 @@ type MintingStore interface {
 
 	// AddSproutsToBatch adds a new set of sprouts to the batch, along with
 	// a GenesisPacket, that once signed and broadcast with create the
-	// set of assets on chain.
+	// set of assets on chain. The meta reveals of the sprouts are stored
+	// as well, as they may differ from the ones of the seedlings.
 	//
 	// NOTE: The BatchState should transition to BatchStateCommitted upon a
 	// successful call.
 	AddSproutsToBatch(ctx context.Context, batchKey *btcec.PublicKey,
-		genesisPacket *FundedPsbt, assets *commitment.TapCommitment) error
+		genesisPacket *FundedPsbt, assets *commitment.TapCommitment,
+		assetMetas AssetMetas) error
 
 	// CommitSignedGenesisTx adds a fully signed genesis transaction to the
-	// batch, along with the Taproot Asset script root, which is the
-	// left/right sibling for the Taproot Asset tapscript commitment in the
-	// transaction.
+	// batch, along with the tapscript root of the minting output and the
+	// Taproot Asset script root, which is the left/right sibling for the
+	// Taproot Asset tapscript commitment in the transaction. The two roots
+	// only differ if the minting output has a tapscript sibling.
 	//
 	// NOTE: The BatchState should transition to the BatchStateBroadcast
 	// state upon a successful call.
 	CommitSignedGenesisTx(ctx context.Context, batchKey *btcec.PublicKey,
-		genesisTx *FundedPsbt, anchorOutputIndex uint32,
+		genesisTx *FundedPsbt, anchorOutputIndex uint32, merkleRoot,
 		tapRoot []byte) error
 
 	// MarkBatchConfirmed marks the batch as confirmed on chain. The passed
@@ -227,6 +368,18 @@ type MintingStore interface {
 		blockHash *chainhash.Hash, blockHeight uint32,
 		txIndex uint32, mintingProofs proof.AssetBlobs) error
 
+	// MarkBatchReOrged records that the minting transaction of the batch
+	// was re-organized out of the chain, and sets the given re-org state.
+	MarkBatchReOrged(ctx context.Context, batchKey *btcec.PublicKey,
+		reOrgState BatchReOrgState) error
+
+	// MarkBatchReConfirmed updates the block location of the minting
+	// transaction of the batch after it confirmed again following a
+	// re-org, and marks the batch as recovered.
+	MarkBatchReConfirmed(ctx context.Context, batchKey *btcec.PublicKey,
+		blockHash *chainhash.Hash, blockHeight uint32,
+		txIndex uint32) error
+
 	// FetchGroupByGenesis fetches the asset group created by the genesis
 	// referenced by the given ID.
 	FetchGroupByGenesis(ctx context.Context,
@@ -236,6 +389,46 @@ type MintingStore interface {
 	// key, including the genesis information used to create the group.
 	FetchGroupByGroupKey(ctx context.Context,
 		groupKey *btcec.PublicKey) (*asset.AssetGroup, error)
+
+	// FetchAssetMeta fetches the meta data reveal of the asset with the
+	// given ID. If the asset was created without meta data, nil is
+	// returned.
+	FetchAssetMeta(ctx context.Context,
+		assetID asset.ID) (*proof.MetaReveal, error)
+
+	// FetchGroupSupply returns the total amount of all assets issued into
+	// the asset group with the given tweaked key, including the seedlings
+	// of batches that haven't been cancelled yet.
+	FetchGroupSupply(ctx context.Context,
+		groupKey *btcec.PublicKey) (uint64, error)
+
+	// FetchDelegatedSupply returns the total amount of all assets issued
+	// into the asset group with the given tweaked key by the given
+	// delegate key, including the seedlings of batches that haven't been
+	// cancelled yet.
+	FetchDelegatedSupply(ctx context.Context, groupKey,
+		delegateKey *btcec.PublicKey) (uint64, error)
+
+	// SaveBatchTemplate stores the given batch template on disk. An
+	// existing template with the same name is replaced.
+	SaveBatchTemplate(ctx context.Context, template *BatchTemplate) error
+
+	// FetchBatchTemplate fetches the batch template with the given name.
+	// If no such template exists, ErrBatchTemplateNotFound is returned.
+	FetchBatchTemplate(ctx context.Context,
+		name string) (*BatchTemplate, error)
+
+	// FetchBatchTemplates fetches all batch templates, ordered by name.
+	FetchBatchTemplates(ctx context.Context) ([]*BatchTemplate, error)
+
+	// DeleteBatchTemplate deletes the batch template with the given name.
+	// If no such template exists, ErrBatchTemplateNotFound is returned.
+	DeleteBatchTemplate(ctx context.Context, name string) error
+
+	// EventPublisher allows callers to subscribe to the state transitions
+	// of all minting batches. Past transitions can optionally be replayed
+	// to new subscribers.
+	fn.EventPublisher[*MintEvent, MintEventReplay]
 }
 
 // ChainBridge is our bridge to the target chain. It's used to get confirmation
@@ -250,6 +443,13 @@ type ChainBridge interface {
 		reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent,
 		chan error, error)
 
+	// RegisterSpendNtfn registers an intent to be notified once the given
+	// outpoint is spent on chain.
+	RegisterSpendNtfn(ctx context.Context, outpoint *wire.OutPoint,
+		pkScript []byte,
+		heightHint uint32) (chan *chainntnfs.SpendDetail, chan error,
+		error)
+
 	// RegisterBlockEpochNtfn registers an intent to be notified of each
 	// new block connected to the main chain.
 	RegisterBlockEpochNtfn(ctx context.Context) (chan int32, chan error,
@@ -272,8 +472,9 @@ type ChainBridge interface {
 	CurrentHeight(context.Context) (uint32, error)
 
 	// PublishTransaction attempts to publish a new transaction to the
-	// network.
-	PublishTransaction(context.Context, *wire.MsgTx) error
+	// network. The label is attached to the transaction by backends that
+	// support labelling transactions.
+	PublishTransaction(context.Context, *wire.MsgTx, string) error
 
 	// EstimateFee returns a fee estimate for the confirmation target.
 	EstimateFee(ctx context.Context,
@@ -0,0 +1,61 @@
+package tapgarden
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// MintEvent is an event that is emitted whenever a minting batch transitions
+// to a new state. Mint events are persisted, so they can be replayed to
+// subscribers that missed them.
+type MintEvent struct {
+	// ID is the unique ID of the event. Event IDs are increasing in the
+	// order the events were emitted.
+	ID uint64
+
+	// BatchKey is the key of the batch that transitioned to a new state.
+	BatchKey *btcec.PublicKey
+
+	// BatchState is the state the batch transitioned to.
+	BatchState BatchState
+
+	// BlockHeight is the height of the block that confirmed the batch for
+	// events of the BatchStateConfirmed state, and the height the batch
+	// was created at for all other events.
+	BlockHeight uint32
+
+	// timestamp is the time the event was emitted.
+	timestamp time.Time
+}
+
+// NewMintEvent creates a new mint event.
+func NewMintEvent(id uint64, batchKey *btcec.PublicKey, state BatchState,
+	blockHeight uint32, timestamp time.Time) *MintEvent {
+
+	return &MintEvent{
+		ID:          id,
+		BatchKey:    batchKey,
+		BatchState:  state,
+		BlockHeight: blockHeight,
+		timestamp:   timestamp,
+	}
+}
+
+// Timestamp returns the time the event was emitted.
+func (e *MintEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// MintEventReplay specifies which of the already emitted mint events are
+// delivered to a new subscriber. If neither field is set, all past events are
+// delivered.
+type MintEventReplay struct {
+	// BatchKey, if set, denotes that all events starting with the first
+	// event of the batch with this key should be delivered.
+	BatchKey *btcec.PublicKey
+
+	// StartHeight, if set, denotes that only events with a block height
+	// of at least this height should be delivered.
+	StartHeight uint32
+}
@@ -0,0 +1,187 @@
+package tapgarden
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"golang.org/x/exp/maps"
+)
+
+// ValidateMintParams are the parameters of a dry run of minting a set of new
+// assets.
+type ValidateMintParams struct {
+	// Seedlings are the seedlings to validate, in the order they would be
+	// queued. A seedling can use a seedling of the pending batch or an
+	// earlier seedling of the set as its group anchor.
+	Seedlings []*Seedling
+
+	// GenesisPoint is the optional first input of the minting
+	// transaction. The asset IDs commit to it, so they are only final if
+	// it's set.
+	GenesisPoint *wire.OutPoint
+
+	// AnchorOutputIndex is the index of the minting output in the minting
+	// transaction, which the asset IDs commit to as well.
+	AnchorOutputIndex uint32
+}
+
+// MintValidation is the result of a dry run of minting a set of new assets.
+type MintValidation struct {
+	// Sprouts are the assets that would be minted for the seedlings, in
+	// the order of the seedlings. Script keys that weren't given by the
+	// seedlings, and the group keys of new groups, are placeholders, as
+	// they are only derived once the batch is finalized.
+	Sprouts []*asset.Asset
+
+	// Metas are the meta data reveals of the sprouts, in the same order.
+	// They include the meta data that is created for the seedlings and
+	// the nonce of vanity asset IDs.
+	Metas []*proof.MetaReveal
+
+	// ProvisionalIDs is true if no genesis outpoint was given, in which
+	// case the asset IDs commit to a placeholder outpoint and vanity
+	// asset IDs aren't ground.
+	ProvisionalIDs bool
+}
+
+// newMintValidation creates the sprouts of all seedlings of the given batch
+// and commits to them, the same way the caretaker would once the batch is
+// finalized. Keys that would be derived by the backing lnd node are replaced
+// with placeholders, so no keys are derived and nothing is signed. The
+// seedlings themselves aren't modified.
+func newMintValidation(ctx context.Context, batch *MintingBatch,
+	params ValidateMintParams) (*MintValidation, error) {
+
+	var genesisPoint wire.OutPoint
+	if params.GenesisPoint != nil {
+		genesisPoint = *params.GenesisPoint
+	}
+
+	var (
+		orderedSeedlings = SortSeedlings(maps.Values(batch.Seedlings))
+		sprouts          = make(map[string]*asset.Asset)
+		metas            = make(map[string]*proof.MetaReveal)
+		newGroups        = make(map[string]*asset.GroupKey)
+	)
+	for _, seedlingName := range orderedSeedlings {
+		seedling := batch.Seedlings[seedlingName]
+
+		assetGen := asset.Genesis{
+			FirstPrevOut: genesisPoint,
+			Tag:          seedling.AssetName,
+			OutputIndex:  params.AnchorOutputIndex,
+			Type:         seedling.AssetType,
+		}
+
+		// A vanity asset ID can only be ground once the genesis
+		// outpoint is known.
+		meta := seedling.Meta
+		if seedling.VanityPrefix != "" && params.GenesisPoint != nil {
+			vanityMeta, err := grindVanityMeta(
+				ctx, assetGen, meta, seedling.VanityPrefix,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to grind "+
+					"vanity asset ID for seedling %v: %w",
+					seedlingName, err)
+			}
+
+			meta = vanityMeta
+		}
+		if meta != nil {
+			assetGen.MetaHash = meta.MetaHash()
+		}
+
+		scriptKey := asset.NUMSScriptKey
+		if seedling.ScriptKey != nil {
+			scriptKey = *seedling.ScriptKey
+		}
+
+		amount := seedling.Amount
+		if seedling.AssetType == asset.Collectible {
+			amount = 1
+		}
+
+		// The group key of a new group commits to the ID of the asset
+		// that creates it, so we can compute it for an externally held
+		// raw group key. Otherwise, the raw key is only derived once
+		// the batch is finalized.
+		var groupKey *asset.GroupKey
+		switch {
+		case seedling.HasGroupKey():
+			groupKey = &asset.GroupKey{
+				GroupPubKey: seedling.GroupInfo.GroupPubKey,
+			}
+
+		case seedling.GroupAnchor != nil:
+			anchorKey, ok := newGroups[*seedling.GroupAnchor]
+			if !ok {
+				return nil, fmt.Errorf("group anchor %v not "+
+					"present in batch",
+					*seedling.GroupAnchor)
+			}
+
+			groupKey = anchorKey
+
+		case seedling.EnableEmission:
+			rawGroupKey := asset.NUMSPubKey
+			if seedling.GroupInternalKey != nil {
+				rawGroupKey = seedling.GroupInternalKey.PubKey
+			}
+
+			genesisTweak := assetGen.ID()
+			groupPubKey, err := asset.GroupPubKey(
+				rawGroupKey, genesisTweak[:], nil,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to tweak group "+
+					"key: %w", err)
+			}
+
+			groupKey = &asset.GroupKey{
+				GroupPubKey: *groupPubKey,
+			}
+			newGroups[seedlingName] = groupKey
+		}
+
+		sprout, err := asset.New(
+			assetGen, amount, 0, 0, scriptKey, groupKey,
+			asset.WithAssetVersion(seedling.AssetVersion),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create asset for "+
+				"seedling %v: %w", seedlingName, err)
+		}
+
+		sprouts[seedlingName] = sprout
+		metas[seedlingName] = meta
+	}
+
+	// The assets of all seedlings of the batch must fit into a single
+	// Taproot Asset commitment.
+	_, err := commitment.FromAssets(maps.Values(sprouts)...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to commit to assets: %w", err)
+	}
+
+	numSeedlings := len(params.Seedlings)
+	validation := &MintValidation{
+		Sprouts:        make([]*asset.Asset, 0, numSeedlings),
+		Metas:          make([]*proof.MetaReveal, 0, numSeedlings),
+		ProvisionalIDs: params.GenesisPoint == nil,
+	}
+	for _, seedling := range params.Seedlings {
+		validation.Sprouts = append(
+			validation.Sprouts, sprouts[seedling.AssetName],
+		)
+		validation.Metas = append(
+			validation.Metas, metas[seedling.AssetName],
+		)
+	}
+
+	return validation, nil
+}
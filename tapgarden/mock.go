@@ -240,8 +240,9 @@ type MockChainBridge struct {
 
 	NewBlocks chan int32
 
-	ReqCount int
-	ConfReqs map[int]*chainntnfs.ConfirmationEvent
+	ReqCount   int
+	ConfReqs   map[int]*chainntnfs.ConfirmationEvent
+	ReOrgChans map[int]chan struct{}
 }
 
 func NewMockChainBridge() *MockChainBridge {
@@ -249,6 +250,7 @@ func NewMockChainBridge() *MockChainBridge {
 		FeeEstimateSignal: make(chan struct{}),
 		PublishReq:        make(chan *wire.MsgTx),
 		ConfReqs:          make(map[int]*chainntnfs.ConfirmationEvent),
+		ReOrgChans:        make(map[int]chan struct{}),
 		ConfReqSignal:     make(chan int),
 		BlockEpochSignal:  make(chan struct{}, 1),
 		NewBlocks:         make(chan int32),
@@ -271,7 +273,8 @@ func (m *MockChainBridge) SendConfNtfn(reqNo int, blockHash *chainhash.Hash,
 
 func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	_ *chainhash.Hash, _ []byte, _, _ uint32, _ bool,
-	_ chan struct{}) (*chainntnfs.ConfirmationEvent, chan error, error) {
+	reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent, chan error,
+	error) {
 
 	select {
 	case <-ctx.Done():
@@ -290,6 +293,7 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	errChan := make(chan error)
 
 	m.ConfReqs[m.ReqCount] = req
+	m.ReOrgChans[m.ReqCount] = reOrgChan
 
 	select {
 	case m.ConfReqSignal <- m.ReqCount:
@@ -299,6 +303,21 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	return req, errChan, nil
 }
 
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent on chain. The mock never notifies about spends.
+func (m *MockChainBridge) RegisterSpendNtfn(ctx context.Context,
+	_ *wire.OutPoint, _ []byte,
+	_ uint32) (chan *chainntnfs.SpendDetail, chan error, error) {
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, fmt.Errorf("shutting down")
+	default:
+	}
+
+	return make(chan *chainntnfs.SpendDetail), make(chan error), nil
+}
+
 func (m *MockChainBridge) RegisterBlockEpochNtfn(
 	ctx context.Context) (chan int32, chan error, error) {
 
@@ -345,7 +364,7 @@ func (m *MockChainBridge) CurrentHeight(_ context.Context) (uint32, error) {
 }
 
 func (m *MockChainBridge) PublishTransaction(_ context.Context,
-	tx *wire.MsgTx) error {
+	tx *wire.MsgTx, _ string) error {
 
 	m.PublishReq <- tx
 	return nil
@@ -504,6 +523,12 @@ func (m *MockProofWatcher) WatchProofs([]*proof.Proof,
 	return nil
 }
 
+func (m *MockProofWatcher) WatchProofsWithReOrg([]*proof.Proof,
+	proof.ReOrgCallback, proof.UpdateCallback) error {
+
+	return nil
+}
+
 func (m *MockProofWatcher) MaybeWatch(*proof.File, proof.UpdateCallback) error {
 	return nil
 }
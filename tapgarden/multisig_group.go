@@ -0,0 +1,258 @@
+package tapgarden
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// GroupMultiSig is a k-of-n multisig tapscript leaf that is the only script
+// of an asset group key. Assets are issued into such a group through a script
+// path spend that carries the signatures of at least Threshold of the signer
+// keys, so no single signer can issue assets on their own.
+type GroupMultiSig struct {
+	// Threshold is the number of signatures required to issue assets into
+	// the group.
+	Threshold uint32
+
+	// Keys are the signer keys of the multisig script. They are sorted by
+	// their x-only serialization in the script.
+	Keys []*btcec.PublicKey
+}
+
+// NewGroupMultiSig creates a new k-of-n multisig script for the given signer
+// keys, whose order doesn't matter.
+func NewGroupMultiSig(threshold uint32,
+	keys []*btcec.PublicKey) (*GroupMultiSig, error) {
+
+	sortedKeys := make([]*btcec.PublicKey, len(keys))
+	copy(sortedKeys, keys)
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return bytes.Compare(
+			schnorr.SerializePubKey(sortedKeys[i]),
+			schnorr.SerializePubKey(sortedKeys[j]),
+		) < 0
+	})
+
+	multiSig := &GroupMultiSig{
+		Threshold: threshold,
+		Keys:      sortedKeys,
+	}
+	if err := multiSig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return multiSig, nil
+}
+
+// ParseGroupMultiSig parses a multisig script that was created by
+// GroupMultiSig.Script.
+func ParseGroupMultiSig(script []byte) (*GroupMultiSig, error) {
+	var (
+		threshold int64 = -1
+		keys      []*btcec.PublicKey
+	)
+
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		switch {
+		case len(tokenizer.Data()) == schnorr.PubKeyBytesLen:
+			key, err := schnorr.ParsePubKey(tokenizer.Data())
+			if err != nil {
+				return nil, fmt.Errorf("invalid multisig "+
+					"key: %w", err)
+			}
+			keys = append(keys, key)
+
+		case op == txscript.OP_CHECKSIG, op == txscript.OP_CHECKSIGADD,
+			op == txscript.OP_NUMEQUAL:
+
+		case op >= txscript.OP_1 && op <= txscript.OP_16:
+			threshold = int64(op - (txscript.OP_1 - 1))
+
+		case len(tokenizer.Data()) != 0:
+			num, err := txscript.MakeScriptNum(
+				tokenizer.Data(), true, 4,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid multisig "+
+					"threshold: %w", err)
+			}
+			threshold = int64(num)
+
+		default:
+			return nil, fmt.Errorf("unexpected opcode %d in "+
+				"multisig script", op)
+		}
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, fmt.Errorf("invalid multisig script: %w", err)
+	}
+	if threshold < 0 {
+		return nil, fmt.Errorf("multisig script without threshold")
+	}
+
+	multiSig := &GroupMultiSig{
+		Threshold: uint32(threshold),
+		Keys:      keys,
+	}
+
+	// Only scripts that exactly match our own template are accepted, so
+	// the witness we assemble for them is known to be valid.
+	expected, err := multiSig.Script()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(expected, script) {
+		return nil, fmt.Errorf("script is not a group multisig script")
+	}
+
+	return multiSig, nil
+}
+
+// Validate makes sure the threshold and the signer keys of the multisig
+// script are sane.
+func (g *GroupMultiSig) Validate() error {
+	if len(g.Keys) < 2 {
+		return fmt.Errorf("at least two multisig keys required")
+	}
+
+	if g.Threshold == 0 || g.Threshold > uint32(len(g.Keys)) {
+		return fmt.Errorf("multisig threshold must be between 1 and %d",
+			len(g.Keys))
+	}
+
+	for i := 1; i < len(g.Keys); i++ {
+		prev := schnorr.SerializePubKey(g.Keys[i-1])
+		cur := schnorr.SerializePubKey(g.Keys[i])
+
+		switch bytes.Compare(prev, cur) {
+		case 0:
+			return fmt.Errorf("duplicate multisig key %x", cur)
+
+		case 1:
+			return fmt.Errorf("multisig keys must be sorted")
+		}
+	}
+
+	return nil
+}
+
+// Script returns the tapscript of the multisig leaf:
+//
+//	<key_1> OP_CHECKSIG <key_2> OP_CHECKSIGADD ... <key_n> OP_CHECKSIGADD
+//	<threshold> OP_NUMEQUAL
+func (g *GroupMultiSig) Script() ([]byte, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+
+	builder := txscript.NewScriptBuilder()
+	for i, key := range g.Keys {
+		builder.AddData(schnorr.SerializePubKey(key))
+
+		if i == 0 {
+			builder.AddOp(txscript.OP_CHECKSIG)
+			continue
+		}
+		builder.AddOp(txscript.OP_CHECKSIGADD)
+	}
+	builder.AddInt64(int64(g.Threshold))
+	builder.AddOp(txscript.OP_NUMEQUAL)
+
+	return builder.Script()
+}
+
+// TapLeaf returns the multisig script as a tapscript leaf.
+func (g *GroupMultiSig) TapLeaf() (txscript.TapLeaf, error) {
+	script, err := g.Script()
+	if err != nil {
+		return txscript.TapLeaf{}, err
+	}
+
+	return txscript.NewBaseTapLeaf(script), nil
+}
+
+// TapscriptRoot returns the root of the tapscript tree of a group key that
+// has the multisig script as its only leaf.
+func (g *GroupMultiSig) TapscriptRoot() ([]byte, error) {
+	leaf, err := g.TapLeaf()
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := leaf.TapHash()
+
+	return rootHash[:], nil
+}
+
+// hasKey returns true if the given key is one of the signer keys.
+func (g *GroupMultiSig) hasKey(key *btcec.PublicKey) bool {
+	return g.keyIndex(key) >= 0
+}
+
+// keyIndex returns the index of the given key in the script, or -1 if it isn't
+// one of the signer keys.
+func (g *GroupMultiSig) keyIndex(key *btcec.PublicKey) int {
+	keyBytes := schnorr.SerializePubKey(key)
+	for i, signer := range g.Keys {
+		if bytes.Equal(schnorr.SerializePubKey(signer), keyBytes) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// witness assembles the witness elements that satisfy the multisig script
+// from the given signatures, excluding the script and the control block. The
+// script consumes the signature of the first key first, so the signatures
+// are pushed in reverse key order, with an empty element for every key that
+// didn't sign.
+func (g *GroupMultiSig) witness(
+	sigs map[asset.SerializedKey]*schnorr.Signature) (wire.TxWitness,
+	error) {
+
+	if len(sigs) != int(g.Threshold) {
+		return nil, fmt.Errorf("multisig requires %d signatures, got %d",
+			g.Threshold, len(sigs))
+	}
+
+	witness := make(wire.TxWitness, 0, len(g.Keys))
+	for i := len(g.Keys) - 1; i >= 0; i-- {
+		sig, ok := sigs[asset.ToSerialized(g.Keys[i])]
+		if !ok {
+			witness = append(witness, []byte{})
+			continue
+		}
+
+		witness = append(witness, sig.Serialize())
+	}
+
+	return witness, nil
+}
+
+// IsEqual returns true if both multisig scripts are nil or the same script.
+func (g *GroupMultiSig) IsEqual(other *GroupMultiSig) bool {
+	if g == nil || other == nil {
+		return g == other
+	}
+
+	script, err := g.Script()
+	if err != nil {
+		return false
+	}
+	otherScript, err := other.Script()
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(script, otherScript)
+}
@@ -0,0 +1,435 @@
+package tapgarden
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// MuSig2GroupInternalKey returns the MuSig2 aggregate of the given signer keys,
+// which can be used as the raw key of an asset group that requires all signers
+// to authorize the issuance of assets into the group. The keys are sorted
+// before aggregation, so the order of the signers doesn't matter.
+func MuSig2GroupInternalKey(signers []*btcec.PublicKey) (*btcec.PublicKey,
+	error) {
+
+	if err := validateMuSig2Signers(signers); err != nil {
+		return nil, err
+	}
+
+	aggKey, _, _, err := musig2.AggregateKeys(signers, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to aggregate signer keys: %w",
+			err)
+	}
+
+	return aggKey.PreTweakedKey, nil
+}
+
+// MuSig2GroupTweaks returns the MuSig2 key tweaks that turn the aggregate of
+// the given signer keys into a tweaked group key. The first tweak is the
+// single tweak of the group key, the second one is the taproot tweak of the
+// resulting internal key with the tapscript root of the group key, which is
+// BIP-0086 style if the root is nil. Signers must apply the same tweaks when
+// producing their partial signatures.
+func MuSig2GroupTweaks(signers []*btcec.PublicKey, singleTweak,
+	tapscriptRoot []byte) ([]musig2.KeyTweakDesc, error) {
+
+	if len(singleTweak) != sha256.Size {
+		return nil, fmt.Errorf("single tweak must be %d bytes",
+			sha256.Size)
+	}
+
+	rawKey, err := MuSig2GroupInternalKey(signers)
+	if err != nil {
+		return nil, err
+	}
+
+	internalKey := input.TweakPubKeyWithTweak(rawKey, singleTweak)
+	tapTweak := chainhash.TaggedHash(
+		chainhash.TagTapTweak, schnorr.SerializePubKey(internalKey),
+		tapscriptRoot,
+	)
+
+	tweaks := []musig2.KeyTweakDesc{{
+		IsXOnly: false,
+	}, {
+		Tweak:   *tapTweak,
+		IsXOnly: true,
+	}}
+	copy(tweaks[0].Tweak[:], singleTweak)
+
+	return tweaks, nil
+}
+
+// validateMuSig2Signers makes sure the set of signer keys of a MuSig2 group key
+// is sane.
+func validateMuSig2Signers(signers []*btcec.PublicKey) error {
+	if len(signers) < 2 {
+		return fmt.Errorf("at least two signer keys required")
+	}
+
+	seen := make(map[asset.SerializedKey]struct{}, len(signers))
+	for _, signer := range signers {
+		signerKey := asset.ToSerialized(signer)
+		if _, ok := seen[signerKey]; ok {
+			return fmt.Errorf("duplicate signer key %x",
+				signerKey[:])
+		}
+
+		seen[signerKey] = struct{}{}
+	}
+
+	return nil
+}
+
+// MuSig2GroupSession is the state of the MuSig2 signing session that produces
+// the signature for a group witness request of a group key that is the
+// aggregate of multiple signer keys. The planter acts as the coordinator of the
+// session: it collects the public nonces of all signers, hands out the combined
+// nonce, and combines the partial signatures into the final signature once all
+// signers submitted theirs.
+type MuSig2GroupSession struct {
+	// SigHash is the signature hash of the group witness request the
+	// session produces the signature for.
+	SigHash chainhash.Hash
+
+	// Signers is the sorted set of signer keys that aggregate to the raw
+	// group key.
+	Signers []*btcec.PublicKey
+
+	// Tweaks are the MuSig2 key tweaks the signers must apply to the
+	// aggregate key when signing.
+	Tweaks []musig2.KeyTweakDesc
+
+	// PubNonces are the public nonces of the signers registered so far.
+	PubNonces map[asset.SerializedKey][musig2.PubNonceSize]byte
+
+	// CombinedNonce is the aggregate of the public nonces of all signers.
+	// It is only set once all signers registered their nonce.
+	CombinedNonce *[musig2.PubNonceSize]byte
+
+	// PartialSigs are the partial signatures of the signers received so
+	// far.
+	PartialSigs map[asset.SerializedKey]*musig2.PartialSignature
+
+	// Complete is true once the partial signatures were combined into the
+	// final signature of the group witness request.
+	Complete bool
+}
+
+// hasSigner returns true if the given key is one of the signers of the session.
+func (m *MuSig2GroupSession) hasSigner(signer *btcec.PublicKey) bool {
+	for _, s := range m.Signers {
+		if s.IsEqual(signer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// copy returns a copy of the session that can be handed out to callers.
+func (m *MuSig2GroupSession) copy() *MuSig2GroupSession {
+	sessionCopy := *m
+	sessionCopy.Signers = append([]*btcec.PublicKey{}, m.Signers...)
+	sessionCopy.Tweaks = append([]musig2.KeyTweakDesc{}, m.Tweaks...)
+
+	sessionCopy.PubNonces = make(
+		map[asset.SerializedKey][musig2.PubNonceSize]byte,
+		len(m.PubNonces),
+	)
+	for signer, nonce := range m.PubNonces {
+		sessionCopy.PubNonces[signer] = nonce
+	}
+
+	sessionCopy.PartialSigs = make(
+		map[asset.SerializedKey]*musig2.PartialSignature,
+		len(m.PartialSigs),
+	)
+	for signer, sig := range m.PartialSigs {
+		sessionCopy.PartialSigs[signer] = sig
+	}
+
+	if m.CombinedNonce != nil {
+		combinedNonce := *m.CombinedNonce
+		sessionCopy.CombinedNonce = &combinedNonce
+	}
+
+	return &sessionCopy
+}
+
+// finalNonce computes the final nonce R of the signature that is produced by
+// the session, as specified by BIP-0327.
+func (m *MuSig2GroupSession) finalNonce(
+	groupKey *btcec.PublicKey) (*btcec.PublicKey, error) {
+
+	var nonceMsg bytes.Buffer
+	nonceMsg.Write(m.CombinedNonce[:])
+	nonceMsg.Write(schnorr.SerializePubKey(groupKey))
+	nonceMsg.Write(m.SigHash[:])
+	nonceCoefHash := chainhash.TaggedHash(
+		musig2.NonceBlindTag, nonceMsg.Bytes(),
+	)
+
+	var nonceCoef btcec.ModNScalar
+	nonceCoef.SetByteSlice(nonceCoefHash[:])
+
+	r1, err := btcec.ParseJacobian(
+		m.CombinedNonce[:btcec.PubKeyBytesLenCompressed],
+	)
+	if err != nil {
+		return nil, err
+	}
+	r2, err := btcec.ParseJacobian(
+		m.CombinedNonce[btcec.PubKeyBytesLenCompressed:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// R = R1 + b*R2, with the generator being used instead of the point at
+	// infinity.
+	var nonce btcec.JacobianPoint
+	btcec.ScalarMultNonConst(&nonceCoef, &r2, &r2)
+	btcec.AddNonConst(&r1, &r2, &nonce)
+	if (nonce.X.IsZero() && nonce.Y.IsZero()) || nonce.Z.IsZero() {
+		btcec.Generator().AsJacobian(&nonce)
+	}
+
+	nonce.ToAffine()
+
+	return btcec.NewPublicKey(&nonce.X, &nonce.Y), nil
+}
+
+// StartMuSig2Session starts a MuSig2 signing session for the group witness
+// request with the given signature hash. The signer keys must aggregate to the
+// raw group key of the request.
+func (e *ExternalGroupSigner) StartMuSig2Session(sigHash chainhash.Hash,
+	signers []*btcec.PublicKey) (*MuSig2GroupSession, error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	req, ok := e.requests[sigHash]
+	if !ok {
+		return nil, fmt.Errorf("%w: %x", ErrGroupWitnessRequestNotFound,
+			sigHash[:])
+	}
+
+	if req.MultiSig != nil {
+		return nil, fmt.Errorf("group witness request %x requires "+
+			"multisig signatures", sigHash[:])
+	}
+
+	if _, ok := e.sessions[sigHash]; ok {
+		return nil, fmt.Errorf("musig2 session for group witness "+
+			"request %x already started", sigHash[:])
+	}
+
+	rawKey, err := MuSig2GroupInternalKey(signers)
+	if err != nil {
+		return nil, err
+	}
+	if !rawKey.IsEqual(req.SignDesc.KeyDesc.PubKey) {
+		return nil, fmt.Errorf("signer keys don't aggregate to the " +
+			"raw group key")
+	}
+
+	tweaks, err := MuSig2GroupTweaks(
+		signers, req.SignDesc.SingleTweak, req.SignDesc.TapTweak,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sortedSigners := append([]*btcec.PublicKey{}, signers...)
+	sort.Slice(sortedSigners, func(i, j int) bool {
+		return bytes.Compare(
+			sortedSigners[i].SerializeCompressed(),
+			sortedSigners[j].SerializeCompressed(),
+		) < 0
+	})
+
+	session := &MuSig2GroupSession{
+		SigHash: sigHash,
+		Signers: sortedSigners,
+		Tweaks:  tweaks,
+		PubNonces: make(
+			map[asset.SerializedKey][musig2.PubNonceSize]byte,
+		),
+		PartialSigs: make(
+			map[asset.SerializedKey]*musig2.PartialSignature,
+		),
+	}
+	e.sessions[sigHash] = session
+
+	return session.copy(), nil
+}
+
+// MuSig2Sessions returns all MuSig2 signing sessions that are in progress.
+func (e *ExternalGroupSigner) MuSig2Sessions() []*MuSig2GroupSession {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	sessions := make([]*MuSig2GroupSession, 0, len(e.sessions))
+	for _, session := range e.sessions {
+		sessions = append(sessions, session.copy())
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return bytes.Compare(
+			sessions[i].SigHash[:], sessions[j].SigHash[:],
+		) < 0
+	})
+
+	return sessions
+}
+
+// RegisterMuSig2Nonce registers the public nonce of a signer of the MuSig2
+// session with the given signature hash. Once the nonces of all signers are
+// known, the combined nonce is available for signing.
+func (e *ExternalGroupSigner) RegisterMuSig2Nonce(sigHash chainhash.Hash,
+	signer *btcec.PublicKey,
+	pubNonce [musig2.PubNonceSize]byte) (*MuSig2GroupSession, error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	session, err := e.fetchSession(sigHash, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	signerKey := asset.ToSerialized(signer)
+	if _, ok := session.PubNonces[signerKey]; ok {
+		return nil, fmt.Errorf("nonce of signer %x already "+
+			"registered", signerKey[:])
+	}
+
+	session.PubNonces[signerKey] = pubNonce
+
+	if len(session.PubNonces) == len(session.Signers) {
+		nonces := make(
+			[][musig2.PubNonceSize]byte, 0, len(session.Signers),
+		)
+		for _, nonce := range session.PubNonces {
+			nonces = append(nonces, nonce)
+		}
+
+		combinedNonce, err := musig2.AggregateNonces(nonces)
+		if err != nil {
+			delete(session.PubNonces, signerKey)
+			return nil, fmt.Errorf("unable to combine nonces: %w",
+				err)
+		}
+
+		session.CombinedNonce = &combinedNonce
+	}
+
+	return session.copy(), nil
+}
+
+// SubmitMuSig2PartialSig submits the partial signature of a signer of the
+// MuSig2 session with the given signature hash. Once the partial signatures of
+// all signers were received, they are combined into the signature of the group
+// witness request, which completes the session.
+func (e *ExternalGroupSigner) SubmitMuSig2PartialSig(sigHash chainhash.Hash,
+	signer *btcec.PublicKey,
+	partialSig *musig2.PartialSignature) (*MuSig2GroupSession, error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	session, err := e.fetchSession(sigHash, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.CombinedNonce == nil {
+		return nil, fmt.Errorf("nonces of all signers must be " +
+			"registered before signing")
+	}
+
+	signerKey := asset.ToSerialized(signer)
+	if _, ok := session.PartialSigs[signerKey]; ok {
+		return nil, fmt.Errorf("partial signature of signer %x "+
+			"already received", signerKey[:])
+	}
+
+	validSig := partialSig.Verify(
+		session.PubNonces[signerKey], *session.CombinedNonce,
+		session.Signers, signer, session.SigHash,
+		musig2.WithSortedKeys(), musig2.WithTweaks(session.Tweaks...),
+	)
+	if !validSig {
+		return nil, fmt.Errorf("invalid partial signature of signer "+
+			"%x", signerKey[:])
+	}
+
+	session.PartialSigs[signerKey] = partialSig
+	if len(session.PartialSigs) < len(session.Signers) {
+		return session.copy(), nil
+	}
+
+	// With all partial signatures in place, we can produce the final
+	// signature and hand it to the caretaker waiting for it.
+	groupKey, err := e.requests[sigHash].TweakedGroupKey()
+	if err != nil {
+		return nil, err
+	}
+
+	finalNonce, err := session.finalNonce(groupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	partialSigs := make(
+		[]*musig2.PartialSignature, 0, len(session.PartialSigs),
+	)
+	for _, sig := range session.PartialSigs {
+		partialSigs = append(partialSigs, sig)
+	}
+
+	sig := musig2.CombineSigs(
+		finalNonce, partialSigs, musig2.WithTweakedCombine(
+			session.SigHash, session.Signers, session.Tweaks, true,
+		),
+	)
+	if err := e.submitSignature(sigHash, sig); err != nil {
+		return nil, err
+	}
+
+	session.Complete = true
+
+	return session.copy(), nil
+}
+
+// fetchSession returns the MuSig2 session with the given signature hash, making
+// sure the given key is one of its signers.
+//
+// NOTE: The mutex must be held when calling this method.
+func (e *ExternalGroupSigner) fetchSession(sigHash chainhash.Hash,
+	signer *btcec.PublicKey) (*MuSig2GroupSession, error) {
+
+	session, ok := e.sessions[sigHash]
+	if !ok {
+		return nil, fmt.Errorf("no musig2 session for group witness "+
+			"request %x", sigHash[:])
+	}
+
+	if !session.hasSigner(signer) {
+		return nil, fmt.Errorf("key %x is not a signer of the "+
+			"session", signer.SerializeCompressed())
+	}
+
+	return session, nil
+}
@@ -3,10 +3,15 @@ package tapgarden
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -14,6 +19,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
 	"golang.org/x/exp/maps"
 )
@@ -50,6 +57,14 @@ type GardenKit struct {
 	// that support reissuance.
 	TxValidator tapscript.TxValidator
 
+	// ExternalSigner collects the group witnesses of assets whose group
+	// key is held by an external signer.
+	ExternalSigner *ExternalGroupSigner
+
+	// ExternalFunder collects the funded and signed genesis PSBTs of
+	// batches that are funded by an external wallet.
+	ExternalFunder *ExternalGenesisFunder
+
 	// ProofFiles stores the set of flat proof files.
 	ProofFiles proof.Archiver
 
@@ -74,6 +89,11 @@ type PlanterConfig struct {
 	// all asset requests into a new batch.
 	BatchTicker *ticker.Force
 
+	// BatchSchedule is the initial schedule that governs when the pending
+	// batch is finalized automatically. It can be changed at runtime
+	// through UpdateBatchSchedule.
+	BatchSchedule BatchSchedule
+
 	// ProofUpdates is the storage backend for updated proofs.
 	ProofUpdates proof.Archiver
 
@@ -171,6 +191,12 @@ const (
 	reqTypeListBatches
 	reqTypeFinalizeBatch
 	reqTypeCancelBatch
+	reqTypeBatchSchedule
+	reqTypeUpdateBatchSchedule
+	reqTypeCancelSeedling
+	reqTypePreviewBatch
+	reqTypeSetBatchLabel
+	reqTypeValidateMint
 )
 
 // ChainPlanter is responsible for accepting new incoming requests to create
@@ -205,6 +231,15 @@ type ChainPlanter struct {
 	// the planter will come across.
 	stateReqs chan stateRequest
 
+	// schedule is the active schedule for automatic finalization of the
+	// pending batch. It's only accessed by the gardener goroutine.
+	schedule BatchSchedule
+
+	// scheduleTimer fires once the pending batch reaches the deadline of
+	// the schedule. It's nil if there is no pending batch or timed
+	// finalization is disabled.
+	scheduleTimer *time.Timer
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -218,6 +253,7 @@ func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 		completionSignals: make(chan BatchKey),
 		seedlingReqs:      make(chan *Seedling),
 		stateReqs:         make(chan stateRequest),
+		schedule:          cfg.BatchSchedule,
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -234,13 +270,23 @@ func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch) *BatchCaretaker
 		GardenKit:             c.cfg.GardenKit,
 		BroadcastCompleteChan: make(chan struct{}, 1),
 		BroadcastErrChan:      make(chan error, 1),
+		ExternalFundingChan:   make(chan struct{}, 1),
 		SignalCompletion: func() {
 			c.completionSignals <- batchKey
 		},
-		CancelReqChan:       make(chan struct{}, 1),
-		CancelRespChan:      make(chan CancelResp, 1),
-		UpdateMintingProofs: c.updateMintingProofs,
-		ErrChan:             c.cfg.ErrChan,
+		CancelReqChan:  make(chan struct{}, 1),
+		CancelRespChan: make(chan CancelResp, 1),
+		UpdateMintingProofs: func(proofs []*proof.Proof) error {
+			return c.updateMintingProofs(
+				batch.BatchKey.PubKey, proofs,
+			)
+		},
+		ReOrgMintingTx: func(_ *wire.MsgTx, broadcastErr error) error {
+			return c.reOrgMintingTx(
+				batch.BatchKey.PubKey, broadcastErr,
+			)
+		},
+		ErrChan: c.cfg.ErrChan,
 	})
 	c.caretakers[batchKey] = caretaker
 
@@ -463,6 +509,143 @@ func (c *ChainPlanter) cancelMintingBatch(ctx context.Context,
 	return nil
 }
 
+// cancelSeedling removes the seedling with the given asset name from the
+// pending batch. If it's the last seedling of the batch, the batch is cancelled
+// as a whole, since there's nothing left to mint.
+func (c *ChainPlanter) cancelSeedling(ctx context.Context,
+	assetName string) error {
+
+	if c.pendingBatch == nil {
+		return fmt.Errorf("no pending batch")
+	}
+
+	if _, ok := c.pendingBatch.Seedlings[assetName]; !ok {
+		return fmt.Errorf("seedling %v not found in pending batch",
+			assetName)
+	}
+
+	// Other seedlings of the batch may depend on this seedling to create
+	// the group they are minted into, in which case those need to be
+	// cancelled first.
+	for _, seedling := range c.pendingBatch.Seedlings {
+		if seedling.GroupAnchor != nil &&
+			*seedling.GroupAnchor == assetName {
+
+			return fmt.Errorf("seedling %v is the group anchor of "+
+				"seedling %v", assetName, seedling.AssetName)
+		}
+	}
+
+	batchKey := c.pendingBatch.BatchKey.PubKey
+
+	if len(c.pendingBatch.Seedlings) == 1 {
+		log.Infof("Cancelling last seedling %v, cancelling "+
+			"MintingBatch(key=%x)", assetName,
+			batchKey.SerializeCompressed())
+
+		err := c.cfg.Log.UpdateBatchState(
+			ctx, batchKey, BatchStateSeedlingCancelled,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to cancel minting batch: %w",
+				err)
+		}
+
+		c.pendingBatch = nil
+		c.resetScheduleTimer()
+
+		return nil
+	}
+
+	log.Infof("Removing seedling %v from MintingBatch(key=%x)", assetName,
+		batchKey.SerializeCompressed())
+
+	err := c.cfg.Log.RemoveSeedlingFromBatch(ctx, batchKey, assetName)
+	if err != nil {
+		return fmt.Errorf("unable to remove seedling: %w", err)
+	}
+
+	delete(c.pendingBatch.Seedlings, assetName)
+
+	return nil
+}
+
+// previewBatch estimates the cost of the minting transaction of the pending
+// batch at the fee rate of the given preview request. If the request has no fee
+// rate, the fee rate the caretaker would use to fund the transaction is
+// estimated.
+func (c *ChainPlanter) previewBatch(ctx context.Context,
+	req stateRequest) (*BatchPreview, error) {
+
+	feeRateParam, err := typedParam[*chainfee.SatPerKWeight](req)
+	if err != nil {
+		return nil, fmt.Errorf("bad fee rate: %w", err)
+	}
+	feeRate := *feeRateParam
+
+	if c.pendingBatch == nil {
+		return nil, fmt.Errorf("no pending batch")
+	}
+
+	if feeRate == nil {
+		estimate, err := c.cfg.ChainBridge.EstimateFee(
+			ctx, GenesisConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
+
+		feeRate = &estimate
+	}
+
+	return newBatchPreview(c.pendingBatch, *feeRate), nil
+}
+
+// validateMint validates the given seedlings as if they were queued one after
+// the other, and creates the assets they would mint. The seedlings are added
+// to a copy of the pending batch, so nothing is persisted.
+func (c *ChainPlanter) validateMint(ctx context.Context,
+	params ValidateMintParams) (*MintValidation, error) {
+
+	if len(params.Seedlings) == 0 {
+		return nil, fmt.Errorf("no seedlings to validate")
+	}
+
+	batch := &MintingBatch{
+		Seedlings: make(map[string]*Seedling),
+	}
+	if c.pendingBatch != nil {
+		batch.TapscriptSibling = c.pendingBatch.TapscriptSibling
+		for name, seedling := range c.pendingBatch.Seedlings {
+			batch.Seedlings[name] = seedling
+		}
+	}
+
+	for _, seedling := range params.Seedlings {
+		// The first seedling creates a new batch if there's no pending
+		// one, which determines the tapscript sibling of the batch.
+		var seedlingBatch *MintingBatch
+		if len(batch.Seedlings) != 0 {
+			seedlingBatch = batch
+		} else {
+			batch.TapscriptSibling = seedling.TapscriptSibling
+		}
+
+		err := c.validateSeedling(ctx, seedling, seedlingBatch)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seedling %v: %w",
+				seedling.AssetName, err)
+		}
+
+		if err := batch.addSeedling(seedling); err != nil {
+			return nil, err
+		}
+	}
+
+	return newMintValidation(ctx, batch, params)
+}
+
 // gardener is responsible for collecting new potential taproot asset
 // seeds/seedlings into a batch to ultimately be anchored in a genesis output
 // creating the assets from seedlings into sprouts, and eventually fully grown
@@ -485,16 +668,21 @@ func (c *ChainPlanter) gardener() {
 				continue
 			}
 
-			_, err := c.finalizeBatch()
-			if err != nil {
-				c.cfg.ErrChan <- fmt.Errorf("unable to freeze "+
-					"minting batch: %w", err)
+			c.finalizePendingBatch()
+
+		// The pending batch reached the deadline of the batch
+		// schedule, so we'll finalize it.
+		case <-c.scheduleTimeout():
+			c.scheduleTimer = nil
+
+			if c.pendingBatch == nil {
 				continue
 			}
 
-			// Now that we have a caretaker launched for this
-			// batch, we'll set the pending batch to nil
-			c.pendingBatch = nil
+			log.Infof("Pending batch reached scheduled deadline, " +
+				"finalizing")
+
+			c.finalizePendingBatch()
 
 		// A request for new asset issuance just arrived, add this to
 		// the pending batch and acknowledge the receipt back to the
@@ -528,6 +716,20 @@ func (c *ChainPlanter) gardener() {
 				NewState:     MintingStateSeed,
 			}
 
+			// A new batch needs a timer for its deadline. If the
+			// seedling instead pushed the batch over one of the
+			// thresholds of the schedule, we finalize it right
+			// away.
+			if c.scheduleTimer == nil {
+				c.resetScheduleTimer()
+			}
+			if c.schedule.ThresholdReached(c.pendingBatch) {
+				log.Infof("Pending batch reached scheduled " +
+					"threshold, finalizing")
+
+				c.finalizePendingBatch()
+			}
+
 		// A caretaker has finished processing their batch to full
 		// Taproot Asset maturity. We'll clean up our local state, and
 		// signal that it can exit.
@@ -581,14 +783,31 @@ func (c *ChainPlanter) gardener() {
 				req.Resolve(batches)
 
 			case reqTypeFinalizeBatch:
+				params, err := typedParam[FinalizeParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad finalize "+
+						"params: %w", err))
+					break
+				}
+
 				if c.pendingBatch == nil {
 					req.Error(fmt.Errorf("no pending batch"))
 					break
 				}
 
 				batchKey := c.pendingBatch.BatchKey.PubKey
-				log.Infof("Finalizing batch %x",
-					batchKey.SerializeCompressed())
+				log.Infof("Finalizing batch %x, "+
+					"external_funding=%v",
+					batchKey.SerializeCompressed(),
+					params.ExternalFunding)
+
+				if params.ExternalFunding {
+					err := c.markExternalFunding()
+					if err != nil {
+						req.Error(err)
+						break
+					}
+				}
 
 				caretaker, err := c.finalizeBatch()
 				if err != nil {
@@ -599,11 +818,18 @@ func (c *ChainPlanter) gardener() {
 				}
 
 				// We now wait for the caretaker to either
-				// broadcast the batch or fail to do so.
+				// broadcast the batch or fail to do so. An
+				// externally funded batch can only be
+				// broadcast once the external wallet funded
+				// and signed its genesis PSBT, so we only wait
+				// for that PSBT to be handed out.
 				select {
 				case <-caretaker.cfg.BroadcastCompleteChan:
 					req.Resolve(caretaker.cfg.Batch)
 
+				case <-caretaker.cfg.ExternalFundingChan:
+					req.Resolve(caretaker.cfg.Batch)
+
 				case err := <-caretaker.cfg.BroadcastErrChan:
 					req.Error(err)
 					continue
@@ -616,6 +842,7 @@ func (c *ChainPlanter) gardener() {
 				// this batch and broadcast its minting
 				// transaction, we can remove the pending batch.
 				c.pendingBatch = nil
+				c.resetScheduleTimer()
 
 			case reqTypeCancelBatch:
 				batchKey, err := c.canCancelBatch()
@@ -630,10 +857,117 @@ func (c *ChainPlanter) gardener() {
 				err = c.cancelMintingBatch(ctx, batchKey)
 				cancel()
 				c.pendingBatch = nil
+				c.resetScheduleTimer()
 
 				// Always return the key of the batch we tried
 				// to cancel.
 				req.Return(batchKey, err)
+
+			case reqTypeBatchSchedule:
+				req.Resolve(c.schedule)
+
+			case reqTypeUpdateBatchSchedule:
+				schedule, err := typedParam[BatchSchedule](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch "+
+						"schedule: %w", err))
+					break
+				}
+
+				if err := schedule.Validate(); err != nil {
+					req.Error(err)
+					break
+				}
+
+				log.Infof("Updating batch schedule: "+
+					"interval=%v, max_seedlings=%v, "+
+					"max_meta_size=%v", schedule.Interval,
+					schedule.MaxSeedlings,
+					schedule.MaxMetaSize)
+
+				c.schedule = *schedule
+				req.Resolve(c.schedule)
+
+				// The new schedule may put the deadline of the
+				// pending batch in the past or lower a
+				// threshold below what the batch already
+				// holds, in which case the batch is finalized
+				// right away.
+				c.resetScheduleTimer()
+				if c.pendingBatch != nil &&
+					c.schedule.ThresholdReached(
+						c.pendingBatch,
+					) {
+
+					c.finalizePendingBatch()
+				}
+
+			case reqTypeCancelSeedling:
+				assetName, err := typedParam[string](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad asset name: "+
+						"%w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				err = c.cancelSeedling(ctx, *assetName)
+				cancel()
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				req.Resolve(c.pendingBatch)
+
+			case reqTypePreviewBatch:
+				ctx, cancel := c.WithCtxQuit()
+				preview, err := c.previewBatch(ctx, req)
+				cancel()
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				req.Resolve(preview)
+
+			case reqTypeValidateMint:
+				params, err := typedParam[ValidateMintParams](
+					req,
+				)
+				if err != nil {
+					req.Error(fmt.Errorf("bad validate "+
+						"mint params: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				validation, err := c.validateMint(ctx, *params)
+				cancel()
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				req.Resolve(validation)
+
+			case reqTypeSetBatchLabel:
+				params, err := typedParam[batchLabelParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch label "+
+						"params: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				err = c.setBatchLabel(ctx, *params)
+				cancel()
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				req.Resolve(true)
 			}
 
 		case <-c.Quit:
@@ -642,6 +976,110 @@ func (c *ChainPlanter) gardener() {
 	}
 }
 
+// finalizePendingBatch finalizes the pending batch without waiting for the
+// caretaker to broadcast the minting transaction. Any error is reported on the
+// main error channel.
+func (c *ChainPlanter) finalizePendingBatch() {
+	_, err := c.finalizeBatch()
+	if err != nil {
+		c.cfg.ErrChan <- fmt.Errorf("unable to freeze minting "+
+			"batch: %w", err)
+		return
+	}
+
+	// Now that we have a caretaker launched for this batch, we'll set the
+	// pending batch to nil.
+	c.pendingBatch = nil
+	c.resetScheduleTimer()
+}
+
+// resetScheduleTimer stops the timer for the deadline of the batch schedule
+// and, if there is a pending batch and timed finalization is enabled, arms it
+// again for the current deadline.
+func (c *ChainPlanter) resetScheduleTimer() {
+	if c.scheduleTimer != nil {
+		c.scheduleTimer.Stop()
+		c.scheduleTimer = nil
+	}
+
+	if c.pendingBatch == nil {
+		return
+	}
+
+	deadline, ok := c.schedule.Deadline(c.pendingBatch)
+	if !ok {
+		return
+	}
+
+	// A deadline in the past results in a negative duration, which makes
+	// the timer fire immediately.
+	c.scheduleTimer = time.NewTimer(time.Until(deadline))
+}
+
+// scheduleTimeout returns the channel of the deadline timer of the batch
+// schedule, or nil (which blocks forever in a select) if no timer is armed.
+func (c *ChainPlanter) scheduleTimeout() <-chan time.Time {
+	if c.scheduleTimer == nil {
+		return nil
+	}
+
+	return c.scheduleTimer.C
+}
+
+// markExternalFunding marks the pending batch as funded and signed by an
+// external wallet, both on disk and in memory.
+func (c *ChainPlanter) markExternalFunding() error {
+	if c.cfg.ExternalFunder == nil {
+		return fmt.Errorf("external funding not supported")
+	}
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	batchKey := c.pendingBatch.BatchKey.PubKey
+	err := c.cfg.Log.MarkBatchExternallyFunded(ctx, batchKey)
+	if err != nil {
+		return fmt.Errorf("unable to mark batch as externally "+
+			"funded: %w", err)
+	}
+
+	c.pendingBatch.ExternalFunding = true
+
+	return nil
+}
+
+// batchLabelParams holds the arguments of a request to set the label of a
+// batch.
+type batchLabelParams struct {
+	batchKey *btcec.PublicKey
+	label    string
+}
+
+// setBatchLabel sets the label of a batch on disk and, if the batch is the
+// pending batch, also in memory.
+func (c *ChainPlanter) setBatchLabel(ctx context.Context,
+	params batchLabelParams) error {
+
+	if len(params.label) > MaxBatchLabelLen {
+		return fmt.Errorf("%w: %d bytes exceeds limit of %d bytes",
+			ErrBatchLabelTooLong, len(params.label),
+			MaxBatchLabelLen)
+	}
+
+	err := c.cfg.Log.UpdateBatchLabel(ctx, params.batchKey, params.label)
+	if err != nil {
+		return fmt.Errorf("unable to update batch label: %w", err)
+	}
+
+	if c.pendingBatch != nil &&
+		c.pendingBatch.BatchKey.PubKey.IsEqual(params.batchKey) {
+
+		c.pendingBatch.Label = params.label
+	}
+
+	return nil
+}
+
 // finalizeBatch creates a new caretaker for the batch and starts it.
 func (c *ChainPlanter) finalizeBatch() (*BatchCaretaker, error) {
 	// Prep the new care taker that'll be launched assuming the call below
@@ -706,9 +1144,19 @@ func (c *ChainPlanter) ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch,
 	return <-req.resp, <-req.err
 }
 
+// FinalizeParams are the parameters for finalizing the pending batch.
+type FinalizeParams struct {
+	// ExternalFunding denotes that the genesis transaction of the batch is
+	// funded and signed by an external wallet instead of the backing
+	// wallet.
+	ExternalFunding bool
+}
+
 // FinalizeBatch sends a signal to the planter to finalize the current batch.
-func (c *ChainPlanter) FinalizeBatch() (*MintingBatch, error) {
-	req := newStateReq[*MintingBatch](reqTypeFinalizeBatch)
+func (c *ChainPlanter) FinalizeBatch(params FinalizeParams) (*MintingBatch,
+	error) {
+
+	req := newStateParamReq[*MintingBatch](reqTypeFinalizeBatch, params)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
@@ -728,17 +1176,332 @@ func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
 	return <-req.resp, <-req.err
 }
 
-// prepAssetSeedling performs some basic validation for the Seedling, then
-// either adds it to an existing pending batch or creates a new batch for it. A
-// bool indicating if a new batch should immediately be created is returned.
-func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
-	req *Seedling) error {
+// PreviewBatch estimates the size and fee of the minting transaction of the
+// pending batch without finalizing it. If no fee rate is given, the fee rate
+// that would be used to fund the transaction right now is estimated.
+func (c *ChainPlanter) PreviewBatch(
+	feeRate *chainfee.SatPerKWeight) (*BatchPreview, error) {
+
+	req := newStateParamReq[*BatchPreview](reqTypePreviewBatch, feeRate)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// ValidateMint validates the given seedlings without queueing them, and returns
+// the assets they would mint.
+func (c *ChainPlanter) ValidateMint(params ValidateMintParams) (
+	*MintValidation, error) {
+
+	req := newStateParamReq[*MintValidation](reqTypeValidateMint, params)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// SetBatchLabel sets the user-defined label of the batch with the given key,
+// which can be in any state.
+func (c *ChainPlanter) SetBatchLabel(batchKey *btcec.PublicKey,
+	label string) error {
+
+	req := newStateParamReq[bool](reqTypeSetBatchLabel, batchLabelParams{
+		batchKey: batchKey,
+		label:    label,
+	})
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return fmt.Errorf("chain planter shutting down")
+	}
+
+	_, err := <-req.resp, <-req.err
+	return err
+}
+
+// BatchSchedule returns the currently active schedule for automatic
+// finalization of the pending batch.
+func (c *ChainPlanter) BatchSchedule() (*BatchSchedule, error) {
+	req := newStateReq[BatchSchedule](reqTypeBatchSchedule)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	schedule := <-req.resp
+	return &schedule, nil
+}
+
+// UpdateBatchSchedule replaces the schedule for automatic finalization of the
+// pending batch. If the pending batch already satisfies the new schedule, it is
+// finalized immediately.
+func (c *ChainPlanter) UpdateBatchSchedule(
+	schedule BatchSchedule) (*BatchSchedule, error) {
+
+	req := newStateParamReq[BatchSchedule](
+		reqTypeUpdateBatchSchedule, schedule,
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	newSchedule, err := <-req.resp, <-req.err
+	if err != nil {
+		return nil, err
+	}
+
+	return &newSchedule, nil
+}
+
+// GroupWitnessRequests returns the group witness requests of assets with an
+// externally held group key that wait for a signature.
+func (c *ChainPlanter) GroupWitnessRequests() ([]*GroupWitnessRequest,
+	error) {
+
+	if c.cfg.ExternalSigner == nil {
+		return nil, fmt.Errorf("external group signer not supported")
+	}
+
+	return c.cfg.ExternalSigner.PendingRequests(), nil
+}
+
+// SubmitGroupWitness submits the signature for the group witness request with
+// the given signature hash.
+func (c *ChainPlanter) SubmitGroupWitness(sigHash chainhash.Hash,
+	sig *schnorr.Signature) error {
+
+	if c.cfg.ExternalSigner == nil {
+		return fmt.Errorf("external group signer not supported")
+	}
+
+	return c.cfg.ExternalSigner.SubmitSignature(sigHash, sig)
+}
+
+// SubmitGroupScriptSig submits the signature of one of the multisig keys for
+// the group witness request with the given signature hash.
+func (c *ChainPlanter) SubmitGroupScriptSig(sigHash chainhash.Hash,
+	signer *btcec.PublicKey, sig *schnorr.Signature) (uint32, error) {
+
+	if c.cfg.ExternalSigner == nil {
+		return 0, fmt.Errorf("external group signer not supported")
+	}
+
+	return c.cfg.ExternalSigner.SubmitScriptSignature(sigHash, signer, sig)
+}
+
+// GenesisPsbtRequests returns the genesis PSBT requests of externally funded
+// batches that wait for the external wallet.
+func (c *ChainPlanter) GenesisPsbtRequests() ([]*GenesisPsbtRequest, error) {
+	if c.cfg.ExternalFunder == nil {
+		return nil, fmt.Errorf("external funding not supported")
+	}
+
+	return c.cfg.ExternalFunder.PendingRequests(), nil
+}
+
+// SubmitGenesisPsbt submits the funded or signed genesis PSBT of the
+// externally funded batch with the given key.
+func (c *ChainPlanter) SubmitGenesisPsbt(batchKey *btcec.PublicKey,
+	pkt *psbt.Packet) error {
+
+	if c.cfg.ExternalFunder == nil {
+		return fmt.Errorf("external funding not supported")
+	}
+
+	return c.cfg.ExternalFunder.SubmitPsbt(batchKey, pkt)
+}
+
+// MuSig2GroupSessions returns the MuSig2 signing sessions for group witness
+// requests that are in progress.
+func (c *ChainPlanter) MuSig2GroupSessions() ([]*MuSig2GroupSession, error) {
+	if c.cfg.ExternalSigner == nil {
+		return nil, fmt.Errorf("external group signer not supported")
+	}
+
+	return c.cfg.ExternalSigner.MuSig2Sessions(), nil
+}
+
+// StartMuSig2GroupSession starts a MuSig2 signing session for the group witness
+// request with the given signature hash.
+func (c *ChainPlanter) StartMuSig2GroupSession(sigHash chainhash.Hash,
+	signers []*btcec.PublicKey) (*MuSig2GroupSession, error) {
+
+	if c.cfg.ExternalSigner == nil {
+		return nil, fmt.Errorf("external group signer not supported")
+	}
+
+	return c.cfg.ExternalSigner.StartMuSig2Session(sigHash, signers)
+}
+
+// RegisterMuSig2GroupNonce registers the public nonce of a signer of a MuSig2
+// group witness session.
+func (c *ChainPlanter) RegisterMuSig2GroupNonce(sigHash chainhash.Hash,
+	signer *btcec.PublicKey,
+	pubNonce [musig2.PubNonceSize]byte) (*MuSig2GroupSession, error) {
+
+	if c.cfg.ExternalSigner == nil {
+		return nil, fmt.Errorf("external group signer not supported")
+	}
+
+	return c.cfg.ExternalSigner.RegisterMuSig2Nonce(
+		sigHash, signer, pubNonce,
+	)
+}
+
+// SubmitMuSig2GroupPartialSig submits the partial signature of a signer of a
+// MuSig2 group witness session.
+func (c *ChainPlanter) SubmitMuSig2GroupPartialSig(sigHash chainhash.Hash,
+	signer *btcec.PublicKey,
+	partialSig *musig2.PartialSignature) (*MuSig2GroupSession, error) {
+
+	if c.cfg.ExternalSigner == nil {
+		return nil, fmt.Errorf("external group signer not supported")
+	}
+
+	return c.cfg.ExternalSigner.SubmitMuSig2PartialSig(
+		sigHash, signer, partialSig,
+	)
+}
+
+// mintHeight returns the earliest block height a batch that is created now can
+// be confirmed at, which is the height the emission schedule of an asset group
+// is checked against.
+func (c *ChainPlanter) mintHeight(ctx context.Context) (uint32, error) {
+	currentHeight, err := c.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get current height: %w", err)
+	}
+
+	return currentHeight + 1, nil
+}
+
+// validateGroupEmission makes sure that issuing the given amount into an
+// asset group with the given issued supply doesn't exceed the supply unlocked
+// by the emission schedule of the group by the time the batch is confirmed.
+func (c *ChainPlanter) validateGroupEmission(ctx context.Context,
+	schedule proof.EmissionSchedule, issued, amount uint64) error {
+
+	if len(schedule) == 0 {
+		return nil
+	}
+
+	mintHeight, err := c.mintHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	return schedule.CheckIssuance(issued, amount, mintHeight)
+}
+
+// validateDelegatedIssuance makes sure that the delegate key of the given
+// seedling can sign for the group witness, and that issuing the seedling
+// doesn't exceed the terms of the delegation of the key by the time the batch
+// is confirmed.
+func (c *ChainPlanter) validateDelegatedIssuance(ctx context.Context,
+	req *Seedling, delegations proof.IssuanceDelegations) error {
+
+	delegateKey := req.DelegateKey.PubKey
+	if !c.cfg.KeyRing.IsLocalKey(ctx, *req.DelegateKey) {
+		return fmt.Errorf("can't sign with delegate key %x",
+			delegateKey.SerializeCompressed())
+	}
+
+	delegation, _, ok := delegations.Find(delegateKey)
+	if !ok {
+		return fmt.Errorf("key %x is not a delegate key of the group",
+			schnorr.SerializePubKey(delegateKey))
+	}
+
+	delegated, err := c.cfg.Log.FetchDelegatedSupply(
+		ctx, &req.GroupInfo.GroupPubKey, delegateKey,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch delegated supply: %w", err)
+	}
+
+	mintHeight, err := c.mintHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	return delegation.CheckIssuance(delegated, req.Amount, mintHeight)
+}
+
+// validateSeedling prepares the meta data of the seedling and validates it as
+// if it was added to the given batch, which may be nil if the seedling would
+// create a new batch. Nothing is persisted.
+func (c *ChainPlanter) validateSeedling(ctx context.Context, req *Seedling,
+	batch *MintingBatch) error {
+
+	// The decimal display, max supply and emission schedule are committed
+	// to in the JSON meta data of the asset. If no meta data was provided,
+	// we create it. If they weren't set explicitly, we take them from the
+	// meta data.
+	if req.Meta == nil && (req.DecimalDisplay != 0 || req.MaxSupply != 0 ||
+		len(req.EmissionSchedule) != 0) {
+
+		meta, err := proof.NewJSONMetaReveal(&proof.AssetMetadata{
+			Decimals:         req.DecimalDisplay,
+			MaxSupply:        req.MaxSupply,
+			EmissionSchedule: req.EmissionSchedule,
+		})
+		if err != nil {
+			return err
+		}
+
+		req.Meta = meta
+	}
+
+	if req.DecimalDisplay == 0 {
+		decimalDisplay, err := req.Meta.DecimalDisplay()
+		if err != nil {
+			return err
+		}
+
+		req.DecimalDisplay = decimalDisplay
+	}
+
+	if req.MaxSupply == 0 {
+		maxSupply, err := req.Meta.MaxSupply()
+		if err != nil {
+			return err
+		}
+
+		req.MaxSupply = maxSupply
+	}
 
-	// First, we'll perform some basic validation for the seedling.
+	if len(req.EmissionSchedule) == 0 {
+		schedule, err := req.Meta.EmissionSchedule()
+		if err != nil {
+			return err
+		}
+
+		req.EmissionSchedule = schedule
+	}
+
+	// Next, we'll perform some basic validation for the seedling.
 	if err := req.validateFields(); err != nil {
 		return err
 	}
 
+	// A new group with an emission schedule can only issue what the
+	// schedule unlocks by the time the batch is confirmed.
+	err := c.validateGroupEmission(
+		ctx, req.EmissionSchedule, 0, req.Amount,
+	)
+	if err != nil {
+		return err
+	}
+
+	if req.ExternalGroupSigner && c.cfg.ExternalSigner == nil {
+		return fmt.Errorf("external group signer not supported")
+	}
+
 	// If emission is enabled and a group key is specified, we need to
 	// make sure the asset types match and that we can sign with that key.
 	if req.HasGroupKey() {
@@ -753,9 +1516,80 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			)
 		}
 
-		if err := req.validateGroupKey(*groupInfo); err != nil {
+		// The decimal display of the group is determined by the
+		// asset that created the group.
+		groupMeta, err := c.cfg.Log.FetchAssetMeta(
+			ctx, groupInfo.Genesis.ID(),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch group meta data: %w",
+				err)
+		}
+		groupDecimalDisplay, err := groupMeta.DecimalDisplay()
+		if err != nil {
+			return err
+		}
+
+		groupDelegations, err := groupMeta.Delegations()
+		if err != nil {
+			return err
+		}
+
+		err = req.validateGroupKey(
+			*groupInfo, groupDecimalDisplay, groupDelegations,
+		)
+		if err != nil {
+			return err
+		}
+
+		// A delegate key can only issue up to the max amount of its
+		// delegation, and only until the delegation expires. Like the
+		// issued supply, the delegated supply includes the seedlings
+		// of all batches that weren't cancelled.
+		if req.DelegateKey != nil {
+			err := c.validateDelegatedIssuance(
+				ctx, req, groupDelegations,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// If the group was created with a max supply or an emission
+		// schedule, the reissuance must not exceed them. The issued
+		// supply includes the seedlings of all batches that weren't
+		// cancelled.
+		groupMaxSupply, err := groupMeta.MaxSupply()
+		if err != nil {
+			return err
+		}
+		groupSchedule, err := groupMeta.EmissionSchedule()
+		if err != nil {
 			return err
 		}
+		if groupMaxSupply != 0 || len(groupSchedule) != 0 {
+			issued, err := c.cfg.Log.FetchGroupSupply(
+				ctx, &req.GroupInfo.GroupPubKey,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch group "+
+					"supply: %w", err)
+			}
+
+			err = validateGroupSupply(
+				issued, req.Amount, groupMaxSupply,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = c.validateGroupEmission(
+				ctx, groupSchedule, issued, req.Amount,
+			)
+			if err != nil {
+				return err
+			}
+		}
 
 		req.GroupInfo = groupInfo
 	}
@@ -763,17 +1597,48 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	// If a group anchor is specified, we need to ensure that the anchor
 	// seedling is already in the batch and has emission enabled.
 	if req.GroupAnchor != nil {
-		if c.pendingBatch == nil {
+		if batch == nil {
 			return fmt.Errorf("batch empty, group anchor %v "+
 				"invalid", *req.GroupAnchor)
 		}
 
-		err := c.pendingBatch.validateGroupAnchor(req)
+		var mintHeight uint32
+		anchor, ok := batch.Seedlings[*req.GroupAnchor]
+		if ok && len(anchor.EmissionSchedule) != 0 {
+			var err error
+			mintHeight, err = c.mintHeight(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := batch.validateGroupAnchor(req, mintHeight)
 		if err != nil {
 			return err
 		}
 	}
 
+	// The tapscript sibling of the minting output is shared by all
+	// seedlings of the batch, so it can't change once the batch exists.
+	if batch != nil {
+		if err := batch.validateTapscriptSibling(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepAssetSeedling performs some basic validation for the Seedling, then
+// either adds it to an existing pending batch or creates a new batch for it. A
+// bool indicating if a new batch should immediately be created is returned.
+func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
+	req *Seedling) error {
+
+	if err := c.validateSeedling(ctx, req, c.pendingBatch); err != nil {
+		return err
+	}
+
 	// Now that we know the field are valid, we'll check to see if a batch
 	// already exists.
 	switch {
@@ -809,7 +1674,8 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			Seedlings: map[string]*Seedling{
 				req.AssetName: req,
 			},
-			AssetMetas: make(AssetMetas),
+			AssetMetas:       make(AssetMetas),
+			TapscriptSibling: req.TapscriptSibling,
 		}
 		newBatch.UpdateState(BatchStatePending)
 		ctx, cancel = c.WithCtxQuit()
@@ -853,11 +1719,58 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	return nil
 }
 
+// reOrgMintingTx is called by the re-org watcher when the minting transaction
+// of a finalized batch was re-organized out of the chain and re-broadcast. The
+// batch is then marked accordingly until the transaction confirms again.
+func (c *ChainPlanter) reOrgMintingTx(batchKey *btcec.PublicKey,
+	broadcastErr error) error {
+
+	reOrgState := ReOrgStatePending
+	switch {
+	case broadcastErr == nil:
+		log.Infof("Minting TX of batch %x was re-organized out of the "+
+			"chain, waiting for it to confirm again",
+			batchKey.SerializeCompressed())
+
+	// If an input of the minting transaction was spent by a different
+	// transaction in the new chain, the transaction can never confirm
+	// again. The assets can't be re-anchored either, since their IDs
+	// commit to the genesis outpoint.
+	case strings.Contains(
+		broadcastErr.Error(), lnwallet.ErrDoubleSpend.Error(),
+	):
+		log.Errorf("Minting TX of batch %x was double spent after a "+
+			"re-org, the assets of the batch can't be recovered",
+			batchKey.SerializeCompressed())
+
+		reOrgState = ReOrgStateDoubleSpent
+
+	// Any other error might be transient, and the wallet of the backing
+	// lnd node re-broadcasts its transactions on its own.
+	default:
+		log.Warnf("Unable to re-broadcast minting TX of batch %x "+
+			"after re-org: %v", batchKey.SerializeCompressed(),
+			broadcastErr)
+	}
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	err := c.cfg.Log.MarkBatchReOrged(ctx, batchKey, reOrgState)
+	if err != nil {
+		return fmt.Errorf("unable to mark batch as re-orged: %w", err)
+	}
+
+	return nil
+}
+
 // updateMintingProofs is called by the re-org watcher when it detects a re-org
 // and has updated the minting proofs. This cannot be done by the caretaker
 // itself, because its job is already done at the point that a re-org can happen
 // (the batch is finalized after a single confirmation).
-func (c *ChainPlanter) updateMintingProofs(proofs []*proof.Proof) error {
+func (c *ChainPlanter) updateMintingProofs(batchKey *btcec.PublicKey,
+	proofs []*proof.Proof) error {
+
 	ctx, cancel := c.WithCtxQuitNoTimeout()
 	defer cancel()
 
@@ -920,6 +1833,23 @@ func (c *ChainPlanter) updateMintingProofs(proofs []*proof.Proof) error {
 		}
 	}
 
+	// All proofs of the batch are anchored in the same block, so we can
+	// take the new block location of the minting transaction from any of
+	// them.
+	if len(proofs) == 0 {
+		return nil
+	}
+	newProof := proofs[0]
+	blockHash := newProof.BlockHeader.BlockHash()
+	err := c.cfg.Log.MarkBatchReConfirmed(
+		ctx, batchKey, &blockHash, newProof.BlockHeight,
+		newProof.TxMerkleProof.TxIndex(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to mark batch as re-confirmed: %w",
+			err)
+	}
+
 	return nil
 }
 
@@ -941,14 +1871,47 @@ func (c *ChainPlanter) QueueNewSeedling(req *Seedling) (SeedlingUpdates, error)
 	return req.updates, nil
 }
 
-// CancelSeedling attempts to cancel the creation of a new asset identified by
-// its name. If the seedling has already progressed to a point where the
-// genesis PSBT has been broadcasted, an error is returned.
+// CancelSeedling removes the seedling for the new asset identified by its name
+// from the pending batch, and returns the updated pending batch. If the
+// seedling was the last one in the batch, the whole batch is cancelled and nil
+// is returned instead.
 //
 // NOTE: This is part of the Planter interface.
-func (c *ChainPlanter) CancelSeedling() error {
-	// TODO(roasbeef): actually needed?
-	return nil
+func (c *ChainPlanter) CancelSeedling(assetName string) (*MintingBatch,
+	error) {
+
+	req := newStateParamReq[*MintingBatch](reqTypeCancelSeedling, assetName)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// RegisterSubscriber adds a new subscriber for receiving mint events. The
+// deliverExisting boolean indicates whether already emitted events should be
+// delivered to the subscriber first, starting from the event specified by
+// deliverFrom.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (c *ChainPlanter) RegisterSubscriber(
+	receiver *fn.EventReceiver[*MintEvent], deliverExisting bool,
+	deliverFrom MintEventReplay) error {
+
+	return c.cfg.Log.RegisterSubscriber(
+		receiver, deliverExisting, deliverFrom,
+	)
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (c *ChainPlanter) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*MintEvent]) error {
+
+	return c.cfg.Log.RemoveSubscriber(subscriber)
 }
 
 // A compile-time assertion to make sure that ChainPlanter implements the
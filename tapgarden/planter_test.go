@@ -7,12 +7,16 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -20,6 +24,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/proof"
@@ -28,8 +33,10 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/stretchr/testify/require"
 )
@@ -72,6 +79,10 @@ type mintingTestHarness struct {
 
 	txValidator tapscript.TxValidator
 
+	externalSigner *tapgarden.ExternalGroupSigner
+
+	externalFunder *tapgarden.ExternalGenesisFunder
+
 	ticker *ticker.Force
 
 	planter *tapgarden.ChainPlanter
@@ -108,6 +119,9 @@ func newMintingTestHarness(t *testing.T, store tapgarden.MintingStore,
 		genTxBuilder: &tapscript.GroupTxBuilder{},
 		txValidator:  &tap.ValidatorV0{},
 		errChan:      make(chan error, 10),
+
+		externalSigner: tapgarden.NewExternalGroupSigner(),
+		externalFunder: tapgarden.NewExternalGenesisFunder(),
 	}
 }
 
@@ -130,6 +144,9 @@ func (t *mintingTestHarness) refreshChainPlanter() {
 			TxValidator:  t.txValidator,
 			ProofFiles:   t.proofFiles,
 			ProofWatcher: t.proofWatcher,
+
+			ExternalSigner: t.externalSigner,
+			ExternalFunder: t.externalFunder,
 		},
 		BatchTicker:  t.ticker,
 		ProofUpdates: t.proofFiles,
@@ -302,6 +319,32 @@ func (t *mintingTestHarness) assertNewBatchFrozen(
 	return newBatches[0]
 }
 
+// assertPendingBatchFrozen waits for the planter to finalize the pending batch
+// on its own and asserts that the batch was frozen.
+func (t *mintingTestHarness) assertPendingBatchFrozen() {
+	t.Helper()
+
+	batch, err := t.planter.PendingBatch()
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+
+	err = wait.NoError(func() error {
+		pendingBatch, err := t.planter.PendingBatch()
+		if err != nil {
+			return err
+		}
+
+		if pendingBatch != nil {
+			return fmt.Errorf("batch still pending")
+		}
+
+		return nil
+	}, defaultTimeout)
+	require.NoError(t, err)
+
+	t.assertBatchState(batch.BatchKey.PubKey, tapgarden.BatchStateFrozen)
+}
+
 func (t *mintingTestHarness) cancelMintingBatch(noBatch bool) *btcec.PublicKey {
 	t.Helper()
 
@@ -430,9 +473,20 @@ func (t *mintingTestHarness) assertSeedlingsExist(
 		require.Equal(t, seedling.AssetName, batchSeedling.AssetName)
 		require.Equal(t, seedling.Meta, batchSeedling.Meta)
 		require.Equal(t, seedling.Amount, batchSeedling.Amount)
+		require.Equal(
+			t, seedling.DecimalDisplay,
+			batchSeedling.DecimalDisplay,
+		)
 		require.Equal(
 			t, seedling.EnableEmission, batchSeedling.EnableEmission,
 		)
+		require.Equal(
+			t, seedling.VanityPrefix, batchSeedling.VanityPrefix,
+		)
+		require.Equal(
+			t, seedling.EmissionSchedule,
+			batchSeedling.EmissionSchedule,
+		)
 	}
 }
 
@@ -960,30 +1014,1862 @@ func testMintingCancelFinalize(t *mintingTestHarness) {
 	t.assertNumCaretakersActive(0)
 }
 
-// mintingStoreTestCase is used to programmatically run a series of test cases
-// that are parametrized based on a fresh minting store.
-type mintingStoreTestCase struct {
-	name     string
-	interval time.Duration
-	testFunc func(t *mintingTestHarness)
+// testMintingScheduleThreshold tests that the pending batch is finalized
+// automatically once it reaches the seedling count threshold of the batch
+// schedule.
+func testMintingScheduleThreshold(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// Without any configured schedule, batches are only finalized by the
+	// ticker or on request.
+	schedule, err := t.planter.BatchSchedule()
+	require.NoError(t, err)
+	require.False(t, schedule.IsActive())
+
+	// We now set a threshold of 3 seedlings per batch.
+	const numSeedlings = 3
+	schedule, err = t.planter.UpdateBatchSchedule(tapgarden.BatchSchedule{
+		MaxSeedlings: numSeedlings,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, numSeedlings, schedule.MaxSeedlings)
+
+	// As long as the batch is below the threshold, it stays pending.
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings[:numSeedlings-1]...)
+	t.assertPendingBatchExists(numSeedlings - 1)
+
+	// The last seedling pushes the batch over the threshold. It should
+	// still be accepted into the batch before the batch is frozen.
+	updates, err := t.planter.QueueNewSeedling(seedlings[numSeedlings-1])
+	require.NoError(t, err)
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, update.Error)
+	require.Len(t, update.PendingBatch.Seedlings, numSeedlings)
+
+	// This should kick off a new caretaker without any tick.
+	t.assertNoPendingBatch()
+	t.assertBatchState(
+		update.PendingBatch.BatchKey.PubKey, tapgarden.BatchStateFrozen,
+	)
+	_ = t.assertGenesisTxFunded()
+	t.assertNumCaretakersActive(1)
+	t.assertNoError()
 }
 
-// testCases houses the set of minting store test cases.
-var testCases = []mintingStoreTestCase{
-	{
-		name:     "basic_asset_creation",
-		interval: defaultInterval,
-		testFunc: testBasicAssetCreation,
-	},
-	{
-		name:     "creation_by_minting_ticker",
-		interval: minterInterval,
-		testFunc: testMintingTicker,
-	},
-	{
-		name:     "minting_with_cancellation",
-		interval: minterInterval,
-		testFunc: testMintingCancelFinalize,
+// testMintingScheduleInterval tests that the pending batch is finalized
+// automatically once it reaches the deadline of the batch schedule, and that
+// invalid schedules are rejected.
+func testMintingScheduleInterval(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// A negative interval isn't a valid schedule.
+	_, err := t.planter.UpdateBatchSchedule(tapgarden.BatchSchedule{
+		Interval: -time.Second,
+	})
+	require.ErrorContains(t, err, "must not be negative")
+
+	// Next make 5 new random seedlings, and queue each of them up within
+	// the main state machine for batched minting. Without a schedule, the
+	// batch stays pending.
+	const numSeedlings = 5
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	// Once we add an interval to the schedule, the deadline of the
+	// existing pending batch is armed, and the batch should be frozen
+	// once it passes.
+	_, err = t.planter.UpdateBatchSchedule(tapgarden.BatchSchedule{
+		Interval: minterInterval,
+	})
+	require.NoError(t, err)
+
+	t.assertPendingBatchFrozen()
+	_ = t.assertGenesisTxFunded()
+	t.assertNumCaretakersActive(1)
+	t.assertNoError()
+}
+
+// testMintingCancelSeedling tests that single seedlings can be removed from the
+// pending batch, and that removing the last seedling cancels the batch.
+func testMintingCancelSeedling(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// Without a pending batch, there's nothing to cancel.
+	_, err := t.planter.CancelSeedling("unknown")
+	require.ErrorContains(t, err, "no pending batch")
+
+	// Next make 2 new random seedlings, and queue each of them up within
+	// the main state machine for batched minting.
+	const numSeedlings = 2
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	// Cancelling a seedling that isn't part of the batch should fail.
+	_, err = t.planter.CancelSeedling("unknown")
+	require.ErrorContains(t, err, "not found in pending batch")
+
+	// Cancelling the first seedling should leave the second one in the
+	// pending batch, on disk as well.
+	batch, err := t.planter.CancelSeedling(seedlings[0].AssetName)
+	require.NoError(t, err)
+	require.Len(t, batch.Seedlings, numSeedlings-1)
+	require.Contains(t, batch.Seedlings, seedlings[1].AssetName)
+	t.assertPendingBatchExists(numSeedlings - 1)
+	t.assertSeedlingsExist(seedlings[1:], nil)
+
+	// Cancelling the last seedling cancels the batch as a whole.
+	batchKey := batch.BatchKey.PubKey
+	batch, err = t.planter.CancelSeedling(seedlings[1].AssetName)
+	require.NoError(t, err)
+	require.Nil(t, batch)
+	t.assertNoPendingBatch()
+	t.assertBatchState(batchKey, tapgarden.BatchStateSeedlingCancelled)
+	t.assertNoError()
+}
+
+// testMintingPreview tests that the cost of the minting transaction of the
+// pending batch can be estimated without finalizing the batch.
+func testMintingPreview(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// Without a pending batch, there's nothing to preview.
+	_, err := t.planter.PreviewBatch(nil)
+	require.ErrorContains(t, err, "no pending batch")
+
+	// Next make 5 new random seedlings, and queue each of them up within
+	// the main state machine for batched minting.
+	const numSeedlings = 5
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	// Without an explicit fee rate, the planter should ask for a fee
+	// estimate, just like the caretaker would when funding the batch.
+	go func() {
+		_, _ = fn.RecvOrTimeout(
+			t.chain.FeeEstimateSignal, defaultTimeout,
+		)
+	}()
+	preview, err := t.planter.PreviewBatch(nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 253, preview.FeeRate)
+	require.Equal(t, numSeedlings, preview.NumAssets)
+	require.Equal(t, tapgarden.GenesisAmtSats, preview.AnchorOutputValue)
+	require.True(t, preview.InternalKey.IsEqual(t.batchKey.PubKey))
+	require.Positive(t, preview.EstimatedWeight)
+	require.Positive(t, preview.EstimatedVSize())
+
+	// The fee should scale with an explicit fee rate.
+	feeRate := chainfee.SatPerKWeight(2_500)
+	preview, err = t.planter.PreviewBatch(&feeRate)
+	require.NoError(t, err)
+	require.Equal(t, feeRate, preview.FeeRate)
+	require.Equal(
+		t, feeRate.FeeForWeight(preview.EstimatedWeight),
+		preview.EstimatedFee,
+	)
+
+	// Previewing the batch must not finalize it.
+	t.assertPendingBatchExists(numSeedlings)
+	t.assertNumCaretakersActive(0)
+	t.assertNoError()
+}
+
+// testMintingBatchCosts tests that a batch can be labelled and that the
+// on-chain cost of its minting transaction is attributed to its assets once
+// the transaction was signed.
+func testMintingBatchCosts(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	const numSeedlings = 3
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	// A label that exceeds the maximum length is rejected, a valid one
+	// is set on the pending batch right away.
+	batchKey := t.batchKey.PubKey
+	longLabel := strings.Repeat("a", tapgarden.MaxBatchLabelLen+1)
+	err := t.planter.SetBatchLabel(batchKey, longLabel)
+	require.ErrorIs(t, err, tapgarden.ErrBatchLabelTooLong)
+
+	const label = "q3 issuance"
+	require.NoError(t, t.planter.SetBatchLabel(batchKey, label))
+
+	pendingBatch, err := t.planter.PendingBatch()
+	require.NoError(t, err)
+	require.Equal(t, label, pendingBatch.Label)
+
+	// The pending batch has no minting transaction yet.
+	_, err = tapgarden.NewBatchCost(pendingBatch)
+	require.Error(t, err)
+
+	// Drive the batch up to the point where the minting transaction is
+	// signed and published.
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	for i := 0; i < numSeedlings; i++ {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+	t.assertGenesisPsbtFinalized()
+	tx := t.assertTxPublished()
+
+	batches, err := t.planter.ListBatches(batchKey)
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Equal(t, label, batches[0].Label)
+
+	cost, err := tapgarden.NewBatchCost(batches[0])
+	require.NoError(t, err)
+	require.Equal(t, tx.TxHash(), cost.Txid)
+	require.Positive(t, cost.ChainFees)
+	require.Equal(
+		t, blockchain.GetTransactionWeight(btcutil.NewTx(tx)),
+		cost.Weight,
+	)
+	require.Positive(t, cost.VSize())
+
+	// The amortized fees of all assets add up to the chain fees, and
+	// differ by at most one satoshi.
+	require.Len(t, cost.AssetCosts, numSeedlings)
+	var totalFees btcutil.Amount
+	for _, assetCost := range cost.AssetCosts {
+		totalFees += assetCost.AmortizedFee
+		require.InDelta(
+			t, int64(cost.ChainFees/numSeedlings),
+			int64(assetCost.AmortizedFee), 1,
+		)
+	}
+	require.Equal(t, cost.ChainFees, totalFees)
+
+	// The label can still be changed after the batch was broadcast.
+	require.NoError(t, t.planter.SetBatchLabel(batchKey, ""))
+	batches, err = t.planter.ListBatches(batchKey)
+	require.NoError(t, err)
+	require.Empty(t, batches[0].Label)
+}
+
+// testMintingDecimalDisplay tests that the decimal display of seedlings is
+// committed to in their meta data, and that it is enforced to be consistent.
+func testMintingDecimalDisplay(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// The anchor of a new group only specifies the decimal display, so the
+	// planter should create the JSON meta data for it. The member of the
+	// group only specifies the meta data, so the decimal display should be
+	// taken from it.
+	anchor := &tapgarden.Seedling{
+		AssetType:      asset.Normal,
+		AssetName:      "decimal-anchor",
+		Amount:         1000,
+		DecimalDisplay: 2,
+		EnableEmission: true,
+	}
+	memberMeta, err := proof.NewJSONMetaReveal(&proof.AssetMetadata{
+		Ticker:   "MEMBER",
+		Decimals: 2,
+	})
+	require.NoError(t, err)
+	member := &tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "decimal-member",
+		Meta:        memberMeta,
+		Amount:      50,
+		GroupAnchor: &anchor.AssetName,
+	}
+	t.queueSeedlingsInBatch(anchor, member)
+	t.assertPendingBatchExists(2)
+
+	require.NotNil(t, anchor.Meta)
+	require.Equal(t, proof.MetaJson, anchor.Meta.Type)
+	require.EqualValues(t, 2, member.DecimalDisplay)
+
+	// The decimal display should be restored from the meta data on disk.
+	t.assertSeedlingsExist([]*tapgarden.Seedling{anchor, member}, nil)
+
+	// Seedlings with an inconsistent decimal display should be rejected.
+	opaqueMeta := &proof.MetaReveal{Data: []byte("opaque")}
+	invalidSeedlings := []struct {
+		seedling *tapgarden.Seedling
+		err      string
+	}{{
+		seedling: &tapgarden.Seedling{
+			AssetType:      asset.Normal,
+			AssetName:      "other-decimals-member",
+			Amount:         50,
+			DecimalDisplay: 3,
+			GroupAnchor:    &anchor.AssetName,
+		},
+		err: "same decimal display",
+	}, {
+		seedling: &tapgarden.Seedling{
+			AssetType:      asset.Collectible,
+			AssetName:      "decimal-collectible",
+			Amount:         1,
+			DecimalDisplay: 1,
+		},
+		err: "not supported for collectibles",
+	}, {
+		seedling: &tapgarden.Seedling{
+			AssetType:      asset.Normal,
+			AssetName:      "opaque-meta",
+			Meta:           opaqueMeta,
+			Amount:         50,
+			DecimalDisplay: 2,
+		},
+		err: "doesn't match meta data",
+	}, {
+		seedling: &tapgarden.Seedling{
+			AssetType:      asset.Normal,
+			AssetName:      "too-many-decimals",
+			Meta:           memberMeta,
+			Amount:         50,
+			DecimalDisplay: asset.MaxDecimalDisplay + 1,
+		},
+		err: asset.ErrInvalidDecimalDisplay.Error(),
+	}}
+	for _, invalid := range invalidSeedlings {
+		updates, err := t.planter.QueueNewSeedling(invalid.seedling)
+		require.NoError(t, err)
+		update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+		require.NoError(t, err)
+		require.ErrorContains(t, update.Error, invalid.err)
+	}
+
+	t.assertPendingBatchExists(2)
+	t.assertNoError()
+}
+
+// testMintingTapscriptSibling tests that a tapscript sibling can be committed
+// to next to the Taproot Asset commitment in the minting output of a batch.
+func testMintingTapscriptSibling(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// The first seedling creates the batch and specifies the sibling, the
+	// second one doesn't specify a sibling and is added to the batch as
+	// is.
+	sibling := commitment.NewPreimageFromLeaf(test.ScriptHashLock(
+		t.T, test.RandBytes(32),
+	))
+	siblingHash, err := sibling.TapHash()
+	require.NoError(t, err)
+
+	const numSeedlings = 2
+	seedlings := t.newRandSeedlings(numSeedlings)
+	seedlings[0].TapscriptSibling = sibling
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	batch, err := t.planter.PendingBatch()
+	require.NoError(t, err)
+	require.Equal(t, sibling, batch.TapscriptSibling)
+
+	// A seedling can't change the sibling of the pending batch.
+	otherSibling := commitment.NewPreimageFromLeaf(test.ScriptHashLock(
+		t.T, test.RandBytes(32),
+	))
+	otherSeedling := t.newRandSeedlings(1)[0]
+	otherSeedling.TapscriptSibling = otherSibling
+	updates, err := t.planter.QueueNewSeedling(otherSeedling)
+	require.NoError(t, err)
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.ErrorContains(t, update.Error, "does not match tapscript "+
+		"sibling")
+	t.assertPendingBatchExists(numSeedlings)
+
+	// The sibling should also be stored with the batch on disk.
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Equal(t, sibling, batches[0].TapscriptSibling)
+
+	// We'll now finalize the batch. The minting output key imported into
+	// the wallet should commit to the sibling.
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	for i := 0; i < numSeedlings; i++ {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+	t.assertGenesisPsbtFinalized()
+
+	// The published genesis transaction should pay to the minting output
+	// key that commits to both the Taproot Asset root and the sibling.
+	tx := t.assertTxPublished()
+	batches, err = t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+
+	merkleRoot := batches[0].RootAssetCommitment.TapscriptRoot(siblingHash)
+	outputKey := txscript.ComputeTaprootOutputKey(
+		t.batchKey.PubKey, merkleRoot[:],
+	)
+	pkScript, err := txscript.PayToTaprootScript(outputKey)
+	require.NoError(t, err)
+
+	found := fn.Any(tx.TxOut, func(txOut *wire.TxOut) bool {
+		return bytes.Equal(txOut.PkScript, pkScript)
+	})
+	require.True(t, found, "minting output not found")
+
+	t.assertNoError()
+}
+
+// testMintingMaxSupply tests that the max supply of a new asset group is
+// committed to in the meta data of the group anchor, and that issuances into
+// the group that would exceed it are rejected.
+func testMintingMaxSupply(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// The anchor of the new group only specifies the max supply, so the
+	// planter should create the JSON meta data for it.
+	anchor := &tapgarden.Seedling{
+		AssetType:      asset.Normal,
+		AssetName:      "capped-anchor",
+		Amount:         600,
+		MaxSupply:      1000,
+		EnableEmission: true,
+	}
+	member := &tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "capped-member",
+		Amount:      300,
+		GroupAnchor: &anchor.AssetName,
+	}
+	seedlings := []*tapgarden.Seedling{anchor, member}
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(2)
+
+	require.NotNil(t, anchor.Meta)
+	maxSupply, err := anchor.Meta.MaxSupply()
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, maxSupply)
+
+	// The max supply should be restored from the meta data on disk.
+	t.assertSeedlingsExist(seedlings, nil)
+
+	assertRejected := func(seedling *tapgarden.Seedling, errStr string) {
+		updates, err := t.planter.QueueNewSeedling(seedling)
+		require.NoError(t, err)
+		update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+		require.NoError(t, err)
+		require.ErrorContains(t, update.Error, errStr)
+	}
+
+	// Seedlings that would exceed the max supply, or that try to set a
+	// max supply for anything but a new group, should be rejected.
+	assertRejected(&tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "exceeding-member",
+		Amount:      101,
+		GroupAnchor: &anchor.AssetName,
+	}, proof.ErrMaxSupplyExceeded.Error())
+	assertRejected(&tapgarden.Seedling{
+		AssetType: asset.Normal,
+		AssetName: "capped-no-emission",
+		Amount:    10,
+		MaxSupply: 100,
+	}, "max supply requires emission")
+	assertRejected(&tapgarden.Seedling{
+		AssetType:      asset.Normal,
+		AssetName:      "exceeding-anchor",
+		Amount:         101,
+		MaxSupply:      100,
+		EnableEmission: true,
+	}, proof.ErrMaxSupplyExceeded.Error())
+	t.assertPendingBatchExists(2)
+
+	t.assertNoError()
+}
+
+// testMintingEmissionSchedule tests that the emission schedule of a new asset
+// group is committed to in the meta data of the group anchor, and that
+// issuances that exceed the supply unlocked by the schedule are rejected.
+func testMintingEmissionSchedule(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// The mock chain is at height zero, so the batch can be confirmed at
+	// height one at the earliest, which only unlocks the first epoch.
+	schedule := proof.EmissionSchedule{{
+		StartHeight: 1,
+		Amount:      600,
+	}, {
+		StartHeight: 1000,
+		Amount:      400,
+	}}
+	anchor := &tapgarden.Seedling{
+		AssetType:        asset.Normal,
+		AssetName:        "scheduled-anchor",
+		Amount:           500,
+		EmissionSchedule: schedule,
+		EnableEmission:   true,
+	}
+	member := &tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "scheduled-member",
+		Amount:      100,
+		GroupAnchor: &anchor.AssetName,
+	}
+	seedlings := []*tapgarden.Seedling{anchor, member}
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(2)
+
+	require.NotNil(t, anchor.Meta)
+	metaSchedule, err := anchor.Meta.EmissionSchedule()
+	require.NoError(t, err)
+	require.Equal(t, schedule, metaSchedule)
+
+	// The emission schedule should be restored from the meta data on
+	// disk.
+	t.assertSeedlingsExist(seedlings, nil)
+
+	assertRejected := func(seedling *tapgarden.Seedling, errStr string) {
+		updates, err := t.planter.QueueNewSeedling(seedling)
+		require.NoError(t, err)
+		update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+		require.NoError(t, err)
+		require.ErrorContains(t, update.Error, errStr)
+	}
+
+	// Seedlings that would exceed the unlocked supply, or that try to set
+	// an emission schedule for anything but a new group, should be
+	// rejected.
+	assertRejected(&tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "early-member",
+		Amount:      1,
+		GroupAnchor: &anchor.AssetName,
+	}, proof.ErrEmissionScheduleExceeded.Error())
+	assertRejected(&tapgarden.Seedling{
+		AssetType:        asset.Normal,
+		AssetName:        "scheduled-no-emission",
+		Amount:           10,
+		EmissionSchedule: schedule,
+	}, "emission schedule requires emission")
+	assertRejected(&tapgarden.Seedling{
+		AssetType: asset.Normal,
+		AssetName: "early-anchor",
+		Amount:    10,
+		EmissionSchedule: proof.EmissionSchedule{{
+			StartHeight: 100,
+			Amount:      10,
+		}},
+		EnableEmission: true,
+	}, proof.ErrEmissionScheduleExceeded.Error())
+	t.assertPendingBatchExists(2)
+
+	t.assertNoError()
+}
+
+// waitForGroupWitnessRequest waits for a single group witness request of an
+// external signer to be published and returns it.
+func (t *mintingTestHarness) waitForGroupWitnessRequest(
+	assetName string) *tapgarden.GroupWitnessRequest {
+
+	t.Helper()
+
+	var req *tapgarden.GroupWitnessRequest
+	err := wait.NoError(func() error {
+		reqs, err := t.planter.GroupWitnessRequests()
+		if err != nil {
+			return err
+		}
+
+		if len(reqs) != 1 {
+			return fmt.Errorf("expected 1 request, got %d",
+				len(reqs))
+		}
+
+		req = reqs[0]
+		return nil
+	}, defaultTimeout)
+	require.NoError(t, err)
+	require.Equal(t, assetName, req.AssetName)
+	require.True(t, req.BatchKey.IsEqual(t.batchKey.PubKey))
+
+	return req
+}
+
+// testMintingExternalGroupSigner tests that the group witnesses of a batch can
+// be produced by an external signer that holds the group key.
+func testMintingExternalGroupSigner(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// We'll create an anchor for a new group whose key is only known to
+	// the external signer, and a second asset that joins the group.
+	groupPriv := test.RandPrivKey(t)
+	anchor := &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           "external-anchor",
+		Meta:                &proof.MetaReveal{Data: []byte("anchor")},
+		Amount:              1000,
+		EnableEmission:      true,
+		ExternalGroupSigner: true,
+		GroupInternalKey: &keychain.KeyDescriptor{
+			PubKey: groupPriv.PubKey(),
+		},
+	}
+	member := &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           "external-member",
+		Meta:                &proof.MetaReveal{Data: []byte("member")},
+		Amount:              50,
+		GroupAnchor:         &anchor.AssetName,
+		ExternalGroupSigner: true,
+	}
+	t.queueSeedlingsInBatch(anchor, member)
+	t.assertPendingBatchExists(2)
+	t.assertSeedlingsExist([]*tapgarden.Seedling{anchor, member}, nil)
+
+	// A seedling that joins the group must use the external signer as
+	// well, as the backing node can't sign for the group.
+	updates, err := t.planter.QueueNewSeedling(&tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "local-member",
+		Amount:      50,
+		GroupAnchor: &anchor.AssetName,
+	})
+	require.NoError(t, err)
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.ErrorContains(t, update.Error, "same group signer")
+
+	// Now we'll finalize the batch. The caretaker should fund the genesis
+	// transaction and then wait for the group witness of the anchor, as
+	// no group key is derived from the backing node.
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	t.assertKeyDerived()
+
+	anchorReq := t.waitForGroupWitnessRequest(anchor.AssetName)
+	require.True(t, anchorReq.SignDesc.KeyDesc.PubKey.IsEqual(
+		groupPriv.PubKey(),
+	))
+	_, err = anchorReq.Packet()
+	require.NoError(t, err)
+
+	// A signature of any other key, or for an unknown request, must be
+	// rejected.
+	otherSig, err := asset.SignVirtualTx(
+		test.RandPrivKey(t), anchorReq.SignDesc, anchorReq.VirtualTx,
+		anchorReq.SignDesc.Output,
+	)
+	require.NoError(t, err)
+	err = t.planter.SubmitGroupWitness(anchorReq.SigHash, otherSig)
+	require.ErrorContains(t, err, "invalid signature")
+
+	err = t.planter.SubmitGroupWitness(chainhash.Hash{}, otherSig)
+	require.ErrorIs(t, err, tapgarden.ErrGroupWitnessRequestNotFound)
+
+	// The valid signature of the external signer is accepted, after which
+	// the caretaker moves on to the member of the group.
+	signRequest := func(req *tapgarden.GroupWitnessRequest) {
+		sig, err := asset.SignVirtualTx(
+			groupPriv, req.SignDesc, req.VirtualTx,
+			req.SignDesc.Output,
+		)
+		require.NoError(t, err)
+		require.NoError(t, t.planter.SubmitGroupWitness(
+			req.SigHash, sig,
+		))
+	}
+	signRequest(anchorReq)
+
+	t.assertKeyDerived()
+	signRequest(t.waitForGroupWitnessRequest(member.AssetName))
+
+	// With all group witnesses in place, the batch proceeds as usual and
+	// both assets are minted into the same group.
+	t.assertNoPendingBatch()
+	t.assertGenesisPsbtFinalized()
+	t.assertTxPublished()
+	t.assertNoError()
+
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+
+	sprouts := batches[0].RootAssetCommitment.CommittedAssets()
+	require.Len(t, sprouts, 2)
+	for _, sprout := range sprouts {
+		require.NotNil(t, sprout.GroupKey)
+		require.True(t, sprout.GroupKey.RawKey.PubKey.IsEqual(
+			groupPriv.PubKey(),
+		))
+		require.Equal(
+			t, sprouts[0].GroupKey.GroupPubKey,
+			sprout.GroupKey.GroupPubKey,
+		)
+	}
+
+	reqs, err := t.planter.GroupWitnessRequests()
+	require.NoError(t, err)
+	require.Empty(t, reqs)
+}
+
+// signMuSig2GroupWitness drives a MuSig2 signing session for the given group
+// witness request with the given signers.
+func (t *mintingTestHarness) signMuSig2GroupWitness(
+	req *tapgarden.GroupWitnessRequest, signers []*btcec.PrivateKey) {
+
+	t.Helper()
+
+	signerKeys := make([]*btcec.PublicKey, len(signers))
+	for idx, signer := range signers {
+		signerKeys[idx] = signer.PubKey()
+	}
+
+	session, err := t.planter.StartMuSig2GroupSession(
+		req.SigHash, signerKeys,
+	)
+	require.NoError(t, err)
+	require.Len(t, session.Signers, len(signers))
+
+	// A session can only be started once per request.
+	_, err = t.planter.StartMuSig2GroupSession(req.SigHash, signerKeys)
+	require.ErrorContains(t, err, "already started")
+
+	// Each signer generates a nonce and registers it with the session.
+	// Only the last nonce completes the combined nonce.
+	nonces := make([]*musig2.Nonces, len(signers))
+	for idx, signer := range signers {
+		nonces[idx], err = musig2.GenNonces(
+			musig2.WithPublicKey(signer.PubKey()),
+		)
+		require.NoError(t, err)
+
+		session, err = t.planter.RegisterMuSig2GroupNonce(
+			req.SigHash, signer.PubKey(), nonces[idx].PubNonce,
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t, idx == len(signers)-1, session.CombinedNonce != nil,
+		)
+	}
+
+	partialSign := func(signer *btcec.PrivateKey,
+		nonce *musig2.Nonces) *musig2.PartialSignature {
+
+		partialSig, err := musig2.Sign(
+			nonce.SecNonce, signer, *session.CombinedNonce,
+			session.Signers, req.SigHash, musig2.WithSortedKeys(),
+			musig2.WithTweaks(session.Tweaks...),
+		)
+		require.NoError(t, err)
+
+		return partialSig
+	}
+
+	// A partial signature created with the nonce of another signer must
+	// be rejected.
+	_, err = t.planter.SubmitMuSig2GroupPartialSig(
+		req.SigHash, signers[0].PubKey(),
+		partialSign(signers[1], nonces[1]),
+	)
+	require.ErrorContains(t, err, "invalid partial signature")
+
+	for idx, signer := range signers {
+		session, err = t.planter.SubmitMuSig2GroupPartialSig(
+			req.SigHash, signer.PubKey(),
+			partialSign(signer, nonces[idx]),
+		)
+		require.NoError(t, err)
+		require.Equal(t, idx == len(signers)-1, session.Complete)
+	}
+}
+
+// testMintingMuSig2GroupSigner tests that the group key of a batch can be the
+// MuSig2 aggregate of multiple signer keys, with the group witnesses being
+// produced in a signing session coordinated by the planter.
+func testMintingMuSig2GroupSigner(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	signers := []*btcec.PrivateKey{
+		test.RandPrivKey(t), test.RandPrivKey(t),
+	}
+	groupKey, err := tapgarden.MuSig2GroupInternalKey(
+		[]*btcec.PublicKey{signers[0].PubKey(), signers[1].PubKey()},
+	)
+	require.NoError(t, err)
+
+	// The tweaks handed to the signers must result in the final group
+	// key.
+	singleTweak := test.RandBytes(32)
+	tweaks, err := tapgarden.MuSig2GroupTweaks(
+		[]*btcec.PublicKey{signers[1].PubKey(), signers[0].PubKey()},
+		singleTweak, nil,
+	)
+	require.NoError(t, err)
+	aggKey, _, _, err := musig2.AggregateKeys(
+		[]*btcec.PublicKey{signers[0].PubKey(), signers[1].PubKey()},
+		true, musig2.WithKeyTweaks(tweaks...),
+	)
+	require.NoError(t, err)
+	internalKey := input.TweakPubKeyWithTweak(groupKey, singleTweak)
+	require.Equal(
+		t, schnorr.SerializePubKey(
+			txscript.ComputeTaprootKeyNoScript(internalKey),
+		), schnorr.SerializePubKey(aggKey.FinalKey),
+	)
+
+	anchor := &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           "musig2-anchor",
+		Meta:                &proof.MetaReveal{Data: []byte("anchor")},
+		Amount:              1000,
+		EnableEmission:      true,
+		ExternalGroupSigner: true,
+		GroupInternalKey: &keychain.KeyDescriptor{
+			PubKey: groupKey,
+		},
+	}
+	member := &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           "musig2-member",
+		Meta:                &proof.MetaReveal{Data: []byte("member")},
+		Amount:              50,
+		GroupAnchor:         &anchor.AssetName,
+		ExternalGroupSigner: true,
+	}
+	t.queueSeedlingsInBatch(anchor, member)
+	t.assertPendingBatchExists(2)
+
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	t.assertKeyDerived()
+
+	// A session that doesn't include all signers of the group key can't
+	// be started.
+	anchorReq := t.waitForGroupWitnessRequest(anchor.AssetName)
+	_, err = t.planter.StartMuSig2GroupSession(
+		anchorReq.SigHash, []*btcec.PublicKey{
+			signers[0].PubKey(), test.RandPrivKey(t).PubKey(),
+		},
+	)
+	require.ErrorContains(t, err, "raw group key")
+
+	// Both signers are needed to produce the group witnesses of the
+	// anchor and the member of the group.
+	t.signMuSig2GroupWitness(anchorReq, signers)
+
+	t.assertKeyDerived()
+	t.signMuSig2GroupWitness(
+		t.waitForGroupWitnessRequest(member.AssetName), signers,
+	)
+
+	t.assertNoPendingBatch()
+	t.assertGenesisPsbtFinalized()
+	t.assertTxPublished()
+	t.assertNoError()
+
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+
+	sprouts := batches[0].RootAssetCommitment.CommittedAssets()
+	require.Len(t, sprouts, 2)
+	for _, sprout := range sprouts {
+		require.NotNil(t, sprout.GroupKey)
+		require.True(t, sprout.GroupKey.RawKey.PubKey.IsEqual(groupKey))
+	}
+
+	sessions, err := t.planter.MuSig2GroupSessions()
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+// signMultiSigGroupWitness submits the signatures of the given multisig
+// signers for the given group witness request.
+func (t *mintingTestHarness) signMultiSigGroupWitness(
+	req *tapgarden.GroupWitnessRequest, signers []*btcec.PrivateKey) {
+
+	t.Helper()
+
+	for idx, signer := range signers {
+		sig, err := schnorr.Sign(signer, req.SigHash[:])
+		require.NoError(t, err)
+
+		remaining, err := t.planter.SubmitGroupScriptSig(
+			req.SigHash, signer.PubKey(), sig,
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, len(signers)-idx-1, remaining)
+	}
+}
+
+// testMintingMultiSigGroupSigner tests that the group key of a batch can commit
+// to a k-of-n multisig script, with the group witnesses being script path
+// spends that carry the signatures of enough multisig keys.
+func testMintingMultiSigGroupSigner(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	signers := []*btcec.PrivateKey{
+		test.RandPrivKey(t), test.RandPrivKey(t), test.RandPrivKey(t),
+	}
+	multiSig, err := tapgarden.NewGroupMultiSig(2, []*btcec.PublicKey{
+		signers[0].PubKey(), signers[1].PubKey(), signers[2].PubKey(),
+	})
+	require.NoError(t, err)
+	tapscriptRoot, err := multiSig.TapscriptRoot()
+	require.NoError(t, err)
+
+	// The internal key of the group key isn't held by anyone, so the
+	// group can only be issued into through the multisig script.
+	groupKey := test.RandPubKey(t)
+
+	anchor := &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           "multisig-anchor",
+		Meta:                &proof.MetaReveal{Data: []byte("anchor")},
+		Amount:              1000,
+		EnableEmission:      true,
+		ExternalGroupSigner: true,
+		GroupInternalKey: &keychain.KeyDescriptor{
+			PubKey: groupKey,
+		},
+		GroupMultiSig: multiSig,
+	}
+	member := &tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           "multisig-member",
+		Meta:                &proof.MetaReveal{Data: []byte("member")},
+		Amount:              50,
+		GroupAnchor:         &anchor.AssetName,
+		ExternalGroupSigner: true,
+		GroupMultiSig:       multiSig,
+	}
+	t.queueSeedlingsInBatch(anchor, member)
+	t.assertPendingBatchExists(2)
+
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	t.assertKeyDerived()
+
+	anchorReq := t.waitForGroupWitnessRequest(anchor.AssetName)
+	require.True(t, anchorReq.MultiSig.IsEqual(multiSig))
+
+	// The PSBT of the request must carry the multisig leaf, so external
+	// signers can sign it.
+	pkt, err := anchorReq.Packet()
+	require.NoError(t, err)
+	require.Len(t, pkt.Inputs[0].TaprootLeafScript, 1)
+	require.Equal(t, anchorReq.SignDesc.WitnessScript,
+		pkt.Inputs[0].TaprootLeafScript[0].Script)
+	require.Equal(t, tapscriptRoot, pkt.Inputs[0].TaprootMerkleRoot)
+
+	// The request can't be signed with a single key path signature, nor
+	// by a key that isn't part of the multisig script.
+	sig, err := schnorr.Sign(signers[0], anchorReq.SigHash[:])
+	require.NoError(t, err)
+	err = t.planter.SubmitGroupWitness(anchorReq.SigHash, sig)
+	require.ErrorContains(t, err, "requires multisig signatures")
+
+	outsider := test.RandPrivKey(t)
+	outsiderSig, err := schnorr.Sign(outsider, anchorReq.SigHash[:])
+	require.NoError(t, err)
+	_, err = t.planter.SubmitGroupScriptSig(
+		anchorReq.SigHash, outsider.PubKey(), outsiderSig,
+	)
+	require.ErrorContains(t, err, "is not a multisig key")
+
+	// A signature must be valid for the key it is submitted for.
+	_, err = t.planter.SubmitGroupScriptSig(
+		anchorReq.SigHash, signers[1].PubKey(), sig,
+	)
+	require.ErrorContains(t, err, "invalid signature")
+
+	// Any two of the three signers can produce the group witnesses. The
+	// first signature is only counted once.
+	remaining, err := t.planter.SubmitGroupScriptSig(
+		anchorReq.SigHash, signers[0].PubKey(), sig,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, remaining)
+
+	_, err = t.planter.SubmitGroupScriptSig(
+		anchorReq.SigHash, signers[0].PubKey(), sig,
+	)
+	require.ErrorContains(t, err, "already signed")
+
+	reqs, err := t.planter.GroupWitnessRequests()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Len(t, reqs[0].SignedKeys, 1)
+
+	t.signMultiSigGroupWitness(
+		anchorReq, []*btcec.PrivateKey{signers[2]},
+	)
+
+	t.assertKeyDerived()
+	t.signMultiSigGroupWitness(
+		t.waitForGroupWitnessRequest(member.AssetName),
+		[]*btcec.PrivateKey{signers[1], signers[2]},
+	)
+
+	t.assertNoPendingBatch()
+	t.assertGenesisPsbtFinalized()
+	t.assertTxPublished()
+	t.assertNoError()
+
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+
+	// The group witnesses are script path spends of the multisig leaf,
+	// which were verified by the caretaker already.
+	sprouts := batches[0].RootAssetCommitment.CommittedAssets()
+	require.Len(t, sprouts, 2)
+	for _, sprout := range sprouts {
+		require.NotNil(t, sprout.GroupKey)
+		require.True(t, sprout.GroupKey.RawKey.PubKey.IsEqual(groupKey))
+		require.Equal(t, tapscriptRoot, sprout.GroupKey.TapscriptRoot)
+		require.Len(t, sprout.GroupKey.Witness, len(signers)+2)
+	}
+}
+
+// testMintingDelegatedIssuance tests that a delegate key listed in the meta
+// data of a group anchor can issue into the group through a script path spend
+// of its delegation leaf, but only up to the max amount of the delegation.
+func testMintingDelegatedIssuance(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// The delegate key is held by the backing lnd node.
+	delegatePriv := test.RandPrivKey(t)
+	delegateLoc := keychain.KeyLocator{
+		Family: 1000,
+	}
+	t.keyRing.Keys[delegateLoc] = delegatePriv
+	delegateKey := &keychain.KeyDescriptor{
+		PubKey:     delegatePriv.PubKey(),
+		KeyLocator: delegateLoc,
+	}
+
+	delegations := proof.IssuanceDelegations{{
+		Key: hex.EncodeToString(
+			schnorr.SerializePubKey(delegateKey.PubKey),
+		),
+		MaxAmount: 100,
+	}}
+	tapscriptRoot, err := delegations.TapscriptRoot()
+	require.NoError(t, err)
+	anchorMeta, err := proof.NewJSONMetaReveal(&proof.AssetMetadata{
+		Delegations: delegations,
+	})
+	require.NoError(t, err)
+
+	assertRejected := func(seedling *tapgarden.Seedling, errStr string) {
+		updates, err := t.planter.QueueNewSeedling(seedling)
+		require.NoError(t, err)
+		update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+		require.NoError(t, err)
+		require.ErrorContains(t, update.Error, errStr)
+	}
+
+	// Delegations are committed to by the group key when the group is
+	// created, so they can't be added to an existing group.
+	assertRejected(&tapgarden.Seedling{
+		AssetType: asset.Normal,
+		AssetName: "delegations-no-emission",
+		Meta:      anchorMeta,
+		Amount:    10,
+	}, "issuance delegations require emission")
+
+	anchor := &tapgarden.Seedling{
+		AssetType:      asset.Normal,
+		AssetName:      "delegating-anchor",
+		Meta:           anchorMeta,
+		Amount:         1000,
+		EnableEmission: true,
+	}
+	t.queueSeedlingsInBatch(anchor)
+	t.assertPendingBatchExists(1)
+
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	t.assertKeyDerived()
+	t.assertKeyDerived()
+
+	t.assertNoPendingBatch()
+	t.assertGenesisPsbtFinalized()
+	t.assertTxPublished()
+
+	// The group key of the anchor commits to the delegation leaf.
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	anchorSprouts := batches[0].RootAssetCommitment.CommittedAssets()
+	require.Len(t, anchorSprouts, 1)
+	groupKey := anchorSprouts[0].GroupKey
+	require.NotNil(t, groupKey)
+	require.Equal(t, tapscriptRoot, groupKey.TapscriptRoot)
+	require.Len(t, groupKey.Witness, 1)
+
+	groupInfo := func() *asset.AssetGroup {
+		return &asset.AssetGroup{
+			GroupKey: &asset.GroupKey{
+				GroupPubKey: groupKey.GroupPubKey,
+			},
+		}
+	}
+
+	// Only delegate keys of the group can issue in place of the group
+	// key, and only with the backing lnd node.
+	outsiderKey := &keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+	}
+	assertRejected(&tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "outsider-member",
+		Amount:      10,
+		GroupInfo:   groupInfo(),
+		DelegateKey: outsiderKey,
+	}, "is not a delegate key of the group")
+	assertRejected(&tapgarden.Seedling{
+		AssetType:           asset.Normal,
+		AssetName:           "external-delegate-member",
+		Amount:              10,
+		GroupInfo:           groupInfo(),
+		DelegateKey:         delegateKey,
+		ExternalGroupSigner: true,
+	}, "can't be used with an external group signer")
+
+	// The delegate key can issue up to its max amount, summed over all
+	// pending batches.
+	delegated := &tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "delegated-member",
+		Amount:      60,
+		GroupInfo:   groupInfo(),
+		DelegateKey: delegateKey,
+	}
+	t.queueSeedlingsInBatch(delegated)
+	t.assertPendingBatchExists(1)
+
+	assertRejected(&tapgarden.Seedling{
+		AssetType:   asset.Normal,
+		AssetName:   "exceeding-member",
+		Amount:      41,
+		GroupInfo:   groupInfo(),
+		DelegateKey: delegateKey,
+	}, proof.ErrDelegationExceeded.Error())
+
+	// The delegate key should be restored from disk.
+	batches, err = t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+
+	var storedSeedling *tapgarden.Seedling
+	for _, batch := range batches {
+		if seedling, ok := batch.Seedlings[delegated.AssetName]; ok {
+			storedSeedling = seedling
+		}
+	}
+	require.NotNil(t, storedSeedling)
+	require.NotNil(t, storedSeedling.DelegateKey)
+	require.True(t, asset.EqualKeyDescriptors(
+		*delegateKey, *storedSeedling.DelegateKey,
+	))
+
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	t.assertKeyDerived()
+
+	// The anchor batch is still pending as well, so we can't use
+	// assertGenesisPsbtFinalized to match the minting key of the batch.
+	t.assertNoPendingBatch()
+	_, err = fn.RecvOrTimeout(t.wallet.SignPsbtSignal, defaultTimeout)
+	require.NoError(t, err, "psbt sign req not sent")
+	_, err = fn.RecvOrTimeout(t.wallet.ImportPubKeySignal, defaultTimeout)
+	require.NoError(t, err, "pubkey import req not sent")
+	t.assertTxPublished()
+	t.assertNoError()
+
+	// The delegated issuance is a script path spend of the delegation
+	// leaf, which was verified by the caretaker already.
+	batches, err = t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+
+	var delegatedSprout *asset.Asset
+	for _, batch := range batches {
+		sprouts := batch.RootAssetCommitment.CommittedAssets()
+		for _, sprout := range sprouts {
+			if sprout.Tag == delegated.AssetName {
+				delegatedSprout = sprout
+			}
+		}
+	}
+	require.NotNil(t, delegatedSprout)
+	require.NotNil(t, delegatedSprout.GroupKey)
+	require.True(t, delegatedSprout.GroupKey.GroupPubKey.IsEqual(
+		&groupKey.GroupPubKey,
+	))
+
+	witness := delegatedSprout.GroupKey.Witness
+	require.Len(t, witness, 3)
+	require.Equal(t, delegations[0], *delegations.FromWitness(witness))
+
+	delegatedSupply, err := t.store.FetchDelegatedSupply(
+		context.Background(), &groupKey.GroupPubKey,
+		delegateKey.PubKey,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 60, delegatedSupply)
+}
+
+// waitForGenesisPsbtRequest waits for the genesis PSBT request of the current
+// batch to reach the given stage.
+func (t *mintingTestHarness) waitForGenesisPsbtRequest(
+	stage tapgarden.GenesisPsbtStage) *tapgarden.GenesisPsbtRequest {
+
+	t.Helper()
+
+	var req *tapgarden.GenesisPsbtRequest
+	err := wait.NoError(func() error {
+		reqs, err := t.planter.GenesisPsbtRequests()
+		if err != nil {
+			return err
+		}
+
+		if len(reqs) != 1 {
+			return fmt.Errorf("expected 1 request, got %d",
+				len(reqs))
+		}
+
+		if reqs[0].Stage != stage {
+			return fmt.Errorf("expected stage %v, got %v", stage,
+				reqs[0].Stage)
+		}
+
+		req = reqs[0]
+		return nil
+	}, defaultTimeout)
+	require.NoError(t, err)
+	require.True(t, req.BatchKey.IsEqual(t.batchKey.PubKey))
+
+	return req
+}
+
+// copyPsbt returns a deep copy of the given PSBT packet.
+func copyPsbt(t *mintingTestHarness, pkt *psbt.Packet) *psbt.Packet {
+	var b bytes.Buffer
+	require.NoError(t, pkt.Serialize(&b))
+
+	pktCopy, err := psbt.NewFromRawBytes(&b, false)
+	require.NoError(t, err)
+
+	return pktCopy
+}
+
+// testMintingExternalFunding tests that the genesis transaction of a batch can
+// be funded and signed by an external wallet, also across a restart.
+func testMintingExternalFunding(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// A batch that waits for the external wallet can still be cancelled.
+	seedlings := t.newRandSeedlings(1)
+	seedlings[0].EnableEmission = false
+	t.queueSeedlingsInBatch(seedlings...)
+
+	batch, err := t.planter.FinalizeBatch(tapgarden.FinalizeParams{
+		ExternalFunding: true,
+	})
+	require.NoError(t, err)
+	require.True(t, batch.ExternalFunding)
+
+	t.waitForGenesisPsbtRequest(tapgarden.GenesisPsbtFund)
+	cancelledKey := t.cancelMintingBatch(false)
+	require.True(t, cancelledKey.IsEqual(t.batchKey.PubKey))
+
+	reqs, err := t.planter.GenesisPsbtRequests()
+	require.NoError(t, err)
+	require.Empty(t, reqs)
+
+	// We'll now create a new batch that is funded and signed externally.
+	seedlings = t.newRandSeedlings(2)
+	for _, seedling := range seedlings {
+		seedling.EnableEmission = false
+	}
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(2)
+
+	batch, err = t.planter.FinalizeBatch(tapgarden.FinalizeParams{
+		ExternalFunding: true,
+	})
+	require.NoError(t, err)
+	require.True(t, batch.ExternalFunding)
+	t.assertNoPendingBatch()
+
+	// The template only contains the dummy minting output. It must not be
+	// accepted before it's funded.
+	fundReq := t.waitForGenesisPsbtRequest(tapgarden.GenesisPsbtFund)
+	require.Empty(t, fundReq.Packet.UnsignedTx.TxIn)
+	require.Len(t, fundReq.Packet.UnsignedTx.TxOut, 1)
+	require.Equal(
+		t, tapgarden.DummyGenesisTxOut,
+		*fundReq.Packet.UnsignedTx.TxOut[0],
+	)
+
+	err = t.planter.SubmitGenesisPsbt(t.batchKey.PubKey, fundReq.Packet)
+	require.ErrorContains(t, err, "no inputs")
+
+	err = t.planter.SubmitGenesisPsbt(
+		test.RandPubKey(t), fundReq.Packet,
+	)
+	require.ErrorIs(t, err, tapgarden.ErrGenesisPsbtRequestNotFound)
+
+	// The external wallet adds an input and a change output in front of
+	// the minting output.
+	fundedPkt := copyPsbt(t, fundReq.Packet)
+	fundedPkt.UnsignedTx.TxIn = []*wire.TxIn{{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  test.RandHash(),
+			Index: 1,
+		},
+	}}
+	fundedPkt.Inputs = []psbt.PInput{{
+		WitnessUtxo: &wire.TxOut{
+			Value:    100_000,
+			PkScript: []byte{0x1},
+		},
+	}}
+	fundedPkt.UnsignedTx.TxOut = []*wire.TxOut{{
+		Value:    90_000,
+		PkScript: []byte{0x2},
+	}, fundedPkt.UnsignedTx.TxOut[0]}
+	fundedPkt.Outputs = []psbt.POutput{{}, {}}
+	require.NoError(t, t.planter.SubmitGenesisPsbt(
+		t.batchKey.PubKey, fundedPkt,
+	))
+
+	// The caretaker now creates the sprouts based on the genesis outpoint
+	// of the external input, and hands out the PSBT with the final minting
+	// output for signing.
+	t.assertKeyDerived()
+	t.assertKeyDerived()
+	signReq := t.waitForGenesisPsbtRequest(tapgarden.GenesisPsbtSign)
+	signTx := signReq.Packet.UnsignedTx
+	require.Equal(
+		t, fundedPkt.UnsignedTx.TxIn[0].PreviousOutPoint,
+		signTx.TxIn[0].PreviousOutPoint,
+	)
+	require.True(t, txscript.IsPayToTaproot(signTx.TxOut[1].PkScript))
+
+	// The request to sign the genesis PSBT survives a restart.
+	t.refreshChainPlanter()
+	signReq = t.waitForGenesisPsbtRequest(tapgarden.GenesisPsbtSign)
+	require.Equal(t, signTx.TxHash(), signReq.Packet.UnsignedTx.TxHash())
+
+	// A signed PSBT of a different transaction, or one that isn't signed
+	// at all, is rejected.
+	otherPkt := copyPsbt(t, signReq.Packet)
+	otherPkt.UnsignedTx.TxOut[0].Value--
+	err = t.planter.SubmitGenesisPsbt(t.batchKey.PubKey, otherPkt)
+	require.ErrorContains(t, err, "signed genesis psbt has txid")
+
+	err = t.planter.SubmitGenesisPsbt(
+		t.batchKey.PubKey, copyPsbt(t, signReq.Packet),
+	)
+	require.ErrorContains(t, err, "unable to finalize")
+
+	// Once the signed PSBT is submitted, the batch is broadcast.
+	signedPkt := copyPsbt(t, signReq.Packet)
+	signedPkt.Inputs[0].FinalScriptWitness = []byte{0x00}
+	require.NoError(t, t.planter.SubmitGenesisPsbt(
+		t.batchKey.PubKey, signedPkt,
+	))
+
+	_, err = fn.RecvOrTimeout(t.wallet.ImportPubKeySignal, defaultTimeout)
+	require.NoError(t, err)
+
+	publishedTx := t.assertTxPublished()
+	require.Equal(t, signTx.TxHash(), publishedTx.TxHash())
+	t.assertNoError()
+
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+
+	pendingBatch, err := fn.First(batches, func(
+		b *tapgarden.MintingBatch) bool {
+
+		return !isCancelledBatch(b)
+	})
+	require.NoError(t, err)
+	require.True(t, pendingBatch.ExternalFunding)
+	require.Equal(t, tapgarden.BatchStateBroadcast, pendingBatch.State())
+	require.EqualValues(t, 0, pendingBatch.GenesisPacket.ChangeOutputIndex)
+}
+
+// testMintingVanityPrefix tests that a nonce is ground in the JSON meta data of
+// seedlings with a vanity prefix, until their asset IDs match the prefix.
+func testMintingVanityPrefix(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// The first seedling has JSON meta data that must be preserved, while
+	// the meta data of the second one is created from scratch.
+	tickerMeta, err := proof.NewJSONMetaReveal(&proof.AssetMetadata{
+		Ticker: "VANITY",
+	})
+	require.NoError(t, err)
+
+	seedlings := []*tapgarden.Seedling{{
+		AssetType:    asset.Normal,
+		AssetName:    "vanity-ticker",
+		Meta:         tickerMeta,
+		Amount:       1000,
+		VanityPrefix: "a",
+	}, {
+		AssetType:    asset.Collectible,
+		AssetName:    "vanity-collectible",
+		Amount:       1,
+		VanityPrefix: "BC",
+	}}
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(len(seedlings))
+
+	// Seedlings with an invalid prefix or meta data that can't hold a
+	// nonce should be rejected.
+	invalidSeedlings := []struct {
+		seedling *tapgarden.Seedling
+		err      string
+	}{{
+		seedling: &tapgarden.Seedling{
+			AssetType:    asset.Normal,
+			AssetName:    "vanity-not-hex",
+			Amount:       50,
+			VanityPrefix: "xyz",
+		},
+		err: tapgarden.ErrInvalidVanityPrefix.Error(),
+	}, {
+		seedling: &tapgarden.Seedling{
+			AssetType:    asset.Normal,
+			AssetName:    "vanity-too-long",
+			Amount:       50,
+			VanityPrefix: "0123456",
+		},
+		err: tapgarden.ErrInvalidVanityPrefix.Error(),
+	}, {
+		seedling: &tapgarden.Seedling{
+			AssetType: asset.Normal,
+			AssetName: "vanity-opaque-meta",
+			Meta: &proof.MetaReveal{
+				Data: []byte("opaque"),
+			},
+			Amount:       50,
+			VanityPrefix: "ab",
+		},
+		err: "requires JSON meta data",
+	}}
+	for _, invalid := range invalidSeedlings {
+		updates, err := t.planter.QueueNewSeedling(invalid.seedling)
+		require.NoError(t, err)
+		update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+		require.NoError(t, err)
+		require.ErrorContains(t, update.Error, invalid.err)
+	}
+	t.assertPendingBatchExists(len(seedlings))
+
+	// The vanity prefix should be stored with the seedlings on disk.
+	t.assertSeedlingsExist(seedlings, nil)
+
+	// We'll now finalize the batch, which grinds the asset IDs once the
+	// genesis outpoint is known.
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	for range seedlings {
+		t.assertKeyDerived()
+	}
+	t.assertGenesisPsbtFinalized()
+	t.assertTxPublished()
+
+	// The asset IDs of the batch on disk should match the prefixes, and
+	// the meta data with the ground nonce should be stored as well.
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+
+	batch := batches[0]
+	prefixes := map[string]string{
+		"vanity-ticker":      "a",
+		"vanity-collectible": "bc",
+	}
+	for _, sprout := range batch.RootAssetCommitment.CommittedAssets() {
+		assetID := sprout.ID()
+		require.True(t, strings.HasPrefix(
+			hex.EncodeToString(assetID[:]), prefixes[sprout.Tag],
+		))
+
+		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+		meta := batch.AssetMetas[scriptKey]
+		require.NotNil(t, meta)
+		require.Equal(t, proof.MetaJson, meta.Type)
+		require.Equal(t, sprout.MetaHash, meta.MetaHash())
+		require.Contains(t, string(meta.Data), tapgarden.VanityNonceKey)
+
+		if sprout.Tag == "vanity-ticker" {
+			metadata, err := meta.DecodeMetadata()
+			require.NoError(t, err)
+			require.Equal(t, "VANITY", metadata.Ticker)
+		}
+	}
+
+	t.assertNoError()
+}
+
+// testMintingScriptKeys tests that seedlings can be minted to script keys held
+// by other parties, and that each script key can only be used once per batch.
+func testMintingScriptKeys(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// The first seedling is minted to a BIP-86 script key and the second
+	// one to a script key with a tapscript tweak, while the last one uses
+	// a script key derived by the planter.
+	const numSeedlings = 3
+	seedlings := t.newRandSeedlings(numSeedlings)
+
+	bip86Key := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+	})
+	seedlings[0].ScriptKey = &bip86Key
+
+	rawKey := keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+	}
+	tweak := test.RandBytes(32)
+
+	// Script keys are x-only keys, which is how they are parsed from RPC
+	// requests as well.
+	tweakedPubKey, err := schnorr.ParsePubKey(schnorr.SerializePubKey(
+		txscript.ComputeTaprootOutputKey(rawKey.PubKey, tweak),
+	))
+	require.NoError(t, err)
+	tweakedKey := asset.ScriptKey{
+		PubKey: tweakedPubKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: rawKey,
+			Tweak:  tweak,
+		},
+	}
+	seedlings[1].ScriptKey = &tweakedKey
+
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	// A script key that doesn't commit to its raw key and tweak is
+	// rejected.
+	invalid := t.newRandSeedlings(1)[0]
+	invalid.ScriptKey = &asset.ScriptKey{
+		PubKey: tweakedPubKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: bip86Key.RawKey,
+			Tweak:  tweak,
+		},
+	}
+	updates, err := t.planter.QueueNewSeedling(invalid)
+	require.NoError(t, err)
+	planterErr := <-updates
+	require.ErrorIs(t, planterErr.Error, tapgarden.ErrInvalidScriptKey)
+
+	// A script key that is already used in the batch is rejected as well.
+	duplicate := t.newRandSeedlings(1)[0]
+	duplicate.ScriptKey = &bip86Key
+	updates, err = t.planter.QueueNewSeedling(duplicate)
+	require.NoError(t, err)
+	planterErr = <-updates
+	require.ErrorContains(t, planterErr.Error, "already used")
+
+	// We'll now finalize the batch. Script keys are only derived for the
+	// seedling that didn't specify one.
+	t.tickMintingBatch(false)
+	_ = t.assertGenesisTxFunded()
+	for _, seedling := range seedlings {
+		if seedling.ScriptKey == nil {
+			t.assertKeyDerived()
+		}
+
+		if seedling.EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+	t.assertGenesisPsbtFinalized()
+	t.assertTxPublished()
+
+	// The sprouts should be minted to the given script keys.
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+
+	sprouts := batches[0].RootAssetCommitment.CommittedAssets()
+	require.Len(t, sprouts, numSeedlings)
+	for _, sprout := range sprouts {
+		switch sprout.Tag {
+		case seedlings[0].AssetName:
+			require.Equal(t, bip86Key, sprout.ScriptKey)
+
+		case seedlings[1].AssetName:
+			require.Equal(t, tweakedKey, sprout.ScriptKey)
+
+		default:
+			require.False(t, sprout.ScriptKey.PubKey.IsEqual(
+				bip86Key.PubKey,
+			))
+			require.False(t, sprout.ScriptKey.PubKey.IsEqual(
+				tweakedKey.PubKey,
+			))
+		}
+	}
+
+	t.assertNoError()
+}
+
+// testMintingValidation tests that a dry run of minting a set of seedlings
+// runs the same validation as queueing them, without modifying the pending
+// batch or deriving any keys.
+func testMintingValidation(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	// We'll start with a pending batch of a single seedling that creates a
+	// new group.
+	seedlings := t.newRandSeedlings(3)
+	seedlings[0].EnableEmission = true
+	t.queueSeedlingsInBatch(seedlings[0])
+	t.assertPendingBatchExists(1)
+
+	// The other seedlings are validated as if they were added to the
+	// pending batch, so they can use its seedling as their group anchor.
+	seedlings[1].EnableEmission = false
+	seedlings[1].GroupAnchor = &seedlings[0].AssetName
+	seedlings[1].AssetType = seedlings[0].AssetType
+	seedlings[1].Amount = seedlings[0].Amount
+	seedlings[2].EnableEmission = false
+
+	genesisPoint := test.RandOp(t)
+	validation, err := t.planter.ValidateMint(
+		tapgarden.ValidateMintParams{
+			Seedlings:         seedlings[1:],
+			GenesisPoint:      &genesisPoint,
+			AnchorOutputIndex: 1,
+		},
+	)
+	require.NoError(t, err)
+	require.False(t, validation.ProvisionalIDs)
+	require.Len(t, validation.Sprouts, 2)
+	require.Len(t, validation.Metas, 2)
+
+	// The asset IDs commit to the given genesis outpoint and output index.
+	for idx, seedling := range seedlings[1:] {
+		sprout := validation.Sprouts[idx]
+		expectedGen := asset.Genesis{
+			FirstPrevOut: genesisPoint,
+			Tag:          seedling.AssetName,
+			MetaHash:     seedling.Meta.MetaHash(),
+			OutputIndex:  1,
+			Type:         seedling.AssetType,
+		}
+		require.Equal(t, expectedGen.ID(), sprout.ID())
+		require.Equal(t, seedling.Meta, validation.Metas[idx])
+	}
+
+	// The grouped seedling has a group key, while the other one doesn't.
+	require.NotNil(t, validation.Sprouts[0].GroupKey)
+	require.Nil(t, validation.Sprouts[1].GroupKey)
+
+	// Without a genesis outpoint, the asset IDs are only provisional.
+	validation, err = t.planter.ValidateMint(
+		tapgarden.ValidateMintParams{
+			Seedlings: seedlings[2:],
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, validation.ProvisionalIDs)
+	require.Len(t, validation.Sprouts, 1)
+
+	// A seedling that would be rejected by the planter is rejected by the
+	// dry run as well.
+	duplicate := t.newRandSeedlings(1)[0]
+	duplicate.AssetName = seedlings[0].AssetName
+	_, err = t.planter.ValidateMint(tapgarden.ValidateMintParams{
+		Seedlings: []*tapgarden.Seedling{duplicate},
+	})
+	require.Error(t, err)
+
+	_, err = t.planter.ValidateMint(tapgarden.ValidateMintParams{
+		Seedlings: []*tapgarden.Seedling{seedlings[2], seedlings[2]},
+	})
+	require.Error(t, err)
+
+	_, err = t.planter.ValidateMint(tapgarden.ValidateMintParams{})
+	require.Error(t, err)
+
+	// None of the validated seedlings were added to the pending batch.
+	t.assertPendingBatchExists(1)
+
+	// The validated seedlings can still be queued afterwards.
+	updates, err := t.planter.QueueNewSeedling(seedlings[1])
+	require.NoError(t, err)
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, update.Error)
+	t.assertPendingBatchExists(2)
+
+	t.assertNoError()
+}
+
+// mintingStoreTestCase is used to programmatically run a series of test cases
+// that are parametrized based on a fresh minting store.
+type mintingStoreTestCase struct {
+	name     string
+	interval time.Duration
+	testFunc func(t *mintingTestHarness)
+}
+
+// testCases houses the set of minting store test cases.
+var testCases = []mintingStoreTestCase{
+	{
+		name:     "basic_asset_creation",
+		interval: defaultInterval,
+		testFunc: testBasicAssetCreation,
+	},
+	{
+		name:     "creation_by_minting_ticker",
+		interval: minterInterval,
+		testFunc: testMintingTicker,
+	},
+	{
+		name:     "minting_with_cancellation",
+		interval: minterInterval,
+		testFunc: testMintingCancelFinalize,
+	},
+	{
+		name:     "minting_schedule_threshold",
+		interval: defaultInterval,
+		testFunc: testMintingScheduleThreshold,
+	},
+	{
+		name:     "minting_schedule_interval",
+		interval: defaultInterval,
+		testFunc: testMintingScheduleInterval,
+	},
+	{
+		name:     "minting_cancel_seedling",
+		interval: defaultInterval,
+		testFunc: testMintingCancelSeedling,
+	},
+	{
+		name:     "minting_preview",
+		interval: defaultInterval,
+		testFunc: testMintingPreview,
+	},
+	{
+		name:     "minting_batch_costs",
+		interval: defaultInterval,
+		testFunc: testMintingBatchCosts,
+	},
+	{
+		name:     "minting_decimal_display",
+		interval: defaultInterval,
+		testFunc: testMintingDecimalDisplay,
+	},
+	{
+		name:     "minting_external_group_signer",
+		interval: defaultInterval,
+		testFunc: testMintingExternalGroupSigner,
+	},
+	{
+		name:     "minting_musig2_group_signer",
+		interval: defaultInterval,
+		testFunc: testMintingMuSig2GroupSigner,
+	},
+	{
+		name:     "minting_multisig_group_signer",
+		interval: defaultInterval,
+		testFunc: testMintingMultiSigGroupSigner,
+	},
+	{
+		name:     "minting_delegated_issuance",
+		interval: defaultInterval,
+		testFunc: testMintingDelegatedIssuance,
+	},
+	{
+		name:     "minting_tapscript_sibling",
+		interval: defaultInterval,
+		testFunc: testMintingTapscriptSibling,
+	},
+	{
+		name:     "minting_max_supply",
+		interval: defaultInterval,
+		testFunc: testMintingMaxSupply,
+	},
+	{
+		name:     "minting_emission_schedule",
+		interval: defaultInterval,
+		testFunc: testMintingEmissionSchedule,
+	},
+	{
+		name:     "minting_external_funding",
+		interval: defaultInterval,
+		testFunc: testMintingExternalFunding,
+	},
+	{
+		name:     "minting_vanity_prefix",
+		interval: defaultInterval,
+		testFunc: testMintingVanityPrefix,
+	},
+	{
+		name:     "minting_script_keys",
+		interval: defaultInterval,
+		testFunc: testMintingScriptKeys,
+	},
+	{
+		name:     "minting_validation",
+		interval: defaultInterval,
+		testFunc: testMintingValidation,
 	},
 }
 
@@ -0,0 +1,174 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+const (
+	// defaultProofRetrievalDelay is the default time we wait before the
+	// first attempt to retrieve a proof for an inbound transfer.
+	defaultProofRetrievalDelay = 5 * time.Second
+
+	// defaultProofRetrievalAttemptTimeout is the default maximum time a
+	// single attempt to retrieve a proof from a courier may take.
+	defaultProofRetrievalAttemptTimeout = 10 * time.Minute
+
+	// defaultProofRetrievalNumTries is the default number of rounds of
+	// attempts to retrieve a proof from all couriers of an address.
+	defaultProofRetrievalNumTries = 2000
+
+	// defaultProofRetrievalInitialBackoff is the default time we wait
+	// after the first failed round of attempts to retrieve a proof.
+	defaultProofRetrievalInitialBackoff = 30 * time.Second
+
+	// defaultProofRetrievalMaxBackoff is the default maximum time we wait
+	// between two rounds of attempts to retrieve a proof.
+	defaultProofRetrievalMaxBackoff = 5 * time.Minute
+)
+
+// ProofRetrievalCfg configures how the custodian retrieves the proofs of
+// inbound transfers from the proof couriers of the receiving address.
+type ProofRetrievalCfg struct {
+	// Delay is the time we wait before the first attempt to retrieve a
+	// proof, to give the sender an opportunity to deliver it.
+	Delay time.Duration `long:"delay" description:"The time to wait after an inbound transfer was detected before the first attempt to retrieve its proof, giving the sender time to deliver it to the proof courier."`
+
+	// AttemptTimeout is the maximum time a single attempt to retrieve a
+	// proof from one courier may take before the next courier is tried.
+	// Zero means there is no timeout.
+	AttemptTimeout time.Duration `long:"attempttimeout" description:"The maximum time a single attempt to retrieve a proof from one proof courier may take before the next courier of the address is tried. 0 means there is no timeout."`
+
+	// NumTries is the number of rounds of attempts to retrieve a proof
+	// from all couriers of an address before giving up. Zero means we try
+	// until the proof was retrieved.
+	NumTries uint32 `long:"numtries" description:"The number of rounds of attempts to retrieve a proof from the main and all fallback proof couriers of an address before giving up. 0 means retrieval is retried until it succeeds."`
+
+	// InitialBackoff is the time we wait after the first failed round of
+	// attempts. It is doubled after every further failed round.
+	InitialBackoff time.Duration `long:"initialbackoff" description:"The time to wait after the first failed round of proof retrieval attempts, doubled after each further failed round."`
+
+	// MaxBackoff is the maximum time we wait between two rounds of
+	// attempts.
+	MaxBackoff time.Duration `long:"maxbackoff" description:"The maximum time to wait between two rounds of proof retrieval attempts."`
+}
+
+// DefaultProofRetrievalCfg returns the default proof retrieval config.
+func DefaultProofRetrievalCfg() *ProofRetrievalCfg {
+	return &ProofRetrievalCfg{
+		Delay:          defaultProofRetrievalDelay,
+		AttemptTimeout: defaultProofRetrievalAttemptTimeout,
+		NumTries:       defaultProofRetrievalNumTries,
+		InitialBackoff: defaultProofRetrievalInitialBackoff,
+		MaxBackoff:     defaultProofRetrievalMaxBackoff,
+	}
+}
+
+// Validate makes sure the proof retrieval config is sane.
+func (c *ProofRetrievalCfg) Validate() error {
+	switch {
+	case c.Delay < 0 || c.AttemptTimeout < 0:
+		return fmt.Errorf("delay and attempt timeout must not be " +
+			"negative")
+
+	case c.InitialBackoff <= 0:
+		return fmt.Errorf("initial backoff must be positive")
+
+	case c.MaxBackoff < c.InitialBackoff:
+		return fmt.Errorf("max backoff must not be smaller than the " +
+			"initial backoff")
+	}
+
+	return nil
+}
+
+// proofFetcher fetches a proof from the proof courier at the given address.
+type proofFetcher func(ctx context.Context,
+	courierAddr url.URL) (*proof.AnnotatedProof, error)
+
+// retrieve attempts to fetch a proof from each of the given proof courier
+// addresses in order, until one of them succeeds. If all of them fail, we back
+// off and start over, until the configured number of rounds is exhausted.
+func (c *ProofRetrievalCfg) retrieve(ctx context.Context,
+	courierAddrs []url.URL, fetch proofFetcher) (*proof.AnnotatedProof,
+	error) {
+
+	if len(courierAddrs) == 0 {
+		return nil, errors.New("no proof courier addresses")
+	}
+
+	// Sleep to give the sender an opportunity to transfer the proof to the
+	// proof courier service. Without this delay our first attempt at
+	// retrieving the proof will very likely fail.
+	select {
+	case <-time.After(c.Delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	var (
+		backoff = c.InitialBackoff
+		lastErr error
+	)
+	for round := uint32(1); ; round++ {
+		if c.NumTries != 0 && round > c.NumTries {
+			break
+		}
+
+		if round > 1 {
+			log.Debugf("Retrying proof retrieval in %v (round %d)",
+				backoff, round)
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			backoff *= 2
+			if backoff > c.MaxBackoff {
+				backoff = c.MaxBackoff
+			}
+		}
+
+		for _, courierAddr := range courierAddrs {
+			addrProof, err := c.fetchOnce(ctx, courierAddr, fetch)
+			if err == nil {
+				return addrProof, nil
+			}
+
+			// If we're shutting down, there's no point in trying
+			// any further couriers.
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			log.Warnf("Unable to retrieve proof from courier %v: "+
+				"%v", courierAddr.String(), err)
+			lastErr = err
+		}
+	}
+
+	return nil, fmt.Errorf("unable to retrieve proof after %d rounds: %w",
+		c.NumTries, lastErr)
+}
+
+// fetchOnce makes a single attempt at fetching a proof from the courier at the
+// given address, limited by the attempt timeout.
+func (c *ProofRetrievalCfg) fetchOnce(ctx context.Context,
+	courierAddr url.URL, fetch proofFetcher) (*proof.AnnotatedProof,
+	error) {
+
+	if c.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.AttemptTimeout)
+		defer cancel()
+	}
+
+	return fetch(ctx, courierAddr)
+}
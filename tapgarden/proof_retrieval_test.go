@@ -0,0 +1,86 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProofRetrievalRetries tests that proofs are retrieved from the fallback
+// proof couriers in order and that failed rounds of attempts are retried.
+func TestProofRetrievalRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := &ProofRetrievalCfg{
+		AttemptTimeout: 50 * time.Millisecond,
+		NumTries:       3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+	require.NoError(t, cfg.Validate())
+
+	courierAddrs := []url.URL{
+		{Scheme: "hashmail", Host: "primary:443"},
+		{Scheme: "universerpc", Host: "fallback:10029"},
+	}
+	errUnavailable := errors.New("courier unavailable")
+
+	// newFetcher returns a proof fetcher that records the attempts and
+	// succeeds at the given attempt through the given courier. The primary
+	// courier blocks until the attempt times out.
+	newFetcher := func(attempts *[]string, successAttempt int,
+		successHost string) proofFetcher {
+
+		return func(ctx context.Context,
+			addr url.URL) (*proof.AnnotatedProof, error) {
+
+			*attempts = append(*attempts, addr.Host)
+			if len(*attempts) == successAttempt &&
+				addr.Host == successHost {
+
+				return &proof.AnnotatedProof{}, nil
+			}
+
+			if addr.Host == "primary:443" {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+
+			return nil, errUnavailable
+		}
+	}
+
+	// A timed out primary courier is skipped for the fallback.
+	var attempts []string
+	p, err := cfg.retrieve(
+		ctx, courierAddrs, newFetcher(&attempts, 2, "fallback:10029"),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	require.Equal(t, []string{"primary:443", "fallback:10029"}, attempts)
+
+	// If all couriers fail, the next round starts with the primary again.
+	attempts = nil
+	_, err = cfg.retrieve(
+		ctx, courierAddrs, newFetcher(&attempts, 3, "primary:443"),
+	)
+	require.NoError(t, err)
+	require.Len(t, attempts, 3)
+
+	// Once all rounds failed, the error of the last attempt is returned.
+	attempts = nil
+	_, err = cfg.retrieve(ctx, courierAddrs, newFetcher(&attempts, 0, ""))
+	require.ErrorIs(t, err, errUnavailable)
+	require.Len(t, attempts, 6)
+
+	// Invalid backoffs are rejected.
+	cfg.MaxBackoff = 0
+	require.Error(t, cfg.Validate())
+	require.NoError(t, DefaultProofRetrievalCfg().Validate())
+}
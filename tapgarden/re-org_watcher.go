@@ -27,6 +27,8 @@ type proofRegistration struct {
 	blockHeight int32
 
 	updateCb proof.UpdateCallback
+
+	reOrgCb proof.ReOrgCallback
 }
 
 // anchorTxNotification is a struct that holds all proof watch subscriptions for
@@ -97,6 +99,7 @@ type ReOrgWatcher struct {
 
 	incomingProofs chan *proofRegistration
 	incomingConfs  chan *chainntnfs.TxConfirmation
+	incomingReOrgs chan chainhash.Hash
 
 	// pendingProofs is a list of all proofs that are currently being
 	// watched for re-orgs, keyed by their anchor transaction hash.
@@ -113,6 +116,7 @@ func NewReOrgWatcher(cfg *ReOrgWatcherConfig) *ReOrgWatcher {
 		cfg:            cfg,
 		incomingProofs: make(chan *proofRegistration),
 		incomingConfs:  make(chan *chainntnfs.TxConfirmation),
+		incomingReOrgs: make(chan chainhash.Hash),
 		pendingProofs:  make(map[chainhash.Hash]*anchorTxNotification),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
@@ -269,10 +273,14 @@ func (w *ReOrgWatcher) waitForConf(ctx context.Context, txHash chainhash.Hash,
 
 				// We continue to watch the transaction until
 				// it reaches a safe confirmation depth. We
-				// basically only use this signal to log the
-				// message above. We expect another confirmation
-				// to come in once the transaction is included
-				// in a new block in the re-organized chain.
+				// expect another confirmation to come in once
+				// the transaction is included in a new block
+				// in the re-organized chain. To make sure it
+				// is, the main loop re-broadcasts it.
+				select {
+				case w.incomingReOrgs <- txHash:
+				case <-w.Quit:
+				}
 
 			case err := <-errChan:
 				if !fn.IsCanceled(err) {
@@ -332,6 +340,49 @@ func (w *ReOrgWatcher) updateProofs(proofNtfn *anchorTxNotification,
 			log.Error(err.Error())
 			return err
 		}
+
+		// The proofs are now anchored in the new block, which is what
+		// we compare any further confirmations and the safe depth
+		// against.
+		r.blockHash = *conf.BlockHash
+		r.blockHeight = int32(conf.BlockHeight)
+	}
+
+	return nil
+}
+
+// handleReOrg re-broadcasts an anchor transaction that was re-organized out of
+// the chain and then informs all callers that registered for re-orgs about the
+// result.
+func (w *ReOrgWatcher) handleReOrg(proofNtfn *anchorTxNotification) error {
+	anchorTx := proofNtfn.firstRegistration().anchorTx
+	txHash := anchorTx.TxHash()
+
+	ctx, cancel := w.WithCtxQuit()
+	defer cancel()
+
+	// Re-broadcasting fails if one of the inputs of the transaction was
+	// spent by a different transaction in the new chain. There is nothing
+	// we can do about that here, so we hand the error to the callers.
+	broadcastErr := w.cfg.ChainBridge.PublishTransaction(
+		ctx, &anchorTx, "tapd-asset-reorg-rebroadcast",
+	)
+	if broadcastErr != nil {
+		log.Warnf("Unable to re-broadcast anchor TX %v after re-org: "+
+			"%v", txHash, broadcastErr)
+	} else {
+		log.Infof("Re-broadcast anchor TX %v after re-org", txHash)
+	}
+
+	for _, r := range proofNtfn.proofsRegistrations {
+		if r.reOrgCb == nil {
+			continue
+		}
+
+		if err := r.reOrgCb(&anchorTx, broadcastErr); err != nil {
+			return fmt.Errorf("unable to handle re-org of anchor "+
+				"TX %v: %w", txHash, err)
+		}
 	}
 
 	return nil
@@ -438,6 +489,20 @@ func (w *ReOrgWatcher) watchTransactions() {
 				return
 			}
 
+		case txHash := <-w.incomingReOrgs:
+			txNtfn, ok := w.pendingProofs[txHash]
+			if !ok {
+				log.Debugf("Received re-org for anchor TX "+
+					"we're (no longer?) watching: %v",
+					txHash)
+				continue
+			}
+
+			if err := w.handleReOrg(txNtfn); err != nil {
+				w.reportErr(err)
+				return
+			}
+
 		case newBlock := <-newBlockChan:
 			log.Infof("New block at height %d", newBlock)
 			w.bestHeight.Store(newBlock)
@@ -476,6 +541,15 @@ func (w *ReOrgWatcher) watchTransactions() {
 func (w *ReOrgWatcher) WatchProofs(newProofs []*proof.Proof,
 	onProofUpdate proof.UpdateCallback) error {
 
+	return w.WatchProofsWithReOrg(newProofs, nil, onProofUpdate)
+}
+
+// WatchProofsWithReOrg is the same as WatchProofs, but additionally calls the
+// given re-org callback whenever the anchor transaction of the proofs was
+// re-organized out of the chain, before it confirms again.
+func (w *ReOrgWatcher) WatchProofsWithReOrg(newProofs []*proof.Proof,
+	onReOrg proof.ReOrgCallback, onProofUpdate proof.UpdateCallback) error {
+
 	if len(newProofs) == 0 {
 		return fmt.Errorf("cannot watch empty proof slice")
 	}
@@ -507,6 +581,7 @@ func (w *ReOrgWatcher) WatchProofs(newProofs []*proof.Proof,
 		blockHash:   blockHash,
 		blockHeight: int32(blockHeight),
 		updateCb:    onProofUpdate,
+		reOrgCb:     onReOrg,
 	}:
 	case <-w.Quit:
 		return fmt.Errorf("re-org watcher was stopped")
@@ -199,3 +199,80 @@ func TestWatchProofs(t *testing.T) {
 		return len(h.w.pendingProofs) == 0
 	})
 }
+
+// TestWatchProofsReOrg makes sure that an anchor transaction that was
+// re-organized out of the chain is re-broadcast, that the re-org callback is
+// called and that the proofs are only updated once for the new block.
+func TestWatchProofsReOrg(t *testing.T) {
+	t.Parallel()
+
+	h := newReOrgWatcherHarness(t)
+	require.NoError(t, h.w.Start())
+	h.assertStartup()
+
+	anchorTx := makeTx()
+	proofs := []*proof.Proof{makeProof(anchorTx)}
+	newBlock := makeBlock(anchorTx)
+	newBlockHash := newBlock.BlockHash()
+
+	var reOrgCalled, updateCalled atomic.Int32
+	reOrgCb := func(tx *wire.MsgTx, broadcastErr error) error {
+		require.Equal(t, anchorTx.TxHash(), tx.TxHash())
+		require.NoError(t, broadcastErr)
+
+		reOrgCalled.Add(1)
+		return nil
+	}
+	updateCb := func(proofs []*proof.Proof) error {
+		require.Equal(t, newBlock.Header, proofs[0].BlockHeader)
+
+		updateCalled.Add(1)
+		return nil
+	}
+
+	require.NoError(t, h.w.WatchProofsWithReOrg(proofs, reOrgCb, updateCb))
+	confReq, err := fn.RecvOrTimeout(
+		h.chainBridge.ConfReqSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	// Once the transaction is re-organized out, it should be re-broadcast
+	// before the re-org callback is called.
+	h.chainBridge.ReOrgChans[*confReq] <- struct{}{}
+	publishedTx, err := fn.RecvOrTimeout(
+		h.chainBridge.PublishReq, testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, anchorTx.TxHash(), (*publishedTx).TxHash())
+
+	h.eventually(func() bool {
+		return reOrgCalled.Load() == 1
+	})
+	require.EqualValues(t, 0, updateCalled.Load())
+
+	// The transaction now confirms in a new block, which should update the
+	// proofs exactly once, even if the confirmation is delivered again.
+	conf := &chainntnfs.TxConfirmation{
+		BlockHash:   &newBlockHash,
+		BlockHeight: testReOrgBlockHeight,
+		TxIndex:     1,
+		Tx:          anchorTx,
+		Block:       newBlock,
+	}
+	confChan := h.chainBridge.ConfReqs[*confReq].Confirmed
+	confChan <- conf
+	confChan <- conf
+
+	h.eventually(func() bool {
+		return updateCalled.Load() == 1
+	})
+
+	// The safe depth is now counted from the new block, so a block that is
+	// deep enough for the initial block but not for the new one shouldn't
+	// cause the transaction to be removed from the watcher.
+	h.chainBridge.NewBlocks <- testInitialBlockHeight + testSafeDepth
+
+	require.NoError(t, h.w.Stop())
+	require.Len(t, h.w.pendingProofs, 1)
+	require.EqualValues(t, 1, updateCalled.Load())
+}
@@ -1,10 +1,18 @@
 package tapgarden
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -17,6 +25,10 @@ var (
 	// ErrInvalidAssetAmt is returned in an asset request has an invalid
 	// amount.
 	ErrInvalidAssetAmt = fmt.Errorf("asset amt cannot be zero")
+
+	// ErrInvalidScriptKey is returned if the script key of a seedling
+	// doesn't match its raw key and tweak.
+	ErrInvalidScriptKey = fmt.Errorf("invalid script key")
 )
 
 // MintingState is an enum that tracks an asset through the various minting
@@ -80,6 +92,26 @@ type Seedling struct {
 	// Amount is the total amount of the asset.
 	Amount uint64
 
+	// DecimalDisplay is the number of decimal places that should be used
+	// when displaying amounts of the asset. It is committed to as part of
+	// the JSON meta data of the asset, and all assets of a group must use
+	// the same decimal display.
+	DecimalDisplay uint32
+
+	// MaxSupply is the optional maximum supply of the asset group created
+	// by the seedling, summed over all issuances into the group. It is
+	// committed to as part of the JSON meta data of the asset, and can
+	// only be set if emission is enabled. Zero means the supply of the
+	// group isn't capped.
+	MaxSupply uint64
+
+	// EmissionSchedule is the optional emission schedule of the asset
+	// group created by the seedling, which determines the supply of the
+	// group that can be issued at each block height. It is committed to as
+	// part of the JSON meta data of the asset, and can only be set if
+	// emission is enabled.
+	EmissionSchedule proof.EmissionSchedule
+
 	// GroupInfo contains the information needed to link this asset to an
 	// exiting group.
 	GroupInfo *asset.AssetGroup
@@ -93,6 +125,53 @@ type Seedling struct {
 	// same group key as the anchor asset.
 	GroupAnchor *string
 
+	// ExternalGroupSigner if true, then the group witness of the asset is
+	// produced by an external signer that holds the group key, instead of
+	// the backing lnd node. Once the batch is finalized, a group witness
+	// request is published for the asset, and the batch is only
+	// broadcast after a valid signature was submitted for it.
+	ExternalGroupSigner bool
+
+	// GroupInternalKey is the raw key of a new asset group that is held by
+	// an external signer. It can only be set if emission is enabled and
+	// an external group signer is used.
+	GroupInternalKey *keychain.KeyDescriptor
+
+	// GroupMultiSig is the optional k-of-n multisig script of the group
+	// key of the seedling. If set, the group key commits to the script as
+	// the only leaf of its tapscript tree, and the group witness is a
+	// script path spend with the signatures of enough multisig keys. It
+	// can only be set if an external group signer is used.
+	GroupMultiSig *GroupMultiSig
+
+	// DelegateKey is the optional delegate key the asset is issued with
+	// into an existing asset group. If set, the group witness is a script
+	// path spend of the issuance delegation of the key that the group key
+	// commits to, instead of a signature of the group key. The key must be
+	// held by the backing lnd node.
+	DelegateKey *keychain.KeyDescriptor
+
+	// ScriptKey is the optional script key the asset is minted to. If it
+	// isn't set, a new BIP-86 key is derived from the backing lnd node.
+	// Setting it allows assets of the same batch to be controlled by
+	// different parties from genesis, in which case the raw key and the
+	// tweak must be fully specified.
+	ScriptKey *asset.ScriptKey
+
+	// TapscriptSibling is an optional tapscript sibling that is committed
+	// to next to the Taproot Asset commitment in the minting output of the
+	// batch the seedling is added to. It is only used when the seedling
+	// creates a new batch, otherwise it must match the sibling of the
+	// pending batch. It isn't stored with the seedling, but with the
+	// batch.
+	TapscriptSibling *commitment.TapscriptPreimage
+
+	// VanityPrefix is an optional hex encoded prefix the ID of the asset
+	// must start with. If set, a nonce is added to the JSON meta data of
+	// the asset and ground until the asset ID matches the prefix, once the
+	// genesis outpoint of the batch is known.
+	VanityPrefix string
+
 	// update is used to send updates w.r.t the state of the batch.
 	updates SeedlingUpdates
 }
@@ -109,6 +188,78 @@ func (c Seedling) validateFields() error {
 		return err
 	}
 
+	// The meta data must be valid for its type, for example structured
+	// JSON meta data must follow the asset metadata schema.
+	if err := c.Meta.Validate(); err != nil {
+		return err
+	}
+
+	// A vanity asset ID is ground by adding a nonce to the JSON meta data
+	// of the asset, so no other meta data type can be used.
+	if c.VanityPrefix != "" {
+		if err := validateVanityPrefix(c.VanityPrefix); err != nil {
+			return err
+		}
+
+		if c.Meta != nil && c.Meta.Type != proof.MetaJson {
+			return fmt.Errorf("vanity asset ID requires JSON " +
+				"meta data")
+		}
+	}
+
+	// A given script key must commit to its raw key and tweak, otherwise
+	// the asset couldn't be spent by the party that holds the raw key.
+	if c.ScriptKey != nil {
+		if err := ValidateScriptKey(*c.ScriptKey); err != nil {
+			return err
+		}
+	}
+
+	// The tapscript sibling must be a valid preimage that isn't a Taproot
+	// Asset commitment itself.
+	if c.TapscriptSibling != nil {
+		if _, err := c.TapscriptSibling.TapHash(); err != nil {
+			return fmt.Errorf("invalid tapscript sibling: %w", err)
+		}
+
+		if err := c.TapscriptSibling.VerifyNoCommitment(); err != nil {
+			return err
+		}
+	}
+
+	if c.GroupMultiSig != nil {
+		if err := c.GroupMultiSig.Validate(); err != nil {
+			return fmt.Errorf("invalid group multisig script: %w",
+				err)
+		}
+	}
+
+	// The decimal display must be committed to in the meta data, so it
+	// can be verified by anyone that receives the asset.
+	metaDecimalDisplay, err := c.Meta.DecimalDisplay()
+	if err != nil {
+		return err
+	}
+
+	// The same goes for the maximum supply of a new asset group.
+	metaMaxSupply, err := c.Meta.MaxSupply()
+	if err != nil {
+		return err
+	}
+
+	// And also for the emission schedule of a new asset group.
+	metaSchedule, err := c.Meta.EmissionSchedule()
+	if err != nil {
+		return err
+	}
+
+	// The issuance delegations of a new asset group are only committed to
+	// in the meta data.
+	metaDelegations, err := c.Meta.Delegations()
+	if err != nil {
+		return err
+	}
+
 	switch {
 	// Only normal and collectible asset types are supported.
 	//
@@ -120,6 +271,134 @@ func (c Seedling) validateFields() error {
 	// Creating an asset with zero available supply is not allowed.
 	case c.Amount == 0:
 		return ErrInvalidAssetAmt
+
+	case c.DecimalDisplay > asset.MaxDecimalDisplay:
+		return asset.ErrInvalidDecimalDisplay
+
+	// Collectibles can't be divided, so their amounts are always
+	// displayed in whole units.
+	case c.AssetType == asset.Collectible && c.DecimalDisplay != 0:
+		return fmt.Errorf("decimal display not supported for " +
+			"collectibles")
+
+	case c.DecimalDisplay != metaDecimalDisplay:
+		return fmt.Errorf("decimal display %d doesn't match meta "+
+			"data decimal display %d", c.DecimalDisplay,
+			metaDecimalDisplay)
+
+	case c.MaxSupply != metaMaxSupply:
+		return fmt.Errorf("max supply %d doesn't match meta data max "+
+			"supply %d", c.MaxSupply, metaMaxSupply)
+
+	// The maximum supply is committed to when the group is created, so
+	// reissuances into an existing group can't change it.
+	case c.MaxSupply != 0 && !c.EnableEmission:
+		return fmt.Errorf("max supply requires emission to be enabled")
+
+	case c.MaxSupply != 0 && c.Amount > c.MaxSupply:
+		return fmt.Errorf("%w: amount %d exceeds max supply %d",
+			proof.ErrMaxSupplyExceeded, c.Amount, c.MaxSupply)
+
+	case !slices.Equal(c.EmissionSchedule, metaSchedule):
+		return fmt.Errorf("emission schedule doesn't match meta data " +
+			"emission schedule")
+
+	case len(c.EmissionSchedule) != 0 && !c.EnableEmission:
+		return fmt.Errorf("emission schedule requires emission to be " +
+			"enabled")
+
+	// A raw group key can only be specified for a new group that is
+	// signed for externally, as the backing lnd node derives its own keys
+	// otherwise.
+	case c.GroupInternalKey != nil && !c.ExternalGroupSigner:
+		return fmt.Errorf("group internal key requires an external " +
+			"group signer")
+
+	case c.GroupInternalKey != nil && !c.EnableEmission:
+		return fmt.Errorf("group internal key requires emission to " +
+			"be enabled")
+
+	// The multisig keys are held by external signers, so the witness
+	// can only be produced by them.
+	case c.GroupMultiSig != nil && !c.ExternalGroupSigner:
+		return fmt.Errorf("group multisig script requires an " +
+			"external group signer")
+
+	// The group key of a new group commits to its issuance delegations,
+	// so they can't be added to an existing group.
+	case len(metaDelegations) != 0 && !c.EnableEmission:
+		return fmt.Errorf("issuance delegations require emission to " +
+			"be enabled")
+
+	// The tapscript tree of a group key with a multisig script has no
+	// room for delegation leaves.
+	case len(metaDelegations) != 0 && c.GroupMultiSig != nil:
+		return fmt.Errorf("issuance delegations can't be combined " +
+			"with a group multisig script")
+
+	// A delegate key can only issue into a group that was created with
+	// its delegation, and it signs in place of the group key.
+	case c.DelegateKey != nil && !c.HasGroupKey():
+		return fmt.Errorf("delegate key requires an existing asset " +
+			"group")
+
+	case c.DelegateKey != nil && c.ExternalGroupSigner:
+		return fmt.Errorf("delegate key can't be used with an " +
+			"external group signer")
+
+	case c.ExternalGroupSigner && c.EnableEmission &&
+		c.GroupInternalKey == nil:
+
+		return fmt.Errorf("external group signer requires a group " +
+			"internal key for a new group")
+
+	case c.ExternalGroupSigner && !c.EnableEmission &&
+		!c.HasGroupKey() && c.GroupAnchor == nil:
+
+		return fmt.Errorf("external group signer requires a grouped " +
+			"asset")
+	}
+
+	return nil
+}
+
+// ValidateScriptKey makes sure the Taproot output key of the given script key
+// is derived from its raw key and tweak. An empty tweak denotes a BIP-86 key.
+func ValidateScriptKey(scriptKey asset.ScriptKey) error {
+	if scriptKey.PubKey == nil || scriptKey.TweakedScriptKey == nil ||
+		scriptKey.RawKey.PubKey == nil {
+
+		return fmt.Errorf("%w: raw key must be specified",
+			ErrInvalidScriptKey)
+	}
+
+	var (
+		rawKey      = scriptKey.RawKey.PubKey
+		expectedKey *btcec.PublicKey
+	)
+	switch len(scriptKey.Tweak) {
+	case 0:
+		expectedKey = txscript.ComputeTaprootKeyNoScript(rawKey)
+
+	case sha256.Size:
+		expectedKey = txscript.ComputeTaprootOutputKey(
+			rawKey, scriptKey.Tweak,
+		)
+
+	default:
+		return fmt.Errorf("%w: tweak must be %d bytes",
+			ErrInvalidScriptKey, sha256.Size)
+	}
+
+	// Only the x coordinate of a Taproot key is committed to.
+	if !bytes.Equal(
+		schnorr.SerializePubKey(expectedKey),
+		schnorr.SerializePubKey(scriptKey.PubKey),
+	) {
+
+		return fmt.Errorf("%w: key %x doesn't match raw key and tweak",
+			ErrInvalidScriptKey,
+			schnorr.SerializePubKey(scriptKey.PubKey))
 	}
 
 	return nil
@@ -127,19 +406,81 @@ func (c Seedling) validateFields() error {
 
 // validateGroupKey attempts to validate that the non-zero group key provided
 // with a seedling is owned by the daemon and can be used with this seedling.
-func (c Seedling) validateGroupKey(group asset.AssetGroup) error {
-	// We must be able to sign with the group key.
-	if !group.GroupKey.IsLocal() {
+// The decimal display and the issuance delegations of the group are the ones
+// of the asset that created it.
+func (c Seedling) validateGroupKey(group asset.AssetGroup,
+	groupDecimalDisplay uint32,
+	groupDelegations proof.IssuanceDelegations) error {
+
+	// We must be able to sign with the group key, unless the signature is
+	// produced by an external signer or a delegate key.
+	if !c.ExternalGroupSigner && c.DelegateKey == nil &&
+		!group.GroupKey.IsLocal() {
+
 		groupKeyBytes := c.GroupInfo.GroupPubKey.SerializeCompressed()
 		return fmt.Errorf("can't sign with group key %x", groupKeyBytes)
 	}
 
+	// A group key with a tapscript root can only be signed for if we know
+	// the scripts it commits to, which are either the multisig script or
+	// the leaves of the issuance delegations of the group.
+	expectedRoot, err := groupDelegations.TapscriptRoot()
+	if err != nil {
+		return err
+	}
+	if c.GroupMultiSig != nil {
+		expectedRoot, err = c.GroupMultiSig.TapscriptRoot()
+		if err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(expectedRoot, group.GroupKey.TapscriptRoot) {
+		return fmt.Errorf("group multisig script or issuance " +
+			"delegations don't match tapscript root of group key")
+	}
+
+	if c.DelegateKey != nil {
+		_, _, ok := groupDelegations.Find(c.DelegateKey.PubKey)
+		if !ok {
+			return fmt.Errorf("key %x is not a delegate key of "+
+				"the group", schnorr.SerializePubKey(
+				c.DelegateKey.PubKey,
+			))
+		}
+	}
+
 	// The seedling asset type must match the group asset type.
 	if c.AssetType != group.Genesis.Type {
 		return fmt.Errorf("seedling type does not match "+
 			"group asset type %v", group.Genesis.Type)
 	}
 
+	// Amounts of all assets in a group must be displayed the same way,
+	// otherwise group balances wouldn't make sense.
+	if c.DecimalDisplay != groupDecimalDisplay {
+		return fmt.Errorf("seedling decimal display does not match "+
+			"group decimal display %d", groupDecimalDisplay)
+	}
+
+	return nil
+}
+
+// validateGroupSupply makes sure that issuing the given amount into an asset
+// group with the given issued supply doesn't exceed the maximum supply of the
+// group. A maximum supply of zero means the supply of the group isn't capped.
+func validateGroupSupply(issued, amount, maxSupply uint64) error {
+	if maxSupply == 0 {
+		return nil
+	}
+
+	// We compare against the remaining supply to avoid an overflow of
+	// the summed amounts.
+	if issued > maxSupply || amount > maxSupply-issued {
+		return fmt.Errorf("%w: issuing %d on top of %d exceeds max "+
+			"supply %d", proof.ErrMaxSupplyExceeded, amount, issued,
+			maxSupply)
+	}
+
 	return nil
 }
 
@@ -151,6 +492,6 @@ func (c Seedling) HasGroupKey() bool {
 // String returns a human-readable representation for the AssetSeedling.
 func (c Seedling) String() string {
 	return fmt.Sprintf("AssetSeedling(name=%v, type=%v, amt=%v, "+
-		"version=%v) received",
-		c.AssetName, c.AssetType, c.Amount, c.AssetVersion)
+		"decimal_display=%v, version=%v) received", c.AssetName,
+		c.AssetType, c.Amount, c.DecimalDisplay, c.AssetVersion)
 }
@@ -0,0 +1,179 @@
+package tapgarden
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+var (
+	// ErrBatchTemplateNotFound is returned if no batch template with the
+	// given name exists.
+	ErrBatchTemplateNotFound = fmt.Errorf("batch template not found")
+)
+
+// AssetTemplate describes a single asset of a batch template. It contains the
+// same information as a seedling, except for anything that is only known at
+// the time the asset is minted.
+type AssetTemplate struct {
+	// AssetVersion is the version of the asset to be created.
+	AssetVersion asset.Version
+
+	// AssetType is the type of the asset.
+	AssetType asset.Type
+
+	// AssetName is the name of the asset. When the template is minted, an
+	// optional suffix can be appended to the name, for example to tell
+	// apart the assets of a recurring series.
+	AssetName string
+
+	// Meta is the optional meta data skeleton of the asset.
+	Meta *proof.MetaReveal
+
+	// Amount is the total amount of the asset.
+	Amount uint64
+
+	// DecimalDisplay is the number of decimal places to use when
+	// displaying amounts of the asset.
+	DecimalDisplay uint32
+
+	// EnableEmission if true, then an asset group key will be specified
+	// for this asset meaning future assets linked to it can be created.
+	EnableEmission bool
+
+	// GroupKey is the tweaked key of an existing asset group the asset
+	// should be issued into.
+	GroupKey *btcec.PublicKey
+
+	// GroupAnchor is the name of another asset of the template that
+	// creates the group this asset should be part of.
+	GroupAnchor *string
+}
+
+// BatchTemplate is a named set of assets that can be minted together with a
+// single call, which makes recurring issuance of the same set of assets easy.
+type BatchTemplate struct {
+	// Name is the unique name of the template.
+	Name string
+
+	// Assets is the ordered list of assets of the template. A group
+	// anchor must come before the assets that reference it.
+	Assets []*AssetTemplate
+
+	// CreationTime is the time the template was saved.
+	CreationTime time.Time
+}
+
+// Validate makes sure the batch template is well-formed. Anything that
+// depends on the state of the daemon, like the existence of a group key, is
+// only checked once the template is minted.
+func (b *BatchTemplate) Validate() error {
+	if b.Name == "" {
+		return fmt.Errorf("batch template name cannot be empty")
+	}
+
+	if len(b.Assets) == 0 {
+		return fmt.Errorf("batch template must contain at least one " +
+			"asset")
+	}
+
+	// Group anchors can only be referenced by assets that come after
+	// them, just like seedlings are added to a batch.
+	emissionEnabled := make(map[string]bool, len(b.Assets))
+	for _, a := range b.Assets {
+		if err := asset.ValidateAssetName(a.AssetName); err != nil {
+			return err
+		}
+
+		if _, ok := emissionEnabled[a.AssetName]; ok {
+			return fmt.Errorf("duplicate asset name %v in batch "+
+				"template", a.AssetName)
+		}
+
+		if err := a.Meta.Validate(); err != nil {
+			return err
+		}
+
+		switch {
+		case a.AssetType != asset.Normal &&
+			a.AssetType != asset.Collectible:
+
+			return fmt.Errorf("%v: %v", int(a.AssetType),
+				ErrInvalidAssetType)
+
+		case a.Amount == 0:
+			return ErrInvalidAssetAmt
+
+		case a.GroupKey != nil && a.GroupAnchor != nil:
+			return fmt.Errorf("asset %v cannot specify a group "+
+				"key and a group anchor", a.AssetName)
+
+		case a.EnableEmission &&
+			(a.GroupKey != nil || a.GroupAnchor != nil):
+
+			return fmt.Errorf("asset %v must disable emission to "+
+				"specify a group", a.AssetName)
+
+		case a.GroupAnchor != nil && !emissionEnabled[*a.GroupAnchor]:
+			return fmt.Errorf("group anchor %v of asset %v must "+
+				"be an earlier asset of the template with "+
+				"emission enabled", *a.GroupAnchor,
+				a.AssetName)
+		}
+
+		emissionEnabled[a.AssetName] = a.EnableEmission
+	}
+
+	return nil
+}
+
+// Seedlings creates a new set of seedlings from the batch template, in the
+// order they need to be added to a batch. The given suffix is appended to the
+// names of all assets.
+func (b *BatchTemplate) Seedlings(nameSuffix string) ([]*Seedling, error) {
+	seedlings := make([]*Seedling, 0, len(b.Assets))
+	for _, a := range b.Assets {
+		seedling := &Seedling{
+			AssetVersion:   a.AssetVersion,
+			AssetType:      a.AssetType,
+			AssetName:      a.AssetName + nameSuffix,
+			Amount:         a.Amount,
+			DecimalDisplay: a.DecimalDisplay,
+			EnableEmission: a.EnableEmission,
+		}
+
+		err := asset.ValidateAssetName(seedling.AssetName)
+		if err != nil {
+			return nil, err
+		}
+
+		// Each seedling gets its own copy of the meta data, as the
+		// planter may fill in missing fields.
+		if a.Meta != nil {
+			seedling.Meta = &proof.MetaReveal{
+				Type: a.Meta.Type,
+				Data: append([]byte(nil), a.Meta.Data...),
+			}
+		}
+
+		if a.GroupKey != nil {
+			seedling.GroupInfo = &asset.AssetGroup{
+				GroupKey: &asset.GroupKey{
+					GroupPubKey: *a.GroupKey,
+				},
+			}
+		}
+
+		if a.GroupAnchor != nil {
+			groupAnchor := *a.GroupAnchor + nameSuffix
+			seedling.GroupAnchor = &groupAnchor
+		}
+
+		seedlings = append(seedlings, seedling)
+	}
+
+	return seedlings, nil
+}
@@ -0,0 +1,179 @@
+package tapgarden_test
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchTemplateValidate tests that malformed batch templates are
+// rejected.
+func TestBatchTemplateValidate(t *testing.T) {
+	t.Parallel()
+
+	anchorName := "anchor"
+	unknownName := "unknown"
+	groupKey := test.RandPubKey(t)
+
+	newTemplate := func(
+		assets ...*tapgarden.AssetTemplate) *tapgarden.BatchTemplate {
+
+		return &tapgarden.BatchTemplate{
+			Name:   "template",
+			Assets: assets,
+		}
+	}
+	anchor := &tapgarden.AssetTemplate{
+		AssetType:      asset.Normal,
+		AssetName:      anchorName,
+		Amount:         100,
+		EnableEmission: true,
+	}
+
+	testCases := []struct {
+		name     string
+		template *tapgarden.BatchTemplate
+		err      string
+	}{{
+		name: "valid template",
+		template: newTemplate(anchor, &tapgarden.AssetTemplate{
+			AssetType:   asset.Normal,
+			AssetName:   "member",
+			Amount:      10,
+			GroupAnchor: &anchorName,
+		}),
+	}, {
+		name: "no name",
+		template: &tapgarden.BatchTemplate{
+			Assets: []*tapgarden.AssetTemplate{anchor},
+		},
+		err: "name cannot be empty",
+	}, {
+		name:     "no assets",
+		template: newTemplate(),
+		err:      "at least one asset",
+	}, {
+		name:     "duplicate asset name",
+		template: newTemplate(anchor, anchor),
+		err:      "duplicate asset name",
+	}, {
+		name: "zero amount",
+		template: newTemplate(&tapgarden.AssetTemplate{
+			AssetType: asset.Normal,
+			AssetName: "zero",
+		}),
+		err: tapgarden.ErrInvalidAssetAmt.Error(),
+	}, {
+		name: "invalid meta",
+		template: newTemplate(&tapgarden.AssetTemplate{
+			AssetType: asset.Normal,
+			AssetName: "invalid-meta",
+			Amount:    1,
+			Meta:      &proof.MetaReveal{},
+		}),
+		err: proof.ErrMetaDataMissing.Error(),
+	}, {
+		name: "group key and anchor",
+		template: newTemplate(anchor, &tapgarden.AssetTemplate{
+			AssetType:   asset.Normal,
+			AssetName:   "member",
+			Amount:      10,
+			GroupKey:    groupKey,
+			GroupAnchor: &anchorName,
+		}),
+		err: "group key and a group anchor",
+	}, {
+		name: "emission with group key",
+		template: newTemplate(&tapgarden.AssetTemplate{
+			AssetType:      asset.Normal,
+			AssetName:      "member",
+			Amount:         10,
+			EnableEmission: true,
+			GroupKey:       groupKey,
+		}),
+		err: "must disable emission",
+	}, {
+		name: "unknown group anchor",
+		template: newTemplate(anchor, &tapgarden.AssetTemplate{
+			AssetType:   asset.Normal,
+			AssetName:   "member",
+			Amount:      10,
+			GroupAnchor: &unknownName,
+		}),
+		err: "must be an earlier asset",
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.template.Validate()
+			if testCase.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, testCase.err)
+		})
+	}
+}
+
+// TestBatchTemplateSeedlings tests that seedlings created from a batch
+// template carry the name suffix, also in their group anchors.
+func TestBatchTemplateSeedlings(t *testing.T) {
+	t.Parallel()
+
+	anchorName := "anchor"
+	groupKey := test.RandPubKey(t)
+	template := &tapgarden.BatchTemplate{
+		Name: "template",
+		Assets: []*tapgarden.AssetTemplate{{
+			AssetType: asset.Normal,
+			AssetName: anchorName,
+			Meta: &proof.MetaReveal{
+				Data: []byte("meta"),
+			},
+			Amount:         100,
+			DecimalDisplay: 2,
+			EnableEmission: true,
+		}, {
+			AssetType:   asset.Normal,
+			AssetName:   "member",
+			Amount:      10,
+			GroupAnchor: &anchorName,
+		}, {
+			AssetType: asset.Collectible,
+			AssetName: "existing-group",
+			Amount:    1,
+			GroupKey:  groupKey,
+		}},
+	}
+	require.NoError(t, template.Validate())
+
+	seedlings, err := template.Seedlings("-2026-10")
+	require.NoError(t, err)
+	require.Len(t, seedlings, 3)
+
+	require.Equal(t, "anchor-2026-10", seedlings[0].AssetName)
+	require.Equal(t, template.Assets[0].Meta, seedlings[0].Meta)
+	require.NotSame(t, template.Assets[0].Meta, seedlings[0].Meta)
+	require.EqualValues(t, 2, seedlings[0].DecimalDisplay)
+	require.True(t, seedlings[0].EnableEmission)
+
+	require.Equal(t, "member-2026-10", seedlings[1].AssetName)
+	require.Equal(t, "anchor-2026-10", *seedlings[1].GroupAnchor)
+	require.Equal(t, anchorName, *template.Assets[1].GroupAnchor)
+
+	require.True(t, seedlings[2].HasGroupKey())
+	require.True(t, groupKey.IsEqual(&seedlings[2].GroupInfo.GroupPubKey))
+
+	// The suffix must not turn the names into invalid asset names.
+	_, err = template.Seedlings("\n")
+	require.ErrorContains(t, err, "unprintable character")
+}
@@ -0,0 +1,212 @@
+package tapgarden
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+const (
+	// MaxVanityPrefixLen is the maximum number of hex characters of a
+	// vanity asset ID prefix. Every additional character multiplies the
+	// expected number of attempts by 16, so we bound it to keep the time
+	// spent grinding within reason.
+	MaxVanityPrefixLen = 6
+
+	// VanityNonceKey is the key of the JSON meta data field that holds the
+	// nonce that was ground to obtain a vanity asset ID.
+	VanityNonceKey = "vanity_nonce"
+
+	// maxVanityWorkers is the maximum number of goroutines that grind a
+	// single vanity asset ID.
+	maxVanityWorkers = 8
+
+	// vanityAttemptsFactor bounds the number of attempts to grind a vanity
+	// asset ID to this multiple of the expected number of attempts, which
+	// makes running out of attempts astronomically unlikely.
+	vanityAttemptsFactor = 64
+
+	// vanityCheckInterval is the number of attempts after which a worker
+	// checks whether it should stop grinding.
+	vanityCheckInterval = 1024
+)
+
+var (
+	// ErrInvalidVanityPrefix is returned if a vanity asset ID prefix isn't
+	// a valid hex string of the supported length.
+	ErrInvalidVanityPrefix = errors.New("invalid vanity prefix")
+
+	// ErrVanityPrefixNotFound is returned if no nonce that results in an
+	// asset ID with the vanity prefix was found within the maximum number
+	// of attempts.
+	ErrVanityPrefixNotFound = errors.New("no asset ID with vanity prefix " +
+		"found")
+)
+
+// validateVanityPrefix makes sure the vanity prefix is a hex string of at
+// most MaxVanityPrefixLen characters. An odd number of characters is allowed,
+// in which case the last character only needs to match the high nibble of the
+// corresponding asset ID byte.
+func validateVanityPrefix(prefix string) error {
+	if len(prefix) == 0 || len(prefix) > MaxVanityPrefixLen {
+		return fmt.Errorf("%w: must be 1 to %d hex characters",
+			ErrInvalidVanityPrefix, MaxVanityPrefixLen)
+	}
+
+	for _, c := range strings.ToLower(prefix) {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return fmt.Errorf("%w: %q is not a hex character",
+				ErrInvalidVanityPrefix, c)
+		}
+	}
+
+	return nil
+}
+
+// vanityMetaTemplate returns the data of a JSON meta reveal with the given
+// meta data, split at the position of the vanity nonce. The nonce is added as
+// the first field of the JSON object, replacing any nonce of a previous
+// attempt. If no meta data is given, the object only holds the nonce.
+func vanityMetaTemplate(meta *proof.MetaReveal) ([]byte, []byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if meta != nil {
+		if meta.Type != proof.MetaJson {
+			return nil, nil, fmt.Errorf("vanity asset ID requires "+
+				"JSON meta data, got meta type %d", meta.Type)
+		}
+
+		if err := json.Unmarshal(meta.Data, &fields); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v",
+				proof.ErrInvalidJSONMeta, err)
+		}
+		if fields == nil {
+			return nil, nil, fmt.Errorf("%w: not a JSON object",
+				proof.ErrInvalidJSONMeta)
+		}
+	}
+	delete(fields, VanityNonceKey)
+
+	otherFields, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	head := []byte(`{"` + VanityNonceKey + `":`)
+
+	// The marshalled remaining fields start with the opening brace, which
+	// we replace with a comma, unless the object is empty.
+	tail := []byte("}")
+	if len(fields) != 0 {
+		tail = append([]byte(","), otherFields[1:]...)
+	}
+
+	return head, tail, nil
+}
+
+// grindVanityMeta adds a nonce to the JSON meta data of an asset and grinds it
+// until the ID of the asset with the given genesis starts with the vanity
+// prefix. The nonces are distributed among a bounded pool of workers, and the
+// first matching meta reveal is returned.
+func grindVanityMeta(ctx context.Context, gen asset.Genesis,
+	meta *proof.MetaReveal, prefix string) (*proof.MetaReveal, error) {
+
+	if err := validateVanityPrefix(prefix); err != nil {
+		return nil, err
+	}
+	prefix = strings.ToLower(prefix)
+
+	head, tail, err := vanityMetaTemplate(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each hex character of the prefix needs 16 attempts on average, and
+	// we only need to encode the asset ID bytes that cover the prefix.
+	maxAttempts := uint64(vanityAttemptsFactor) << (4 * len(prefix))
+	prefixBytes := (len(prefix) + 1) / 2
+	numWorkers := min(runtime.NumCPU(), maxVanityWorkers)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		found = make(chan *proof.MetaReveal, numWorkers)
+	)
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func(nonce uint64) {
+			defer wg.Done()
+
+			workerGen := gen
+			data := make([]byte, 0, len(head)+len(tail)+20)
+			for ; nonce < maxAttempts; nonce += uint64(numWorkers) {
+				if nonce%vanityCheckInterval == 0 &&
+					ctx.Err() != nil {
+
+					return
+				}
+
+				data = append(data[:0], head...)
+				data = strconv.AppendUint(data, nonce, 10)
+				data = append(data, tail...)
+
+				vanityMeta := &proof.MetaReveal{
+					Type: proof.MetaJson,
+					Data: data,
+				}
+				workerGen.MetaHash = vanityMeta.MetaHash()
+
+				id := workerGen.ID()
+				idHex := hex.EncodeToString(id[:prefixBytes])
+				if !strings.HasPrefix(idHex, prefix) {
+					continue
+				}
+
+				vanityMeta.Data = append([]byte(nil), data...)
+				found <- vanityMeta
+
+				return
+			}
+		}(uint64(i))
+	}
+
+	go func() {
+		wg.Wait()
+		close(found)
+	}()
+
+	select {
+	case vanityMeta, ok := <-found:
+		switch {
+		case ok:
+
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+
+		default:
+			return nil, fmt.Errorf("%w: prefix=%v, attempts=%d",
+				ErrVanityPrefixNotFound, prefix, maxAttempts)
+		}
+
+		// The nonce makes the meta data slightly larger, so we need to
+		// make sure it's still within the limits.
+		if err := vanityMeta.Validate(); err != nil {
+			return nil, err
+		}
+
+		return vanityMeta, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
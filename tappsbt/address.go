@@ -130,24 +130,29 @@ func AddOutput(pkt *VPacket, amount uint64, scriptAddr asset.ScriptKey,
 
 // OwnershipProofPacket creates a virtual transaction packet that is used to
 // prove ownership of an asset. It creates a 1-in-1-out transaction that spends
-// the owned asset to the NUMS key. The witness is created over an empty
-// previous outpoint, so it can never be used in an actual state transition.
-func OwnershipProofPacket(ownedAsset *asset.Asset,
+// the owned asset to the NUMS key. The witness is created over a previous
+// outpoint that uses the challenge as its hash, so it can never be used in an
+// actual state transition. A zero challenge results in an empty outpoint.
+func OwnershipProofPacket(ownedAsset *asset.Asset, challenge [32]byte,
 	chainParams *address.ChainParams) *VPacket {
 
 	// We create the ownership proof by creating a virtual packet that
 	// spends the full asset into a NUMS key. But in order to prevent that
 	// witness to be used in an actual state transition by a malicious
-	// actor, we create the signature over an empty outpoint. This means the
-	// witness is fully valid, but a full transition proof can never be
-	// created, as the previous outpoint would not match the one that
-	// actually goes on chain.
+	// actor, we create the signature over an outpoint that doesn't exist.
+	// This means the witness is fully valid, but a full transition proof
+	// can never be created, as the previous outpoint would not match the
+	// one that actually goes on chain. Using the challenge as the hash of
+	// that outpoint also binds the witness to the challenge, so it can't
+	// be replayed for a different one.
 	//
 	// TODO(guggero): Revisit this proof once we support pocket universes.
-	emptyOutPoint := wire.OutPoint{}
+	challengeOutPoint := wire.OutPoint{
+		Hash: challenge,
+	}
 	prevId := asset.PrevID{
 		ID:       ownedAsset.ID(),
-		OutPoint: emptyOutPoint,
+		OutPoint: challengeOutPoint,
 		ScriptKey: asset.ToSerialized(
 			ownedAsset.ScriptKey.PubKey,
 		),
@@ -50,9 +50,64 @@ type AssetWalletClient interface {
 	// VerifyAssetOwnership verifies the asset ownership proof embedded in the
 	// given transition proof of an asset and returns true if the proof is valid.
 	VerifyAssetOwnership(ctx context.Context, in *VerifyAssetOwnershipRequest, opts ...grpc.CallOption) (*VerifyAssetOwnershipResponse, error)
+	// AttestReserves creates a reserve attestation that binds all unspent
+	// holdings of an asset or asset group to a message and the current time. Each
+	// holding is proven with an ownership proof whose challenge witness commits
+	// to the message and the time, so the attestation can't be replayed for a
+	// different message.
+	AttestReserves(ctx context.Context, in *AttestReservesRequest, opts ...grpc.CallOption) (*AttestReservesResponse, error)
+	// VerifyReserveAttestation verifies the ownership proofs of all holdings of a
+	// reserve attestation and returns the attested holdings. It doesn't check
+	// whether the anchor outpoints of the holdings are still unspent.
+	VerifyReserveAttestation(ctx context.Context, in *VerifyReserveAttestationRequest, opts ...grpc.CallOption) (*VerifyReserveAttestationResponse, error)
+	// ImportAsset imports an asset into local custody from its proof file, for
+	// example to migrate an asset that was minted by another tapd instance
+	// without an on-chain send. The key descriptors of the script key and of the
+	// internal key of the anchor output must be given, so the asset is
+	// recognized as spendable. Unless the keys are held by an external signer,
+	// they must be under the control of the backing lnd node.
+	ImportAsset(ctx context.Context, in *ImportAssetRequest, opts ...grpc.CallOption) (*ImportAssetResponse, error)
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(ctx context.Context, in *RemoveUTXOLeaseRequest, opts ...grpc.CallOption) (*RemoveUTXOLeaseResponse, error)
+	// CreateSwapOffer creates an offer to atomically swap an asset for BTC. The
+	// asset is transferred to the given address of the taker, who pays the
+	// requested amount of satoshis to a new output of the backing lnd node in the
+	// same anchor transaction. The asset inputs of the offer are leased for 10
+	// minutes, the swap must be completed within that time.
+	//
+	// TODO: Support swapping an asset for a different asset.
+	CreateSwapOffer(ctx context.Context, in *CreateSwapOfferRequest, opts ...grpc.CallOption) (*CreateSwapOfferResponse, error)
+	// AcceptSwapOffer verifies a swap offer, funds its anchor transaction with BTC
+	// of the backing lnd node and signs the funding inputs. The countersigned
+	// anchor transaction must be handed back to the maker of the offer to
+	// complete the swap. The asset is received with the normal receive flow of
+	// the address it is transferred to.
+	AcceptSwapOffer(ctx context.Context, in *AcceptSwapOfferRequest, opts ...grpc.CallOption) (*AcceptSwapOfferResponse, error)
+	// CompleteSwap verifies the anchor transaction of a swap offer that was
+	// countersigned by the taker, signs the asset inputs of the maker, then
+	// broadcasts the transaction and logs the transfer.
+	CompleteSwap(ctx context.Context, in *CompleteSwapRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error)
+	// PrepareExternalSigning creates the unsigned passive asset virtual
+	// transactions for a funded virtual transaction and returns the signing
+	// requests for the asset level witnesses of all of them. Together with
+	// ApplyExternalSignatures, FundAnchorPsbt and PublishAnchorPsbt, this allows
+	// a node that doesn't hold the private keys of its assets to send them, with
+	// all signatures created by an external signer.
+	PrepareExternalSigning(ctx context.Context, in *PrepareExternalSigningRequest, opts ...grpc.CallOption) (*PrepareExternalSigningResponse, error)
+	// ApplyExternalSignatures adds the signatures created by an external signer
+	// for the signing requests returned by PrepareExternalSigning to the virtual
+	// transactions, then validates them.
+	ApplyExternalSignatures(ctx context.Context, in *ApplyExternalSignaturesRequest, opts ...grpc.CallOption) (*ApplyExternalSignaturesResponse, error)
+	// FundAnchorPsbt creates and funds the BTC level anchor transaction for the
+	// externally signed virtual transactions, without signing it. The anchor
+	// transaction must be signed by the external signer, including the asset
+	// anchor inputs, and then be passed to PublishAnchorPsbt.
+	FundAnchorPsbt(ctx context.Context, in *FundAnchorPsbtRequest, opts ...grpc.CallOption) (*FundAnchorPsbtResponse, error)
+	// PublishAnchorPsbt verifies that the externally signed anchor transaction
+	// commits to the virtual transactions, then broadcasts it, logs the transfer
+	// and delivers the proofs.
+	PublishAnchorPsbt(ctx context.Context, in *PublishAnchorPsbtRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error)
 }
 
 type assetWalletClient struct {
@@ -126,6 +181,33 @@ func (c *assetWalletClient) VerifyAssetOwnership(ctx context.Context, in *Verify
 	return out, nil
 }
 
+func (c *assetWalletClient) AttestReserves(ctx context.Context, in *AttestReservesRequest, opts ...grpc.CallOption) (*AttestReservesResponse, error) {
+	out := new(AttestReservesResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/AttestReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) VerifyReserveAttestation(ctx context.Context, in *VerifyReserveAttestationRequest, opts ...grpc.CallOption) (*VerifyReserveAttestationResponse, error) {
+	out := new(VerifyReserveAttestationResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/VerifyReserveAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ImportAsset(ctx context.Context, in *ImportAssetRequest, opts ...grpc.CallOption) (*ImportAssetResponse, error) {
+	out := new(ImportAssetResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ImportAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *assetWalletClient) RemoveUTXOLease(ctx context.Context, in *RemoveUTXOLeaseRequest, opts ...grpc.CallOption) (*RemoveUTXOLeaseResponse, error) {
 	out := new(RemoveUTXOLeaseResponse)
 	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/RemoveUTXOLease", in, out, opts...)
@@ -135,6 +217,69 @@ func (c *assetWalletClient) RemoveUTXOLease(ctx context.Context, in *RemoveUTXOL
 	return out, nil
 }
 
+func (c *assetWalletClient) CreateSwapOffer(ctx context.Context, in *CreateSwapOfferRequest, opts ...grpc.CallOption) (*CreateSwapOfferResponse, error) {
+	out := new(CreateSwapOfferResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CreateSwapOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) AcceptSwapOffer(ctx context.Context, in *AcceptSwapOfferRequest, opts ...grpc.CallOption) (*AcceptSwapOfferResponse, error) {
+	out := new(AcceptSwapOfferResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/AcceptSwapOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) CompleteSwap(ctx context.Context, in *CompleteSwapRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error) {
+	out := new(taprpc.SendAssetResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CompleteSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) PrepareExternalSigning(ctx context.Context, in *PrepareExternalSigningRequest, opts ...grpc.CallOption) (*PrepareExternalSigningResponse, error) {
+	out := new(PrepareExternalSigningResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/PrepareExternalSigning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ApplyExternalSignatures(ctx context.Context, in *ApplyExternalSignaturesRequest, opts ...grpc.CallOption) (*ApplyExternalSignaturesResponse, error) {
+	out := new(ApplyExternalSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ApplyExternalSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) FundAnchorPsbt(ctx context.Context, in *FundAnchorPsbtRequest, opts ...grpc.CallOption) (*FundAnchorPsbtResponse, error) {
+	out := new(FundAnchorPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/FundAnchorPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) PublishAnchorPsbt(ctx context.Context, in *PublishAnchorPsbtRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error) {
+	out := new(taprpc.SendAssetResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/PublishAnchorPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetWalletServer is the server API for AssetWallet service.
 // All implementations must embed UnimplementedAssetWalletServer
 // for forward compatibility
@@ -170,9 +315,64 @@ type AssetWalletServer interface {
 	// VerifyAssetOwnership verifies the asset ownership proof embedded in the
 	// given transition proof of an asset and returns true if the proof is valid.
 	VerifyAssetOwnership(context.Context, *VerifyAssetOwnershipRequest) (*VerifyAssetOwnershipResponse, error)
+	// AttestReserves creates a reserve attestation that binds all unspent
+	// holdings of an asset or asset group to a message and the current time. Each
+	// holding is proven with an ownership proof whose challenge witness commits
+	// to the message and the time, so the attestation can't be replayed for a
+	// different message.
+	AttestReserves(context.Context, *AttestReservesRequest) (*AttestReservesResponse, error)
+	// VerifyReserveAttestation verifies the ownership proofs of all holdings of a
+	// reserve attestation and returns the attested holdings. It doesn't check
+	// whether the anchor outpoints of the holdings are still unspent.
+	VerifyReserveAttestation(context.Context, *VerifyReserveAttestationRequest) (*VerifyReserveAttestationResponse, error)
+	// ImportAsset imports an asset into local custody from its proof file, for
+	// example to migrate an asset that was minted by another tapd instance
+	// without an on-chain send. The key descriptors of the script key and of the
+	// internal key of the anchor output must be given, so the asset is
+	// recognized as spendable. Unless the keys are held by an external signer,
+	// they must be under the control of the backing lnd node.
+	ImportAsset(context.Context, *ImportAssetRequest) (*ImportAssetResponse, error)
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error)
+	// CreateSwapOffer creates an offer to atomically swap an asset for BTC. The
+	// asset is transferred to the given address of the taker, who pays the
+	// requested amount of satoshis to a new output of the backing lnd node in the
+	// same anchor transaction. The asset inputs of the offer are leased for 10
+	// minutes, the swap must be completed within that time.
+	//
+	// TODO: Support swapping an asset for a different asset.
+	CreateSwapOffer(context.Context, *CreateSwapOfferRequest) (*CreateSwapOfferResponse, error)
+	// AcceptSwapOffer verifies a swap offer, funds its anchor transaction with BTC
+	// of the backing lnd node and signs the funding inputs. The countersigned
+	// anchor transaction must be handed back to the maker of the offer to
+	// complete the swap. The asset is received with the normal receive flow of
+	// the address it is transferred to.
+	AcceptSwapOffer(context.Context, *AcceptSwapOfferRequest) (*AcceptSwapOfferResponse, error)
+	// CompleteSwap verifies the anchor transaction of a swap offer that was
+	// countersigned by the taker, signs the asset inputs of the maker, then
+	// broadcasts the transaction and logs the transfer.
+	CompleteSwap(context.Context, *CompleteSwapRequest) (*taprpc.SendAssetResponse, error)
+	// PrepareExternalSigning creates the unsigned passive asset virtual
+	// transactions for a funded virtual transaction and returns the signing
+	// requests for the asset level witnesses of all of them. Together with
+	// ApplyExternalSignatures, FundAnchorPsbt and PublishAnchorPsbt, this allows
+	// a node that doesn't hold the private keys of its assets to send them, with
+	// all signatures created by an external signer.
+	PrepareExternalSigning(context.Context, *PrepareExternalSigningRequest) (*PrepareExternalSigningResponse, error)
+	// ApplyExternalSignatures adds the signatures created by an external signer
+	// for the signing requests returned by PrepareExternalSigning to the virtual
+	// transactions, then validates them.
+	ApplyExternalSignatures(context.Context, *ApplyExternalSignaturesRequest) (*ApplyExternalSignaturesResponse, error)
+	// FundAnchorPsbt creates and funds the BTC level anchor transaction for the
+	// externally signed virtual transactions, without signing it. The anchor
+	// transaction must be signed by the external signer, including the asset
+	// anchor inputs, and then be passed to PublishAnchorPsbt.
+	FundAnchorPsbt(context.Context, *FundAnchorPsbtRequest) (*FundAnchorPsbtResponse, error)
+	// PublishAnchorPsbt verifies that the externally signed anchor transaction
+	// commits to the virtual transactions, then broadcasts it, logs the transfer
+	// and delivers the proofs.
+	PublishAnchorPsbt(context.Context, *PublishAnchorPsbtRequest) (*taprpc.SendAssetResponse, error)
 	mustEmbedUnimplementedAssetWalletServer()
 }
 
@@ -201,9 +401,39 @@ func (UnimplementedAssetWalletServer) ProveAssetOwnership(context.Context, *Prov
 func (UnimplementedAssetWalletServer) VerifyAssetOwnership(context.Context, *VerifyAssetOwnershipRequest) (*VerifyAssetOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyAssetOwnership not implemented")
 }
+func (UnimplementedAssetWalletServer) AttestReserves(context.Context, *AttestReservesRequest) (*AttestReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestReserves not implemented")
+}
+func (UnimplementedAssetWalletServer) VerifyReserveAttestation(context.Context, *VerifyReserveAttestationRequest) (*VerifyReserveAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyReserveAttestation not implemented")
+}
+func (UnimplementedAssetWalletServer) ImportAsset(context.Context, *ImportAssetRequest) (*ImportAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAsset not implemented")
+}
 func (UnimplementedAssetWalletServer) RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUTXOLease not implemented")
 }
+func (UnimplementedAssetWalletServer) CreateSwapOffer(context.Context, *CreateSwapOfferRequest) (*CreateSwapOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwapOffer not implemented")
+}
+func (UnimplementedAssetWalletServer) AcceptSwapOffer(context.Context, *AcceptSwapOfferRequest) (*AcceptSwapOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSwapOffer not implemented")
+}
+func (UnimplementedAssetWalletServer) CompleteSwap(context.Context, *CompleteSwapRequest) (*taprpc.SendAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSwap not implemented")
+}
+func (UnimplementedAssetWalletServer) PrepareExternalSigning(context.Context, *PrepareExternalSigningRequest) (*PrepareExternalSigningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareExternalSigning not implemented")
+}
+func (UnimplementedAssetWalletServer) ApplyExternalSignatures(context.Context, *ApplyExternalSignaturesRequest) (*ApplyExternalSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyExternalSignatures not implemented")
+}
+func (UnimplementedAssetWalletServer) FundAnchorPsbt(context.Context, *FundAnchorPsbtRequest) (*FundAnchorPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundAnchorPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) PublishAnchorPsbt(context.Context, *PublishAnchorPsbtRequest) (*taprpc.SendAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishAnchorPsbt not implemented")
+}
 func (UnimplementedAssetWalletServer) mustEmbedUnimplementedAssetWalletServer() {}
 
 // UnsafeAssetWalletServer may be embedded to opt out of forward compatibility for this service.
@@ -343,6 +573,60 @@ func _AssetWallet_VerifyAssetOwnership_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_AttestReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).AttestReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/AttestReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).AttestReserves(ctx, req.(*AttestReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_VerifyReserveAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyReserveAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).VerifyReserveAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/VerifyReserveAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).VerifyReserveAttestation(ctx, req.(*VerifyReserveAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ImportAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ImportAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ImportAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ImportAsset(ctx, req.(*ImportAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AssetWallet_RemoveUTXOLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveUTXOLeaseRequest)
 	if err := dec(in); err != nil {
@@ -361,6 +645,132 @@ func _AssetWallet_RemoveUTXOLease_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_CreateSwapOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSwapOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CreateSwapOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CreateSwapOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CreateSwapOffer(ctx, req.(*CreateSwapOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_AcceptSwapOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSwapOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).AcceptSwapOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/AcceptSwapOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).AcceptSwapOffer(ctx, req.(*AcceptSwapOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_CompleteSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CompleteSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CompleteSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CompleteSwap(ctx, req.(*CompleteSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_PrepareExternalSigning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareExternalSigningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).PrepareExternalSigning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/PrepareExternalSigning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).PrepareExternalSigning(ctx, req.(*PrepareExternalSigningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ApplyExternalSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyExternalSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ApplyExternalSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ApplyExternalSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ApplyExternalSignatures(ctx, req.(*ApplyExternalSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_FundAnchorPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundAnchorPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).FundAnchorPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/FundAnchorPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).FundAnchorPsbt(ctx, req.(*FundAnchorPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_PublishAnchorPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishAnchorPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).PublishAnchorPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/PublishAnchorPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).PublishAnchorPsbt(ctx, req.(*PublishAnchorPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetWallet_ServiceDesc is the grpc.ServiceDesc for AssetWallet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -396,10 +806,50 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyAssetOwnership",
 			Handler:    _AssetWallet_VerifyAssetOwnership_Handler,
 		},
+		{
+			MethodName: "AttestReserves",
+			Handler:    _AssetWallet_AttestReserves_Handler,
+		},
+		{
+			MethodName: "VerifyReserveAttestation",
+			Handler:    _AssetWallet_VerifyReserveAttestation_Handler,
+		},
+		{
+			MethodName: "ImportAsset",
+			Handler:    _AssetWallet_ImportAsset_Handler,
+		},
 		{
 			MethodName: "RemoveUTXOLease",
 			Handler:    _AssetWallet_RemoveUTXOLease_Handler,
 		},
+		{
+			MethodName: "CreateSwapOffer",
+			Handler:    _AssetWallet_CreateSwapOffer_Handler,
+		},
+		{
+			MethodName: "AcceptSwapOffer",
+			Handler:    _AssetWallet_AcceptSwapOffer_Handler,
+		},
+		{
+			MethodName: "CompleteSwap",
+			Handler:    _AssetWallet_CompleteSwap_Handler,
+		},
+		{
+			MethodName: "PrepareExternalSigning",
+			Handler:    _AssetWallet_PrepareExternalSigning_Handler,
+		},
+		{
+			MethodName: "ApplyExternalSignatures",
+			Handler:    _AssetWallet_ApplyExternalSignatures_Handler,
+		},
+		{
+			MethodName: "FundAnchorPsbt",
+			Handler:    _AssetWallet_FundAnchorPsbt_Handler,
+		},
+		{
+			MethodName: "PublishAnchorPsbt",
+			Handler:    _AssetWallet_PublishAnchorPsbt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assetwalletrpc/assetwallet.proto",
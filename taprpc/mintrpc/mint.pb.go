@@ -21,6 +21,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BatchReOrgState int32
+
+const (
+	// The minting transaction of the batch was never re-organized out of the
+	// chain.
+	BatchReOrgState_BATCH_REORG_STATE_NONE BatchReOrgState = 0
+	// The minting transaction was re-organized out of the chain and was
+	// re-broadcast, but didn't confirm again yet.
+	BatchReOrgState_BATCH_REORG_STATE_PENDING BatchReOrgState = 1
+	// The minting transaction confirmed again after a re-org, and the proofs of
+	// the batch were updated to the new block.
+	BatchReOrgState_BATCH_REORG_STATE_RECOVERED BatchReOrgState = 2
+	// The minting transaction was re-organized out of the chain and one of its
+	// inputs was spent by a different transaction. The assets of the batch can't
+	// be recovered.
+	BatchReOrgState_BATCH_REORG_STATE_DOUBLE_SPENT BatchReOrgState = 3
+)
+
+// Enum value maps for BatchReOrgState.
+var (
+	BatchReOrgState_name = map[int32]string{
+		0: "BATCH_REORG_STATE_NONE",
+		1: "BATCH_REORG_STATE_PENDING",
+		2: "BATCH_REORG_STATE_RECOVERED",
+		3: "BATCH_REORG_STATE_DOUBLE_SPENT",
+	}
+	BatchReOrgState_value = map[string]int32{
+		"BATCH_REORG_STATE_NONE":         0,
+		"BATCH_REORG_STATE_PENDING":      1,
+		"BATCH_REORG_STATE_RECOVERED":    2,
+		"BATCH_REORG_STATE_DOUBLE_SPENT": 3,
+	}
+)
+
+func (x BatchReOrgState) Enum() *BatchReOrgState {
+	p := new(BatchReOrgState)
+	*p = x
+	return p
+}
+
+func (x BatchReOrgState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchReOrgState) Descriptor() protoreflect.EnumDescriptor {
+	return file_mintrpc_mint_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchReOrgState) Type() protoreflect.EnumType {
+	return &file_mintrpc_mint_proto_enumTypes[0]
+}
+
+func (x BatchReOrgState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchReOrgState.Descriptor instead.
+func (BatchReOrgState) EnumDescriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{0}
+}
+
 type BatchState int32
 
 const (
@@ -72,11 +133,11 @@ func (x BatchState) String() string {
 }
 
 func (BatchState) Descriptor() protoreflect.EnumDescriptor {
-	return file_mintrpc_mint_proto_enumTypes[0].Descriptor()
+	return file_mintrpc_mint_proto_enumTypes[1].Descriptor()
 }
 
 func (BatchState) Type() protoreflect.EnumType {
-	return &file_mintrpc_mint_proto_enumTypes[0]
+	return &file_mintrpc_mint_proto_enumTypes[1]
 }
 
 func (x BatchState) Number() protoreflect.EnumNumber {
@@ -85,7 +146,57 @@ func (x BatchState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BatchState.Descriptor instead.
 func (BatchState) EnumDescriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{0}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{1}
+}
+
+type GenesisPsbtStage int32
+
+const (
+	// The genesis PSBT must be funded with inputs and an optional change output,
+	// but not signed yet.
+	GenesisPsbtStage_GENESIS_PSBT_STAGE_FUND GenesisPsbtStage = 0
+	// The genesis PSBT contains the final minting output and must be signed
+	// without any other changes.
+	GenesisPsbtStage_GENESIS_PSBT_STAGE_SIGN GenesisPsbtStage = 1
+)
+
+// Enum value maps for GenesisPsbtStage.
+var (
+	GenesisPsbtStage_name = map[int32]string{
+		0: "GENESIS_PSBT_STAGE_FUND",
+		1: "GENESIS_PSBT_STAGE_SIGN",
+	}
+	GenesisPsbtStage_value = map[string]int32{
+		"GENESIS_PSBT_STAGE_FUND": 0,
+		"GENESIS_PSBT_STAGE_SIGN": 1,
+	}
+)
+
+func (x GenesisPsbtStage) Enum() *GenesisPsbtStage {
+	p := new(GenesisPsbtStage)
+	*p = x
+	return p
+}
+
+func (x GenesisPsbtStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GenesisPsbtStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_mintrpc_mint_proto_enumTypes[2].Descriptor()
+}
+
+func (GenesisPsbtStage) Type() protoreflect.EnumType {
+	return &file_mintrpc_mint_proto_enumTypes[2]
+}
+
+func (x GenesisPsbtStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GenesisPsbtStage.Descriptor instead.
+func (GenesisPsbtStage) EnumDescriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{2}
 }
 
 type MintAsset struct {
@@ -110,6 +221,70 @@ type MintAsset struct {
 	GroupAnchor string `protobuf:"bytes,6,opt,name=group_anchor,json=groupAnchor,proto3" json:"group_anchor,omitempty"`
 	// The version of asset to mint.
 	AssetVersion taprpc.AssetVersion `protobuf:"varint,7,opt,name=asset_version,json=assetVersion,proto3,enum=taprpc.AssetVersion" json:"asset_version,omitempty"`
+	// If true, then the group witness of the asset is produced by an external
+	// signer that holds the group key, instead of the backing lnd node. The
+	// signature must be submitted with SubmitGroupWitness once the batch is
+	// finalized.
+	ExternalGroupSigner bool `protobuf:"varint,8,opt,name=external_group_signer,json=externalGroupSigner,proto3" json:"external_group_signer,omitempty"`
+	// The raw key of a new asset group that is held by an external signer. Can
+	// only be set if emission is enabled and external_group_signer is true.
+	GroupInternalKey *taprpc.KeyDescriptor `protobuf:"bytes,9,opt,name=group_internal_key,json=groupInternalKey,proto3" json:"group_internal_key,omitempty"`
+	// If true, then the data of asset_meta is treated as a meta data blob that
+	// is committed to by reference. Only the hash and size of the blob are part
+	// of the asset and its proofs, while the blob itself is stored and served by
+	// the daemon. The type of asset_meta is ignored in this case.
+	MetaByReference bool `protobuf:"varint,10,opt,name=meta_by_reference,json=metaByReference,proto3" json:"meta_by_reference,omitempty"`
+	// The URL the meta data blob can be fetched from by others. If not set, the
+	// URL is derived from the meta blob base URL of the daemon, if configured.
+	// Can only be set if meta_by_reference is true.
+	MetaBlobUrl string `protobuf:"bytes,11,opt,name=meta_blob_url,json=metaBlobUrl,proto3" json:"meta_blob_url,omitempty"`
+	// The number of decimal places that should be used when displaying amounts of
+	// the asset. It is committed to in the JSON meta data of the asset, which is
+	// created if no meta data is given. If not set, the decimal display is taken
+	// from the meta data. Must be the same for all assets of a group.
+	DecimalDisplay uint32 `protobuf:"varint,12,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
+	// The maximum total amount of the asset group created by this asset, summed
+	// over all issuances into the group. It is committed to in the JSON meta data
+	// of the asset, which is created if no meta data is given. If not set, the
+	// max supply is taken from the meta data. Can only be set if emission is
+	// enabled. Zero means the supply of the group isn't capped.
+	MaxSupply uint64 `protobuf:"varint,13,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// An optional hex encoded prefix of up to 6 characters the asset ID must
+	// start with. If set, a nonce is added to the JSON meta data of the asset,
+	// which is created if no meta data is given, and ground until the asset ID
+	// matches the prefix. As the asset ID commits to the genesis outpoint, the
+	// grinding only happens once the batch is funded. Every additional character
+	// makes the grinding 16 times more expensive.
+	VanityPrefix string `protobuf:"bytes,14,opt,name=vanity_prefix,json=vanityPrefix,proto3" json:"vanity_prefix,omitempty"`
+	// The optional emission schedule of the asset group created by this asset,
+	// ordered by the start height of the epochs. The supply of the group issued
+	// up to a block height, summed over all issuances into the group, can't
+	// exceed the sum of the amounts of all epochs started by then. It is
+	// committed to in the JSON meta data of the asset, which is created if no
+	// meta data is given. If not set, the schedule is taken from the meta data.
+	// Can only be set if emission is enabled.
+	EmissionSchedule []*taprpc.EmissionEpoch `protobuf:"bytes,15,rep,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty"`
+	// The optional script key the asset is minted to. If set, the asset is
+	// controlled by the holder of the raw key of the script key from genesis,
+	// which may be a party other than the backing lnd node. The raw key must be
+	// set, and the tap tweak is optional. If not set, a new script key is derived
+	// by the backing lnd node.
+	ScriptKey *taprpc.ScriptKey `protobuf:"bytes,16,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The optional k-of-n multisig script of the group key. If set, the group
+	// key commits to the script as the only leaf of its tapscript tree, and the
+	// group witness is a script path spend that must be signed by enough
+	// multisig keys with SubmitGroupScriptSig. Can only be set if
+	// external_group_signer is true. For an existing group, it must match the
+	// script the group key commits to.
+	GroupMultisig *GroupMultiSig `protobuf:"bytes,17,opt,name=group_multisig,json=groupMultisig,proto3" json:"group_multisig,omitempty"`
+	// The optional delegate key the asset is issued with into the existing group
+	// of group_key, which must be held by the backing lnd node. The JSON meta
+	// data of the asset that created the group must list a delegation for the
+	// key, whose tapscript leaf is spent to authorize the issuance instead of a
+	// signature of the group key. The asset can only be issued up to the max
+	// amount of the delegation, and before it expires. Can't be set if
+	// external_group_signer is true.
+	DelegateKey *taprpc.KeyDescriptor `protobuf:"bytes,18,opt,name=delegate_key,json=delegateKey,proto3" json:"delegate_key,omitempty"`
 }
 
 func (x *MintAsset) Reset() {
@@ -193,6 +368,141 @@ func (x *MintAsset) GetAssetVersion() taprpc.AssetVersion {
 	return taprpc.AssetVersion(0)
 }
 
+func (x *MintAsset) GetExternalGroupSigner() bool {
+	if x != nil {
+		return x.ExternalGroupSigner
+	}
+	return false
+}
+
+func (x *MintAsset) GetGroupInternalKey() *taprpc.KeyDescriptor {
+	if x != nil {
+		return x.GroupInternalKey
+	}
+	return nil
+}
+
+func (x *MintAsset) GetMetaByReference() bool {
+	if x != nil {
+		return x.MetaByReference
+	}
+	return false
+}
+
+func (x *MintAsset) GetMetaBlobUrl() string {
+	if x != nil {
+		return x.MetaBlobUrl
+	}
+	return ""
+}
+
+func (x *MintAsset) GetDecimalDisplay() uint32 {
+	if x != nil {
+		return x.DecimalDisplay
+	}
+	return 0
+}
+
+func (x *MintAsset) GetMaxSupply() uint64 {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return 0
+}
+
+func (x *MintAsset) GetVanityPrefix() string {
+	if x != nil {
+		return x.VanityPrefix
+	}
+	return ""
+}
+
+func (x *MintAsset) GetEmissionSchedule() []*taprpc.EmissionEpoch {
+	if x != nil {
+		return x.EmissionSchedule
+	}
+	return nil
+}
+
+func (x *MintAsset) GetScriptKey() *taprpc.ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *MintAsset) GetGroupMultisig() *GroupMultiSig {
+	if x != nil {
+		return x.GroupMultisig
+	}
+	return nil
+}
+
+func (x *MintAsset) GetDelegateKey() *taprpc.KeyDescriptor {
+	if x != nil {
+		return x.DelegateKey
+	}
+	return nil
+}
+
+type GroupMultiSig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of signatures required to issue assets into the group.
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// The x-only or compressed signer keys of the multisig script. They are
+	// sorted in the script, so their order doesn't matter.
+	Keys [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *GroupMultiSig) Reset() {
+	*x = GroupMultiSig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMultiSig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMultiSig) ProtoMessage() {}
+
+func (x *GroupMultiSig) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMultiSig.ProtoReflect.Descriptor instead.
+func (*GroupMultiSig) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupMultiSig) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GroupMultiSig) GetKeys() [][]byte {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type MintAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,12 +517,24 @@ type MintAssetRequest struct {
 	// response. This is mainly to avoid a lot of data being transmitted and
 	// possibly printed on the command line in the case of a very large batch.
 	ShortResponse bool `protobuf:"varint,3,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// The optional serialized preimage of a Tapscript sibling that should be
+	// committed to next to the Taproot Asset commitment in the genesis output of
+	// the batch. The sibling can be used to attach custom spending conditions
+	// to the minting output. Only the seedling that creates a new batch can set
+	// the sibling, all other seedlings must specify the same sibling (or none).
+	// Cannot be set together with full_tree.
+	TapscriptSibling []byte `protobuf:"bytes,4,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
+	// The optional full Tapscript tree that should be committed to next to the
+	// Taproot Asset commitment in the genesis output of the batch. This is an
+	// alternative to specifying the serialized Tapscript sibling preimage.
+	// Cannot be set together with tapscript_sibling.
+	FullTree *taprpc.TapscriptFullTree `protobuf:"bytes,5,opt,name=full_tree,json=fullTree,proto3" json:"full_tree,omitempty"`
 }
 
 func (x *MintAssetRequest) Reset() {
 	*x = MintAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[1]
+		mi := &file_mintrpc_mint_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -225,7 +547,7 @@ func (x *MintAssetRequest) String() string {
 func (*MintAssetRequest) ProtoMessage() {}
 
 func (x *MintAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[1]
+	mi := &file_mintrpc_mint_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +560,7 @@ func (x *MintAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintAssetRequest.ProtoReflect.Descriptor instead.
 func (*MintAssetRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{1}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MintAssetRequest) GetAsset() *MintAsset {
@@ -262,6 +584,20 @@ func (x *MintAssetRequest) GetShortResponse() bool {
 	return false
 }
 
+func (x *MintAssetRequest) GetTapscriptSibling() []byte {
+	if x != nil {
+		return x.TapscriptSibling
+	}
+	return nil
+}
+
+func (x *MintAssetRequest) GetFullTree() *taprpc.TapscriptFullTree {
+	if x != nil {
+		return x.FullTree
+	}
+	return nil
+}
+
 type MintAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -274,7 +610,7 @@ type MintAssetResponse struct {
 func (x *MintAssetResponse) Reset() {
 	*x = MintAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[2]
+		mi := &file_mintrpc_mint_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +623,7 @@ func (x *MintAssetResponse) String() string {
 func (*MintAssetResponse) ProtoMessage() {}
 
 func (x *MintAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[2]
+	mi := &file_mintrpc_mint_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +636,7 @@ func (x *MintAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintAssetResponse.ProtoReflect.Descriptor instead.
 func (*MintAssetResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{2}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MintAssetResponse) GetPendingBatch() *MintingBatch {
@@ -323,12 +659,27 @@ type MintingBatch struct {
 	Assets []*MintAsset `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
 	// The state of the batch.
 	State BatchState `protobuf:"varint,3,opt,name=state,proto3,enum=mintrpc.BatchState" json:"state,omitempty"`
+	// The serialized preimage of the Tapscript sibling that is committed to next
+	// to the Taproot Asset commitment in the genesis output of the batch, if
+	// there is one.
+	TapscriptSibling []byte `protobuf:"bytes,4,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
+	// If true, the genesis transaction of the batch is funded and signed by an
+	// external wallet instead of the backing lnd wallet.
+	ExternalFunding bool `protobuf:"varint,5,opt,name=external_funding,json=externalFunding,proto3" json:"external_funding,omitempty"`
+	// The user-defined label of the batch.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// Whether the minting transaction of the batch was re-organized out of the
+	// chain after the batch was finalized, and whether it recovered from that.
+	ReorgState BatchReOrgState `protobuf:"varint,7,opt,name=reorg_state,json=reorgState,proto3,enum=mintrpc.BatchReOrgState" json:"reorg_state,omitempty"`
+	// The number of times the minting transaction of the batch was re-organized
+	// out of the chain.
+	NumReorgs uint32 `protobuf:"varint,8,opt,name=num_reorgs,json=numReorgs,proto3" json:"num_reorgs,omitempty"`
 }
 
 func (x *MintingBatch) Reset() {
 	*x = MintingBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[3]
+		mi := &file_mintrpc_mint_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -341,7 +692,7 @@ func (x *MintingBatch) String() string {
 func (*MintingBatch) ProtoMessage() {}
 
 func (x *MintingBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[3]
+	mi := &file_mintrpc_mint_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +705,7 @@ func (x *MintingBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintingBatch.ProtoReflect.Descriptor instead.
 func (*MintingBatch) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{3}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MintingBatch) GetBatchKey() []byte {
@@ -378,6 +729,41 @@ func (x *MintingBatch) GetState() BatchState {
 	return BatchState_BATCH_STATE_UNKNOWN
 }
 
+func (x *MintingBatch) GetTapscriptSibling() []byte {
+	if x != nil {
+		return x.TapscriptSibling
+	}
+	return nil
+}
+
+func (x *MintingBatch) GetExternalFunding() bool {
+	if x != nil {
+		return x.ExternalFunding
+	}
+	return false
+}
+
+func (x *MintingBatch) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MintingBatch) GetReorgState() BatchReOrgState {
+	if x != nil {
+		return x.ReorgState
+	}
+	return BatchReOrgState_BATCH_REORG_STATE_NONE
+}
+
+func (x *MintingBatch) GetNumReorgs() uint32 {
+	if x != nil {
+		return x.NumReorgs
+	}
+	return 0
+}
+
 type FinalizeBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -387,12 +773,17 @@ type FinalizeBatchRequest struct {
 	// response. This is mainly to avoid a lot of data being transmitted and
 	// possibly printed on the command line in the case of a very large batch.
 	ShortResponse bool `protobuf:"varint,1,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// If true, the genesis transaction of the batch isn't funded by the backing
+	// lnd wallet. Instead, the unfunded genesis PSBT is returned, which must be
+	// funded and later signed by an external wallet and submitted back with
+	// SubmitGenesisPsbt.
+	ExternalFunding bool `protobuf:"varint,2,opt,name=external_funding,json=externalFunding,proto3" json:"external_funding,omitempty"`
 }
 
 func (x *FinalizeBatchRequest) Reset() {
 	*x = FinalizeBatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[4]
+		mi := &file_mintrpc_mint_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -405,7 +796,7 @@ func (x *FinalizeBatchRequest) String() string {
 func (*FinalizeBatchRequest) ProtoMessage() {}
 
 func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[4]
+	mi := &file_mintrpc_mint_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +809,7 @@ func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeBatchRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{4}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FinalizeBatchRequest) GetShortResponse() bool {
@@ -428,6 +819,13 @@ func (x *FinalizeBatchRequest) GetShortResponse() bool {
 	return false
 }
 
+func (x *FinalizeBatchRequest) GetExternalFunding() bool {
+	if x != nil {
+		return x.ExternalFunding
+	}
+	return false
+}
+
 type FinalizeBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -435,12 +833,16 @@ type FinalizeBatchResponse struct {
 
 	// The finalized batch.
 	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	// The unfunded genesis PSBT of the batch, if the batch is funded externally.
+	// The external wallet must add inputs and an optional change output to it
+	// without signing it yet.
+	GenesisPsbt []byte `protobuf:"bytes,2,opt,name=genesis_psbt,json=genesisPsbt,proto3" json:"genesis_psbt,omitempty"`
 }
 
 func (x *FinalizeBatchResponse) Reset() {
 	*x = FinalizeBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[5]
+		mi := &file_mintrpc_mint_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +855,7 @@ func (x *FinalizeBatchResponse) String() string {
 func (*FinalizeBatchResponse) ProtoMessage() {}
 
 func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[5]
+	mi := &file_mintrpc_mint_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +868,7 @@ func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeBatchResponse.ProtoReflect.Descriptor instead.
 func (*FinalizeBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{5}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
@@ -476,29 +878,45 @@ func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
 	return nil
 }
 
-type CancelBatchRequest struct {
+func (x *FinalizeBatchResponse) GetGenesisPsbt() []byte {
+	if x != nil {
+		return x.GenesisPsbt
+	}
+	return nil
+}
+
+type PreviewBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The fee rate in sat/vbyte to base the fee estimate on. If not set, the fee
+	// rate that would currently be used to fund the minting transaction is
+	// estimated.
+	SatPerVbyte uint64 `protobuf:"varint,1,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// If true, then the assets currently in the batch won't be returned in the
+	// response. This is mainly to avoid a lot of data being transmitted and
+	// possibly printed on the command line in the case of a very large batch.
+	ShortResponse bool `protobuf:"varint,2,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
 }
 
-func (x *CancelBatchRequest) Reset() {
-	*x = CancelBatchRequest{}
+func (x *PreviewBatchRequest) Reset() {
+	*x = PreviewBatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[6]
+		mi := &file_mintrpc_mint_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelBatchRequest) String() string {
+func (x *PreviewBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBatchRequest) ProtoMessage() {}
+func (*PreviewBatchRequest) ProtoMessage() {}
 
-func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[6]
+func (x *PreviewBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,37 +927,58 @@ func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
-func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PreviewBatchRequest.ProtoReflect.Descriptor instead.
+func (*PreviewBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{7}
 }
 
-type CancelBatchResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The internal public key of the batch.
-	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+func (x *PreviewBatchRequest) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
 }
 
-func (x *CancelBatchResponse) Reset() {
-	*x = CancelBatchResponse{}
+func (x *PreviewBatchRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type PreviewAnchorOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount of satoshis locked in the anchor output.
+	AmtSats int64 `protobuf:"varint,1,opt,name=amt_sats,json=amtSats,proto3" json:"amt_sats,omitempty"`
+	// The internal key of the anchor output, which is the batch key, serialized
+	// in compressed format.
+	InternalKey []byte `protobuf:"bytes,2,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// The number of assets the anchor output will commit to.
+	NumAssets uint32 `protobuf:"varint,3,opt,name=num_assets,json=numAssets,proto3" json:"num_assets,omitempty"`
+	// The number of new asset groups that will be created by the batch.
+	NumNewGroups uint32 `protobuf:"varint,4,opt,name=num_new_groups,json=numNewGroups,proto3" json:"num_new_groups,omitempty"`
+}
+
+func (x *PreviewAnchorOutput) Reset() {
+	*x = PreviewAnchorOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[7]
+		mi := &file_mintrpc_mint_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelBatchResponse) String() string {
+func (x *PreviewAnchorOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBatchResponse) ProtoMessage() {}
+func (*PreviewAnchorOutput) ProtoMessage() {}
 
-func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[7]
+func (x *PreviewAnchorOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,49 +989,75 @@ func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
-func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PreviewAnchorOutput.ProtoReflect.Descriptor instead.
+func (*PreviewAnchorOutput) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CancelBatchResponse) GetBatchKey() []byte {
+func (x *PreviewAnchorOutput) GetAmtSats() int64 {
 	if x != nil {
-		return x.BatchKey
+		return x.AmtSats
+	}
+	return 0
+}
+
+func (x *PreviewAnchorOutput) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
 	}
 	return nil
 }
 
-type ListBatchRequest struct {
+func (x *PreviewAnchorOutput) GetNumAssets() uint32 {
+	if x != nil {
+		return x.NumAssets
+	}
+	return 0
+}
+
+func (x *PreviewAnchorOutput) GetNumNewGroups() uint32 {
+	if x != nil {
+		return x.NumNewGroups
+	}
+	return 0
+}
+
+type PreviewBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The optional batch key of the batch to list.
-	//
-	// Types that are assignable to Filter:
-	//
-	//	*ListBatchRequest_BatchKey
-	//	*ListBatchRequest_BatchKeyStr
-	Filter isListBatchRequest_Filter `protobuf_oneof:"filter"`
+	// The pending batch the preview was created for.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	// The fee rate in sat/vbyte the fee estimate is based on.
+	SatPerVbyte uint64 `protobuf:"varint,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// The estimated virtual size of the minting transaction in vbytes. This
+	// assumes that the wallet funds the transaction with a single P2TR input and
+	// adds a P2TR change output. Any additional inputs increase the final size.
+	EstimatedVsize int64 `protobuf:"varint,3,opt,name=estimated_vsize,json=estimatedVsize,proto3" json:"estimated_vsize,omitempty"`
+	// The estimated fee of the minting transaction in satoshis.
+	EstimatedFeeSats int64 `protobuf:"varint,4,opt,name=estimated_fee_sats,json=estimatedFeeSats,proto3" json:"estimated_fee_sats,omitempty"`
+	// The structure of the Taproot output that will anchor the batch.
+	AnchorOutput *PreviewAnchorOutput `protobuf:"bytes,5,opt,name=anchor_output,json=anchorOutput,proto3" json:"anchor_output,omitempty"`
 }
 
-func (x *ListBatchRequest) Reset() {
-	*x = ListBatchRequest{}
+func (x *PreviewBatchResponse) Reset() {
+	*x = PreviewBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[8]
+		mi := &file_mintrpc_mint_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBatchRequest) String() string {
+func (x *PreviewBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBatchRequest) ProtoMessage() {}
+func (*PreviewBatchResponse) ProtoMessage() {}
 
-func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[8]
+func (x *PreviewBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,77 +1068,149 @@ func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBatchRequest.ProtoReflect.Descriptor instead.
-func (*ListBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PreviewBatchResponse.ProtoReflect.Descriptor instead.
+func (*PreviewBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *ListBatchRequest) GetFilter() isListBatchRequest_Filter {
-	if m != nil {
-		return m.Filter
+func (x *PreviewBatchResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
 	}
 	return nil
 }
 
-func (x *ListBatchRequest) GetBatchKey() []byte {
-	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKey); ok {
-		return x.BatchKey
+func (x *PreviewBatchResponse) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+func (x *PreviewBatchResponse) GetEstimatedVsize() int64 {
+	if x != nil {
+		return x.EstimatedVsize
+	}
+	return 0
+}
+
+func (x *PreviewBatchResponse) GetEstimatedFeeSats() int64 {
+	if x != nil {
+		return x.EstimatedFeeSats
+	}
+	return 0
+}
+
+func (x *PreviewBatchResponse) GetAnchorOutput() *PreviewAnchorOutput {
+	if x != nil {
+		return x.AnchorOutput
 	}
 	return nil
 }
 
-func (x *ListBatchRequest) GetBatchKeyStr() string {
-	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKeyStr); ok {
-		return x.BatchKeyStr
+type ValidateMintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The assets to validate, in the order they would be minted. An asset can
+	// use an asset of the pending batch or an earlier asset of the request as
+	// its group anchor. The short_response field of the requests is ignored.
+	Assets []*MintAssetRequest `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The optional outpoint of the first input of the minting transaction, in
+	// the form txid:index. The asset IDs commit to it, so they are only final if
+	// it is set. Otherwise a placeholder outpoint is used, and vanity asset IDs
+	// aren't ground.
+	GenesisOutpoint string `protobuf:"bytes,2,opt,name=genesis_outpoint,json=genesisOutpoint,proto3" json:"genesis_outpoint,omitempty"`
+	// The index of the output of the minting transaction that commits to the
+	// assets, which the asset IDs commit to as well.
+	AnchorOutputIndex uint32 `protobuf:"varint,3,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
+}
+
+func (x *ValidateMintRequest) Reset() {
+	*x = ValidateMintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type isListBatchRequest_Filter interface {
-	isListBatchRequest_Filter()
+func (x *ValidateMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ListBatchRequest_BatchKey struct {
-	// The optional batch key of the batch to list, specified as raw bytes
-	// (gRPC only).
-	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+func (*ValidateMintRequest) ProtoMessage() {}
+
+func (x *ValidateMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ListBatchRequest_BatchKeyStr struct {
-	// The optional batch key of the batch to list, specified as a hex
-	// encoded string (use this for REST).
-	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+// Deprecated: Use ValidateMintRequest.ProtoReflect.Descriptor instead.
+func (*ValidateMintRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{10}
 }
 
-func (*ListBatchRequest_BatchKey) isListBatchRequest_Filter() {}
+func (x *ValidateMintRequest) GetAssets() []*MintAssetRequest {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
 
-func (*ListBatchRequest_BatchKeyStr) isListBatchRequest_Filter() {}
+func (x *ValidateMintRequest) GetGenesisOutpoint() string {
+	if x != nil {
+		return x.GenesisOutpoint
+	}
+	return ""
+}
 
-type ListBatchResponse struct {
+func (x *ValidateMintRequest) GetAnchorOutputIndex() uint32 {
+	if x != nil {
+		return x.AnchorOutputIndex
+	}
+	return 0
+}
+
+type ValidatedAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Batches []*MintingBatch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	// The name of the asset.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The ID the asset would be minted with.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The meta data the asset would commit to, which includes the JSON meta data
+	// created for the asset and the nonce of a vanity asset ID.
+	AssetMeta *taprpc.AssetMeta `protobuf:"bytes,3,opt,name=asset_meta,json=assetMeta,proto3" json:"asset_meta,omitempty"`
 }
 
-func (x *ListBatchResponse) Reset() {
-	*x = ListBatchResponse{}
+func (x *ValidatedAsset) Reset() {
+	*x = ValidatedAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[9]
+		mi := &file_mintrpc_mint_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBatchResponse) String() string {
+func (x *ValidatedAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBatchResponse) ProtoMessage() {}
+func (*ValidatedAsset) ProtoMessage() {}
 
-func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[9]
+func (x *ValidatedAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,216 +1221,3983 @@ func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBatchResponse.ProtoReflect.Descriptor instead.
-func (*ListBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ValidatedAsset.ProtoReflect.Descriptor instead.
+func (*ValidatedAsset) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListBatchResponse) GetBatches() []*MintingBatch {
+func (x *ValidatedAsset) GetName() string {
 	if x != nil {
-		return x.Batches
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ValidatedAsset) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
 	}
 	return nil
 }
 
-var File_mintrpc_mint_proto protoreflect.FileDescriptor
+func (x *ValidatedAsset) GetAssetMeta() *taprpc.AssetMeta {
+	if x != nil {
+		return x.AssetMeta
+	}
+	return nil
+}
 
-var file_mintrpc_mint_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x74,
-	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x96, 0x02, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
-	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x10,
-	0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x82, 0x01, 0x0a, 0x0c,
-	0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0x3d, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22,
-	0x61, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b,
-	0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
-	0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x50, 0x45, 0x44, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54,
-	0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10,
-	0x02, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41,
-	0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44,
-	0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22, 0x0a,
-	0x1e, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x45,
-	0x44, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
-	0x07, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x53, 0x50, 0x52, 0x4f, 0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
-	0x44, 0x10, 0x08, 0x32, 0xaa, 0x02, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09,
-	0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d,
-	0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4e, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d,
-	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70,
-	0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+type ValidateMintResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The validated assets, in the order of the request.
+	Assets []*ValidatedAsset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	// If true, then no genesis outpoint was given and the asset IDs commit to a
+	// placeholder outpoint, so they will change once the batch is funded.
+	ProvisionalAssetIds bool `protobuf:"varint,2,opt,name=provisional_asset_ids,json=provisionalAssetIds,proto3" json:"provisional_asset_ids,omitempty"`
 }
 
-var (
-	file_mintrpc_mint_proto_rawDescOnce sync.Once
-	file_mintrpc_mint_proto_rawDescData = file_mintrpc_mint_proto_rawDesc
-)
+func (x *ValidateMintResponse) Reset() {
+	*x = ValidateMintResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_mintrpc_mint_proto_rawDescGZIP() []byte {
-	file_mintrpc_mint_proto_rawDescOnce.Do(func() {
-		file_mintrpc_mint_proto_rawDescData = protoimpl.X.CompressGZIP(file_mintrpc_mint_proto_rawDescData)
-	})
-	return file_mintrpc_mint_proto_rawDescData
+func (x *ValidateMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_mintrpc_mint_proto_goTypes = []interface{}{
-	(BatchState)(0),               // 0: mintrpc.BatchState
-	(*MintAsset)(nil),             // 1: mintrpc.MintAsset
-	(*MintAssetRequest)(nil),      // 2: mintrpc.MintAssetRequest
-	(*MintAssetResponse)(nil),     // 3: mintrpc.MintAssetResponse
-	(*MintingBatch)(nil),          // 4: mintrpc.MintingBatch
-	(*FinalizeBatchRequest)(nil),  // 5: mintrpc.FinalizeBatchRequest
-	(*FinalizeBatchResponse)(nil), // 6: mintrpc.FinalizeBatchResponse
-	(*CancelBatchRequest)(nil),    // 7: mintrpc.CancelBatchRequest
-	(*CancelBatchResponse)(nil),   // 8: mintrpc.CancelBatchResponse
-	(*ListBatchRequest)(nil),      // 9: mintrpc.ListBatchRequest
-	(*ListBatchResponse)(nil),     // 10: mintrpc.ListBatchResponse
-	(taprpc.AssetType)(0),         // 11: taprpc.AssetType
-	(*taprpc.AssetMeta)(nil),      // 12: taprpc.AssetMeta
-	(taprpc.AssetVersion)(0),      // 13: taprpc.AssetVersion
+func (*ValidateMintResponse) ProtoMessage() {}
+
+func (x *ValidateMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_mintrpc_mint_proto_depIdxs = []int32{
-	11, // 0: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
-	12, // 1: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
-	13, // 2: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
-	1,  // 3: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
-	4,  // 4: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
-	1,  // 5: mintrpc.MintingBatch.assets:type_name -> mintrpc.MintAsset
-	0,  // 6: mintrpc.MintingBatch.state:type_name -> mintrpc.BatchState
-	4,  // 7: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
-	4,  // 8: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
-	2,  // 9: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
-	5,  // 10: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
-	7,  // 11: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
-	9,  // 12: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
-	3,  // 13: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
-	6,  // 14: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
-	8,  // 15: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
-	10, // 16: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use ValidateMintResponse.ProtoReflect.Descriptor instead.
+func (*ValidateMintResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{12}
 }
 
-func init() { file_mintrpc_mint_proto_init() }
-func file_mintrpc_mint_proto_init() {
-	if File_mintrpc_mint_proto != nil {
-		return
+func (x *ValidateMintResponse) GetAssets() []*ValidatedAsset {
+	if x != nil {
+		return x.Assets
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_mintrpc_mint_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAsset); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_mintrpc_mint_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAssetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
+	return nil
+}
+
+func (x *ValidateMintResponse) GetProvisionalAssetIds() bool {
+	if x != nil {
+		return x.ProvisionalAssetIds
+	}
+	return false
+}
+
+type CancelBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelBatchRequest) Reset() {
+	*x = CancelBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchRequest) ProtoMessage() {}
+
+func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{13}
+}
+
+type CancelBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The internal public key of the batch.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *CancelBatchResponse) Reset() {
+	*x = CancelBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchResponse) ProtoMessage() {}
+
+func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
+func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelBatchResponse) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type CancelSeedlingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the asset to remove from the pending batch.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// If true, then the assets remaining in the batch won't be returned in the
+	// response. This is mainly to avoid a lot of data being transmitted and
+	// possibly printed on the command line in the case of a very large batch.
+	ShortResponse bool `protobuf:"varint,2,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *CancelSeedlingRequest) Reset() {
+	*x = CancelSeedlingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSeedlingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSeedlingRequest) ProtoMessage() {}
+
+func (x *CancelSeedlingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSeedlingRequest.ProtoReflect.Descriptor instead.
+func (*CancelSeedlingRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelSeedlingRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *CancelSeedlingRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type CancelSeedlingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pending batch the asset was removed from. This is not set if the
+	// removed asset was the last one in the batch, in which case the batch was
+	// cancelled.
+	PendingBatch *MintingBatch `protobuf:"bytes,1,opt,name=pending_batch,json=pendingBatch,proto3" json:"pending_batch,omitempty"`
+}
+
+func (x *CancelSeedlingResponse) Reset() {
+	*x = CancelSeedlingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSeedlingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSeedlingResponse) ProtoMessage() {}
+
+func (x *CancelSeedlingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSeedlingResponse.ProtoReflect.Descriptor instead.
+func (*CancelSeedlingResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelSeedlingResponse) GetPendingBatch() *MintingBatch {
+	if x != nil {
+		return x.PendingBatch
+	}
+	return nil
+}
+
+type ListBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The optional batch key of the batch to list.
+	//
+	// Types that are assignable to Filter:
+	//
+	//	*ListBatchRequest_BatchKey
+	//	*ListBatchRequest_BatchKeyStr
+	Filter isListBatchRequest_Filter `protobuf_oneof:"filter"`
+}
+
+func (x *ListBatchRequest) Reset() {
+	*x = ListBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchRequest) ProtoMessage() {}
+
+func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *ListBatchRequest) GetFilter() isListBatchRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *ListBatchRequest) GetBatchKey() []byte {
+	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *ListBatchRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+type isListBatchRequest_Filter interface {
+	isListBatchRequest_Filter()
+}
+
+type ListBatchRequest_BatchKey struct {
+	// The optional batch key of the batch to list, specified as raw bytes
+	// (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type ListBatchRequest_BatchKeyStr struct {
+	// The optional batch key of the batch to list, specified as a hex
+	// encoded string (use this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*ListBatchRequest_BatchKey) isListBatchRequest_Filter() {}
+
+func (*ListBatchRequest_BatchKeyStr) isListBatchRequest_Filter() {}
+
+type ListBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Batches []*MintingBatch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (x *ListBatchResponse) Reset() {
+	*x = ListBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchResponse) ProtoMessage() {}
+
+func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListBatchResponse) GetBatches() []*MintingBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type SetBatchLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch key of the batch to label.
+	//
+	// Types that are assignable to Batch:
+	//
+	//	*SetBatchLabelRequest_BatchKey
+	//	*SetBatchLabelRequest_BatchKeyStr
+	Batch isSetBatchLabelRequest_Batch `protobuf_oneof:"batch"`
+	// The new label of the batch. An empty label removes the current label. The
+	// label can be at most 500 bytes long.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *SetBatchLabelRequest) Reset() {
+	*x = SetBatchLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBatchLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBatchLabelRequest) ProtoMessage() {}
+
+func (x *SetBatchLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBatchLabelRequest.ProtoReflect.Descriptor instead.
+func (*SetBatchLabelRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *SetBatchLabelRequest) GetBatch() isSetBatchLabelRequest_Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (x *SetBatchLabelRequest) GetBatchKey() []byte {
+	if x, ok := x.GetBatch().(*SetBatchLabelRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *SetBatchLabelRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetBatch().(*SetBatchLabelRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+func (x *SetBatchLabelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type isSetBatchLabelRequest_Batch interface {
+	isSetBatchLabelRequest_Batch()
+}
+
+type SetBatchLabelRequest_BatchKey struct {
+	// The batch key of the batch to label, specified as raw bytes (gRPC
+	// only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type SetBatchLabelRequest_BatchKeyStr struct {
+	// The batch key of the batch to label, specified as a hex encoded
+	// string (use this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*SetBatchLabelRequest_BatchKey) isSetBatchLabelRequest_Batch() {}
+
+func (*SetBatchLabelRequest_BatchKeyStr) isSetBatchLabelRequest_Batch() {}
+
+type SetBatchLabelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetBatchLabelResponse) Reset() {
+	*x = SetBatchLabelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBatchLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBatchLabelResponse) ProtoMessage() {}
+
+func (x *SetBatchLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBatchLabelResponse.ProtoReflect.Descriptor instead.
+func (*SetBatchLabelResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{20}
+}
+
+type ListBatchCostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The optional batch key of the batch to report the cost of.
+	//
+	// Types that are assignable to Filter:
+	//
+	//	*ListBatchCostsRequest_BatchKey
+	//	*ListBatchCostsRequest_BatchKeyStr
+	Filter isListBatchCostsRequest_Filter `protobuf_oneof:"filter"`
+}
+
+func (x *ListBatchCostsRequest) Reset() {
+	*x = ListBatchCostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchCostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchCostsRequest) ProtoMessage() {}
+
+func (x *ListBatchCostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchCostsRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchCostsRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *ListBatchCostsRequest) GetFilter() isListBatchCostsRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *ListBatchCostsRequest) GetBatchKey() []byte {
+	if x, ok := x.GetFilter().(*ListBatchCostsRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *ListBatchCostsRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetFilter().(*ListBatchCostsRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+type isListBatchCostsRequest_Filter interface {
+	isListBatchCostsRequest_Filter()
+}
+
+type ListBatchCostsRequest_BatchKey struct {
+	// The optional batch key of the batch to report the cost of,
+	// specified as raw bytes (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type ListBatchCostsRequest_BatchKeyStr struct {
+	// The optional batch key of the batch to report the cost of,
+	// specified as a hex encoded string (use this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*ListBatchCostsRequest_BatchKey) isListBatchCostsRequest_Filter() {}
+
+func (*ListBatchCostsRequest_BatchKeyStr) isListBatchCostsRequest_Filter() {}
+
+type AssetCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The name of the asset.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The amount of units of the asset that were minted.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The share of the chain fees of the minting transaction in satoshis that is
+	// attributed to the asset. The fees are split equally among all assets of the
+	// batch.
+	AmortizedFeeSats int64 `protobuf:"varint,4,opt,name=amortized_fee_sats,json=amortizedFeeSats,proto3" json:"amortized_fee_sats,omitempty"`
+}
+
+func (x *AssetCost) Reset() {
+	*x = AssetCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetCost) ProtoMessage() {}
+
+func (x *AssetCost) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetCost.ProtoReflect.Descriptor instead.
+func (*AssetCost) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AssetCost) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetCost) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetCost) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AssetCost) GetAmortizedFeeSats() int64 {
+	if x != nil {
+		return x.AmortizedFeeSats
+	}
+	return 0
+}
+
+type BatchCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch key of the batch, serialized in compressed format.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The user-defined label of the batch.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The state of the batch.
+	State BatchState `protobuf:"varint,3,opt,name=state,proto3,enum=mintrpc.BatchState" json:"state,omitempty"`
+	// The time the batch was created at, as a Unix timestamp in seconds.
+	CreationTimeUnix int64 `protobuf:"varint,4,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
+	// The ID of the minting transaction, encoded as a hex string.
+	Txid string `protobuf:"bytes,5,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The total chain fees paid by the minting transaction in satoshis.
+	ChainFeesSats int64 `protobuf:"varint,6,opt,name=chain_fees_sats,json=chainFeesSats,proto3" json:"chain_fees_sats,omitempty"`
+	// The virtual size of the minting transaction in vbytes.
+	Vsize int64 `protobuf:"varint,7,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	// The cost attributed to each asset of the batch.
+	AssetCosts []*AssetCost `protobuf:"bytes,8,rep,name=asset_costs,json=assetCosts,proto3" json:"asset_costs,omitempty"`
+}
+
+func (x *BatchCost) Reset() {
+	*x = BatchCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCost) ProtoMessage() {}
+
+func (x *BatchCost) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCost.ProtoReflect.Descriptor instead.
+func (*BatchCost) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchCost) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *BatchCost) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BatchCost) GetState() BatchState {
+	if x != nil {
+		return x.State
+	}
+	return BatchState_BATCH_STATE_UNKNOWN
+}
+
+func (x *BatchCost) GetCreationTimeUnix() int64 {
+	if x != nil {
+		return x.CreationTimeUnix
+	}
+	return 0
+}
+
+func (x *BatchCost) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *BatchCost) GetChainFeesSats() int64 {
+	if x != nil {
+		return x.ChainFeesSats
+	}
+	return 0
+}
+
+func (x *BatchCost) GetVsize() int64 {
+	if x != nil {
+		return x.Vsize
+	}
+	return 0
+}
+
+func (x *BatchCost) GetAssetCosts() []*AssetCost {
+	if x != nil {
+		return x.AssetCosts
+	}
+	return nil
+}
+
+type ListBatchCostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The cost of each batch with a signed minting transaction. Batches that were
+	// never signed are omitted.
+	BatchCosts []*BatchCost `protobuf:"bytes,1,rep,name=batch_costs,json=batchCosts,proto3" json:"batch_costs,omitempty"`
+}
+
+func (x *ListBatchCostsResponse) Reset() {
+	*x = ListBatchCostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchCostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchCostsResponse) ProtoMessage() {}
+
+func (x *ListBatchCostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchCostsResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchCostsResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListBatchCostsResponse) GetBatchCosts() []*BatchCost {
+	if x != nil {
+		return x.BatchCosts
+	}
+	return nil
+}
+
+type BatchSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of seconds a pending batch is kept open before it is
+	// finalized automatically, counted from the creation of the batch. A value of
+	// 0 disables timed finalization.
+	IntervalSeconds uint64 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// The number of seedlings in a pending batch that causes the batch to be
+	// finalized automatically. A value of 0 disables this threshold.
+	MaxSeedlings uint32 `protobuf:"varint,2,opt,name=max_seedlings,json=maxSeedlings,proto3" json:"max_seedlings,omitempty"`
+	// The total size in bytes of the asset meta data of all seedlings in a pending
+	// batch that causes the batch to be finalized automatically. A value of 0
+	// disables this threshold.
+	MaxMetaSize uint64 `protobuf:"varint,3,opt,name=max_meta_size,json=maxMetaSize,proto3" json:"max_meta_size,omitempty"`
+}
+
+func (x *BatchSchedule) Reset() {
+	*x = BatchSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSchedule) ProtoMessage() {}
+
+func (x *BatchSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSchedule.ProtoReflect.Descriptor instead.
+func (*BatchSchedule) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchSchedule) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *BatchSchedule) GetMaxSeedlings() uint32 {
+	if x != nil {
+		return x.MaxSeedlings
+	}
+	return 0
+}
+
+func (x *BatchSchedule) GetMaxMetaSize() uint64 {
+	if x != nil {
+		return x.MaxMetaSize
+	}
+	return 0
+}
+
+type GetBatchScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBatchScheduleRequest) Reset() {
+	*x = GetBatchScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchScheduleRequest) ProtoMessage() {}
+
+func (x *GetBatchScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{26}
+}
+
+type GetBatchScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The active batch schedule.
+	Schedule *BatchSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *GetBatchScheduleResponse) Reset() {
+	*x = GetBatchScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchScheduleResponse) ProtoMessage() {}
+
+func (x *GetBatchScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetBatchScheduleResponse) GetSchedule() *BatchSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateBatchScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new batch schedule. Unset fields disable the respective condition.
+	Schedule *BatchSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *UpdateBatchScheduleRequest) Reset() {
+	*x = UpdateBatchScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateBatchScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBatchScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateBatchScheduleRequest) GetSchedule() *BatchSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateBatchScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch schedule that is now active.
+	Schedule *BatchSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *UpdateBatchScheduleResponse) Reset() {
+	*x = UpdateBatchScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateBatchScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBatchScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateBatchScheduleResponse) GetSchedule() *BatchSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GroupWitnessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the batch the asset is minted in.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The name of the asset the group witness is for.
+	AssetName string `protobuf:"bytes,2,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The raw group key held by the external signer.
+	RawGroupKey *taprpc.KeyDescriptor `protobuf:"bytes,3,opt,name=raw_group_key,json=rawGroupKey,proto3" json:"raw_group_key,omitempty"`
+	// The tweak that must be added to the raw group key before tweaking it
+	// BIP-0086 style to obtain the key the signature must be produced with.
+	SingleTweak []byte `protobuf:"bytes,4,opt,name=single_tweak,json=singleTweak,proto3" json:"single_tweak,omitempty"`
+	// The x-only tweaked group key the signature must be valid for.
+	TweakedGroupKey []byte `protobuf:"bytes,5,opt,name=tweaked_group_key,json=tweakedGroupKey,proto3" json:"tweaked_group_key,omitempty"`
+	// The virtual minting transaction of the asset as a PSBT, which contains the
+	// previous output and the BIP-0086 internal key of its only input.
+	VirtualPsbt []byte `protobuf:"bytes,6,opt,name=virtual_psbt,json=virtualPsbt,proto3" json:"virtual_psbt,omitempty"`
+	// The BIP-0341 signature hash of the virtual minting transaction, which is
+	// the message that must be signed. For a multisig group key, it is the
+	// script path signature hash of the multisig leaf. It also identifies the
+	// request.
+	SigHash []byte `protobuf:"bytes,7,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The multisig script of the group key, if the group witness must be signed
+	// by multiple signers with SubmitGroupScriptSig. The virtual PSBT then also
+	// contains the multisig leaf and its control block.
+	Multisig *GroupMultiSig `protobuf:"bytes,8,opt,name=multisig,proto3" json:"multisig,omitempty"`
+	// The x-only multisig keys that already submitted their signature.
+	SignedKeys [][]byte `protobuf:"bytes,9,rep,name=signed_keys,json=signedKeys,proto3" json:"signed_keys,omitempty"`
+}
+
+func (x *GroupWitnessRequest) Reset() {
+	*x = GroupWitnessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupWitnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupWitnessRequest) ProtoMessage() {}
+
+func (x *GroupWitnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupWitnessRequest.ProtoReflect.Descriptor instead.
+func (*GroupWitnessRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GroupWitnessRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *GroupWitnessRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *GroupWitnessRequest) GetRawGroupKey() *taprpc.KeyDescriptor {
+	if x != nil {
+		return x.RawGroupKey
+	}
+	return nil
+}
+
+func (x *GroupWitnessRequest) GetSingleTweak() []byte {
+	if x != nil {
+		return x.SingleTweak
+	}
+	return nil
+}
+
+func (x *GroupWitnessRequest) GetTweakedGroupKey() []byte {
+	if x != nil {
+		return x.TweakedGroupKey
+	}
+	return nil
+}
+
+func (x *GroupWitnessRequest) GetVirtualPsbt() []byte {
+	if x != nil {
+		return x.VirtualPsbt
+	}
+	return nil
+}
+
+func (x *GroupWitnessRequest) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *GroupWitnessRequest) GetMultisig() *GroupMultiSig {
+	if x != nil {
+		return x.Multisig
+	}
+	return nil
+}
+
+func (x *GroupWitnessRequest) GetSignedKeys() [][]byte {
+	if x != nil {
+		return x.SignedKeys
+	}
+	return nil
+}
+
+type ListGroupWitnessRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGroupWitnessRequestsRequest) Reset() {
+	*x = ListGroupWitnessRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupWitnessRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupWitnessRequestsRequest) ProtoMessage() {}
+
+func (x *ListGroupWitnessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupWitnessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupWitnessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{31}
+}
+
+type ListGroupWitnessRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The group witness requests that wait for a signature.
+	Requests []*GroupWitnessRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListGroupWitnessRequestsResponse) Reset() {
+	*x = ListGroupWitnessRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupWitnessRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupWitnessRequestsResponse) ProtoMessage() {}
+
+func (x *ListGroupWitnessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupWitnessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupWitnessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListGroupWitnessRequestsResponse) GetRequests() []*GroupWitnessRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type SubmitGroupWitnessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signature hash of the group witness request to sign.
+	SigHash []byte `protobuf:"bytes,1,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The 64-byte schnorr signature over the signature hash.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SubmitGroupWitnessRequest) Reset() {
+	*x = SubmitGroupWitnessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGroupWitnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGroupWitnessRequest) ProtoMessage() {}
+
+func (x *SubmitGroupWitnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGroupWitnessRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGroupWitnessRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubmitGroupWitnessRequest) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *SubmitGroupWitnessRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type SubmitGroupWitnessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitGroupWitnessResponse) Reset() {
+	*x = SubmitGroupWitnessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGroupWitnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGroupWitnessResponse) ProtoMessage() {}
+
+func (x *SubmitGroupWitnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGroupWitnessResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGroupWitnessResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{34}
+}
+
+type SubmitGroupScriptSigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signature hash of the group witness request to sign.
+	SigHash []byte `protobuf:"bytes,1,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The multisig key the signature belongs to.
+	SignerKey []byte `protobuf:"bytes,2,opt,name=signer_key,json=signerKey,proto3" json:"signer_key,omitempty"`
+	// The 64-byte schnorr signature over the signature hash.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SubmitGroupScriptSigRequest) Reset() {
+	*x = SubmitGroupScriptSigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGroupScriptSigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGroupScriptSigRequest) ProtoMessage() {}
+
+func (x *SubmitGroupScriptSigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGroupScriptSigRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGroupScriptSigRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubmitGroupScriptSigRequest) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *SubmitGroupScriptSigRequest) GetSignerKey() []byte {
+	if x != nil {
+		return x.SignerKey
+	}
+	return nil
+}
+
+func (x *SubmitGroupScriptSigRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type SubmitGroupScriptSigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of signatures that are still missing before the group witness
+	// is complete.
+	SigsRemaining uint32 `protobuf:"varint,1,opt,name=sigs_remaining,json=sigsRemaining,proto3" json:"sigs_remaining,omitempty"`
+}
+
+func (x *SubmitGroupScriptSigResponse) Reset() {
+	*x = SubmitGroupScriptSigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGroupScriptSigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGroupScriptSigResponse) ProtoMessage() {}
+
+func (x *SubmitGroupScriptSigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGroupScriptSigResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGroupScriptSigResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubmitGroupScriptSigResponse) GetSigsRemaining() uint32 {
+	if x != nil {
+		return x.SigsRemaining
+	}
+	return 0
+}
+
+type MuSig2GroupSigner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The public key of the signer.
+	SignerKey []byte `protobuf:"bytes,1,opt,name=signer_key,json=signerKey,proto3" json:"signer_key,omitempty"`
+	// The 66-byte public nonce of the signer, if it was registered already.
+	PubNonce []byte `protobuf:"bytes,2,opt,name=pub_nonce,json=pubNonce,proto3" json:"pub_nonce,omitempty"`
+	// True if the partial signature of the signer was received.
+	PartialSigReceived bool `protobuf:"varint,3,opt,name=partial_sig_received,json=partialSigReceived,proto3" json:"partial_sig_received,omitempty"`
+}
+
+func (x *MuSig2GroupSigner) Reset() {
+	*x = MuSig2GroupSigner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuSig2GroupSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuSig2GroupSigner) ProtoMessage() {}
+
+func (x *MuSig2GroupSigner) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuSig2GroupSigner.ProtoReflect.Descriptor instead.
+func (*MuSig2GroupSigner) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MuSig2GroupSigner) GetSignerKey() []byte {
+	if x != nil {
+		return x.SignerKey
+	}
+	return nil
+}
+
+func (x *MuSig2GroupSigner) GetPubNonce() []byte {
+	if x != nil {
+		return x.PubNonce
+	}
+	return nil
+}
+
+func (x *MuSig2GroupSigner) GetPartialSigReceived() bool {
+	if x != nil {
+		return x.PartialSigReceived
+	}
+	return false
+}
+
+type MuSig2KeyTweak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 32-byte tweak.
+	Tweak []byte `protobuf:"bytes,1,opt,name=tweak,proto3" json:"tweak,omitempty"`
+	// True if the tweak is applied to the x-only key.
+	IsXOnly bool `protobuf:"varint,2,opt,name=is_x_only,json=isXOnly,proto3" json:"is_x_only,omitempty"`
+}
+
+func (x *MuSig2KeyTweak) Reset() {
+	*x = MuSig2KeyTweak{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuSig2KeyTweak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuSig2KeyTweak) ProtoMessage() {}
+
+func (x *MuSig2KeyTweak) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuSig2KeyTweak.ProtoReflect.Descriptor instead.
+func (*MuSig2KeyTweak) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MuSig2KeyTweak) GetTweak() []byte {
+	if x != nil {
+		return x.Tweak
+	}
+	return nil
+}
+
+func (x *MuSig2KeyTweak) GetIsXOnly() bool {
+	if x != nil {
+		return x.IsXOnly
+	}
+	return false
+}
+
+type MuSig2GroupSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signature hash of the group witness request the session signs.
+	SigHash []byte `protobuf:"bytes,1,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The signers of the session, sorted by their public key.
+	Signers []*MuSig2GroupSigner `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// The key tweaks the signers must apply to the aggregate key, in order, when
+	// producing their partial signatures.
+	Tweaks []*MuSig2KeyTweak `protobuf:"bytes,3,rep,name=tweaks,proto3" json:"tweaks,omitempty"`
+	// The 66-byte aggregate of the public nonces of all signers. Only set once
+	// all signers registered their nonce.
+	CombinedNonce []byte `protobuf:"bytes,4,opt,name=combined_nonce,json=combinedNonce,proto3" json:"combined_nonce,omitempty"`
+	// True if the partial signatures were combined into the group witness of the
+	// asset.
+	Complete bool `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (x *MuSig2GroupSession) Reset() {
+	*x = MuSig2GroupSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuSig2GroupSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuSig2GroupSession) ProtoMessage() {}
+
+func (x *MuSig2GroupSession) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuSig2GroupSession.ProtoReflect.Descriptor instead.
+func (*MuSig2GroupSession) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MuSig2GroupSession) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *MuSig2GroupSession) GetSigners() []*MuSig2GroupSigner {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *MuSig2GroupSession) GetTweaks() []*MuSig2KeyTweak {
+	if x != nil {
+		return x.Tweaks
+	}
+	return nil
+}
+
+func (x *MuSig2GroupSession) GetCombinedNonce() []byte {
+	if x != nil {
+		return x.CombinedNonce
+	}
+	return nil
+}
+
+func (x *MuSig2GroupSession) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type ListMuSig2GroupSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMuSig2GroupSessionsRequest) Reset() {
+	*x = ListMuSig2GroupSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMuSig2GroupSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMuSig2GroupSessionsRequest) ProtoMessage() {}
+
+func (x *ListMuSig2GroupSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMuSig2GroupSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMuSig2GroupSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{40}
+}
+
+type ListMuSig2GroupSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The MuSig2 signing sessions that are in progress.
+	Sessions []*MuSig2GroupSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListMuSig2GroupSessionsResponse) Reset() {
+	*x = ListMuSig2GroupSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMuSig2GroupSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMuSig2GroupSessionsResponse) ProtoMessage() {}
+
+func (x *ListMuSig2GroupSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMuSig2GroupSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMuSig2GroupSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListMuSig2GroupSessionsResponse) GetSessions() []*MuSig2GroupSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type StartMuSig2GroupSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signature hash of the group witness request to sign.
+	SigHash []byte `protobuf:"bytes,1,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The public keys of the signers that aggregate to the raw group key.
+	SignerKeys [][]byte `protobuf:"bytes,2,rep,name=signer_keys,json=signerKeys,proto3" json:"signer_keys,omitempty"`
+}
+
+func (x *StartMuSig2GroupSessionRequest) Reset() {
+	*x = StartMuSig2GroupSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMuSig2GroupSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMuSig2GroupSessionRequest) ProtoMessage() {}
+
+func (x *StartMuSig2GroupSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMuSig2GroupSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartMuSig2GroupSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StartMuSig2GroupSessionRequest) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *StartMuSig2GroupSessionRequest) GetSignerKeys() [][]byte {
+	if x != nil {
+		return x.SignerKeys
+	}
+	return nil
+}
+
+type StartMuSig2GroupSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The newly started session.
+	Session *MuSig2GroupSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *StartMuSig2GroupSessionResponse) Reset() {
+	*x = StartMuSig2GroupSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMuSig2GroupSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMuSig2GroupSessionResponse) ProtoMessage() {}
+
+func (x *StartMuSig2GroupSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMuSig2GroupSessionResponse.ProtoReflect.Descriptor instead.
+func (*StartMuSig2GroupSessionResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StartMuSig2GroupSessionResponse) GetSession() *MuSig2GroupSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type RegisterMuSig2GroupNonceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signature hash of the group witness request of the session.
+	SigHash []byte `protobuf:"bytes,1,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The public key of the signer the nonce belongs to.
+	SignerKey []byte `protobuf:"bytes,2,opt,name=signer_key,json=signerKey,proto3" json:"signer_key,omitempty"`
+	// The 66-byte public nonce of the signer.
+	PubNonce []byte `protobuf:"bytes,3,opt,name=pub_nonce,json=pubNonce,proto3" json:"pub_nonce,omitempty"`
+}
+
+func (x *RegisterMuSig2GroupNonceRequest) Reset() {
+	*x = RegisterMuSig2GroupNonceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterMuSig2GroupNonceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMuSig2GroupNonceRequest) ProtoMessage() {}
+
+func (x *RegisterMuSig2GroupNonceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMuSig2GroupNonceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterMuSig2GroupNonceRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RegisterMuSig2GroupNonceRequest) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *RegisterMuSig2GroupNonceRequest) GetSignerKey() []byte {
+	if x != nil {
+		return x.SignerKey
+	}
+	return nil
+}
+
+func (x *RegisterMuSig2GroupNonceRequest) GetPubNonce() []byte {
+	if x != nil {
+		return x.PubNonce
+	}
+	return nil
+}
+
+type RegisterMuSig2GroupNonceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The updated session.
+	Session *MuSig2GroupSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *RegisterMuSig2GroupNonceResponse) Reset() {
+	*x = RegisterMuSig2GroupNonceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterMuSig2GroupNonceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMuSig2GroupNonceResponse) ProtoMessage() {}
+
+func (x *RegisterMuSig2GroupNonceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMuSig2GroupNonceResponse.ProtoReflect.Descriptor instead.
+func (*RegisterMuSig2GroupNonceResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RegisterMuSig2GroupNonceResponse) GetSession() *MuSig2GroupSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type SubmitMuSig2GroupPartialSigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signature hash of the group witness request of the session.
+	SigHash []byte `protobuf:"bytes,1,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// The public key of the signer the partial signature belongs to.
+	SignerKey []byte `protobuf:"bytes,2,opt,name=signer_key,json=signerKey,proto3" json:"signer_key,omitempty"`
+	// The 32-byte partial signature of the signer.
+	PartialSig []byte `protobuf:"bytes,3,opt,name=partial_sig,json=partialSig,proto3" json:"partial_sig,omitempty"`
+}
+
+func (x *SubmitMuSig2GroupPartialSigRequest) Reset() {
+	*x = SubmitMuSig2GroupPartialSigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitMuSig2GroupPartialSigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMuSig2GroupPartialSigRequest) ProtoMessage() {}
+
+func (x *SubmitMuSig2GroupPartialSigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMuSig2GroupPartialSigRequest.ProtoReflect.Descriptor instead.
+func (*SubmitMuSig2GroupPartialSigRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SubmitMuSig2GroupPartialSigRequest) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *SubmitMuSig2GroupPartialSigRequest) GetSignerKey() []byte {
+	if x != nil {
+		return x.SignerKey
+	}
+	return nil
+}
+
+func (x *SubmitMuSig2GroupPartialSigRequest) GetPartialSig() []byte {
+	if x != nil {
+		return x.PartialSig
+	}
+	return nil
+}
+
+type SubmitMuSig2GroupPartialSigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The updated session.
+	Session *MuSig2GroupSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *SubmitMuSig2GroupPartialSigResponse) Reset() {
+	*x = SubmitMuSig2GroupPartialSigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitMuSig2GroupPartialSigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMuSig2GroupPartialSigResponse) ProtoMessage() {}
+
+func (x *SubmitMuSig2GroupPartialSigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMuSig2GroupPartialSigResponse.ProtoReflect.Descriptor instead.
+func (*SubmitMuSig2GroupPartialSigResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SubmitMuSig2GroupPartialSigResponse) GetSession() *MuSig2GroupSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type TemplateAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset to be minted. Group internal keys, external group signers, group
+	// multisig scripts and meta data by reference are not supported in
+	// templates.
+	Asset *MintAsset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// If true, then the asset will be created with a group key, which allows for
+	// future asset issuance.
+	EnableEmission bool `protobuf:"varint,2,opt,name=enable_emission,json=enableEmission,proto3" json:"enable_emission,omitempty"`
+}
+
+func (x *TemplateAsset) Reset() {
+	*x = TemplateAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateAsset) ProtoMessage() {}
+
+func (x *TemplateAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateAsset.ProtoReflect.Descriptor instead.
+func (*TemplateAsset) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TemplateAsset) GetAsset() *MintAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *TemplateAsset) GetEnableEmission() bool {
+	if x != nil {
+		return x.EnableEmission
+	}
+	return false
+}
+
+type BatchTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The ordered list of assets of the template. A group anchor must come before
+	// the assets that reference it.
+	Assets []*TemplateAsset `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The time the template was saved, as a Unix timestamp in seconds.
+	CreationTimeUnix int64 `protobuf:"varint,3,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
+}
+
+func (x *BatchTemplate) Reset() {
+	*x = BatchTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTemplate) ProtoMessage() {}
+
+func (x *BatchTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTemplate.ProtoReflect.Descriptor instead.
+func (*BatchTemplate) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchTemplate) GetAssets() []*TemplateAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *BatchTemplate) GetCreationTimeUnix() int64 {
+	if x != nil {
+		return x.CreationTimeUnix
+	}
+	return 0
+}
+
+type SaveBatchTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The template to save. The creation time is ignored.
+	Template *BatchTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *SaveBatchTemplateRequest) Reset() {
+	*x = SaveBatchTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveBatchTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveBatchTemplateRequest) ProtoMessage() {}
+
+func (x *SaveBatchTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveBatchTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveBatchTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SaveBatchTemplateRequest) GetTemplate() *BatchTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SaveBatchTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SaveBatchTemplateResponse) Reset() {
+	*x = SaveBatchTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveBatchTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveBatchTemplateResponse) ProtoMessage() {}
+
+func (x *SaveBatchTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveBatchTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveBatchTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{51}
+}
+
+type ListBatchTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBatchTemplatesRequest) Reset() {
+	*x = ListBatchTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchTemplatesRequest) ProtoMessage() {}
+
+func (x *ListBatchTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{52}
+}
+
+type ListBatchTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The saved batch templates, ordered by name.
+	Templates []*BatchTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListBatchTemplatesResponse) Reset() {
+	*x = ListBatchTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchTemplatesResponse) ProtoMessage() {}
+
+func (x *ListBatchTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListBatchTemplatesResponse) GetTemplates() []*BatchTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteBatchTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the template to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteBatchTemplateRequest) Reset() {
+	*x = DeleteBatchTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBatchTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBatchTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteBatchTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBatchTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBatchTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteBatchTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteBatchTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBatchTemplateResponse) Reset() {
+	*x = DeleteBatchTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBatchTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBatchTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteBatchTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBatchTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBatchTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{55}
+}
+
+type MintBatchTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the template to mint.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// An optional suffix that is appended to the names of all assets of the
+	// template, for example to tell apart the assets of a recurring series.
+	AssetNameSuffix string `protobuf:"bytes,2,opt,name=asset_name_suffix,json=assetNameSuffix,proto3" json:"asset_name_suffix,omitempty"`
+	// If true, then the assets currently in the batch won't be returned in the
+	// response.
+	ShortResponse bool `protobuf:"varint,3,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *MintBatchTemplateRequest) Reset() {
+	*x = MintBatchTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintBatchTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBatchTemplateRequest) ProtoMessage() {}
+
+func (x *MintBatchTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBatchTemplateRequest.ProtoReflect.Descriptor instead.
+func (*MintBatchTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MintBatchTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MintBatchTemplateRequest) GetAssetNameSuffix() string {
+	if x != nil {
+		return x.AssetNameSuffix
+	}
+	return ""
+}
+
+func (x *MintBatchTemplateRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type MintBatchTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pending batch the assets of the template were added to.
+	PendingBatch *MintingBatch `protobuf:"bytes,1,opt,name=pending_batch,json=pendingBatch,proto3" json:"pending_batch,omitempty"`
+}
+
+func (x *MintBatchTemplateResponse) Reset() {
+	*x = MintBatchTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintBatchTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBatchTemplateResponse) ProtoMessage() {}
+
+func (x *MintBatchTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBatchTemplateResponse.ProtoReflect.Descriptor instead.
+func (*MintBatchTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MintBatchTemplateResponse) GetPendingBatch() *MintingBatch {
+	if x != nil {
+		return x.PendingBatch
+	}
+	return nil
+}
+
+type GenesisPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the batch the genesis PSBT belongs to.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// Whether the genesis PSBT must be funded or signed.
+	Stage GenesisPsbtStage `protobuf:"varint,2,opt,name=stage,proto3,enum=mintrpc.GenesisPsbtStage" json:"stage,omitempty"`
+	// The genesis PSBT that must be funded or signed.
+	Psbt []byte `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (x *GenesisPsbtRequest) Reset() {
+	*x = GenesisPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisPsbtRequest) ProtoMessage() {}
+
+func (x *GenesisPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisPsbtRequest.ProtoReflect.Descriptor instead.
+func (*GenesisPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GenesisPsbtRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *GenesisPsbtRequest) GetStage() GenesisPsbtStage {
+	if x != nil {
+		return x.Stage
+	}
+	return GenesisPsbtStage_GENESIS_PSBT_STAGE_FUND
+}
+
+func (x *GenesisPsbtRequest) GetPsbt() []byte {
+	if x != nil {
+		return x.Psbt
+	}
+	return nil
+}
+
+type ListGenesisPsbtRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGenesisPsbtRequestsRequest) Reset() {
+	*x = ListGenesisPsbtRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGenesisPsbtRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenesisPsbtRequestsRequest) ProtoMessage() {}
+
+func (x *ListGenesisPsbtRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenesisPsbtRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListGenesisPsbtRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{59}
+}
+
+type ListGenesisPsbtRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The genesis PSBT requests that wait for the external wallet.
+	Requests []*GenesisPsbtRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListGenesisPsbtRequestsResponse) Reset() {
+	*x = ListGenesisPsbtRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGenesisPsbtRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenesisPsbtRequestsResponse) ProtoMessage() {}
+
+func (x *ListGenesisPsbtRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenesisPsbtRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListGenesisPsbtRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListGenesisPsbtRequestsResponse) GetRequests() []*GenesisPsbtRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type SubmitGenesisPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the batch the genesis PSBT belongs to.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The funded or signed genesis PSBT.
+	Psbt []byte `protobuf:"bytes,2,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (x *SubmitGenesisPsbtRequest) Reset() {
+	*x = SubmitGenesisPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGenesisPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGenesisPsbtRequest) ProtoMessage() {}
+
+func (x *SubmitGenesisPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGenesisPsbtRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGenesisPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SubmitGenesisPsbtRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *SubmitGenesisPsbtRequest) GetPsbt() []byte {
+	if x != nil {
+		return x.Psbt
+	}
+	return nil
+}
+
+type SubmitGenesisPsbtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitGenesisPsbtResponse) Reset() {
+	*x = SubmitGenesisPsbtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGenesisPsbtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGenesisPsbtResponse) ProtoMessage() {}
+
+func (x *SubmitGenesisPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGenesisPsbtResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGenesisPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{62}
+}
+
+type SubscribeMintEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, all past events starting with the first event of the batch with
+	// this key are replayed before any new events are delivered.
+	ReplayFromBatchKey []byte `protobuf:"bytes,1,opt,name=replay_from_batch_key,json=replayFromBatchKey,proto3" json:"replay_from_batch_key,omitempty"`
+	// If set, all past events with a block height of at least this height are
+	// replayed before any new events are delivered. If a batch key is set as
+	// well, both conditions must be met for an event to be replayed.
+	ReplayFromHeight uint32 `protobuf:"varint,2,opt,name=replay_from_height,json=replayFromHeight,proto3" json:"replay_from_height,omitempty"`
+}
+
+func (x *SubscribeMintEventsRequest) Reset() {
+	*x = SubscribeMintEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeMintEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMintEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeMintEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMintEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMintEventsRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SubscribeMintEventsRequest) GetReplayFromBatchKey() []byte {
+	if x != nil {
+		return x.ReplayFromBatchKey
+	}
+	return nil
+}
+
+func (x *SubscribeMintEventsRequest) GetReplayFromHeight() uint32 {
+	if x != nil {
+		return x.ReplayFromHeight
+	}
+	return 0
+}
+
+type MintEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID of the event. Event IDs are increasing in the order the
+	// events were emitted, which allows clients to detect events they've already
+	// received.
+	EventId uint64 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The time the event was emitted, as a unix timestamp in microseconds.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The key of the batch that transitioned to a new state.
+	BatchKey []byte `protobuf:"bytes,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The state the batch transitioned to.
+	BatchState BatchState `protobuf:"varint,4,opt,name=batch_state,json=batchState,proto3,enum=mintrpc.BatchState" json:"batch_state,omitempty"`
+	// The height of the block that confirmed the batch for events of the
+	// BATCH_STATE_CONFIRMED state, and the height the batch was created at for all
+	// other events.
+	BlockHeight uint32 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *MintEvent) Reset() {
+	*x = MintEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintEvent) ProtoMessage() {}
+
+func (x *MintEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintEvent.ProtoReflect.Descriptor instead.
+func (*MintEvent) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MintEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *MintEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *MintEvent) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *MintEvent) GetBatchState() BatchState {
+	if x != nil {
+		return x.BatchState
+	}
+	return BatchState_BATCH_STATE_UNKNOWN
+}
+
+func (x *MintEvent) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+var File_mintrpc_mint_proto protoreflect.FileDescriptor
+
+var file_mintrpc_mint_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xbb, 0x06, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x43, 0x0a, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x62, 0x79, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x6d, 0x65, 0x74, 0x61, 0x42, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x42, 0x6c, 0x6f,
+	0x62, 0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x61, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x42, 0x0a, 0x11, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x10, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x22, 0x41, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74,
+	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x36, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x52, 0x08, 0x66,
+	0x75, 0x6c, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0xca, 0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73, 0x69, 0x62, 0x6c, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x46, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0b, 0x72,
+	0x65, 0x6f, 0x72, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x4f, 0x72, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x6f, 0x72,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65,
+	0x6f, 0x72, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x6f, 0x72, 0x67, 0x73, 0x22, 0x68, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x67, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x22, 0x60, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62,
+	0x79, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x4e, 0x65, 0x77, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x73,
+	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x56, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x65, 0x65, 0x53, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x71, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x22, 0x7b, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22,
+	0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x5d, 0x0a, 0x15, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x61,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73,
+	0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24,
+	0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x53, 0x74, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6d, 0x6f,
+	0x72, 0x74, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x65, 0x64,
+	0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66,
+	0x65, 0x65, 0x73, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x53, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x65, 0x64,
+	0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x19, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x50, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xee, 0x02,
+	0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x0b, 0x72, 0x61, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x12,
+	0x2a, 0x0a, 0x11, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x77, 0x65, 0x61,
+	0x6b, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x21,
+	0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5c, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0x54, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x45, 0x0a, 0x1c, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69,
+	0x67, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x75, 0x62, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x67, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x0e, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x4b,
+	0x65, 0x79, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x61, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x12, 0x1a, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x78, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x58, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xd9, 0x01, 0x0a, 0x12, 0x4d, 0x75,
+	0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x34, 0x0a, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x53, 0x69,
+	0x67, 0x32, 0x4b, 0x65, 0x79, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x52, 0x06, 0x74, 0x77, 0x65, 0x61,
+	0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x65, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x53,
+	0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x1e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x75, 0x53, 0x69,
+	0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x73, 0x22, 0x58, 0x0a, 0x1f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x1f, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x75, 0x62,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x59, 0x0a, 0x20, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x7f, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x22, 0x5c, 0x0a, 0x23, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67,
+	0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x62, 0x0a, 0x0d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x22, 0x4e, 0x0a, 0x18, 0x53, 0x61, 0x76, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x61, 0x76, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x52, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x4d, 0x69, 0x6e, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x66,
+	0x66, 0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x19, 0x4d, 0x69,
+	0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x76, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73,
+	0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x62, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x73, 0x62, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a,
+	0x1f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x18, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x70, 0x73, 0x62, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a,
+	0x91, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x4f, 0x72, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x4f,
+	0x52, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f,
+	0x0a, 0x1b, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x47, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x4f, 0x52, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x4e,
+	0x54, 0x10, 0x03, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x44, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a,
+	0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e,
+	0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x4c, 0x49, 0x4e, 0x47,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x52, 0x4f,
+	0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x2a, 0x4c,
+	0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x50, 0x53,
+	0x42, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x50, 0x53, 0x42, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x32, 0x86, 0x12, 0x0a,
+	0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x28, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x22, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x53, 0x69,
+	0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67,
+	0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x75,
+	0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x61, 0x76, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x11, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x12, 0x21, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69,
+	0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mintrpc_mint_proto_rawDescOnce sync.Once
+	file_mintrpc_mint_proto_rawDescData = file_mintrpc_mint_proto_rawDesc
+)
+
+func file_mintrpc_mint_proto_rawDescGZIP() []byte {
+	file_mintrpc_mint_proto_rawDescOnce.Do(func() {
+		file_mintrpc_mint_proto_rawDescData = protoimpl.X.CompressGZIP(file_mintrpc_mint_proto_rawDescData)
+	})
+	return file_mintrpc_mint_proto_rawDescData
+}
+
+var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_mintrpc_mint_proto_goTypes = []interface{}{
+	(BatchReOrgState)(0),                        // 0: mintrpc.BatchReOrgState
+	(BatchState)(0),                             // 1: mintrpc.BatchState
+	(GenesisPsbtStage)(0),                       // 2: mintrpc.GenesisPsbtStage
+	(*MintAsset)(nil),                           // 3: mintrpc.MintAsset
+	(*GroupMultiSig)(nil),                       // 4: mintrpc.GroupMultiSig
+	(*MintAssetRequest)(nil),                    // 5: mintrpc.MintAssetRequest
+	(*MintAssetResponse)(nil),                   // 6: mintrpc.MintAssetResponse
+	(*MintingBatch)(nil),                        // 7: mintrpc.MintingBatch
+	(*FinalizeBatchRequest)(nil),                // 8: mintrpc.FinalizeBatchRequest
+	(*FinalizeBatchResponse)(nil),               // 9: mintrpc.FinalizeBatchResponse
+	(*PreviewBatchRequest)(nil),                 // 10: mintrpc.PreviewBatchRequest
+	(*PreviewAnchorOutput)(nil),                 // 11: mintrpc.PreviewAnchorOutput
+	(*PreviewBatchResponse)(nil),                // 12: mintrpc.PreviewBatchResponse
+	(*ValidateMintRequest)(nil),                 // 13: mintrpc.ValidateMintRequest
+	(*ValidatedAsset)(nil),                      // 14: mintrpc.ValidatedAsset
+	(*ValidateMintResponse)(nil),                // 15: mintrpc.ValidateMintResponse
+	(*CancelBatchRequest)(nil),                  // 16: mintrpc.CancelBatchRequest
+	(*CancelBatchResponse)(nil),                 // 17: mintrpc.CancelBatchResponse
+	(*CancelSeedlingRequest)(nil),               // 18: mintrpc.CancelSeedlingRequest
+	(*CancelSeedlingResponse)(nil),              // 19: mintrpc.CancelSeedlingResponse
+	(*ListBatchRequest)(nil),                    // 20: mintrpc.ListBatchRequest
+	(*ListBatchResponse)(nil),                   // 21: mintrpc.ListBatchResponse
+	(*SetBatchLabelRequest)(nil),                // 22: mintrpc.SetBatchLabelRequest
+	(*SetBatchLabelResponse)(nil),               // 23: mintrpc.SetBatchLabelResponse
+	(*ListBatchCostsRequest)(nil),               // 24: mintrpc.ListBatchCostsRequest
+	(*AssetCost)(nil),                           // 25: mintrpc.AssetCost
+	(*BatchCost)(nil),                           // 26: mintrpc.BatchCost
+	(*ListBatchCostsResponse)(nil),              // 27: mintrpc.ListBatchCostsResponse
+	(*BatchSchedule)(nil),                       // 28: mintrpc.BatchSchedule
+	(*GetBatchScheduleRequest)(nil),             // 29: mintrpc.GetBatchScheduleRequest
+	(*GetBatchScheduleResponse)(nil),            // 30: mintrpc.GetBatchScheduleResponse
+	(*UpdateBatchScheduleRequest)(nil),          // 31: mintrpc.UpdateBatchScheduleRequest
+	(*UpdateBatchScheduleResponse)(nil),         // 32: mintrpc.UpdateBatchScheduleResponse
+	(*GroupWitnessRequest)(nil),                 // 33: mintrpc.GroupWitnessRequest
+	(*ListGroupWitnessRequestsRequest)(nil),     // 34: mintrpc.ListGroupWitnessRequestsRequest
+	(*ListGroupWitnessRequestsResponse)(nil),    // 35: mintrpc.ListGroupWitnessRequestsResponse
+	(*SubmitGroupWitnessRequest)(nil),           // 36: mintrpc.SubmitGroupWitnessRequest
+	(*SubmitGroupWitnessResponse)(nil),          // 37: mintrpc.SubmitGroupWitnessResponse
+	(*SubmitGroupScriptSigRequest)(nil),         // 38: mintrpc.SubmitGroupScriptSigRequest
+	(*SubmitGroupScriptSigResponse)(nil),        // 39: mintrpc.SubmitGroupScriptSigResponse
+	(*MuSig2GroupSigner)(nil),                   // 40: mintrpc.MuSig2GroupSigner
+	(*MuSig2KeyTweak)(nil),                      // 41: mintrpc.MuSig2KeyTweak
+	(*MuSig2GroupSession)(nil),                  // 42: mintrpc.MuSig2GroupSession
+	(*ListMuSig2GroupSessionsRequest)(nil),      // 43: mintrpc.ListMuSig2GroupSessionsRequest
+	(*ListMuSig2GroupSessionsResponse)(nil),     // 44: mintrpc.ListMuSig2GroupSessionsResponse
+	(*StartMuSig2GroupSessionRequest)(nil),      // 45: mintrpc.StartMuSig2GroupSessionRequest
+	(*StartMuSig2GroupSessionResponse)(nil),     // 46: mintrpc.StartMuSig2GroupSessionResponse
+	(*RegisterMuSig2GroupNonceRequest)(nil),     // 47: mintrpc.RegisterMuSig2GroupNonceRequest
+	(*RegisterMuSig2GroupNonceResponse)(nil),    // 48: mintrpc.RegisterMuSig2GroupNonceResponse
+	(*SubmitMuSig2GroupPartialSigRequest)(nil),  // 49: mintrpc.SubmitMuSig2GroupPartialSigRequest
+	(*SubmitMuSig2GroupPartialSigResponse)(nil), // 50: mintrpc.SubmitMuSig2GroupPartialSigResponse
+	(*TemplateAsset)(nil),                       // 51: mintrpc.TemplateAsset
+	(*BatchTemplate)(nil),                       // 52: mintrpc.BatchTemplate
+	(*SaveBatchTemplateRequest)(nil),            // 53: mintrpc.SaveBatchTemplateRequest
+	(*SaveBatchTemplateResponse)(nil),           // 54: mintrpc.SaveBatchTemplateResponse
+	(*ListBatchTemplatesRequest)(nil),           // 55: mintrpc.ListBatchTemplatesRequest
+	(*ListBatchTemplatesResponse)(nil),          // 56: mintrpc.ListBatchTemplatesResponse
+	(*DeleteBatchTemplateRequest)(nil),          // 57: mintrpc.DeleteBatchTemplateRequest
+	(*DeleteBatchTemplateResponse)(nil),         // 58: mintrpc.DeleteBatchTemplateResponse
+	(*MintBatchTemplateRequest)(nil),            // 59: mintrpc.MintBatchTemplateRequest
+	(*MintBatchTemplateResponse)(nil),           // 60: mintrpc.MintBatchTemplateResponse
+	(*GenesisPsbtRequest)(nil),                  // 61: mintrpc.GenesisPsbtRequest
+	(*ListGenesisPsbtRequestsRequest)(nil),      // 62: mintrpc.ListGenesisPsbtRequestsRequest
+	(*ListGenesisPsbtRequestsResponse)(nil),     // 63: mintrpc.ListGenesisPsbtRequestsResponse
+	(*SubmitGenesisPsbtRequest)(nil),            // 64: mintrpc.SubmitGenesisPsbtRequest
+	(*SubmitGenesisPsbtResponse)(nil),           // 65: mintrpc.SubmitGenesisPsbtResponse
+	(*SubscribeMintEventsRequest)(nil),          // 66: mintrpc.SubscribeMintEventsRequest
+	(*MintEvent)(nil),                           // 67: mintrpc.MintEvent
+	(taprpc.AssetType)(0),                       // 68: taprpc.AssetType
+	(*taprpc.AssetMeta)(nil),                    // 69: taprpc.AssetMeta
+	(taprpc.AssetVersion)(0),                    // 70: taprpc.AssetVersion
+	(*taprpc.KeyDescriptor)(nil),                // 71: taprpc.KeyDescriptor
+	(*taprpc.EmissionEpoch)(nil),                // 72: taprpc.EmissionEpoch
+	(*taprpc.ScriptKey)(nil),                    // 73: taprpc.ScriptKey
+	(*taprpc.TapscriptFullTree)(nil),            // 74: taprpc.TapscriptFullTree
+}
+var file_mintrpc_mint_proto_depIdxs = []int32{
+	68, // 0: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
+	69, // 1: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
+	70, // 2: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
+	71, // 3: mintrpc.MintAsset.group_internal_key:type_name -> taprpc.KeyDescriptor
+	72, // 4: mintrpc.MintAsset.emission_schedule:type_name -> taprpc.EmissionEpoch
+	73, // 5: mintrpc.MintAsset.script_key:type_name -> taprpc.ScriptKey
+	4,  // 6: mintrpc.MintAsset.group_multisig:type_name -> mintrpc.GroupMultiSig
+	71, // 7: mintrpc.MintAsset.delegate_key:type_name -> taprpc.KeyDescriptor
+	3,  // 8: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
+	74, // 9: mintrpc.MintAssetRequest.full_tree:type_name -> taprpc.TapscriptFullTree
+	7,  // 10: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
+	3,  // 11: mintrpc.MintingBatch.assets:type_name -> mintrpc.MintAsset
+	1,  // 12: mintrpc.MintingBatch.state:type_name -> mintrpc.BatchState
+	0,  // 13: mintrpc.MintingBatch.reorg_state:type_name -> mintrpc.BatchReOrgState
+	7,  // 14: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	7,  // 15: mintrpc.PreviewBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	11, // 16: mintrpc.PreviewBatchResponse.anchor_output:type_name -> mintrpc.PreviewAnchorOutput
+	5,  // 17: mintrpc.ValidateMintRequest.assets:type_name -> mintrpc.MintAssetRequest
+	69, // 18: mintrpc.ValidatedAsset.asset_meta:type_name -> taprpc.AssetMeta
+	14, // 19: mintrpc.ValidateMintResponse.assets:type_name -> mintrpc.ValidatedAsset
+	7,  // 20: mintrpc.CancelSeedlingResponse.pending_batch:type_name -> mintrpc.MintingBatch
+	7,  // 21: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
+	1,  // 22: mintrpc.BatchCost.state:type_name -> mintrpc.BatchState
+	25, // 23: mintrpc.BatchCost.asset_costs:type_name -> mintrpc.AssetCost
+	26, // 24: mintrpc.ListBatchCostsResponse.batch_costs:type_name -> mintrpc.BatchCost
+	28, // 25: mintrpc.GetBatchScheduleResponse.schedule:type_name -> mintrpc.BatchSchedule
+	28, // 26: mintrpc.UpdateBatchScheduleRequest.schedule:type_name -> mintrpc.BatchSchedule
+	28, // 27: mintrpc.UpdateBatchScheduleResponse.schedule:type_name -> mintrpc.BatchSchedule
+	71, // 28: mintrpc.GroupWitnessRequest.raw_group_key:type_name -> taprpc.KeyDescriptor
+	4,  // 29: mintrpc.GroupWitnessRequest.multisig:type_name -> mintrpc.GroupMultiSig
+	33, // 30: mintrpc.ListGroupWitnessRequestsResponse.requests:type_name -> mintrpc.GroupWitnessRequest
+	40, // 31: mintrpc.MuSig2GroupSession.signers:type_name -> mintrpc.MuSig2GroupSigner
+	41, // 32: mintrpc.MuSig2GroupSession.tweaks:type_name -> mintrpc.MuSig2KeyTweak
+	42, // 33: mintrpc.ListMuSig2GroupSessionsResponse.sessions:type_name -> mintrpc.MuSig2GroupSession
+	42, // 34: mintrpc.StartMuSig2GroupSessionResponse.session:type_name -> mintrpc.MuSig2GroupSession
+	42, // 35: mintrpc.RegisterMuSig2GroupNonceResponse.session:type_name -> mintrpc.MuSig2GroupSession
+	42, // 36: mintrpc.SubmitMuSig2GroupPartialSigResponse.session:type_name -> mintrpc.MuSig2GroupSession
+	3,  // 37: mintrpc.TemplateAsset.asset:type_name -> mintrpc.MintAsset
+	51, // 38: mintrpc.BatchTemplate.assets:type_name -> mintrpc.TemplateAsset
+	52, // 39: mintrpc.SaveBatchTemplateRequest.template:type_name -> mintrpc.BatchTemplate
+	52, // 40: mintrpc.ListBatchTemplatesResponse.templates:type_name -> mintrpc.BatchTemplate
+	7,  // 41: mintrpc.MintBatchTemplateResponse.pending_batch:type_name -> mintrpc.MintingBatch
+	2,  // 42: mintrpc.GenesisPsbtRequest.stage:type_name -> mintrpc.GenesisPsbtStage
+	61, // 43: mintrpc.ListGenesisPsbtRequestsResponse.requests:type_name -> mintrpc.GenesisPsbtRequest
+	1,  // 44: mintrpc.MintEvent.batch_state:type_name -> mintrpc.BatchState
+	5,  // 45: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
+	8,  // 46: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
+	10, // 47: mintrpc.Mint.PreviewBatch:input_type -> mintrpc.PreviewBatchRequest
+	13, // 48: mintrpc.Mint.ValidateMint:input_type -> mintrpc.ValidateMintRequest
+	16, // 49: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
+	18, // 50: mintrpc.Mint.CancelSeedling:input_type -> mintrpc.CancelSeedlingRequest
+	20, // 51: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
+	22, // 52: mintrpc.Mint.SetBatchLabel:input_type -> mintrpc.SetBatchLabelRequest
+	24, // 53: mintrpc.Mint.ListBatchCosts:input_type -> mintrpc.ListBatchCostsRequest
+	29, // 54: mintrpc.Mint.GetBatchSchedule:input_type -> mintrpc.GetBatchScheduleRequest
+	31, // 55: mintrpc.Mint.UpdateBatchSchedule:input_type -> mintrpc.UpdateBatchScheduleRequest
+	34, // 56: mintrpc.Mint.ListGroupWitnessRequests:input_type -> mintrpc.ListGroupWitnessRequestsRequest
+	36, // 57: mintrpc.Mint.SubmitGroupWitness:input_type -> mintrpc.SubmitGroupWitnessRequest
+	38, // 58: mintrpc.Mint.SubmitGroupScriptSig:input_type -> mintrpc.SubmitGroupScriptSigRequest
+	43, // 59: mintrpc.Mint.ListMuSig2GroupSessions:input_type -> mintrpc.ListMuSig2GroupSessionsRequest
+	45, // 60: mintrpc.Mint.StartMuSig2GroupSession:input_type -> mintrpc.StartMuSig2GroupSessionRequest
+	47, // 61: mintrpc.Mint.RegisterMuSig2GroupNonce:input_type -> mintrpc.RegisterMuSig2GroupNonceRequest
+	49, // 62: mintrpc.Mint.SubmitMuSig2GroupPartialSig:input_type -> mintrpc.SubmitMuSig2GroupPartialSigRequest
+	53, // 63: mintrpc.Mint.SaveBatchTemplate:input_type -> mintrpc.SaveBatchTemplateRequest
+	55, // 64: mintrpc.Mint.ListBatchTemplates:input_type -> mintrpc.ListBatchTemplatesRequest
+	57, // 65: mintrpc.Mint.DeleteBatchTemplate:input_type -> mintrpc.DeleteBatchTemplateRequest
+	59, // 66: mintrpc.Mint.MintBatchTemplate:input_type -> mintrpc.MintBatchTemplateRequest
+	62, // 67: mintrpc.Mint.ListGenesisPsbtRequests:input_type -> mintrpc.ListGenesisPsbtRequestsRequest
+	64, // 68: mintrpc.Mint.SubmitGenesisPsbt:input_type -> mintrpc.SubmitGenesisPsbtRequest
+	66, // 69: mintrpc.Mint.SubscribeMintEvents:input_type -> mintrpc.SubscribeMintEventsRequest
+	6,  // 70: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
+	9,  // 71: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
+	12, // 72: mintrpc.Mint.PreviewBatch:output_type -> mintrpc.PreviewBatchResponse
+	15, // 73: mintrpc.Mint.ValidateMint:output_type -> mintrpc.ValidateMintResponse
+	17, // 74: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
+	19, // 75: mintrpc.Mint.CancelSeedling:output_type -> mintrpc.CancelSeedlingResponse
+	21, // 76: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
+	23, // 77: mintrpc.Mint.SetBatchLabel:output_type -> mintrpc.SetBatchLabelResponse
+	27, // 78: mintrpc.Mint.ListBatchCosts:output_type -> mintrpc.ListBatchCostsResponse
+	30, // 79: mintrpc.Mint.GetBatchSchedule:output_type -> mintrpc.GetBatchScheduleResponse
+	32, // 80: mintrpc.Mint.UpdateBatchSchedule:output_type -> mintrpc.UpdateBatchScheduleResponse
+	35, // 81: mintrpc.Mint.ListGroupWitnessRequests:output_type -> mintrpc.ListGroupWitnessRequestsResponse
+	37, // 82: mintrpc.Mint.SubmitGroupWitness:output_type -> mintrpc.SubmitGroupWitnessResponse
+	39, // 83: mintrpc.Mint.SubmitGroupScriptSig:output_type -> mintrpc.SubmitGroupScriptSigResponse
+	44, // 84: mintrpc.Mint.ListMuSig2GroupSessions:output_type -> mintrpc.ListMuSig2GroupSessionsResponse
+	46, // 85: mintrpc.Mint.StartMuSig2GroupSession:output_type -> mintrpc.StartMuSig2GroupSessionResponse
+	48, // 86: mintrpc.Mint.RegisterMuSig2GroupNonce:output_type -> mintrpc.RegisterMuSig2GroupNonceResponse
+	50, // 87: mintrpc.Mint.SubmitMuSig2GroupPartialSig:output_type -> mintrpc.SubmitMuSig2GroupPartialSigResponse
+	54, // 88: mintrpc.Mint.SaveBatchTemplate:output_type -> mintrpc.SaveBatchTemplateResponse
+	56, // 89: mintrpc.Mint.ListBatchTemplates:output_type -> mintrpc.ListBatchTemplatesResponse
+	58, // 90: mintrpc.Mint.DeleteBatchTemplate:output_type -> mintrpc.DeleteBatchTemplateResponse
+	60, // 91: mintrpc.Mint.MintBatchTemplate:output_type -> mintrpc.MintBatchTemplateResponse
+	63, // 92: mintrpc.Mint.ListGenesisPsbtRequests:output_type -> mintrpc.ListGenesisPsbtRequestsResponse
+	65, // 93: mintrpc.Mint.SubmitGenesisPsbt:output_type -> mintrpc.SubmitGenesisPsbtResponse
+	67, // 94: mintrpc.Mint.SubscribeMintEvents:output_type -> mintrpc.MintEvent
+	70, // [70:95] is the sub-list for method output_type
+	45, // [45:70] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_mintrpc_mint_proto_init() }
+func file_mintrpc_mint_proto_init() {
+	if File_mintrpc_mint_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mintrpc_mint_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMultiSig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_mintrpc_mint_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MintAssetResponse); i {
 			case 0:
 				return &v.state
@@ -905,8 +5209,368 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
-		file_mintrpc_mint_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintingBatch); i {
+		file_mintrpc_mint_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintingBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizeBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizeBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewAnchorOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateMintRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatedAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateMintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSeedlingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSeedlingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBatchLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBatchLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchCostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetCost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchCostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupWitnessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupWitnessRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupWitnessRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGroupWitnessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGroupWitnessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -917,8 +5581,8 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
-		file_mintrpc_mint_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchRequest); i {
+		file_mintrpc_mint_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGroupScriptSigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -929,8 +5593,8 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
-		file_mintrpc_mint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchResponse); i {
+		file_mintrpc_mint_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGroupScriptSigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -941,8 +5605,8 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
-		file_mintrpc_mint_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchRequest); i {
+		file_mintrpc_mint_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuSig2GroupSigner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -953,8 +5617,8 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
-		file_mintrpc_mint_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchResponse); i {
+		file_mintrpc_mint_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuSig2KeyTweak); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -965,8 +5629,8 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
-		file_mintrpc_mint_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBatchRequest); i {
+		file_mintrpc_mint_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuSig2GroupSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -977,8 +5641,296 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
-		file_mintrpc_mint_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBatchResponse); i {
+		file_mintrpc_mint_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMuSig2GroupSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMuSig2GroupSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMuSig2GroupSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMuSig2GroupSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMuSig2GroupNonceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMuSig2GroupNonceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitMuSig2GroupPartialSigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitMuSig2GroupPartialSigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveBatchTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveBatchTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBatchTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBatchTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintBatchTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintBatchTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGenesisPsbtRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGenesisPsbtRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGenesisPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGenesisPsbtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMintEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -990,17 +5942,25 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 	}
-	file_mintrpc_mint_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_mintrpc_mint_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*ListBatchRequest_BatchKey)(nil),
 		(*ListBatchRequest_BatchKeyStr)(nil),
 	}
+	file_mintrpc_mint_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*SetBatchLabelRequest_BatchKey)(nil),
+		(*SetBatchLabelRequest_BatchKeyStr)(nil),
+	}
+	file_mintrpc_mint_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*ListBatchCostsRequest_BatchKey)(nil),
+		(*ListBatchCostsRequest_BatchKeyStr)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mintrpc_mint_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,13 +28,117 @@ type MintClient interface {
 	// tapcli: `assets mint finalize`
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error)
+	// tapcli: `assets mint preview`
+	// PreviewBatch will estimate the size and fee of the minting transaction of
+	// the current pending batch, without finalizing the batch.
+	PreviewBatch(ctx context.Context, in *PreviewBatchRequest, opts ...grpc.CallOption) (*PreviewBatchResponse, error)
+	// tapcli: `assets mint validate`
+	// ValidateMint will run all validation of a set of assets to mint as if they
+	// were added to the current pending batch, including the meta data checks,
+	// the group key verification and the construction of the asset commitment,
+	// without persisting anything. The IDs the assets would be minted with are
+	// returned.
+	ValidateMint(ctx context.Context, in *ValidateMintRequest, opts ...grpc.CallOption) (*ValidateMintResponse, error)
 	// tapcli: `assets mint cancel`
 	// CancelBatch will attempt to cancel the current pending batch.
 	CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error)
+	// tapcli: `assets mint remove`
+	// CancelSeedling will attempt to remove a single asset from the current
+	// pending batch, without cancelling the rest of the batch. If the asset is
+	// the last one in the batch, the whole batch is cancelled.
+	CancelSeedling(ctx context.Context, in *CancelSeedlingRequest, opts ...grpc.CallOption) (*CancelSeedlingResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(ctx context.Context, in *ListBatchRequest, opts ...grpc.CallOption) (*ListBatchResponse, error)
+	// tapcli: `assets mint label`
+	// SetBatchLabel sets the user-defined label of a batch, replacing any
+	// previous label. The label can be changed in any state of the batch.
+	SetBatchLabel(ctx context.Context, in *SetBatchLabelRequest, opts ...grpc.CallOption) (*SetBatchLabelResponse, error)
+	// tapcli: `assets mint costs`
+	// ListBatchCosts reports the on-chain cost of the minting transaction of
+	// each batch that was signed, including the chain fees, the virtual size and
+	// the share of the fees attributed to each asset of the batch.
+	ListBatchCosts(ctx context.Context, in *ListBatchCostsRequest, opts ...grpc.CallOption) (*ListBatchCostsResponse, error)
+	// tapcli: `assets mint schedule`
+	// GetBatchSchedule returns the schedule that governs when the pending batch
+	// is finalized automatically.
+	GetBatchSchedule(ctx context.Context, in *GetBatchScheduleRequest, opts ...grpc.CallOption) (*GetBatchScheduleResponse, error)
+	// tapcli: `assets mint schedule update`
+	// UpdateBatchSchedule replaces the schedule that governs when the pending
+	// batch is finalized automatically. If the pending batch already satisfies
+	// the new schedule, it is finalized immediately.
+	UpdateBatchSchedule(ctx context.Context, in *UpdateBatchScheduleRequest, opts ...grpc.CallOption) (*UpdateBatchScheduleResponse, error)
+	// tapcli: `assets mint witnesses`
+	// ListGroupWitnessRequests lists the signing requests for the group witnesses
+	// of assets in a finalized batch whose group key is held by an external
+	// signer. The batch is only broadcast once a signature was submitted for
+	// each of its requests.
+	ListGroupWitnessRequests(ctx context.Context, in *ListGroupWitnessRequestsRequest, opts ...grpc.CallOption) (*ListGroupWitnessRequestsResponse, error)
+	// tapcli: `assets mint witnesses submit`
+	// SubmitGroupWitness submits the signature of an external signer for a
+	// pending group witness request. The signature is only accepted if it is
+	// valid for the tweaked group key of the request.
+	SubmitGroupWitness(ctx context.Context, in *SubmitGroupWitnessRequest, opts ...grpc.CallOption) (*SubmitGroupWitnessResponse, error)
+	// tapcli: `assets mint witnesses scriptsig`
+	// SubmitGroupScriptSig submits the signature of one of the multisig keys for
+	// a pending group witness request of an asset whose group key commits to a
+	// multisig script. The signature is only accepted if it is valid for the
+	// signer key. Once enough signatures were submitted, they are assembled into
+	// the group witness of the asset.
+	SubmitGroupScriptSig(ctx context.Context, in *SubmitGroupScriptSigRequest, opts ...grpc.CallOption) (*SubmitGroupScriptSigResponse, error)
+	// tapcli: `assets mint witnesses musig2`
+	// ListMuSig2GroupSessions lists the MuSig2 signing sessions that are in
+	// progress for group witness requests of assets whose group key is the
+	// aggregate of multiple signer keys.
+	ListMuSig2GroupSessions(ctx context.Context, in *ListMuSig2GroupSessionsRequest, opts ...grpc.CallOption) (*ListMuSig2GroupSessionsResponse, error)
+	// tapcli: `assets mint witnesses musig2 start`
+	// StartMuSig2GroupSession starts a MuSig2 signing session for a pending group
+	// witness request. The signer keys must aggregate to the raw group key of the
+	// request. The session collects the nonces and partial signatures of all
+	// signers and combines them into the group witness.
+	StartMuSig2GroupSession(ctx context.Context, in *StartMuSig2GroupSessionRequest, opts ...grpc.CallOption) (*StartMuSig2GroupSessionResponse, error)
+	// tapcli: `assets mint witnesses musig2 nonce`
+	// RegisterMuSig2GroupNonce registers the public nonce of a signer of a MuSig2
+	// group witness session. Once the nonces of all signers are registered, the
+	// combined nonce of the session can be used for signing.
+	RegisterMuSig2GroupNonce(ctx context.Context, in *RegisterMuSig2GroupNonceRequest, opts ...grpc.CallOption) (*RegisterMuSig2GroupNonceResponse, error)
+	// tapcli: `assets mint witnesses musig2 partialsig`
+	// SubmitMuSig2GroupPartialSig submits the partial signature of a signer of a
+	// MuSig2 group witness session. Once the partial signatures of all signers
+	// are received, they are combined into the group witness of the asset.
+	SubmitMuSig2GroupPartialSig(ctx context.Context, in *SubmitMuSig2GroupPartialSigRequest, opts ...grpc.CallOption) (*SubmitMuSig2GroupPartialSigResponse, error)
+	// tapcli: `assets mint templates save`
+	// SaveBatchTemplate saves a named template of a set of assets that can later
+	// be minted with a single call to MintBatchTemplate. An existing template
+	// with the same name is replaced.
+	SaveBatchTemplate(ctx context.Context, in *SaveBatchTemplateRequest, opts ...grpc.CallOption) (*SaveBatchTemplateResponse, error)
+	// tapcli: `assets mint templates list`
+	// ListBatchTemplates lists all saved batch templates.
+	ListBatchTemplates(ctx context.Context, in *ListBatchTemplatesRequest, opts ...grpc.CallOption) (*ListBatchTemplatesResponse, error)
+	// tapcli: `assets mint templates delete`
+	// DeleteBatchTemplate deletes a saved batch template.
+	DeleteBatchTemplate(ctx context.Context, in *DeleteBatchTemplateRequest, opts ...grpc.CallOption) (*DeleteBatchTemplateResponse, error)
+	// tapcli: `assets mint templates mint`
+	// MintBatchTemplate adds all assets of a saved batch template to the pending
+	// batch. Either all assets of the template are added, or none of them.
+	MintBatchTemplate(ctx context.Context, in *MintBatchTemplateRequest, opts ...grpc.CallOption) (*MintBatchTemplateResponse, error)
+	// tapcli: `assets mint funding`
+	// ListGenesisPsbtRequests lists the genesis PSBTs of finalized batches that
+	// are funded by an external wallet and wait to be funded or signed.
+	ListGenesisPsbtRequests(ctx context.Context, in *ListGenesisPsbtRequestsRequest, opts ...grpc.CallOption) (*ListGenesisPsbtRequestsResponse, error)
+	// tapcli: `assets mint funding submit`
+	// SubmitGenesisPsbt submits the genesis PSBT of an externally funded batch
+	// back to the daemon. The PSBT is first submitted funded but unsigned, then
+	// once more signed after the daemon added the final minting output to it.
+	// The batch is broadcast once the signed PSBT was accepted.
+	SubmitGenesisPsbt(ctx context.Context, in *SubmitGenesisPsbtRequest, opts ...grpc.CallOption) (*SubmitGenesisPsbtResponse, error)
+	// tapcli: `assets mint events`
+	// SubscribeMintEvents registers a subscription to the state transitions of
+	// all minting batches. Past transitions can be replayed starting from a given
+	// batch or block height before any new transitions are delivered, so a
+	// restarted client doesn't miss any of them.
+	SubscribeMintEvents(ctx context.Context, in *SubscribeMintEventsRequest, opts ...grpc.CallOption) (Mint_SubscribeMintEventsClient, error)
 }
 
 type mintClient struct {
@@ -63,6 +167,24 @@ func (c *mintClient) FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest
 	return out, nil
 }
 
+func (c *mintClient) PreviewBatch(ctx context.Context, in *PreviewBatchRequest, opts ...grpc.CallOption) (*PreviewBatchResponse, error) {
+	out := new(PreviewBatchResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/PreviewBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ValidateMint(ctx context.Context, in *ValidateMintRequest, opts ...grpc.CallOption) (*ValidateMintResponse, error) {
+	out := new(ValidateMintResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ValidateMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error) {
 	out := new(CancelBatchResponse)
 	err := c.cc.Invoke(ctx, "/mintrpc.Mint/CancelBatch", in, out, opts...)
@@ -72,6 +194,15 @@ func (c *mintClient) CancelBatch(ctx context.Context, in *CancelBatchRequest, op
 	return out, nil
 }
 
+func (c *mintClient) CancelSeedling(ctx context.Context, in *CancelSeedlingRequest, opts ...grpc.CallOption) (*CancelSeedlingResponse, error) {
+	out := new(CancelSeedlingResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/CancelSeedling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) ListBatches(ctx context.Context, in *ListBatchRequest, opts ...grpc.CallOption) (*ListBatchResponse, error) {
 	out := new(ListBatchResponse)
 	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListBatches", in, out, opts...)
@@ -81,6 +212,191 @@ func (c *mintClient) ListBatches(ctx context.Context, in *ListBatchRequest, opts
 	return out, nil
 }
 
+func (c *mintClient) SetBatchLabel(ctx context.Context, in *SetBatchLabelRequest, opts ...grpc.CallOption) (*SetBatchLabelResponse, error) {
+	out := new(SetBatchLabelResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SetBatchLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ListBatchCosts(ctx context.Context, in *ListBatchCostsRequest, opts ...grpc.CallOption) (*ListBatchCostsResponse, error) {
+	out := new(ListBatchCostsResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListBatchCosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) GetBatchSchedule(ctx context.Context, in *GetBatchScheduleRequest, opts ...grpc.CallOption) (*GetBatchScheduleResponse, error) {
+	out := new(GetBatchScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/GetBatchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) UpdateBatchSchedule(ctx context.Context, in *UpdateBatchScheduleRequest, opts ...grpc.CallOption) (*UpdateBatchScheduleResponse, error) {
+	out := new(UpdateBatchScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/UpdateBatchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ListGroupWitnessRequests(ctx context.Context, in *ListGroupWitnessRequestsRequest, opts ...grpc.CallOption) (*ListGroupWitnessRequestsResponse, error) {
+	out := new(ListGroupWitnessRequestsResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListGroupWitnessRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SubmitGroupWitness(ctx context.Context, in *SubmitGroupWitnessRequest, opts ...grpc.CallOption) (*SubmitGroupWitnessResponse, error) {
+	out := new(SubmitGroupWitnessResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SubmitGroupWitness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SubmitGroupScriptSig(ctx context.Context, in *SubmitGroupScriptSigRequest, opts ...grpc.CallOption) (*SubmitGroupScriptSigResponse, error) {
+	out := new(SubmitGroupScriptSigResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SubmitGroupScriptSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ListMuSig2GroupSessions(ctx context.Context, in *ListMuSig2GroupSessionsRequest, opts ...grpc.CallOption) (*ListMuSig2GroupSessionsResponse, error) {
+	out := new(ListMuSig2GroupSessionsResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListMuSig2GroupSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) StartMuSig2GroupSession(ctx context.Context, in *StartMuSig2GroupSessionRequest, opts ...grpc.CallOption) (*StartMuSig2GroupSessionResponse, error) {
+	out := new(StartMuSig2GroupSessionResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/StartMuSig2GroupSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) RegisterMuSig2GroupNonce(ctx context.Context, in *RegisterMuSig2GroupNonceRequest, opts ...grpc.CallOption) (*RegisterMuSig2GroupNonceResponse, error) {
+	out := new(RegisterMuSig2GroupNonceResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/RegisterMuSig2GroupNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SubmitMuSig2GroupPartialSig(ctx context.Context, in *SubmitMuSig2GroupPartialSigRequest, opts ...grpc.CallOption) (*SubmitMuSig2GroupPartialSigResponse, error) {
+	out := new(SubmitMuSig2GroupPartialSigResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SubmitMuSig2GroupPartialSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SaveBatchTemplate(ctx context.Context, in *SaveBatchTemplateRequest, opts ...grpc.CallOption) (*SaveBatchTemplateResponse, error) {
+	out := new(SaveBatchTemplateResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SaveBatchTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ListBatchTemplates(ctx context.Context, in *ListBatchTemplatesRequest, opts ...grpc.CallOption) (*ListBatchTemplatesResponse, error) {
+	out := new(ListBatchTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListBatchTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) DeleteBatchTemplate(ctx context.Context, in *DeleteBatchTemplateRequest, opts ...grpc.CallOption) (*DeleteBatchTemplateResponse, error) {
+	out := new(DeleteBatchTemplateResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/DeleteBatchTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) MintBatchTemplate(ctx context.Context, in *MintBatchTemplateRequest, opts ...grpc.CallOption) (*MintBatchTemplateResponse, error) {
+	out := new(MintBatchTemplateResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/MintBatchTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ListGenesisPsbtRequests(ctx context.Context, in *ListGenesisPsbtRequestsRequest, opts ...grpc.CallOption) (*ListGenesisPsbtRequestsResponse, error) {
+	out := new(ListGenesisPsbtRequestsResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListGenesisPsbtRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SubmitGenesisPsbt(ctx context.Context, in *SubmitGenesisPsbtRequest, opts ...grpc.CallOption) (*SubmitGenesisPsbtResponse, error) {
+	out := new(SubmitGenesisPsbtResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SubmitGenesisPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SubscribeMintEvents(ctx context.Context, in *SubscribeMintEventsRequest, opts ...grpc.CallOption) (Mint_SubscribeMintEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Mint_ServiceDesc.Streams[0], "/mintrpc.Mint/SubscribeMintEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mintSubscribeMintEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mint_SubscribeMintEventsClient interface {
+	Recv() (*MintEvent, error)
+	grpc.ClientStream
+}
+
+type mintSubscribeMintEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mintSubscribeMintEventsClient) Recv() (*MintEvent, error) {
+	m := new(MintEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MintServer is the server API for Mint service.
 // All implementations must embed UnimplementedMintServer
 // for forward compatibility
@@ -95,13 +411,117 @@ type MintServer interface {
 	// tapcli: `assets mint finalize`
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error)
+	// tapcli: `assets mint preview`
+	// PreviewBatch will estimate the size and fee of the minting transaction of
+	// the current pending batch, without finalizing the batch.
+	PreviewBatch(context.Context, *PreviewBatchRequest) (*PreviewBatchResponse, error)
+	// tapcli: `assets mint validate`
+	// ValidateMint will run all validation of a set of assets to mint as if they
+	// were added to the current pending batch, including the meta data checks,
+	// the group key verification and the construction of the asset commitment,
+	// without persisting anything. The IDs the assets would be minted with are
+	// returned.
+	ValidateMint(context.Context, *ValidateMintRequest) (*ValidateMintResponse, error)
 	// tapcli: `assets mint cancel`
 	// CancelBatch will attempt to cancel the current pending batch.
 	CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error)
+	// tapcli: `assets mint remove`
+	// CancelSeedling will attempt to remove a single asset from the current
+	// pending batch, without cancelling the rest of the batch. If the asset is
+	// the last one in the batch, the whole batch is cancelled.
+	CancelSeedling(context.Context, *CancelSeedlingRequest) (*CancelSeedlingResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error)
+	// tapcli: `assets mint label`
+	// SetBatchLabel sets the user-defined label of a batch, replacing any
+	// previous label. The label can be changed in any state of the batch.
+	SetBatchLabel(context.Context, *SetBatchLabelRequest) (*SetBatchLabelResponse, error)
+	// tapcli: `assets mint costs`
+	// ListBatchCosts reports the on-chain cost of the minting transaction of
+	// each batch that was signed, including the chain fees, the virtual size and
+	// the share of the fees attributed to each asset of the batch.
+	ListBatchCosts(context.Context, *ListBatchCostsRequest) (*ListBatchCostsResponse, error)
+	// tapcli: `assets mint schedule`
+	// GetBatchSchedule returns the schedule that governs when the pending batch
+	// is finalized automatically.
+	GetBatchSchedule(context.Context, *GetBatchScheduleRequest) (*GetBatchScheduleResponse, error)
+	// tapcli: `assets mint schedule update`
+	// UpdateBatchSchedule replaces the schedule that governs when the pending
+	// batch is finalized automatically. If the pending batch already satisfies
+	// the new schedule, it is finalized immediately.
+	UpdateBatchSchedule(context.Context, *UpdateBatchScheduleRequest) (*UpdateBatchScheduleResponse, error)
+	// tapcli: `assets mint witnesses`
+	// ListGroupWitnessRequests lists the signing requests for the group witnesses
+	// of assets in a finalized batch whose group key is held by an external
+	// signer. The batch is only broadcast once a signature was submitted for
+	// each of its requests.
+	ListGroupWitnessRequests(context.Context, *ListGroupWitnessRequestsRequest) (*ListGroupWitnessRequestsResponse, error)
+	// tapcli: `assets mint witnesses submit`
+	// SubmitGroupWitness submits the signature of an external signer for a
+	// pending group witness request. The signature is only accepted if it is
+	// valid for the tweaked group key of the request.
+	SubmitGroupWitness(context.Context, *SubmitGroupWitnessRequest) (*SubmitGroupWitnessResponse, error)
+	// tapcli: `assets mint witnesses scriptsig`
+	// SubmitGroupScriptSig submits the signature of one of the multisig keys for
+	// a pending group witness request of an asset whose group key commits to a
+	// multisig script. The signature is only accepted if it is valid for the
+	// signer key. Once enough signatures were submitted, they are assembled into
+	// the group witness of the asset.
+	SubmitGroupScriptSig(context.Context, *SubmitGroupScriptSigRequest) (*SubmitGroupScriptSigResponse, error)
+	// tapcli: `assets mint witnesses musig2`
+	// ListMuSig2GroupSessions lists the MuSig2 signing sessions that are in
+	// progress for group witness requests of assets whose group key is the
+	// aggregate of multiple signer keys.
+	ListMuSig2GroupSessions(context.Context, *ListMuSig2GroupSessionsRequest) (*ListMuSig2GroupSessionsResponse, error)
+	// tapcli: `assets mint witnesses musig2 start`
+	// StartMuSig2GroupSession starts a MuSig2 signing session for a pending group
+	// witness request. The signer keys must aggregate to the raw group key of the
+	// request. The session collects the nonces and partial signatures of all
+	// signers and combines them into the group witness.
+	StartMuSig2GroupSession(context.Context, *StartMuSig2GroupSessionRequest) (*StartMuSig2GroupSessionResponse, error)
+	// tapcli: `assets mint witnesses musig2 nonce`
+	// RegisterMuSig2GroupNonce registers the public nonce of a signer of a MuSig2
+	// group witness session. Once the nonces of all signers are registered, the
+	// combined nonce of the session can be used for signing.
+	RegisterMuSig2GroupNonce(context.Context, *RegisterMuSig2GroupNonceRequest) (*RegisterMuSig2GroupNonceResponse, error)
+	// tapcli: `assets mint witnesses musig2 partialsig`
+	// SubmitMuSig2GroupPartialSig submits the partial signature of a signer of a
+	// MuSig2 group witness session. Once the partial signatures of all signers
+	// are received, they are combined into the group witness of the asset.
+	SubmitMuSig2GroupPartialSig(context.Context, *SubmitMuSig2GroupPartialSigRequest) (*SubmitMuSig2GroupPartialSigResponse, error)
+	// tapcli: `assets mint templates save`
+	// SaveBatchTemplate saves a named template of a set of assets that can later
+	// be minted with a single call to MintBatchTemplate. An existing template
+	// with the same name is replaced.
+	SaveBatchTemplate(context.Context, *SaveBatchTemplateRequest) (*SaveBatchTemplateResponse, error)
+	// tapcli: `assets mint templates list`
+	// ListBatchTemplates lists all saved batch templates.
+	ListBatchTemplates(context.Context, *ListBatchTemplatesRequest) (*ListBatchTemplatesResponse, error)
+	// tapcli: `assets mint templates delete`
+	// DeleteBatchTemplate deletes a saved batch template.
+	DeleteBatchTemplate(context.Context, *DeleteBatchTemplateRequest) (*DeleteBatchTemplateResponse, error)
+	// tapcli: `assets mint templates mint`
+	// MintBatchTemplate adds all assets of a saved batch template to the pending
+	// batch. Either all assets of the template are added, or none of them.
+	MintBatchTemplate(context.Context, *MintBatchTemplateRequest) (*MintBatchTemplateResponse, error)
+	// tapcli: `assets mint funding`
+	// ListGenesisPsbtRequests lists the genesis PSBTs of finalized batches that
+	// are funded by an external wallet and wait to be funded or signed.
+	ListGenesisPsbtRequests(context.Context, *ListGenesisPsbtRequestsRequest) (*ListGenesisPsbtRequestsResponse, error)
+	// tapcli: `assets mint funding submit`
+	// SubmitGenesisPsbt submits the genesis PSBT of an externally funded batch
+	// back to the daemon. The PSBT is first submitted funded but unsigned, then
+	// once more signed after the daemon added the final minting output to it.
+	// The batch is broadcast once the signed PSBT was accepted.
+	SubmitGenesisPsbt(context.Context, *SubmitGenesisPsbtRequest) (*SubmitGenesisPsbtResponse, error)
+	// tapcli: `assets mint events`
+	// SubscribeMintEvents registers a subscription to the state transitions of
+	// all minting batches. Past transitions can be replayed starting from a given
+	// batch or block height before any new transitions are delivered, so a
+	// restarted client doesn't miss any of them.
+	SubscribeMintEvents(*SubscribeMintEventsRequest, Mint_SubscribeMintEventsServer) error
 	mustEmbedUnimplementedMintServer()
 }
 
@@ -115,12 +535,75 @@ func (UnimplementedMintServer) MintAsset(context.Context, *MintAssetRequest) (*M
 func (UnimplementedMintServer) FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeBatch not implemented")
 }
+func (UnimplementedMintServer) PreviewBatch(context.Context, *PreviewBatchRequest) (*PreviewBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewBatch not implemented")
+}
+func (UnimplementedMintServer) ValidateMint(context.Context, *ValidateMintRequest) (*ValidateMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMint not implemented")
+}
 func (UnimplementedMintServer) CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBatch not implemented")
 }
+func (UnimplementedMintServer) CancelSeedling(context.Context, *CancelSeedlingRequest) (*CancelSeedlingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSeedling not implemented")
+}
 func (UnimplementedMintServer) ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
 }
+func (UnimplementedMintServer) SetBatchLabel(context.Context, *SetBatchLabelRequest) (*SetBatchLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBatchLabel not implemented")
+}
+func (UnimplementedMintServer) ListBatchCosts(context.Context, *ListBatchCostsRequest) (*ListBatchCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchCosts not implemented")
+}
+func (UnimplementedMintServer) GetBatchSchedule(context.Context, *GetBatchScheduleRequest) (*GetBatchScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchSchedule not implemented")
+}
+func (UnimplementedMintServer) UpdateBatchSchedule(context.Context, *UpdateBatchScheduleRequest) (*UpdateBatchScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatchSchedule not implemented")
+}
+func (UnimplementedMintServer) ListGroupWitnessRequests(context.Context, *ListGroupWitnessRequestsRequest) (*ListGroupWitnessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupWitnessRequests not implemented")
+}
+func (UnimplementedMintServer) SubmitGroupWitness(context.Context, *SubmitGroupWitnessRequest) (*SubmitGroupWitnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGroupWitness not implemented")
+}
+func (UnimplementedMintServer) SubmitGroupScriptSig(context.Context, *SubmitGroupScriptSigRequest) (*SubmitGroupScriptSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGroupScriptSig not implemented")
+}
+func (UnimplementedMintServer) ListMuSig2GroupSessions(context.Context, *ListMuSig2GroupSessionsRequest) (*ListMuSig2GroupSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMuSig2GroupSessions not implemented")
+}
+func (UnimplementedMintServer) StartMuSig2GroupSession(context.Context, *StartMuSig2GroupSessionRequest) (*StartMuSig2GroupSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMuSig2GroupSession not implemented")
+}
+func (UnimplementedMintServer) RegisterMuSig2GroupNonce(context.Context, *RegisterMuSig2GroupNonceRequest) (*RegisterMuSig2GroupNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMuSig2GroupNonce not implemented")
+}
+func (UnimplementedMintServer) SubmitMuSig2GroupPartialSig(context.Context, *SubmitMuSig2GroupPartialSigRequest) (*SubmitMuSig2GroupPartialSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMuSig2GroupPartialSig not implemented")
+}
+func (UnimplementedMintServer) SaveBatchTemplate(context.Context, *SaveBatchTemplateRequest) (*SaveBatchTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBatchTemplate not implemented")
+}
+func (UnimplementedMintServer) ListBatchTemplates(context.Context, *ListBatchTemplatesRequest) (*ListBatchTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchTemplates not implemented")
+}
+func (UnimplementedMintServer) DeleteBatchTemplate(context.Context, *DeleteBatchTemplateRequest) (*DeleteBatchTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBatchTemplate not implemented")
+}
+func (UnimplementedMintServer) MintBatchTemplate(context.Context, *MintBatchTemplateRequest) (*MintBatchTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBatchTemplate not implemented")
+}
+func (UnimplementedMintServer) ListGenesisPsbtRequests(context.Context, *ListGenesisPsbtRequestsRequest) (*ListGenesisPsbtRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGenesisPsbtRequests not implemented")
+}
+func (UnimplementedMintServer) SubmitGenesisPsbt(context.Context, *SubmitGenesisPsbtRequest) (*SubmitGenesisPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGenesisPsbt not implemented")
+}
+func (UnimplementedMintServer) SubscribeMintEvents(*SubscribeMintEventsRequest, Mint_SubscribeMintEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMintEvents not implemented")
+}
 func (UnimplementedMintServer) mustEmbedUnimplementedMintServer() {}
 
 // UnsafeMintServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +653,42 @@ func _Mint_FinalizeBatch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_PreviewBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).PreviewBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/PreviewBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).PreviewBatch(ctx, req.(*PreviewBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ValidateMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ValidateMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ValidateMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ValidateMint(ctx, req.(*ValidateMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_CancelBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelBatchRequest)
 	if err := dec(in); err != nil {
@@ -188,6 +707,24 @@ func _Mint_CancelBatch_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_CancelSeedling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSeedlingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).CancelSeedling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/CancelSeedling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).CancelSeedling(ctx, req.(*CancelSeedlingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBatchRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +743,333 @@ func _Mint_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_SetBatchLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBatchLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SetBatchLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SetBatchLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SetBatchLabel(ctx, req.(*SetBatchLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ListBatchCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListBatchCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListBatchCosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListBatchCosts(ctx, req.(*ListBatchCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_GetBatchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).GetBatchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/GetBatchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).GetBatchSchedule(ctx, req.(*GetBatchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_UpdateBatchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBatchScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).UpdateBatchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/UpdateBatchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).UpdateBatchSchedule(ctx, req.(*UpdateBatchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ListGroupWitnessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupWitnessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListGroupWitnessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListGroupWitnessRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListGroupWitnessRequests(ctx, req.(*ListGroupWitnessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SubmitGroupWitness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGroupWitnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SubmitGroupWitness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SubmitGroupWitness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SubmitGroupWitness(ctx, req.(*SubmitGroupWitnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SubmitGroupScriptSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGroupScriptSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SubmitGroupScriptSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SubmitGroupScriptSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SubmitGroupScriptSig(ctx, req.(*SubmitGroupScriptSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ListMuSig2GroupSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMuSig2GroupSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListMuSig2GroupSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListMuSig2GroupSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListMuSig2GroupSessions(ctx, req.(*ListMuSig2GroupSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_StartMuSig2GroupSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMuSig2GroupSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).StartMuSig2GroupSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/StartMuSig2GroupSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).StartMuSig2GroupSession(ctx, req.(*StartMuSig2GroupSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_RegisterMuSig2GroupNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMuSig2GroupNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).RegisterMuSig2GroupNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/RegisterMuSig2GroupNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).RegisterMuSig2GroupNonce(ctx, req.(*RegisterMuSig2GroupNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SubmitMuSig2GroupPartialSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitMuSig2GroupPartialSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SubmitMuSig2GroupPartialSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SubmitMuSig2GroupPartialSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SubmitMuSig2GroupPartialSig(ctx, req.(*SubmitMuSig2GroupPartialSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SaveBatchTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveBatchTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SaveBatchTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SaveBatchTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SaveBatchTemplate(ctx, req.(*SaveBatchTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ListBatchTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListBatchTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListBatchTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListBatchTemplates(ctx, req.(*ListBatchTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_DeleteBatchTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBatchTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).DeleteBatchTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/DeleteBatchTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).DeleteBatchTemplate(ctx, req.(*DeleteBatchTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_MintBatchTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintBatchTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).MintBatchTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/MintBatchTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).MintBatchTemplate(ctx, req.(*MintBatchTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ListGenesisPsbtRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGenesisPsbtRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListGenesisPsbtRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListGenesisPsbtRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListGenesisPsbtRequests(ctx, req.(*ListGenesisPsbtRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SubmitGenesisPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGenesisPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SubmitGenesisPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SubmitGenesisPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SubmitGenesisPsbt(ctx, req.(*SubmitGenesisPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SubscribeMintEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMintEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MintServer).SubscribeMintEvents(m, &mintSubscribeMintEventsServer{stream})
+}
+
+type Mint_SubscribeMintEventsServer interface {
+	Send(*MintEvent) error
+	grpc.ServerStream
+}
+
+type mintSubscribeMintEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mintSubscribeMintEventsServer) Send(m *MintEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Mint_ServiceDesc is the grpc.ServiceDesc for Mint service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,15 +1085,101 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizeBatch",
 			Handler:    _Mint_FinalizeBatch_Handler,
 		},
+		{
+			MethodName: "PreviewBatch",
+			Handler:    _Mint_PreviewBatch_Handler,
+		},
+		{
+			MethodName: "ValidateMint",
+			Handler:    _Mint_ValidateMint_Handler,
+		},
 		{
 			MethodName: "CancelBatch",
 			Handler:    _Mint_CancelBatch_Handler,
 		},
+		{
+			MethodName: "CancelSeedling",
+			Handler:    _Mint_CancelSeedling_Handler,
+		},
 		{
 			MethodName: "ListBatches",
 			Handler:    _Mint_ListBatches_Handler,
 		},
+		{
+			MethodName: "SetBatchLabel",
+			Handler:    _Mint_SetBatchLabel_Handler,
+		},
+		{
+			MethodName: "ListBatchCosts",
+			Handler:    _Mint_ListBatchCosts_Handler,
+		},
+		{
+			MethodName: "GetBatchSchedule",
+			Handler:    _Mint_GetBatchSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateBatchSchedule",
+			Handler:    _Mint_UpdateBatchSchedule_Handler,
+		},
+		{
+			MethodName: "ListGroupWitnessRequests",
+			Handler:    _Mint_ListGroupWitnessRequests_Handler,
+		},
+		{
+			MethodName: "SubmitGroupWitness",
+			Handler:    _Mint_SubmitGroupWitness_Handler,
+		},
+		{
+			MethodName: "SubmitGroupScriptSig",
+			Handler:    _Mint_SubmitGroupScriptSig_Handler,
+		},
+		{
+			MethodName: "ListMuSig2GroupSessions",
+			Handler:    _Mint_ListMuSig2GroupSessions_Handler,
+		},
+		{
+			MethodName: "StartMuSig2GroupSession",
+			Handler:    _Mint_StartMuSig2GroupSession_Handler,
+		},
+		{
+			MethodName: "RegisterMuSig2GroupNonce",
+			Handler:    _Mint_RegisterMuSig2GroupNonce_Handler,
+		},
+		{
+			MethodName: "SubmitMuSig2GroupPartialSig",
+			Handler:    _Mint_SubmitMuSig2GroupPartialSig_Handler,
+		},
+		{
+			MethodName: "SaveBatchTemplate",
+			Handler:    _Mint_SaveBatchTemplate_Handler,
+		},
+		{
+			MethodName: "ListBatchTemplates",
+			Handler:    _Mint_ListBatchTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteBatchTemplate",
+			Handler:    _Mint_DeleteBatchTemplate_Handler,
+		},
+		{
+			MethodName: "MintBatchTemplate",
+			Handler:    _Mint_MintBatchTemplate_Handler,
+		},
+		{
+			MethodName: "ListGenesisPsbtRequests",
+			Handler:    _Mint_ListGenesisPsbtRequests_Handler,
+		},
+		{
+			MethodName: "SubmitGenesisPsbt",
+			Handler:    _Mint_SubmitGenesisPsbt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeMintEvents",
+			Handler:       _Mint_SubscribeMintEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mintrpc/mint.proto",
 }
@@ -35,6 +35,14 @@ type TaprootAssetsClient interface {
 	// tapcli: `assets transfers`
 	// ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// tapcli: `assets exporttransfers`
+	// ExportTransfers exports the outbound asset transfers and the completed
+	// inbound address receives made within a time range for accounting purposes,
+	// as CSV or JSON. The export contains the direction and the amounts of all
+	// assets moved, the chain fees paid by the anchor transactions of outbound
+	// transfers, the BTC sent along with the assets to other parties and the
+	// addresses the assets were sent to or received with.
+	ExportTransfers(ctx context.Context, in *ExportTransfersRequest, opts ...grpc.CallOption) (*ExportTransfersResponse, error)
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -60,6 +68,10 @@ type TaprootAssetsClient interface {
 	// List all receives for incoming asset transfers for addresses that were
 	// created previously.
 	AddrReceives(ctx context.Context, in *AddrReceivesRequest, opts ...grpc.CallOption) (*AddrReceivesResponse, error)
+	// tapcli: `addrs label`
+	// SetReceiveLabel sets a user-defined label or memo on the receives of the
+	// given on-chain output, so they can be reconciled with an external ledger.
+	SetReceiveLabel(ctx context.Context, in *SetReceiveLabelRequest, opts ...grpc.CallOption) (*SetReceiveLabelResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
 	// at the specified genesis point.
@@ -85,15 +97,94 @@ type TaprootAssetsClient interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error)
+	// tapcli: `assets listburns`
+	// ListBurns lists the asset burns of the burn registry, optionally filtered
+	// by the burned asset, its group or the anchor transaction of the burn.
+	ListBurns(ctx context.Context, in *ListBurnsRequest, opts ...grpc.CallOption) (*ListBurnsResponse, error)
+	// tapcli: `assets exportburn`
+	// ExportBurnAttestation exports an attestation of a confirmed asset burn. The
+	// attestation contains the full proof file of the burn output, which lets
+	// third parties verify that the burned units were provably destroyed and the
+	// supply of the asset was reduced accordingly.
+	ExportBurnAttestation(ctx context.Context, in *ExportBurnAttestationRequest, opts ...grpc.CallOption) (*BurnAttestation, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee replaces the anchor transaction of a pending, unconfirmed
+	// asset transfer with one that pays a higher fee rate. The additional fee is
+	// deducted from the BTC change output of the anchor transaction. The proofs
+	// of the transfer are updated to reference the replacement, which is then
+	// delivered to the receivers once it confirms.
+	BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error)
+	// tapcli: `assets cpfp`
+	// CpfpTransfer accelerates the confirmation of the anchor transaction of a
+	// pending, unconfirmed asset transfer by spending its BTC change output in a
+	// child transaction that pays the given fee rate. The child is created and
+	// published by the backing lnd node's wallet. This can be used if the anchor
+	// transaction can't be replaced, for example because it spends inputs of
+	// another wallet.
+	CpfpTransfer(ctx context.Context, in *CpfpTransferRequest, opts ...grpc.CallOption) (*CpfpTransferResponse, error)
+	// tapcli: `assets canceltransfer`
+	// CancelTransfer cancels a pending asset transfer whose anchor transaction
+	// hasn't confirmed yet by double spending its BTC inputs back to the
+	// backing lnd node's wallet. The transfer is kept until either the anchor
+	// transaction or the double spend confirms. Only once the double spend
+	// confirms are the leases on the asset inputs of the transfer released and
+	// its pending outputs and proofs removed, so the assets can be spent
+	// again. Transfers whose anchor transaction has no BTC wallet input can't
+	// be cancelled.
+	CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*CancelTransferResponse, error)
+	// tapcli: `assets resumedelivery`
+	// ResumeTransferDelivery resumes the delivery of the receiver proofs of a
+	// confirmed asset transfer after it failed, without re-creating the
+	// transfer. If a proof courier address is given, it replaces the proof
+	// courier of all outputs of the transfer that go to other nodes, also for
+	// any later delivery attempts.
+	ResumeTransferDelivery(ctx context.Context, in *ResumeTransferDeliveryRequest, opts ...grpc.CallOption) (*ResumeTransferDeliveryResponse, error)
+	// tapcli: `assets queue add`
+	// QueueSend queues a send to one or more Taproot Asset addresses that is
+	// executed by the daemon once the given time has passed and the estimated
+	// on-chain fee rate is at or below the given maximum. Queued sends are
+	// executed one at a time, so they don't compete for the same inputs.
+	QueueSend(ctx context.Context, in *QueueSendRequest, opts ...grpc.CallOption) (*QueuedSend, error)
+	// tapcli: `assets queue list`
+	// ListQueuedSends lists all sends that are queued and not yet executed.
+	ListQueuedSends(ctx context.Context, in *ListQueuedSendsRequest, opts ...grpc.CallOption) (*ListQueuedSendsResponse, error)
+	// tapcli: `assets queue update`
+	// UpdateQueuedSend replaces the conditions of a queued send.
+	UpdateQueuedSend(ctx context.Context, in *UpdateQueuedSendRequest, opts ...grpc.CallOption) (*QueuedSend, error)
+	// tapcli: `assets queue cancel`
+	// CancelQueuedSend removes a send from the queue before it is executed.
+	CancelQueuedSend(ctx context.Context, in *CancelQueuedSendRequest, opts ...grpc.CallOption) (*CancelQueuedSendResponse, error)
+	// tapcli: `assets consolidate`
+	// ConsolidateAssets merges the smallest UTXOs of an asset, along with their
+	// BTC anchors, into a single output of the wallet, so that at most the given
+	// number of UTXOs of the asset remain.
+	ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error)
+	// tapcli: `assets sweep`
+	// SweepAssets moves all UTXOs of an asset, or of all assets, to new script
+	// keys of the wallet in a single anchor transaction, merging the UTXOs of
+	// each asset into one output. This rotates the keys of the assets, for
+	// example after a key was exposed or to migrate to a new signing backend.
+	// Assets that share an anchor output with another swept asset are only
+	// re-anchored under their current script keys and must be swept again once
+	// the transfer confirmed.
+	SweepAssets(ctx context.Context, in *SweepAssetsRequest, opts ...grpc.CallOption) (*SweepAssetsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
 	// SubscribeSendAssetEventNtfns registers a subscription to the event
-	// notification stream which relates to the asset sending process.
+	// notification stream which relates to the asset sending process. An event
+	// is emitted before each state of a transfer is executed and again if it
+	// fails. The most recent events are kept in memory and can be replayed.
 	SubscribeSendAssetEventNtfns(ctx context.Context, in *SubscribeSendAssetEventNtfnsRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeSendAssetEventNtfnsClient, error)
 	// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
 	// either by the asset ID for that asset, or a meta hash.
 	FetchAssetMeta(ctx context.Context, in *FetchAssetMetaRequest, opts ...grpc.CallOption) (*AssetMeta, error)
+	// tapcli: `assets meta blob`
+	// FetchAssetMetaBlob allows a caller to fetch the meta data blob of an asset
+	// that commits to its meta data by reference. When fetching by asset ID, the
+	// blob is fetched from the URL of the reference if it isn't known locally.
+	// The blob is always verified against the reference.
+	FetchAssetMetaBlob(ctx context.Context, in *FetchAssetMetaBlobRequest, opts ...grpc.CallOption) (*AssetMetaBlob, error)
 }
 
 type taprootAssetsClient struct {
@@ -149,6 +240,15 @@ func (c *taprootAssetsClient) ListTransfers(ctx context.Context, in *ListTransfe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ExportTransfers(ctx context.Context, in *ExportTransfersRequest, opts ...grpc.CallOption) (*ExportTransfersResponse, error) {
+	out := new(ExportTransfersResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/StopDaemon", in, out, opts...)
@@ -203,6 +303,15 @@ func (c *taprootAssetsClient) AddrReceives(ctx context.Context, in *AddrReceives
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SetReceiveLabel(ctx context.Context, in *SetReceiveLabelRequest, opts ...grpc.CallOption) (*SetReceiveLabelResponse, error) {
+	out := new(SetReceiveLabelResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetReceiveLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) VerifyProof(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*VerifyProofResponse, error) {
 	out := new(VerifyProofResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/VerifyProof", in, out, opts...)
@@ -248,6 +357,114 @@ func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ListBurns(ctx context.Context, in *ListBurnsRequest, opts ...grpc.CallOption) (*ListBurnsResponse, error) {
+	out := new(ListBurnsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListBurns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ExportBurnAttestation(ctx context.Context, in *ExportBurnAttestationRequest, opts ...grpc.CallOption) (*BurnAttestation, error) {
+	out := new(BurnAttestation)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportBurnAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error) {
+	out := new(BumpTransferFeeResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BumpTransferFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CpfpTransfer(ctx context.Context, in *CpfpTransferRequest, opts ...grpc.CallOption) (*CpfpTransferResponse, error) {
+	out := new(CpfpTransferResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CpfpTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*CancelTransferResponse, error) {
+	out := new(CancelTransferResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CancelTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ResumeTransferDelivery(ctx context.Context, in *ResumeTransferDeliveryRequest, opts ...grpc.CallOption) (*ResumeTransferDeliveryResponse, error) {
+	out := new(ResumeTransferDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ResumeTransferDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) QueueSend(ctx context.Context, in *QueueSendRequest, opts ...grpc.CallOption) (*QueuedSend, error) {
+	out := new(QueuedSend)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/QueueSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListQueuedSends(ctx context.Context, in *ListQueuedSendsRequest, opts ...grpc.CallOption) (*ListQueuedSendsResponse, error) {
+	out := new(ListQueuedSendsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListQueuedSends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) UpdateQueuedSend(ctx context.Context, in *UpdateQueuedSendRequest, opts ...grpc.CallOption) (*QueuedSend, error) {
+	out := new(QueuedSend)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/UpdateQueuedSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CancelQueuedSend(ctx context.Context, in *CancelQueuedSendRequest, opts ...grpc.CallOption) (*CancelQueuedSendResponse, error) {
+	out := new(CancelQueuedSendResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CancelQueuedSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error) {
+	out := new(ConsolidateAssetsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ConsolidateAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SweepAssets(ctx context.Context, in *SweepAssetsRequest, opts ...grpc.CallOption) (*SweepAssetsResponse, error) {
+	out := new(SweepAssetsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SweepAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetInfo", in, out, opts...)
@@ -298,6 +515,15 @@ func (c *taprootAssetsClient) FetchAssetMeta(ctx context.Context, in *FetchAsset
 	return out, nil
 }
 
+func (c *taprootAssetsClient) FetchAssetMetaBlob(ctx context.Context, in *FetchAssetMetaBlobRequest, opts ...grpc.CallOption) (*AssetMetaBlob, error) {
+	out := new(AssetMetaBlob)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/FetchAssetMetaBlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaprootAssetsServer is the server API for TaprootAssets service.
 // All implementations must embed UnimplementedTaprootAssetsServer
 // for forward compatibility
@@ -319,6 +545,14 @@ type TaprootAssetsServer interface {
 	// tapcli: `assets transfers`
 	// ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// tapcli: `assets exporttransfers`
+	// ExportTransfers exports the outbound asset transfers and the completed
+	// inbound address receives made within a time range for accounting purposes,
+	// as CSV or JSON. The export contains the direction and the amounts of all
+	// assets moved, the chain fees paid by the anchor transactions of outbound
+	// transfers, the BTC sent along with the assets to other parties and the
+	// addresses the assets were sent to or received with.
+	ExportTransfers(context.Context, *ExportTransfersRequest) (*ExportTransfersResponse, error)
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -344,6 +578,10 @@ type TaprootAssetsServer interface {
 	// List all receives for incoming asset transfers for addresses that were
 	// created previously.
 	AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error)
+	// tapcli: `addrs label`
+	// SetReceiveLabel sets a user-defined label or memo on the receives of the
+	// given on-chain output, so they can be reconciled with an external ledger.
+	SetReceiveLabel(context.Context, *SetReceiveLabelRequest) (*SetReceiveLabelResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
 	// at the specified genesis point.
@@ -369,15 +607,94 @@ type TaprootAssetsServer interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error)
+	// tapcli: `assets listburns`
+	// ListBurns lists the asset burns of the burn registry, optionally filtered
+	// by the burned asset, its group or the anchor transaction of the burn.
+	ListBurns(context.Context, *ListBurnsRequest) (*ListBurnsResponse, error)
+	// tapcli: `assets exportburn`
+	// ExportBurnAttestation exports an attestation of a confirmed asset burn. The
+	// attestation contains the full proof file of the burn output, which lets
+	// third parties verify that the burned units were provably destroyed and the
+	// supply of the asset was reduced accordingly.
+	ExportBurnAttestation(context.Context, *ExportBurnAttestationRequest) (*BurnAttestation, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee replaces the anchor transaction of a pending, unconfirmed
+	// asset transfer with one that pays a higher fee rate. The additional fee is
+	// deducted from the BTC change output of the anchor transaction. The proofs
+	// of the transfer are updated to reference the replacement, which is then
+	// delivered to the receivers once it confirms.
+	BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error)
+	// tapcli: `assets cpfp`
+	// CpfpTransfer accelerates the confirmation of the anchor transaction of a
+	// pending, unconfirmed asset transfer by spending its BTC change output in a
+	// child transaction that pays the given fee rate. The child is created and
+	// published by the backing lnd node's wallet. This can be used if the anchor
+	// transaction can't be replaced, for example because it spends inputs of
+	// another wallet.
+	CpfpTransfer(context.Context, *CpfpTransferRequest) (*CpfpTransferResponse, error)
+	// tapcli: `assets canceltransfer`
+	// CancelTransfer cancels a pending asset transfer whose anchor transaction
+	// hasn't confirmed yet by double spending its BTC inputs back to the
+	// backing lnd node's wallet. The transfer is kept until either the anchor
+	// transaction or the double spend confirms. Only once the double spend
+	// confirms are the leases on the asset inputs of the transfer released and
+	// its pending outputs and proofs removed, so the assets can be spent
+	// again. Transfers whose anchor transaction has no BTC wallet input can't
+	// be cancelled.
+	CancelTransfer(context.Context, *CancelTransferRequest) (*CancelTransferResponse, error)
+	// tapcli: `assets resumedelivery`
+	// ResumeTransferDelivery resumes the delivery of the receiver proofs of a
+	// confirmed asset transfer after it failed, without re-creating the
+	// transfer. If a proof courier address is given, it replaces the proof
+	// courier of all outputs of the transfer that go to other nodes, also for
+	// any later delivery attempts.
+	ResumeTransferDelivery(context.Context, *ResumeTransferDeliveryRequest) (*ResumeTransferDeliveryResponse, error)
+	// tapcli: `assets queue add`
+	// QueueSend queues a send to one or more Taproot Asset addresses that is
+	// executed by the daemon once the given time has passed and the estimated
+	// on-chain fee rate is at or below the given maximum. Queued sends are
+	// executed one at a time, so they don't compete for the same inputs.
+	QueueSend(context.Context, *QueueSendRequest) (*QueuedSend, error)
+	// tapcli: `assets queue list`
+	// ListQueuedSends lists all sends that are queued and not yet executed.
+	ListQueuedSends(context.Context, *ListQueuedSendsRequest) (*ListQueuedSendsResponse, error)
+	// tapcli: `assets queue update`
+	// UpdateQueuedSend replaces the conditions of a queued send.
+	UpdateQueuedSend(context.Context, *UpdateQueuedSendRequest) (*QueuedSend, error)
+	// tapcli: `assets queue cancel`
+	// CancelQueuedSend removes a send from the queue before it is executed.
+	CancelQueuedSend(context.Context, *CancelQueuedSendRequest) (*CancelQueuedSendResponse, error)
+	// tapcli: `assets consolidate`
+	// ConsolidateAssets merges the smallest UTXOs of an asset, along with their
+	// BTC anchors, into a single output of the wallet, so that at most the given
+	// number of UTXOs of the asset remain.
+	ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error)
+	// tapcli: `assets sweep`
+	// SweepAssets moves all UTXOs of an asset, or of all assets, to new script
+	// keys of the wallet in a single anchor transaction, merging the UTXOs of
+	// each asset into one output. This rotates the keys of the assets, for
+	// example after a key was exposed or to migrate to a new signing backend.
+	// Assets that share an anchor output with another swept asset are only
+	// re-anchored under their current script keys and must be swept again once
+	// the transfer confirmed.
+	SweepAssets(context.Context, *SweepAssetsRequest) (*SweepAssetsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
 	// SubscribeSendAssetEventNtfns registers a subscription to the event
-	// notification stream which relates to the asset sending process.
+	// notification stream which relates to the asset sending process. An event
+	// is emitted before each state of a transfer is executed and again if it
+	// fails. The most recent events are kept in memory and can be replayed.
 	SubscribeSendAssetEventNtfns(*SubscribeSendAssetEventNtfnsRequest, TaprootAssets_SubscribeSendAssetEventNtfnsServer) error
 	// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
 	// either by the asset ID for that asset, or a meta hash.
 	FetchAssetMeta(context.Context, *FetchAssetMetaRequest) (*AssetMeta, error)
+	// tapcli: `assets meta blob`
+	// FetchAssetMetaBlob allows a caller to fetch the meta data blob of an asset
+	// that commits to its meta data by reference. When fetching by asset ID, the
+	// blob is fetched from the URL of the reference if it isn't known locally.
+	// The blob is always verified against the reference.
+	FetchAssetMetaBlob(context.Context, *FetchAssetMetaBlobRequest) (*AssetMetaBlob, error)
 	mustEmbedUnimplementedTaprootAssetsServer()
 }
 
@@ -400,6 +717,9 @@ func (UnimplementedTaprootAssetsServer) ListBalances(context.Context, *ListBalan
 func (UnimplementedTaprootAssetsServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ExportTransfers(context.Context, *ExportTransfersRequest) (*ExportTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTransfers not implemented")
+}
 func (UnimplementedTaprootAssetsServer) StopDaemon(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
@@ -418,6 +738,9 @@ func (UnimplementedTaprootAssetsServer) DecodeAddr(context.Context, *DecodeAddrR
 func (UnimplementedTaprootAssetsServer) AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddrReceives not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SetReceiveLabel(context.Context, *SetReceiveLabelRequest) (*SetReceiveLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiveLabel not implemented")
+}
 func (UnimplementedTaprootAssetsServer) VerifyProof(context.Context, *ProofFile) (*VerifyProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
 }
@@ -433,6 +756,42 @@ func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetReq
 func (UnimplementedTaprootAssetsServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ListBurns(context.Context, *ListBurnsRequest) (*ListBurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBurns not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ExportBurnAttestation(context.Context, *ExportBurnAttestationRequest) (*BurnAttestation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBurnAttestation not implemented")
+}
+func (UnimplementedTaprootAssetsServer) BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpTransferFee not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CpfpTransfer(context.Context, *CpfpTransferRequest) (*CpfpTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CpfpTransfer not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CancelTransfer(context.Context, *CancelTransferRequest) (*CancelTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ResumeTransferDelivery(context.Context, *ResumeTransferDeliveryRequest) (*ResumeTransferDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTransferDelivery not implemented")
+}
+func (UnimplementedTaprootAssetsServer) QueueSend(context.Context, *QueueSendRequest) (*QueuedSend, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueSend not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListQueuedSends(context.Context, *ListQueuedSendsRequest) (*ListQueuedSendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueuedSends not implemented")
+}
+func (UnimplementedTaprootAssetsServer) UpdateQueuedSend(context.Context, *UpdateQueuedSendRequest) (*QueuedSend, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueuedSend not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CancelQueuedSend(context.Context, *CancelQueuedSendRequest) (*CancelQueuedSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedSend not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateAssets not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SweepAssets(context.Context, *SweepAssetsRequest) (*SweepAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepAssets not implemented")
+}
 func (UnimplementedTaprootAssetsServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
@@ -442,6 +801,9 @@ func (UnimplementedTaprootAssetsServer) SubscribeSendAssetEventNtfns(*SubscribeS
 func (UnimplementedTaprootAssetsServer) FetchAssetMeta(context.Context, *FetchAssetMetaRequest) (*AssetMeta, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchAssetMeta not implemented")
 }
+func (UnimplementedTaprootAssetsServer) FetchAssetMetaBlob(context.Context, *FetchAssetMetaBlobRequest) (*AssetMetaBlob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchAssetMetaBlob not implemented")
+}
 func (UnimplementedTaprootAssetsServer) mustEmbedUnimplementedTaprootAssetsServer() {}
 
 // UnsafeTaprootAssetsServer may be embedded to opt out of forward compatibility for this service.
@@ -545,6 +907,24 @@ func _TaprootAssets_ListTransfers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_ExportTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportTransfers(ctx, req.(*ExportTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -653,6 +1033,24 @@ func _TaprootAssets_AddrReceives_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_SetReceiveLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReceiveLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetReceiveLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetReceiveLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetReceiveLabel(ctx, req.(*SetReceiveLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProofFile)
 	if err := dec(in); err != nil {
@@ -743,6 +1141,222 @@ func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_ListBurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListBurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListBurns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListBurns(ctx, req.(*ListBurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ExportBurnAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBurnAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportBurnAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportBurnAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportBurnAttestation(ctx, req.(*ExportBurnAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_BumpTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/BumpTransferFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, req.(*BumpTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_CpfpTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CpfpTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).CpfpTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/CpfpTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).CpfpTransfer(ctx, req.(*CpfpTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).CancelTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/CancelTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).CancelTransfer(ctx, req.(*CancelTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ResumeTransferDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTransferDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ResumeTransferDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ResumeTransferDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ResumeTransferDelivery(ctx, req.(*ResumeTransferDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_QueueSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).QueueSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/QueueSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).QueueSend(ctx, req.(*QueueSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListQueuedSends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuedSendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListQueuedSends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListQueuedSends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListQueuedSends(ctx, req.(*ListQueuedSendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_UpdateQueuedSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQueuedSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).UpdateQueuedSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/UpdateQueuedSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).UpdateQueuedSend(ctx, req.(*UpdateQueuedSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_CancelQueuedSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelQueuedSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).CancelQueuedSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/CancelQueuedSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).CancelQueuedSend(ctx, req.(*CancelQueuedSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ConsolidateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ConsolidateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ConsolidateAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ConsolidateAssets(ctx, req.(*ConsolidateAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SweepAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SweepAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SweepAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SweepAssets(ctx, req.(*SweepAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -800,6 +1414,24 @@ func _TaprootAssets_FetchAssetMeta_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_FetchAssetMetaBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchAssetMetaBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).FetchAssetMetaBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/FetchAssetMetaBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).FetchAssetMetaBlob(ctx, req.(*FetchAssetMetaBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaprootAssets_ServiceDesc is the grpc.ServiceDesc for TaprootAssets service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -827,6 +1459,10 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransfers",
 			Handler:    _TaprootAssets_ListTransfers_Handler,
 		},
+		{
+			MethodName: "ExportTransfers",
+			Handler:    _TaprootAssets_ExportTransfers_Handler,
+		},
 		{
 			MethodName: "StopDaemon",
 			Handler:    _TaprootAssets_StopDaemon_Handler,
@@ -851,6 +1487,10 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddrReceives",
 			Handler:    _TaprootAssets_AddrReceives_Handler,
 		},
+		{
+			MethodName: "SetReceiveLabel",
+			Handler:    _TaprootAssets_SetReceiveLabel_Handler,
+		},
 		{
 			MethodName: "VerifyProof",
 			Handler:    _TaprootAssets_VerifyProof_Handler,
@@ -871,6 +1511,54 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BurnAsset",
 			Handler:    _TaprootAssets_BurnAsset_Handler,
 		},
+		{
+			MethodName: "ListBurns",
+			Handler:    _TaprootAssets_ListBurns_Handler,
+		},
+		{
+			MethodName: "ExportBurnAttestation",
+			Handler:    _TaprootAssets_ExportBurnAttestation_Handler,
+		},
+		{
+			MethodName: "BumpTransferFee",
+			Handler:    _TaprootAssets_BumpTransferFee_Handler,
+		},
+		{
+			MethodName: "CpfpTransfer",
+			Handler:    _TaprootAssets_CpfpTransfer_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _TaprootAssets_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "ResumeTransferDelivery",
+			Handler:    _TaprootAssets_ResumeTransferDelivery_Handler,
+		},
+		{
+			MethodName: "QueueSend",
+			Handler:    _TaprootAssets_QueueSend_Handler,
+		},
+		{
+			MethodName: "ListQueuedSends",
+			Handler:    _TaprootAssets_ListQueuedSends_Handler,
+		},
+		{
+			MethodName: "UpdateQueuedSend",
+			Handler:    _TaprootAssets_UpdateQueuedSend_Handler,
+		},
+		{
+			MethodName: "CancelQueuedSend",
+			Handler:    _TaprootAssets_CancelQueuedSend_Handler,
+		},
+		{
+			MethodName: "ConsolidateAssets",
+			Handler:    _TaprootAssets_ConsolidateAssets_Handler,
+		},
+		{
+			MethodName: "SweepAssets",
+			Handler:    _TaprootAssets_SweepAssets_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _TaprootAssets_GetInfo_Handler,
@@ -879,6 +1567,10 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchAssetMeta",
 			Handler:    _TaprootAssets_FetchAssetMeta_Handler,
 		},
+		{
+			MethodName: "FetchAssetMetaBlob",
+			Handler:    _TaprootAssets_FetchAssetMetaBlob_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -45,6 +45,12 @@ type UniverseClient interface {
 	// took place on chain. The leaves contain a normal Taproot Asset proof, as
 	// well as details for the asset.
 	AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error)
+	// tapcli: `universe emission`
+	// QueryGroupEmission queries for the max supply and the emission schedule an
+	// asset group committed to in the meta data of its group anchor, along with
+	// the supply issued into the group so far. The commitment can be verified by
+	// querying the issuance proof of the group anchor.
+	QueryGroupEmission(ctx context.Context, in *ID, opts ...grpc.CallOption) (*GroupEmissionResponse, error)
 	// tapcli: `universe proofs query`
 	// QueryProof attempts to query for an issuance or transfer proof for a given
 	// asset based on its UniverseKey. A UniverseKey is composed of the Universe
@@ -104,6 +110,12 @@ type UniverseClient interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(ctx context.Context, in *QueryFederationSyncConfigRequest, opts ...grpc.CallOption) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe publications`
+	// ListProofPublications lists the publication status of new issuance proofs
+	// for each of the static publication targets the daemon is configured with.
+	// Unlike the federation servers, publication targets are retried in the
+	// background until they accept a proof.
+	ListProofPublications(ctx context.Context, in *ListProofPublicationsRequest, opts ...grpc.CallOption) (*ListProofPublicationsResponse, error)
 }
 
 type universeClient struct {
@@ -159,6 +171,15 @@ func (c *universeClient) AssetLeaves(ctx context.Context, in *ID, opts ...grpc.C
 	return out, nil
 }
 
+func (c *universeClient) QueryGroupEmission(ctx context.Context, in *ID, opts ...grpc.CallOption) (*GroupEmissionResponse, error) {
+	out := new(GroupEmissionResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryGroupEmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) QueryProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*AssetProofResponse, error) {
 	out := new(AssetProofResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryProof", in, out, opts...)
@@ -267,6 +288,15 @@ func (c *universeClient) QueryFederationSyncConfig(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *universeClient) ListProofPublications(ctx context.Context, in *ListProofPublicationsRequest, opts ...grpc.CallOption) (*ListProofPublicationsResponse, error) {
+	out := new(ListProofPublicationsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListProofPublications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UniverseServer is the server API for Universe service.
 // All implementations must embed UnimplementedUniverseServer
 // for forward compatibility
@@ -298,6 +328,12 @@ type UniverseServer interface {
 	// took place on chain. The leaves contain a normal Taproot Asset proof, as
 	// well as details for the asset.
 	AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error)
+	// tapcli: `universe emission`
+	// QueryGroupEmission queries for the max supply and the emission schedule an
+	// asset group committed to in the meta data of its group anchor, along with
+	// the supply issued into the group so far. The commitment can be verified by
+	// querying the issuance proof of the group anchor.
+	QueryGroupEmission(context.Context, *ID) (*GroupEmissionResponse, error)
 	// tapcli: `universe proofs query`
 	// QueryProof attempts to query for an issuance or transfer proof for a given
 	// asset based on its UniverseKey. A UniverseKey is composed of the Universe
@@ -357,6 +393,12 @@ type UniverseServer interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe publications`
+	// ListProofPublications lists the publication status of new issuance proofs
+	// for each of the static publication targets the daemon is configured with.
+	// Unlike the federation servers, publication targets are retried in the
+	// background until they accept a proof.
+	ListProofPublications(context.Context, *ListProofPublicationsRequest) (*ListProofPublicationsResponse, error)
 	mustEmbedUnimplementedUniverseServer()
 }
 
@@ -379,6 +421,9 @@ func (UnimplementedUniverseServer) AssetLeafKeys(context.Context, *ID) (*AssetLe
 func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeaves not implemented")
 }
+func (UnimplementedUniverseServer) QueryGroupEmission(context.Context, *ID) (*GroupEmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupEmission not implemented")
+}
 func (UnimplementedUniverseServer) QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryProof not implemented")
 }
@@ -415,6 +460,9 @@ func (UnimplementedUniverseServer) SetFederationSyncConfig(context.Context, *Set
 func (UnimplementedUniverseServer) QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFederationSyncConfig not implemented")
 }
+func (UnimplementedUniverseServer) ListProofPublications(context.Context, *ListProofPublicationsRequest) (*ListProofPublicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProofPublications not implemented")
+}
 func (UnimplementedUniverseServer) mustEmbedUnimplementedUniverseServer() {}
 
 // UnsafeUniverseServer may be embedded to opt out of forward compatibility for this service.
@@ -518,6 +566,24 @@ func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_QueryGroupEmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryGroupEmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryGroupEmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryGroupEmission(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UniverseKey)
 	if err := dec(in); err != nil {
@@ -734,6 +800,24 @@ func _Universe_QueryFederationSyncConfig_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_ListProofPublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProofPublicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ListProofPublications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/ListProofPublications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).ListProofPublications(ctx, req.(*ListProofPublicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Universe_ServiceDesc is the grpc.ServiceDesc for Universe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -761,6 +845,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssetLeaves",
 			Handler:    _Universe_AssetLeaves_Handler,
 		},
+		{
+			MethodName: "QueryGroupEmission",
+			Handler:    _Universe_QueryGroupEmission_Handler,
+		},
 		{
 			MethodName: "QueryProof",
 			Handler:    _Universe_QueryProof_Handler,
@@ -809,6 +897,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryFederationSyncConfig",
 			Handler:    _Universe_QueryFederationSyncConfig_Handler,
 		},
+		{
+			MethodName: "ListProofPublications",
+			Handler:    _Universe_ListProofPublications_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "universerpc/universe.proto",
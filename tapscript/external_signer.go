@@ -0,0 +1,199 @@
+package tapscript
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+var (
+	// ErrMissingSignature is returned by the external signer if no
+	// signature was provided for a signature hash it is asked to sign.
+	ErrMissingSignature = errors.New("missing external signature")
+)
+
+// SigningRequest describes a signature an external signer needs to create for
+// an input of a virtual transaction.
+type SigningRequest struct {
+	// InputIndex is the index of the virtual packet input the signature is
+	// for.
+	InputIndex int
+
+	// SignDesc describes how the virtual transaction must be signed. The
+	// key descriptor holds the raw script key of the input. Depending on
+	// the sign method, the key must be tweaked BIP-0086 style, with the
+	// tap tweak or not at all (for a script spend of the witness script).
+	SignDesc *lndclient.SignDescriptor
+
+	// SigHash is the BIP-0341 signature hash of the virtual transaction,
+	// which is the message that must be signed. It also identifies the
+	// request.
+	SigHash chainhash.Hash
+}
+
+// VirtualTxSigHash computes the BIP-0341 signature hash an external signer
+// must sign for the given virtual transaction and sign descriptor.
+func VirtualTxSigHash(signDesc *lndclient.SignDescriptor, tx *wire.MsgTx,
+	prevOut *wire.TxOut) (chainhash.Hash, error) {
+
+	var (
+		prevOutFetcher = txscript.NewCannedPrevOutputFetcher(
+			prevOut.PkScript, prevOut.Value,
+		)
+		sigHashes = txscript.NewTxSigHashes(tx, prevOutFetcher)
+		sigHash   []byte
+		err       error
+	)
+	switch signDesc.SignMethod {
+	case input.TaprootKeySpendBIP0086SignMethod,
+		input.TaprootKeySpendSignMethod:
+
+		sigHash, err = txscript.CalcTaprootSignatureHash(
+			sigHashes, signDesc.HashType, tx, signDesc.InputIndex,
+			prevOutFetcher,
+		)
+
+	case input.TaprootScriptSpendSignMethod:
+		sigHash, err = txscript.CalcTapscriptSignaturehash(
+			sigHashes, signDesc.HashType, tx, signDesc.InputIndex,
+			prevOutFetcher,
+			txscript.NewBaseTapLeaf(signDesc.WitnessScript),
+		)
+
+	default:
+		return chainhash.Hash{}, fmt.Errorf("unsupported sign method "+
+			"%v", signDesc.SignMethod)
+	}
+	if err != nil {
+		return chainhash.Hash{}, fmt.Errorf("unable to compute "+
+			"sighash: %w", err)
+	}
+
+	var hash chainhash.Hash
+	copy(hash[:], sigHash)
+
+	return hash, nil
+}
+
+// SigningRequests returns the signing requests for all inputs of the given
+// virtual packet, which must be funded but not yet signed.
+func SigningRequests(vPkt *tappsbt.VPacket) ([]*SigningRequest, error) {
+	_, virtualTx, _, _, err := signingVirtualTx(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*SigningRequest, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		vIn := vPkt.Inputs[idx]
+		inputSpecificVirtualTx := asset.VirtualTxWithInput(
+			virtualTx.Copy(), vIn.Asset(), uint32(idx), nil,
+		)
+
+		// We let the signature creation determine the sign method, so
+		// the requests match exactly what the external signer is asked
+		// for once the signatures are applied.
+		recorder := &requestRecorder{}
+		_, err := CreateTaprootSignature(
+			vIn, inputSpecificVirtualTx, 0, recorder,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create signing "+
+				"request for input %d: %w", idx, err)
+		}
+
+		// The raw key of the script key also carries the key locator
+		// the external signer needs to derive the private key.
+		scriptKey := vIn.Asset().ScriptKey
+		if scriptKey.TweakedScriptKey != nil {
+			recorder.req.SignDesc.KeyDesc = scriptKey.RawKey
+		}
+
+		recorder.req.InputIndex = idx
+		requests[idx] = recorder.req
+	}
+
+	return requests, nil
+}
+
+// requestRecorder is a signer that records the signing request it is asked to
+// sign and returns a placeholder signature.
+type requestRecorder struct {
+	req *SigningRequest
+}
+
+// SignVirtualTx records the signing request for the passed virtual
+// transaction.
+//
+// NOTE: This is part of the Signer interface.
+func (r *requestRecorder) SignVirtualTx(signDesc *lndclient.SignDescriptor,
+	tx *wire.MsgTx, prevOut *wire.TxOut) (*schnorr.Signature, error) {
+
+	sigHash, err := VirtualTxSigHash(signDesc, tx, prevOut)
+	if err != nil {
+		return nil, err
+	}
+
+	r.req = &SigningRequest{
+		SignDesc: signDesc,
+		SigHash:  sigHash,
+	}
+
+	return schnorr.NewSignature(&btcec.FieldVal{}, &btcec.ModNScalar{}),
+		nil
+}
+
+// A compile-time assertion to ensure requestRecorder meets the Signer
+// interface.
+var _ Signer = (*requestRecorder)(nil)
+
+// ExternalSigner is a signer that returns signatures created by an external
+// signer for the signing requests of a virtual packet. The signatures are
+// identified by the signature hash they sign. They aren't verified here, that
+// happens when the signed virtual transaction is validated.
+type ExternalSigner struct {
+	sigs map[chainhash.Hash]*schnorr.Signature
+}
+
+// NewExternalSigner creates a new signer from the given signatures.
+func NewExternalSigner(
+	sigs map[chainhash.Hash]*schnorr.Signature) *ExternalSigner {
+
+	return &ExternalSigner{
+		sigs: sigs,
+	}
+}
+
+// SignVirtualTx returns the external signature for the passed virtual
+// transaction.
+//
+// NOTE: This is part of the Signer interface.
+func (e *ExternalSigner) SignVirtualTx(signDesc *lndclient.SignDescriptor,
+	tx *wire.MsgTx, prevOut *wire.TxOut) (*schnorr.Signature, error) {
+
+	sigHash, err := VirtualTxSigHash(signDesc, tx, prevOut)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, ok := e.sigs[sigHash]
+	if !ok {
+		return nil, fmt.Errorf("%w for sighash %x", ErrMissingSignature,
+			sigHash[:])
+	}
+
+	return sig, nil
+}
+
+// A compile-time assertion to ensure ExternalSigner meets the Signer
+// interface.
+var _ Signer = (*ExternalSigner)(nil)
@@ -0,0 +1,64 @@
+package tapscript_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExternalSigner tests that the signatures created for the signing
+// requests of a virtual packet produce a valid transfer when applied with the
+// external signer.
+func TestExternalSigner(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+	pkt := createPacket(
+		state.address1, state.asset2PrevID, state,
+		state.asset2InputAssets, false,
+	)
+	err := tapscript.PrepareOutputAssets(context.Background(), pkt)
+	require.NoError(t, err)
+
+	requests, err := tapscript.SigningRequests(pkt)
+	require.NoError(t, err)
+	require.Len(t, requests, len(pkt.Inputs))
+
+	// The script key of the input is a BIP-0086 key, so the private key
+	// needs to be tweaked accordingly.
+	req := requests[0]
+	require.Equal(
+		t, input.TaprootKeySpendBIP0086SignMethod,
+		req.SignDesc.SignMethod,
+	)
+	require.True(t, req.SignDesc.KeyDesc.PubKey.IsEqual(
+		&state.spenderPubKey,
+	))
+
+	sigs := make(map[chainhash.Hash]*schnorr.Signature)
+
+	// Without the signatures, the packet can't be signed.
+	err = tapscript.SignVirtualTransaction(
+		pkt, tapscript.NewExternalSigner(sigs), state.validator,
+	)
+	require.ErrorIs(t, err, tapscript.ErrMissingSignature)
+
+	privKey := txscript.TweakTaprootPrivKey(state.spenderPrivKey, nil)
+	sig, err := schnorr.Sign(privKey, req.SigHash[:])
+	require.NoError(t, err)
+	sigs[req.SigHash] = sig
+
+	err = tapscript.SignVirtualTransaction(
+		pkt, tapscript.NewExternalSigner(sigs), state.validator,
+	)
+	require.NoError(t, err)
+	require.NoError(t, tapscript.ValidateVirtualTransaction(
+		pkt, state.validator,
+	))
+}
@@ -133,6 +133,12 @@ type FundingDescriptor struct {
 
 	// Amount is the amount of the asset to transfer.
 	Amount uint64
+
+	// PrevIDs is the optional list of asset inputs that must be spent to
+	// fund the transfer. An input without an asset ID and script key
+	// spends all assets of the transfer's asset ID at its anchor outpoint.
+	// If set, no automatic coin selection is performed.
+	PrevIDs []asset.PrevID
 }
 
 // TapCommitmentKey is the key that maps to the root commitment for the asset
@@ -584,37 +590,8 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 	validator TxValidator) error {
 
 	inputs := vPkt.Inputs
-	outputs := vPkt.Outputs
-
-	// If this is a split transfer, it means that the asset to be signed is
-	// the root asset, which is located at the change output.
-	isSplit, err := vPkt.HasSplitCommitment()
-	if err != nil {
-		return err
-	}
-
-	// Identify new output asset. For splits, the new asset that receives
-	// the signature is the one with the split root set to true.
-	newAsset := outputs[0].Asset
-	if isSplit {
-		splitOut, err := vPkt.SplitRootOutput()
-		if err != nil {
-			return fmt.Errorf("no split root output found for "+
-				"split transaction: %w", err)
-		}
-		newAsset = splitOut.Asset
-	}
 
-	// Construct input set from all input assets.
-	prevAssets := make(commitment.InputSet, len(inputs))
-	for idx := range vPkt.Inputs {
-		input := vPkt.Inputs[idx]
-		prevAssets[input.PrevID] = input.Asset()
-	}
-
-	// Create a Taproot Asset virtual transaction representing the asset
-	// transfer.
-	virtualTx, _, err := VirtualTx(newAsset, prevAssets)
+	newAsset, virtualTx, prevAssets, isSplit, err := signingVirtualTx(vPkt)
 	if err != nil {
 		return err
 	}
@@ -643,6 +620,73 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 		newAsset.PrevWitnesses[idx].TxWitness = newWitness
 	}
 
+	return finalizeVirtualTx(vPkt, newAsset, prevAssets, isSplit, validator)
+}
+
+// AttachVirtualTxWitnesses attaches the given witnesses, one for each input
+// and created outside of this process (for example by a signing service), to
+// the new asset of the virtual packet. The transfer is then verified with the
+// Taproot Asset VM, so only witnesses valid for the virtual transaction of the
+// packet are accepted.
+func AttachVirtualTxWitnesses(vPkt *tappsbt.VPacket,
+	witnesses []wire.TxWitness, validator TxValidator) error {
+
+	if len(witnesses) != len(vPkt.Inputs) {
+		return fmt.Errorf("got %d witnesses for %d inputs",
+			len(witnesses), len(vPkt.Inputs))
+	}
+
+	newAsset, _, prevAssets, isSplit, err := signingVirtualTx(vPkt)
+	if err != nil {
+		return err
+	}
+
+	for idx := range witnesses {
+		if len(witnesses[idx]) == 0 {
+			return fmt.Errorf("missing witness for input %d", idx)
+		}
+
+		newAsset.PrevWitnesses[idx].TxWitness = witnesses[idx]
+	}
+
+	return finalizeVirtualTx(vPkt, newAsset, prevAssets, isSplit, validator)
+}
+
+// VirtualTxWitnesses returns the witnesses of all inputs of the given signed
+// virtual packet, in the order of the inputs.
+func VirtualTxWitnesses(vPkt *tappsbt.VPacket) ([]wire.TxWitness, error) {
+	if len(vPkt.Outputs) == 0 {
+		return nil, fmt.Errorf("virtual packet has no outputs")
+	}
+
+	newAsset, _, _, _, err := signingVirtualTx(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(newAsset.PrevWitnesses) != len(vPkt.Inputs) {
+		return nil, fmt.Errorf("got %d witnesses for %d inputs",
+			len(newAsset.PrevWitnesses), len(vPkt.Inputs))
+	}
+
+	witnesses := make([]wire.TxWitness, len(newAsset.PrevWitnesses))
+	for idx := range newAsset.PrevWitnesses {
+		witnesses[idx] = newAsset.PrevWitnesses[idx].TxWitness
+	}
+
+	return witnesses, nil
+}
+
+// finalizeVirtualTx validates the transfer of the given virtual packet, with
+// the witnesses attached to its new asset, with the Taproot Asset VM. For a
+// split transfer, the signed root asset is then also committed to by each
+// split asset.
+func finalizeVirtualTx(vPkt *tappsbt.VPacket, newAsset *asset.Asset,
+	prevAssets commitment.InputSet, isSplit bool,
+	validator TxValidator) error {
+
+	outputs := vPkt.Outputs
+
 	// Create an instance of the Taproot Asset VM and validate the transfer.
 	verifySpend := func(splitAssets []*commitment.SplitAsset) error {
 		newAssetCopy := newAsset.Copy()
@@ -699,6 +743,104 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 	return nil
 }
 
+// signingVirtualTx identifies the new asset of the given virtual packet that
+// receives the witnesses of the transfer (the root asset located at the change
+// output in case of a split) and creates the virtual transaction that must be
+// signed for it. The set of input assets is returned as well, along with
+// whether the transfer is a split.
+func signingVirtualTx(vPkt *tappsbt.VPacket) (*asset.Asset, *wire.MsgTx,
+	commitment.InputSet, bool, error) {
+
+	// If this is a split transfer, it means that the asset to be signed is
+	// the root asset, which is located at the change output.
+	isSplit, err := vPkt.HasSplitCommitment()
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	// Identify new output asset. For splits, the new asset that receives
+	// the signature is the one with the split root set to true.
+	newAsset := vPkt.Outputs[0].Asset
+	if isSplit {
+		splitOut, err := vPkt.SplitRootOutput()
+		if err != nil {
+			return nil, nil, nil, false, fmt.Errorf("no split "+
+				"root output found for split transaction: %w",
+				err)
+		}
+		newAsset = splitOut.Asset
+	}
+
+	// Construct input set from all input assets.
+	prevAssets := make(commitment.InputSet, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		input := vPkt.Inputs[idx]
+		prevAssets[input.PrevID] = input.Asset()
+	}
+
+	// Create a Taproot Asset virtual transaction representing the asset
+	// transfer.
+	virtualTx, _, err := VirtualTx(newAsset, prevAssets)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	return newAsset, virtualTx, prevAssets, isSplit, nil
+}
+
+// ValidateVirtualTransaction validates the asset transfer of an already signed
+// virtual packet with the Taproot Asset VM. For a split transfer, the split
+// root asset and all split assets are validated.
+func ValidateVirtualTransaction(vPkt *tappsbt.VPacket,
+	validator TxValidator) error {
+
+	if len(vPkt.Inputs) == 0 || len(vPkt.Outputs) == 0 {
+		return fmt.Errorf("virtual packet must have inputs and outputs")
+	}
+
+	isSplit, err := vPkt.HasSplitCommitment()
+	if err != nil {
+		return err
+	}
+
+	prevAssets := make(commitment.InputSet, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		input := vPkt.Inputs[idx]
+		prevAssets[input.PrevID] = input.Asset()
+	}
+
+	if !isSplit {
+		return validator.Execute(
+			vPkt.Outputs[0].Asset.Copy(), nil, prevAssets,
+		)
+	}
+
+	splitOut, err := vPkt.SplitRootOutput()
+	if err != nil {
+		return fmt.Errorf("no split root output found for split "+
+			"transaction: %w", err)
+	}
+
+	splitAssets := make([]*commitment.SplitAsset, len(vPkt.Outputs))
+	for idx, vOut := range vPkt.Outputs {
+		splitAsset := vOut.Asset
+		if vOut.Type.IsSplitRoot() {
+			splitAsset = vOut.SplitAsset
+		}
+		if splitAsset == nil {
+			return fmt.Errorf("output %d is missing its split "+
+				"asset", idx)
+		}
+
+		splitAssets[idx] = &commitment.SplitAsset{
+			Asset:       *splitAsset,
+			OutputIndex: vOut.AnchorOutputIndex,
+		}
+	}
+
+	return validator.Execute(splitOut.Asset.Copy(), splitAssets, prevAssets)
+}
+
 // CreateOutputCommitments creates the final set of Taproot asset commitments
 // representing the asset send.
 func CreateOutputCommitments(inputTapCommitments tappsbt.InputCommitments,
@@ -1035,6 +1035,63 @@ var signVirtualTransactionTestCases = []testCase{{
 		return nil
 	},
 	err: nil,
+}, {
+	name: "attach witnesses of externally signed asset split",
+	f: func(t *testing.T) error {
+		state := initSpendScenario(t)
+
+		newPkt := func() *tappsbt.VPacket {
+			pkt := createPacket(
+				state.address1, state.asset2PrevID,
+				state, state.asset2InputAssets, false,
+			)
+			err := tapscript.PrepareOutputAssets(
+				context.Background(), pkt,
+			)
+			require.NoError(t, err)
+
+			return pkt
+		}
+
+		// The packet is signed "externally", then its witnesses are
+		// attached to our own, unsigned copy of the packet.
+		signedPkt := newPkt()
+		err := tapscript.SignVirtualTransaction(
+			signedPkt, state.signer, state.validator,
+		)
+		require.NoError(t, err)
+
+		witnesses, err := tapscript.VirtualTxWitnesses(signedPkt)
+		require.NoError(t, err)
+		require.Len(t, witnesses, 1)
+
+		pkt := newPkt()
+		unvalidatedAsset := pkt.Outputs[0].Asset.Copy()
+		err = tapscript.AttachVirtualTxWitnesses(
+			pkt, witnesses, state.validator,
+		)
+		require.NoError(t, err)
+
+		checkSignedAsset(
+			t, unvalidatedAsset, pkt.Outputs[0].Asset, true, false,
+		)
+		require.Equal(t, signedPkt.Outputs, pkt.Outputs)
+
+		// An invalid signature is rejected by the VM.
+		invalidSig := bytes.Clone(witnesses[0][0])
+		invalidSig[0] ^= 0x01
+		err = tapscript.AttachVirtualTxWitnesses(
+			newPkt(), []wire.TxWitness{{invalidSig}},
+			state.validator,
+		)
+
+		var vmErr vm.Error
+		require.ErrorAs(t, err, &vmErr)
+		require.Equal(t, vm.ErrInvalidTransferWitness, vmErr.Kind)
+
+		return nil
+	},
+	err: nil,
 }}
 
 // TestCreateOutputCommitments tests edge cases around creating TapCommitments
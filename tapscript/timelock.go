@@ -0,0 +1,125 @@
+package tapscript
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+var (
+	// ErrInvalidTimeLock is returned if a time lock doesn't restrict
+	// anything or uses a relative lock time that is disabled.
+	ErrInvalidTimeLock = errors.New("invalid time lock")
+)
+
+// TimeLock is the time restriction of a time locked script key. The Taproot
+// Asset VM uses the lock time and relative lock time of the asset that is
+// spent as the lock time and sequence of the virtual transaction, so an asset
+// can only be spent through the time locked script path if its lock times
+// satisfy the conditions of the script.
+type TimeLock struct {
+	// LockTime is the absolute lock time, as a block height or UNIX
+	// timestamp, that is enforced with OP_CHECKLOCKTIMEVERIFY. Zero means
+	// there is no absolute lock time.
+	LockTime uint32
+
+	// RelativeLockTime is the BIP-0068 encoded relative lock time that is
+	// enforced with OP_CHECKSEQUENCEVERIFY. Zero means there is no
+	// relative lock time.
+	RelativeLockTime uint32
+}
+
+// Validate makes sure the time lock restricts the spend and can be satisfied.
+func (t TimeLock) Validate() error {
+	if t.LockTime == 0 && t.RelativeLockTime == 0 {
+		return fmt.Errorf("%w: neither lock time nor relative lock "+
+			"time set", ErrInvalidTimeLock)
+	}
+
+	if t.RelativeLockTime&wire.SequenceLockTimeDisabled != 0 {
+		return fmt.Errorf("%w: relative lock time %d is disabled",
+			ErrInvalidTimeLock, t.RelativeLockTime)
+	}
+
+	return nil
+}
+
+// Script returns the leaf script of the time lock template for the given key
+// that must sign the spend:
+//
+//	[<lock_time> OP_CHECKLOCKTIMEVERIFY OP_DROP]
+//	[<relative_lock_time> OP_CHECKSEQUENCEVERIFY OP_DROP]
+//	<spend_key> OP_CHECKSIG
+func (t TimeLock) Script(spendKey *btcec.PublicKey) ([]byte, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	builder := txscript.NewScriptBuilder()
+	if t.LockTime != 0 {
+		builder.AddInt64(int64(t.LockTime))
+		builder.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+		builder.AddOp(txscript.OP_DROP)
+	}
+	if t.RelativeLockTime != 0 {
+		builder.AddInt64(int64(t.RelativeLockTime))
+		builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+		builder.AddOp(txscript.OP_DROP)
+	}
+	builder.AddData(schnorr.SerializePubKey(spendKey))
+	builder.AddOp(txscript.OP_CHECKSIG)
+
+	return builder.Script()
+}
+
+// NewTimeLockScriptKey creates a script key that can be spent by the spend key
+// through the time locked script path of the template, or by the internal key
+// through the key path. To only allow the time locked spend, for example to
+// vest an asset, asset.NUMSPubKey should be used as the internal key. The
+// returned leaf script contains the control block needed to spend the asset
+// through the script path.
+func NewTimeLockScriptKey(internalKey keychain.KeyDescriptor,
+	spendKey *btcec.PublicKey, lock TimeLock) (asset.ScriptKey,
+	*psbt.TaprootTapLeafScript, error) {
+
+	script, err := lock.Script(spendKey)
+	if err != nil {
+		return asset.ScriptKey{}, nil, err
+	}
+
+	leaf := txscript.NewBaseTapLeaf(script)
+	tree := txscript.AssembleTaprootScriptTree(leaf)
+	rootHash := tree.RootNode.TapHash()
+
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(
+		internalKey.PubKey,
+	)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	if err != nil {
+		return asset.ScriptKey{}, nil, fmt.Errorf("unable to encode "+
+			"control block: %w", err)
+	}
+
+	scriptKey := asset.ScriptKey{
+		PubKey: txscript.ComputeTaprootOutputKey(
+			internalKey.PubKey, rootHash[:],
+		),
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: internalKey,
+			Tweak:  rootHash[:],
+		},
+	}
+
+	return scriptKey, &psbt.TaprootTapLeafScript{
+		ControlBlock: controlBlockBytes,
+		Script:       leaf.Script,
+		LeafVersion:  leaf.LeafVersion,
+	}, nil
+}
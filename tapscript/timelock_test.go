@@ -0,0 +1,92 @@
+package tapscript_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTimeLockScriptKey tests that an asset locked to a time lock script key
+// can only be spent through the script path if its lock times satisfy the time
+// lock.
+func TestTimeLockScriptKey(t *testing.T) {
+	t.Parallel()
+
+	lock := tapscript.TimeLock{
+		LockTime:         800_000,
+		RelativeLockTime: 144,
+	}
+
+	err := tapscript.TimeLock{}.Validate()
+	require.ErrorIs(t, err, tapscript.ErrInvalidTimeLock)
+	require.ErrorIs(t, tapscript.TimeLock{
+		RelativeLockTime: wire.SequenceLockTimeDisabled | 1,
+	}.Validate(), tapscript.ErrInvalidTimeLock)
+
+	spendPrivKey := test.RandPrivKey(t)
+	scriptKey, leafScript, err := tapscript.NewTimeLockScriptKey(
+		keychain.KeyDescriptor{PubKey: asset.NUMSPubKey},
+		spendPrivKey.PubKey(), lock,
+	)
+	require.NoError(t, err)
+
+	tapLeaf := txscript.NewBaseTapLeaf(leafScript.Script)
+
+	// spend creates and validates a transfer of an asset with the given
+	// lock times that is locked to the time lock script key.
+	spend := func(lockTime, relativeLockTime uint64) error {
+		inputAsset := asset.RandAsset(t, asset.Normal)
+		inputAsset.ScriptKey = scriptKey
+		inputAsset.LockTime = lockTime
+		inputAsset.RelativeLockTime = relativeLockTime
+
+		prevID := asset.PrevID{
+			OutPoint:  test.RandOp(t),
+			ID:        inputAsset.ID(),
+			ScriptKey: asset.ToSerialized(scriptKey.PubKey),
+		}
+		newAsset := inputAsset.Copy()
+		newAsset.LockTime = 0
+		newAsset.RelativeLockTime = 0
+		newAsset.ScriptKey = asset.RandScriptKey(t)
+		newAsset.PrevWitnesses = []asset.Witness{{
+			PrevID: &prevID,
+		}}
+
+		inputs := commitment.InputSet{prevID: inputAsset}
+		virtualTx, _, err := tapscript.VirtualTx(newAsset, inputs)
+		require.NoError(t, err)
+
+		virtualTxCopy := asset.VirtualTxWithInput(
+			virtualTx, inputAsset, 0, nil,
+		)
+		sigHash, err := tapscript.InputScriptSpendSigHash(
+			virtualTxCopy, inputAsset, 0, txscript.SigHashDefault,
+			&tapLeaf,
+		)
+		require.NoError(t, err)
+
+		sig, err := schnorr.Sign(spendPrivKey, sigHash)
+		require.NoError(t, err)
+
+		newAsset.PrevWitnesses[0].TxWitness = wire.TxWitness{
+			sig.Serialize(), leafScript.Script,
+			leafScript.ControlBlock,
+		}
+
+		return (&tap.ValidatorV0{}).Execute(newAsset, nil, inputs)
+	}
+
+	require.NoError(t, spend(800_000, 144))
+	require.Error(t, spend(799_999, 144))
+	require.Error(t, spend(800_000, 143))
+}
@@ -54,6 +54,12 @@ type FederationConfig struct {
 	// ServerChecker is a function that can be used to check if a server is
 	// operational and not the local daemon.
 	ServerChecker func(ServerAddr) error
+
+	// Publisher is an optional proof publisher that new issuance proofs
+	// are handed to after they've been registered locally. It publishes
+	// them to a static set of universe servers, independent of the
+	// federation.
+	Publisher *ProofPublisher
 }
 
 // FederationPushReq is used to push out new updates to all or some members of
@@ -139,6 +145,13 @@ func (f *FederationEnvoy) Start() error {
 			return true
 		})
 
+		if f.cfg.Publisher != nil {
+			if err := f.cfg.Publisher.Start(); err != nil {
+				log.Warnf("Unable to start proof publisher: %v",
+					err)
+			}
+		}
+
 		err := f.AddServer(serverAddrs...)
 		// On restart, we'll get an error for universe servers already
 		// inserted in our DB, since we can't store duplicates.
@@ -164,6 +177,13 @@ func (f *FederationEnvoy) Stop() error {
 
 		f.Wg.Wait()
 
+		if f.cfg.Publisher != nil {
+			if err := f.cfg.Publisher.Stop(); err != nil {
+				log.Warnf("Unable to stop proof publisher: %v",
+					err)
+			}
+		}
+
 		log.Infof("Stopped FederationEnvoy")
 	})
 
@@ -283,6 +303,29 @@ func (f *FederationEnvoy) pushProofToFederation(uniID Identifier, key LeafKey,
 	}
 }
 
+// publishProofs hands the given issuance items to the proof publisher, if one
+// is configured.
+func (f *FederationEnvoy) publishProofs(items ...*IssuanceItem) {
+	if f.cfg.Publisher == nil {
+		return
+	}
+
+	f.cfg.Publisher.Publish(items...)
+}
+
+// PublicationStatus returns the publication status of all static publication
+// targets. If a filter is given, only the publications of the proofs it
+// matches are returned.
+func (f *FederationEnvoy) PublicationStatus(
+	filter func(Identifier) bool) []PublicationTargetStatus {
+
+	if f.cfg.Publisher == nil {
+		return nil
+	}
+
+	return f.cfg.Publisher.Status(filter)
+}
+
 // syncer is the main goroutine that's responsible for interacting with the
 // federation envoy. It also accepts incoming requests to push out new updates
 // to the federation.
@@ -360,6 +403,12 @@ func (f *FederationEnvoy) syncer() {
 				pushReq.ID, pushReq.Key, pushReq.Leaf,
 			)
 
+			f.publishProofs(&IssuanceItem{
+				ID:   pushReq.ID,
+				Key:  pushReq.Key,
+				Leaf: pushReq.Leaf,
+			})
+
 		case pushReq := <-f.batchPushRequests:
 			ctx, cancel := f.WithCtxQuitNoTimeout()
 
@@ -394,6 +443,8 @@ func (f *FederationEnvoy) syncer() {
 				}
 			}()
 
+			f.publishProofs(pushReq.IssuanceBatch...)
+
 		case <-f.Quit:
 			return
 		}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -59,6 +60,14 @@ type MintingArchive struct {
 	// instances for the archive.
 	baseUniverses map[Identifier]BaseBackend
 
+	// groupRules caches the rules committed to by the group anchors of the
+	// asset groups, keyed by group key. A nil entry means the stored
+	// issuances of the group didn't include its anchor.
+	groupRules map[asset.SerializedKey]*groupAnchorRules
+
+	// groupRulesMtx guards groupRules.
+	groupRulesMtx sync.Mutex
+
 	sync.RWMutex
 }
 
@@ -67,6 +76,9 @@ func NewMintingArchive(cfg MintingArchiveConfig) *MintingArchive {
 	a := &MintingArchive{
 		cfg:           cfg,
 		baseUniverses: make(map[Identifier]BaseBackend),
+		groupRules: make(
+			map[asset.SerializedKey]*groupAnchorRules,
+		),
 	}
 
 	return a
@@ -211,6 +223,17 @@ func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 		return nil, err
 	}
 
+	// A new issuance into an asset group must also respect the max supply
+	// of the group.
+	err = a.verifyGroupSupply(ctx, id, []*IssuanceItem{{
+		ID:   id,
+		Key:  key,
+		Leaf: leaf,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
 	// Now that we know the proof is valid, we'll insert it into the base
 	// multiverse backend, and return the new issuance proof.
 	issuanceProof, err := a.cfg.Multiverse.UpsertProofLeaf(
@@ -280,6 +303,412 @@ func (a *MintingArchive) verifyIssuanceProof(ctx context.Context, id Identifier,
 	return assetSnapshot, nil
 }
 
+// groupAnchorRules are the max supply, the emission schedule and the issuance
+// delegations the group anchor of an asset group committed to in its meta data.
+type groupAnchorRules struct {
+	// anchorKey is the leaf key of the issuance of the group anchor.
+	anchorKey LeafKey
+
+	// maxSupply is the max supply of the group. Zero means the supply of
+	// the group isn't capped.
+	maxSupply uint64
+
+	// schedule is the emission schedule of the group.
+	schedule proof.EmissionSchedule
+
+	// delegations are the issuance delegations of the group, which allow
+	// delegate keys to issue into the group up to their own limits.
+	delegations proof.IssuanceDelegations
+}
+
+// isGroupAnchor returns true if the given issuance proof created the asset
+// group with the given key. Only the group anchor reveals the raw group key,
+// which is tweaked with the ID of the anchor asset to derive the group key.
+func isGroupAnchor(leafProof *proof.Proof, groupKey *btcec.PublicKey) bool {
+	if leafProof.GroupKeyReveal == nil {
+		return false
+	}
+
+	revealedKey, err := leafProof.GroupKeyReveal.GroupPubKey(
+		leafProof.Asset.ID(),
+	)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(
+		schnorr.SerializePubKey(revealedKey),
+		schnorr.SerializePubKey(groupKey),
+	)
+}
+
+// newGroupAnchorRules extracts the rules committed to by the meta data of the
+// given group anchor issuance proof.
+func newGroupAnchorRules(leafProof *proof.Proof) (*groupAnchorRules, error) {
+	maxSupply, err := leafProof.MetaReveal.MaxSupply()
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := leafProof.MetaReveal.EmissionSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	// The group key must commit to the leaves of the delegations, as
+	// delegate keys couldn't issue into the group otherwise. More
+	// importantly, a group key with other leaves could be used to issue
+	// without the limits of the delegations.
+	delegations, err := leafProof.MetaReveal.Delegations()
+	if err != nil {
+		return nil, err
+	}
+	if len(delegations) != 0 {
+		root, err := delegations.TapscriptRoot()
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(root, leafProof.GroupKeyReveal.TapscriptRoot) {
+			return nil, fmt.Errorf("tapscript root of group key " +
+				"doesn't commit to issuance delegations")
+		}
+	}
+
+	return &groupAnchorRules{
+		anchorKey: LeafKey{
+			OutPoint:  leafProof.OutPoint(),
+			ScriptKey: &leafProof.Asset.ScriptKey,
+		},
+		maxSupply:   maxSupply,
+		schedule:    schedule,
+		delegations: delegations,
+	}, nil
+}
+
+// fetchGroupRules returns the rules committed to by the group anchor of the
+// asset group universe with the given ID, looking for the anchor among the
+// given new issuances first. Nil is returned if the group anchor is unknown.
+// The rules of a group can't change, as the group key commits to the genesis
+// of its anchor, so they're cached once the anchor is found.
+func (a *MintingArchive) fetchGroupRules(ctx context.Context, id Identifier,
+	items []*IssuanceItem) (*groupAnchorRules, error) {
+
+	groupKey := asset.ToSerialized(id.GroupKey)
+
+	a.groupRulesMtx.Lock()
+	defer a.groupRulesMtx.Unlock()
+
+	rules, scanned := a.groupRules[groupKey]
+	if rules != nil {
+		return rules, nil
+	}
+
+	for _, item := range items {
+		if !isGroupAnchor(item.Leaf.Proof, id.GroupKey) {
+			continue
+		}
+
+		rules, err := newGroupAnchorRules(item.Leaf.Proof)
+		if err != nil {
+			return nil, err
+		}
+		a.groupRules[groupKey] = rules
+
+		return rules, nil
+	}
+
+	// If we already searched the stored issuances of the group for its
+	// anchor, we know the anchor can only be added as a new issuance.
+	if scanned {
+		return nil, nil
+	}
+
+	leaves, err := a.MintingLeaves(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch group issuances: %w",
+			err)
+	}
+	for idx := range leaves {
+		leafProof := leaves[idx].Proof
+		if !isGroupAnchor(leafProof, id.GroupKey) {
+			continue
+		}
+
+		rules, err = newGroupAnchorRules(leafProof)
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+	a.groupRules[groupKey] = rules
+
+	return rules, nil
+}
+
+// issuedSupply returns the supply issued into the asset group universe with
+// the given ID so far, which is the sum of its root node.
+func (a *MintingArchive) issuedSupply(ctx context.Context,
+	id Identifier) (uint64, error) {
+
+	root, err := a.RootNode(ctx, id)
+	switch {
+	case errors.Is(err, ErrNoUniverseRoot):
+		return 0, nil
+
+	case err != nil:
+		return 0, fmt.Errorf("unable to fetch universe root: %w", err)
+	}
+
+	return root.NodeSum(), nil
+}
+
+// verifyGroupSupply makes sure the new issuance items of a grouped asset don't
+// push the supply of the group over the max supply committed to in the meta
+// data of the group anchor, and that they don't issue more than the emission
+// schedule of the group unlocked by the height they were confirmed at. All
+// items must belong to the universe with the given ID, which may already
+// contain the group anchor.
+func (a *MintingArchive) verifyGroupSupply(ctx context.Context, id Identifier,
+	items []*IssuanceItem) error {
+
+	if id.GroupKey == nil || id.ProofType != ProofTypeIssuance {
+		return nil
+	}
+
+	rules, err := a.fetchGroupRules(ctx, id, items)
+	if err != nil {
+		return err
+	}
+	if rules == nil || (rules.maxSupply == 0 && len(rules.schedule) == 0 &&
+		len(rules.delegations) == 0) {
+
+		return nil
+	}
+
+	// Issuances that are already known, for example because the minting
+	// transaction was re-organized, don't add to the supply again.
+	var newItems []*IssuanceItem
+	for _, item := range items {
+		_, err := withBaseUni(a, id, func(baseUni BaseBackend) (
+			[]*Proof, error) {
+
+			return baseUni.FetchIssuanceProof(ctx, item.Key)
+		})
+		switch {
+		case errors.Is(err, ErrNoUniverseProofFound):
+			newItems = append(newItems, item)
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch issuance: %w", err)
+		}
+	}
+
+	if len(rules.delegations) != 0 {
+		err := a.verifyDelegatedIssuance(
+			ctx, id, rules.delegations, newItems,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	issued, err := a.issuedSupply(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if rules.maxSupply != 0 {
+		total := issued
+		for _, item := range newItems {
+			// We compare against the remaining supply to avoid an
+			// overflow of the summed amounts.
+			amt := item.Leaf.Amt
+			if total > rules.maxSupply ||
+				amt > rules.maxSupply-total {
+
+				return fmt.Errorf("%w: issuing %d on top of "+
+					"%d exceeds max supply %d",
+					proof.ErrMaxSupplyExceeded, amt, total,
+					rules.maxSupply)
+			}
+
+			total += amt
+		}
+	}
+
+	if len(rules.schedule) == 0 {
+		return nil
+	}
+
+	// The emission schedule limits the supply issued up to each block
+	// height. Counting all known issuances against each new one is
+	// stricter than that, since the schedule only unlocks more supply
+	// over time. So if that passes, the new issuances are fine.
+	sort.SliceStable(newItems, func(i, j int) bool {
+		return newItems[i].Leaf.Proof.BlockHeight <
+			newItems[j].Leaf.Proof.BlockHeight
+	})
+	emitted := issued
+	for _, item := range newItems {
+		leaf := item.Leaf
+		err := rules.schedule.CheckIssuance(
+			emitted, leaf.Amt, leaf.Proof.BlockHeight,
+		)
+		if err != nil {
+			return a.verifyGroupEmission(
+				ctx, id, rules.schedule, newItems,
+			)
+		}
+
+		emitted += leaf.Amt
+	}
+
+	return nil
+}
+
+// verifyGroupEmission makes sure the given new issuances and all known
+// issuances of the asset group universe with the given ID together don't
+// issue more than the emission schedule unlocked by the heights they were
+// confirmed at. This requires all known issuances, so it's only used if a
+// new issuance was confirmed before known ones.
+func (a *MintingArchive) verifyGroupEmission(ctx context.Context,
+	id Identifier, schedule proof.EmissionSchedule,
+	newItems []*IssuanceItem) error {
+
+	knownLeaves, err := a.MintingLeaves(ctx, id)
+	if err != nil {
+		return fmt.Errorf("unable to fetch group issuances: %w", err)
+	}
+
+	// We go through all issuances in the order they were confirmed in.
+	leaves := make([]*Leaf, 0, len(knownLeaves)+len(newItems))
+	for idx := range knownLeaves {
+		leaves = append(leaves, &knownLeaves[idx])
+	}
+	for _, item := range newItems {
+		leaves = append(leaves, item.Leaf)
+	}
+	sort.SliceStable(leaves, func(i, j int) bool {
+		return leaves[i].Proof.BlockHeight < leaves[j].Proof.BlockHeight
+	})
+
+	var emitted uint64
+	for _, leaf := range leaves {
+		err := schedule.CheckIssuance(
+			emitted, leaf.Amt, leaf.Proof.BlockHeight,
+		)
+		if err != nil {
+			return err
+		}
+
+		emitted += leaf.Amt
+	}
+
+	return nil
+}
+
+// leafDelegation returns the issuance delegation whose leaf is spent by the
+// group witness of the given issuance leaf, or nil if the asset wasn't issued
+// by a delegate key.
+func leafDelegation(delegations proof.IssuanceDelegations,
+	leaf *Leaf) *proof.IssuanceDelegation {
+
+	if leaf.Proof == nil || len(leaf.Proof.Asset.PrevWitnesses) == 0 {
+		return nil
+	}
+
+	return delegations.FromWitness(
+		leaf.Proof.Asset.PrevWitnesses[0].TxWitness,
+	)
+}
+
+// verifyDelegatedIssuance makes sure the new issuances of the asset group
+// universe with the given ID that were issued by a delegate key were confirmed
+// before the delegation of the key expired, and that no delegate key issues
+// more than the max amount of its delegation, summed over all of its known
+// and new issuances. This requires all known issuances, so they're only
+// fetched if a new issuance was issued by a delegate key.
+func (a *MintingArchive) verifyDelegatedIssuance(ctx context.Context,
+	id Identifier, delegations proof.IssuanceDelegations,
+	newItems []*IssuanceItem) error {
+
+	hasDelegated := fn.Any(newItems, func(item *IssuanceItem) bool {
+		return leafDelegation(delegations, item.Leaf) != nil
+	})
+	if !hasDelegated {
+		return nil
+	}
+
+	knownLeaves, err := a.MintingLeaves(ctx, id)
+	if err != nil {
+		return fmt.Errorf("unable to fetch group issuances: %w", err)
+	}
+
+	delegated := make(map[string]uint64)
+	for idx := range knownLeaves {
+		delegation := leafDelegation(delegations, &knownLeaves[idx])
+		if delegation == nil {
+			continue
+		}
+
+		delegated[delegation.Key] += knownLeaves[idx].Amt
+	}
+
+	for _, item := range newItems {
+		delegation := leafDelegation(delegations, item.Leaf)
+		if delegation == nil {
+			continue
+		}
+
+		err := delegation.CheckIssuance(
+			delegated[delegation.Key], item.Leaf.Amt,
+			item.Leaf.Proof.BlockHeight,
+		)
+		if err != nil {
+			return err
+		}
+
+		delegated[delegation.Key] += item.Leaf.Amt
+	}
+
+	return nil
+}
+
+// GroupEmission returns the max supply and the emission schedule committed to
+// by the group anchor of the asset group universe with the given ID, along
+// with the supply issued into the group so far. Verifiers can check the
+// commitment by fetching the issuance proof of the group anchor.
+func (a *MintingArchive) GroupEmission(ctx context.Context,
+	id Identifier) (*GroupEmission, error) {
+
+	if id.GroupKey == nil || id.ProofType != ProofTypeIssuance {
+		return nil, fmt.Errorf("emission schedules are only defined " +
+			"for asset group issuance universes")
+	}
+
+	rules, err := a.fetchGroupRules(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		return nil, fmt.Errorf("%w: group anchor not found",
+			ErrNoUniverseProofFound)
+	}
+
+	issued, err := a.issuedSupply(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupEmission{
+		AnchorKey: rules.anchorKey,
+		MaxSupply: rules.maxSupply,
+		Schedule:  rules.schedule,
+		Issued:    issued,
+	}, nil
+}
+
 // RegisterNewIssuanceBatch inserts a batch of new minting leaves within the
 // target universe tree (based on the ID), stored at the base key(s). We assume
 // the proofs within the batch have already been checked that they don't yet
@@ -354,11 +783,37 @@ func (a *MintingArchive) RegisterNewIssuanceBatch(ctx context.Context,
 		return nil
 	}
 
+	// The issuances of each asset group in the batch must not exceed the
+	// max supply of the group, summed over all new and known issuances.
+	verifySupply := func(batchItems []*IssuanceItem) error {
+		itemsByID := make(map[string][]*IssuanceItem)
+		ids := make(map[string]Identifier)
+		for _, item := range batchItems {
+			idStr := item.ID.String()
+			itemsByID[idStr] = append(itemsByID[idStr], item)
+			ids[idStr] = item.ID
+		}
+
+		for idStr, idItems := range itemsByID {
+			err := a.verifyGroupSupply(ctx, ids[idStr], idItems)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
 	err := verifyBatch(anchorItems)
 	if err != nil {
 		return err
 	}
 
+	err = verifySupply(anchorItems)
+	if err != nil {
+		return err
+	}
+
 	log.Infof("Inserting %d verified group anchor proofs into Universe",
 		len(anchorItems))
 	err = a.cfg.Multiverse.RegisterBatchIssuance(ctx, anchorItems)
@@ -372,6 +827,11 @@ func (a *MintingArchive) RegisterNewIssuanceBatch(ctx context.Context,
 		return err
 	}
 
+	err = verifySupply(nonAnchorItems)
+	if err != nil {
+		return err
+	}
+
 	log.Infof("Inserting %d verified proofs into Universe",
 		len(nonAnchorItems))
 	err = a.cfg.Multiverse.RegisterBatchIssuance(ctx, nonAnchorItems)
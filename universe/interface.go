@@ -118,6 +118,27 @@ type Leaf struct {
 	Amt uint64
 }
 
+// GroupEmission describes the issuance rules an asset group committed to when
+// it was created, along with the supply issued into the group so far.
+type GroupEmission struct {
+	// AnchorKey is the key of the issuance leaf of the group anchor, which
+	// commits to the max supply and the emission schedule in its meta
+	// data.
+	AnchorKey LeafKey
+
+	// MaxSupply is the max supply of the group. Zero means the supply of
+	// the group isn't capped.
+	MaxSupply uint64
+
+	// Schedule is the emission schedule of the group. An empty schedule
+	// means issuances aren't restricted by block height.
+	Schedule proof.EmissionSchedule
+
+	// Issued is the total amount of all issuances into the group known to
+	// the universe.
+	Issued uint64
+}
+
 // SmtLeafNode returns the SMT leaf node for the given leaf.
 func (m *Leaf) SmtLeafNode() (*mssmt.LeafNode, error) {
 	var buf bytes.Buffer
@@ -589,6 +610,21 @@ type FederationLog interface {
 	LogNewSyncs(ctx context.Context, addrs ...ServerAddr) error
 }
 
+// PublicationLog is used to persist the publication state of the issuance
+// proofs published to the static publication targets, so the publications
+// that are still pending survive a restart.
+type PublicationLog interface {
+	// UpsertPublication inserts the publication of a proof to the given
+	// target, or updates its state if it already exists.
+	UpsertPublication(ctx context.Context, target ServerAddr,
+		pub Publication) error
+
+	// QueryPublications returns the publications of all proofs to the
+	// given target, in the order they were queued.
+	QueryPublications(ctx context.Context,
+		target ServerAddr) ([]Publication, error)
+}
+
 // ProofType is an enum that describes the type of proof which can be stored in
 // a given universe.
 type ProofType uint8
@@ -0,0 +1,542 @@
+package universe
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// DefaultPublishMinBackoff is the default amount of time we wait
+	// before retrying to publish a proof to a target after the first
+	// failed attempt.
+	DefaultPublishMinBackoff = 5 * time.Second
+
+	// DefaultPublishMaxBackoff is the default upper bound of the time we
+	// wait between two attempts to publish a proof to a target.
+	DefaultPublishMaxBackoff = 10 * time.Minute
+
+	// DefaultPublishMaxAttempts is the default number of attempts after
+	// which we give up publishing a single proof to a target.
+	DefaultPublishMaxAttempts = 20
+)
+
+// PublicationState denotes whether a proof has reached a publication target.
+type PublicationState uint8
+
+const (
+	// PublicationPending denotes that the proof hasn't been accepted by
+	// the target yet, and that we'll keep trying to publish it.
+	PublicationPending PublicationState = 0
+
+	// PublicationPublished denotes that the proof was accepted by the
+	// target.
+	PublicationPublished PublicationState = 1
+
+	// PublicationFailed denotes that we gave up publishing the proof to
+	// the target after the maximum number of attempts.
+	PublicationFailed PublicationState = 2
+)
+
+// String returns a human-readable string for the publication state.
+func (s PublicationState) String() string {
+	switch s {
+	case PublicationPending:
+		return "pending"
+
+	case PublicationPublished:
+		return "published"
+
+	case PublicationFailed:
+		return "failed"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// Publication is the publication status of a single proof for a single
+// target.
+type Publication struct {
+	// ID identifies the Universe tree the proof belongs to.
+	ID Identifier
+
+	// Key is the leaf key of the proof within the Universe tree.
+	Key LeafKey
+
+	// State is the current publication state of the proof.
+	State PublicationState
+
+	// NumAttempts is the number of times we tried to publish the proof.
+	NumAttempts uint32
+
+	// LastAttempt is the time of the last attempt to publish the proof.
+	LastAttempt time.Time
+
+	// LastErr is the error of the last failed attempt, if any.
+	LastErr error
+}
+
+// PublicationTargetStatus is the publication status of a single target.
+type PublicationTargetStatus struct {
+	// Target is the universe server the proofs are published to.
+	Target ServerAddr
+
+	// ConsecutiveFailures is the number of attempts that failed since the
+	// last successful one. The backoff of the target is derived from it.
+	ConsecutiveFailures uint32
+
+	// NextAttempt is the earliest time of the next attempt to publish a
+	// pending proof to the target.
+	NextAttempt time.Time
+
+	// Publications is the publication status of all proofs for the target,
+	// in the order they were queued.
+	Publications []Publication
+}
+
+// PublisherConfig is the config of the ProofPublisher.
+type PublisherConfig struct {
+	// Targets is the set of universe servers that all new issuance proofs
+	// are published to, independent of the federation.
+	Targets []ServerAddr
+
+	// NewRemoteRegistrar is a function that returns a new register
+	// instance to the target remote Universe.
+	NewRemoteRegistrar func(ServerAddr) (Registrar, error)
+
+	// PublicationLog is used to persist the publication state of all
+	// proofs, so pending publications are resumed after a restart.
+	PublicationLog PublicationLog
+
+	// LocalDiffEngine is the local universe the proofs of pending
+	// publications are fetched from when they are resumed after a
+	// restart.
+	LocalDiffEngine DiffEngine
+
+	// MinBackoff is the time we wait before retrying a target after the
+	// first failed attempt. It is doubled with every consecutive failure.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the upper bound of the time we wait before retrying
+	// a target.
+	MaxBackoff time.Duration
+
+	// MaxAttempts is the number of attempts after which we give up
+	// publishing a single proof to a target.
+	MaxAttempts uint32
+}
+
+// publication is a proof that is queued for publication to a target, along
+// with its publication status.
+type publication struct {
+	Publication
+
+	leaf *Leaf
+}
+
+// publicationTarget tracks the proofs queued for a single target and the
+// retry state of that target.
+type publicationTarget struct {
+	addr ServerAddr
+
+	// mu guards all fields below.
+	mu sync.Mutex
+
+	publications []*publication
+
+	pending []*publication
+
+	consecutiveFailures uint32
+
+	nextAttempt time.Time
+
+	// registrar is the connection to the target. It is re-created after
+	// a failed attempt.
+	registrar Registrar
+
+	// newProofs is signalled whenever new proofs are queued.
+	newProofs chan struct{}
+}
+
+// ProofPublisher publishes new issuance proofs to a static set of universe
+// servers. Each target is served by its own goroutine and keeps its own
+// backoff state, so an unreachable target doesn't delay the publication to
+// any other target. The publication state of every proof is persisted, so the
+// publications that are still pending are resumed after a restart.
+type ProofPublisher struct {
+	cfg PublisherConfig
+
+	targets []*publicationTarget
+
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+}
+
+// NewProofPublisher creates a new proof publisher from the passed config.
+func NewProofPublisher(cfg PublisherConfig) *ProofPublisher {
+	if cfg.MinBackoff == 0 {
+		cfg.MinBackoff = DefaultPublishMinBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = DefaultPublishMaxBackoff
+	}
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = DefaultPublishMaxAttempts
+	}
+
+	targets := fn.Map(cfg.Targets, func(a ServerAddr) *publicationTarget {
+		return &publicationTarget{
+			addr:      a,
+			newProofs: make(chan struct{}, 1),
+		}
+	})
+
+	return &ProofPublisher{
+		cfg:     cfg,
+		targets: targets,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start resumes the persisted publications and launches a publication
+// goroutine for each target.
+func (p *ProofPublisher) Start() error {
+	var startErr error
+	p.startOnce.Do(func() {
+		log.Infof("Starting ProofPublisher with %d targets",
+			len(p.targets))
+
+		for _, target := range p.targets {
+			if err := p.resumePublications(target); err != nil {
+				startErr = fmt.Errorf("unable to resume "+
+					"publications to %v: %w",
+					target.addr.HostStr(), err)
+				return
+			}
+		}
+
+		for idx := range p.targets {
+			p.Wg.Add(1)
+			go p.publisher(p.targets[idx])
+		}
+	})
+
+	return startErr
+}
+
+// resumePublications loads the persisted publications of the target. The
+// proofs of the ones that are still pending are fetched from the local
+// universe, so they can be published again.
+func (p *ProofPublisher) resumePublications(target *publicationTarget) error {
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	pubs, err := p.cfg.PublicationLog.QueryPublications(ctx, target.addr)
+	if err != nil {
+		return err
+	}
+
+	target.mu.Lock()
+	defer target.mu.Unlock()
+
+	for idx := range pubs {
+		pub := &publication{
+			Publication: pubs[idx],
+		}
+		target.publications = append(target.publications, pub)
+
+		if pub.State != PublicationPending {
+			continue
+		}
+
+		proofs, err := p.cfg.LocalDiffEngine.FetchIssuanceProof(
+			ctx, pub.ID, pub.Key,
+		)
+		if err == nil && len(proofs) == 0 {
+			err = ErrNoUniverseProofFound
+		}
+
+		// If the proof is gone from our local universe, there is
+		// nothing left to publish.
+		if err != nil {
+			log.Errorf("Unable to resume publishing proof to "+
+				"universe server %v, universe=%v: %v",
+				target.addr.HostStr(), pub.ID.StringForLog(),
+				err)
+
+			pub.State = PublicationFailed
+			pub.LastErr = err
+
+			err = p.cfg.PublicationLog.UpsertPublication(
+				ctx, target.addr, pub.Publication,
+			)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		pub.leaf = proofs[0].Leaf
+		target.pending = append(target.pending, pub)
+	}
+
+	return nil
+}
+
+// Stop stops all active goroutines.
+func (p *ProofPublisher) Stop() error {
+	p.stopOnce.Do(func() {
+		log.Infof("Stopping ProofPublisher")
+
+		close(p.Quit)
+
+		p.Wg.Wait()
+	})
+
+	return nil
+}
+
+// Publish queues the given issuance items for publication to all targets.
+// Items that don't carry an issuance proof are ignored.
+func (p *ProofPublisher) Publish(items ...*IssuanceItem) {
+	items = fn.Filter(items, func(item *IssuanceItem) bool {
+		return item.ID.ProofType == ProofTypeIssuance
+	})
+	if len(items) == 0 {
+		return
+	}
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	for _, target := range p.targets {
+		target.mu.Lock()
+		for _, item := range items {
+			pub := &publication{
+				Publication: Publication{
+					ID:    item.ID,
+					Key:   item.Key,
+					State: PublicationPending,
+				},
+				leaf: item.Leaf,
+			}
+
+			// If we can't persist the publication, we still
+			// attempt it, it just won't be resumed after a
+			// restart.
+			err := p.cfg.PublicationLog.UpsertPublication(
+				ctx, target.addr, pub.Publication,
+			)
+			if err != nil {
+				log.Errorf("Unable to persist publication to "+
+					"universe server %v, universe=%v: %v",
+					target.addr.HostStr(),
+					item.ID.StringForLog(), err)
+			}
+
+			target.publications = append(
+				target.publications, pub,
+			)
+			target.pending = append(target.pending, pub)
+		}
+		target.mu.Unlock()
+
+		select {
+		case target.newProofs <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Status returns the current publication status of all targets. If a filter
+// is given, only the publications of the proofs it matches are returned.
+func (p *ProofPublisher) Status(
+	filter func(Identifier) bool) []PublicationTargetStatus {
+
+	status := make([]PublicationTargetStatus, 0, len(p.targets))
+	for _, target := range p.targets {
+		target.mu.Lock()
+
+		targetStatus := PublicationTargetStatus{
+			Target:              target.addr,
+			ConsecutiveFailures: target.consecutiveFailures,
+			NextAttempt:         target.nextAttempt,
+		}
+		for _, pub := range target.publications {
+			if filter != nil && !filter(pub.ID) {
+				continue
+			}
+
+			targetStatus.Publications = append(
+				targetStatus.Publications, pub.Publication,
+			)
+		}
+
+		target.mu.Unlock()
+
+		status = append(status, targetStatus)
+	}
+
+	return status
+}
+
+// nextPending returns the next pending publication of the target, along with
+// the time we need to wait before attempting it.
+func (t *publicationTarget) nextPending() (*publication, time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.pending) == 0 {
+		return nil, 0
+	}
+
+	return t.pending[0], time.Until(t.nextAttempt)
+}
+
+// backoff returns the time to wait after the given number of consecutive
+// failures.
+func (p *ProofPublisher) backoff(consecutiveFailures uint32) time.Duration {
+	backoff := p.cfg.MinBackoff
+	for i := uint32(1); i < consecutiveFailures; i++ {
+		backoff *= 2
+		if backoff >= p.cfg.MaxBackoff {
+			return p.cfg.MaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// publish attempts to publish a single proof to the target and updates the
+// publication and retry state based on the outcome.
+func (p *ProofPublisher) publish(target *publicationTarget,
+	pub *publication) {
+
+	target.mu.Lock()
+	registrar := target.registrar
+	target.mu.Unlock()
+
+	var err error
+	if registrar == nil {
+		registrar, err = p.cfg.NewRemoteRegistrar(target.addr)
+	}
+	if err == nil {
+		ctx, cancel := p.WithCtxQuit()
+		_, err = registrar.RegisterIssuance(
+			ctx, pub.ID, pub.Key, pub.leaf,
+		)
+		cancel()
+	}
+
+	target.mu.Lock()
+	p.updatePublication(target, pub, registrar, err)
+	pubState := pub.Publication
+	target.mu.Unlock()
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	err = p.cfg.PublicationLog.UpsertPublication(ctx, target.addr, pubState)
+	if err != nil {
+		log.Errorf("Unable to persist publication to universe server "+
+			"%v, universe=%v: %v", target.addr.HostStr(),
+			pub.ID.StringForLog(), err)
+	}
+}
+
+// updatePublication updates the publication and the retry state of the target
+// based on the outcome of an attempt to publish the proof.
+//
+// NOTE: The caller must hold the lock of the target.
+func (p *ProofPublisher) updatePublication(target *publicationTarget,
+	pub *publication, registrar Registrar, err error) {
+
+	pub.NumAttempts++
+	pub.LastAttempt = time.Now()
+	pub.LastErr = err
+
+	if err == nil {
+		log.Debugf("Published proof to universe server %v, "+
+			"universe=%v", target.addr.HostStr(),
+			pub.ID.StringForLog())
+
+		target.registrar = registrar
+		target.consecutiveFailures = 0
+		target.nextAttempt = time.Time{}
+		pub.State = PublicationPublished
+		target.pending = target.pending[1:]
+
+		return
+	}
+
+	// The connection may be broken, so we'll re-connect on the next
+	// attempt.
+	target.registrar = nil
+	target.consecutiveFailures++
+	target.nextAttempt = time.Now().Add(
+		p.backoff(target.consecutiveFailures),
+	)
+
+	if pub.NumAttempts >= p.cfg.MaxAttempts {
+		log.Errorf("Giving up publishing proof to universe server %v "+
+			"after %d attempts, universe=%v: %v",
+			target.addr.HostStr(), pub.NumAttempts,
+			pub.ID.StringForLog(), err)
+
+		pub.State = PublicationFailed
+		target.pending = target.pending[1:]
+
+		return
+	}
+
+	log.Warnf("Unable to publish proof to universe server %v, retrying "+
+		"at %v: %v", target.addr.HostStr(), target.nextAttempt, err)
+}
+
+// publisher is the goroutine that publishes the queued proofs to a single
+// target, one by one. After a failed attempt, the target is backed off
+// exponentially.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (p *ProofPublisher) publisher(target *publicationTarget) {
+	defer p.Wg.Done()
+
+	for {
+		pub, wait := target.nextPending()
+
+		// If there is nothing to publish, we wait for new proofs to be
+		// queued.
+		if pub == nil {
+			select {
+			case <-target.newProofs:
+				continue
+
+			case <-p.Quit:
+				return
+			}
+		}
+
+		// Otherwise, we wait for the backoff of the target to expire
+		// before the next attempt.
+		if wait > 0 {
+			select {
+			case <-time.After(wait):
+
+			case <-p.Quit:
+				return
+			}
+		}
+
+		p.publish(target, pub)
+	}
+}
@@ -0,0 +1,361 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// publishTimeout is the maximum time we wait for all publications to
+	// complete.
+	publishTimeout = 5 * time.Second
+)
+
+// mockRegistrar is a remote registrar that records all registered leaves and
+// fails a configurable number of times before accepting any.
+type mockRegistrar struct {
+	sync.Mutex
+
+	numFailures int
+
+	leaves []LeafKey
+}
+
+// RegisterIssuance records the leaf key, unless the registrar still needs to
+// fail.
+func (m *mockRegistrar) RegisterIssuance(_ context.Context, _ Identifier,
+	key LeafKey, _ *Leaf) (*Proof, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.numFailures > 0 {
+		m.numFailures--
+		return nil, fmt.Errorf("server unavailable")
+	}
+
+	m.leaves = append(m.leaves, key)
+
+	return &Proof{}, nil
+}
+
+// mockPublicationLog is an in-memory publication log.
+type mockPublicationLog struct {
+	sync.Mutex
+
+	pubs map[string][]Publication
+}
+
+// newMockPublicationLog creates a new, empty in-memory publication log.
+func newMockPublicationLog() *mockPublicationLog {
+	return &mockPublicationLog{
+		pubs: make(map[string][]Publication),
+	}
+}
+
+// UpsertPublication inserts the publication or updates the existing one with
+// the same universe and leaf key.
+func (m *mockPublicationLog) UpsertPublication(_ context.Context,
+	target ServerAddr, pub Publication) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	pubs := m.pubs[target.HostStr()]
+	for idx := range pubs {
+		if pubs[idx].ID == pub.ID &&
+			pubs[idx].Key.OutPoint == pub.Key.OutPoint {
+
+			pubs[idx] = pub
+			return nil
+		}
+	}
+	m.pubs[target.HostStr()] = append(pubs, pub)
+
+	return nil
+}
+
+// QueryPublications returns all publications of the target.
+func (m *mockPublicationLog) QueryPublications(_ context.Context,
+	target ServerAddr) ([]Publication, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return append([]Publication{}, m.pubs[target.HostStr()]...), nil
+}
+
+// mockLocalUniverse is a local universe that only serves the issuance proofs
+// of the leaves it knows.
+type mockLocalUniverse struct {
+	DiffEngine
+
+	leaves map[LeafKey]*Leaf
+}
+
+// FetchIssuanceProof returns the proof of the given leaf, if it is known.
+func (m *mockLocalUniverse) FetchIssuanceProof(_ context.Context,
+	_ Identifier, key LeafKey) ([]*Proof, error) {
+
+	leaf, ok := m.leaves[key]
+	if !ok {
+		return nil, ErrNoUniverseProofFound
+	}
+
+	return []*Proof{{
+		Leaf:    leaf,
+		LeafKey: key,
+	}}, nil
+}
+
+// randIssuanceItem returns a random issuance item of the given proof type.
+func randIssuanceItem(t *testing.T, proofType ProofType) *IssuanceItem {
+	return &IssuanceItem{
+		ID: Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: proofType,
+		},
+		Key: LeafKey{
+			OutPoint:  test.RandOp(t),
+			ScriptKey: fn.Ptr(asset.RandScriptKey(t)),
+		},
+		Leaf: &Leaf{},
+	}
+}
+
+// TestProofPublisher tests that new issuance proofs are published to every
+// target, and that each target is retried independently of the others.
+func TestProofPublisher(t *testing.T) {
+	t.Parallel()
+
+	var (
+		goodAddr    = NewServerAddrFromStr("good:10029")
+		flakyAddr   = NewServerAddrFromStr("flaky:10029")
+		offlineAddr = NewServerAddrFromStr("offline:10029")
+
+		goodRegistrar  = &mockRegistrar{}
+		flakyRegistrar = &mockRegistrar{numFailures: 2}
+	)
+
+	publisher := NewProofPublisher(PublisherConfig{
+		Targets: []ServerAddr{goodAddr, flakyAddr, offlineAddr},
+		NewRemoteRegistrar: func(addr ServerAddr) (Registrar, error) {
+			switch addr.HostStr() {
+			case goodAddr.HostStr():
+				return goodRegistrar, nil
+
+			case flakyAddr.HostStr():
+				return flakyRegistrar, nil
+
+			default:
+				return nil, fmt.Errorf("unable to connect")
+			}
+		},
+		PublicationLog: newMockPublicationLog(),
+		MinBackoff:     time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		MaxAttempts:    3,
+	})
+	require.NoError(t, publisher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, publisher.Stop())
+	})
+
+	// Transfer proofs are never published, only issuance proofs are.
+	items := []*IssuanceItem{
+		randIssuanceItem(t, ProofTypeIssuance),
+		randIssuanceItem(t, ProofTypeTransfer),
+		randIssuanceItem(t, ProofTypeIssuance),
+	}
+	publisher.Publish(items...)
+
+	issuanceKeys := []LeafKey{items[0].Key, items[2].Key}
+
+	// Both the good and the flaky target eventually accept both proofs,
+	// while we give up on the offline target after the max number of
+	// attempts for each proof.
+	err := wait.NoError(func() error {
+		for _, status := range publisher.Status(nil) {
+			for _, pub := range status.Publications {
+				if pub.State == PublicationPending {
+					return fmt.Errorf("publication to %v "+
+						"still pending",
+						status.Target.HostStr())
+				}
+			}
+		}
+
+		return nil
+	}, publishTimeout)
+	require.NoError(t, err)
+
+	require.Equal(t, issuanceKeys, goodRegistrar.leaves)
+	require.Equal(t, issuanceKeys, flakyRegistrar.leaves)
+
+	status := publisher.Status(nil)
+	require.Len(t, status, 3)
+
+	for _, targetStatus := range status {
+		require.Len(t, targetStatus.Publications, 2)
+
+		for idx, pub := range targetStatus.Publications {
+			require.Equal(t, issuanceKeys[idx], pub.Key)
+		}
+	}
+
+	// The good target accepted every proof on the first attempt.
+	goodStatus := status[0]
+	require.Zero(t, goodStatus.ConsecutiveFailures)
+	for _, pub := range goodStatus.Publications {
+		require.Equal(t, PublicationPublished, pub.State)
+		require.EqualValues(t, 1, pub.NumAttempts)
+		require.NoError(t, pub.LastErr)
+	}
+
+	// The flaky target needed two retries for the first proof, after which
+	// its backoff was reset.
+	flakyStatus := status[1]
+	require.Zero(t, flakyStatus.ConsecutiveFailures)
+	require.True(t, flakyStatus.NextAttempt.IsZero())
+	require.EqualValues(t, 3, flakyStatus.Publications[0].NumAttempts)
+	require.EqualValues(t, 1, flakyStatus.Publications[1].NumAttempts)
+	for _, pub := range flakyStatus.Publications {
+		require.Equal(t, PublicationPublished, pub.State)
+	}
+
+	// The offline target never accepted any proof.
+	offlineStatus := status[2]
+	require.EqualValues(t, 6, offlineStatus.ConsecutiveFailures)
+	for _, pub := range offlineStatus.Publications {
+		require.Equal(t, PublicationFailed, pub.State)
+		require.EqualValues(t, 3, pub.NumAttempts)
+		require.ErrorContains(t, pub.LastErr, "unable to connect")
+	}
+
+	// The status can also be filtered by universe.
+	filtered := publisher.Status(func(id Identifier) bool {
+		return id == items[2].ID
+	})
+	require.Len(t, filtered, 3)
+	for _, targetStatus := range filtered {
+		require.Len(t, targetStatus.Publications, 1)
+		require.Equal(
+			t, items[2].Key, targetStatus.Publications[0].Key,
+		)
+	}
+}
+
+// TestProofPublisherRestart tests that the publications that are still pending
+// when the publisher is stopped are resumed once it's started again, while the
+// ones that completed aren't attempted again.
+func TestProofPublisherRestart(t *testing.T) {
+	t.Parallel()
+
+	var (
+		target      = NewServerAddrFromStr("target:10029")
+		pubLog      = newMockPublicationLog()
+		registrar   = &mockRegistrar{}
+		published   = randIssuanceItem(t, ProofTypeIssuance)
+		pending     = randIssuanceItem(t, ProofTypeIssuance)
+		unknownLeaf = randIssuanceItem(t, ProofTypeIssuance)
+	)
+
+	// The publications were persisted by an earlier run, which published
+	// one of the proofs and was stopped before it could publish the other
+	// two. One of them is no longer known to the local universe.
+	ctx := context.Background()
+	publishedPub := Publication{
+		ID:          published.ID,
+		Key:         published.Key,
+		State:       PublicationPublished,
+		NumAttempts: 1,
+	}
+	for _, pub := range []Publication{publishedPub, {
+		ID:          pending.ID,
+		Key:         pending.Key,
+		State:       PublicationPending,
+		NumAttempts: 2,
+	}, {
+		ID:    unknownLeaf.ID,
+		Key:   unknownLeaf.Key,
+		State: PublicationPending,
+	}} {
+		require.NoError(t, pubLog.UpsertPublication(ctx, target, pub))
+	}
+
+	publisher := NewProofPublisher(PublisherConfig{
+		Targets: []ServerAddr{target},
+		NewRemoteRegistrar: func(ServerAddr) (Registrar, error) {
+			return registrar, nil
+		},
+		PublicationLog: pubLog,
+		LocalDiffEngine: &mockLocalUniverse{
+			leaves: map[LeafKey]*Leaf{
+				pending.Key: pending.Leaf,
+			},
+		},
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 10 * time.Millisecond,
+	})
+	require.NoError(t, publisher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, publisher.Stop())
+	})
+
+	err := wait.NoError(func() error {
+		pubs, err := pubLog.QueryPublications(ctx, target)
+		if err != nil {
+			return err
+		}
+
+		if pubs[1].State != PublicationPublished {
+			return fmt.Errorf("pending publication not resumed")
+		}
+
+		return nil
+	}, publishTimeout)
+	require.NoError(t, err)
+
+	// Only the pending proof was published again.
+	registrar.Lock()
+	require.Equal(t, []LeafKey{pending.Key}, registrar.leaves)
+	registrar.Unlock()
+
+	pubs, err := pubLog.QueryPublications(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, publishedPub, pubs[0])
+	require.EqualValues(t, 3, pubs[1].NumAttempts)
+	require.Equal(t, PublicationFailed, pubs[2].State)
+	require.ErrorIs(t, pubs[2].LastErr, ErrNoUniverseProofFound)
+
+	// The status reflects the resumed publications as well.
+	status := publisher.Status(nil)
+	require.Len(t, status, 1)
+	require.Len(t, status[0].Publications, 3)
+}
+
+// TestProofPublisherBackoff tests that the backoff of a target doubles with
+// every consecutive failure, up to the configured maximum.
+func TestProofPublisherBackoff(t *testing.T) {
+	t.Parallel()
+
+	publisher := NewProofPublisher(PublisherConfig{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+	})
+
+	require.Equal(t, time.Second, publisher.backoff(1))
+	require.Equal(t, 2*time.Second, publisher.backoff(2))
+	require.Equal(t, 8*time.Second, publisher.backoff(4))
+	require.Equal(t, 10*time.Second, publisher.backoff(5))
+	require.Equal(t, 10*time.Second, publisher.backoff(100))
+}
@@ -1,9 +1,17 @@
 package universe
 
 import (
+	"context"
+	"encoding/hex"
+	"math"
+	"math/rand"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/stretchr/testify/require"
 )
@@ -105,3 +113,426 @@ func TestValidateProofUniverseType(t *testing.T) {
 		})
 	}
 }
+
+// mockGroupUniverse is a base universe backend that only serves a static set
+// of minting leaves.
+type mockGroupUniverse struct {
+	BaseBackend
+
+	leaves []Leaf
+
+	// numLeafScans is the number of times all leaves were fetched.
+	numLeafScans int
+}
+
+// RootNode returns a root node that sums up the amounts of all leaves.
+func (m *mockGroupUniverse) RootNode(context.Context) (mssmt.Node, string,
+	error) {
+
+	if len(m.leaves) == 0 {
+		return nil, "", ErrNoUniverseRoot
+	}
+
+	var sum uint64
+	for _, leaf := range m.leaves {
+		sum += leaf.Amt
+	}
+
+	return mssmt.NewComputedNode(mssmt.EmptyTreeRootHash, sum), "", nil
+}
+
+// FetchIssuanceProof returns an empty proof if a leaf with the given key is
+// known.
+func (m *mockGroupUniverse) FetchIssuanceProof(_ context.Context,
+	key LeafKey) ([]*Proof, error) {
+
+	for _, leaf := range m.leaves {
+		leafKey := LeafKey{
+			OutPoint:  leaf.Proof.OutPoint(),
+			ScriptKey: &leaf.Proof.Asset.ScriptKey,
+		}
+		if leafKey.UniverseKey() == key.UniverseKey() {
+			return []*Proof{{}}, nil
+		}
+	}
+
+	return nil, ErrNoUniverseProofFound
+}
+
+// MintingLeaves returns all the minting leaves inserted into the universe.
+func (m *mockGroupUniverse) MintingLeaves(context.Context) ([]Leaf, error) {
+	m.numLeafScans++
+
+	return m.leaves, nil
+}
+
+// groupIssuer creates issuance items of an asset group for tests.
+type groupIssuer struct {
+	t *testing.T
+
+	id Identifier
+
+	reveal *asset.GroupKeyReveal
+
+	anchorAsset asset.Asset
+}
+
+// newGroupIssuer creates a new asset group with a random raw group key.
+func newGroupIssuer(t *testing.T) *groupIssuer {
+	return newScriptGroupIssuer(t, nil)
+}
+
+// newScriptGroupIssuer creates a new asset group with a random raw group key
+// that commits to the given tapscript root.
+func newScriptGroupIssuer(t *testing.T, tapscriptRoot []byte) *groupIssuer {
+	rawKey := test.RandPubKey(t)
+	anchorAsset := randGenesisAsset(t)
+	anchorID := anchorAsset.ID()
+	groupKey, err := asset.GroupPubKey(rawKey, anchorID[:], tapscriptRoot)
+	require.NoError(t, err)
+
+	return &groupIssuer{
+		t: t,
+		id: Identifier{
+			GroupKey:  groupKey,
+			ProofType: ProofTypeIssuance,
+		},
+		reveal: &asset.GroupKeyReveal{
+			RawKey:        asset.ToSerialized(rawKey),
+			TapscriptRoot: tapscriptRoot,
+		},
+		anchorAsset: anchorAsset,
+	}
+}
+
+// newItem creates a new issuance item of the group. If anchor is true, the
+// item is the group anchor.
+func (g *groupIssuer) newItem(amt uint64, height uint32, anchor bool,
+	meta *proof.MetaReveal) *IssuanceItem {
+
+	leafProof := &proof.Proof{
+		Asset:       randGenesisAsset(g.t),
+		BlockHeight: height,
+		InclusionProof: proof.TaprootProof{
+			OutputIndex: rand.Uint32(),
+		},
+		MetaReveal: meta,
+	}
+	if anchor {
+		leafProof.Asset = g.anchorAsset
+		leafProof.GroupKeyReveal = g.reveal
+	}
+
+	return &IssuanceItem{
+		ID: g.id,
+		Key: LeafKey{
+			OutPoint:  leafProof.OutPoint(),
+			ScriptKey: &leafProof.Asset.ScriptKey,
+		},
+		Leaf: &Leaf{
+			Proof: leafProof,
+			Amt:   amt,
+		},
+	}
+}
+
+// newGroupArchive creates a minting archive that serves the given backend for
+// all universes.
+func newGroupArchive(backend *mockGroupUniverse) *MintingArchive {
+	return NewMintingArchive(MintingArchiveConfig{
+		NewBaseTree: func(Identifier) BaseBackend {
+			return backend
+		},
+	})
+}
+
+// TestVerifyGroupSupply tests that new issuances into an asset group are
+// rejected if they exceed the max supply committed to by the group anchor.
+func TestVerifyGroupSupply(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	newMeta := func(maxSupply uint64) *proof.MetaReveal {
+		meta, err := proof.NewJSONMetaReveal(&proof.AssetMetadata{
+			MaxSupply: maxSupply,
+		})
+		require.NoError(t, err)
+
+		return meta
+	}
+
+	group := newGroupIssuer(t)
+	id := group.id
+	anchor := group.newItem(600, 0, true, newMeta(1000))
+	reissuance := group.newItem(300, 0, false, nil)
+
+	backend := &mockGroupUniverse{}
+	archive := newGroupArchive(backend)
+
+	// The anchor and a reissuance within the max supply can be inserted
+	// together.
+	err := archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{reissuance, anchor},
+	)
+	require.NoError(t, err)
+
+	// Once they are known, only the remaining supply can be issued.
+	backend.leaves = []Leaf{*anchor.Leaf, *reissuance.Leaf}
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{group.newItem(100, 0, false, nil)},
+	))
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			group.newItem(50, 0, false, nil),
+			group.newItem(51, 0, false, nil),
+		},
+	), proof.ErrMaxSupplyExceeded)
+
+	// Known issuances don't add to the supply again.
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{anchor, reissuance},
+	))
+
+	// A reissuance that reveals the raw group key can't raise or reset
+	// the max supply, since it isn't the group anchor.
+	fakeAnchor := group.newItem(200, 0, false, newMeta(0))
+	fakeAnchor.Leaf.Proof.GroupKeyReveal = group.reveal
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{fakeAnchor},
+	), proof.ErrMaxSupplyExceeded)
+
+	// The max supply was only looked up once, in the new issuances.
+	require.Zero(t, backend.numLeafScans)
+
+	// Groups created without a max supply aren't capped. The anchor is
+	// looked up once in the known issuances, after which new issuances
+	// are accepted without going through the known ones again.
+	uncappedGroup := newGroupIssuer(t)
+	uncappedBackend := &mockGroupUniverse{
+		leaves: []Leaf{
+			*uncappedGroup.newItem(600, 0, true, &proof.MetaReveal{
+				Data: []byte("opaque"),
+			}).Leaf,
+		},
+	}
+	uncappedArchive := newGroupArchive(uncappedBackend)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, uncappedArchive.verifyGroupSupply(
+			ctx, uncappedGroup.id, []*IssuanceItem{
+				uncappedGroup.newItem(
+					math.MaxInt64, 0, false, nil,
+				),
+			},
+		))
+	}
+	require.Equal(t, 1, uncappedBackend.numLeafScans)
+}
+
+// TestVerifyGroupEmission tests that new issuances into an asset group are
+// rejected if they exceed the supply unlocked by the emission schedule of the
+// group anchor at the height they were confirmed at.
+func TestVerifyGroupEmission(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schedule := proof.EmissionSchedule{{
+		StartHeight: 100,
+		Amount:      600,
+	}, {
+		StartHeight: 200,
+		Amount:      400,
+	}}
+	scheduledMeta, err := proof.NewJSONMetaReveal(&proof.AssetMetadata{
+		EmissionSchedule: schedule,
+	})
+	require.NoError(t, err)
+
+	group := newGroupIssuer(t)
+	id := group.id
+	anchor := group.newItem(500, 100, true, scheduledMeta)
+
+	backend := &mockGroupUniverse{}
+	archive := newGroupArchive(backend)
+
+	// Nothing can be issued before the first epoch starts, not even the
+	// group anchor.
+	earlyProof := *anchor.Leaf.Proof
+	earlyProof.BlockHeight = 99
+	earlyAnchor := &IssuanceItem{
+		ID:  id,
+		Key: anchor.Key,
+		Leaf: &Leaf{
+			Proof: &earlyProof,
+			Amt:   anchor.Leaf.Amt,
+		},
+	}
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{earlyAnchor},
+	), proof.ErrEmissionScheduleExceeded)
+
+	// The anchor and a reissuance within the first epoch can be inserted
+	// together, but not more than the epoch unlocked.
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			group.newItem(100, 150, false, nil), anchor,
+		},
+	))
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			group.newItem(101, 150, false, nil), anchor,
+		},
+	), proof.ErrEmissionScheduleExceeded)
+
+	// Once the anchor is known, the second epoch unlocks more supply, but
+	// only for issuances confirmed after it started.
+	backend.leaves = []Leaf{*anchor.Leaf}
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{group.newItem(200, 199, false, nil)},
+	), proof.ErrEmissionScheduleExceeded)
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{group.newItem(500, 200, false, nil)},
+	))
+
+	// An issuance within the first epoch is also rejected if it would make
+	// the supply issued by then exceed it, even if it was confirmed before
+	// other issuances of the group.
+	backend.leaves = append(
+		backend.leaves, *group.newItem(400, 250, false, nil).Leaf,
+	)
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{group.newItem(101, 150, false, nil)},
+	), proof.ErrEmissionScheduleExceeded)
+
+	// But it is accepted if it fits within the supply unlocked at its
+	// height, which requires going through the known issuances.
+	numLeafScans := backend.numLeafScans
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{group.newItem(100, 150, false, nil)},
+	))
+	require.Equal(t, numLeafScans+1, backend.numLeafScans)
+
+	// The emission rules of the group can be queried, along with the key
+	// of the group anchor that commits to them.
+	emission, err := archive.GroupEmission(ctx, id)
+	require.NoError(t, err)
+	require.Equal(
+		t, anchor.Key.UniverseKey(), emission.AnchorKey.UniverseKey(),
+	)
+	require.Equal(t, schedule, emission.Schedule)
+	require.Zero(t, emission.MaxSupply)
+	require.EqualValues(t, 900, emission.Issued)
+
+	// Without the group anchor, the emission rules are unknown.
+	otherGroup := newGroupIssuer(t)
+	_, err = newGroupArchive(backend).GroupEmission(ctx, otherGroup.id)
+	require.ErrorIs(t, err, ErrNoUniverseProofFound)
+}
+
+// TestVerifyDelegatedIssuance tests that new issuances into an asset group by
+// a delegate key are rejected if they exceed the max amount or the expiry
+// height of the delegation committed to by the group anchor.
+func TestVerifyDelegatedIssuance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	delegations := proof.IssuanceDelegations{{
+		Key: hex.EncodeToString(
+			schnorr.SerializePubKey(test.RandPubKey(t)),
+		),
+		MaxAmount:    500,
+		ExpiryHeight: 200,
+	}, {
+		Key: hex.EncodeToString(
+			schnorr.SerializePubKey(test.RandPubKey(t)),
+		),
+		MaxAmount: 100,
+	}}
+	delegatedMeta, err := proof.NewJSONMetaReveal(&proof.AssetMetadata{
+		Delegations: delegations,
+	})
+	require.NoError(t, err)
+	root, err := delegations.TapscriptRoot()
+	require.NoError(t, err)
+
+	group := newScriptGroupIssuer(t, root)
+	id := group.id
+	anchor := group.newItem(1000, 100, true, delegatedMeta)
+
+	// A delegated item has a group witness that spends the leaf of its
+	// delegation.
+	delegatedItem := func(delegation proof.IssuanceDelegation, amt uint64,
+		height uint32) *IssuanceItem {
+
+		script, err := delegation.Script()
+		require.NoError(t, err)
+
+		item := group.newItem(amt, height, false, nil)
+		item.Leaf.Proof.Asset.PrevWitnesses[0].TxWitness =
+			wire.TxWitness{
+				test.RandBytes(64), script, test.RandBytes(33),
+			}
+
+		return item
+	}
+
+	backend := &mockGroupUniverse{}
+	archive := newGroupArchive(backend)
+
+	// The anchor and delegated issuances within their limits can be
+	// inserted together. The group key isn't limited by the delegations.
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			delegatedItem(delegations[0], 300, 150),
+			delegatedItem(delegations[1], 100, 150),
+			group.newItem(math.MaxInt32, 150, false, nil),
+			anchor,
+		},
+	))
+
+	// Each delegate key can only issue up to its own max amount, summed
+	// over the new issuances.
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			delegatedItem(delegations[0], 300, 150),
+			delegatedItem(delegations[0], 201, 150),
+			anchor,
+		},
+	), proof.ErrDelegationExceeded)
+
+	// Once issuances are known, they count against the delegation too.
+	backend.leaves = []Leaf{
+		*anchor.Leaf, *delegatedItem(delegations[0], 300, 150).Leaf,
+	}
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			delegatedItem(delegations[0], 200, 200),
+		},
+	))
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			delegatedItem(delegations[0], 201, 200),
+		},
+	), proof.ErrDelegationExceeded)
+
+	// A delegation can't be used after it expired.
+	require.ErrorIs(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{
+			delegatedItem(delegations[0], 1, 201),
+		},
+	), proof.ErrDelegationExceeded)
+
+	// The known issuances are only scanned for new delegated issuances.
+	numLeafScans := backend.numLeafScans
+	require.NoError(t, archive.verifyGroupSupply(
+		ctx, id, []*IssuanceItem{group.newItem(1, 300, false, nil)},
+	))
+	require.Equal(t, numLeafScans, backend.numLeafScans)
+
+	// A group anchor whose group key doesn't commit to the delegations
+	// in its meta data is rejected.
+	badGroup := newGroupIssuer(t)
+	require.ErrorContains(t, newGroupArchive(&mockGroupUniverse{}).
+		verifyGroupSupply(ctx, badGroup.id, []*IssuanceItem{
+			badGroup.newItem(1, 100, true, delegatedMeta),
+		}), "doesn't commit to issuance delegations")
+}
@@ -88,6 +88,61 @@ func (l *LndRpcWalletAnchor) FundPsbt(ctx context.Context, packet *psbt.Packet,
 	}, nil
 }
 
+// FundPsbtWithStrategy attaches enough inputs to the target PSBT packet for it
+// to be valid, selecting them with the given strategy. lnd selects the largest
+// UTXOs first, so for any other strategy the inputs are selected from the
+// wallet's UTXOs and added to the template, which lnd then only adds the change
+// output to.
+func (l *LndRpcWalletAnchor) FundPsbtWithStrategy(ctx context.Context,
+	packet *psbt.Packet, minConfs uint32, feeRate chainfee.SatPerKWeight,
+	strategy tapfreighter.MultiCommitmentSelectStrategy) (
+	tapgarden.FundedPsbt, error) {
+
+	switch strategy {
+	case tapfreighter.DefaultSelectStrategy, tapfreighter.PreferMaxAmount:
+		return l.FundPsbt(ctx, packet, minConfs, feeRate)
+	}
+
+	if len(packet.UnsignedTx.TxIn) != 0 {
+		return tapgarden.FundedPsbt{}, fmt.Errorf("template already " +
+			"has inputs")
+	}
+
+	utxos, err := l.lnd.WalletKit.ListUnspent(
+		ctx, int32(minConfs), math.MaxInt32,
+		lndclient.WithUnspentAccount(lnwallet.DefaultAccountName),
+	)
+	if err != nil {
+		return tapgarden.FundedPsbt{}, fmt.Errorf("unable to list "+
+			"unspent outputs: %w", err)
+	}
+
+	selected, err := tapfreighter.SelectAnchorUtxos(
+		utxos, packet, feeRate, strategy,
+	)
+	if err != nil {
+		return tapgarden.FundedPsbt{}, err
+	}
+
+	template, err := psbt.New(
+		nil, packet.UnsignedTx.TxOut, packet.UnsignedTx.Version,
+		packet.UnsignedTx.LockTime, nil,
+	)
+	if err != nil {
+		return tapgarden.FundedPsbt{}, fmt.Errorf("unable to create "+
+			"template: %w", err)
+	}
+	template.Outputs = packet.Outputs
+	for _, utxo := range selected {
+		template.UnsignedTx.AddTxIn(
+			wire.NewTxIn(&utxo.OutPoint, nil, nil),
+		)
+		template.Inputs = append(template.Inputs, psbt.PInput{})
+	}
+
+	return l.FundPsbt(ctx, template, minConfs, feeRate)
+}
+
 // SignPsbt...
 func (l *LndRpcWalletAnchor) SignPsbt(ctx context.Context,
 	packet *psbt.Packet) (*psbt.Packet, error) {
@@ -100,6 +155,15 @@ func (l *LndRpcWalletAnchor) SignPsbt(ctx context.Context,
 	return pkt, nil
 }
 
+// BumpOutputFee asks lnd to sweep the given wallet output into a child
+// transaction that pays the given fee rate, accelerating the confirmation of
+// the unconfirmed parent transaction that created it.
+func (l *LndRpcWalletAnchor) BumpOutputFee(ctx context.Context,
+	op wire.OutPoint, feeRate chainfee.SatPerKWeight) error {
+
+	return l.lnd.WalletKit.BumpFee(ctx, op, feeRate)
+}
+
 // SignAndFinalizePsbt fully signs and finalizes the target PSBT packet.
 func (l *LndRpcWalletAnchor) SignAndFinalizePsbt(ctx context.Context,
 	pkt *psbt.Packet) (*psbt.Packet, error) {